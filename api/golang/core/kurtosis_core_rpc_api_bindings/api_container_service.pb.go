@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -164,6 +165,21 @@ type ServiceInfo struct {
 	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	// Shortened uuid of the service
 	ShortenedUuid string `protobuf:"bytes,7,opt,name=shortened_uuid,json=shortenedUuid,proto3" json:"shortened_uuid,omitempty"`
+	// The IP addresses of the individual replicas of the service inside the enclave, each listening on the private ports;
+	// private_ip_addr load-balances between them
+	// NOTE: Will be empty unless the service runs more than one replica, which only the Kubernetes backend supports
+	ReplicaPrivateIpAddrs []string `protobuf:"bytes,8,rep,name=replica_private_ip_addrs,json=replicaPrivateIpAddrs,proto3" json:"replica_private_ip_addrs,omitempty"`
+	// When the service's container was created
+	// NOTE: Will be unset if the backend doesn't report it
+	CreationTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// When the service's container was reported running to the API container
+	RunningTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=running_time,json=runningTime,proto3" json:"running_time,omitempty"`
+	// When the service's ready conditions passed
+	// NOTE: Will be unset if the service has no ready conditions or they haven't passed yet
+	ReadyTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ready_time,json=readyTime,proto3" json:"ready_time,omitempty"`
+	// The IPv6 address of the service inside the enclave
+	// NOTE: Will be empty unless the enclave was created with IPv6 enabled
+	MaybePrivateIpv6Addr string `protobuf:"bytes,12,opt,name=maybe_private_ipv6_addr,json=maybePrivateIpv6Addr,proto3" json:"maybe_private_ipv6_addr,omitempty"`
 }
 
 func (x *ServiceInfo) Reset() {
@@ -247,6 +263,41 @@ func (x *ServiceInfo) GetShortenedUuid() string {
 	return ""
 }
 
+func (x *ServiceInfo) GetReplicaPrivateIpAddrs() []string {
+	if x != nil {
+		return x.ReplicaPrivateIpAddrs
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetRunningTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunningTime
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetReadyTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyTime
+	}
+	return nil
+}
+
+func (x *ServiceInfo) GetMaybePrivateIpv6Addr() string {
+	if x != nil {
+		return x.MaybePrivateIpv6Addr
+	}
+	return ""
+}
+
 type ServiceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -273,6 +324,37 @@ type ServiceConfig struct {
 	PrivateIpAddrPlaceholder string `protobuf:"bytes,10,opt,name=private_ip_addr_placeholder,json=privateIpAddrPlaceholder,proto3" json:"private_ip_addr_placeholder,omitempty"`
 	// The subnetwork the service should be part of. If unset, the service will be placed in the 'default' subnetwork
 	Subnetwork *string `protobuf:"bytes,11,opt,name=subnetwork,proto3,oneof" json:"subnetwork,omitempty"`
+	// Kubernetes only: the service account the service's pod runs as, created in the enclave namespace if it doesn't exist yet.
+	// If unset, the pod runs as the default service account of the namespace
+	KubernetesServiceAccount *string `protobuf:"bytes,12,opt,name=kubernetes_service_account,json=kubernetesServiceAccount,proto3,oneof" json:"kubernetes_service_account,omitempty"`
+	// Kubernetes only: annotations of the above service account, e.g. to bind it to a cloud IAM role with IRSA or Workload Identity
+	KubernetesServiceAccountAnnotations map[string]string `protobuf:"bytes,13,rep,name=kubernetes_service_account_annotations,json=kubernetesServiceAccountAnnotations,proto3" json:"kubernetes_service_account_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Kubernetes only: constraints on the nodes the service's pod can be scheduled on
+	KubernetesScheduling *KubernetesScheduling `protobuf:"bytes,14,opt,name=kubernetes_scheduling,json=kubernetesScheduling,proto3,oneof" json:"kubernetes_scheduling,omitempty"`
+	// The number of replicas of the service, all reachable through the service's private IP address. Only the Kubernetes
+	// backend supports more than one, running them as a Deployment. If unset, the service runs a single instance
+	Replicas *uint32 `protobuf:"varint,15,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	// Docker only: the host CPUs the service is pinned to, in the Linux cpuset list format (e.g. "0-3,6"), which must all
+	// exist on the host. If unset, the service can run on any CPU
+	Cpuset *string `protobuf:"bytes,16,opt,name=cpuset,proto3,oneof" json:"cpuset,omitempty"`
+	// Docker only: the host NUMA nodes the service can allocate memory on, in the Linux cpuset list format (e.g. "0").
+	// If unset, the service can use any NUMA node
+	NumaNodes *string `protobuf:"bytes,17,opt,name=numa_nodes,json=numaNodes,proto3,oneof" json:"numa_nodes,omitempty"`
+	// The resource limits of the service's container, specified as ulimit_name -> limit (e.g. "nofile" or "nproc")
+	Ulimits map[string]*Ulimit `protobuf:"bytes,18,rep,name=ulimits,proto3" json:"ulimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The namespaced kernel parameters of the service's container, specified as sysctl_name -> value
+	// (e.g. "net.core.somaxconn" -> "1024")
+	Sysctls map[string]string `protobuf:"bytes,19,rep,name=sysctls,proto3" json:"sysctls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The size of the service's /dev/shm in megabytes, e.g. for Chromium-based services or databases that outgrow the
+	// default 64 megabytes. If unset, the container engine's default is used
+	ShmSizeMegabytes *uint64 `protobuf:"varint,20,opt,name=shm_size_megabytes,json=shmSizeMegabytes,proto3,oneof" json:"shm_size_megabytes,omitempty"`
+	// Docker only: the directories of the host bind-mounted into the service's container, specified as
+	// mountpoint_on_service -> absolute_host_dirpath, so code edited on the host shows up in the service without
+	// rebuilding its image. Only enclaves created with host directory mounts allowed accept them
+	HostDirMounts map[string]string `protobuf:"bytes,21,rep,name=host_dir_mounts,json=hostDirMounts,proto3" json:"host_dir_mounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The ID of the private port the service serves Prometheus metrics on, which makes the service a scrape target of the
+	// enclave. If unset, the service's port with ID 'metrics' is used, if it has one
+	MetricsPortId *string `protobuf:"bytes,22,opt,name=metrics_port_id,json=metricsPortId,proto3,oneof" json:"metrics_port_id,omitempty"`
 }
 
 func (x *ServiceConfig) Reset() {
@@ -384,20 +466,95 @@ func (x *ServiceConfig) GetSubnetwork() string {
 	return ""
 }
 
-// Subset of ServiceConfig attributes containing only the fields that are "live-updatable"
-// This will eventually get removed in favour of ServiceConfig when all attributes become "live-updatable"
-type UpdateServiceConfig struct {
+func (x *ServiceConfig) GetKubernetesServiceAccount() string {
+	if x != nil && x.KubernetesServiceAccount != nil {
+		return *x.KubernetesServiceAccount
+	}
+	return ""
+}
+
+func (x *ServiceConfig) GetKubernetesServiceAccountAnnotations() map[string]string {
+	if x != nil {
+		return x.KubernetesServiceAccountAnnotations
+	}
+	return nil
+}
+
+func (x *ServiceConfig) GetKubernetesScheduling() *KubernetesScheduling {
+	if x != nil {
+		return x.KubernetesScheduling
+	}
+	return nil
+}
+
+func (x *ServiceConfig) GetReplicas() uint32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *ServiceConfig) GetCpuset() string {
+	if x != nil && x.Cpuset != nil {
+		return *x.Cpuset
+	}
+	return ""
+}
+
+func (x *ServiceConfig) GetNumaNodes() string {
+	if x != nil && x.NumaNodes != nil {
+		return *x.NumaNodes
+	}
+	return ""
+}
+
+func (x *ServiceConfig) GetUlimits() map[string]*Ulimit {
+	if x != nil {
+		return x.Ulimits
+	}
+	return nil
+}
+
+func (x *ServiceConfig) GetSysctls() map[string]string {
+	if x != nil {
+		return x.Sysctls
+	}
+	return nil
+}
+
+func (x *ServiceConfig) GetShmSizeMegabytes() uint64 {
+	if x != nil && x.ShmSizeMegabytes != nil {
+		return *x.ShmSizeMegabytes
+	}
+	return 0
+}
+
+func (x *ServiceConfig) GetHostDirMounts() map[string]string {
+	if x != nil {
+		return x.HostDirMounts
+	}
+	return nil
+}
+
+func (x *ServiceConfig) GetMetricsPortId() string {
+	if x != nil && x.MetricsPortId != nil {
+		return *x.MetricsPortId
+	}
+	return ""
+}
+
+// A resource limit of a service's container; -1 means unlimited
+type Ulimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The name of the subnetwork the service will be moved to. If the subnetwork does not exist, it will be created.
-	// If it is set to "" the service will be moved to the default subnetwork
-	Subnetwork *string `protobuf:"bytes,1,opt,name=subnetwork,proto3,oneof" json:"subnetwork,omitempty"`
+	Soft int64 `protobuf:"varint,1,opt,name=soft,proto3" json:"soft,omitempty"`
+	Hard int64 `protobuf:"varint,2,opt,name=hard,proto3" json:"hard,omitempty"`
 }
 
-func (x *UpdateServiceConfig) Reset() {
-	*x = UpdateServiceConfig{}
+func (x *Ulimit) Reset() {
+	*x = Ulimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,13 +562,13 @@ func (x *UpdateServiceConfig) Reset() {
 	}
 }
 
-func (x *UpdateServiceConfig) String() string {
+func (x *Ulimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateServiceConfig) ProtoMessage() {}
+func (*Ulimit) ProtoMessage() {}
 
-func (x *UpdateServiceConfig) ProtoReflect() protoreflect.Message {
+func (x *Ulimit) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,38 +580,42 @@ func (x *UpdateServiceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateServiceConfig.ProtoReflect.Descriptor instead.
-func (*UpdateServiceConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use Ulimit.ProtoReflect.Descriptor instead.
+func (*Ulimit) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateServiceConfig) GetSubnetwork() string {
-	if x != nil && x.Subnetwork != nil {
-		return *x.Subnetwork
+func (x *Ulimit) GetSoft() int64 {
+	if x != nil {
+		return x.Soft
 	}
-	return ""
+	return 0
 }
 
-// ==============================================================================================
-//
-//	Execute Starlark Arguments
-//
-// ==============================================================================================
-type RunStarlarkScriptArgs struct {
+func (x *Ulimit) GetHard() int64 {
+	if x != nil {
+		return x.Hard
+	}
+	return 0
+}
+
+// Node scheduling controls of a service's pod, following the Kubernetes pod spec
+type KubernetesScheduling struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SerializedScript string `protobuf:"bytes,1,opt,name=serialized_script,json=serializedScript,proto3" json:"serialized_script,omitempty"`
-	SerializedParams string `protobuf:"bytes,2,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
-	// Defaults to false
-	DryRun *bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
-	// Defaults to 4
-	Parallelism *int32 `protobuf:"varint,4,opt,name=parallelism,proto3,oneof" json:"parallelism,omitempty"`
+	// Labels a node must have for the pod to be scheduled on it
+	NodeSelector map[string]string       `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Tolerations  []*KubernetesToleration `protobuf:"bytes,2,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	// JSON of a Kubernetes core/v1 Affinity object; empty if there's no affinity
+	SerializedAffinity string `protobuf:"bytes,3,opt,name=serialized_affinity,json=serializedAffinity,proto3" json:"serialized_affinity,omitempty"`
+	// JSON of a list of Kubernetes core/v1 TopologySpreadConstraint objects; empty if there are no constraints
+	SerializedTopologySpreadConstraints string `protobuf:"bytes,4,opt,name=serialized_topology_spread_constraints,json=serializedTopologySpreadConstraints,proto3" json:"serialized_topology_spread_constraints,omitempty"`
 }
 
-func (x *RunStarlarkScriptArgs) Reset() {
-	*x = RunStarlarkScriptArgs{}
+func (x *KubernetesScheduling) Reset() {
+	*x = KubernetesScheduling{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,13 +623,13 @@ func (x *RunStarlarkScriptArgs) Reset() {
 	}
 }
 
-func (x *RunStarlarkScriptArgs) String() string {
+func (x *KubernetesScheduling) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunStarlarkScriptArgs) ProtoMessage() {}
+func (*KubernetesScheduling) ProtoMessage() {}
 
-func (x *RunStarlarkScriptArgs) ProtoReflect() protoreflect.Message {
+func (x *KubernetesScheduling) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,62 +641,58 @@ func (x *RunStarlarkScriptArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunStarlarkScriptArgs.ProtoReflect.Descriptor instead.
-func (*RunStarlarkScriptArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use KubernetesScheduling.ProtoReflect.Descriptor instead.
+func (*KubernetesScheduling) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *RunStarlarkScriptArgs) GetSerializedScript() string {
+func (x *KubernetesScheduling) GetNodeSelector() map[string]string {
 	if x != nil {
-		return x.SerializedScript
+		return x.NodeSelector
 	}
-	return ""
+	return nil
 }
 
-func (x *RunStarlarkScriptArgs) GetSerializedParams() string {
+func (x *KubernetesScheduling) GetTolerations() []*KubernetesToleration {
 	if x != nil {
-		return x.SerializedParams
+		return x.Tolerations
 	}
-	return ""
+	return nil
 }
 
-func (x *RunStarlarkScriptArgs) GetDryRun() bool {
-	if x != nil && x.DryRun != nil {
-		return *x.DryRun
+func (x *KubernetesScheduling) GetSerializedAffinity() string {
+	if x != nil {
+		return x.SerializedAffinity
 	}
-	return false
+	return ""
 }
 
-func (x *RunStarlarkScriptArgs) GetParallelism() int32 {
-	if x != nil && x.Parallelism != nil {
-		return *x.Parallelism
+func (x *KubernetesScheduling) GetSerializedTopologySpreadConstraints() string {
+	if x != nil {
+		return x.SerializedTopologySpreadConstraints
 	}
-	return 0
+	return ""
 }
 
-type RunStarlarkPackageArgs struct {
+type KubernetesToleration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PackageId string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	// Serialized parameters data for the Starlark package main function
-	// This should be a valid JSON string
-	//
-	// Types that are assignable to StarlarkPackageContent:
-	//
-	//	*RunStarlarkPackageArgs_Local
-	//	*RunStarlarkPackageArgs_Remote
-	StarlarkPackageContent isRunStarlarkPackageArgs_StarlarkPackageContent `protobuf_oneof:"starlark_package_content"`
-	SerializedParams       string                                          `protobuf:"bytes,5,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
-	// Defaults to false
-	DryRun *bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
-	// Defaults to 4
-	Parallelism *int32 `protobuf:"varint,7,opt,name=parallelism,proto3,oneof" json:"parallelism,omitempty"`
+	// Empty along with the 'Exists' operator to tolerate every taint
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 'Equal' or 'Exists'
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Must be empty with the 'Exists' operator
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// 'NoSchedule', 'PreferNoSchedule' or 'NoExecute'; empty to tolerate every effect
+	Effect string `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
+	// Only applies to the 'NoExecute' effect: how long the pod stays bound to a node after the taint is added
+	TolerationSeconds *int64 `protobuf:"varint,5,opt,name=toleration_seconds,json=tolerationSeconds,proto3,oneof" json:"toleration_seconds,omitempty"`
 }
 
-func (x *RunStarlarkPackageArgs) Reset() {
-	*x = RunStarlarkPackageArgs{}
+func (x *KubernetesToleration) Reset() {
+	*x = KubernetesToleration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,13 +700,13 @@ func (x *RunStarlarkPackageArgs) Reset() {
 	}
 }
 
-func (x *RunStarlarkPackageArgs) String() string {
+func (x *KubernetesToleration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunStarlarkPackageArgs) ProtoMessage() {}
+func (*KubernetesToleration) ProtoMessage() {}
 
-func (x *RunStarlarkPackageArgs) ProtoReflect() protoreflect.Message {
+func (x *KubernetesToleration) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -561,98 +718,68 @@ func (x *RunStarlarkPackageArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunStarlarkPackageArgs.ProtoReflect.Descriptor instead.
-func (*RunStarlarkPackageArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use KubernetesToleration.ProtoReflect.Descriptor instead.
+func (*KubernetesToleration) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RunStarlarkPackageArgs) GetPackageId() string {
+func (x *KubernetesToleration) GetKey() string {
 	if x != nil {
-		return x.PackageId
+		return x.Key
 	}
 	return ""
 }
 
-func (m *RunStarlarkPackageArgs) GetStarlarkPackageContent() isRunStarlarkPackageArgs_StarlarkPackageContent {
-	if m != nil {
-		return m.StarlarkPackageContent
-	}
-	return nil
-}
-
-func (x *RunStarlarkPackageArgs) GetLocal() []byte {
-	if x, ok := x.GetStarlarkPackageContent().(*RunStarlarkPackageArgs_Local); ok {
-		return x.Local
-	}
-	return nil
-}
-
-func (x *RunStarlarkPackageArgs) GetRemote() bool {
-	if x, ok := x.GetStarlarkPackageContent().(*RunStarlarkPackageArgs_Remote); ok {
-		return x.Remote
+func (x *KubernetesToleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
 	}
-	return false
+	return ""
 }
 
-func (x *RunStarlarkPackageArgs) GetSerializedParams() string {
+func (x *KubernetesToleration) GetValue() string {
 	if x != nil {
-		return x.SerializedParams
+		return x.Value
 	}
 	return ""
 }
 
-func (x *RunStarlarkPackageArgs) GetDryRun() bool {
-	if x != nil && x.DryRun != nil {
-		return *x.DryRun
+func (x *KubernetesToleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
 	}
-	return false
+	return ""
 }
 
-func (x *RunStarlarkPackageArgs) GetParallelism() int32 {
-	if x != nil && x.Parallelism != nil {
-		return *x.Parallelism
+func (x *KubernetesToleration) GetTolerationSeconds() int64 {
+	if x != nil && x.TolerationSeconds != nil {
+		return *x.TolerationSeconds
 	}
 	return 0
 }
 
-type isRunStarlarkPackageArgs_StarlarkPackageContent interface {
-	isRunStarlarkPackageArgs_StarlarkPackageContent()
-}
-
-type RunStarlarkPackageArgs_Local struct {
-	Local []byte `protobuf:"bytes,3,opt,name=local,proto3,oneof"` // the payload of the local module
-}
-
-type RunStarlarkPackageArgs_Remote struct {
-	Remote bool `protobuf:"varint,4,opt,name=remote,proto3,oneof"` // just a flag to indicate the module must be cloned inside the API
-}
-
-func (*RunStarlarkPackageArgs_Local) isRunStarlarkPackageArgs_StarlarkPackageContent() {}
-
-func (*RunStarlarkPackageArgs_Remote) isRunStarlarkPackageArgs_StarlarkPackageContent() {}
-
-// ==============================================================================================
-//
-//	Starlark Execution Response
-//
-// ==============================================================================================
-type StarlarkRunResponseLine struct {
+// Subset of ServiceConfig attributes containing only the fields that are "live-updatable"
+// This will eventually get removed in favour of ServiceConfig when all attributes become "live-updatable"
+type UpdateServiceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to RunResponseLine:
-	//
-	//	*StarlarkRunResponseLine_Instruction
-	//	*StarlarkRunResponseLine_Error
-	//	*StarlarkRunResponseLine_ProgressInfo
-	//	*StarlarkRunResponseLine_InstructionResult
-	//	*StarlarkRunResponseLine_RunFinishedEvent
-	RunResponseLine isStarlarkRunResponseLine_RunResponseLine `protobuf_oneof:"run_response_line"`
+	// The name of the subnetwork the service will be moved to. If the subnetwork does not exist, it will be created.
+	// If it is set to "" the service will be moved to the default subnetwork
+	Subnetwork *string `protobuf:"bytes,1,opt,name=subnetwork,proto3,oneof" json:"subnetwork,omitempty"`
+	// The image the container of the service gets recreated from. The service keeps its name, UUID and private IP
+	// address, so services that depend on it don't need to be updated
+	ContainerImageName *string `protobuf:"bytes,2,opt,name=container_image_name,json=containerImageName,proto3,oneof" json:"container_image_name,omitempty"`
+	// Replaces the private ports of the service, recreating its container. Public ports, if any, get dropped as they
+	// need to match the private ones. Left unchanged when empty
+	PrivatePorts map[string]*Port `protobuf:"bytes,3,rep,name=private_ports,json=privatePorts,proto3" json:"private_ports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Replaces the environment variables of the service, recreating its container. Left unchanged when empty
+	EnvVars map[string]string `protobuf:"bytes,4,rep,name=env_vars,json=envVars,proto3" json:"env_vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *StarlarkRunResponseLine) Reset() {
-	*x = StarlarkRunResponseLine{}
+func (x *UpdateServiceConfig) Reset() {
+	*x = UpdateServiceConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -660,13 +787,13 @@ func (x *StarlarkRunResponseLine) Reset() {
 	}
 }
 
-func (x *StarlarkRunResponseLine) String() string {
+func (x *UpdateServiceConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkRunResponseLine) ProtoMessage() {}
+func (*UpdateServiceConfig) ProtoMessage() {}
 
-func (x *StarlarkRunResponseLine) ProtoReflect() protoreflect.Message {
+func (x *UpdateServiceConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -678,100 +805,61 @@ func (x *StarlarkRunResponseLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkRunResponseLine.ProtoReflect.Descriptor instead.
-func (*StarlarkRunResponseLine) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateServiceConfig.ProtoReflect.Descriptor instead.
+func (*UpdateServiceConfig) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (m *StarlarkRunResponseLine) GetRunResponseLine() isStarlarkRunResponseLine_RunResponseLine {
-	if m != nil {
-		return m.RunResponseLine
+func (x *UpdateServiceConfig) GetSubnetwork() string {
+	if x != nil && x.Subnetwork != nil {
+		return *x.Subnetwork
 	}
-	return nil
+	return ""
 }
 
-func (x *StarlarkRunResponseLine) GetInstruction() *StarlarkInstruction {
-	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_Instruction); ok {
-		return x.Instruction
+func (x *UpdateServiceConfig) GetContainerImageName() string {
+	if x != nil && x.ContainerImageName != nil {
+		return *x.ContainerImageName
 	}
-	return nil
+	return ""
 }
 
-func (x *StarlarkRunResponseLine) GetError() *StarlarkError {
-	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_Error); ok {
-		return x.Error
-	}
-	return nil
-}
-
-func (x *StarlarkRunResponseLine) GetProgressInfo() *StarlarkRunProgress {
-	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_ProgressInfo); ok {
-		return x.ProgressInfo
-	}
-	return nil
-}
-
-func (x *StarlarkRunResponseLine) GetInstructionResult() *StarlarkInstructionResult {
-	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_InstructionResult); ok {
-		return x.InstructionResult
+func (x *UpdateServiceConfig) GetPrivatePorts() map[string]*Port {
+	if x != nil {
+		return x.PrivatePorts
 	}
 	return nil
 }
 
-func (x *StarlarkRunResponseLine) GetRunFinishedEvent() *StarlarkRunFinishedEvent {
-	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_RunFinishedEvent); ok {
-		return x.RunFinishedEvent
+func (x *UpdateServiceConfig) GetEnvVars() map[string]string {
+	if x != nil {
+		return x.EnvVars
 	}
 	return nil
 }
 
-type isStarlarkRunResponseLine_RunResponseLine interface {
-	isStarlarkRunResponseLine_RunResponseLine()
-}
-
-type StarlarkRunResponseLine_Instruction struct {
-	Instruction *StarlarkInstruction `protobuf:"bytes,1,opt,name=instruction,proto3,oneof"`
-}
-
-type StarlarkRunResponseLine_Error struct {
-	Error *StarlarkError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
-}
-
-type StarlarkRunResponseLine_ProgressInfo struct {
-	ProgressInfo *StarlarkRunProgress `protobuf:"bytes,3,opt,name=progress_info,json=progressInfo,proto3,oneof"`
-}
-
-type StarlarkRunResponseLine_InstructionResult struct {
-	InstructionResult *StarlarkInstructionResult `protobuf:"bytes,4,opt,name=instruction_result,json=instructionResult,proto3,oneof"`
-}
-
-type StarlarkRunResponseLine_RunFinishedEvent struct {
-	RunFinishedEvent *StarlarkRunFinishedEvent `protobuf:"bytes,5,opt,name=run_finished_event,json=runFinishedEvent,proto3,oneof"`
-}
-
-func (*StarlarkRunResponseLine_Instruction) isStarlarkRunResponseLine_RunResponseLine() {}
-
-func (*StarlarkRunResponseLine_Error) isStarlarkRunResponseLine_RunResponseLine() {}
-
-func (*StarlarkRunResponseLine_ProgressInfo) isStarlarkRunResponseLine_RunResponseLine() {}
-
-func (*StarlarkRunResponseLine_InstructionResult) isStarlarkRunResponseLine_RunResponseLine() {}
-
-func (*StarlarkRunResponseLine_RunFinishedEvent) isStarlarkRunResponseLine_RunResponseLine() {}
-
-type StarlarkInstruction struct {
+// ==============================================================================================
+//
+//	Execute Starlark Arguments
+//
+// ==============================================================================================
+type RunStarlarkScriptArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position              *StarlarkInstructionPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	InstructionName       string                       `protobuf:"bytes,2,opt,name=instruction_name,json=instructionName,proto3" json:"instruction_name,omitempty"`
-	Arguments             []*StarlarkInstructionArg    `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
-	ExecutableInstruction string                       `protobuf:"bytes,4,opt,name=executable_instruction,json=executableInstruction,proto3" json:"executable_instruction,omitempty"`
+	SerializedScript string `protobuf:"bytes,1,opt,name=serialized_script,json=serializedScript,proto3" json:"serialized_script,omitempty"`
+	SerializedParams string `protobuf:"bytes,2,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
+	// Defaults to false
+	DryRun *bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
+	// Defaults to 4
+	Parallelism *int32 `protobuf:"varint,4,opt,name=parallelism,proto3,oneof" json:"parallelism,omitempty"`
+	// Fails the run on the first deprecated feature used instead of only warning about it. Defaults to false
+	Strict *bool `protobuf:"varint,5,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
 }
 
-func (x *StarlarkInstruction) Reset() {
-	*x = StarlarkInstruction{}
+func (x *RunStarlarkScriptArgs) Reset() {
+	*x = RunStarlarkScriptArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,13 +867,13 @@ func (x *StarlarkInstruction) Reset() {
 	}
 }
 
-func (x *StarlarkInstruction) String() string {
+func (x *RunStarlarkScriptArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkInstruction) ProtoMessage() {}
+func (*RunStarlarkScriptArgs) ProtoMessage() {}
 
-func (x *StarlarkInstruction) ProtoReflect() protoreflect.Message {
+func (x *RunStarlarkScriptArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -797,49 +885,71 @@ func (x *StarlarkInstruction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkInstruction.ProtoReflect.Descriptor instead.
-func (*StarlarkInstruction) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunStarlarkScriptArgs.ProtoReflect.Descriptor instead.
+func (*RunStarlarkScriptArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *StarlarkInstruction) GetPosition() *StarlarkInstructionPosition {
+func (x *RunStarlarkScriptArgs) GetSerializedScript() string {
 	if x != nil {
-		return x.Position
+		return x.SerializedScript
 	}
-	return nil
+	return ""
 }
 
-func (x *StarlarkInstruction) GetInstructionName() string {
+func (x *RunStarlarkScriptArgs) GetSerializedParams() string {
 	if x != nil {
-		return x.InstructionName
+		return x.SerializedParams
 	}
 	return ""
 }
 
-func (x *StarlarkInstruction) GetArguments() []*StarlarkInstructionArg {
-	if x != nil {
-		return x.Arguments
+func (x *RunStarlarkScriptArgs) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
 	}
-	return nil
+	return false
 }
 
-func (x *StarlarkInstruction) GetExecutableInstruction() string {
-	if x != nil {
-		return x.ExecutableInstruction
+func (x *RunStarlarkScriptArgs) GetParallelism() int32 {
+	if x != nil && x.Parallelism != nil {
+		return *x.Parallelism
 	}
-	return ""
+	return 0
 }
 
-type StarlarkInstructionResult struct {
+func (x *RunStarlarkScriptArgs) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+type RunStarlarkPackageArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SerializedInstructionResult string `protobuf:"bytes,1,opt,name=serialized_instruction_result,json=serializedInstructionResult,proto3" json:"serialized_instruction_result,omitempty"`
+	PackageId string `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// Serialized parameters data for the Starlark package main function
+	// This should be a valid JSON string
+	//
+	// Types that are assignable to StarlarkPackageContent:
+	//
+	//	*RunStarlarkPackageArgs_Local
+	//	*RunStarlarkPackageArgs_Remote
+	StarlarkPackageContent isRunStarlarkPackageArgs_StarlarkPackageContent `protobuf_oneof:"starlark_package_content"`
+	SerializedParams       string                                          `protobuf:"bytes,5,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
+	// Defaults to false
+	DryRun *bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"`
+	// Defaults to 4
+	Parallelism *int32 `protobuf:"varint,7,opt,name=parallelism,proto3,oneof" json:"parallelism,omitempty"`
+	// Fails the run on the first deprecated feature used instead of only warning about it. Defaults to false
+	Strict *bool `protobuf:"varint,8,opt,name=strict,proto3,oneof" json:"strict,omitempty"`
 }
 
-func (x *StarlarkInstructionResult) Reset() {
-	*x = StarlarkInstructionResult{}
+func (x *RunStarlarkPackageArgs) Reset() {
+	*x = RunStarlarkPackageArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,13 +957,13 @@ func (x *StarlarkInstructionResult) Reset() {
 	}
 }
 
-func (x *StarlarkInstructionResult) String() string {
+func (x *RunStarlarkPackageArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkInstructionResult) ProtoMessage() {}
+func (*RunStarlarkPackageArgs) ProtoMessage() {}
 
-func (x *StarlarkInstructionResult) ProtoReflect() protoreflect.Message {
+func (x *RunStarlarkPackageArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -865,30 +975,107 @@ func (x *StarlarkInstructionResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkInstructionResult.ProtoReflect.Descriptor instead.
-func (*StarlarkInstructionResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use RunStarlarkPackageArgs.ProtoReflect.Descriptor instead.
+func (*RunStarlarkPackageArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StarlarkInstructionResult) GetSerializedInstructionResult() string {
+func (x *RunStarlarkPackageArgs) GetPackageId() string {
 	if x != nil {
-		return x.SerializedInstructionResult
+		return x.PackageId
 	}
 	return ""
 }
 
-type StarlarkInstructionArg struct {
+func (m *RunStarlarkPackageArgs) GetStarlarkPackageContent() isRunStarlarkPackageArgs_StarlarkPackageContent {
+	if m != nil {
+		return m.StarlarkPackageContent
+	}
+	return nil
+}
+
+func (x *RunStarlarkPackageArgs) GetLocal() []byte {
+	if x, ok := x.GetStarlarkPackageContent().(*RunStarlarkPackageArgs_Local); ok {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *RunStarlarkPackageArgs) GetRemote() bool {
+	if x, ok := x.GetStarlarkPackageContent().(*RunStarlarkPackageArgs_Remote); ok {
+		return x.Remote
+	}
+	return false
+}
+
+func (x *RunStarlarkPackageArgs) GetSerializedParams() string {
+	if x != nil {
+		return x.SerializedParams
+	}
+	return ""
+}
+
+func (x *RunStarlarkPackageArgs) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *RunStarlarkPackageArgs) GetParallelism() int32 {
+	if x != nil && x.Parallelism != nil {
+		return *x.Parallelism
+	}
+	return 0
+}
+
+func (x *RunStarlarkPackageArgs) GetStrict() bool {
+	if x != nil && x.Strict != nil {
+		return *x.Strict
+	}
+	return false
+}
+
+type isRunStarlarkPackageArgs_StarlarkPackageContent interface {
+	isRunStarlarkPackageArgs_StarlarkPackageContent()
+}
+
+type RunStarlarkPackageArgs_Local struct {
+	Local []byte `protobuf:"bytes,3,opt,name=local,proto3,oneof"` // the payload of the local module
+}
+
+type RunStarlarkPackageArgs_Remote struct {
+	Remote bool `protobuf:"varint,4,opt,name=remote,proto3,oneof"` // just a flag to indicate the module must be cloned inside the API
+}
+
+func (*RunStarlarkPackageArgs_Local) isRunStarlarkPackageArgs_StarlarkPackageContent() {}
+
+func (*RunStarlarkPackageArgs_Remote) isRunStarlarkPackageArgs_StarlarkPackageContent() {}
+
+// ==============================================================================================
+//
+//	Starlark Execution Response
+//
+// ==============================================================================================
+type StarlarkRunResponseLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SerializedArgValue string  `protobuf:"bytes,1,opt,name=serialized_arg_value,json=serializedArgValue,proto3" json:"serialized_arg_value,omitempty"`
-	ArgName            *string `protobuf:"bytes,2,opt,name=arg_name,json=argName,proto3,oneof" json:"arg_name,omitempty"`
-	IsRepresentative   bool    `protobuf:"varint,3,opt,name=is_representative,json=isRepresentative,proto3" json:"is_representative,omitempty"`
+	// Types that are assignable to RunResponseLine:
+	//
+	//	*StarlarkRunResponseLine_Instruction
+	//	*StarlarkRunResponseLine_Error
+	//	*StarlarkRunResponseLine_ProgressInfo
+	//	*StarlarkRunResponseLine_InstructionResult
+	//	*StarlarkRunResponseLine_RunFinishedEvent
+	//	*StarlarkRunResponseLine_ResourceEstimate
+	//	*StarlarkRunResponseLine_DeprecationWarning
+	RunResponseLine isStarlarkRunResponseLine_RunResponseLine `protobuf_oneof:"run_response_line"`
 }
 
-func (x *StarlarkInstructionArg) Reset() {
-	*x = StarlarkInstructionArg{}
+func (x *StarlarkRunResponseLine) Reset() {
+	*x = StarlarkRunResponseLine{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -896,13 +1083,13 @@ func (x *StarlarkInstructionArg) Reset() {
 	}
 }
 
-func (x *StarlarkInstructionArg) String() string {
+func (x *StarlarkRunResponseLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkInstructionArg) ProtoMessage() {}
+func (*StarlarkRunResponseLine) ProtoMessage() {}
 
-func (x *StarlarkInstructionArg) ProtoReflect() protoreflect.Message {
+func (x *StarlarkRunResponseLine) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,44 +1101,129 @@ func (x *StarlarkInstructionArg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkInstructionArg.ProtoReflect.Descriptor instead.
-func (*StarlarkInstructionArg) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkRunResponseLine.ProtoReflect.Descriptor instead.
+func (*StarlarkRunResponseLine) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StarlarkInstructionArg) GetSerializedArgValue() string {
-	if x != nil {
-		return x.SerializedArgValue
+func (m *StarlarkRunResponseLine) GetRunResponseLine() isStarlarkRunResponseLine_RunResponseLine {
+	if m != nil {
+		return m.RunResponseLine
 	}
-	return ""
+	return nil
 }
 
-func (x *StarlarkInstructionArg) GetArgName() string {
-	if x != nil && x.ArgName != nil {
-		return *x.ArgName
+func (x *StarlarkRunResponseLine) GetInstruction() *StarlarkInstruction {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_Instruction); ok {
+		return x.Instruction
 	}
-	return ""
+	return nil
 }
 
-func (x *StarlarkInstructionArg) GetIsRepresentative() bool {
-	if x != nil {
-		return x.IsRepresentative
+func (x *StarlarkRunResponseLine) GetError() *StarlarkError {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_Error); ok {
+		return x.Error
 	}
-	return false
+	return nil
 }
 
-type StarlarkInstructionPosition struct {
+func (x *StarlarkRunResponseLine) GetProgressInfo() *StarlarkRunProgress {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_ProgressInfo); ok {
+		return x.ProgressInfo
+	}
+	return nil
+}
+
+func (x *StarlarkRunResponseLine) GetInstructionResult() *StarlarkInstructionResult {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_InstructionResult); ok {
+		return x.InstructionResult
+	}
+	return nil
+}
+
+func (x *StarlarkRunResponseLine) GetRunFinishedEvent() *StarlarkRunFinishedEvent {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_RunFinishedEvent); ok {
+		return x.RunFinishedEvent
+	}
+	return nil
+}
+
+func (x *StarlarkRunResponseLine) GetResourceEstimate() *StarlarkResourceEstimate {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_ResourceEstimate); ok {
+		return x.ResourceEstimate
+	}
+	return nil
+}
+
+func (x *StarlarkRunResponseLine) GetDeprecationWarning() *StarlarkDeprecationWarning {
+	if x, ok := x.GetRunResponseLine().(*StarlarkRunResponseLine_DeprecationWarning); ok {
+		return x.DeprecationWarning
+	}
+	return nil
+}
+
+type isStarlarkRunResponseLine_RunResponseLine interface {
+	isStarlarkRunResponseLine_RunResponseLine()
+}
+
+type StarlarkRunResponseLine_Instruction struct {
+	Instruction *StarlarkInstruction `protobuf:"bytes,1,opt,name=instruction,proto3,oneof"`
+}
+
+type StarlarkRunResponseLine_Error struct {
+	Error *StarlarkError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+type StarlarkRunResponseLine_ProgressInfo struct {
+	ProgressInfo *StarlarkRunProgress `protobuf:"bytes,3,opt,name=progress_info,json=progressInfo,proto3,oneof"`
+}
+
+type StarlarkRunResponseLine_InstructionResult struct {
+	InstructionResult *StarlarkInstructionResult `protobuf:"bytes,4,opt,name=instruction_result,json=instructionResult,proto3,oneof"`
+}
+
+type StarlarkRunResponseLine_RunFinishedEvent struct {
+	RunFinishedEvent *StarlarkRunFinishedEvent `protobuf:"bytes,5,opt,name=run_finished_event,json=runFinishedEvent,proto3,oneof"`
+}
+
+type StarlarkRunResponseLine_ResourceEstimate struct {
+	// Only sent on dry runs, once the plan got validated
+	ResourceEstimate *StarlarkResourceEstimate `protobuf:"bytes,6,opt,name=resource_estimate,json=resourceEstimate,proto3,oneof"`
+}
+
+type StarlarkRunResponseLine_DeprecationWarning struct {
+	// Sent once per deprecated feature used by the run, once it got interpreted
+	DeprecationWarning *StarlarkDeprecationWarning `protobuf:"bytes,7,opt,name=deprecation_warning,json=deprecationWarning,proto3,oneof"`
+}
+
+func (*StarlarkRunResponseLine_Instruction) isStarlarkRunResponseLine_RunResponseLine() {}
+
+func (*StarlarkRunResponseLine_Error) isStarlarkRunResponseLine_RunResponseLine() {}
+
+func (*StarlarkRunResponseLine_ProgressInfo) isStarlarkRunResponseLine_RunResponseLine() {}
+
+func (*StarlarkRunResponseLine_InstructionResult) isStarlarkRunResponseLine_RunResponseLine() {}
+
+func (*StarlarkRunResponseLine_RunFinishedEvent) isStarlarkRunResponseLine_RunResponseLine() {}
+
+func (*StarlarkRunResponseLine_ResourceEstimate) isStarlarkRunResponseLine_RunResponseLine() {}
+
+func (*StarlarkRunResponseLine_DeprecationWarning) isStarlarkRunResponseLine_RunResponseLine() {}
+
+type StarlarkDeprecationWarning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Line     int32  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
-	Column   int32  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	// Stable identifier of the deprecated feature, e.g. 'SERVICE_CONFIG_PUBLIC_PORTS'
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Where the deprecated feature got first used
+	Position *StarlarkInstructionPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *StarlarkInstructionPosition) Reset() {
-	*x = StarlarkInstructionPosition{}
+func (x *StarlarkDeprecationWarning) Reset() {
+	*x = StarlarkDeprecationWarning{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,13 +1231,13 @@ func (x *StarlarkInstructionPosition) Reset() {
 	}
 }
 
-func (x *StarlarkInstructionPosition) String() string {
+func (x *StarlarkDeprecationWarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkInstructionPosition) ProtoMessage() {}
+func (*StarlarkDeprecationWarning) ProtoMessage() {}
 
-func (x *StarlarkInstructionPosition) ProtoReflect() protoreflect.Message {
+func (x *StarlarkDeprecationWarning) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,47 +1249,45 @@ func (x *StarlarkInstructionPosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkInstructionPosition.ProtoReflect.Descriptor instead.
-func (*StarlarkInstructionPosition) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkDeprecationWarning.ProtoReflect.Descriptor instead.
+func (*StarlarkDeprecationWarning) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StarlarkInstructionPosition) GetFilename() string {
+func (x *StarlarkDeprecationWarning) GetCode() string {
 	if x != nil {
-		return x.Filename
+		return x.Code
 	}
 	return ""
 }
 
-func (x *StarlarkInstructionPosition) GetLine() int32 {
+func (x *StarlarkDeprecationWarning) GetMessage() string {
 	if x != nil {
-		return x.Line
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *StarlarkInstructionPosition) GetColumn() int32 {
+func (x *StarlarkDeprecationWarning) GetPosition() *StarlarkInstructionPosition {
 	if x != nil {
-		return x.Column
+		return x.Position
 	}
-	return 0
+	return nil
 }
 
-type StarlarkError struct {
+type StarlarkInstruction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Error:
-	//
-	//	*StarlarkError_InterpretationError
-	//	*StarlarkError_ValidationError
-	//	*StarlarkError_ExecutionError
-	Error isStarlarkError_Error `protobuf_oneof:"error"`
+	Position              *StarlarkInstructionPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	InstructionName       string                       `protobuf:"bytes,2,opt,name=instruction_name,json=instructionName,proto3" json:"instruction_name,omitempty"`
+	Arguments             []*StarlarkInstructionArg    `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	ExecutableInstruction string                       `protobuf:"bytes,4,opt,name=executable_instruction,json=executableInstruction,proto3" json:"executable_instruction,omitempty"`
 }
 
-func (x *StarlarkError) Reset() {
-	*x = StarlarkError{}
+func (x *StarlarkInstruction) Reset() {
+	*x = StarlarkInstruction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,13 +1295,13 @@ func (x *StarlarkError) Reset() {
 	}
 }
 
-func (x *StarlarkError) String() string {
+func (x *StarlarkInstruction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkError) ProtoMessage() {}
+func (*StarlarkInstruction) ProtoMessage() {}
 
-func (x *StarlarkError) ProtoReflect() protoreflect.Message {
+func (x *StarlarkInstruction) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,71 +1313,49 @@ func (x *StarlarkError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkError.ProtoReflect.Descriptor instead.
-func (*StarlarkError) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkInstruction.ProtoReflect.Descriptor instead.
+func (*StarlarkInstruction) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (m *StarlarkError) GetError() isStarlarkError_Error {
-	if m != nil {
-		return m.Error
+func (x *StarlarkInstruction) GetPosition() *StarlarkInstructionPosition {
+	if x != nil {
+		return x.Position
 	}
 	return nil
 }
 
-func (x *StarlarkError) GetInterpretationError() *StarlarkInterpretationError {
-	if x, ok := x.GetError().(*StarlarkError_InterpretationError); ok {
-		return x.InterpretationError
+func (x *StarlarkInstruction) GetInstructionName() string {
+	if x != nil {
+		return x.InstructionName
 	}
-	return nil
+	return ""
 }
 
-func (x *StarlarkError) GetValidationError() *StarlarkValidationError {
-	if x, ok := x.GetError().(*StarlarkError_ValidationError); ok {
-		return x.ValidationError
+func (x *StarlarkInstruction) GetArguments() []*StarlarkInstructionArg {
+	if x != nil {
+		return x.Arguments
 	}
 	return nil
 }
 
-func (x *StarlarkError) GetExecutionError() *StarlarkExecutionError {
-	if x, ok := x.GetError().(*StarlarkError_ExecutionError); ok {
-		return x.ExecutionError
+func (x *StarlarkInstruction) GetExecutableInstruction() string {
+	if x != nil {
+		return x.ExecutableInstruction
 	}
-	return nil
-}
-
-type isStarlarkError_Error interface {
-	isStarlarkError_Error()
-}
-
-type StarlarkError_InterpretationError struct {
-	InterpretationError *StarlarkInterpretationError `protobuf:"bytes,1,opt,name=interpretation_error,json=interpretationError,proto3,oneof"`
-}
-
-type StarlarkError_ValidationError struct {
-	ValidationError *StarlarkValidationError `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof"`
-}
-
-type StarlarkError_ExecutionError struct {
-	ExecutionError *StarlarkExecutionError `protobuf:"bytes,3,opt,name=execution_error,json=executionError,proto3,oneof"`
+	return ""
 }
 
-func (*StarlarkError_InterpretationError) isStarlarkError_Error() {}
-
-func (*StarlarkError_ValidationError) isStarlarkError_Error() {}
-
-func (*StarlarkError_ExecutionError) isStarlarkError_Error() {}
-
-type StarlarkInterpretationError struct {
+type StarlarkInstructionResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SerializedInstructionResult string `protobuf:"bytes,1,opt,name=serialized_instruction_result,json=serializedInstructionResult,proto3" json:"serialized_instruction_result,omitempty"`
 }
 
-func (x *StarlarkInterpretationError) Reset() {
-	*x = StarlarkInterpretationError{}
+func (x *StarlarkInstructionResult) Reset() {
+	*x = StarlarkInstructionResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1115,13 +1363,13 @@ func (x *StarlarkInterpretationError) Reset() {
 	}
 }
 
-func (x *StarlarkInterpretationError) String() string {
+func (x *StarlarkInstructionResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkInterpretationError) ProtoMessage() {}
+func (*StarlarkInstructionResult) ProtoMessage() {}
 
-func (x *StarlarkInterpretationError) ProtoReflect() protoreflect.Message {
+func (x *StarlarkInstructionResult) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1133,28 +1381,30 @@ func (x *StarlarkInterpretationError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkInterpretationError.ProtoReflect.Descriptor instead.
-func (*StarlarkInterpretationError) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkInstructionResult.ProtoReflect.Descriptor instead.
+func (*StarlarkInstructionResult) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StarlarkInterpretationError) GetErrorMessage() string {
+func (x *StarlarkInstructionResult) GetSerializedInstructionResult() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.SerializedInstructionResult
 	}
 	return ""
 }
 
-type StarlarkValidationError struct {
+type StarlarkInstructionArg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SerializedArgValue string  `protobuf:"bytes,1,opt,name=serialized_arg_value,json=serializedArgValue,proto3" json:"serialized_arg_value,omitempty"`
+	ArgName            *string `protobuf:"bytes,2,opt,name=arg_name,json=argName,proto3,oneof" json:"arg_name,omitempty"`
+	IsRepresentative   bool    `protobuf:"varint,3,opt,name=is_representative,json=isRepresentative,proto3" json:"is_representative,omitempty"`
 }
 
-func (x *StarlarkValidationError) Reset() {
-	*x = StarlarkValidationError{}
+func (x *StarlarkInstructionArg) Reset() {
+	*x = StarlarkInstructionArg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1162,13 +1412,13 @@ func (x *StarlarkValidationError) Reset() {
 	}
 }
 
-func (x *StarlarkValidationError) String() string {
+func (x *StarlarkInstructionArg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkValidationError) ProtoMessage() {}
+func (*StarlarkInstructionArg) ProtoMessage() {}
 
-func (x *StarlarkValidationError) ProtoReflect() protoreflect.Message {
+func (x *StarlarkInstructionArg) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,28 +1430,44 @@ func (x *StarlarkValidationError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkValidationError.ProtoReflect.Descriptor instead.
-func (*StarlarkValidationError) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkInstructionArg.ProtoReflect.Descriptor instead.
+func (*StarlarkInstructionArg) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StarlarkValidationError) GetErrorMessage() string {
+func (x *StarlarkInstructionArg) GetSerializedArgValue() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.SerializedArgValue
 	}
 	return ""
 }
 
-type StarlarkExecutionError struct {
+func (x *StarlarkInstructionArg) GetArgName() string {
+	if x != nil && x.ArgName != nil {
+		return *x.ArgName
+	}
+	return ""
+}
+
+func (x *StarlarkInstructionArg) GetIsRepresentative() bool {
+	if x != nil {
+		return x.IsRepresentative
+	}
+	return false
+}
+
+type StarlarkInstructionPosition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Line     int32  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Column   int32  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
 }
 
-func (x *StarlarkExecutionError) Reset() {
-	*x = StarlarkExecutionError{}
+func (x *StarlarkInstructionPosition) Reset() {
+	*x = StarlarkInstructionPosition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,13 +1475,13 @@ func (x *StarlarkExecutionError) Reset() {
 	}
 }
 
-func (x *StarlarkExecutionError) String() string {
+func (x *StarlarkInstructionPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkExecutionError) ProtoMessage() {}
+func (*StarlarkInstructionPosition) ProtoMessage() {}
 
-func (x *StarlarkExecutionError) ProtoReflect() protoreflect.Message {
+func (x *StarlarkInstructionPosition) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1227,30 +1493,47 @@ func (x *StarlarkExecutionError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkExecutionError.ProtoReflect.Descriptor instead.
-func (*StarlarkExecutionError) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkInstructionPosition.ProtoReflect.Descriptor instead.
+func (*StarlarkInstructionPosition) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *StarlarkExecutionError) GetErrorMessage() string {
+func (x *StarlarkInstructionPosition) GetFilename() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Filename
 	}
 	return ""
 }
 
-type StarlarkRunProgress struct {
+func (x *StarlarkInstructionPosition) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *StarlarkInstructionPosition) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type StarlarkError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurrentStepInfo   []string `protobuf:"bytes,1,rep,name=current_step_info,json=currentStepInfo,proto3" json:"current_step_info,omitempty"`
-	TotalSteps        uint32   `protobuf:"varint,2,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
-	CurrentStepNumber uint32   `protobuf:"varint,3,opt,name=current_step_number,json=currentStepNumber,proto3" json:"current_step_number,omitempty"`
+	// Types that are assignable to Error:
+	//
+	//	*StarlarkError_InterpretationError
+	//	*StarlarkError_ValidationError
+	//	*StarlarkError_ExecutionError
+	Error isStarlarkError_Error `protobuf_oneof:"error"`
 }
 
-func (x *StarlarkRunProgress) Reset() {
-	*x = StarlarkRunProgress{}
+func (x *StarlarkError) Reset() {
+	*x = StarlarkError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1258,13 +1541,13 @@ func (x *StarlarkRunProgress) Reset() {
 	}
 }
 
-func (x *StarlarkRunProgress) String() string {
+func (x *StarlarkError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkRunProgress) ProtoMessage() {}
+func (*StarlarkError) ProtoMessage() {}
 
-func (x *StarlarkRunProgress) ProtoReflect() protoreflect.Message {
+func (x *StarlarkError) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1276,43 +1559,71 @@ func (x *StarlarkRunProgress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkRunProgress.ProtoReflect.Descriptor instead.
-func (*StarlarkRunProgress) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkError.ProtoReflect.Descriptor instead.
+func (*StarlarkError) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *StarlarkRunProgress) GetCurrentStepInfo() []string {
-	if x != nil {
-		return x.CurrentStepInfo
+func (m *StarlarkError) GetError() isStarlarkError_Error {
+	if m != nil {
+		return m.Error
 	}
 	return nil
 }
 
-func (x *StarlarkRunProgress) GetTotalSteps() uint32 {
-	if x != nil {
-		return x.TotalSteps
+func (x *StarlarkError) GetInterpretationError() *StarlarkInterpretationError {
+	if x, ok := x.GetError().(*StarlarkError_InterpretationError); ok {
+		return x.InterpretationError
 	}
-	return 0
+	return nil
 }
 
-func (x *StarlarkRunProgress) GetCurrentStepNumber() uint32 {
-	if x != nil {
-		return x.CurrentStepNumber
+func (x *StarlarkError) GetValidationError() *StarlarkValidationError {
+	if x, ok := x.GetError().(*StarlarkError_ValidationError); ok {
+		return x.ValidationError
 	}
-	return 0
+	return nil
 }
 
-type StarlarkRunFinishedEvent struct {
+func (x *StarlarkError) GetExecutionError() *StarlarkExecutionError {
+	if x, ok := x.GetError().(*StarlarkError_ExecutionError); ok {
+		return x.ExecutionError
+	}
+	return nil
+}
+
+type isStarlarkError_Error interface {
+	isStarlarkError_Error()
+}
+
+type StarlarkError_InterpretationError struct {
+	InterpretationError *StarlarkInterpretationError `protobuf:"bytes,1,opt,name=interpretation_error,json=interpretationError,proto3,oneof"`
+}
+
+type StarlarkError_ValidationError struct {
+	ValidationError *StarlarkValidationError `protobuf:"bytes,2,opt,name=validation_error,json=validationError,proto3,oneof"`
+}
+
+type StarlarkError_ExecutionError struct {
+	ExecutionError *StarlarkExecutionError `protobuf:"bytes,3,opt,name=execution_error,json=executionError,proto3,oneof"`
+}
+
+func (*StarlarkError_InterpretationError) isStarlarkError_Error() {}
+
+func (*StarlarkError_ValidationError) isStarlarkError_Error() {}
+
+func (*StarlarkError_ExecutionError) isStarlarkError_Error() {}
+
+type StarlarkInterpretationError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsRunSuccessful  bool    `protobuf:"varint,1,opt,name=isRunSuccessful,proto3" json:"isRunSuccessful,omitempty"`
-	SerializedOutput *string `protobuf:"bytes,2,opt,name=serialized_output,json=serializedOutput,proto3,oneof" json:"serialized_output,omitempty"`
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
-func (x *StarlarkRunFinishedEvent) Reset() {
-	*x = StarlarkRunFinishedEvent{}
+func (x *StarlarkInterpretationError) Reset() {
+	*x = StarlarkInterpretationError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1320,13 +1631,13 @@ func (x *StarlarkRunFinishedEvent) Reset() {
 	}
 }
 
-func (x *StarlarkRunFinishedEvent) String() string {
+func (x *StarlarkInterpretationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StarlarkRunFinishedEvent) ProtoMessage() {}
+func (*StarlarkInterpretationError) ProtoMessage() {}
 
-func (x *StarlarkRunFinishedEvent) ProtoReflect() protoreflect.Message {
+func (x *StarlarkInterpretationError) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1338,40 +1649,28 @@ func (x *StarlarkRunFinishedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StarlarkRunFinishedEvent.ProtoReflect.Descriptor instead.
-func (*StarlarkRunFinishedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkInterpretationError.ProtoReflect.Descriptor instead.
+func (*StarlarkInterpretationError) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *StarlarkRunFinishedEvent) GetIsRunSuccessful() bool {
+func (x *StarlarkInterpretationError) GetErrorMessage() string {
 	if x != nil {
-		return x.IsRunSuccessful
-	}
-	return false
-}
-
-func (x *StarlarkRunFinishedEvent) GetSerializedOutput() string {
-	if x != nil && x.SerializedOutput != nil {
-		return *x.SerializedOutput
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-// ==============================================================================================
-//
-//	Start Service
-//
-// ==============================================================================================
-type StartServicesArgs struct {
+type StarlarkValidationError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceNamesToConfigs map[string]*ServiceConfig `protobuf:"bytes,1,rep,name=service_names_to_configs,json=serviceNamesToConfigs,proto3" json:"service_names_to_configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
-func (x *StartServicesArgs) Reset() {
-	*x = StartServicesArgs{}
+func (x *StarlarkValidationError) Reset() {
+	*x = StarlarkValidationError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,13 +1678,13 @@ func (x *StartServicesArgs) Reset() {
 	}
 }
 
-func (x *StartServicesArgs) String() string {
+func (x *StarlarkValidationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartServicesArgs) ProtoMessage() {}
+func (*StarlarkValidationError) ProtoMessage() {}
 
-func (x *StartServicesArgs) ProtoReflect() protoreflect.Message {
+func (x *StarlarkValidationError) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1397,31 +1696,28 @@ func (x *StartServicesArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartServicesArgs.ProtoReflect.Descriptor instead.
-func (*StartServicesArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkValidationError.ProtoReflect.Descriptor instead.
+func (*StarlarkValidationError) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *StartServicesArgs) GetServiceNamesToConfigs() map[string]*ServiceConfig {
+func (x *StarlarkValidationError) GetErrorMessage() string {
 	if x != nil {
-		return x.ServiceNamesToConfigs
+		return x.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-type StartServicesResponse struct {
+type StarlarkExecutionError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A map of Service Names to info describing that newly started service
-	SuccessfulServiceNameToServiceInfo map[string]*ServiceInfo `protobuf:"bytes,1,rep,name=successful_service_name_to_service_info,json=successfulServiceNameToServiceInfo,proto3" json:"successful_service_name_to_service_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// A map of Service Names that failed to start with the error causing the failure
-	FailedServiceNameToError map[string]string `protobuf:"bytes,2,rep,name=failed_service_name_to_error,json=failedServiceNameToError,proto3" json:"failed_service_name_to_error,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 }
 
-func (x *StartServicesResponse) Reset() {
-	*x = StartServicesResponse{}
+func (x *StarlarkExecutionError) Reset() {
+	*x = StarlarkExecutionError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1429,13 +1725,13 @@ func (x *StartServicesResponse) Reset() {
 	}
 }
 
-func (x *StartServicesResponse) String() string {
+func (x *StarlarkExecutionError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartServicesResponse) ProtoMessage() {}
+func (*StarlarkExecutionError) ProtoMessage() {}
 
-func (x *StartServicesResponse) ProtoReflect() protoreflect.Message {
+func (x *StarlarkExecutionError) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1447,42 +1743,30 @@ func (x *StartServicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartServicesResponse.ProtoReflect.Descriptor instead.
-func (*StartServicesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkExecutionError.ProtoReflect.Descriptor instead.
+func (*StarlarkExecutionError) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *StartServicesResponse) GetSuccessfulServiceNameToServiceInfo() map[string]*ServiceInfo {
-	if x != nil {
-		return x.SuccessfulServiceNameToServiceInfo
-	}
-	return nil
-}
-
-func (x *StartServicesResponse) GetFailedServiceNameToError() map[string]string {
+func (x *StarlarkExecutionError) GetErrorMessage() string {
 	if x != nil {
-		return x.FailedServiceNameToError
+		return x.ErrorMessage
 	}
-	return nil
+	return ""
 }
 
-// ==============================================================================================
-//
-//	Get Services
-//
-// ==============================================================================================
-type GetServicesArgs struct {
+type StarlarkRunProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// "Set" of identifiers to fetch info for
-	// If empty, will fetch info for all services
-	ServiceIdentifiers map[string]bool `protobuf:"bytes,1,rep,name=service_identifiers,json=serviceIdentifiers,proto3" json:"service_identifiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	CurrentStepInfo   []string `protobuf:"bytes,1,rep,name=current_step_info,json=currentStepInfo,proto3" json:"current_step_info,omitempty"`
+	TotalSteps        uint32   `protobuf:"varint,2,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	CurrentStepNumber uint32   `protobuf:"varint,3,opt,name=current_step_number,json=currentStepNumber,proto3" json:"current_step_number,omitempty"`
 }
 
-func (x *GetServicesArgs) Reset() {
-	*x = GetServicesArgs{}
+func (x *StarlarkRunProgress) Reset() {
+	*x = StarlarkRunProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,13 +1774,13 @@ func (x *GetServicesArgs) Reset() {
 	}
 }
 
-func (x *GetServicesArgs) String() string {
+func (x *StarlarkRunProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServicesArgs) ProtoMessage() {}
+func (*StarlarkRunProgress) ProtoMessage() {}
 
-func (x *GetServicesArgs) ProtoReflect() protoreflect.Message {
+func (x *StarlarkRunProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1508,29 +1792,49 @@ func (x *GetServicesArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServicesArgs.ProtoReflect.Descriptor instead.
-func (*GetServicesArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkRunProgress.ProtoReflect.Descriptor instead.
+func (*StarlarkRunProgress) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetServicesArgs) GetServiceIdentifiers() map[string]bool {
+func (x *StarlarkRunProgress) GetCurrentStepInfo() []string {
 	if x != nil {
-		return x.ServiceIdentifiers
+		return x.CurrentStepInfo
 	}
 	return nil
 }
 
-type GetServicesResponse struct {
+func (x *StarlarkRunProgress) GetTotalSteps() uint32 {
+	if x != nil {
+		return x.TotalSteps
+	}
+	return 0
+}
+
+func (x *StarlarkRunProgress) GetCurrentStepNumber() uint32 {
+	if x != nil {
+		return x.CurrentStepNumber
+	}
+	return 0
+}
+
+type StarlarkRunFinishedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// "Set" from identifiers -> info about the service
-	ServiceInfo map[string]*ServiceInfo `protobuf:"bytes,1,rep,name=service_info,json=serviceInfo,proto3" json:"service_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IsRunSuccessful  bool    `protobuf:"varint,1,opt,name=isRunSuccessful,proto3" json:"isRunSuccessful,omitempty"`
+	SerializedOutput *string `protobuf:"bytes,2,opt,name=serialized_output,json=serializedOutput,proto3,oneof" json:"serialized_output,omitempty"`
+	// Identifies the run to get its profile; unset if the run didn't get to execution or was a dry run
+	RunId *string `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`
+	// Tags the version constraints of the packages cloned by this run got resolved to, keyed by
+	// '<repository path>@<constraint>'. Only set for successful runs that aren't dry runs, and meant to be merged into
+	// the lock file next to the package that got run
+	ResolvedPackageVersions map[string]string `protobuf:"bytes,4,rep,name=resolved_package_versions,json=resolvedPackageVersions,proto3" json:"resolved_package_versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GetServicesResponse) Reset() {
-	*x = GetServicesResponse{}
+func (x *StarlarkRunFinishedEvent) Reset() {
+	*x = StarlarkRunFinishedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1538,13 +1842,13 @@ func (x *GetServicesResponse) Reset() {
 	}
 }
 
-func (x *GetServicesResponse) String() string {
+func (x *StarlarkRunFinishedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServicesResponse) ProtoMessage() {}
+func (*StarlarkRunFinishedEvent) ProtoMessage() {}
 
-func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
+func (x *StarlarkRunFinishedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1556,34 +1860,65 @@ func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
-func (*GetServicesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkRunFinishedEvent.ProtoReflect.Descriptor instead.
+func (*StarlarkRunFinishedEvent) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetServicesResponse) GetServiceInfo() map[string]*ServiceInfo {
+func (x *StarlarkRunFinishedEvent) GetIsRunSuccessful() bool {
 	if x != nil {
-		return x.ServiceInfo
+		return x.IsRunSuccessful
+	}
+	return false
+}
+
+func (x *StarlarkRunFinishedEvent) GetSerializedOutput() string {
+	if x != nil && x.SerializedOutput != nil {
+		return *x.SerializedOutput
+	}
+	return ""
+}
+
+func (x *StarlarkRunFinishedEvent) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+func (x *StarlarkRunFinishedEvent) GetResolvedPackageVersions() map[string]string {
+	if x != nil {
+		return x.ResolvedPackageVersions
 	}
 	return nil
 }
 
-// An service identifier is a collection of uuid, name and shortened uuid
-type ServiceIdentifiers struct {
+// Pre-flight estimate of the resources the plan would consume if it ran
+type StarlarkResourceEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UUID of the service
-	ServiceUuid string `protobuf:"bytes,1,opt,name=service_uuid,json=serviceUuid,proto3" json:"service_uuid,omitempty"`
-	// Name of the service
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The shortened uuid of the service
-	ShortenedUuid string `protobuf:"bytes,3,opt,name=shortened_uuid,json=shortenedUuid,proto3" json:"shortened_uuid,omitempty"`
-}
-
-func (x *ServiceIdentifiers) Reset() {
-	*x = ServiceIdentifiers{}
+	NumServices uint32 `protobuf:"varint,1,opt,name=num_services,json=numServices,proto3" json:"num_services,omitempty"`
+	// Sum of the CPU allocations declared by the services; services not declaring one can use as much as they like
+	CpuAllocationMillicpus          uint64 `protobuf:"varint,2,opt,name=cpu_allocation_millicpus,json=cpuAllocationMillicpus,proto3" json:"cpu_allocation_millicpus,omitempty"`
+	NumServicesWithoutCpuAllocation uint32 `protobuf:"varint,3,opt,name=num_services_without_cpu_allocation,json=numServicesWithoutCpuAllocation,proto3" json:"num_services_without_cpu_allocation,omitempty"`
+	// Sum of the memory allocations declared by the services; services not declaring one can use as much as they like
+	MemoryAllocationMegabytes          uint64 `protobuf:"varint,4,opt,name=memory_allocation_megabytes,json=memoryAllocationMegabytes,proto3" json:"memory_allocation_megabytes,omitempty"`
+	NumServicesWithoutMemoryAllocation uint32 `protobuf:"varint,5,opt,name=num_services_without_memory_allocation,json=numServicesWithoutMemoryAllocation,proto3" json:"num_services_without_memory_allocation,omitempty"`
+	NumPorts                           uint32 `protobuf:"varint,6,opt,name=num_ports,json=numPorts,proto3" json:"num_ports,omitempty"`
+	// The ports published on the host
+	NumPublicPorts uint32                           `protobuf:"varint,7,opt,name=num_public_ports,json=numPublicPorts,proto3" json:"num_public_ports,omitempty"`
+	ImageDownloads []*StarlarkImageDownloadEstimate `protobuf:"bytes,8,rep,name=image_downloads,json=imageDownloads,proto3" json:"image_downloads,omitempty"`
+	// Zero if the backend couldn't report them
+	HostNumCpus     uint64 `protobuf:"varint,9,opt,name=host_num_cpus,json=hostNumCpus,proto3" json:"host_num_cpus,omitempty"`
+	HostMemoryBytes uint64 `protobuf:"varint,10,opt,name=host_memory_bytes,json=hostMemoryBytes,proto3" json:"host_memory_bytes,omitempty"`
+	// The ways the host looks like it can't cope with the plan
+	Warnings []string `protobuf:"bytes,11,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *StarlarkResourceEstimate) Reset() {
+	*x = StarlarkResourceEstimate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1591,13 +1926,13 @@ func (x *ServiceIdentifiers) Reset() {
 	}
 }
 
-func (x *ServiceIdentifiers) String() string {
+func (x *StarlarkResourceEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceIdentifiers) ProtoMessage() {}
+func (*StarlarkResourceEstimate) ProtoMessage() {}
 
-func (x *ServiceIdentifiers) ProtoReflect() protoreflect.Message {
+func (x *StarlarkResourceEstimate) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1609,42 +1944,102 @@ func (x *ServiceIdentifiers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceIdentifiers.ProtoReflect.Descriptor instead.
-func (*ServiceIdentifiers) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkResourceEstimate.ProtoReflect.Descriptor instead.
+func (*StarlarkResourceEstimate) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ServiceIdentifiers) GetServiceUuid() string {
+func (x *StarlarkResourceEstimate) GetNumServices() uint32 {
 	if x != nil {
-		return x.ServiceUuid
+		return x.NumServices
 	}
-	return ""
+	return 0
 }
 
-func (x *ServiceIdentifiers) GetName() string {
+func (x *StarlarkResourceEstimate) GetCpuAllocationMillicpus() uint64 {
 	if x != nil {
-		return x.Name
+		return x.CpuAllocationMillicpus
 	}
-	return ""
+	return 0
 }
 
-func (x *ServiceIdentifiers) GetShortenedUuid() string {
+func (x *StarlarkResourceEstimate) GetNumServicesWithoutCpuAllocation() uint32 {
 	if x != nil {
-		return x.ShortenedUuid
+		return x.NumServicesWithoutCpuAllocation
 	}
-	return ""
+	return 0
 }
 
-type GetExistingAndHistoricalServiceIdentifiersResponse struct {
+func (x *StarlarkResourceEstimate) GetMemoryAllocationMegabytes() uint64 {
+	if x != nil {
+		return x.MemoryAllocationMegabytes
+	}
+	return 0
+}
+
+func (x *StarlarkResourceEstimate) GetNumServicesWithoutMemoryAllocation() uint32 {
+	if x != nil {
+		return x.NumServicesWithoutMemoryAllocation
+	}
+	return 0
+}
+
+func (x *StarlarkResourceEstimate) GetNumPorts() uint32 {
+	if x != nil {
+		return x.NumPorts
+	}
+	return 0
+}
+
+func (x *StarlarkResourceEstimate) GetNumPublicPorts() uint32 {
+	if x != nil {
+		return x.NumPublicPorts
+	}
+	return 0
+}
+
+func (x *StarlarkResourceEstimate) GetImageDownloads() []*StarlarkImageDownloadEstimate {
+	if x != nil {
+		return x.ImageDownloads
+	}
+	return nil
+}
+
+func (x *StarlarkResourceEstimate) GetHostNumCpus() uint64 {
+	if x != nil {
+		return x.HostNumCpus
+	}
+	return 0
+}
+
+func (x *StarlarkResourceEstimate) GetHostMemoryBytes() uint64 {
+	if x != nil {
+		return x.HostMemoryBytes
+	}
+	return 0
+}
+
+func (x *StarlarkResourceEstimate) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type StarlarkImageDownloadEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllIdentifiers []*ServiceIdentifiers `protobuf:"bytes,1,rep,name=allIdentifiers,proto3" json:"allIdentifiers,omitempty"`
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Nothing gets downloaded for images already on the host
+	IsPresentOnHost bool `protobuf:"varint,2,opt,name=is_present_on_host,json=isPresentOnHost,proto3" json:"is_present_on_host,omitempty"`
+	// The compressed size of the layers of the image according to its registry; unset if the registry couldn't tell
+	DownloadSizeBytes *uint64 `protobuf:"varint,3,opt,name=download_size_bytes,json=downloadSizeBytes,proto3,oneof" json:"download_size_bytes,omitempty"`
 }
 
-func (x *GetExistingAndHistoricalServiceIdentifiersResponse) Reset() {
-	*x = GetExistingAndHistoricalServiceIdentifiersResponse{}
+func (x *StarlarkImageDownloadEstimate) Reset() {
+	*x = StarlarkImageDownloadEstimate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1652,13 +2047,13 @@ func (x *GetExistingAndHistoricalServiceIdentifiersResponse) Reset() {
 	}
 }
 
-func (x *GetExistingAndHistoricalServiceIdentifiersResponse) String() string {
+func (x *StarlarkImageDownloadEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExistingAndHistoricalServiceIdentifiersResponse) ProtoMessage() {}
+func (*StarlarkImageDownloadEstimate) ProtoMessage() {}
 
-func (x *GetExistingAndHistoricalServiceIdentifiersResponse) ProtoReflect() protoreflect.Message {
+func (x *StarlarkImageDownloadEstimate) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1670,33 +2065,47 @@ func (x *GetExistingAndHistoricalServiceIdentifiersResponse) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExistingAndHistoricalServiceIdentifiersResponse.ProtoReflect.Descriptor instead.
-func (*GetExistingAndHistoricalServiceIdentifiersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StarlarkImageDownloadEstimate.ProtoReflect.Descriptor instead.
+func (*StarlarkImageDownloadEstimate) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetExistingAndHistoricalServiceIdentifiersResponse) GetAllIdentifiers() []*ServiceIdentifiers {
+func (x *StarlarkImageDownloadEstimate) GetImage() string {
 	if x != nil {
-		return x.AllIdentifiers
+		return x.Image
 	}
-	return nil
+	return ""
+}
+
+func (x *StarlarkImageDownloadEstimate) GetIsPresentOnHost() bool {
+	if x != nil {
+		return x.IsPresentOnHost
+	}
+	return false
+}
+
+func (x *StarlarkImageDownloadEstimate) GetDownloadSizeBytes() uint64 {
+	if x != nil && x.DownloadSizeBytes != nil {
+		return *x.DownloadSizeBytes
+	}
+	return 0
 }
 
 // ==============================================================================================
 //
-//	Remove Service
+//	Start Service
 //
 // ==============================================================================================
-type RemoveServiceArgs struct {
+type StartServicesArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	ServiceNamesToConfigs map[string]*ServiceConfig `protobuf:"bytes,1,rep,name=service_names_to_configs,json=serviceNamesToConfigs,proto3" json:"service_names_to_configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RemoveServiceArgs) Reset() {
-	*x = RemoveServiceArgs{}
+func (x *StartServicesArgs) Reset() {
+	*x = StartServicesArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1704,13 +2113,13 @@ func (x *RemoveServiceArgs) Reset() {
 	}
 }
 
-func (x *RemoveServiceArgs) String() string {
+func (x *StartServicesArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveServiceArgs) ProtoMessage() {}
+func (*StartServicesArgs) ProtoMessage() {}
 
-func (x *RemoveServiceArgs) ProtoReflect() protoreflect.Message {
+func (x *StartServicesArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1722,29 +2131,31 @@ func (x *RemoveServiceArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveServiceArgs.ProtoReflect.Descriptor instead.
-func (*RemoveServiceArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartServicesArgs.ProtoReflect.Descriptor instead.
+func (*StartServicesArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RemoveServiceArgs) GetServiceIdentifier() string {
+func (x *StartServicesArgs) GetServiceNamesToConfigs() map[string]*ServiceConfig {
 	if x != nil {
-		return x.ServiceIdentifier
+		return x.ServiceNamesToConfigs
 	}
-	return ""
+	return nil
 }
 
-type RemoveServiceResponse struct {
+type StartServicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The UUID of the service that was removed
-	ServiceUuid string `protobuf:"bytes,1,opt,name=service_uuid,json=serviceUuid,proto3" json:"service_uuid,omitempty"`
+	// A map of Service Names to info describing that newly started service
+	SuccessfulServiceNameToServiceInfo map[string]*ServiceInfo `protobuf:"bytes,1,rep,name=successful_service_name_to_service_info,json=successfulServiceNameToServiceInfo,proto3" json:"successful_service_name_to_service_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// A map of Service Names that failed to start with the error causing the failure
+	FailedServiceNameToError map[string]string `protobuf:"bytes,2,rep,name=failed_service_name_to_error,json=failedServiceNameToError,proto3" json:"failed_service_name_to_error,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RemoveServiceResponse) Reset() {
-	*x = RemoveServiceResponse{}
+func (x *StartServicesResponse) Reset() {
+	*x = StartServicesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,13 +2163,13 @@ func (x *RemoveServiceResponse) Reset() {
 	}
 }
 
-func (x *RemoveServiceResponse) String() string {
+func (x *StartServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveServiceResponse) ProtoMessage() {}
+func (*StartServicesResponse) ProtoMessage() {}
 
-func (x *RemoveServiceResponse) ProtoReflect() protoreflect.Message {
+func (x *StartServicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1770,40 +2181,42 @@ func (x *RemoveServiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveServiceResponse.ProtoReflect.Descriptor instead.
-func (*RemoveServiceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartServicesResponse.ProtoReflect.Descriptor instead.
+func (*StartServicesResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RemoveServiceResponse) GetServiceUuid() string {
+func (x *StartServicesResponse) GetSuccessfulServiceNameToServiceInfo() map[string]*ServiceInfo {
 	if x != nil {
-		return x.ServiceUuid
+		return x.SuccessfulServiceNameToServiceInfo
 	}
-	return ""
+	return nil
+}
+
+func (x *StartServicesResponse) GetFailedServiceNameToError() map[string]string {
+	if x != nil {
+		return x.FailedServiceNameToError
+	}
+	return nil
 }
 
 // ==============================================================================================
 //
-//	Repartition
+//	Get Services
 //
 // ==============================================================================================
-type RepartitionArgs struct {
+type GetServicesArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Definition of partitionId -> services that should be inside the partition after repartitioning
-	PartitionServices map[string]*PartitionServices `protobuf:"bytes,1,rep,name=partition_services,json=partitionServices,proto3" json:"partition_services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Definition of partitionIdA -> partitionIdB -> information defining the connection between A <-> B
-	PartitionConnections map[string]*PartitionConnections `protobuf:"bytes,2,rep,name=partition_connections,json=partitionConnections,proto3" json:"partition_connections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Information about the default inter-partition connection to set up if one is not defined in the
-	//
-	//	partition connections map
-	DefaultConnection *PartitionConnectionInfo `protobuf:"bytes,3,opt,name=default_connection,json=defaultConnection,proto3" json:"default_connection,omitempty"`
+	// "Set" of identifiers to fetch info for
+	// If empty, will fetch info for all services
+	ServiceIdentifiers map[string]bool `protobuf:"bytes,1,rep,name=service_identifiers,json=serviceIdentifiers,proto3" json:"service_identifiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *RepartitionArgs) Reset() {
-	*x = RepartitionArgs{}
+func (x *GetServicesArgs) Reset() {
+	*x = GetServicesArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1811,13 +2224,13 @@ func (x *RepartitionArgs) Reset() {
 	}
 }
 
-func (x *RepartitionArgs) String() string {
+func (x *GetServicesArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RepartitionArgs) ProtoMessage() {}
+func (*GetServicesArgs) ProtoMessage() {}
 
-func (x *RepartitionArgs) ProtoReflect() protoreflect.Message {
+func (x *GetServicesArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1829,43 +2242,29 @@ func (x *RepartitionArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RepartitionArgs.ProtoReflect.Descriptor instead.
-func (*RepartitionArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServicesArgs.ProtoReflect.Descriptor instead.
+func (*GetServicesArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RepartitionArgs) GetPartitionServices() map[string]*PartitionServices {
-	if x != nil {
-		return x.PartitionServices
-	}
-	return nil
-}
-
-func (x *RepartitionArgs) GetPartitionConnections() map[string]*PartitionConnections {
-	if x != nil {
-		return x.PartitionConnections
-	}
-	return nil
-}
-
-func (x *RepartitionArgs) GetDefaultConnection() *PartitionConnectionInfo {
+func (x *GetServicesArgs) GetServiceIdentifiers() map[string]bool {
 	if x != nil {
-		return x.DefaultConnection
+		return x.ServiceIdentifiers
 	}
 	return nil
 }
 
-type PartitionServices struct {
+type GetServicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// "Set" of service names in partition
-	ServiceNameSet map[string]bool `protobuf:"bytes,1,rep,name=service_name_set,json=serviceNameSet,proto3" json:"service_name_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// "Set" from identifiers -> info about the service
+	ServiceInfo map[string]*ServiceInfo `protobuf:"bytes,1,rep,name=service_info,json=serviceInfo,proto3" json:"service_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *PartitionServices) Reset() {
-	*x = PartitionServices{}
+func (x *GetServicesResponse) Reset() {
+	*x = GetServicesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1873,13 +2272,13 @@ func (x *PartitionServices) Reset() {
 	}
 }
 
-func (x *PartitionServices) String() string {
+func (x *GetServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionServices) ProtoMessage() {}
+func (*GetServicesResponse) ProtoMessage() {}
 
-func (x *PartitionServices) ProtoReflect() protoreflect.Message {
+func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1891,28 +2290,34 @@ func (x *PartitionServices) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionServices.ProtoReflect.Descriptor instead.
-func (*PartitionServices) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
+func (*GetServicesResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PartitionServices) GetServiceNameSet() map[string]bool {
+func (x *GetServicesResponse) GetServiceInfo() map[string]*ServiceInfo {
 	if x != nil {
-		return x.ServiceNameSet
+		return x.ServiceInfo
 	}
 	return nil
 }
 
-type PartitionConnections struct {
+// An service identifier is a collection of uuid, name and shortened uuid
+type ServiceIdentifiers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConnectionInfo map[string]*PartitionConnectionInfo `protobuf:"bytes,1,rep,name=connection_info,json=connectionInfo,proto3" json:"connection_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *PartitionConnections) Reset() {
-	*x = PartitionConnections{}
+	// UUID of the service
+	ServiceUuid string `protobuf:"bytes,1,opt,name=service_uuid,json=serviceUuid,proto3" json:"service_uuid,omitempty"`
+	// Name of the service
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The shortened uuid of the service
+	ShortenedUuid string `protobuf:"bytes,3,opt,name=shortened_uuid,json=shortenedUuid,proto3" json:"shortened_uuid,omitempty"`
+}
+
+func (x *ServiceIdentifiers) Reset() {
+	*x = ServiceIdentifiers{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1920,13 +2325,13 @@ func (x *PartitionConnections) Reset() {
 	}
 }
 
-func (x *PartitionConnections) String() string {
+func (x *ServiceIdentifiers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionConnections) ProtoMessage() {}
+func (*ServiceIdentifiers) ProtoMessage() {}
 
-func (x *PartitionConnections) ProtoReflect() protoreflect.Message {
+func (x *ServiceIdentifiers) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1938,29 +2343,42 @@ func (x *PartitionConnections) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionConnections.ProtoReflect.Descriptor instead.
-func (*PartitionConnections) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceIdentifiers.ProtoReflect.Descriptor instead.
+func (*ServiceIdentifiers) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PartitionConnections) GetConnectionInfo() map[string]*PartitionConnectionInfo {
+func (x *ServiceIdentifiers) GetServiceUuid() string {
 	if x != nil {
-		return x.ConnectionInfo
+		return x.ServiceUuid
 	}
-	return nil
+	return ""
 }
 
-type PartitionConnectionInfo struct {
+func (x *ServiceIdentifiers) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceIdentifiers) GetShortenedUuid() string {
+	if x != nil {
+		return x.ShortenedUuid
+	}
+	return ""
+}
+
+type GetExistingAndHistoricalServiceIdentifiersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Percentage value of packet loss in a partition connection
-	PacketLossPercentage float32 `protobuf:"fixed32,1,opt,name=packet_loss_percentage,json=packetLossPercentage,proto3" json:"packet_loss_percentage,omitempty"`
+	AllIdentifiers []*ServiceIdentifiers `protobuf:"bytes,1,rep,name=allIdentifiers,proto3" json:"allIdentifiers,omitempty"`
 }
 
-func (x *PartitionConnectionInfo) Reset() {
-	*x = PartitionConnectionInfo{}
+func (x *GetExistingAndHistoricalServiceIdentifiersResponse) Reset() {
+	*x = GetExistingAndHistoricalServiceIdentifiersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1968,13 +2386,13 @@ func (x *PartitionConnectionInfo) Reset() {
 	}
 }
 
-func (x *PartitionConnectionInfo) String() string {
+func (x *GetExistingAndHistoricalServiceIdentifiersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartitionConnectionInfo) ProtoMessage() {}
+func (*GetExistingAndHistoricalServiceIdentifiersResponse) ProtoMessage() {}
 
-func (x *PartitionConnectionInfo) ProtoReflect() protoreflect.Message {
+func (x *GetExistingAndHistoricalServiceIdentifiersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1986,35 +2404,30 @@ func (x *PartitionConnectionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartitionConnectionInfo.ProtoReflect.Descriptor instead.
-func (*PartitionConnectionInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExistingAndHistoricalServiceIdentifiersResponse.ProtoReflect.Descriptor instead.
+func (*GetExistingAndHistoricalServiceIdentifiersResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PartitionConnectionInfo) GetPacketLossPercentage() float32 {
+func (x *GetExistingAndHistoricalServiceIdentifiersResponse) GetAllIdentifiers() []*ServiceIdentifiers {
 	if x != nil {
-		return x.PacketLossPercentage
+		return x.AllIdentifiers
 	}
-	return 0
+	return nil
 }
 
-// ==============================================================================================
-//
-//	Exec Command
-//
-// ==============================================================================================
-type ExecCommandArgs struct {
+type GetServiceConfigsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The service identifier of the container that the command should be executed in
-	ServiceIdentifier string   `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	CommandArgs       []string `protobuf:"bytes,2,rep,name=command_args,json=commandArgs,proto3" json:"command_args,omitempty"`
+	// Service name -> the config the service was started with
+	// NOTE: Services started before the API container last restarted are missing, as the configs are only kept in memory
+	ServiceConfigs map[string]*ServiceConfig `protobuf:"bytes,1,rep,name=service_configs,json=serviceConfigs,proto3" json:"service_configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ExecCommandArgs) Reset() {
-	*x = ExecCommandArgs{}
+func (x *GetServiceConfigsResponse) Reset() {
+	*x = GetServiceConfigsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2022,13 +2435,13 @@ func (x *ExecCommandArgs) Reset() {
 	}
 }
 
-func (x *ExecCommandArgs) String() string {
+func (x *GetServiceConfigsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecCommandArgs) ProtoMessage() {}
+func (*GetServiceConfigsResponse) ProtoMessage() {}
 
-func (x *ExecCommandArgs) ProtoReflect() protoreflect.Message {
+func (x *GetServiceConfigsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2040,41 +2453,35 @@ func (x *ExecCommandArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecCommandArgs.ProtoReflect.Descriptor instead.
-func (*ExecCommandArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetServiceConfigsResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceConfigsResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ExecCommandArgs) GetServiceIdentifier() string {
-	if x != nil {
-		return x.ServiceIdentifier
-	}
-	return ""
-}
-
-func (x *ExecCommandArgs) GetCommandArgs() []string {
+func (x *GetServiceConfigsResponse) GetServiceConfigs() map[string]*ServiceConfig {
 	if x != nil {
-		return x.CommandArgs
+		return x.ServiceConfigs
 	}
 	return nil
 }
 
-// ==============================================================================================
-//
-//	Pause/Unpause Service
-//
-// ==============================================================================================
-type PauseServiceArgs struct {
+type ScrapeTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The service identifier of the container that should be paused
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceUuid string `protobuf:"bytes,2,opt,name=service_uuid,json=serviceUuid,proto3" json:"service_uuid,omitempty"`
+	// The ID of the private port the metrics are served on
+	MetricsPortId string `protobuf:"bytes,3,opt,name=metrics_port_id,json=metricsPortId,proto3" json:"metrics_port_id,omitempty"`
+	// ip:port to scrape the metrics from within the enclave
+	PrivateAddress string `protobuf:"bytes,4,opt,name=private_address,json=privateAddress,proto3" json:"private_address,omitempty"`
+	// ip:port to scrape the metrics from outside the enclave; empty if the metrics port isn't published
+	PublicAddress string `protobuf:"bytes,5,opt,name=public_address,json=publicAddress,proto3" json:"public_address,omitempty"`
 }
 
-func (x *PauseServiceArgs) Reset() {
-	*x = PauseServiceArgs{}
+func (x *ScrapeTarget) Reset() {
+	*x = ScrapeTarget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2082,13 +2489,13 @@ func (x *PauseServiceArgs) Reset() {
 	}
 }
 
-func (x *PauseServiceArgs) String() string {
+func (x *ScrapeTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauseServiceArgs) ProtoMessage() {}
+func (*ScrapeTarget) ProtoMessage() {}
 
-func (x *PauseServiceArgs) ProtoReflect() protoreflect.Message {
+func (x *ScrapeTarget) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2100,29 +2507,57 @@ func (x *PauseServiceArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PauseServiceArgs.ProtoReflect.Descriptor instead.
-func (*PauseServiceArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScrapeTarget.ProtoReflect.Descriptor instead.
+func (*ScrapeTarget) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *PauseServiceArgs) GetServiceIdentifier() string {
+func (x *ScrapeTarget) GetServiceName() string {
 	if x != nil {
-		return x.ServiceIdentifier
+		return x.ServiceName
 	}
 	return ""
 }
 
-type UnpauseServiceArgs struct {
+func (x *ScrapeTarget) GetServiceUuid() string {
+	if x != nil {
+		return x.ServiceUuid
+	}
+	return ""
+}
+
+func (x *ScrapeTarget) GetMetricsPortId() string {
+	if x != nil {
+		return x.MetricsPortId
+	}
+	return ""
+}
+
+func (x *ScrapeTarget) GetPrivateAddress() string {
+	if x != nil {
+		return x.PrivateAddress
+	}
+	return ""
+}
+
+func (x *ScrapeTarget) GetPublicAddress() string {
+	if x != nil {
+		return x.PublicAddress
+	}
+	return ""
+}
+
+type GetScrapeTargetsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The service identifier of the container that should be unpaused
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// Sorted by service name
+	ScrapeTargets []*ScrapeTarget `protobuf:"bytes,1,rep,name=scrape_targets,json=scrapeTargets,proto3" json:"scrape_targets,omitempty"`
 }
 
-func (x *UnpauseServiceArgs) Reset() {
-	*x = UnpauseServiceArgs{}
+func (x *GetScrapeTargetsResponse) Reset() {
+	*x = GetScrapeTargetsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2130,13 +2565,13 @@ func (x *UnpauseServiceArgs) Reset() {
 	}
 }
 
-func (x *UnpauseServiceArgs) String() string {
+func (x *GetScrapeTargetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnpauseServiceArgs) ProtoMessage() {}
+func (*GetScrapeTargetsResponse) ProtoMessage() {}
 
-func (x *UnpauseServiceArgs) ProtoReflect() protoreflect.Message {
+func (x *GetScrapeTargetsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2148,30 +2583,37 @@ func (x *UnpauseServiceArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnpauseServiceArgs.ProtoReflect.Descriptor instead.
-func (*UnpauseServiceArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetScrapeTargetsResponse.ProtoReflect.Descriptor instead.
+func (*GetScrapeTargetsResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UnpauseServiceArgs) GetServiceIdentifier() string {
+func (x *GetScrapeTargetsResponse) GetScrapeTargets() []*ScrapeTarget {
 	if x != nil {
-		return x.ServiceIdentifier
+		return x.ScrapeTargets
 	}
-	return ""
+	return nil
 }
 
-type ExecCommandResponse struct {
+type SubnetworkConnection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExitCode int32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
-	// Assumes UTF-8 encoding
-	LogOutput string `protobuf:"bytes,2,opt,name=log_output,json=logOutput,proto3" json:"log_output,omitempty"`
-}
-
-func (x *ExecCommandResponse) Reset() {
-	*x = ExecCommandResponse{}
+	// Percentage value of packet loss
+	PacketLossPercentage float32 `protobuf:"fixed32,1,opt,name=packet_loss_percentage,json=packetLossPercentage,proto3" json:"packet_loss_percentage,omitempty"`
+	// Average delay of the packets, in milliseconds
+	PacketDelayMeanMs uint32 `protobuf:"varint,2,opt,name=packet_delay_mean_ms,json=packetDelayMeanMs,proto3" json:"packet_delay_mean_ms,omitempty"`
+	// Variation of the delay of the packets around the average, in milliseconds
+	PacketDelayStdDevMs uint32 `protobuf:"varint,3,opt,name=packet_delay_std_dev_ms,json=packetDelayStdDevMs,proto3" json:"packet_delay_std_dev_ms,omitempty"`
+	// Percentage of correlation between the delay of a packet and the delay of the previous one
+	PacketDelayCorrelation float32 `protobuf:"fixed32,4,opt,name=packet_delay_correlation,json=packetDelayCorrelation,proto3" json:"packet_delay_correlation,omitempty"`
+	// Maximum bandwidth of the connection, in kbit/s; 0 means no limit
+	BandwidthLimitKbps uint32 `protobuf:"varint,5,opt,name=bandwidth_limit_kbps,json=bandwidthLimitKbps,proto3" json:"bandwidth_limit_kbps,omitempty"`
+}
+
+func (x *SubnetworkConnection) Reset() {
+	*x = SubnetworkConnection{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2179,13 +2621,13 @@ func (x *ExecCommandResponse) Reset() {
 	}
 }
 
-func (x *ExecCommandResponse) String() string {
+func (x *SubnetworkConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecCommandResponse) ProtoMessage() {}
+func (*SubnetworkConnection) ProtoMessage() {}
 
-func (x *ExecCommandResponse) ProtoReflect() protoreflect.Message {
+func (x *SubnetworkConnection) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2197,53 +2639,64 @@ func (x *ExecCommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecCommandResponse.ProtoReflect.Descriptor instead.
-func (*ExecCommandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubnetworkConnection.ProtoReflect.Descriptor instead.
+func (*SubnetworkConnection) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ExecCommandResponse) GetExitCode() int32 {
+func (x *SubnetworkConnection) GetPacketLossPercentage() float32 {
 	if x != nil {
-		return x.ExitCode
+		return x.PacketLossPercentage
 	}
 	return 0
 }
 
-func (x *ExecCommandResponse) GetLogOutput() string {
+func (x *SubnetworkConnection) GetPacketDelayMeanMs() uint32 {
 	if x != nil {
-		return x.LogOutput
+		return x.PacketDelayMeanMs
 	}
-	return ""
+	return 0
+}
+
+func (x *SubnetworkConnection) GetPacketDelayStdDevMs() uint32 {
+	if x != nil {
+		return x.PacketDelayStdDevMs
+	}
+	return 0
+}
+
+func (x *SubnetworkConnection) GetPacketDelayCorrelation() float32 {
+	if x != nil {
+		return x.PacketDelayCorrelation
+	}
+	return 0
+}
+
+func (x *SubnetworkConnection) GetBandwidthLimitKbps() uint32 {
+	if x != nil {
+		return x.BandwidthLimitKbps
+	}
+	return 0
 }
 
 // ==============================================================================================
 //
-//	Wait For HTTP Get Endpoint Availability
+//	Incremental Repartitioning
 //
 // ==============================================================================================
-type WaitForHttpGetEndpointAvailabilityArgs struct {
+type AddPartitionArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier of the service to check.
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	// The port of the service to check. For instance 8080
-	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	// The number of milliseconds to wait until executing the first HTTP call
-	InitialDelayMilliseconds uint32 `protobuf:"varint,4,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3" json:"initial_delay_milliseconds,omitempty"`
-	// Max number of HTTP call attempts that this will execute until giving up and returning an error
-	Retries uint32 `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
-	// Number of milliseconds to wait between retries
-	RetriesDelayMilliseconds uint32 `protobuf:"varint,6,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3" json:"retries_delay_milliseconds,omitempty"`
-	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
-	BodyText string `protobuf:"bytes,7,opt,name=body_text,json=bodyText,proto3" json:"body_text,omitempty"`
+	// ID of the partition to create; it must not exist yet
+	PartitionId string `protobuf:"bytes,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	// "Set" of service names to move to the new partition
+	ServiceNameSet map[string]bool `protobuf:"bytes,2,rep,name=service_name_set,json=serviceNameSet,proto3" json:"service_name_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) Reset() {
-	*x = WaitForHttpGetEndpointAvailabilityArgs{}
+func (x *AddPartitionArgs) Reset() {
+	*x = AddPartitionArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2251,13 +2704,13 @@ func (x *WaitForHttpGetEndpointAvailabilityArgs) Reset() {
 	}
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) String() string {
+func (x *AddPartitionArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForHttpGetEndpointAvailabilityArgs) ProtoMessage() {}
+func (*AddPartitionArgs) ProtoMessage() {}
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
+func (x *AddPartitionArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2269,105 +2722,110 @@ func (x *WaitForHttpGetEndpointAvailabilityArgs) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForHttpGetEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
-func (*WaitForHttpGetEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddPartitionArgs.ProtoReflect.Descriptor instead.
+func (*AddPartitionArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetServiceIdentifier() string {
+func (x *AddPartitionArgs) GetPartitionId() string {
 	if x != nil {
-		return x.ServiceIdentifier
+		return x.PartitionId
 	}
 	return ""
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPort() uint32 {
+func (x *AddPartitionArgs) GetServiceNameSet() map[string]bool {
 	if x != nil {
-		return x.Port
+		return x.ServiceNameSet
 	}
-	return 0
+	return nil
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+type MovePartitionArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the existing partition to move the services to
+	PartitionId string `protobuf:"bytes,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	// "Set" of service names to move to the partition
+	ServiceNameSet map[string]bool `protobuf:"bytes,2,rep,name=service_name_set,json=serviceNameSet,proto3" json:"service_name_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
-	if x != nil {
-		return x.InitialDelayMilliseconds
+func (x *MovePartitionArgs) Reset() {
+	*x = MovePartitionArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetries() uint32 {
-	if x != nil {
-		return x.Retries
+func (x *MovePartitionArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovePartitionArgs) ProtoMessage() {}
+
+func (x *MovePartitionArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
+// Deprecated: Use MovePartitionArgs.ProtoReflect.Descriptor instead.
+func (*MovePartitionArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MovePartitionArgs) GetPartitionId() string {
 	if x != nil {
-		return x.RetriesDelayMilliseconds
+		return x.PartitionId
 	}
-	return 0
+	return ""
 }
 
-func (x *WaitForHttpGetEndpointAvailabilityArgs) GetBodyText() string {
+func (x *MovePartitionArgs) GetServiceNameSet() map[string]bool {
 	if x != nil {
-		return x.BodyText
+		return x.ServiceNameSet
 	}
-	return ""
+	return nil
 }
 
-// ==============================================================================================
-//
-//	Wait For HTTP Post Endpoint Availability
-//
-// ==============================================================================================
-type WaitForHttpPostEndpointAvailabilityArgs struct {
+type UpdateConnectionArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier of the service to check.
-	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	// The port of the service to check. For instance 8080
-	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	// The content of the request body.
-	RequestBody string `protobuf:"bytes,4,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
-	// The number of milliseconds to wait until executing the first HTTP call
-	InitialDelayMilliseconds uint32 `protobuf:"varint,5,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3" json:"initial_delay_milliseconds,omitempty"`
-	// Max number of HTTP call attempts that this will execute until giving up and returning an error
-	Retries uint32 `protobuf:"varint,6,opt,name=retries,proto3" json:"retries,omitempty"`
-	// Number of milliseconds to wait between retries
-	RetriesDelayMilliseconds uint32 `protobuf:"varint,7,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3" json:"retries_delay_milliseconds,omitempty"`
-	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
-	BodyText string `protobuf:"bytes,8,opt,name=body_text,json=bodyText,proto3" json:"body_text,omitempty"`
+	PartitionIdA string `protobuf:"bytes,1,opt,name=partition_id_a,json=partitionIdA,proto3" json:"partition_id_a,omitempty"`
+	PartitionIdB string `protobuf:"bytes,2,opt,name=partition_id_b,json=partitionIdB,proto3" json:"partition_id_b,omitempty"`
+	// Information defining the connection between A <-> B
+	ConnectionInfo *PartitionConnectionInfo `protobuf:"bytes,3,opt,name=connection_info,json=connectionInfo,proto3" json:"connection_info,omitempty"`
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) Reset() {
-	*x = WaitForHttpPostEndpointAvailabilityArgs{}
+func (x *UpdateConnectionArgs) Reset() {
+	*x = UpdateConnectionArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[34]
+		mi := &file_api_container_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) String() string {
+func (x *UpdateConnectionArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaitForHttpPostEndpointAvailabilityArgs) ProtoMessage() {}
+func (*UpdateConnectionArgs) ProtoMessage() {}
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[34]
+func (x *UpdateConnectionArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,100 +2836,59 @@ func (x *WaitForHttpPostEndpointAvailabilityArgs) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaitForHttpPostEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
-func (*WaitForHttpPostEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetServiceIdentifier() string {
-	if x != nil {
-		return x.ServiceIdentifier
-	}
-	return ""
-}
-
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
+// Deprecated: Use UpdateConnectionArgs.ProtoReflect.Descriptor instead.
+func (*UpdateConnectionArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPath() string {
+func (x *UpdateConnectionArgs) GetPartitionIdA() string {
 	if x != nil {
-		return x.Path
+		return x.PartitionIdA
 	}
 	return ""
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRequestBody() string {
+func (x *UpdateConnectionArgs) GetPartitionIdB() string {
 	if x != nil {
-		return x.RequestBody
+		return x.PartitionIdB
 	}
 	return ""
 }
 
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
-	if x != nil {
-		return x.InitialDelayMilliseconds
-	}
-	return 0
-}
-
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetries() uint32 {
-	if x != nil {
-		return x.Retries
-	}
-	return 0
-}
-
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
-	if x != nil {
-		return x.RetriesDelayMilliseconds
-	}
-	return 0
-}
-
-func (x *WaitForHttpPostEndpointAvailabilityArgs) GetBodyText() string {
+func (x *UpdateConnectionArgs) GetConnectionInfo() *PartitionConnectionInfo {
 	if x != nil {
-		return x.BodyText
+		return x.ConnectionInfo
 	}
-	return ""
+	return nil
 }
 
-// ==============================================================================================
-//
-//	Upload Files Artifact
-//
-// ==============================================================================================
-type UploadFilesArtifactArgs struct {
+type SubnetworkConnectionOverride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Bytes of the files artifact to store
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Name of the files artifact
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Subnetwork1 string                `protobuf:"bytes,1,opt,name=subnetwork1,proto3" json:"subnetwork1,omitempty"`
+	Subnetwork2 string                `protobuf:"bytes,2,opt,name=subnetwork2,proto3" json:"subnetwork2,omitempty"`
+	Connection  *SubnetworkConnection `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection,omitempty"`
 }
 
-func (x *UploadFilesArtifactArgs) Reset() {
-	*x = UploadFilesArtifactArgs{}
+func (x *SubnetworkConnectionOverride) Reset() {
+	*x = SubnetworkConnectionOverride{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[35]
+		mi := &file_api_container_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadFilesArtifactArgs) String() string {
+func (x *SubnetworkConnectionOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFilesArtifactArgs) ProtoMessage() {}
+func (*SubnetworkConnectionOverride) ProtoMessage() {}
 
-func (x *UploadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[35]
+func (x *SubnetworkConnectionOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,53 +2899,59 @@ func (x *UploadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFilesArtifactArgs.ProtoReflect.Descriptor instead.
-func (*UploadFilesArtifactArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SubnetworkConnectionOverride.ProtoReflect.Descriptor instead.
+func (*SubnetworkConnectionOverride) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UploadFilesArtifactArgs) GetData() []byte {
+func (x *SubnetworkConnectionOverride) GetSubnetwork1() string {
 	if x != nil {
-		return x.Data
+		return x.Subnetwork1
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadFilesArtifactArgs) GetName() string {
+func (x *SubnetworkConnectionOverride) GetSubnetwork2() string {
 	if x != nil {
-		return x.Name
+		return x.Subnetwork2
 	}
 	return ""
 }
 
-type UploadFilesArtifactResponse struct {
+func (x *SubnetworkConnectionOverride) GetConnection() *SubnetworkConnection {
+	if x != nil {
+		return x.Connection
+	}
+	return nil
+}
+
+type GetSubnetworkConnectionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// UUID of the files artifact, for use when referencing it in the future
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The connection between subnetworks that don't have an override
+	DefaultConnection   *SubnetworkConnection           `protobuf:"bytes,1,opt,name=default_connection,json=defaultConnection,proto3" json:"default_connection,omitempty"`
+	ConnectionOverrides []*SubnetworkConnectionOverride `protobuf:"bytes,2,rep,name=connection_overrides,json=connectionOverrides,proto3" json:"connection_overrides,omitempty"`
 }
 
-func (x *UploadFilesArtifactResponse) Reset() {
-	*x = UploadFilesArtifactResponse{}
+func (x *GetSubnetworkConnectionsResponse) Reset() {
+	*x = GetSubnetworkConnectionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[36]
+		mi := &file_api_container_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadFilesArtifactResponse) String() string {
+func (x *GetSubnetworkConnectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFilesArtifactResponse) ProtoMessage() {}
+func (*GetSubnetworkConnectionsResponse) ProtoMessage() {}
 
-func (x *UploadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[36]
+func (x *GetSubnetworkConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,56 +2962,58 @@ func (x *UploadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFilesArtifactResponse.ProtoReflect.Descriptor instead.
-func (*UploadFilesArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetSubnetworkConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSubnetworkConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UploadFilesArtifactResponse) GetUuid() string {
+func (x *GetSubnetworkConnectionsResponse) GetDefaultConnection() *SubnetworkConnection {
 	if x != nil {
-		return x.Uuid
+		return x.DefaultConnection
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadFilesArtifactResponse) GetName() string {
+func (x *GetSubnetworkConnectionsResponse) GetConnectionOverrides() []*SubnetworkConnectionOverride {
 	if x != nil {
-		return x.Name
+		return x.ConnectionOverrides
 	}
-	return ""
+	return nil
 }
 
 // ==============================================================================================
 //
-//	Download Files Artifact
+//	Test Connectivity
 //
 // ==============================================================================================
-type DownloadFilesArtifactArgs struct {
+type TestConnectivityArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Files identifier to get bytes for
-	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// "Set" of identifiers of the services to test the connectivity between; all the services get tested if empty
+	ServiceIdentifiers map[string]bool `protobuf:"bytes,1,rep,name=service_identifiers,json=serviceIdentifiers,proto3" json:"service_identifiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Number of pings sent along each path; a default gets used if 0
+	NumProbes uint32 `protobuf:"varint,2,opt,name=num_probes,json=numProbes,proto3" json:"num_probes,omitempty"`
 }
 
-func (x *DownloadFilesArtifactArgs) Reset() {
-	*x = DownloadFilesArtifactArgs{}
+func (x *TestConnectivityArgs) Reset() {
+	*x = TestConnectivityArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[37]
+		mi := &file_api_container_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadFilesArtifactArgs) String() string {
+func (x *TestConnectivityArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFilesArtifactArgs) ProtoMessage() {}
+func (*TestConnectivityArgs) ProtoMessage() {}
 
-func (x *DownloadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[37]
+func (x *TestConnectivityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2599,44 +3024,62 @@ func (x *DownloadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFilesArtifactArgs.ProtoReflect.Descriptor instead.
-func (*DownloadFilesArtifactArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TestConnectivityArgs.ProtoReflect.Descriptor instead.
+func (*TestConnectivityArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DownloadFilesArtifactArgs) GetIdentifier() string {
+func (x *TestConnectivityArgs) GetServiceIdentifiers() map[string]bool {
 	if x != nil {
-		return x.Identifier
+		return x.ServiceIdentifiers
 	}
-	return ""
+	return nil
 }
 
-type DownloadFilesArtifactResponse struct {
+func (x *TestConnectivityArgs) GetNumProbes() uint32 {
+	if x != nil {
+		return x.NumProbes
+	}
+	return 0
+}
+
+type ConnectivityProbeResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Contents of the requested files artifact
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-}
-
-func (x *DownloadFilesArtifactResponse) Reset() {
-	*x = DownloadFilesArtifactResponse{}
+	// Name of the service the pings got sent from
+	SourceServiceName string `protobuf:"bytes,1,opt,name=source_service_name,json=sourceServiceName,proto3" json:"source_service_name,omitempty"`
+	// Name of the service the pings got sent to
+	TargetServiceName string `protobuf:"bytes,2,opt,name=target_service_name,json=targetServiceName,proto3" json:"target_service_name,omitempty"`
+	// What the partition topology says the connection between the services should be
+	ExpectedPacketLossPercentage float32 `protobuf:"fixed32,3,opt,name=expected_packet_loss_percentage,json=expectedPacketLossPercentage,proto3" json:"expected_packet_loss_percentage,omitempty"`
+	ExpectedPacketDelayMs        uint32  `protobuf:"varint,4,opt,name=expected_packet_delay_ms,json=expectedPacketDelayMs,proto3" json:"expected_packet_delay_ms,omitempty"`
+	ObservedPacketLossPercentage float32 `protobuf:"fixed32,5,opt,name=observed_packet_loss_percentage,json=observedPacketLossPercentage,proto3" json:"observed_packet_loss_percentage,omitempty"`
+	// Average round trip time of the pings that got a reply; 0 if none did
+	ObservedAvgLatencyMs float32 `protobuf:"fixed32,6,opt,name=observed_avg_latency_ms,json=observedAvgLatencyMs,proto3" json:"observed_avg_latency_ms,omitempty"`
+	// Set if the pings couldn't be sent at all, e.g. because the source service has no 'ping' binary, in which case the
+	//  observed values are meaningless
+	ProbeError string `protobuf:"bytes,7,opt,name=probe_error,json=probeError,proto3" json:"probe_error,omitempty"`
+}
+
+func (x *ConnectivityProbeResult) Reset() {
+	*x = ConnectivityProbeResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[38]
+		mi := &file_api_container_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DownloadFilesArtifactResponse) String() string {
+func (x *ConnectivityProbeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadFilesArtifactResponse) ProtoMessage() {}
+func (*ConnectivityProbeResult) ProtoMessage() {}
 
-func (x *DownloadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[38]
+func (x *ConnectivityProbeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2647,91 +3090,70 @@ func (x *DownloadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadFilesArtifactResponse.ProtoReflect.Descriptor instead.
-func (*DownloadFilesArtifactResponse) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ConnectivityProbeResult.ProtoReflect.Descriptor instead.
+func (*ConnectivityProbeResult) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DownloadFilesArtifactResponse) GetData() []byte {
+func (x *ConnectivityProbeResult) GetSourceServiceName() string {
 	if x != nil {
-		return x.Data
+		return x.SourceServiceName
 	}
-	return nil
-}
-
-// ==============================================================================================
-//
-//	Store Web Files Artifact
-//
-// ==============================================================================================
-type StoreWebFilesArtifactArgs struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// URL to download the artifact from
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	// The name of the files artifact
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	return ""
 }
 
-func (x *StoreWebFilesArtifactArgs) Reset() {
-	*x = StoreWebFilesArtifactArgs{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ConnectivityProbeResult) GetTargetServiceName() string {
+	if x != nil {
+		return x.TargetServiceName
 	}
+	return ""
 }
 
-func (x *StoreWebFilesArtifactArgs) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ConnectivityProbeResult) GetExpectedPacketLossPercentage() float32 {
+	if x != nil {
+		return x.ExpectedPacketLossPercentage
+	}
+	return 0
 }
 
-func (*StoreWebFilesArtifactArgs) ProtoMessage() {}
-
-func (x *StoreWebFilesArtifactArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ConnectivityProbeResult) GetExpectedPacketDelayMs() uint32 {
+	if x != nil {
+		return x.ExpectedPacketDelayMs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StoreWebFilesArtifactArgs.ProtoReflect.Descriptor instead.
-func (*StoreWebFilesArtifactArgs) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{39}
+func (x *ConnectivityProbeResult) GetObservedPacketLossPercentage() float32 {
+	if x != nil {
+		return x.ObservedPacketLossPercentage
+	}
+	return 0
 }
 
-func (x *StoreWebFilesArtifactArgs) GetUrl() string {
+func (x *ConnectivityProbeResult) GetObservedAvgLatencyMs() float32 {
 	if x != nil {
-		return x.Url
+		return x.ObservedAvgLatencyMs
 	}
-	return ""
+	return 0
 }
 
-func (x *StoreWebFilesArtifactArgs) GetName() string {
+func (x *ConnectivityProbeResult) GetProbeError() string {
 	if x != nil {
-		return x.Name
+		return x.ProbeError
 	}
 	return ""
 }
 
-type StoreWebFilesArtifactResponse struct {
+type TestConnectivityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ProbeResults []*ConnectivityProbeResult `protobuf:"bytes,1,rep,name=probe_results,json=probeResults,proto3" json:"probe_results,omitempty"`
 }
 
-func (x *StoreWebFilesArtifactResponse) Reset() {
-	*x = StoreWebFilesArtifactResponse{}
+func (x *TestConnectivityResponse) Reset() {
+	*x = TestConnectivityResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2739,13 +3161,13 @@ func (x *StoreWebFilesArtifactResponse) Reset() {
 	}
 }
 
-func (x *StoreWebFilesArtifactResponse) String() string {
+func (x *TestConnectivityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreWebFilesArtifactResponse) ProtoMessage() {}
+func (*TestConnectivityResponse) ProtoMessage() {}
 
-func (x *StoreWebFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+func (x *TestConnectivityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2757,33 +3179,33 @@ func (x *StoreWebFilesArtifactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreWebFilesArtifactResponse.ProtoReflect.Descriptor instead.
-func (*StoreWebFilesArtifactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestConnectivityResponse.ProtoReflect.Descriptor instead.
+func (*TestConnectivityResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *StoreWebFilesArtifactResponse) GetUuid() string {
+func (x *TestConnectivityResponse) GetProbeResults() []*ConnectivityProbeResult {
 	if x != nil {
-		return x.Uuid
+		return x.ProbeResults
 	}
-	return ""
+	return nil
 }
 
-type StoreFilesArtifactFromServiceArgs struct {
+// ==============================================================================================
+//
+//	Remove Service
+//
+// ==============================================================================================
+type RemoveServiceArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Identifier that will be used to identify the service where the source files will be copied from
 	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
-	// The absolute source path where the source files will be copied from
-	SourcePath string `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
-	// The name of the files artifact
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) Reset() {
-	*x = StoreFilesArtifactFromServiceArgs{}
+func (x *RemoveServiceArgs) Reset() {
+	*x = RemoveServiceArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2791,13 +3213,13 @@ func (x *StoreFilesArtifactFromServiceArgs) Reset() {
 	}
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) String() string {
+func (x *RemoveServiceArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreFilesArtifactFromServiceArgs) ProtoMessage() {}
+func (*RemoveServiceArgs) ProtoMessage() {}
 
-func (x *StoreFilesArtifactFromServiceArgs) ProtoReflect() protoreflect.Message {
+func (x *RemoveServiceArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2809,43 +3231,29 @@ func (x *StoreFilesArtifactFromServiceArgs) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreFilesArtifactFromServiceArgs.ProtoReflect.Descriptor instead.
-func (*StoreFilesArtifactFromServiceArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServiceArgs.ProtoReflect.Descriptor instead.
+func (*RemoveServiceArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) GetServiceIdentifier() string {
+func (x *RemoveServiceArgs) GetServiceIdentifier() string {
 	if x != nil {
 		return x.ServiceIdentifier
 	}
 	return ""
 }
 
-func (x *StoreFilesArtifactFromServiceArgs) GetSourcePath() string {
-	if x != nil {
-		return x.SourcePath
-	}
-	return ""
-}
-
-func (x *StoreFilesArtifactFromServiceArgs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type StoreFilesArtifactFromServiceResponse struct {
+type RemoveServiceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The UUID of the service that was removed
+	ServiceUuid string `protobuf:"bytes,1,opt,name=service_uuid,json=serviceUuid,proto3" json:"service_uuid,omitempty"`
 }
 
-func (x *StoreFilesArtifactFromServiceResponse) Reset() {
-	*x = StoreFilesArtifactFromServiceResponse{}
+func (x *RemoveServiceResponse) Reset() {
+	*x = RemoveServiceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2853,13 +3261,13 @@ func (x *StoreFilesArtifactFromServiceResponse) Reset() {
 	}
 }
 
-func (x *StoreFilesArtifactFromServiceResponse) String() string {
+func (x *RemoveServiceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StoreFilesArtifactFromServiceResponse) ProtoMessage() {}
+func (*RemoveServiceResponse) ProtoMessage() {}
 
-func (x *StoreFilesArtifactFromServiceResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveServiceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2871,31 +3279,33 @@ func (x *StoreFilesArtifactFromServiceResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StoreFilesArtifactFromServiceResponse.ProtoReflect.Descriptor instead.
-func (*StoreFilesArtifactFromServiceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServiceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveServiceResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *StoreFilesArtifactFromServiceResponse) GetUuid() string {
+func (x *RemoveServiceResponse) GetServiceUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.ServiceUuid
 	}
 	return ""
 }
 
-type RenderTemplatesToFilesArtifactArgs struct {
+type RemoveServicesArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A map of template and its data by the path of the file relative to the root of the files artifact it will be rendered into.
-	TemplatesAndDataByDestinationRelFilepath map[string]*RenderTemplatesToFilesArtifactArgs_TemplateAndData `protobuf:"bytes,1,rep,name=templates_and_data_by_destination_rel_filepath,json=templatesAndDataByDestinationRelFilepath,proto3" json:"templates_and_data_by_destination_rel_filepath,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Name of the files artifact
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// "Set" of identifiers of the services that should be removed
+	ServiceIdentifiers map[string]bool `protobuf:"bytes,1,rep,name=service_identifiers,json=serviceIdentifiers,proto3" json:"service_identifiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Glob patterns (e.g. 'node-*') matched against the service names; every matching service is removed
+	ServiceNamePatterns []string `protobuf:"bytes,2,rep,name=service_name_patterns,json=serviceNamePatterns,proto3" json:"service_name_patterns,omitempty"`
+	// Remove all the services of the enclave, ignoring the service identifiers and the name patterns
+	AllServices bool `protobuf:"varint,3,opt,name=all_services,json=allServices,proto3" json:"all_services,omitempty"`
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs) Reset() {
-	*x = RenderTemplatesToFilesArtifactArgs{}
+func (x *RemoveServicesArgs) Reset() {
+	*x = RemoveServicesArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2903,13 +3313,13 @@ func (x *RenderTemplatesToFilesArtifactArgs) Reset() {
 	}
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs) String() string {
+func (x *RemoveServicesArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenderTemplatesToFilesArtifactArgs) ProtoMessage() {}
+func (*RemoveServicesArgs) ProtoMessage() {}
 
-func (x *RenderTemplatesToFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+func (x *RemoveServicesArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2921,36 +3331,45 @@ func (x *RenderTemplatesToFilesArtifactArgs) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenderTemplatesToFilesArtifactArgs.ProtoReflect.Descriptor instead.
-func (*RenderTemplatesToFilesArtifactArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServicesArgs.ProtoReflect.Descriptor instead.
+func (*RemoveServicesArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs) GetTemplatesAndDataByDestinationRelFilepath() map[string]*RenderTemplatesToFilesArtifactArgs_TemplateAndData {
+func (x *RemoveServicesArgs) GetServiceIdentifiers() map[string]bool {
 	if x != nil {
-		return x.TemplatesAndDataByDestinationRelFilepath
+		return x.ServiceIdentifiers
 	}
 	return nil
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs) GetName() string {
+func (x *RemoveServicesArgs) GetServiceNamePatterns() []string {
 	if x != nil {
-		return x.Name
+		return x.ServiceNamePatterns
 	}
-	return ""
+	return nil
 }
 
-type RenderTemplatesToFilesArtifactResponse struct {
+func (x *RemoveServicesArgs) GetAllServices() bool {
+	if x != nil {
+		return x.AllServices
+	}
+	return false
+}
+
+type RemoveServicesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// UUID of the files artifact, for use when referencing it in the future
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Mapping from name of the services that were successfully removed to their UUID
+	RemovedServiceNameToUuid map[string]string `protobuf:"bytes,1,rep,name=removed_service_name_to_uuid,json=removedServiceNameToUuid,proto3" json:"removed_service_name_to_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Mapping from name (or identifier, if it couldn't be resolved) of the services that failed to be removed to the error
+	FailedServiceNameToError map[string]string `protobuf:"bytes,2,rep,name=failed_service_name_to_error,json=failedServiceNameToError,proto3" json:"failed_service_name_to_error,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RenderTemplatesToFilesArtifactResponse) Reset() {
-	*x = RenderTemplatesToFilesArtifactResponse{}
+func (x *RemoveServicesResponse) Reset() {
+	*x = RemoveServicesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2958,13 +3377,13 @@ func (x *RenderTemplatesToFilesArtifactResponse) Reset() {
 	}
 }
 
-func (x *RenderTemplatesToFilesArtifactResponse) String() string {
+func (x *RemoveServicesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenderTemplatesToFilesArtifactResponse) ProtoMessage() {}
+func (*RemoveServicesResponse) ProtoMessage() {}
 
-func (x *RenderTemplatesToFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveServicesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2976,31 +3395,47 @@ func (x *RenderTemplatesToFilesArtifactResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenderTemplatesToFilesArtifactResponse.ProtoReflect.Descriptor instead.
-func (*RenderTemplatesToFilesArtifactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveServicesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveServicesResponse) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RenderTemplatesToFilesArtifactResponse) GetUuid() string {
+func (x *RemoveServicesResponse) GetRemovedServiceNameToUuid() map[string]string {
 	if x != nil {
-		return x.Uuid
+		return x.RemovedServiceNameToUuid
 	}
-	return ""
+	return nil
 }
 
-type FilesArtifactNameAndUuid struct {
+func (x *RemoveServicesResponse) GetFailedServiceNameToError() map[string]string {
+	if x != nil {
+		return x.FailedServiceNameToError
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Repartition
+//
+// ==============================================================================================
+type RepartitionArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A string representing the name of the file
-	FileName string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
-	// A string representing the uuid of the file
-	FileUuid string `protobuf:"bytes,2,opt,name=fileUuid,proto3" json:"fileUuid,omitempty"`
+	// Definition of partitionId -> services that should be inside the partition after repartitioning
+	PartitionServices map[string]*PartitionServices `protobuf:"bytes,1,rep,name=partition_services,json=partitionServices,proto3" json:"partition_services,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Definition of partitionIdA -> partitionIdB -> information defining the connection between A <-> B
+	PartitionConnections map[string]*PartitionConnections `protobuf:"bytes,2,rep,name=partition_connections,json=partitionConnections,proto3" json:"partition_connections,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Information about the default inter-partition connection to set up if one is not defined in the
+	//
+	//	partition connections map
+	DefaultConnection *PartitionConnectionInfo `protobuf:"bytes,3,opt,name=default_connection,json=defaultConnection,proto3" json:"default_connection,omitempty"`
 }
 
-func (x *FilesArtifactNameAndUuid) Reset() {
-	*x = FilesArtifactNameAndUuid{}
+func (x *RepartitionArgs) Reset() {
+	*x = RepartitionArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3008,13 +3443,13 @@ func (x *FilesArtifactNameAndUuid) Reset() {
 	}
 }
 
-func (x *FilesArtifactNameAndUuid) String() string {
+func (x *RepartitionArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilesArtifactNameAndUuid) ProtoMessage() {}
+func (*RepartitionArgs) ProtoMessage() {}
 
-func (x *FilesArtifactNameAndUuid) ProtoReflect() protoreflect.Message {
+func (x *RepartitionArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3026,35 +3461,43 @@ func (x *FilesArtifactNameAndUuid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilesArtifactNameAndUuid.ProtoReflect.Descriptor instead.
-func (*FilesArtifactNameAndUuid) Descriptor() ([]byte, []int) {
+// Deprecated: Use RepartitionArgs.ProtoReflect.Descriptor instead.
+func (*RepartitionArgs) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *FilesArtifactNameAndUuid) GetFileName() string {
+func (x *RepartitionArgs) GetPartitionServices() map[string]*PartitionServices {
 	if x != nil {
-		return x.FileName
+		return x.PartitionServices
 	}
-	return ""
+	return nil
 }
 
-func (x *FilesArtifactNameAndUuid) GetFileUuid() string {
+func (x *RepartitionArgs) GetPartitionConnections() map[string]*PartitionConnections {
 	if x != nil {
-		return x.FileUuid
+		return x.PartitionConnections
 	}
-	return ""
+	return nil
 }
 
-type ListFilesArtifactNamesAndUuidsResponse struct {
+func (x *RepartitionArgs) GetDefaultConnection() *PartitionConnectionInfo {
+	if x != nil {
+		return x.DefaultConnection
+	}
+	return nil
+}
+
+type PartitionServices struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileNamesAndUuids []*FilesArtifactNameAndUuid `protobuf:"bytes,1,rep,name=file_names_and_uuids,json=fileNamesAndUuids,proto3" json:"file_names_and_uuids,omitempty"`
+	// "Set" of service names in partition
+	ServiceNameSet map[string]bool `protobuf:"bytes,1,rep,name=service_name_set,json=serviceNameSet,proto3" json:"service_name_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) Reset() {
-	*x = ListFilesArtifactNamesAndUuidsResponse{}
+func (x *PartitionServices) Reset() {
+	*x = PartitionServices{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_container_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3062,13 +3505,13 @@ func (x *ListFilesArtifactNamesAndUuidsResponse) Reset() {
 	}
 }
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) String() string {
+func (x *PartitionServices) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFilesArtifactNamesAndUuidsResponse) ProtoMessage() {}
+func (*PartitionServices) ProtoMessage() {}
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) ProtoReflect() protoreflect.Message {
+func (x *PartitionServices) ProtoReflect() protoreflect.Message {
 	mi := &file_api_container_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3080,47 +3523,43 @@ func (x *ListFilesArtifactNamesAndUuidsResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFilesArtifactNamesAndUuidsResponse.ProtoReflect.Descriptor instead.
-func (*ListFilesArtifactNamesAndUuidsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PartitionServices.ProtoReflect.Descriptor instead.
+func (*PartitionServices) Descriptor() ([]byte, []int) {
 	return file_api_container_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListFilesArtifactNamesAndUuidsResponse) GetFileNamesAndUuids() []*FilesArtifactNameAndUuid {
+func (x *PartitionServices) GetServiceNameSet() map[string]bool {
 	if x != nil {
-		return x.FileNamesAndUuids
+		return x.ServiceNameSet
 	}
 	return nil
 }
 
-// An object representing the template and the data that needs to be inserted
-type RenderTemplatesToFilesArtifactArgs_TemplateAndData struct {
+type PartitionConnections struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// A string representation of the template file
-	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
-	// A json string representation of the data to be written to template
-	DataAsJson string `protobuf:"bytes,2,opt,name=data_as_json,json=dataAsJson,proto3" json:"data_as_json,omitempty"`
+	ConnectionInfo map[string]*PartitionConnectionInfo `protobuf:"bytes,1,rep,name=connection_info,json=connectionInfo,proto3" json:"connection_info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) Reset() {
-	*x = RenderTemplatesToFilesArtifactArgs_TemplateAndData{}
+func (x *PartitionConnections) Reset() {
+	*x = PartitionConnections{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_container_service_proto_msgTypes[62]
+		mi := &file_api_container_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) String() string {
+func (x *PartitionConnections) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenderTemplatesToFilesArtifactArgs_TemplateAndData) ProtoMessage() {}
+func (*PartitionConnections) ProtoMessage() {}
 
-func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_container_service_proto_msgTypes[62]
+func (x *PartitionConnections) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3131,923 +3570,5764 @@ func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenderTemplatesToFilesArtifactArgs_TemplateAndData.ProtoReflect.Descriptor instead.
-func (*RenderTemplatesToFilesArtifactArgs_TemplateAndData) Descriptor() ([]byte, []int) {
-	return file_api_container_service_proto_rawDescGZIP(), []int{43, 0}
+// Deprecated: Use PartitionConnections.ProtoReflect.Descriptor instead.
+func (*PartitionConnections) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) GetTemplate() string {
+func (x *PartitionConnections) GetConnectionInfo() map[string]*PartitionConnectionInfo {
 	if x != nil {
-		return x.Template
+		return x.ConnectionInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) GetDataAsJson() string {
-	if x != nil {
-		return x.DataAsJson
+type PartitionConnectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Percentage value of packet loss in a partition connection
+	PacketLossPercentage float32 `protobuf:"fixed32,1,opt,name=packet_loss_percentage,json=packetLossPercentage,proto3" json:"packet_loss_percentage,omitempty"`
+	// Average delay of the packets, in milliseconds
+	PacketDelayMeanMs uint32 `protobuf:"varint,2,opt,name=packet_delay_mean_ms,json=packetDelayMeanMs,proto3" json:"packet_delay_mean_ms,omitempty"`
+	// Variation of the delay of the packets around the average, in milliseconds
+	PacketDelayStdDevMs uint32 `protobuf:"varint,3,opt,name=packet_delay_std_dev_ms,json=packetDelayStdDevMs,proto3" json:"packet_delay_std_dev_ms,omitempty"`
+	// Percentage of correlation between the delay of a packet and the delay of the previous one
+	PacketDelayCorrelation float32 `protobuf:"fixed32,4,opt,name=packet_delay_correlation,json=packetDelayCorrelation,proto3" json:"packet_delay_correlation,omitempty"`
+	// Maximum bandwidth of the connection, in kbit/s; 0 means no limit
+	BandwidthLimitKbps uint32 `protobuf:"varint,5,opt,name=bandwidth_limit_kbps,json=bandwidthLimitKbps,proto3" json:"bandwidth_limit_kbps,omitempty"`
+}
+
+func (x *PartitionConnectionInfo) Reset() {
+	*x = PartitionConnectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-var File_api_container_service_proto protoreflect.FileDescriptor
+func (x *PartitionConnectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_api_container_service_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
-	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x01,
-	0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x58,
-	0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50,
-	0x6f, 0x72, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x61, 0x79, 0x62,
-	0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6d, 0x61,
-	0x79, 0x62, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x2f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x54,
-	0x43, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43, 0x54, 0x50, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x22, 0xb7, 0x04, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x55, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x79,
-	0x62, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x62, 0x0a, 0x12, 0x6d, 0x61,
-	0x79, 0x62, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x61, 0x79, 0x62, 0x65, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x6d, 0x61,
-	0x79, 0x62, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x1a, 0x58, 0x0a, 0x11, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x15, 0x4d, 0x61, 0x79, 0x62, 0x65, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xa5, 0x08, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x54,
-	0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50,
-	0x6f, 0x72, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65,
-	0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x6d, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6d, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f,
-	0x76, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x76,
-	0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x56, 0x61,
-	0x72, 0x73, 0x12, 0x7c, 0x0a, 0x1a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
-	0x69, 0x66, 0x61, 0x63, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x38, 0x0a, 0x18, 0x63, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x16, 0x63, 0x70, 0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x19, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x18, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x1a, 0x58,
-	0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a,
-	0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4d, 0x6f,
-	0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x49, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x22, 0xd2, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72,
-	0x6c, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
-	0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x64, 0x72, 0x79,
-	0x52, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
-	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0b, 0x70,
-	0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x22, 0x93, 0x02, 0x0a, 0x16, 0x52, 0x75,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x41, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x25, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
-	0x6c, 0x69, 0x73, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x1a, 0x0a, 0x18, 0x73, 0x74, 0x61, 0x72, 0x6c,
-	0x61, 0x72, 0x6b, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x22,
-	0xbf, 0x03, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c,
-	0x61, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x48, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x5d, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x5b, 0x0a, 0x12, 0x72, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f,
-	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72, 0x75, 0x6e, 0x46,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11,
-	0x72, 0x75, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6c, 0x69, 0x6e,
-	0x65, 0x22, 0x8c, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x47, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x52, 0x09,
-	0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x16, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x5f, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a,
-	0x1d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x12, 0x30, 0x0a, 0x14,
-	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x72, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e,
-	0x0a, 0x08, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x07, 0x61, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b,
-	0x0a, 0x11, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x52, 0x65, 0x70,
-	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72,
-	0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22,
-	0xac, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x63, 0x0a, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x54, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
-	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x42,
-	0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70,
-	0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a,
-	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x3e, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a,
-	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x3d, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75,
-	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65,
-	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
-	0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x6c,
-	0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73,
-	0x52, 0x75, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x12, 0x30, 0x0a,
-	0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x78, 0x0a, 0x18, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41,
-	0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x6a, 0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x8c, 0x04, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x27,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
-	0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x22, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x86, 0x01, 0x0a, 0x1c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x61, 0x70, 0x69,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x18, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x75, 0x0a, 0x27,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x41, 0x72, 0x67, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x5e, 0x0a, 0x10,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x12,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64,
-	0x22, 0x83, 0x01, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
-	0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x15, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0xa7, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x68, 0x0a, 0x12, 0x70, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x71, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x14, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x6a, 0x0a, 0x16, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70,
-	0x0a, 0x19, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xba, 0x01, 0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xeb, 0x01,
-	0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x6d, 0x0a, 0x13,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a, 0x17, 0x50,
-	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f,
-	0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x63, 0x0a, 0x0f,
-	0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72, 0x67, 0x73, 0x12,
-	0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72, 0x67,
-	0x73, 0x22, 0x41, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x13, 0x45, 0x78, 0x65,
-	0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xb2, 0x02, 0x0a,
-	0x26, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70, 0x47, 0x65, 0x74, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x3c,
-	0x0a, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f,
-	0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x72, 0x65, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x65, 0x78,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x54, 0x65, 0x78,
-	0x74, 0x22, 0xd6, 0x02, 0x0a, 0x27, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74,
-	0x70, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a,
-	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
-	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x18, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x61,
-	0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x54, 0x65, 0x78, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a,
-	0x1b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x22, 0x33, 0x0a, 0x1d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x41, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57,
-	0x65, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41,
-	0x72, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x1d, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x57, 0x65, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x87,
-	0x01, 0x0a, 0x21, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
-	0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x25, 0x53, 0x74, 0x6f, 0x72,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72,
-	0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xf6, 0x03, 0x0a, 0x22, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0xc5, 0x01, 0x0a,
-	0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x63, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x46, 0x69, 0x6c,
-	0x65, 0x70, 0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x28, 0x74, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x4f, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x61, 0x73, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x41, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x1a, 0xa2, 0x01, 0x0a, 0x2d, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x46, 0x69,
-	0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x61,
-	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
-	0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41,
-	0x72, 0x67, 0x73, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c,
-	0x0a, 0x26, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x18,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x22, 0x86, 0x01, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x75,
-	0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x14, 0x66,
-	0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x41,
-	0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x32, 0xf9, 0x0f, 0x0a,
-	0x13, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c,
-	0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x75,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41,
-	0x72, 0x67, 0x73, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
-	0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
-	0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x75,
-	0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x41, 0x72, 0x67, 0x73, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72,
-	0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x28, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x45, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x28, 0x2e, 0x61, 0x70,
-	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x51, 0x0a, 0x0e, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x22, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74,
-	0x74, 0x70, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61,
-	0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x7b,
-	0x0a, 0x23, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x73,
-	0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
-	0x72, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67,
-	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x13, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
-	0x63, 0x74, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2e,
-	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
-	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x79, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66,
-	0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x15, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65,
-	0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72,
-	0x67, 0x73, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x46,
-	0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x38,
-	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
-	0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x94, 0x01, 0x0a, 0x1e, 0x52,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x35, 0x2e,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
-	0x41, 0x72, 0x67, 0x73, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
-	0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75,
-	0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,0x46, 0x69, 0x6c, 0x65, 0x73, 0x41,
-	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73,0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x52, 0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75,
-	0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
-	0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f,
-	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*PartitionConnectionInfo) ProtoMessage() {}
+
+func (x *PartitionConnectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_api_container_service_proto_rawDescOnce sync.Once
-	file_api_container_service_proto_rawDescData = file_api_container_service_proto_rawDesc
-)
+// Deprecated: Use PartitionConnectionInfo.ProtoReflect.Descriptor instead.
+func (*PartitionConnectionInfo) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{48}
+}
 
-func file_api_container_service_proto_rawDescGZIP() []byte {
-	file_api_container_service_proto_rawDescOnce.Do(func() {
-		file_api_container_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_container_service_proto_rawDescData)
-	})
-	return file_api_container_service_proto_rawDescData
+func (x *PartitionConnectionInfo) GetPacketLossPercentage() float32 {
+	if x != nil {
+		return x.PacketLossPercentage
+	}
+	return 0
 }
 
-var file_api_container_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_container_service_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
-var file_api_container_service_proto_goTypes = []interface{}{
-	(Port_TransportProtocol)(0),                                // 0: api_container_api.Port.TransportProtocol
-	(*Port)(nil),                                               // 1: api_container_api.Port
-	(*ServiceInfo)(nil),                                        // 2: api_container_api.ServiceInfo
-	(*ServiceConfig)(nil),                                      // 3: api_container_api.ServiceConfig
-	(*UpdateServiceConfig)(nil),                                // 4: api_container_api.UpdateServiceConfig
-	(*RunStarlarkScriptArgs)(nil),                              // 5: api_container_api.RunStarlarkScriptArgs
-	(*RunStarlarkPackageArgs)(nil),                             // 6: api_container_api.RunStarlarkPackageArgs
-	(*StarlarkRunResponseLine)(nil),                            // 7: api_container_api.StarlarkRunResponseLine
-	(*StarlarkInstruction)(nil),                                // 8: api_container_api.StarlarkInstruction
-	(*StarlarkInstructionResult)(nil),                          // 9: api_container_api.StarlarkInstructionResult
-	(*StarlarkInstructionArg)(nil),                             // 10: api_container_api.StarlarkInstructionArg
-	(*StarlarkInstructionPosition)(nil),                        // 11: api_container_api.StarlarkInstructionPosition
-	(*StarlarkError)(nil),                                      // 12: api_container_api.StarlarkError
-	(*StarlarkInterpretationError)(nil),                        // 13: api_container_api.StarlarkInterpretationError
-	(*StarlarkValidationError)(nil),                            // 14: api_container_api.StarlarkValidationError
-	(*StarlarkExecutionError)(nil),                             // 15: api_container_api.StarlarkExecutionError
-	(*StarlarkRunProgress)(nil),                                // 16: api_container_api.StarlarkRunProgress
-	(*StarlarkRunFinishedEvent)(nil),                           // 17: api_container_api.StarlarkRunFinishedEvent
-	(*StartServicesArgs)(nil),                                  // 18: api_container_api.StartServicesArgs
-	(*StartServicesResponse)(nil),                              // 19: api_container_api.StartServicesResponse
-	(*GetServicesArgs)(nil),                                    // 20: api_container_api.GetServicesArgs
-	(*GetServicesResponse)(nil),                                // 21: api_container_api.GetServicesResponse
-	(*ServiceIdentifiers)(nil),                                 // 22: api_container_api.ServiceIdentifiers
-	(*GetExistingAndHistoricalServiceIdentifiersResponse)(nil), // 23: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
-	(*RemoveServiceArgs)(nil),                                  // 24: api_container_api.RemoveServiceArgs
-	(*RemoveServiceResponse)(nil),                              // 25: api_container_api.RemoveServiceResponse
-	(*RepartitionArgs)(nil),                                    // 26: api_container_api.RepartitionArgs
-	(*PartitionServices)(nil),                                  // 27: api_container_api.PartitionServices
-	(*PartitionConnections)(nil),                               // 28: api_container_api.PartitionConnections
-	(*PartitionConnectionInfo)(nil),                            // 29: api_container_api.PartitionConnectionInfo
-	(*ExecCommandArgs)(nil),                                    // 30: api_container_api.ExecCommandArgs
-	(*PauseServiceArgs)(nil),                                   // 31: api_container_api.PauseServiceArgs
-	(*UnpauseServiceArgs)(nil),                                 // 32: api_container_api.UnpauseServiceArgs
-	(*ExecCommandResponse)(nil),                                // 33: api_container_api.ExecCommandResponse
-	(*WaitForHttpGetEndpointAvailabilityArgs)(nil),             // 34: api_container_api.WaitForHttpGetEndpointAvailabilityArgs
-	(*WaitForHttpPostEndpointAvailabilityArgs)(nil),            // 35: api_container_api.WaitForHttpPostEndpointAvailabilityArgs
-	(*UploadFilesArtifactArgs)(nil),                            // 36: api_container_api.UploadFilesArtifactArgs
-	(*UploadFilesArtifactResponse)(nil),                        // 37: api_container_api.UploadFilesArtifactResponse
-	(*DownloadFilesArtifactArgs)(nil),                          // 38: api_container_api.DownloadFilesArtifactArgs
-	(*DownloadFilesArtifactResponse)(nil),                      // 39: api_container_api.DownloadFilesArtifactResponse
-	(*StoreWebFilesArtifactArgs)(nil),                          // 40: api_container_api.StoreWebFilesArtifactArgs
-	(*StoreWebFilesArtifactResponse)(nil),                      // 41: api_container_api.StoreWebFilesArtifactResponse
-	(*StoreFilesArtifactFromServiceArgs)(nil),                  // 42: api_container_api.StoreFilesArtifactFromServiceArgs
-	(*StoreFilesArtifactFromServiceResponse)(nil),              // 43: api_container_api.StoreFilesArtifactFromServiceResponse
-	(*RenderTemplatesToFilesArtifactArgs)(nil),                 // 44: api_container_api.RenderTemplatesToFilesArtifactArgs
-	(*RenderTemplatesToFilesArtifactResponse)(nil),             // 45: api_container_api.RenderTemplatesToFilesArtifactResponse
-	(*FilesArtifactNameAndUuid)(nil),                           // 46: api_container_api.FilesArtifactNameAndUuid
-	(*ListFilesArtifactNamesAndUuidsResponse)(nil),             // 47: api_container_api.ListFilesArtifactNamesAndUuidsResponse
-	nil, // 48: api_container_api.ServiceInfo.PrivatePortsEntry
-	nil, // 49: api_container_api.ServiceInfo.MaybePublicPortsEntry
-	nil, // 50: api_container_api.ServiceConfig.PrivatePortsEntry
-	nil, // 51: api_container_api.ServiceConfig.PublicPortsEntry
-	nil, // 52: api_container_api.ServiceConfig.EnvVarsEntry
-	nil, // 53: api_container_api.ServiceConfig.FilesArtifactMountpointsEntry
-	nil, // 54: api_container_api.StartServicesArgs.ServiceNamesToConfigsEntry
-	nil, // 55: api_container_api.StartServicesResponse.SuccessfulServiceNameToServiceInfoEntry
-	nil, // 56: api_container_api.StartServicesResponse.FailedServiceNameToErrorEntry
-	nil, // 57: api_container_api.GetServicesArgs.ServiceIdentifiersEntry
-	nil, // 58: api_container_api.GetServicesResponse.ServiceInfoEntry
-	nil, // 59: api_container_api.RepartitionArgs.PartitionServicesEntry
-	nil, // 60: api_container_api.RepartitionArgs.PartitionConnectionsEntry
-	nil, // 61: api_container_api.PartitionServices.ServiceNameSetEntry
-	nil, // 62: api_container_api.PartitionConnections.ConnectionInfoEntry
-	(*RenderTemplatesToFilesArtifactArgs_TemplateAndData)(nil), // 63: api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData
-	nil,                   // 64: api_container_api.RenderTemplatesToFilesArtifactArgs.TemplatesAndDataByDestinationRelFilepathEntry
-	(*emptypb.Empty)(nil), // 65: google.protobuf.Empty
+func (x *PartitionConnectionInfo) GetPacketDelayMeanMs() uint32 {
+	if x != nil {
+		return x.PacketDelayMeanMs
+	}
+	return 0
 }
-var file_api_container_service_proto_depIdxs = []int32{
-	0,  // 0: api_container_api.Port.transport_protocol:type_name -> api_container_api.Port.TransportProtocol
-	48, // 1: api_container_api.ServiceInfo.private_ports:type_name -> api_container_api.ServiceInfo.PrivatePortsEntry
-	49, // 2: api_container_api.ServiceInfo.maybe_public_ports:type_name -> api_container_api.ServiceInfo.MaybePublicPortsEntry
-	50, // 3: api_container_api.ServiceConfig.private_ports:type_name -> api_container_api.ServiceConfig.PrivatePortsEntry
-	51, // 4: api_container_api.ServiceConfig.public_ports:type_name -> api_container_api.ServiceConfig.PublicPortsEntry
-	52, // 5: api_container_api.ServiceConfig.env_vars:type_name -> api_container_api.ServiceConfig.EnvVarsEntry
-	53, // 6: api_container_api.ServiceConfig.files_artifact_mountpoints:type_name -> api_container_api.ServiceConfig.FilesArtifactMountpointsEntry
-	8,  // 7: api_container_api.StarlarkRunResponseLine.instruction:type_name -> api_container_api.StarlarkInstruction
-	12, // 8: api_container_api.StarlarkRunResponseLine.error:type_name -> api_container_api.StarlarkError
-	16, // 9: api_container_api.StarlarkRunResponseLine.progress_info:type_name -> api_container_api.StarlarkRunProgress
-	9,  // 10: api_container_api.StarlarkRunResponseLine.instruction_result:type_name -> api_container_api.StarlarkInstructionResult
-	17, // 11: api_container_api.StarlarkRunResponseLine.run_finished_event:type_name -> api_container_api.StarlarkRunFinishedEvent
-	11, // 12: api_container_api.StarlarkInstruction.position:type_name -> api_container_api.StarlarkInstructionPosition
-	10, // 13: api_container_api.StarlarkInstruction.arguments:type_name -> api_container_api.StarlarkInstructionArg
-	13, // 14: api_container_api.StarlarkError.interpretation_error:type_name -> api_container_api.StarlarkInterpretationError
-	14, // 15: api_container_api.StarlarkError.validation_error:type_name -> api_container_api.StarlarkValidationError
-	15, // 16: api_container_api.StarlarkError.execution_error:type_name -> api_container_api.StarlarkExecutionError
-	54, // 17: api_container_api.StartServicesArgs.service_names_to_configs:type_name -> api_container_api.StartServicesArgs.ServiceNamesToConfigsEntry
-	55, // 18: api_container_api.StartServicesResponse.successful_service_name_to_service_info:type_name -> api_container_api.StartServicesResponse.SuccessfulServiceNameToServiceInfoEntry
-	56, // 19: api_container_api.StartServicesResponse.failed_service_name_to_error:type_name -> api_container_api.StartServicesResponse.FailedServiceNameToErrorEntry
-	57, // 20: api_container_api.GetServicesArgs.service_identifiers:type_name -> api_container_api.GetServicesArgs.ServiceIdentifiersEntry
-	58, // 21: api_container_api.GetServicesResponse.service_info:type_name -> api_container_api.GetServicesResponse.ServiceInfoEntry
-	22, // 22: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.allIdentifiers:type_name -> api_container_api.ServiceIdentifiers
-	59, // 23: api_container_api.RepartitionArgs.partition_services:type_name -> api_container_api.RepartitionArgs.PartitionServicesEntry
-	60, // 24: api_container_api.RepartitionArgs.partition_connections:type_name -> api_container_api.RepartitionArgs.PartitionConnectionsEntry
-	29, // 25: api_container_api.RepartitionArgs.default_connection:type_name -> api_container_api.PartitionConnectionInfo
-	61, // 26: api_container_api.PartitionServices.service_name_set:type_name -> api_container_api.PartitionServices.ServiceNameSetEntry
-	62, // 27: api_container_api.PartitionConnections.connection_info:type_name -> api_container_api.PartitionConnections.ConnectionInfoEntry
-	64, // 28: api_container_api.RenderTemplatesToFilesArtifactArgs.templates_and_data_by_destination_rel_filepath:type_name -> api_container_api.RenderTemplatesToFilesArtifactArgs.TemplatesAndDataByDestinationRelFilepathEntry
-	46, // 29: api_container_api.ListFilesArtifactNamesAndUuidsResponse.file_names_and_uuids:type_name -> api_container_api.FilesArtifactNameAndUuid
-	1,  // 30: api_container_api.ServiceInfo.PrivatePortsEntry.value:type_name -> api_container_api.Port
-	1,  // 31: api_container_api.ServiceInfo.MaybePublicPortsEntry.value:type_name -> api_container_api.Port
-	1,  // 32: api_container_api.ServiceConfig.PrivatePortsEntry.value:type_name -> api_container_api.Port
-	1,  // 33: api_container_api.ServiceConfig.PublicPortsEntry.value:type_name -> api_container_api.Port
-	3,  // 34: api_container_api.StartServicesArgs.ServiceNamesToConfigsEntry.value:type_name -> api_container_api.ServiceConfig
-	2,  // 35: api_container_api.StartServicesResponse.SuccessfulServiceNameToServiceInfoEntry.value:type_name -> api_container_api.ServiceInfo
-	2,  // 36: api_container_api.GetServicesResponse.ServiceInfoEntry.value:type_name -> api_container_api.ServiceInfo
-	27, // 37: api_container_api.RepartitionArgs.PartitionServicesEntry.value:type_name -> api_container_api.PartitionServices
-	28, // 38: api_container_api.RepartitionArgs.PartitionConnectionsEntry.value:type_name -> api_container_api.PartitionConnections
-	29, // 39: api_container_api.PartitionConnections.ConnectionInfoEntry.value:type_name -> api_container_api.PartitionConnectionInfo
-	63, // 40: api_container_api.RenderTemplatesToFilesArtifactArgs.TemplatesAndDataByDestinationRelFilepathEntry.value:type_name -> api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData
-	5,  // 41: api_container_api.ApiContainerService.RunStarlarkScript:input_type -> api_container_api.RunStarlarkScriptArgs
-	6,  // 42: api_container_api.ApiContainerService.RunStarlarkPackage:input_type -> api_container_api.RunStarlarkPackageArgs
-	18, // 43: api_container_api.ApiContainerService.StartServices:input_type -> api_container_api.StartServicesArgs
-	20, // 44: api_container_api.ApiContainerService.GetServices:input_type -> api_container_api.GetServicesArgs
-	65, // 45: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:input_type -> google.protobuf.Empty
-	24, // 46: api_container_api.ApiContainerService.RemoveService:input_type -> api_container_api.RemoveServiceArgs
-	26, // 47: api_container_api.ApiContainerService.Repartition:input_type -> api_container_api.RepartitionArgs
-	30, // 48: api_container_api.ApiContainerService.ExecCommand:input_type -> api_container_api.ExecCommandArgs
-	31, // 49: api_container_api.ApiContainerService.PauseService:input_type -> api_container_api.PauseServiceArgs
-	32, // 50: api_container_api.ApiContainerService.UnpauseService:input_type -> api_container_api.UnpauseServiceArgs
-	34, // 51: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:input_type -> api_container_api.WaitForHttpGetEndpointAvailabilityArgs
-	35, // 52: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:input_type -> api_container_api.WaitForHttpPostEndpointAvailabilityArgs
-	36, // 53: api_container_api.ApiContainerService.UploadFilesArtifact:input_type -> api_container_api.UploadFilesArtifactArgs
-	38, // 54: api_container_api.ApiContainerService.DownloadFilesArtifact:input_type -> api_container_api.DownloadFilesArtifactArgs
-	40, // 55: api_container_api.ApiContainerService.StoreWebFilesArtifact:input_type -> api_container_api.StoreWebFilesArtifactArgs
-	42, // 56: api_container_api.ApiContainerService.StoreFilesArtifactFromService:input_type -> api_container_api.StoreFilesArtifactFromServiceArgs
-	44, // 57: api_container_api.ApiContainerService.RenderTemplatesToFilesArtifact:input_type -> api_container_api.RenderTemplatesToFilesArtifactArgs
-	65, // 58: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:input_type -> google.protobuf.Empty
-	7,  // 59: api_container_api.ApiContainerService.RunStarlarkScript:output_type -> api_container_api.StarlarkRunResponseLine
-	7,  // 60: api_container_api.ApiContainerService.RunStarlarkPackage:output_type -> api_container_api.StarlarkRunResponseLine
-	19, // 61: api_container_api.ApiContainerService.StartServices:output_type -> api_container_api.StartServicesResponse
-	21, // 62: api_container_api.ApiContainerService.GetServices:output_type -> api_container_api.GetServicesResponse
-	23, // 63: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:output_type -> api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
-	25, // 64: api_container_api.ApiContainerService.RemoveService:output_type -> api_container_api.RemoveServiceResponse
-	65, // 65: api_container_api.ApiContainerService.Repartition:output_type -> google.protobuf.Empty
-	33, // 66: api_container_api.ApiContainerService.ExecCommand:output_type -> api_container_api.ExecCommandResponse
-	65, // 67: api_container_api.ApiContainerService.PauseService:output_type -> google.protobuf.Empty
-	65, // 68: api_container_api.ApiContainerService.UnpauseService:output_type -> google.protobuf.Empty
-	65, // 69: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:output_type -> google.protobuf.Empty
-	65, // 70: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:output_type -> google.protobuf.Empty
-	37, // 71: api_container_api.ApiContainerService.UploadFilesArtifact:output_type -> api_container_api.UploadFilesArtifactResponse
-	39, // 72: api_container_api.ApiContainerService.DownloadFilesArtifact:output_type -> api_container_api.DownloadFilesArtifactResponse
-	41, // 73: api_container_api.ApiContainerService.StoreWebFilesArtifact:output_type -> api_container_api.StoreWebFilesArtifactResponse
-	43, // 74: api_container_api.ApiContainerService.StoreFilesArtifactFromService:output_type -> api_container_api.StoreFilesArtifactFromServiceResponse
-	45, // 75: api_container_api.ApiContainerService.RenderTemplatesToFilesArtifact:output_type -> api_container_api.RenderTemplatesToFilesArtifactResponse
-	47, // 76: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:output_type -> api_container_api.ListFilesArtifactNamesAndUuidsResponse
-	59, // [59:77] is the sub-list for method output_type
-	41, // [41:59] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+
+func (x *PartitionConnectionInfo) GetPacketDelayStdDevMs() uint32 {
+	if x != nil {
+		return x.PacketDelayStdDevMs
+	}
+	return 0
 }
 
-func init() { file_api_container_service_proto_init() }
-func file_api_container_service_proto_init() {
-	if File_api_container_service_proto != nil {
-		return
+func (x *PartitionConnectionInfo) GetPacketDelayCorrelation() float32 {
+	if x != nil {
+		return x.PacketDelayCorrelation
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_container_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Port); i {
+	return 0
+}
+
+func (x *PartitionConnectionInfo) GetBandwidthLimitKbps() uint32 {
+	if x != nil {
+		return x.BandwidthLimitKbps
+	}
+	return 0
+}
+
+// ==============================================================================================
+//
+//	Exec Command
+//
+// ==============================================================================================
+type ExecCommandArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service identifier of the container that the command should be executed in
+	ServiceIdentifier string   `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	CommandArgs       []string `protobuf:"bytes,2,rep,name=command_args,json=commandArgs,proto3" json:"command_args,omitempty"`
+}
+
+func (x *ExecCommandArgs) Reset() {
+	*x = ExecCommandArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecCommandArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCommandArgs) ProtoMessage() {}
+
+func (x *ExecCommandArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCommandArgs.ProtoReflect.Descriptor instead.
+func (*ExecCommandArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExecCommandArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *ExecCommandArgs) GetCommandArgs() []string {
+	if x != nil {
+		return x.CommandArgs
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Pause/Unpause Service
+//
+// ==============================================================================================
+type PauseServiceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service identifier of the container that should be paused
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+}
+
+func (x *PauseServiceArgs) Reset() {
+	*x = PauseServiceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseServiceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseServiceArgs) ProtoMessage() {}
+
+func (x *PauseServiceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseServiceArgs.ProtoReflect.Descriptor instead.
+func (*PauseServiceArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PauseServiceArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+type UnpauseServiceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service identifier of the container that should be unpaused
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+}
+
+func (x *UnpauseServiceArgs) Reset() {
+	*x = UnpauseServiceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpauseServiceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseServiceArgs) ProtoMessage() {}
+
+func (x *UnpauseServiceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseServiceArgs.ProtoReflect.Descriptor instead.
+func (*UnpauseServiceArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnpauseServiceArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+type PauseServicesArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "Set" of identifiers of the services whose containers should be paused
+	ServiceIdentifiers map[string]bool `protobuf:"bytes,1,rep,name=service_identifiers,json=serviceIdentifiers,proto3" json:"service_identifiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Pause the containers of all the services of the enclave, ignoring the service identifiers
+	AllServices bool `protobuf:"varint,2,opt,name=all_services,json=allServices,proto3" json:"all_services,omitempty"`
+}
+
+func (x *PauseServicesArgs) Reset() {
+	*x = PauseServicesArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseServicesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseServicesArgs) ProtoMessage() {}
+
+func (x *PauseServicesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseServicesArgs.ProtoReflect.Descriptor instead.
+func (*PauseServicesArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PauseServicesArgs) GetServiceIdentifiers() map[string]bool {
+	if x != nil {
+		return x.ServiceIdentifiers
+	}
+	return nil
+}
+
+func (x *PauseServicesArgs) GetAllServices() bool {
+	if x != nil {
+		return x.AllServices
+	}
+	return false
+}
+
+type PauseServicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "Set" of names of the services that were successfully paused
+	SuccessfulServiceNames map[string]bool `protobuf:"bytes,1,rep,name=successful_service_names,json=successfulServiceNames,proto3" json:"successful_service_names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Mapping from name of the services that failed to be paused to the error
+	FailedServiceNameToError map[string]string `protobuf:"bytes,2,rep,name=failed_service_name_to_error,json=failedServiceNameToError,proto3" json:"failed_service_name_to_error,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PauseServicesResponse) Reset() {
+	*x = PauseServicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseServicesResponse) ProtoMessage() {}
+
+func (x *PauseServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseServicesResponse.ProtoReflect.Descriptor instead.
+func (*PauseServicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PauseServicesResponse) GetSuccessfulServiceNames() map[string]bool {
+	if x != nil {
+		return x.SuccessfulServiceNames
+	}
+	return nil
+}
+
+func (x *PauseServicesResponse) GetFailedServiceNameToError() map[string]string {
+	if x != nil {
+		return x.FailedServiceNameToError
+	}
+	return nil
+}
+
+type UnpauseServicesArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "Set" of identifiers of the services whose containers should be unpaused
+	ServiceIdentifiers map[string]bool `protobuf:"bytes,1,rep,name=service_identifiers,json=serviceIdentifiers,proto3" json:"service_identifiers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Unpause the containers of all the services of the enclave, ignoring the service identifiers
+	AllServices bool `protobuf:"varint,2,opt,name=all_services,json=allServices,proto3" json:"all_services,omitempty"`
+}
+
+func (x *UnpauseServicesArgs) Reset() {
+	*x = UnpauseServicesArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpauseServicesArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseServicesArgs) ProtoMessage() {}
+
+func (x *UnpauseServicesArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseServicesArgs.ProtoReflect.Descriptor instead.
+func (*UnpauseServicesArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UnpauseServicesArgs) GetServiceIdentifiers() map[string]bool {
+	if x != nil {
+		return x.ServiceIdentifiers
+	}
+	return nil
+}
+
+func (x *UnpauseServicesArgs) GetAllServices() bool {
+	if x != nil {
+		return x.AllServices
+	}
+	return false
+}
+
+type UnpauseServicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "Set" of names of the services that were successfully unpaused
+	SuccessfulServiceNames map[string]bool `protobuf:"bytes,1,rep,name=successful_service_names,json=successfulServiceNames,proto3" json:"successful_service_names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Mapping from name of the services that failed to be unpaused to the error
+	FailedServiceNameToError map[string]string `protobuf:"bytes,2,rep,name=failed_service_name_to_error,json=failedServiceNameToError,proto3" json:"failed_service_name_to_error,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UnpauseServicesResponse) Reset() {
+	*x = UnpauseServicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpauseServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpauseServicesResponse) ProtoMessage() {}
+
+func (x *UnpauseServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpauseServicesResponse.ProtoReflect.Descriptor instead.
+func (*UnpauseServicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnpauseServicesResponse) GetSuccessfulServiceNames() map[string]bool {
+	if x != nil {
+		return x.SuccessfulServiceNames
+	}
+	return nil
+}
+
+func (x *UnpauseServicesResponse) GetFailedServiceNameToError() map[string]string {
+	if x != nil {
+		return x.FailedServiceNameToError
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Wait For Service Exit
+//
+// ==============================================================================================
+type WaitForServiceExitArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+}
+
+func (x *WaitForServiceExitArgs) Reset() {
+	*x = WaitForServiceExitArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForServiceExitArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForServiceExitArgs) ProtoMessage() {}
+
+func (x *WaitForServiceExitArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForServiceExitArgs.ProtoReflect.Descriptor instead.
+func (*WaitForServiceExitArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WaitForServiceExitArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+type WaitForServiceExitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode int32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+}
+
+func (x *WaitForServiceExitResponse) Reset() {
+	*x = WaitForServiceExitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForServiceExitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForServiceExitResponse) ProtoMessage() {}
+
+func (x *WaitForServiceExitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForServiceExitResponse.ProtoReflect.Descriptor instead.
+func (*WaitForServiceExitResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WaitForServiceExitResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type ExecCommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExitCode int32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Assumes UTF-8 encoding
+	LogOutput string `protobuf:"bytes,2,opt,name=log_output,json=logOutput,proto3" json:"log_output,omitempty"`
+}
+
+func (x *ExecCommandResponse) Reset() {
+	*x = ExecCommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCommandResponse) ProtoMessage() {}
+
+func (x *ExecCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCommandResponse.ProtoReflect.Descriptor instead.
+func (*ExecCommandResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ExecCommandResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecCommandResponse) GetLogOutput() string {
+	if x != nil {
+		return x.LogOutput
+	}
+	return ""
+}
+
+type ExecCommandStreamedOutputChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Chunk:
+	//	*ExecCommandStreamedOutputChunk_Output
+	//	*ExecCommandStreamedOutputChunk_ExitCode
+	Chunk isExecCommandStreamedOutputChunk_Chunk `protobuf_oneof:"chunk"`
+}
+
+func (x *ExecCommandStreamedOutputChunk) Reset() {
+	*x = ExecCommandStreamedOutputChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecCommandStreamedOutputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecCommandStreamedOutputChunk) ProtoMessage() {}
+
+func (x *ExecCommandStreamedOutputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecCommandStreamedOutputChunk.ProtoReflect.Descriptor instead.
+func (*ExecCommandStreamedOutputChunk) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (m *ExecCommandStreamedOutputChunk) GetChunk() isExecCommandStreamedOutputChunk_Chunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (x *ExecCommandStreamedOutputChunk) GetOutput() []byte {
+	if x, ok := x.GetChunk().(*ExecCommandStreamedOutputChunk_Output); ok {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *ExecCommandStreamedOutputChunk) GetExitCode() int32 {
+	if x, ok := x.GetChunk().(*ExecCommandStreamedOutputChunk_ExitCode); ok {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type isExecCommandStreamedOutputChunk_Chunk interface {
+	isExecCommandStreamedOutputChunk_Chunk()
+}
+
+type ExecCommandStreamedOutputChunk_Output struct {
+	// A piece of the combined stdout and stderr of the command, in the order it got produced
+	Output []byte `protobuf:"bytes,1,opt,name=output,proto3,oneof"`
+}
+
+type ExecCommandStreamedOutputChunk_ExitCode struct {
+	// Sent in the last message of the stream, once the command has exited
+	ExitCode int32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3,oneof"`
+}
+
+func (*ExecCommandStreamedOutputChunk_Output) isExecCommandStreamedOutputChunk_Chunk() {}
+
+func (*ExecCommandStreamedOutputChunk_ExitCode) isExecCommandStreamedOutputChunk_Chunk() {}
+
+// ==============================================================================================
+//
+//	Get Service Metrics
+//
+// ==============================================================================================
+type GetServiceMetricsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service identifier of the container whose resource usage should be returned
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+}
+
+func (x *GetServiceMetricsArgs) Reset() {
+	*x = GetServiceMetricsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceMetricsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceMetricsArgs) ProtoMessage() {}
+
+func (x *GetServiceMetricsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceMetricsArgs.ProtoReflect.Descriptor instead.
+func (*GetServiceMetricsArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetServiceMetricsArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+type GetServiceMetricsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When the snapshot got taken
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Share of the host CPUs used by the service, where 100 is one full CPU
+	CpuUsagePercent float64 `protobuf:"fixed64,2,opt,name=cpu_usage_percent,json=cpuUsagePercent,proto3" json:"cpu_usage_percent,omitempty"`
+	// Memory used by the service, leaving out the page cache
+	MemoryUsageBytes uint64 `protobuf:"varint,3,opt,name=memory_usage_bytes,json=memoryUsageBytes,proto3" json:"memory_usage_bytes,omitempty"`
+	// Memory the service can use before getting killed; the host memory for services without a memory limit
+	MemoryLimitBytes uint64 `protobuf:"varint,4,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	// Bytes received and sent by the service across all its network interfaces
+	NetworkRxBytes uint64 `protobuf:"varint,5,opt,name=network_rx_bytes,json=networkRxBytes,proto3" json:"network_rx_bytes,omitempty"`
+	NetworkTxBytes uint64 `protobuf:"varint,6,opt,name=network_tx_bytes,json=networkTxBytes,proto3" json:"network_tx_bytes,omitempty"`
+}
+
+func (x *GetServiceMetricsResponse) Reset() {
+	*x = GetServiceMetricsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceMetricsResponse) ProtoMessage() {}
+
+func (x *GetServiceMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetServiceMetricsResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *GetServiceMetricsResponse) GetCpuUsagePercent() float64 {
+	if x != nil {
+		return x.CpuUsagePercent
+	}
+	return 0
+}
+
+func (x *GetServiceMetricsResponse) GetMemoryUsageBytes() uint64 {
+	if x != nil {
+		return x.MemoryUsageBytes
+	}
+	return 0
+}
+
+func (x *GetServiceMetricsResponse) GetMemoryLimitBytes() uint64 {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return 0
+}
+
+func (x *GetServiceMetricsResponse) GetNetworkRxBytes() uint64 {
+	if x != nil {
+		return x.NetworkRxBytes
+	}
+	return 0
+}
+
+func (x *GetServiceMetricsResponse) GetNetworkTxBytes() uint64 {
+	if x != nil {
+		return x.NetworkTxBytes
+	}
+	return 0
+}
+
+// ==============================================================================================
+//
+//	Wait For HTTP Get Endpoint Availability
+//
+// ==============================================================================================
+type WaitForHttpGetEndpointAvailabilityArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the service to check.
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// The port of the service to check. For instance 8080
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The number of milliseconds to wait until executing the first HTTP call
+	InitialDelayMilliseconds uint32 `protobuf:"varint,4,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3" json:"initial_delay_milliseconds,omitempty"`
+	// Max number of HTTP call attempts that this will execute until giving up and returning an error
+	Retries uint32 `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+	// Number of milliseconds to wait between retries
+	RetriesDelayMilliseconds uint32 `protobuf:"varint,6,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3" json:"retries_delay_milliseconds,omitempty"`
+	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
+	BodyText string `protobuf:"bytes,7,opt,name=body_text,json=bodyText,proto3" json:"body_text,omitempty"`
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) Reset() {
+	*x = WaitForHttpGetEndpointAvailabilityArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForHttpGetEndpointAvailabilityArgs) ProtoMessage() {}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForHttpGetEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
+func (*WaitForHttpGetEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
+	if x != nil {
+		return x.InitialDelayMilliseconds
+	}
+	return 0
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
+	if x != nil {
+		return x.RetriesDelayMilliseconds
+	}
+	return 0
+}
+
+func (x *WaitForHttpGetEndpointAvailabilityArgs) GetBodyText() string {
+	if x != nil {
+		return x.BodyText
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Wait For HTTP Post Endpoint Availability
+//
+// ==============================================================================================
+type WaitForHttpPostEndpointAvailabilityArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the service to check.
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// The port of the service to check. For instance 8080
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The path of the service to check. It mustn't start with the first slash. For instance `service/health`
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The content of the request body.
+	RequestBody string `protobuf:"bytes,4,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	// The number of milliseconds to wait until executing the first HTTP call
+	InitialDelayMilliseconds uint32 `protobuf:"varint,5,opt,name=initial_delay_milliseconds,json=initialDelayMilliseconds,proto3" json:"initial_delay_milliseconds,omitempty"`
+	// Max number of HTTP call attempts that this will execute until giving up and returning an error
+	Retries uint32 `protobuf:"varint,6,opt,name=retries,proto3" json:"retries,omitempty"`
+	// Number of milliseconds to wait between retries
+	RetriesDelayMilliseconds uint32 `protobuf:"varint,7,opt,name=retries_delay_milliseconds,json=retriesDelayMilliseconds,proto3" json:"retries_delay_milliseconds,omitempty"`
+	// If the endpoint returns this value, the service will be marked as available (e.g. Hello World).
+	BodyText string `protobuf:"bytes,8,opt,name=body_text,json=bodyText,proto3" json:"body_text,omitempty"`
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) Reset() {
+	*x = WaitForHttpPostEndpointAvailabilityArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForHttpPostEndpointAvailabilityArgs) ProtoMessage() {}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForHttpPostEndpointAvailabilityArgs.ProtoReflect.Descriptor instead.
+func (*WaitForHttpPostEndpointAvailabilityArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRequestBody() string {
+	if x != nil {
+		return x.RequestBody
+	}
+	return ""
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetInitialDelayMilliseconds() uint32 {
+	if x != nil {
+		return x.InitialDelayMilliseconds
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetRetriesDelayMilliseconds() uint32 {
+	if x != nil {
+		return x.RetriesDelayMilliseconds
+	}
+	return 0
+}
+
+func (x *WaitForHttpPostEndpointAvailabilityArgs) GetBodyText() string {
+	if x != nil {
+		return x.BodyText
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Upload Files Artifact
+//
+// ==============================================================================================
+type UploadFilesArtifactArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes of the files artifact to store
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Name of the files artifact
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Hex-encoded SHA-256 of the data. If the enclave already stores a files artifact with the same contents, the name is
+	// given to it and the data isn't needed, so it can be left empty to skip uploading it
+	ContentHash *string `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`
+}
+
+func (x *UploadFilesArtifactArgs) Reset() {
+	*x = UploadFilesArtifactArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFilesArtifactArgs) ProtoMessage() {}
+
+func (x *UploadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*UploadFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UploadFilesArtifactArgs) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadFilesArtifactArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadFilesArtifactArgs) GetContentHash() string {
+	if x != nil && x.ContentHash != nil {
+		return *x.ContentHash
+	}
+	return ""
+}
+
+type UploadFilesArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the files artifact, for use when referencing it in the future
+	// Empty if only the content hash got sent and the enclave doesn't store these contents yet, in which case the data
+	// has to be uploaded
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// UUID of the files artifact, for use when referencing it in the future
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the data didn't need storing because the enclave already stores a files artifact with the given content
+	// hash, which the name was given to
+	IsAlreadyStored bool `protobuf:"varint,3,opt,name=is_already_stored,json=isAlreadyStored,proto3" json:"is_already_stored,omitempty"`
+}
+
+func (x *UploadFilesArtifactResponse) Reset() {
+	*x = UploadFilesArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFilesArtifactResponse) ProtoMessage() {}
+
+func (x *UploadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*UploadFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UploadFilesArtifactResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UploadFilesArtifactResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadFilesArtifactResponse) GetIsAlreadyStored() bool {
+	if x != nil {
+		return x.IsAlreadyStored
+	}
+	return false
+}
+
+// ==============================================================================================
+//
+//	Download Files Artifact
+//
+// ==============================================================================================
+type DownloadFilesArtifactArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Files identifier to get bytes for
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (x *DownloadFilesArtifactArgs) Reset() {
+	*x = DownloadFilesArtifactArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFilesArtifactArgs) ProtoMessage() {}
+
+func (x *DownloadFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*DownloadFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DownloadFilesArtifactArgs) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type DownloadFilesArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Contents of the requested files artifact
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DownloadFilesArtifactResponse) Reset() {
+	*x = DownloadFilesArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFilesArtifactResponse) ProtoMessage() {}
+
+func (x *DownloadFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*DownloadFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DownloadFilesArtifactResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Files Artifact Stream
+//
+// ==============================================================================================
+// A piece of the contents of a files artifact being streamed, in order
+type FilesArtifactStreamChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bytes of the files artifact that follow the ones of the previous chunk
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Name of the files artifact being uploaded; only read from the first chunk of an upload, and auto-generated if empty
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FilesArtifactStreamChunk) Reset() {
+	*x = FilesArtifactStreamChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesArtifactStreamChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesArtifactStreamChunk) ProtoMessage() {}
+
+func (x *FilesArtifactStreamChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesArtifactStreamChunk.ProtoReflect.Descriptor instead.
+func (*FilesArtifactStreamChunk) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FilesArtifactStreamChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FilesArtifactStreamChunk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Store Web Files Artifact
+//
+// ==============================================================================================
+type StoreWebFilesArtifactArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL to download the artifact from
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The name of the files artifact
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StoreWebFilesArtifactArgs) Reset() {
+	*x = StoreWebFilesArtifactArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreWebFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreWebFilesArtifactArgs) ProtoMessage() {}
+
+func (x *StoreWebFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreWebFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*StoreWebFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *StoreWebFilesArtifactArgs) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *StoreWebFilesArtifactArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StoreWebFilesArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the files artifact, for use when referencing it in the future
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *StoreWebFilesArtifactResponse) Reset() {
+	*x = StoreWebFilesArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreWebFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreWebFilesArtifactResponse) ProtoMessage() {}
+
+func (x *StoreWebFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreWebFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*StoreWebFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StoreWebFilesArtifactResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type StoreFilesArtifactFromServiceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier that will be used to identify the service where the source files will be copied from
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// The absolute source path where the source files will be copied from
+	SourcePath string `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	// The name of the files artifact
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) Reset() {
+	*x = StoreFilesArtifactFromServiceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreFilesArtifactFromServiceArgs) ProtoMessage() {}
+
+func (x *StoreFilesArtifactFromServiceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreFilesArtifactFromServiceArgs.ProtoReflect.Descriptor instead.
+func (*StoreFilesArtifactFromServiceArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *StoreFilesArtifactFromServiceArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StoreFilesArtifactFromServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the files artifact, for use when referencing it in the future
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *StoreFilesArtifactFromServiceResponse) Reset() {
+	*x = StoreFilesArtifactFromServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreFilesArtifactFromServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreFilesArtifactFromServiceResponse) ProtoMessage() {}
+
+func (x *StoreFilesArtifactFromServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreFilesArtifactFromServiceResponse.ProtoReflect.Descriptor instead.
+func (*StoreFilesArtifactFromServiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StoreFilesArtifactFromServiceResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type RenderTemplatesToFilesArtifactArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A map of template and its data by the path of the file relative to the root of the files artifact it will be rendered into.
+	TemplatesAndDataByDestinationRelFilepath map[string]*RenderTemplatesToFilesArtifactArgs_TemplateAndData `protobuf:"bytes,1,rep,name=templates_and_data_by_destination_rel_filepath,json=templatesAndDataByDestinationRelFilepath,proto3" json:"templates_and_data_by_destination_rel_filepath,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Name of the files artifact
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs) Reset() {
+	*x = RenderTemplatesToFilesArtifactArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplatesToFilesArtifactArgs) ProtoMessage() {}
+
+func (x *RenderTemplatesToFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplatesToFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*RenderTemplatesToFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs) GetTemplatesAndDataByDestinationRelFilepath() map[string]*RenderTemplatesToFilesArtifactArgs_TemplateAndData {
+	if x != nil {
+		return x.TemplatesAndDataByDestinationRelFilepath
+	}
+	return nil
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenderTemplatesToFilesArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID of the files artifact, for use when referencing it in the future
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *RenderTemplatesToFilesArtifactResponse) Reset() {
+	*x = RenderTemplatesToFilesArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderTemplatesToFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplatesToFilesArtifactResponse) ProtoMessage() {}
+
+func (x *RenderTemplatesToFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplatesToFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*RenderTemplatesToFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *RenderTemplatesToFilesArtifactResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type FilesArtifactNameAndUuid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A string representing the name of the file
+	FileName string `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	// A string representing the uuid of the file
+	FileUuid string `protobuf:"bytes,2,opt,name=fileUuid,proto3" json:"fileUuid,omitempty"`
+	// The size of the compressed files artifact
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// When the contents of the files artifact were stored; artifacts with the same contents share them
+	CreationTimeUnixSeconds int64 `protobuf:"varint,4,opt,name=creation_time_unix_seconds,json=creationTimeUnixSeconds,proto3" json:"creation_time_unix_seconds,omitempty"`
+}
+
+func (x *FilesArtifactNameAndUuid) Reset() {
+	*x = FilesArtifactNameAndUuid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesArtifactNameAndUuid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesArtifactNameAndUuid) ProtoMessage() {}
+
+func (x *FilesArtifactNameAndUuid) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesArtifactNameAndUuid.ProtoReflect.Descriptor instead.
+func (*FilesArtifactNameAndUuid) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *FilesArtifactNameAndUuid) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FilesArtifactNameAndUuid) GetFileUuid() string {
+	if x != nil {
+		return x.FileUuid
+	}
+	return ""
+}
+
+func (x *FilesArtifactNameAndUuid) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *FilesArtifactNameAndUuid) GetCreationTimeUnixSeconds() int64 {
+	if x != nil {
+		return x.CreationTimeUnixSeconds
+	}
+	return 0
+}
+
+type ListFilesArtifactNamesAndUuidsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileNamesAndUuids []*FilesArtifactNameAndUuid `protobuf:"bytes,1,rep,name=file_names_and_uuids,json=fileNamesAndUuids,proto3" json:"file_names_and_uuids,omitempty"`
+}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) Reset() {
+	*x = ListFilesArtifactNamesAndUuidsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesArtifactNamesAndUuidsResponse) ProtoMessage() {}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesArtifactNamesAndUuidsResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesArtifactNamesAndUuidsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListFilesArtifactNamesAndUuidsResponse) GetFileNamesAndUuids() []*FilesArtifactNameAndUuid {
+	if x != nil {
+		return x.FileNamesAndUuids
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Delete Files Artifact
+//
+// ==============================================================================================
+type DeleteFilesArtifactArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID, shortened UUID, or name of the files artifact to delete
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (x *DeleteFilesArtifactArgs) Reset() {
+	*x = DeleteFilesArtifactArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesArtifactArgs) ProtoMessage() {}
+
+func (x *DeleteFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*DeleteFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteFilesArtifactArgs) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Inspect Files Artifact Contents
+//
+// ==============================================================================================
+type InspectFilesArtifactContentsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UUID, shortened UUID, or name of the files artifact to inspect
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (x *InspectFilesArtifactContentsArgs) Reset() {
+	*x = InspectFilesArtifactContentsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectFilesArtifactContentsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectFilesArtifactContentsArgs) ProtoMessage() {}
+
+func (x *InspectFilesArtifactContentsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectFilesArtifactContentsArgs.ProtoReflect.Descriptor instead.
+func (*InspectFilesArtifactContentsArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InspectFilesArtifactContentsArgs) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+type InspectFilesArtifactContentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The files and directories inside the files artifact, in the order they were archived
+	FileDescriptions []*FilesArtifactContentsFileDescription `protobuf:"bytes,1,rep,name=file_descriptions,json=fileDescriptions,proto3" json:"file_descriptions,omitempty"`
+}
+
+func (x *InspectFilesArtifactContentsResponse) Reset() {
+	*x = InspectFilesArtifactContentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectFilesArtifactContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectFilesArtifactContentsResponse) ProtoMessage() {}
+
+func (x *InspectFilesArtifactContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectFilesArtifactContentsResponse.ProtoReflect.Descriptor instead.
+func (*InspectFilesArtifactContentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *InspectFilesArtifactContentsResponse) GetFileDescriptions() []*FilesArtifactContentsFileDescription {
+	if x != nil {
+		return x.FileDescriptions
+	}
+	return nil
+}
+
+type FilesArtifactContentsFileDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the file relative to the root of the files artifact
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The uncompressed size of the file; 0 for directories
+	SizeBytes   uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	IsDirectory bool   `protobuf:"varint,3,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+}
+
+func (x *FilesArtifactContentsFileDescription) Reset() {
+	*x = FilesArtifactContentsFileDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilesArtifactContentsFileDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilesArtifactContentsFileDescription) ProtoMessage() {}
+
+func (x *FilesArtifactContentsFileDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilesArtifactContentsFileDescription.ProtoReflect.Descriptor instead.
+func (*FilesArtifactContentsFileDescription) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *FilesArtifactContentsFileDescription) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FilesArtifactContentsFileDescription) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *FilesArtifactContentsFileDescription) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+// ==============================================================================================
+//
+//	Set Log Level
+//
+// ==============================================================================================
+type SetLogLevelArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new log level, e.g. 'debug'
+	LogLevel string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	// If non-zero, the previous log level gets restored after this many seconds
+	RevertAfterSeconds uint32 `protobuf:"varint,2,opt,name=revert_after_seconds,json=revertAfterSeconds,proto3" json:"revert_after_seconds,omitempty"`
+}
+
+func (x *SetLogLevelArgs) Reset() {
+	*x = SetLogLevelArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelArgs) ProtoMessage() {}
+
+func (x *SetLogLevelArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelArgs.ProtoReflect.Descriptor instead.
+func (*SetLogLevelArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetLogLevelArgs) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *SetLogLevelArgs) GetRevertAfterSeconds() uint32 {
+	if x != nil {
+		return x.RevertAfterSeconds
+	}
+	return 0
+}
+
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The log level before this call
+	PreviousLogLevel string `protobuf:"bytes,1,opt,name=previous_log_level,json=previousLogLevel,proto3" json:"previous_log_level,omitempty"`
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetLogLevelResponse) GetPreviousLogLevel() string {
+	if x != nil {
+		return x.PreviousLogLevel
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Run Shutdown Hooks
+//
+// ==============================================================================================
+type ShutdownHookResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Describes what the hook ran, and on which service
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// Set if the hook failed or timed out
+	Error *string `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *ShutdownHookResult) Reset() {
+	*x = ShutdownHookResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShutdownHookResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownHookResult) ProtoMessage() {}
+
+func (x *ShutdownHookResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShutdownHookResult.ProtoReflect.Descriptor instead.
+func (*ShutdownHookResult) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ShutdownHookResult) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShutdownHookResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type RunShutdownHooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// In the order the hooks were run
+	Results []*ShutdownHookResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *RunShutdownHooksResponse) Reset() {
+	*x = RunShutdownHooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunShutdownHooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunShutdownHooksResponse) ProtoMessage() {}
+
+func (x *RunShutdownHooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunShutdownHooksResponse.ProtoReflect.Descriptor instead.
+func (*RunShutdownHooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RunShutdownHooksResponse) GetResults() []*ShutdownHookResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	HTTP Request Service
+//
+// ==============================================================================================
+type HttpRequestServiceArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceIdentifier string `protobuf:"bytes,1,opt,name=service_identifier,json=serviceIdentifier,proto3" json:"service_identifier,omitempty"`
+	// The ID of the private port of the service to send the request to
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// Defaults to GET
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// The path and query of the request, e.g. '/health?verbose=true'
+	Path    string            `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Headers map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Body    string            `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// HTTPS is also used when the application protocol of the port is 'https'
+	UseHttps bool `protobuf:"varint,7,opt,name=use_https,json=useHttps,proto3" json:"use_https,omitempty"`
+	// Accepts any certificate when using HTTPS, as services typically use self-signed ones
+	SkipTlsVerification bool `protobuf:"varint,8,opt,name=skip_tls_verification,json=skipTlsVerification,proto3" json:"skip_tls_verification,omitempty"`
+	// Zero means the default timeout of the API container
+	TimeoutSeconds uint32 `protobuf:"varint,9,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *HttpRequestServiceArgs) Reset() {
+	*x = HttpRequestServiceArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpRequestServiceArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpRequestServiceArgs) ProtoMessage() {}
+
+func (x *HttpRequestServiceArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpRequestServiceArgs.ProtoReflect.Descriptor instead.
+func (*HttpRequestServiceArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *HttpRequestServiceArgs) GetServiceIdentifier() string {
+	if x != nil {
+		return x.ServiceIdentifier
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceArgs) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceArgs) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceArgs) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceArgs) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpRequestServiceArgs) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceArgs) GetUseHttps() bool {
+	if x != nil {
+		return x.UseHttps
+	}
+	return false
+}
+
+func (x *HttpRequestServiceArgs) GetSkipTlsVerification() bool {
+	if x != nil {
+		return x.SkipTlsVerification
+	}
+	return false
+}
+
+func (x *HttpRequestServiceArgs) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type HttpHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *HttpHeader) Reset() {
+	*x = HttpHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpHeader) ProtoMessage() {}
+
+func (x *HttpHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpHeader.ProtoReflect.Descriptor instead.
+func (*HttpHeader) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *HttpHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpHeader) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type HttpRequestServiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URL the request was sent to, with the private IP of the service
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// E.g. '200 OK'
+	Status     string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StatusCode int32  `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Sorted by name, a header with several values appearing once per value
+	Headers []*HttpHeader `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	Body    []byte        `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// Set if the body was bigger than what the API container returns, in which case only its beginning is returned
+	IsBodyTruncated bool `protobuf:"varint,6,opt,name=is_body_truncated,json=isBodyTruncated,proto3" json:"is_body_truncated,omitempty"`
+	// Time taken to open the connection to the service, including the TLS handshake
+	ConnectionDurationMicros uint64 `protobuf:"varint,7,opt,name=connection_duration_micros,json=connectionDurationMicros,proto3" json:"connection_duration_micros,omitempty"`
+	// Time from the start of the request to the first byte of the response
+	TimeToFirstByteMicros uint64 `protobuf:"varint,8,opt,name=time_to_first_byte_micros,json=timeToFirstByteMicros,proto3" json:"time_to_first_byte_micros,omitempty"`
+	// Time from the start of the request to the end of the response body
+	TotalDurationMicros uint64 `protobuf:"varint,9,opt,name=total_duration_micros,json=totalDurationMicros,proto3" json:"total_duration_micros,omitempty"`
+}
+
+func (x *HttpRequestServiceResponse) Reset() {
+	*x = HttpRequestServiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpRequestServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpRequestServiceResponse) ProtoMessage() {}
+
+func (x *HttpRequestServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpRequestServiceResponse.ProtoReflect.Descriptor instead.
+func (*HttpRequestServiceResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *HttpRequestServiceResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HttpRequestServiceResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *HttpRequestServiceResponse) GetHeaders() []*HttpHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpRequestServiceResponse) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *HttpRequestServiceResponse) GetIsBodyTruncated() bool {
+	if x != nil {
+		return x.IsBodyTruncated
+	}
+	return false
+}
+
+func (x *HttpRequestServiceResponse) GetConnectionDurationMicros() uint64 {
+	if x != nil {
+		return x.ConnectionDurationMicros
+	}
+	return 0
+}
+
+func (x *HttpRequestServiceResponse) GetTimeToFirstByteMicros() uint64 {
+	if x != nil {
+		return x.TimeToFirstByteMicros
+	}
+	return 0
+}
+
+func (x *HttpRequestServiceResponse) GetTotalDurationMicros() uint64 {
+	if x != nil {
+		return x.TotalDurationMicros
+	}
+	return 0
+}
+
+// ==============================================================================================
+//
+//	Get Backend Capabilities
+//
+// ==============================================================================================
+type GetBackendCapabilitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsNetworkPartitioningSupported bool `protobuf:"varint,1,opt,name=is_network_partitioning_supported,json=isNetworkPartitioningSupported,proto3" json:"is_network_partitioning_supported,omitempty"`
+	IsServicePausingSupported      bool `protobuf:"varint,2,opt,name=is_service_pausing_supported,json=isServicePausingSupported,proto3" json:"is_service_pausing_supported,omitempty"`
+	// Whether services can ask for the port numbers their ports get published on
+	AreStaticPublicPortsSupported bool `protobuf:"varint,3,opt,name=are_static_public_ports_supported,json=areStaticPublicPortsSupported,proto3" json:"are_static_public_ports_supported,omitempty"`
+	ArePersistentVolumesSupported bool `protobuf:"varint,4,opt,name=are_persistent_volumes_supported,json=arePersistentVolumesSupported,proto3" json:"are_persistent_volumes_supported,omitempty"`
+	// Whether interactive shells with a TTY can be opened in services
+	IsExecTtySupported bool `protobuf:"varint,5,opt,name=is_exec_tty_supported,json=isExecTtySupported,proto3" json:"is_exec_tty_supported,omitempty"`
+}
+
+func (x *GetBackendCapabilitiesResponse) Reset() {
+	*x = GetBackendCapabilitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBackendCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackendCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetBackendCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackendCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetBackendCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetBackendCapabilitiesResponse) GetIsNetworkPartitioningSupported() bool {
+	if x != nil {
+		return x.IsNetworkPartitioningSupported
+	}
+	return false
+}
+
+func (x *GetBackendCapabilitiesResponse) GetIsServicePausingSupported() bool {
+	if x != nil {
+		return x.IsServicePausingSupported
+	}
+	return false
+}
+
+func (x *GetBackendCapabilitiesResponse) GetAreStaticPublicPortsSupported() bool {
+	if x != nil {
+		return x.AreStaticPublicPortsSupported
+	}
+	return false
+}
+
+func (x *GetBackendCapabilitiesResponse) GetArePersistentVolumesSupported() bool {
+	if x != nil {
+		return x.ArePersistentVolumesSupported
+	}
+	return false
+}
+
+func (x *GetBackendCapabilitiesResponse) GetIsExecTtySupported() bool {
+	if x != nil {
+		return x.IsExecTtySupported
+	}
+	return false
+}
+
+// ==============================================================================================
+//
+//	Package Cache
+//
+// ==============================================================================================
+type CachedPackage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. 'kurtosis-tech/eth2-package'
+	Repository          string `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	SizeBytes           uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	LastUsedUnixSeconds int64  `protobuf:"varint,3,opt,name=last_used_unix_seconds,json=lastUsedUnixSeconds,proto3" json:"last_used_unix_seconds,omitempty"`
+}
+
+func (x *CachedPackage) Reset() {
+	*x = CachedPackage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CachedPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachedPackage) ProtoMessage() {}
+
+func (x *CachedPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachedPackage.ProtoReflect.Descriptor instead.
+func (*CachedPackage) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CachedPackage) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *CachedPackage) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CachedPackage) GetLastUsedUnixSeconds() int64 {
+	if x != nil {
+		return x.LastUsedUnixSeconds
+	}
+	return 0
+}
+
+type PackageCacheInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// From the least to the most recently used, which is the order they get evicted in
+	CachedPackages []*CachedPackage `protobuf:"bytes,1,rep,name=cached_packages,json=cachedPackages,proto3" json:"cached_packages,omitempty"`
+	TotalSizeBytes uint64           `protobuf:"varint,2,opt,name=total_size_bytes,json=totalSizeBytes,proto3" json:"total_size_bytes,omitempty"`
+	// Once exceeded, the least recently used packages get evicted
+	MaxSizeBytes uint64 `protobuf:"varint,3,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	// Number of times a file of a package already on disk got used since the API container started
+	NumHits uint64 `protobuf:"varint,4,opt,name=num_hits,json=numHits,proto3" json:"num_hits,omitempty"`
+	// Number of times a package had to be cloned since the API container started
+	NumMisses uint64 `protobuf:"varint,5,opt,name=num_misses,json=numMisses,proto3" json:"num_misses,omitempty"`
+}
+
+func (x *PackageCacheInfo) Reset() {
+	*x = PackageCacheInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageCacheInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageCacheInfo) ProtoMessage() {}
+
+func (x *PackageCacheInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageCacheInfo.ProtoReflect.Descriptor instead.
+func (*PackageCacheInfo) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PackageCacheInfo) GetCachedPackages() []*CachedPackage {
+	if x != nil {
+		return x.CachedPackages
+	}
+	return nil
+}
+
+func (x *PackageCacheInfo) GetTotalSizeBytes() uint64 {
+	if x != nil {
+		return x.TotalSizeBytes
+	}
+	return 0
+}
+
+func (x *PackageCacheInfo) GetMaxSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
+}
+
+func (x *PackageCacheInfo) GetNumHits() uint64 {
+	if x != nil {
+		return x.NumHits
+	}
+	return 0
+}
+
+func (x *PackageCacheInfo) GetNumMisses() uint64 {
+	if x != nil {
+		return x.NumMisses
+	}
+	return 0
+}
+
+type ClearPackageCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovedRepositories []string `protobuf:"bytes,1,rep,name=removed_repositories,json=removedRepositories,proto3" json:"removed_repositories,omitempty"`
+}
+
+func (x *ClearPackageCacheResponse) Reset() {
+	*x = ClearPackageCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearPackageCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearPackageCacheResponse) ProtoMessage() {}
+
+func (x *ClearPackageCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearPackageCacheResponse.ProtoReflect.Descriptor instead.
+func (*ClearPackageCacheResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ClearPackageCacheResponse) GetRemovedRepositories() []string {
+	if x != nil {
+		return x.RemovedRepositories
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Starlark Run Profile
+//
+// ==============================================================================================
+type GetStarlarkRunProfileArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The most recent run if unset
+	RunId *string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`
+}
+
+func (x *GetStarlarkRunProfileArgs) Reset() {
+	*x = GetStarlarkRunProfileArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStarlarkRunProfileArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarlarkRunProfileArgs) ProtoMessage() {}
+
+func (x *GetStarlarkRunProfileArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarlarkRunProfileArgs.ProtoReflect.Descriptor instead.
+func (*GetStarlarkRunProfileArgs) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetStarlarkRunProfileArgs) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+type StarlarkInstructionProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position        *StarlarkInstructionPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	InstructionName string                       `protobuf:"bytes,2,opt,name=instruction_name,json=instructionName,proto3" json:"instruction_name,omitempty"`
+	DurationMicros  uint64                       `protobuf:"varint,3,opt,name=duration_micros,json=durationMicros,proto3" json:"duration_micros,omitempty"`
+	// Number of service containers the instruction started
+	NumContainersCreated uint32 `protobuf:"varint,4,opt,name=num_containers_created,json=numContainersCreated,proto3" json:"num_containers_created,omitempty"`
+	// Size of the files artifacts the instruction stored in the enclave
+	ArtifactBytesTransferred uint64 `protobuf:"varint,5,opt,name=artifact_bytes_transferred,json=artifactBytesTransferred,proto3" json:"artifact_bytes_transferred,omitempty"`
+	IsSuccessful             bool   `protobuf:"varint,6,opt,name=is_successful,json=isSuccessful,proto3" json:"is_successful,omitempty"`
+}
+
+func (x *StarlarkInstructionProfile) Reset() {
+	*x = StarlarkInstructionProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StarlarkInstructionProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarlarkInstructionProfile) ProtoMessage() {}
+
+func (x *StarlarkInstructionProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarlarkInstructionProfile.ProtoReflect.Descriptor instead.
+func (*StarlarkInstructionProfile) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *StarlarkInstructionProfile) GetPosition() *StarlarkInstructionPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *StarlarkInstructionProfile) GetInstructionName() string {
+	if x != nil {
+		return x.InstructionName
+	}
+	return ""
+}
+
+func (x *StarlarkInstructionProfile) GetDurationMicros() uint64 {
+	if x != nil {
+		return x.DurationMicros
+	}
+	return 0
+}
+
+func (x *StarlarkInstructionProfile) GetNumContainersCreated() uint32 {
+	if x != nil {
+		return x.NumContainersCreated
+	}
+	return 0
+}
+
+func (x *StarlarkInstructionProfile) GetArtifactBytesTransferred() uint64 {
+	if x != nil {
+		return x.ArtifactBytesTransferred
+	}
+	return 0
+}
+
+func (x *StarlarkInstructionProfile) GetIsSuccessful() bool {
+	if x != nil {
+		return x.IsSuccessful
+	}
+	return false
+}
+
+type StarlarkRunProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// e.g. 'github.com/kurtosis-tech/eth2-package', or the placeholder of standalone scripts
+	PackageId string                 `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// In the order they got executed; the run stopped at the last one if it isn't successful
+	Instructions []*StarlarkInstructionProfile `protobuf:"bytes,4,rep,name=instructions,proto3" json:"instructions,omitempty"`
+}
+
+func (x *StarlarkRunProfile) Reset() {
+	*x = StarlarkRunProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StarlarkRunProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarlarkRunProfile) ProtoMessage() {}
+
+func (x *StarlarkRunProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarlarkRunProfile.ProtoReflect.Descriptor instead.
+func (*StarlarkRunProfile) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *StarlarkRunProfile) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *StarlarkRunProfile) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *StarlarkRunProfile) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *StarlarkRunProfile) GetInstructions() []*StarlarkInstructionProfile {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Deprecated Features
+//
+// ==============================================================================================
+type DeprecatedFeature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Same code as the one of the warnings sent by runs using the feature
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *DeprecatedFeature) Reset() {
+	*x = DeprecatedFeature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeprecatedFeature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeprecatedFeature) ProtoMessage() {}
+
+func (x *DeprecatedFeature) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeprecatedFeature.ProtoReflect.Descriptor instead.
+func (*DeprecatedFeature) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeprecatedFeature) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeprecatedFeature) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetDeprecatedFeaturesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeprecatedFeatures []*DeprecatedFeature `protobuf:"bytes,1,rep,name=deprecated_features,json=deprecatedFeatures,proto3" json:"deprecated_features,omitempty"`
+}
+
+func (x *GetDeprecatedFeaturesResponse) Reset() {
+	*x = GetDeprecatedFeaturesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeprecatedFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeprecatedFeaturesResponse) ProtoMessage() {}
+
+func (x *GetDeprecatedFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeprecatedFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*GetDeprecatedFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetDeprecatedFeaturesResponse) GetDeprecatedFeatures() []*DeprecatedFeature {
+	if x != nil {
+		return x.DeprecatedFeatures
+	}
+	return nil
+}
+
+// An object representing the template and the data that needs to be inserted
+type RenderTemplatesToFilesArtifactArgs_TemplateAndData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A string representation of the template file
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// A json string representation of the data to be written to template
+	DataAsJson string `protobuf:"bytes,2,opt,name=data_as_json,json=dataAsJson,proto3" json:"data_as_json,omitempty"`
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) Reset() {
+	*x = RenderTemplatesToFilesArtifactArgs_TemplateAndData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_container_service_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplatesToFilesArtifactArgs_TemplateAndData) ProtoMessage() {}
+
+func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_container_service_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplatesToFilesArtifactArgs_TemplateAndData.ProtoReflect.Descriptor instead.
+func (*RenderTemplatesToFilesArtifactArgs_TemplateAndData) Descriptor() ([]byte, []int) {
+	return file_api_container_service_proto_rawDescGZIP(), []int{73, 0}
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *RenderTemplatesToFilesArtifactArgs_TemplateAndData) GetDataAsJson() string {
+	if x != nil {
+		return x.DataAsJson
+	}
+	return ""
+}
+
+var File_api_container_service_proto protoreflect.FileDescriptor
+
+var file_api_container_service_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7,
+	0x01, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x58, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x1a, 0x6d, 0x61, 0x79,
+	0x62, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x6d,
+	0x61, 0x79, 0x62, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x2f, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03,
+	0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43, 0x54, 0x50, 0x10, 0x01, 0x12,
+	0x07, 0x0a, 0x03, 0x55, 0x44, 0x50, 0x10, 0x02, 0x22, 0xe2, 0x06, 0x0a, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x55, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
+	0x79, 0x62, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x62, 0x0a, 0x12, 0x6d,
+	0x61, 0x79, 0x62, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x61, 0x79, 0x62, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x6d,
+	0x61, 0x79, 0x62, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x17, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x70, 0x76, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x76,
+	0x36, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x58, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x5c, 0x0a, 0x15, 0x4d, 0x61, 0x79, 0x62, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd5, 0x11,
+	0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x57, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6d, 0x64,
+	0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73, 0x12, 0x7c,
+	0x0a, 0x1a, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x18, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18,
+	0x63, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x63, 0x70, 0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x67, 0x61,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x67,
+	0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x68,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x1a, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x18, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x9e, 0x01,
+	0x0a, 0x26, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x23, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x61,
+	0x0a, 0x15, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x02, 0x52, 0x14, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x70, 0x75, 0x73, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x63, 0x70, 0x75, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x07, 0x75, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x12,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x55, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x75, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x07,
+	0x73, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x79,
+	0x73, 0x63, 0x74, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x12, 0x73, 0x68, 0x6d, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x06, 0x52, 0x10, 0x73, 0x68, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x67, 0x61,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x64, 0x69, 0x72, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x72, 0x4d, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x72, 0x4d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07,
+	0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x1a, 0x58, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57, 0x0a, 0x10,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56,
+	0x0a, 0x28, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x0c, 0x55, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a,
+	0x0c, 0x53, 0x79, 0x73, 0x63, 0x74, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x48, 0x6f, 0x73,
+	0x74, 0x44, 0x69, 0x72, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x69, 0x6e, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x70, 0x75, 0x73, 0x65, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x73,
+	0x68, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x06, 0x55, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6f, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x6f, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x68, 0x61, 0x72, 0x64, 0x22, 0x88, 0x03, 0x0a, 0x14, 0x4b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x12, 0x5e, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x49, 0x0a, 0x0b, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x41, 0x66, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x26,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x70, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x23, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x54, 0x6f, 0x70, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x12, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0xde, 0x03, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x88, 0x01, 0x01, 0x12,
+	0x35, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x5d, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4e, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x5f, 0x76, 0x61, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x76, 0x56, 0x61, 0x72, 0x73, 0x1a, 0x58, 0x0a, 0x11, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c,
+	0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x69, 0x73, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c,
+	0x65, 0x6c, 0x69, 0x73, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x22, 0xbb, 0x02, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0b,
+	0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x1a, 0x0a, 0x18, 0x73,
+	0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c,
+	0x69, 0x73, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x22, 0xfd,
+	0x04, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x4d, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x5d, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x5b,
+	0x0a, 0x12, 0x72, 0x75, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x72, 0x75, 0x6e, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x11, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x60, 0x0a, 0x13, 0x64, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72,
+	0x6b, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x12, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x13, 0x0a, 0x11, 0x72, 0x75, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x96,
+	0x01, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x02, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x72, 0x67, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x35, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x42, 0x0a, 0x1d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x72, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x61, 0x72, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x41, 0x72, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x72, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x69, 0x73, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x65,
+	0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0xac, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x63, 0x0a, 0x14, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x57, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c,
+	0x61, 0x72, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x54, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x65, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3d, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x86, 0x03, 0x0a,
+	0x18, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x52,
+	0x75, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x12, 0x30, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x84, 0x01, 0x0a, 0x19, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x17, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x4a, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72,
+	0x75, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xe7, 0x04, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61,
+	0x72, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x63, 0x70, 0x75, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x63, 0x70, 0x75, 0x73, 0x12,
+	0x4c, 0x0a, 0x23, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f, 0x6e, 0x75,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x43, 0x70, 0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a,
+	0x1b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x19, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x67, 0x61, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x52, 0x0a,
+	0x26, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x22, 0x6e,
+	0x75, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75,
+	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x59, 0x0a, 0x0f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x52, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x5f,
+	0x63, 0x70, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x68, 0x6f, 0x73, 0x74,
+	0x4e, 0x75, 0x6d, 0x43, 0x70, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0xaf, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x4f, 0x6e,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x78, 0x0a, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x1a, 0x6a, 0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8c, 0x04,
+	0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x27, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x50, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x22, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x86, 0x01, 0x0a, 0x1c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x75, 0x0a, 0x27, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x54, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x4b, 0x0a, 0x1d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc5, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x6b, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67,
+	0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x45, 0x0a,
+	0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0c,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x5e, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e,
+	0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x22, 0x83, 0x01, 0x0a,
+	0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x69, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x63, 0x0a, 0x13, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xcc, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x62, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x73,
+	0x63, 0x72, 0x61, 0x70, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x61, 0x70, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x61,
+	0x6e, 0x4d, 0x73, 0x12, 0x34, 0x0a, 0x17, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x4d, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4b, 0x62, 0x70, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x61, 0x0a,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74,
+	0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x62, 0x0a, 0x10,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74,
+	0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xb7, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x41, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x5f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0x12, 0x53, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xab, 0x01,
+	0x0a, 0x1c, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x31,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x32, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x32, 0x12, 0x47, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x62, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0xee, 0x01, 0x0a,
+	0x14, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x70, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x70,
+	0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x75, 0x6d,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x98, 0x03,
+	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x1f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x73,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x1c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x37, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x73, 0x12, 0x45, 0x0a, 0x1f, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x73,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x1c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x35, 0x0a, 0x17, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x76, 0x67,
+	0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x14, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41, 0x76, 0x67, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6b, 0x0a, 0x18, 0x54, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x15, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0xa2, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x6e, 0x0a, 0x13,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc6, 0x03, 0x0a, 0x16, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x1c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x55, 0x75, 0x69, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x87, 0x01, 0x0a, 0x1c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x18, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x4b, 0x0a, 0x1d, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54,
+	0x6f, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xa7, 0x04, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x68, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x71, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x67, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x59, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x6a, 0x0a, 0x16, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70, 0x0a, 0x19, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x01,
+	0x0a, 0x11, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xeb, 0x01, 0x0a, 0x14, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x6d, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa2, 0x02, 0x0a, 0x17, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c,
+	0x6f, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x73, 0x73,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x61, 0x6e, 0x4d, 0x73, 0x12, 0x34, 0x0a, 0x17, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x64, 0x5f,
+	0x64, 0x65, 0x76, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x4d,
+	0x73, 0x12, 0x38, 0x0a, 0x18, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x62,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6b,
+	0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x62, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4b, 0x62, 0x70, 0x73, 0x22, 0x63, 0x0a,
+	0x0f, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72,
+	0x67, 0x73, 0x22, 0x41, 0x0a, 0x10, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xec, 0x01, 0x0a, 0x11, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x6d, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41,
+	0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x1a, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb8, 0x03, 0x0a, 0x15, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x18, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x1c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x18, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x1b,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x6f, 0x0a, 0x13,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67,
+	0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x1a, 0x45, 0x0a, 0x17, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbf, 0x03, 0x0a, 0x17, 0x55, 0x6e, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x1c, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x49, 0x0a, 0x1b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x54, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x16, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x41,
+	0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x39, 0x0a, 0x1a, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x51, 0x0a,
+	0x13, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x22, 0x62, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x09,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x00, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x46, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xb1, 0x02, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x72, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xb2, 0x02, 0x0a, 0x26, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x3c, 0x0a, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x64, 0x79,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x64,
+	0x79, 0x54, 0x65, 0x78, 0x74, 0x22, 0xd6, 0x02, 0x0a, 0x27, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x54, 0x65, 0x78, 0x74, 0x22, 0x7a,
+	0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x71, 0x0a, 0x1b, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73,
+	0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x3b, 0x0a,
+	0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x33, 0x0a, 0x1d, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x42, 0x0a, 0x18, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x41, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x1d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57,
+	0x65, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x21,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x25, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x22, 0xf6, 0x03, 0x0a, 0x22, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0xc5, 0x01, 0x0a, 0x2e, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x62, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x63, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x70, 0x61,
+	0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x28, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x4f, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x73, 0x5f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x41, 0x73, 0x4a, 0x73, 0x6f, 0x6e, 0x1a, 0xa2, 0x01, 0x0a, 0x2d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x42, 0x79, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x70,
+	0x61, 0x74, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5b, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3c, 0x0a, 0x26, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x41,
+	0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3b, 0x0a,
+	0x1a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x55,
+	0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x26, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x14, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64,
+	0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x55, 0x75,
+	0x69, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x42,
+	0x0a, 0x20, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x22, 0x8c, 0x01, 0x0a, 0x24, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x10, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x7c, 0x0a, 0x24, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x73, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22,
+	0x60, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x30, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0x43, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x5b, 0x0a, 0x12, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x5b, 0x0a, 0x18, 0x52, 0x75, 0x6e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f,
+	0x77, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0xa8, 0x03, 0x0a, 0x16, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x50, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x5f, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x65, 0x48, 0x74, 0x74,
+	0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x0a, 0x48,
+	0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x8c, 0x03, 0x0a, 0x1a, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x73,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x42, 0x6f, 0x64, 0x79, 0x54, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x1a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x12, 0x38, 0x0a, 0x19, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x46,
+	0x69, 0x72, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x63, 0x72,
+	0x6f, 0x73, 0x22, 0xf2, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x21, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x1e, 0x69, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x12, 0x3f, 0x0a, 0x1c, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70,
+	0x61, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x50, 0x61, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x48, 0x0a, 0x21, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x61, 0x72,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x20, 0x61,
+	0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f,
+	0x74, 0x74, 0x79, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x45, 0x78, 0x65, 0x63, 0x54, 0x74, 0x79, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x64, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xe7, 0x01,
+	0x0a, 0x10, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x0e, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x6e, 0x75, 0x6d, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6e, 0x75, 0x6d, 0x48, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75,
+	0x6d, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x19, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x41, 0x72, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xd5, 0x02, 0x0a, 0x1a,
+	0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x75,
+	0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6e, 0x75, 0x6d, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x22, 0xd8, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b,
+	0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x49,
+	0x0a, 0x11, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x13, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x12, 0x64,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x32, 0x91, 0x24, 0x0a, 0x13, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x11, 0x52, 0x75, 0x6e,
+	0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x12, 0x52, 0x75, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x29,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8d, 0x01, 0x0a, 0x2a, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x45, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72,
+	0x61, 0x70, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x61, 0x70, 0x65,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x69, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x33, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0d,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x0d, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x10, 0x54, 0x65, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0b, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7a, 0x0a, 0x1d, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65, 0x64, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d,
+	0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x23,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x0e, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x0d, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2a,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x12,
+	0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78,
+	0x69, 0x74, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2d, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2c, 0x2e,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a,
+	0x22, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x12, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48,
+	0x74, 0x74, 0x70, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x23, 0x57, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70, 0x50, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x3a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x48, 0x74, 0x74, 0x70, 0x50,
+	0x6f, 0x73, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x19, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x79, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x79, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x57, 0x65, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a,
+	0x1d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34,
+	0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x67, 0x73, 0x1a, 0x38, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x94, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x12, 0x35, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x54, 0x6f,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x39, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x55,
+	0x75, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x1c,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x33, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x37, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0b,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x2e, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x26, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x10, 0x52, 0x75, 0x6e,
+	0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2b, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x68, 0x75,
+	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x12, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x2d, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x31, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x61,
+	0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52,
+	0x75, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x6c, 0x61, 0x72, 0x6b, 0x52, 0x75, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x00,
+	0x12, 0x63, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x30, 0x2e, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x52, 0x5a, 0x50, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63,
+	0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f,
+	0x73, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x69,
+	0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_api_container_service_proto_rawDescOnce sync.Once
+	file_api_container_service_proto_rawDescData = file_api_container_service_proto_rawDesc
+)
+
+func file_api_container_service_proto_rawDescGZIP() []byte {
+	file_api_container_service_proto_rawDescOnce.Do(func() {
+		file_api_container_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_container_service_proto_rawDescData)
+	})
+	return file_api_container_service_proto_rawDescData
+}
+
+var file_api_container_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_container_service_proto_msgTypes = make([]protoimpl.MessageInfo, 136)
+var file_api_container_service_proto_goTypes = []interface{}{
+	(Port_TransportProtocol)(0),                                // 0: api_container_api.Port.TransportProtocol
+	(*Port)(nil),                                               // 1: api_container_api.Port
+	(*ServiceInfo)(nil),                                        // 2: api_container_api.ServiceInfo
+	(*ServiceConfig)(nil),                                      // 3: api_container_api.ServiceConfig
+	(*Ulimit)(nil),                                             // 4: api_container_api.Ulimit
+	(*KubernetesScheduling)(nil),                               // 5: api_container_api.KubernetesScheduling
+	(*KubernetesToleration)(nil),                               // 6: api_container_api.KubernetesToleration
+	(*UpdateServiceConfig)(nil),                                // 7: api_container_api.UpdateServiceConfig
+	(*RunStarlarkScriptArgs)(nil),                              // 8: api_container_api.RunStarlarkScriptArgs
+	(*RunStarlarkPackageArgs)(nil),                             // 9: api_container_api.RunStarlarkPackageArgs
+	(*StarlarkRunResponseLine)(nil),                            // 10: api_container_api.StarlarkRunResponseLine
+	(*StarlarkDeprecationWarning)(nil),                         // 11: api_container_api.StarlarkDeprecationWarning
+	(*StarlarkInstruction)(nil),                                // 12: api_container_api.StarlarkInstruction
+	(*StarlarkInstructionResult)(nil),                          // 13: api_container_api.StarlarkInstructionResult
+	(*StarlarkInstructionArg)(nil),                             // 14: api_container_api.StarlarkInstructionArg
+	(*StarlarkInstructionPosition)(nil),                        // 15: api_container_api.StarlarkInstructionPosition
+	(*StarlarkError)(nil),                                      // 16: api_container_api.StarlarkError
+	(*StarlarkInterpretationError)(nil),                        // 17: api_container_api.StarlarkInterpretationError
+	(*StarlarkValidationError)(nil),                            // 18: api_container_api.StarlarkValidationError
+	(*StarlarkExecutionError)(nil),                             // 19: api_container_api.StarlarkExecutionError
+	(*StarlarkRunProgress)(nil),                                // 20: api_container_api.StarlarkRunProgress
+	(*StarlarkRunFinishedEvent)(nil),                           // 21: api_container_api.StarlarkRunFinishedEvent
+	(*StarlarkResourceEstimate)(nil),                           // 22: api_container_api.StarlarkResourceEstimate
+	(*StarlarkImageDownloadEstimate)(nil),                      // 23: api_container_api.StarlarkImageDownloadEstimate
+	(*StartServicesArgs)(nil),                                  // 24: api_container_api.StartServicesArgs
+	(*StartServicesResponse)(nil),                              // 25: api_container_api.StartServicesResponse
+	(*GetServicesArgs)(nil),                                    // 26: api_container_api.GetServicesArgs
+	(*GetServicesResponse)(nil),                                // 27: api_container_api.GetServicesResponse
+	(*ServiceIdentifiers)(nil),                                 // 28: api_container_api.ServiceIdentifiers
+	(*GetExistingAndHistoricalServiceIdentifiersResponse)(nil), // 29: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
+	(*GetServiceConfigsResponse)(nil),                          // 30: api_container_api.GetServiceConfigsResponse
+	(*ScrapeTarget)(nil),                                       // 31: api_container_api.ScrapeTarget
+	(*GetScrapeTargetsResponse)(nil),                           // 32: api_container_api.GetScrapeTargetsResponse
+	(*SubnetworkConnection)(nil),                               // 33: api_container_api.SubnetworkConnection
+	(*AddPartitionArgs)(nil),                                   // 34: api_container_api.AddPartitionArgs
+	(*MovePartitionArgs)(nil),                                  // 35: api_container_api.MovePartitionArgs
+	(*UpdateConnectionArgs)(nil),                               // 36: api_container_api.UpdateConnectionArgs
+	(*SubnetworkConnectionOverride)(nil),                       // 37: api_container_api.SubnetworkConnectionOverride
+	(*GetSubnetworkConnectionsResponse)(nil),                   // 38: api_container_api.GetSubnetworkConnectionsResponse
+	(*TestConnectivityArgs)(nil),                               // 39: api_container_api.TestConnectivityArgs
+	(*ConnectivityProbeResult)(nil),                            // 40: api_container_api.ConnectivityProbeResult
+	(*TestConnectivityResponse)(nil),                           // 41: api_container_api.TestConnectivityResponse
+	(*RemoveServiceArgs)(nil),                                  // 42: api_container_api.RemoveServiceArgs
+	(*RemoveServiceResponse)(nil),                              // 43: api_container_api.RemoveServiceResponse
+	(*RemoveServicesArgs)(nil),                                 // 44: api_container_api.RemoveServicesArgs
+	(*RemoveServicesResponse)(nil),                             // 45: api_container_api.RemoveServicesResponse
+	(*RepartitionArgs)(nil),                                    // 46: api_container_api.RepartitionArgs
+	(*PartitionServices)(nil),                                  // 47: api_container_api.PartitionServices
+	(*PartitionConnections)(nil),                               // 48: api_container_api.PartitionConnections
+	(*PartitionConnectionInfo)(nil),                            // 49: api_container_api.PartitionConnectionInfo
+	(*ExecCommandArgs)(nil),                                    // 50: api_container_api.ExecCommandArgs
+	(*PauseServiceArgs)(nil),                                   // 51: api_container_api.PauseServiceArgs
+	(*UnpauseServiceArgs)(nil),                                 // 52: api_container_api.UnpauseServiceArgs
+	(*PauseServicesArgs)(nil),                                  // 53: api_container_api.PauseServicesArgs
+	(*PauseServicesResponse)(nil),                              // 54: api_container_api.PauseServicesResponse
+	(*UnpauseServicesArgs)(nil),                                // 55: api_container_api.UnpauseServicesArgs
+	(*UnpauseServicesResponse)(nil),                            // 56: api_container_api.UnpauseServicesResponse
+	(*WaitForServiceExitArgs)(nil),                             // 57: api_container_api.WaitForServiceExitArgs
+	(*WaitForServiceExitResponse)(nil),                         // 58: api_container_api.WaitForServiceExitResponse
+	(*ExecCommandResponse)(nil),                                // 59: api_container_api.ExecCommandResponse
+	(*ExecCommandStreamedOutputChunk)(nil),                     // 60: api_container_api.ExecCommandStreamedOutputChunk
+	(*GetServiceMetricsArgs)(nil),                              // 61: api_container_api.GetServiceMetricsArgs
+	(*GetServiceMetricsResponse)(nil),                          // 62: api_container_api.GetServiceMetricsResponse
+	(*WaitForHttpGetEndpointAvailabilityArgs)(nil),             // 63: api_container_api.WaitForHttpGetEndpointAvailabilityArgs
+	(*WaitForHttpPostEndpointAvailabilityArgs)(nil),            // 64: api_container_api.WaitForHttpPostEndpointAvailabilityArgs
+	(*UploadFilesArtifactArgs)(nil),                            // 65: api_container_api.UploadFilesArtifactArgs
+	(*UploadFilesArtifactResponse)(nil),                        // 66: api_container_api.UploadFilesArtifactResponse
+	(*DownloadFilesArtifactArgs)(nil),                          // 67: api_container_api.DownloadFilesArtifactArgs
+	(*DownloadFilesArtifactResponse)(nil),                      // 68: api_container_api.DownloadFilesArtifactResponse
+	(*FilesArtifactStreamChunk)(nil),                           // 69: api_container_api.FilesArtifactStreamChunk
+	(*StoreWebFilesArtifactArgs)(nil),                          // 70: api_container_api.StoreWebFilesArtifactArgs
+	(*StoreWebFilesArtifactResponse)(nil),                      // 71: api_container_api.StoreWebFilesArtifactResponse
+	(*StoreFilesArtifactFromServiceArgs)(nil),                  // 72: api_container_api.StoreFilesArtifactFromServiceArgs
+	(*StoreFilesArtifactFromServiceResponse)(nil),              // 73: api_container_api.StoreFilesArtifactFromServiceResponse
+	(*RenderTemplatesToFilesArtifactArgs)(nil),                 // 74: api_container_api.RenderTemplatesToFilesArtifactArgs
+	(*RenderTemplatesToFilesArtifactResponse)(nil),             // 75: api_container_api.RenderTemplatesToFilesArtifactResponse
+	(*FilesArtifactNameAndUuid)(nil),                           // 76: api_container_api.FilesArtifactNameAndUuid
+	(*ListFilesArtifactNamesAndUuidsResponse)(nil),             // 77: api_container_api.ListFilesArtifactNamesAndUuidsResponse
+	(*DeleteFilesArtifactArgs)(nil),                            // 78: api_container_api.DeleteFilesArtifactArgs
+	(*InspectFilesArtifactContentsArgs)(nil),                   // 79: api_container_api.InspectFilesArtifactContentsArgs
+	(*InspectFilesArtifactContentsResponse)(nil),               // 80: api_container_api.InspectFilesArtifactContentsResponse
+	(*FilesArtifactContentsFileDescription)(nil),               // 81: api_container_api.FilesArtifactContentsFileDescription
+	(*SetLogLevelArgs)(nil),                                    // 82: api_container_api.SetLogLevelArgs
+	(*SetLogLevelResponse)(nil),                                // 83: api_container_api.SetLogLevelResponse
+	(*ShutdownHookResult)(nil),                                 // 84: api_container_api.ShutdownHookResult
+	(*RunShutdownHooksResponse)(nil),                           // 85: api_container_api.RunShutdownHooksResponse
+	(*HttpRequestServiceArgs)(nil),                             // 86: api_container_api.HttpRequestServiceArgs
+	(*HttpHeader)(nil),                                         // 87: api_container_api.HttpHeader
+	(*HttpRequestServiceResponse)(nil),                         // 88: api_container_api.HttpRequestServiceResponse
+	(*GetBackendCapabilitiesResponse)(nil),                     // 89: api_container_api.GetBackendCapabilitiesResponse
+	(*CachedPackage)(nil),                                      // 90: api_container_api.CachedPackage
+	(*PackageCacheInfo)(nil),                                   // 91: api_container_api.PackageCacheInfo
+	(*ClearPackageCacheResponse)(nil),                          // 92: api_container_api.ClearPackageCacheResponse
+	(*GetStarlarkRunProfileArgs)(nil),                          // 93: api_container_api.GetStarlarkRunProfileArgs
+	(*StarlarkInstructionProfile)(nil),                         // 94: api_container_api.StarlarkInstructionProfile
+	(*StarlarkRunProfile)(nil),                                 // 95: api_container_api.StarlarkRunProfile
+	(*DeprecatedFeature)(nil),                                  // 96: api_container_api.DeprecatedFeature
+	(*GetDeprecatedFeaturesResponse)(nil),                      // 97: api_container_api.GetDeprecatedFeaturesResponse
+	nil,                                                        // 98: api_container_api.ServiceInfo.PrivatePortsEntry
+	nil,                                                        // 99: api_container_api.ServiceInfo.MaybePublicPortsEntry
+	nil,                                                        // 100: api_container_api.ServiceConfig.PrivatePortsEntry
+	nil,                                                        // 101: api_container_api.ServiceConfig.PublicPortsEntry
+	nil,                                                        // 102: api_container_api.ServiceConfig.EnvVarsEntry
+	nil,                                                        // 103: api_container_api.ServiceConfig.FilesArtifactMountpointsEntry
+	nil,                                                        // 104: api_container_api.ServiceConfig.KubernetesServiceAccountAnnotationsEntry
+	nil,                                                        // 105: api_container_api.ServiceConfig.UlimitsEntry
+	nil,                                                        // 106: api_container_api.ServiceConfig.SysctlsEntry
+	nil,                                                        // 107: api_container_api.ServiceConfig.HostDirMountsEntry
+	nil,                                                        // 108: api_container_api.KubernetesScheduling.NodeSelectorEntry
+	nil,                                                        // 109: api_container_api.UpdateServiceConfig.PrivatePortsEntry
+	nil,                                                        // 110: api_container_api.UpdateServiceConfig.EnvVarsEntry
+	nil,                                                        // 111: api_container_api.StarlarkRunFinishedEvent.ResolvedPackageVersionsEntry
+	nil,                                                        // 112: api_container_api.StartServicesArgs.ServiceNamesToConfigsEntry
+	nil,                                                        // 113: api_container_api.StartServicesResponse.SuccessfulServiceNameToServiceInfoEntry
+	nil,                                                        // 114: api_container_api.StartServicesResponse.FailedServiceNameToErrorEntry
+	nil,                                                        // 115: api_container_api.GetServicesArgs.ServiceIdentifiersEntry
+	nil,                                                        // 116: api_container_api.GetServicesResponse.ServiceInfoEntry
+	nil,                                                        // 117: api_container_api.GetServiceConfigsResponse.ServiceConfigsEntry
+	nil,                                                        // 118: api_container_api.AddPartitionArgs.ServiceNameSetEntry
+	nil,                                                        // 119: api_container_api.MovePartitionArgs.ServiceNameSetEntry
+	nil,                                                        // 120: api_container_api.TestConnectivityArgs.ServiceIdentifiersEntry
+	nil,                                                        // 121: api_container_api.RemoveServicesArgs.ServiceIdentifiersEntry
+	nil,                                                        // 122: api_container_api.RemoveServicesResponse.RemovedServiceNameToUuidEntry
+	nil,                                                        // 123: api_container_api.RemoveServicesResponse.FailedServiceNameToErrorEntry
+	nil,                                                        // 124: api_container_api.RepartitionArgs.PartitionServicesEntry
+	nil,                                                        // 125: api_container_api.RepartitionArgs.PartitionConnectionsEntry
+	nil,                                                        // 126: api_container_api.PartitionServices.ServiceNameSetEntry
+	nil,                                                        // 127: api_container_api.PartitionConnections.ConnectionInfoEntry
+	nil,                                                        // 128: api_container_api.PauseServicesArgs.ServiceIdentifiersEntry
+	nil,                                                        // 129: api_container_api.PauseServicesResponse.SuccessfulServiceNamesEntry
+	nil,                                                        // 130: api_container_api.PauseServicesResponse.FailedServiceNameToErrorEntry
+	nil,                                                        // 131: api_container_api.UnpauseServicesArgs.ServiceIdentifiersEntry
+	nil,                                                        // 132: api_container_api.UnpauseServicesResponse.SuccessfulServiceNamesEntry
+	nil,                                                        // 133: api_container_api.UnpauseServicesResponse.FailedServiceNameToErrorEntry
+	(*RenderTemplatesToFilesArtifactArgs_TemplateAndData)(nil), // 134: api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData
+	nil,                           // 135: api_container_api.RenderTemplatesToFilesArtifactArgs.TemplatesAndDataByDestinationRelFilepathEntry
+	nil,                           // 136: api_container_api.HttpRequestServiceArgs.HeadersEntry
+	(*timestamppb.Timestamp)(nil), // 137: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 138: google.protobuf.Empty
+}
+var file_api_container_service_proto_depIdxs = []int32{
+	0,   // 0: api_container_api.Port.transport_protocol:type_name -> api_container_api.Port.TransportProtocol
+	98,  // 1: api_container_api.ServiceInfo.private_ports:type_name -> api_container_api.ServiceInfo.PrivatePortsEntry
+	99,  // 2: api_container_api.ServiceInfo.maybe_public_ports:type_name -> api_container_api.ServiceInfo.MaybePublicPortsEntry
+	137, // 3: api_container_api.ServiceInfo.creation_time:type_name -> google.protobuf.Timestamp
+	137, // 4: api_container_api.ServiceInfo.running_time:type_name -> google.protobuf.Timestamp
+	137, // 5: api_container_api.ServiceInfo.ready_time:type_name -> google.protobuf.Timestamp
+	100, // 6: api_container_api.ServiceConfig.private_ports:type_name -> api_container_api.ServiceConfig.PrivatePortsEntry
+	101, // 7: api_container_api.ServiceConfig.public_ports:type_name -> api_container_api.ServiceConfig.PublicPortsEntry
+	102, // 8: api_container_api.ServiceConfig.env_vars:type_name -> api_container_api.ServiceConfig.EnvVarsEntry
+	103, // 9: api_container_api.ServiceConfig.files_artifact_mountpoints:type_name -> api_container_api.ServiceConfig.FilesArtifactMountpointsEntry
+	104, // 10: api_container_api.ServiceConfig.kubernetes_service_account_annotations:type_name -> api_container_api.ServiceConfig.KubernetesServiceAccountAnnotationsEntry
+	5,   // 11: api_container_api.ServiceConfig.kubernetes_scheduling:type_name -> api_container_api.KubernetesScheduling
+	105, // 12: api_container_api.ServiceConfig.ulimits:type_name -> api_container_api.ServiceConfig.UlimitsEntry
+	106, // 13: api_container_api.ServiceConfig.sysctls:type_name -> api_container_api.ServiceConfig.SysctlsEntry
+	107, // 14: api_container_api.ServiceConfig.host_dir_mounts:type_name -> api_container_api.ServiceConfig.HostDirMountsEntry
+	108, // 15: api_container_api.KubernetesScheduling.node_selector:type_name -> api_container_api.KubernetesScheduling.NodeSelectorEntry
+	6,   // 16: api_container_api.KubernetesScheduling.tolerations:type_name -> api_container_api.KubernetesToleration
+	109, // 17: api_container_api.UpdateServiceConfig.private_ports:type_name -> api_container_api.UpdateServiceConfig.PrivatePortsEntry
+	110, // 18: api_container_api.UpdateServiceConfig.env_vars:type_name -> api_container_api.UpdateServiceConfig.EnvVarsEntry
+	12,  // 19: api_container_api.StarlarkRunResponseLine.instruction:type_name -> api_container_api.StarlarkInstruction
+	16,  // 20: api_container_api.StarlarkRunResponseLine.error:type_name -> api_container_api.StarlarkError
+	20,  // 21: api_container_api.StarlarkRunResponseLine.progress_info:type_name -> api_container_api.StarlarkRunProgress
+	13,  // 22: api_container_api.StarlarkRunResponseLine.instruction_result:type_name -> api_container_api.StarlarkInstructionResult
+	21,  // 23: api_container_api.StarlarkRunResponseLine.run_finished_event:type_name -> api_container_api.StarlarkRunFinishedEvent
+	22,  // 24: api_container_api.StarlarkRunResponseLine.resource_estimate:type_name -> api_container_api.StarlarkResourceEstimate
+	11,  // 25: api_container_api.StarlarkRunResponseLine.deprecation_warning:type_name -> api_container_api.StarlarkDeprecationWarning
+	15,  // 26: api_container_api.StarlarkDeprecationWarning.position:type_name -> api_container_api.StarlarkInstructionPosition
+	15,  // 27: api_container_api.StarlarkInstruction.position:type_name -> api_container_api.StarlarkInstructionPosition
+	14,  // 28: api_container_api.StarlarkInstruction.arguments:type_name -> api_container_api.StarlarkInstructionArg
+	17,  // 29: api_container_api.StarlarkError.interpretation_error:type_name -> api_container_api.StarlarkInterpretationError
+	18,  // 30: api_container_api.StarlarkError.validation_error:type_name -> api_container_api.StarlarkValidationError
+	19,  // 31: api_container_api.StarlarkError.execution_error:type_name -> api_container_api.StarlarkExecutionError
+	111, // 32: api_container_api.StarlarkRunFinishedEvent.resolved_package_versions:type_name -> api_container_api.StarlarkRunFinishedEvent.ResolvedPackageVersionsEntry
+	23,  // 33: api_container_api.StarlarkResourceEstimate.image_downloads:type_name -> api_container_api.StarlarkImageDownloadEstimate
+	112, // 34: api_container_api.StartServicesArgs.service_names_to_configs:type_name -> api_container_api.StartServicesArgs.ServiceNamesToConfigsEntry
+	113, // 35: api_container_api.StartServicesResponse.successful_service_name_to_service_info:type_name -> api_container_api.StartServicesResponse.SuccessfulServiceNameToServiceInfoEntry
+	114, // 36: api_container_api.StartServicesResponse.failed_service_name_to_error:type_name -> api_container_api.StartServicesResponse.FailedServiceNameToErrorEntry
+	115, // 37: api_container_api.GetServicesArgs.service_identifiers:type_name -> api_container_api.GetServicesArgs.ServiceIdentifiersEntry
+	116, // 38: api_container_api.GetServicesResponse.service_info:type_name -> api_container_api.GetServicesResponse.ServiceInfoEntry
+	28,  // 39: api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.allIdentifiers:type_name -> api_container_api.ServiceIdentifiers
+	117, // 40: api_container_api.GetServiceConfigsResponse.service_configs:type_name -> api_container_api.GetServiceConfigsResponse.ServiceConfigsEntry
+	31,  // 41: api_container_api.GetScrapeTargetsResponse.scrape_targets:type_name -> api_container_api.ScrapeTarget
+	118, // 42: api_container_api.AddPartitionArgs.service_name_set:type_name -> api_container_api.AddPartitionArgs.ServiceNameSetEntry
+	119, // 43: api_container_api.MovePartitionArgs.service_name_set:type_name -> api_container_api.MovePartitionArgs.ServiceNameSetEntry
+	49,  // 44: api_container_api.UpdateConnectionArgs.connection_info:type_name -> api_container_api.PartitionConnectionInfo
+	33,  // 45: api_container_api.SubnetworkConnectionOverride.connection:type_name -> api_container_api.SubnetworkConnection
+	33,  // 46: api_container_api.GetSubnetworkConnectionsResponse.default_connection:type_name -> api_container_api.SubnetworkConnection
+	37,  // 47: api_container_api.GetSubnetworkConnectionsResponse.connection_overrides:type_name -> api_container_api.SubnetworkConnectionOverride
+	120, // 48: api_container_api.TestConnectivityArgs.service_identifiers:type_name -> api_container_api.TestConnectivityArgs.ServiceIdentifiersEntry
+	40,  // 49: api_container_api.TestConnectivityResponse.probe_results:type_name -> api_container_api.ConnectivityProbeResult
+	121, // 50: api_container_api.RemoveServicesArgs.service_identifiers:type_name -> api_container_api.RemoveServicesArgs.ServiceIdentifiersEntry
+	122, // 51: api_container_api.RemoveServicesResponse.removed_service_name_to_uuid:type_name -> api_container_api.RemoveServicesResponse.RemovedServiceNameToUuidEntry
+	123, // 52: api_container_api.RemoveServicesResponse.failed_service_name_to_error:type_name -> api_container_api.RemoveServicesResponse.FailedServiceNameToErrorEntry
+	124, // 53: api_container_api.RepartitionArgs.partition_services:type_name -> api_container_api.RepartitionArgs.PartitionServicesEntry
+	125, // 54: api_container_api.RepartitionArgs.partition_connections:type_name -> api_container_api.RepartitionArgs.PartitionConnectionsEntry
+	49,  // 55: api_container_api.RepartitionArgs.default_connection:type_name -> api_container_api.PartitionConnectionInfo
+	126, // 56: api_container_api.PartitionServices.service_name_set:type_name -> api_container_api.PartitionServices.ServiceNameSetEntry
+	127, // 57: api_container_api.PartitionConnections.connection_info:type_name -> api_container_api.PartitionConnections.ConnectionInfoEntry
+	128, // 58: api_container_api.PauseServicesArgs.service_identifiers:type_name -> api_container_api.PauseServicesArgs.ServiceIdentifiersEntry
+	129, // 59: api_container_api.PauseServicesResponse.successful_service_names:type_name -> api_container_api.PauseServicesResponse.SuccessfulServiceNamesEntry
+	130, // 60: api_container_api.PauseServicesResponse.failed_service_name_to_error:type_name -> api_container_api.PauseServicesResponse.FailedServiceNameToErrorEntry
+	131, // 61: api_container_api.UnpauseServicesArgs.service_identifiers:type_name -> api_container_api.UnpauseServicesArgs.ServiceIdentifiersEntry
+	132, // 62: api_container_api.UnpauseServicesResponse.successful_service_names:type_name -> api_container_api.UnpauseServicesResponse.SuccessfulServiceNamesEntry
+	133, // 63: api_container_api.UnpauseServicesResponse.failed_service_name_to_error:type_name -> api_container_api.UnpauseServicesResponse.FailedServiceNameToErrorEntry
+	137, // 64: api_container_api.GetServiceMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	135, // 65: api_container_api.RenderTemplatesToFilesArtifactArgs.templates_and_data_by_destination_rel_filepath:type_name -> api_container_api.RenderTemplatesToFilesArtifactArgs.TemplatesAndDataByDestinationRelFilepathEntry
+	76,  // 66: api_container_api.ListFilesArtifactNamesAndUuidsResponse.file_names_and_uuids:type_name -> api_container_api.FilesArtifactNameAndUuid
+	81,  // 67: api_container_api.InspectFilesArtifactContentsResponse.file_descriptions:type_name -> api_container_api.FilesArtifactContentsFileDescription
+	84,  // 68: api_container_api.RunShutdownHooksResponse.results:type_name -> api_container_api.ShutdownHookResult
+	136, // 69: api_container_api.HttpRequestServiceArgs.headers:type_name -> api_container_api.HttpRequestServiceArgs.HeadersEntry
+	87,  // 70: api_container_api.HttpRequestServiceResponse.headers:type_name -> api_container_api.HttpHeader
+	90,  // 71: api_container_api.PackageCacheInfo.cached_packages:type_name -> api_container_api.CachedPackage
+	15,  // 72: api_container_api.StarlarkInstructionProfile.position:type_name -> api_container_api.StarlarkInstructionPosition
+	137, // 73: api_container_api.StarlarkRunProfile.started_at:type_name -> google.protobuf.Timestamp
+	94,  // 74: api_container_api.StarlarkRunProfile.instructions:type_name -> api_container_api.StarlarkInstructionProfile
+	96,  // 75: api_container_api.GetDeprecatedFeaturesResponse.deprecated_features:type_name -> api_container_api.DeprecatedFeature
+	1,   // 76: api_container_api.ServiceInfo.PrivatePortsEntry.value:type_name -> api_container_api.Port
+	1,   // 77: api_container_api.ServiceInfo.MaybePublicPortsEntry.value:type_name -> api_container_api.Port
+	1,   // 78: api_container_api.ServiceConfig.PrivatePortsEntry.value:type_name -> api_container_api.Port
+	1,   // 79: api_container_api.ServiceConfig.PublicPortsEntry.value:type_name -> api_container_api.Port
+	4,   // 80: api_container_api.ServiceConfig.UlimitsEntry.value:type_name -> api_container_api.Ulimit
+	1,   // 81: api_container_api.UpdateServiceConfig.PrivatePortsEntry.value:type_name -> api_container_api.Port
+	3,   // 82: api_container_api.StartServicesArgs.ServiceNamesToConfigsEntry.value:type_name -> api_container_api.ServiceConfig
+	2,   // 83: api_container_api.StartServicesResponse.SuccessfulServiceNameToServiceInfoEntry.value:type_name -> api_container_api.ServiceInfo
+	2,   // 84: api_container_api.GetServicesResponse.ServiceInfoEntry.value:type_name -> api_container_api.ServiceInfo
+	3,   // 85: api_container_api.GetServiceConfigsResponse.ServiceConfigsEntry.value:type_name -> api_container_api.ServiceConfig
+	47,  // 86: api_container_api.RepartitionArgs.PartitionServicesEntry.value:type_name -> api_container_api.PartitionServices
+	48,  // 87: api_container_api.RepartitionArgs.PartitionConnectionsEntry.value:type_name -> api_container_api.PartitionConnections
+	49,  // 88: api_container_api.PartitionConnections.ConnectionInfoEntry.value:type_name -> api_container_api.PartitionConnectionInfo
+	134, // 89: api_container_api.RenderTemplatesToFilesArtifactArgs.TemplatesAndDataByDestinationRelFilepathEntry.value:type_name -> api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData
+	8,   // 90: api_container_api.ApiContainerService.RunStarlarkScript:input_type -> api_container_api.RunStarlarkScriptArgs
+	9,   // 91: api_container_api.ApiContainerService.RunStarlarkPackage:input_type -> api_container_api.RunStarlarkPackageArgs
+	24,  // 92: api_container_api.ApiContainerService.StartServices:input_type -> api_container_api.StartServicesArgs
+	26,  // 93: api_container_api.ApiContainerService.GetServices:input_type -> api_container_api.GetServicesArgs
+	138, // 94: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:input_type -> google.protobuf.Empty
+	138, // 95: api_container_api.ApiContainerService.GetServiceConfigs:input_type -> google.protobuf.Empty
+	138, // 96: api_container_api.ApiContainerService.GetScrapeTargets:input_type -> google.protobuf.Empty
+	138, // 97: api_container_api.ApiContainerService.GetSubnetworkConnections:input_type -> google.protobuf.Empty
+	42,  // 98: api_container_api.ApiContainerService.RemoveService:input_type -> api_container_api.RemoveServiceArgs
+	44,  // 99: api_container_api.ApiContainerService.RemoveServices:input_type -> api_container_api.RemoveServicesArgs
+	46,  // 100: api_container_api.ApiContainerService.Repartition:input_type -> api_container_api.RepartitionArgs
+	34,  // 101: api_container_api.ApiContainerService.AddPartition:input_type -> api_container_api.AddPartitionArgs
+	35,  // 102: api_container_api.ApiContainerService.MovePartition:input_type -> api_container_api.MovePartitionArgs
+	36,  // 103: api_container_api.ApiContainerService.UpdateConnection:input_type -> api_container_api.UpdateConnectionArgs
+	39,  // 104: api_container_api.ApiContainerService.TestConnectivity:input_type -> api_container_api.TestConnectivityArgs
+	50,  // 105: api_container_api.ApiContainerService.ExecCommand:input_type -> api_container_api.ExecCommandArgs
+	50,  // 106: api_container_api.ApiContainerService.ExecCommandWithStreamedOutput:input_type -> api_container_api.ExecCommandArgs
+	51,  // 107: api_container_api.ApiContainerService.PauseService:input_type -> api_container_api.PauseServiceArgs
+	52,  // 108: api_container_api.ApiContainerService.UnpauseService:input_type -> api_container_api.UnpauseServiceArgs
+	53,  // 109: api_container_api.ApiContainerService.PauseServices:input_type -> api_container_api.PauseServicesArgs
+	55,  // 110: api_container_api.ApiContainerService.UnpauseServices:input_type -> api_container_api.UnpauseServicesArgs
+	57,  // 111: api_container_api.ApiContainerService.WaitForServiceExit:input_type -> api_container_api.WaitForServiceExitArgs
+	61,  // 112: api_container_api.ApiContainerService.GetServiceMetrics:input_type -> api_container_api.GetServiceMetricsArgs
+	63,  // 113: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:input_type -> api_container_api.WaitForHttpGetEndpointAvailabilityArgs
+	64,  // 114: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:input_type -> api_container_api.WaitForHttpPostEndpointAvailabilityArgs
+	65,  // 115: api_container_api.ApiContainerService.UploadFilesArtifact:input_type -> api_container_api.UploadFilesArtifactArgs
+	69,  // 116: api_container_api.ApiContainerService.UploadFilesArtifactStream:input_type -> api_container_api.FilesArtifactStreamChunk
+	67,  // 117: api_container_api.ApiContainerService.DownloadFilesArtifact:input_type -> api_container_api.DownloadFilesArtifactArgs
+	67,  // 118: api_container_api.ApiContainerService.DownloadFilesArtifactStream:input_type -> api_container_api.DownloadFilesArtifactArgs
+	70,  // 119: api_container_api.ApiContainerService.StoreWebFilesArtifact:input_type -> api_container_api.StoreWebFilesArtifactArgs
+	72,  // 120: api_container_api.ApiContainerService.StoreFilesArtifactFromService:input_type -> api_container_api.StoreFilesArtifactFromServiceArgs
+	74,  // 121: api_container_api.ApiContainerService.RenderTemplatesToFilesArtifact:input_type -> api_container_api.RenderTemplatesToFilesArtifactArgs
+	138, // 122: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:input_type -> google.protobuf.Empty
+	78,  // 123: api_container_api.ApiContainerService.DeleteFilesArtifact:input_type -> api_container_api.DeleteFilesArtifactArgs
+	79,  // 124: api_container_api.ApiContainerService.InspectFilesArtifactContents:input_type -> api_container_api.InspectFilesArtifactContentsArgs
+	82,  // 125: api_container_api.ApiContainerService.SetLogLevel:input_type -> api_container_api.SetLogLevelArgs
+	138, // 126: api_container_api.ApiContainerService.RunShutdownHooks:input_type -> google.protobuf.Empty
+	86,  // 127: api_container_api.ApiContainerService.HttpRequestService:input_type -> api_container_api.HttpRequestServiceArgs
+	138, // 128: api_container_api.ApiContainerService.GetBackendCapabilities:input_type -> google.protobuf.Empty
+	138, // 129: api_container_api.ApiContainerService.GetPackageCacheInfo:input_type -> google.protobuf.Empty
+	138, // 130: api_container_api.ApiContainerService.ClearPackageCache:input_type -> google.protobuf.Empty
+	93,  // 131: api_container_api.ApiContainerService.GetStarlarkRunProfile:input_type -> api_container_api.GetStarlarkRunProfileArgs
+	138, // 132: api_container_api.ApiContainerService.GetDeprecatedFeatures:input_type -> google.protobuf.Empty
+	10,  // 133: api_container_api.ApiContainerService.RunStarlarkScript:output_type -> api_container_api.StarlarkRunResponseLine
+	10,  // 134: api_container_api.ApiContainerService.RunStarlarkPackage:output_type -> api_container_api.StarlarkRunResponseLine
+	25,  // 135: api_container_api.ApiContainerService.StartServices:output_type -> api_container_api.StartServicesResponse
+	27,  // 136: api_container_api.ApiContainerService.GetServices:output_type -> api_container_api.GetServicesResponse
+	29,  // 137: api_container_api.ApiContainerService.GetExistingAndHistoricalServiceIdentifiers:output_type -> api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse
+	30,  // 138: api_container_api.ApiContainerService.GetServiceConfigs:output_type -> api_container_api.GetServiceConfigsResponse
+	32,  // 139: api_container_api.ApiContainerService.GetScrapeTargets:output_type -> api_container_api.GetScrapeTargetsResponse
+	38,  // 140: api_container_api.ApiContainerService.GetSubnetworkConnections:output_type -> api_container_api.GetSubnetworkConnectionsResponse
+	43,  // 141: api_container_api.ApiContainerService.RemoveService:output_type -> api_container_api.RemoveServiceResponse
+	45,  // 142: api_container_api.ApiContainerService.RemoveServices:output_type -> api_container_api.RemoveServicesResponse
+	138, // 143: api_container_api.ApiContainerService.Repartition:output_type -> google.protobuf.Empty
+	138, // 144: api_container_api.ApiContainerService.AddPartition:output_type -> google.protobuf.Empty
+	138, // 145: api_container_api.ApiContainerService.MovePartition:output_type -> google.protobuf.Empty
+	138, // 146: api_container_api.ApiContainerService.UpdateConnection:output_type -> google.protobuf.Empty
+	41,  // 147: api_container_api.ApiContainerService.TestConnectivity:output_type -> api_container_api.TestConnectivityResponse
+	59,  // 148: api_container_api.ApiContainerService.ExecCommand:output_type -> api_container_api.ExecCommandResponse
+	60,  // 149: api_container_api.ApiContainerService.ExecCommandWithStreamedOutput:output_type -> api_container_api.ExecCommandStreamedOutputChunk
+	138, // 150: api_container_api.ApiContainerService.PauseService:output_type -> google.protobuf.Empty
+	138, // 151: api_container_api.ApiContainerService.UnpauseService:output_type -> google.protobuf.Empty
+	54,  // 152: api_container_api.ApiContainerService.PauseServices:output_type -> api_container_api.PauseServicesResponse
+	56,  // 153: api_container_api.ApiContainerService.UnpauseServices:output_type -> api_container_api.UnpauseServicesResponse
+	58,  // 154: api_container_api.ApiContainerService.WaitForServiceExit:output_type -> api_container_api.WaitForServiceExitResponse
+	62,  // 155: api_container_api.ApiContainerService.GetServiceMetrics:output_type -> api_container_api.GetServiceMetricsResponse
+	138, // 156: api_container_api.ApiContainerService.WaitForHttpGetEndpointAvailability:output_type -> google.protobuf.Empty
+	138, // 157: api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability:output_type -> google.protobuf.Empty
+	66,  // 158: api_container_api.ApiContainerService.UploadFilesArtifact:output_type -> api_container_api.UploadFilesArtifactResponse
+	66,  // 159: api_container_api.ApiContainerService.UploadFilesArtifactStream:output_type -> api_container_api.UploadFilesArtifactResponse
+	68,  // 160: api_container_api.ApiContainerService.DownloadFilesArtifact:output_type -> api_container_api.DownloadFilesArtifactResponse
+	69,  // 161: api_container_api.ApiContainerService.DownloadFilesArtifactStream:output_type -> api_container_api.FilesArtifactStreamChunk
+	71,  // 162: api_container_api.ApiContainerService.StoreWebFilesArtifact:output_type -> api_container_api.StoreWebFilesArtifactResponse
+	73,  // 163: api_container_api.ApiContainerService.StoreFilesArtifactFromService:output_type -> api_container_api.StoreFilesArtifactFromServiceResponse
+	75,  // 164: api_container_api.ApiContainerService.RenderTemplatesToFilesArtifact:output_type -> api_container_api.RenderTemplatesToFilesArtifactResponse
+	77,  // 165: api_container_api.ApiContainerService.ListFilesArtifactNamesAndUuids:output_type -> api_container_api.ListFilesArtifactNamesAndUuidsResponse
+	138, // 166: api_container_api.ApiContainerService.DeleteFilesArtifact:output_type -> google.protobuf.Empty
+	80,  // 167: api_container_api.ApiContainerService.InspectFilesArtifactContents:output_type -> api_container_api.InspectFilesArtifactContentsResponse
+	83,  // 168: api_container_api.ApiContainerService.SetLogLevel:output_type -> api_container_api.SetLogLevelResponse
+	85,  // 169: api_container_api.ApiContainerService.RunShutdownHooks:output_type -> api_container_api.RunShutdownHooksResponse
+	88,  // 170: api_container_api.ApiContainerService.HttpRequestService:output_type -> api_container_api.HttpRequestServiceResponse
+	89,  // 171: api_container_api.ApiContainerService.GetBackendCapabilities:output_type -> api_container_api.GetBackendCapabilitiesResponse
+	91,  // 172: api_container_api.ApiContainerService.GetPackageCacheInfo:output_type -> api_container_api.PackageCacheInfo
+	92,  // 173: api_container_api.ApiContainerService.ClearPackageCache:output_type -> api_container_api.ClearPackageCacheResponse
+	95,  // 174: api_container_api.ApiContainerService.GetStarlarkRunProfile:output_type -> api_container_api.StarlarkRunProfile
+	97,  // 175: api_container_api.ApiContainerService.GetDeprecatedFeatures:output_type -> api_container_api.GetDeprecatedFeaturesResponse
+	133, // [133:176] is the sub-list for method output_type
+	90,  // [90:133] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
+}
+
+func init() { file_api_container_service_proto_init() }
+func file_api_container_service_proto_init() {
+	if File_api_container_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_container_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Port); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ulimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesScheduling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesToleration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateServiceConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunStarlarkScriptArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunStarlarkPackageArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkRunResponseLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkDeprecationWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkInstruction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkInstructionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkInstructionArg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkInstructionPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkInterpretationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkExecutionError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkRunProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkRunFinishedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkResourceEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkImageDownloadEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartServicesArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServicesArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceIdentifiers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExistingAndHistoricalServiceIdentifiersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceConfigsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScrapeTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScrapeTargetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetworkConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPartitionArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MovePartitionArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateConnectionArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubnetworkConnectionOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubnetworkConnectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestConnectivityArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4058,8 +9338,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceInfo); i {
+		file_api_container_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectivityProbeResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4070,8 +9350,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceConfig); i {
+		file_api_container_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestConnectivityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4082,8 +9362,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateServiceConfig); i {
+		file_api_container_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServiceArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4094,8 +9374,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunStarlarkScriptArgs); i {
+		file_api_container_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4106,8 +9386,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RunStarlarkPackageArgs); i {
+		file_api_container_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServicesArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4118,8 +9398,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkRunResponseLine); i {
+		file_api_container_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveServicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4130,8 +9410,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkInstruction); i {
+		file_api_container_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepartitionArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4142,8 +9422,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkInstructionResult); i {
+		file_api_container_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionServices); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4154,8 +9434,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkInstructionArg); i {
+		file_api_container_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionConnections); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4166,8 +9446,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkInstructionPosition); i {
+		file_api_container_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionConnectionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4178,8 +9458,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkError); i {
+		file_api_container_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecCommandArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4190,8 +9470,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkInterpretationError); i {
+		file_api_container_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseServiceArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4202,8 +9482,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkValidationError); i {
+		file_api_container_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpauseServiceArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4214,8 +9494,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkExecutionError); i {
+		file_api_container_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseServicesArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4226,8 +9506,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkRunProgress); i {
+		file_api_container_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseServicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4238,8 +9518,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StarlarkRunFinishedEvent); i {
+		file_api_container_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpauseServicesArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4250,8 +9530,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartServicesArgs); i {
+		file_api_container_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpauseServicesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4262,8 +9542,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartServicesResponse); i {
+		file_api_container_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForServiceExitArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4274,8 +9554,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServicesArgs); i {
+		file_api_container_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForServiceExitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4286,8 +9566,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServicesResponse); i {
+		file_api_container_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecCommandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4298,8 +9578,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServiceIdentifiers); i {
+		file_api_container_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecCommandStreamedOutputChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4310,8 +9590,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExistingAndHistoricalServiceIdentifiersResponse); i {
+		file_api_container_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceMetricsArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4322,8 +9602,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveServiceArgs); i {
+		file_api_container_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceMetricsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4334,8 +9614,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveServiceResponse); i {
+		file_api_container_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForHttpGetEndpointAvailabilityArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4346,8 +9626,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepartitionArgs); i {
+		file_api_container_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForHttpPostEndpointAvailabilityArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4358,8 +9638,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartitionServices); i {
+		file_api_container_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFilesArtifactArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4370,8 +9650,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartitionConnections); i {
+		file_api_container_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFilesArtifactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4382,8 +9662,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PartitionConnectionInfo); i {
+		file_api_container_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFilesArtifactArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4394,8 +9674,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecCommandArgs); i {
+		file_api_container_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadFilesArtifactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4406,8 +9686,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseServiceArgs); i {
+		file_api_container_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesArtifactStreamChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4418,8 +9698,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnpauseServiceArgs); i {
+		file_api_container_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreWebFilesArtifactArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4430,8 +9710,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecCommandResponse); i {
+		file_api_container_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreWebFilesArtifactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4442,8 +9722,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitForHttpGetEndpointAvailabilityArgs); i {
+		file_api_container_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreFilesArtifactFromServiceArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4454,8 +9734,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaitForHttpPostEndpointAvailabilityArgs); i {
+		file_api_container_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoreFilesArtifactFromServiceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4466,8 +9746,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadFilesArtifactArgs); i {
+		file_api_container_service_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderTemplatesToFilesArtifactArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4478,8 +9758,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadFilesArtifactResponse); i {
+		file_api_container_service_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderTemplatesToFilesArtifactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4490,8 +9770,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadFilesArtifactArgs); i {
+		file_api_container_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesArtifactNameAndUuid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4502,8 +9782,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadFilesArtifactResponse); i {
+		file_api_container_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesArtifactNamesAndUuidsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4514,8 +9794,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreWebFilesArtifactArgs); i {
+		file_api_container_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFilesArtifactArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4526,8 +9806,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreWebFilesArtifactResponse); i {
+		file_api_container_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectFilesArtifactContentsArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4538,8 +9818,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreFilesArtifactFromServiceArgs); i {
+		file_api_container_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectFilesArtifactContentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4550,8 +9830,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StoreFilesArtifactFromServiceResponse); i {
+		file_api_container_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilesArtifactContentsFileDescription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4562,8 +9842,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenderTemplatesToFilesArtifactArgs); i {
+		file_api_container_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4574,8 +9854,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenderTemplatesToFilesArtifactResponse); i {
+		file_api_container_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4586,8 +9866,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilesArtifactNameAndUuid); i {
+		file_api_container_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShutdownHookResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4598,8 +9878,8 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFilesArtifactNamesAndUuidsResponse); i {
+		file_api_container_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunShutdownHooksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4610,7 +9890,151 @@ func file_api_container_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_container_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_api_container_service_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpRequestServiceArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpRequestServiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBackendCapabilitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CachedPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PackageCacheInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearPackageCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStarlarkRunProfileArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkInstructionProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StarlarkRunProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeprecatedFeature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeprecatedFeaturesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_container_service_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenderTemplatesToFilesArtifactArgs_TemplateAndData); i {
 			case 0:
 				return &v.state
@@ -4624,33 +10048,44 @@ func file_api_container_service_proto_init() {
 		}
 	}
 	file_api_container_service_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_api_container_service_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_api_container_service_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_api_container_service_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_api_container_service_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*RunStarlarkPackageArgs_Local)(nil),
 		(*RunStarlarkPackageArgs_Remote)(nil),
 	}
-	file_api_container_service_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_api_container_service_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*StarlarkRunResponseLine_Instruction)(nil),
 		(*StarlarkRunResponseLine_Error)(nil),
 		(*StarlarkRunResponseLine_ProgressInfo)(nil),
 		(*StarlarkRunResponseLine_InstructionResult)(nil),
 		(*StarlarkRunResponseLine_RunFinishedEvent)(nil),
+		(*StarlarkRunResponseLine_ResourceEstimate)(nil),
+		(*StarlarkRunResponseLine_DeprecationWarning)(nil),
 	}
-	file_api_container_service_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_api_container_service_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_api_container_service_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*StarlarkError_InterpretationError)(nil),
 		(*StarlarkError_ValidationError)(nil),
 		(*StarlarkError_ExecutionError)(nil),
 	}
-	file_api_container_service_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[59].OneofWrappers = []interface{}{
+		(*ExecCommandStreamedOutputChunk_Output)(nil),
+		(*ExecCommandStreamedOutputChunk_ExitCode)(nil),
+	}
+	file_api_container_service_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[83].OneofWrappers = []interface{}{}
+	file_api_container_service_proto_msgTypes[92].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_container_service_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   64,
+			NumMessages:   136,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,19 +25,44 @@ const (
 	ApiContainerService_StartServices_FullMethodName                              = "/api_container_api.ApiContainerService/StartServices"
 	ApiContainerService_GetServices_FullMethodName                                = "/api_container_api.ApiContainerService/GetServices"
 	ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_FullMethodName = "/api_container_api.ApiContainerService/GetExistingAndHistoricalServiceIdentifiers"
+	ApiContainerService_GetServiceConfigs_FullMethodName                          = "/api_container_api.ApiContainerService/GetServiceConfigs"
+	ApiContainerService_GetScrapeTargets_FullMethodName                           = "/api_container_api.ApiContainerService/GetScrapeTargets"
+	ApiContainerService_GetSubnetworkConnections_FullMethodName                   = "/api_container_api.ApiContainerService/GetSubnetworkConnections"
 	ApiContainerService_RemoveService_FullMethodName                              = "/api_container_api.ApiContainerService/RemoveService"
+	ApiContainerService_RemoveServices_FullMethodName                             = "/api_container_api.ApiContainerService/RemoveServices"
 	ApiContainerService_Repartition_FullMethodName                                = "/api_container_api.ApiContainerService/Repartition"
+	ApiContainerService_AddPartition_FullMethodName                               = "/api_container_api.ApiContainerService/AddPartition"
+	ApiContainerService_MovePartition_FullMethodName                              = "/api_container_api.ApiContainerService/MovePartition"
+	ApiContainerService_UpdateConnection_FullMethodName                           = "/api_container_api.ApiContainerService/UpdateConnection"
+	ApiContainerService_TestConnectivity_FullMethodName                           = "/api_container_api.ApiContainerService/TestConnectivity"
 	ApiContainerService_ExecCommand_FullMethodName                                = "/api_container_api.ApiContainerService/ExecCommand"
+	ApiContainerService_ExecCommandWithStreamedOutput_FullMethodName              = "/api_container_api.ApiContainerService/ExecCommandWithStreamedOutput"
 	ApiContainerService_PauseService_FullMethodName                               = "/api_container_api.ApiContainerService/PauseService"
 	ApiContainerService_UnpauseService_FullMethodName                             = "/api_container_api.ApiContainerService/UnpauseService"
+	ApiContainerService_PauseServices_FullMethodName                              = "/api_container_api.ApiContainerService/PauseServices"
+	ApiContainerService_UnpauseServices_FullMethodName                            = "/api_container_api.ApiContainerService/UnpauseServices"
+	ApiContainerService_WaitForServiceExit_FullMethodName                         = "/api_container_api.ApiContainerService/WaitForServiceExit"
+	ApiContainerService_GetServiceMetrics_FullMethodName                          = "/api_container_api.ApiContainerService/GetServiceMetrics"
 	ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName         = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
 	ApiContainerService_WaitForHttpPostEndpointAvailability_FullMethodName        = "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability"
 	ApiContainerService_UploadFilesArtifact_FullMethodName                        = "/api_container_api.ApiContainerService/UploadFilesArtifact"
+	ApiContainerService_UploadFilesArtifactStream_FullMethodName                  = "/api_container_api.ApiContainerService/UploadFilesArtifactStream"
 	ApiContainerService_DownloadFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/DownloadFilesArtifact"
+	ApiContainerService_DownloadFilesArtifactStream_FullMethodName                = "/api_container_api.ApiContainerService/DownloadFilesArtifactStream"
 	ApiContainerService_StoreWebFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/StoreWebFilesArtifact"
 	ApiContainerService_StoreFilesArtifactFromService_FullMethodName              = "/api_container_api.ApiContainerService/StoreFilesArtifactFromService"
 	ApiContainerService_RenderTemplatesToFilesArtifact_FullMethodName             = "/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact"
 	ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName             = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
+	ApiContainerService_DeleteFilesArtifact_FullMethodName                        = "/api_container_api.ApiContainerService/DeleteFilesArtifact"
+	ApiContainerService_InspectFilesArtifactContents_FullMethodName               = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
+	ApiContainerService_SetLogLevel_FullMethodName                                = "/api_container_api.ApiContainerService/SetLogLevel"
+	ApiContainerService_RunShutdownHooks_FullMethodName                           = "/api_container_api.ApiContainerService/RunShutdownHooks"
+	ApiContainerService_HttpRequestService_FullMethodName                         = "/api_container_api.ApiContainerService/HttpRequestService"
+	ApiContainerService_GetBackendCapabilities_FullMethodName                     = "/api_container_api.ApiContainerService/GetBackendCapabilities"
+	ApiContainerService_GetPackageCacheInfo_FullMethodName                        = "/api_container_api.ApiContainerService/GetPackageCacheInfo"
+	ApiContainerService_ClearPackageCache_FullMethodName                          = "/api_container_api.ApiContainerService/ClearPackageCache"
+	ApiContainerService_GetStarlarkRunProfile_FullMethodName                      = "/api_container_api.ApiContainerService/GetStarlarkRunProfile"
+	ApiContainerService_GetDeprecatedFeatures_FullMethodName                      = "/api_container_api.ApiContainerService/GetDeprecatedFeatures"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -54,25 +79,60 @@ type ApiContainerServiceClient interface {
 	GetServices(ctx context.Context, in *GetServicesArgs, opts ...grpc.CallOption) (*GetServicesResponse, error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
+	// Returns the configs the current services in the enclave were started with
+	GetServiceConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetServiceConfigsResponse, error)
+	// Returns the endpoints to scrape the Prometheus metrics of the services in the enclave from
+	GetScrapeTargets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetScrapeTargetsResponse, error)
+	// Returns the default connection between subnetworks, and the connections between subnetworks that override it
+	GetSubnetworkConnections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSubnetworkConnectionsResponse, error)
 	// Instructs the API container to remove the given service
 	RemoveService(ctx context.Context, in *RemoveServiceArgs, opts ...grpc.CallOption) (*RemoveServiceResponse, error)
+	// Instructs the API container to remove the given services, the services matching the given name patterns, or all
+	// the services, at once
+	RemoveServices(ctx context.Context, in *RemoveServicesArgs, opts ...grpc.CallOption) (*RemoveServicesResponse, error)
 	// Instructs the API container to repartition the enclave
 	Repartition(ctx context.Context, in *RepartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Instructs the API container to create a new partition and move the given services to it, only updating the
+	//  services whose connections change
+	AddPartition(ctx context.Context, in *AddPartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Instructs the API container to move the given services to an existing partition, only updating the services whose
+	//  connections change
+	MovePartition(ctx context.Context, in *MovePartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Instructs the API container to update the connection between two partitions, only updating the services in those
+	//  partitions
+	UpdateConnection(ctx context.Context, in *UpdateConnectionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pings every given service from every other one and returns the observed connection of every pair along with the
+	//  connection the partition topology expects, to find blocked or slow paths
+	TestConnectivity(ctx context.Context, in *TestConnectivityArgs, opts ...grpc.CallOption) (*TestConnectivityResponse, error)
 	// Executes the given command inside a running container
 	ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error)
+	// Executes the given command inside a running container, streaming its output back in chunks followed by its exit
+	// code, so commands with outputs too big to be held in memory can be run
+	ExecCommandWithStreamedOutput(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (ApiContainerService_ExecCommandWithStreamedOutputClient, error)
 	// Pauses all processes running in the service container
 	PauseService(ctx context.Context, in *PauseServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unpauses all paused processes running in the service container
 	UnpauseService(ctx context.Context, in *UnpauseServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pauses all processes running in the containers of the given services, or of all the services, at once
+	PauseServices(ctx context.Context, in *PauseServicesArgs, opts ...grpc.CallOption) (*PauseServicesResponse, error)
+	// Unpauses all paused processes running in the containers of the given services, or of all the services, at once
+	UnpauseServices(ctx context.Context, in *UnpauseServicesArgs, opts ...grpc.CallOption) (*UnpauseServicesResponse, error)
+	// Blocks until the container of the service exits, e.g. a service running a test suite, returning its exit code
+	WaitForServiceExit(ctx context.Context, in *WaitForServiceExitArgs, opts ...grpc.CallOption) (*WaitForServiceExitResponse, error)
+	// Gets a snapshot of the CPU, memory and network usage of the service container
+	GetServiceMetrics(ctx context.Context, in *GetServiceMetricsArgs, opts ...grpc.CallOption) (*GetServiceMetricsResponse, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(ctx context.Context, in *WaitForHttpPostEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(ctx context.Context, in *UploadFilesArtifactArgs, opts ...grpc.CallOption) (*UploadFilesArtifactResponse, error)
-	// TODO Make this a server-side streaming method so the client can download large files
+	// Uploads a files artifact to the Kurtosis File System in chunks, so that it can be bigger than the gRPC message limit
+	UploadFilesArtifactStream(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactStreamClient, error)
 	// Downloads a files artifact from the Kurtosis File System
 	DownloadFilesArtifact(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (*DownloadFilesArtifactResponse, error)
+	// Downloads a files artifact from the Kurtosis File System in chunks, so that it can be bigger than the gRPC message limit
+	DownloadFilesArtifactStream(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadFilesArtifactStreamClient, error)
 	// Tells the API container to download a files artifact from the web to the Kurtosis File System
 	StoreWebFilesArtifact(ctx context.Context, in *StoreWebFilesArtifactArgs, opts ...grpc.CallOption) (*StoreWebFilesArtifactResponse, error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
@@ -80,6 +140,29 @@ type ApiContainerServiceClient interface {
 	// Renders the templates and their data to a files artifact in the Kurtosis File System
 	RenderTemplatesToFilesArtifact(ctx context.Context, in *RenderTemplatesToFilesArtifactArgs, opts ...grpc.CallOption) (*RenderTemplatesToFilesArtifactResponse, error)
 	ListFilesArtifactNamesAndUuids(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFilesArtifactNamesAndUuidsResponse, error)
+	// Deletes a files artifact from the Kurtosis File System, unless it's mounted on services
+	DeleteFilesArtifact(ctx context.Context, in *DeleteFilesArtifactArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the files inside a files artifact, without having to download it
+	InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsArgs, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error)
+	// Changes the log level of the API container at runtime, e.g. to temporarily debug an issue without restarting it
+	SetLogLevel(ctx context.Context, in *SetLogLevelArgs, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// Runs the shutdown hooks registered by the Starlark code run in the enclave, which should happen right before the
+	// enclave gets stopped or destroyed. Each hook is only ever run once
+	RunShutdownHooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RunShutdownHooksResponse, error)
+	// Makes an HTTP request to a private port of a service from inside the enclave network, e.g. to probe a service whose
+	// ports aren't published without needing an HTTP client in its image
+	HttpRequestService(ctx context.Context, in *HttpRequestServiceArgs, opts ...grpc.CallOption) (*HttpRequestServiceResponse, error)
+	// Tells which of the optional features of Kurtosis the backend of the enclave supports, so that clients can fail
+	// upfront when asked for one that isn't supported
+	GetBackendCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBackendCapabilitiesResponse, error)
+	// Lists the packages cloned into the enclave, along with the size limit of the package cache and how often it gets hit
+	GetPackageCacheInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PackageCacheInfo, error)
+	// Removes all the packages cloned into the enclave, which will get cloned again the next time they're used
+	ClearPackageCache(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClearPackageCacheResponse, error)
+	// Returns how long each instruction of a past Starlark run took and what it created, for profiling slow packages
+	GetStarlarkRunProfile(ctx context.Context, in *GetStarlarkRunProfileArgs, opts ...grpc.CallOption) (*StarlarkRunProfile, error)
+	// Lists the Starlark features deprecated in this version of Kurtosis, so that SDKs can warn before using them
+	GetDeprecatedFeatures(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeprecatedFeaturesResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -181,6 +264,33 @@ func (c *apiContainerServiceClient) GetExistingAndHistoricalServiceIdentifiers(c
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetServiceConfigs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetServiceConfigsResponse, error) {
+	out := new(GetServiceConfigsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetServiceConfigs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetScrapeTargets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetScrapeTargetsResponse, error) {
+	out := new(GetScrapeTargetsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetScrapeTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetSubnetworkConnections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSubnetworkConnectionsResponse, error) {
+	out := new(GetSubnetworkConnectionsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetSubnetworkConnections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) RemoveService(ctx context.Context, in *RemoveServiceArgs, opts ...grpc.CallOption) (*RemoveServiceResponse, error) {
 	out := new(RemoveServiceResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_RemoveService_FullMethodName, in, out, opts...)
@@ -190,6 +300,15 @@ func (c *apiContainerServiceClient) RemoveService(ctx context.Context, in *Remov
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) RemoveServices(ctx context.Context, in *RemoveServicesArgs, opts ...grpc.CallOption) (*RemoveServicesResponse, error) {
+	out := new(RemoveServicesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_RemoveServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) Repartition(ctx context.Context, in *RepartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ApiContainerService_Repartition_FullMethodName, in, out, opts...)
@@ -199,6 +318,42 @@ func (c *apiContainerServiceClient) Repartition(ctx context.Context, in *Reparti
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) AddPartition(ctx context.Context, in *AddPartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_AddPartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) MovePartition(ctx context.Context, in *MovePartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_MovePartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) UpdateConnection(ctx context.Context, in *UpdateConnectionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_UpdateConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) TestConnectivity(ctx context.Context, in *TestConnectivityArgs, opts ...grpc.CallOption) (*TestConnectivityResponse, error) {
+	out := new(TestConnectivityResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_TestConnectivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (*ExecCommandResponse, error) {
 	out := new(ExecCommandResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_ExecCommand_FullMethodName, in, out, opts...)
@@ -208,6 +363,38 @@ func (c *apiContainerServiceClient) ExecCommand(ctx context.Context, in *ExecCom
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) ExecCommandWithStreamedOutput(ctx context.Context, in *ExecCommandArgs, opts ...grpc.CallOption) (ApiContainerService_ExecCommandWithStreamedOutputClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[2], ApiContainerService_ExecCommandWithStreamedOutput_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceExecCommandWithStreamedOutputClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_ExecCommandWithStreamedOutputClient interface {
+	Recv() (*ExecCommandStreamedOutputChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceExecCommandWithStreamedOutputClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceExecCommandWithStreamedOutputClient) Recv() (*ExecCommandStreamedOutputChunk, error) {
+	m := new(ExecCommandStreamedOutputChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) PauseService(ctx context.Context, in *PauseServiceArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ApiContainerService_PauseService_FullMethodName, in, out, opts...)
@@ -226,6 +413,42 @@ func (c *apiContainerServiceClient) UnpauseService(ctx context.Context, in *Unpa
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) PauseServices(ctx context.Context, in *PauseServicesArgs, opts ...grpc.CallOption) (*PauseServicesResponse, error) {
+	out := new(PauseServicesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_PauseServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) UnpauseServices(ctx context.Context, in *UnpauseServicesArgs, opts ...grpc.CallOption) (*UnpauseServicesResponse, error) {
+	out := new(UnpauseServicesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_UnpauseServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) WaitForServiceExit(ctx context.Context, in *WaitForServiceExitArgs, opts ...grpc.CallOption) (*WaitForServiceExitResponse, error) {
+	out := new(WaitForServiceExitResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_WaitForServiceExit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetServiceMetrics(ctx context.Context, in *GetServiceMetricsArgs, opts ...grpc.CallOption) (*GetServiceMetricsResponse, error) {
+	out := new(GetServiceMetricsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetServiceMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName, in, out, opts...)
@@ -253,6 +476,40 @@ func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context, in
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) UploadFilesArtifactStream(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_UploadFilesArtifactStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceUploadFilesArtifactStreamClient{stream}
+	return x, nil
+}
+
+type ApiContainerService_UploadFilesArtifactStreamClient interface {
+	Send(*FilesArtifactStreamChunk) error
+	CloseAndRecv() (*UploadFilesArtifactResponse, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceUploadFilesArtifactStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceUploadFilesArtifactStreamClient) Send(m *FilesArtifactStreamChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceUploadFilesArtifactStreamClient) CloseAndRecv() (*UploadFilesArtifactResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadFilesArtifactResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) DownloadFilesArtifact(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (*DownloadFilesArtifactResponse, error) {
 	out := new(DownloadFilesArtifactResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_DownloadFilesArtifact_FullMethodName, in, out, opts...)
@@ -262,6 +519,38 @@ func (c *apiContainerServiceClient) DownloadFilesArtifact(ctx context.Context, i
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) DownloadFilesArtifactStream(ctx context.Context, in *DownloadFilesArtifactArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadFilesArtifactStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[4], ApiContainerService_DownloadFilesArtifactStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceDownloadFilesArtifactStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_DownloadFilesArtifactStreamClient interface {
+	Recv() (*FilesArtifactStreamChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceDownloadFilesArtifactStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceDownloadFilesArtifactStreamClient) Recv() (*FilesArtifactStreamChunk, error) {
+	m := new(FilesArtifactStreamChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiContainerServiceClient) StoreWebFilesArtifact(ctx context.Context, in *StoreWebFilesArtifactArgs, opts ...grpc.CallOption) (*StoreWebFilesArtifactResponse, error) {
 	out := new(StoreWebFilesArtifactResponse)
 	err := c.cc.Invoke(ctx, ApiContainerService_StoreWebFilesArtifact_FullMethodName, in, out, opts...)
@@ -298,6 +587,96 @@ func (c *apiContainerServiceClient) ListFilesArtifactNamesAndUuids(ctx context.C
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) DeleteFilesArtifact(ctx context.Context, in *DeleteFilesArtifactArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_DeleteFilesArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsArgs, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error) {
+	out := new(InspectFilesArtifactContentsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_InspectFilesArtifactContents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelArgs, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RunShutdownHooks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RunShutdownHooksResponse, error) {
+	out := new(RunShutdownHooksResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_RunShutdownHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) HttpRequestService(ctx context.Context, in *HttpRequestServiceArgs, opts ...grpc.CallOption) (*HttpRequestServiceResponse, error) {
+	out := new(HttpRequestServiceResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_HttpRequestService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetBackendCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBackendCapabilitiesResponse, error) {
+	out := new(GetBackendCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetBackendCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetPackageCacheInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PackageCacheInfo, error) {
+	out := new(PackageCacheInfo)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetPackageCacheInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ClearPackageCache(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClearPackageCacheResponse, error) {
+	out := new(ClearPackageCacheResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ClearPackageCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetStarlarkRunProfile(ctx context.Context, in *GetStarlarkRunProfileArgs, opts ...grpc.CallOption) (*StarlarkRunProfile, error) {
+	out := new(StarlarkRunProfile)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkRunProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetDeprecatedFeatures(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeprecatedFeaturesResponse, error) {
+	out := new(GetDeprecatedFeaturesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetDeprecatedFeatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -312,25 +691,60 @@ type ApiContainerServiceServer interface {
 	GetServices(context.Context, *GetServicesArgs) (*GetServicesResponse, error)
 	// Returns information about all existing & historical services
 	GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error)
+	// Returns the configs the current services in the enclave were started with
+	GetServiceConfigs(context.Context, *emptypb.Empty) (*GetServiceConfigsResponse, error)
+	// Returns the endpoints to scrape the Prometheus metrics of the services in the enclave from
+	GetScrapeTargets(context.Context, *emptypb.Empty) (*GetScrapeTargetsResponse, error)
+	// Returns the default connection between subnetworks, and the connections between subnetworks that override it
+	GetSubnetworkConnections(context.Context, *emptypb.Empty) (*GetSubnetworkConnectionsResponse, error)
 	// Instructs the API container to remove the given service
 	RemoveService(context.Context, *RemoveServiceArgs) (*RemoveServiceResponse, error)
+	// Instructs the API container to remove the given services, the services matching the given name patterns, or all
+	// the services, at once
+	RemoveServices(context.Context, *RemoveServicesArgs) (*RemoveServicesResponse, error)
 	// Instructs the API container to repartition the enclave
 	Repartition(context.Context, *RepartitionArgs) (*emptypb.Empty, error)
+	// Instructs the API container to create a new partition and move the given services to it, only updating the
+	//  services whose connections change
+	AddPartition(context.Context, *AddPartitionArgs) (*emptypb.Empty, error)
+	// Instructs the API container to move the given services to an existing partition, only updating the services whose
+	//  connections change
+	MovePartition(context.Context, *MovePartitionArgs) (*emptypb.Empty, error)
+	// Instructs the API container to update the connection between two partitions, only updating the services in those
+	//  partitions
+	UpdateConnection(context.Context, *UpdateConnectionArgs) (*emptypb.Empty, error)
+	// Pings every given service from every other one and returns the observed connection of every pair along with the
+	//  connection the partition topology expects, to find blocked or slow paths
+	TestConnectivity(context.Context, *TestConnectivityArgs) (*TestConnectivityResponse, error)
 	// Executes the given command inside a running container
 	ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error)
+	// Executes the given command inside a running container, streaming its output back in chunks followed by its exit
+	// code, so commands with outputs too big to be held in memory can be run
+	ExecCommandWithStreamedOutput(*ExecCommandArgs, ApiContainerService_ExecCommandWithStreamedOutputServer) error
 	// Pauses all processes running in the service container
 	PauseService(context.Context, *PauseServiceArgs) (*emptypb.Empty, error)
 	// Unpauses all paused processes running in the service container
 	UnpauseService(context.Context, *UnpauseServiceArgs) (*emptypb.Empty, error)
+	// Pauses all processes running in the containers of the given services, or of all the services, at once
+	PauseServices(context.Context, *PauseServicesArgs) (*PauseServicesResponse, error)
+	// Unpauses all paused processes running in the containers of the given services, or of all the services, at once
+	UnpauseServices(context.Context, *UnpauseServicesArgs) (*UnpauseServicesResponse, error)
+	// Blocks until the container of the service exits, e.g. a service running a test suite, returning its exit code
+	WaitForServiceExit(context.Context, *WaitForServiceExitArgs) (*WaitForServiceExitResponse, error)
+	// Gets a snapshot of the CPU, memory and network usage of the service container
+	GetServiceMetrics(context.Context, *GetServiceMetricsArgs) (*GetServiceMetricsResponse, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 	WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *WaitForHttpPostEndpointAvailabilityArgs) (*emptypb.Empty, error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(context.Context, *UploadFilesArtifactArgs) (*UploadFilesArtifactResponse, error)
-	// TODO Make this a server-side streaming method so the client can download large files
+	// Uploads a files artifact to the Kurtosis File System in chunks, so that it can be bigger than the gRPC message limit
+	UploadFilesArtifactStream(ApiContainerService_UploadFilesArtifactStreamServer) error
 	// Downloads a files artifact from the Kurtosis File System
 	DownloadFilesArtifact(context.Context, *DownloadFilesArtifactArgs) (*DownloadFilesArtifactResponse, error)
+	// Downloads a files artifact from the Kurtosis File System in chunks, so that it can be bigger than the gRPC message limit
+	DownloadFilesArtifactStream(*DownloadFilesArtifactArgs, ApiContainerService_DownloadFilesArtifactStreamServer) error
 	// Tells the API container to download a files artifact from the web to the Kurtosis File System
 	StoreWebFilesArtifact(context.Context, *StoreWebFilesArtifactArgs) (*StoreWebFilesArtifactResponse, error)
 	// Tells the API container to copy a files artifact from a service to the Kurtosis File System
@@ -338,6 +752,29 @@ type ApiContainerServiceServer interface {
 	// Renders the templates and their data to a files artifact in the Kurtosis File System
 	RenderTemplatesToFilesArtifact(context.Context, *RenderTemplatesToFilesArtifactArgs) (*RenderTemplatesToFilesArtifactResponse, error)
 	ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error)
+	// Deletes a files artifact from the Kurtosis File System, unless it's mounted on services
+	DeleteFilesArtifact(context.Context, *DeleteFilesArtifactArgs) (*emptypb.Empty, error)
+	// Lists the files inside a files artifact, without having to download it
+	InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsArgs) (*InspectFilesArtifactContentsResponse, error)
+	// Changes the log level of the API container at runtime, e.g. to temporarily debug an issue without restarting it
+	SetLogLevel(context.Context, *SetLogLevelArgs) (*SetLogLevelResponse, error)
+	// Runs the shutdown hooks registered by the Starlark code run in the enclave, which should happen right before the
+	// enclave gets stopped or destroyed. Each hook is only ever run once
+	RunShutdownHooks(context.Context, *emptypb.Empty) (*RunShutdownHooksResponse, error)
+	// Makes an HTTP request to a private port of a service from inside the enclave network, e.g. to probe a service whose
+	// ports aren't published without needing an HTTP client in its image
+	HttpRequestService(context.Context, *HttpRequestServiceArgs) (*HttpRequestServiceResponse, error)
+	// Tells which of the optional features of Kurtosis the backend of the enclave supports, so that clients can fail
+	// upfront when asked for one that isn't supported
+	GetBackendCapabilities(context.Context, *emptypb.Empty) (*GetBackendCapabilitiesResponse, error)
+	// Lists the packages cloned into the enclave, along with the size limit of the package cache and how often it gets hit
+	GetPackageCacheInfo(context.Context, *emptypb.Empty) (*PackageCacheInfo, error)
+	// Removes all the packages cloned into the enclave, which will get cloned again the next time they're used
+	ClearPackageCache(context.Context, *emptypb.Empty) (*ClearPackageCacheResponse, error)
+	// Returns how long each instruction of a past Starlark run took and what it created, for profiling slow packages
+	GetStarlarkRunProfile(context.Context, *GetStarlarkRunProfileArgs) (*StarlarkRunProfile, error)
+	// Lists the Starlark features deprecated in this version of Kurtosis, so that SDKs can warn before using them
+	GetDeprecatedFeatures(context.Context, *emptypb.Empty) (*GetDeprecatedFeaturesResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -359,21 +796,60 @@ func (UnimplementedApiContainerServiceServer) GetServices(context.Context, *GetS
 func (UnimplementedApiContainerServiceServer) GetExistingAndHistoricalServiceIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalServiceIdentifiersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExistingAndHistoricalServiceIdentifiers not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetServiceConfigs(context.Context, *emptypb.Empty) (*GetServiceConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceConfigs not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetScrapeTargets(context.Context, *emptypb.Empty) (*GetScrapeTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScrapeTargets not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetSubnetworkConnections(context.Context, *emptypb.Empty) (*GetSubnetworkConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubnetworkConnections not implemented")
+}
 func (UnimplementedApiContainerServiceServer) RemoveService(context.Context, *RemoveServiceArgs) (*RemoveServiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
 }
+func (UnimplementedApiContainerServiceServer) RemoveServices(context.Context, *RemoveServicesArgs) (*RemoveServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveServices not implemented")
+}
 func (UnimplementedApiContainerServiceServer) Repartition(context.Context, *RepartitionArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Repartition not implemented")
 }
+func (UnimplementedApiContainerServiceServer) AddPartition(context.Context, *AddPartitionArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPartition not implemented")
+}
+func (UnimplementedApiContainerServiceServer) MovePartition(context.Context, *MovePartitionArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePartition not implemented")
+}
+func (UnimplementedApiContainerServiceServer) UpdateConnection(context.Context, *UpdateConnectionArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnection not implemented")
+}
+func (UnimplementedApiContainerServiceServer) TestConnectivity(context.Context, *TestConnectivityArgs) (*TestConnectivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestConnectivity not implemented")
+}
 func (UnimplementedApiContainerServiceServer) ExecCommand(context.Context, *ExecCommandArgs) (*ExecCommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
 }
+func (UnimplementedApiContainerServiceServer) ExecCommandWithStreamedOutput(*ExecCommandArgs, ApiContainerService_ExecCommandWithStreamedOutputServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecCommandWithStreamedOutput not implemented")
+}
 func (UnimplementedApiContainerServiceServer) PauseService(context.Context, *PauseServiceArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PauseService not implemented")
 }
 func (UnimplementedApiContainerServiceServer) UnpauseService(context.Context, *UnpauseServiceArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpauseService not implemented")
 }
+func (UnimplementedApiContainerServiceServer) PauseServices(context.Context, *PauseServicesArgs) (*PauseServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseServices not implemented")
+}
+func (UnimplementedApiContainerServiceServer) UnpauseServices(context.Context, *UnpauseServicesArgs) (*UnpauseServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseServices not implemented")
+}
+func (UnimplementedApiContainerServiceServer) WaitForServiceExit(context.Context, *WaitForServiceExitArgs) (*WaitForServiceExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForServiceExit not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetServiceMetrics(context.Context, *GetServiceMetricsArgs) (*GetServiceMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceMetrics not implemented")
+}
 func (UnimplementedApiContainerServiceServer) WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitForHttpGetEndpointAvailability not implemented")
 }
@@ -383,9 +859,15 @@ func (UnimplementedApiContainerServiceServer) WaitForHttpPostEndpointAvailabilit
 func (UnimplementedApiContainerServiceServer) UploadFilesArtifact(context.Context, *UploadFilesArtifactArgs) (*UploadFilesArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadFilesArtifact not implemented")
 }
+func (UnimplementedApiContainerServiceServer) UploadFilesArtifactStream(ApiContainerService_UploadFilesArtifactStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFilesArtifactStream not implemented")
+}
 func (UnimplementedApiContainerServiceServer) DownloadFilesArtifact(context.Context, *DownloadFilesArtifactArgs) (*DownloadFilesArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadFilesArtifact not implemented")
 }
+func (UnimplementedApiContainerServiceServer) DownloadFilesArtifactStream(*DownloadFilesArtifactArgs, ApiContainerService_DownloadFilesArtifactStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFilesArtifactStream not implemented")
+}
 func (UnimplementedApiContainerServiceServer) StoreWebFilesArtifact(context.Context, *StoreWebFilesArtifactArgs) (*StoreWebFilesArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoreWebFilesArtifact not implemented")
 }
@@ -398,6 +880,36 @@ func (UnimplementedApiContainerServiceServer) RenderTemplatesToFilesArtifact(con
 func (UnimplementedApiContainerServiceServer) ListFilesArtifactNamesAndUuids(context.Context, *emptypb.Empty) (*ListFilesArtifactNamesAndUuidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFilesArtifactNamesAndUuids not implemented")
 }
+func (UnimplementedApiContainerServiceServer) DeleteFilesArtifact(context.Context, *DeleteFilesArtifactArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFilesArtifact not implemented")
+}
+func (UnimplementedApiContainerServiceServer) InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsArgs) (*InspectFilesArtifactContentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectFilesArtifactContents not implemented")
+}
+func (UnimplementedApiContainerServiceServer) SetLogLevel(context.Context, *SetLogLevelArgs) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RunShutdownHooks(context.Context, *emptypb.Empty) (*RunShutdownHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunShutdownHooks not implemented")
+}
+func (UnimplementedApiContainerServiceServer) HttpRequestService(context.Context, *HttpRequestServiceArgs) (*HttpRequestServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HttpRequestService not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetBackendCapabilities(context.Context, *emptypb.Empty) (*GetBackendCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackendCapabilities not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetPackageCacheInfo(context.Context, *emptypb.Empty) (*PackageCacheInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackageCacheInfo not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ClearPackageCache(context.Context, *emptypb.Empty) (*ClearPackageCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPackageCache not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetStarlarkRunProfile(context.Context, *GetStarlarkRunProfileArgs) (*StarlarkRunProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRunProfile not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetDeprecatedFeatures(context.Context, *emptypb.Empty) (*GetDeprecatedFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeprecatedFeatures not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -506,67 +1018,232 @@ func _ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_Handler(srv
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiContainerService_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveServiceArgs)
+func _ApiContainerService_GetServiceConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiContainerServiceServer).RemoveService(ctx, in)
+		return srv.(ApiContainerServiceServer).GetServiceConfigs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApiContainerService_RemoveService_FullMethodName,
+		FullMethod: ApiContainerService_GetServiceConfigs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiContainerServiceServer).RemoveService(ctx, req.(*RemoveServiceArgs))
+		return srv.(ApiContainerServiceServer).GetServiceConfigs(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiContainerService_Repartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RepartitionArgs)
+func _ApiContainerService_GetScrapeTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiContainerServiceServer).Repartition(ctx, in)
+		return srv.(ApiContainerServiceServer).GetScrapeTargets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApiContainerService_Repartition_FullMethodName,
+		FullMethod: ApiContainerService_GetScrapeTargets_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiContainerServiceServer).Repartition(ctx, req.(*RepartitionArgs))
+		return srv.(ApiContainerServiceServer).GetScrapeTargets(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiContainerService_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExecCommandArgs)
+func _ApiContainerService_GetSubnetworkConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiContainerServiceServer).ExecCommand(ctx, in)
+		return srv.(ApiContainerServiceServer).GetSubnetworkConnections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ApiContainerService_ExecCommand_FullMethodName,
+		FullMethod: ApiContainerService_GetSubnetworkConnections_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiContainerServiceServer).ExecCommand(ctx, req.(*ExecCommandArgs))
+		return srv.(ApiContainerServiceServer).GetSubnetworkConnections(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiContainerService_PauseService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PauseServiceArgs)
+func _ApiContainerService_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServiceArgs)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiContainerServiceServer).PauseService(ctx, in)
+		return srv.(ApiContainerServiceServer).RemoveService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemoveService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemoveService(ctx, req.(*RemoveServiceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RemoveServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveServicesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RemoveServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemoveServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemoveServices(ctx, req.(*RemoveServicesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_Repartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepartitionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).Repartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_Repartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).Repartition(ctx, req.(*RepartitionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_AddPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPartitionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).AddPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_AddPartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).AddPartition(ctx, req.(*AddPartitionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_MovePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePartitionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).MovePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_MovePartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).MovePartition(ctx, req.(*MovePartitionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_UpdateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConnectionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).UpdateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_UpdateConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).UpdateConnection(ctx, req.(*UpdateConnectionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_TestConnectivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestConnectivityArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).TestConnectivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_TestConnectivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).TestConnectivity(ctx, req.(*TestConnectivityArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecCommandArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ExecCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ExecCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ExecCommand(ctx, req.(*ExecCommandArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ExecCommandWithStreamedOutput_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecCommandArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).ExecCommandWithStreamedOutput(m, &apiContainerServiceExecCommandWithStreamedOutputServer{stream})
+}
+
+type ApiContainerService_ExecCommandWithStreamedOutputServer interface {
+	Send(*ExecCommandStreamedOutputChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceExecCommandWithStreamedOutputServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceExecCommandWithStreamedOutputServer) Send(m *ExecCommandStreamedOutputChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_PauseService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseServiceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).PauseService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -596,6 +1273,78 @@ func _ApiContainerService_UnpauseService_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_PauseServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseServicesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).PauseServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_PauseServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).PauseServices(ctx, req.(*PauseServicesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_UnpauseServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpauseServicesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).UnpauseServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_UnpauseServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).UnpauseServices(ctx, req.(*UnpauseServicesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_WaitForServiceExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForServiceExitArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).WaitForServiceExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_WaitForServiceExit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).WaitForServiceExit(ctx, req.(*WaitForServiceExitArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetServiceMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceMetricsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetServiceMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetServiceMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetServiceMetrics(ctx, req.(*GetServiceMetricsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_WaitForHttpGetEndpointAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WaitForHttpGetEndpointAvailabilityArgs)
 	if err := dec(in); err != nil {
@@ -650,6 +1399,32 @@ func _ApiContainerService_UploadFilesArtifact_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_UploadFilesArtifactStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiContainerServiceServer).UploadFilesArtifactStream(&apiContainerServiceUploadFilesArtifactStreamServer{stream})
+}
+
+type ApiContainerService_UploadFilesArtifactStreamServer interface {
+	SendAndClose(*UploadFilesArtifactResponse) error
+	Recv() (*FilesArtifactStreamChunk, error)
+	grpc.ServerStream
+}
+
+type apiContainerServiceUploadFilesArtifactStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceUploadFilesArtifactStreamServer) SendAndClose(m *UploadFilesArtifactResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceUploadFilesArtifactStreamServer) Recv() (*FilesArtifactStreamChunk, error) {
+	m := new(FilesArtifactStreamChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _ApiContainerService_DownloadFilesArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DownloadFilesArtifactArgs)
 	if err := dec(in); err != nil {
@@ -668,6 +1443,27 @@ func _ApiContainerService_DownloadFilesArtifact_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_DownloadFilesArtifactStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFilesArtifactArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).DownloadFilesArtifactStream(m, &apiContainerServiceDownloadFilesArtifactStreamServer{stream})
+}
+
+type ApiContainerService_DownloadFilesArtifactStreamServer interface {
+	Send(*FilesArtifactStreamChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceDownloadFilesArtifactStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceDownloadFilesArtifactStreamServer) Send(m *FilesArtifactStreamChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiContainerService_StoreWebFilesArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StoreWebFilesArtifactArgs)
 	if err := dec(in); err != nil {
@@ -740,6 +1536,186 @@ func _ApiContainerService_ListFilesArtifactNamesAndUuids_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_DeleteFilesArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilesArtifactArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).DeleteFilesArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_DeleteFilesArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).DeleteFilesArtifact(ctx, req.(*DeleteFilesArtifactArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_InspectFilesArtifactContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectFilesArtifactContentsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).InspectFilesArtifactContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_InspectFilesArtifactContents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).InspectFilesArtifactContents(ctx, req.(*InspectFilesArtifactContentsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).SetLogLevel(ctx, req.(*SetLogLevelArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RunShutdownHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RunShutdownHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RunShutdownHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RunShutdownHooks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_HttpRequestService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HttpRequestServiceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).HttpRequestService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_HttpRequestService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).HttpRequestService(ctx, req.(*HttpRequestServiceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetBackendCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetBackendCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetBackendCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetBackendCapabilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetPackageCacheInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetPackageCacheInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetPackageCacheInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetPackageCacheInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ClearPackageCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ClearPackageCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ClearPackageCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ClearPackageCache(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetStarlarkRunProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStarlarkRunProfileArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetStarlarkRunProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunProfile(ctx, req.(*GetStarlarkRunProfileArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetDeprecatedFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetDeprecatedFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetDeprecatedFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetDeprecatedFeatures(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -759,14 +1735,46 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExistingAndHistoricalServiceIdentifiers",
 			Handler:    _ApiContainerService_GetExistingAndHistoricalServiceIdentifiers_Handler,
 		},
+		{
+			MethodName: "GetServiceConfigs",
+			Handler:    _ApiContainerService_GetServiceConfigs_Handler,
+		},
+		{
+			MethodName: "GetScrapeTargets",
+			Handler:    _ApiContainerService_GetScrapeTargets_Handler,
+		},
+		{
+			MethodName: "GetSubnetworkConnections",
+			Handler:    _ApiContainerService_GetSubnetworkConnections_Handler,
+		},
 		{
 			MethodName: "RemoveService",
 			Handler:    _ApiContainerService_RemoveService_Handler,
 		},
+		{
+			MethodName: "RemoveServices",
+			Handler:    _ApiContainerService_RemoveServices_Handler,
+		},
 		{
 			MethodName: "Repartition",
 			Handler:    _ApiContainerService_Repartition_Handler,
 		},
+		{
+			MethodName: "AddPartition",
+			Handler:    _ApiContainerService_AddPartition_Handler,
+		},
+		{
+			MethodName: "MovePartition",
+			Handler:    _ApiContainerService_MovePartition_Handler,
+		},
+		{
+			MethodName: "UpdateConnection",
+			Handler:    _ApiContainerService_UpdateConnection_Handler,
+		},
+		{
+			MethodName: "TestConnectivity",
+			Handler:    _ApiContainerService_TestConnectivity_Handler,
+		},
 		{
 			MethodName: "ExecCommand",
 			Handler:    _ApiContainerService_ExecCommand_Handler,
@@ -779,6 +1787,22 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseService",
 			Handler:    _ApiContainerService_UnpauseService_Handler,
 		},
+		{
+			MethodName: "PauseServices",
+			Handler:    _ApiContainerService_PauseServices_Handler,
+		},
+		{
+			MethodName: "UnpauseServices",
+			Handler:    _ApiContainerService_UnpauseServices_Handler,
+		},
+		{
+			MethodName: "WaitForServiceExit",
+			Handler:    _ApiContainerService_WaitForServiceExit_Handler,
+		},
+		{
+			MethodName: "GetServiceMetrics",
+			Handler:    _ApiContainerService_GetServiceMetrics_Handler,
+		},
 		{
 			MethodName: "WaitForHttpGetEndpointAvailability",
 			Handler:    _ApiContainerService_WaitForHttpGetEndpointAvailability_Handler,
@@ -811,6 +1835,46 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFilesArtifactNamesAndUuids",
 			Handler:    _ApiContainerService_ListFilesArtifactNamesAndUuids_Handler,
 		},
+		{
+			MethodName: "DeleteFilesArtifact",
+			Handler:    _ApiContainerService_DeleteFilesArtifact_Handler,
+		},
+		{
+			MethodName: "InspectFilesArtifactContents",
+			Handler:    _ApiContainerService_InspectFilesArtifactContents_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _ApiContainerService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "RunShutdownHooks",
+			Handler:    _ApiContainerService_RunShutdownHooks_Handler,
+		},
+		{
+			MethodName: "HttpRequestService",
+			Handler:    _ApiContainerService_HttpRequestService_Handler,
+		},
+		{
+			MethodName: "GetBackendCapabilities",
+			Handler:    _ApiContainerService_GetBackendCapabilities_Handler,
+		},
+		{
+			MethodName: "GetPackageCacheInfo",
+			Handler:    _ApiContainerService_GetPackageCacheInfo_Handler,
+		},
+		{
+			MethodName: "ClearPackageCache",
+			Handler:    _ApiContainerService_ClearPackageCache_Handler,
+		},
+		{
+			MethodName: "GetStarlarkRunProfile",
+			Handler:    _ApiContainerService_GetStarlarkRunProfile_Handler,
+		},
+		{
+			MethodName: "GetDeprecatedFeatures",
+			Handler:    _ApiContainerService_GetDeprecatedFeatures_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -823,6 +1887,21 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_RunStarlarkPackage_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExecCommandWithStreamedOutput",
+			Handler:       _ApiContainerService_ExecCommandWithStreamedOutput_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFilesArtifactStream",
+			Handler:       _ApiContainerService_UploadFilesArtifactStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFilesArtifactStream",
+			Handler:       _ApiContainerService_DownloadFilesArtifactStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -2,6 +2,7 @@ package binding_constructors
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // The generated bindings don't come with constructors (leaving it up to the user to initialize all the fields), so we
@@ -32,19 +33,73 @@ func NewServiceConfig(
 	cpuAllocationMillicpus uint64,
 	memoryAllocationMegabytes uint64,
 	privateIPAddrPlaceholder string,
-	subnetwork string) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	subnetwork string,
+	kubernetesServiceAccount string,
+	kubernetesServiceAccountAnnotations map[string]string,
+	kubernetesScheduling *kurtosis_core_rpc_api_bindings.KubernetesScheduling,
+	replicas uint32,
+	cpuset string,
+	numaNodes string,
+	ulimits map[string]*kurtosis_core_rpc_api_bindings.Ulimit,
+	sysctls map[string]string,
+	shmSizeMegabytes uint64,
+	hostDirMounts map[string]string,
+	metricsPortId string) *kurtosis_core_rpc_api_bindings.ServiceConfig {
 	return &kurtosis_core_rpc_api_bindings.ServiceConfig{
-		ContainerImageName:        containerImageName,
-		PrivatePorts:              privatePorts,
-		PublicPorts:               publicPorts,
-		EntrypointArgs:            entrypointArgs,
-		CmdArgs:                   cmdArgs,
-		EnvVars:                   envVars,
-		FilesArtifactMountpoints:  filesArtifactMountDirpaths,
-		CpuAllocationMillicpus:    cpuAllocationMillicpus,
-		MemoryAllocationMegabytes: memoryAllocationMegabytes,
-		PrivateIpAddrPlaceholder:  privateIPAddrPlaceholder,
-		Subnetwork:                &subnetwork,
+		ContainerImageName:                  containerImageName,
+		PrivatePorts:                        privatePorts,
+		PublicPorts:                         publicPorts,
+		EntrypointArgs:                      entrypointArgs,
+		CmdArgs:                             cmdArgs,
+		EnvVars:                             envVars,
+		FilesArtifactMountpoints:            filesArtifactMountDirpaths,
+		CpuAllocationMillicpus:              cpuAllocationMillicpus,
+		MemoryAllocationMegabytes:           memoryAllocationMegabytes,
+		PrivateIpAddrPlaceholder:            privateIPAddrPlaceholder,
+		Subnetwork:                          &subnetwork,
+		KubernetesServiceAccount:            &kubernetesServiceAccount,
+		KubernetesServiceAccountAnnotations: kubernetesServiceAccountAnnotations,
+		KubernetesScheduling:                kubernetesScheduling,
+		Replicas:                            &replicas,
+		Cpuset:                              &cpuset,
+		NumaNodes:                           &numaNodes,
+		Ulimits:                             ulimits,
+		Sysctls:                             sysctls,
+		ShmSizeMegabytes:                    &shmSizeMegabytes,
+		HostDirMounts:                       hostDirMounts,
+		MetricsPortId:                       &metricsPortId,
+	}
+}
+
+func NewKubernetesScheduling(
+	nodeSelector map[string]string,
+	tolerations []*kurtosis_core_rpc_api_bindings.KubernetesToleration,
+	serializedAffinity string,
+	serializedTopologySpreadConstraints string) *kurtosis_core_rpc_api_bindings.KubernetesScheduling {
+	return &kurtosis_core_rpc_api_bindings.KubernetesScheduling{
+		NodeSelector:                        nodeSelector,
+		Tolerations:                         tolerations,
+		SerializedAffinity:                  serializedAffinity,
+		SerializedTopologySpreadConstraints: serializedTopologySpreadConstraints,
+	}
+}
+
+// NewKubernetesToleration creates a toleration; tolerationSeconds can be nil to tolerate the taint forever
+func NewKubernetesToleration(key string, operator string, value string, effect string, tolerationSeconds *int64) *kurtosis_core_rpc_api_bindings.KubernetesToleration {
+	return &kurtosis_core_rpc_api_bindings.KubernetesToleration{
+		Key:               key,
+		Operator:          operator,
+		Value:             value,
+		Effect:            effect,
+		TolerationSeconds: tolerationSeconds,
+	}
+}
+
+// NewUlimit creates a resource limit; -1 means unlimited
+func NewUlimit(soft int64, hard int64) *kurtosis_core_rpc_api_bindings.Ulimit {
+	return &kurtosis_core_rpc_api_bindings.Ulimit{
+		Soft: soft,
+		Hard: hard,
 	}
 }
 
@@ -54,12 +109,28 @@ func NewUpdateServiceConfig(subnetwork string) *kurtosis_core_rpc_api_bindings.U
 	}
 }
 
+// NewContainerUpdateServiceConfig creates an update that recreates the container of the service, leaving the fields
+// that are nil or empty unchanged
+func NewContainerUpdateServiceConfig(
+	subnetwork *string,
+	containerImageName *string,
+	privatePorts map[string]*kurtosis_core_rpc_api_bindings.Port,
+	envVars map[string]string,
+) *kurtosis_core_rpc_api_bindings.UpdateServiceConfig {
+	return &kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		Subnetwork:         subnetwork,
+		ContainerImageName: containerImageName,
+		PrivatePorts:       privatePorts,
+		EnvVars:            envVars,
+	}
+}
+
 // ==============================================================================================
 //
 //	Execute Starlark Arguments
 //
 // ==============================================================================================
-func NewRunStarlarkScriptArgs(serializedString string, serializedParams string, dryRun bool, parallelism int32) *kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs {
+func NewRunStarlarkScriptArgs(serializedString string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) *kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs{
@@ -67,10 +138,11 @@ func NewRunStarlarkScriptArgs(serializedString string, serializedParams string,
 		SerializedParams: serializedParams,
 		DryRun:           &dryRun,
 		Parallelism:      parallelismCopy,
+		Strict:           &isStrict,
 	}
 }
 
-func NewRunStarlarkPackageArgs(packageId string, compressedPackage []byte, serializedParams string, dryRun bool, parallelism int32) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
+func NewRunStarlarkPackageArgs(packageId string, compressedPackage []byte, serializedParams string, dryRun bool, parallelism int32, isStrict bool) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
@@ -79,10 +151,11 @@ func NewRunStarlarkPackageArgs(packageId string, compressedPackage []byte, seria
 		SerializedParams:       serializedParams,
 		DryRun:                 &dryRun,
 		Parallelism:            parallelismCopy,
+		Strict:                 &isStrict,
 	}
 }
 
-func NewRunStarlarkRemotePackageArgs(packageId string, serializedParams string, dryRun bool, parallelism int32) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
+func NewRunStarlarkRemotePackageArgs(packageId string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
@@ -91,6 +164,7 @@ func NewRunStarlarkRemotePackageArgs(packageId string, serializedParams string,
 		SerializedParams:       serializedParams,
 		DryRun:                 &dryRun,
 		Parallelism:            parallelismCopy,
+		Strict:                 &isStrict,
 	}
 }
 
@@ -117,6 +191,14 @@ func NewStarlarkRunResponseLineFromInstructionResult(serializedInstructionResult
 	}
 }
 
+func NewStarlarkRunResponseLineFromDeprecationWarning(deprecationWarning *kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_DeprecationWarning{
+			DeprecationWarning: deprecationWarning,
+		},
+	}
+}
+
 func NewStarlarkRunResponseLineFromInterpretationError(interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Error{
@@ -181,8 +263,10 @@ func NewStarlarkRunResponseLineFromRunFailureEvent() *kurtosis_core_rpc_api_bind
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
 			RunFinishedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent{
-				IsRunSuccessful:  false,
-				SerializedOutput: nil,
+				IsRunSuccessful:         false,
+				SerializedOutput:        nil,
+				RunId:                   nil,
+				ResolvedPackageVersions: nil,
 			},
 		},
 	}
@@ -192,13 +276,57 @@ func NewStarlarkRunResponseLineFromRunSuccessEvent(serializedOutputObject string
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
 			RunFinishedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent{
-				IsRunSuccessful:  true,
-				SerializedOutput: &serializedOutputObject,
+				IsRunSuccessful:         true,
+				SerializedOutput:        &serializedOutputObject,
+				RunId:                   nil,
+				ResolvedPackageVersions: nil,
+			},
+		},
+	}
+}
+
+func NewStarlarkRunResponseLineFromExecutionFailureEvent(runId string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
+			RunFinishedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent{
+				IsRunSuccessful:         false,
+				SerializedOutput:        nil,
+				RunId:                   &runId,
+				ResolvedPackageVersions: nil,
+			},
+		},
+	}
+}
+
+func NewStarlarkRunResponseLineFromExecutionSuccessEvent(serializedOutputObject string, runId string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
+			RunFinishedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent{
+				IsRunSuccessful:         true,
+				SerializedOutput:        &serializedOutputObject,
+				RunId:                   &runId,
+				ResolvedPackageVersions: nil,
 			},
 		},
 	}
 }
 
+func NewStarlarkRunResponseLineFromResourceEstimate(resourceEstimate *kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_ResourceEstimate{
+			ResourceEstimate: resourceEstimate,
+		},
+	}
+}
+
+func NewStarlarkImageDownloadEstimate(image string, isPresentOnHost bool, downloadSizeBytes *uint64) *kurtosis_core_rpc_api_bindings.StarlarkImageDownloadEstimate {
+	return &kurtosis_core_rpc_api_bindings.StarlarkImageDownloadEstimate{
+		Image:             image,
+		IsPresentOnHost:   isPresentOnHost,
+		DownloadSizeBytes: downloadSizeBytes,
+	}
+}
+
 func NewStarlarkInstruction(position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition, name string, executableInstruction string, arguments []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
 	return &kurtosis_core_rpc_api_bindings.StarlarkInstruction{
 		InstructionName:       name,
@@ -208,6 +336,14 @@ func NewStarlarkInstruction(position *kurtosis_core_rpc_api_bindings.StarlarkIns
 	}
 }
 
+func NewStarlarkDeprecationWarning(code string, message string, position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition) *kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning {
+	return &kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning{
+		Code:     code,
+		Message:  message,
+		Position: position,
+	}
+}
+
 func NewStarlarkInstructionPosition(filename string, line int32, column int32) *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition {
 	return &kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition{
 		Filename: filename,
@@ -290,18 +426,28 @@ func NewServiceInfo(
 	name string,
 	shortenedUuid string,
 	privateIpAddr string,
+	maybePrivateIpv6Addr string,
 	privatePorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	maybePublicIpAddr string,
 	maybePublicPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
+	replicaPrivateIpAddrs []string,
+	maybeCreationTime *timestamppb.Timestamp,
+	maybeRunningTime *timestamppb.Timestamp,
+	maybeReadyTime *timestamppb.Timestamp,
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
-		ServiceUuid:       uuid,
-		Name:              name,
-		ShortenedUuid:     shortenedUuid,
-		PrivateIpAddr:     privateIpAddr,
-		PrivatePorts:      privatePorts,
-		MaybePublicIpAddr: maybePublicIpAddr,
-		MaybePublicPorts:  maybePublicPorts,
+		ServiceUuid:           uuid,
+		Name:                  name,
+		ShortenedUuid:         shortenedUuid,
+		PrivateIpAddr:         privateIpAddr,
+		MaybePrivateIpv6Addr:  maybePrivateIpv6Addr,
+		PrivatePorts:          privatePorts,
+		MaybePublicIpAddr:     maybePublicIpAddr,
+		MaybePublicPorts:      maybePublicPorts,
+		ReplicaPrivateIpAddrs: replicaPrivateIpAddrs,
+		CreationTime:          maybeCreationTime,
+		RunningTime:           maybeRunningTime,
+		ReadyTime:             maybeReadyTime,
 	}
 }
 
@@ -317,6 +463,156 @@ func NewRemoveServiceResponse(serviceUuid string) *kurtosis_core_rpc_api_binding
 	}
 }
 
+func NewRemoveServicesArgs(serviceIdentifiers map[string]bool, serviceNamePatterns []string, allServices bool) *kurtosis_core_rpc_api_bindings.RemoveServicesArgs {
+	return &kurtosis_core_rpc_api_bindings.RemoveServicesArgs{
+		ServiceIdentifiers:  serviceIdentifiers,
+		ServiceNamePatterns: serviceNamePatterns,
+		AllServices:         allServices,
+	}
+}
+
+func NewRemoveServicesResponse(removedServiceNamesToUuids map[string]string, failedServicesErrors map[string]string) *kurtosis_core_rpc_api_bindings.RemoveServicesResponse {
+	return &kurtosis_core_rpc_api_bindings.RemoveServicesResponse{
+		RemovedServiceNameToUuid: removedServiceNamesToUuids,
+		FailedServiceNameToError: failedServicesErrors,
+	}
+}
+
+// ==============================================================================================
+//
+//	Pause/Unpause Services
+//
+// ==============================================================================================
+
+func NewPauseServicesArgs(serviceIdentifiers map[string]bool, allServices bool) *kurtosis_core_rpc_api_bindings.PauseServicesArgs {
+	return &kurtosis_core_rpc_api_bindings.PauseServicesArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+		AllServices:        allServices,
+	}
+}
+
+func NewPauseServicesResponse(successfulServiceNames map[string]bool, failedServicesErrors map[string]string) *kurtosis_core_rpc_api_bindings.PauseServicesResponse {
+	return &kurtosis_core_rpc_api_bindings.PauseServicesResponse{
+		SuccessfulServiceNames:   successfulServiceNames,
+		FailedServiceNameToError: failedServicesErrors,
+	}
+}
+
+func NewUnpauseServicesArgs(serviceIdentifiers map[string]bool, allServices bool) *kurtosis_core_rpc_api_bindings.UnpauseServicesArgs {
+	return &kurtosis_core_rpc_api_bindings.UnpauseServicesArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+		AllServices:        allServices,
+	}
+}
+
+func NewUnpauseServicesResponse(successfulServiceNames map[string]bool, failedServicesErrors map[string]string) *kurtosis_core_rpc_api_bindings.UnpauseServicesResponse {
+	return &kurtosis_core_rpc_api_bindings.UnpauseServicesResponse{
+		SuccessfulServiceNames:   successfulServiceNames,
+		FailedServiceNameToError: failedServicesErrors,
+	}
+}
+
+// ==============================================================================================
+//
+//	Wait For Service Exit
+//
+// ==============================================================================================
+
+func NewWaitForServiceExitArgs(serviceIdentifier string) *kurtosis_core_rpc_api_bindings.WaitForServiceExitArgs {
+	return &kurtosis_core_rpc_api_bindings.WaitForServiceExitArgs{
+		ServiceIdentifier: serviceIdentifier,
+	}
+}
+
+func NewWaitForServiceExitResponse(exitCode int32) *kurtosis_core_rpc_api_bindings.WaitForServiceExitResponse {
+	return &kurtosis_core_rpc_api_bindings.WaitForServiceExitResponse{
+		ExitCode: exitCode,
+	}
+}
+
+// ==============================================================================================
+//
+//	Incremental Repartitioning
+//
+// ==============================================================================================
+
+func NewAddPartitionArgs(partitionId string, serviceNameSet map[string]bool) *kurtosis_core_rpc_api_bindings.AddPartitionArgs {
+	return &kurtosis_core_rpc_api_bindings.AddPartitionArgs{
+		PartitionId:    partitionId,
+		ServiceNameSet: serviceNameSet,
+	}
+}
+
+func NewMovePartitionArgs(partitionId string, serviceNameSet map[string]bool) *kurtosis_core_rpc_api_bindings.MovePartitionArgs {
+	return &kurtosis_core_rpc_api_bindings.MovePartitionArgs{
+		PartitionId:    partitionId,
+		ServiceNameSet: serviceNameSet,
+	}
+}
+
+func NewUpdateConnectionArgs(partitionIdA string, partitionIdB string, connectionInfo *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo) *kurtosis_core_rpc_api_bindings.UpdateConnectionArgs {
+	return &kurtosis_core_rpc_api_bindings.UpdateConnectionArgs{
+		PartitionIdA:   partitionIdA,
+		PartitionIdB:   partitionIdB,
+		ConnectionInfo: connectionInfo,
+	}
+}
+
+func NewPartitionConnectionInfo(
+	packetLossPercentage float32,
+	packetDelayMeanMs uint32,
+	packetDelayStdDevMs uint32,
+	packetDelayCorrelation float32,
+	bandwidthLimitKbps uint32,
+) *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo {
+	return &kurtosis_core_rpc_api_bindings.PartitionConnectionInfo{
+		PacketLossPercentage:   packetLossPercentage,
+		PacketDelayMeanMs:      packetDelayMeanMs,
+		PacketDelayStdDevMs:    packetDelayStdDevMs,
+		PacketDelayCorrelation: packetDelayCorrelation,
+		BandwidthLimitKbps:     bandwidthLimitKbps,
+	}
+}
+
+// ==============================================================================================
+//
+//	Test Connectivity
+//
+// ==============================================================================================
+
+func NewTestConnectivityArgs(serviceIdentifiers map[string]bool, numProbes uint32) *kurtosis_core_rpc_api_bindings.TestConnectivityArgs {
+	return &kurtosis_core_rpc_api_bindings.TestConnectivityArgs{
+		ServiceIdentifiers: serviceIdentifiers,
+		NumProbes:          numProbes,
+	}
+}
+
+func NewConnectivityProbeResult(
+	sourceServiceName string,
+	targetServiceName string,
+	expectedPacketLossPercentage float32,
+	expectedPacketDelayMs uint32,
+	observedPacketLossPercentage float32,
+	observedAvgLatencyMs float32,
+	probeError string,
+) *kurtosis_core_rpc_api_bindings.ConnectivityProbeResult {
+	return &kurtosis_core_rpc_api_bindings.ConnectivityProbeResult{
+		SourceServiceName:            sourceServiceName,
+		TargetServiceName:            targetServiceName,
+		ExpectedPacketLossPercentage: expectedPacketLossPercentage,
+		ExpectedPacketDelayMs:        expectedPacketDelayMs,
+		ObservedPacketLossPercentage: observedPacketLossPercentage,
+		ObservedAvgLatencyMs:         observedAvgLatencyMs,
+		ProbeError:                   probeError,
+	}
+}
+
+func NewTestConnectivityResponse(probeResults []*kurtosis_core_rpc_api_bindings.ConnectivityProbeResult) *kurtosis_core_rpc_api_bindings.TestConnectivityResponse {
+	return &kurtosis_core_rpc_api_bindings.TestConnectivityResponse{
+		ProbeResults: probeResults,
+	}
+}
+
 // ==============================================================================================
 //
 //	Exec Command
@@ -337,6 +633,50 @@ func NewExecCommandResponse(exitCode int32, logOutput string) *kurtosis_core_rpc
 	}
 }
 
+func NewExecCommandStreamedOutputChunkFromOutput(output []byte) *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk {
+	return &kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk{
+		Chunk: &kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk_Output{
+			Output: output,
+		},
+	}
+}
+
+func NewExecCommandStreamedOutputChunkFromExitCode(exitCode int32) *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk {
+	return &kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk{
+		Chunk: &kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk_ExitCode{
+			ExitCode: exitCode,
+		},
+	}
+}
+
+// ==============================================================================================
+//
+//	Get Service Metrics
+//
+// ==============================================================================================
+
+func NewGetServiceMetricsArgs(serviceIdentifier string) *kurtosis_core_rpc_api_bindings.GetServiceMetricsArgs {
+	return &kurtosis_core_rpc_api_bindings.GetServiceMetricsArgs{ServiceIdentifier: serviceIdentifier}
+}
+
+func NewGetServiceMetricsResponse(
+	timestamp *timestamppb.Timestamp,
+	cpuUsagePercent float64,
+	memoryUsageBytes uint64,
+	memoryLimitBytes uint64,
+	networkRxBytes uint64,
+	networkTxBytes uint64,
+) *kurtosis_core_rpc_api_bindings.GetServiceMetricsResponse {
+	return &kurtosis_core_rpc_api_bindings.GetServiceMetricsResponse{
+		Timestamp:        timestamp,
+		CpuUsagePercent:  cpuUsagePercent,
+		MemoryUsageBytes: memoryUsageBytes,
+		MemoryLimitBytes: memoryLimitBytes,
+		NetworkRxBytes:   networkRxBytes,
+		NetworkTxBytes:   networkTxBytes,
+	}
+}
+
 // ==============================================================================================
 //
 //	Upload Files Artifact
@@ -344,7 +684,17 @@ func NewExecCommandResponse(exitCode int32, logOutput string) *kurtosis_core_rpc
 // ==============================================================================================
 
 func NewUploadFilesArtifactArgs(data []byte, name string) *kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs {
-	return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs{Data: data, Name: name}
+	return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs{Data: data, Name: name, ContentHash: nil}
+}
+
+// NewUploadFilesArtifactArgsWithContentHash is NewUploadFilesArtifactArgs, but lets the API container skip storing the
+// data if it already stores files with the same content hash; the data can be nil to only ask whether it does
+func NewUploadFilesArtifactArgsWithContentHash(data []byte, name string, contentHash string) *kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs {
+	return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs{Data: data, Name: name, ContentHash: &contentHash}
+}
+
+func NewUploadFilesArtifactResponse(uuid string, name string, isAlreadyStored bool) *kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse {
+	return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse{Uuid: uuid, Name: name, IsAlreadyStored: isAlreadyStored}
 }
 
 // ==============================================================================================
@@ -369,6 +719,43 @@ func DownloadFilesArtifactArgs(fileIdentifier string) *kurtosis_core_rpc_api_bin
 	}
 }
 
+// ==============================================================================================
+//
+//	Delete Files Artifact
+//
+// ==============================================================================================
+
+func NewDeleteFilesArtifactArgs(identifier string) *kurtosis_core_rpc_api_bindings.DeleteFilesArtifactArgs {
+	return &kurtosis_core_rpc_api_bindings.DeleteFilesArtifactArgs{
+		Identifier: identifier,
+	}
+}
+
+// ==============================================================================================
+//
+//	Inspect Files Artifact Contents
+//
+// ==============================================================================================
+
+func NewInspectFilesArtifactContentsArgs(identifier string) *kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsArgs {
+	return &kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsArgs{
+		Identifier: identifier,
+	}
+}
+
+// ==============================================================================================
+//
+//	Files Artifact Stream
+//
+// ==============================================================================================
+
+func NewFilesArtifactStreamChunk(data []byte, name string) *kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk {
+	return &kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk{
+		Data: data,
+		Name: name,
+	}
+}
+
 // ==============================================================================================
 //
 //	Render Templates To Files Artifact
@@ -387,3 +774,127 @@ func NewRenderTemplatesToFilesArtifactResponse(filesArtifactUuid string) *kurtos
 		Uuid: filesArtifactUuid,
 	}
 }
+
+// ==============================================================================================
+//
+//	Get Backend Capabilities
+//
+// ==============================================================================================
+
+func NewGetBackendCapabilitiesResponse(
+	isNetworkPartitioningSupported bool,
+	isServicePausingSupported bool,
+	areStaticPublicPortsSupported bool,
+	arePersistentVolumesSupported bool,
+	isExecTtySupported bool,
+) *kurtosis_core_rpc_api_bindings.GetBackendCapabilitiesResponse {
+	return &kurtosis_core_rpc_api_bindings.GetBackendCapabilitiesResponse{
+		IsNetworkPartitioningSupported: isNetworkPartitioningSupported,
+		IsServicePausingSupported:      isServicePausingSupported,
+		AreStaticPublicPortsSupported:  areStaticPublicPortsSupported,
+		ArePersistentVolumesSupported:  arePersistentVolumesSupported,
+		IsExecTtySupported:             isExecTtySupported,
+	}
+}
+
+// ==============================================================================================
+//
+//	Package Cache
+//
+// ==============================================================================================
+
+func NewCachedPackage(repository string, sizeBytes uint64, lastUsedUnixSeconds int64) *kurtosis_core_rpc_api_bindings.CachedPackage {
+	return &kurtosis_core_rpc_api_bindings.CachedPackage{
+		Repository:          repository,
+		SizeBytes:           sizeBytes,
+		LastUsedUnixSeconds: lastUsedUnixSeconds,
+	}
+}
+
+func NewPackageCacheInfo(
+	cachedPackages []*kurtosis_core_rpc_api_bindings.CachedPackage,
+	totalSizeBytes uint64,
+	maxSizeBytes uint64,
+	numHits uint64,
+	numMisses uint64,
+) *kurtosis_core_rpc_api_bindings.PackageCacheInfo {
+	return &kurtosis_core_rpc_api_bindings.PackageCacheInfo{
+		CachedPackages: cachedPackages,
+		TotalSizeBytes: totalSizeBytes,
+		MaxSizeBytes:   maxSizeBytes,
+		NumHits:        numHits,
+		NumMisses:      numMisses,
+	}
+}
+
+func NewClearPackageCacheResponse(removedRepositories []string) *kurtosis_core_rpc_api_bindings.ClearPackageCacheResponse {
+	return &kurtosis_core_rpc_api_bindings.ClearPackageCacheResponse{
+		RemovedRepositories: removedRepositories,
+	}
+}
+
+// ==============================================================================================
+//
+//	Starlark Run Profile
+//
+// ==============================================================================================
+func NewGetStarlarkRunProfileArgs(runId string) *kurtosis_core_rpc_api_bindings.GetStarlarkRunProfileArgs {
+	if runId == "" {
+		return &kurtosis_core_rpc_api_bindings.GetStarlarkRunProfileArgs{
+			RunId: nil,
+		}
+	}
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunProfileArgs{
+		RunId: &runId,
+	}
+}
+
+func NewStarlarkInstructionProfile(
+	position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition,
+	instructionName string,
+	durationMicros uint64,
+	numContainersCreated uint32,
+	artifactBytesTransferred uint64,
+	isSuccessful bool,
+) *kurtosis_core_rpc_api_bindings.StarlarkInstructionProfile {
+	return &kurtosis_core_rpc_api_bindings.StarlarkInstructionProfile{
+		Position:                 position,
+		InstructionName:          instructionName,
+		DurationMicros:           durationMicros,
+		NumContainersCreated:     numContainersCreated,
+		ArtifactBytesTransferred: artifactBytesTransferred,
+		IsSuccessful:             isSuccessful,
+	}
+}
+
+func NewStarlarkRunProfile(
+	runId string,
+	packageId string,
+	startedAt *timestamppb.Timestamp,
+	instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstructionProfile,
+) *kurtosis_core_rpc_api_bindings.StarlarkRunProfile {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunProfile{
+		RunId:        runId,
+		PackageId:    packageId,
+		StartedAt:    startedAt,
+		Instructions: instructions,
+	}
+}
+
+// ==============================================================================================
+//
+//	Deprecated Features
+//
+// ==============================================================================================
+func NewDeprecatedFeature(code string, description string) *kurtosis_core_rpc_api_bindings.DeprecatedFeature {
+	return &kurtosis_core_rpc_api_bindings.DeprecatedFeature{
+		Code:        code,
+		Description: description,
+	}
+}
+
+func NewGetDeprecatedFeaturesResponse(deprecatedFeatures []*kurtosis_core_rpc_api_bindings.DeprecatedFeature) *kurtosis_core_rpc_api_bindings.GetDeprecatedFeaturesResponse {
+	return &kurtosis_core_rpc_api_bindings.GetDeprecatedFeaturesResponse{
+		DeprecatedFeatures: deprecatedFeatures,
+	}
+}
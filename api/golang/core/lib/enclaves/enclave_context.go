@@ -18,7 +18,10 @@
 package enclaves
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
@@ -38,6 +41,9 @@ type PartitionID string
 const (
 	kurtosisYamlFilename                      = "kurtosis.yml"
 	ensureCompressedFileIsLesserThanGRPCLimit = true
+
+	// Kept below the default 4MB gRPC message limit, with room to spare for the rest of the message
+	filesArtifactStreamChunkSizeBytes = 3 * 1024 * 1024
 )
 
 // Docs available at https://docs.kurtosis.com/sdk/#enclavecontext
@@ -76,9 +82,9 @@ func (enclaveCtx *EnclaveContext) GetEnclaveName() string {
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkscriptstring-serializedstarlarkscript-boolean-dryrun---streamstarlarkrunresponseline-responselines-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkScript(ctx context.Context, serializedScript string, serializedParams string, dryRun bool, parallelism int32) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func (enclaveCtx *EnclaveContext) RunStarlarkScript(ctx context.Context, serializedScript string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkScriptArgs(serializedScript, serializedParams, dryRun, parallelism)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkScriptArgs(serializedScript, serializedParams, dryRun, parallelism, isStrict)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
 	stream, err := enclaveCtx.client.RunStarlarkScript(ctxWithCancel, executeStartosisScriptArgs)
@@ -92,8 +98,8 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScript(ctx context.Context, seriali
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkscriptblockingstring-serializedstarlarkscript-boolean-dryrun---starlarkrunresult-runresult-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkScriptBlocking(ctx context.Context, serializedScript string, serializedParams string, dryRun bool, parallelism int32) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkScript(ctx, serializedScript, serializedParams, dryRun, parallelism)
+func (enclaveCtx *EnclaveContext) RunStarlarkScriptBlocking(ctx context.Context, serializedScript string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (*StarlarkRunResult, error) {
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkScript(ctx, serializedScript, serializedParams, dryRun, parallelism, isStrict)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running Starlark Script")
 	}
@@ -101,10 +107,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScriptBlocking(ctx context.Context,
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkpackagestring-packagerootpath-string-serializedparams-boolean-dryrun---streamstarlarkrunresponseline-responselines-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkPackage(ctx context.Context, packageRootPath string, serializedParams string, dryRun bool, parallelism int32) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func (enclaveCtx *EnclaveContext) RunStarlarkPackage(ctx context.Context, packageRootPath string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(packageRootPath, serializedParams, dryRun, parallelism)
+	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(packageRootPath, serializedParams, dryRun, parallelism, isStrict)
 	if err != nil {
 		cancelCtxFunc() // manually call the cancel function as something went wrong
 		return nil, nil, stacktrace.Propagate(err, "Error preparing package for execution '%v'", packageRootPath)
@@ -121,8 +127,8 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(ctx context.Context, packag
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkpackageblockingstring-packagerootpath-string-serializedparams-boolean-dryrun---starlarkrunresult-runresult-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(ctx context.Context, packageRootPath string, serializedParams string, dryRun bool, parallelism int32) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkPackage(ctx, packageRootPath, serializedParams, dryRun, parallelism)
+func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(ctx context.Context, packageRootPath string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (*StarlarkRunResult, error) {
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkPackage(ctx, packageRootPath, serializedParams, dryRun, parallelism, isStrict)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running Starlark package")
 	}
@@ -130,10 +136,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(ctx context.Context
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkremotepackagestring-packageid-string-serializedparams-boolean-dryrun---streamstarlarkrunresponseline-responselines-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(ctx context.Context, packageId string, serializedParams string, dryRun bool, parallelism int32) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(ctx context.Context, packageId string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, serializedParams, dryRun, parallelism)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, serializedParams, dryRun, parallelism, isStrict)
 
 	stream, err := enclaveCtx.client.RunStarlarkPackage(ctxWithCancel, executeStartosisScriptArgs)
 	if err != nil {
@@ -146,8 +152,8 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(ctx context.Context,
 }
 
 // Docs available at https://docs.kurtosis.com/sdk/#runstarlarkremotepackageblockingstring-packageid-string-serializedparams-boolean-dryrun---starlarkrunresult-runresult-error-error
-func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackageBlocking(ctx context.Context, packageId string, serializedParams string, dryRun bool, parallelism int32) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, dryRun, parallelism)
+func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackageBlocking(ctx context.Context, packageId string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (*StarlarkRunResult, error) {
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, dryRun, parallelism, isStrict)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running remote Starlark package")
 	}
@@ -192,6 +198,8 @@ func (enclaveCtx *EnclaveContext) GetServiceContext(serviceIdentifier string) (*
 		serviceCtxPrivatePorts,
 		serviceInfo.GetMaybePublicIpAddr(),
 		serviceCtxPublicPorts,
+		serviceInfo.GetReplicaPrivateIpAddrs(),
+		serviceInfo.GetMaybePrivateIpv6Addr(),
 	)
 
 	return serviceContext, nil
@@ -216,15 +224,57 @@ func (enclaveCtx *EnclaveContext) GetServices() (map[services.ServiceName]servic
 
 // Docs available at https://docs.kurtosis.com/sdk#uploadfilesstring-pathtoupload-string-artifactname
 func (enclaveCtx *EnclaveContext) UploadFiles(pathToUpload string, artifactName string) (services.FilesArtifactUUID, services.FileArtifactName, error) {
-	content, err := shared_utils.CompressPath(pathToUpload, ensureCompressedFileIsLesserThanGRPCLimit)
-	if err != nil {
+	return enclaveCtx.UploadFilesWithProgress(pathToUpload, artifactName, func(uploadedBytes uint64, totalBytes uint64) {})
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#uploadfileswithprogressstring-pathtoupload-string-artifactname-funcuint64-uint64-progresscallback
+func (enclaveCtx *EnclaveContext) UploadFilesWithProgress(
+	pathToUpload string,
+	artifactName string,
+	progressCallback func(uploadedBytes uint64, totalBytes uint64),
+) (services.FilesArtifactUUID, services.FileArtifactName, error) {
+	// The hash gets sent first, so that the upload can be skipped if the enclave already stores the same files. It's
+	// computed in a pass of its own, so that the compressed files never have to be held in memory. That pass also
+	// gives the size of the compressed files, which the progress gets reported against
+	contentHasher := sha256.New()
+	contentSizeCounter := &byteCountingWriter{numWrittenBytes: 0}
+	if err := shared_utils.CompressPathToWriter(pathToUpload, io.MultiWriter(contentHasher, contentSizeCounter)); err != nil {
 		return "", "", stacktrace.Propagate(err,
 			"There was an error compressing the file '%v' before upload",
 			pathToUpload)
 	}
+	contentHashStr := hex.EncodeToString(contentHasher.Sum(nil))
+	hashOnlyArgs := binding_constructors.NewUploadFilesArtifactArgsWithContentHash(nil, artifactName, contentHashStr)
+	response, err := enclaveCtx.client.UploadFilesArtifact(context.Background(), hashOnlyArgs)
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error was encountered while checking whether the API Container already stores the files to upload.")
+	}
+	if response.GetIsAlreadyStored() {
+		return services.FilesArtifactUUID(response.GetUuid()), services.FileArtifactName(response.GetName()), nil
+	}
 
-	args := binding_constructors.NewUploadFilesArtifactArgs(content, artifactName)
-	response, err := enclaveCtx.client.UploadFilesArtifact(context.Background(), args)
+	// Streamed in chunks as the files get compressed, as the compressed files can be bigger than the gRPC message limit
+	stream, err := enclaveCtx.client.UploadFilesArtifactStream(context.Background())
+	if err != nil {
+		return "", "", stacktrace.Propagate(err, "An error was encountered while opening the stream to upload data to the API Container.")
+	}
+	pipeReader, pipeWriter := io.Pipe()
+	// Unblocks the compression if sending stops before the end of the archive
+	defer pipeReader.Close()
+	go func() {
+		// A nil error makes the reading side see the end of the archive
+		pipeWriter.CloseWithError(shared_utils.CompressPathToWriter(pathToUpload, pipeWriter))
+	}()
+	progressReportingReader := &progressReportingReader{
+		reader:           pipeReader,
+		numReadBytes:     0,
+		totalBytes:       contentSizeCounter.numWrittenBytes,
+		progressCallback: progressCallback,
+	}
+	if err := sendFilesArtifactChunks(stream, progressReportingReader, response.GetName()); err != nil {
+		return "", "", stacktrace.Propagate(err, "An error was encountered while uploading data to the API Container.")
+	}
+	response, err = stream.CloseAndRecv()
 	if err != nil {
 		return "", "", stacktrace.Propagate(err, "An error was encountered while uploading data to the API Container.")
 	}
@@ -243,12 +293,32 @@ func (enclaveCtx *EnclaveContext) StoreWebFiles(ctx context.Context, urlToStoreW
 
 // Docs available at https://docs.kurtosis.com/sdk#downloadfilesartifact-fileidentifier-string
 func (enclaveCtx *EnclaveContext) DownloadFilesArtifact(ctx context.Context, artifactIdentifier string) ([]byte, error) {
+	content := &bytes.Buffer{}
+	if err := enclaveCtx.DownloadFilesArtifactToWriter(ctx, artifactIdentifier, content); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+	}
+	return content.Bytes(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#downloadfilesartifacttowritercontext-ctx-string-artifactidentifier-writer-output
+func (enclaveCtx *EnclaveContext) DownloadFilesArtifactToWriter(ctx context.Context, artifactIdentifier string, output io.Writer) error {
 	args := binding_constructors.DownloadFilesArtifactArgs(artifactIdentifier)
-	response, err := enclaveCtx.client.DownloadFilesArtifact(ctx, args)
+	stream, err := enclaveCtx.client.DownloadFilesArtifactStream(ctx, args)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v'", artifactIdentifier)
+		return stacktrace.Propagate(err, "An error occurred starting the download of files artifact '%v'", artifactIdentifier)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving a chunk of files artifact '%v'", artifactIdentifier)
+		}
+		if _, err := output.Write(chunk.GetData()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing a chunk of files artifact '%v' to the output", artifactIdentifier)
+		}
 	}
-	return response.Data, nil
 }
 
 // Docs available at https://docs.kurtosis.com/sdk#getexistingandhistoricalserviceidentifiers---serviceidentifiers-serviceidentifiers
@@ -260,6 +330,121 @@ func (enclaveCtx *EnclaveContext) GetExistingAndHistoricalServiceIdentifiers(ctx
 	return services.NewServiceIdentifiers(enclaveCtx.enclaveName, response.AllIdentifiers), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#getserviceconfigs---mapservicename-serviceconfig-serviceconfigs
+func (enclaveCtx *EnclaveContext) GetServiceConfigs(ctx context.Context) (map[services.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, error) {
+	response, err := enclaveCtx.client.GetServiceConfigs(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching the service configs")
+	}
+	serviceConfigs := map[services.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serviceConfig := range response.GetServiceConfigs() {
+		serviceConfigs[services.ServiceName(serviceName)] = serviceConfig
+	}
+	return serviceConfigs, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#getscrapetargets---scrapetarget-scrapetargets
+func (enclaveCtx *EnclaveContext) GetScrapeTargets(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error) {
+	response, err := enclaveCtx.client.GetScrapeTargets(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching the scrape targets")
+	}
+	return response.GetScrapeTargets(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#getsubnetworkconnections---getsubnetworkconnectionsresponse-subnetworkconnections
+func (enclaveCtx *EnclaveContext) GetSubnetworkConnections(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetSubnetworkConnectionsResponse, error) {
+	response, err := enclaveCtx.client.GetSubnetworkConnections(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while fetching the connections between subnetworks")
+	}
+	return response, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#removeservicessetstring-serviceidentifiers-liststring-servicenamepatterns-bool-allservices---mapservicename-serviceuuid-removedservices-mapservicename-error-failedservicenames
+func (enclaveCtx *EnclaveContext) RemoveServices(ctx context.Context, serviceIdentifiers map[string]bool, serviceNamePatterns []string, allServices bool) (map[services.ServiceName]services.ServiceUUID, map[services.ServiceName]error, error) {
+	args := binding_constructors.NewRemoveServicesArgs(serviceIdentifiers, serviceNamePatterns, allServices)
+	response, err := enclaveCtx.client.RemoveServices(ctx, args)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing services '%v' and services matching patterns '%v'", serviceIdentifiers, serviceNamePatterns)
+	}
+	removedServices := map[services.ServiceName]services.ServiceUUID{}
+	for serviceNameStr, serviceUuidStr := range response.GetRemovedServiceNameToUuid() {
+		removedServices[services.ServiceName(serviceNameStr)] = services.ServiceUUID(serviceUuidStr)
+	}
+	_, failedServiceNames := convertApiServiceNameResults(nil, response.GetFailedServiceNameToError())
+	return removedServices, failedServiceNames, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#pauseservicessetstring-serviceidentifiers-bool-allservices---setservicename-successfulservicenames-mapservicename-error-failedservicenames
+func (enclaveCtx *EnclaveContext) PauseServices(ctx context.Context, serviceIdentifiers map[string]bool, allServices bool) (map[services.ServiceName]bool, map[services.ServiceName]error, error) {
+	args := binding_constructors.NewPauseServicesArgs(serviceIdentifiers, allServices)
+	response, err := enclaveCtx.client.PauseServices(ctx, args)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred pausing services '%v'", serviceIdentifiers)
+	}
+	successfulServiceNames, failedServiceNames := convertApiServiceNameResults(response.GetSuccessfulServiceNames(), response.GetFailedServiceNameToError())
+	return successfulServiceNames, failedServiceNames, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#unpauseservicessetstring-serviceidentifiers-bool-allservices---setservicename-successfulservicenames-mapservicename-error-failedservicenames
+func (enclaveCtx *EnclaveContext) UnpauseServices(ctx context.Context, serviceIdentifiers map[string]bool, allServices bool) (map[services.ServiceName]bool, map[services.ServiceName]error, error) {
+	args := binding_constructors.NewUnpauseServicesArgs(serviceIdentifiers, allServices)
+	response, err := enclaveCtx.client.UnpauseServices(ctx, args)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred unpausing services '%v'", serviceIdentifiers)
+	}
+	successfulServiceNames, failedServiceNames := convertApiServiceNameResults(response.GetSuccessfulServiceNames(), response.GetFailedServiceNameToError())
+	return successfulServiceNames, failedServiceNames, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#waitforserviceexitstring-serviceidentifier---int32-exitcode
+func (enclaveCtx *EnclaveContext) WaitForServiceExit(ctx context.Context, serviceIdentifier string) (int32, error) {
+	args := binding_constructors.NewWaitForServiceExitArgs(serviceIdentifier)
+	response, err := enclaveCtx.client.WaitForServiceExit(ctx, args)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred waiting for service '%v' to exit", serviceIdentifier)
+	}
+	return response.GetExitCode(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#addpartitionstring-partitionid-setservicename-servicenames
+func (enclaveCtx *EnclaveContext) AddPartition(ctx context.Context, partitionId string, serviceNames map[services.ServiceName]bool) error {
+	args := binding_constructors.NewAddPartitionArgs(partitionId, serviceNameSetToStrings(serviceNames))
+	if _, err := enclaveCtx.client.AddPartition(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding partition '%v' with services '%v'", partitionId, serviceNames)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#movepartitionstring-partitionid-setservicename-servicenames
+func (enclaveCtx *EnclaveContext) MovePartition(ctx context.Context, partitionId string, serviceNames map[services.ServiceName]bool) error {
+	args := binding_constructors.NewMovePartitionArgs(partitionId, serviceNameSetToStrings(serviceNames))
+	if _, err := enclaveCtx.client.MovePartition(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving services '%v' to partition '%v'", serviceNames, partitionId)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#updateconnectionstring-partitionida-string-partitionidb-partitionconnectioninfo-connectioninfo
+func (enclaveCtx *EnclaveContext) UpdateConnection(ctx context.Context, partitionIdA string, partitionIdB string, connectionInfo *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo) error {
+	args := binding_constructors.NewUpdateConnectionArgs(partitionIdA, partitionIdB, connectionInfo)
+	if _, err := enclaveCtx.client.UpdateConnection(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the connection between partitions '%v' and '%v'", partitionIdA, partitionIdB)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#testconnectivitysetstring-serviceidentifiers-uint32-numprobes---connectivityproberesult-proberesults
+func (enclaveCtx *EnclaveContext) TestConnectivity(ctx context.Context, serviceIdentifiers map[string]bool, numProbes uint32) ([]*kurtosis_core_rpc_api_bindings.ConnectivityProbeResult, error) {
+	args := binding_constructors.NewTestConnectivityArgs(serviceIdentifiers, numProbes)
+	response, err := enclaveCtx.client.TestConnectivity(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred testing the connectivity between services '%v'", serviceIdentifiers)
+	}
+	return response.GetProbeResults(), nil
+}
+
 // Docs available at https://docs.kurtosis.com/#getallfilesartifactnamesanduuids---filesartifactnameanduuid-filesartifactnamesanduuids
 func (enclaveCtx *EnclaveContext) GetAllFilesArtifactNamesAndUuids(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid, error) {
 	response, err := enclaveCtx.client.ListFilesArtifactNamesAndUuids(ctx, &emptypb.Empty{})
@@ -269,6 +454,112 @@ func (enclaveCtx *EnclaveContext) GetAllFilesArtifactNamesAndUuids(ctx context.C
 	return response.GetFileNamesAndUuids(), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#deletefilesartifactstring-artifactidentifier
+func (enclaveCtx *EnclaveContext) DeleteFilesArtifact(ctx context.Context, artifactIdentifier string) error {
+	args := binding_constructors.NewDeleteFilesArtifactArgs(artifactIdentifier)
+	if _, err := enclaveCtx.client.DeleteFilesArtifact(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred deleting files artifact '%v'", artifactIdentifier)
+	}
+	return nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk#inspectfilesartifactcontentsstring-artifactidentifier---filesartifactcontentsfiledescription-filedescriptions
+func (enclaveCtx *EnclaveContext) InspectFilesArtifactContents(ctx context.Context, artifactIdentifier string) ([]*kurtosis_core_rpc_api_bindings.FilesArtifactContentsFileDescription, error) {
+	args := binding_constructors.NewInspectFilesArtifactContentsArgs(artifactIdentifier)
+	response, err := enclaveCtx.client.InspectFilesArtifactContents(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred inspecting the contents of files artifact '%v'", artifactIdentifier)
+	}
+	return response.GetFileDescriptions(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#setloglevelstring-loglevel-uint32-revertafterseconds---string-previousloglevel
+func (enclaveCtx *EnclaveContext) SetLogLevel(ctx context.Context, logLevel string, revertAfterSeconds uint32) (string, error) {
+	args := &kurtosis_core_rpc_api_bindings.SetLogLevelArgs{
+		LogLevel:           logLevel,
+		RevertAfterSeconds: revertAfterSeconds,
+	}
+	response, err := enclaveCtx.client.SetLogLevel(ctx, args)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting the log level of the API container to '%v'", logLevel)
+	}
+	return response.GetPreviousLogLevel(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#runshutdownhooks---shutdownhookresult-results
+func (enclaveCtx *EnclaveContext) RunShutdownHooks(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.ShutdownHookResult, error) {
+	response, err := enclaveCtx.client.RunShutdownHooks(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the shutdown hooks of the enclave")
+	}
+	return response.GetResults(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#httprequestservicehttprequestserviceargs-args---httprequestserviceresponse-response
+func (enclaveCtx *EnclaveContext) HttpRequestService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs) (*kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse, error) {
+	response, err := enclaveCtx.client.HttpRequestService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending an HTTP request to port '%v' of service '%v'", args.GetPortId(), args.GetServiceIdentifier())
+	}
+	return response, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#getbackendcapabilities---getbackendcapabilitiesresponse-capabilities
+func (enclaveCtx *EnclaveContext) GetBackendCapabilities(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetBackendCapabilitiesResponse, error) {
+	response, err := enclaveCtx.client.GetBackendCapabilities(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the capabilities of the backend of the enclave")
+	}
+	return response, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#getpackagecacheinfo---packagecacheinfo-cacheinfo
+func (enclaveCtx *EnclaveContext) GetPackageCacheInfo(ctx context.Context) (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error) {
+	cacheInfo, err := enclaveCtx.client.GetPackageCacheInfo(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info about the package cache of the enclave")
+	}
+	return cacheInfo, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#clearpackagecache---string-removedrepositories
+func (enclaveCtx *EnclaveContext) ClearPackageCache(ctx context.Context) ([]string, error) {
+	response, err := enclaveCtx.client.ClearPackageCache(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred clearing the package cache of the enclave")
+	}
+	return response.GetRemovedRepositories(), nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#getstarlarkrunprofilestring-runid---starlarkrunprofile-runprofile
+func (enclaveCtx *EnclaveContext) GetStarlarkRunProfile(ctx context.Context, runId string) (*kurtosis_core_rpc_api_bindings.StarlarkRunProfile, error) {
+	args := binding_constructors.NewGetStarlarkRunProfileArgs(runId)
+	runProfile, err := enclaveCtx.client.GetStarlarkRunProfile(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the profile of Starlark run '%v'", runId)
+	}
+	return runProfile, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#getdeprecatedfeatures---getdeprecatedfeaturesresponse-deprecatedfeatures
+func (enclaveCtx *EnclaveContext) GetDeprecatedFeatures(ctx context.Context) (*kurtosis_core_rpc_api_bindings.GetDeprecatedFeaturesResponse, error) {
+	response, err := enclaveCtx.client.GetDeprecatedFeatures(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Starlark features deprecated in the enclave")
+	}
+	return response, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#getservicemetricsstring-serviceidentifier---getservicemetricsresponse-metrics
+func (enclaveCtx *EnclaveContext) GetServiceMetrics(ctx context.Context, serviceIdentifier string) (*kurtosis_core_rpc_api_bindings.GetServiceMetricsResponse, error) {
+	args := binding_constructors.NewGetServiceMetricsArgs(serviceIdentifier)
+	metrics, err := enclaveCtx.client.GetServiceMetrics(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the metrics of service '%v'", serviceIdentifier)
+	}
+	return metrics, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -307,6 +598,76 @@ func convertApiPortsToServiceContextPorts(apiPorts map[string]*kurtosis_core_rpc
 	return result, nil
 }
 
+// sendFilesArtifactChunks sends the content read from the reader in chunks; at least one chunk gets sent, even for empty
+// contents, as it carries the name
+func sendFilesArtifactChunks(stream kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactStreamClient, content io.Reader, artifactName string) error {
+	chunkBuffer := make([]byte, filesArtifactStreamChunkSizeBytes)
+	isFirstChunk := true
+	for {
+		numReadBytes, err := io.ReadFull(content, chunkBuffer)
+		isContentFinished := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !isContentFinished {
+			return stacktrace.Propagate(err, "An error occurred reading the content to upload")
+		}
+		if numReadBytes > 0 || isFirstChunk {
+			if err := stream.Send(binding_constructors.NewFilesArtifactStreamChunk(chunkBuffer[:numReadBytes], artifactName)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending a chunk of the content to upload")
+			}
+			isFirstChunk = false
+		}
+		if isContentFinished {
+			return nil
+		}
+	}
+}
+
+// byteCountingWriter discards what gets written to it, only counting the bytes
+type byteCountingWriter struct {
+	numWrittenBytes uint64
+}
+
+func (writer *byteCountingWriter) Write(data []byte) (int, error) {
+	writer.numWrittenBytes += uint64(len(data))
+	return len(data), nil
+}
+
+// progressReportingReader calls the progress callback with the number of bytes read so far each time it gets read from
+type progressReportingReader struct {
+	reader           io.Reader
+	numReadBytes     uint64
+	totalBytes       uint64
+	progressCallback func(readBytes uint64, totalBytes uint64)
+}
+
+func (reader *progressReportingReader) Read(buffer []byte) (int, error) {
+	numReadBytes, err := reader.reader.Read(buffer)
+	if numReadBytes > 0 {
+		reader.numReadBytes += uint64(numReadBytes)
+		reader.progressCallback(reader.numReadBytes, reader.totalBytes)
+	}
+	return numReadBytes, err
+}
+
+func convertApiServiceNameResults(successfulServiceNameStrs map[string]bool, failedServiceNameStrs map[string]string) (map[services.ServiceName]bool, map[services.ServiceName]error) {
+	successfulServiceNames := map[services.ServiceName]bool{}
+	for serviceNameStr := range successfulServiceNameStrs {
+		successfulServiceNames[services.ServiceName(serviceNameStr)] = true
+	}
+	failedServiceNames := map[services.ServiceName]error{}
+	for serviceNameStr, errStr := range failedServiceNameStrs {
+		failedServiceNames[services.ServiceName(serviceNameStr)] = stacktrace.NewError("%s", errStr)
+	}
+	return successfulServiceNames, failedServiceNames
+}
+
+func serviceNameSetToStrings(serviceNames map[services.ServiceName]bool) map[string]bool {
+	result := map[string]bool{}
+	for serviceName := range serviceNames {
+		result[string(serviceName)] = true
+	}
+	return result
+}
+
 func runReceiveStarlarkResponseLineRoutine(cancelCtxFunc context.CancelFunc, stream grpc.ClientStream, kurtosisResponseLineChan chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
 	defer func() {
 		close(kurtosisResponseLineChan)
@@ -327,7 +688,7 @@ func runReceiveStarlarkResponseLineRoutine(cancelCtxFunc context.CancelFunc, str
 	}
 }
 
-func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(packageRootPath string, serializedParams string, dryRun bool, parallelism int32) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
+func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(packageRootPath string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
 	kurtosisYamlFilepath := path.Join(packageRootPath, kurtosisYamlFilename)
 
 	kurtosisYaml, err := parseKurtosisYaml(kurtosisYamlFilepath)
@@ -341,5 +702,5 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(packageRootPath
 		return nil, stacktrace.Propagate(err, "There was an error compressing module '%v' before upload", packageRootPath)
 	}
 	logrus.Infof("Uploading and executing package '%v'", kurtosisYaml.PackageName)
-	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, compressedModule, serializedParams, dryRun, parallelism), nil
+	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, compressedModule, serializedParams, dryRun, parallelism, isStrict), nil
 }
@@ -0,0 +1,72 @@
+package enclaves
+
+import (
+	"bytes"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// fakeUploadFilesArtifactStreamClient records the chunks sent to it
+type fakeUploadFilesArtifactStreamClient struct {
+	kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactStreamClient
+
+	sentData [][]byte
+
+	sentNames []string
+}
+
+func (stream *fakeUploadFilesArtifactStreamClient) Send(chunk *kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk) error {
+	stream.sentData = append(stream.sentData, append([]byte{}, chunk.GetData()...))
+	stream.sentNames = append(stream.sentNames, chunk.GetName())
+	return nil
+}
+
+func TestSendFilesArtifactChunks_SplitsContentInChunks(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), filesArtifactStreamChunkSizeBytes+10)
+	stream := &fakeUploadFilesArtifactStreamClient{
+		ApiContainerService_UploadFilesArtifactStreamClient: nil,
+		sentData:  nil,
+		sentNames: nil,
+	}
+
+	require.NoError(t, sendFilesArtifactChunks(stream, bytes.NewReader(content), "artifact"))
+	require.Len(t, stream.sentData, 2)
+	require.Len(t, stream.sentData[0], filesArtifactStreamChunkSizeBytes)
+	require.Equal(t, content, append(stream.sentData[0], stream.sentData[1]...))
+	require.Equal(t, []string{"artifact", "artifact"}, stream.sentNames)
+}
+
+func TestSendFilesArtifactChunks_SendsOneChunkForEmptyContent(t *testing.T) {
+	stream := &fakeUploadFilesArtifactStreamClient{
+		ApiContainerService_UploadFilesArtifactStreamClient: nil,
+		sentData:  nil,
+		sentNames: nil,
+	}
+
+	require.NoError(t, sendFilesArtifactChunks(stream, bytes.NewReader(nil), "artifact"))
+	require.Len(t, stream.sentData, 1)
+	require.Empty(t, stream.sentData[0])
+}
+
+func TestProgressReportingReader_ReportsTheBytesReadSoFar(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), filesArtifactStreamChunkSizeBytes+10)
+	reportedProgress := [][2]uint64{}
+	reader := &progressReportingReader{
+		reader:       bytes.NewReader(content),
+		numReadBytes: 0,
+		totalBytes:   uint64(len(content)),
+		progressCallback: func(readBytes uint64, totalBytes uint64) {
+			reportedProgress = append(reportedProgress, [2]uint64{readBytes, totalBytes})
+		},
+	}
+	stream := &fakeUploadFilesArtifactStreamClient{
+		ApiContainerService_UploadFilesArtifactStreamClient: nil,
+		sentData:  nil,
+		sentNames: nil,
+	}
+
+	require.NoError(t, sendFilesArtifactChunks(stream, reader, "artifact"))
+	require.NotEmpty(t, reportedProgress)
+	require.Equal(t, [2]uint64{uint64(len(content)), uint64(len(content))}, reportedProgress[len(reportedProgress)-1])
+}
@@ -0,0 +1,21 @@
+package enclaves
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// FullErrorChainMetadataKey is the gRPC metadata key that asks the API container to return the full chain of an
+	// error rather than its summary. Sensitive values are redacted either way
+	FullErrorChainMetadataKey = "kurtosis-full-error-chain"
+
+	// FullErrorChainMetadataValue is the value of FullErrorChainMetadataKey that asks for the full error chain
+	FullErrorChainMetadataValue = "true"
+)
+
+// WithFullErrorChain returns a context that, when passed to the methods of EnclaveContext, makes the API container
+// return the full chain of the errors it hits instead of a summary of them
+func WithFullErrorChain(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, FullErrorChainMetadataKey, FullErrorChainMetadataValue)
+}
@@ -0,0 +1,87 @@
+package enclaves
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"regexp"
+)
+
+const (
+	// Bumped whenever the serialization changes in a way that would make existing golden files differ
+	starlarkPlanSerializationVersion = 1
+
+	serializedStarlarkPlanIndent = "  "
+
+	normalizedRuntimeValueIdFormat = "runtime-value-%d"
+)
+
+var (
+	// Runtime values get a random UUID at interpretation time, e.g. '{{kurtosis:8ab3...:code.runtime_value}}'
+	runtimeValueIdRegex = regexp.MustCompile(`{{kurtosis:([0-9a-f]{32}):`)
+)
+
+// StarlarkPlan is the machine-readable representation of the instructions a Starlark script or package produced.
+// Instruction positions aren't part of it so that moving code around doesn't change the plan
+type StarlarkPlan struct {
+	Version int `json:"version"`
+
+	Instructions []*StarlarkPlanInstruction `json:"instructions"`
+}
+
+type StarlarkPlanInstruction struct {
+	Name string `json:"name"`
+
+	Arguments []*StarlarkPlanInstructionArg `json:"arguments"`
+}
+
+type StarlarkPlanInstructionArg struct {
+	// Empty for positional arguments
+	Name string `json:"name,omitempty"`
+
+	Value string `json:"value"`
+}
+
+// SerializeStarlarkPlan serializes the instructions of a run (usually a dry run) into a stable, indented JSON document
+// suitable for golden-file testing: the same plan always serializes to the same bytes. Runtime value UUIDs, which are
+// random, are replaced by IDs numbered in order of appearance
+func SerializeStarlarkPlan(instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction) ([]byte, error) {
+	normalizedRuntimeValueIds := map[string]string{}
+	normalizeRuntimeValueIds := func(serializedValue string) string {
+		return runtimeValueIdRegex.ReplaceAllStringFunc(serializedValue, func(match string) string {
+			runtimeValueId := runtimeValueIdRegex.FindStringSubmatch(match)[1]
+			normalizedRuntimeValueId, found := normalizedRuntimeValueIds[runtimeValueId]
+			if !found {
+				normalizedRuntimeValueId = fmt.Sprintf(normalizedRuntimeValueIdFormat, len(normalizedRuntimeValueIds)+1)
+				normalizedRuntimeValueIds[runtimeValueId] = normalizedRuntimeValueId
+			}
+			return "{{kurtosis:" + normalizedRuntimeValueId + ":"
+		})
+	}
+
+	plan := &StarlarkPlan{
+		Version:      starlarkPlanSerializationVersion,
+		Instructions: []*StarlarkPlanInstruction{},
+	}
+	for _, instruction := range instructions {
+		planInstruction := &StarlarkPlanInstruction{
+			Name:      instruction.GetInstructionName(),
+			Arguments: []*StarlarkPlanInstructionArg{},
+		}
+		for _, arg := range instruction.GetArguments() {
+			planInstruction.Arguments = append(planInstruction.Arguments, &StarlarkPlanInstructionArg{
+				Name:  arg.GetArgName(),
+				Value: normalizeRuntimeValueIds(arg.GetSerializedArgValue()),
+			})
+		}
+		plan.Instructions = append(plan.Instructions, planInstruction)
+	}
+
+	serializedPlan, err := json.MarshalIndent(plan, "", serializedStarlarkPlanIndent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the Starlark plan")
+	}
+	// Ending with a newline plays nicer with editors and diffs of committed golden files
+	return append(serializedPlan, '\n'), nil
+}
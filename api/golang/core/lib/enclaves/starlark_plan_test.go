@@ -0,0 +1,64 @@
+package enclaves
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	execRuntimeValueId    = "8ab3f07e0a1d4a7b9e3c1f2d4b5a6c7d"
+	requestRuntimeValueId = "0f1e2d3c4b5a69788796a5b4c3d2e1f0"
+)
+
+func TestSerializeStarlarkPlan(t *testing.T) {
+	serviceNameArgName := "service_name"
+	instructions := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		newInstruction("exec", &kurtosis_core_rpc_api_bindings.StarlarkInstructionArg{
+			SerializedArgValue: `"datastore"`,
+			ArgName:            &serviceNameArgName,
+			IsRepresentative:   true,
+		}),
+		newInstruction("print", &kurtosis_core_rpc_api_bindings.StarlarkInstructionArg{
+			SerializedArgValue: `"{{kurtosis:` + execRuntimeValueId + `:code.runtime_value}} {{kurtosis:` + requestRuntimeValueId + `:body.runtime_value}} {{kurtosis:` + execRuntimeValueId + `:output.runtime_value}}"`,
+			ArgName:            nil,
+			IsRepresentative:   true,
+		}),
+	}
+
+	serializedPlan, err := SerializeStarlarkPlan(instructions)
+	require.NoError(t, err)
+	expectedSerializedPlan := `{
+  "version": 1,
+  "instructions": [
+    {
+      "name": "exec",
+      "arguments": [
+        {
+          "name": "service_name",
+          "value": "\"datastore\""
+        }
+      ]
+    },
+    {
+      "name": "print",
+      "arguments": [
+        {
+          "value": "\"{{kurtosis:runtime-value-1:code.runtime_value}} {{kurtosis:runtime-value-2:body.runtime_value}} {{kurtosis:runtime-value-1:output.runtime_value}}\""
+        }
+      ]
+    }
+  ]
+}
+`
+	require.Equal(t, expectedSerializedPlan, string(serializedPlan))
+}
+
+func newInstruction(name string, args ...*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
+	return &kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		Position:              nil,
+		InstructionName:       name,
+		Arguments:             args,
+		ExecutableInstruction: "",
+	}
+}
@@ -21,15 +21,18 @@ type StarlarkRunResult struct {
 	ValidationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError
 
 	ExecutionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+
+	DeprecationWarnings []*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning
 }
 
-func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) *StarlarkRunResult {
+func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError, deprecationWarnings []*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning) *StarlarkRunResult {
 	return &StarlarkRunResult{
 		RunOutput:           runOutput,
 		Instructions:        instructions,
 		InterpretationError: interpretationError,
 		ValidationErrors:    validationErrors,
 		ExecutionError:      executionError,
+		DeprecationWarnings: deprecationWarnings,
 	}
 }
 
@@ -39,6 +42,7 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 	var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 	validationErrors := make([]*kurtosis_core_rpc_api_bindings.StarlarkValidationError, 0)
 	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+	deprecationWarnings := make([]*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning, 0)
 
 	for responseLine := range starlarkRunResponseLines {
 		if responseLine.GetInstruction() != nil {
@@ -46,6 +50,8 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 		} else if responseLine.GetInstructionResult() != nil {
 			scriptOutput.WriteString(responseLine.GetInstructionResult().GetSerializedInstructionResult())
 			scriptOutput.WriteString(starlarkRunOutputLinesSplit)
+		} else if responseLine.GetDeprecationWarning() != nil {
+			deprecationWarnings = append(deprecationWarnings, responseLine.GetDeprecationWarning())
 		} else if responseLine.GetError() != nil {
 			if responseLine.GetError().GetInterpretationError() != nil {
 				interpretationError = responseLine.GetError().GetInterpretationError()
@@ -61,5 +67,6 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 		instructions,
 		interpretationError,
 		validationErrors,
-		executionError)
+		executionError,
+		deprecationWarnings)
 }
@@ -13,6 +13,7 @@ type FileArtifactName string
 const (
 	defaultSubnetwork               = "default"
 	defaultPrivateIPAddrPlaceholder = "KURTOSIS_IP_ADDR_PLACEHOLDER"
+	defaultReplicas                 = 1
 )
 
 type ServiceConfigBuilder struct {
@@ -27,6 +28,24 @@ type ServiceConfigBuilder struct {
 	memoryAllocationMegabytes  uint64
 	privateIPAddrPlaceholder   string
 	subnetwork                 string
+
+	kubernetesServiceAccount            string
+	kubernetesServiceAccountAnnotations map[string]string
+	kubernetesScheduling                *kurtosis_core_rpc_api_bindings.KubernetesScheduling
+
+	replicas uint32
+
+	cpuset    string
+	numaNodes string
+
+	ulimits map[string]*kurtosis_core_rpc_api_bindings.Ulimit
+	sysctls map[string]string
+
+	shmSizeMegabytes uint64
+
+	hostDirMounts map[string]string
+
+	metricsPortId string
 }
 
 func NewServiceConfigBuilder(containerImageName string) *ServiceConfigBuilder {
@@ -42,6 +61,24 @@ func NewServiceConfigBuilder(containerImageName string) *ServiceConfigBuilder {
 		memoryAllocationMegabytes:  0,
 		privateIPAddrPlaceholder:   defaultPrivateIPAddrPlaceholder,
 		subnetwork:                 defaultSubnetwork,
+
+		kubernetesServiceAccount:            "",
+		kubernetesServiceAccountAnnotations: map[string]string{},
+		kubernetesScheduling:                nil,
+
+		replicas: defaultReplicas,
+
+		cpuset:    "",
+		numaNodes: "",
+
+		ulimits: map[string]*kurtosis_core_rpc_api_bindings.Ulimit{},
+		sysctls: map[string]string{},
+
+		shmSizeMegabytes: 0,
+
+		hostDirMounts: map[string]string{},
+
+		metricsPortId: "",
 	}
 }
 
@@ -60,6 +97,24 @@ func NewServiceConfigBuilderFromServiceConfig(serviceConfig *kurtosis_core_rpc_a
 		memoryAllocationMegabytes:  serviceConfig.MemoryAllocationMegabytes,
 		privateIPAddrPlaceholder:   serviceConfig.PrivateIpAddrPlaceholder,
 		subnetwork:                 *serviceConfig.Subnetwork,
+
+		kubernetesServiceAccount:            serviceConfig.GetKubernetesServiceAccount(),
+		kubernetesServiceAccountAnnotations: copyMap(serviceConfig.KubernetesServiceAccountAnnotations),
+		kubernetesScheduling:                copyKubernetesScheduling(serviceConfig.KubernetesScheduling),
+
+		replicas: serviceConfig.GetReplicas(),
+
+		cpuset:    serviceConfig.GetCpuset(),
+		numaNodes: serviceConfig.GetNumaNodes(),
+
+		ulimits: copyUlimitsMap(serviceConfig.Ulimits),
+		sysctls: copyMap(serviceConfig.Sysctls),
+
+		shmSizeMegabytes: serviceConfig.GetShmSizeMegabytes(),
+
+		hostDirMounts: copyMap(serviceConfig.HostDirMounts),
+
+		metricsPortId: serviceConfig.GetMetricsPortId(),
 	}
 }
 
@@ -118,6 +173,74 @@ func (builder *ServiceConfigBuilder) WithMemoryAllocationMegabytes(memoryAllocat
 	return builder
 }
 
+// WithKubernetesServiceAccount makes the service run as the given service account, along with the annotations of the
+// service account (e.g. to bind it to a cloud IAM role). Only the Kubernetes backend supports it
+func (builder *ServiceConfigBuilder) WithKubernetesServiceAccount(serviceAccount string, annotations map[string]string) *ServiceConfigBuilder {
+	builder.kubernetesServiceAccount = serviceAccount
+	builder.kubernetesServiceAccountAnnotations = copyMap(annotations)
+	return builder
+}
+
+// WithKubernetesScheduling constrains the nodes the service can be scheduled on (node selector, tolerations, affinity
+// and topology spread constraints). Only the Kubernetes backend supports it
+func (builder *ServiceConfigBuilder) WithKubernetesScheduling(scheduling *kurtosis_core_rpc_api_bindings.KubernetesScheduling) *ServiceConfigBuilder {
+	builder.kubernetesScheduling = copyKubernetesScheduling(scheduling)
+	return builder
+}
+
+// WithReplicas runs the given number of replicas of the service behind its private IP address. Only the Kubernetes
+// backend supports more than one
+func (builder *ServiceConfigBuilder) WithReplicas(replicas uint32) *ServiceConfigBuilder {
+	builder.replicas = replicas
+	return builder
+}
+
+// WithCpuset pins the service to the given host CPUs, in the Linux cpuset list format (e.g. "0-3,6"). Only the Docker
+// backend supports it
+func (builder *ServiceConfigBuilder) WithCpuset(cpuset string) *ServiceConfigBuilder {
+	builder.cpuset = cpuset
+	return builder
+}
+
+// WithNumaNodes restricts the memory of the service to the given host NUMA nodes, in the Linux cpuset list format. Only
+// the Docker backend supports it
+func (builder *ServiceConfigBuilder) WithNumaNodes(numaNodes string) *ServiceConfigBuilder {
+	builder.numaNodes = numaNodes
+	return builder
+}
+
+// WithUlimits sets the resource limits of the service's container, by ulimit name (e.g. "nofile" or "nproc")
+func (builder *ServiceConfigBuilder) WithUlimits(ulimits map[string]*kurtosis_core_rpc_api_bindings.Ulimit) *ServiceConfigBuilder {
+	builder.ulimits = copyUlimitsMap(ulimits)
+	return builder
+}
+
+// WithSysctls sets namespaced kernel parameters of the service's container (e.g. "net.core.somaxconn")
+func (builder *ServiceConfigBuilder) WithSysctls(sysctls map[string]string) *ServiceConfigBuilder {
+	builder.sysctls = copyMap(sysctls)
+	return builder
+}
+
+// WithShmSizeMegabytes sets the size of the service's /dev/shm, in megabytes
+func (builder *ServiceConfigBuilder) WithShmSizeMegabytes(shmSizeMegabytes uint64) *ServiceConfigBuilder {
+	builder.shmSizeMegabytes = shmSizeMegabytes
+	return builder
+}
+
+// WithHostDirMounts bind-mounts directories of the host into the service, specified as mountpoint -> absolute host
+// dirpath; the enclave must have been created with host directory mounts allowed
+func (builder *ServiceConfigBuilder) WithHostDirMounts(hostDirMounts map[string]string) *ServiceConfigBuilder {
+	builder.hostDirMounts = hostDirMounts
+	return builder
+}
+
+// WithMetricsPortId sets the ID of the private port the service serves Prometheus metrics on, making it a scrape
+// target of the enclave; if unset, the service's port with ID 'metrics' is used, if it has one
+func (builder *ServiceConfigBuilder) WithMetricsPortId(metricsPortId string) *ServiceConfigBuilder {
+	builder.metricsPortId = metricsPortId
+	return builder
+}
+
 func (builder *ServiceConfigBuilder) Build() *kurtosis_core_rpc_api_bindings.ServiceConfig {
 	return binding_constructors.NewServiceConfig(
 		builder.containerImageName,
@@ -131,6 +254,17 @@ func (builder *ServiceConfigBuilder) Build() *kurtosis_core_rpc_api_bindings.Ser
 		builder.memoryAllocationMegabytes,
 		builder.privateIPAddrPlaceholder,
 		builder.subnetwork,
+		builder.kubernetesServiceAccount,
+		builder.kubernetesServiceAccountAnnotations,
+		builder.kubernetesScheduling,
+		builder.replicas,
+		builder.cpuset,
+		builder.numaNodes,
+		builder.ulimits,
+		builder.sysctls,
+		builder.shmSizeMegabytes,
+		builder.hostDirMounts,
+		builder.metricsPortId,
 	)
 }
 
@@ -145,6 +279,38 @@ func copyPortsMap(ports map[string]*kurtosis_core_rpc_api_bindings.Port) map[str
 	return newPorts
 }
 
+func copyUlimitsMap(ulimits map[string]*kurtosis_core_rpc_api_bindings.Ulimit) map[string]*kurtosis_core_rpc_api_bindings.Ulimit {
+	if ulimits == nil {
+		return nil
+	}
+	newUlimits := make(map[string]*kurtosis_core_rpc_api_bindings.Ulimit, len(ulimits))
+	for name, ulimit := range ulimits {
+		newUlimits[name] = binding_constructors.NewUlimit(ulimit.Soft, ulimit.Hard)
+	}
+	return newUlimits
+}
+
+func copyKubernetesScheduling(scheduling *kurtosis_core_rpc_api_bindings.KubernetesScheduling) *kurtosis_core_rpc_api_bindings.KubernetesScheduling {
+	if scheduling == nil {
+		return nil
+	}
+	var tolerations []*kurtosis_core_rpc_api_bindings.KubernetesToleration
+	for _, toleration := range scheduling.Tolerations {
+		var tolerationSeconds *int64
+		if toleration.TolerationSeconds != nil {
+			tolerationSecondsCopy := *toleration.TolerationSeconds
+			tolerationSeconds = &tolerationSecondsCopy
+		}
+		tolerations = append(tolerations, binding_constructors.NewKubernetesToleration(toleration.Key, toleration.Operator, toleration.Value, toleration.Effect, tolerationSeconds))
+	}
+	return binding_constructors.NewKubernetesScheduling(
+		copyMap(scheduling.NodeSelector),
+		tolerations,
+		scheduling.SerializedAffinity,
+		scheduling.SerializedTopologySpreadConstraints,
+	)
+}
+
 func copySlice(value []string) []string {
 	if value == nil {
 		return nil
@@ -38,6 +38,36 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 		"<IP_ADDRESS>",
 	).WithSubnetwork(
 		"subnetwork_1",
+	).WithKubernetesServiceAccount(
+		"s3-reader",
+		map[string]string{
+			"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/s3-reader",
+		},
+	).WithKubernetesScheduling(
+		binding_constructors.NewKubernetesScheduling(
+			map[string]string{"node-pool": "gpu"},
+			[]*kurtosis_core_rpc_api_bindings.KubernetesToleration{
+				binding_constructors.NewKubernetesToleration("nvidia.com/gpu", "Exists", "", "NoSchedule", nil),
+			},
+			"",
+			"",
+		),
+	).WithReplicas(
+		3,
+	).WithCpuset(
+		"0-3",
+	).WithNumaNodes(
+		"0",
+	).WithUlimits(
+		map[string]*kurtosis_core_rpc_api_bindings.Ulimit{"nofile": binding_constructors.NewUlimit(65536, 65536)},
+	).WithSysctls(
+		map[string]string{"net.core.somaxconn": "1024"},
+	).WithShmSizeMegabytes(
+		256,
+	).WithHostDirMounts(
+		map[string]string{"/app/src": "/home/dev/project/src"},
+	).WithMetricsPortId(
+		"grpc",
 	).Build()
 
 	newServiceConfigBuilder := NewServiceConfigBuilderFromServiceConfig(initialServiceConfig)
@@ -57,6 +87,20 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 	newServiceConfig.MemoryAllocationMegabytes = 512
 	newSubnetwork := "new-subnetwork"
 	newServiceConfig.Subnetwork = &newSubnetwork
+	newServiceConfig.KubernetesServiceAccountAnnotations["eks.amazonaws.com/role-arn"] = "arn:aws:iam::111122223333:role/s3-writer"
+	newServiceConfig.KubernetesScheduling.NodeSelector["node-pool"] = "cpu"
+	newServiceConfig.KubernetesScheduling.Tolerations[0].Effect = "NoExecute"
+	newReplicas := uint32(5)
+	newServiceConfig.Replicas = &newReplicas
+	newCpuset := "4-7"
+	newServiceConfig.Cpuset = &newCpuset
+	newServiceConfig.Ulimits["nofile"].Soft = 1024
+	newServiceConfig.Sysctls["net.core.somaxconn"] = "4096"
+	newShmSizeMegabytes := uint64(1024)
+	newServiceConfig.ShmSizeMegabytes = &newShmSizeMegabytes
+	newServiceConfig.HostDirMounts["/app/src"] = "/home/dev/other-project/src"
+	newMetricsPortId := "prometheus"
+	newServiceConfig.MetricsPortId = &newMetricsPortId
 
 	// test that initial value has not changed
 	require.Equal(t, "test-image", initialServiceConfig.ContainerImageName)
@@ -69,6 +113,18 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 	require.Equal(t, uint64(1000), initialServiceConfig.CpuAllocationMillicpus)
 	require.Equal(t, uint64(1024), initialServiceConfig.MemoryAllocationMegabytes)
 	require.Equal(t, "subnetwork_1", *initialServiceConfig.Subnetwork)
+	require.Equal(t, "s3-reader", initialServiceConfig.GetKubernetesServiceAccount())
+	require.Equal(t, map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::111122223333:role/s3-reader"}, initialServiceConfig.KubernetesServiceAccountAnnotations)
+	require.Equal(t, map[string]string{"node-pool": "gpu"}, initialServiceConfig.GetKubernetesScheduling().GetNodeSelector())
+	require.Equal(t, "NoSchedule", initialServiceConfig.GetKubernetesScheduling().GetTolerations()[0].GetEffect())
+	require.Equal(t, uint32(3), initialServiceConfig.GetReplicas())
+	require.Equal(t, "0-3", initialServiceConfig.GetCpuset())
+	require.Equal(t, "0", initialServiceConfig.GetNumaNodes())
+	require.Equal(t, int64(65536), initialServiceConfig.GetUlimits()["nofile"].GetSoft())
+	require.Equal(t, map[string]string{"net.core.somaxconn": "1024"}, initialServiceConfig.GetSysctls())
+	require.Equal(t, uint64(256), initialServiceConfig.GetShmSizeMegabytes())
+	require.Equal(t, map[string]string{"/app/src": "/home/dev/project/src"}, initialServiceConfig.GetHostDirMounts())
+	require.Equal(t, "grpc", initialServiceConfig.GetMetricsPortId())
 
 	// test that new value are as expected
 	require.Equal(t, "new-test-image", newServiceConfig.ContainerImageName)
@@ -81,4 +137,10 @@ func TestServiceConfigBuilderFrom_Invariant(t *testing.T) {
 	require.Equal(t, uint64(500), newServiceConfig.CpuAllocationMillicpus)
 	require.Equal(t, uint64(512), newServiceConfig.MemoryAllocationMegabytes)
 	require.Equal(t, "new-subnetwork", *newServiceConfig.Subnetwork)
+	require.Equal(t, "4-7", newServiceConfig.GetCpuset())
+	require.Equal(t, int64(1024), newServiceConfig.GetUlimits()["nofile"].GetSoft())
+	require.Equal(t, map[string]string{"net.core.somaxconn": "4096"}, newServiceConfig.GetSysctls())
+	require.Equal(t, uint64(1024), newServiceConfig.GetShmSizeMegabytes())
+	require.Equal(t, map[string]string{"/app/src": "/home/dev/other-project/src"}, newServiceConfig.GetHostDirMounts())
+	require.Equal(t, "prometheus", newServiceConfig.GetMetricsPortId())
 }
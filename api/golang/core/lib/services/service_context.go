@@ -22,6 +22,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/stacktrace"
+	"io"
 )
 
 // Docs available at https://docs.kurtosis.com/sdk/#servicecontext
@@ -34,6 +35,12 @@ type ServiceContext struct {
 	privateIpAddr string
 	privatePorts  map[string]*PortSpec
 
+	// Network locations of the individual replicas inside the enclave; empty unless the service runs several replicas
+	replicaPrivateIpAddrs []string
+
+	// Network location inside the enclave over IPv6; empty unless the enclave was created with IPv6 enabled
+	maybePrivateIpv6Addr string
+
 	// Network location outside the enclave
 	publicIpAddr string
 	publicPorts  map[string]*PortSpec
@@ -47,15 +54,19 @@ func NewServiceContext(
 	privatePorts map[string]*PortSpec,
 	publicIpAddr string,
 	publicPorts map[string]*PortSpec,
+	replicaPrivateIpAddrs []string,
+	maybePrivateIpv6Addr string,
 ) *ServiceContext {
 	return &ServiceContext{
-		client:        client,
-		serviceName:   serviceName,
-		serviceUuid:   serviceUuid,
-		privateIpAddr: privateIpAddr,
-		privatePorts:  privatePorts,
-		publicIpAddr:  publicIpAddr,
-		publicPorts:   publicPorts,
+		client:                client,
+		serviceName:           serviceName,
+		serviceUuid:           serviceUuid,
+		privateIpAddr:         privateIpAddr,
+		privatePorts:          privatePorts,
+		replicaPrivateIpAddrs: replicaPrivateIpAddrs,
+		maybePrivateIpv6Addr:  maybePrivateIpv6Addr,
+		publicIpAddr:          publicIpAddr,
+		publicPorts:           publicPorts,
 	}
 }
 
@@ -79,6 +90,17 @@ func (service *ServiceContext) GetPrivatePorts() map[string]*PortSpec {
 	return service.privatePorts
 }
 
+// GetReplicaPrivateIPAddresses returns the private IP addresses of the individual replicas of the service, each listening
+// on the private ports. It's empty unless the service runs more than one replica, which only the Kubernetes backend supports
+func (service *ServiceContext) GetReplicaPrivateIPAddresses() []string {
+	return service.replicaPrivateIpAddrs
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#getmaybeprivateipv6address---string
+func (service *ServiceContext) GetMaybePrivateIPv6Address() string {
+	return service.maybePrivateIpv6Addr
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#getmaybepublicipaddress---string
 func (service *ServiceContext) GetMaybePublicIPAddress() string {
 	return service.publicIpAddr
@@ -103,3 +125,37 @@ func (service *ServiceContext) ExecCommand(command []string) (int32, string, err
 	}
 	return resp.ExitCode, resp.LogOutput, nil
 }
+
+// Docs available at https://docs.kurtosis.com/sdk/#execcommandwithstreamedoutputcontext-ctx-liststring-command-writer-output---int-exitcode
+func (service *ServiceContext) ExecCommandWithStreamedOutput(ctx context.Context, command []string, output io.Writer) (int32, error) {
+	serviceName := service.serviceName
+	args := binding_constructors.NewExecCommandArgs(string(serviceName), command)
+	stream, err := service.client.ExecCommandWithStreamedOutput(ctx, args)
+	if err != nil {
+		return 0, stacktrace.Propagate(
+			err,
+			"An error occurred executing command '%v' with streamed output on service '%v'",
+			command,
+			serviceName)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return 0, stacktrace.NewError("The output stream of command '%v' on service '%v' ended without an exit code", command, serviceName)
+		}
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred receiving the output of command '%v' on service '%v'", command, serviceName)
+		}
+		if chunkOutput := chunk.GetOutput(); chunkOutput != nil {
+			if _, err := output.Write(chunkOutput); err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred writing the output of command '%v' on service '%v'", command, serviceName)
+			}
+			continue
+		}
+		exitCodeChunk, isExitCodeChunk := chunk.GetChunk().(*kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk_ExitCode)
+		if !isExitCodeChunk {
+			return 0, stacktrace.NewError("Received an empty chunk in the output stream of command '%v' on service '%v'", command, serviceName)
+		}
+		return exitCodeChunk.ExitCode, nil
+	}
+}
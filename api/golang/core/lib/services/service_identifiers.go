@@ -67,3 +67,14 @@ func (identifiers *ServiceIdentifiers) GetOrderedListOfNames() []string {
 	sort.Strings(serviceNames)
 	return serviceNames
 }
+
+// Docs available at https://docs.kurtosis.com/sdk#getservicenamesbyuuid---mapserviceuuid-servicename-servicenamesbyuuid
+func (identifiers *ServiceIdentifiers) GetServiceNamesByUuid() map[ServiceUUID]ServiceName {
+	serviceNamesByUuid := map[ServiceUUID]ServiceName{}
+	for name, uuids := range identifiers.serviceNameToUuids {
+		for _, uuid := range uuids {
+			serviceNamesByUuid[uuid] = name
+		}
+	}
+	return serviceNamesByUuid
+}
@@ -0,0 +1,150 @@
+package shared_utils
+
+import (
+	"bufio"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// KurtosisIgnoreFilename is the file at the root of an uploaded directory listing the paths not to upload, with the
+	// same syntax as a .gitignore file
+	KurtosisIgnoreFilename = ".kurtosisignore"
+
+	ignoreFileCommentPrefix  = "#"
+	ignoreFileNegationPrefix = "!"
+	ignoreFilePathSeparator  = "/"
+	anyNumberOfDirsPattern   = "**"
+)
+
+type ignorePattern struct {
+	// The path segments of the pattern, e.g. ['build', '*.o'] for 'build/*.o'
+	segments []string
+
+	// Patterns containing a slash only match from the root of the uploaded directory; the others match at any depth
+	isAnchored bool
+
+	// Patterns ending with a slash only match directories
+	isDirOnly bool
+
+	// Negated patterns re-include paths that a previous pattern excluded
+	isNegated bool
+}
+
+// KurtosisIgnore decides which paths of a directory being uploaded should be left out, according to its
+// .kurtosisignore file
+type KurtosisIgnore struct {
+	patterns []*ignorePattern
+}
+
+// LoadKurtosisIgnore reads the .kurtosisignore file at the root of the given directory; a directory without one
+// ignores nothing
+func LoadKurtosisIgnore(dirpath string) (*KurtosisIgnore, error) {
+	ignoreFilepath := filepath.Join(dirpath, KurtosisIgnoreFilename)
+	ignoreFile, err := os.Open(ignoreFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return NewKurtosisIgnore([]string{}), nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred opening ignore file '%s'", ignoreFilepath)
+	}
+	defer ignoreFile.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(ignoreFile)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading ignore file '%s'", ignoreFilepath)
+	}
+	return NewKurtosisIgnore(lines), nil
+}
+
+// NewKurtosisIgnore parses the lines of a .kurtosisignore file
+func NewKurtosisIgnore(lines []string) *KurtosisIgnore {
+	patterns := []*ignorePattern{}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, ignoreFileCommentPrefix) {
+			continue
+		}
+		pattern := &ignorePattern{
+			segments:   nil,
+			isAnchored: false,
+			isDirOnly:  false,
+			isNegated:  false,
+		}
+		if strings.HasPrefix(line, ignoreFileNegationPrefix) {
+			pattern.isNegated = true
+			line = strings.TrimPrefix(line, ignoreFileNegationPrefix)
+		}
+		if strings.HasSuffix(line, ignoreFilePathSeparator) {
+			pattern.isDirOnly = true
+			line = strings.TrimRight(line, ignoreFilePathSeparator)
+		}
+		if strings.Contains(line, ignoreFilePathSeparator) {
+			pattern.isAnchored = true
+			line = strings.TrimLeft(line, ignoreFilePathSeparator)
+		}
+		if line == "" {
+			continue
+		}
+		pattern.segments = strings.Split(line, ignoreFilePathSeparator)
+		patterns = append(patterns, pattern)
+	}
+	return &KurtosisIgnore{
+		patterns: patterns,
+	}
+}
+
+// IsIgnored returns whether the path, relative to the root of the uploaded directory and using slashes, should be
+// left out. As with .gitignore files, the last pattern matching the path wins, and the content of an ignored
+// directory is ignored too
+func (kurtosisIgnore *KurtosisIgnore) IsIgnored(relativePath string, isDir bool) bool {
+	isIgnored := false
+	for _, pattern := range kurtosisIgnore.patterns {
+		if pattern.isDirOnly && !isDir {
+			continue
+		}
+		if pattern.matches(strings.Split(relativePath, ignoreFilePathSeparator)) {
+			isIgnored = !pattern.isNegated
+		}
+	}
+	return isIgnored
+}
+
+func (pattern *ignorePattern) matches(pathSegments []string) bool {
+	if pattern.isAnchored {
+		return matchSegments(pattern.segments, pathSegments)
+	}
+	// Unanchored patterns are a single segment matching the name of the path at any depth
+	return matchSegment(pattern.segments[0], pathSegments[len(pathSegments)-1])
+}
+
+func matchSegments(patternSegments []string, pathSegments []string) bool {
+	if len(patternSegments) == 0 {
+		return len(pathSegments) == 0
+	}
+	if patternSegments[0] == anyNumberOfDirsPattern {
+		for numSkippedSegments := 0; numSkippedSegments <= len(pathSegments); numSkippedSegments++ {
+			if matchSegments(patternSegments[1:], pathSegments[numSkippedSegments:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(pathSegments) == 0 || !matchSegment(patternSegments[0], pathSegments[0]) {
+		return false
+	}
+	return matchSegments(patternSegments[1:], pathSegments[1:])
+}
+
+func matchSegment(patternSegment string, pathSegment string) bool {
+	// The only error path.Match returns is for malformed patterns, which are treated as not matching anything
+	isMatch, err := path.Match(patternSegment, pathSegment)
+	return err == nil && isMatch
+}
@@ -0,0 +1,32 @@
+package shared_utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestKurtosisIgnore_IsIgnored(t *testing.T) {
+	kurtosisIgnore := NewKurtosisIgnore([]string{
+		"# dependencies",
+		"node_modules",
+		"",
+		"*.log",
+		"!important.log",
+		"build/",
+		"/docs/generated",
+		"**/testdata/*.bin",
+	})
+
+	require.True(t, kurtosisIgnore.IsIgnored("node_modules", true))
+	require.True(t, kurtosisIgnore.IsIgnored("web/node_modules", true))
+	require.True(t, kurtosisIgnore.IsIgnored("debug.log", false))
+	require.True(t, kurtosisIgnore.IsIgnored("logs/debug.log", false))
+	require.False(t, kurtosisIgnore.IsIgnored("logs/important.log", false))
+	require.True(t, kurtosisIgnore.IsIgnored("build", true))
+	require.False(t, kurtosisIgnore.IsIgnored("build", false))
+	require.True(t, kurtosisIgnore.IsIgnored("docs/generated", true))
+	require.False(t, kurtosisIgnore.IsIgnored("src/docs/generated", true))
+	require.True(t, kurtosisIgnore.IsIgnored("testdata/blob.bin", false))
+	require.True(t, kurtosisIgnore.IsIgnored("pkg/parser/testdata/blob.bin", false))
+	require.False(t, kurtosisIgnore.IsIgnored("main.star", false))
+}
@@ -0,0 +1,58 @@
+package shared_utils
+
+import (
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// PackageLockFilename is the file at the root of a package recording which tag the version constraints of the
+	// packages it imports got resolved to. It's meant to be committed along with the package
+	PackageLockFilename = "kurtosis-package.lock"
+
+	packageLockFilePermission = 0644
+)
+
+// PackageLockFile records which tag every version constraint got resolved to, so that running a package again keeps
+// using the same versions even if newer tags matching the constraints got pushed in the meantime
+type PackageLockFile struct {
+	// Keyed by '<repository path>@<constraint>' (e.g. 'kurtosis-tech/eth2-package@^1.2'); values are tag names
+	ResolvedVersions map[string]string `yaml:"resolved_versions"`
+}
+
+// ReadPackageLockFile reads the lock file at the root of the given directory; a directory without one locks nothing
+func ReadPackageLockFile(dirpath string) (*PackageLockFile, error) {
+	lockFile := &PackageLockFile{
+		ResolvedVersions: map[string]string{},
+	}
+	lockFilepath := filepath.Join(dirpath, PackageLockFilename)
+	lockFileContent, err := os.ReadFile(lockFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return lockFile, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred reading package lock file '%v'", lockFilepath)
+	}
+	if err = yaml.Unmarshal(lockFileContent, lockFile); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing package lock file '%v'", lockFilepath)
+	}
+	if lockFile.ResolvedVersions == nil {
+		lockFile.ResolvedVersions = map[string]string{}
+	}
+	return lockFile, nil
+}
+
+// WritePackageLockFile writes the lock file at the root of the given directory, replacing the existing one if any
+func WritePackageLockFile(dirpath string, lockFile *PackageLockFile) error {
+	lockFilepath := filepath.Join(dirpath, PackageLockFilename)
+	lockFileContent, err := yaml.Marshal(lockFile)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the package lock file")
+	}
+	if err = os.WriteFile(lockFilepath, lockFileContent, packageLockFilePermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing package lock file '%v'", lockFilepath)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package shared_utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReadPackageLockFile_NoLockFileLocksNothing(t *testing.T) {
+	lockFile, err := ReadPackageLockFile(t.TempDir())
+	require.NoError(t, err)
+	require.Empty(t, lockFile.ResolvedVersions)
+}
+
+func TestWritePackageLockFile_CanBeReadBack(t *testing.T) {
+	dirpath := t.TempDir()
+	resolvedVersions := map[string]string{
+		"kurtosis-tech/eth2-package@^1.2":     "v1.2.3",
+		"kurtosis-tech/datastore-army@~0.4.1": "0.4.5",
+	}
+	require.NoError(t, WritePackageLockFile(dirpath, &PackageLockFile{ResolvedVersions: resolvedVersions}))
+
+	lockFile, err := ReadPackageLockFile(dirpath)
+	require.NoError(t, err)
+	require.Equal(t, resolvedVersions, lockFile.ResolvedVersions)
+}
@@ -1,75 +1,174 @@
 package shared_utils
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"github.com/kurtosis-tech/stacktrace"
-	"github.com/mholt/archiver"
-	"io/ioutil"
+	"io"
+	"io/fs"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
 const (
-	grpcDataTransferLimit     = 3999000 //3.999 Mb. 1kb wiggle room. 1kb being about the size of a simple 2 paragraph readme.
-	tempCompressionDirPattern = "upload-compression-cache-"
-	compressionExtension      = ".tgz"
-	defaultTmpDir             = ""
+	grpcDataTransferLimit = 3999000 //3.999 Mb. 1kb wiggle room. 1kb being about the size of a simple 2 paragraph readme.
 )
 
+// CompressPath returns the file, or the content of the directory, at the given path as a .tgz archive. The paths of a
+// directory matching its .kurtosisignore file are left out. The archive gets streamed into memory as the files get
+// read, so that compressing stops as soon as the gRPC limit is reached when it has to be accounted for
 func CompressPath(pathToCompress string, accountForGRPCLimit bool) ([]byte, error) {
+	compressedContent := &bytes.Buffer{}
+	maxSizeBytes := math.MaxInt
+	if accountForGRPCLimit {
+		maxSizeBytes = grpcDataTransferLimit
+	}
+	compressedContentWriter := &limitedWriter{
+		underlying:      compressedContent,
+		maxSizeBytes:    maxSizeBytes,
+		numWrittenBytes: 0,
+		isLimitReached:  false,
+	}
+	err := CompressPathToWriter(pathToCompress, compressedContentWriter)
+	if compressedContentWriter.isLimitReached {
+		return nil, stacktrace.NewError(
+			"The files you are trying to upload, which are now compressed, exceed or reach 4mb, a limit imposed by gRPC. "+
+				"Please reduce the total file size and ensure it can compress to a size below 4mb, e.g. by listing the "+
+				"paths not to upload in a '%s' file at the root of the directory.", KurtosisIgnoreFilename)
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred compressing '%s'", pathToCompress)
+	}
+	return compressedContent.Bytes(), nil
+}
+
+// CompressPathToWriter is CompressPath for archives too big to be held in memory: the archive gets written to the output
+// as the files get read. Compressing the same files twice writes the same bytes, so the archive can be hashed in a first
+// pass and sent in a second one
+func CompressPathToWriter(pathToCompress string, output io.Writer) error {
 	pathToCompress = strings.TrimRight(pathToCompress, string(filepath.Separator))
 	uploadFileInfo, err := os.Stat(pathToCompress)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "There was a path error for '%s' during file compression.", pathToCompress)
+		return stacktrace.Propagate(err, "There was a path error for '%s' during file compression.", pathToCompress)
 	}
 
-	// This allows us to archive contents of dirs in root instead of nesting
-	var filepathsToUpload []string
-	if uploadFileInfo.IsDir() {
-		filesInDirectory, err := ioutil.ReadDir(pathToCompress)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "There was an error in getting a list of files in the directory '%s' provided", pathToCompress)
-		}
-		if len(filesInDirectory) == 0 {
-			return nil, stacktrace.NewError("The directory '%s' you are trying to compress is empty", pathToCompress)
-		}
+	gzipWriter := gzip.NewWriter(output)
+	tarWriter := tar.NewWriter(gzipWriter)
 
-		for _, fileInDirectory := range filesInDirectory {
-			filepathToUpload := filepath.Join(pathToCompress, fileInDirectory.Name())
-			filepathsToUpload = append(filepathsToUpload, filepathToUpload)
-		}
+	if uploadFileInfo.IsDir() {
+		err = writeDirContentToTar(tarWriter, pathToCompress)
 	} else {
-		filepathsToUpload = append(filepathsToUpload, pathToCompress)
+		err = writePathToTar(tarWriter, pathToCompress, uploadFileInfo.Name(), uploadFileInfo)
+	}
+	if err == nil {
+		err = tarWriter.Close()
+	}
+	if err == nil {
+		err = gzipWriter.Close()
 	}
-
-	tempDir, err := ioutil.TempDir(defaultTmpDir, tempCompressionDirPattern)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to create temporary directory '%s' for compression.", tempDir)
+		return stacktrace.Propagate(err, "Failed to compress '%s'.", pathToCompress)
 	}
+	return nil
+}
 
-	compressedFilePath := filepath.Join(tempDir, filepath.Base(pathToCompress)+compressionExtension)
-	if err = archiver.Archive(filepathsToUpload, compressedFilePath); err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to compress '%s'.", pathToCompress)
+// writeDirContentToTar archives the content of the directory at the root of the archive instead of nesting it
+func writeDirContentToTar(tarWriter *tar.Writer, dirpath string) error {
+	kurtosisIgnore, err := LoadKurtosisIgnore(dirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the ignore file of directory '%s'", dirpath)
 	}
 
-	compressedFileInfo, err := os.Stat(compressedFilePath)
+	numArchivedPaths := 0
+	err = filepath.WalkDir(dirpath, func(pathInDir string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if pathInDir == dirpath {
+			return nil
+		}
+		relativePath, err := filepath.Rel(dirpath, pathInDir)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%s' relative to '%s'", pathInDir, dirpath)
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if kurtosisIgnore.IsIgnored(relativePath, dirEntry.IsDir()) {
+			if dirEntry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting info about '%s'", pathInDir)
+		}
+		numArchivedPaths++
+		return writePathToTar(tarWriter, pathInDir, relativePath, info)
+	})
 	if err != nil {
-		return nil, stacktrace.Propagate(err,
-			"Failed to create a temporary archive file at '%s' during files upload for '%s'.",
-			tempDir, pathToCompress)
+		return stacktrace.Propagate(err, "There was an error archiving the content of directory '%s'", dirpath)
+	}
+	if numArchivedPaths == 0 {
+		return stacktrace.NewError("The directory '%s' you are trying to compress is empty, or all its content is ignored by its '%s' file", dirpath, KurtosisIgnoreFilename)
 	}
+	return nil
+}
 
-	if accountForGRPCLimit && compressedFileInfo.Size() >= grpcDataTransferLimit {
-		return nil, stacktrace.NewError(
-			"The files you are trying to upload, which are now compressed, exceed or reach 4mb, a limit imposed by gRPC. " +
-				"Please reduce the total file size and ensure it can compress to a size below 4mb.")
+func writePathToTar(tarWriter *tar.Writer, pathOnDisk string, nameInArchive string, info fs.FileInfo) error {
+	symlinkTarget := ""
+	if info.Mode()&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(pathOnDisk)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the target of symlink '%s'", pathOnDisk)
+		}
+		symlinkTarget = target
 	}
-	content, err := ioutil.ReadFile(compressedFilePath)
+	header, err := tar.FileInfoHeader(info, symlinkTarget)
 	if err != nil {
-		return nil, stacktrace.Propagate(err,
-			"There was an error reading from the temporary tar file '%s' recently compressed for upload.",
-			compressedFileInfo.Name())
+		return stacktrace.Propagate(err, "An error occurred creating the archive header of '%s'", pathOnDisk)
+	}
+	header.Name = nameInArchive
+	if info.IsDir() {
+		header.Name += "/"
+	}
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the archive header of '%s'", pathOnDisk)
+	}
+	if !info.Mode().IsRegular() {
+		return nil
 	}
 
-	return content, nil
+	file, err := os.Open(pathOnDisk)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening '%s'", pathOnDisk)
+	}
+	defer file.Close()
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return stacktrace.Propagate(err, "An error occurred archiving the content of '%s'", pathOnDisk)
+	}
+	return nil
+}
+
+// limitedWriter fails as soon as more than the max size gets written, so that compressing stops early
+type limitedWriter struct {
+	underlying io.Writer
+
+	maxSizeBytes int
+
+	numWrittenBytes int
+
+	isLimitReached bool
+}
+
+func (writer *limitedWriter) Write(data []byte) (int, error) {
+	if writer.numWrittenBytes+len(data) >= writer.maxSizeBytes {
+		writer.isLimitReached = true
+		return 0, stacktrace.NewError("Writing %d more bytes would reach the limit of %d bytes", len(data), writer.maxSizeBytes)
+	}
+	numWrittenBytes, err := writer.underlying.Write(data)
+	writer.numWrittenBytes += numWrittenBytes
+	return numWrittenBytes, err
 }
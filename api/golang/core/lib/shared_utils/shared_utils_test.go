@@ -0,0 +1,63 @@
+package shared_utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompressPath_LeavesOutIgnoredPaths(t *testing.T) {
+	dirpath := t.TempDir()
+	writeTestFile(t, filepath.Join(dirpath, KurtosisIgnoreFilename), "node_modules\n.git/\n")
+	writeTestFile(t, filepath.Join(dirpath, "main.star"), "def run(plan):\n    pass\n")
+	writeTestFile(t, filepath.Join(dirpath, "static", "config.json"), "{}")
+	writeTestFile(t, filepath.Join(dirpath, "node_modules", "left-pad", "index.js"), "module.exports = {}")
+	writeTestFile(t, filepath.Join(dirpath, ".git", "HEAD"), "ref: refs/heads/main")
+
+	compressedContent, err := CompressPath(dirpath, true)
+	require.NoError(t, err)
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressedContent))
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	archivedPaths := []string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		archivedPaths = append(archivedPaths, header.Name)
+	}
+	require.ElementsMatch(t, []string{KurtosisIgnoreFilename, "main.star", "static/", "static/config.json"}, archivedPaths)
+}
+
+func TestCompressPath_FailsWhenEverythingIsIgnored(t *testing.T) {
+	dirpath := t.TempDir()
+	writeTestFile(t, filepath.Join(dirpath, KurtosisIgnoreFilename), "*\n")
+
+	_, err := CompressPath(dirpath, true)
+	require.Error(t, err)
+}
+
+func TestCompressPathToWriter_WritesSameArchiveAsCompressPath(t *testing.T) {
+	dirpath := t.TempDir()
+	writeTestFile(t, filepath.Join(dirpath, "main.star"), "def run(plan):\n    pass\n")
+	writeTestFile(t, filepath.Join(dirpath, "static", "config.json"), "{}")
+
+	compressedContent, err := CompressPath(dirpath, true)
+	require.NoError(t, err)
+	streamedContent := &bytes.Buffer{}
+	require.NoError(t, CompressPathToWriter(dirpath, streamedContent))
+	require.Equal(t, compressedContent, streamedContent.Bytes())
+}
+
+func writeTestFile(t *testing.T, filepathToWrite string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepathToWrite), 0755))
+	require.NoError(t, os.WriteFile(filepathToWrite, []byte(content), 0644))
+}
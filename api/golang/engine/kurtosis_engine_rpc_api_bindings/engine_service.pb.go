@@ -22,6 +22,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type EnclaveIpRangeReusePolicy int32
+
+const (
+	// The IP ranges of destroyed enclaves can be given to new enclaves right away
+	EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE EnclaveIpRangeReusePolicy = 0
+	// The IP ranges of destroyed enclaves can only be given to new enclaves after a while, so that whatever still routes
+	// traffic to the destroyed enclave (e.g. stale ARP entries or VPN routes) doesn't reach the new one
+	EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_AFTER_COOLDOWN EnclaveIpRangeReusePolicy = 1
+	// The enclave gets an IP range that no enclave known to the engine ever had
+	EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_NEVER EnclaveIpRangeReusePolicy = 2
+)
+
+// Enum value maps for EnclaveIpRangeReusePolicy.
+var (
+	EnclaveIpRangeReusePolicy_name = map[int32]string{
+		0: "EnclaveIpRangeReusePolicy_IMMEDIATE",
+		1: "EnclaveIpRangeReusePolicy_AFTER_COOLDOWN",
+		2: "EnclaveIpRangeReusePolicy_NEVER",
+	}
+	EnclaveIpRangeReusePolicy_value = map[string]int32{
+		"EnclaveIpRangeReusePolicy_IMMEDIATE":      0,
+		"EnclaveIpRangeReusePolicy_AFTER_COOLDOWN": 1,
+		"EnclaveIpRangeReusePolicy_NEVER":          2,
+	}
+)
+
+func (x EnclaveIpRangeReusePolicy) Enum() *EnclaveIpRangeReusePolicy {
+	p := new(EnclaveIpRangeReusePolicy)
+	*p = x
+	return p
+}
+
+func (x EnclaveIpRangeReusePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnclaveIpRangeReusePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[0].Descriptor()
+}
+
+func (EnclaveIpRangeReusePolicy) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[0]
+}
+
+func (x EnclaveIpRangeReusePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnclaveIpRangeReusePolicy.Descriptor instead.
+func (EnclaveIpRangeReusePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{0}
+}
+
 // ==============================================================================================
 //
 //	Get Enclaves
@@ -65,11 +119,11 @@ func (x EnclaveContainersStatus) String() string {
 }
 
 func (EnclaveContainersStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[0].Descriptor()
+	return file_engine_service_proto_enumTypes[1].Descriptor()
 }
 
 func (EnclaveContainersStatus) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[0]
+	return &file_engine_service_proto_enumTypes[1]
 }
 
 func (x EnclaveContainersStatus) Number() protoreflect.EnumNumber {
@@ -78,7 +132,7 @@ func (x EnclaveContainersStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveContainersStatus.Descriptor instead.
 func (EnclaveContainersStatus) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{0}
+	return file_engine_service_proto_rawDescGZIP(), []int{1}
 }
 
 // NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
@@ -122,11 +176,11 @@ func (x EnclaveAPIContainerStatus) String() string {
 }
 
 func (EnclaveAPIContainerStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[1].Descriptor()
+	return file_engine_service_proto_enumTypes[2].Descriptor()
 }
 
 func (EnclaveAPIContainerStatus) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[1]
+	return &file_engine_service_proto_enumTypes[2]
 }
 
 func (x EnclaveAPIContainerStatus) Number() protoreflect.EnumNumber {
@@ -135,7 +189,7 @@ func (x EnclaveAPIContainerStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnclaveAPIContainerStatus.Descriptor instead.
 func (EnclaveAPIContainerStatus) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{1}
+	return file_engine_service_proto_rawDescGZIP(), []int{2}
 }
 
 // The filter operator which can be text or regex type
@@ -176,11 +230,11 @@ func (x LogLineOperator) String() string {
 }
 
 func (LogLineOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_engine_service_proto_enumTypes[2].Descriptor()
+	return file_engine_service_proto_enumTypes[3].Descriptor()
 }
 
 func (LogLineOperator) Type() protoreflect.EnumType {
-	return &file_engine_service_proto_enumTypes[2]
+	return &file_engine_service_proto_enumTypes[3]
 }
 
 func (x LogLineOperator) Number() protoreflect.EnumNumber {
@@ -189,7 +243,60 @@ func (x LogLineOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LogLineOperator.Descriptor instead.
 func (LogLineOperator) EnumDescriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{2}
+	return file_engine_service_proto_rawDescGZIP(), []int{3}
+}
+
+// NOTE: We have to prefix the enum values with the enum name due to the way Protobuf enum value uniqueness works
+type EnclaveLifecycleEventType int32
+
+const (
+	EnclaveLifecycleEventType_EnclaveLifecycleEventType_CREATED       EnclaveLifecycleEventType = 0
+	EnclaveLifecycleEventType_EnclaveLifecycleEventType_STOPPED       EnclaveLifecycleEventType = 1
+	EnclaveLifecycleEventType_EnclaveLifecycleEventType_DESTROYED     EnclaveLifecycleEventType = 2
+	EnclaveLifecycleEventType_EnclaveLifecycleEventType_SERVICE_ADDED EnclaveLifecycleEventType = 3
+)
+
+// Enum value maps for EnclaveLifecycleEventType.
+var (
+	EnclaveLifecycleEventType_name = map[int32]string{
+		0: "EnclaveLifecycleEventType_CREATED",
+		1: "EnclaveLifecycleEventType_STOPPED",
+		2: "EnclaveLifecycleEventType_DESTROYED",
+		3: "EnclaveLifecycleEventType_SERVICE_ADDED",
+	}
+	EnclaveLifecycleEventType_value = map[string]int32{
+		"EnclaveLifecycleEventType_CREATED":       0,
+		"EnclaveLifecycleEventType_STOPPED":       1,
+		"EnclaveLifecycleEventType_DESTROYED":     2,
+		"EnclaveLifecycleEventType_SERVICE_ADDED": 3,
+	}
+)
+
+func (x EnclaveLifecycleEventType) Enum() *EnclaveLifecycleEventType {
+	p := new(EnclaveLifecycleEventType)
+	*p = x
+	return p
+}
+
+func (x EnclaveLifecycleEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnclaveLifecycleEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_service_proto_enumTypes[4].Descriptor()
+}
+
+func (EnclaveLifecycleEventType) Type() protoreflect.EnumType {
+	return &file_engine_service_proto_enumTypes[4]
+}
+
+func (x EnclaveLifecycleEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnclaveLifecycleEventType.Descriptor instead.
+func (EnclaveLifecycleEventType) EnumDescriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{4}
 }
 
 // ==============================================================================================
@@ -264,6 +371,24 @@ type CreateEnclaveArgs struct {
 	ApiContainerLogLevel string `protobuf:"bytes,3,opt,name=api_container_log_level,json=apiContainerLogLevel,proto3" json:"api_container_log_level,omitempty"`
 	// Whether network partitioning will be enabled or not on the new Kurtosis Enclave
 	IsPartitioningEnabled bool `protobuf:"varint,4,opt,name=is_partitioning_enabled,json=isPartitioningEnabled,proto3" json:"is_partitioning_enabled,omitempty"`
+	// Whether the enclave can get the IP range of an enclave that was destroyed before
+	IpRangeReusePolicy EnclaveIpRangeReusePolicy `protobuf:"varint,5,opt,name=ip_range_reuse_policy,json=ipRangeReusePolicy,proto3,enum=engine_api.EnclaveIpRangeReusePolicy" json:"ip_range_reuse_policy,omitempty"`
+	// CIDRs that the enclave's IP range must not overlap with, e.g. the ones of the host's network interfaces
+	ExcludedIpRanges []string `protobuf:"bytes,6,rep,name=excluded_ip_ranges,json=excludedIpRanges,proto3" json:"excluded_ip_ranges,omitempty"`
+	// The subnet of the enclave's network, either a CIDR (e.g. '10.200.0.0/16') for the enclave to get exactly that
+	// subnet, or just a mask (e.g. '/16') for the enclave to get a random free subnet of that size
+	// If blank, the enclave gets a random free /20 subnet
+	Subnet string `protobuf:"bytes,7,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	// How long the files artifacts that no service has mounted get kept around after they were last stored, downloaded or
+	// mounted, before the API container deletes them to free up disk
+	// If 0, files artifacts are kept until they get deleted or the enclave gets destroyed
+	FilesArtifactsTtlSeconds uint32 `protobuf:"varint,8,opt,name=files_artifacts_ttl_seconds,json=filesArtifactsTtlSeconds,proto3" json:"files_artifacts_ttl_seconds,omitempty"`
+	// Whether the services of the enclave can bind-mount directories of the host, which gives them access to whatever
+	// is in those directories and so must be explicitly opted into
+	AllowHostDirMounts bool `protobuf:"varint,9,opt,name=allow_host_dir_mounts,json=allowHostDirMounts,proto3" json:"allow_host_dir_mounts,omitempty"`
+	// Whether the enclave's network also gets a random IPv6 unique local address subnet, for its services to get an IPv6
+	// address on top of their IPv4 one
+	IsIpv6Enabled bool `protobuf:"varint,10,opt,name=is_ipv6_enabled,json=isIpv6Enabled,proto3" json:"is_ipv6_enabled,omitempty"`
 }
 
 func (x *CreateEnclaveArgs) Reset() {
@@ -326,6 +451,48 @@ func (x *CreateEnclaveArgs) GetIsPartitioningEnabled() bool {
 	return false
 }
 
+func (x *CreateEnclaveArgs) GetIpRangeReusePolicy() EnclaveIpRangeReusePolicy {
+	if x != nil {
+		return x.IpRangeReusePolicy
+	}
+	return EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE
+}
+
+func (x *CreateEnclaveArgs) GetExcludedIpRanges() []string {
+	if x != nil {
+		return x.ExcludedIpRanges
+	}
+	return nil
+}
+
+func (x *CreateEnclaveArgs) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *CreateEnclaveArgs) GetFilesArtifactsTtlSeconds() uint32 {
+	if x != nil {
+		return x.FilesArtifactsTtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateEnclaveArgs) GetAllowHostDirMounts() bool {
+	if x != nil {
+		return x.AllowHostDirMounts
+	}
+	return false
+}
+
+func (x *CreateEnclaveArgs) GetIsIpv6Enabled() bool {
+	if x != nil {
+		return x.IsIpv6Enabled
+	}
+	return false
+}
+
 type CreateEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -538,6 +705,10 @@ type EnclaveInfo struct {
 	ApiContainerHostMachineInfo *EnclaveAPIContainerHostMachineInfo `protobuf:"bytes,7,opt,name=api_container_host_machine_info,json=apiContainerHostMachineInfo,proto3" json:"api_container_host_machine_info,omitempty"`
 	// The enclave's creation time
 	CreationTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// The CIDR of the enclave's IP range, e.g. '32.16.0.0/20'; empty if the backend doesn't report it
+	IpRange string `protobuf:"bytes,9,opt,name=ip_range,json=ipRange,proto3" json:"ip_range,omitempty"`
+	// Name of the enclave schedule whose run created the enclave; empty if the enclave wasn't created by a schedule
+	ScheduleName string `protobuf:"bytes,10,opt,name=schedule_name,json=scheduleName,proto3" json:"schedule_name,omitempty"`
 }
 
 func (x *EnclaveInfo) Reset() {
@@ -628,6 +799,20 @@ func (x *EnclaveInfo) GetCreationTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *EnclaveInfo) GetIpRange() string {
+	if x != nil {
+		return x.IpRange
+	}
+	return ""
+}
+
+func (x *EnclaveInfo) GetScheduleName() string {
+	if x != nil {
+		return x.ScheduleName
+	}
+	return ""
+}
+
 type GetEnclavesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -898,20 +1083,22 @@ func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
 
 // ==============================================================================================
 //
-//	Create Enclave
+//	Enclave Teardown Progress
 //
 // ==============================================================================================
-type CleanArgs struct {
+type EnclaveTeardownProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// If true, It will clean even the running enclaves
-	ShouldCleanAll bool `protobuf:"varint,1,opt,name=should_clean_all,json=shouldCleanAll,proto3" json:"should_clean_all,omitempty"`
+	// Types that are assignable to Progress:
+	//	*EnclaveTeardownProgress_Step
+	//	*EnclaveTeardownProgress_Summary
+	Progress isEnclaveTeardownProgress_Progress `protobuf_oneof:"progress"`
 }
 
-func (x *CleanArgs) Reset() {
-	*x = CleanArgs{}
+func (x *EnclaveTeardownProgress) Reset() {
+	*x = EnclaveTeardownProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -919,13 +1106,13 @@ func (x *CleanArgs) Reset() {
 	}
 }
 
-func (x *CleanArgs) String() string {
+func (x *EnclaveTeardownProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanArgs) ProtoMessage() {}
+func (*EnclaveTeardownProgress) ProtoMessage() {}
 
-func (x *CleanArgs) ProtoReflect() protoreflect.Message {
+func (x *EnclaveTeardownProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -937,29 +1124,62 @@ func (x *CleanArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
-func (*CleanArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnclaveTeardownProgress.ProtoReflect.Descriptor instead.
+func (*EnclaveTeardownProgress) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CleanArgs) GetShouldCleanAll() bool {
-	if x != nil {
-		return x.ShouldCleanAll
+func (m *EnclaveTeardownProgress) GetProgress() isEnclaveTeardownProgress_Progress {
+	if m != nil {
+		return m.Progress
 	}
-	return false
+	return nil
 }
 
-type EnclaveNameAndUuid struct {
+func (x *EnclaveTeardownProgress) GetStep() *EnclaveTeardownStep {
+	if x, ok := x.GetProgress().(*EnclaveTeardownProgress_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *EnclaveTeardownProgress) GetSummary() *EnclaveTeardownSummary {
+	if x, ok := x.GetProgress().(*EnclaveTeardownProgress_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isEnclaveTeardownProgress_Progress interface {
+	isEnclaveTeardownProgress_Progress()
+}
+
+type EnclaveTeardownProgress_Step struct {
+	Step *EnclaveTeardownStep `protobuf:"bytes,1,opt,name=step,proto3,oneof"`
+}
+
+type EnclaveTeardownProgress_Summary struct {
+	// Always the last message of the stream, even when the teardown fails
+	Summary *EnclaveTeardownSummary `protobuf:"bytes,2,opt,name=summary,proto3,oneof"`
+}
+
+func (*EnclaveTeardownProgress_Step) isEnclaveTeardownProgress_Progress() {}
+
+func (*EnclaveTeardownProgress_Summary) isEnclaveTeardownProgress_Progress() {}
+
+// Same fields as the progress of Starlark runs, so that clients can display both the same way
+type EnclaveTeardownStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CurrentStepInfo   []string `protobuf:"bytes,1,rep,name=current_step_info,json=currentStepInfo,proto3" json:"current_step_info,omitempty"`
+	TotalSteps        uint32   `protobuf:"varint,2,opt,name=total_steps,json=totalSteps,proto3" json:"total_steps,omitempty"`
+	CurrentStepNumber uint32   `protobuf:"varint,3,opt,name=current_step_number,json=currentStepNumber,proto3" json:"current_step_number,omitempty"`
 }
 
-func (x *EnclaveNameAndUuid) Reset() {
-	*x = EnclaveNameAndUuid{}
+func (x *EnclaveTeardownStep) Reset() {
+	*x = EnclaveTeardownStep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,13 +1187,13 @@ func (x *EnclaveNameAndUuid) Reset() {
 	}
 }
 
-func (x *EnclaveNameAndUuid) String() string {
+func (x *EnclaveTeardownStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnclaveNameAndUuid) ProtoMessage() {}
+func (*EnclaveTeardownStep) ProtoMessage() {}
 
-func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
+func (x *EnclaveTeardownStep) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,36 +1205,42 @@ func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
-func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnclaveTeardownStep.ProtoReflect.Descriptor instead.
+func (*EnclaveTeardownStep) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EnclaveNameAndUuid) GetName() string {
+func (x *EnclaveTeardownStep) GetCurrentStepInfo() []string {
 	if x != nil {
-		return x.Name
+		return x.CurrentStepInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *EnclaveNameAndUuid) GetUuid() string {
+func (x *EnclaveTeardownStep) GetTotalSteps() uint32 {
 	if x != nil {
-		return x.Uuid
+		return x.TotalSteps
 	}
-	return ""
+	return 0
 }
 
-type CleanResponse struct {
+func (x *EnclaveTeardownStep) GetCurrentStepNumber() uint32 {
+	if x != nil {
+		return x.CurrentStepNumber
+	}
+	return 0
+}
+
+type EnclaveTeardownSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// removed enclave name and uuids
-	RemovedEnclaveNameAndUuids []*EnclaveNameAndUuid `protobuf:"bytes,1,rep,name=removed_enclave_name_and_uuids,json=removedEnclaveNameAndUuids,proto3" json:"removed_enclave_name_and_uuids,omitempty"`
+	LeakedResources []*LeakedEnclaveResource `protobuf:"bytes,1,rep,name=leaked_resources,json=leakedResources,proto3" json:"leaked_resources,omitempty"`
 }
 
-func (x *CleanResponse) Reset() {
-	*x = CleanResponse{}
+func (x *EnclaveTeardownSummary) Reset() {
+	*x = EnclaveTeardownSummary{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1022,13 +1248,13 @@ func (x *CleanResponse) Reset() {
 	}
 }
 
-func (x *CleanResponse) String() string {
+func (x *EnclaveTeardownSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanResponse) ProtoMessage() {}
+func (*EnclaveTeardownSummary) ProtoMessage() {}
 
-func (x *CleanResponse) ProtoReflect() protoreflect.Message {
+func (x *EnclaveTeardownSummary) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1040,40 +1266,33 @@ func (x *CleanResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
-func (*CleanResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnclaveTeardownSummary.ProtoReflect.Descriptor instead.
+func (*EnclaveTeardownSummary) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
+func (x *EnclaveTeardownSummary) GetLeakedResources() []*LeakedEnclaveResource {
 	if x != nil {
-		return x.RemovedEnclaveNameAndUuids
+		return x.LeakedResources
 	}
 	return nil
 }
 
-// ==============================================================================================
-//
-//	Get User Service Logs
-//
-// ==============================================================================================
-type GetServiceLogsArgs struct {
+// A resource of the enclave that couldn't be removed (or stopped), which has to be cleaned up by hand
+type LeakedEnclaveResource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The identifier of the user service's Kurtosis Enclave
-	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
-	// "Set" of service UUIDs in the enclave
-	ServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=service_uuid_set,json=serviceUuidSet,proto3" json:"service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// If true, It will follow the container logs
-	FollowLogs bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3" json:"follow_logs,omitempty"`
-	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
-	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
+	// One of 'container', 'volume' or 'network'
+	ResourceType string `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// The name of the resource, or its ID for networks
+	ResourceName string `protobuf:"bytes,2,opt,name=resource_name,json=resourceName,proto3" json:"resource_name,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GetServiceLogsArgs) Reset() {
-	*x = GetServiceLogsArgs{}
+func (x *LeakedEnclaveResource) Reset() {
+	*x = LeakedEnclaveResource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1081,13 +1300,13 @@ func (x *GetServiceLogsArgs) Reset() {
 	}
 }
 
-func (x *GetServiceLogsArgs) String() string {
+func (x *LeakedEnclaveResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceLogsArgs) ProtoMessage() {}
+func (*LeakedEnclaveResource) ProtoMessage() {}
 
-func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
+func (x *LeakedEnclaveResource) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1099,53 +1318,48 @@ func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
-func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use LeakedEnclaveResource.ProtoReflect.Descriptor instead.
+func (*LeakedEnclaveResource) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
+func (x *LeakedEnclaveResource) GetResourceType() string {
 	if x != nil {
-		return x.EnclaveIdentifier
+		return x.ResourceType
 	}
 	return ""
 }
 
-func (x *GetServiceLogsArgs) GetServiceUuidSet() map[string]bool {
-	if x != nil {
-		return x.ServiceUuidSet
-	}
-	return nil
-}
-
-func (x *GetServiceLogsArgs) GetFollowLogs() bool {
+func (x *LeakedEnclaveResource) GetResourceName() string {
 	if x != nil {
-		return x.FollowLogs
+		return x.ResourceName
 	}
-	return false
+	return ""
 }
 
-func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
+func (x *LeakedEnclaveResource) GetError() string {
 	if x != nil {
-		return x.ConjunctiveFilters
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type GetServiceLogsResponse struct {
+// ==============================================================================================
+//
+//	Create Enclave
+//
+// ==============================================================================================
+type CleanArgs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The service log lines grouped by service UUIDs and ordered in forward direction (oldest log line is the first element)
-	ServiceLogsByServiceUuid map[string]*LogLine `protobuf:"bytes,1,rep,name=service_logs_by_service_uuid,json=serviceLogsByServiceUuid,proto3" json:"service_logs_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// A set of service GUIDs requested by the user that were not found in the logs database, could be related that users send
-	// a wrong GUID or a right GUID for a service that has not sent any logs so far
-	NotFoundServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=not_found_service_uuid_set,json=notFoundServiceUuidSet,proto3" json:"not_found_service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// If true, It will clean even the running enclaves
+	ShouldCleanAll bool `protobuf:"varint,1,opt,name=should_clean_all,json=shouldCleanAll,proto3" json:"should_clean_all,omitempty"`
 }
 
-func (x *GetServiceLogsResponse) Reset() {
-	*x = GetServiceLogsResponse{}
+func (x *CleanArgs) Reset() {
+	*x = CleanArgs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_engine_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1153,13 +1367,13 @@ func (x *GetServiceLogsResponse) Reset() {
 	}
 }
 
-func (x *GetServiceLogsResponse) String() string {
+func (x *CleanArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceLogsResponse) ProtoMessage() {}
+func (*CleanArgs) ProtoMessage() {}
 
-func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
+func (x *CleanArgs) ProtoReflect() protoreflect.Message {
 	mi := &file_engine_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1171,23 +1385,459 @@ func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
+func (*CleanArgs) Descriptor() ([]byte, []int) {
 	return file_engine_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
-	if x != nil {
-		return x.ServiceLogsByServiceUuid
-	}
-	return nil
-}
-
-func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
+func (x *CleanArgs) GetShouldCleanAll() bool {
 	if x != nil {
-		return x.NotFoundServiceUuidSet
+		return x.ShouldCleanAll
 	}
-	return nil
+	return false
+}
+
+type EnclaveNameAndUuid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *EnclaveNameAndUuid) Reset() {
+	*x = EnclaveNameAndUuid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveNameAndUuid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveNameAndUuid) ProtoMessage() {}
+
+func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
+func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EnclaveNameAndUuid) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnclaveNameAndUuid) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type CleanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// removed enclave name and uuids
+	RemovedEnclaveNameAndUuids []*EnclaveNameAndUuid `protobuf:"bytes,1,rep,name=removed_enclave_name_and_uuids,json=removedEnclaveNameAndUuids,proto3" json:"removed_enclave_name_and_uuids,omitempty"`
+}
+
+func (x *CleanResponse) Reset() {
+	*x = CleanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanResponse) ProtoMessage() {}
+
+func (x *CleanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
+func (*CleanResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
+	if x != nil {
+		return x.RemovedEnclaveNameAndUuids
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Pulled Images
+//
+// ==============================================================================================
+type PulledImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The image name, as it was given when starting the services, e.g. 'postgres:15'
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// The size of the image on disk, including the layers it shares with other images
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// The UUIDs of the existing enclaves that have services using the image
+	EnclaveUuids []string `protobuf:"bytes,3,rep,name=enclave_uuids,json=enclaveUuids,proto3" json:"enclave_uuids,omitempty"`
+}
+
+func (x *PulledImage) Reset() {
+	*x = PulledImage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PulledImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PulledImage) ProtoMessage() {}
+
+func (x *PulledImage) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PulledImage.ProtoReflect.Descriptor instead.
+func (*PulledImage) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PulledImage) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *PulledImage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *PulledImage) GetEnclaveUuids() []string {
+	if x != nil {
+		return x.EnclaveUuids
+	}
+	return nil
+}
+
+type GetPulledImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PulledImages []*PulledImage `protobuf:"bytes,1,rep,name=pulled_images,json=pulledImages,proto3" json:"pulled_images,omitempty"`
+}
+
+func (x *GetPulledImagesResponse) Reset() {
+	*x = GetPulledImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPulledImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPulledImagesResponse) ProtoMessage() {}
+
+func (x *GetPulledImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPulledImagesResponse.ProtoReflect.Descriptor instead.
+func (*GetPulledImagesResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetPulledImagesResponse) GetPulledImages() []*PulledImage {
+	if x != nil {
+		return x.PulledImages
+	}
+	return nil
+}
+
+type PruneImagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemovedImages []*PulledImage `protobuf:"bytes,1,rep,name=removed_images,json=removedImages,proto3" json:"removed_images,omitempty"`
+	// Error messages of the images that couldn't be removed, e.g. because a container outside Kurtosis uses them
+	RemovalErrorsByImage map[string]string `protobuf:"bytes,2,rep,name=removal_errors_by_image,json=removalErrorsByImage,proto3" json:"removal_errors_by_image,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PruneImagesResponse) Reset() {
+	*x = PruneImagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneImagesResponse) ProtoMessage() {}
+
+func (x *PruneImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneImagesResponse.ProtoReflect.Descriptor instead.
+func (*PruneImagesResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PruneImagesResponse) GetRemovedImages() []*PulledImage {
+	if x != nil {
+		return x.RemovedImages
+	}
+	return nil
+}
+
+func (x *PruneImagesResponse) GetRemovalErrorsByImage() map[string]string {
+	if x != nil {
+		return x.RemovalErrorsByImage
+	}
+	return nil
+}
+
+// ==============================================================================================
+//
+//	Get User Service Logs
+//
+// ==============================================================================================
+type GetServiceLogsArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the user service's Kurtosis Enclave
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+	// "Set" of service UUIDs in the enclave
+	ServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=service_uuid_set,json=serviceUuidSet,proto3" json:"service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// If true, It will follow the container logs
+	FollowLogs bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3" json:"follow_logs,omitempty"`
+	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
+	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
+	// If set, only the log lines logged at or after this time are returned
+	Since *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// If set, only the log lines logged at or before this time are returned; can't be used when following the logs
+	Until *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+}
+
+func (x *GetServiceLogsArgs) Reset() {
+	*x = GetServiceLogsArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceLogsArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceLogsArgs) ProtoMessage() {}
+
+func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
+func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *GetServiceLogsArgs) GetServiceUuidSet() map[string]bool {
+	if x != nil {
+		return x.ServiceUuidSet
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetFollowLogs() bool {
+	if x != nil {
+		return x.FollowLogs
+	}
+	return false
+}
+
+func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
+	if x != nil {
+		return x.ConjunctiveFilters
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GetServiceLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service log lines grouped by service UUIDs and ordered in forward direction (oldest log line is the first element)
+	ServiceLogsByServiceUuid map[string]*LogLine `protobuf:"bytes,1,rep,name=service_logs_by_service_uuid,json=serviceLogsByServiceUuid,proto3" json:"service_logs_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// A set of service GUIDs requested by the user that were not found in the logs database, could be related that users send
+	// a wrong GUID or a right GUID for a service that has not sent any logs so far
+	NotFoundServiceUuidSet map[string]bool `protobuf:"bytes,2,rep,name=not_found_service_uuid_set,json=notFoundServiceUuidSet,proto3" json:"not_found_service_uuid_set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// When following logs, the engine buffers a bounded amount of log lines per stream and drops the oldest ones if the
+	// client can't keep up; this is how many lines were dropped for each service since the previous response
+	NumDroppedLogLinesByServiceUuid map[string]uint32 `protobuf:"bytes,3,rep,name=num_dropped_log_lines_by_service_uuid,json=numDroppedLogLinesByServiceUuid,proto3" json:"num_dropped_log_lines_by_service_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetServiceLogsResponse) Reset() {
+	*x = GetServiceLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceLogsResponse) ProtoMessage() {}
+
+func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
+	if x != nil {
+		return x.ServiceLogsByServiceUuid
+	}
+	return nil
+}
+
+func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
+	if x != nil {
+		return x.NotFoundServiceUuidSet
+	}
+	return nil
+}
+
+func (x *GetServiceLogsResponse) GetNumDroppedLogLinesByServiceUuid() map[string]uint32 {
+	if x != nil {
+		return x.NumDroppedLogLinesByServiceUuid
+	}
+	return nil
 }
 
 // TODO add timestamp as well, for when we do timestamp-handling on the client side
@@ -1196,26 +1846,700 @@ type LogLine struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Line []string `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
+	Line []string `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *LogLine) Reset() {
+	*x = LogLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLine) ProtoMessage() {}
+
+func (x *LogLine) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
+func (*LogLine) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LogLine) GetLine() []string {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+type LogLineFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operator    LogLineOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=engine_api.LogLineOperator" json:"operator,omitempty"`
+	TextPattern string          `protobuf:"bytes,2,opt,name=text_pattern,json=textPattern,proto3" json:"text_pattern,omitempty"`
+}
+
+func (x *LogLineFilter) Reset() {
+	*x = LogLineFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLineFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLineFilter) ProtoMessage() {}
+
+func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
+func (*LogLineFilter) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LogLineFilter) GetOperator() LogLineOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+}
+
+func (x *LogLineFilter) GetTextPattern() string {
+	if x != nil {
+		return x.TextPattern
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Get Enclave Lifecycle Events
+//
+// ==============================================================================================
+type EnclaveLifecycleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType   EnclaveLifecycleEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=engine_api.EnclaveLifecycleEventType" json:"event_type,omitempty"`
+	EnclaveUuid string                    `protobuf:"bytes,2,opt,name=enclave_uuid,json=enclaveUuid,proto3" json:"enclave_uuid,omitempty"`
+	EnclaveName string                    `protobuf:"bytes,3,opt,name=enclave_name,json=enclaveName,proto3" json:"enclave_name,omitempty"`
+	// When the engine noticed the event
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Only set for EnclaveLifecycleEventType_SERVICE_ADDED events
+	ServiceName string `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Only set for EnclaveLifecycleEventType_SERVICE_ADDED events
+	ServiceUuid string `protobuf:"bytes,6,opt,name=service_uuid,json=serviceUuid,proto3" json:"service_uuid,omitempty"`
+}
+
+func (x *EnclaveLifecycleEvent) Reset() {
+	*x = EnclaveLifecycleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveLifecycleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveLifecycleEvent) ProtoMessage() {}
+
+func (x *EnclaveLifecycleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveLifecycleEvent.ProtoReflect.Descriptor instead.
+func (*EnclaveLifecycleEvent) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EnclaveLifecycleEvent) GetEventType() EnclaveLifecycleEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EnclaveLifecycleEventType_EnclaveLifecycleEventType_CREATED
+}
+
+func (x *EnclaveLifecycleEvent) GetEnclaveUuid() string {
+	if x != nil {
+		return x.EnclaveUuid
+	}
+	return ""
+}
+
+func (x *EnclaveLifecycleEvent) GetEnclaveName() string {
+	if x != nil {
+		return x.EnclaveName
+	}
+	return ""
+}
+
+func (x *EnclaveLifecycleEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *EnclaveLifecycleEvent) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *EnclaveLifecycleEvent) GetServiceUuid() string {
+	if x != nil {
+		return x.ServiceUuid
+	}
+	return ""
+}
+
+// ==============================================================================================
+//
+//	Enclave Schedules
+//
+// ==============================================================================================
+type EnclaveSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The enclaves the schedule creates are named '<name>-<yyyymmddhhmm>' after the time of the run, in UTC
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A standard 5-field cron expression (minute, hour, day of month, month, day of week), evaluated in UTC
+	CronExpression string `protobuf:"bytes,2,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// The locator of the package to run in the new enclave, e.g. 'github.com/kurtosis-tech/eth2-package'
+	PackageId string `protobuf:"bytes,3,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// The JSON-serialized args of the package
+	SerializedParams string `protobuf:"bytes,4,opt,name=serialized_params,json=serializedParams,proto3" json:"serialized_params,omitempty"`
+	// How many of the enclaves created by the schedule are kept; the oldest ones get destroyed after each run. 0 keeps
+	// them all
+	Retention uint32 `protobuf:"varint,5,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (x *EnclaveSchedule) Reset() {
+	*x = EnclaveSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveSchedule) ProtoMessage() {}
+
+func (x *EnclaveSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveSchedule.ProtoReflect.Descriptor instead.
+func (*EnclaveSchedule) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EnclaveSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnclaveSchedule) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *EnclaveSchedule) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *EnclaveSchedule) GetSerializedParams() string {
+	if x != nil {
+		return x.SerializedParams
+	}
+	return ""
+}
+
+func (x *EnclaveSchedule) GetRetention() uint32 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+type EnclaveScheduleInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule    *EnclaveSchedule       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	NextRunTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	// Unset if the schedule hasn't run since the engine started
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_run_time,json=lastRunTime,proto3,oneof" json:"last_run_time,omitempty"`
+	// Empty if the last run succeeded
+	LastRunError string `protobuf:"bytes,4,opt,name=last_run_error,json=lastRunError,proto3" json:"last_run_error,omitempty"`
+	// The names of the existing enclaves created by the schedule, oldest first
+	EnclaveNames []string `protobuf:"bytes,5,rep,name=enclave_names,json=enclaveNames,proto3" json:"enclave_names,omitempty"`
+}
+
+func (x *EnclaveScheduleInfo) Reset() {
+	*x = EnclaveScheduleInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclaveScheduleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclaveScheduleInfo) ProtoMessage() {}
+
+func (x *EnclaveScheduleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclaveScheduleInfo.ProtoReflect.Descriptor instead.
+func (*EnclaveScheduleInfo) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EnclaveScheduleInfo) GetSchedule() *EnclaveSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *EnclaveScheduleInfo) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+func (x *EnclaveScheduleInfo) GetLastRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunTime
+	}
+	return nil
+}
+
+func (x *EnclaveScheduleInfo) GetLastRunError() string {
+	if x != nil {
+		return x.LastRunError
+	}
+	return ""
+}
+
+func (x *EnclaveScheduleInfo) GetEnclaveNames() []string {
+	if x != nil {
+		return x.EnclaveNames
+	}
+	return nil
+}
+
+type AddEnclaveScheduleArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedule *EnclaveSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *AddEnclaveScheduleArgs) Reset() {
+	*x = AddEnclaveScheduleArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEnclaveScheduleArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEnclaveScheduleArgs) ProtoMessage() {}
+
+func (x *AddEnclaveScheduleArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEnclaveScheduleArgs.ProtoReflect.Descriptor instead.
+func (*AddEnclaveScheduleArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddEnclaveScheduleArgs) GetSchedule() *EnclaveSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetEnclaveSchedulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*EnclaveScheduleInfo `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *GetEnclaveSchedulesResponse) Reset() {
+	*x = GetEnclaveSchedulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnclaveSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnclaveSchedulesResponse) ProtoMessage() {}
+
+func (x *GetEnclaveSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnclaveSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*GetEnclaveSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetEnclaveSchedulesResponse) GetSchedules() []*EnclaveScheduleInfo {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type RemoveEnclaveScheduleArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If true, the existing enclaves created by the schedule get destroyed too
+	ShouldDestroyEnclaves bool `protobuf:"varint,2,opt,name=should_destroy_enclaves,json=shouldDestroyEnclaves,proto3" json:"should_destroy_enclaves,omitempty"`
+}
+
+func (x *RemoveEnclaveScheduleArgs) Reset() {
+	*x = RemoveEnclaveScheduleArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveEnclaveScheduleArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEnclaveScheduleArgs) ProtoMessage() {}
+
+func (x *RemoveEnclaveScheduleArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEnclaveScheduleArgs.ProtoReflect.Descriptor instead.
+func (*RemoveEnclaveScheduleArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveEnclaveScheduleArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveEnclaveScheduleArgs) GetShouldDestroyEnclaves() bool {
+	if x != nil {
+		return x.ShouldDestroyEnclaves
+	}
+	return false
+}
+
+// ==============================================================================================
+//
+//	Copy Files Artifact
+//
+// ==============================================================================================
+type CopyFilesArtifactArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The enclave to copy the files artifact from
+	SourceEnclaveIdentifier string `protobuf:"bytes,1,opt,name=source_enclave_identifier,json=sourceEnclaveIdentifier,proto3" json:"source_enclave_identifier,omitempty"`
+	// The enclave to copy the files artifact to
+	DestinationEnclaveIdentifier string `protobuf:"bytes,2,opt,name=destination_enclave_identifier,json=destinationEnclaveIdentifier,proto3" json:"destination_enclave_identifier,omitempty"`
+	// The name or UUID of the files artifact in the source enclave
+	FilesArtifactIdentifier string `protobuf:"bytes,3,opt,name=files_artifact_identifier,json=filesArtifactIdentifier,proto3" json:"files_artifact_identifier,omitempty"`
+	// The name the files artifact gets in the destination enclave
+	// If blank, it keeps its name from the source enclave
+	DestinationFilesArtifactName string `protobuf:"bytes,4,opt,name=destination_files_artifact_name,json=destinationFilesArtifactName,proto3" json:"destination_files_artifact_name,omitempty"`
+}
+
+func (x *CopyFilesArtifactArgs) Reset() {
+	*x = CopyFilesArtifactArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyFilesArtifactArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFilesArtifactArgs) ProtoMessage() {}
+
+func (x *CopyFilesArtifactArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyFilesArtifactArgs.ProtoReflect.Descriptor instead.
+func (*CopyFilesArtifactArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CopyFilesArtifactArgs) GetSourceEnclaveIdentifier() string {
+	if x != nil {
+		return x.SourceEnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *CopyFilesArtifactArgs) GetDestinationEnclaveIdentifier() string {
+	if x != nil {
+		return x.DestinationEnclaveIdentifier
+	}
+	return ""
+}
+
+func (x *CopyFilesArtifactArgs) GetFilesArtifactIdentifier() string {
+	if x != nil {
+		return x.FilesArtifactIdentifier
+	}
+	return ""
+}
+
+func (x *CopyFilesArtifactArgs) GetDestinationFilesArtifactName() string {
+	if x != nil {
+		return x.DestinationFilesArtifactName
+	}
+	return ""
+}
+
+type CopyFilesArtifactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The UUID of the files artifact in the destination enclave
+	FilesArtifactUuid string `protobuf:"bytes,1,opt,name=files_artifact_uuid,json=filesArtifactUuid,proto3" json:"files_artifact_uuid,omitempty"`
+	// The name of the files artifact in the destination enclave
+	FilesArtifactName string `protobuf:"bytes,2,opt,name=files_artifact_name,json=filesArtifactName,proto3" json:"files_artifact_name,omitempty"`
+	// True if the destination enclave already stored the same files, in which case nothing got transferred
+	WasAlreadyStored bool `protobuf:"varint,3,opt,name=was_already_stored,json=wasAlreadyStored,proto3" json:"was_already_stored,omitempty"`
+}
+
+func (x *CopyFilesArtifactResponse) Reset() {
+	*x = CopyFilesArtifactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CopyFilesArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyFilesArtifactResponse) ProtoMessage() {}
+
+func (x *CopyFilesArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyFilesArtifactResponse.ProtoReflect.Descriptor instead.
+func (*CopyFilesArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CopyFilesArtifactResponse) GetFilesArtifactUuid() string {
+	if x != nil {
+		return x.FilesArtifactUuid
+	}
+	return ""
+}
+
+func (x *CopyFilesArtifactResponse) GetFilesArtifactName() string {
+	if x != nil {
+		return x.FilesArtifactName
+	}
+	return ""
+}
+
+func (x *CopyFilesArtifactResponse) GetWasAlreadyStored() bool {
+	if x != nil {
+		return x.WasAlreadyStored
+	}
+	return false
+}
+
+// ==============================================================================================
+//
+//	Set Log Level
+//
+// ==============================================================================================
+type SetLogLevelArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new log level, e.g. 'debug'
+	LogLevel string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	// If non-zero, the previous log level gets restored after this many seconds
+	RevertAfterSeconds uint32 `protobuf:"varint,2,opt,name=revert_after_seconds,json=revertAfterSeconds,proto3" json:"revert_after_seconds,omitempty"`
 }
 
-func (x *LogLine) Reset() {
-	*x = LogLine{}
+func (x *SetLogLevelArgs) Reset() {
+	*x = SetLogLevelArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[16]
+		mi := &file_engine_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogLine) String() string {
+func (x *SetLogLevelArgs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogLine) ProtoMessage() {}
+func (*SetLogLevelArgs) ProtoMessage() {}
 
-func (x *LogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[16]
+func (x *SetLogLevelArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,44 +2550,51 @@ func (x *LogLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
-func (*LogLine) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetLogLevelArgs.ProtoReflect.Descriptor instead.
+func (*SetLogLevelArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *LogLine) GetLine() []string {
+func (x *SetLogLevelArgs) GetLogLevel() string {
 	if x != nil {
-		return x.Line
+		return x.LogLevel
 	}
-	return nil
+	return ""
 }
 
-type LogLineFilter struct {
+func (x *SetLogLevelArgs) GetRevertAfterSeconds() uint32 {
+	if x != nil {
+		return x.RevertAfterSeconds
+	}
+	return 0
+}
+
+type SetLogLevelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operator    LogLineOperator `protobuf:"varint,1,opt,name=operator,proto3,enum=engine_api.LogLineOperator" json:"operator,omitempty"`
-	TextPattern string          `protobuf:"bytes,2,opt,name=text_pattern,json=textPattern,proto3" json:"text_pattern,omitempty"`
+	// The log level before this call
+	PreviousLogLevel string `protobuf:"bytes,1,opt,name=previous_log_level,json=previousLogLevel,proto3" json:"previous_log_level,omitempty"`
 }
 
-func (x *LogLineFilter) Reset() {
-	*x = LogLineFilter{}
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[17]
+		mi := &file_engine_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LogLineFilter) String() string {
+func (x *SetLogLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogLineFilter) ProtoMessage() {}
+func (*SetLogLevelResponse) ProtoMessage() {}
 
-func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[17]
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,21 +2605,14 @@ func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
-func (*LogLineFilter) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *LogLineFilter) GetOperator() LogLineOperator {
-	if x != nil {
-		return x.Operator
-	}
-	return LogLineOperator_LogLineOperator_DOES_CONTAIN_TEXT
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *LogLineFilter) GetTextPattern() string {
+func (x *SetLogLevelResponse) GetPreviousLogLevel() string {
 	if x != nil {
-		return x.TextPattern
+		return x.PreviousLogLevel
 	}
 	return ""
 }
@@ -1306,7 +2630,7 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x67,
 	0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xe0, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x22, 0x9a, 0x04, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
 	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
 	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e,
 	0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70, 0x69,
@@ -1320,163 +2644,267 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x65,
 	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69, 0x73,
 	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
-	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x65, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe5, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e,
-	0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x49,
-	0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x42, 0x0a, 0x1e,
-	0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x67, 0x72, 0x70, 0x63, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50,
-	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x22, 0xd0, 0x01, 0x0a, 0x22, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x6f, 0x6e,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72,
-	0x74, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x43,
-	0x0a, 0x1f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x67, 0x72, 0x70, 0x63, 0x50, 0x72, 0x6f,
-	0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69,
-	0x64, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65,
+	0x6c, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x15, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x75, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x12, 0x69, 0x70, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a,
+	0x12, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x54, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x64, 0x69, 0x72, 0x5f, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x6f, 0x73, 0x74, 0x44, 0x69, 0x72, 0x4d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36,
+	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x73, 0x49, 0x70, 0x76, 0x36, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x53, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65,
 	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x57, 0x0a, 0x14, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xe5, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50,
+	0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x70,
+	0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x37, 0x0a,
+	0x18, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65,
+	0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a,
+	0x67, 0x72, 0x70, 0x63, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73,
+	0x69, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x22, 0x45,
 	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12,
-	0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x61,
-	0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x74, 0x0a, 0x1f, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63,
-	0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x1b, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
-	0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x1a, 0x57, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x12,
-	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64,
-	0x22, 0x7c, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41,
-	0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0e,
-	0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x40,
-	0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67,
-	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x22, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72,
-	0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65,
-	0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68,
-	0x6f, 0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x6c, 0x6c, 0x22, 0x3c, 0x0a, 0x12,
+	0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x2b, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69,
+	0x70, 0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x38,
+	0x0a, 0x19, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x15, 0x67, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x48, 0x6f, 0x73,
+	0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12, 0x43, 0x0a, 0x1f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x1a, 0x67, 0x72, 0x70, 0x63, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72, 0x74,
+	0x4f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0xe0, 0x04,
+	0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65,
+	0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x50, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x57, 0x0a,
+	0x14, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x12, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x74, 0x0a, 0x1f, 0x61, 0x70, 0x69,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x1b, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x48, 0x6f, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xc3, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x57, 0x0a,
+	0x10, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x55, 0x75, 0x69, 0x64, 0x22, 0x7c, 0x0a, 0x32, 0x47, 0x65,
+	0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x70,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0x9c, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x72, 0x64,
+	0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x04, 0x73,
+	0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65,
+	0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74,
+	0x65, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x92,
+	0x01, 0x0a, 0x13, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f,
+	0x77, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74,
+	0x65, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x74, 0x65, 0x70, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x65, 0x70, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x16, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65,
+	0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x4c, 0x0a,
+	0x10, 0x6c, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0f, 0x6c, 0x65, 0x61, 0x6b,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x15, 0x4c,
+	0x65, 0x61, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f,
+	0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x6c, 0x6c, 0x22, 0x3c, 0x0a, 0x12, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
 	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55,
-	0x75, 0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22,
-	0xd1, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
-	0x53, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x63, 0x6f,
-	0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53,
-	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0x67,
+	0x0a, 0x0b, 0x50, 0x75, 0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x90, 0x02, 0x0a, 0x13, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75,
+	0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x70, 0x0a, 0x17, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x1a, 0x47, 0x0a, 0x19, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x79, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80,
-	0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f,
-	0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75,
-	0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
-	0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
-	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x1a, 0x60, 0x0a,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xd3, 0x03, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41,
+	0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6a,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x88,
+	0x01, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
+	0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xb2, 0x05, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55,
+	0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x53, 0x65, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x25, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x72, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x62, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x75, 0x6d, 0x44, 0x72, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1f, 0x6e, 0x75,
+	0x6d, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x1a, 0x60, 0x0a,
 	0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
@@ -1487,46 +2915,179 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d, 0x0a, 0x07, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67,
-	0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e,
-	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x45, 0x4d,
-	0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x52, 0x0a, 0x24, 0x4e, 0x75,
+	0x6d, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x1d,
+	0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x6b, 0x0a,
+	0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f,
+	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xa3, 0x02, 0x0a, 0x15, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0xb8, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x6f, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x72, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x02, 0x0a, 0x13,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x52,
+	0x75, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51,
+	0x0a, 0x16, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x22, 0x5c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x22,
+	0x67, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x70,
+	0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x72,
+	0x67, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x44,
+	0x0a, 0x1e, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x19, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x45, 0x0a, 0x1f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x70, 0x79,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x73, 0x5f, 0x61, 0x6c, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x77, 0x61, 0x73, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x22, 0x60, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x2a, 0x97, 0x01, 0x0a, 0x19, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x75,
+	0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10,
+	0x00, 0x12, 0x2c, 0x0a, 0x28, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x70, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x41,
+	0x46, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4f, 0x4c, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12,
+	0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x70, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x4e, 0x45, 0x56,
+	0x45, 0x52, 0x10, 0x02, 0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
 	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a,
-	0x94, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a,
-	0x25, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x58,
-	0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
-	0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f,
-	0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
-	0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x6f,
-	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f,
-	0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10,
-	0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f,
-	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28,
-	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54,
-	0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x6f,
+	0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x45, 0x4d, 0x50, 0x54,
+	0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52,
+	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x94, 0x01,
+	0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x25, 0x0a,
+	0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x6f, 0x67, 0x4c,
+	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53,
+	0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12,
+	0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4c, 0x6f,
 	0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f,
-	0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32, 0xae, 0x05, 0x0a,
+	0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x6f, 0x67, 0x4c,
+	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x2a, 0xbf, 0x01, 0x0a, 0x19, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x54, 0x4f,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x2b, 0x0a, 0x27, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x03, 0x32, 0xa6, 0x0c, 0x0a,
 	0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c,
 	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
@@ -1555,27 +3116,83 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
 	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73,
 	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65,
-	0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f,
-	0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x17, 0x53, 0x74,
+	0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a, 0x0e, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1e, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x1a, 0x44, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f,
+	0x77, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b,
+	0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x65, 0x64, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0b, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x12,
-	0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65,
-	0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
-	0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x56, 0x5a,
-	0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74,
-	0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73,
-	0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x5a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5f, 0x0a,
+	0x11, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x12, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
+	0x69, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x27, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x15,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63,
+	0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6b, 0x75, 0x72,
+	0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x70, 0x63,
+	0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1590,75 +3207,133 @@ func file_engine_service_proto_rawDescGZIP() []byte {
 	return file_engine_service_proto_rawDescData
 }
 
-var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_engine_service_proto_goTypes = []interface{}{
-	(EnclaveContainersStatus)(0),                               // 0: engine_api.EnclaveContainersStatus
-	(EnclaveAPIContainerStatus)(0),                             // 1: engine_api.EnclaveAPIContainerStatus
-	(LogLineOperator)(0),                                       // 2: engine_api.LogLineOperator
-	(*GetEngineInfoResponse)(nil),                              // 3: engine_api.GetEngineInfoResponse
-	(*CreateEnclaveArgs)(nil),                                  // 4: engine_api.CreateEnclaveArgs
-	(*CreateEnclaveResponse)(nil),                              // 5: engine_api.CreateEnclaveResponse
-	(*EnclaveAPIContainerInfo)(nil),                            // 6: engine_api.EnclaveAPIContainerInfo
-	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 7: engine_api.EnclaveAPIContainerHostMachineInfo
-	(*EnclaveInfo)(nil),                                        // 8: engine_api.EnclaveInfo
-	(*GetEnclavesResponse)(nil),                                // 9: engine_api.GetEnclavesResponse
-	(*EnclaveIdentifiers)(nil),                                 // 10: engine_api.EnclaveIdentifiers
-	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 11: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	(*StopEnclaveArgs)(nil),                                    // 12: engine_api.StopEnclaveArgs
-	(*DestroyEnclaveArgs)(nil),                                 // 13: engine_api.DestroyEnclaveArgs
-	(*CleanArgs)(nil),                                          // 14: engine_api.CleanArgs
-	(*EnclaveNameAndUuid)(nil),                                 // 15: engine_api.EnclaveNameAndUuid
-	(*CleanResponse)(nil),                                      // 16: engine_api.CleanResponse
-	(*GetServiceLogsArgs)(nil),                                 // 17: engine_api.GetServiceLogsArgs
-	(*GetServiceLogsResponse)(nil),                             // 18: engine_api.GetServiceLogsResponse
-	(*LogLine)(nil),                                            // 19: engine_api.LogLine
-	(*LogLineFilter)(nil),                                      // 20: engine_api.LogLineFilter
-	nil,                                                        // 21: engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	nil,                                                        // 22: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	nil,                                                        // 23: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	nil,                                                        // 24: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	(*timestamppb.Timestamp)(nil),                              // 25: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                      // 26: google.protobuf.Empty
+	(EnclaveIpRangeReusePolicy)(0),                             // 0: engine_api.EnclaveIpRangeReusePolicy
+	(EnclaveContainersStatus)(0),                               // 1: engine_api.EnclaveContainersStatus
+	(EnclaveAPIContainerStatus)(0),                             // 2: engine_api.EnclaveAPIContainerStatus
+	(LogLineOperator)(0),                                       // 3: engine_api.LogLineOperator
+	(EnclaveLifecycleEventType)(0),                             // 4: engine_api.EnclaveLifecycleEventType
+	(*GetEngineInfoResponse)(nil),                              // 5: engine_api.GetEngineInfoResponse
+	(*CreateEnclaveArgs)(nil),                                  // 6: engine_api.CreateEnclaveArgs
+	(*CreateEnclaveResponse)(nil),                              // 7: engine_api.CreateEnclaveResponse
+	(*EnclaveAPIContainerInfo)(nil),                            // 8: engine_api.EnclaveAPIContainerInfo
+	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 9: engine_api.EnclaveAPIContainerHostMachineInfo
+	(*EnclaveInfo)(nil),                                        // 10: engine_api.EnclaveInfo
+	(*GetEnclavesResponse)(nil),                                // 11: engine_api.GetEnclavesResponse
+	(*EnclaveIdentifiers)(nil),                                 // 12: engine_api.EnclaveIdentifiers
+	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 13: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	(*StopEnclaveArgs)(nil),                                    // 14: engine_api.StopEnclaveArgs
+	(*DestroyEnclaveArgs)(nil),                                 // 15: engine_api.DestroyEnclaveArgs
+	(*EnclaveTeardownProgress)(nil),                            // 16: engine_api.EnclaveTeardownProgress
+	(*EnclaveTeardownStep)(nil),                                // 17: engine_api.EnclaveTeardownStep
+	(*EnclaveTeardownSummary)(nil),                             // 18: engine_api.EnclaveTeardownSummary
+	(*LeakedEnclaveResource)(nil),                              // 19: engine_api.LeakedEnclaveResource
+	(*CleanArgs)(nil),                                          // 20: engine_api.CleanArgs
+	(*EnclaveNameAndUuid)(nil),                                 // 21: engine_api.EnclaveNameAndUuid
+	(*CleanResponse)(nil),                                      // 22: engine_api.CleanResponse
+	(*PulledImage)(nil),                                        // 23: engine_api.PulledImage
+	(*GetPulledImagesResponse)(nil),                            // 24: engine_api.GetPulledImagesResponse
+	(*PruneImagesResponse)(nil),                                // 25: engine_api.PruneImagesResponse
+	(*GetServiceLogsArgs)(nil),                                 // 26: engine_api.GetServiceLogsArgs
+	(*GetServiceLogsResponse)(nil),                             // 27: engine_api.GetServiceLogsResponse
+	(*LogLine)(nil),                                            // 28: engine_api.LogLine
+	(*LogLineFilter)(nil),                                      // 29: engine_api.LogLineFilter
+	(*EnclaveLifecycleEvent)(nil),                              // 30: engine_api.EnclaveLifecycleEvent
+	(*EnclaveSchedule)(nil),                                    // 31: engine_api.EnclaveSchedule
+	(*EnclaveScheduleInfo)(nil),                                // 32: engine_api.EnclaveScheduleInfo
+	(*AddEnclaveScheduleArgs)(nil),                             // 33: engine_api.AddEnclaveScheduleArgs
+	(*GetEnclaveSchedulesResponse)(nil),                        // 34: engine_api.GetEnclaveSchedulesResponse
+	(*RemoveEnclaveScheduleArgs)(nil),                          // 35: engine_api.RemoveEnclaveScheduleArgs
+	(*CopyFilesArtifactArgs)(nil),                              // 36: engine_api.CopyFilesArtifactArgs
+	(*CopyFilesArtifactResponse)(nil),                          // 37: engine_api.CopyFilesArtifactResponse
+	(*SetLogLevelArgs)(nil),                                    // 38: engine_api.SetLogLevelArgs
+	(*SetLogLevelResponse)(nil),                                // 39: engine_api.SetLogLevelResponse
+	nil,                                                        // 40: engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	nil,                                                        // 41: engine_api.PruneImagesResponse.RemovalErrorsByImageEntry
+	nil,                                                        // 42: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	nil,                                                        // 43: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	nil,                                                        // 44: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	nil,                                                        // 45: engine_api.GetServiceLogsResponse.NumDroppedLogLinesByServiceUuidEntry
+	(*timestamppb.Timestamp)(nil),                              // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 47: google.protobuf.Empty
 }
 var file_engine_service_proto_depIdxs = []int32{
-	8,  // 0: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
-	0,  // 1: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
-	1,  // 2: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
-	6,  // 3: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
-	7,  // 4: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
-	25, // 5: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
-	21, // 6: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	10, // 7: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
-	15, // 8: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
-	22, // 9: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	20, // 10: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
-	23, // 11: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	24, // 12: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	2,  // 13: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
-	8,  // 14: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
-	19, // 15: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
-	26, // 16: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
-	4,  // 17: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
-	26, // 18: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
-	26, // 19: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
-	12, // 20: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
-	13, // 21: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
-	14, // 22: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
-	17, // 23: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
-	3,  // 24: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
-	5,  // 25: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
-	9,  // 26: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
-	11, // 27: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	26, // 28: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
-	26, // 29: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
-	16, // 30: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
-	18, // 31: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
-	24, // [24:32] is the sub-list for method output_type
-	16, // [16:24] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	0,  // 0: engine_api.CreateEnclaveArgs.ip_range_reuse_policy:type_name -> engine_api.EnclaveIpRangeReusePolicy
+	10, // 1: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	1,  // 2: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
+	2,  // 3: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
+	8,  // 4: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
+	9,  // 5: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
+	46, // 6: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
+	40, // 7: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	12, // 8: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
+	17, // 9: engine_api.EnclaveTeardownProgress.step:type_name -> engine_api.EnclaveTeardownStep
+	18, // 10: engine_api.EnclaveTeardownProgress.summary:type_name -> engine_api.EnclaveTeardownSummary
+	19, // 11: engine_api.EnclaveTeardownSummary.leaked_resources:type_name -> engine_api.LeakedEnclaveResource
+	21, // 12: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
+	23, // 13: engine_api.GetPulledImagesResponse.pulled_images:type_name -> engine_api.PulledImage
+	23, // 14: engine_api.PruneImagesResponse.removed_images:type_name -> engine_api.PulledImage
+	41, // 15: engine_api.PruneImagesResponse.removal_errors_by_image:type_name -> engine_api.PruneImagesResponse.RemovalErrorsByImageEntry
+	42, // 16: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	29, // 17: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
+	46, // 18: engine_api.GetServiceLogsArgs.since:type_name -> google.protobuf.Timestamp
+	46, // 19: engine_api.GetServiceLogsArgs.until:type_name -> google.protobuf.Timestamp
+	43, // 20: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	44, // 21: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	45, // 22: engine_api.GetServiceLogsResponse.num_dropped_log_lines_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.NumDroppedLogLinesByServiceUuidEntry
+	3,  // 23: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
+	4,  // 24: engine_api.EnclaveLifecycleEvent.event_type:type_name -> engine_api.EnclaveLifecycleEventType
+	46, // 25: engine_api.EnclaveLifecycleEvent.timestamp:type_name -> google.protobuf.Timestamp
+	31, // 26: engine_api.EnclaveScheduleInfo.schedule:type_name -> engine_api.EnclaveSchedule
+	46, // 27: engine_api.EnclaveScheduleInfo.next_run_time:type_name -> google.protobuf.Timestamp
+	46, // 28: engine_api.EnclaveScheduleInfo.last_run_time:type_name -> google.protobuf.Timestamp
+	31, // 29: engine_api.AddEnclaveScheduleArgs.schedule:type_name -> engine_api.EnclaveSchedule
+	32, // 30: engine_api.GetEnclaveSchedulesResponse.schedules:type_name -> engine_api.EnclaveScheduleInfo
+	10, // 31: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
+	28, // 32: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
+	47, // 33: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
+	6,  // 34: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
+	47, // 35: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
+	47, // 36: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
+	14, // 37: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
+	14, // 38: engine_api.EngineService.StopEnclaveWithProgress:input_type -> engine_api.StopEnclaveArgs
+	15, // 39: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
+	15, // 40: engine_api.EngineService.DestroyEnclaveWithProgress:input_type -> engine_api.DestroyEnclaveArgs
+	20, // 41: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
+	47, // 42: engine_api.EngineService.GetPulledImages:input_type -> google.protobuf.Empty
+	47, // 43: engine_api.EngineService.PruneImages:input_type -> google.protobuf.Empty
+	26, // 44: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
+	47, // 45: engine_api.EngineService.GetEnclaveLifecycleEvents:input_type -> google.protobuf.Empty
+	36, // 46: engine_api.EngineService.CopyFilesArtifact:input_type -> engine_api.CopyFilesArtifactArgs
+	33, // 47: engine_api.EngineService.AddEnclaveSchedule:input_type -> engine_api.AddEnclaveScheduleArgs
+	47, // 48: engine_api.EngineService.GetEnclaveSchedules:input_type -> google.protobuf.Empty
+	35, // 49: engine_api.EngineService.RemoveEnclaveSchedule:input_type -> engine_api.RemoveEnclaveScheduleArgs
+	38, // 50: engine_api.EngineService.SetLogLevel:input_type -> engine_api.SetLogLevelArgs
+	5,  // 51: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
+	7,  // 52: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
+	11, // 53: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
+	13, // 54: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	47, // 55: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
+	16, // 56: engine_api.EngineService.StopEnclaveWithProgress:output_type -> engine_api.EnclaveTeardownProgress
+	47, // 57: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
+	16, // 58: engine_api.EngineService.DestroyEnclaveWithProgress:output_type -> engine_api.EnclaveTeardownProgress
+	22, // 59: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
+	24, // 60: engine_api.EngineService.GetPulledImages:output_type -> engine_api.GetPulledImagesResponse
+	25, // 61: engine_api.EngineService.PruneImages:output_type -> engine_api.PruneImagesResponse
+	27, // 62: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
+	30, // 63: engine_api.EngineService.GetEnclaveLifecycleEvents:output_type -> engine_api.EnclaveLifecycleEvent
+	37, // 64: engine_api.EngineService.CopyFilesArtifact:output_type -> engine_api.CopyFilesArtifactResponse
+	47, // 65: engine_api.EngineService.AddEnclaveSchedule:output_type -> google.protobuf.Empty
+	34, // 66: engine_api.EngineService.GetEnclaveSchedules:output_type -> engine_api.GetEnclaveSchedulesResponse
+	47, // 67: engine_api.EngineService.RemoveEnclaveSchedule:output_type -> google.protobuf.Empty
+	39, // 68: engine_api.EngineService.SetLogLevel:output_type -> engine_api.SetLogLevelResponse
+	51, // [51:69] is the sub-list for method output_type
+	33, // [33:51] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_engine_service_proto_init() }
@@ -1800,7 +3475,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanArgs); i {
+			switch v := v.(*EnclaveTeardownProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1812,7 +3487,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveNameAndUuid); i {
+			switch v := v.(*EnclaveTeardownStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1824,7 +3499,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanResponse); i {
+			switch v := v.(*EnclaveTeardownSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1836,7 +3511,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsArgs); i {
+			switch v := v.(*LeakedEnclaveResource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1848,7 +3523,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsResponse); i {
+			switch v := v.(*CleanArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1860,7 +3535,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogLine); i {
+			switch v := v.(*EnclaveNameAndUuid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1872,6 +3547,90 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PulledImage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPulledImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneImagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogLineFilter); i {
 			case 0:
 				return &v.state
@@ -1883,14 +3642,140 @@ func file_engine_service_proto_init() {
 				return nil
 			}
 		}
+		file_engine_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveLifecycleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnclaveScheduleInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEnclaveScheduleArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnclaveSchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveEnclaveScheduleArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyFilesArtifactArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyFilesArtifactResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_engine_service_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*EnclaveTeardownProgress_Step)(nil),
+		(*EnclaveTeardownProgress_Summary)(nil),
 	}
+	file_engine_service_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[27].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_engine_service_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   22,
+			NumEnums:      5,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,9 +25,19 @@ const (
 	EngineService_GetEnclaves_FullMethodName                                = "/engine_api.EngineService/GetEnclaves"
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
+	EngineService_StopEnclaveWithProgress_FullMethodName                    = "/engine_api.EngineService/StopEnclaveWithProgress"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
+	EngineService_DestroyEnclaveWithProgress_FullMethodName                 = "/engine_api.EngineService/DestroyEnclaveWithProgress"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
+	EngineService_GetPulledImages_FullMethodName                            = "/engine_api.EngineService/GetPulledImages"
+	EngineService_PruneImages_FullMethodName                                = "/engine_api.EngineService/PruneImages"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_GetEnclaveLifecycleEvents_FullMethodName                  = "/engine_api.EngineService/GetEnclaveLifecycleEvents"
+	EngineService_CopyFilesArtifact_FullMethodName                          = "/engine_api.EngineService/CopyFilesArtifact"
+	EngineService_AddEnclaveSchedule_FullMethodName                         = "/engine_api.EngineService/AddEnclaveSchedule"
+	EngineService_GetEnclaveSchedules_FullMethodName                        = "/engine_api.EngineService/GetEnclaveSchedules"
+	EngineService_RemoveEnclaveSchedule_FullMethodName                      = "/engine_api.EngineService/RemoveEnclaveSchedule"
+	EngineService_SetLogLevel_FullMethodName                                = "/engine_api.EngineService/SetLogLevel"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -49,12 +59,45 @@ type EngineServiceClient interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Like StopEnclave, but streams the progress of the stop as containers get stopped, followed by a summary of the
+	// containers that couldn't be stopped
+	StopEnclaveWithProgress(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (EngineService_StopEnclaveWithProgressClient, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Like DestroyEnclave, but streams the progress of the destruction as containers, volumes and networks get removed,
+	// followed by a summary of the resources that were left behind
+	DestroyEnclaveWithProgress(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (EngineService_DestroyEnclaveWithProgressClient, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
+	// Lists the images Kurtosis pulled for enclaves, along with the existing enclaves still using them
+	GetPulledImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPulledImagesResponse, error)
+	// Removes the images Kurtosis pulled for enclaves that no existing enclave uses anymore
+	PruneImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PruneImagesResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Streams the lifecycle events of all the enclaves (created, stopped, destroyed, service added) as they happen
+	GetEnclaveLifecycleEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (EngineService_GetEnclaveLifecycleEventsClient, error)
+	// Copies a files artifact from one enclave to another without it going through the client, e.g. to reuse a generated
+	// genesis state in another enclave
+	CopyFilesArtifact(ctx context.Context, in *CopyFilesArtifactArgs, opts ...grpc.CallOption) (*CopyFilesArtifactResponse, error)
+	// ==============================================================================================
+	//
+	//	Enclave Schedules
+	//
+	// ==============================================================================================
+	// Registers a recurring job creating an enclave from a package on a cron schedule, e.g. to rebuild a devnet nightly
+	AddEnclaveSchedule(ctx context.Context, in *AddEnclaveScheduleArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns the registered enclave schedules along with their last runs
+	GetEnclaveSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveSchedulesResponse, error)
+	// Unregisters an enclave schedule, optionally destroying the enclaves it created
+	RemoveEnclaveSchedule(ctx context.Context, in *RemoveEnclaveScheduleArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ==============================================================================================
+	//
+	//	Administration
+	//
+	// ==============================================================================================
+	// Changes the log level of the engine at runtime, e.g. to temporarily debug an issue without restarting it
+	SetLogLevel(ctx context.Context, in *SetLogLevelArgs, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
 }
 
 type engineServiceClient struct {
@@ -110,6 +153,38 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, in *StopEnclaveAr
 	return out, nil
 }
 
+func (c *engineServiceClient) StopEnclaveWithProgress(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (EngineService_StopEnclaveWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[0], EngineService_StopEnclaveWithProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceStopEnclaveWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_StopEnclaveWithProgressClient interface {
+	Recv() (*EnclaveTeardownProgress, error)
+	grpc.ClientStream
+}
+
+type engineServiceStopEnclaveWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceStopEnclaveWithProgressClient) Recv() (*EnclaveTeardownProgress, error) {
+	m := new(EnclaveTeardownProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, EngineService_DestroyEnclave_FullMethodName, in, out, opts...)
@@ -119,6 +194,38 @@ func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnc
 	return out, nil
 }
 
+func (c *engineServiceClient) DestroyEnclaveWithProgress(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (EngineService_DestroyEnclaveWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[1], EngineService_DestroyEnclaveWithProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceDestroyEnclaveWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_DestroyEnclaveWithProgressClient interface {
+	Recv() (*EnclaveTeardownProgress, error)
+	grpc.ClientStream
+}
+
+type engineServiceDestroyEnclaveWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceDestroyEnclaveWithProgressClient) Recv() (*EnclaveTeardownProgress, error) {
+	m := new(EnclaveTeardownProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error) {
 	out := new(CleanResponse)
 	err := c.cc.Invoke(ctx, EngineService_Clean_FullMethodName, in, out, opts...)
@@ -128,8 +235,26 @@ func (c *engineServiceClient) Clean(ctx context.Context, in *CleanArgs, opts ...
 	return out, nil
 }
 
+func (c *engineServiceClient) GetPulledImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPulledImagesResponse, error) {
+	out := new(GetPulledImagesResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetPulledImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) PruneImages(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PruneImagesResponse, error) {
+	out := new(PruneImagesResponse)
+	err := c.cc.Invoke(ctx, EngineService_PruneImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[0], EngineService_GetServiceLogs_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[2], EngineService_GetServiceLogs_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +285,83 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) GetEnclaveLifecycleEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (EngineService_GetEnclaveLifecycleEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EngineService_ServiceDesc.Streams[3], EngineService_GetEnclaveLifecycleEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &engineServiceGetEnclaveLifecycleEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EngineService_GetEnclaveLifecycleEventsClient interface {
+	Recv() (*EnclaveLifecycleEvent, error)
+	grpc.ClientStream
+}
+
+type engineServiceGetEnclaveLifecycleEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *engineServiceGetEnclaveLifecycleEventsClient) Recv() (*EnclaveLifecycleEvent, error) {
+	m := new(EnclaveLifecycleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *engineServiceClient) CopyFilesArtifact(ctx context.Context, in *CopyFilesArtifactArgs, opts ...grpc.CallOption) (*CopyFilesArtifactResponse, error) {
+	out := new(CopyFilesArtifactResponse)
+	err := c.cc.Invoke(ctx, EngineService_CopyFilesArtifact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) AddEnclaveSchedule(ctx context.Context, in *AddEnclaveScheduleArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_AddEnclaveSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) GetEnclaveSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetEnclaveSchedulesResponse, error) {
+	out := new(GetEnclaveSchedulesResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetEnclaveSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) RemoveEnclaveSchedule(ctx context.Context, in *RemoveEnclaveScheduleArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_RemoveEnclaveSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *engineServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelArgs, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, EngineService_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -179,12 +381,45 @@ type EngineServiceServer interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
+	// Like StopEnclave, but streams the progress of the stop as containers get stopped, followed by a summary of the
+	// containers that couldn't be stopped
+	StopEnclaveWithProgress(*StopEnclaveArgs, EngineService_StopEnclaveWithProgressServer) error
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
+	// Like DestroyEnclave, but streams the progress of the destruction as containers, volumes and networks get removed,
+	// followed by a summary of the resources that were left behind
+	DestroyEnclaveWithProgress(*DestroyEnclaveArgs, EngineService_DestroyEnclaveWithProgressServer) error
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
+	// Lists the images Kurtosis pulled for enclaves, along with the existing enclaves still using them
+	GetPulledImages(context.Context, *emptypb.Empty) (*GetPulledImagesResponse, error)
+	// Removes the images Kurtosis pulled for enclaves that no existing enclave uses anymore
+	PruneImages(context.Context, *emptypb.Empty) (*PruneImagesResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Streams the lifecycle events of all the enclaves (created, stopped, destroyed, service added) as they happen
+	GetEnclaveLifecycleEvents(*emptypb.Empty, EngineService_GetEnclaveLifecycleEventsServer) error
+	// Copies a files artifact from one enclave to another without it going through the client, e.g. to reuse a generated
+	// genesis state in another enclave
+	CopyFilesArtifact(context.Context, *CopyFilesArtifactArgs) (*CopyFilesArtifactResponse, error)
+	// ==============================================================================================
+	//
+	//	Enclave Schedules
+	//
+	// ==============================================================================================
+	// Registers a recurring job creating an enclave from a package on a cron schedule, e.g. to rebuild a devnet nightly
+	AddEnclaveSchedule(context.Context, *AddEnclaveScheduleArgs) (*emptypb.Empty, error)
+	// Returns the registered enclave schedules along with their last runs
+	GetEnclaveSchedules(context.Context, *emptypb.Empty) (*GetEnclaveSchedulesResponse, error)
+	// Unregisters an enclave schedule, optionally destroying the enclaves it created
+	RemoveEnclaveSchedule(context.Context, *RemoveEnclaveScheduleArgs) (*emptypb.Empty, error)
+	// ==============================================================================================
+	//
+	//	Administration
+	//
+	// ==============================================================================================
+	// Changes the log level of the engine at runtime, e.g. to temporarily debug an issue without restarting it
+	SetLogLevel(context.Context, *SetLogLevelArgs) (*SetLogLevelResponse, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -206,15 +441,45 @@ func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifie
 func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) StopEnclaveWithProgress(*StopEnclaveArgs, EngineService_StopEnclaveWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method StopEnclaveWithProgress not implemented")
+}
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) DestroyEnclaveWithProgress(*DestroyEnclaveArgs, EngineService_DestroyEnclaveWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method DestroyEnclaveWithProgress not implemented")
+}
 func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*CleanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clean not implemented")
 }
+func (UnimplementedEngineServiceServer) GetPulledImages(context.Context, *emptypb.Empty) (*GetPulledImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPulledImages not implemented")
+}
+func (UnimplementedEngineServiceServer) PruneImages(context.Context, *emptypb.Empty) (*PruneImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneImages not implemented")
+}
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) GetEnclaveLifecycleEvents(*emptypb.Empty, EngineService_GetEnclaveLifecycleEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetEnclaveLifecycleEvents not implemented")
+}
+func (UnimplementedEngineServiceServer) CopyFilesArtifact(context.Context, *CopyFilesArtifactArgs) (*CopyFilesArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFilesArtifact not implemented")
+}
+func (UnimplementedEngineServiceServer) AddEnclaveSchedule(context.Context, *AddEnclaveScheduleArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEnclaveSchedule not implemented")
+}
+func (UnimplementedEngineServiceServer) GetEnclaveSchedules(context.Context, *emptypb.Empty) (*GetEnclaveSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnclaveSchedules not implemented")
+}
+func (UnimplementedEngineServiceServer) RemoveEnclaveSchedule(context.Context, *RemoveEnclaveScheduleArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEnclaveSchedule not implemented")
+}
+func (UnimplementedEngineServiceServer) SetLogLevel(context.Context, *SetLogLevelArgs) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -317,6 +582,27 @@ func _EngineService_StopEnclave_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_StopEnclaveWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StopEnclaveArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).StopEnclaveWithProgress(m, &engineServiceStopEnclaveWithProgressServer{stream})
+}
+
+type EngineService_StopEnclaveWithProgressServer interface {
+	Send(*EnclaveTeardownProgress) error
+	grpc.ServerStream
+}
+
+type engineServiceStopEnclaveWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceStopEnclaveWithProgressServer) Send(m *EnclaveTeardownProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -335,6 +621,27 @@ func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_DestroyEnclaveWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DestroyEnclaveArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).DestroyEnclaveWithProgress(m, &engineServiceDestroyEnclaveWithProgressServer{stream})
+}
+
+type EngineService_DestroyEnclaveWithProgressServer interface {
+	Send(*EnclaveTeardownProgress) error
+	grpc.ServerStream
+}
+
+type engineServiceDestroyEnclaveWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceDestroyEnclaveWithProgressServer) Send(m *EnclaveTeardownProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _EngineService_Clean_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanArgs)
 	if err := dec(in); err != nil {
@@ -353,6 +660,42 @@ func _EngineService_Clean_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_GetPulledImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetPulledImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetPulledImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetPulledImages(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_PruneImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).PruneImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_PruneImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).PruneImages(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_GetServiceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetServiceLogsArgs)
 	if err := stream.RecvMsg(m); err != nil {
@@ -374,6 +717,117 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_GetEnclaveLifecycleEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EngineServiceServer).GetEnclaveLifecycleEvents(m, &engineServiceGetEnclaveLifecycleEventsServer{stream})
+}
+
+type EngineService_GetEnclaveLifecycleEventsServer interface {
+	Send(*EnclaveLifecycleEvent) error
+	grpc.ServerStream
+}
+
+type engineServiceGetEnclaveLifecycleEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *engineServiceGetEnclaveLifecycleEventsServer) Send(m *EnclaveLifecycleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EngineService_CopyFilesArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFilesArtifactArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).CopyFilesArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_CopyFilesArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).CopyFilesArtifact(ctx, req.(*CopyFilesArtifactArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_AddEnclaveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEnclaveScheduleArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).AddEnclaveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_AddEnclaveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).AddEnclaveSchedule(ctx, req.(*AddEnclaveScheduleArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_GetEnclaveSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetEnclaveSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetEnclaveSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetEnclaveSchedules(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_RemoveEnclaveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEnclaveScheduleArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).RemoveEnclaveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_RemoveEnclaveSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).RemoveEnclaveSchedule(ctx, req.(*RemoveEnclaveScheduleArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EngineService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).SetLogLevel(ctx, req.(*SetLogLevelArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -409,13 +863,56 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "GetPulledImages",
+			Handler:    _EngineService_GetPulledImages_Handler,
+		},
+		{
+			MethodName: "PruneImages",
+			Handler:    _EngineService_PruneImages_Handler,
+		},
+		{
+			MethodName: "CopyFilesArtifact",
+			Handler:    _EngineService_CopyFilesArtifact_Handler,
+		},
+		{
+			MethodName: "AddEnclaveSchedule",
+			Handler:    _EngineService_AddEnclaveSchedule_Handler,
+		},
+		{
+			MethodName: "GetEnclaveSchedules",
+			Handler:    _EngineService_GetEnclaveSchedules_Handler,
+		},
+		{
+			MethodName: "RemoveEnclaveSchedule",
+			Handler:    _EngineService_RemoveEnclaveSchedule_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _EngineService_SetLogLevel_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StopEnclaveWithProgress",
+			Handler:       _EngineService_StopEnclaveWithProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DestroyEnclaveWithProgress",
+			Handler:       _EngineService_DestroyEnclaveWithProgress_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetServiceLogs",
 			Handler:       _EngineService_GetServiceLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetEnclaveLifecycleEvents",
+			Handler:       _EngineService_GetEnclaveLifecycleEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "engine_service.proto",
 }
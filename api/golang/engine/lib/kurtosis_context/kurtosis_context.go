@@ -17,7 +17,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"io"
+	"time"
 )
 
 const (
@@ -31,8 +33,13 @@ const (
 	// Blank tells the engine server to use the default
 	defaultApiContainerVersionTag = ""
 
+	// Blank tells the engine server to give the enclave a random free subnet of the default size
+	defaultEnclaveSubnet = ""
+
 	serviceLogsStreamContentChanBufferSize = 5
 
+	enclaveLifecycleEventsChanBufferSize = 5
+
 	grpcStreamCancelContextErrorMessage = "rpc error: code = Canceled desc = context canceled"
 
 	validUuidMatchesAllowed = 1
@@ -97,10 +104,16 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(
 ) (*enclaves.EnclaveContext, error) {
 
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
-		EnclaveName:            enclaveName,
-		ApiContainerVersionTag: defaultApiContainerVersionTag,
-		ApiContainerLogLevel:   apiContainerLogLevel.String(),
-		IsPartitioningEnabled:  isPartitioningEnabled,
+		EnclaveName:              enclaveName,
+		ApiContainerVersionTag:   defaultApiContainerVersionTag,
+		ApiContainerLogLevel:     apiContainerLogLevel.String(),
+		IsPartitioningEnabled:    isPartitioningEnabled,
+		IpRangeReusePolicy:       kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE,
+		ExcludedIpRanges:         nil,
+		Subnet:                   defaultEnclaveSubnet,
+		FilesArtifactsTtlSeconds: 0,
+		AllowHostDirMounts:       false,
+		IsIpv6Enabled:            false,
 	}
 
 	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
@@ -193,6 +206,8 @@ func (kurtosisCtx *KurtosisContext) GetEnclave(ctx context.Context, enclaveIdent
 
 // Docs available at https://docs.kurtosis.com/sdk/#stopenclavestring-enclaveidentifier
 func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIdentifier string) error {
+	kurtosisCtx.runShutdownHooks(ctx, enclaveIdentifier)
+
 	stopEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StopEnclaveArgs{
 		EnclaveIdentifier: enclaveIdentifier,
 	}
@@ -206,6 +221,8 @@ func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIden
 
 // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
 func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
+	kurtosisCtx.runShutdownHooks(ctx, enclaveIdentifier)
+
 	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
 		EnclaveIdentifier: enclaveIdentifier,
 	}
@@ -217,6 +234,50 @@ func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveI
 	return nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk/#stopenclavewithprogressstring-enclaveidentifier-funcenclaveteardownstep-progresscallback---enclaveteardownsummary-summary
+func (kurtosisCtx *KurtosisContext) StopEnclaveWithProgress(
+	ctx context.Context,
+	enclaveIdentifier string,
+	progressCallback func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep),
+) (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary, error) {
+	kurtosisCtx.runShutdownHooks(ctx, enclaveIdentifier)
+
+	stopEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StopEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+	stream, err := kurtosisCtx.engineClient.StopEnclaveWithProgress(ctx, stopEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred stopping enclave with identifier '%v'", enclaveIdentifier)
+	}
+	summary, err := receiveEnclaveTeardownProgress(stream, progressCallback)
+	if err != nil {
+		return summary, stacktrace.Propagate(err, "An error occurred stopping enclave with identifier '%v'", enclaveIdentifier)
+	}
+	return summary, nil
+}
+
+// Docs available at https://docs.kurtosis.com/sdk/#destroyenclavewithprogressstring-enclaveidentifier-funcenclaveteardownstep-progresscallback---enclaveteardownsummary-summary
+func (kurtosisCtx *KurtosisContext) DestroyEnclaveWithProgress(
+	ctx context.Context,
+	enclaveIdentifier string,
+	progressCallback func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep),
+) (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary, error) {
+	kurtosisCtx.runShutdownHooks(ctx, enclaveIdentifier)
+
+	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+	stream, err := kurtosisCtx.engineClient.DestroyEnclaveWithProgress(ctx, destroyEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v'", enclaveIdentifier)
+	}
+	summary, err := receiveEnclaveTeardownProgress(stream, progressCallback)
+	if err != nil {
+		return summary, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v'", enclaveIdentifier)
+	}
+	return summary, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#cleanboolean-shouldcleanall---enclavenameanduuid-removedenclavenameanduuids
 func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bool) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
 	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{
@@ -242,6 +303,24 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	func(),
 	error,
 ) {
+	return kurtosisCtx.GetServiceLogsInTimeRange(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter, time.Time{}, time.Time{})
+}
+
+// GetServiceLogsInTimeRange is GetServiceLogs, but only with the log lines logged between since and until, which get
+// filtered out by the engine; the zero time leaves that end of the range unbounded. Logs can't be followed until a time
+func (kurtosisCtx *KurtosisContext) GetServiceLogsInTimeRange(
+	ctx context.Context,
+	enclaveIdentifier string,
+	userServiceUuids map[services.ServiceUUID]bool,
+	shouldFollowLogs bool,
+	logLineFilter *LogLineFilter,
+	since time.Time,
+	until time.Time,
+) (
+	chan *serviceLogsStreamContent,
+	func(),
+	error,
+) {
 
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
 	shouldCancelCtx := true
@@ -255,7 +334,7 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	//this process could take much time until the next channel pull, so we could be filling the buffer during that time to not let the servers thread idled
 	serviceLogsStreamContentChan := make(chan *serviceLogsStreamContent, serviceLogsStreamContentChanBufferSize)
 
-	getServiceLogsArgs, err := newGetServiceLogsArgs(enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter)
+	getServiceLogsArgs, err := newGetServiceLogsArgs(enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter, since, until)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(
 			err,
@@ -285,6 +364,25 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	return serviceLogsStreamContentChan, cancelCtxFunc, nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#getenclavelifecycleevents---streamenclavelifecycleevent-enclavelifecycleevents
+func (kurtosisCtx *KurtosisContext) GetEnclaveLifecycleEvents(ctx context.Context) (
+	chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent,
+	func(),
+	error,
+) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	stream, err := kurtosisCtx.engineClient.GetEnclaveLifecycleEvents(ctxWithCancel, &emptypb.Empty{})
+	if err != nil {
+		cancelCtxFunc()
+		return nil, nil, stacktrace.Propagate(err, "An error occurred streaming the enclave lifecycle events")
+	}
+
+	enclaveLifecycleEventsChan := make(chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent, enclaveLifecycleEventsChanBufferSize)
+	go runReceiveEnclaveLifecycleEventsFromTheServerRoutine(cancelCtxFunc, enclaveLifecycleEventsChan, stream)
+
+	return enclaveLifecycleEventsChan, cancelCtxFunc, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#getexistingandhistoricalenclaveidentifiers---enclaveidentifiers-enclaveidentifiers
 func (kurtosisCtx *KurtosisContext) GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context) (*EnclaveIdentifiers, error) {
 	historicalEnclaveIdentifiers, err := kurtosisCtx.engineClient.GetExistingAndHistoricalEnclaveIdentifiers(ctx, &emptypb.Empty{})
@@ -340,6 +438,91 @@ func runReceiveStreamLogsFromTheServerRoutine(
 	}
 }
 
+func runReceiveEnclaveLifecycleEventsFromTheServerRoutine(
+	cancelCtxFunc context.CancelFunc,
+	enclaveLifecycleEventsChan chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent,
+	stream kurtosis_engine_rpc_api_bindings.EngineService_GetEnclaveLifecycleEventsClient,
+) {
+	defer func() {
+		cancelCtxFunc()
+		close(enclaveLifecycleEventsChan)
+	}()
+
+	for {
+		enclaveLifecycleEvent, errReceivingStream := stream.Recv()
+		if errReceivingStream == io.EOF {
+			logrus.Debug("Received an 'EOF' error from the enclave lifecycle events GRPC stream")
+			return
+		}
+		if errReceivingStream != nil {
+			if errReceivingStream.Error() == grpcStreamCancelContextErrorMessage {
+				logrus.Debug("Received a 'context canceled' error from the enclave lifecycle events GRPC stream")
+				return
+			}
+			logrus.Errorf("An error occurred receiving the enclave lifecycle events stream. Error:\n%v", errReceivingStream)
+			return
+		}
+		enclaveLifecycleEventsChan <- enclaveLifecycleEvent
+	}
+}
+
+// runShutdownHooks gives the enclave the chance to run the shutdown hooks its packages registered, e.g. to collect
+// results, before its containers go away. It's best-effort: a stopped enclave has no API container to run them, and
+// failing hooks don't prevent the enclave from being stopped or destroyed
+// receiveEnclaveTeardownProgress returns the summary even when the teardown fails, as the engine sends it before the
+// error, so that callers can still tell what got left behind
+func receiveEnclaveTeardownProgress(
+	stream interface {
+		Recv() (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress, error)
+	},
+	progressCallback func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep),
+) (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary, error) {
+	var summary *kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary
+	for {
+		progress, err := stream.Recv()
+		if err == io.EOF {
+			return summary, nil
+		}
+		if err != nil {
+			return summary, stacktrace.Propagate(err, "An error occurred receiving the teardown progress")
+		}
+		if step := progress.GetStep(); step != nil && progressCallback != nil {
+			progressCallback(step)
+		}
+		if progress.GetSummary() != nil {
+			summary = progress.GetSummary()
+		}
+	}
+}
+
+func (kurtosisCtx *KurtosisContext) runShutdownHooks(ctx context.Context, enclaveIdentifier string) {
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		logrus.Debugf("Couldn't get enclave '%v' to run its shutdown hooks:\n%v", enclaveIdentifier, err)
+		return
+	}
+	if enclaveInfo.GetApiContainerStatus() != kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+		return
+	}
+	enclaveCtx, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, enclaveInfo)
+	if err != nil {
+		logrus.Warnf("Couldn't connect to enclave '%v' to run its shutdown hooks:\n%v", enclaveIdentifier, err)
+		return
+	}
+	hookResults, err := enclaveCtx.RunShutdownHooks(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred running the shutdown hooks of enclave '%v':\n%v", enclaveIdentifier, err)
+		return
+	}
+	for _, hookResult := range hookResults {
+		if hookResult.Error != nil {
+			logrus.Warnf("Shutdown hook '%v' of enclave '%v' failed:\n%v", hookResult.GetDescription(), enclaveIdentifier, hookResult.GetError())
+			continue
+		}
+		logrus.Infof("Ran shutdown hook '%v' of enclave '%v'", hookResult.GetDescription(), enclaveIdentifier)
+	}
+}
+
 func newEnclaveContextFromEnclaveInfo(
 	ctx context.Context,
 	portalClient portal_api.KurtosisPortalClientClient,
@@ -459,6 +642,8 @@ func newGetServiceLogsArgs(
 	userServiceUUIDs map[services.ServiceUUID]bool,
 	shouldFollowLogs bool,
 	logLineFilter *LogLineFilter,
+	since time.Time,
+	until time.Time,
 ) (*kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, error) {
 	userServiceUuuidSet := make(map[string]bool, len(userServiceUUIDs))
 
@@ -472,11 +657,26 @@ func newGetServiceLogsArgs(
 		return nil, stacktrace.Propagate(err, "An error occurred creating the GRPC conjunctive log line filters '%+v'", logLineFilter)
 	}
 
+	if shouldFollowLogs && !until.IsZero() {
+		return nil, stacktrace.NewError("Logs can't be followed until a given time; only the start of the time range can be set when following them")
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return nil, stacktrace.NewError("The end of the logs time range '%v' is before its start '%v'", until, since)
+	}
+
 	getUserServiceLogsArgs := &kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs{
 		EnclaveIdentifier:  enclaveIdentifier,
 		ServiceUuidSet:     userServiceUuuidSet,
 		FollowLogs:         shouldFollowLogs,
 		ConjunctiveFilters: grpcConjunctiveFilters,
+		Since:              nil,
+		Until:              nil,
+	}
+	if !since.IsZero() {
+		getUserServiceLogsArgs.Since = timestamppb.New(since)
+	}
+	if !until.IsZero() {
+		getUserServiceLogsArgs.Until = timestamppb.New(until)
 	}
 
 	return getUserServiceLogsArgs, nil
@@ -548,7 +748,15 @@ func newServiceLogsStreamContentFromGrpcStreamResponse(
 		notFoundServiceUuids[notFoundServiceUuid] = true
 	}
 
-	newServiceLogsStreamContentObj := newServiceLogsStreamContent(serviceLogsByServiceUuidMap, notFoundServiceUuids)
+	numDroppedLogLinesByServiceUuidStr := getServiceLogResponse.GetNumDroppedLogLinesByServiceUuid()
+
+	numDroppedLogLinesByServiceUuids := make(map[services.ServiceUUID]uint32, len(numDroppedLogLinesByServiceUuidStr))
+
+	for serviceUuidStr, numDroppedLogLines := range numDroppedLogLinesByServiceUuidStr {
+		numDroppedLogLinesByServiceUuids[services.ServiceUUID(serviceUuidStr)] = numDroppedLogLines
+	}
+
+	newServiceLogsStreamContentObj := newServiceLogsStreamContent(serviceLogsByServiceUuidMap, notFoundServiceUuids, numDroppedLogLinesByServiceUuids)
 
 	return newServiceLogsStreamContentObj
 }
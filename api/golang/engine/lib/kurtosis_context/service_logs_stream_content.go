@@ -6,15 +6,19 @@ import "github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 type serviceLogsStreamContent struct {
 	serviceLogsByServiceUuids map[services.ServiceUUID][]*ServiceLog
 	notFoundServiceUuids      map[services.ServiceUUID]bool
+	// Lines the engine dropped since the previous stream content, because they got produced faster than they were consumed
+	numDroppedLogLinesByServiceUuids map[services.ServiceUUID]uint32
 }
 
 func newServiceLogsStreamContent(
 	serviceLogsByServiceUuids map[services.ServiceUUID][]*ServiceLog,
 	notFoundServiceUuids map[services.ServiceUUID]bool,
+	numDroppedLogLinesByServiceUuids map[services.ServiceUUID]uint32,
 ) *serviceLogsStreamContent {
 	return &serviceLogsStreamContent{
-		serviceLogsByServiceUuids: serviceLogsByServiceUuids,
-		notFoundServiceUuids:      notFoundServiceUuids,
+		serviceLogsByServiceUuids:        serviceLogsByServiceUuids,
+		notFoundServiceUuids:             notFoundServiceUuids,
+		numDroppedLogLinesByServiceUuids: numDroppedLogLinesByServiceUuids,
 	}
 }
 
@@ -27,3 +31,8 @@ func (streamContent *serviceLogsStreamContent) GetServiceLogsByServiceUuids() ma
 func (streamContent *serviceLogsStreamContent) GetNotFoundServiceUuids() map[services.ServiceUUID]bool {
 	return streamContent.notFoundServiceUuids
 }
+
+// Docs available at https://docs.kurtosis.com/sdk#getnumdroppedloglinesbyserviceuuids---mapserviceuuid-number-numdroppedloglinesbyserviceuuids
+func (streamContent *serviceLogsStreamContent) GetNumDroppedLogLinesByServiceUuids() map[services.ServiceUUID]uint32 {
+	return streamContent.numDroppedLogLinesByServiceUuids
+}
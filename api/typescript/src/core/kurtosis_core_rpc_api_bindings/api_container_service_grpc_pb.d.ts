@@ -13,19 +13,44 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   startServices: grpc.MethodDefinition<api_container_service_pb.StartServicesArgs, api_container_service_pb.StartServicesResponse>;
   getServices: grpc.MethodDefinition<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
   getExistingAndHistoricalServiceIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
+  getServiceConfigs: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetServiceConfigsResponse>;
+  getScrapeTargets: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetScrapeTargetsResponse>;
+  getSubnetworkConnections: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetSubnetworkConnectionsResponse>;
   removeService: grpc.MethodDefinition<api_container_service_pb.RemoveServiceArgs, api_container_service_pb.RemoveServiceResponse>;
+  removeServices: grpc.MethodDefinition<api_container_service_pb.RemoveServicesArgs, api_container_service_pb.RemoveServicesResponse>;
   repartition: grpc.MethodDefinition<api_container_service_pb.RepartitionArgs, google_protobuf_empty_pb.Empty>;
+  addPartition: grpc.MethodDefinition<api_container_service_pb.AddPartitionArgs, google_protobuf_empty_pb.Empty>;
+  movePartition: grpc.MethodDefinition<api_container_service_pb.MovePartitionArgs, google_protobuf_empty_pb.Empty>;
+  updateConnection: grpc.MethodDefinition<api_container_service_pb.UpdateConnectionArgs, google_protobuf_empty_pb.Empty>;
+  testConnectivity: grpc.MethodDefinition<api_container_service_pb.TestConnectivityArgs, api_container_service_pb.TestConnectivityResponse>;
   execCommand: grpc.MethodDefinition<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
+  execCommandWithStreamedOutput: grpc.MethodDefinition<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandStreamedOutputChunk>;
   pauseService: grpc.MethodDefinition<api_container_service_pb.PauseServiceArgs, google_protobuf_empty_pb.Empty>;
   unpauseService: grpc.MethodDefinition<api_container_service_pb.UnpauseServiceArgs, google_protobuf_empty_pb.Empty>;
+  pauseServices: grpc.MethodDefinition<api_container_service_pb.PauseServicesArgs, api_container_service_pb.PauseServicesResponse>;
+  unpauseServices: grpc.MethodDefinition<api_container_service_pb.UnpauseServicesArgs, api_container_service_pb.UnpauseServicesResponse>;
+  waitForServiceExit: grpc.MethodDefinition<api_container_service_pb.WaitForServiceExitArgs, api_container_service_pb.WaitForServiceExitResponse>;
+  getServiceMetrics: grpc.MethodDefinition<api_container_service_pb.GetServiceMetricsArgs, api_container_service_pb.GetServiceMetricsResponse>;
   waitForHttpGetEndpointAvailability: grpc.MethodDefinition<api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
   waitForHttpPostEndpointAvailability: grpc.MethodDefinition<api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
   uploadFilesArtifact: grpc.MethodDefinition<api_container_service_pb.UploadFilesArtifactArgs, api_container_service_pb.UploadFilesArtifactResponse>;
+  uploadFilesArtifactStream: grpc.MethodDefinition<api_container_service_pb.FilesArtifactStreamChunk, api_container_service_pb.UploadFilesArtifactResponse>;
   downloadFilesArtifact: grpc.MethodDefinition<api_container_service_pb.DownloadFilesArtifactArgs, api_container_service_pb.DownloadFilesArtifactResponse>;
+  downloadFilesArtifactStream: grpc.MethodDefinition<api_container_service_pb.DownloadFilesArtifactArgs, api_container_service_pb.FilesArtifactStreamChunk>;
   storeWebFilesArtifact: grpc.MethodDefinition<api_container_service_pb.StoreWebFilesArtifactArgs, api_container_service_pb.StoreWebFilesArtifactResponse>;
   storeFilesArtifactFromService: grpc.MethodDefinition<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
   renderTemplatesToFilesArtifact: grpc.MethodDefinition<api_container_service_pb.RenderTemplatesToFilesArtifactArgs, api_container_service_pb.RenderTemplatesToFilesArtifactResponse>;
   listFilesArtifactNamesAndUuids: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
+  deleteFilesArtifact: grpc.MethodDefinition<api_container_service_pb.DeleteFilesArtifactArgs, google_protobuf_empty_pb.Empty>;
+  inspectFilesArtifactContents: grpc.MethodDefinition<api_container_service_pb.InspectFilesArtifactContentsArgs, api_container_service_pb.InspectFilesArtifactContentsResponse>;
+  setLogLevel: grpc.MethodDefinition<api_container_service_pb.SetLogLevelArgs, api_container_service_pb.SetLogLevelResponse>;
+  runShutdownHooks: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.RunShutdownHooksResponse>;
+  httpRequestService: grpc.MethodDefinition<api_container_service_pb.HttpRequestServiceArgs, api_container_service_pb.HttpRequestServiceResponse>;
+  getBackendCapabilities: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetBackendCapabilitiesResponse>;
+  getPackageCacheInfo: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.PackageCacheInfo>;
+  clearPackageCache: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ClearPackageCacheResponse>;
+  getStarlarkRunProfile: grpc.MethodDefinition<api_container_service_pb.GetStarlarkRunProfileArgs, api_container_service_pb.StarlarkRunProfile>;
+  getDeprecatedFeatures: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.GetDeprecatedFeaturesResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -36,19 +61,44 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   startServices: grpc.handleUnaryCall<api_container_service_pb.StartServicesArgs, api_container_service_pb.StartServicesResponse>;
   getServices: grpc.handleUnaryCall<api_container_service_pb.GetServicesArgs, api_container_service_pb.GetServicesResponse>;
   getExistingAndHistoricalServiceIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
+  getServiceConfigs: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetServiceConfigsResponse>;
+  getScrapeTargets: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetScrapeTargetsResponse>;
+  getSubnetworkConnections: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetSubnetworkConnectionsResponse>;
   removeService: grpc.handleUnaryCall<api_container_service_pb.RemoveServiceArgs, api_container_service_pb.RemoveServiceResponse>;
+  removeServices: grpc.handleUnaryCall<api_container_service_pb.RemoveServicesArgs, api_container_service_pb.RemoveServicesResponse>;
   repartition: grpc.handleUnaryCall<api_container_service_pb.RepartitionArgs, google_protobuf_empty_pb.Empty>;
+  addPartition: grpc.handleUnaryCall<api_container_service_pb.AddPartitionArgs, google_protobuf_empty_pb.Empty>;
+  movePartition: grpc.handleUnaryCall<api_container_service_pb.MovePartitionArgs, google_protobuf_empty_pb.Empty>;
+  updateConnection: grpc.handleUnaryCall<api_container_service_pb.UpdateConnectionArgs, google_protobuf_empty_pb.Empty>;
+  testConnectivity: grpc.handleUnaryCall<api_container_service_pb.TestConnectivityArgs, api_container_service_pb.TestConnectivityResponse>;
   execCommand: grpc.handleUnaryCall<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandResponse>;
+  execCommandWithStreamedOutput: grpc.handleServerStreamingCall<api_container_service_pb.ExecCommandArgs, api_container_service_pb.ExecCommandStreamedOutputChunk>;
   pauseService: grpc.handleUnaryCall<api_container_service_pb.PauseServiceArgs, google_protobuf_empty_pb.Empty>;
   unpauseService: grpc.handleUnaryCall<api_container_service_pb.UnpauseServiceArgs, google_protobuf_empty_pb.Empty>;
+  pauseServices: grpc.handleUnaryCall<api_container_service_pb.PauseServicesArgs, api_container_service_pb.PauseServicesResponse>;
+  unpauseServices: grpc.handleUnaryCall<api_container_service_pb.UnpauseServicesArgs, api_container_service_pb.UnpauseServicesResponse>;
+  waitForServiceExit: grpc.handleUnaryCall<api_container_service_pb.WaitForServiceExitArgs, api_container_service_pb.WaitForServiceExitResponse>;
+  getServiceMetrics: grpc.handleUnaryCall<api_container_service_pb.GetServiceMetricsArgs, api_container_service_pb.GetServiceMetricsResponse>;
   waitForHttpGetEndpointAvailability: grpc.handleUnaryCall<api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
   waitForHttpPostEndpointAvailability: grpc.handleUnaryCall<api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs, google_protobuf_empty_pb.Empty>;
   uploadFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.UploadFilesArtifactArgs, api_container_service_pb.UploadFilesArtifactResponse>;
+  uploadFilesArtifactStream: grpc.handleClientStreamingCall<api_container_service_pb.FilesArtifactStreamChunk, api_container_service_pb.UploadFilesArtifactResponse>;
   downloadFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.DownloadFilesArtifactArgs, api_container_service_pb.DownloadFilesArtifactResponse>;
+  downloadFilesArtifactStream: grpc.handleServerStreamingCall<api_container_service_pb.DownloadFilesArtifactArgs, api_container_service_pb.FilesArtifactStreamChunk>;
   storeWebFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.StoreWebFilesArtifactArgs, api_container_service_pb.StoreWebFilesArtifactResponse>;
   storeFilesArtifactFromService: grpc.handleUnaryCall<api_container_service_pb.StoreFilesArtifactFromServiceArgs, api_container_service_pb.StoreFilesArtifactFromServiceResponse>;
   renderTemplatesToFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.RenderTemplatesToFilesArtifactArgs, api_container_service_pb.RenderTemplatesToFilesArtifactResponse>;
   listFilesArtifactNamesAndUuids: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
+  deleteFilesArtifact: grpc.handleUnaryCall<api_container_service_pb.DeleteFilesArtifactArgs, google_protobuf_empty_pb.Empty>;
+  inspectFilesArtifactContents: grpc.handleUnaryCall<api_container_service_pb.InspectFilesArtifactContentsArgs, api_container_service_pb.InspectFilesArtifactContentsResponse>;
+  setLogLevel: grpc.handleUnaryCall<api_container_service_pb.SetLogLevelArgs, api_container_service_pb.SetLogLevelResponse>;
+  runShutdownHooks: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.RunShutdownHooksResponse>;
+  httpRequestService: grpc.handleUnaryCall<api_container_service_pb.HttpRequestServiceArgs, api_container_service_pb.HttpRequestServiceResponse>;
+  getBackendCapabilities: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetBackendCapabilitiesResponse>;
+  getPackageCacheInfo: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.PackageCacheInfo>;
+  clearPackageCache: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ClearPackageCacheResponse>;
+  getStarlarkRunProfile: grpc.handleUnaryCall<api_container_service_pb.GetStarlarkRunProfileArgs, api_container_service_pb.StarlarkRunProfile>;
+  getDeprecatedFeatures: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.GetDeprecatedFeaturesResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -66,21 +116,59 @@ export class ApiContainerServiceClient extends grpc.Client {
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
   getExistingAndHistoricalServiceIdentifiers(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>): grpc.ClientUnaryCall;
+  getServiceConfigs(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetServiceConfigsResponse>): grpc.ClientUnaryCall;
+  getServiceConfigs(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceConfigsResponse>): grpc.ClientUnaryCall;
+  getServiceConfigs(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceConfigsResponse>): grpc.ClientUnaryCall;
+  getScrapeTargets(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetScrapeTargetsResponse>): grpc.ClientUnaryCall;
+  getScrapeTargets(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetScrapeTargetsResponse>): grpc.ClientUnaryCall;
+  getScrapeTargets(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetScrapeTargetsResponse>): grpc.ClientUnaryCall;
+  getSubnetworkConnections(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetSubnetworkConnectionsResponse>): grpc.ClientUnaryCall;
+  getSubnetworkConnections(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetSubnetworkConnectionsResponse>): grpc.ClientUnaryCall;
+  getSubnetworkConnections(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetSubnetworkConnectionsResponse>): grpc.ClientUnaryCall;
   removeService(argument: api_container_service_pb.RemoveServiceArgs, callback: grpc.requestCallback<api_container_service_pb.RemoveServiceResponse>): grpc.ClientUnaryCall;
   removeService(argument: api_container_service_pb.RemoveServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RemoveServiceResponse>): grpc.ClientUnaryCall;
   removeService(argument: api_container_service_pb.RemoveServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RemoveServiceResponse>): grpc.ClientUnaryCall;
+  removeServices(argument: api_container_service_pb.RemoveServicesArgs, callback: grpc.requestCallback<api_container_service_pb.RemoveServicesResponse>): grpc.ClientUnaryCall;
+  removeServices(argument: api_container_service_pb.RemoveServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RemoveServicesResponse>): grpc.ClientUnaryCall;
+  removeServices(argument: api_container_service_pb.RemoveServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RemoveServicesResponse>): grpc.ClientUnaryCall;
   repartition(argument: api_container_service_pb.RepartitionArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   repartition(argument: api_container_service_pb.RepartitionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   repartition(argument: api_container_service_pb.RepartitionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  addPartition(argument: api_container_service_pb.AddPartitionArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  addPartition(argument: api_container_service_pb.AddPartitionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  addPartition(argument: api_container_service_pb.AddPartitionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  movePartition(argument: api_container_service_pb.MovePartitionArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  movePartition(argument: api_container_service_pb.MovePartitionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  movePartition(argument: api_container_service_pb.MovePartitionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateConnection(argument: api_container_service_pb.UpdateConnectionArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateConnection(argument: api_container_service_pb.UpdateConnectionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  updateConnection(argument: api_container_service_pb.UpdateConnectionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  testConnectivity(argument: api_container_service_pb.TestConnectivityArgs, callback: grpc.requestCallback<api_container_service_pb.TestConnectivityResponse>): grpc.ClientUnaryCall;
+  testConnectivity(argument: api_container_service_pb.TestConnectivityArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.TestConnectivityResponse>): grpc.ClientUnaryCall;
+  testConnectivity(argument: api_container_service_pb.TestConnectivityArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.TestConnectivityResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
   execCommand(argument: api_container_service_pb.ExecCommandArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ExecCommandResponse>): grpc.ClientUnaryCall;
+  execCommandWithStreamedOutput(argument: api_container_service_pb.ExecCommandArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ExecCommandStreamedOutputChunk>;
+  execCommandWithStreamedOutput(argument: api_container_service_pb.ExecCommandArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.ExecCommandStreamedOutputChunk>;
   pauseService(argument: api_container_service_pb.PauseServiceArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   pauseService(argument: api_container_service_pb.PauseServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   pauseService(argument: api_container_service_pb.PauseServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   unpauseService(argument: api_container_service_pb.UnpauseServiceArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   unpauseService(argument: api_container_service_pb.UnpauseServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   unpauseService(argument: api_container_service_pb.UnpauseServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  pauseServices(argument: api_container_service_pb.PauseServicesArgs, callback: grpc.requestCallback<api_container_service_pb.PauseServicesResponse>): grpc.ClientUnaryCall;
+  pauseServices(argument: api_container_service_pb.PauseServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PauseServicesResponse>): grpc.ClientUnaryCall;
+  pauseServices(argument: api_container_service_pb.PauseServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PauseServicesResponse>): grpc.ClientUnaryCall;
+  unpauseServices(argument: api_container_service_pb.UnpauseServicesArgs, callback: grpc.requestCallback<api_container_service_pb.UnpauseServicesResponse>): grpc.ClientUnaryCall;
+  unpauseServices(argument: api_container_service_pb.UnpauseServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.UnpauseServicesResponse>): grpc.ClientUnaryCall;
+  unpauseServices(argument: api_container_service_pb.UnpauseServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.UnpauseServicesResponse>): grpc.ClientUnaryCall;
+  waitForServiceExit(argument: api_container_service_pb.WaitForServiceExitArgs, callback: grpc.requestCallback<api_container_service_pb.WaitForServiceExitResponse>): grpc.ClientUnaryCall;
+  waitForServiceExit(argument: api_container_service_pb.WaitForServiceExitArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.WaitForServiceExitResponse>): grpc.ClientUnaryCall;
+  waitForServiceExit(argument: api_container_service_pb.WaitForServiceExitArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.WaitForServiceExitResponse>): grpc.ClientUnaryCall;
+  getServiceMetrics(argument: api_container_service_pb.GetServiceMetricsArgs, callback: grpc.requestCallback<api_container_service_pb.GetServiceMetricsResponse>): grpc.ClientUnaryCall;
+  getServiceMetrics(argument: api_container_service_pb.GetServiceMetricsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceMetricsResponse>): grpc.ClientUnaryCall;
+  getServiceMetrics(argument: api_container_service_pb.GetServiceMetricsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetServiceMetricsResponse>): grpc.ClientUnaryCall;
   waitForHttpGetEndpointAvailability(argument: api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   waitForHttpGetEndpointAvailability(argument: api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   waitForHttpGetEndpointAvailability(argument: api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
@@ -90,9 +178,14 @@ export class ApiContainerServiceClient extends grpc.Client {
   uploadFilesArtifact(argument: api_container_service_pb.UploadFilesArtifactArgs, callback: grpc.requestCallback<api_container_service_pb.UploadFilesArtifactResponse>): grpc.ClientUnaryCall;
   uploadFilesArtifact(argument: api_container_service_pb.UploadFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.UploadFilesArtifactResponse>): grpc.ClientUnaryCall;
   uploadFilesArtifact(argument: api_container_service_pb.UploadFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.UploadFilesArtifactResponse>): grpc.ClientUnaryCall;
+  uploadFilesArtifactStream(callback: grpc.requestCallback<api_container_service_pb.UploadFilesArtifactResponse>): grpc.ClientWritableStream<api_container_service_pb.FilesArtifactStreamChunk>;
+  uploadFilesArtifactStream(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.UploadFilesArtifactResponse>): grpc.ClientWritableStream<api_container_service_pb.FilesArtifactStreamChunk>;
+  uploadFilesArtifactStream(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.UploadFilesArtifactResponse>): grpc.ClientWritableStream<api_container_service_pb.FilesArtifactStreamChunk>;
   downloadFilesArtifact(argument: api_container_service_pb.DownloadFilesArtifactArgs, callback: grpc.requestCallback<api_container_service_pb.DownloadFilesArtifactResponse>): grpc.ClientUnaryCall;
   downloadFilesArtifact(argument: api_container_service_pb.DownloadFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.DownloadFilesArtifactResponse>): grpc.ClientUnaryCall;
   downloadFilesArtifact(argument: api_container_service_pb.DownloadFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.DownloadFilesArtifactResponse>): grpc.ClientUnaryCall;
+  downloadFilesArtifactStream(argument: api_container_service_pb.DownloadFilesArtifactArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.FilesArtifactStreamChunk>;
+  downloadFilesArtifactStream(argument: api_container_service_pb.DownloadFilesArtifactArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.FilesArtifactStreamChunk>;
   storeWebFilesArtifact(argument: api_container_service_pb.StoreWebFilesArtifactArgs, callback: grpc.requestCallback<api_container_service_pb.StoreWebFilesArtifactResponse>): grpc.ClientUnaryCall;
   storeWebFilesArtifact(argument: api_container_service_pb.StoreWebFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreWebFilesArtifactResponse>): grpc.ClientUnaryCall;
   storeWebFilesArtifact(argument: api_container_service_pb.StoreWebFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreWebFilesArtifactResponse>): grpc.ClientUnaryCall;
@@ -105,4 +198,34 @@ export class ApiContainerServiceClient extends grpc.Client {
   listFilesArtifactNamesAndUuids(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>): grpc.ClientUnaryCall;
   listFilesArtifactNamesAndUuids(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>): grpc.ClientUnaryCall;
   listFilesArtifactNamesAndUuids(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>): grpc.ClientUnaryCall;
+  deleteFilesArtifact(argument: api_container_service_pb.DeleteFilesArtifactArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  deleteFilesArtifact(argument: api_container_service_pb.DeleteFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  deleteFilesArtifact(argument: api_container_service_pb.DeleteFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsArgs, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
+  inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
+  inspectFilesArtifactContents(argument: api_container_service_pb.InspectFilesArtifactContentsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.InspectFilesArtifactContentsResponse>): grpc.ClientUnaryCall;
+  setLogLevel(argument: api_container_service_pb.SetLogLevelArgs, callback: grpc.requestCallback<api_container_service_pb.SetLogLevelResponse>): grpc.ClientUnaryCall;
+  setLogLevel(argument: api_container_service_pb.SetLogLevelArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.SetLogLevelResponse>): grpc.ClientUnaryCall;
+  setLogLevel(argument: api_container_service_pb.SetLogLevelArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.SetLogLevelResponse>): grpc.ClientUnaryCall;
+  runShutdownHooks(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.RunShutdownHooksResponse>): grpc.ClientUnaryCall;
+  runShutdownHooks(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunShutdownHooksResponse>): grpc.ClientUnaryCall;
+  runShutdownHooks(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunShutdownHooksResponse>): grpc.ClientUnaryCall;
+  httpRequestService(argument: api_container_service_pb.HttpRequestServiceArgs, callback: grpc.requestCallback<api_container_service_pb.HttpRequestServiceResponse>): grpc.ClientUnaryCall;
+  httpRequestService(argument: api_container_service_pb.HttpRequestServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.HttpRequestServiceResponse>): grpc.ClientUnaryCall;
+  httpRequestService(argument: api_container_service_pb.HttpRequestServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.HttpRequestServiceResponse>): grpc.ClientUnaryCall;
+  getBackendCapabilities(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetBackendCapabilitiesResponse>): grpc.ClientUnaryCall;
+  getBackendCapabilities(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetBackendCapabilitiesResponse>): grpc.ClientUnaryCall;
+  getBackendCapabilities(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetBackendCapabilitiesResponse>): grpc.ClientUnaryCall;
+  getPackageCacheInfo(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.PackageCacheInfo>): grpc.ClientUnaryCall;
+  getPackageCacheInfo(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PackageCacheInfo>): grpc.ClientUnaryCall;
+  getPackageCacheInfo(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.PackageCacheInfo>): grpc.ClientUnaryCall;
+  clearPackageCache(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.ClearPackageCacheResponse>): grpc.ClientUnaryCall;
+  clearPackageCache(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ClearPackageCacheResponse>): grpc.ClientUnaryCall;
+  clearPackageCache(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ClearPackageCacheResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunProfile(argument: api_container_service_pb.GetStarlarkRunProfileArgs, callback: grpc.requestCallback<api_container_service_pb.StarlarkRunProfile>): grpc.ClientUnaryCall;
+  getStarlarkRunProfile(argument: api_container_service_pb.GetStarlarkRunProfileArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StarlarkRunProfile>): grpc.ClientUnaryCall;
+  getStarlarkRunProfile(argument: api_container_service_pb.GetStarlarkRunProfileArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StarlarkRunProfile>): grpc.ClientUnaryCall;
+  getDeprecatedFeatures(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.GetDeprecatedFeaturesResponse>): grpc.ClientUnaryCall;
+  getDeprecatedFeatures(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetDeprecatedFeaturesResponse>): grpc.ClientUnaryCall;
+  getDeprecatedFeatures(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetDeprecatedFeaturesResponse>): grpc.ClientUnaryCall;
 }
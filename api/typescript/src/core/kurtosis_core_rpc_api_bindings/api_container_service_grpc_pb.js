@@ -4,6 +4,40 @@
 var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+
+function serialize_api_container_api_AddPartitionArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.AddPartitionArgs)) {
+    throw new Error('Expected argument of type api_container_api.AddPartitionArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_AddPartitionArgs(buffer_arg) {
+  return api_container_service_pb.AddPartitionArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_ClearPackageCacheResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.ClearPackageCacheResponse)) {
+    throw new Error('Expected argument of type api_container_api.ClearPackageCacheResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ClearPackageCacheResponse(buffer_arg) {
+  return api_container_service_pb.ClearPackageCacheResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_DeleteFilesArtifactArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.DeleteFilesArtifactArgs)) {
+    throw new Error('Expected argument of type api_container_api.DeleteFilesArtifactArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_DeleteFilesArtifactArgs(buffer_arg) {
+  return api_container_service_pb.DeleteFilesArtifactArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
 
 function serialize_api_container_api_DownloadFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.DownloadFilesArtifactArgs)) {
@@ -49,6 +83,50 @@ function deserialize_api_container_api_ExecCommandResponse(buffer_arg) {
   return api_container_service_pb.ExecCommandResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ExecCommandStreamedOutputChunk(arg) {
+  if (!(arg instanceof api_container_service_pb.ExecCommandStreamedOutputChunk)) {
+    throw new Error('Expected argument of type api_container_api.ExecCommandStreamedOutputChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ExecCommandStreamedOutputChunk(buffer_arg) {
+  return api_container_service_pb.ExecCommandStreamedOutputChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_FilesArtifactStreamChunk(arg) {
+  if (!(arg instanceof api_container_service_pb.FilesArtifactStreamChunk)) {
+    throw new Error('Expected argument of type api_container_api.FilesArtifactStreamChunk');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_FilesArtifactStreamChunk(buffer_arg) {
+  return api_container_service_pb.FilesArtifactStreamChunk.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetBackendCapabilitiesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetBackendCapabilitiesResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetBackendCapabilitiesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetBackendCapabilitiesResponse(buffer_arg) {
+  return api_container_service_pb.GetBackendCapabilitiesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetDeprecatedFeaturesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetDeprecatedFeaturesResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetDeprecatedFeaturesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetDeprecatedFeaturesResponse(buffer_arg) {
+  return api_container_service_pb.GetDeprecatedFeaturesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse)) {
     throw new Error('Expected argument of type api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse');
@@ -60,6 +138,50 @@ function deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifier
   return api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetScrapeTargetsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetScrapeTargetsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetScrapeTargetsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetScrapeTargetsResponse(buffer_arg) {
+  return api_container_service_pb.GetScrapeTargetsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceConfigsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceConfigsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceConfigsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceConfigsResponse(buffer_arg) {
+  return api_container_service_pb.GetServiceConfigsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceMetricsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceMetricsArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceMetricsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceMetricsArgs(buffer_arg) {
+  return api_container_service_pb.GetServiceMetricsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetServiceMetricsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetServiceMetricsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetServiceMetricsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetServiceMetricsResponse(buffer_arg) {
+  return api_container_service_pb.GetServiceMetricsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.GetServicesArgs)) {
     throw new Error('Expected argument of type api_container_api.GetServicesArgs');
@@ -82,6 +204,72 @@ function deserialize_api_container_api_GetServicesResponse(buffer_arg) {
   return api_container_service_pb.GetServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetStarlarkRunProfileArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetStarlarkRunProfileArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetStarlarkRunProfileArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetStarlarkRunProfileArgs(buffer_arg) {
+  return api_container_service_pb.GetStarlarkRunProfileArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetSubnetworkConnectionsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetSubnetworkConnectionsResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetSubnetworkConnectionsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetSubnetworkConnectionsResponse(buffer_arg) {
+  return api_container_service_pb.GetSubnetworkConnectionsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_HttpRequestServiceArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.HttpRequestServiceArgs)) {
+    throw new Error('Expected argument of type api_container_api.HttpRequestServiceArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_HttpRequestServiceArgs(buffer_arg) {
+  return api_container_service_pb.HttpRequestServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_HttpRequestServiceResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.HttpRequestServiceResponse)) {
+    throw new Error('Expected argument of type api_container_api.HttpRequestServiceResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_HttpRequestServiceResponse(buffer_arg) {
+  return api_container_service_pb.HttpRequestServiceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_InspectFilesArtifactContentsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.InspectFilesArtifactContentsArgs)) {
+    throw new Error('Expected argument of type api_container_api.InspectFilesArtifactContentsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_InspectFilesArtifactContentsArgs(buffer_arg) {
+  return api_container_service_pb.InspectFilesArtifactContentsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_InspectFilesArtifactContentsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.InspectFilesArtifactContentsResponse)) {
+    throw new Error('Expected argument of type api_container_api.InspectFilesArtifactContentsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_InspectFilesArtifactContentsResponse(buffer_arg) {
+  return api_container_service_pb.InspectFilesArtifactContentsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse(arg) {
   if (!(arg instanceof api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse)) {
     throw new Error('Expected argument of type api_container_api.ListFilesArtifactNamesAndUuidsResponse');
@@ -93,6 +281,28 @@ function deserialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse(bu
   return api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_MovePartitionArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.MovePartitionArgs)) {
+    throw new Error('Expected argument of type api_container_api.MovePartitionArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_MovePartitionArgs(buffer_arg) {
+  return api_container_service_pb.MovePartitionArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_PackageCacheInfo(arg) {
+  if (!(arg instanceof api_container_service_pb.PackageCacheInfo)) {
+    throw new Error('Expected argument of type api_container_api.PackageCacheInfo');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_PackageCacheInfo(buffer_arg) {
+  return api_container_service_pb.PackageCacheInfo.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_PauseServiceArgs(arg) {
   if (!(arg instanceof api_container_service_pb.PauseServiceArgs)) {
     throw new Error('Expected argument of type api_container_api.PauseServiceArgs');
@@ -104,6 +314,28 @@ function deserialize_api_container_api_PauseServiceArgs(buffer_arg) {
   return api_container_service_pb.PauseServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_PauseServicesArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.PauseServicesArgs)) {
+    throw new Error('Expected argument of type api_container_api.PauseServicesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_PauseServicesArgs(buffer_arg) {
+  return api_container_service_pb.PauseServicesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_PauseServicesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.PauseServicesResponse)) {
+    throw new Error('Expected argument of type api_container_api.PauseServicesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_PauseServicesResponse(buffer_arg) {
+  return api_container_service_pb.PauseServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RemoveServiceArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RemoveServiceArgs)) {
     throw new Error('Expected argument of type api_container_api.RemoveServiceArgs');
@@ -126,6 +358,28 @@ function deserialize_api_container_api_RemoveServiceResponse(buffer_arg) {
   return api_container_service_pb.RemoveServiceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RemoveServicesArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RemoveServicesArgs)) {
+    throw new Error('Expected argument of type api_container_api.RemoveServicesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RemoveServicesArgs(buffer_arg) {
+  return api_container_service_pb.RemoveServicesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RemoveServicesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RemoveServicesResponse)) {
+    throw new Error('Expected argument of type api_container_api.RemoveServicesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RemoveServicesResponse(buffer_arg) {
+  return api_container_service_pb.RemoveServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RenderTemplatesToFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RenderTemplatesToFilesArtifactArgs)) {
     throw new Error('Expected argument of type api_container_api.RenderTemplatesToFilesArtifactArgs');
@@ -159,6 +413,17 @@ function deserialize_api_container_api_RepartitionArgs(buffer_arg) {
   return api_container_service_pb.RepartitionArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RunShutdownHooksResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RunShutdownHooksResponse)) {
+    throw new Error('Expected argument of type api_container_api.RunShutdownHooksResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunShutdownHooksResponse(buffer_arg) {
+  return api_container_service_pb.RunShutdownHooksResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkPackageArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkPackageArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkPackageArgs');
@@ -181,6 +446,39 @@ function deserialize_api_container_api_RunStarlarkScriptArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkScriptArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_SetLogLevelArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.SetLogLevelArgs)) {
+    throw new Error('Expected argument of type api_container_api.SetLogLevelArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_SetLogLevelArgs(buffer_arg) {
+  return api_container_service_pb.SetLogLevelArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_SetLogLevelResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.SetLogLevelResponse)) {
+    throw new Error('Expected argument of type api_container_api.SetLogLevelResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_SetLogLevelResponse(buffer_arg) {
+  return api_container_service_pb.SetLogLevelResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_StarlarkRunProfile(arg) {
+  if (!(arg instanceof api_container_service_pb.StarlarkRunProfile)) {
+    throw new Error('Expected argument of type api_container_api.StarlarkRunProfile');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_StarlarkRunProfile(buffer_arg) {
+  return api_container_service_pb.StarlarkRunProfile.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_StarlarkRunResponseLine(arg) {
   if (!(arg instanceof api_container_service_pb.StarlarkRunResponseLine)) {
     throw new Error('Expected argument of type api_container_api.StarlarkRunResponseLine');
@@ -258,6 +556,28 @@ function deserialize_api_container_api_StoreWebFilesArtifactResponse(buffer_arg)
   return api_container_service_pb.StoreWebFilesArtifactResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_TestConnectivityArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.TestConnectivityArgs)) {
+    throw new Error('Expected argument of type api_container_api.TestConnectivityArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_TestConnectivityArgs(buffer_arg) {
+  return api_container_service_pb.TestConnectivityArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_TestConnectivityResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.TestConnectivityResponse)) {
+    throw new Error('Expected argument of type api_container_api.TestConnectivityResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_TestConnectivityResponse(buffer_arg) {
+  return api_container_service_pb.TestConnectivityResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_UnpauseServiceArgs(arg) {
   if (!(arg instanceof api_container_service_pb.UnpauseServiceArgs)) {
     throw new Error('Expected argument of type api_container_api.UnpauseServiceArgs');
@@ -269,6 +589,39 @@ function deserialize_api_container_api_UnpauseServiceArgs(buffer_arg) {
   return api_container_service_pb.UnpauseServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_UnpauseServicesArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.UnpauseServicesArgs)) {
+    throw new Error('Expected argument of type api_container_api.UnpauseServicesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_UnpauseServicesArgs(buffer_arg) {
+  return api_container_service_pb.UnpauseServicesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_UnpauseServicesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.UnpauseServicesResponse)) {
+    throw new Error('Expected argument of type api_container_api.UnpauseServicesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_UnpauseServicesResponse(buffer_arg) {
+  return api_container_service_pb.UnpauseServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_UpdateConnectionArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.UpdateConnectionArgs)) {
+    throw new Error('Expected argument of type api_container_api.UpdateConnectionArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_UpdateConnectionArgs(buffer_arg) {
+  return api_container_service_pb.UpdateConnectionArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_UploadFilesArtifactArgs(arg) {
   if (!(arg instanceof api_container_service_pb.UploadFilesArtifactArgs)) {
     throw new Error('Expected argument of type api_container_api.UploadFilesArtifactArgs');
@@ -313,6 +666,28 @@ function deserialize_api_container_api_WaitForHttpPostEndpointAvailabilityArgs(b
   return api_container_service_pb.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_WaitForServiceExitArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.WaitForServiceExitArgs)) {
+    throw new Error('Expected argument of type api_container_api.WaitForServiceExitArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_WaitForServiceExitArgs(buffer_arg) {
+  return api_container_service_pb.WaitForServiceExitArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_WaitForServiceExitResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.WaitForServiceExitResponse)) {
+    throw new Error('Expected argument of type api_container_api.WaitForServiceExitResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_WaitForServiceExitResponse(buffer_arg) {
+  return api_container_service_pb.WaitForServiceExitResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_google_protobuf_Empty(arg) {
   if (!(arg instanceof google_protobuf_empty_pb.Empty)) {
     throw new Error('Expected argument of type google.protobuf.Empty');
@@ -386,6 +761,42 @@ getExistingAndHistoricalServiceIdentifiers: {
     responseSerialize: serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse,
     responseDeserialize: deserialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse,
   },
+  // Returns the configs the current services in the enclave were started with
+getServiceConfigs: {
+    path: '/api_container_api.ApiContainerService/GetServiceConfigs',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetServiceConfigsResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetServiceConfigsResponse,
+    responseDeserialize: deserialize_api_container_api_GetServiceConfigsResponse,
+  },
+  // Returns the endpoints to scrape the Prometheus metrics of the services in the enclave from
+getScrapeTargets: {
+    path: '/api_container_api.ApiContainerService/GetScrapeTargets',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetScrapeTargetsResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetScrapeTargetsResponse,
+    responseDeserialize: deserialize_api_container_api_GetScrapeTargetsResponse,
+  },
+  // Returns the default connection between subnetworks, and the connections between subnetworks that override it
+getSubnetworkConnections: {
+    path: '/api_container_api.ApiContainerService/GetSubnetworkConnections',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetSubnetworkConnectionsResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetSubnetworkConnectionsResponse,
+    responseDeserialize: deserialize_api_container_api_GetSubnetworkConnectionsResponse,
+  },
   // Instructs the API container to remove the given service
 removeService: {
     path: '/api_container_api.ApiContainerService/RemoveService',
@@ -398,6 +809,19 @@ removeService: {
     responseSerialize: serialize_api_container_api_RemoveServiceResponse,
     responseDeserialize: deserialize_api_container_api_RemoveServiceResponse,
   },
+  // Instructs the API container to remove the given services, the services matching the given name patterns, or all
+// the services, at once
+removeServices: {
+    path: '/api_container_api.ApiContainerService/RemoveServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RemoveServicesArgs,
+    responseType: api_container_service_pb.RemoveServicesResponse,
+    requestSerialize: serialize_api_container_api_RemoveServicesArgs,
+    requestDeserialize: deserialize_api_container_api_RemoveServicesArgs,
+    responseSerialize: serialize_api_container_api_RemoveServicesResponse,
+    responseDeserialize: deserialize_api_container_api_RemoveServicesResponse,
+  },
   // Instructs the API container to repartition the enclave
 repartition: {
     path: '/api_container_api.ApiContainerService/Repartition',
@@ -410,6 +834,58 @@ repartition: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Instructs the API container to create a new partition and move the given services to it, only updating the
+//  services whose connections change
+addPartition: {
+    path: '/api_container_api.ApiContainerService/AddPartition',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.AddPartitionArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_AddPartitionArgs,
+    requestDeserialize: deserialize_api_container_api_AddPartitionArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Instructs the API container to move the given services to an existing partition, only updating the services whose
+//  connections change
+movePartition: {
+    path: '/api_container_api.ApiContainerService/MovePartition',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.MovePartitionArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_MovePartitionArgs,
+    requestDeserialize: deserialize_api_container_api_MovePartitionArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Instructs the API container to update the connection between two partitions, only updating the services in those
+//  partitions
+updateConnection: {
+    path: '/api_container_api.ApiContainerService/UpdateConnection',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.UpdateConnectionArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_UpdateConnectionArgs,
+    requestDeserialize: deserialize_api_container_api_UpdateConnectionArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Pings every given service from every other one and returns the observed connection of every pair along with the
+//  connection the partition topology expects, to find blocked or slow paths
+testConnectivity: {
+    path: '/api_container_api.ApiContainerService/TestConnectivity',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.TestConnectivityArgs,
+    responseType: api_container_service_pb.TestConnectivityResponse,
+    requestSerialize: serialize_api_container_api_TestConnectivityArgs,
+    requestDeserialize: deserialize_api_container_api_TestConnectivityArgs,
+    responseSerialize: serialize_api_container_api_TestConnectivityResponse,
+    responseDeserialize: deserialize_api_container_api_TestConnectivityResponse,
+  },
   // Executes the given command inside a running container
 execCommand: {
     path: '/api_container_api.ApiContainerService/ExecCommand',
@@ -422,6 +898,19 @@ execCommand: {
     responseSerialize: serialize_api_container_api_ExecCommandResponse,
     responseDeserialize: deserialize_api_container_api_ExecCommandResponse,
   },
+  // Executes the given command inside a running container, streaming its output back in chunks followed by its exit
+// code, so commands with outputs too big to be held in memory can be run
+execCommandWithStreamedOutput: {
+    path: '/api_container_api.ApiContainerService/ExecCommandWithStreamedOutput',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.ExecCommandArgs,
+    responseType: api_container_service_pb.ExecCommandStreamedOutputChunk,
+    requestSerialize: serialize_api_container_api_ExecCommandArgs,
+    requestDeserialize: deserialize_api_container_api_ExecCommandArgs,
+    responseSerialize: serialize_api_container_api_ExecCommandStreamedOutputChunk,
+    responseDeserialize: deserialize_api_container_api_ExecCommandStreamedOutputChunk,
+  },
   // Pauses all processes running in the service container
 pauseService: {
     path: '/api_container_api.ApiContainerService/PauseService',
@@ -446,6 +935,54 @@ unpauseService: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Pauses all processes running in the containers of the given services, or of all the services, at once
+pauseServices: {
+    path: '/api_container_api.ApiContainerService/PauseServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.PauseServicesArgs,
+    responseType: api_container_service_pb.PauseServicesResponse,
+    requestSerialize: serialize_api_container_api_PauseServicesArgs,
+    requestDeserialize: deserialize_api_container_api_PauseServicesArgs,
+    responseSerialize: serialize_api_container_api_PauseServicesResponse,
+    responseDeserialize: deserialize_api_container_api_PauseServicesResponse,
+  },
+  // Unpauses all paused processes running in the containers of the given services, or of all the services, at once
+unpauseServices: {
+    path: '/api_container_api.ApiContainerService/UnpauseServices',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.UnpauseServicesArgs,
+    responseType: api_container_service_pb.UnpauseServicesResponse,
+    requestSerialize: serialize_api_container_api_UnpauseServicesArgs,
+    requestDeserialize: deserialize_api_container_api_UnpauseServicesArgs,
+    responseSerialize: serialize_api_container_api_UnpauseServicesResponse,
+    responseDeserialize: deserialize_api_container_api_UnpauseServicesResponse,
+  },
+  // Blocks until the container of the service exits, e.g. a service running a test suite, returning its exit code
+waitForServiceExit: {
+    path: '/api_container_api.ApiContainerService/WaitForServiceExit',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.WaitForServiceExitArgs,
+    responseType: api_container_service_pb.WaitForServiceExitResponse,
+    requestSerialize: serialize_api_container_api_WaitForServiceExitArgs,
+    requestDeserialize: deserialize_api_container_api_WaitForServiceExitArgs,
+    responseSerialize: serialize_api_container_api_WaitForServiceExitResponse,
+    responseDeserialize: deserialize_api_container_api_WaitForServiceExitResponse,
+  },
+  // Gets a snapshot of the CPU, memory and network usage of the service container
+getServiceMetrics: {
+    path: '/api_container_api.ApiContainerService/GetServiceMetrics',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetServiceMetricsArgs,
+    responseType: api_container_service_pb.GetServiceMetricsResponse,
+    requestSerialize: serialize_api_container_api_GetServiceMetricsArgs,
+    requestDeserialize: deserialize_api_container_api_GetServiceMetricsArgs,
+    responseSerialize: serialize_api_container_api_GetServiceMetricsResponse,
+    responseDeserialize: deserialize_api_container_api_GetServiceMetricsResponse,
+  },
   // Block until the given HTTP endpoint returns available, calling it through a HTTP Get request
 waitForHttpGetEndpointAvailability: {
     path: '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
@@ -482,8 +1019,19 @@ uploadFilesArtifact: {
     responseSerialize: serialize_api_container_api_UploadFilesArtifactResponse,
     responseDeserialize: deserialize_api_container_api_UploadFilesArtifactResponse,
   },
-  // TODO Make this a server-side streaming method so the client can download large files
-// Downloads a files artifact from the Kurtosis File System
+  // Uploads a files artifact to the Kurtosis File System in chunks, so that it can be bigger than the gRPC message limit
+uploadFilesArtifactStream: {
+    path: '/api_container_api.ApiContainerService/UploadFilesArtifactStream',
+    requestStream: true,
+    responseStream: false,
+    requestType: api_container_service_pb.FilesArtifactStreamChunk,
+    responseType: api_container_service_pb.UploadFilesArtifactResponse,
+    requestSerialize: serialize_api_container_api_FilesArtifactStreamChunk,
+    requestDeserialize: deserialize_api_container_api_FilesArtifactStreamChunk,
+    responseSerialize: serialize_api_container_api_UploadFilesArtifactResponse,
+    responseDeserialize: deserialize_api_container_api_UploadFilesArtifactResponse,
+  },
+  // Downloads a files artifact from the Kurtosis File System
 downloadFilesArtifact: {
     path: '/api_container_api.ApiContainerService/DownloadFilesArtifact',
     requestStream: false,
@@ -495,6 +1043,18 @@ downloadFilesArtifact: {
     responseSerialize: serialize_api_container_api_DownloadFilesArtifactResponse,
     responseDeserialize: deserialize_api_container_api_DownloadFilesArtifactResponse,
   },
+  // Downloads a files artifact from the Kurtosis File System in chunks, so that it can be bigger than the gRPC message limit
+downloadFilesArtifactStream: {
+    path: '/api_container_api.ApiContainerService/DownloadFilesArtifactStream',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.DownloadFilesArtifactArgs,
+    responseType: api_container_service_pb.FilesArtifactStreamChunk,
+    requestSerialize: serialize_api_container_api_DownloadFilesArtifactArgs,
+    requestDeserialize: deserialize_api_container_api_DownloadFilesArtifactArgs,
+    responseSerialize: serialize_api_container_api_FilesArtifactStreamChunk,
+    responseDeserialize: deserialize_api_container_api_FilesArtifactStreamChunk,
+  },
   // Tells the API container to download a files artifact from the web to the Kurtosis File System
 storeWebFilesArtifact: {
     path: '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
@@ -542,6 +1102,129 @@ renderTemplatesToFilesArtifact: {
     responseSerialize: serialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse,
     responseDeserialize: deserialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse,
   },
+  // Deletes a files artifact from the Kurtosis File System, unless it's mounted on services
+deleteFilesArtifact: {
+    path: '/api_container_api.ApiContainerService/DeleteFilesArtifact',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.DeleteFilesArtifactArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_DeleteFilesArtifactArgs,
+    requestDeserialize: deserialize_api_container_api_DeleteFilesArtifactArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Lists the files inside a files artifact, without having to download it
+inspectFilesArtifactContents: {
+    path: '/api_container_api.ApiContainerService/InspectFilesArtifactContents',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.InspectFilesArtifactContentsArgs,
+    responseType: api_container_service_pb.InspectFilesArtifactContentsResponse,
+    requestSerialize: serialize_api_container_api_InspectFilesArtifactContentsArgs,
+    requestDeserialize: deserialize_api_container_api_InspectFilesArtifactContentsArgs,
+    responseSerialize: serialize_api_container_api_InspectFilesArtifactContentsResponse,
+    responseDeserialize: deserialize_api_container_api_InspectFilesArtifactContentsResponse,
+  },
+  // Changes the log level of the API container at runtime, e.g. to temporarily debug an issue without restarting it
+setLogLevel: {
+    path: '/api_container_api.ApiContainerService/SetLogLevel',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.SetLogLevelArgs,
+    responseType: api_container_service_pb.SetLogLevelResponse,
+    requestSerialize: serialize_api_container_api_SetLogLevelArgs,
+    requestDeserialize: deserialize_api_container_api_SetLogLevelArgs,
+    responseSerialize: serialize_api_container_api_SetLogLevelResponse,
+    responseDeserialize: deserialize_api_container_api_SetLogLevelResponse,
+  },
+  // Runs the shutdown hooks registered by the Starlark code run in the enclave, which should happen right before the
+// enclave gets stopped or destroyed. Each hook is only ever run once
+runShutdownHooks: {
+    path: '/api_container_api.ApiContainerService/RunShutdownHooks',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.RunShutdownHooksResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_RunShutdownHooksResponse,
+    responseDeserialize: deserialize_api_container_api_RunShutdownHooksResponse,
+  },
+  // Makes an HTTP request to a private port of a service from inside the enclave network, e.g. to probe a service whose
+// ports aren't published without needing an HTTP client in its image
+httpRequestService: {
+    path: '/api_container_api.ApiContainerService/HttpRequestService',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.HttpRequestServiceArgs,
+    responseType: api_container_service_pb.HttpRequestServiceResponse,
+    requestSerialize: serialize_api_container_api_HttpRequestServiceArgs,
+    requestDeserialize: deserialize_api_container_api_HttpRequestServiceArgs,
+    responseSerialize: serialize_api_container_api_HttpRequestServiceResponse,
+    responseDeserialize: deserialize_api_container_api_HttpRequestServiceResponse,
+  },
+  // Tells which of the optional features of Kurtosis the backend of the enclave supports, so that clients can fail
+// upfront when asked for one that isn't supported
+getBackendCapabilities: {
+    path: '/api_container_api.ApiContainerService/GetBackendCapabilities',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetBackendCapabilitiesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetBackendCapabilitiesResponse,
+    responseDeserialize: deserialize_api_container_api_GetBackendCapabilitiesResponse,
+  },
+  // Lists the packages cloned into the enclave, along with the size limit of the package cache and how often it gets hit
+getPackageCacheInfo: {
+    path: '/api_container_api.ApiContainerService/GetPackageCacheInfo',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.PackageCacheInfo,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_PackageCacheInfo,
+    responseDeserialize: deserialize_api_container_api_PackageCacheInfo,
+  },
+  // Removes all the packages cloned into the enclave, which will get cloned again the next time they're used
+clearPackageCache: {
+    path: '/api_container_api.ApiContainerService/ClearPackageCache',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.ClearPackageCacheResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_ClearPackageCacheResponse,
+    responseDeserialize: deserialize_api_container_api_ClearPackageCacheResponse,
+  },
+  // Returns how long each instruction of a past Starlark run took and what it created, for profiling slow packages
+getStarlarkRunProfile: {
+    path: '/api_container_api.ApiContainerService/GetStarlarkRunProfile',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetStarlarkRunProfileArgs,
+    responseType: api_container_service_pb.StarlarkRunProfile,
+    requestSerialize: serialize_api_container_api_GetStarlarkRunProfileArgs,
+    requestDeserialize: deserialize_api_container_api_GetStarlarkRunProfileArgs,
+    responseSerialize: serialize_api_container_api_StarlarkRunProfile,
+    responseDeserialize: deserialize_api_container_api_StarlarkRunProfile,
+  },
+  // Lists the Starlark features deprecated in this version of Kurtosis, so that SDKs can warn before using them
+getDeprecatedFeatures: {
+    path: '/api_container_api.ApiContainerService/GetDeprecatedFeatures',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.GetDeprecatedFeaturesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_GetDeprecatedFeaturesResponse,
+    responseDeserialize: deserialize_api_container_api_GetDeprecatedFeaturesResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
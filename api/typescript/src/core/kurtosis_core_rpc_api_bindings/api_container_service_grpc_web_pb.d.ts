@@ -40,6 +40,27 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
 
+  getServiceConfigs(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetServiceConfigsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServiceConfigsResponse>;
+
+  getScrapeTargets(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetScrapeTargetsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetScrapeTargetsResponse>;
+
+  getSubnetworkConnections(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetSubnetworkConnectionsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetSubnetworkConnectionsResponse>;
+
   removeService(
     request: api_container_service_pb.RemoveServiceArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -47,6 +68,13 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.RemoveServiceResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.RemoveServiceResponse>;
 
+  removeServices(
+    request: api_container_service_pb.RemoveServicesArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.RemoveServicesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.RemoveServicesResponse>;
+
   repartition(
     request: api_container_service_pb.RepartitionArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -54,6 +82,34 @@ export class ApiContainerServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  addPartition(
+    request: api_container_service_pb.AddPartitionArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  movePartition(
+    request: api_container_service_pb.MovePartitionArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  updateConnection(
+    request: api_container_service_pb.UpdateConnectionArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  testConnectivity(
+    request: api_container_service_pb.TestConnectivityArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.TestConnectivityResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.TestConnectivityResponse>;
+
   execCommand(
     request: api_container_service_pb.ExecCommandArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -61,6 +117,11 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.ExecCommandResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.ExecCommandResponse>;
 
+  execCommandWithStreamedOutput(
+    request: api_container_service_pb.ExecCommandArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ExecCommandStreamedOutputChunk>;
+
   pauseService(
     request: api_container_service_pb.PauseServiceArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -75,6 +136,34 @@ export class ApiContainerServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  pauseServices(
+    request: api_container_service_pb.PauseServicesArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.PauseServicesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.PauseServicesResponse>;
+
+  unpauseServices(
+    request: api_container_service_pb.UnpauseServicesArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.UnpauseServicesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.UnpauseServicesResponse>;
+
+  waitForServiceExit(
+    request: api_container_service_pb.WaitForServiceExitArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.WaitForServiceExitResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.WaitForServiceExitResponse>;
+
+  getServiceMetrics(
+    request: api_container_service_pb.GetServiceMetricsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetServiceMetricsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetServiceMetricsResponse>;
+
   waitForHttpGetEndpointAvailability(
     request: api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -103,6 +192,11 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.DownloadFilesArtifactResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.DownloadFilesArtifactResponse>;
 
+  downloadFilesArtifactStream(
+    request: api_container_service_pb.DownloadFilesArtifactArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.FilesArtifactStreamChunk>;
+
   storeWebFilesArtifact(
     request: api_container_service_pb.StoreWebFilesArtifactArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -131,6 +225,76 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
 
+  deleteFilesArtifact(
+    request: api_container_service_pb.DeleteFilesArtifactArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  inspectFilesArtifactContents(
+    request: api_container_service_pb.InspectFilesArtifactContentsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.InspectFilesArtifactContentsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.InspectFilesArtifactContentsResponse>;
+
+  setLogLevel(
+    request: api_container_service_pb.SetLogLevelArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.SetLogLevelResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.SetLogLevelResponse>;
+
+  runShutdownHooks(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.RunShutdownHooksResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.RunShutdownHooksResponse>;
+
+  httpRequestService(
+    request: api_container_service_pb.HttpRequestServiceArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.HttpRequestServiceResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.HttpRequestServiceResponse>;
+
+  getBackendCapabilities(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetBackendCapabilitiesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetBackendCapabilitiesResponse>;
+
+  getPackageCacheInfo(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.PackageCacheInfo) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.PackageCacheInfo>;
+
+  clearPackageCache(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.ClearPackageCacheResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ClearPackageCacheResponse>;
+
+  getStarlarkRunProfile(
+    request: api_container_service_pb.GetStarlarkRunProfileArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.StarlarkRunProfile) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunProfile>;
+
+  getDeprecatedFeatures(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetDeprecatedFeaturesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetDeprecatedFeaturesResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -163,21 +327,66 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse>;
 
+  getServiceConfigs(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetServiceConfigsResponse>;
+
+  getScrapeTargets(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetScrapeTargetsResponse>;
+
+  getSubnetworkConnections(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetSubnetworkConnectionsResponse>;
+
   removeService(
     request: api_container_service_pb.RemoveServiceArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.RemoveServiceResponse>;
 
+  removeServices(
+    request: api_container_service_pb.RemoveServicesArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.RemoveServicesResponse>;
+
   repartition(
     request: api_container_service_pb.RepartitionArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  addPartition(
+    request: api_container_service_pb.AddPartitionArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  movePartition(
+    request: api_container_service_pb.MovePartitionArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  updateConnection(
+    request: api_container_service_pb.UpdateConnectionArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  testConnectivity(
+    request: api_container_service_pb.TestConnectivityArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.TestConnectivityResponse>;
+
   execCommand(
     request: api_container_service_pb.ExecCommandArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.ExecCommandResponse>;
 
+  execCommandWithStreamedOutput(
+    request: api_container_service_pb.ExecCommandArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ExecCommandStreamedOutputChunk>;
+
   pauseService(
     request: api_container_service_pb.PauseServiceArgs,
     metadata?: grpcWeb.Metadata
@@ -188,6 +397,26 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  pauseServices(
+    request: api_container_service_pb.PauseServicesArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.PauseServicesResponse>;
+
+  unpauseServices(
+    request: api_container_service_pb.UnpauseServicesArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.UnpauseServicesResponse>;
+
+  waitForServiceExit(
+    request: api_container_service_pb.WaitForServiceExitArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.WaitForServiceExitResponse>;
+
+  getServiceMetrics(
+    request: api_container_service_pb.GetServiceMetricsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetServiceMetricsResponse>;
+
   waitForHttpGetEndpointAvailability(
     request: api_container_service_pb.WaitForHttpGetEndpointAvailabilityArgs,
     metadata?: grpcWeb.Metadata
@@ -208,6 +437,11 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.DownloadFilesArtifactResponse>;
 
+  downloadFilesArtifactStream(
+    request: api_container_service_pb.DownloadFilesArtifactArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.FilesArtifactStreamChunk>;
+
   storeWebFilesArtifact(
     request: api_container_service_pb.StoreWebFilesArtifactArgs,
     metadata?: grpcWeb.Metadata
@@ -228,5 +462,55 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
 
+  deleteFilesArtifact(
+    request: api_container_service_pb.DeleteFilesArtifactArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  inspectFilesArtifactContents(
+    request: api_container_service_pb.InspectFilesArtifactContentsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.InspectFilesArtifactContentsResponse>;
+
+  setLogLevel(
+    request: api_container_service_pb.SetLogLevelArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.SetLogLevelResponse>;
+
+  runShutdownHooks(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.RunShutdownHooksResponse>;
+
+  httpRequestService(
+    request: api_container_service_pb.HttpRequestServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.HttpRequestServiceResponse>;
+
+  getBackendCapabilities(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetBackendCapabilitiesResponse>;
+
+  getPackageCacheInfo(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.PackageCacheInfo>;
+
+  clearPackageCache(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.ClearPackageCacheResponse>;
+
+  getStarlarkRunProfile(
+    request: api_container_service_pb.GetStarlarkRunProfileArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.StarlarkRunProfile>;
+
+  getDeprecatedFeatures(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetDeprecatedFeaturesResponse>;
+
 }
 
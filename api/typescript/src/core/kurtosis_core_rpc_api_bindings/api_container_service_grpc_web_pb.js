@@ -21,6 +21,8 @@ grpc.web = require('grpc-web');
 
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js')
+
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js')
 const proto = {};
 proto.api_container_api = require('./api_container_service_pb.js');
 
@@ -374,321 +376,321 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.getExistingAn
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.RemoveServiceArgs,
- *   !proto.api_container_api.RemoveServiceResponse>}
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetServiceConfigsResponse>}
  */
-const methodDescriptor_ApiContainerService_RemoveService = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/RemoveService',
+const methodDescriptor_ApiContainerService_GetServiceConfigs = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetServiceConfigs',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.RemoveServiceArgs,
-  proto.api_container_api.RemoveServiceResponse,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetServiceConfigsResponse,
   /**
-   * @param {!proto.api_container_api.RemoveServiceArgs} request
+   * @param {!proto.google.protobuf.Empty} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.RemoveServiceResponse.deserializeBinary
+  proto.api_container_api.GetServiceConfigsResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.RemoveServiceArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RemoveServiceResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetServiceConfigsResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RemoveServiceResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServiceConfigsResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.removeService =
+proto.api_container_api.ApiContainerServiceClient.prototype.getServiceConfigs =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/RemoveService',
+      '/api_container_api.ApiContainerService/GetServiceConfigs',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_RemoveService,
+      methodDescriptor_ApiContainerService_GetServiceConfigs,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.RemoveServiceArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.RemoveServiceResponse>}
+ * @return {!Promise<!proto.api_container_api.GetServiceConfigsResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.removeService =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServiceConfigs =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/RemoveService',
+      '/api_container_api.ApiContainerService/GetServiceConfigs',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_RemoveService);
+      methodDescriptor_ApiContainerService_GetServiceConfigs);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.RepartitionArgs,
- *   !proto.google.protobuf.Empty>}
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetScrapeTargetsResponse>}
  */
-const methodDescriptor_ApiContainerService_Repartition = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/Repartition',
+const methodDescriptor_ApiContainerService_GetScrapeTargets = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetScrapeTargets',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.RepartitionArgs,
   google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetScrapeTargetsResponse,
   /**
-   * @param {!proto.api_container_api.RepartitionArgs} request
+   * @param {!proto.google.protobuf.Empty} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  google_protobuf_empty_pb.Empty.deserializeBinary
+  proto.api_container_api.GetScrapeTargetsResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.RepartitionArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetScrapeTargetsResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetScrapeTargetsResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.repartition =
+proto.api_container_api.ApiContainerServiceClient.prototype.getScrapeTargets =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/Repartition',
+      '/api_container_api.ApiContainerService/GetScrapeTargets',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_Repartition,
+      methodDescriptor_ApiContainerService_GetScrapeTargets,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.RepartitionArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.google.protobuf.Empty>}
+ * @return {!Promise<!proto.api_container_api.GetScrapeTargetsResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.repartition =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getScrapeTargets =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/Repartition',
+      '/api_container_api.ApiContainerService/GetScrapeTargets',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_Repartition);
+      methodDescriptor_ApiContainerService_GetScrapeTargets);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.ExecCommandArgs,
- *   !proto.api_container_api.ExecCommandResponse>}
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetSubnetworkConnectionsResponse>}
  */
-const methodDescriptor_ApiContainerService_ExecCommand = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/ExecCommand',
+const methodDescriptor_ApiContainerService_GetSubnetworkConnections = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetSubnetworkConnections',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.ExecCommandArgs,
-  proto.api_container_api.ExecCommandResponse,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetSubnetworkConnectionsResponse,
   /**
-   * @param {!proto.api_container_api.ExecCommandArgs} request
+   * @param {!proto.google.protobuf.Empty} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.ExecCommandResponse.deserializeBinary
+  proto.api_container_api.GetSubnetworkConnectionsResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.ExecCommandArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ExecCommandResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetSubnetworkConnectionsResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ExecCommandResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetSubnetworkConnectionsResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.execCommand =
+proto.api_container_api.ApiContainerServiceClient.prototype.getSubnetworkConnections =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/ExecCommand',
+      '/api_container_api.ApiContainerService/GetSubnetworkConnections',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_ExecCommand,
+      methodDescriptor_ApiContainerService_GetSubnetworkConnections,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.ExecCommandArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.ExecCommandResponse>}
+ * @return {!Promise<!proto.api_container_api.GetSubnetworkConnectionsResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.execCommand =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getSubnetworkConnections =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/ExecCommand',
+      '/api_container_api.ApiContainerService/GetSubnetworkConnections',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_ExecCommand);
+      methodDescriptor_ApiContainerService_GetSubnetworkConnections);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.PauseServiceArgs,
- *   !proto.google.protobuf.Empty>}
+ *   !proto.api_container_api.RemoveServiceArgs,
+ *   !proto.api_container_api.RemoveServiceResponse>}
  */
-const methodDescriptor_ApiContainerService_PauseService = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/PauseService',
+const methodDescriptor_ApiContainerService_RemoveService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RemoveService',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.PauseServiceArgs,
-  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.RemoveServiceArgs,
+  proto.api_container_api.RemoveServiceResponse,
   /**
-   * @param {!proto.api_container_api.PauseServiceArgs} request
+   * @param {!proto.api_container_api.RemoveServiceArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  google_protobuf_empty_pb.Empty.deserializeBinary
+  proto.api_container_api.RemoveServiceResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.PauseServiceArgs} request The
+ * @param {!proto.api_container_api.RemoveServiceArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RemoveServiceResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RemoveServiceResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.pauseService =
+proto.api_container_api.ApiContainerServiceClient.prototype.removeService =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/PauseService',
+      '/api_container_api.ApiContainerService/RemoveService',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_PauseService,
+      methodDescriptor_ApiContainerService_RemoveService,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.PauseServiceArgs} request The
+ * @param {!proto.api_container_api.RemoveServiceArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.google.protobuf.Empty>}
+ * @return {!Promise<!proto.api_container_api.RemoveServiceResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.pauseService =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.removeService =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/PauseService',
+      '/api_container_api.ApiContainerService/RemoveService',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_PauseService);
+      methodDescriptor_ApiContainerService_RemoveService);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.UnpauseServiceArgs,
- *   !proto.google.protobuf.Empty>}
+ *   !proto.api_container_api.RemoveServicesArgs,
+ *   !proto.api_container_api.RemoveServicesResponse>}
  */
-const methodDescriptor_ApiContainerService_UnpauseService = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/UnpauseService',
+const methodDescriptor_ApiContainerService_RemoveServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RemoveServices',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.UnpauseServiceArgs,
-  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.RemoveServicesArgs,
+  proto.api_container_api.RemoveServicesResponse,
   /**
-   * @param {!proto.api_container_api.UnpauseServiceArgs} request
+   * @param {!proto.api_container_api.RemoveServicesArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  google_protobuf_empty_pb.Empty.deserializeBinary
+  proto.api_container_api.RemoveServicesResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.UnpauseServiceArgs} request The
+ * @param {!proto.api_container_api.RemoveServicesArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RemoveServicesResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RemoveServicesResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.unpauseService =
+proto.api_container_api.ApiContainerServiceClient.prototype.removeServices =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/UnpauseService',
+      '/api_container_api.ApiContainerService/RemoveServices',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_UnpauseService,
+      methodDescriptor_ApiContainerService_RemoveServices,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.UnpauseServiceArgs} request The
+ * @param {!proto.api_container_api.RemoveServicesArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.google.protobuf.Empty>}
+ * @return {!Promise<!proto.api_container_api.RemoveServicesResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.unpauseService =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.removeServices =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/UnpauseService',
+      '/api_container_api.ApiContainerService/RemoveServices',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_UnpauseService);
+      methodDescriptor_ApiContainerService_RemoveServices);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs,
+ *   !proto.api_container_api.RepartitionArgs,
  *   !proto.google.protobuf.Empty>}
  */
-const methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
+const methodDescriptor_ApiContainerService_Repartition = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/Repartition',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs,
+  proto.api_container_api.RepartitionArgs,
   google_protobuf_empty_pb.Empty,
   /**
-   * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} request
+   * @param {!proto.api_container_api.RepartitionArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -699,7 +701,7 @@ const methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability =
 
 
 /**
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} request The
+ * @param {!proto.api_container_api.RepartitionArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -708,48 +710,48 @@ const methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability =
  * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.waitForHttpGetEndpointAvailability =
+proto.api_container_api.ApiContainerServiceClient.prototype.repartition =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
+      '/api_container_api.ApiContainerService/Repartition',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability,
+      methodDescriptor_ApiContainerService_Repartition,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} request The
+ * @param {!proto.api_container_api.RepartitionArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
  * @return {!Promise<!proto.google.protobuf.Empty>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.waitForHttpGetEndpointAvailability =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.repartition =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
+      '/api_container_api.ApiContainerService/Repartition',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability);
+      methodDescriptor_ApiContainerService_Repartition);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs,
+ *   !proto.api_container_api.AddPartitionArgs,
  *   !proto.google.protobuf.Empty>}
  */
-const methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability',
+const methodDescriptor_ApiContainerService_AddPartition = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/AddPartition',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs,
+  proto.api_container_api.AddPartitionArgs,
   google_protobuf_empty_pb.Empty,
   /**
-   * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} request
+   * @param {!proto.api_container_api.AddPartitionArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
@@ -760,7 +762,7 @@ const methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability =
 
 
 /**
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} request The
+ * @param {!proto.api_container_api.AddPartitionArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
@@ -769,337 +771,1547 @@ const methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability =
  * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.waitForHttpPostEndpointAvailability =
+proto.api_container_api.ApiContainerServiceClient.prototype.addPartition =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability',
+      '/api_container_api.ApiContainerService/AddPartition',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability,
+      methodDescriptor_ApiContainerService_AddPartition,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} request The
+ * @param {!proto.api_container_api.AddPartitionArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
  * @return {!Promise<!proto.google.protobuf.Empty>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.waitForHttpPostEndpointAvailability =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.addPartition =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability',
+      '/api_container_api.ApiContainerService/AddPartition',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability);
+      methodDescriptor_ApiContainerService_AddPartition);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.UploadFilesArtifactArgs,
- *   !proto.api_container_api.UploadFilesArtifactResponse>}
+ *   !proto.api_container_api.MovePartitionArgs,
+ *   !proto.google.protobuf.Empty>}
  */
-const methodDescriptor_ApiContainerService_UploadFilesArtifact = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/UploadFilesArtifact',
+const methodDescriptor_ApiContainerService_MovePartition = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/MovePartition',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.UploadFilesArtifactArgs,
-  proto.api_container_api.UploadFilesArtifactResponse,
+  proto.api_container_api.MovePartitionArgs,
+  google_protobuf_empty_pb.Empty,
   /**
-   * @param {!proto.api_container_api.UploadFilesArtifactArgs} request
+   * @param {!proto.api_container_api.MovePartitionArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary
+  google_protobuf_empty_pb.Empty.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.MovePartitionArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.UploadFilesArtifactResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.UploadFilesArtifactResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.uploadFilesArtifact =
+proto.api_container_api.ApiContainerServiceClient.prototype.movePartition =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/UploadFilesArtifact',
+      '/api_container_api.ApiContainerService/MovePartition',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_UploadFilesArtifact,
+      methodDescriptor_ApiContainerService_MovePartition,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.MovePartitionArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.UploadFilesArtifactResponse>}
+ * @return {!Promise<!proto.google.protobuf.Empty>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.uploadFilesArtifact =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.movePartition =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/UploadFilesArtifact',
+      '/api_container_api.ApiContainerService/MovePartition',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_UploadFilesArtifact);
+      methodDescriptor_ApiContainerService_MovePartition);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.DownloadFilesArtifactArgs,
- *   !proto.api_container_api.DownloadFilesArtifactResponse>}
+ *   !proto.api_container_api.UpdateConnectionArgs,
+ *   !proto.google.protobuf.Empty>}
  */
-const methodDescriptor_ApiContainerService_DownloadFilesArtifact = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/DownloadFilesArtifact',
+const methodDescriptor_ApiContainerService_UpdateConnection = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/UpdateConnection',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.DownloadFilesArtifactArgs,
-  proto.api_container_api.DownloadFilesArtifactResponse,
+  proto.api_container_api.UpdateConnectionArgs,
+  google_protobuf_empty_pb.Empty,
   /**
-   * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request
+   * @param {!proto.api_container_api.UpdateConnectionArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinary
+  google_protobuf_empty_pb.Empty.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.UpdateConnectionArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.DownloadFilesArtifactResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.DownloadFilesArtifactResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.downloadFilesArtifact =
+proto.api_container_api.ApiContainerServiceClient.prototype.updateConnection =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/DownloadFilesArtifact',
+      '/api_container_api.ApiContainerService/UpdateConnection',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_DownloadFilesArtifact,
+      methodDescriptor_ApiContainerService_UpdateConnection,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.UpdateConnectionArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.DownloadFilesArtifactResponse>}
+ * @return {!Promise<!proto.google.protobuf.Empty>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.downloadFilesArtifact =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.updateConnection =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/DownloadFilesArtifact',
+      '/api_container_api.ApiContainerService/UpdateConnection',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_DownloadFilesArtifact);
+      methodDescriptor_ApiContainerService_UpdateConnection);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.StoreWebFilesArtifactArgs,
- *   !proto.api_container_api.StoreWebFilesArtifactResponse>}
+ *   !proto.api_container_api.TestConnectivityArgs,
+ *   !proto.api_container_api.TestConnectivityResponse>}
  */
-const methodDescriptor_ApiContainerService_StoreWebFilesArtifact = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
+const methodDescriptor_ApiContainerService_TestConnectivity = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/TestConnectivity',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.StoreWebFilesArtifactArgs,
-  proto.api_container_api.StoreWebFilesArtifactResponse,
+  proto.api_container_api.TestConnectivityArgs,
+  proto.api_container_api.TestConnectivityResponse,
   /**
-   * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} request
+   * @param {!proto.api_container_api.TestConnectivityArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary
+  proto.api_container_api.TestConnectivityResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.TestConnectivityArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StoreWebFilesArtifactResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.TestConnectivityResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StoreWebFilesArtifactResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.TestConnectivityResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.storeWebFilesArtifact =
+proto.api_container_api.ApiContainerServiceClient.prototype.testConnectivity =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
+      '/api_container_api.ApiContainerService/TestConnectivity',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_StoreWebFilesArtifact,
+      methodDescriptor_ApiContainerService_TestConnectivity,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.TestConnectivityArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.StoreWebFilesArtifactResponse>}
+ * @return {!Promise<!proto.api_container_api.TestConnectivityResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeWebFilesArtifact =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.testConnectivity =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
+      '/api_container_api.ApiContainerService/TestConnectivity',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_StoreWebFilesArtifact);
+      methodDescriptor_ApiContainerService_TestConnectivity);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.StoreFilesArtifactFromServiceArgs,
- *   !proto.api_container_api.StoreFilesArtifactFromServiceResponse>}
+ *   !proto.api_container_api.ExecCommandArgs,
+ *   !proto.api_container_api.ExecCommandResponse>}
  */
-const methodDescriptor_ApiContainerService_StoreFilesArtifactFromService = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/StoreFilesArtifactFromService',
+const methodDescriptor_ApiContainerService_ExecCommand = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ExecCommand',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs,
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse,
+  proto.api_container_api.ExecCommandArgs,
+  proto.api_container_api.ExecCommandResponse,
   /**
-   * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} request
+   * @param {!proto.api_container_api.ExecCommandArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary
+  proto.api_container_api.ExecCommandResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} request The
+ * @param {!proto.api_container_api.ExecCommandArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StoreFilesArtifactFromServiceResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ExecCommandResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ExecCommandResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.execCommand =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ExecCommand',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ExecCommand,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ExecCommandArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ExecCommandResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.execCommand =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ExecCommand',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ExecCommand);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.ExecCommandArgs,
+ *   !proto.api_container_api.ExecCommandStreamedOutputChunk>}
+ */
+const methodDescriptor_ApiContainerService_ExecCommandWithStreamedOutput = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ExecCommandWithStreamedOutput',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.ExecCommandArgs,
+  proto.api_container_api.ExecCommandStreamedOutputChunk,
+  /**
+   * @param {!proto.api_container_api.ExecCommandArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ExecCommandStreamedOutputChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.ExecCommandArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ExecCommandStreamedOutputChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.execCommandWithStreamedOutput =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/ExecCommandWithStreamedOutput',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ExecCommandWithStreamedOutput);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ExecCommandArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ExecCommandStreamedOutputChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.execCommandWithStreamedOutput =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/ExecCommandWithStreamedOutput',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ExecCommandWithStreamedOutput);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.PauseServiceArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_PauseService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/PauseService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.PauseServiceArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.PauseServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.PauseServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.pauseService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/PauseService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_PauseService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.PauseServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.pauseService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/PauseService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_PauseService);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.UnpauseServiceArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_UnpauseService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/UnpauseService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.UnpauseServiceArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.UnpauseServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.UnpauseServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.unpauseService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/UnpauseService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_UnpauseService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.UnpauseServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.unpauseService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/UnpauseService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_UnpauseService);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.PauseServicesArgs,
+ *   !proto.api_container_api.PauseServicesResponse>}
+ */
+const methodDescriptor_ApiContainerService_PauseServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/PauseServices',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.PauseServicesArgs,
+  proto.api_container_api.PauseServicesResponse,
+  /**
+   * @param {!proto.api_container_api.PauseServicesArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.PauseServicesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.PauseServicesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.PauseServicesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.PauseServicesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.pauseServices =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/PauseServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_PauseServices,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.PauseServicesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.PauseServicesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.pauseServices =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/PauseServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_PauseServices);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.UnpauseServicesArgs,
+ *   !proto.api_container_api.UnpauseServicesResponse>}
+ */
+const methodDescriptor_ApiContainerService_UnpauseServices = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/UnpauseServices',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.UnpauseServicesArgs,
+  proto.api_container_api.UnpauseServicesResponse,
+  /**
+   * @param {!proto.api_container_api.UnpauseServicesArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.UnpauseServicesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.UnpauseServicesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.UnpauseServicesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.UnpauseServicesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.unpauseServices =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/UnpauseServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_UnpauseServices,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.UnpauseServicesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.UnpauseServicesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.unpauseServices =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/UnpauseServices',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_UnpauseServices);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.WaitForServiceExitArgs,
+ *   !proto.api_container_api.WaitForServiceExitResponse>}
+ */
+const methodDescriptor_ApiContainerService_WaitForServiceExit = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WaitForServiceExit',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.WaitForServiceExitArgs,
+  proto.api_container_api.WaitForServiceExitResponse,
+  /**
+   * @param {!proto.api_container_api.WaitForServiceExitArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.WaitForServiceExitResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.WaitForServiceExitArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.WaitForServiceExitResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.WaitForServiceExitResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.waitForServiceExit =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/WaitForServiceExit',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WaitForServiceExit,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.WaitForServiceExitArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.WaitForServiceExitResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.waitForServiceExit =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/WaitForServiceExit',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WaitForServiceExit);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetServiceMetricsArgs,
+ *   !proto.api_container_api.GetServiceMetricsResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetServiceMetrics = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetServiceMetrics',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetServiceMetricsArgs,
+  proto.api_container_api.GetServiceMetricsResponse,
+  /**
+   * @param {!proto.api_container_api.GetServiceMetricsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetServiceMetricsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetServiceMetricsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetServiceMetricsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetServiceMetricsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getServiceMetrics =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceMetrics',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceMetrics,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetServiceMetricsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetServiceMetricsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getServiceMetrics =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetServiceMetrics',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetServiceMetrics);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.waitForHttpGetEndpointAvailability =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.waitForHttpGetEndpointAvailability =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WaitForHttpGetEndpointAvailability);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.waitForHttpPostEndpointAvailability =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.waitForHttpPostEndpointAvailability =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_WaitForHttpPostEndpointAvailability);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.UploadFilesArtifactArgs,
+ *   !proto.api_container_api.UploadFilesArtifactResponse>}
+ */
+const methodDescriptor_ApiContainerService_UploadFilesArtifact = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/UploadFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.UploadFilesArtifactArgs,
+  proto.api_container_api.UploadFilesArtifactResponse,
+  /**
+   * @param {!proto.api_container_api.UploadFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.UploadFilesArtifactResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.UploadFilesArtifactResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.uploadFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/UploadFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_UploadFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.UploadFilesArtifactResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.uploadFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/UploadFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_UploadFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.DownloadFilesArtifactArgs,
+ *   !proto.api_container_api.DownloadFilesArtifactResponse>}
+ */
+const methodDescriptor_ApiContainerService_DownloadFilesArtifact = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/DownloadFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.DownloadFilesArtifactArgs,
+  proto.api_container_api.DownloadFilesArtifactResponse,
+  /**
+   * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.DownloadFilesArtifactResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.DownloadFilesArtifactResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.downloadFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/DownloadFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DownloadFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.DownloadFilesArtifactResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.downloadFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/DownloadFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DownloadFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.DownloadFilesArtifactArgs,
+ *   !proto.api_container_api.FilesArtifactStreamChunk>}
+ */
+const methodDescriptor_ApiContainerService_DownloadFilesArtifactStream = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/DownloadFilesArtifactStream',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.DownloadFilesArtifactArgs,
+  proto.api_container_api.FilesArtifactStreamChunk,
+  /**
+   * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.FilesArtifactStreamChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.FilesArtifactStreamChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.downloadFilesArtifactStream =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/DownloadFilesArtifactStream',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DownloadFilesArtifactStream);
+};
+
+
+/**
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.FilesArtifactStreamChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.downloadFilesArtifactStream =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/DownloadFilesArtifactStream',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DownloadFilesArtifactStream);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.StoreWebFilesArtifactArgs,
+ *   !proto.api_container_api.StoreWebFilesArtifactResponse>}
+ */
+const methodDescriptor_ApiContainerService_StoreWebFilesArtifact = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.StoreWebFilesArtifactArgs,
+  proto.api_container_api.StoreWebFilesArtifactResponse,
+  /**
+   * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StoreWebFilesArtifactResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StoreWebFilesArtifactResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.storeWebFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreWebFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.StoreWebFilesArtifactResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeWebFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreWebFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreWebFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.StoreFilesArtifactFromServiceArgs,
+ *   !proto.api_container_api.StoreFilesArtifactFromServiceResponse>}
+ */
+const methodDescriptor_ApiContainerService_StoreFilesArtifactFromService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StoreFilesArtifactFromService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs,
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse,
+  /**
+   * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StoreFilesArtifactFromServiceResponse)}
  *     callback The callback function(error, response)
  * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StoreFilesArtifactFromServiceResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.storeFilesArtifactFromService =
+proto.api_container_api.ApiContainerServiceClient.prototype.storeFilesArtifactFromService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreFilesArtifactFromService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreFilesArtifactFromService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.StoreFilesArtifactFromServiceResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeFilesArtifactFromService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreFilesArtifactFromService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreFilesArtifactFromService);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RenderTemplatesToFilesArtifactArgs,
+ *   !proto.api_container_api.RenderTemplatesToFilesArtifactResponse>}
+ */
+const methodDescriptor_ApiContainerService_RenderTemplatesToFilesArtifact = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs,
+  proto.api_container_api.RenderTemplatesToFilesArtifactResponse,
+  /**
+   * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RenderTemplatesToFilesArtifactResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RenderTemplatesToFilesArtifactResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.renderTemplatesToFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RenderTemplatesToFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.RenderTemplatesToFilesArtifactResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.renderTemplatesToFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RenderTemplatesToFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse>}
+ */
+const methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.listFilesArtifactNamesAndUuids =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.listFilesArtifactNamesAndUuids =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.DeleteFilesArtifactArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_DeleteFilesArtifact = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/DeleteFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.DeleteFilesArtifactArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.DeleteFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.DeleteFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.deleteFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/DeleteFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DeleteFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.DeleteFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.deleteFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/DeleteFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DeleteFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.InspectFilesArtifactContentsArgs,
+ *   !proto.api_container_api.InspectFilesArtifactContentsResponse>}
+ */
+const methodDescriptor_ApiContainerService_InspectFilesArtifactContents = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/InspectFilesArtifactContents',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.InspectFilesArtifactContentsArgs,
+  proto.api_container_api.InspectFilesArtifactContentsResponse,
+  /**
+   * @param {!proto.api_container_api.InspectFilesArtifactContentsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.InspectFilesArtifactContentsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.InspectFilesArtifactContentsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.inspectFilesArtifactContents =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/InspectFilesArtifactContents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_InspectFilesArtifactContents,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.InspectFilesArtifactContentsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.inspectFilesArtifactContents =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/InspectFilesArtifactContents',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_InspectFilesArtifactContents);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.SetLogLevelArgs,
+ *   !proto.api_container_api.SetLogLevelResponse>}
+ */
+const methodDescriptor_ApiContainerService_SetLogLevel = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/SetLogLevel',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.SetLogLevelArgs,
+  proto.api_container_api.SetLogLevelResponse,
+  /**
+   * @param {!proto.api_container_api.SetLogLevelArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.SetLogLevelResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.SetLogLevelArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.SetLogLevelResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.SetLogLevelResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.setLogLevel =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetLogLevel',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetLogLevel,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.SetLogLevelArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.SetLogLevelResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.setLogLevel =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/SetLogLevel',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_SetLogLevel);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.RunShutdownHooksResponse>}
+ */
+const methodDescriptor_ApiContainerService_RunShutdownHooks = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RunShutdownHooks',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.RunShutdownHooksResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.RunShutdownHooksResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RunShutdownHooksResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RunShutdownHooksResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.runShutdownHooks =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/StoreFilesArtifactFromService',
+      '/api_container_api.ApiContainerService/RunShutdownHooks',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_StoreFilesArtifactFromService,
+      methodDescriptor_ApiContainerService_RunShutdownHooks,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} request The
+ * @param {!proto.google.protobuf.Empty} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.StoreFilesArtifactFromServiceResponse>}
+ * @return {!Promise<!proto.api_container_api.RunShutdownHooksResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeFilesArtifactFromService =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.runShutdownHooks =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/StoreFilesArtifactFromService',
+      '/api_container_api.ApiContainerService/RunShutdownHooks',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_StoreFilesArtifactFromService);
+      methodDescriptor_ApiContainerService_RunShutdownHooks);
 };
 
 
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
- *   !proto.api_container_api.RenderTemplatesToFilesArtifactArgs,
- *   !proto.api_container_api.RenderTemplatesToFilesArtifactResponse>}
+ *   !proto.api_container_api.HttpRequestServiceArgs,
+ *   !proto.api_container_api.HttpRequestServiceResponse>}
  */
-const methodDescriptor_ApiContainerService_RenderTemplatesToFilesArtifact = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact',
+const methodDescriptor_ApiContainerService_HttpRequestService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/HttpRequestService',
   grpc.web.MethodType.UNARY,
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs,
-  proto.api_container_api.RenderTemplatesToFilesArtifactResponse,
+  proto.api_container_api.HttpRequestServiceArgs,
+  proto.api_container_api.HttpRequestServiceResponse,
   /**
-   * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} request
+   * @param {!proto.api_container_api.HttpRequestServiceArgs} request
    * @return {!Uint8Array}
    */
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary
+  proto.api_container_api.HttpRequestServiceResponse.deserializeBinary
 );
 
 
 /**
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} request The
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RenderTemplatesToFilesArtifactResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.HttpRequestServiceResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RenderTemplatesToFilesArtifactResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.HttpRequestServiceResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.renderTemplatesToFilesArtifact =
+proto.api_container_api.ApiContainerServiceClient.prototype.httpRequestService =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact',
+      '/api_container_api.ApiContainerService/HttpRequestService',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_RenderTemplatesToFilesArtifact,
+      methodDescriptor_ApiContainerService_HttpRequestService,
       callback);
 };
 
 
 /**
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} request The
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} request The
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.RenderTemplatesToFilesArtifactResponse>}
+ * @return {!Promise<!proto.api_container_api.HttpRequestServiceResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.renderTemplatesToFilesArtifact =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.httpRequestService =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/RenderTemplatesToFilesArtifact',
+      '/api_container_api.ApiContainerService/HttpRequestService',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_RenderTemplatesToFilesArtifact);
+      methodDescriptor_ApiContainerService_HttpRequestService);
 };
 
 
@@ -1107,13 +2319,13 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.renderTemplat
  * @const
  * @type {!grpc.web.MethodDescriptor<
  *   !proto.google.protobuf.Empty,
- *   !proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse>}
+ *   !proto.api_container_api.GetBackendCapabilitiesResponse>}
  */
-const methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids = new grpc.web.MethodDescriptor(
-  '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
+const methodDescriptor_ApiContainerService_GetBackendCapabilities = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetBackendCapabilities',
   grpc.web.MethodType.UNARY,
   google_protobuf_empty_pb.Empty,
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse,
+  proto.api_container_api.GetBackendCapabilitiesResponse,
   /**
    * @param {!proto.google.protobuf.Empty} request
    * @return {!Uint8Array}
@@ -1121,7 +2333,7 @@ const methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids = new
   function(request) {
     return request.serializeBinary();
   },
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
+  proto.api_container_api.GetBackendCapabilitiesResponse.deserializeBinary
 );
 
 
@@ -1130,18 +2342,18 @@ const methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids = new
  *     request proto
  * @param {?Object<string, string>} metadata User defined
  *     call metadata
- * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse)}
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetBackendCapabilitiesResponse)}
  *     callback The callback function(error, response)
- * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse>|undefined}
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetBackendCapabilitiesResponse>|undefined}
  *     The XHR Node Readable Stream
  */
-proto.api_container_api.ApiContainerServiceClient.prototype.listFilesArtifactNamesAndUuids =
+proto.api_container_api.ApiContainerServiceClient.prototype.getBackendCapabilities =
     function(request, metadata, callback) {
   return this.client_.rpcCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
+      '/api_container_api.ApiContainerService/GetBackendCapabilities',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids,
+      methodDescriptor_ApiContainerService_GetBackendCapabilities,
       callback);
 };
 
@@ -1151,16 +2363,260 @@ proto.api_container_api.ApiContainerServiceClient.prototype.listFilesArtifactNam
  *     request proto
  * @param {?Object<string, string>=} metadata User defined
  *     call metadata
- * @return {!Promise<!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse>}
+ * @return {!Promise<!proto.api_container_api.GetBackendCapabilitiesResponse>}
  *     Promise that resolves to the response
  */
-proto.api_container_api.ApiContainerServicePromiseClient.prototype.listFilesArtifactNamesAndUuids =
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getBackendCapabilities =
     function(request, metadata) {
   return this.client_.unaryCall(this.hostname_ +
-      '/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids',
+      '/api_container_api.ApiContainerService/GetBackendCapabilities',
       request,
       metadata || {},
-      methodDescriptor_ApiContainerService_ListFilesArtifactNamesAndUuids);
+      methodDescriptor_ApiContainerService_GetBackendCapabilities);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.PackageCacheInfo>}
+ */
+const methodDescriptor_ApiContainerService_GetPackageCacheInfo = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetPackageCacheInfo',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.PackageCacheInfo,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.PackageCacheInfo.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.PackageCacheInfo)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.PackageCacheInfo>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getPackageCacheInfo =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetPackageCacheInfo',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetPackageCacheInfo,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.PackageCacheInfo>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getPackageCacheInfo =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetPackageCacheInfo',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetPackageCacheInfo);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ClearPackageCacheResponse>}
+ */
+const methodDescriptor_ApiContainerService_ClearPackageCache = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ClearPackageCache',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ClearPackageCacheResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ClearPackageCacheResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ClearPackageCacheResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ClearPackageCacheResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.clearPackageCache =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ClearPackageCache',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ClearPackageCache,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ClearPackageCacheResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.clearPackageCache =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ClearPackageCache',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ClearPackageCache);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetStarlarkRunProfileArgs,
+ *   !proto.api_container_api.StarlarkRunProfile>}
+ */
+const methodDescriptor_ApiContainerService_GetStarlarkRunProfile = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetStarlarkRunProfile',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetStarlarkRunProfileArgs,
+  proto.api_container_api.StarlarkRunProfile,
+  /**
+   * @param {!proto.api_container_api.GetStarlarkRunProfileArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StarlarkRunProfile.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetStarlarkRunProfileArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StarlarkRunProfile)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunProfile>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getStarlarkRunProfile =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunProfile',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunProfile,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetStarlarkRunProfileArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.StarlarkRunProfile>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkRunProfile =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunProfile',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunProfile);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.GetDeprecatedFeaturesResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetDeprecatedFeatures = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetDeprecatedFeatures',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.GetDeprecatedFeaturesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetDeprecatedFeaturesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetDeprecatedFeaturesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetDeprecatedFeaturesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getDeprecatedFeatures =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetDeprecatedFeatures',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetDeprecatedFeatures,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetDeprecatedFeaturesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getDeprecatedFeatures =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetDeprecatedFeatures',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetDeprecatedFeatures);
 };
 
 
@@ -1,6 +1,7 @@
 import * as jspb from 'google-protobuf'
 
 import * as google_protobuf_empty_pb from 'google-protobuf/google/protobuf/empty_pb';
+import * as google_protobuf_timestamp_pb from 'google-protobuf/google/protobuf/timestamp_pb';
 
 
 export class Port extends jspb.Message {
@@ -57,6 +58,29 @@ export class ServiceInfo extends jspb.Message {
   getShortenedUuid(): string;
   setShortenedUuid(value: string): ServiceInfo;
 
+  getReplicaPrivateIpAddrsList(): Array<string>;
+  setReplicaPrivateIpAddrsList(value: Array<string>): ServiceInfo;
+  clearReplicaPrivateIpAddrsList(): ServiceInfo;
+  addReplicaPrivateIpAddrs(value: string, index?: number): ServiceInfo;
+
+  getCreationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreationTime(value?: google_protobuf_timestamp_pb.Timestamp): ServiceInfo;
+  hasCreationTime(): boolean;
+  clearCreationTime(): ServiceInfo;
+
+  getRunningTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setRunningTime(value?: google_protobuf_timestamp_pb.Timestamp): ServiceInfo;
+  hasRunningTime(): boolean;
+  clearRunningTime(): ServiceInfo;
+
+  getReadyTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setReadyTime(value?: google_protobuf_timestamp_pb.Timestamp): ServiceInfo;
+  hasReadyTime(): boolean;
+  clearReadyTime(): ServiceInfo;
+
+  getMaybePrivateIpv6Addr(): string;
+  setMaybePrivateIpv6Addr(value: string): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -74,6 +98,11 @@ export namespace ServiceInfo {
     maybePublicPortsMap: Array<[string, Port.AsObject]>,
     name: string,
     shortenedUuid: string,
+    replicaPrivateIpAddrsList: Array<string>,
+    creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    runningTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    readyTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    maybePrivateIpv6Addr: string,
   }
 }
 
@@ -117,6 +146,53 @@ export class ServiceConfig extends jspb.Message {
   hasSubnetwork(): boolean;
   clearSubnetwork(): ServiceConfig;
 
+  getKubernetesServiceAccount(): string;
+  setKubernetesServiceAccount(value: string): ServiceConfig;
+  hasKubernetesServiceAccount(): boolean;
+  clearKubernetesServiceAccount(): ServiceConfig;
+
+  getKubernetesServiceAccountAnnotationsMap(): jspb.Map<string, string>;
+  clearKubernetesServiceAccountAnnotationsMap(): ServiceConfig;
+
+  getKubernetesScheduling(): KubernetesScheduling | undefined;
+  setKubernetesScheduling(value?: KubernetesScheduling): ServiceConfig;
+  hasKubernetesScheduling(): boolean;
+  clearKubernetesScheduling(): ServiceConfig;
+
+  getReplicas(): number;
+  setReplicas(value: number): ServiceConfig;
+  hasReplicas(): boolean;
+  clearReplicas(): ServiceConfig;
+
+  getCpuset(): string;
+  setCpuset(value: string): ServiceConfig;
+  hasCpuset(): boolean;
+  clearCpuset(): ServiceConfig;
+
+  getNumaNodes(): string;
+  setNumaNodes(value: string): ServiceConfig;
+  hasNumaNodes(): boolean;
+  clearNumaNodes(): ServiceConfig;
+
+  getUlimitsMap(): jspb.Map<string, Ulimit>;
+  clearUlimitsMap(): ServiceConfig;
+
+  getSysctlsMap(): jspb.Map<string, string>;
+  clearSysctlsMap(): ServiceConfig;
+
+  getShmSizeMegabytes(): number;
+  setShmSizeMegabytes(value: number): ServiceConfig;
+  hasShmSizeMegabytes(): boolean;
+  clearShmSizeMegabytes(): ServiceConfig;
+
+  getHostDirMountsMap(): jspb.Map<string, string>;
+  clearHostDirMountsMap(): ServiceConfig;
+
+  getMetricsPortId(): string;
+  setMetricsPortId(value: string): ServiceConfig;
+  hasMetricsPortId(): boolean;
+  clearMetricsPortId(): ServiceConfig;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceConfig.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceConfig): ServiceConfig.AsObject;
@@ -138,12 +214,153 @@ export namespace ServiceConfig {
     memoryAllocationMegabytes: number,
     privateIpAddrPlaceholder: string,
     subnetwork?: string,
+    kubernetesServiceAccount?: string,
+    kubernetesServiceAccountAnnotationsMap: Array<[string, string]>,
+    kubernetesScheduling?: KubernetesScheduling.AsObject,
+    replicas?: number,
+    cpuset?: string,
+    numaNodes?: string,
+    ulimitsMap: Array<[string, Ulimit.AsObject]>,
+    sysctlsMap: Array<[string, string]>,
+    shmSizeMegabytes?: number,
+    hostDirMountsMap: Array<[string, string]>,
+    metricsPortId?: string,
   }
 
   export enum SubnetworkCase { 
     _SUBNETWORK_NOT_SET = 0,
     SUBNETWORK = 11,
   }
+
+  export enum KubernetesServiceAccountCase { 
+    _KUBERNETES_SERVICE_ACCOUNT_NOT_SET = 0,
+    KUBERNETES_SERVICE_ACCOUNT = 12,
+  }
+
+  export enum KubernetesSchedulingCase { 
+    _KUBERNETES_SCHEDULING_NOT_SET = 0,
+    KUBERNETES_SCHEDULING = 14,
+  }
+
+  export enum ReplicasCase { 
+    _REPLICAS_NOT_SET = 0,
+    REPLICAS = 15,
+  }
+
+  export enum CpusetCase { 
+    _CPUSET_NOT_SET = 0,
+    CPUSET = 16,
+  }
+
+  export enum NumaNodesCase { 
+    _NUMA_NODES_NOT_SET = 0,
+    NUMA_NODES = 17,
+  }
+
+  export enum ShmSizeMegabytesCase { 
+    _SHM_SIZE_MEGABYTES_NOT_SET = 0,
+    SHM_SIZE_MEGABYTES = 20,
+  }
+
+  export enum MetricsPortIdCase { 
+    _METRICS_PORT_ID_NOT_SET = 0,
+    METRICS_PORT_ID = 22,
+  }
+}
+
+export class Ulimit extends jspb.Message {
+  getSoft(): number;
+  setSoft(value: number): Ulimit;
+
+  getHard(): number;
+  setHard(value: number): Ulimit;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): Ulimit.AsObject;
+  static toObject(includeInstance: boolean, msg: Ulimit): Ulimit.AsObject;
+  static serializeBinaryToWriter(message: Ulimit, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): Ulimit;
+  static deserializeBinaryFromReader(message: Ulimit, reader: jspb.BinaryReader): Ulimit;
+}
+
+export namespace Ulimit {
+  export type AsObject = {
+    soft: number,
+    hard: number,
+  }
+}
+
+export class KubernetesScheduling extends jspb.Message {
+  getNodeSelectorMap(): jspb.Map<string, string>;
+  clearNodeSelectorMap(): KubernetesScheduling;
+
+  getTolerationsList(): Array<KubernetesToleration>;
+  setTolerationsList(value: Array<KubernetesToleration>): KubernetesScheduling;
+  clearTolerationsList(): KubernetesScheduling;
+  addTolerations(value?: KubernetesToleration, index?: number): KubernetesToleration;
+
+  getSerializedAffinity(): string;
+  setSerializedAffinity(value: string): KubernetesScheduling;
+
+  getSerializedTopologySpreadConstraints(): string;
+  setSerializedTopologySpreadConstraints(value: string): KubernetesScheduling;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KubernetesScheduling.AsObject;
+  static toObject(includeInstance: boolean, msg: KubernetesScheduling): KubernetesScheduling.AsObject;
+  static serializeBinaryToWriter(message: KubernetesScheduling, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KubernetesScheduling;
+  static deserializeBinaryFromReader(message: KubernetesScheduling, reader: jspb.BinaryReader): KubernetesScheduling;
+}
+
+export namespace KubernetesScheduling {
+  export type AsObject = {
+    nodeSelectorMap: Array<[string, string]>,
+    tolerationsList: Array<KubernetesToleration.AsObject>,
+    serializedAffinity: string,
+    serializedTopologySpreadConstraints: string,
+  }
+}
+
+export class KubernetesToleration extends jspb.Message {
+  getKey(): string;
+  setKey(value: string): KubernetesToleration;
+
+  getOperator(): string;
+  setOperator(value: string): KubernetesToleration;
+
+  getValue(): string;
+  setValue(value: string): KubernetesToleration;
+
+  getEffect(): string;
+  setEffect(value: string): KubernetesToleration;
+
+  getTolerationSeconds(): number;
+  setTolerationSeconds(value: number): KubernetesToleration;
+  hasTolerationSeconds(): boolean;
+  clearTolerationSeconds(): KubernetesToleration;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KubernetesToleration.AsObject;
+  static toObject(includeInstance: boolean, msg: KubernetesToleration): KubernetesToleration.AsObject;
+  static serializeBinaryToWriter(message: KubernetesToleration, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KubernetesToleration;
+  static deserializeBinaryFromReader(message: KubernetesToleration, reader: jspb.BinaryReader): KubernetesToleration;
+}
+
+export namespace KubernetesToleration {
+  export type AsObject = {
+    key: string,
+    operator: string,
+    value: string,
+    effect: string,
+    tolerationSeconds?: number,
+  }
+
+  export enum TolerationSecondsCase { 
+    _TOLERATION_SECONDS_NOT_SET = 0,
+    TOLERATION_SECONDS = 5,
+  }
 }
 
 export class UpdateServiceConfig extends jspb.Message {
@@ -152,6 +369,17 @@ export class UpdateServiceConfig extends jspb.Message {
   hasSubnetwork(): boolean;
   clearSubnetwork(): UpdateServiceConfig;
 
+  getContainerImageName(): string;
+  setContainerImageName(value: string): UpdateServiceConfig;
+  hasContainerImageName(): boolean;
+  clearContainerImageName(): UpdateServiceConfig;
+
+  getPrivatePortsMap(): jspb.Map<string, Port>;
+  clearPrivatePortsMap(): UpdateServiceConfig;
+
+  getEnvVarsMap(): jspb.Map<string, string>;
+  clearEnvVarsMap(): UpdateServiceConfig;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): UpdateServiceConfig.AsObject;
   static toObject(includeInstance: boolean, msg: UpdateServiceConfig): UpdateServiceConfig.AsObject;
@@ -163,12 +391,20 @@ export class UpdateServiceConfig extends jspb.Message {
 export namespace UpdateServiceConfig {
   export type AsObject = {
     subnetwork?: string,
+    containerImageName?: string,
+    privatePortsMap: Array<[string, Port.AsObject]>,
+    envVarsMap: Array<[string, string]>,
   }
 
   export enum SubnetworkCase { 
     _SUBNETWORK_NOT_SET = 0,
     SUBNETWORK = 1,
   }
+
+  export enum ContainerImageNameCase { 
+    _CONTAINER_IMAGE_NAME_NOT_SET = 0,
+    CONTAINER_IMAGE_NAME = 2,
+  }
 }
 
 export class RunStarlarkScriptArgs extends jspb.Message {
@@ -188,6 +424,11 @@ export class RunStarlarkScriptArgs extends jspb.Message {
   hasParallelism(): boolean;
   clearParallelism(): RunStarlarkScriptArgs;
 
+  getStrict(): boolean;
+  setStrict(value: boolean): RunStarlarkScriptArgs;
+  hasStrict(): boolean;
+  clearStrict(): RunStarlarkScriptArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RunStarlarkScriptArgs.AsObject;
   static toObject(includeInstance: boolean, msg: RunStarlarkScriptArgs): RunStarlarkScriptArgs.AsObject;
@@ -202,6 +443,7 @@ export namespace RunStarlarkScriptArgs {
     serializedParams: string,
     dryRun?: boolean,
     parallelism?: number,
+    strict?: boolean,
   }
 
   export enum DryRunCase { 
@@ -213,6 +455,11 @@ export namespace RunStarlarkScriptArgs {
     _PARALLELISM_NOT_SET = 0,
     PARALLELISM = 4,
   }
+
+  export enum StrictCase { 
+    _STRICT_NOT_SET = 0,
+    STRICT = 5,
+  }
 }
 
 export class RunStarlarkPackageArgs extends jspb.Message {
@@ -240,6 +487,11 @@ export class RunStarlarkPackageArgs extends jspb.Message {
   hasParallelism(): boolean;
   clearParallelism(): RunStarlarkPackageArgs;
 
+  getStrict(): boolean;
+  setStrict(value: boolean): RunStarlarkPackageArgs;
+  hasStrict(): boolean;
+  clearStrict(): RunStarlarkPackageArgs;
+
   getStarlarkPackageContentCase(): RunStarlarkPackageArgs.StarlarkPackageContentCase;
 
   serializeBinary(): Uint8Array;
@@ -258,6 +510,7 @@ export namespace RunStarlarkPackageArgs {
     serializedParams: string,
     dryRun?: boolean,
     parallelism?: number,
+    strict?: boolean,
   }
 
   export enum StarlarkPackageContentCase { 
@@ -275,6 +528,11 @@ export namespace RunStarlarkPackageArgs {
     _PARALLELISM_NOT_SET = 0,
     PARALLELISM = 7,
   }
+
+  export enum StrictCase { 
+    _STRICT_NOT_SET = 0,
+    STRICT = 8,
+  }
 }
 
 export class StarlarkRunResponseLine extends jspb.Message {
@@ -303,6 +561,16 @@ export class StarlarkRunResponseLine extends jspb.Message {
   hasRunFinishedEvent(): boolean;
   clearRunFinishedEvent(): StarlarkRunResponseLine;
 
+  getResourceEstimate(): StarlarkResourceEstimate | undefined;
+  setResourceEstimate(value?: StarlarkResourceEstimate): StarlarkRunResponseLine;
+  hasResourceEstimate(): boolean;
+  clearResourceEstimate(): StarlarkRunResponseLine;
+
+  getDeprecationWarning(): StarlarkDeprecationWarning | undefined;
+  setDeprecationWarning(value?: StarlarkDeprecationWarning): StarlarkRunResponseLine;
+  hasDeprecationWarning(): boolean;
+  clearDeprecationWarning(): StarlarkRunResponseLine;
+
   getRunResponseLineCase(): StarlarkRunResponseLine.RunResponseLineCase;
 
   serializeBinary(): Uint8Array;
@@ -320,6 +588,8 @@ export namespace StarlarkRunResponseLine {
     progressInfo?: StarlarkRunProgress.AsObject,
     instructionResult?: StarlarkInstructionResult.AsObject,
     runFinishedEvent?: StarlarkRunFinishedEvent.AsObject,
+    resourceEstimate?: StarlarkResourceEstimate.AsObject,
+    deprecationWarning?: StarlarkDeprecationWarning.AsObject,
   }
 
   export enum RunResponseLineCase { 
@@ -329,6 +599,36 @@ export namespace StarlarkRunResponseLine {
     PROGRESS_INFO = 3,
     INSTRUCTION_RESULT = 4,
     RUN_FINISHED_EVENT = 5,
+    RESOURCE_ESTIMATE = 6,
+    DEPRECATION_WARNING = 7,
+  }
+}
+
+export class StarlarkDeprecationWarning extends jspb.Message {
+  getCode(): string;
+  setCode(value: string): StarlarkDeprecationWarning;
+
+  getMessage(): string;
+  setMessage(value: string): StarlarkDeprecationWarning;
+
+  getPosition(): StarlarkInstructionPosition | undefined;
+  setPosition(value?: StarlarkInstructionPosition): StarlarkDeprecationWarning;
+  hasPosition(): boolean;
+  clearPosition(): StarlarkDeprecationWarning;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkDeprecationWarning.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkDeprecationWarning): StarlarkDeprecationWarning.AsObject;
+  static serializeBinaryToWriter(message: StarlarkDeprecationWarning, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkDeprecationWarning;
+  static deserializeBinaryFromReader(message: StarlarkDeprecationWarning, reader: jspb.BinaryReader): StarlarkDeprecationWarning;
+}
+
+export namespace StarlarkDeprecationWarning {
+  export type AsObject = {
+    code: string,
+    message: string,
+    position?: StarlarkInstructionPosition.AsObject,
   }
 }
 
@@ -575,6 +875,14 @@ export class StarlarkRunFinishedEvent extends jspb.Message {
   hasSerializedOutput(): boolean;
   clearSerializedOutput(): StarlarkRunFinishedEvent;
 
+  getRunId(): string;
+  setRunId(value: string): StarlarkRunFinishedEvent;
+  hasRunId(): boolean;
+  clearRunId(): StarlarkRunFinishedEvent;
+
+  getResolvedPackageVersionsMap(): jspb.Map<string, string>;
+  clearResolvedPackageVersionsMap(): StarlarkRunFinishedEvent;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): StarlarkRunFinishedEvent.AsObject;
   static toObject(includeInstance: boolean, msg: StarlarkRunFinishedEvent): StarlarkRunFinishedEvent.AsObject;
@@ -587,12 +895,114 @@ export namespace StarlarkRunFinishedEvent {
   export type AsObject = {
     isrunsuccessful: boolean,
     serializedOutput?: string,
+    runId?: string,
+    resolvedPackageVersionsMap: Array<[string, string]>,
   }
 
   export enum SerializedOutputCase { 
     _SERIALIZED_OUTPUT_NOT_SET = 0,
     SERIALIZED_OUTPUT = 2,
   }
+
+  export enum RunIdCase { 
+    _RUN_ID_NOT_SET = 0,
+    RUN_ID = 3,
+  }
+}
+
+export class StarlarkResourceEstimate extends jspb.Message {
+  getNumServices(): number;
+  setNumServices(value: number): StarlarkResourceEstimate;
+
+  getCpuAllocationMillicpus(): number;
+  setCpuAllocationMillicpus(value: number): StarlarkResourceEstimate;
+
+  getNumServicesWithoutCpuAllocation(): number;
+  setNumServicesWithoutCpuAllocation(value: number): StarlarkResourceEstimate;
+
+  getMemoryAllocationMegabytes(): number;
+  setMemoryAllocationMegabytes(value: number): StarlarkResourceEstimate;
+
+  getNumServicesWithoutMemoryAllocation(): number;
+  setNumServicesWithoutMemoryAllocation(value: number): StarlarkResourceEstimate;
+
+  getNumPorts(): number;
+  setNumPorts(value: number): StarlarkResourceEstimate;
+
+  getNumPublicPorts(): number;
+  setNumPublicPorts(value: number): StarlarkResourceEstimate;
+
+  getImageDownloadsList(): Array<StarlarkImageDownloadEstimate>;
+  setImageDownloadsList(value: Array<StarlarkImageDownloadEstimate>): StarlarkResourceEstimate;
+  clearImageDownloadsList(): StarlarkResourceEstimate;
+  addImageDownloads(value?: StarlarkImageDownloadEstimate, index?: number): StarlarkImageDownloadEstimate;
+
+  getHostNumCpus(): number;
+  setHostNumCpus(value: number): StarlarkResourceEstimate;
+
+  getHostMemoryBytes(): number;
+  setHostMemoryBytes(value: number): StarlarkResourceEstimate;
+
+  getWarningsList(): Array<string>;
+  setWarningsList(value: Array<string>): StarlarkResourceEstimate;
+  clearWarningsList(): StarlarkResourceEstimate;
+  addWarnings(value: string, index?: number): StarlarkResourceEstimate;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkResourceEstimate.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkResourceEstimate): StarlarkResourceEstimate.AsObject;
+  static serializeBinaryToWriter(message: StarlarkResourceEstimate, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkResourceEstimate;
+  static deserializeBinaryFromReader(message: StarlarkResourceEstimate, reader: jspb.BinaryReader): StarlarkResourceEstimate;
+}
+
+export namespace StarlarkResourceEstimate {
+  export type AsObject = {
+    numServices: number,
+    cpuAllocationMillicpus: number,
+    numServicesWithoutCpuAllocation: number,
+    memoryAllocationMegabytes: number,
+    numServicesWithoutMemoryAllocation: number,
+    numPorts: number,
+    numPublicPorts: number,
+    imageDownloadsList: Array<StarlarkImageDownloadEstimate.AsObject>,
+    hostNumCpus: number,
+    hostMemoryBytes: number,
+    warningsList: Array<string>,
+  }
+}
+
+export class StarlarkImageDownloadEstimate extends jspb.Message {
+  getImage(): string;
+  setImage(value: string): StarlarkImageDownloadEstimate;
+
+  getIsPresentOnHost(): boolean;
+  setIsPresentOnHost(value: boolean): StarlarkImageDownloadEstimate;
+
+  getDownloadSizeBytes(): number;
+  setDownloadSizeBytes(value: number): StarlarkImageDownloadEstimate;
+  hasDownloadSizeBytes(): boolean;
+  clearDownloadSizeBytes(): StarlarkImageDownloadEstimate;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkImageDownloadEstimate.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkImageDownloadEstimate): StarlarkImageDownloadEstimate.AsObject;
+  static serializeBinaryToWriter(message: StarlarkImageDownloadEstimate, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkImageDownloadEstimate;
+  static deserializeBinaryFromReader(message: StarlarkImageDownloadEstimate, reader: jspb.BinaryReader): StarlarkImageDownloadEstimate;
+}
+
+export namespace StarlarkImageDownloadEstimate {
+  export type AsObject = {
+    image: string,
+    isPresentOnHost: boolean,
+    downloadSizeBytes?: number,
+  }
+
+  export enum DownloadSizeBytesCase { 
+    _DOWNLOAD_SIZE_BYTES_NOT_SET = 0,
+    DOWNLOAD_SIZE_BYTES = 3,
+  }
 }
 
 export class StartServicesArgs extends jspb.Message {
@@ -717,175 +1127,557 @@ export namespace GetExistingAndHistoricalServiceIdentifiersResponse {
   }
 }
 
-export class RemoveServiceArgs extends jspb.Message {
-  getServiceIdentifier(): string;
-  setServiceIdentifier(value: string): RemoveServiceArgs;
+export class GetServiceConfigsResponse extends jspb.Message {
+  getServiceConfigsMap(): jspb.Map<string, ServiceConfig>;
+  clearServiceConfigsMap(): GetServiceConfigsResponse;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RemoveServiceArgs.AsObject;
-  static toObject(includeInstance: boolean, msg: RemoveServiceArgs): RemoveServiceArgs.AsObject;
-  static serializeBinaryToWriter(message: RemoveServiceArgs, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RemoveServiceArgs;
-  static deserializeBinaryFromReader(message: RemoveServiceArgs, reader: jspb.BinaryReader): RemoveServiceArgs;
+  toObject(includeInstance?: boolean): GetServiceConfigsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceConfigsResponse): GetServiceConfigsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServiceConfigsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceConfigsResponse;
+  static deserializeBinaryFromReader(message: GetServiceConfigsResponse, reader: jspb.BinaryReader): GetServiceConfigsResponse;
 }
 
-export namespace RemoveServiceArgs {
+export namespace GetServiceConfigsResponse {
   export type AsObject = {
-    serviceIdentifier: string,
+    serviceConfigsMap: Array<[string, ServiceConfig.AsObject]>,
   }
 }
 
-export class RemoveServiceResponse extends jspb.Message {
+export class ScrapeTarget extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ScrapeTarget;
+
   getServiceUuid(): string;
-  setServiceUuid(value: string): RemoveServiceResponse;
+  setServiceUuid(value: string): ScrapeTarget;
+
+  getMetricsPortId(): string;
+  setMetricsPortId(value: string): ScrapeTarget;
+
+  getPrivateAddress(): string;
+  setPrivateAddress(value: string): ScrapeTarget;
+
+  getPublicAddress(): string;
+  setPublicAddress(value: string): ScrapeTarget;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RemoveServiceResponse.AsObject;
-  static toObject(includeInstance: boolean, msg: RemoveServiceResponse): RemoveServiceResponse.AsObject;
-  static serializeBinaryToWriter(message: RemoveServiceResponse, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RemoveServiceResponse;
-  static deserializeBinaryFromReader(message: RemoveServiceResponse, reader: jspb.BinaryReader): RemoveServiceResponse;
+  toObject(includeInstance?: boolean): ScrapeTarget.AsObject;
+  static toObject(includeInstance: boolean, msg: ScrapeTarget): ScrapeTarget.AsObject;
+  static serializeBinaryToWriter(message: ScrapeTarget, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ScrapeTarget;
+  static deserializeBinaryFromReader(message: ScrapeTarget, reader: jspb.BinaryReader): ScrapeTarget;
 }
 
-export namespace RemoveServiceResponse {
+export namespace ScrapeTarget {
   export type AsObject = {
+    serviceName: string,
     serviceUuid: string,
+    metricsPortId: string,
+    privateAddress: string,
+    publicAddress: string,
   }
 }
 
-export class RepartitionArgs extends jspb.Message {
-  getPartitionServicesMap(): jspb.Map<string, PartitionServices>;
-  clearPartitionServicesMap(): RepartitionArgs;
+export class GetScrapeTargetsResponse extends jspb.Message {
+  getScrapeTargetsList(): Array<ScrapeTarget>;
+  setScrapeTargetsList(value: Array<ScrapeTarget>): GetScrapeTargetsResponse;
+  clearScrapeTargetsList(): GetScrapeTargetsResponse;
+  addScrapeTargets(value?: ScrapeTarget, index?: number): ScrapeTarget;
 
-  getPartitionConnectionsMap(): jspb.Map<string, PartitionConnections>;
-  clearPartitionConnectionsMap(): RepartitionArgs;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetScrapeTargetsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetScrapeTargetsResponse): GetScrapeTargetsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetScrapeTargetsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetScrapeTargetsResponse;
+  static deserializeBinaryFromReader(message: GetScrapeTargetsResponse, reader: jspb.BinaryReader): GetScrapeTargetsResponse;
+}
 
-  getDefaultConnection(): PartitionConnectionInfo | undefined;
-  setDefaultConnection(value?: PartitionConnectionInfo): RepartitionArgs;
-  hasDefaultConnection(): boolean;
-  clearDefaultConnection(): RepartitionArgs;
+export namespace GetScrapeTargetsResponse {
+  export type AsObject = {
+    scrapeTargetsList: Array<ScrapeTarget.AsObject>,
+  }
+}
+
+export class SubnetworkConnection extends jspb.Message {
+  getPacketLossPercentage(): number;
+  setPacketLossPercentage(value: number): SubnetworkConnection;
+
+  getPacketDelayMeanMs(): number;
+  setPacketDelayMeanMs(value: number): SubnetworkConnection;
+
+  getPacketDelayStdDevMs(): number;
+  setPacketDelayStdDevMs(value: number): SubnetworkConnection;
+
+  getPacketDelayCorrelation(): number;
+  setPacketDelayCorrelation(value: number): SubnetworkConnection;
+
+  getBandwidthLimitKbps(): number;
+  setBandwidthLimitKbps(value: number): SubnetworkConnection;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): RepartitionArgs.AsObject;
-  static toObject(includeInstance: boolean, msg: RepartitionArgs): RepartitionArgs.AsObject;
-  static serializeBinaryToWriter(message: RepartitionArgs, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): RepartitionArgs;
-  static deserializeBinaryFromReader(message: RepartitionArgs, reader: jspb.BinaryReader): RepartitionArgs;
+  toObject(includeInstance?: boolean): SubnetworkConnection.AsObject;
+  static toObject(includeInstance: boolean, msg: SubnetworkConnection): SubnetworkConnection.AsObject;
+  static serializeBinaryToWriter(message: SubnetworkConnection, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubnetworkConnection;
+  static deserializeBinaryFromReader(message: SubnetworkConnection, reader: jspb.BinaryReader): SubnetworkConnection;
 }
 
-export namespace RepartitionArgs {
+export namespace SubnetworkConnection {
   export type AsObject = {
-    partitionServicesMap: Array<[string, PartitionServices.AsObject]>,
-    partitionConnectionsMap: Array<[string, PartitionConnections.AsObject]>,
-    defaultConnection?: PartitionConnectionInfo.AsObject,
+    packetLossPercentage: number,
+    packetDelayMeanMs: number,
+    packetDelayStdDevMs: number,
+    packetDelayCorrelation: number,
+    bandwidthLimitKbps: number,
   }
 }
 
-export class PartitionServices extends jspb.Message {
+export class AddPartitionArgs extends jspb.Message {
+  getPartitionId(): string;
+  setPartitionId(value: string): AddPartitionArgs;
+
   getServiceNameSetMap(): jspb.Map<string, boolean>;
-  clearServiceNameSetMap(): PartitionServices;
+  clearServiceNameSetMap(): AddPartitionArgs;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PartitionServices.AsObject;
-  static toObject(includeInstance: boolean, msg: PartitionServices): PartitionServices.AsObject;
-  static serializeBinaryToWriter(message: PartitionServices, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PartitionServices;
-  static deserializeBinaryFromReader(message: PartitionServices, reader: jspb.BinaryReader): PartitionServices;
+  toObject(includeInstance?: boolean): AddPartitionArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: AddPartitionArgs): AddPartitionArgs.AsObject;
+  static serializeBinaryToWriter(message: AddPartitionArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddPartitionArgs;
+  static deserializeBinaryFromReader(message: AddPartitionArgs, reader: jspb.BinaryReader): AddPartitionArgs;
 }
 
-export namespace PartitionServices {
+export namespace AddPartitionArgs {
   export type AsObject = {
+    partitionId: string,
     serviceNameSetMap: Array<[string, boolean]>,
   }
 }
 
-export class PartitionConnections extends jspb.Message {
-  getConnectionInfoMap(): jspb.Map<string, PartitionConnectionInfo>;
-  clearConnectionInfoMap(): PartitionConnections;
+export class MovePartitionArgs extends jspb.Message {
+  getPartitionId(): string;
+  setPartitionId(value: string): MovePartitionArgs;
+
+  getServiceNameSetMap(): jspb.Map<string, boolean>;
+  clearServiceNameSetMap(): MovePartitionArgs;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PartitionConnections.AsObject;
-  static toObject(includeInstance: boolean, msg: PartitionConnections): PartitionConnections.AsObject;
-  static serializeBinaryToWriter(message: PartitionConnections, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PartitionConnections;
-  static deserializeBinaryFromReader(message: PartitionConnections, reader: jspb.BinaryReader): PartitionConnections;
+  toObject(includeInstance?: boolean): MovePartitionArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: MovePartitionArgs): MovePartitionArgs.AsObject;
+  static serializeBinaryToWriter(message: MovePartitionArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): MovePartitionArgs;
+  static deserializeBinaryFromReader(message: MovePartitionArgs, reader: jspb.BinaryReader): MovePartitionArgs;
 }
 
-export namespace PartitionConnections {
+export namespace MovePartitionArgs {
   export type AsObject = {
-    connectionInfoMap: Array<[string, PartitionConnectionInfo.AsObject]>,
+    partitionId: string,
+    serviceNameSetMap: Array<[string, boolean]>,
   }
 }
 
-export class PartitionConnectionInfo extends jspb.Message {
-  getPacketLossPercentage(): number;
-  setPacketLossPercentage(value: number): PartitionConnectionInfo;
+export class UpdateConnectionArgs extends jspb.Message {
+  getPartitionIdA(): string;
+  setPartitionIdA(value: string): UpdateConnectionArgs;
+
+  getPartitionIdB(): string;
+  setPartitionIdB(value: string): UpdateConnectionArgs;
+
+  getConnectionInfo(): PartitionConnectionInfo | undefined;
+  setConnectionInfo(value?: PartitionConnectionInfo): UpdateConnectionArgs;
+  hasConnectionInfo(): boolean;
+  clearConnectionInfo(): UpdateConnectionArgs;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PartitionConnectionInfo.AsObject;
-  static toObject(includeInstance: boolean, msg: PartitionConnectionInfo): PartitionConnectionInfo.AsObject;
-  static serializeBinaryToWriter(message: PartitionConnectionInfo, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PartitionConnectionInfo;
-  static deserializeBinaryFromReader(message: PartitionConnectionInfo, reader: jspb.BinaryReader): PartitionConnectionInfo;
+  toObject(includeInstance?: boolean): UpdateConnectionArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateConnectionArgs): UpdateConnectionArgs.AsObject;
+  static serializeBinaryToWriter(message: UpdateConnectionArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateConnectionArgs;
+  static deserializeBinaryFromReader(message: UpdateConnectionArgs, reader: jspb.BinaryReader): UpdateConnectionArgs;
 }
 
-export namespace PartitionConnectionInfo {
+export namespace UpdateConnectionArgs {
   export type AsObject = {
-    packetLossPercentage: number,
+    partitionIdA: string,
+    partitionIdB: string,
+    connectionInfo?: PartitionConnectionInfo.AsObject,
   }
 }
 
-export class ExecCommandArgs extends jspb.Message {
-  getServiceIdentifier(): string;
-  setServiceIdentifier(value: string): ExecCommandArgs;
+export class SubnetworkConnectionOverride extends jspb.Message {
+  getSubnetwork1(): string;
+  setSubnetwork1(value: string): SubnetworkConnectionOverride;
 
-  getCommandArgsList(): Array<string>;
-  setCommandArgsList(value: Array<string>): ExecCommandArgs;
-  clearCommandArgsList(): ExecCommandArgs;
-  addCommandArgs(value: string, index?: number): ExecCommandArgs;
+  getSubnetwork2(): string;
+  setSubnetwork2(value: string): SubnetworkConnectionOverride;
+
+  getConnection(): SubnetworkConnection | undefined;
+  setConnection(value?: SubnetworkConnection): SubnetworkConnectionOverride;
+  hasConnection(): boolean;
+  clearConnection(): SubnetworkConnectionOverride;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): ExecCommandArgs.AsObject;
-  static toObject(includeInstance: boolean, msg: ExecCommandArgs): ExecCommandArgs.AsObject;
-  static serializeBinaryToWriter(message: ExecCommandArgs, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): ExecCommandArgs;
-  static deserializeBinaryFromReader(message: ExecCommandArgs, reader: jspb.BinaryReader): ExecCommandArgs;
+  toObject(includeInstance?: boolean): SubnetworkConnectionOverride.AsObject;
+  static toObject(includeInstance: boolean, msg: SubnetworkConnectionOverride): SubnetworkConnectionOverride.AsObject;
+  static serializeBinaryToWriter(message: SubnetworkConnectionOverride, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SubnetworkConnectionOverride;
+  static deserializeBinaryFromReader(message: SubnetworkConnectionOverride, reader: jspb.BinaryReader): SubnetworkConnectionOverride;
 }
 
-export namespace ExecCommandArgs {
+export namespace SubnetworkConnectionOverride {
   export type AsObject = {
-    serviceIdentifier: string,
-    commandArgsList: Array<string>,
+    subnetwork1: string,
+    subnetwork2: string,
+    connection?: SubnetworkConnection.AsObject,
   }
 }
 
-export class PauseServiceArgs extends jspb.Message {
-  getServiceIdentifier(): string;
-  setServiceIdentifier(value: string): PauseServiceArgs;
+export class GetSubnetworkConnectionsResponse extends jspb.Message {
+  getDefaultConnection(): SubnetworkConnection | undefined;
+  setDefaultConnection(value?: SubnetworkConnection): GetSubnetworkConnectionsResponse;
+  hasDefaultConnection(): boolean;
+  clearDefaultConnection(): GetSubnetworkConnectionsResponse;
+
+  getConnectionOverridesList(): Array<SubnetworkConnectionOverride>;
+  setConnectionOverridesList(value: Array<SubnetworkConnectionOverride>): GetSubnetworkConnectionsResponse;
+  clearConnectionOverridesList(): GetSubnetworkConnectionsResponse;
+  addConnectionOverrides(value?: SubnetworkConnectionOverride, index?: number): SubnetworkConnectionOverride;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): PauseServiceArgs.AsObject;
-  static toObject(includeInstance: boolean, msg: PauseServiceArgs): PauseServiceArgs.AsObject;
-  static serializeBinaryToWriter(message: PauseServiceArgs, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): PauseServiceArgs;
-  static deserializeBinaryFromReader(message: PauseServiceArgs, reader: jspb.BinaryReader): PauseServiceArgs;
+  toObject(includeInstance?: boolean): GetSubnetworkConnectionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetSubnetworkConnectionsResponse): GetSubnetworkConnectionsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetSubnetworkConnectionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetSubnetworkConnectionsResponse;
+  static deserializeBinaryFromReader(message: GetSubnetworkConnectionsResponse, reader: jspb.BinaryReader): GetSubnetworkConnectionsResponse;
 }
 
-export namespace PauseServiceArgs {
+export namespace GetSubnetworkConnectionsResponse {
   export type AsObject = {
-    serviceIdentifier: string,
+    defaultConnection?: SubnetworkConnection.AsObject,
+    connectionOverridesList: Array<SubnetworkConnectionOverride.AsObject>,
   }
 }
 
-export class UnpauseServiceArgs extends jspb.Message {
-  getServiceIdentifier(): string;
-  setServiceIdentifier(value: string): UnpauseServiceArgs;
+export class TestConnectivityArgs extends jspb.Message {
+  getServiceIdentifiersMap(): jspb.Map<string, boolean>;
+  clearServiceIdentifiersMap(): TestConnectivityArgs;
+
+  getNumProbes(): number;
+  setNumProbes(value: number): TestConnectivityArgs;
 
   serializeBinary(): Uint8Array;
-  toObject(includeInstance?: boolean): UnpauseServiceArgs.AsObject;
-  static toObject(includeInstance: boolean, msg: UnpauseServiceArgs): UnpauseServiceArgs.AsObject;
-  static serializeBinaryToWriter(message: UnpauseServiceArgs, writer: jspb.BinaryWriter): void;
-  static deserializeBinary(bytes: Uint8Array): UnpauseServiceArgs;
+  toObject(includeInstance?: boolean): TestConnectivityArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: TestConnectivityArgs): TestConnectivityArgs.AsObject;
+  static serializeBinaryToWriter(message: TestConnectivityArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestConnectivityArgs;
+  static deserializeBinaryFromReader(message: TestConnectivityArgs, reader: jspb.BinaryReader): TestConnectivityArgs;
+}
+
+export namespace TestConnectivityArgs {
+  export type AsObject = {
+    serviceIdentifiersMap: Array<[string, boolean]>,
+    numProbes: number,
+  }
+}
+
+export class ConnectivityProbeResult extends jspb.Message {
+  getSourceServiceName(): string;
+  setSourceServiceName(value: string): ConnectivityProbeResult;
+
+  getTargetServiceName(): string;
+  setTargetServiceName(value: string): ConnectivityProbeResult;
+
+  getExpectedPacketLossPercentage(): number;
+  setExpectedPacketLossPercentage(value: number): ConnectivityProbeResult;
+
+  getExpectedPacketDelayMs(): number;
+  setExpectedPacketDelayMs(value: number): ConnectivityProbeResult;
+
+  getObservedPacketLossPercentage(): number;
+  setObservedPacketLossPercentage(value: number): ConnectivityProbeResult;
+
+  getObservedAvgLatencyMs(): number;
+  setObservedAvgLatencyMs(value: number): ConnectivityProbeResult;
+
+  getProbeError(): string;
+  setProbeError(value: string): ConnectivityProbeResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ConnectivityProbeResult.AsObject;
+  static toObject(includeInstance: boolean, msg: ConnectivityProbeResult): ConnectivityProbeResult.AsObject;
+  static serializeBinaryToWriter(message: ConnectivityProbeResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ConnectivityProbeResult;
+  static deserializeBinaryFromReader(message: ConnectivityProbeResult, reader: jspb.BinaryReader): ConnectivityProbeResult;
+}
+
+export namespace ConnectivityProbeResult {
+  export type AsObject = {
+    sourceServiceName: string,
+    targetServiceName: string,
+    expectedPacketLossPercentage: number,
+    expectedPacketDelayMs: number,
+    observedPacketLossPercentage: number,
+    observedAvgLatencyMs: number,
+    probeError: string,
+  }
+}
+
+export class TestConnectivityResponse extends jspb.Message {
+  getProbeResultsList(): Array<ConnectivityProbeResult>;
+  setProbeResultsList(value: Array<ConnectivityProbeResult>): TestConnectivityResponse;
+  clearProbeResultsList(): TestConnectivityResponse;
+  addProbeResults(value?: ConnectivityProbeResult, index?: number): ConnectivityProbeResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TestConnectivityResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TestConnectivityResponse): TestConnectivityResponse.AsObject;
+  static serializeBinaryToWriter(message: TestConnectivityResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TestConnectivityResponse;
+  static deserializeBinaryFromReader(message: TestConnectivityResponse, reader: jspb.BinaryReader): TestConnectivityResponse;
+}
+
+export namespace TestConnectivityResponse {
+  export type AsObject = {
+    probeResultsList: Array<ConnectivityProbeResult.AsObject>,
+  }
+}
+
+export class RemoveServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): RemoveServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveServiceArgs): RemoveServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: RemoveServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveServiceArgs;
+  static deserializeBinaryFromReader(message: RemoveServiceArgs, reader: jspb.BinaryReader): RemoveServiceArgs;
+}
+
+export namespace RemoveServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+  }
+}
+
+export class RemoveServiceResponse extends jspb.Message {
+  getServiceUuid(): string;
+  setServiceUuid(value: string): RemoveServiceResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveServiceResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveServiceResponse): RemoveServiceResponse.AsObject;
+  static serializeBinaryToWriter(message: RemoveServiceResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveServiceResponse;
+  static deserializeBinaryFromReader(message: RemoveServiceResponse, reader: jspb.BinaryReader): RemoveServiceResponse;
+}
+
+export namespace RemoveServiceResponse {
+  export type AsObject = {
+    serviceUuid: string,
+  }
+}
+
+export class RemoveServicesArgs extends jspb.Message {
+  getServiceIdentifiersMap(): jspb.Map<string, boolean>;
+  clearServiceIdentifiersMap(): RemoveServicesArgs;
+
+  getServiceNamePatternsList(): Array<string>;
+  setServiceNamePatternsList(value: Array<string>): RemoveServicesArgs;
+  clearServiceNamePatternsList(): RemoveServicesArgs;
+  addServiceNamePatterns(value: string, index?: number): RemoveServicesArgs;
+
+  getAllServices(): boolean;
+  setAllServices(value: boolean): RemoveServicesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveServicesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveServicesArgs): RemoveServicesArgs.AsObject;
+  static serializeBinaryToWriter(message: RemoveServicesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveServicesArgs;
+  static deserializeBinaryFromReader(message: RemoveServicesArgs, reader: jspb.BinaryReader): RemoveServicesArgs;
+}
+
+export namespace RemoveServicesArgs {
+  export type AsObject = {
+    serviceIdentifiersMap: Array<[string, boolean]>,
+    serviceNamePatternsList: Array<string>,
+    allServices: boolean,
+  }
+}
+
+export class RemoveServicesResponse extends jspb.Message {
+  getRemovedServiceNameToUuidMap(): jspb.Map<string, string>;
+  clearRemovedServiceNameToUuidMap(): RemoveServicesResponse;
+
+  getFailedServiceNameToErrorMap(): jspb.Map<string, string>;
+  clearFailedServiceNameToErrorMap(): RemoveServicesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveServicesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveServicesResponse): RemoveServicesResponse.AsObject;
+  static serializeBinaryToWriter(message: RemoveServicesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveServicesResponse;
+  static deserializeBinaryFromReader(message: RemoveServicesResponse, reader: jspb.BinaryReader): RemoveServicesResponse;
+}
+
+export namespace RemoveServicesResponse {
+  export type AsObject = {
+    removedServiceNameToUuidMap: Array<[string, string]>,
+    failedServiceNameToErrorMap: Array<[string, string]>,
+  }
+}
+
+export class RepartitionArgs extends jspb.Message {
+  getPartitionServicesMap(): jspb.Map<string, PartitionServices>;
+  clearPartitionServicesMap(): RepartitionArgs;
+
+  getPartitionConnectionsMap(): jspb.Map<string, PartitionConnections>;
+  clearPartitionConnectionsMap(): RepartitionArgs;
+
+  getDefaultConnection(): PartitionConnectionInfo | undefined;
+  setDefaultConnection(value?: PartitionConnectionInfo): RepartitionArgs;
+  hasDefaultConnection(): boolean;
+  clearDefaultConnection(): RepartitionArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RepartitionArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RepartitionArgs): RepartitionArgs.AsObject;
+  static serializeBinaryToWriter(message: RepartitionArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RepartitionArgs;
+  static deserializeBinaryFromReader(message: RepartitionArgs, reader: jspb.BinaryReader): RepartitionArgs;
+}
+
+export namespace RepartitionArgs {
+  export type AsObject = {
+    partitionServicesMap: Array<[string, PartitionServices.AsObject]>,
+    partitionConnectionsMap: Array<[string, PartitionConnections.AsObject]>,
+    defaultConnection?: PartitionConnectionInfo.AsObject,
+  }
+}
+
+export class PartitionServices extends jspb.Message {
+  getServiceNameSetMap(): jspb.Map<string, boolean>;
+  clearServiceNameSetMap(): PartitionServices;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PartitionServices.AsObject;
+  static toObject(includeInstance: boolean, msg: PartitionServices): PartitionServices.AsObject;
+  static serializeBinaryToWriter(message: PartitionServices, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PartitionServices;
+  static deserializeBinaryFromReader(message: PartitionServices, reader: jspb.BinaryReader): PartitionServices;
+}
+
+export namespace PartitionServices {
+  export type AsObject = {
+    serviceNameSetMap: Array<[string, boolean]>,
+  }
+}
+
+export class PartitionConnections extends jspb.Message {
+  getConnectionInfoMap(): jspb.Map<string, PartitionConnectionInfo>;
+  clearConnectionInfoMap(): PartitionConnections;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PartitionConnections.AsObject;
+  static toObject(includeInstance: boolean, msg: PartitionConnections): PartitionConnections.AsObject;
+  static serializeBinaryToWriter(message: PartitionConnections, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PartitionConnections;
+  static deserializeBinaryFromReader(message: PartitionConnections, reader: jspb.BinaryReader): PartitionConnections;
+}
+
+export namespace PartitionConnections {
+  export type AsObject = {
+    connectionInfoMap: Array<[string, PartitionConnectionInfo.AsObject]>,
+  }
+}
+
+export class PartitionConnectionInfo extends jspb.Message {
+  getPacketLossPercentage(): number;
+  setPacketLossPercentage(value: number): PartitionConnectionInfo;
+
+  getPacketDelayMeanMs(): number;
+  setPacketDelayMeanMs(value: number): PartitionConnectionInfo;
+
+  getPacketDelayStdDevMs(): number;
+  setPacketDelayStdDevMs(value: number): PartitionConnectionInfo;
+
+  getPacketDelayCorrelation(): number;
+  setPacketDelayCorrelation(value: number): PartitionConnectionInfo;
+
+  getBandwidthLimitKbps(): number;
+  setBandwidthLimitKbps(value: number): PartitionConnectionInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PartitionConnectionInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: PartitionConnectionInfo): PartitionConnectionInfo.AsObject;
+  static serializeBinaryToWriter(message: PartitionConnectionInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PartitionConnectionInfo;
+  static deserializeBinaryFromReader(message: PartitionConnectionInfo, reader: jspb.BinaryReader): PartitionConnectionInfo;
+}
+
+export namespace PartitionConnectionInfo {
+  export type AsObject = {
+    packetLossPercentage: number,
+    packetDelayMeanMs: number,
+    packetDelayStdDevMs: number,
+    packetDelayCorrelation: number,
+    bandwidthLimitKbps: number,
+  }
+}
+
+export class ExecCommandArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): ExecCommandArgs;
+
+  getCommandArgsList(): Array<string>;
+  setCommandArgsList(value: Array<string>): ExecCommandArgs;
+  clearCommandArgsList(): ExecCommandArgs;
+  addCommandArgs(value: string, index?: number): ExecCommandArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExecCommandArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ExecCommandArgs): ExecCommandArgs.AsObject;
+  static serializeBinaryToWriter(message: ExecCommandArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExecCommandArgs;
+  static deserializeBinaryFromReader(message: ExecCommandArgs, reader: jspb.BinaryReader): ExecCommandArgs;
+}
+
+export namespace ExecCommandArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    commandArgsList: Array<string>,
+  }
+}
+
+export class PauseServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): PauseServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PauseServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: PauseServiceArgs): PauseServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: PauseServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PauseServiceArgs;
+  static deserializeBinaryFromReader(message: PauseServiceArgs, reader: jspb.BinaryReader): PauseServiceArgs;
+}
+
+export namespace PauseServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+  }
+}
+
+export class UnpauseServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): UnpauseServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UnpauseServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: UnpauseServiceArgs): UnpauseServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: UnpauseServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UnpauseServiceArgs;
   static deserializeBinaryFromReader(message: UnpauseServiceArgs, reader: jspb.BinaryReader): UnpauseServiceArgs;
 }
 
@@ -895,6 +1687,130 @@ export namespace UnpauseServiceArgs {
   }
 }
 
+export class PauseServicesArgs extends jspb.Message {
+  getServiceIdentifiersMap(): jspb.Map<string, boolean>;
+  clearServiceIdentifiersMap(): PauseServicesArgs;
+
+  getAllServices(): boolean;
+  setAllServices(value: boolean): PauseServicesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PauseServicesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: PauseServicesArgs): PauseServicesArgs.AsObject;
+  static serializeBinaryToWriter(message: PauseServicesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PauseServicesArgs;
+  static deserializeBinaryFromReader(message: PauseServicesArgs, reader: jspb.BinaryReader): PauseServicesArgs;
+}
+
+export namespace PauseServicesArgs {
+  export type AsObject = {
+    serviceIdentifiersMap: Array<[string, boolean]>,
+    allServices: boolean,
+  }
+}
+
+export class PauseServicesResponse extends jspb.Message {
+  getSuccessfulServiceNamesMap(): jspb.Map<string, boolean>;
+  clearSuccessfulServiceNamesMap(): PauseServicesResponse;
+
+  getFailedServiceNameToErrorMap(): jspb.Map<string, string>;
+  clearFailedServiceNameToErrorMap(): PauseServicesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PauseServicesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PauseServicesResponse): PauseServicesResponse.AsObject;
+  static serializeBinaryToWriter(message: PauseServicesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PauseServicesResponse;
+  static deserializeBinaryFromReader(message: PauseServicesResponse, reader: jspb.BinaryReader): PauseServicesResponse;
+}
+
+export namespace PauseServicesResponse {
+  export type AsObject = {
+    successfulServiceNamesMap: Array<[string, boolean]>,
+    failedServiceNameToErrorMap: Array<[string, string]>,
+  }
+}
+
+export class UnpauseServicesArgs extends jspb.Message {
+  getServiceIdentifiersMap(): jspb.Map<string, boolean>;
+  clearServiceIdentifiersMap(): UnpauseServicesArgs;
+
+  getAllServices(): boolean;
+  setAllServices(value: boolean): UnpauseServicesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UnpauseServicesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: UnpauseServicesArgs): UnpauseServicesArgs.AsObject;
+  static serializeBinaryToWriter(message: UnpauseServicesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UnpauseServicesArgs;
+  static deserializeBinaryFromReader(message: UnpauseServicesArgs, reader: jspb.BinaryReader): UnpauseServicesArgs;
+}
+
+export namespace UnpauseServicesArgs {
+  export type AsObject = {
+    serviceIdentifiersMap: Array<[string, boolean]>,
+    allServices: boolean,
+  }
+}
+
+export class UnpauseServicesResponse extends jspb.Message {
+  getSuccessfulServiceNamesMap(): jspb.Map<string, boolean>;
+  clearSuccessfulServiceNamesMap(): UnpauseServicesResponse;
+
+  getFailedServiceNameToErrorMap(): jspb.Map<string, string>;
+  clearFailedServiceNameToErrorMap(): UnpauseServicesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UnpauseServicesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UnpauseServicesResponse): UnpauseServicesResponse.AsObject;
+  static serializeBinaryToWriter(message: UnpauseServicesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UnpauseServicesResponse;
+  static deserializeBinaryFromReader(message: UnpauseServicesResponse, reader: jspb.BinaryReader): UnpauseServicesResponse;
+}
+
+export namespace UnpauseServicesResponse {
+  export type AsObject = {
+    successfulServiceNamesMap: Array<[string, boolean]>,
+    failedServiceNameToErrorMap: Array<[string, string]>,
+  }
+}
+
+export class WaitForServiceExitArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): WaitForServiceExitArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WaitForServiceExitArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: WaitForServiceExitArgs): WaitForServiceExitArgs.AsObject;
+  static serializeBinaryToWriter(message: WaitForServiceExitArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WaitForServiceExitArgs;
+  static deserializeBinaryFromReader(message: WaitForServiceExitArgs, reader: jspb.BinaryReader): WaitForServiceExitArgs;
+}
+
+export namespace WaitForServiceExitArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+  }
+}
+
+export class WaitForServiceExitResponse extends jspb.Message {
+  getExitCode(): number;
+  setExitCode(value: number): WaitForServiceExitResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WaitForServiceExitResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: WaitForServiceExitResponse): WaitForServiceExitResponse.AsObject;
+  static serializeBinaryToWriter(message: WaitForServiceExitResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WaitForServiceExitResponse;
+  static deserializeBinaryFromReader(message: WaitForServiceExitResponse, reader: jspb.BinaryReader): WaitForServiceExitResponse;
+}
+
+export namespace WaitForServiceExitResponse {
+  export type AsObject = {
+    exitCode: number,
+  }
+}
+
 export class ExecCommandResponse extends jspb.Message {
   getExitCode(): number;
   setExitCode(value: number): ExecCommandResponse;
@@ -910,10 +1826,100 @@ export class ExecCommandResponse extends jspb.Message {
   static deserializeBinaryFromReader(message: ExecCommandResponse, reader: jspb.BinaryReader): ExecCommandResponse;
 }
 
-export namespace ExecCommandResponse {
+export namespace ExecCommandResponse {
+  export type AsObject = {
+    exitCode: number,
+    logOutput: string,
+  }
+}
+
+export class ExecCommandStreamedOutputChunk extends jspb.Message {
+  getOutput(): Uint8Array | string;
+  getOutput_asU8(): Uint8Array;
+  getOutput_asB64(): string;
+  setOutput(value: Uint8Array | string): ExecCommandStreamedOutputChunk;
+
+  getExitCode(): number;
+  setExitCode(value: number): ExecCommandStreamedOutputChunk;
+
+  getChunkCase(): ExecCommandStreamedOutputChunk.ChunkCase;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExecCommandStreamedOutputChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: ExecCommandStreamedOutputChunk): ExecCommandStreamedOutputChunk.AsObject;
+  static serializeBinaryToWriter(message: ExecCommandStreamedOutputChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExecCommandStreamedOutputChunk;
+  static deserializeBinaryFromReader(message: ExecCommandStreamedOutputChunk, reader: jspb.BinaryReader): ExecCommandStreamedOutputChunk;
+}
+
+export namespace ExecCommandStreamedOutputChunk {
+  export type AsObject = {
+    output: Uint8Array | string,
+    exitCode: number,
+  }
+
+  export enum ChunkCase { 
+    CHUNK_NOT_SET = 0,
+    OUTPUT = 1,
+    EXIT_CODE = 2,
+  }
+}
+
+export class GetServiceMetricsArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): GetServiceMetricsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceMetricsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceMetricsArgs): GetServiceMetricsArgs.AsObject;
+  static serializeBinaryToWriter(message: GetServiceMetricsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceMetricsArgs;
+  static deserializeBinaryFromReader(message: GetServiceMetricsArgs, reader: jspb.BinaryReader): GetServiceMetricsArgs;
+}
+
+export namespace GetServiceMetricsArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+  }
+}
+
+export class GetServiceMetricsResponse extends jspb.Message {
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceMetricsResponse;
+  hasTimestamp(): boolean;
+  clearTimestamp(): GetServiceMetricsResponse;
+
+  getCpuUsagePercent(): number;
+  setCpuUsagePercent(value: number): GetServiceMetricsResponse;
+
+  getMemoryUsageBytes(): number;
+  setMemoryUsageBytes(value: number): GetServiceMetricsResponse;
+
+  getMemoryLimitBytes(): number;
+  setMemoryLimitBytes(value: number): GetServiceMetricsResponse;
+
+  getNetworkRxBytes(): number;
+  setNetworkRxBytes(value: number): GetServiceMetricsResponse;
+
+  getNetworkTxBytes(): number;
+  setNetworkTxBytes(value: number): GetServiceMetricsResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetServiceMetricsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetServiceMetricsResponse): GetServiceMetricsResponse.AsObject;
+  static serializeBinaryToWriter(message: GetServiceMetricsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetServiceMetricsResponse;
+  static deserializeBinaryFromReader(message: GetServiceMetricsResponse, reader: jspb.BinaryReader): GetServiceMetricsResponse;
+}
+
+export namespace GetServiceMetricsResponse {
   export type AsObject = {
-    exitCode: number,
-    logOutput: string,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    cpuUsagePercent: number,
+    memoryUsageBytes: number,
+    memoryLimitBytes: number,
+    networkRxBytes: number,
+    networkTxBytes: number,
   }
 }
 
@@ -1014,6 +2020,11 @@ export class UploadFilesArtifactArgs extends jspb.Message {
   getName(): string;
   setName(value: string): UploadFilesArtifactArgs;
 
+  getContentHash(): string;
+  setContentHash(value: string): UploadFilesArtifactArgs;
+  hasContentHash(): boolean;
+  clearContentHash(): UploadFilesArtifactArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): UploadFilesArtifactArgs.AsObject;
   static toObject(includeInstance: boolean, msg: UploadFilesArtifactArgs): UploadFilesArtifactArgs.AsObject;
@@ -1026,6 +2037,12 @@ export namespace UploadFilesArtifactArgs {
   export type AsObject = {
     data: Uint8Array | string,
     name: string,
+    contentHash?: string,
+  }
+
+  export enum ContentHashCase { 
+    _CONTENT_HASH_NOT_SET = 0,
+    CONTENT_HASH = 3,
   }
 }
 
@@ -1036,6 +2053,9 @@ export class UploadFilesArtifactResponse extends jspb.Message {
   getName(): string;
   setName(value: string): UploadFilesArtifactResponse;
 
+  getIsAlreadyStored(): boolean;
+  setIsAlreadyStored(value: boolean): UploadFilesArtifactResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): UploadFilesArtifactResponse.AsObject;
   static toObject(includeInstance: boolean, msg: UploadFilesArtifactResponse): UploadFilesArtifactResponse.AsObject;
@@ -1048,6 +2068,7 @@ export namespace UploadFilesArtifactResponse {
   export type AsObject = {
     uuid: string,
     name: string,
+    isAlreadyStored: boolean,
   }
 }
 
@@ -1089,6 +2110,30 @@ export namespace DownloadFilesArtifactResponse {
   }
 }
 
+export class FilesArtifactStreamChunk extends jspb.Message {
+  getData(): Uint8Array | string;
+  getData_asU8(): Uint8Array;
+  getData_asB64(): string;
+  setData(value: Uint8Array | string): FilesArtifactStreamChunk;
+
+  getName(): string;
+  setName(value: string): FilesArtifactStreamChunk;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FilesArtifactStreamChunk.AsObject;
+  static toObject(includeInstance: boolean, msg: FilesArtifactStreamChunk): FilesArtifactStreamChunk.AsObject;
+  static serializeBinaryToWriter(message: FilesArtifactStreamChunk, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FilesArtifactStreamChunk;
+  static deserializeBinaryFromReader(message: FilesArtifactStreamChunk, reader: jspb.BinaryReader): FilesArtifactStreamChunk;
+}
+
+export namespace FilesArtifactStreamChunk {
+  export type AsObject = {
+    data: Uint8Array | string,
+    name: string,
+  }
+}
+
 export class StoreWebFilesArtifactArgs extends jspb.Message {
   getUrl(): string;
   setUrl(value: string): StoreWebFilesArtifactArgs;
@@ -1243,6 +2288,12 @@ export class FilesArtifactNameAndUuid extends jspb.Message {
   getFileuuid(): string;
   setFileuuid(value: string): FilesArtifactNameAndUuid;
 
+  getSizeBytes(): number;
+  setSizeBytes(value: number): FilesArtifactNameAndUuid;
+
+  getCreationTimeUnixSeconds(): number;
+  setCreationTimeUnixSeconds(value: number): FilesArtifactNameAndUuid;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): FilesArtifactNameAndUuid.AsObject;
   static toObject(includeInstance: boolean, msg: FilesArtifactNameAndUuid): FilesArtifactNameAndUuid.AsObject;
@@ -1255,6 +2306,8 @@ export namespace FilesArtifactNameAndUuid {
   export type AsObject = {
     filename: string,
     fileuuid: string,
+    sizeBytes: number,
+    creationTimeUnixSeconds: number,
   }
 }
 
@@ -1278,3 +2331,557 @@ export namespace ListFilesArtifactNamesAndUuidsResponse {
   }
 }
 
+export class DeleteFilesArtifactArgs extends jspb.Message {
+  getIdentifier(): string;
+  setIdentifier(value: string): DeleteFilesArtifactArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeleteFilesArtifactArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: DeleteFilesArtifactArgs): DeleteFilesArtifactArgs.AsObject;
+  static serializeBinaryToWriter(message: DeleteFilesArtifactArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeleteFilesArtifactArgs;
+  static deserializeBinaryFromReader(message: DeleteFilesArtifactArgs, reader: jspb.BinaryReader): DeleteFilesArtifactArgs;
+}
+
+export namespace DeleteFilesArtifactArgs {
+  export type AsObject = {
+    identifier: string,
+  }
+}
+
+export class InspectFilesArtifactContentsArgs extends jspb.Message {
+  getIdentifier(): string;
+  setIdentifier(value: string): InspectFilesArtifactContentsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InspectFilesArtifactContentsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: InspectFilesArtifactContentsArgs): InspectFilesArtifactContentsArgs.AsObject;
+  static serializeBinaryToWriter(message: InspectFilesArtifactContentsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InspectFilesArtifactContentsArgs;
+  static deserializeBinaryFromReader(message: InspectFilesArtifactContentsArgs, reader: jspb.BinaryReader): InspectFilesArtifactContentsArgs;
+}
+
+export namespace InspectFilesArtifactContentsArgs {
+  export type AsObject = {
+    identifier: string,
+  }
+}
+
+export class InspectFilesArtifactContentsResponse extends jspb.Message {
+  getFileDescriptionsList(): Array<FilesArtifactContentsFileDescription>;
+  setFileDescriptionsList(value: Array<FilesArtifactContentsFileDescription>): InspectFilesArtifactContentsResponse;
+  clearFileDescriptionsList(): InspectFilesArtifactContentsResponse;
+  addFileDescriptions(value?: FilesArtifactContentsFileDescription, index?: number): FilesArtifactContentsFileDescription;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): InspectFilesArtifactContentsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: InspectFilesArtifactContentsResponse): InspectFilesArtifactContentsResponse.AsObject;
+  static serializeBinaryToWriter(message: InspectFilesArtifactContentsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): InspectFilesArtifactContentsResponse;
+  static deserializeBinaryFromReader(message: InspectFilesArtifactContentsResponse, reader: jspb.BinaryReader): InspectFilesArtifactContentsResponse;
+}
+
+export namespace InspectFilesArtifactContentsResponse {
+  export type AsObject = {
+    fileDescriptionsList: Array<FilesArtifactContentsFileDescription.AsObject>,
+  }
+}
+
+export class FilesArtifactContentsFileDescription extends jspb.Message {
+  getPath(): string;
+  setPath(value: string): FilesArtifactContentsFileDescription;
+
+  getSizeBytes(): number;
+  setSizeBytes(value: number): FilesArtifactContentsFileDescription;
+
+  getIsDirectory(): boolean;
+  setIsDirectory(value: boolean): FilesArtifactContentsFileDescription;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FilesArtifactContentsFileDescription.AsObject;
+  static toObject(includeInstance: boolean, msg: FilesArtifactContentsFileDescription): FilesArtifactContentsFileDescription.AsObject;
+  static serializeBinaryToWriter(message: FilesArtifactContentsFileDescription, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FilesArtifactContentsFileDescription;
+  static deserializeBinaryFromReader(message: FilesArtifactContentsFileDescription, reader: jspb.BinaryReader): FilesArtifactContentsFileDescription;
+}
+
+export namespace FilesArtifactContentsFileDescription {
+  export type AsObject = {
+    path: string,
+    sizeBytes: number,
+    isDirectory: boolean,
+  }
+}
+
+export class SetLogLevelArgs extends jspb.Message {
+  getLogLevel(): string;
+  setLogLevel(value: string): SetLogLevelArgs;
+
+  getRevertAfterSeconds(): number;
+  setRevertAfterSeconds(value: number): SetLogLevelArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetLogLevelArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: SetLogLevelArgs): SetLogLevelArgs.AsObject;
+  static serializeBinaryToWriter(message: SetLogLevelArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetLogLevelArgs;
+  static deserializeBinaryFromReader(message: SetLogLevelArgs, reader: jspb.BinaryReader): SetLogLevelArgs;
+}
+
+export namespace SetLogLevelArgs {
+  export type AsObject = {
+    logLevel: string,
+    revertAfterSeconds: number,
+  }
+}
+
+export class SetLogLevelResponse extends jspb.Message {
+  getPreviousLogLevel(): string;
+  setPreviousLogLevel(value: string): SetLogLevelResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetLogLevelResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetLogLevelResponse): SetLogLevelResponse.AsObject;
+  static serializeBinaryToWriter(message: SetLogLevelResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetLogLevelResponse;
+  static deserializeBinaryFromReader(message: SetLogLevelResponse, reader: jspb.BinaryReader): SetLogLevelResponse;
+}
+
+export namespace SetLogLevelResponse {
+  export type AsObject = {
+    previousLogLevel: string,
+  }
+}
+
+export class ShutdownHookResult extends jspb.Message {
+  getDescription(): string;
+  setDescription(value: string): ShutdownHookResult;
+
+  getError(): string;
+  setError(value: string): ShutdownHookResult;
+  hasError(): boolean;
+  clearError(): ShutdownHookResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ShutdownHookResult.AsObject;
+  static toObject(includeInstance: boolean, msg: ShutdownHookResult): ShutdownHookResult.AsObject;
+  static serializeBinaryToWriter(message: ShutdownHookResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ShutdownHookResult;
+  static deserializeBinaryFromReader(message: ShutdownHookResult, reader: jspb.BinaryReader): ShutdownHookResult;
+}
+
+export namespace ShutdownHookResult {
+  export type AsObject = {
+    description: string,
+    error?: string,
+  }
+
+  export enum ErrorCase { 
+    _ERROR_NOT_SET = 0,
+    ERROR = 2,
+  }
+}
+
+export class RunShutdownHooksResponse extends jspb.Message {
+  getResultsList(): Array<ShutdownHookResult>;
+  setResultsList(value: Array<ShutdownHookResult>): RunShutdownHooksResponse;
+  clearResultsList(): RunShutdownHooksResponse;
+  addResults(value?: ShutdownHookResult, index?: number): ShutdownHookResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunShutdownHooksResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RunShutdownHooksResponse): RunShutdownHooksResponse.AsObject;
+  static serializeBinaryToWriter(message: RunShutdownHooksResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunShutdownHooksResponse;
+  static deserializeBinaryFromReader(message: RunShutdownHooksResponse, reader: jspb.BinaryReader): RunShutdownHooksResponse;
+}
+
+export namespace RunShutdownHooksResponse {
+  export type AsObject = {
+    resultsList: Array<ShutdownHookResult.AsObject>,
+  }
+}
+
+export class HttpRequestServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): HttpRequestServiceArgs;
+
+  getPortId(): string;
+  setPortId(value: string): HttpRequestServiceArgs;
+
+  getMethod(): string;
+  setMethod(value: string): HttpRequestServiceArgs;
+
+  getPath(): string;
+  setPath(value: string): HttpRequestServiceArgs;
+
+  getHeadersMap(): jspb.Map<string, string>;
+  clearHeadersMap(): HttpRequestServiceArgs;
+
+  getBody(): string;
+  setBody(value: string): HttpRequestServiceArgs;
+
+  getUseHttps(): boolean;
+  setUseHttps(value: boolean): HttpRequestServiceArgs;
+
+  getSkipTlsVerification(): boolean;
+  setSkipTlsVerification(value: boolean): HttpRequestServiceArgs;
+
+  getTimeoutSeconds(): number;
+  setTimeoutSeconds(value: number): HttpRequestServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpRequestServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpRequestServiceArgs): HttpRequestServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: HttpRequestServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpRequestServiceArgs;
+  static deserializeBinaryFromReader(message: HttpRequestServiceArgs, reader: jspb.BinaryReader): HttpRequestServiceArgs;
+}
+
+export namespace HttpRequestServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    portId: string,
+    method: string,
+    path: string,
+    headersMap: Array<[string, string]>,
+    body: string,
+    useHttps: boolean,
+    skipTlsVerification: boolean,
+    timeoutSeconds: number,
+  }
+}
+
+export class HttpHeader extends jspb.Message {
+  getName(): string;
+  setName(value: string): HttpHeader;
+
+  getValue(): string;
+  setValue(value: string): HttpHeader;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpHeader.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpHeader): HttpHeader.AsObject;
+  static serializeBinaryToWriter(message: HttpHeader, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpHeader;
+  static deserializeBinaryFromReader(message: HttpHeader, reader: jspb.BinaryReader): HttpHeader;
+}
+
+export namespace HttpHeader {
+  export type AsObject = {
+    name: string,
+    value: string,
+  }
+}
+
+export class HttpRequestServiceResponse extends jspb.Message {
+  getUrl(): string;
+  setUrl(value: string): HttpRequestServiceResponse;
+
+  getStatus(): string;
+  setStatus(value: string): HttpRequestServiceResponse;
+
+  getStatusCode(): number;
+  setStatusCode(value: number): HttpRequestServiceResponse;
+
+  getHeadersList(): Array<HttpHeader>;
+  setHeadersList(value: Array<HttpHeader>): HttpRequestServiceResponse;
+  clearHeadersList(): HttpRequestServiceResponse;
+  addHeaders(value?: HttpHeader, index?: number): HttpHeader;
+
+  getBody(): Uint8Array | string;
+  getBody_asU8(): Uint8Array;
+  getBody_asB64(): string;
+  setBody(value: Uint8Array | string): HttpRequestServiceResponse;
+
+  getIsBodyTruncated(): boolean;
+  setIsBodyTruncated(value: boolean): HttpRequestServiceResponse;
+
+  getConnectionDurationMicros(): number;
+  setConnectionDurationMicros(value: number): HttpRequestServiceResponse;
+
+  getTimeToFirstByteMicros(): number;
+  setTimeToFirstByteMicros(value: number): HttpRequestServiceResponse;
+
+  getTotalDurationMicros(): number;
+  setTotalDurationMicros(value: number): HttpRequestServiceResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpRequestServiceResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpRequestServiceResponse): HttpRequestServiceResponse.AsObject;
+  static serializeBinaryToWriter(message: HttpRequestServiceResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpRequestServiceResponse;
+  static deserializeBinaryFromReader(message: HttpRequestServiceResponse, reader: jspb.BinaryReader): HttpRequestServiceResponse;
+}
+
+export namespace HttpRequestServiceResponse {
+  export type AsObject = {
+    url: string,
+    status: string,
+    statusCode: number,
+    headersList: Array<HttpHeader.AsObject>,
+    body: Uint8Array | string,
+    isBodyTruncated: boolean,
+    connectionDurationMicros: number,
+    timeToFirstByteMicros: number,
+    totalDurationMicros: number,
+  }
+}
+
+export class GetBackendCapabilitiesResponse extends jspb.Message {
+  getIsNetworkPartitioningSupported(): boolean;
+  setIsNetworkPartitioningSupported(value: boolean): GetBackendCapabilitiesResponse;
+
+  getIsServicePausingSupported(): boolean;
+  setIsServicePausingSupported(value: boolean): GetBackendCapabilitiesResponse;
+
+  getAreStaticPublicPortsSupported(): boolean;
+  setAreStaticPublicPortsSupported(value: boolean): GetBackendCapabilitiesResponse;
+
+  getArePersistentVolumesSupported(): boolean;
+  setArePersistentVolumesSupported(value: boolean): GetBackendCapabilitiesResponse;
+
+  getIsExecTtySupported(): boolean;
+  setIsExecTtySupported(value: boolean): GetBackendCapabilitiesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetBackendCapabilitiesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetBackendCapabilitiesResponse): GetBackendCapabilitiesResponse.AsObject;
+  static serializeBinaryToWriter(message: GetBackendCapabilitiesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetBackendCapabilitiesResponse;
+  static deserializeBinaryFromReader(message: GetBackendCapabilitiesResponse, reader: jspb.BinaryReader): GetBackendCapabilitiesResponse;
+}
+
+export namespace GetBackendCapabilitiesResponse {
+  export type AsObject = {
+    isNetworkPartitioningSupported: boolean,
+    isServicePausingSupported: boolean,
+    areStaticPublicPortsSupported: boolean,
+    arePersistentVolumesSupported: boolean,
+    isExecTtySupported: boolean,
+  }
+}
+
+export class CachedPackage extends jspb.Message {
+  getRepository(): string;
+  setRepository(value: string): CachedPackage;
+
+  getSizeBytes(): number;
+  setSizeBytes(value: number): CachedPackage;
+
+  getLastUsedUnixSeconds(): number;
+  setLastUsedUnixSeconds(value: number): CachedPackage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CachedPackage.AsObject;
+  static toObject(includeInstance: boolean, msg: CachedPackage): CachedPackage.AsObject;
+  static serializeBinaryToWriter(message: CachedPackage, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CachedPackage;
+  static deserializeBinaryFromReader(message: CachedPackage, reader: jspb.BinaryReader): CachedPackage;
+}
+
+export namespace CachedPackage {
+  export type AsObject = {
+    repository: string,
+    sizeBytes: number,
+    lastUsedUnixSeconds: number,
+  }
+}
+
+export class PackageCacheInfo extends jspb.Message {
+  getCachedPackagesList(): Array<CachedPackage>;
+  setCachedPackagesList(value: Array<CachedPackage>): PackageCacheInfo;
+  clearCachedPackagesList(): PackageCacheInfo;
+  addCachedPackages(value?: CachedPackage, index?: number): CachedPackage;
+
+  getTotalSizeBytes(): number;
+  setTotalSizeBytes(value: number): PackageCacheInfo;
+
+  getMaxSizeBytes(): number;
+  setMaxSizeBytes(value: number): PackageCacheInfo;
+
+  getNumHits(): number;
+  setNumHits(value: number): PackageCacheInfo;
+
+  getNumMisses(): number;
+  setNumMisses(value: number): PackageCacheInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PackageCacheInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: PackageCacheInfo): PackageCacheInfo.AsObject;
+  static serializeBinaryToWriter(message: PackageCacheInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PackageCacheInfo;
+  static deserializeBinaryFromReader(message: PackageCacheInfo, reader: jspb.BinaryReader): PackageCacheInfo;
+}
+
+export namespace PackageCacheInfo {
+  export type AsObject = {
+    cachedPackagesList: Array<CachedPackage.AsObject>,
+    totalSizeBytes: number,
+    maxSizeBytes: number,
+    numHits: number,
+    numMisses: number,
+  }
+}
+
+export class ClearPackageCacheResponse extends jspb.Message {
+  getRemovedRepositoriesList(): Array<string>;
+  setRemovedRepositoriesList(value: Array<string>): ClearPackageCacheResponse;
+  clearRemovedRepositoriesList(): ClearPackageCacheResponse;
+  addRemovedRepositories(value: string, index?: number): ClearPackageCacheResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ClearPackageCacheResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ClearPackageCacheResponse): ClearPackageCacheResponse.AsObject;
+  static serializeBinaryToWriter(message: ClearPackageCacheResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ClearPackageCacheResponse;
+  static deserializeBinaryFromReader(message: ClearPackageCacheResponse, reader: jspb.BinaryReader): ClearPackageCacheResponse;
+}
+
+export namespace ClearPackageCacheResponse {
+  export type AsObject = {
+    removedRepositoriesList: Array<string>,
+  }
+}
+
+export class GetStarlarkRunProfileArgs extends jspb.Message {
+  getRunId(): string;
+  setRunId(value: string): GetStarlarkRunProfileArgs;
+  hasRunId(): boolean;
+  clearRunId(): GetStarlarkRunProfileArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStarlarkRunProfileArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStarlarkRunProfileArgs): GetStarlarkRunProfileArgs.AsObject;
+  static serializeBinaryToWriter(message: GetStarlarkRunProfileArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStarlarkRunProfileArgs;
+  static deserializeBinaryFromReader(message: GetStarlarkRunProfileArgs, reader: jspb.BinaryReader): GetStarlarkRunProfileArgs;
+}
+
+export namespace GetStarlarkRunProfileArgs {
+  export type AsObject = {
+    runId?: string,
+  }
+
+  export enum RunIdCase { 
+    _RUN_ID_NOT_SET = 0,
+    RUN_ID = 1,
+  }
+}
+
+export class StarlarkInstructionProfile extends jspb.Message {
+  getPosition(): StarlarkInstructionPosition | undefined;
+  setPosition(value?: StarlarkInstructionPosition): StarlarkInstructionProfile;
+  hasPosition(): boolean;
+  clearPosition(): StarlarkInstructionProfile;
+
+  getInstructionName(): string;
+  setInstructionName(value: string): StarlarkInstructionProfile;
+
+  getDurationMicros(): number;
+  setDurationMicros(value: number): StarlarkInstructionProfile;
+
+  getNumContainersCreated(): number;
+  setNumContainersCreated(value: number): StarlarkInstructionProfile;
+
+  getArtifactBytesTransferred(): number;
+  setArtifactBytesTransferred(value: number): StarlarkInstructionProfile;
+
+  getIsSuccessful(): boolean;
+  setIsSuccessful(value: boolean): StarlarkInstructionProfile;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkInstructionProfile.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkInstructionProfile): StarlarkInstructionProfile.AsObject;
+  static serializeBinaryToWriter(message: StarlarkInstructionProfile, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkInstructionProfile;
+  static deserializeBinaryFromReader(message: StarlarkInstructionProfile, reader: jspb.BinaryReader): StarlarkInstructionProfile;
+}
+
+export namespace StarlarkInstructionProfile {
+  export type AsObject = {
+    position?: StarlarkInstructionPosition.AsObject,
+    instructionName: string,
+    durationMicros: number,
+    numContainersCreated: number,
+    artifactBytesTransferred: number,
+    isSuccessful: boolean,
+  }
+}
+
+export class StarlarkRunProfile extends jspb.Message {
+  getRunId(): string;
+  setRunId(value: string): StarlarkRunProfile;
+
+  getPackageId(): string;
+  setPackageId(value: string): StarlarkRunProfile;
+
+  getStartedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setStartedAt(value?: google_protobuf_timestamp_pb.Timestamp): StarlarkRunProfile;
+  hasStartedAt(): boolean;
+  clearStartedAt(): StarlarkRunProfile;
+
+  getInstructionsList(): Array<StarlarkInstructionProfile>;
+  setInstructionsList(value: Array<StarlarkInstructionProfile>): StarlarkRunProfile;
+  clearInstructionsList(): StarlarkRunProfile;
+  addInstructions(value?: StarlarkInstructionProfile, index?: number): StarlarkInstructionProfile;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkRunProfile.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkRunProfile): StarlarkRunProfile.AsObject;
+  static serializeBinaryToWriter(message: StarlarkRunProfile, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkRunProfile;
+  static deserializeBinaryFromReader(message: StarlarkRunProfile, reader: jspb.BinaryReader): StarlarkRunProfile;
+}
+
+export namespace StarlarkRunProfile {
+  export type AsObject = {
+    runId: string,
+    packageId: string,
+    startedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    instructionsList: Array<StarlarkInstructionProfile.AsObject>,
+  }
+}
+
+export class DeprecatedFeature extends jspb.Message {
+  getCode(): string;
+  setCode(value: string): DeprecatedFeature;
+
+  getDescription(): string;
+  setDescription(value: string): DeprecatedFeature;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DeprecatedFeature.AsObject;
+  static toObject(includeInstance: boolean, msg: DeprecatedFeature): DeprecatedFeature.AsObject;
+  static serializeBinaryToWriter(message: DeprecatedFeature, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DeprecatedFeature;
+  static deserializeBinaryFromReader(message: DeprecatedFeature, reader: jspb.BinaryReader): DeprecatedFeature;
+}
+
+export namespace DeprecatedFeature {
+  export type AsObject = {
+    code: string,
+    description: string,
+  }
+}
+
+export class GetDeprecatedFeaturesResponse extends jspb.Message {
+  getDeprecatedFeaturesList(): Array<DeprecatedFeature>;
+  setDeprecatedFeaturesList(value: Array<DeprecatedFeature>): GetDeprecatedFeaturesResponse;
+  clearDeprecatedFeaturesList(): GetDeprecatedFeaturesResponse;
+  addDeprecatedFeatures(value?: DeprecatedFeature, index?: number): DeprecatedFeature;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetDeprecatedFeaturesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetDeprecatedFeaturesResponse): GetDeprecatedFeaturesResponse.AsObject;
+  static serializeBinaryToWriter(message: GetDeprecatedFeaturesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetDeprecatedFeaturesResponse;
+  static deserializeBinaryFromReader(message: GetDeprecatedFeaturesResponse, reader: jspb.BinaryReader): GetDeprecatedFeaturesResponse;
+}
+
+export namespace GetDeprecatedFeaturesResponse {
+  export type AsObject = {
+    deprecatedFeaturesList: Array<DeprecatedFeature.AsObject>,
+  }
+}
+
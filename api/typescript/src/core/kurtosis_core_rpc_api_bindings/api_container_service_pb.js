@@ -23,42 +23,85 @@ var global = (function() {
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.api_container_api.AddPartitionArgs', null, global);
+goog.exportSymbol('proto.api_container_api.CachedPackage', null, global);
+goog.exportSymbol('proto.api_container_api.ClearPackageCacheResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ConnectivityProbeResult', null, global);
+goog.exportSymbol('proto.api_container_api.DeleteFilesArtifactArgs', null, global);
+goog.exportSymbol('proto.api_container_api.DeprecatedFeature', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactArgs', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ExecCommandStreamedOutputChunk', null, global);
+goog.exportSymbol('proto.api_container_api.ExecCommandStreamedOutputChunk.ChunkCase', null, global);
+goog.exportSymbol('proto.api_container_api.FilesArtifactContentsFileDescription', null, global);
 goog.exportSymbol('proto.api_container_api.FilesArtifactNameAndUuid', null, global);
+goog.exportSymbol('proto.api_container_api.FilesArtifactStreamChunk', null, global);
+goog.exportSymbol('proto.api_container_api.GetBackendCapabilitiesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetDeprecatedFeaturesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetScrapeTargetsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetServiceConfigsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetServiceMetricsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetServiceMetricsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetStarlarkRunProfileArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetSubnetworkConnectionsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.HttpHeader', null, global);
+goog.exportSymbol('proto.api_container_api.HttpRequestServiceArgs', null, global);
+goog.exportSymbol('proto.api_container_api.HttpRequestServiceResponse', null, global);
+goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.KubernetesScheduling', null, global);
+goog.exportSymbol('proto.api_container_api.KubernetesToleration', null, global);
 goog.exportSymbol('proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.MovePartitionArgs', null, global);
+goog.exportSymbol('proto.api_container_api.PackageCacheInfo', null, global);
 goog.exportSymbol('proto.api_container_api.PartitionConnectionInfo', null, global);
 goog.exportSymbol('proto.api_container_api.PartitionConnections', null, global);
 goog.exportSymbol('proto.api_container_api.PartitionServices', null, global);
 goog.exportSymbol('proto.api_container_api.PauseServiceArgs', null, global);
+goog.exportSymbol('proto.api_container_api.PauseServicesArgs', null, global);
+goog.exportSymbol('proto.api_container_api.PauseServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.Port', null, global);
 goog.exportSymbol('proto.api_container_api.Port.TransportProtocol', null, global);
 goog.exportSymbol('proto.api_container_api.RemoveServiceArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RemoveServiceResponse', null, global);
+goog.exportSymbol('proto.api_container_api.RemoveServicesArgs', null, global);
+goog.exportSymbol('proto.api_container_api.RemoveServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.RenderTemplatesToFilesArtifactArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData', null, global);
 goog.exportSymbol('proto.api_container_api.RenderTemplatesToFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.api_container_api.RepartitionArgs', null, global);
+goog.exportSymbol('proto.api_container_api.RunShutdownHooksResponse', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkScriptArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ScrapeTarget', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceConfig', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceIdentifiers', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceInfo', null, global);
+goog.exportSymbol('proto.api_container_api.SetLogLevelArgs', null, global);
+goog.exportSymbol('proto.api_container_api.SetLogLevelResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ShutdownHookResult', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkDeprecationWarning', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError.ErrorCase', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkExecutionError', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkImageDownloadEstimate', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstruction', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionArg', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionPosition', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkInstructionProfile', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionResult', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInterpretationError', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkResourceEstimate', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunFinishedEvent', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkRunProfile', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunProgress', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase', null, global);
@@ -69,12 +112,22 @@ goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromServiceArgs', n
 goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromServiceResponse', null, global);
 goog.exportSymbol('proto.api_container_api.StoreWebFilesArtifactArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StoreWebFilesArtifactResponse', null, global);
+goog.exportSymbol('proto.api_container_api.SubnetworkConnection', null, global);
+goog.exportSymbol('proto.api_container_api.SubnetworkConnectionOverride', null, global);
+goog.exportSymbol('proto.api_container_api.TestConnectivityArgs', null, global);
+goog.exportSymbol('proto.api_container_api.TestConnectivityResponse', null, global);
+goog.exportSymbol('proto.api_container_api.Ulimit', null, global);
 goog.exportSymbol('proto.api_container_api.UnpauseServiceArgs', null, global);
+goog.exportSymbol('proto.api_container_api.UnpauseServicesArgs', null, global);
+goog.exportSymbol('proto.api_container_api.UnpauseServicesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.UpdateConnectionArgs', null, global);
 goog.exportSymbol('proto.api_container_api.UpdateServiceConfig', null, global);
 goog.exportSymbol('proto.api_container_api.UploadFilesArtifactArgs', null, global);
 goog.exportSymbol('proto.api_container_api.UploadFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs', null, global);
 goog.exportSymbol('proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs', null, global);
+goog.exportSymbol('proto.api_container_api.WaitForServiceExitArgs', null, global);
+goog.exportSymbol('proto.api_container_api.WaitForServiceExitResponse', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -107,7 +160,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.api_container_api.ServiceInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ServiceInfo.repeatedFields_, null);
 };
 goog.inherits(proto.api_container_api.ServiceInfo, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -138,6 +191,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.ServiceConfig.displayName = 'proto.api_container_api.ServiceConfig';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.Ulimit = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.Ulimit, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.Ulimit.displayName = 'proto.api_container_api.Ulimit';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.KubernetesScheduling = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.KubernetesScheduling.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.KubernetesScheduling, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.KubernetesScheduling.displayName = 'proto.api_container_api.KubernetesScheduling';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.KubernetesToleration = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.KubernetesToleration, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.KubernetesToleration.displayName = 'proto.api_container_api.KubernetesToleration';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -222,6 +338,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkRunResponseLine.displayName = 'proto.api_container_api.StarlarkRunResponseLine';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkDeprecationWarning = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkDeprecationWarning, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkDeprecationWarning.displayName = 'proto.api_container_api.StarlarkDeprecationWarning';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -432,6 +569,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkRunFinishedEvent.displayName = 'proto.api_container_api.StarlarkRunFinishedEvent';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkResourceEstimate = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkResourceEstimate.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.StarlarkResourceEstimate, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkResourceEstimate.displayName = 'proto.api_container_api.StarlarkResourceEstimate';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkImageDownloadEstimate, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkImageDownloadEstimate.displayName = 'proto.api_container_api.StarlarkImageDownloadEstimate';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -568,16 +747,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.RemoveServiceArgs = function(opt_data) {
+proto.api_container_api.GetServiceConfigsResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.RemoveServiceArgs, jspb.Message);
+goog.inherits(proto.api_container_api.GetServiceConfigsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.RemoveServiceArgs.displayName = 'proto.api_container_api.RemoveServiceArgs';
+  proto.api_container_api.GetServiceConfigsResponse.displayName = 'proto.api_container_api.GetServiceConfigsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -589,16 +768,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.RemoveServiceResponse = function(opt_data) {
+proto.api_container_api.ScrapeTarget = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.RemoveServiceResponse, jspb.Message);
+goog.inherits(proto.api_container_api.ScrapeTarget, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.RemoveServiceResponse.displayName = 'proto.api_container_api.RemoveServiceResponse';
+  proto.api_container_api.ScrapeTarget.displayName = 'proto.api_container_api.ScrapeTarget';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -610,16 +789,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.RepartitionArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.api_container_api.GetScrapeTargetsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetScrapeTargetsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.api_container_api.RepartitionArgs, jspb.Message);
+goog.inherits(proto.api_container_api.GetScrapeTargetsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.RepartitionArgs.displayName = 'proto.api_container_api.RepartitionArgs';
+  proto.api_container_api.GetScrapeTargetsResponse.displayName = 'proto.api_container_api.GetScrapeTargetsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -631,16 +810,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.PartitionServices = function(opt_data) {
+proto.api_container_api.SubnetworkConnection = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.PartitionServices, jspb.Message);
+goog.inherits(proto.api_container_api.SubnetworkConnection, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.PartitionServices.displayName = 'proto.api_container_api.PartitionServices';
+  proto.api_container_api.SubnetworkConnection.displayName = 'proto.api_container_api.SubnetworkConnection';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -652,16 +831,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.PartitionConnections = function(opt_data) {
+proto.api_container_api.AddPartitionArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.PartitionConnections, jspb.Message);
+goog.inherits(proto.api_container_api.AddPartitionArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.PartitionConnections.displayName = 'proto.api_container_api.PartitionConnections';
+  proto.api_container_api.AddPartitionArgs.displayName = 'proto.api_container_api.AddPartitionArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -673,16 +852,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.PartitionConnectionInfo = function(opt_data) {
+proto.api_container_api.MovePartitionArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.PartitionConnectionInfo, jspb.Message);
+goog.inherits(proto.api_container_api.MovePartitionArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.PartitionConnectionInfo.displayName = 'proto.api_container_api.PartitionConnectionInfo';
+  proto.api_container_api.MovePartitionArgs.displayName = 'proto.api_container_api.MovePartitionArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -694,16 +873,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.ExecCommandArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ExecCommandArgs.repeatedFields_, null);
+proto.api_container_api.UpdateConnectionArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.ExecCommandArgs, jspb.Message);
+goog.inherits(proto.api_container_api.UpdateConnectionArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.ExecCommandArgs.displayName = 'proto.api_container_api.ExecCommandArgs';
+  proto.api_container_api.UpdateConnectionArgs.displayName = 'proto.api_container_api.UpdateConnectionArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -715,16 +894,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.PauseServiceArgs = function(opt_data) {
+proto.api_container_api.SubnetworkConnectionOverride = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.PauseServiceArgs, jspb.Message);
+goog.inherits(proto.api_container_api.SubnetworkConnectionOverride, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.PauseServiceArgs.displayName = 'proto.api_container_api.PauseServiceArgs';
+  proto.api_container_api.SubnetworkConnectionOverride.displayName = 'proto.api_container_api.SubnetworkConnectionOverride';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -736,16 +915,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.UnpauseServiceArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.api_container_api.GetSubnetworkConnectionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetSubnetworkConnectionsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.api_container_api.UnpauseServiceArgs, jspb.Message);
+goog.inherits(proto.api_container_api.GetSubnetworkConnectionsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.UnpauseServiceArgs.displayName = 'proto.api_container_api.UnpauseServiceArgs';
+  proto.api_container_api.GetSubnetworkConnectionsResponse.displayName = 'proto.api_container_api.GetSubnetworkConnectionsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -757,16 +936,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.ExecCommandResponse = function(opt_data) {
+proto.api_container_api.TestConnectivityArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.ExecCommandResponse, jspb.Message);
+goog.inherits(proto.api_container_api.TestConnectivityArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.ExecCommandResponse.displayName = 'proto.api_container_api.ExecCommandResponse';
+  proto.api_container_api.TestConnectivityArgs.displayName = 'proto.api_container_api.TestConnectivityArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -778,16 +957,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs = function(opt_data) {
+proto.api_container_api.ConnectivityProbeResult = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs, jspb.Message);
+goog.inherits(proto.api_container_api.ConnectivityProbeResult, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.displayName = 'proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs';
+  proto.api_container_api.ConnectivityProbeResult.displayName = 'proto.api_container_api.ConnectivityProbeResult';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -799,16 +978,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.api_container_api.TestConnectivityResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.TestConnectivityResponse.repeatedFields_, null);
 };
-goog.inherits(proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs, jspb.Message);
+goog.inherits(proto.api_container_api.TestConnectivityResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.displayName = 'proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs';
+  proto.api_container_api.TestConnectivityResponse.displayName = 'proto.api_container_api.TestConnectivityResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -820,16 +999,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.UploadFilesArtifactArgs = function(opt_data) {
+proto.api_container_api.RemoveServiceArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.UploadFilesArtifactArgs, jspb.Message);
+goog.inherits(proto.api_container_api.RemoveServiceArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.UploadFilesArtifactArgs.displayName = 'proto.api_container_api.UploadFilesArtifactArgs';
+  proto.api_container_api.RemoveServiceArgs.displayName = 'proto.api_container_api.RemoveServiceArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -841,16 +1020,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.UploadFilesArtifactResponse = function(opt_data) {
+proto.api_container_api.RemoveServiceResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.UploadFilesArtifactResponse, jspb.Message);
+goog.inherits(proto.api_container_api.RemoveServiceResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.UploadFilesArtifactResponse.displayName = 'proto.api_container_api.UploadFilesArtifactResponse';
+  proto.api_container_api.RemoveServiceResponse.displayName = 'proto.api_container_api.RemoveServiceResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -862,16 +1041,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.DownloadFilesArtifactArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.api_container_api.RemoveServicesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RemoveServicesArgs.repeatedFields_, null);
 };
-goog.inherits(proto.api_container_api.DownloadFilesArtifactArgs, jspb.Message);
+goog.inherits(proto.api_container_api.RemoveServicesArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.DownloadFilesArtifactArgs.displayName = 'proto.api_container_api.DownloadFilesArtifactArgs';
+  proto.api_container_api.RemoveServicesArgs.displayName = 'proto.api_container_api.RemoveServicesArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -883,16 +1062,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.DownloadFilesArtifactResponse = function(opt_data) {
+proto.api_container_api.RemoveServicesResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.DownloadFilesArtifactResponse, jspb.Message);
+goog.inherits(proto.api_container_api.RemoveServicesResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.DownloadFilesArtifactResponse.displayName = 'proto.api_container_api.DownloadFilesArtifactResponse';
+  proto.api_container_api.RemoveServicesResponse.displayName = 'proto.api_container_api.RemoveServicesResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -904,16 +1083,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.StoreWebFilesArtifactArgs = function(opt_data) {
+proto.api_container_api.RepartitionArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.StoreWebFilesArtifactArgs, jspb.Message);
+goog.inherits(proto.api_container_api.RepartitionArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.StoreWebFilesArtifactArgs.displayName = 'proto.api_container_api.StoreWebFilesArtifactArgs';
+  proto.api_container_api.RepartitionArgs.displayName = 'proto.api_container_api.RepartitionArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -925,16 +1104,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.StoreWebFilesArtifactResponse = function(opt_data) {
+proto.api_container_api.PartitionServices = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.StoreWebFilesArtifactResponse, jspb.Message);
+goog.inherits(proto.api_container_api.PartitionServices, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.StoreWebFilesArtifactResponse.displayName = 'proto.api_container_api.StoreWebFilesArtifactResponse';
+  proto.api_container_api.PartitionServices.displayName = 'proto.api_container_api.PartitionServices';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -946,16 +1125,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs = function(opt_data) {
+proto.api_container_api.PartitionConnections = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.StoreFilesArtifactFromServiceArgs, jspb.Message);
+goog.inherits(proto.api_container_api.PartitionConnections, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.displayName = 'proto.api_container_api.StoreFilesArtifactFromServiceArgs';
+  proto.api_container_api.PartitionConnections.displayName = 'proto.api_container_api.PartitionConnections';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -967,16 +1146,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse = function(opt_data) {
+proto.api_container_api.PartitionConnectionInfo = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.StoreFilesArtifactFromServiceResponse, jspb.Message);
+goog.inherits(proto.api_container_api.PartitionConnectionInfo, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.displayName = 'proto.api_container_api.StoreFilesArtifactFromServiceResponse';
+  proto.api_container_api.PartitionConnectionInfo.displayName = 'proto.api_container_api.PartitionConnectionInfo';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -988,16 +1167,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.api_container_api.ExecCommandArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ExecCommandArgs.repeatedFields_, null);
 };
-goog.inherits(proto.api_container_api.RenderTemplatesToFilesArtifactArgs, jspb.Message);
+goog.inherits(proto.api_container_api.ExecCommandArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactArgs';
+  proto.api_container_api.ExecCommandArgs.displayName = 'proto.api_container_api.ExecCommandArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1009,16 +1188,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData = function(opt_data) {
+proto.api_container_api.PauseServiceArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData, jspb.Message);
+goog.inherits(proto.api_container_api.PauseServiceArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData';
+  proto.api_container_api.PauseServiceArgs.displayName = 'proto.api_container_api.PauseServiceArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1030,16 +1209,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse = function(opt_data) {
+proto.api_container_api.UnpauseServiceArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.RenderTemplatesToFilesArtifactResponse, jspb.Message);
+goog.inherits(proto.api_container_api.UnpauseServiceArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactResponse';
+  proto.api_container_api.UnpauseServiceArgs.displayName = 'proto.api_container_api.UnpauseServiceArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1051,16 +1230,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.FilesArtifactNameAndUuid = function(opt_data) {
+proto.api_container_api.PauseServicesArgs = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.FilesArtifactNameAndUuid, jspb.Message);
+goog.inherits(proto.api_container_api.PauseServicesArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.FilesArtifactNameAndUuid.displayName = 'proto.api_container_api.FilesArtifactNameAndUuid';
+  proto.api_container_api.PauseServicesArgs.displayName = 'proto.api_container_api.PauseServicesArgs';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1072,52 +1251,11511 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_, null);
+proto.api_container_api.PauseServicesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse, jspb.Message);
+goog.inherits(proto.api_container_api.PauseServicesResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.displayName = 'proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse';
+  proto.api_container_api.PauseServicesResponse.displayName = 'proto.api_container_api.PauseServicesResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+proto.api_container_api.UnpauseServicesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.api_container_api.UnpauseServicesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.UnpauseServicesArgs.displayName = 'proto.api_container_api.UnpauseServicesArgs';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.UnpauseServicesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.UnpauseServicesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.UnpauseServicesResponse.displayName = 'proto.api_container_api.UnpauseServicesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.WaitForServiceExitArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.WaitForServiceExitArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.WaitForServiceExitArgs.displayName = 'proto.api_container_api.WaitForServiceExitArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.WaitForServiceExitResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.WaitForServiceExitResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.WaitForServiceExitResponse.displayName = 'proto.api_container_api.WaitForServiceExitResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ExecCommandResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ExecCommandResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ExecCommandResponse.displayName = 'proto.api_container_api.ExecCommandResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ExecCommandStreamedOutputChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_);
+};
+goog.inherits(proto.api_container_api.ExecCommandStreamedOutputChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ExecCommandStreamedOutputChunk.displayName = 'proto.api_container_api.ExecCommandStreamedOutputChunk';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServiceMetricsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetServiceMetricsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServiceMetricsArgs.displayName = 'proto.api_container_api.GetServiceMetricsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetServiceMetricsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetServiceMetricsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetServiceMetricsResponse.displayName = 'proto.api_container_api.GetServiceMetricsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.displayName = 'proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.displayName = 'proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.UploadFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.UploadFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.UploadFilesArtifactArgs.displayName = 'proto.api_container_api.UploadFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.UploadFilesArtifactResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.UploadFilesArtifactResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.UploadFilesArtifactResponse.displayName = 'proto.api_container_api.UploadFilesArtifactResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.DownloadFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.DownloadFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.DownloadFilesArtifactArgs.displayName = 'proto.api_container_api.DownloadFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.DownloadFilesArtifactResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.DownloadFilesArtifactResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.DownloadFilesArtifactResponse.displayName = 'proto.api_container_api.DownloadFilesArtifactResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.FilesArtifactStreamChunk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.FilesArtifactStreamChunk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.FilesArtifactStreamChunk.displayName = 'proto.api_container_api.FilesArtifactStreamChunk';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreWebFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreWebFilesArtifactArgs.displayName = 'proto.api_container_api.StoreWebFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StoreWebFilesArtifactResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreWebFilesArtifactResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreWebFilesArtifactResponse.displayName = 'proto.api_container_api.StoreWebFilesArtifactResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreFilesArtifactFromServiceArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.displayName = 'proto.api_container_api.StoreFilesArtifactFromServiceArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreFilesArtifactFromServiceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.displayName = 'proto.api_container_api.StoreFilesArtifactFromServiceResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.RenderTemplatesToFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.RenderTemplatesToFilesArtifactResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.displayName = 'proto.api_container_api.RenderTemplatesToFilesArtifactResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.FilesArtifactNameAndUuid = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.FilesArtifactNameAndUuid, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.FilesArtifactNameAndUuid.displayName = 'proto.api_container_api.FilesArtifactNameAndUuid';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.displayName = 'proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.DeleteFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.DeleteFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.DeleteFilesArtifactArgs.displayName = 'proto.api_container_api.DeleteFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.InspectFilesArtifactContentsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.InspectFilesArtifactContentsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.InspectFilesArtifactContentsArgs.displayName = 'proto.api_container_api.InspectFilesArtifactContentsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.InspectFilesArtifactContentsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.InspectFilesArtifactContentsResponse.displayName = 'proto.api_container_api.InspectFilesArtifactContentsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.FilesArtifactContentsFileDescription = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.FilesArtifactContentsFileDescription, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.FilesArtifactContentsFileDescription.displayName = 'proto.api_container_api.FilesArtifactContentsFileDescription';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.SetLogLevelArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.SetLogLevelArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.SetLogLevelArgs.displayName = 'proto.api_container_api.SetLogLevelArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.SetLogLevelResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.SetLogLevelResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.SetLogLevelResponse.displayName = 'proto.api_container_api.SetLogLevelResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ShutdownHookResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ShutdownHookResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ShutdownHookResult.displayName = 'proto.api_container_api.ShutdownHookResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RunShutdownHooksResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RunShutdownHooksResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.RunShutdownHooksResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RunShutdownHooksResponse.displayName = 'proto.api_container_api.RunShutdownHooksResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpRequestServiceArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.HttpRequestServiceArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpRequestServiceArgs.displayName = 'proto.api_container_api.HttpRequestServiceArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpHeader = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.HttpHeader, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpHeader.displayName = 'proto.api_container_api.HttpHeader';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpRequestServiceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.HttpRequestServiceResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.HttpRequestServiceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpRequestServiceResponse.displayName = 'proto.api_container_api.HttpRequestServiceResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetBackendCapabilitiesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetBackendCapabilitiesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetBackendCapabilitiesResponse.displayName = 'proto.api_container_api.GetBackendCapabilitiesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.CachedPackage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.CachedPackage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CachedPackage.displayName = 'proto.api_container_api.CachedPackage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.PackageCacheInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.PackageCacheInfo.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.PackageCacheInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.PackageCacheInfo.displayName = 'proto.api_container_api.PackageCacheInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ClearPackageCacheResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ClearPackageCacheResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ClearPackageCacheResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ClearPackageCacheResponse.displayName = 'proto.api_container_api.ClearPackageCacheResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetStarlarkRunProfileArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetStarlarkRunProfileArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetStarlarkRunProfileArgs.displayName = 'proto.api_container_api.GetStarlarkRunProfileArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkInstructionProfile = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkInstructionProfile, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkInstructionProfile.displayName = 'proto.api_container_api.StarlarkInstructionProfile';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkRunProfile = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.StarlarkRunProfile.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.StarlarkRunProfile, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkRunProfile.displayName = 'proto.api_container_api.StarlarkRunProfile';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.DeprecatedFeature = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.DeprecatedFeature, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.DeprecatedFeature.displayName = 'proto.api_container_api.DeprecatedFeature';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetDeprecatedFeaturesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetDeprecatedFeaturesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetDeprecatedFeaturesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetDeprecatedFeaturesResponse.displayName = 'proto.api_container_api.GetDeprecatedFeaturesResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
  * @param {!proto.api_container_api.Port} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Port.toObject = function(includeInstance, msg) {
+proto.api_container_api.Port.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.Port;
+  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumber(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.api_container_api.Port.TransportProtocol} */ (reader.readEnum());
+      msg.setTransportProtocol(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybeApplicationProtocol(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.Port.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.Port.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.Port} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getTransportProtocol();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getMaybeApplicationProtocol();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.Port.TransportProtocol = {
+  TCP: 0,
+  SCTP: 1,
+  UDP: 2
+};
+
+/**
+ * optional uint32 number = 1;
+ * @return {number}
+ */
+proto.api_container_api.Port.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional TransportProtocol transport_protocol = 2;
+ * @return {!proto.api_container_api.Port.TransportProtocol}
+ */
+proto.api_container_api.Port.prototype.getTransportProtocol = function() {
+  return /** @type {!proto.api_container_api.Port.TransportProtocol} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.Port.TransportProtocol} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setTransportProtocol = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string maybe_application_protocol = 3;
+ * @return {string}
+ */
+proto.api_container_api.Port.prototype.getMaybeApplicationProtocol = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ServiceInfo.repeatedFields_ = [8];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    privateIpAddr: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    name: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    replicaPrivateIpAddrsList: (f = jspb.Message.getRepeatedField(msg, 8)) == null ? undefined : f,
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    runningTime: (f = msg.getRunningTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    readyTime: (f = msg.getReadyTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    maybePrivateIpv6Addr: jspb.Message.getFieldWithDefault(msg, 12, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceInfo}
+ */
+proto.api_container_api.ServiceInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceInfo;
+  return proto.api_container_api.ServiceInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceInfo}
+ */
+proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateIpAddr(value);
+      break;
+    case 3:
+      var value = msg.getPrivatePortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybePublicIpAddr(value);
+      break;
+    case 5:
+      var value = msg.getMaybePublicPortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addReplicaPrivateIpAddrs(value);
+      break;
+    case 9:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreationTime(value);
+      break;
+    case 10:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setRunningTime(value);
+      break;
+    case 11:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setReadyTime(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybePrivateIpv6Addr(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPrivateIpAddr();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPrivatePortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getMaybePublicIpAddr();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMaybePublicPortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getReplicaPrivateIpAddrsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      8,
+      f
+    );
+  }
+  f = message.getCreationTime();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunningTime();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getReadyTime();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaybePrivateIpv6Addr();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string private_ip_addr = 2;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivateIpAddr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setPrivateIpAddr = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, Port> private_ports = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearPrivatePortsMap = function() {
+  this.getPrivatePortsMap().clear();
+  return this;};
+
+
+/**
+ * optional string maybe_public_ip_addr = 4;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePublicIpAddr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setMaybePublicIpAddr = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * map<string, Port> maybe_public_ports = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePublicPortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearMaybePublicPortsMap = function() {
+  this.getMaybePublicPortsMap().clear();
+  return this;};
+
+
+/**
+ * optional string name = 6;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 7;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * repeated string replica_private_ip_addrs = 8;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getReplicaPrivateIpAddrsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 8));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setReplicaPrivateIpAddrsList = function(value) {
+  return jspb.Message.setField(this, 8, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.addReplicaPrivateIpAddrs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 8, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearReplicaPrivateIpAddrsList = function() {
+  return this.setReplicaPrivateIpAddrsList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp creation_time = 9;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.ServiceInfo.prototype.getCreationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setCreationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearCreationTime = function() {
+  return this.setCreationTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasCreationTime = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp running_time = 10;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.ServiceInfo.prototype.getRunningTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setRunningTime = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearRunningTime = function() {
+  return this.setRunningTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasRunningTime = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp ready_time = 11;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.api_container_api.ServiceInfo.prototype.getReadyTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 11));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setReadyTime = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearReadyTime = function() {
+  return this.setReadyTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasReadyTime = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional string maybe_private_ipv6_addr = 12;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePrivateIpv6Addr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setMaybePrivateIpv6Addr = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ServiceConfig.repeatedFields_ = [4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceConfig.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceConfig} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceConfig.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    containerImageName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    publicPortsMap: (f = msg.getPublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    entrypointArgsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
+    cmdArgsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
+    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : [],
+    filesArtifactMountpointsMap: (f = msg.getFilesArtifactMountpointsMap()) ? f.toObject(includeInstance, undefined) : [],
+    cpuAllocationMillicpus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    memoryAllocationMegabytes: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    privateIpAddrPlaceholder: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    subnetwork: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    kubernetesServiceAccount: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    kubernetesServiceAccountAnnotationsMap: (f = msg.getKubernetesServiceAccountAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
+    kubernetesScheduling: (f = msg.getKubernetesScheduling()) && proto.api_container_api.KubernetesScheduling.toObject(includeInstance, f),
+    replicas: jspb.Message.getFieldWithDefault(msg, 15, 0),
+    cpuset: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    numaNodes: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    ulimitsMap: (f = msg.getUlimitsMap()) ? f.toObject(includeInstance, proto.api_container_api.Ulimit.toObject) : [],
+    sysctlsMap: (f = msg.getSysctlsMap()) ? f.toObject(includeInstance, undefined) : [],
+    shmSizeMegabytes: jspb.Message.getFieldWithDefault(msg, 20, 0),
+    hostDirMountsMap: (f = msg.getHostDirMountsMap()) ? f.toObject(includeInstance, undefined) : [],
+    metricsPortId: jspb.Message.getFieldWithDefault(msg, 22, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceConfig}
+ */
+proto.api_container_api.ServiceConfig.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceConfig;
+  return proto.api_container_api.ServiceConfig.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceConfig} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceConfig}
+ */
+proto.api_container_api.ServiceConfig.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContainerImageName(value);
+      break;
+    case 2:
+      var value = msg.getPrivatePortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 3:
+      var value = msg.getPublicPortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEntrypointArgs(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCmdArgs(value);
+      break;
+    case 6:
+      var value = msg.getEnvVarsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 7:
+      var value = msg.getFilesArtifactMountpointsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuAllocationMillicpus(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryAllocationMegabytes(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateIpAddrPlaceholder(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSubnetwork(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKubernetesServiceAccount(value);
+      break;
+    case 13:
+      var value = msg.getKubernetesServiceAccountAnnotationsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 14:
+      var value = new proto.api_container_api.KubernetesScheduling;
+      reader.readMessage(value,proto.api_container_api.KubernetesScheduling.deserializeBinaryFromReader);
+      msg.setKubernetesScheduling(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReplicas(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCpuset(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNumaNodes(value);
+      break;
+    case 18:
+      var value = msg.getUlimitsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Ulimit.deserializeBinaryFromReader, "", new proto.api_container_api.Ulimit());
+         });
+      break;
+    case 19:
+      var value = msg.getSysctlsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 20:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setShmSizeMegabytes(value);
+      break;
+    case 21:
+      var value = msg.getHostDirMountsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMetricsPortId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceConfig.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceConfig.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceConfig} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceConfig.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContainerImageName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPrivatePortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getPublicPortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getEntrypointArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
+  f = message.getCmdArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+  f = message.getEnvVarsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getFilesArtifactMountpointsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(7, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getCpuAllocationMillicpus();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getMemoryAllocationMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      9,
+      f
+    );
+  }
+  f = message.getPrivateIpAddrPlaceholder();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 11));
+  if (f != null) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 12));
+  if (f != null) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getKubernetesServiceAccountAnnotationsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(13, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getKubernetesScheduling();
+  if (f != null) {
+    writer.writeMessage(
+      14,
+      f,
+      proto.api_container_api.KubernetesScheduling.serializeBinaryToWriter
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 15));
+  if (f != null) {
+    writer.writeUint32(
+      15,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 16));
+  if (f != null) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 17));
+  if (f != null) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getUlimitsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(18, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Ulimit.serializeBinaryToWriter);
+  }
+  f = message.getSysctlsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(19, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 20));
+  if (f != null) {
+    writer.writeUint64(
+      20,
+      f
+    );
+  }
+  f = message.getHostDirMountsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(21, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 22));
+  if (f != null) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string container_image_name = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getContainerImageName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setContainerImageName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, Port> private_ports = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearPrivatePortsMap = function() {
+  this.getPrivatePortsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, Port> public_ports = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getPublicPortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearPublicPortsMap = function() {
+  this.getPublicPortsMap().clear();
+  return this;};
+
+
+/**
+ * repeated string entrypoint_args = 4;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getEntrypointArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setEntrypointArgsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.addEntrypointArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearEntrypointArgsList = function() {
+  return this.setEntrypointArgsList([]);
+};
+
+
+/**
+ * repeated string cmd_args = 5;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getCmdArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setCmdArgsList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.addCmdArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearCmdArgsList = function() {
+  return this.setCmdArgsList([]);
+};
+
+
+/**
+ * map<string, string> env_vars = 6;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearEnvVarsMap = function() {
+  this.getEnvVarsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> files_artifact_mountpoints = 7;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getFilesArtifactMountpointsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 7, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearFilesArtifactMountpointsMap = function() {
+  this.getFilesArtifactMountpointsMap().clear();
+  return this;};
+
+
+/**
+ * optional uint64 cpu_allocation_millicpus = 8;
+ * @return {number}
+ */
+proto.api_container_api.ServiceConfig.prototype.getCpuAllocationMillicpus = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setCpuAllocationMillicpus = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional uint64 memory_allocation_megabytes = 9;
+ * @return {number}
+ */
+proto.api_container_api.ServiceConfig.prototype.getMemoryAllocationMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setMemoryAllocationMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional string private_ip_addr_placeholder = 10;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getPrivateIpAddrPlaceholder = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setPrivateIpAddrPlaceholder = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional string subnetwork = 11;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getSubnetwork = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setSubnetwork = function(value) {
+  return jspb.Message.setField(this, 11, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearSubnetwork = function() {
+  return jspb.Message.setField(this, 11, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasSubnetwork = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional string kubernetes_service_account = 12;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getKubernetesServiceAccount = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setKubernetesServiceAccount = function(value) {
+  return jspb.Message.setField(this, 12, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearKubernetesServiceAccount = function() {
+  return jspb.Message.setField(this, 12, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasKubernetesServiceAccount = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * map<string, string> kubernetes_service_account_annotations = 13;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getKubernetesServiceAccountAnnotationsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 13, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearKubernetesServiceAccountAnnotationsMap = function() {
+  this.getKubernetesServiceAccountAnnotationsMap().clear();
+  return this;};
+
+
+/**
+ * optional KubernetesScheduling kubernetes_scheduling = 14;
+ * @return {?proto.api_container_api.KubernetesScheduling}
+ */
+proto.api_container_api.ServiceConfig.prototype.getKubernetesScheduling = function() {
+  return /** @type{?proto.api_container_api.KubernetesScheduling} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.KubernetesScheduling, 14));
+};
+
+
+/**
+ * @param {?proto.api_container_api.KubernetesScheduling|undefined} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+*/
+proto.api_container_api.ServiceConfig.prototype.setKubernetesScheduling = function(value) {
+  return jspb.Message.setWrapperField(this, 14, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearKubernetesScheduling = function() {
+  return this.setKubernetesScheduling(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasKubernetesScheduling = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional uint32 replicas = 15;
+ * @return {number}
+ */
+proto.api_container_api.ServiceConfig.prototype.getReplicas = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setReplicas = function(value) {
+  return jspb.Message.setField(this, 15, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearReplicas = function() {
+  return jspb.Message.setField(this, 15, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasReplicas = function() {
+  return jspb.Message.getField(this, 15) != null;
+};
+
+
+/**
+ * optional string cpuset = 16;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getCpuset = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setCpuset = function(value) {
+  return jspb.Message.setField(this, 16, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearCpuset = function() {
+  return jspb.Message.setField(this, 16, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasCpuset = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+/**
+ * optional string numa_nodes = 17;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getNumaNodes = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setNumaNodes = function(value) {
+  return jspb.Message.setField(this, 17, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearNumaNodes = function() {
+  return jspb.Message.setField(this, 17, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasNumaNodes = function() {
+  return jspb.Message.getField(this, 17) != null;
+};
+
+
+/**
+ * map<string, Ulimit> ulimits = 18;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Ulimit>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getUlimitsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Ulimit>} */ (
+      jspb.Message.getMapField(this, 18, opt_noLazyCreate,
+      proto.api_container_api.Ulimit));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearUlimitsMap = function() {
+  this.getUlimitsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> sysctls = 19;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getSysctlsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 19, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearSysctlsMap = function() {
+  this.getSysctlsMap().clear();
+  return this;};
+
+
+/**
+ * optional uint64 shm_size_megabytes = 20;
+ * @return {number}
+ */
+proto.api_container_api.ServiceConfig.prototype.getShmSizeMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 20, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setShmSizeMegabytes = function(value) {
+  return jspb.Message.setField(this, 20, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearShmSizeMegabytes = function() {
+  return jspb.Message.setField(this, 20, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasShmSizeMegabytes = function() {
+  return jspb.Message.getField(this, 20) != null;
+};
+
+
+/**
+ * map<string, string> host_dir_mounts = 21;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.ServiceConfig.prototype.getHostDirMountsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 21, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearHostDirMountsMap = function() {
+  this.getHostDirMountsMap().clear();
+  return this;};
+
+
+/**
+ * optional string metrics_port_id = 22;
+ * @return {string}
+ */
+proto.api_container_api.ServiceConfig.prototype.getMetricsPortId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.setMetricsPortId = function(value) {
+  return jspb.Message.setField(this, 22, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ServiceConfig} returns this
+ */
+proto.api_container_api.ServiceConfig.prototype.clearMetricsPortId = function() {
+  return jspb.Message.setField(this, 22, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceConfig.prototype.hasMetricsPortId = function() {
+  return jspb.Message.getField(this, 22) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Ulimit.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Ulimit.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.Ulimit} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Ulimit.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    soft: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    hard: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.Ulimit}
+ */
+proto.api_container_api.Ulimit.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.Ulimit;
+  return proto.api_container_api.Ulimit.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.Ulimit} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.Ulimit}
+ */
+proto.api_container_api.Ulimit.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSoft(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setHard(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.Ulimit.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.Ulimit.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.Ulimit} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Ulimit.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSoft();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getHard();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 soft = 1;
+ * @return {number}
+ */
+proto.api_container_api.Ulimit.prototype.getSoft = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.Ulimit} returns this
+ */
+proto.api_container_api.Ulimit.prototype.setSoft = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 hard = 2;
+ * @return {number}
+ */
+proto.api_container_api.Ulimit.prototype.getHard = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.Ulimit} returns this
+ */
+proto.api_container_api.Ulimit.prototype.setHard = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.KubernetesScheduling.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.KubernetesScheduling.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.KubernetesScheduling} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.KubernetesScheduling.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    nodeSelectorMap: (f = msg.getNodeSelectorMap()) ? f.toObject(includeInstance, undefined) : [],
+    tolerationsList: jspb.Message.toObjectList(msg.getTolerationsList(),
+    proto.api_container_api.KubernetesToleration.toObject, includeInstance),
+    serializedAffinity: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    serializedTopologySpreadConstraints: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.KubernetesScheduling}
+ */
+proto.api_container_api.KubernetesScheduling.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.KubernetesScheduling;
+  return proto.api_container_api.KubernetesScheduling.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.KubernetesScheduling} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.KubernetesScheduling}
+ */
+proto.api_container_api.KubernetesScheduling.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getNodeSelectorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 2:
+      var value = new proto.api_container_api.KubernetesToleration;
+      reader.readMessage(value,proto.api_container_api.KubernetesToleration.deserializeBinaryFromReader);
+      msg.addTolerations(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedAffinity(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedTopologySpreadConstraints(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.KubernetesScheduling.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.KubernetesScheduling} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.KubernetesScheduling.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodeSelectorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getTolerationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.KubernetesToleration.serializeBinaryToWriter
+    );
+  }
+  f = message.getSerializedAffinity();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSerializedTopologySpreadConstraints();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, string> node_selector = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.getNodeSelectorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.KubernetesScheduling} returns this
+ */
+proto.api_container_api.KubernetesScheduling.prototype.clearNodeSelectorMap = function() {
+  this.getNodeSelectorMap().clear();
+  return this;};
+
+
+/**
+ * repeated KubernetesToleration tolerations = 2;
+ * @return {!Array<!proto.api_container_api.KubernetesToleration>}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.getTolerationsList = function() {
+  return /** @type{!Array<!proto.api_container_api.KubernetesToleration>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.KubernetesToleration, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KubernetesToleration>} value
+ * @return {!proto.api_container_api.KubernetesScheduling} returns this
+*/
+proto.api_container_api.KubernetesScheduling.prototype.setTolerationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KubernetesToleration=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.KubernetesToleration}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.addTolerations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.KubernetesToleration, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.KubernetesScheduling} returns this
+ */
+proto.api_container_api.KubernetesScheduling.prototype.clearTolerationsList = function() {
+  return this.setTolerationsList([]);
+};
+
+
+/**
+ * optional string serialized_affinity = 3;
+ * @return {string}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.getSerializedAffinity = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.KubernetesScheduling} returns this
+ */
+proto.api_container_api.KubernetesScheduling.prototype.setSerializedAffinity = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string serialized_topology_spread_constraints = 4;
+ * @return {string}
+ */
+proto.api_container_api.KubernetesScheduling.prototype.getSerializedTopologySpreadConstraints = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.KubernetesScheduling} returns this
+ */
+proto.api_container_api.KubernetesScheduling.prototype.setSerializedTopologySpreadConstraints = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.KubernetesToleration.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.KubernetesToleration.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.KubernetesToleration} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.KubernetesToleration.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    key: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    operator: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    effect: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    tolerationSeconds: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.KubernetesToleration}
+ */
+proto.api_container_api.KubernetesToleration.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.KubernetesToleration;
+  return proto.api_container_api.KubernetesToleration.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.KubernetesToleration} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.KubernetesToleration}
+ */
+proto.api_container_api.KubernetesToleration.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKey(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOperator(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEffect(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTolerationSeconds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.KubernetesToleration.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.KubernetesToleration.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.KubernetesToleration} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.KubernetesToleration.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getKey();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOperator();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getEffect();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string key = 1;
+ * @return {string}
+ */
+proto.api_container_api.KubernetesToleration.prototype.getKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.KubernetesToleration} returns this
+ */
+proto.api_container_api.KubernetesToleration.prototype.setKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string operator = 2;
+ * @return {string}
+ */
+proto.api_container_api.KubernetesToleration.prototype.getOperator = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.KubernetesToleration} returns this
+ */
+proto.api_container_api.KubernetesToleration.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string value = 3;
+ * @return {string}
+ */
+proto.api_container_api.KubernetesToleration.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.KubernetesToleration} returns this
+ */
+proto.api_container_api.KubernetesToleration.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string effect = 4;
+ * @return {string}
+ */
+proto.api_container_api.KubernetesToleration.prototype.getEffect = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.KubernetesToleration} returns this
+ */
+proto.api_container_api.KubernetesToleration.prototype.setEffect = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 toleration_seconds = 5;
+ * @return {number}
+ */
+proto.api_container_api.KubernetesToleration.prototype.getTolerationSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.KubernetesToleration} returns this
+ */
+proto.api_container_api.KubernetesToleration.prototype.setTolerationSeconds = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.KubernetesToleration} returns this
+ */
+proto.api_container_api.KubernetesToleration.prototype.clearTolerationSeconds = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.KubernetesToleration.prototype.hasTolerationSeconds = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UpdateServiceConfig.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.UpdateServiceConfig} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.UpdateServiceConfig.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    subnetwork: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    containerImageName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.UpdateServiceConfig}
+ */
+proto.api_container_api.UpdateServiceConfig.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.UpdateServiceConfig;
+  return proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.UpdateServiceConfig} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.UpdateServiceConfig}
+ */
+proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSubnetwork(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContainerImageName(value);
+      break;
+    case 3:
+      var value = msg.getPrivatePortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 4:
+      var value = msg.getEnvVarsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.UpdateServiceConfig.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.UpdateServiceConfig} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.UpdateServiceConfig.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPrivatePortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getEnvVarsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string subnetwork = 1;
+ * @return {string}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getSubnetwork = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.setSubnetwork = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.clearSubnetwork = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.hasSubnetwork = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string container_image_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getContainerImageName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.setContainerImageName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.clearContainerImageName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.hasContainerImageName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * map<string, Port> private_ports = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.clearPrivatePortsMap = function() {
+  this.getPrivatePortsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> env_vars = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UpdateServiceConfig} returns this
+ */
+proto.api_container_api.UpdateServiceConfig.prototype.clearEnvVarsMap = function() {
+  this.getEnvVarsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    strict: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
+  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStrict(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 5));
+  if (f != null) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_script = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_params = 2;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool dry_run = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int32 parallelism = 4;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional bool strict = 5;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getStrict = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setStrict = function(value) {
+  return jspb.Message.setField(this, 5, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearStrict = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasStrict = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
+  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
+  LOCAL: 3,
+  REMOTE: 4
+};
+
+/**
+ * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
+  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    local: msg.getLocal_asB64(),
+    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    strict: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
+  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLocal(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setStrict(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string package_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes local = 3;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLocal()));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLocal()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool remote = 4;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string serialized_params = 5;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional bool dry_run = 6;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional int32 parallelism = 7;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool strict = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getStrict = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setStrict = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearStrict = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasStrict = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
+  RUN_RESPONSE_LINE_NOT_SET: 0,
+  INSTRUCTION: 1,
+  ERROR: 2,
+  PROGRESS_INFO: 3,
+  INSTRUCTION_RESULT: 4,
+  RUN_FINISHED_EVENT: 5,
+  RESOURCE_ESTIMATE: 6,
+  DEPRECATION_WARNING: 7
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
+  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
+    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
+    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
+    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
+    resourceEstimate: (f = msg.getResourceEstimate()) && proto.api_container_api.StarlarkResourceEstimate.toObject(includeInstance, f),
+    deprecationWarning: (f = msg.getDeprecationWarning()) && proto.api_container_api.StarlarkDeprecationWarning.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunResponseLine;
+  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkError;
+      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
+      msg.setError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkRunProgress;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
+      msg.setProgressInfo(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstructionResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
+      msg.setInstructionResult(value);
+      break;
+    case 5:
+      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
+      msg.setRunFinishedEvent(value);
+      break;
+    case 6:
+      var value = new proto.api_container_api.StarlarkResourceEstimate;
+      reader.readMessage(value,proto.api_container_api.StarlarkResourceEstimate.deserializeBinaryFromReader);
+      msg.setResourceEstimate(value);
+      break;
+    case 7:
+      var value = new proto.api_container_api.StarlarkDeprecationWarning;
+      reader.readMessage(value,proto.api_container_api.StarlarkDeprecationWarning.deserializeBinaryFromReader);
+      msg.setDeprecationWarning(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstruction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+    );
+  }
+  f = message.getProgressInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionResult();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunFinishedEvent();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getResourceEstimate();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.api_container_api.StarlarkResourceEstimate.serializeBinaryToWriter
+    );
+  }
+  f = message.getDeprecationWarning();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.api_container_api.StarlarkDeprecationWarning.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkError error = 2;
+ * @return {?proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
+  return /** @type{?proto.api_container_api.StarlarkError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkError|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
+  return this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkRunProgress progress_info = 3;
+ * @return {?proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
+  return this.setProgressInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional StarlarkInstructionResult instruction_result = 4;
+ * @return {?proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
+  return this.setInstructionResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional StarlarkRunFinishedEvent run_finished_event = 5;
+ * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
+  return this.setRunFinishedEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional StarlarkResourceEstimate resource_estimate = 6;
+ * @return {?proto.api_container_api.StarlarkResourceEstimate}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getResourceEstimate = function() {
+  return /** @type{?proto.api_container_api.StarlarkResourceEstimate} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkResourceEstimate, 6));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkResourceEstimate|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setResourceEstimate = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearResourceEstimate = function() {
+  return this.setResourceEstimate(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasResourceEstimate = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional StarlarkDeprecationWarning deprecation_warning = 7;
+ * @return {?proto.api_container_api.StarlarkDeprecationWarning}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getDeprecationWarning = function() {
+  return /** @type{?proto.api_container_api.StarlarkDeprecationWarning} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkDeprecationWarning, 7));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkDeprecationWarning|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setDeprecationWarning = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearDeprecationWarning = function() {
+  return this.setDeprecationWarning(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasDeprecationWarning = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkDeprecationWarning.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkDeprecationWarning} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkDeprecationWarning.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    code: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkDeprecationWarning}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkDeprecationWarning;
+  return proto.api_container_api.StarlarkDeprecationWarning.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkDeprecationWarning} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkDeprecationWarning}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCode(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkDeprecationWarning.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkDeprecationWarning} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkDeprecationWarning.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCode();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string code = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.getCode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkDeprecationWarning} returns this
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.setCode = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkDeprecationWarning} returns this
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 3;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkDeprecationWarning} returns this
+*/
+proto.api_container_api.StarlarkDeprecationWarning.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkDeprecationWarning} returns this
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkDeprecationWarning.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
+    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
+    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstruction;
+  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInstructionName(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstructionArg;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
+      msg.addArguments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutableInstruction(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getArgumentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutableInstruction();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated StarlarkInstructionArg arguments = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
+  return this.setArgumentsList([]);
+};
+
+
+/**
+ * optional string executable_instruction = 4;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionResult;
+  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedInstructionResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedInstructionResult();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_instruction_result = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionArg;
+  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedArgValue(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRepresentative(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedArgValue();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIsRepresentative();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_arg_value = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string arg_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool is_representative = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionPosition;
+  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string filename = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 line = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 column = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkError.ErrorCase = {
+  ERROR_NOT_SET: 0,
+  INTERPRETATION_ERROR: 1,
+  VALIDATION_ERROR: 2,
+  EXECUTION_ERROR: 3
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ */
+proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
+  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
+    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkError;
+  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkValidationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
+      msg.setValidationError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkExecutionError;
+      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
+      msg.setExecutionError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getValidationError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutionError();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkValidationError validation_error = 2;
+ * @return {?proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
+  return this.setValidationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkExecutionError execution_error = 3;
+ * @return {?proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
+  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
+  return this.setExecutionError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInterpretationError;
+  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkValidationError;
+  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ */
+proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkExecutionError;
+  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunProgress;
+  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCurrentStepInfo(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCurrentStepInfoList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
+};
+
+
+/**
+ * optional uint32 total_steps = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 current_step_number = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isrunsuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    runId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    resolvedPackageVersionsMap: (f = msg.getResolvedPackageVersionsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
+  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsrunsuccessful(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedOutput(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunId(value);
+      break;
+    case 4:
+      var value = msg.getResolvedPackageVersionsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsrunsuccessful();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getResolvedPackageVersionsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional bool isRunSuccessful = 1;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsrunsuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsrunsuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_output = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string run_id = 3;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setRunId = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearRunId = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasRunId = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * map<string, string> resolved_package_versions = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getResolvedPackageVersionsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearResolvedPackageVersionsMap = function() {
+  this.getResolvedPackageVersionsMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkResourceEstimate.repeatedFields_ = [8,11];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkResourceEstimate.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkResourceEstimate} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkResourceEstimate.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    numServices: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    cpuAllocationMillicpus: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    numServicesWithoutCpuAllocation: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    memoryAllocationMegabytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    numServicesWithoutMemoryAllocation: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    numPorts: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    numPublicPorts: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    imageDownloadsList: jspb.Message.toObjectList(msg.getImageDownloadsList(),
+    proto.api_container_api.StarlarkImageDownloadEstimate.toObject, includeInstance),
+    hostNumCpus: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    hostMemoryBytes: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    warningsList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkResourceEstimate}
+ */
+proto.api_container_api.StarlarkResourceEstimate.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkResourceEstimate;
+  return proto.api_container_api.StarlarkResourceEstimate.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkResourceEstimate} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkResourceEstimate}
+ */
+proto.api_container_api.StarlarkResourceEstimate.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumServices(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setCpuAllocationMillicpus(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumServicesWithoutCpuAllocation(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryAllocationMegabytes(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumServicesWithoutMemoryAllocation(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumPorts(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumPublicPorts(value);
+      break;
+    case 8:
+      var value = new proto.api_container_api.StarlarkImageDownloadEstimate;
+      reader.readMessage(value,proto.api_container_api.StarlarkImageDownloadEstimate.deserializeBinaryFromReader);
+      msg.addImageDownloads(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHostNumCpus(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHostMemoryBytes(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addWarnings(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkResourceEstimate.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkResourceEstimate} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkResourceEstimate.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumServices();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getCpuAllocationMillicpus();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getNumServicesWithoutCpuAllocation();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getMemoryAllocationMegabytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getNumServicesWithoutMemoryAllocation();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getNumPorts();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getNumPublicPorts();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getImageDownloadsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      8,
+      f,
+      proto.api_container_api.StarlarkImageDownloadEstimate.serializeBinaryToWriter
+    );
+  }
+  f = message.getHostNumCpus();
+  if (f !== 0) {
+    writer.writeUint64(
+      9,
+      f
+    );
+  }
+  f = message.getHostMemoryBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      10,
+      f
+    );
+  }
+  f = message.getWarningsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      11,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional uint32 num_services = 1;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getNumServices = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setNumServices = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 cpu_allocation_millicpus = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getCpuAllocationMillicpus = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setCpuAllocationMillicpus = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 num_services_without_cpu_allocation = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getNumServicesWithoutCpuAllocation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setNumServicesWithoutCpuAllocation = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 memory_allocation_megabytes = 4;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getMemoryAllocationMegabytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setMemoryAllocationMegabytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 num_services_without_memory_allocation = 5;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getNumServicesWithoutMemoryAllocation = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setNumServicesWithoutMemoryAllocation = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 num_ports = 6;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getNumPorts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setNumPorts = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 num_public_ports = 7;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getNumPublicPorts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setNumPublicPorts = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * repeated StarlarkImageDownloadEstimate image_downloads = 8;
+ * @return {!Array<!proto.api_container_api.StarlarkImageDownloadEstimate>}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getImageDownloadsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkImageDownloadEstimate>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkImageDownloadEstimate, 8));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkImageDownloadEstimate>} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+*/
+proto.api_container_api.StarlarkResourceEstimate.prototype.setImageDownloadsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 8, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkImageDownloadEstimate=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.addImageDownloads = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 8, opt_value, proto.api_container_api.StarlarkImageDownloadEstimate, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.clearImageDownloadsList = function() {
+  return this.setImageDownloadsList([]);
+};
+
+
+/**
+ * optional uint64 host_num_cpus = 9;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getHostNumCpus = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setHostNumCpus = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional uint64 host_memory_bytes = 10;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getHostMemoryBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setHostMemoryBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * repeated string warnings = 11;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.getWarningsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.setWarningsList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.addWarnings = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkResourceEstimate} returns this
+ */
+proto.api_container_api.StarlarkResourceEstimate.prototype.clearWarningsList = function() {
+  return this.setWarningsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkImageDownloadEstimate.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkImageDownloadEstimate} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    image: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    isPresentOnHost: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    downloadSizeBytes: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkImageDownloadEstimate;
+  return proto.api_container_api.StarlarkImageDownloadEstimate.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkImageDownloadEstimate} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImage(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsPresentOnHost(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDownloadSizeBytes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkImageDownloadEstimate.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkImageDownloadEstimate} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIsPresentOnHost();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string image = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.getImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate} returns this
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.setImage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool is_present_on_host = 2;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.getIsPresentOnHost = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate} returns this
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.setIsPresentOnHost = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 download_size_bytes = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.getDownloadSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate} returns this
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.setDownloadSizeBytes = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkImageDownloadEstimate} returns this
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.clearDownloadSizeBytes = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkImageDownloadEstimate.prototype.hasDownloadSizeBytes = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StartServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StartServicesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StartServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StartServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceNamesToConfigsMap: (f = msg.getServiceNamesToConfigsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceConfig.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StartServicesArgs}
+ */
+proto.api_container_api.StartServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StartServicesArgs;
+  return proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StartServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StartServicesArgs}
+ */
+proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceNamesToConfigsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceConfig.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceConfig());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StartServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StartServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StartServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StartServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceNamesToConfigsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceConfig.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, ServiceConfig> service_names_to_configs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceConfig>}
+ */
+proto.api_container_api.StartServicesArgs.prototype.getServiceNamesToConfigsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceConfig>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceConfig));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StartServicesArgs} returns this
+ */
+proto.api_container_api.StartServicesArgs.prototype.clearServiceNamesToConfigsMap = function() {
+  this.getServiceNamesToConfigsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StartServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StartServicesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StartServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StartServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    successfulServiceNameToServiceInfoMap: (f = msg.getSuccessfulServiceNameToServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : [],
+    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StartServicesResponse}
+ */
+proto.api_container_api.StartServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StartServicesResponse;
+  return proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StartServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StartServicesResponse}
+ */
+proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getSuccessfulServiceNameToServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
+      break;
+    case 2:
+      var value = msg.getFailedServiceNameToErrorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StartServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StartServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StartServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StartServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccessfulServiceNameToServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  }
+  f = message.getFailedServiceNameToErrorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * map<string, ServiceInfo> successful_service_name_to_service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ */
+proto.api_container_api.StartServicesResponse.prototype.getSuccessfulServiceNameToServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StartServicesResponse} returns this
+ */
+proto.api_container_api.StartServicesResponse.prototype.clearSuccessfulServiceNameToServiceInfoMap = function() {
+  this.getSuccessfulServiceNameToServiceInfoMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> failed_service_name_to_error = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.StartServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.StartServicesResponse} returns this
+ */
+proto.api_container_api.StartServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
+  this.getFailedServiceNameToErrorMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesArgs}
+ */
+proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesArgs;
+  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesArgs}
+ */
+proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+};
+
+
+/**
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
+ */
+proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesResponse}
+ */
+proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesResponse;
+  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesResponse}
+ */
+proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, ServiceInfo> service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ */
+proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
+ */
+proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
+  this.getServiceInfoMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceIdentifiers;
+  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ServiceIdentifiers;
+      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ServiceIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+*/
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetServiceConfigsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServiceConfigsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServiceConfigsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServiceConfigsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceConfigsMap: (f = msg.getServiceConfigsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceConfig.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServiceConfigsResponse}
+ */
+proto.api_container_api.GetServiceConfigsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServiceConfigsResponse;
+  return proto.api_container_api.GetServiceConfigsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServiceConfigsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServiceConfigsResponse}
+ */
+proto.api_container_api.GetServiceConfigsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceConfigsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceConfig.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceConfig());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetServiceConfigsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServiceConfigsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServiceConfigsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServiceConfigsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceConfigsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceConfig.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, ServiceConfig> service_configs = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceConfig>}
+ */
+proto.api_container_api.GetServiceConfigsResponse.prototype.getServiceConfigsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceConfig>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceConfig));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServiceConfigsResponse} returns this
+ */
+proto.api_container_api.GetServiceConfigsResponse.prototype.clearServiceConfigsMap = function() {
+  this.getServiceConfigsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ScrapeTarget.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ScrapeTarget.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ScrapeTarget} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ScrapeTarget.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metricsPortId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    privateAddress: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    publicAddress: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ScrapeTarget}
+ */
+proto.api_container_api.ScrapeTarget.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ScrapeTarget;
+  return proto.api_container_api.ScrapeTarget.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ScrapeTarget} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ScrapeTarget}
+ */
+proto.api_container_api.ScrapeTarget.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMetricsPortId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateAddress(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPublicAddress(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ScrapeTarget.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ScrapeTarget.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ScrapeTarget} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ScrapeTarget.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMetricsPortId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getPrivateAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getPublicAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_name = 1;
+ * @return {string}
+ */
+proto.api_container_api.ScrapeTarget.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ScrapeTarget} returns this
+ */
+proto.api_container_api.ScrapeTarget.prototype.setServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string service_uuid = 2;
+ * @return {string}
+ */
+proto.api_container_api.ScrapeTarget.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ScrapeTarget} returns this
+ */
+proto.api_container_api.ScrapeTarget.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string metrics_port_id = 3;
+ * @return {string}
+ */
+proto.api_container_api.ScrapeTarget.prototype.getMetricsPortId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ScrapeTarget} returns this
+ */
+proto.api_container_api.ScrapeTarget.prototype.setMetricsPortId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string private_address = 4;
+ * @return {string}
+ */
+proto.api_container_api.ScrapeTarget.prototype.getPrivateAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ScrapeTarget} returns this
+ */
+proto.api_container_api.ScrapeTarget.prototype.setPrivateAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string public_address = 5;
+ * @return {string}
+ */
+proto.api_container_api.ScrapeTarget.prototype.getPublicAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ScrapeTarget} returns this
+ */
+proto.api_container_api.ScrapeTarget.prototype.setPublicAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetScrapeTargetsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetScrapeTargetsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetScrapeTargetsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetScrapeTargetsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetScrapeTargetsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    scrapeTargetsList: jspb.Message.toObjectList(msg.getScrapeTargetsList(),
+    proto.api_container_api.ScrapeTarget.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetScrapeTargetsResponse}
+ */
+proto.api_container_api.GetScrapeTargetsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetScrapeTargetsResponse;
+  return proto.api_container_api.GetScrapeTargetsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetScrapeTargetsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetScrapeTargetsResponse}
+ */
+proto.api_container_api.GetScrapeTargetsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ScrapeTarget;
+      reader.readMessage(value,proto.api_container_api.ScrapeTarget.deserializeBinaryFromReader);
+      msg.addScrapeTargets(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetScrapeTargetsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetScrapeTargetsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetScrapeTargetsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetScrapeTargetsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getScrapeTargetsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.ScrapeTarget.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ScrapeTarget scrape_targets = 1;
+ * @return {!Array<!proto.api_container_api.ScrapeTarget>}
+ */
+proto.api_container_api.GetScrapeTargetsResponse.prototype.getScrapeTargetsList = function() {
+  return /** @type{!Array<!proto.api_container_api.ScrapeTarget>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ScrapeTarget, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ScrapeTarget>} value
+ * @return {!proto.api_container_api.GetScrapeTargetsResponse} returns this
+*/
+proto.api_container_api.GetScrapeTargetsResponse.prototype.setScrapeTargetsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ScrapeTarget=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ScrapeTarget}
+ */
+proto.api_container_api.GetScrapeTargetsResponse.prototype.addScrapeTargets = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ScrapeTarget, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetScrapeTargetsResponse} returns this
+ */
+proto.api_container_api.GetScrapeTargetsResponse.prototype.clearScrapeTargetsList = function() {
+  return this.setScrapeTargetsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SubnetworkConnection.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.SubnetworkConnection} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.SubnetworkConnection.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packetLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    packetDelayMeanMs: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    packetDelayStdDevMs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    packetDelayCorrelation: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    bandwidthLimitKbps: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.SubnetworkConnection}
+ */
+proto.api_container_api.SubnetworkConnection.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.SubnetworkConnection;
+  return proto.api_container_api.SubnetworkConnection.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.SubnetworkConnection} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.SubnetworkConnection}
+ */
+proto.api_container_api.SubnetworkConnection.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setPacketLossPercentage(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPacketDelayMeanMs(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPacketDelayStdDevMs(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setPacketDelayCorrelation(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBandwidthLimitKbps(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.SubnetworkConnection.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.SubnetworkConnection} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.SubnetworkConnection.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPacketLossPercentage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      1,
+      f
+    );
+  }
+  f = message.getPacketDelayMeanMs();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPacketDelayStdDevMs();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getPacketDelayCorrelation();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      4,
+      f
+    );
+  }
+  f = message.getBandwidthLimitKbps();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional float packet_loss_percentage = 1;
+ * @return {number}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.getPacketLossPercentage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.SubnetworkConnection} returns this
+ */
+proto.api_container_api.SubnetworkConnection.prototype.setPacketLossPercentage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 packet_delay_mean_ms = 2;
+ * @return {number}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.getPacketDelayMeanMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.SubnetworkConnection} returns this
+ */
+proto.api_container_api.SubnetworkConnection.prototype.setPacketDelayMeanMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 packet_delay_std_dev_ms = 3;
+ * @return {number}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.getPacketDelayStdDevMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.SubnetworkConnection} returns this
+ */
+proto.api_container_api.SubnetworkConnection.prototype.setPacketDelayStdDevMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional float packet_delay_correlation = 4;
+ * @return {number}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.getPacketDelayCorrelation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.SubnetworkConnection} returns this
+ */
+proto.api_container_api.SubnetworkConnection.prototype.setPacketDelayCorrelation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 bandwidth_limit_kbps = 5;
+ * @return {number}
+ */
+proto.api_container_api.SubnetworkConnection.prototype.getBandwidthLimitKbps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.SubnetworkConnection} returns this
+ */
+proto.api_container_api.SubnetworkConnection.prototype.setBandwidthLimitKbps = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.AddPartitionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.AddPartitionArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.AddPartitionArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.AddPartitionArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    partitionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceNameSetMap: (f = msg.getServiceNameSetMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.AddPartitionArgs}
+ */
+proto.api_container_api.AddPartitionArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.AddPartitionArgs;
+  return proto.api_container_api.AddPartitionArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.AddPartitionArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.AddPartitionArgs}
+ */
+proto.api_container_api.AddPartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartitionId(value);
+      break;
+    case 2:
+      var value = msg.getServiceNameSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.AddPartitionArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.AddPartitionArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.AddPartitionArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.AddPartitionArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPartitionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceNameSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+};
+
+
+/**
+ * optional string partition_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.AddPartitionArgs.prototype.getPartitionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.AddPartitionArgs} returns this
+ */
+proto.api_container_api.AddPartitionArgs.prototype.setPartitionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, bool> service_name_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.AddPartitionArgs.prototype.getServiceNameSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.AddPartitionArgs} returns this
+ */
+proto.api_container_api.AddPartitionArgs.prototype.clearServiceNameSetMap = function() {
+  this.getServiceNameSetMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.MovePartitionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.MovePartitionArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.MovePartitionArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.MovePartitionArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    partitionId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceNameSetMap: (f = msg.getServiceNameSetMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.MovePartitionArgs}
+ */
+proto.api_container_api.MovePartitionArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.MovePartitionArgs;
+  return proto.api_container_api.MovePartitionArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.MovePartitionArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.MovePartitionArgs}
+ */
+proto.api_container_api.MovePartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartitionId(value);
+      break;
+    case 2:
+      var value = msg.getServiceNameSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.MovePartitionArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.MovePartitionArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.MovePartitionArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.MovePartitionArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPartitionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceNameSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+};
+
+
+/**
+ * optional string partition_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.MovePartitionArgs.prototype.getPartitionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.MovePartitionArgs} returns this
+ */
+proto.api_container_api.MovePartitionArgs.prototype.setPartitionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, bool> service_name_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.MovePartitionArgs.prototype.getServiceNameSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.MovePartitionArgs} returns this
+ */
+proto.api_container_api.MovePartitionArgs.prototype.clearServiceNameSetMap = function() {
+  this.getServiceNameSetMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UpdateConnectionArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.UpdateConnectionArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.UpdateConnectionArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    partitionIdA: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    partitionIdB: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    connectionInfo: (f = msg.getConnectionInfo()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.UpdateConnectionArgs}
+ */
+proto.api_container_api.UpdateConnectionArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.UpdateConnectionArgs;
+  return proto.api_container_api.UpdateConnectionArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.UpdateConnectionArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.UpdateConnectionArgs}
+ */
+proto.api_container_api.UpdateConnectionArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartitionIdA(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartitionIdB(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.PartitionConnectionInfo;
+      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
+      msg.setConnectionInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.UpdateConnectionArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.UpdateConnectionArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.UpdateConnectionArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPartitionIdA();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPartitionIdB();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getConnectionInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string partition_id_a = 1;
+ * @return {string}
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.getPartitionIdA = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.UpdateConnectionArgs} returns this
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.setPartitionIdA = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string partition_id_b = 2;
+ * @return {string}
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.getPartitionIdB = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.UpdateConnectionArgs} returns this
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.setPartitionIdB = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional PartitionConnectionInfo connection_info = 3;
+ * @return {?proto.api_container_api.PartitionConnectionInfo}
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.getConnectionInfo = function() {
+  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
+ * @return {!proto.api_container_api.UpdateConnectionArgs} returns this
+*/
+proto.api_container_api.UpdateConnectionArgs.prototype.setConnectionInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.UpdateConnectionArgs} returns this
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.clearConnectionInfo = function() {
+  return this.setConnectionInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.UpdateConnectionArgs.prototype.hasConnectionInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SubnetworkConnectionOverride.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.SubnetworkConnectionOverride} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.SubnetworkConnectionOverride.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    subnetwork1: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    subnetwork2: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    connection: (f = msg.getConnection()) && proto.api_container_api.SubnetworkConnection.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.SubnetworkConnectionOverride;
+  return proto.api_container_api.SubnetworkConnectionOverride.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.SubnetworkConnectionOverride} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSubnetwork1(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSubnetwork2(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.SubnetworkConnection;
+      reader.readMessage(value,proto.api_container_api.SubnetworkConnection.deserializeBinaryFromReader);
+      msg.setConnection(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.SubnetworkConnectionOverride.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.SubnetworkConnectionOverride} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.SubnetworkConnectionOverride.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSubnetwork1();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSubnetwork2();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getConnection();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.SubnetworkConnection.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string subnetwork1 = 1;
+ * @return {string}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.getSubnetwork1 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride} returns this
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.setSubnetwork1 = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string subnetwork2 = 2;
+ * @return {string}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.getSubnetwork2 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride} returns this
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.setSubnetwork2 = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional SubnetworkConnection connection = 3;
+ * @return {?proto.api_container_api.SubnetworkConnection}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.getConnection = function() {
+  return /** @type{?proto.api_container_api.SubnetworkConnection} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.SubnetworkConnection, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.SubnetworkConnection|undefined} value
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride} returns this
+*/
+proto.api_container_api.SubnetworkConnectionOverride.prototype.setConnection = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride} returns this
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.clearConnection = function() {
+  return this.setConnection(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.SubnetworkConnectionOverride.prototype.hasConnection = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetSubnetworkConnectionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetSubnetworkConnectionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    defaultConnection: (f = msg.getDefaultConnection()) && proto.api_container_api.SubnetworkConnection.toObject(includeInstance, f),
+    connectionOverridesList: jspb.Message.toObjectList(msg.getConnectionOverridesList(),
+    proto.api_container_api.SubnetworkConnectionOverride.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetSubnetworkConnectionsResponse}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetSubnetworkConnectionsResponse;
+  return proto.api_container_api.GetSubnetworkConnectionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetSubnetworkConnectionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetSubnetworkConnectionsResponse}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.SubnetworkConnection;
+      reader.readMessage(value,proto.api_container_api.SubnetworkConnection.deserializeBinaryFromReader);
+      msg.setDefaultConnection(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.SubnetworkConnectionOverride;
+      reader.readMessage(value,proto.api_container_api.SubnetworkConnectionOverride.deserializeBinaryFromReader);
+      msg.addConnectionOverrides(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetSubnetworkConnectionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetSubnetworkConnectionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDefaultConnection();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.SubnetworkConnection.serializeBinaryToWriter
+    );
+  }
+  f = message.getConnectionOverridesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.SubnetworkConnectionOverride.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional SubnetworkConnection default_connection = 1;
+ * @return {?proto.api_container_api.SubnetworkConnection}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.getDefaultConnection = function() {
+  return /** @type{?proto.api_container_api.SubnetworkConnection} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.SubnetworkConnection, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.SubnetworkConnection|undefined} value
+ * @return {!proto.api_container_api.GetSubnetworkConnectionsResponse} returns this
+*/
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.setDefaultConnection = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetSubnetworkConnectionsResponse} returns this
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.clearDefaultConnection = function() {
+  return this.setDefaultConnection(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.hasDefaultConnection = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated SubnetworkConnectionOverride connection_overrides = 2;
+ * @return {!Array<!proto.api_container_api.SubnetworkConnectionOverride>}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.getConnectionOverridesList = function() {
+  return /** @type{!Array<!proto.api_container_api.SubnetworkConnectionOverride>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.SubnetworkConnectionOverride, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.SubnetworkConnectionOverride>} value
+ * @return {!proto.api_container_api.GetSubnetworkConnectionsResponse} returns this
+*/
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.setConnectionOverridesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.SubnetworkConnectionOverride=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.SubnetworkConnectionOverride}
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.addConnectionOverrides = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.SubnetworkConnectionOverride, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetSubnetworkConnectionsResponse} returns this
+ */
+proto.api_container_api.GetSubnetworkConnectionsResponse.prototype.clearConnectionOverridesList = function() {
+  return this.setConnectionOverridesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.TestConnectivityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.TestConnectivityArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.TestConnectivityArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.TestConnectivityArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : [],
+    numProbes: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.TestConnectivityArgs}
+ */
+proto.api_container_api.TestConnectivityArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.TestConnectivityArgs;
+  return proto.api_container_api.TestConnectivityArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.TestConnectivityArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.TestConnectivityArgs}
+ */
+proto.api_container_api.TestConnectivityArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumProbes(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.TestConnectivityArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.TestConnectivityArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.TestConnectivityArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.TestConnectivityArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getNumProbes();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.TestConnectivityArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.TestConnectivityArgs} returns this
+ */
+proto.api_container_api.TestConnectivityArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
+
+/**
+ * optional uint32 num_probes = 2;
+ * @return {number}
+ */
+proto.api_container_api.TestConnectivityArgs.prototype.getNumProbes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.TestConnectivityArgs} returns this
+ */
+proto.api_container_api.TestConnectivityArgs.prototype.setNumProbes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectivityProbeResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ConnectivityProbeResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ConnectivityProbeResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    sourceServiceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    targetServiceName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    expectedPacketLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
+    expectedPacketDelayMs: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    observedPacketLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 5, 0.0),
+    observedAvgLatencyMs: jspb.Message.getFloatingPointFieldWithDefault(msg, 6, 0.0),
+    probeError: jspb.Message.getFieldWithDefault(msg, 7, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ConnectivityProbeResult}
+ */
+proto.api_container_api.ConnectivityProbeResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ConnectivityProbeResult;
+  return proto.api_container_api.ConnectivityProbeResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ConnectivityProbeResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ConnectivityProbeResult}
+ */
+proto.api_container_api.ConnectivityProbeResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourceServiceName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetServiceName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setExpectedPacketLossPercentage(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setExpectedPacketDelayMs(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setObservedPacketLossPercentage(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setObservedAvgLatencyMs(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProbeError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectivityProbeResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectivityProbeResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ConnectivityProbeResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSourceServiceName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTargetServiceName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getExpectedPacketLossPercentage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      3,
+      f
+    );
+  }
+  f = message.getExpectedPacketDelayMs();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getObservedPacketLossPercentage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      5,
+      f
+    );
+  }
+  f = message.getObservedAvgLatencyMs();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      6,
+      f
+    );
+  }
+  f = message.getProbeError();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string source_service_name = 1;
+ * @return {string}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getSourceServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setSourceServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string target_service_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getTargetServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setTargetServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional float expected_packet_loss_percentage = 3;
+ * @return {number}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getExpectedPacketLossPercentage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 3, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setExpectedPacketLossPercentage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 expected_packet_delay_ms = 4;
+ * @return {number}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getExpectedPacketDelayMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setExpectedPacketDelayMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional float observed_packet_loss_percentage = 5;
+ * @return {number}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getObservedPacketLossPercentage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 5, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setObservedPacketLossPercentage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 5, value);
+};
+
+
+/**
+ * optional float observed_avg_latency_ms = 6;
+ * @return {number}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getObservedAvgLatencyMs = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 6, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setObservedAvgLatencyMs = function(value) {
+  return jspb.Message.setProto3FloatField(this, 6, value);
+};
+
+
+/**
+ * optional string probe_error = 7;
+ * @return {string}
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.getProbeError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ConnectivityProbeResult} returns this
+ */
+proto.api_container_api.ConnectivityProbeResult.prototype.setProbeError = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.TestConnectivityResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.TestConnectivityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.TestConnectivityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.TestConnectivityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.TestConnectivityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    probeResultsList: jspb.Message.toObjectList(msg.getProbeResultsList(),
+    proto.api_container_api.ConnectivityProbeResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.TestConnectivityResponse}
+ */
+proto.api_container_api.TestConnectivityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.TestConnectivityResponse;
+  return proto.api_container_api.TestConnectivityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.TestConnectivityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.TestConnectivityResponse}
+ */
+proto.api_container_api.TestConnectivityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ConnectivityProbeResult;
+      reader.readMessage(value,proto.api_container_api.ConnectivityProbeResult.deserializeBinaryFromReader);
+      msg.addProbeResults(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.TestConnectivityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.TestConnectivityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.TestConnectivityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.TestConnectivityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getProbeResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.ConnectivityProbeResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ConnectivityProbeResult probe_results = 1;
+ * @return {!Array<!proto.api_container_api.ConnectivityProbeResult>}
+ */
+proto.api_container_api.TestConnectivityResponse.prototype.getProbeResultsList = function() {
+  return /** @type{!Array<!proto.api_container_api.ConnectivityProbeResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ConnectivityProbeResult, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ConnectivityProbeResult>} value
+ * @return {!proto.api_container_api.TestConnectivityResponse} returns this
+*/
+proto.api_container_api.TestConnectivityResponse.prototype.setProbeResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ConnectivityProbeResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ConnectivityProbeResult}
+ */
+proto.api_container_api.TestConnectivityResponse.prototype.addProbeResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ConnectivityProbeResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.TestConnectivityResponse} returns this
+ */
+proto.api_container_api.TestConnectivityResponse.prototype.clearProbeResultsList = function() {
+  return this.setProbeResultsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RemoveServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveServiceArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RemoveServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RemoveServiceArgs}
+ */
+proto.api_container_api.RemoveServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RemoveServiceArgs;
+  return proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RemoveServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RemoveServiceArgs}
+ */
+proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RemoveServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RemoveServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.RemoveServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RemoveServiceArgs} returns this
+ */
+proto.api_container_api.RemoveServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RemoveServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveServiceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RemoveServiceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServiceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RemoveServiceResponse}
+ */
+proto.api_container_api.RemoveServiceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RemoveServiceResponse;
+  return proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RemoveServiceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RemoveServiceResponse}
+ */
+proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RemoveServiceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RemoveServiceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.RemoveServiceResponse.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RemoveServiceResponse} returns this
+ */
+proto.api_container_api.RemoveServiceResponse.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RemoveServicesArgs.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveServicesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RemoveServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : [],
+    serviceNamePatternsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    allServices: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RemoveServicesArgs}
+ */
+proto.api_container_api.RemoveServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RemoveServicesArgs;
+  return proto.api_container_api.RemoveServicesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RemoveServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RemoveServicesArgs}
+ */
+proto.api_container_api.RemoveServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addServiceNamePatterns(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllServices(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RemoveServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RemoveServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getServiceNamePatternsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getAllServices();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RemoveServicesArgs} returns this
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
+
+/**
+ * repeated string service_name_patterns = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.getServiceNamePatternsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.RemoveServicesArgs} returns this
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.setServiceNamePatternsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RemoveServicesArgs} returns this
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.addServiceNamePatterns = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RemoveServicesArgs} returns this
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.clearServiceNamePatternsList = function() {
+  return this.setServiceNamePatternsList([]);
+};
+
+
+/**
+ * optional bool all_services = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.getAllServices = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RemoveServicesArgs} returns this
+ */
+proto.api_container_api.RemoveServicesArgs.prototype.setAllServices = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RemoveServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemoveServicesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RemoveServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServicesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, "")
+    removedServiceNameToUuidMap: (f = msg.getRemovedServiceNameToUuidMap()) ? f.toObject(includeInstance, undefined) : [],
+    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -1131,23 +12769,23 @@ proto.api_container_api.Port.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.Port}
+ * @return {!proto.api_container_api.RemoveServicesResponse}
  */
-proto.api_container_api.Port.deserializeBinary = function(bytes) {
+proto.api_container_api.RemoveServicesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.Port;
-  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RemoveServicesResponse;
+  return proto.api_container_api.RemoveServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RemoveServicesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.Port}
+ * @return {!proto.api_container_api.RemoveServicesResponse}
  */
-proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RemoveServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1155,16 +12793,188 @@ proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumber(value);
+      var value = msg.getRemovedServiceNameToUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     case 2:
-      var value = /** @type {!proto.api_container_api.Port.TransportProtocol} */ (reader.readEnum());
-      msg.setTransportProtocol(value);
+      var value = msg.getFailedServiceNameToErrorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RemoveServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RemoveServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RemoveServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RemoveServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedServiceNameToUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getFailedServiceNameToErrorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * map<string, string> removed_service_name_to_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.RemoveServicesResponse.prototype.getRemovedServiceNameToUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RemoveServicesResponse} returns this
+ */
+proto.api_container_api.RemoveServicesResponse.prototype.clearRemovedServiceNameToUuidMap = function() {
+  this.getRemovedServiceNameToUuidMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> failed_service_name_to_error = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.api_container_api.RemoveServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RemoveServicesResponse} returns this
+ */
+proto.api_container_api.RemoveServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
+  this.getFailedServiceNameToErrorMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RepartitionArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RepartitionArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    partitionServicesMap: (f = msg.getPartitionServicesMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionServices.toObject) : [],
+    partitionConnectionsMap: (f = msg.getPartitionConnectionsMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnections.toObject) : [],
+    defaultConnection: (f = msg.getDefaultConnection()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RepartitionArgs}
+ */
+proto.api_container_api.RepartitionArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RepartitionArgs;
+  return proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RepartitionArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RepartitionArgs}
+ */
+proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getPartitionServicesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionServices.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionServices());
+         });
+      break;
+    case 2:
+      var value = msg.getPartitionConnectionsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnections.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnections());
+         });
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybeApplicationProtocol(value);
+      var value = new proto.api_container_api.PartitionConnectionInfo;
+      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
+      msg.setDefaultConnection(value);
       break;
     default:
       reader.skipField();
@@ -1179,9 +12989,9 @@ proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.Port.prototype.serializeBinary = function() {
+proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.Port.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RepartitionArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1189,96 +12999,109 @@ proto.api_container_api.Port.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.Port} message
+ * @param {!proto.api_container_api.RepartitionArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RepartitionArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      1,
-      f
-    );
+  f = message.getPartitionServicesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionServices.serializeBinaryToWriter);
   }
-  f = message.getTransportProtocol();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
+  f = message.getPartitionConnectionsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnections.serializeBinaryToWriter);
   }
-  f = message.getMaybeApplicationProtocol();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getDefaultConnection();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * @enum {number}
+ * map<string, PartitionServices> partition_services = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.PartitionServices>}
  */
-proto.api_container_api.Port.TransportProtocol = {
-  TCP: 0,
-  SCTP: 1,
-  UDP: 2
+proto.api_container_api.RepartitionArgs.prototype.getPartitionServicesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionServices>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.PartitionServices));
 };
 
+
 /**
- * optional uint32 number = 1;
- * @return {number}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
  */
-proto.api_container_api.Port.prototype.getNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
+proto.api_container_api.RepartitionArgs.prototype.clearPartitionServicesMap = function() {
+  this.getPartitionServicesMap().clear();
+  return this;};
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.Port} returns this
+ * map<string, PartitionConnections> partition_connections = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnections>}
  */
-proto.api_container_api.Port.prototype.setNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.api_container_api.RepartitionArgs.prototype.getPartitionConnectionsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnections>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      proto.api_container_api.PartitionConnections));
 };
 
 
 /**
- * optional TransportProtocol transport_protocol = 2;
- * @return {!proto.api_container_api.Port.TransportProtocol}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
  */
-proto.api_container_api.Port.prototype.getTransportProtocol = function() {
-  return /** @type {!proto.api_container_api.Port.TransportProtocol} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
+proto.api_container_api.RepartitionArgs.prototype.clearPartitionConnectionsMap = function() {
+  this.getPartitionConnectionsMap().clear();
+  return this;};
 
 
 /**
- * @param {!proto.api_container_api.Port.TransportProtocol} value
- * @return {!proto.api_container_api.Port} returns this
+ * optional PartitionConnectionInfo default_connection = 3;
+ * @return {?proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.Port.prototype.setTransportProtocol = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.api_container_api.RepartitionArgs.prototype.getDefaultConnection = function() {
+  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
 };
 
 
 /**
- * optional string maybe_application_protocol = 3;
- * @return {string}
+ * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+*/
+proto.api_container_api.RepartitionArgs.prototype.setDefaultConnection = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
  */
-proto.api_container_api.Port.prototype.getMaybeApplicationProtocol = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.RepartitionArgs.prototype.clearDefaultConnection = function() {
+  return this.setDefaultConnection(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.Port} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.RepartitionArgs.prototype.hasDefaultConnection = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -1298,8 +13121,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionServices.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionServices.toObject(opt_includeInstance, this);
 };
 
 
@@ -1308,19 +13131,13 @@ proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionServices} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionServices.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    privateIpAddr: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    name: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, "")
+    serviceNameSetMap: (f = msg.getServiceNameSetMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -1334,23 +13151,23 @@ proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceInfo}
+ * @return {!proto.api_container_api.PartitionServices}
  */
-proto.api_container_api.ServiceInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionServices.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceInfo;
-  return proto.api_container_api.ServiceInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionServices;
+  return proto.api_container_api.PartitionServices.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionServices} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceInfo}
+ * @return {!proto.api_container_api.PartitionServices}
  */
-proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1358,37 +13175,11 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPrivateIpAddr(value);
-      break;
-    case 3:
-      var value = msg.getPrivatePortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybePublicIpAddr(value);
-      break;
-    case 5:
-      var value = msg.getMaybePublicPortsMap();
+      var value = msg.getServiceNameSetMap();
       reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
          });
       break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
-      break;
     default:
       reader.skipField();
       break;
@@ -1402,9 +13193,9 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
+proto.api_container_api.PartitionServices.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PartitionServices.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1412,199 +13203,174 @@ proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceInfo} message
+ * @param {!proto.api_container_api.PartitionServices} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PartitionServices.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getPrivateIpAddr();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getPrivatePortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getMaybePublicIpAddr();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getMaybePublicPortsMap(true);
+  f = message.getServiceNameSetMap(true);
   if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
 };
 
 
 /**
- * optional string service_uuid = 1;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getServiceUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setServiceUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string private_ip_addr = 2;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getPrivateIpAddr = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setPrivateIpAddr = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * map<string, Port> private_ports = 3;
+ * map<string, bool> service_name_set = 1;
  * @param {boolean=} opt_noLazyCreate Do not create the map if
  * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.ServiceInfo.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.api_container_api.Port));
+proto.api_container_api.PartitionServices.prototype.getServiceNameSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
  * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * @return {!proto.api_container_api.PartitionServices} returns this
  */
-proto.api_container_api.ServiceInfo.prototype.clearPrivatePortsMap = function() {
-  this.getPrivatePortsMap().clear();
+proto.api_container_api.PartitionServices.prototype.clearServiceNameSetMap = function() {
+  this.getServiceNameSetMap().clear();
   return this;};
 
 
-/**
- * optional string maybe_public_ip_addr = 4;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getMaybePublicIpAddr = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.ServiceInfo.prototype.setMaybePublicIpAddr = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.PartitionConnections.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionConnections.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * map<string, Port> maybe_public_ports = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.PartitionConnections} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.prototype.getMaybePublicPortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      proto.api_container_api.Port));
+proto.api_container_api.PartitionConnections.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    connectionInfoMap: (f = msg.getConnectionInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnectionInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.PartitionConnections}
  */
-proto.api_container_api.ServiceInfo.prototype.clearMaybePublicPortsMap = function() {
-  this.getMaybePublicPortsMap().clear();
-  return this;};
+proto.api_container_api.PartitionConnections.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.PartitionConnections;
+  return proto.api_container_api.PartitionConnections.deserializeBinaryFromReader(msg, reader);
+};
 
 
 /**
- * optional string name = 6;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.PartitionConnections} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.PartitionConnections}
  */
-proto.api_container_api.ServiceInfo.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getConnectionInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnectionInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceInfo.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.PartitionConnections.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.PartitionConnections.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string shortened_uuid = 7;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.PartitionConnections} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.PartitionConnections.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConnectionInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter);
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * map<string, PartitionConnectionInfo> connection_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>}
  */
-proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.api_container_api.PartitionConnections.prototype.getConnectionInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.PartitionConnectionInfo));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.PartitionConnections} returns this
  */
-proto.api_container_api.ServiceConfig.repeatedFields_ = [4,5];
+proto.api_container_api.PartitionConnections.prototype.clearConnectionInfoMap = function() {
+  this.getConnectionInfoMap().clear();
+  return this;};
+
+
 
 
 
@@ -1621,8 +13387,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceConfig.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceConfig.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionConnectionInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionConnectionInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -1631,23 +13397,17 @@ proto.api_container_api.ServiceConfig.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceConfig} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceConfig.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionConnectionInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    containerImageName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    publicPortsMap: (f = msg.getPublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    entrypointArgsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f,
-    cmdArgsList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f,
-    envVarsMap: (f = msg.getEnvVarsMap()) ? f.toObject(includeInstance, undefined) : [],
-    filesArtifactMountpointsMap: (f = msg.getFilesArtifactMountpointsMap()) ? f.toObject(includeInstance, undefined) : [],
-    cpuAllocationMillicpus: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    memoryAllocationMegabytes: jspb.Message.getFieldWithDefault(msg, 9, 0),
-    privateIpAddrPlaceholder: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    subnetwork: jspb.Message.getFieldWithDefault(msg, 11, "")
+    packetLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    packetDelayMeanMs: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    packetDelayStdDevMs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    packetDelayCorrelation: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    bandwidthLimitKbps: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -1661,23 +13421,23 @@ proto.api_container_api.ServiceConfig.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceConfig}
+ * @return {!proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.ServiceConfig.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionConnectionInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceConfig;
-  return proto.api_container_api.ServiceConfig.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionConnectionInfo;
+  return proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceConfig} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceConfig}
+ * @return {!proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.ServiceConfig.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1685,56 +13445,24 @@ proto.api_container_api.ServiceConfig.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setContainerImageName(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setPacketLossPercentage(value);
       break;
     case 2:
-      var value = msg.getPrivatePortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPacketDelayMeanMs(value);
       break;
     case 3:
-      var value = msg.getPublicPortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPacketDelayStdDevMs(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addEntrypointArgs(value);
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setPacketDelayCorrelation(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addCmdArgs(value);
-      break;
-    case 6:
-      var value = msg.getEnvVarsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 7:
-      var value = msg.getFilesArtifactMountpointsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
-      break;
-    case 8:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setCpuAllocationMillicpus(value);
-      break;
-    case 9:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setMemoryAllocationMegabytes(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPrivateIpAddrPlaceholder(value);
-      break;
-    case 11:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSubnetwork(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setBandwidthLimitKbps(value);
       break;
     default:
       reader.skipField();
@@ -1749,9 +13477,9 @@ proto.api_container_api.ServiceConfig.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceConfig.prototype.serializeBinary = function() {
+proto.api_container_api.PartitionConnectionInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceConfig.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1759,74 +13487,44 @@ proto.api_container_api.ServiceConfig.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceConfig} message
+ * @param {!proto.api_container_api.PartitionConnectionInfo} message
  * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.ServiceConfig.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getContainerImageName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getPrivatePortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getPublicPortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getEntrypointArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      4,
-      f
-    );
-  }
-  f = message.getCmdArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      5,
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPacketLossPercentage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      1,
       f
     );
   }
-  f = message.getEnvVarsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(6, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getFilesArtifactMountpointsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(7, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
-  f = message.getCpuAllocationMillicpus();
+  f = message.getPacketDelayMeanMs();
   if (f !== 0) {
-    writer.writeUint64(
-      8,
+    writer.writeUint32(
+      2,
       f
     );
   }
-  f = message.getMemoryAllocationMegabytes();
+  f = message.getPacketDelayStdDevMs();
   if (f !== 0) {
-    writer.writeUint64(
-      9,
+    writer.writeUint32(
+      3,
       f
     );
   }
-  f = message.getPrivateIpAddrPlaceholder();
-  if (f.length > 0) {
-    writer.writeString(
-      10,
+  f = message.getPacketDelayCorrelation();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      4,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 11));
-  if (f != null) {
-    writer.writeString(
-      11,
+  f = message.getBandwidthLimitKbps();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
       f
     );
   }
@@ -1834,272 +13532,278 @@ proto.api_container_api.ServiceConfig.serializeBinaryToWriter = function(message
 
 
 /**
- * optional string container_image_name = 1;
- * @return {string}
+ * optional float packet_loss_percentage = 1;
+ * @return {number}
  */
-proto.api_container_api.ServiceConfig.prototype.getContainerImageName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.PartitionConnectionInfo.prototype.getPacketLossPercentage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.setContainerImageName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.PartitionConnectionInfo.prototype.setPacketLossPercentage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
 };
 
 
 /**
- * map<string, Port> private_ports = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ * optional uint32 packet_delay_mean_ms = 2;
+ * @return {number}
  */
-proto.api_container_api.ServiceConfig.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      proto.api_container_api.Port));
+proto.api_container_api.PartitionConnectionInfo.prototype.getPacketDelayMeanMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceConfig} returns this
- */
-proto.api_container_api.ServiceConfig.prototype.clearPrivatePortsMap = function() {
-  this.getPrivatePortsMap().clear();
-  return this;};
-
-
-/**
- * map<string, Port> public_ports = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.getPublicPortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.api_container_api.Port));
+proto.api_container_api.PartitionConnectionInfo.prototype.setPacketDelayMeanMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceConfig} returns this
- */
-proto.api_container_api.ServiceConfig.prototype.clearPublicPortsMap = function() {
-  this.getPublicPortsMap().clear();
-  return this;};
-
-
-/**
- * repeated string entrypoint_args = 4;
- * @return {!Array<string>}
+ * optional uint32 packet_delay_std_dev_ms = 3;
+ * @return {number}
  */
-proto.api_container_api.ServiceConfig.prototype.getEntrypointArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+proto.api_container_api.PartitionConnectionInfo.prototype.getPacketDelayStdDevMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.setEntrypointArgsList = function(value) {
-  return jspb.Message.setField(this, 4, value || []);
+proto.api_container_api.PartitionConnectionInfo.prototype.setPacketDelayStdDevMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * optional float packet_delay_correlation = 4;
+ * @return {number}
  */
-proto.api_container_api.ServiceConfig.prototype.addEntrypointArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+proto.api_container_api.PartitionConnectionInfo.prototype.getPacketDelayCorrelation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.clearEntrypointArgsList = function() {
-  return this.setEntrypointArgsList([]);
+proto.api_container_api.PartitionConnectionInfo.prototype.setPacketDelayCorrelation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
 };
 
 
 /**
- * repeated string cmd_args = 5;
- * @return {!Array<string>}
+ * optional uint32 bandwidth_limit_kbps = 5;
+ * @return {number}
  */
-proto.api_container_api.ServiceConfig.prototype.getCmdArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+proto.api_container_api.PartitionConnectionInfo.prototype.getBandwidthLimitKbps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.setCmdArgsList = function(value) {
-  return jspb.Message.setField(this, 5, value || []);
+proto.api_container_api.PartitionConnectionInfo.prototype.setBandwidthLimitKbps = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceConfig} returns this
- */
-proto.api_container_api.ServiceConfig.prototype.addCmdArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
-};
-
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.ServiceConfig.prototype.clearCmdArgsList = function() {
-  return this.setCmdArgsList([]);
-};
+proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * map<string, string> env_vars = 6;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.ServiceConfig.prototype.getEnvVarsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 6, opt_noLazyCreate,
-      null));
+proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceConfig.prototype.clearEnvVarsMap = function() {
-  this.getEnvVarsMap().clear();
-  return this;};
-
+proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
 
-/**
- * map<string, string> files_artifact_mountpoints = 7;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
- */
-proto.api_container_api.ServiceConfig.prototype.getFilesArtifactMountpointsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 7, opt_noLazyCreate,
-      null));
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceConfig} returns this
- */
-proto.api_container_api.ServiceConfig.prototype.clearFilesArtifactMountpointsMap = function() {
-  this.getFilesArtifactMountpointsMap().clear();
-  return this;};
-
-
-/**
- * optional uint64 cpu_allocation_millicpus = 8;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.ServiceConfig.prototype.getCpuAllocationMillicpus = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ExecCommandArgs;
+  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ExecCommandArgs}
  */
-proto.api_container_api.ServiceConfig.prototype.setCpuAllocationMillicpus = function(value) {
-  return jspb.Message.setProto3IntField(this, 8, value);
+proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandArgs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint64 memory_allocation_megabytes = 9;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceConfig.prototype.getMemoryAllocationMegabytes = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceConfig.prototype.setMemoryAllocationMegabytes = function(value) {
-  return jspb.Message.setProto3IntField(this, 9, value);
+proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCommandArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string private_ip_addr_placeholder = 10;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceConfig.prototype.getPrivateIpAddrPlaceholder = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.setPrivateIpAddrPlaceholder = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string subnetwork = 11;
- * @return {string}
+ * repeated string command_args = 2;
+ * @return {!Array<string>}
  */
-proto.api_container_api.ServiceConfig.prototype.getSubnetwork = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.setSubnetwork = function(value) {
-  return jspb.Message.setField(this, 11, value);
+proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.ServiceConfig} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.clearSubnetwork = function() {
-  return jspb.Message.setField(this, 11, undefined);
+proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
  */
-proto.api_container_api.ServiceConfig.prototype.hasSubnetwork = function() {
-  return jspb.Message.getField(this, 11) != null;
+proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
+  return this.setCommandArgsList([]);
 };
 
 
@@ -2119,8 +13823,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UpdateServiceConfig.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UpdateServiceConfig.toObject(opt_includeInstance, this);
+proto.api_container_api.PauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PauseServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2129,13 +13833,13 @@ proto.api_container_api.UpdateServiceConfig.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UpdateServiceConfig} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PauseServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UpdateServiceConfig.toObject = function(includeInstance, msg) {
+proto.api_container_api.PauseServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    subnetwork: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2149,23 +13853,23 @@ proto.api_container_api.UpdateServiceConfig.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UpdateServiceConfig}
+ * @return {!proto.api_container_api.PauseServiceArgs}
  */
-proto.api_container_api.UpdateServiceConfig.deserializeBinary = function(bytes) {
+proto.api_container_api.PauseServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UpdateServiceConfig;
-  return proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PauseServiceArgs;
+  return proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UpdateServiceConfig} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PauseServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UpdateServiceConfig}
+ * @return {!proto.api_container_api.PauseServiceArgs}
  */
-proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2174,7 +13878,7 @@ proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSubnetwork(value);
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -2189,9 +13893,9 @@ proto.api_container_api.UpdateServiceConfig.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UpdateServiceConfig.prototype.serializeBinary = function() {
+proto.api_container_api.PauseServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UpdateServiceConfig.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2199,14 +13903,14 @@ proto.api_container_api.UpdateServiceConfig.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UpdateServiceConfig} message
+ * @param {!proto.api_container_api.PauseServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UpdateServiceConfig.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = /** @type {string} */ (jspb.Message.getField(message, 1));
-  if (f != null) {
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
     writer.writeString(
       1,
       f
@@ -2216,38 +13920,20 @@ proto.api_container_api.UpdateServiceConfig.serializeBinaryToWriter = function(m
 
 
 /**
- * optional string subnetwork = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.UpdateServiceConfig.prototype.getSubnetwork = function() {
+proto.api_container_api.PauseServiceArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UpdateServiceConfig} returns this
- */
-proto.api_container_api.UpdateServiceConfig.prototype.setSubnetwork = function(value) {
-  return jspb.Message.setField(this, 1, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.UpdateServiceConfig} returns this
- */
-proto.api_container_api.UpdateServiceConfig.prototype.clearSubnetwork = function() {
-  return jspb.Message.setField(this, 1, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.PauseServiceArgs} returns this
  */
-proto.api_container_api.UpdateServiceConfig.prototype.hasSubnetwork = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.PauseServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -2267,8 +13953,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.UnpauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UnpauseServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2277,16 +13963,13 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UnpauseServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.UnpauseServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2300,23 +13983,23 @@ proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.UnpauseServiceArgs}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.UnpauseServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
-  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UnpauseServiceArgs;
+  return proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UnpauseServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.UnpauseServiceArgs}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2325,19 +14008,7 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -2352,9 +14023,9 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+proto.api_container_api.UnpauseServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2362,180 +14033,206 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!proto.api_container_api.UnpauseServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedScript();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSerializedParams();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string serialized_script = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
+proto.api_container_api.UnpauseServiceArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @return {!proto.api_container_api.UnpauseServiceArgs} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
+proto.api_container_api.UnpauseServiceArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional string serialized_params = 2;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
 
 
-/**
- * optional bool dry_run = 3;
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.api_container_api.PauseServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PauseServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.PauseServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.PauseServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : [],
+    allServices: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.PauseServicesArgs}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.PauseServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.PauseServicesArgs;
+  return proto.api_container_api.PauseServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional int32 parallelism = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.PauseServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.PauseServicesArgs}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.PauseServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllServices(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.PauseServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.PauseServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.PauseServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.PauseServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getAllServices();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.PauseServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
-
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.PauseServicesArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
+proto.api_container_api.PauseServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
 
 /**
- * @enum {number}
+ * optional bool all_services = 2;
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
-  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
-  LOCAL: 3,
-  REMOTE: 4
+proto.api_container_api.PauseServicesArgs.prototype.getAllServices = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
+
 /**
- * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.PauseServicesArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
-  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
+proto.api_container_api.PauseServicesArgs.prototype.setAllServices = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2549,8 +14246,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.PauseServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PauseServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2559,18 +14256,14 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PauseServicesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.PauseServicesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    local: msg.getLocal_asB64(),
-    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0)
+    successfulServiceNamesMap: (f = msg.getSuccessfulServiceNamesMap()) ? f.toObject(includeInstance, undefined) : [],
+    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -2584,23 +14277,23 @@ proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.PauseServicesResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.PauseServicesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
-  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PauseServicesResponse;
+  return proto.api_container_api.PauseServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PauseServicesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.PauseServicesResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PauseServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2608,28 +14301,16 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
-      break;
-    case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setLocal(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRemote(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
+      var value = msg.getSuccessfulServiceNamesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
       break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
+    case 2:
+      var value = msg.getFailedServiceNameToErrorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -2644,9 +14325,9 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+proto.api_container_api.PauseServicesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PauseServicesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2654,290 +14335,396 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!proto.api_container_api.PauseServicesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PauseServicesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBytes(
-      3,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getSerializedParams();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeBool(
-      6,
-      f
-    );
+  f = message.getSuccessfulServiceNamesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
   }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeInt32(
-      7,
-      f
-    );
+  f = message.getFailedServiceNameToErrorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
 };
 
 
 /**
- * optional string package_id = 1;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * map<string, bool> successful_service_names = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.PauseServicesResponse.prototype.getSuccessfulServiceNamesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * optional bytes local = 3;
- * @return {string}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.PauseServicesResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
+proto.api_container_api.PauseServicesResponse.prototype.clearSuccessfulServiceNamesMap = function() {
+  this.getSuccessfulServiceNamesMap().clear();
+  return this;};
 
 
 /**
- * optional bytes local = 3;
- * This is a type-conversion wrapper around `getLocal()`
- * @return {string}
+ * map<string, string> failed_service_name_to_error = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getLocal()));
+proto.api_container_api.PauseServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * optional bytes local = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getLocal()`
- * @return {!Uint8Array}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.PauseServicesResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getLocal()));
-};
+proto.api_container_api.PauseServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
+  this.getFailedServiceNameToErrorMap().clear();
+  return this;};
 
 
-/**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.UnpauseServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UnpauseServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.UnpauseServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.UnpauseServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : [],
+    allServices: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional bool remote = 4;
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.UnpauseServicesArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.api_container_api.UnpauseServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.UnpauseServicesArgs;
+  return proto.api_container_api.UnpauseServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.UnpauseServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.UnpauseServicesArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.UnpauseServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllServices(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.UnpauseServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.UnpauseServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.UnpauseServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.UnpauseServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getAllServices();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string serialized_params = 5;
- * @return {string}
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.UnpauseServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UnpauseServicesArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
-};
+proto.api_container_api.UnpauseServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
 
 
 /**
- * optional bool dry_run = 6;
+ * optional bool all_services = 2;
  * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+proto.api_container_api.UnpauseServicesArgs.prototype.getAllServices = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
  * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.UnpauseServicesArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.UnpauseServicesArgs.prototype.setAllServices = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.UnpauseServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UnpauseServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.UnpauseServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.UnpauseServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    successfulServiceNamesMap: (f = msg.getSuccessfulServiceNamesMap()) ? f.toObject(includeInstance, undefined) : [],
+    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional int32 parallelism = 7;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.UnpauseServicesResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.UnpauseServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.UnpauseServicesResponse;
+  return proto.api_container_api.UnpauseServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.UnpauseServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.UnpauseServicesResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.UnpauseServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getSuccessfulServiceNamesMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 2:
+      var value = msg.getFailedServiceNameToErrorMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.UnpauseServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.UnpauseServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.UnpauseServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.UnpauseServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSuccessfulServiceNamesMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getFailedServiceNameToErrorMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
+/**
+ * map<string, bool> successful_service_names = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.UnpauseServicesResponse.prototype.getSuccessfulServiceNamesMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UnpauseServicesResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5]];
+proto.api_container_api.UnpauseServicesResponse.prototype.clearSuccessfulServiceNamesMap = function() {
+  this.getSuccessfulServiceNamesMap().clear();
+  return this;};
+
 
 /**
- * @enum {number}
+ * map<string, string> failed_service_name_to_error = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
-  RUN_RESPONSE_LINE_NOT_SET: 0,
-  INSTRUCTION: 1,
-  ERROR: 2,
-  PROGRESS_INFO: 3,
-  INSTRUCTION_RESULT: 4,
-  RUN_FINISHED_EVENT: 5
+proto.api_container_api.UnpauseServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.UnpauseServicesResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
-  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
-};
+proto.api_container_api.UnpauseServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
+  this.getFailedServiceNameToErrorMap().clear();
+  return this;};
+
+
 
 
 
@@ -2954,8 +14741,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForServiceExitArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForServiceExitArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2964,17 +14751,13 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForServiceExitArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForServiceExitArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
-    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
-    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
-    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
-    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2988,23 +14771,23 @@ proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.WaitForServiceExitArgs}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForServiceExitArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunResponseLine;
-  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForServiceExitArgs;
+  return proto.api_container_api.WaitForServiceExitArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForServiceExitArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.WaitForServiceExitArgs}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForServiceExitArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3012,29 +14795,8 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstruction;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
-      msg.setInstruction(value);
-      break;
-    case 2:
-      var value = new proto.api_container_api.StarlarkError;
-      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
-      msg.setError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkRunProgress;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
-      msg.setProgressInfo(value);
-      break;
-    case 4:
-      var value = new proto.api_container_api.StarlarkInstructionResult;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
-      msg.setInstructionResult(value);
-      break;
-    case 5:
-      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
-      msg.setRunFinishedEvent(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -3049,9 +14811,9 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForServiceExitArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForServiceExitArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3059,247 +14821,356 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!proto.api_container_api.WaitForServiceExitArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForServiceExitArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstruction();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
-    );
-  }
-  f = message.getError();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.api_container_api.StarlarkError.serializeBinaryToWriter
-    );
-  }
-  f = message.getProgressInfo();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionResult();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
-    );
-  }
-  f = message.getRunFinishedEvent();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional StarlarkInstruction instruction = 1;
- * @return {?proto.api_container_api.StarlarkInstruction}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+proto.api_container_api.WaitForServiceExitArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForServiceExitArgs} returns this
+ */
+proto.api_container_api.WaitForServiceExitArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
-  return this.setInstruction(undefined);
+proto.api_container_api.WaitForServiceExitResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForServiceExitResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.WaitForServiceExitResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.WaitForServiceExitResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional StarlarkError error = 2;
- * @return {?proto.api_container_api.StarlarkError}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.WaitForServiceExitResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
-  return /** @type{?proto.api_container_api.StarlarkError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+proto.api_container_api.WaitForServiceExitResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.WaitForServiceExitResponse;
+  return proto.api_container_api.WaitForServiceExitResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkError|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.WaitForServiceExitResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.WaitForServiceExitResponse}
+ */
+proto.api_container_api.WaitForServiceExitResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
-  return this.setError(undefined);
+proto.api_container_api.WaitForServiceExitResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.WaitForServiceExitResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.WaitForServiceExitResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.WaitForServiceExitResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional StarlarkRunProgress progress_info = 3;
- * @return {?proto.api_container_api.StarlarkRunProgress}
+ * optional int32 exit_code = 1;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+proto.api_container_api.WaitForServiceExitResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForServiceExitResponse} returns this
+ */
+proto.api_container_api.WaitForServiceExitResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
-  return this.setProgressInfo(undefined);
+proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ExecCommandResponse;
+  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional StarlarkInstructionResult instruction_result = 4;
- * @return {?proto.api_container_api.StarlarkInstructionResult}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ExecCommandResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogOutput(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
-  return this.setInstructionResult(undefined);
+proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getLogOutput();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional int32 exit_code = 1;
+ * @return {number}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * optional StarlarkRunFinishedEvent run_finished_event = 5;
- * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+ * optional string log_output = 2;
+ * @return {string}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
-  return this.setRunFinishedEvent(undefined);
+proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
-  return jspb.Message.getField(this, 5) != null;
-};
-
+proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_ = [[1,2]];
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ExecCommandStreamedOutputChunk.ChunkCase = {
+  CHUNK_NOT_SET: 0,
+  OUTPUT: 1,
+  EXIT_CODE: 2
+};
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @return {proto.api_container_api.ExecCommandStreamedOutputChunk.ChunkCase}
  */
-proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.getChunkCase = function() {
+  return /** @type {proto.api_container_api.ExecCommandStreamedOutputChunk.ChunkCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_[0]));
+};
 
 
 
@@ -3316,8 +15187,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandStreamedOutputChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -3326,17 +15197,14 @@ proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ExecCommandStreamedOutputChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+proto.api_container_api.ExecCommandStreamedOutputChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
-    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
-    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
-    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, "")
+    output: msg.getOutput_asB64(),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3350,23 +15218,23 @@ proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.ExecCommandStreamedOutputChunk}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+proto.api_container_api.ExecCommandStreamedOutputChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstruction;
-  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ExecCommandStreamedOutputChunk;
+  return proto.api_container_api.ExecCommandStreamedOutputChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ExecCommandStreamedOutputChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.ExecCommandStreamedOutputChunk}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ExecCommandStreamedOutputChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3374,22 +15242,12 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstructionPosition;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setOutput(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setInstructionName(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkInstructionArg;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
-      msg.addArguments(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setExecutableInstruction(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
       break;
     default:
       reader.skipField();
@@ -3404,9 +15262,9 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ExecCommandStreamedOutputChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3414,39 +15272,23 @@ proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!proto.api_container_api.ExecCommandStreamedOutputChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ExecCommandStreamedOutputChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 1));
   if (f != null) {
-    writer.writeMessage(
+    writer.writeBytes(
       1,
-      f,
-      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
       f
     );
   }
-  f = message.getArgumentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutableInstruction();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
+  f = /** @type {number} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeInt32(
+      2,
       f
     );
   }
@@ -3454,113 +15296,98 @@ proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(m
 
 
 /**
- * optional StarlarkInstructionPosition position = 1;
- * @return {?proto.api_container_api.StarlarkInstructionPosition}
- */
-proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * optional bytes output = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.getOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional bytes output = 1;
+ * This is a type-conversion wrapper around `getOutput()`
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.getOutput_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getOutput()));
 };
 
 
 /**
- * optional string instruction_name = 2;
- * @return {string}
+ * optional bytes output = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getOutput()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.getOutput_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getOutput()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.ExecCommandStreamedOutputChunk} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.setOutput = function(value) {
+  return jspb.Message.setOneofField(this, 1, proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_[0], value);
 };
 
 
 /**
- * repeated StarlarkInstructionArg arguments = 3;
- * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ExecCommandStreamedOutputChunk} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
-  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.clearOutput = function() {
+  return jspb.Message.setOneofField(this, 1, proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_[0], undefined);
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.hasOutput = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * optional int32 exit_code = 2;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandStreamedOutputChunk} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
-  return this.setArgumentsList([]);
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.setExitCode = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_[0], value);
 };
 
 
 /**
- * optional string executable_instruction = 4;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ExecCommandStreamedOutputChunk} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.clearExitCode = function() {
+  return jspb.Message.setOneofField(this, 2, proto.api_container_api.ExecCommandStreamedOutputChunk.oneofGroups_[0], undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.ExecCommandStreamedOutputChunk.prototype.hasExitCode = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
@@ -3580,8 +15407,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServiceMetricsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServiceMetricsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3590,13 +15417,13 @@ proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServiceMetricsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServiceMetricsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3610,23 +15437,23 @@ proto.api_container_api.StarlarkInstructionResult.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.GetServiceMetricsArgs}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServiceMetricsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionResult;
-  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServiceMetricsArgs;
+  return proto.api_container_api.GetServiceMetricsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServiceMetricsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.GetServiceMetricsArgs}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServiceMetricsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3635,7 +15462,7 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedInstructionResult(value);
+      msg.setServiceIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -3650,9 +15477,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+proto.api_container_api.GetServiceMetricsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServiceMetricsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3660,13 +15487,13 @@ proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!proto.api_container_api.GetServiceMetricsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServiceMetricsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedInstructionResult();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -3677,19 +15504,19 @@ proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = func
 
 
 /**
- * optional string serialized_instruction_result = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+proto.api_container_api.GetServiceMetricsArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ * @return {!proto.api_container_api.GetServiceMetricsArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+proto.api_container_api.GetServiceMetricsArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -3710,8 +15537,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+proto.api_container_api.GetServiceMetricsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServiceMetricsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3720,15 +15547,18 @@ proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetServiceMetricsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetServiceMetricsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    cpuUsagePercent: jspb.Message.getFloatingPointFieldWithDefault(msg, 2, 0.0),
+    memoryUsageBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    memoryLimitBytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    networkRxBytes: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    networkTxBytes: jspb.Message.getFieldWithDefault(msg, 6, 0)
   };
 
   if (includeInstance) {
@@ -3742,23 +15572,23 @@ proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.GetServiceMetricsResponse}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+proto.api_container_api.GetServiceMetricsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionArg;
-  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetServiceMetricsResponse;
+  return proto.api_container_api.GetServiceMetricsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetServiceMetricsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.GetServiceMetricsResponse}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetServiceMetricsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3766,16 +15596,29 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedArgValue(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setArgName(value);
+      var value = /** @type {number} */ (reader.readDouble());
+      msg.setCpuUsagePercent(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRepresentative(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryUsageBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMemoryLimitBytes(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkRxBytes(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNetworkTxBytes(value);
       break;
     default:
       reader.skipField();
@@ -3790,9 +15633,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+proto.api_container_api.GetServiceMetricsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetServiceMetricsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3800,105 +15643,182 @@ proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!proto.api_container_api.GetServiceMetricsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetServiceMetricsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedArgValue();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
+  f = message.getCpuUsagePercent();
+  if (f !== 0.0) {
+    writer.writeDouble(
       2,
       f
     );
   }
-  f = message.getIsRepresentative();
-  if (f) {
-    writer.writeBool(
+  f = message.getMemoryUsageBytes();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
       f
     );
   }
+  f = message.getMemoryLimitBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getNetworkRxBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getNetworkTxBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      6,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string serialized_arg_value = 1;
- * @return {string}
+ * optional google.protobuf.Timestamp timestamp = 1;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetServiceMetricsResponse.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
+*/
+proto.api_container_api.GetServiceMetricsResponse.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetServiceMetricsResponse.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
 };
 
 
 /**
- * optional string arg_name = 2;
- * @return {string}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.GetServiceMetricsResponse.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * optional double cpu_usage_percent = 2;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.GetServiceMetricsResponse.prototype.getCpuUsagePercent = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 2, 0.0));
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.GetServiceMetricsResponse.prototype.setCpuUsagePercent = function(value) {
+  return jspb.Message.setProto3FloatField(this, 2, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional uint64 memory_usage_bytes = 3;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.GetServiceMetricsResponse.prototype.getMemoryUsageBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional bool is_representative = 3;
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.GetServiceMetricsResponse.prototype.setMemoryUsageBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * optional uint64 memory_limit_bytes = 4;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.api_container_api.GetServiceMetricsResponse.prototype.getMemoryLimitBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
+ */
+proto.api_container_api.GetServiceMetricsResponse.prototype.setMemoryLimitBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 network_rx_bytes = 5;
+ * @return {number}
+ */
+proto.api_container_api.GetServiceMetricsResponse.prototype.getNetworkRxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
+ */
+proto.api_container_api.GetServiceMetricsResponse.prototype.setNetworkRxBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint64 network_tx_bytes = 6;
+ * @return {number}
+ */
+proto.api_container_api.GetServiceMetricsResponse.prototype.getNetworkTxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.GetServiceMetricsResponse} returns this
+ */
+proto.api_container_api.GetServiceMetricsResponse.prototype.setNetworkTxBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
@@ -3918,8 +15838,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3928,15 +15848,19 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
   };
 
   if (includeInstance) {
@@ -3950,23 +15874,23 @@ proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionPosition;
-  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3975,15 +15899,31 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLine(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setColumn(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -3998,9 +15938,9 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4008,120 +15948,193 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getLine();
+  f = message.getPort();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getColumn();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string filename = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 line = 2;
+ * optional uint32 port = 2;
  * @return {number}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 column = 3;
+ * optional string path = 3;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 initial_delay_milliseconds = 4;
  * @return {number}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 retries = 5;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
 
 /**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
+ * optional uint32 retries_delay_milliseconds = 6;
+ * @return {number}
  */
-proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
 
 /**
- * @enum {number}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.ErrorCase = {
-  ERROR_NOT_SET: 0,
-  INTERPRETATION_ERROR: 1,
-  VALIDATION_ERROR: 2,
-  EXECUTION_ERROR: 3
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ * optional string body_text = 7;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
-  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4135,8 +16148,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4145,15 +16158,20 @@ proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
-    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
-    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
   };
 
   if (includeInstance) {
@@ -4167,23 +16185,23 @@ proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkError;
-  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
  */
-proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4191,19 +16209,36 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInterpretationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
-      msg.setInterpretationError(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = new proto.api_container_api.StarlarkValidationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
-      msg.setValidationError(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
       break;
     case 3:
-      var value = new proto.api_container_api.StarlarkExecutionError;
-      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
-      msg.setExecutionError(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestBody(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
       break;
     default:
       reader.skipField();
@@ -4218,9 +16253,9 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4228,277 +16263,212 @@ proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInterpretationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getValidationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
-      f,
-      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getExecutionError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
       3,
-      f,
-      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getRequestBody();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
     );
   }
 };
 
 
 /**
- * optional StarlarkInterpretationError interpretation_error = 1;
- * @return {?proto.api_container_api.StarlarkInterpretationError}
- */
-proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
-  return this.setInterpretationError(undefined);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional StarlarkValidationError validation_error = 2;
- * @return {?proto.api_container_api.StarlarkValidationError}
+ * optional uint32 port = 2;
+ * @return {number}
  */
-proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
-  return this.setValidationError(undefined);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string path = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * optional StarlarkExecutionError execution_error = 3;
- * @return {?proto.api_container_api.StarlarkExecutionError}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
-  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * optional string request_body = 4;
+ * @return {string}
  */
-proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
-  return this.setExecutionError(undefined);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional uint32 initial_delay_milliseconds = 5;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * optional uint32 retries = 6;
+ * @return {number}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInterpretationError;
-  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * optional uint32 retries_delay_milliseconds = 7;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInterpretationError} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getErrorMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string body_text = 8;
  * @return {string}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
@@ -4518,8 +16488,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4528,13 +16498,15 @@ proto.api_container_api.StarlarkValidationError.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    data: msg.getData_asB64(),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    contentHash: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -4548,23 +16520,23 @@ proto.api_container_api.StarlarkValidationError.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkValidationError;
-  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactArgs;
+  return proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4572,8 +16544,16 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContentHash(value);
       break;
     default:
       reader.skipField();
@@ -4588,9 +16568,9 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4598,178 +16578,133 @@ proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!proto.api_container_api.UploadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getData_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional bytes data = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getData = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.UploadFilesArtifactArgs.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkExecutionError;
-  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.UploadFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string content_hash = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.UploadFilesArtifactArgs.prototype.getContentHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkExecutionError} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getErrorMessage();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.UploadFilesArtifactArgs.prototype.setContentHash = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
 /**
- * optional string error_message = 1;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.UploadFilesArtifactArgs.prototype.clearContentHash = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.UploadFilesArtifactArgs.prototype.hasContentHash = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -4785,8 +16720,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4795,15 +16730,15 @@ proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isAlreadyStored: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -4817,23 +16752,23 @@ proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunProgress;
-  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
+  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4842,15 +16777,15 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCurrentStepInfo(value);
+      msg.setUuid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setTotalSteps(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCurrentStepNumber(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsAlreadyStored(value);
       break;
     default:
       reader.skipField();
@@ -4865,9 +16800,9 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4875,29 +16810,29 @@ proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCurrentStepInfoList();
+  f = message.getUuid();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTotalSteps();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getCurrentStepNumber();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getIsAlreadyStored();
+  if (f) {
+    writer.writeBool(
       3,
       f
     );
@@ -4906,75 +16841,56 @@ proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(m
 
 
 /**
- * repeated string current_step_info = 1;
- * @return {!Array<string>}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
-  return this.setCurrentStepInfoList([]);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 total_steps = 2;
- * @return {number}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional uint32 current_step_number = 3;
- * @return {number}
+ * optional bool is_already_stored = 3;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getIsAlreadyStored = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setIsAlreadyStored = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -4994,8 +16910,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5004,14 +16920,13 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isrunsuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5025,23 +16940,23 @@ proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
-  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
+  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5049,12 +16964,8 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsrunsuccessful(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedOutput(value);
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -5069,9 +16980,9 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5079,23 +16990,16 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsrunsuccessful();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getIdentifier();
+  if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -5103,56 +17007,20 @@ proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = funct
 
 
 /**
- * optional bool isRunSuccessful = 1;
- * @return {boolean}
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsrunsuccessful = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsrunsuccessful = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
-};
-
-
-/**
- * optional string serialized_output = 2;
+ * optional string identifier = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
- */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
-  return jspb.Message.setField(this, 2, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -5172,8 +17040,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StartServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StartServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5182,13 +17050,13 @@ proto.api_container_api.StartServicesArgs.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StartServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceNamesToConfigsMap: (f = msg.getServiceNamesToConfigsMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceConfig.toObject) : []
+    data: msg.getData_asB64()
   };
 
   if (includeInstance) {
@@ -5202,23 +17070,23 @@ proto.api_container_api.StartServicesArgs.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StartServicesArgs}
+ * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
  */
-proto.api_container_api.StartServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StartServicesArgs;
-  return proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadFilesArtifactResponse;
+  return proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StartServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StartServicesArgs}
+ * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
  */
-proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5226,10 +17094,8 @@ proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceNamesToConfigsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceConfig.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceConfig());
-         });
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     default:
       reader.skipField();
@@ -5244,49 +17110,72 @@ proto.api_container_api.StartServicesArgs.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StartServicesArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StartServicesArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.DownloadFilesArtifactResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes data = 1;
+ * @return {string}
+ */
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StartServicesArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.StartServicesArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceNamesToConfigsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceConfig.serializeBinaryToWriter);
-  }
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
 /**
- * map<string, ServiceConfig> service_names_to_configs = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceConfig>}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StartServicesArgs.prototype.getServiceNamesToConfigsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceConfig>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceConfig));
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.StartServicesArgs} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.DownloadFilesArtifactResponse} returns this
  */
-proto.api_container_api.StartServicesArgs.prototype.clearServiceNamesToConfigsMap = function() {
-  this.getServiceNamesToConfigsMap().clear();
-  return this;};
+proto.api_container_api.DownloadFilesArtifactResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
 
 
 
@@ -5305,8 +17194,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StartServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StartServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactStreamChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactStreamChunk.toObject(opt_includeInstance, this);
 };
 
 
@@ -5315,14 +17204,14 @@ proto.api_container_api.StartServicesResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StartServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactStreamChunk} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactStreamChunk.toObject = function(includeInstance, msg) {
   var f, obj = {
-    successfulServiceNameToServiceInfoMap: (f = msg.getSuccessfulServiceNameToServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : [],
-    failedServiceNameToErrorMap: (f = msg.getFailedServiceNameToErrorMap()) ? f.toObject(includeInstance, undefined) : []
+    data: msg.getData_asB64(),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5336,23 +17225,23 @@ proto.api_container_api.StartServicesResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StartServicesResponse}
+ * @return {!proto.api_container_api.FilesArtifactStreamChunk}
  */
-proto.api_container_api.StartServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactStreamChunk.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StartServicesResponse;
-  return proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactStreamChunk;
+  return proto.api_container_api.FilesArtifactStreamChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StartServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactStreamChunk} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StartServicesResponse}
+ * @return {!proto.api_container_api.FilesArtifactStreamChunk}
  */
-proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactStreamChunk.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5360,16 +17249,12 @@ proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getSuccessfulServiceNameToServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
       break;
     case 2:
-      var value = msg.getFailedServiceNameToErrorMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5384,9 +17269,9 @@ proto.api_container_api.StartServicesResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StartServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactStreamChunk.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StartServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactStreamChunk.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5394,65 +17279,87 @@ proto.api_container_api.StartServicesResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StartServicesResponse} message
+ * @param {!proto.api_container_api.FilesArtifactStreamChunk} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StartServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactStreamChunk.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSuccessfulServiceNameToServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
   }
-  f = message.getFailedServiceNameToErrorMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, ServiceInfo> successful_service_name_to_service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * optional bytes data = 1;
+ * @return {string}
  */
-proto.api_container_api.StartServicesResponse.prototype.getSuccessfulServiceNameToServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.FilesArtifactStreamChunk.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.StartServicesResponse} returns this
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.StartServicesResponse.prototype.clearSuccessfulServiceNameToServiceInfoMap = function() {
-  this.getSuccessfulServiceNameToServiceInfoMap().clear();
-  return this;};
+proto.api_container_api.FilesArtifactStreamChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
 
 
 /**
- * map<string, string> failed_service_name_to_error = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StartServicesResponse.prototype.getFailedServiceNameToErrorMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.api_container_api.FilesArtifactStreamChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.StartServicesResponse} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.FilesArtifactStreamChunk} returns this
  */
-proto.api_container_api.StartServicesResponse.prototype.clearFailedServiceNameToErrorMap = function() {
-  this.getFailedServiceNameToErrorMap().clear();
-  return this;};
+proto.api_container_api.FilesArtifactStreamChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.FilesArtifactStreamChunk.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactStreamChunk} returns this
+ */
+proto.api_container_api.FilesArtifactStreamChunk.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
 
 
 
@@ -5471,8 +17378,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5481,13 +17388,14 @@ proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5501,23 +17409,23 @@ proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesArgs;
-  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
+  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5525,10 +17433,12 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceIdentifiersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5543,9 +17453,9 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5553,39 +17463,63 @@ proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifiersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, bool> service_identifiers = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional string url = 1;
+ * @return {string}
  */
-proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
-  this.getServiceIdentifiersMap().clear();
-  return this;};
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ */
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
 
 
 
@@ -5604,8 +17538,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5614,13 +17548,13 @@ proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -5634,23 +17568,23 @@ proto.api_container_api.GetServicesResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesResponse;
-  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
+  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5658,10 +17592,8 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -5676,9 +17608,9 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5686,39 +17618,38 @@ proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, ServiceInfo> service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
  */
-proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
-  this.getServiceInfoMap().clear();
-  return this;};
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 
@@ -5737,8 +17668,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5747,15 +17678,15 @@ proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -5769,23 +17700,23 @@ proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceIdentifiers;
-  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5794,15 +17725,15 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      msg.setServiceIdentifier(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setSourcePath(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -5817,9 +17748,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5827,27 +17758,27 @@ proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getServiceIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getSourcePath();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       3,
@@ -5858,67 +17789,190 @@ proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(me
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string source_path = 2;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5934,8 +17988,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5944,14 +17998,14 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+    templatesAndDataByDestinationRelFilepathMap: (f = msg.getTemplatesAndDataByDestinationRelFilepathMap()) ? f.toObject(includeInstance, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject) : [],
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5965,23 +18019,23 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs;
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5989,9 +18043,14 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.ServiceIdentifiers;
-      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = msg.getTemplatesAndDataByDestinationRelFilepathMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader, "", new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData());
+         });
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -6006,9 +18065,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6016,61 +18075,26 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getTemplatesAndDataByDestinationRelFilepathMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter);
+  }
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+    writer.writeString(
+      2,
+      f
     );
   }
 };
 
 
-/**
- * repeated ServiceIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
-};
-
-
-/**
- * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
-*/
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceIdentifiers}
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
- */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
-};
-
-
 
 
 
@@ -6087,8 +18111,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RemoveServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RemoveServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject(opt_includeInstance, this);
 };
 
 
@@ -6097,13 +18121,14 @@ proto.api_container_api.RemoveServiceArgs.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RemoveServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    template: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    dataAsJson: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6117,23 +18142,23 @@ proto.api_container_api.RemoveServiceArgs.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RemoveServiceArgs}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
  */
-proto.api_container_api.RemoveServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RemoveServiceArgs;
-  return proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData;
+  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RemoveServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RemoveServiceArgs}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
  */
-proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6142,7 +18167,11 @@ proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setTemplate(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDataAsJson(value);
       break;
     default:
       reader.skipField();
@@ -6157,9 +18186,9 @@ proto.api_container_api.RemoveServiceArgs.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RemoveServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6167,40 +18196,105 @@ proto.api_container_api.RemoveServiceArgs.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RemoveServiceArgs} message
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getTemplate();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getDataAsJson();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string template = 1;
  * @return {string}
  */
-proto.api_container_api.RemoveServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getTemplate = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RemoveServiceArgs} returns this
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
  */
-proto.api_container_api.RemoveServiceArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setTemplate = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional string data_as_json = 2;
+ * @return {string}
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getDataAsJson = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setDataAsJson = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, TemplateAndData> templates_and_data_by_destination_rel_filepath = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>}
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getTemplatesAndDataByDestinationRelFilepathMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.clearTemplatesAndDataByDestinationRelFilepathMap = function() {
+  this.getTemplatesAndDataByDestinationRelFilepathMap().clear();
+  return this;};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ */
+proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
@@ -6217,8 +18311,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RemoveServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RemoveServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6227,13 +18321,13 @@ proto.api_container_api.RemoveServiceResponse.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RemoveServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6247,23 +18341,23 @@ proto.api_container_api.RemoveServiceResponse.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RemoveServiceResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
  */
-proto.api_container_api.RemoveServiceResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RemoveServiceResponse;
-  return proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactResponse;
+  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RemoveServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RemoveServiceResponse}
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
  */
-proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6272,7 +18366,7 @@ proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = func
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -6287,9 +18381,9 @@ proto.api_container_api.RemoveServiceResponse.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RemoveServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6297,13 +18391,13 @@ proto.api_container_api.RemoveServiceResponse.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RemoveServiceResponse} message
+ * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -6314,19 +18408,19 @@ proto.api_container_api.RemoveServiceResponse.serializeBinaryToWriter = function
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.RemoveServiceResponse.prototype.getServiceUuid = function() {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RemoveServiceResponse} returns this
+ * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} returns this
  */
-proto.api_container_api.RemoveServiceResponse.prototype.setServiceUuid = function(value) {
+proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -6347,8 +18441,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RepartitionArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
 };
 
 
@@ -6357,15 +18451,16 @@ proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RepartitionArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
   var f, obj = {
-    partitionServicesMap: (f = msg.getPartitionServicesMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionServices.toObject) : [],
-    partitionConnectionsMap: (f = msg.getPartitionConnectionsMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnections.toObject) : [],
-    defaultConnection: (f = msg.getDefaultConnection()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    sizeBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    creationTimeUnixSeconds: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -6379,23 +18474,23 @@ proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RepartitionArgs}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.RepartitionArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RepartitionArgs;
-  return proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
+  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RepartitionArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RepartitionArgs}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6403,21 +18498,20 @@ proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getPartitionServicesMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionServices.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionServices());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
       break;
     case 2:
-      var value = msg.getPartitionConnectionsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnections.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnections());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFileuuid(value);
       break;
     case 3:
-      var value = new proto.api_container_api.PartitionConnectionInfo;
-      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
-      msg.setDefaultConnection(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSizeBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreationTimeUnixSeconds(value);
       break;
     default:
       reader.skipField();
@@ -6432,9 +18526,9 @@ proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RepartitionArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6442,113 +18536,123 @@ proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RepartitionArgs} message
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RepartitionArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPartitionServicesMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionServices.serializeBinaryToWriter);
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
-  f = message.getPartitionConnectionsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnections.serializeBinaryToWriter);
+  f = message.getFileuuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
   }
-  f = message.getDefaultConnection();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getSizeBytes();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
-      f,
-      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getCreationTimeUnixSeconds();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
     );
   }
 };
 
 
 /**
- * map<string, PartitionServices> partition_services = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.PartitionServices>}
+ * optional string fileName = 1;
+ * @return {string}
  */
-proto.api_container_api.RepartitionArgs.prototype.getPartitionServicesMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionServices>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.PartitionServices));
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.RepartitionArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
  */
-proto.api_container_api.RepartitionArgs.prototype.clearPartitionServicesMap = function() {
-  this.getPartitionServicesMap().clear();
-  return this;};
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 /**
- * map<string, PartitionConnections> partition_connections = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnections>}
+ * optional string fileUuid = 2;
+ * @return {string}
  */
-proto.api_container_api.RepartitionArgs.prototype.getPartitionConnectionsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnections>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      proto.api_container_api.PartitionConnections));
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.RepartitionArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
  */
-proto.api_container_api.RepartitionArgs.prototype.clearPartitionConnectionsMap = function() {
-  this.getPartitionConnectionsMap().clear();
-  return this;};
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
 
 
 /**
- * optional PartitionConnectionInfo default_connection = 3;
- * @return {?proto.api_container_api.PartitionConnectionInfo}
+ * optional uint64 size_bytes = 3;
+ * @return {number}
  */
-proto.api_container_api.RepartitionArgs.prototype.getDefaultConnection = function() {
-  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
- * @return {!proto.api_container_api.RepartitionArgs} returns this
-*/
-proto.api_container_api.RepartitionArgs.prototype.setDefaultConnection = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.RepartitionArgs} returns this
+ * optional int64 creation_time_unix_seconds = 4;
+ * @return {number}
  */
-proto.api_container_api.RepartitionArgs.prototype.clearDefaultConnection = function() {
-  return this.setDefaultConnection(undefined);
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getCreationTimeUnixSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
  */
-proto.api_container_api.RepartitionArgs.prototype.hasDefaultConnection = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setCreationTimeUnixSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6564,8 +18668,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PartitionServices.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PartitionServices.toObject(opt_includeInstance, this);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6574,13 +18678,14 @@ proto.api_container_api.PartitionServices.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PartitionServices} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionServices.toObject = function(includeInstance, msg) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceNameSetMap: (f = msg.getServiceNameSetMap()) ? f.toObject(includeInstance, undefined) : []
+    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
+    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6594,23 +18699,23 @@ proto.api_container_api.PartitionServices.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PartitionServices}
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.PartitionServices.deserializeBinary = function(bytes) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PartitionServices;
-  return proto.api_container_api.PartitionServices.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PartitionServices} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PartitionServices}
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6618,10 +18723,9 @@ proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceNameSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.addFileNamesAndUuids(value);
       break;
     default:
       reader.skipField();
@@ -6636,9 +18740,9 @@ proto.api_container_api.PartitionServices.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PartitionServices.prototype.serializeBinary = function() {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PartitionServices.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6646,39 +18750,59 @@ proto.api_container_api.PartitionServices.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PartitionServices} message
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionServices.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceNameSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getFileNamesAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+    );
   }
 };
 
 
 /**
- * map<string, bool> service_name_set = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+*/
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.PartitionServices.prototype.getServiceNameSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.PartitionServices} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
  */
-proto.api_container_api.PartitionServices.prototype.clearServiceNameSetMap = function() {
-  this.getServiceNameSetMap().clear();
-  return this;};
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
+  return this.setFileNamesAndUuidsList([]);
+};
 
 
 
@@ -6697,8 +18821,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PartitionConnections.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PartitionConnections.toObject(opt_includeInstance, this);
+proto.api_container_api.DeleteFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DeleteFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6707,13 +18831,13 @@ proto.api_container_api.PartitionConnections.prototype.toObject = function(opt_i
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PartitionConnections} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DeleteFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionConnections.toObject = function(includeInstance, msg) {
+proto.api_container_api.DeleteFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    connectionInfoMap: (f = msg.getConnectionInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.PartitionConnectionInfo.toObject) : []
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6727,23 +18851,23 @@ proto.api_container_api.PartitionConnections.toObject = function(includeInstance
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PartitionConnections}
+ * @return {!proto.api_container_api.DeleteFilesArtifactArgs}
  */
-proto.api_container_api.PartitionConnections.deserializeBinary = function(bytes) {
+proto.api_container_api.DeleteFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PartitionConnections;
-  return proto.api_container_api.PartitionConnections.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DeleteFilesArtifactArgs;
+  return proto.api_container_api.DeleteFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PartitionConnections} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DeleteFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PartitionConnections}
+ * @return {!proto.api_container_api.DeleteFilesArtifactArgs}
  */
-proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DeleteFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6751,10 +18875,8 @@ proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = funct
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getConnectionInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader, "", new proto.api_container_api.PartitionConnectionInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -6769,9 +18891,9 @@ proto.api_container_api.PartitionConnections.deserializeBinaryFromReader = funct
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PartitionConnections.prototype.serializeBinary = function() {
+proto.api_container_api.DeleteFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PartitionConnections.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DeleteFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6779,39 +18901,38 @@ proto.api_container_api.PartitionConnections.prototype.serializeBinary = functio
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PartitionConnections} message
+ * @param {!proto.api_container_api.DeleteFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionConnections.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DeleteFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConnectionInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter);
+  f = message.getIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, PartitionConnectionInfo> connection_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>}
+ * optional string identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.PartitionConnections.prototype.getConnectionInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.PartitionConnectionInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.PartitionConnectionInfo));
+proto.api_container_api.DeleteFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.PartitionConnections} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.DeleteFilesArtifactArgs} returns this
  */
-proto.api_container_api.PartitionConnections.prototype.clearConnectionInfoMap = function() {
-  this.getConnectionInfoMap().clear();
-  return this;};
+proto.api_container_api.DeleteFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 
@@ -6830,8 +18951,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PartitionConnectionInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6840,13 +18961,13 @@ proto.api_container_api.PartitionConnectionInfo.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PartitionConnectionInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionConnectionInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packetLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0)
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -6860,23 +18981,23 @@ proto.api_container_api.PartitionConnectionInfo.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PartitionConnectionInfo}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsArgs}
  */
-proto.api_container_api.PartitionConnectionInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PartitionConnectionInfo;
-  return proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsArgs;
+  return proto.api_container_api.InspectFilesArtifactContentsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PartitionConnectionInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PartitionConnectionInfo}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsArgs}
  */
-proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6884,8 +19005,8 @@ proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readFloat());
-      msg.setPacketLossPercentage(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdentifier(value);
       break;
     default:
       reader.skipField();
@@ -6900,9 +19021,9 @@ proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6910,15 +19031,15 @@ proto.api_container_api.PartitionConnectionInfo.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PartitionConnectionInfo} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPacketLossPercentage();
-  if (f !== 0.0) {
-    writer.writeFloat(
+  f = message.getIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
@@ -6927,20 +19048,20 @@ proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter = functi
 
 
 /**
- * optional float packet_loss_percentage = 1;
- * @return {number}
+ * optional string identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.getPacketLossPercentage = function() {
-  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+proto.api_container_api.InspectFilesArtifactContentsArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsArgs} returns this
  */
-proto.api_container_api.PartitionConnectionInfo.prototype.setPacketLossPercentage = function(value) {
-  return jspb.Message.setProto3FloatField(this, 1, value);
+proto.api_container_api.InspectFilesArtifactContentsArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -6950,7 +19071,7 @@ proto.api_container_api.PartitionConnectionInfo.prototype.setPacketLossPercentag
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
 
 
 
@@ -6967,8 +19088,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6977,14 +19098,14 @@ proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
+    proto.api_container_api.FilesArtifactContentsFileDescription.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -6998,23 +19119,23 @@ proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandArgs;
-  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7022,12 +19143,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addCommandArgs(value);
+      var value = new proto.api_container_api.FilesArtifactContentsFileDescription;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactContentsFileDescription.deserializeBinaryFromReader);
+      msg.addFileDescriptions(value);
       break;
     default:
       reader.skipField();
@@ -7042,9 +19160,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7052,81 +19170,58 @@ proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getFileDescriptionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getCommandArgsList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
-      2,
-      f
+      f,
+      proto.api_container_api.FilesArtifactContentsFileDescription.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string service_identifier = 1;
- * @return {string}
- */
-proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * repeated string command_args = 2;
- * @return {!Array<string>}
+ * repeated FilesArtifactContentsFileDescription file_descriptions = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactContentsFileDescription>}
  */
-proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactContentsFileDescription>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactContentsFileDescription, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+ * @param {!Array<!proto.api_container_api.FilesArtifactContentsFileDescription>} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
+ * @param {!proto.api_container_api.FilesArtifactContentsFileDescription=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @return {!proto.api_container_api.FilesArtifactContentsFileDescription}
  */
-proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactContentsFileDescription, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
-  return this.setCommandArgsList([]);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
+  return this.setFileDescriptionsList([]);
 };
 
 
@@ -7146,8 +19241,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.PauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.PauseServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactContentsFileDescription.toObject(opt_includeInstance, this);
 };
 
 
@@ -7156,13 +19251,15 @@ proto.api_container_api.PauseServiceArgs.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.PauseServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactContentsFileDescription} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PauseServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sizeBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    isDirectory: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -7176,23 +19273,23 @@ proto.api_container_api.PauseServiceArgs.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.PauseServiceArgs}
+ * @return {!proto.api_container_api.FilesArtifactContentsFileDescription}
  */
-proto.api_container_api.PauseServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactContentsFileDescription.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.PauseServiceArgs;
-  return proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactContentsFileDescription;
+  return proto.api_container_api.FilesArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.PauseServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactContentsFileDescription} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.PauseServiceArgs}
+ * @return {!proto.api_container_api.FilesArtifactContentsFileDescription}
  */
-proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7201,7 +19298,15 @@ proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setPath(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSizeBytes(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsDirectory(value);
       break;
     default:
       reader.skipField();
@@ -7216,9 +19321,9 @@ proto.api_container_api.PauseServiceArgs.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.PauseServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7226,37 +19331,87 @@ proto.api_container_api.PauseServiceArgs.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.PauseServiceArgs} message
+ * @param {!proto.api_container_api.FilesArtifactContentsFileDescription} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.PauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getPath();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getSizeBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getIsDirectory();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string path = 1;
  * @return {string}
  */
-proto.api_container_api.PauseServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.getPath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.PauseServiceArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 size_bytes = 2;
+ * @return {number}
+ */
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.getSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.FilesArtifactContentsFileDescription} returns this
+ */
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.setSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bool is_directory = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.getIsDirectory = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.FilesArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.PauseServiceArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.FilesArtifactContentsFileDescription.prototype.setIsDirectory = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
@@ -7276,8 +19431,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UnpauseServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.SetLogLevelArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SetLogLevelArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7286,13 +19441,14 @@ proto.api_container_api.UnpauseServiceArgs.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UnpauseServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.SetLogLevelArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UnpauseServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.SetLogLevelArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    logLevel: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    revertAfterSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -7306,23 +19462,23 @@ proto.api_container_api.UnpauseServiceArgs.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UnpauseServiceArgs}
+ * @return {!proto.api_container_api.SetLogLevelArgs}
  */
-proto.api_container_api.UnpauseServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.SetLogLevelArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UnpauseServiceArgs;
-  return proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.SetLogLevelArgs;
+  return proto.api_container_api.SetLogLevelArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UnpauseServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.SetLogLevelArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UnpauseServiceArgs}
+ * @return {!proto.api_container_api.SetLogLevelArgs}
  */
-proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.SetLogLevelArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7331,7 +19487,11 @@ proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = functio
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setLogLevel(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRevertAfterSeconds(value);
       break;
     default:
       reader.skipField();
@@ -7346,9 +19506,9 @@ proto.api_container_api.UnpauseServiceArgs.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.SetLogLevelArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.SetLogLevelArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7356,40 +19516,65 @@ proto.api_container_api.UnpauseServiceArgs.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UnpauseServiceArgs} message
+ * @param {!proto.api_container_api.SetLogLevelArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UnpauseServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.SetLogLevelArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getLogLevel();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getRevertAfterSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string log_level = 1;
  * @return {string}
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.SetLogLevelArgs.prototype.getLogLevel = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UnpauseServiceArgs} returns this
+ * @return {!proto.api_container_api.SetLogLevelArgs} returns this
  */
-proto.api_container_api.UnpauseServiceArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.SetLogLevelArgs.prototype.setLogLevel = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint32 revert_after_seconds = 2;
+ * @return {number}
+ */
+proto.api_container_api.SetLogLevelArgs.prototype.getRevertAfterSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.SetLogLevelArgs} returns this
+ */
+proto.api_container_api.SetLogLevelArgs.prototype.setRevertAfterSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 
 
 
@@ -7406,8 +19591,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.SetLogLevelResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.SetLogLevelResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7416,14 +19601,13 @@ proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.SetLogLevelResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.SetLogLevelResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    previousLogLevel: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7437,23 +19621,23 @@ proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.SetLogLevelResponse}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.SetLogLevelResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandResponse;
-  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.SetLogLevelResponse;
+  return proto.api_container_api.SetLogLevelResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.SetLogLevelResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * @return {!proto.api_container_api.SetLogLevelResponse}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.SetLogLevelResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7461,12 +19645,8 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setExitCode(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLogOutput(value);
+      msg.setPreviousLogLevel(value);
       break;
     default:
       reader.skipField();
@@ -7481,9 +19661,9 @@ proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+proto.api_container_api.SetLogLevelResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.SetLogLevelResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7491,23 +19671,16 @@ proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!proto.api_container_api.SetLogLevelResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.SetLogLevelResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getLogOutput();
+  f = message.getPreviousLogLevel();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -7515,38 +19688,20 @@ proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(m
 
 
 /**
- * optional int32 exit_code = 1;
- * @return {number}
- */
-proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
- */
-proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional string log_output = 2;
+ * optional string previous_log_level = 1;
  * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.SetLogLevelResponse.prototype.getPreviousLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * @return {!proto.api_container_api.SetLogLevelResponse} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.SetLogLevelResponse.prototype.setPreviousLogLevel = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -7566,8 +19721,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ShutdownHookResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ShutdownHookResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -7576,19 +19731,14 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ShutdownHookResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ShutdownHookResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+    description: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7602,23 +19752,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.ShutdownHookResult}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ShutdownHookResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ShutdownHookResult;
+  return proto.api_container_api.ShutdownHookResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ShutdownHookResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.ShutdownHookResult}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ShutdownHookResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7627,31 +19777,11 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setDescription(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 7:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -7666,9 +19796,9 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ShutdownHookResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ShutdownHookResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7676,58 +19806,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.ShutdownHookResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ShutdownHookResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getDescription();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      4,
-      f
-    );
-  }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getBodyText();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
     writer.writeString(
-      7,
+      2,
       f
     );
   }
@@ -7735,128 +19830,216 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryTo
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string description = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.ShutdownHookResult.prototype.getDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.ShutdownHookResult} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.ShutdownHookResult.prototype.setDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
+ * optional string error = 2;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.ShutdownHookResult.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.ShutdownHookResult} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.ShutdownHookResult.prototype.setError = function(value) {
+  return jspb.Message.setField(this, 2, value);
 };
 
 
 /**
- * optional string path = 3;
- * @return {string}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.ShutdownHookResult} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.ShutdownHookResult.prototype.clearError = function() {
+  return jspb.Message.setField(this, 2, undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ShutdownHookResult.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunShutdownHooksResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunShutdownHooksResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunShutdownHooksResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunShutdownHooksResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.RunShutdownHooksResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resultsList: jspb.Message.toObjectList(msg.getResultsList(),
+    proto.api_container_api.ShutdownHookResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunShutdownHooksResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.RunShutdownHooksResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunShutdownHooksResponse;
+  return proto.api_container_api.RunShutdownHooksResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunShutdownHooksResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunShutdownHooksResponse}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.api_container_api.RunShutdownHooksResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ShutdownHookResult;
+      reader.readMessage(value,proto.api_container_api.ShutdownHookResult.deserializeBinaryFromReader);
+      msg.addResults(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional uint32 retries = 5;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.RunShutdownHooksResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunShutdownHooksResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunShutdownHooksResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.api_container_api.RunShutdownHooksResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.ShutdownHookResult.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 6;
- * @return {number}
+ * repeated ShutdownHookResult results = 1;
+ * @return {!Array<!proto.api_container_api.ShutdownHookResult>}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.RunShutdownHooksResponse.prototype.getResultsList = function() {
+  return /** @type{!Array<!proto.api_container_api.ShutdownHookResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ShutdownHookResult, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+ * @param {!Array<!proto.api_container_api.ShutdownHookResult>} value
+ * @return {!proto.api_container_api.RunShutdownHooksResponse} returns this
+*/
+proto.api_container_api.RunShutdownHooksResponse.prototype.setResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string body_text = 7;
- * @return {string}
+ * @param {!proto.api_container_api.ShutdownHookResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ShutdownHookResult}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.RunShutdownHooksResponse.prototype.addResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ShutdownHookResult, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunShutdownHooksResponse} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.api_container_api.RunShutdownHooksResponse.prototype.clearResultsList = function() {
+  return this.setResultsList([]);
 };
 
 
@@ -7876,8 +20059,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.HttpRequestServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpRequestServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7886,20 +20069,21 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.HttpRequestServiceArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
     serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+    portId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    method: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    path: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    headersMap: (f = msg.getHeadersMap()) ? f.toObject(includeInstance, undefined) : [],
+    body: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    useHttps: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    skipTlsVerification: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    timeoutSeconds: jspb.Message.getFieldWithDefault(msg, 9, 0)
   };
 
   if (includeInstance) {
@@ -7913,23 +20097,23 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.HttpRequestServiceArgs}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.HttpRequestServiceArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.HttpRequestServiceArgs;
+  return proto.api_container_api.HttpRequestServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.HttpRequestServiceArgs}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.HttpRequestServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7941,32 +20125,38 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
       msg.setServiceIdentifier(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPortId(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      msg.setMethod(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setRequestBody(value);
+      msg.setPath(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
+      var value = msg.getHeadersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
       break;
     case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUseHttps(value);
       break;
     case 8:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSkipTlsVerification(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTimeoutSeconds(value);
       break;
     default:
       reader.skipField();
@@ -7981,9 +20171,9 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.HttpRequestServiceArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpRequestServiceArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7991,11 +20181,11 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpRequestServiceArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getServiceIdentifier();
   if (f.length > 0) {
@@ -8004,55 +20194,59 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryT
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getPortId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getPath();
+  f = message.getMethod();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getRequestBody();
+  f = message.getPath();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
+  f = message.getHeadersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
   }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
       6,
       f
     );
   }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getUseHttps();
+  if (f) {
+    writer.writeBool(
       7,
       f
     );
   }
-  f = message.getBodyText();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSkipTlsVerification();
+  if (f) {
+    writer.writeBool(
       8,
       f
     );
   }
+  f = message.getTimeoutSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -8060,143 +20254,165 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryT
  * optional string service_identifier = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.HttpRequestServiceArgs.prototype.getServiceIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.HttpRequestServiceArgs.prototype.setServiceIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
+ * optional string port_id = 2;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.HttpRequestServiceArgs.prototype.getPortId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.HttpRequestServiceArgs.prototype.setPortId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string path = 3;
+ * optional string method = 3;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+proto.api_container_api.HttpRequestServiceArgs.prototype.getMethod = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+proto.api_container_api.HttpRequestServiceArgs.prototype.setMethod = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string request_body = 4;
+ * optional string path = 4;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+proto.api_container_api.HttpRequestServiceArgs.prototype.getPath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+proto.api_container_api.HttpRequestServiceArgs.prototype.setPath = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 5;
- * @return {number}
+ * map<string, string> headers = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.HttpRequestServiceArgs.prototype.getHeadersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+proto.api_container_api.HttpRequestServiceArgs.prototype.clearHeadersMap = function() {
+  this.getHeadersMap().clear();
+  return this;};
+
+
+/**
+ * optional string body = 6;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * optional uint32 retries = 6;
- * @return {number}
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.HttpRequestServiceArgs.prototype.setBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * optional bool use_https = 7;
+ * @return {boolean}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.api_container_api.HttpRequestServiceArgs.prototype.getUseHttps = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 7;
- * @return {number}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.HttpRequestServiceArgs.prototype.setUseHttps = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * optional bool skip_tls_verification = 8;
+ * @return {boolean}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.api_container_api.HttpRequestServiceArgs.prototype.getSkipTlsVerification = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
 };
 
 
 /**
- * optional string body_text = 8;
- * @return {string}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.api_container_api.HttpRequestServiceArgs.prototype.setSkipTlsVerification = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * optional uint32 timeout_seconds = 9;
+ * @return {number}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.api_container_api.HttpRequestServiceArgs.prototype.getTimeoutSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setTimeoutSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
 };
 
 
@@ -8216,8 +20432,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.HttpHeader.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpHeader.toObject(opt_includeInstance, this);
 };
 
 
@@ -8226,14 +20442,14 @@ proto.api_container_api.UploadFilesArtifactArgs.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.HttpHeader} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.HttpHeader.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64(),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8247,23 +20463,23 @@ proto.api_container_api.UploadFilesArtifactArgs.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactArgs}
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.UploadFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.HttpHeader.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactArgs;
-  return proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.HttpHeader;
+  return proto.api_container_api.HttpHeader.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.HttpHeader} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactArgs}
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.HttpHeader.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8271,12 +20487,12 @@ proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -8291,9 +20507,9 @@ proto.api_container_api.UploadFilesArtifactArgs.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.HttpHeader.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpHeader.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8301,20 +20517,20 @@ proto.api_container_api.UploadFilesArtifactArgs.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactArgs} message
+ * @param {!proto.api_container_api.HttpHeader} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpHeader.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getValue();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -8325,66 +20541,49 @@ proto.api_container_api.UploadFilesArtifactArgs.serializeBinaryToWriter = functi
 
 
 /**
- * optional bytes data = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getData = function() {
+proto.api_container_api.HttpHeader.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
-};
-
-
-/**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
- */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
-};
-
-
-/**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpHeader} returns this
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.api_container_api.HttpHeader.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
+ * optional string value = 2;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.getName = function() {
+proto.api_container_api.HttpHeader.prototype.getValue = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.HttpHeader} returns this
  */
-proto.api_container_api.UploadFilesArtifactArgs.prototype.setName = function(value) {
+proto.api_container_api.HttpHeader.prototype.setValue = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.HttpRequestServiceResponse.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8400,8 +20599,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.HttpRequestServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpRequestServiceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8410,14 +20609,22 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.HttpRequestServiceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.HttpRequestServiceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    statusCode: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    headersList: jspb.Message.toObjectList(msg.getHeadersList(),
+    proto.api_container_api.HttpHeader.toObject, includeInstance),
+    body: msg.getBody_asB64(),
+    isBodyTruncated: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    connectionDurationMicros: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    timeToFirstByteMicros: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    totalDurationMicros: jspb.Message.getFieldWithDefault(msg, 9, 0)
   };
 
   if (includeInstance) {
@@ -8431,23 +20638,23 @@ proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.HttpRequestServiceResponse}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.HttpRequestServiceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
-  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.HttpRequestServiceResponse;
+  return proto.api_container_api.HttpRequestServiceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.HttpRequestServiceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.HttpRequestServiceResponse}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.HttpRequestServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8456,11 +20663,40 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setUrl(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setStatus(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStatusCode(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.HttpHeader;
+      reader.readMessage(value,proto.api_container_api.HttpHeader.deserializeBinaryFromReader);
+      msg.addHeaders(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setBody(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsBodyTruncated(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setConnectionDurationMicros(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTimeToFirstByteMicros(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalDurationMicros(value);
       break;
     default:
       reader.skipField();
@@ -8475,9 +20711,9 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.HttpRequestServiceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpRequestServiceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8485,62 +20721,282 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.HttpRequestServiceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpRequestServiceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getUrl();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getStatus();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getStatusCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getHeadersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.api_container_api.HttpHeader.serializeBinaryToWriter
+    );
+  }
+  f = message.getBody_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
+    );
+  }
+  f = message.getIsBodyTruncated();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = message.getConnectionDurationMicros();
+  if (f !== 0) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
+  f = message.getTimeToFirstByteMicros();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getTotalDurationMicros();
+  if (f !== 0) {
+    writer.writeUint64(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string status = 2;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getStatus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 status_code = 3;
+ * @return {number}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getStatusCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.setStatusCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated HttpHeader headers = 4;
+ * @return {!Array<!proto.api_container_api.HttpHeader>}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getHeadersList = function() {
+  return /** @type{!Array<!proto.api_container_api.HttpHeader>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.HttpHeader, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.HttpHeader>} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+*/
+proto.api_container_api.HttpRequestServiceResponse.prototype.setHeadersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.HttpHeader=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.HttpHeader}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.addHeaders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.api_container_api.HttpHeader, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.clearHeadersList = function() {
+  return this.setHeadersList([]);
+};
+
+
+/**
+ * optional bytes body = 5;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes body = 5;
+ * This is a type-conversion wrapper around `getBody()`
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getBody_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getBody()));
+};
+
+
+/**
+ * optional bytes body = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getBody()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getBody_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getBody()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.setBody = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
+/**
+ * optional bool is_body_truncated = 6;
+ * @return {boolean}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getIsBodyTruncated = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.setIsBodyTruncated = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * optional uint64 connection_duration_micros = 7;
+ * @return {number}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.HttpRequestServiceResponse.prototype.getConnectionDurationMicros = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.HttpRequestServiceResponse.prototype.setConnectionDurationMicros = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional uint64 time_to_first_byte_micros = 8;
+ * @return {number}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.HttpRequestServiceResponse.prototype.getTimeToFirstByteMicros = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.HttpRequestServiceResponse.prototype.setTimeToFirstByteMicros = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional uint64 total_duration_micros = 9;
+ * @return {number}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.getTotalDurationMicros = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.setTotalDurationMicros = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
 };
 
 
@@ -8560,8 +21016,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetBackendCapabilitiesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8570,13 +21026,17 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetBackendCapabilitiesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetBackendCapabilitiesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+    isNetworkPartitioningSupported: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    isServicePausingSupported: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    areStaticPublicPortsSupported: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    arePersistentVolumesSupported: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    isExecTtySupported: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -8590,23 +21050,23 @@ proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetBackendCapabilitiesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
-  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetBackendCapabilitiesResponse;
+  return proto.api_container_api.GetBackendCapabilitiesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetBackendCapabilitiesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetBackendCapabilitiesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8614,8 +21074,24 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setIdentifier(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsNetworkPartitioningSupported(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsServicePausingSupported(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAreStaticPublicPortsSupported(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setArePersistentVolumesSupported(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsExecTtySupported(value);
       break;
     default:
       reader.skipField();
@@ -8630,9 +21106,9 @@ proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetBackendCapabilitiesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8640,191 +21116,137 @@ proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
+ * @param {!proto.api_container_api.GetBackendCapabilitiesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetBackendCapabilitiesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getIsNetworkPartitioningSupported();
+  if (f) {
+    writer.writeBool(
       1,
       f
     );
   }
+  f = message.getIsServicePausingSupported();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getAreStaticPublicPortsSupported();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getArePersistentVolumesSupported();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getIsExecTtySupported();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string identifier = 1;
- * @return {string}
- */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
- */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional bool is_network_partitioning_supported = 1;
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.getIsNetworkPartitioningSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse} returns this
  */
-proto.api_container_api.DownloadFilesArtifactResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    data: msg.getData_asB64()
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.setIsNetworkPartitioningSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
+ * optional bool is_service_pausing_supported = 2;
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactResponse;
-  return proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.getIsServicePausingSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactResponse}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse} returns this
  */
-proto.api_container_api.DownloadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.setIsServicePausingSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional bool are_static_public_ports_supported = 3;
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.getAreStaticPublicPortsSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse} returns this
  */
-proto.api_container_api.DownloadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getData_asU8();
-  if (f.length > 0) {
-    writer.writeBytes(
-      1,
-      f
-    );
-  }
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.setAreStaticPublicPortsSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * optional bytes data = 1;
- * @return {string}
+ * optional bool are_persistent_volumes_supported = 4;
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.getArePersistentVolumesSupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
- * @return {string}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse} returns this
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.setArePersistentVolumesSupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
+ * optional bool is_exec_tty_supported = 5;
+ * @return {boolean}
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.getIsExecTtySupported = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.DownloadFilesArtifactResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetBackendCapabilitiesResponse} returns this
  */
-proto.api_container_api.DownloadFilesArtifactResponse.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.api_container_api.GetBackendCapabilitiesResponse.prototype.setIsExecTtySupported = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
@@ -8844,8 +21266,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.CachedPackage.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CachedPackage.toObject(opt_includeInstance, this);
 };
 
 
@@ -8854,14 +21276,15 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.CachedPackage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.CachedPackage.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    repository: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sizeBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lastUsedUnixSeconds: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -8875,23 +21298,23 @@ proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.CachedPackage}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.CachedPackage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
-  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.CachedPackage;
+  return proto.api_container_api.CachedPackage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.CachedPackage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.CachedPackage}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.CachedPackage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8900,11 +21323,15 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      msg.setRepository(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSizeBytes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastUsedUnixSeconds(value);
       break;
     default:
       reader.skipField();
@@ -8919,9 +21346,9 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.CachedPackage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.CachedPackage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8929,66 +21356,98 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!proto.api_container_api.CachedPackage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.CachedPackage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
+  f = message.getRepository();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getSizeBytes();
+  if (f !== 0) {
+    writer.writeUint64(
       2,
       f
     );
   }
+  f = message.getLastUsedUnixSeconds();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string url = 1;
+ * optional string repository = 1;
  * @return {string}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+proto.api_container_api.CachedPackage.prototype.getRepository = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.CachedPackage} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+proto.api_container_api.CachedPackage.prototype.setRepository = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * optional uint64 size_bytes = 2;
+ * @return {number}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.CachedPackage.prototype.getSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.CachedPackage} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.CachedPackage.prototype.setSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 last_used_unix_seconds = 3;
+ * @return {number}
+ */
+proto.api_container_api.CachedPackage.prototype.getLastUsedUnixSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.CachedPackage} returns this
+ */
+proto.api_container_api.CachedPackage.prototype.setLastUsedUnixSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.PackageCacheInfo.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9004,8 +21463,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.PackageCacheInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PackageCacheInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -9014,13 +21473,18 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PackageCacheInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.PackageCacheInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    cachedPackagesList: jspb.Message.toObjectList(msg.getCachedPackagesList(),
+    proto.api_container_api.CachedPackage.toObject, includeInstance),
+    totalSizeBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maxSizeBytes: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    numHits: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    numMisses: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -9034,23 +21498,23 @@ proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.PackageCacheInfo}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.PackageCacheInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
-  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PackageCacheInfo;
+  return proto.api_container_api.PackageCacheInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PackageCacheInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @return {!proto.api_container_api.PackageCacheInfo}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PackageCacheInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9058,8 +21522,25 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = new proto.api_container_api.CachedPackage;
+      reader.readMessage(value,proto.api_container_api.CachedPackage.deserializeBinaryFromReader);
+      msg.addCachedPackages(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalSizeBytes(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setMaxSizeBytes(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumHits(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumMisses(value);
       break;
     default:
       reader.skipField();
@@ -9074,9 +21555,9 @@ proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.PackageCacheInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PackageCacheInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9084,16 +21565,45 @@ proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
+ * @param {!proto.api_container_api.PackageCacheInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PackageCacheInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getCachedPackagesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
+      f,
+      proto.api_container_api.CachedPackage.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotalSizeBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getMaxSizeBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getNumHits();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getNumMisses();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
       f
     );
   }
@@ -9101,24 +21611,123 @@ proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter =
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * repeated CachedPackage cached_packages = 1;
+ * @return {!Array<!proto.api_container_api.CachedPackage>}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.PackageCacheInfo.prototype.getCachedPackagesList = function() {
+  return /** @type{!Array<!proto.api_container_api.CachedPackage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.CachedPackage, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ * @param {!Array<!proto.api_container_api.CachedPackage>} value
+ * @return {!proto.api_container_api.PackageCacheInfo} returns this
+*/
+proto.api_container_api.PackageCacheInfo.prototype.setCachedPackagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CachedPackage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.CachedPackage}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.PackageCacheInfo.prototype.addCachedPackages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.CachedPackage, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.PackageCacheInfo} returns this
+ */
+proto.api_container_api.PackageCacheInfo.prototype.clearCachedPackagesList = function() {
+  return this.setCachedPackagesList([]);
+};
+
+
+/**
+ * optional uint64 total_size_bytes = 2;
+ * @return {number}
+ */
+proto.api_container_api.PackageCacheInfo.prototype.getTotalSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PackageCacheInfo} returns this
+ */
+proto.api_container_api.PackageCacheInfo.prototype.setTotalSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 max_size_bytes = 3;
+ * @return {number}
+ */
+proto.api_container_api.PackageCacheInfo.prototype.getMaxSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PackageCacheInfo} returns this
+ */
+proto.api_container_api.PackageCacheInfo.prototype.setMaxSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 num_hits = 4;
+ * @return {number}
+ */
+proto.api_container_api.PackageCacheInfo.prototype.getNumHits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PackageCacheInfo} returns this
+ */
+proto.api_container_api.PackageCacheInfo.prototype.setNumHits = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 num_misses = 5;
+ * @return {number}
+ */
+proto.api_container_api.PackageCacheInfo.prototype.getNumMisses = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PackageCacheInfo} returns this
+ */
+proto.api_container_api.PackageCacheInfo.prototype.setNumMisses = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ClearPackageCacheResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9134,8 +21743,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ClearPackageCacheResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ClearPackageCacheResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -9144,15 +21753,13 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ClearPackageCacheResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ClearPackageCacheResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+    removedRepositoriesList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -9166,23 +21773,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.ClearPackageCacheResponse}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ClearPackageCacheResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ClearPackageCacheResponse;
+  return proto.api_container_api.ClearPackageCacheResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ClearPackageCacheResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.ClearPackageCacheResponse}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ClearPackageCacheResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9191,15 +21798,7 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSourcePath(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.addRemovedRepositories(value);
       break;
     default:
       reader.skipField();
@@ -9214,9 +21813,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ClearPackageCacheResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ClearPackageCacheResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9224,87 +21823,56 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!proto.api_container_api.ClearPackageCacheResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getSourcePath();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string service_identifier = 1;
- * @return {string}
- */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
- */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.ClearPackageCacheResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedRepositoriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string source_path = 2;
- * @return {string}
+ * repeated string removed_repositories = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ClearPackageCacheResponse.prototype.getRemovedRepositoriesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ClearPackageCacheResponse} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ClearPackageCacheResponse.prototype.setRemovedRepositoriesList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * optional string name = 3;
- * @return {string}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ClearPackageCacheResponse} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.ClearPackageCacheResponse.prototype.addRemovedRepositories = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ClearPackageCacheResponse} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.ClearPackageCacheResponse.prototype.clearRemovedRepositoriesList = function() {
+  return this.setRemovedRepositoriesList([]);
 };
 
 
@@ -9324,8 +21892,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunProfileArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunProfileArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -9334,13 +21902,13 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunProfileArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunProfileArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    runId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -9354,23 +21922,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.GetStarlarkRunProfileArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunProfileArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunProfileArgs;
+  return proto.api_container_api.GetStarlarkRunProfileArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunProfileArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.GetStarlarkRunProfileArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunProfileArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9379,7 +21947,7 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setRunId(value);
       break;
     default:
       reader.skipField();
@@ -9394,9 +21962,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunProfileArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunProfileArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9404,14 +21972,14 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!proto.api_container_api.GetStarlarkRunProfileArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunProfileArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
     writer.writeString(
       1,
       f
@@ -9421,20 +21989,38 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToW
 
 
 /**
- * optional string uuid = 1;
+ * optional string run_id = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+proto.api_container_api.GetStarlarkRunProfileArgs.prototype.getRunId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunProfileArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetStarlarkRunProfileArgs.prototype.setRunId = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetStarlarkRunProfileArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunProfileArgs.prototype.clearRunId = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetStarlarkRunProfileArgs.prototype.hasRunId = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -9454,8 +22040,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkInstructionProfile.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionProfile.toObject(opt_includeInstance, this);
 };
 
 
@@ -9464,14 +22050,18 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkInstructionProfile} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkInstructionProfile.toObject = function(includeInstance, msg) {
   var f, obj = {
-    templatesAndDataByDestinationRelFilepathMap: (f = msg.getTemplatesAndDataByDestinationRelFilepathMap()) ? f.toObject(includeInstance, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject) : [],
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    durationMicros: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    numContainersCreated: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    artifactBytesTransferred: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    isSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 6, false)
   };
 
   if (includeInstance) {
@@ -9485,23 +22075,23 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.toObject = function(i
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
+ * @return {!proto.api_container_api.StarlarkInstructionProfile}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkInstructionProfile.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs;
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkInstructionProfile;
+  return proto.api_container_api.StarlarkInstructionProfile.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkInstructionProfile} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs}
+ * @return {!proto.api_container_api.StarlarkInstructionProfile}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkInstructionProfile.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9509,14 +22099,29 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFrom
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getTemplatesAndDataByDestinationRelFilepathMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader, "", new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData());
-         });
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setInstructionName(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDurationMicros(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumContainersCreated(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setArtifactBytesTransferred(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsSuccessful(value);
       break;
     default:
       reader.skipField();
@@ -9531,9 +22136,9 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.deserializeBinaryFrom
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkInstructionProfile.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkInstructionProfile.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9541,27 +22146,193 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.serializeBi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} message
+ * @param {!proto.api_container_api.StarlarkInstructionProfile} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkInstructionProfile.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTemplatesAndDataByDestinationRelFilepathMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter);
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
   }
-  f = message.getName();
+  f = message.getInstructionName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getDurationMicros();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getNumContainersCreated();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getArtifactBytesTransferred();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getIsSuccessful();
+  if (f) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+*/
+proto.api_container_api.StarlarkInstructionProfile.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 duration_micros = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.getDurationMicros = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.setDurationMicros = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 num_containers_created = 4;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.getNumContainersCreated = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.setNumContainersCreated = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint64 artifact_bytes_transferred = 5;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.getArtifactBytesTransferred = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.setArtifactBytesTransferred = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional bool is_successful = 6;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.getIsSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionProfile} returns this
+ */
+proto.api_container_api.StarlarkInstructionProfile.prototype.setIsSuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 6, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunProfile.repeatedFields_ = [4];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -9577,8 +22348,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunProfile.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProfile.toObject(opt_includeInstance, this);
 };
 
 
@@ -9587,14 +22358,17 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkRunProfile} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkRunProfile.toObject = function(includeInstance, msg) {
   var f, obj = {
-    template: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    dataAsJson: jspb.Message.getFieldWithDefault(msg, 2, "")
+    runId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    packageId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    startedAt: (f = msg.getStartedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    instructionsList: jspb.Message.toObjectList(msg.getInstructionsList(),
+    proto.api_container_api.StarlarkInstructionProfile.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -9608,23 +22382,23 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
+ * @return {!proto.api_container_api.StarlarkRunProfile}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkRunProfile.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData;
-  return proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkRunProfile;
+  return proto.api_container_api.StarlarkRunProfile.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkRunProfile} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData}
+ * @return {!proto.api_container_api.StarlarkRunProfile}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkRunProfile.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9633,11 +22407,21 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deser
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTemplate(value);
+      msg.setRunId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDataAsJson(value);
+      msg.setPackageId(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setStartedAt(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstructionProfile;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionProfile.deserializeBinaryFromReader);
+      msg.addInstructions(value);
       break;
     default:
       reader.skipField();
@@ -9652,9 +22436,9 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkRunProfile.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkRunProfile.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9662,232 +22446,153 @@ proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} message
+ * @param {!proto.api_container_api.StarlarkRunProfile} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkRunProfile.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getTemplate();
+  f = message.getRunId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getDataAsJson();
+  f = message.getPackageId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getStartedAt();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstructionProfile.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string template = 1;
+ * optional string run_id = 1;
  * @return {string}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getTemplate = function() {
+proto.api_container_api.StarlarkRunProfile.prototype.getRunId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
+ * @return {!proto.api_container_api.StarlarkRunProfile} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setTemplate = function(value) {
+proto.api_container_api.StarlarkRunProfile.prototype.setRunId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string data_as_json = 2;
- * @return {string}
- */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.getDataAsJson = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData} returns this
- */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData.prototype.setDataAsJson = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * map<string, TemplateAndData> templates_and_data_by_destination_rel_filepath = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>}
- */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getTemplatesAndDataByDestinationRelFilepathMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.RenderTemplatesToFilesArtifactArgs.TemplateAndData));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
- */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.clearTemplatesAndDataByDestinationRelFilepathMap = function() {
-  this.getTemplatesAndDataByDestinationRelFilepathMap().clear();
-  return this;};
-
-
-/**
- * optional string name = 2;
+ * optional string package_id = 2;
  * @return {string}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.getName = function() {
+proto.api_container_api.StarlarkRunProfile.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactArgs} returns this
+ * @return {!proto.api_container_api.StarlarkRunProfile} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactArgs.prototype.setName = function(value) {
+proto.api_container_api.StarlarkRunProfile.prototype.setPackageId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional google.protobuf.Timestamp started_at = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkRunProfile.prototype.getStartedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunProfile} returns this
+*/
+proto.api_container_api.StarlarkRunProfile.prototype.setStartedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunProfile} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RenderTemplatesToFilesArtifactResponse;
-  return proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.StarlarkRunProfile.prototype.clearStartedAt = function() {
+  return this.setStartedAt(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.StarlarkRunProfile.prototype.hasStartedAt = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * repeated StarlarkInstructionProfile instructions = 4;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionProfile>}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.StarlarkRunProfile.prototype.getInstructionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionProfile>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionProfile, 4));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionProfile>} value
+ * @return {!proto.api_container_api.StarlarkRunProfile} returns this
+*/
+proto.api_container_api.StarlarkRunProfile.prototype.setInstructionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
 };
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * @param {!proto.api_container_api.StarlarkInstructionProfile=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionProfile}
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.StarlarkRunProfile.prototype.addInstructions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.api_container_api.StarlarkInstructionProfile, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RenderTemplatesToFilesArtifactResponse} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProfile} returns this
  */
-proto.api_container_api.RenderTemplatesToFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.StarlarkRunProfile.prototype.clearInstructionsList = function() {
+  return this.setInstructionsList([]);
 };
 
 
@@ -9907,8 +22612,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
+proto.api_container_api.DeprecatedFeature.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DeprecatedFeature.toObject(opt_includeInstance, this);
 };
 
 
@@ -9917,14 +22622,14 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DeprecatedFeature} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
+proto.api_container_api.DeprecatedFeature.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    code: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -9938,23 +22643,23 @@ proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.DeprecatedFeature}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
+proto.api_container_api.DeprecatedFeature.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
-  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DeprecatedFeature;
+  return proto.api_container_api.DeprecatedFeature.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DeprecatedFeature} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.DeprecatedFeature}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DeprecatedFeature.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -9963,11 +22668,11 @@ proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = f
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      msg.setCode(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFileuuid(value);
+      msg.setDescription(value);
       break;
     default:
       reader.skipField();
@@ -9982,9 +22687,9 @@ proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
+proto.api_container_api.DeprecatedFeature.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DeprecatedFeature.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -9992,20 +22697,20 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
+ * @param {!proto.api_container_api.DeprecatedFeature} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DeprecatedFeature.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
+  f = message.getCode();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getFileuuid();
+  f = message.getDescription();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -10016,37 +22721,37 @@ proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = funct
 
 
 /**
- * optional string fileName = 1;
+ * optional string code = 1;
  * @return {string}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
+proto.api_container_api.DeprecatedFeature.prototype.getCode = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * @return {!proto.api_container_api.DeprecatedFeature} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
+proto.api_container_api.DeprecatedFeature.prototype.setCode = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string fileUuid = 2;
+ * optional string description = 2;
  * @return {string}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
+proto.api_container_api.DeprecatedFeature.prototype.getDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * @return {!proto.api_container_api.DeprecatedFeature} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
+proto.api_container_api.DeprecatedFeature.prototype.setDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -10057,7 +22762,7 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = functio
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
+proto.api_container_api.GetDeprecatedFeaturesResponse.repeatedFields_ = [1];
 
 
 
@@ -10074,8 +22779,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetDeprecatedFeaturesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetDeprecatedFeaturesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -10084,14 +22789,14 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetDeprecatedFeaturesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetDeprecatedFeaturesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
-    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+    deprecatedFeaturesList: jspb.Message.toObjectList(msg.getDeprecatedFeaturesList(),
+    proto.api_container_api.DeprecatedFeature.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -10105,23 +22810,23 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.GetDeprecatedFeaturesResponse}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GetDeprecatedFeaturesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetDeprecatedFeaturesResponse;
+  return proto.api_container_api.GetDeprecatedFeaturesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetDeprecatedFeaturesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.GetDeprecatedFeaturesResponse}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetDeprecatedFeaturesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -10129,9 +22834,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.addFileNamesAndUuids(value);
+      var value = new proto.api_container_api.DeprecatedFeature;
+      reader.readMessage(value,proto.api_container_api.DeprecatedFeature.deserializeBinaryFromReader);
+      msg.addDeprecatedFeatures(value);
       break;
     default:
       reader.skipField();
@@ -10146,9 +22851,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GetDeprecatedFeaturesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetDeprecatedFeaturesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -10156,58 +22861,58 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!proto.api_container_api.GetDeprecatedFeaturesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetDeprecatedFeaturesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuidsList();
+  f = message.getDeprecatedFeaturesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      proto.api_container_api.DeprecatedFeature.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
- * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
+ * repeated DeprecatedFeature deprecated_features = 1;
+ * @return {!Array<!proto.api_container_api.DeprecatedFeature>}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+proto.api_container_api.GetDeprecatedFeaturesResponse.prototype.getDeprecatedFeaturesList = function() {
+  return /** @type{!Array<!proto.api_container_api.DeprecatedFeature>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.DeprecatedFeature, 1));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * @param {!Array<!proto.api_container_api.DeprecatedFeature>} value
+ * @return {!proto.api_container_api.GetDeprecatedFeaturesResponse} returns this
 */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
+proto.api_container_api.GetDeprecatedFeaturesResponse.prototype.setDeprecatedFeaturesList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
+ * @param {!proto.api_container_api.DeprecatedFeature=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.DeprecatedFeature}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
+proto.api_container_api.GetDeprecatedFeaturesResponse.prototype.addDeprecatedFeatures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.DeprecatedFeature, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * @return {!proto.api_container_api.GetDeprecatedFeaturesResponse} returns this
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
-  return this.setFileNamesAndUuidsList([]);
+proto.api_container_api.GetDeprecatedFeaturesResponse.prototype.clearDeprecatedFeaturesList = function() {
+  return this.setDeprecatedFeaturesList([]);
 };
 
 
@@ -13,9 +13,19 @@ interface IEngineServiceService extends grpc.ServiceDefinition<grpc.UntypedServi
   getEnclaves: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  stopEnclaveWithProgress: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, engine_service_pb.EnclaveTeardownProgress>;
   destroyEnclave: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  destroyEnclaveWithProgress: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, engine_service_pb.EnclaveTeardownProgress>;
   clean: grpc.MethodDefinition<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
+  getPulledImages: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetPulledImagesResponse>;
+  pruneImages: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.PruneImagesResponse>;
   getServiceLogs: grpc.MethodDefinition<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  getEnclaveLifecycleEvents: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.EnclaveLifecycleEvent>;
+  copyFilesArtifact: grpc.MethodDefinition<engine_service_pb.CopyFilesArtifactArgs, engine_service_pb.CopyFilesArtifactResponse>;
+  addEnclaveSchedule: grpc.MethodDefinition<engine_service_pb.AddEnclaveScheduleArgs, google_protobuf_empty_pb.Empty>;
+  getEnclaveSchedules: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclaveSchedulesResponse>;
+  removeEnclaveSchedule: grpc.MethodDefinition<engine_service_pb.RemoveEnclaveScheduleArgs, google_protobuf_empty_pb.Empty>;
+  setLogLevel: grpc.MethodDefinition<engine_service_pb.SetLogLevelArgs, engine_service_pb.SetLogLevelResponse>;
 }
 
 export const EngineServiceService: IEngineServiceService;
@@ -26,9 +36,19 @@ export interface IEngineServiceServer extends grpc.UntypedServiceImplementation
   getEnclaves: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.handleUnaryCall<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  stopEnclaveWithProgress: grpc.handleServerStreamingCall<engine_service_pb.StopEnclaveArgs, engine_service_pb.EnclaveTeardownProgress>;
   destroyEnclave: grpc.handleUnaryCall<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  destroyEnclaveWithProgress: grpc.handleServerStreamingCall<engine_service_pb.DestroyEnclaveArgs, engine_service_pb.EnclaveTeardownProgress>;
   clean: grpc.handleUnaryCall<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
+  getPulledImages: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetPulledImagesResponse>;
+  pruneImages: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.PruneImagesResponse>;
   getServiceLogs: grpc.handleServerStreamingCall<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  getEnclaveLifecycleEvents: grpc.handleServerStreamingCall<google_protobuf_empty_pb.Empty, engine_service_pb.EnclaveLifecycleEvent>;
+  copyFilesArtifact: grpc.handleUnaryCall<engine_service_pb.CopyFilesArtifactArgs, engine_service_pb.CopyFilesArtifactResponse>;
+  addEnclaveSchedule: grpc.handleUnaryCall<engine_service_pb.AddEnclaveScheduleArgs, google_protobuf_empty_pb.Empty>;
+  getEnclaveSchedules: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclaveSchedulesResponse>;
+  removeEnclaveSchedule: grpc.handleUnaryCall<engine_service_pb.RemoveEnclaveScheduleArgs, google_protobuf_empty_pb.Empty>;
+  setLogLevel: grpc.handleUnaryCall<engine_service_pb.SetLogLevelArgs, engine_service_pb.SetLogLevelResponse>;
 }
 
 export class EngineServiceClient extends grpc.Client {
@@ -48,12 +68,39 @@ export class EngineServiceClient extends grpc.Client {
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  stopEnclaveWithProgress(argument: engine_service_pb.StopEnclaveArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
+  stopEnclaveWithProgress(argument: engine_service_pb.StopEnclaveArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  destroyEnclaveWithProgress(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
+  destroyEnclaveWithProgress(argument: engine_service_pb.DestroyEnclaveArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
   clean(argument: engine_service_pb.CleanArgs, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   clean(argument: engine_service_pb.CleanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
+  getPulledImages(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetPulledImagesResponse>): grpc.ClientUnaryCall;
+  getPulledImages(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetPulledImagesResponse>): grpc.ClientUnaryCall;
+  getPulledImages(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetPulledImagesResponse>): grpc.ClientUnaryCall;
+  pruneImages(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.PruneImagesResponse>): grpc.ClientUnaryCall;
+  pruneImages(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.PruneImagesResponse>): grpc.ClientUnaryCall;
+  pruneImages(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.PruneImagesResponse>): grpc.ClientUnaryCall;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
+  getEnclaveLifecycleEvents(argument: google_protobuf_empty_pb.Empty, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveLifecycleEvent>;
+  getEnclaveLifecycleEvents(argument: google_protobuf_empty_pb.Empty, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.EnclaveLifecycleEvent>;
+  copyFilesArtifact(argument: engine_service_pb.CopyFilesArtifactArgs, callback: grpc.requestCallback<engine_service_pb.CopyFilesArtifactResponse>): grpc.ClientUnaryCall;
+  copyFilesArtifact(argument: engine_service_pb.CopyFilesArtifactArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CopyFilesArtifactResponse>): grpc.ClientUnaryCall;
+  copyFilesArtifact(argument: engine_service_pb.CopyFilesArtifactArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CopyFilesArtifactResponse>): grpc.ClientUnaryCall;
+  addEnclaveSchedule(argument: engine_service_pb.AddEnclaveScheduleArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  addEnclaveSchedule(argument: engine_service_pb.AddEnclaveScheduleArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  addEnclaveSchedule(argument: engine_service_pb.AddEnclaveScheduleArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  getEnclaveSchedules(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetEnclaveSchedulesResponse>): grpc.ClientUnaryCall;
+  getEnclaveSchedules(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclaveSchedulesResponse>): grpc.ClientUnaryCall;
+  getEnclaveSchedules(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetEnclaveSchedulesResponse>): grpc.ClientUnaryCall;
+  removeEnclaveSchedule(argument: engine_service_pb.RemoveEnclaveScheduleArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  removeEnclaveSchedule(argument: engine_service_pb.RemoveEnclaveScheduleArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  removeEnclaveSchedule(argument: engine_service_pb.RemoveEnclaveScheduleArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  setLogLevel(argument: engine_service_pb.SetLogLevelArgs, callback: grpc.requestCallback<engine_service_pb.SetLogLevelResponse>): grpc.ClientUnaryCall;
+  setLogLevel(argument: engine_service_pb.SetLogLevelArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.SetLogLevelResponse>): grpc.ClientUnaryCall;
+  setLogLevel(argument: engine_service_pb.SetLogLevelArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.SetLogLevelResponse>): grpc.ClientUnaryCall;
 }
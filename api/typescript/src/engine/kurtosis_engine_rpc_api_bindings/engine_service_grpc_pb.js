@@ -6,6 +6,17 @@ var engine_service_pb = require('./engine_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 
+function serialize_engine_api_AddEnclaveScheduleArgs(arg) {
+  if (!(arg instanceof engine_service_pb.AddEnclaveScheduleArgs)) {
+    throw new Error('Expected argument of type engine_api.AddEnclaveScheduleArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_AddEnclaveScheduleArgs(buffer_arg) {
+  return engine_service_pb.AddEnclaveScheduleArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_CleanArgs(arg) {
   if (!(arg instanceof engine_service_pb.CleanArgs)) {
     throw new Error('Expected argument of type engine_api.CleanArgs');
@@ -28,6 +39,28 @@ function deserialize_engine_api_CleanResponse(buffer_arg) {
   return engine_service_pb.CleanResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_CopyFilesArtifactArgs(arg) {
+  if (!(arg instanceof engine_service_pb.CopyFilesArtifactArgs)) {
+    throw new Error('Expected argument of type engine_api.CopyFilesArtifactArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CopyFilesArtifactArgs(buffer_arg) {
+  return engine_service_pb.CopyFilesArtifactArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_CopyFilesArtifactResponse(arg) {
+  if (!(arg instanceof engine_service_pb.CopyFilesArtifactResponse)) {
+    throw new Error('Expected argument of type engine_api.CopyFilesArtifactResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_CopyFilesArtifactResponse(buffer_arg) {
+  return engine_service_pb.CopyFilesArtifactResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_CreateEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.CreateEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.CreateEnclaveArgs');
@@ -61,6 +94,39 @@ function deserialize_engine_api_DestroyEnclaveArgs(buffer_arg) {
   return engine_service_pb.DestroyEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_EnclaveLifecycleEvent(arg) {
+  if (!(arg instanceof engine_service_pb.EnclaveLifecycleEvent)) {
+    throw new Error('Expected argument of type engine_api.EnclaveLifecycleEvent');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EnclaveLifecycleEvent(buffer_arg) {
+  return engine_service_pb.EnclaveLifecycleEvent.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_EnclaveTeardownProgress(arg) {
+  if (!(arg instanceof engine_service_pb.EnclaveTeardownProgress)) {
+    throw new Error('Expected argument of type engine_api.EnclaveTeardownProgress');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_EnclaveTeardownProgress(buffer_arg) {
+  return engine_service_pb.EnclaveTeardownProgress.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_GetEnclaveSchedulesResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetEnclaveSchedulesResponse)) {
+    throw new Error('Expected argument of type engine_api.GetEnclaveSchedulesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetEnclaveSchedulesResponse(buffer_arg) {
+  return engine_service_pb.GetEnclaveSchedulesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetEnclavesResponse(arg) {
   if (!(arg instanceof engine_service_pb.GetEnclavesResponse)) {
     throw new Error('Expected argument of type engine_api.GetEnclavesResponse');
@@ -94,6 +160,17 @@ function deserialize_engine_api_GetExistingAndHistoricalEnclaveIdentifiersRespon
   return engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetPulledImagesResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetPulledImagesResponse)) {
+    throw new Error('Expected argument of type engine_api.GetPulledImagesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetPulledImagesResponse(buffer_arg) {
+  return engine_service_pb.GetPulledImagesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetServiceLogsArgs(arg) {
   if (!(arg instanceof engine_service_pb.GetServiceLogsArgs)) {
     throw new Error('Expected argument of type engine_api.GetServiceLogsArgs');
@@ -116,6 +193,50 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_PruneImagesResponse(arg) {
+  if (!(arg instanceof engine_service_pb.PruneImagesResponse)) {
+    throw new Error('Expected argument of type engine_api.PruneImagesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_PruneImagesResponse(buffer_arg) {
+  return engine_service_pb.PruneImagesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_RemoveEnclaveScheduleArgs(arg) {
+  if (!(arg instanceof engine_service_pb.RemoveEnclaveScheduleArgs)) {
+    throw new Error('Expected argument of type engine_api.RemoveEnclaveScheduleArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_RemoveEnclaveScheduleArgs(buffer_arg) {
+  return engine_service_pb.RemoveEnclaveScheduleArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_SetLogLevelArgs(arg) {
+  if (!(arg instanceof engine_service_pb.SetLogLevelArgs)) {
+    throw new Error('Expected argument of type engine_api.SetLogLevelArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_SetLogLevelArgs(buffer_arg) {
+  return engine_service_pb.SetLogLevelArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_engine_api_SetLogLevelResponse(arg) {
+  if (!(arg instanceof engine_service_pb.SetLogLevelResponse)) {
+    throw new Error('Expected argument of type engine_api.SetLogLevelResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_SetLogLevelResponse(buffer_arg) {
+  return engine_service_pb.SetLogLevelResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -203,6 +324,19 @@ stopEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Like StopEnclave, but streams the progress of the stop as containers get stopped, followed by a summary of the
+// containers that couldn't be stopped
+stopEnclaveWithProgress: {
+    path: '/engine_api.EngineService/StopEnclaveWithProgress',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.StopEnclaveArgs,
+    responseType: engine_service_pb.EnclaveTeardownProgress,
+    requestSerialize: serialize_engine_api_StopEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_StopEnclaveArgs,
+    responseSerialize: serialize_engine_api_EnclaveTeardownProgress,
+    responseDeserialize: deserialize_engine_api_EnclaveTeardownProgress,
+  },
   // Destroys an enclave, removing all artifacts associated with it
 destroyEnclave: {
     path: '/engine_api.EngineService/DestroyEnclave',
@@ -215,6 +349,19 @@ destroyEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Like DestroyEnclave, but streams the progress of the destruction as containers, volumes and networks get removed,
+// followed by a summary of the resources that were left behind
+destroyEnclaveWithProgress: {
+    path: '/engine_api.EngineService/DestroyEnclaveWithProgress',
+    requestStream: false,
+    responseStream: true,
+    requestType: engine_service_pb.DestroyEnclaveArgs,
+    responseType: engine_service_pb.EnclaveTeardownProgress,
+    requestSerialize: serialize_engine_api_DestroyEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_DestroyEnclaveArgs,
+    responseSerialize: serialize_engine_api_EnclaveTeardownProgress,
+    responseDeserialize: deserialize_engine_api_EnclaveTeardownProgress,
+  },
   // Gets rid of old enclaves
 clean: {
     path: '/engine_api.EngineService/Clean',
@@ -227,6 +374,30 @@ clean: {
     responseSerialize: serialize_engine_api_CleanResponse,
     responseDeserialize: deserialize_engine_api_CleanResponse,
   },
+  // Lists the images Kurtosis pulled for enclaves, along with the existing enclaves still using them
+getPulledImages: {
+    path: '/engine_api.EngineService/GetPulledImages',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.GetPulledImagesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_GetPulledImagesResponse,
+    responseDeserialize: deserialize_engine_api_GetPulledImagesResponse,
+  },
+  // Removes the images Kurtosis pulled for enclaves that no existing enclave uses anymore
+pruneImages: {
+    path: '/engine_api.EngineService/PruneImages',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.PruneImagesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_PruneImagesResponse,
+    responseDeserialize: deserialize_engine_api_PruneImagesResponse,
+  },
   // Get service logs
 getServiceLogs: {
     path: '/engine_api.EngineService/GetServiceLogs',
@@ -239,6 +410,85 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // Streams the lifecycle events of all the enclaves (created, stopped, destroyed, service added) as they happen
+getEnclaveLifecycleEvents: {
+    path: '/engine_api.EngineService/GetEnclaveLifecycleEvents',
+    requestStream: false,
+    responseStream: true,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.EnclaveLifecycleEvent,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_EnclaveLifecycleEvent,
+    responseDeserialize: deserialize_engine_api_EnclaveLifecycleEvent,
+  },
+  // Copies a files artifact from one enclave to another without it going through the client, e.g. to reuse a generated
+// genesis state in another enclave
+copyFilesArtifact: {
+    path: '/engine_api.EngineService/CopyFilesArtifact',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.CopyFilesArtifactArgs,
+    responseType: engine_service_pb.CopyFilesArtifactResponse,
+    requestSerialize: serialize_engine_api_CopyFilesArtifactArgs,
+    requestDeserialize: deserialize_engine_api_CopyFilesArtifactArgs,
+    responseSerialize: serialize_engine_api_CopyFilesArtifactResponse,
+    responseDeserialize: deserialize_engine_api_CopyFilesArtifactResponse,
+  },
+  // ==============================================================================================
+//                                   Enclave Schedules
+// ==============================================================================================
+// Registers a recurring job creating an enclave from a package on a cron schedule, e.g. to rebuild a devnet nightly
+addEnclaveSchedule: {
+    path: '/engine_api.EngineService/AddEnclaveSchedule',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.AddEnclaveScheduleArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_AddEnclaveScheduleArgs,
+    requestDeserialize: deserialize_engine_api_AddEnclaveScheduleArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Returns the registered enclave schedules along with their last runs
+getEnclaveSchedules: {
+    path: '/engine_api.EngineService/GetEnclaveSchedules',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.GetEnclaveSchedulesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_GetEnclaveSchedulesResponse,
+    responseDeserialize: deserialize_engine_api_GetEnclaveSchedulesResponse,
+  },
+  // Unregisters an enclave schedule, optionally destroying the enclaves it created
+removeEnclaveSchedule: {
+    path: '/engine_api.EngineService/RemoveEnclaveSchedule',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.RemoveEnclaveScheduleArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_RemoveEnclaveScheduleArgs,
+    requestDeserialize: deserialize_engine_api_RemoveEnclaveScheduleArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // ==============================================================================================
+//                                   Administration
+// ==============================================================================================
+// Changes the log level of the engine at runtime, e.g. to temporarily debug an issue without restarting it
+setLogLevel: {
+    path: '/engine_api.EngineService/SetLogLevel',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.SetLogLevelArgs,
+    responseType: engine_service_pb.SetLogLevelResponse,
+    requestSerialize: serialize_engine_api_SetLogLevelArgs,
+    requestDeserialize: deserialize_engine_api_SetLogLevelArgs,
+    responseSerialize: serialize_engine_api_SetLogLevelResponse,
+    responseDeserialize: deserialize_engine_api_SetLogLevelResponse,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -44,6 +44,11 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  stopEnclaveWithProgress(
+    request: engine_service_pb.StopEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -51,6 +56,11 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  destroyEnclaveWithProgress(
+    request: engine_service_pb.DestroyEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -58,11 +68,65 @@ export class EngineServiceClient {
                response: engine_service_pb.CleanResponse) => void
   ): grpcWeb.ClientReadableStream<engine_service_pb.CleanResponse>;
 
+  getPulledImages(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetPulledImagesResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetPulledImagesResponse>;
+
+  pruneImages(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.PruneImagesResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.PruneImagesResponse>;
+
   getServiceLogs(
     request: engine_service_pb.GetServiceLogsArgs,
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getEnclaveLifecycleEvents(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveLifecycleEvent>;
+
+  copyFilesArtifact(
+    request: engine_service_pb.CopyFilesArtifactArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.CopyFilesArtifactResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.CopyFilesArtifactResponse>;
+
+  addEnclaveSchedule(
+    request: engine_service_pb.AddEnclaveScheduleArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveSchedules(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetEnclaveSchedulesResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetEnclaveSchedulesResponse>;
+
+  removeEnclaveSchedule(
+    request: engine_service_pb.RemoveEnclaveScheduleArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  setLogLevel(
+    request: engine_service_pb.SetLogLevelArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.SetLogLevelResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.SetLogLevelResponse>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -95,20 +159,70 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  stopEnclaveWithProgress(
+    request: engine_service_pb.StopEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  destroyEnclaveWithProgress(
+    request: engine_service_pb.DestroyEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveTeardownProgress>;
+
   clean(
     request: engine_service_pb.CleanArgs,
     metadata?: grpcWeb.Metadata
   ): Promise<engine_service_pb.CleanResponse>;
 
+  getPulledImages(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetPulledImagesResponse>;
+
+  pruneImages(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.PruneImagesResponse>;
+
   getServiceLogs(
     request: engine_service_pb.GetServiceLogsArgs,
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getEnclaveLifecycleEvents(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<engine_service_pb.EnclaveLifecycleEvent>;
+
+  copyFilesArtifact(
+    request: engine_service_pb.CopyFilesArtifactArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.CopyFilesArtifactResponse>;
+
+  addEnclaveSchedule(
+    request: engine_service_pb.AddEnclaveScheduleArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  getEnclaveSchedules(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetEnclaveSchedulesResponse>;
+
+  removeEnclaveSchedule(
+    request: engine_service_pb.RemoveEnclaveScheduleArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  setLogLevel(
+    request: engine_service_pb.SetLogLevelArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.SetLogLevelResponse>;
+
 }
 
@@ -383,6 +383,62 @@ proto.engine_api.EngineServicePromiseClient.prototype.stopEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.StopEnclaveArgs,
+ *   !proto.engine_api.EnclaveTeardownProgress>}
+ */
+const methodDescriptor_EngineService_StopEnclaveWithProgress = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/StopEnclaveWithProgress',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.StopEnclaveArgs,
+  proto.engine_api.EnclaveTeardownProgress,
+  /**
+   * @param {!proto.engine_api.StopEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EnclaveTeardownProgress.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.StopEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveTeardownProgress>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.stopEnclaveWithProgress =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/StopEnclaveWithProgress',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StopEnclaveWithProgress);
+};
+
+
+/**
+ * @param {!proto.engine_api.StopEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveTeardownProgress>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.stopEnclaveWithProgress =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/StopEnclaveWithProgress',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StopEnclaveWithProgress);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -444,6 +500,62 @@ proto.engine_api.EngineServicePromiseClient.prototype.destroyEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.DestroyEnclaveArgs,
+ *   !proto.engine_api.EnclaveTeardownProgress>}
+ */
+const methodDescriptor_EngineService_DestroyEnclaveWithProgress = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/DestroyEnclaveWithProgress',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.engine_api.DestroyEnclaveArgs,
+  proto.engine_api.EnclaveTeardownProgress,
+  /**
+   * @param {!proto.engine_api.DestroyEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EnclaveTeardownProgress.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.DestroyEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveTeardownProgress>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.destroyEnclaveWithProgress =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/DestroyEnclaveWithProgress',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_DestroyEnclaveWithProgress);
+};
+
+
+/**
+ * @param {!proto.engine_api.DestroyEnclaveArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveTeardownProgress>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.destroyEnclaveWithProgress =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/DestroyEnclaveWithProgress',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_DestroyEnclaveWithProgress);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -505,6 +617,128 @@ proto.engine_api.EngineServicePromiseClient.prototype.clean =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetPulledImagesResponse>}
+ */
+const methodDescriptor_EngineService_GetPulledImages = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetPulledImages',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetPulledImagesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetPulledImagesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetPulledImagesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetPulledImagesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getPulledImages =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetPulledImages',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetPulledImages,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetPulledImagesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getPulledImages =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetPulledImages',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetPulledImages);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.PruneImagesResponse>}
+ */
+const methodDescriptor_EngineService_PruneImages = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/PruneImages',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.PruneImagesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.PruneImagesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.PruneImagesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.PruneImagesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.pruneImages =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/PruneImages',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_PruneImages,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.PruneImagesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.pruneImages =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/PruneImages',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_PruneImages);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +795,366 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.EnclaveLifecycleEvent>}
+ */
+const methodDescriptor_EngineService_GetEnclaveLifecycleEvents = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetEnclaveLifecycleEvents',
+  grpc.web.MethodType.SERVER_STREAMING,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.EnclaveLifecycleEvent,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.EnclaveLifecycleEvent.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveLifecycleEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getEnclaveLifecycleEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveLifecycleEvents',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveLifecycleEvents);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.EnclaveLifecycleEvent>}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getEnclaveLifecycleEvents =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveLifecycleEvents',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveLifecycleEvents);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.CopyFilesArtifactArgs,
+ *   !proto.engine_api.CopyFilesArtifactResponse>}
+ */
+const methodDescriptor_EngineService_CopyFilesArtifact = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/CopyFilesArtifact',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.CopyFilesArtifactArgs,
+  proto.engine_api.CopyFilesArtifactResponse,
+  /**
+   * @param {!proto.engine_api.CopyFilesArtifactArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.CopyFilesArtifactResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.CopyFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.CopyFilesArtifactResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.CopyFilesArtifactResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.copyFilesArtifact =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/CopyFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CopyFilesArtifact,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.CopyFilesArtifactArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.CopyFilesArtifactResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.copyFilesArtifact =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/CopyFilesArtifact',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_CopyFilesArtifact);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.AddEnclaveScheduleArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_AddEnclaveSchedule = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/AddEnclaveSchedule',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.AddEnclaveScheduleArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.AddEnclaveScheduleArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.AddEnclaveScheduleArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.addEnclaveSchedule =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/AddEnclaveSchedule',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_AddEnclaveSchedule,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.AddEnclaveScheduleArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.addEnclaveSchedule =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/AddEnclaveSchedule',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_AddEnclaveSchedule);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetEnclaveSchedulesResponse>}
+ */
+const methodDescriptor_EngineService_GetEnclaveSchedules = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetEnclaveSchedules',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetEnclaveSchedulesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetEnclaveSchedulesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetEnclaveSchedulesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetEnclaveSchedulesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getEnclaveSchedules =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveSchedules',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveSchedules,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetEnclaveSchedulesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getEnclaveSchedules =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetEnclaveSchedules',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetEnclaveSchedules);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.RemoveEnclaveScheduleArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_RemoveEnclaveSchedule = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/RemoveEnclaveSchedule',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.RemoveEnclaveScheduleArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.RemoveEnclaveScheduleArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.RemoveEnclaveScheduleArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.removeEnclaveSchedule =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/RemoveEnclaveSchedule',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_RemoveEnclaveSchedule,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.RemoveEnclaveScheduleArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.removeEnclaveSchedule =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/RemoveEnclaveSchedule',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_RemoveEnclaveSchedule);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.SetLogLevelArgs,
+ *   !proto.engine_api.SetLogLevelResponse>}
+ */
+const methodDescriptor_EngineService_SetLogLevel = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/SetLogLevel',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.SetLogLevelArgs,
+  proto.engine_api.SetLogLevelResponse,
+  /**
+   * @param {!proto.engine_api.SetLogLevelArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.SetLogLevelResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.SetLogLevelArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.SetLogLevelResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.SetLogLevelResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.setLogLevel =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/SetLogLevel',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_SetLogLevel,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.SetLogLevelArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.SetLogLevelResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.setLogLevel =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/SetLogLevel',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_SetLogLevel);
+};
+
+
 module.exports = proto.engine_api;
 
@@ -35,6 +35,26 @@ export class CreateEnclaveArgs extends jspb.Message {
   getIsPartitioningEnabled(): boolean;
   setIsPartitioningEnabled(value: boolean): CreateEnclaveArgs;
 
+  getIpRangeReusePolicy(): EnclaveIpRangeReusePolicy;
+  setIpRangeReusePolicy(value: EnclaveIpRangeReusePolicy): CreateEnclaveArgs;
+
+  getExcludedIpRangesList(): Array<string>;
+  setExcludedIpRangesList(value: Array<string>): CreateEnclaveArgs;
+  clearExcludedIpRangesList(): CreateEnclaveArgs;
+  addExcludedIpRanges(value: string, index?: number): CreateEnclaveArgs;
+
+  getSubnet(): string;
+  setSubnet(value: string): CreateEnclaveArgs;
+
+  getFilesArtifactsTtlSeconds(): number;
+  setFilesArtifactsTtlSeconds(value: number): CreateEnclaveArgs;
+
+  getAllowHostDirMounts(): boolean;
+  setAllowHostDirMounts(value: boolean): CreateEnclaveArgs;
+
+  getIsIpv6Enabled(): boolean;
+  setIsIpv6Enabled(value: boolean): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -49,6 +69,12 @@ export namespace CreateEnclaveArgs {
     apiContainerVersionTag: string,
     apiContainerLogLevel: string,
     isPartitioningEnabled: boolean,
+    ipRangeReusePolicy: EnclaveIpRangeReusePolicy,
+    excludedIpRangesList: Array<string>,
+    subnet: string,
+    filesArtifactsTtlSeconds: number,
+    allowHostDirMounts: boolean,
+    isIpv6Enabled: boolean,
   }
 }
 
@@ -159,6 +185,12 @@ export class EnclaveInfo extends jspb.Message {
   hasCreationTime(): boolean;
   clearCreationTime(): EnclaveInfo;
 
+  getIpRange(): string;
+  setIpRange(value: string): EnclaveInfo;
+
+  getScheduleName(): string;
+  setScheduleName(value: string): EnclaveInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): EnclaveInfo.AsObject;
   static toObject(includeInstance: boolean, msg: EnclaveInfo): EnclaveInfo.AsObject;
@@ -177,6 +209,8 @@ export namespace EnclaveInfo {
     apiContainerInfo?: EnclaveAPIContainerInfo.AsObject,
     apiContainerHostMachineInfo?: EnclaveAPIContainerHostMachineInfo.AsObject,
     creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    ipRange: string,
+    scheduleName: string,
   }
 }
 
@@ -280,6 +314,114 @@ export namespace DestroyEnclaveArgs {
   }
 }
 
+export class EnclaveTeardownProgress extends jspb.Message {
+  getStep(): EnclaveTeardownStep | undefined;
+  setStep(value?: EnclaveTeardownStep): EnclaveTeardownProgress;
+  hasStep(): boolean;
+  clearStep(): EnclaveTeardownProgress;
+
+  getSummary(): EnclaveTeardownSummary | undefined;
+  setSummary(value?: EnclaveTeardownSummary): EnclaveTeardownProgress;
+  hasSummary(): boolean;
+  clearSummary(): EnclaveTeardownProgress;
+
+  getProgressCase(): EnclaveTeardownProgress.ProgressCase;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveTeardownProgress.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveTeardownProgress): EnclaveTeardownProgress.AsObject;
+  static serializeBinaryToWriter(message: EnclaveTeardownProgress, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveTeardownProgress;
+  static deserializeBinaryFromReader(message: EnclaveTeardownProgress, reader: jspb.BinaryReader): EnclaveTeardownProgress;
+}
+
+export namespace EnclaveTeardownProgress {
+  export type AsObject = {
+    step?: EnclaveTeardownStep.AsObject,
+    summary?: EnclaveTeardownSummary.AsObject,
+  }
+
+  export enum ProgressCase { 
+    PROGRESS_NOT_SET = 0,
+    STEP = 1,
+    SUMMARY = 2,
+  }
+}
+
+export class EnclaveTeardownStep extends jspb.Message {
+  getCurrentStepInfoList(): Array<string>;
+  setCurrentStepInfoList(value: Array<string>): EnclaveTeardownStep;
+  clearCurrentStepInfoList(): EnclaveTeardownStep;
+  addCurrentStepInfo(value: string, index?: number): EnclaveTeardownStep;
+
+  getTotalSteps(): number;
+  setTotalSteps(value: number): EnclaveTeardownStep;
+
+  getCurrentStepNumber(): number;
+  setCurrentStepNumber(value: number): EnclaveTeardownStep;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveTeardownStep.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveTeardownStep): EnclaveTeardownStep.AsObject;
+  static serializeBinaryToWriter(message: EnclaveTeardownStep, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveTeardownStep;
+  static deserializeBinaryFromReader(message: EnclaveTeardownStep, reader: jspb.BinaryReader): EnclaveTeardownStep;
+}
+
+export namespace EnclaveTeardownStep {
+  export type AsObject = {
+    currentStepInfoList: Array<string>,
+    totalSteps: number,
+    currentStepNumber: number,
+  }
+}
+
+export class EnclaveTeardownSummary extends jspb.Message {
+  getLeakedResourcesList(): Array<LeakedEnclaveResource>;
+  setLeakedResourcesList(value: Array<LeakedEnclaveResource>): EnclaveTeardownSummary;
+  clearLeakedResourcesList(): EnclaveTeardownSummary;
+  addLeakedResources(value?: LeakedEnclaveResource, index?: number): LeakedEnclaveResource;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveTeardownSummary.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveTeardownSummary): EnclaveTeardownSummary.AsObject;
+  static serializeBinaryToWriter(message: EnclaveTeardownSummary, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveTeardownSummary;
+  static deserializeBinaryFromReader(message: EnclaveTeardownSummary, reader: jspb.BinaryReader): EnclaveTeardownSummary;
+}
+
+export namespace EnclaveTeardownSummary {
+  export type AsObject = {
+    leakedResourcesList: Array<LeakedEnclaveResource.AsObject>,
+  }
+}
+
+export class LeakedEnclaveResource extends jspb.Message {
+  getResourceType(): string;
+  setResourceType(value: string): LeakedEnclaveResource;
+
+  getResourceName(): string;
+  setResourceName(value: string): LeakedEnclaveResource;
+
+  getError(): string;
+  setError(value: string): LeakedEnclaveResource;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LeakedEnclaveResource.AsObject;
+  static toObject(includeInstance: boolean, msg: LeakedEnclaveResource): LeakedEnclaveResource.AsObject;
+  static serializeBinaryToWriter(message: LeakedEnclaveResource, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LeakedEnclaveResource;
+  static deserializeBinaryFromReader(message: LeakedEnclaveResource, reader: jspb.BinaryReader): LeakedEnclaveResource;
+}
+
+export namespace LeakedEnclaveResource {
+  export type AsObject = {
+    resourceType: string,
+    resourceName: string,
+    error: string,
+  }
+}
+
 export class CleanArgs extends jspb.Message {
   getShouldCleanAll(): boolean;
   setShouldCleanAll(value: boolean): CleanArgs;
@@ -340,6 +482,78 @@ export namespace CleanResponse {
   }
 }
 
+export class PulledImage extends jspb.Message {
+  getImage(): string;
+  setImage(value: string): PulledImage;
+
+  getSizeBytes(): number;
+  setSizeBytes(value: number): PulledImage;
+
+  getEnclaveUuidsList(): Array<string>;
+  setEnclaveUuidsList(value: Array<string>): PulledImage;
+  clearEnclaveUuidsList(): PulledImage;
+  addEnclaveUuids(value: string, index?: number): PulledImage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PulledImage.AsObject;
+  static toObject(includeInstance: boolean, msg: PulledImage): PulledImage.AsObject;
+  static serializeBinaryToWriter(message: PulledImage, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PulledImage;
+  static deserializeBinaryFromReader(message: PulledImage, reader: jspb.BinaryReader): PulledImage;
+}
+
+export namespace PulledImage {
+  export type AsObject = {
+    image: string,
+    sizeBytes: number,
+    enclaveUuidsList: Array<string>,
+  }
+}
+
+export class GetPulledImagesResponse extends jspb.Message {
+  getPulledImagesList(): Array<PulledImage>;
+  setPulledImagesList(value: Array<PulledImage>): GetPulledImagesResponse;
+  clearPulledImagesList(): GetPulledImagesResponse;
+  addPulledImages(value?: PulledImage, index?: number): PulledImage;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetPulledImagesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetPulledImagesResponse): GetPulledImagesResponse.AsObject;
+  static serializeBinaryToWriter(message: GetPulledImagesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetPulledImagesResponse;
+  static deserializeBinaryFromReader(message: GetPulledImagesResponse, reader: jspb.BinaryReader): GetPulledImagesResponse;
+}
+
+export namespace GetPulledImagesResponse {
+  export type AsObject = {
+    pulledImagesList: Array<PulledImage.AsObject>,
+  }
+}
+
+export class PruneImagesResponse extends jspb.Message {
+  getRemovedImagesList(): Array<PulledImage>;
+  setRemovedImagesList(value: Array<PulledImage>): PruneImagesResponse;
+  clearRemovedImagesList(): PruneImagesResponse;
+  addRemovedImages(value?: PulledImage, index?: number): PulledImage;
+
+  getRemovalErrorsByImageMap(): jspb.Map<string, string>;
+  clearRemovalErrorsByImageMap(): PruneImagesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PruneImagesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: PruneImagesResponse): PruneImagesResponse.AsObject;
+  static serializeBinaryToWriter(message: PruneImagesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PruneImagesResponse;
+  static deserializeBinaryFromReader(message: PruneImagesResponse, reader: jspb.BinaryReader): PruneImagesResponse;
+}
+
+export namespace PruneImagesResponse {
+  export type AsObject = {
+    removedImagesList: Array<PulledImage.AsObject>,
+    removalErrorsByImageMap: Array<[string, string]>,
+  }
+}
+
 export class GetServiceLogsArgs extends jspb.Message {
   getEnclaveIdentifier(): string;
   setEnclaveIdentifier(value: string): GetServiceLogsArgs;
@@ -355,6 +569,16 @@ export class GetServiceLogsArgs extends jspb.Message {
   clearConjunctiveFiltersList(): GetServiceLogsArgs;
   addConjunctiveFilters(value?: LogLineFilter, index?: number): LogLineFilter;
 
+  getSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSince(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceLogsArgs;
+  hasSince(): boolean;
+  clearSince(): GetServiceLogsArgs;
+
+  getUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUntil(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceLogsArgs;
+  hasUntil(): boolean;
+  clearUntil(): GetServiceLogsArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetServiceLogsArgs.AsObject;
   static toObject(includeInstance: boolean, msg: GetServiceLogsArgs): GetServiceLogsArgs.AsObject;
@@ -369,6 +593,18 @@ export namespace GetServiceLogsArgs {
     serviceUuidSetMap: Array<[string, boolean]>,
     followLogs: boolean,
     conjunctiveFiltersList: Array<LogLineFilter.AsObject>,
+    since?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    until?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+  }
+
+  export enum SinceCase { 
+    _SINCE_NOT_SET = 0,
+    SINCE = 5,
+  }
+
+  export enum UntilCase { 
+    _UNTIL_NOT_SET = 0,
+    UNTIL = 6,
   }
 }
 
@@ -379,6 +615,9 @@ export class GetServiceLogsResponse extends jspb.Message {
   getNotFoundServiceUuidSetMap(): jspb.Map<string, boolean>;
   clearNotFoundServiceUuidSetMap(): GetServiceLogsResponse;
 
+  getNumDroppedLogLinesByServiceUuidMap(): jspb.Map<string, number>;
+  clearNumDroppedLogLinesByServiceUuidMap(): GetServiceLogsResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetServiceLogsResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetServiceLogsResponse): GetServiceLogsResponse.AsObject;
@@ -391,6 +630,7 @@ export namespace GetServiceLogsResponse {
   export type AsObject = {
     serviceLogsByServiceUuidMap: Array<[string, LogLine.AsObject]>,
     notFoundServiceUuidSetMap: Array<[string, boolean]>,
+    numDroppedLogLinesByServiceUuidMap: Array<[string, number]>,
   }
 }
 
@@ -436,6 +676,290 @@ export namespace LogLineFilter {
   }
 }
 
+export class EnclaveLifecycleEvent extends jspb.Message {
+  getEventType(): EnclaveLifecycleEventType;
+  setEventType(value: EnclaveLifecycleEventType): EnclaveLifecycleEvent;
+
+  getEnclaveUuid(): string;
+  setEnclaveUuid(value: string): EnclaveLifecycleEvent;
+
+  getEnclaveName(): string;
+  setEnclaveName(value: string): EnclaveLifecycleEvent;
+
+  getTimestamp(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setTimestamp(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveLifecycleEvent;
+  hasTimestamp(): boolean;
+  clearTimestamp(): EnclaveLifecycleEvent;
+
+  getServiceName(): string;
+  setServiceName(value: string): EnclaveLifecycleEvent;
+
+  getServiceUuid(): string;
+  setServiceUuid(value: string): EnclaveLifecycleEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveLifecycleEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveLifecycleEvent): EnclaveLifecycleEvent.AsObject;
+  static serializeBinaryToWriter(message: EnclaveLifecycleEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveLifecycleEvent;
+  static deserializeBinaryFromReader(message: EnclaveLifecycleEvent, reader: jspb.BinaryReader): EnclaveLifecycleEvent;
+}
+
+export namespace EnclaveLifecycleEvent {
+  export type AsObject = {
+    eventType: EnclaveLifecycleEventType,
+    enclaveUuid: string,
+    enclaveName: string,
+    timestamp?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    serviceName: string,
+    serviceUuid: string,
+  }
+}
+
+export class EnclaveSchedule extends jspb.Message {
+  getName(): string;
+  setName(value: string): EnclaveSchedule;
+
+  getCronExpression(): string;
+  setCronExpression(value: string): EnclaveSchedule;
+
+  getPackageId(): string;
+  setPackageId(value: string): EnclaveSchedule;
+
+  getSerializedParams(): string;
+  setSerializedParams(value: string): EnclaveSchedule;
+
+  getRetention(): number;
+  setRetention(value: number): EnclaveSchedule;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveSchedule.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveSchedule): EnclaveSchedule.AsObject;
+  static serializeBinaryToWriter(message: EnclaveSchedule, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveSchedule;
+  static deserializeBinaryFromReader(message: EnclaveSchedule, reader: jspb.BinaryReader): EnclaveSchedule;
+}
+
+export namespace EnclaveSchedule {
+  export type AsObject = {
+    name: string,
+    cronExpression: string,
+    packageId: string,
+    serializedParams: string,
+    retention: number,
+  }
+}
+
+export class EnclaveScheduleInfo extends jspb.Message {
+  getSchedule(): EnclaveSchedule | undefined;
+  setSchedule(value?: EnclaveSchedule): EnclaveScheduleInfo;
+  hasSchedule(): boolean;
+  clearSchedule(): EnclaveScheduleInfo;
+
+  getNextRunTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setNextRunTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveScheduleInfo;
+  hasNextRunTime(): boolean;
+  clearNextRunTime(): EnclaveScheduleInfo;
+
+  getLastRunTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setLastRunTime(value?: google_protobuf_timestamp_pb.Timestamp): EnclaveScheduleInfo;
+  hasLastRunTime(): boolean;
+  clearLastRunTime(): EnclaveScheduleInfo;
+
+  getLastRunError(): string;
+  setLastRunError(value: string): EnclaveScheduleInfo;
+
+  getEnclaveNamesList(): Array<string>;
+  setEnclaveNamesList(value: Array<string>): EnclaveScheduleInfo;
+  clearEnclaveNamesList(): EnclaveScheduleInfo;
+  addEnclaveNames(value: string, index?: number): EnclaveScheduleInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclaveScheduleInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclaveScheduleInfo): EnclaveScheduleInfo.AsObject;
+  static serializeBinaryToWriter(message: EnclaveScheduleInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclaveScheduleInfo;
+  static deserializeBinaryFromReader(message: EnclaveScheduleInfo, reader: jspb.BinaryReader): EnclaveScheduleInfo;
+}
+
+export namespace EnclaveScheduleInfo {
+  export type AsObject = {
+    schedule?: EnclaveSchedule.AsObject,
+    nextRunTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    lastRunTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    lastRunError: string,
+    enclaveNamesList: Array<string>,
+  }
+
+  export enum LastRunTimeCase { 
+    _LAST_RUN_TIME_NOT_SET = 0,
+    LAST_RUN_TIME = 3,
+  }
+}
+
+export class AddEnclaveScheduleArgs extends jspb.Message {
+  getSchedule(): EnclaveSchedule | undefined;
+  setSchedule(value?: EnclaveSchedule): AddEnclaveScheduleArgs;
+  hasSchedule(): boolean;
+  clearSchedule(): AddEnclaveScheduleArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AddEnclaveScheduleArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: AddEnclaveScheduleArgs): AddEnclaveScheduleArgs.AsObject;
+  static serializeBinaryToWriter(message: AddEnclaveScheduleArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AddEnclaveScheduleArgs;
+  static deserializeBinaryFromReader(message: AddEnclaveScheduleArgs, reader: jspb.BinaryReader): AddEnclaveScheduleArgs;
+}
+
+export namespace AddEnclaveScheduleArgs {
+  export type AsObject = {
+    schedule?: EnclaveSchedule.AsObject,
+  }
+}
+
+export class GetEnclaveSchedulesResponse extends jspb.Message {
+  getSchedulesList(): Array<EnclaveScheduleInfo>;
+  setSchedulesList(value: Array<EnclaveScheduleInfo>): GetEnclaveSchedulesResponse;
+  clearSchedulesList(): GetEnclaveSchedulesResponse;
+  addSchedules(value?: EnclaveScheduleInfo, index?: number): EnclaveScheduleInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveSchedulesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveSchedulesResponse): GetEnclaveSchedulesResponse.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveSchedulesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveSchedulesResponse;
+  static deserializeBinaryFromReader(message: GetEnclaveSchedulesResponse, reader: jspb.BinaryReader): GetEnclaveSchedulesResponse;
+}
+
+export namespace GetEnclaveSchedulesResponse {
+  export type AsObject = {
+    schedulesList: Array<EnclaveScheduleInfo.AsObject>,
+  }
+}
+
+export class RemoveEnclaveScheduleArgs extends jspb.Message {
+  getName(): string;
+  setName(value: string): RemoveEnclaveScheduleArgs;
+
+  getShouldDestroyEnclaves(): boolean;
+  setShouldDestroyEnclaves(value: boolean): RemoveEnclaveScheduleArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemoveEnclaveScheduleArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RemoveEnclaveScheduleArgs): RemoveEnclaveScheduleArgs.AsObject;
+  static serializeBinaryToWriter(message: RemoveEnclaveScheduleArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemoveEnclaveScheduleArgs;
+  static deserializeBinaryFromReader(message: RemoveEnclaveScheduleArgs, reader: jspb.BinaryReader): RemoveEnclaveScheduleArgs;
+}
+
+export namespace RemoveEnclaveScheduleArgs {
+  export type AsObject = {
+    name: string,
+    shouldDestroyEnclaves: boolean,
+  }
+}
+
+export class CopyFilesArtifactArgs extends jspb.Message {
+  getSourceEnclaveIdentifier(): string;
+  setSourceEnclaveIdentifier(value: string): CopyFilesArtifactArgs;
+
+  getDestinationEnclaveIdentifier(): string;
+  setDestinationEnclaveIdentifier(value: string): CopyFilesArtifactArgs;
+
+  getFilesArtifactIdentifier(): string;
+  setFilesArtifactIdentifier(value: string): CopyFilesArtifactArgs;
+
+  getDestinationFilesArtifactName(): string;
+  setDestinationFilesArtifactName(value: string): CopyFilesArtifactArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CopyFilesArtifactArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CopyFilesArtifactArgs): CopyFilesArtifactArgs.AsObject;
+  static serializeBinaryToWriter(message: CopyFilesArtifactArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CopyFilesArtifactArgs;
+  static deserializeBinaryFromReader(message: CopyFilesArtifactArgs, reader: jspb.BinaryReader): CopyFilesArtifactArgs;
+}
+
+export namespace CopyFilesArtifactArgs {
+  export type AsObject = {
+    sourceEnclaveIdentifier: string,
+    destinationEnclaveIdentifier: string,
+    filesArtifactIdentifier: string,
+    destinationFilesArtifactName: string,
+  }
+}
+
+export class CopyFilesArtifactResponse extends jspb.Message {
+  getFilesArtifactUuid(): string;
+  setFilesArtifactUuid(value: string): CopyFilesArtifactResponse;
+
+  getFilesArtifactName(): string;
+  setFilesArtifactName(value: string): CopyFilesArtifactResponse;
+
+  getWasAlreadyStored(): boolean;
+  setWasAlreadyStored(value: boolean): CopyFilesArtifactResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CopyFilesArtifactResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CopyFilesArtifactResponse): CopyFilesArtifactResponse.AsObject;
+  static serializeBinaryToWriter(message: CopyFilesArtifactResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CopyFilesArtifactResponse;
+  static deserializeBinaryFromReader(message: CopyFilesArtifactResponse, reader: jspb.BinaryReader): CopyFilesArtifactResponse;
+}
+
+export namespace CopyFilesArtifactResponse {
+  export type AsObject = {
+    filesArtifactUuid: string,
+    filesArtifactName: string,
+    wasAlreadyStored: boolean,
+  }
+}
+
+export class SetLogLevelArgs extends jspb.Message {
+  getLogLevel(): string;
+  setLogLevel(value: string): SetLogLevelArgs;
+
+  getRevertAfterSeconds(): number;
+  setRevertAfterSeconds(value: number): SetLogLevelArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetLogLevelArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: SetLogLevelArgs): SetLogLevelArgs.AsObject;
+  static serializeBinaryToWriter(message: SetLogLevelArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetLogLevelArgs;
+  static deserializeBinaryFromReader(message: SetLogLevelArgs, reader: jspb.BinaryReader): SetLogLevelArgs;
+}
+
+export namespace SetLogLevelArgs {
+  export type AsObject = {
+    logLevel: string,
+    revertAfterSeconds: number,
+  }
+}
+
+export class SetLogLevelResponse extends jspb.Message {
+  getPreviousLogLevel(): string;
+  setPreviousLogLevel(value: string): SetLogLevelResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SetLogLevelResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: SetLogLevelResponse): SetLogLevelResponse.AsObject;
+  static serializeBinaryToWriter(message: SetLogLevelResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SetLogLevelResponse;
+  static deserializeBinaryFromReader(message: SetLogLevelResponse, reader: jspb.BinaryReader): SetLogLevelResponse;
+}
+
+export namespace SetLogLevelResponse {
+  export type AsObject = {
+    previousLogLevel: string,
+  }
+}
+
+export enum EnclaveIpRangeReusePolicy { 
+  ENCLAVEIPRANGEREUSEPOLICY_IMMEDIATE = 0,
+  ENCLAVEIPRANGEREUSEPOLICY_AFTER_COOLDOWN = 1,
+  ENCLAVEIPRANGEREUSEPOLICY_NEVER = 2,
+}
 export enum EnclaveContainersStatus { 
   ENCLAVECONTAINERSSTATUS_EMPTY = 0,
   ENCLAVECONTAINERSSTATUS_RUNNING = 1,
@@ -452,3 +976,9 @@ export enum LogLineOperator {
   LOGLINEOPERATOR_DOES_CONTAIN_MATCH_REGEX = 2,
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX = 3,
 }
+export enum EnclaveLifecycleEventType { 
+  ENCLAVELIFECYCLEEVENTTYPE_CREATED = 0,
+  ENCLAVELIFECYCLEEVENTTYPE_STOPPED = 1,
+  ENCLAVELIFECYCLEEVENTTYPE_DESTROYED = 2,
+  ENCLAVELIFECYCLEEVENTTYPE_SERVICE_ADDED = 3,
+}
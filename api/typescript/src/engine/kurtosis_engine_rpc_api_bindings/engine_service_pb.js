@@ -25,8 +25,11 @@ var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb
 goog.object.extend(proto, google_protobuf_empty_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.engine_api.AddEnclaveScheduleArgs', null, global);
 goog.exportSymbol('proto.engine_api.CleanArgs', null, global);
 goog.exportSymbol('proto.engine_api.CleanResponse', null, global);
+goog.exportSymbol('proto.engine_api.CopyFilesArtifactArgs', null, global);
+goog.exportSymbol('proto.engine_api.CopyFilesArtifactResponse', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.CreateEnclaveResponse', null, global);
 goog.exportSymbol('proto.engine_api.DestroyEnclaveArgs', null, global);
@@ -36,15 +39,32 @@ goog.exportSymbol('proto.engine_api.EnclaveAPIContainerStatus', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveContainersStatus', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveIpRangeReusePolicy', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveLifecycleEvent', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveLifecycleEventType', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveSchedule', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveScheduleInfo', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveTeardownProgress', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveTeardownProgress.ProgressCase', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveTeardownStep', null, global);
+goog.exportSymbol('proto.engine_api.EnclaveTeardownSummary', null, global);
+goog.exportSymbol('proto.engine_api.GetEnclaveSchedulesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetPulledImagesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
+goog.exportSymbol('proto.engine_api.LeakedEnclaveResource', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.PruneImagesResponse', null, global);
+goog.exportSymbol('proto.engine_api.PulledImage', null, global);
+goog.exportSymbol('proto.engine_api.RemoveEnclaveScheduleArgs', null, global);
+goog.exportSymbol('proto.engine_api.SetLogLevelArgs', null, global);
+goog.exportSymbol('proto.engine_api.SetLogLevelResponse', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -78,7 +98,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.engine_api.CreateEnclaveArgs = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.CreateEnclaveArgs.repeatedFields_, null);
 };
 goog.inherits(proto.engine_api.CreateEnclaveArgs, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -277,6 +297,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.DestroyEnclaveArgs.displayName = 'proto.engine_api.DestroyEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveTeardownProgress = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.engine_api.EnclaveTeardownProgress.oneofGroups_);
+};
+goog.inherits(proto.engine_api.EnclaveTeardownProgress, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveTeardownProgress.displayName = 'proto.engine_api.EnclaveTeardownProgress';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveTeardownStep = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.EnclaveTeardownStep.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.EnclaveTeardownStep, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveTeardownStep.displayName = 'proto.engine_api.EnclaveTeardownStep';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclaveTeardownSummary = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.EnclaveTeardownSummary.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.EnclaveTeardownSummary, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveTeardownSummary.displayName = 'proto.engine_api.EnclaveTeardownSummary';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.LeakedEnclaveResource = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.LeakedEnclaveResource, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.LeakedEnclaveResource.displayName = 'proto.engine_api.LeakedEnclaveResource';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -340,6 +444,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.CleanResponse.displayName = 'proto.engine_api.CleanResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.PulledImage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.PulledImage.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.PulledImage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.PulledImage.displayName = 'proto.engine_api.PulledImage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetPulledImagesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.GetPulledImagesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.GetPulledImagesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetPulledImagesResponse.displayName = 'proto.engine_api.GetPulledImagesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.PruneImagesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.PruneImagesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.PruneImagesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.PruneImagesResponse.displayName = 'proto.engine_api.PruneImagesResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -424,97 +591,307 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetEngineInfoResponse.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveLifecycleEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.engine_api.EnclaveLifecycleEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveLifecycleEvent.displayName = 'proto.engine_api.EnclaveLifecycleEvent';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetEngineInfoResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.engine_api.EnclaveSchedule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.engine_api.EnclaveSchedule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveSchedule.displayName = 'proto.engine_api.EnclaveSchedule';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetEngineInfoResponse}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetEngineInfoResponse;
-  return proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader(msg, reader);
+proto.engine_api.EnclaveScheduleInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.EnclaveScheduleInfo.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.engine_api.EnclaveScheduleInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclaveScheduleInfo.displayName = 'proto.engine_api.EnclaveScheduleInfo';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.engine_api.GetEngineInfoResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetEngineInfoResponse}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEngineVersion(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.engine_api.AddEnclaveScheduleArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.engine_api.AddEnclaveScheduleArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.AddEnclaveScheduleArgs.displayName = 'proto.engine_api.AddEnclaveScheduleArgs';
+}
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.engine_api.GetEngineInfoResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
-};
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.GetEnclaveSchedulesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.engine_api.GetEnclaveSchedulesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.engine_api.GetEnclaveSchedulesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetEnclaveSchedulesResponse.displayName = 'proto.engine_api.GetEnclaveSchedulesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.RemoveEnclaveScheduleArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.RemoveEnclaveScheduleArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.RemoveEnclaveScheduleArgs.displayName = 'proto.engine_api.RemoveEnclaveScheduleArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CopyFilesArtifactArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.CopyFilesArtifactArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CopyFilesArtifactArgs.displayName = 'proto.engine_api.CopyFilesArtifactArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.CopyFilesArtifactResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.CopyFilesArtifactResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.CopyFilesArtifactResponse.displayName = 'proto.engine_api.CopyFilesArtifactResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.SetLogLevelArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.SetLogLevelArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.SetLogLevelArgs.displayName = 'proto.engine_api.SetLogLevelArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.SetLogLevelResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.SetLogLevelResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.SetLogLevelResponse.displayName = 'proto.engine_api.SetLogLevelResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEngineInfoResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetEngineInfoResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetEngineInfoResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetEngineInfoResponse}
+ */
+proto.engine_api.GetEngineInfoResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetEngineInfoResponse;
+  return proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetEngineInfoResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetEngineInfoResponse}
+ */
+proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEngineVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
 
 
 /**
@@ -555,6 +932,13 @@ proto.engine_api.GetEngineInfoResponse.prototype.setEngineVersion = function(val
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CreateEnclaveArgs.repeatedFields_ = [6];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -589,7 +973,13 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
     enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
     apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
     apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    isPartitioningEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    isPartitioningEnabled: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    ipRangeReusePolicy: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    excludedIpRangesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    subnet: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    filesArtifactsTtlSeconds: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    allowHostDirMounts: jspb.Message.getBooleanFieldWithDefault(msg, 9, false),
+    isIpv6Enabled: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
   };
 
   if (includeInstance) {
@@ -642,6 +1032,30 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setIsPartitioningEnabled(value);
       break;
+    case 5:
+      var value = /** @type {!proto.engine_api.EnclaveIpRangeReusePolicy} */ (reader.readEnum());
+      msg.setIpRangeReusePolicy(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addExcludedIpRanges(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSubnet(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setFilesArtifactsTtlSeconds(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllowHostDirMounts(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsIpv6Enabled(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -699,6 +1113,48 @@ proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getIpRangeReusePolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getExcludedIpRangesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getSubnet();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getFilesArtifactsTtlSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+  f = message.getAllowHostDirMounts();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+  f = message.getIsIpv6Enabled();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
 };
 
 
@@ -774,9 +1230,136 @@ proto.engine_api.CreateEnclaveArgs.prototype.setIsPartitioningEnabled = function
 };
 
 
+/**
+ * optional EnclaveIpRangeReusePolicy ip_range_reuse_policy = 5;
+ * @return {!proto.engine_api.EnclaveIpRangeReusePolicy}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getIpRangeReusePolicy = function() {
+  return /** @type {!proto.engine_api.EnclaveIpRangeReusePolicy} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveIpRangeReusePolicy} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIpRangeReusePolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * repeated string excluded_ip_ranges = 6;
+ * @return {!Array<string>}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getExcludedIpRangesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setExcludedIpRangesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.addExcludedIpRanges = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearExcludedIpRangesList = function() {
+  return this.setExcludedIpRangesList([]);
+};
+
+
+/**
+ * optional string subnet = 7;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getSubnet = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setSubnet = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint32 files_artifacts_ttl_seconds = 8;
+ * @return {number}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getFilesArtifactsTtlSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setFilesArtifactsTtlSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional bool allow_host_dir_mounts = 9;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getAllowHostDirMounts = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setAllowHostDirMounts = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+/**
+ * optional bool is_ipv6_enabled = 10;
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getIsIpv6Enabled = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setIsIpv6Enabled = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
+};
+
+
+
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -1374,7 +1957,9 @@ proto.engine_api.EnclaveInfo.toObject = function(includeInstance, msg) {
     apiContainerStatus: jspb.Message.getFieldWithDefault(msg, 5, 0),
     apiContainerInfo: (f = msg.getApiContainerInfo()) && proto.engine_api.EnclaveAPIContainerInfo.toObject(includeInstance, f),
     apiContainerHostMachineInfo: (f = msg.getApiContainerHostMachineInfo()) && proto.engine_api.EnclaveAPIContainerHostMachineInfo.toObject(includeInstance, f),
-    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    ipRange: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    scheduleName: jspb.Message.getFieldWithDefault(msg, 10, "")
   };
 
   if (includeInstance) {
@@ -1446,6 +2031,14 @@ proto.engine_api.EnclaveInfo.deserializeBinaryFromReader = function(msg, reader)
       reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
       msg.setCreationTime(value);
       break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIpRange(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setScheduleName(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1534,6 +2127,20 @@ proto.engine_api.EnclaveInfo.serializeBinaryToWriter = function(message, writer)
       google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
+  f = message.getIpRange();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getScheduleName();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
 };
 
 
@@ -1738,6 +2345,42 @@ proto.engine_api.EnclaveInfo.prototype.hasCreationTime = function() {
 };
 
 
+/**
+ * optional string ip_range = 9;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getIpRange = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setIpRange = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string schedule_name = 10;
+ * @return {string}
+ */
+proto.engine_api.EnclaveInfo.prototype.getScheduleName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveInfo} returns this
+ */
+proto.engine_api.EnclaveInfo.prototype.setScheduleName = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
 
 
 
@@ -2482,6 +3125,32 @@ proto.engine_api.DestroyEnclaveArgs.prototype.setEnclaveIdentifier = function(va
 
 
 
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.engine_api.EnclaveTeardownProgress.oneofGroups_ = [[1,2]];
+
+/**
+ * @enum {number}
+ */
+proto.engine_api.EnclaveTeardownProgress.ProgressCase = {
+  PROGRESS_NOT_SET: 0,
+  STEP: 1,
+  SUMMARY: 2
+};
+
+/**
+ * @return {proto.engine_api.EnclaveTeardownProgress.ProgressCase}
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.getProgressCase = function() {
+  return /** @type {proto.engine_api.EnclaveTeardownProgress.ProgressCase} */(jspb.Message.computeOneofCase(this, proto.engine_api.EnclaveTeardownProgress.oneofGroups_[0]));
+};
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2497,8 +3166,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+proto.engine_api.EnclaveTeardownProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveTeardownProgress.toObject(opt_includeInstance, this);
 };
 
 
@@ -2507,13 +3176,14 @@ proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclaveTeardownProgress} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclaveTeardownProgress.toObject = function(includeInstance, msg) {
   var f, obj = {
-    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+    step: (f = msg.getStep()) && proto.engine_api.EnclaveTeardownStep.toObject(includeInstance, f),
+    summary: (f = msg.getSummary()) && proto.engine_api.EnclaveTeardownSummary.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2527,23 +3197,23 @@ proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.EnclaveTeardownProgress}
  */
-proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+proto.engine_api.EnclaveTeardownProgress.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanArgs;
-  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclaveTeardownProgress;
+  return proto.engine_api.EnclaveTeardownProgress.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclaveTeardownProgress} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanArgs}
+ * @return {!proto.engine_api.EnclaveTeardownProgress}
  */
-proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclaveTeardownProgress.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2551,8 +3221,3555 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setShouldCleanAll(value);
+      var value = new proto.engine_api.EnclaveTeardownStep;
+      reader.readMessage(value,proto.engine_api.EnclaveTeardownStep.deserializeBinaryFromReader);
+      msg.setStep(value);
+      break;
+    case 2:
+      var value = new proto.engine_api.EnclaveTeardownSummary;
+      reader.readMessage(value,proto.engine_api.EnclaveTeardownSummary.deserializeBinaryFromReader);
+      msg.setSummary(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveTeardownProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveTeardownProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveTeardownProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStep();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveTeardownStep.serializeBinaryToWriter
+    );
+  }
+  f = message.getSummary();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.engine_api.EnclaveTeardownSummary.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveTeardownStep step = 1;
+ * @return {?proto.engine_api.EnclaveTeardownStep}
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.getStep = function() {
+  return /** @type{?proto.engine_api.EnclaveTeardownStep} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveTeardownStep, 1));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveTeardownStep|undefined} value
+ * @return {!proto.engine_api.EnclaveTeardownProgress} returns this
+*/
+proto.engine_api.EnclaveTeardownProgress.prototype.setStep = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.engine_api.EnclaveTeardownProgress.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveTeardownProgress} returns this
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.clearStep = function() {
+  return this.setStep(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.hasStep = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional EnclaveTeardownSummary summary = 2;
+ * @return {?proto.engine_api.EnclaveTeardownSummary}
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.getSummary = function() {
+  return /** @type{?proto.engine_api.EnclaveTeardownSummary} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveTeardownSummary, 2));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveTeardownSummary|undefined} value
+ * @return {!proto.engine_api.EnclaveTeardownProgress} returns this
+*/
+proto.engine_api.EnclaveTeardownProgress.prototype.setSummary = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.engine_api.EnclaveTeardownProgress.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveTeardownProgress} returns this
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.clearSummary = function() {
+  return this.setSummary(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveTeardownProgress.prototype.hasSummary = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.EnclaveTeardownStep.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveTeardownStep.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveTeardownStep} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveTeardownStep.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveTeardownStep}
+ */
+proto.engine_api.EnclaveTeardownStep.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveTeardownStep;
+  return proto.engine_api.EnclaveTeardownStep.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveTeardownStep} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveTeardownStep}
+ */
+proto.engine_api.EnclaveTeardownStep.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCurrentStepInfo(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveTeardownStep.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveTeardownStep} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveTeardownStep.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCurrentStepInfoList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.EnclaveTeardownStep} returns this
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveTeardownStep} returns this
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.EnclaveTeardownStep} returns this
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
+};
+
+
+/**
+ * optional uint32 total_steps = 2;
+ * @return {number}
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveTeardownStep} returns this
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 current_step_number = 3;
+ * @return {number}
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveTeardownStep} returns this
+ */
+proto.engine_api.EnclaveTeardownStep.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.EnclaveTeardownSummary.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveTeardownSummary.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveTeardownSummary.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveTeardownSummary} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveTeardownSummary.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    leakedResourcesList: jspb.Message.toObjectList(msg.getLeakedResourcesList(),
+    proto.engine_api.LeakedEnclaveResource.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveTeardownSummary}
+ */
+proto.engine_api.EnclaveTeardownSummary.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveTeardownSummary;
+  return proto.engine_api.EnclaveTeardownSummary.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveTeardownSummary} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveTeardownSummary}
+ */
+proto.engine_api.EnclaveTeardownSummary.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.LeakedEnclaveResource;
+      reader.readMessage(value,proto.engine_api.LeakedEnclaveResource.deserializeBinaryFromReader);
+      msg.addLeakedResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveTeardownSummary.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveTeardownSummary.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveTeardownSummary} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveTeardownSummary.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLeakedResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.LeakedEnclaveResource.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated LeakedEnclaveResource leaked_resources = 1;
+ * @return {!Array<!proto.engine_api.LeakedEnclaveResource>}
+ */
+proto.engine_api.EnclaveTeardownSummary.prototype.getLeakedResourcesList = function() {
+  return /** @type{!Array<!proto.engine_api.LeakedEnclaveResource>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LeakedEnclaveResource, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.LeakedEnclaveResource>} value
+ * @return {!proto.engine_api.EnclaveTeardownSummary} returns this
+*/
+proto.engine_api.EnclaveTeardownSummary.prototype.setLeakedResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LeakedEnclaveResource=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LeakedEnclaveResource}
+ */
+proto.engine_api.EnclaveTeardownSummary.prototype.addLeakedResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.LeakedEnclaveResource, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.EnclaveTeardownSummary} returns this
+ */
+proto.engine_api.EnclaveTeardownSummary.prototype.clearLeakedResourcesList = function() {
+  return this.setLeakedResourcesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LeakedEnclaveResource.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LeakedEnclaveResource} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LeakedEnclaveResource.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourceType: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    resourceName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    error: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LeakedEnclaveResource}
+ */
+proto.engine_api.LeakedEnclaveResource.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LeakedEnclaveResource;
+  return proto.engine_api.LeakedEnclaveResource.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LeakedEnclaveResource} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LeakedEnclaveResource}
+ */
+proto.engine_api.LeakedEnclaveResource.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResourceType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResourceName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LeakedEnclaveResource.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LeakedEnclaveResource} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LeakedEnclaveResource.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourceType();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getResourceName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string resource_type = 1;
+ * @return {string}
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.getResourceType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LeakedEnclaveResource} returns this
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.setResourceType = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string resource_name = 2;
+ * @return {string}
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.getResourceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LeakedEnclaveResource} returns this
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.setResourceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string error = 3;
+ * @return {string}
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LeakedEnclaveResource} returns this
+ */
+proto.engine_api.LeakedEnclaveResource.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    shouldCleanAll: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanArgs;
+  return proto.engine_api.CleanArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanArgs}
+ */
+proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldCleanAll(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getShouldCleanAll();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool should_clean_all = 1;
+ * @return {boolean}
+ */
+proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.CleanArgs} returns this
+ */
+proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveNameAndUuid;
+  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string uuid = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ */
+proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.CleanResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
+    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CleanResponse;
+  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CleanResponse}
+ */
+proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveNameAndUuid;
+      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
+      msg.addRemovedEnclaveNameAndUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CleanResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedEnclaveNameAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
+ * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ */
+proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
+ * @return {!proto.engine_api.CleanResponse} returns this
+*/
+proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveNameAndUuid}
+ */
+proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.CleanResponse} returns this
+ */
+proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
+  return this.setRemovedEnclaveNameAndUuidsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.PulledImage.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.PulledImage.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.PulledImage.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.PulledImage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.PulledImage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    image: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sizeBytes: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    enclaveUuidsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.PulledImage}
+ */
+proto.engine_api.PulledImage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.PulledImage;
+  return proto.engine_api.PulledImage.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.PulledImage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.PulledImage}
+ */
+proto.engine_api.PulledImage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImage(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSizeBytes(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEnclaveUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.PulledImage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.PulledImage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.PulledImage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.PulledImage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getImage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSizeBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getEnclaveUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string image = 1;
+ * @return {string}
+ */
+proto.engine_api.PulledImage.prototype.getImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.PulledImage} returns this
+ */
+proto.engine_api.PulledImage.prototype.setImage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 size_bytes = 2;
+ * @return {number}
+ */
+proto.engine_api.PulledImage.prototype.getSizeBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.PulledImage} returns this
+ */
+proto.engine_api.PulledImage.prototype.setSizeBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated string enclave_uuids = 3;
+ * @return {!Array<string>}
+ */
+proto.engine_api.PulledImage.prototype.getEnclaveUuidsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.PulledImage} returns this
+ */
+proto.engine_api.PulledImage.prototype.setEnclaveUuidsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.PulledImage} returns this
+ */
+proto.engine_api.PulledImage.prototype.addEnclaveUuids = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.PulledImage} returns this
+ */
+proto.engine_api.PulledImage.prototype.clearEnclaveUuidsList = function() {
+  return this.setEnclaveUuidsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetPulledImagesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetPulledImagesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetPulledImagesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetPulledImagesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetPulledImagesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pulledImagesList: jspb.Message.toObjectList(msg.getPulledImagesList(),
+    proto.engine_api.PulledImage.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetPulledImagesResponse}
+ */
+proto.engine_api.GetPulledImagesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetPulledImagesResponse;
+  return proto.engine_api.GetPulledImagesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetPulledImagesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetPulledImagesResponse}
+ */
+proto.engine_api.GetPulledImagesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.PulledImage;
+      reader.readMessage(value,proto.engine_api.PulledImage.deserializeBinaryFromReader);
+      msg.addPulledImages(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetPulledImagesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetPulledImagesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetPulledImagesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetPulledImagesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPulledImagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.PulledImage.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated PulledImage pulled_images = 1;
+ * @return {!Array<!proto.engine_api.PulledImage>}
+ */
+proto.engine_api.GetPulledImagesResponse.prototype.getPulledImagesList = function() {
+  return /** @type{!Array<!proto.engine_api.PulledImage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.PulledImage, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.PulledImage>} value
+ * @return {!proto.engine_api.GetPulledImagesResponse} returns this
+*/
+proto.engine_api.GetPulledImagesResponse.prototype.setPulledImagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.PulledImage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.PulledImage}
+ */
+proto.engine_api.GetPulledImagesResponse.prototype.addPulledImages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.PulledImage, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetPulledImagesResponse} returns this
+ */
+proto.engine_api.GetPulledImagesResponse.prototype.clearPulledImagesList = function() {
+  return this.setPulledImagesList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.PruneImagesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.PruneImagesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.PruneImagesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.PruneImagesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.PruneImagesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    removedImagesList: jspb.Message.toObjectList(msg.getRemovedImagesList(),
+    proto.engine_api.PulledImage.toObject, includeInstance),
+    removalErrorsByImageMap: (f = msg.getRemovalErrorsByImageMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.PruneImagesResponse}
+ */
+proto.engine_api.PruneImagesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.PruneImagesResponse;
+  return proto.engine_api.PruneImagesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.PruneImagesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.PruneImagesResponse}
+ */
+proto.engine_api.PruneImagesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.PulledImage;
+      reader.readMessage(value,proto.engine_api.PulledImage.deserializeBinaryFromReader);
+      msg.addRemovedImages(value);
+      break;
+    case 2:
+      var value = msg.getRemovalErrorsByImageMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.PruneImagesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.PruneImagesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.PruneImagesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.PruneImagesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRemovedImagesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.engine_api.PulledImage.serializeBinaryToWriter
+    );
+  }
+  f = message.getRemovalErrorsByImageMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * repeated PulledImage removed_images = 1;
+ * @return {!Array<!proto.engine_api.PulledImage>}
+ */
+proto.engine_api.PruneImagesResponse.prototype.getRemovedImagesList = function() {
+  return /** @type{!Array<!proto.engine_api.PulledImage>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.PulledImage, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.PulledImage>} value
+ * @return {!proto.engine_api.PruneImagesResponse} returns this
+*/
+proto.engine_api.PruneImagesResponse.prototype.setRemovedImagesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.PulledImage=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.PulledImage}
+ */
+proto.engine_api.PruneImagesResponse.prototype.addRemovedImages = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.PulledImage, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.PruneImagesResponse} returns this
+ */
+proto.engine_api.PruneImagesResponse.prototype.clearRemovedImagesList = function() {
+  return this.setRemovedImagesList([]);
+};
+
+
+/**
+ * map<string, string> removal_errors_by_image = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.PruneImagesResponse.prototype.getRemovalErrorsByImageMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.PruneImagesResponse} returns this
+ */
+proto.engine_api.PruneImagesResponse.prototype.clearRemovalErrorsByImageMap = function() {
+  this.getRemovalErrorsByImageMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
+    proto.engine_api.LogLineFilter.toObject, includeInstance),
+    since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    until: (f = msg.getUntil()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsArgs;
+  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsArgs}
+ */
+proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    case 2:
+      var value = msg.getServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollowLogs(value);
+      break;
+    case 4:
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUntil(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getFollowLogs();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getConjunctiveFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+    );
+  }
+  f = message.getSince();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getUntil();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * map<string, bool> service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
+  this.getServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * optional bool follow_logs = 3;
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * repeated LogLineFilter conjunctive_filters = 4;
+ * @return {!Array<!proto.engine_api.LogLineFilter>}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
+  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.engine_api.LogLineFilter>} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
+  return this.setConjunctiveFiltersList([]);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp since = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearSince = function() {
+  return this.setSince(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp until = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getUntil = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setUntil = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearUntil = function() {
+  return this.setUntil(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasUntil = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
+    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
+    numDroppedLogLinesByServiceUuidMap: (f = msg.getNumDroppedLogLinesByServiceUuidMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetServiceLogsResponse;
+  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetServiceLogsResponse}
+ */
+proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceLogsByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
+         });
+      break;
+    case 2:
+      var value = msg.getNotFoundServiceUuidSetMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    case 3:
+      var value = msg.getNumDroppedLogLinesByServiceUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readUint32, null, "", 0);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceLogsByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  }
+  f = message.getNotFoundServiceUuidSetMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+  f = message.getNumDroppedLogLinesByServiceUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeUint32);
+  }
+};
+
+
+/**
+ * map<string, LogLine> service_logs_by_service_uuid = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.engine_api.LogLine));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
+  this.getServiceLogsByServiceUuidMap().clear();
+  return this;};
+
+
+/**
+ * map<string, bool> not_found_service_uuid_set = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
+  this.getNotFoundServiceUuidSetMap().clear();
+  return this;};
+
+
+/**
+ * map<string, uint32> num_dropped_log_lines_by_service_uuid = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.getNumDroppedLogLinesByServiceUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ */
+proto.engine_api.GetServiceLogsResponse.prototype.clearNumDroppedLogLinesByServiceUuidMap = function() {
+  this.getNumDroppedLogLinesByServiceUuidMap().clear();
+  return this;};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.LogLine.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLine}
+ */
+proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLine;
+  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLine}
+ */
+proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLine(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LogLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLineList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string line = 1;
+ * @return {!Array<string>}
+ */
+proto.engine_api.LogLine.prototype.getLineList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.setLineList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearLineList = function() {
+  return this.setLineList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.LogLineFilter;
+  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.LogLineFilter}
+ */
+proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
+      msg.setOperator(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPattern(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getTextPattern();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional LogLineOperator operator = 1;
+ * @return {!proto.engine_api.LogLineOperator}
+ */
+proto.engine_api.LogLineFilter.prototype.getOperator = function() {
+  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.LogLineOperator} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string text_pattern = 2;
+ * @return {string}
+ */
+proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.LogLineFilter} returns this
+ */
+proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveLifecycleEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveLifecycleEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveLifecycleEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventType: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    enclaveUuid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    timestamp: (f = msg.getTimestamp()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    serviceName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveLifecycleEvent}
+ */
+proto.engine_api.EnclaveLifecycleEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveLifecycleEvent;
+  return proto.engine_api.EnclaveLifecycleEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveLifecycleEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveLifecycleEvent}
+ */
+proto.engine_api.EnclaveLifecycleEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.engine_api.EnclaveLifecycleEventType} */ (reader.readEnum());
+      msg.setEventType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveUuid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setTimestamp(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceName(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveLifecycleEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveLifecycleEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveLifecycleEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getEnclaveUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEnclaveName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getServiceName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveLifecycleEventType event_type = 1;
+ * @return {!proto.engine_api.EnclaveLifecycleEventType}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.getEventType = function() {
+  return /** @type {!proto.engine_api.EnclaveLifecycleEventType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveLifecycleEventType} value
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.setEventType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string enclave_uuid = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.getEnclaveUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.setEnclaveUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string enclave_name = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp timestamp = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.getTimestamp = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+*/
+proto.engine_api.EnclaveLifecycleEvent.prototype.setTimestamp = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.clearTimestamp = function() {
+  return this.setTimestamp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.hasTimestamp = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string service_name = 5;
+ * @return {string}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.setServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string service_uuid = 6;
+ * @return {string}
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveLifecycleEvent} returns this
+ */
+proto.engine_api.EnclaveLifecycleEvent.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveSchedule.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveSchedule.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveSchedule} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveSchedule.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cronExpression: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    packageId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    retention: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveSchedule}
+ */
+proto.engine_api.EnclaveSchedule.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveSchedule;
+  return proto.engine_api.EnclaveSchedule.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveSchedule} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveSchedule}
+ */
+proto.engine_api.EnclaveSchedule.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCronExpression(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetention(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveSchedule.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveSchedule.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveSchedule} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveSchedule.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCronExpression();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getRetention();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.engine_api.EnclaveSchedule.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveSchedule} returns this
+ */
+proto.engine_api.EnclaveSchedule.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string cron_expression = 2;
+ * @return {string}
+ */
+proto.engine_api.EnclaveSchedule.prototype.getCronExpression = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveSchedule} returns this
+ */
+proto.engine_api.EnclaveSchedule.prototype.setCronExpression = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string package_id = 3;
+ * @return {string}
+ */
+proto.engine_api.EnclaveSchedule.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveSchedule} returns this
+ */
+proto.engine_api.EnclaveSchedule.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string serialized_params = 4;
+ * @return {string}
+ */
+proto.engine_api.EnclaveSchedule.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveSchedule} returns this
+ */
+proto.engine_api.EnclaveSchedule.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 retention = 5;
+ * @return {number}
+ */
+proto.engine_api.EnclaveSchedule.prototype.getRetention = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.EnclaveSchedule} returns this
+ */
+proto.engine_api.EnclaveSchedule.prototype.setRetention = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.EnclaveScheduleInfo.repeatedFields_ = [5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclaveScheduleInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.EnclaveScheduleInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveScheduleInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    schedule: (f = msg.getSchedule()) && proto.engine_api.EnclaveSchedule.toObject(includeInstance, f),
+    nextRunTime: (f = msg.getNextRunTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastRunTime: (f = msg.getLastRunTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    lastRunError: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    enclaveNamesList: (f = jspb.Message.getRepeatedField(msg, 5)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.EnclaveScheduleInfo}
+ */
+proto.engine_api.EnclaveScheduleInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.EnclaveScheduleInfo;
+  return proto.engine_api.EnclaveScheduleInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.EnclaveScheduleInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.EnclaveScheduleInfo}
+ */
+proto.engine_api.EnclaveScheduleInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveSchedule;
+      reader.readMessage(value,proto.engine_api.EnclaveSchedule.deserializeBinaryFromReader);
+      msg.setSchedule(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setNextRunTime(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastRunTime(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastRunError(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addEnclaveNames(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.EnclaveScheduleInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.EnclaveScheduleInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.EnclaveScheduleInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSchedule();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveSchedule.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextRunTime();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastRunTime();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastRunError();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getEnclaveNamesList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveSchedule schedule = 1;
+ * @return {?proto.engine_api.EnclaveSchedule}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.getSchedule = function() {
+  return /** @type{?proto.engine_api.EnclaveSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveSchedule, 1));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclaveSchedule|undefined} value
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+*/
+proto.engine_api.EnclaveScheduleInfo.prototype.setSchedule = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.clearSchedule = function() {
+  return this.setSchedule(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.hasSchedule = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp next_run_time = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.getNextRunTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+*/
+proto.engine_api.EnclaveScheduleInfo.prototype.setNextRunTime = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.clearNextRunTime = function() {
+  return this.setNextRunTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.hasNextRunTime = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_run_time = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.getLastRunTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+*/
+proto.engine_api.EnclaveScheduleInfo.prototype.setLastRunTime = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.clearLastRunTime = function() {
+  return this.setLastRunTime(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.hasLastRunTime = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string last_run_error = 4;
+ * @return {string}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.getLastRunError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.setLastRunError = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * repeated string enclave_names = 5;
+ * @return {!Array<string>}
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.getEnclaveNamesList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 5));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.setEnclaveNamesList = function(value) {
+  return jspb.Message.setField(this, 5, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.addEnclaveNames = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 5, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.EnclaveScheduleInfo} returns this
+ */
+proto.engine_api.EnclaveScheduleInfo.prototype.clearEnclaveNamesList = function() {
+  return this.setEnclaveNamesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.AddEnclaveScheduleArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.AddEnclaveScheduleArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.AddEnclaveScheduleArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.AddEnclaveScheduleArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    schedule: (f = msg.getSchedule()) && proto.engine_api.EnclaveSchedule.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.AddEnclaveScheduleArgs}
+ */
+proto.engine_api.AddEnclaveScheduleArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.AddEnclaveScheduleArgs;
+  return proto.engine_api.AddEnclaveScheduleArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.AddEnclaveScheduleArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.AddEnclaveScheduleArgs}
+ */
+proto.engine_api.AddEnclaveScheduleArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveSchedule;
+      reader.readMessage(value,proto.engine_api.EnclaveSchedule.deserializeBinaryFromReader);
+      msg.setSchedule(value);
       break;
     default:
       reader.skipField();
@@ -2567,9 +6784,9 @@ proto.engine_api.CleanArgs.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
+proto.engine_api.AddEnclaveScheduleArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.AddEnclaveScheduleArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2577,41 +6794,68 @@ proto.engine_api.CleanArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanArgs} message
+ * @param {!proto.engine_api.AddEnclaveScheduleArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.AddEnclaveScheduleArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getShouldCleanAll();
-  if (f) {
-    writer.writeBool(
+  f = message.getSchedule();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.engine_api.EnclaveSchedule.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional bool should_clean_all = 1;
- * @return {boolean}
+ * optional EnclaveSchedule schedule = 1;
+ * @return {?proto.engine_api.EnclaveSchedule}
  */
-proto.engine_api.CleanArgs.prototype.getShouldCleanAll = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.engine_api.AddEnclaveScheduleArgs.prototype.getSchedule = function() {
+  return /** @type{?proto.engine_api.EnclaveSchedule} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveSchedule, 1));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.CleanArgs} returns this
+ * @param {?proto.engine_api.EnclaveSchedule|undefined} value
+ * @return {!proto.engine_api.AddEnclaveScheduleArgs} returns this
+*/
+proto.engine_api.AddEnclaveScheduleArgs.prototype.setSchedule = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.AddEnclaveScheduleArgs} returns this
  */
-proto.engine_api.CleanArgs.prototype.setShouldCleanAll = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.engine_api.AddEnclaveScheduleArgs.prototype.clearSchedule = function() {
+  return this.setSchedule(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.AddEnclaveScheduleArgs.prototype.hasSchedule = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.engine_api.GetEnclaveSchedulesResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2627,8 +6871,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.EnclaveNameAndUuid.toObject(opt_includeInstance, this);
+proto.engine_api.GetEnclaveSchedulesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetEnclaveSchedulesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2637,14 +6881,14 @@ proto.engine_api.EnclaveNameAndUuid.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.engine_api.GetEnclaveSchedulesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
+proto.engine_api.GetEnclaveSchedulesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    uuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    schedulesList: jspb.Message.toObjectList(msg.getSchedulesList(),
+    proto.engine_api.EnclaveScheduleInfo.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -2658,23 +6902,23 @@ proto.engine_api.EnclaveNameAndUuid.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.GetEnclaveSchedulesResponse}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinary = function(bytes) {
+proto.engine_api.GetEnclaveSchedulesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.EnclaveNameAndUuid;
-  return proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.GetEnclaveSchedulesResponse;
+  return proto.engine_api.GetEnclaveSchedulesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.EnclaveNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.engine_api.GetEnclaveSchedulesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * @return {!proto.engine_api.GetEnclaveSchedulesResponse}
  */
-proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.GetEnclaveSchedulesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2682,12 +6926,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      var value = new proto.engine_api.EnclaveScheduleInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveScheduleInfo.deserializeBinaryFromReader);
+      msg.addSchedules(value);
       break;
     default:
       reader.skipField();
@@ -2702,9 +6943,9 @@ proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
+proto.engine_api.GetEnclaveSchedulesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.engine_api.GetEnclaveSchedulesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2712,73 +6953,62 @@ proto.engine_api.EnclaveNameAndUuid.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.EnclaveNameAndUuid} message
+ * @param {!proto.engine_api.GetEnclaveSchedulesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.GetEnclaveSchedulesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getName();
+  f = message.getSchedulesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
+      f,
+      proto.engine_api.EnclaveScheduleInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * repeated EnclaveScheduleInfo schedules = 1;
+ * @return {!Array<!proto.engine_api.EnclaveScheduleInfo>}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.GetEnclaveSchedulesResponse.prototype.getSchedulesList = function() {
+  return /** @type{!Array<!proto.engine_api.EnclaveScheduleInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveScheduleInfo, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
- */
-proto.engine_api.EnclaveNameAndUuid.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {!Array<!proto.engine_api.EnclaveScheduleInfo>} value
+ * @return {!proto.engine_api.GetEnclaveSchedulesResponse} returns this
+*/
+proto.engine_api.GetEnclaveSchedulesResponse.prototype.setSchedulesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string uuid = 2;
- * @return {string}
+ * @param {!proto.engine_api.EnclaveScheduleInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.engine_api.EnclaveScheduleInfo}
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.GetEnclaveSchedulesResponse.prototype.addSchedules = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveScheduleInfo, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.EnclaveNameAndUuid} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.GetEnclaveSchedulesResponse} returns this
  */
-proto.engine_api.EnclaveNameAndUuid.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.GetEnclaveSchedulesResponse.prototype.clearSchedulesList = function() {
+  return this.setSchedulesList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.CleanResponse.repeatedFields_ = [1];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2794,8 +7024,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CleanResponse.toObject(opt_includeInstance, this);
+proto.engine_api.RemoveEnclaveScheduleArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.RemoveEnclaveScheduleArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2804,14 +7034,14 @@ proto.engine_api.CleanResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CleanResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.RemoveEnclaveScheduleArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.RemoveEnclaveScheduleArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    removedEnclaveNameAndUuidsList: jspb.Message.toObjectList(msg.getRemovedEnclaveNameAndUuidsList(),
-    proto.engine_api.EnclaveNameAndUuid.toObject, includeInstance)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    shouldDestroyEnclaves: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
   };
 
   if (includeInstance) {
@@ -2825,23 +7055,23 @@ proto.engine_api.CleanResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.RemoveEnclaveScheduleArgs}
  */
-proto.engine_api.CleanResponse.deserializeBinary = function(bytes) {
+proto.engine_api.RemoveEnclaveScheduleArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CleanResponse;
-  return proto.engine_api.CleanResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.RemoveEnclaveScheduleArgs;
+  return proto.engine_api.RemoveEnclaveScheduleArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CleanResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.RemoveEnclaveScheduleArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CleanResponse}
+ * @return {!proto.engine_api.RemoveEnclaveScheduleArgs}
  */
-proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.RemoveEnclaveScheduleArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2849,9 +7079,12 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveNameAndUuid;
-      reader.readMessage(value,proto.engine_api.EnclaveNameAndUuid.deserializeBinaryFromReader);
-      msg.addRemovedEnclaveNameAndUuids(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setShouldDestroyEnclaves(value);
       break;
     default:
       reader.skipField();
@@ -2866,9 +7099,9 @@ proto.engine_api.CleanResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
+proto.engine_api.RemoveEnclaveScheduleArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CleanResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.RemoveEnclaveScheduleArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2876,69 +7109,66 @@ proto.engine_api.CleanResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CleanResponse} message
+ * @param {!proto.engine_api.RemoveEnclaveScheduleArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CleanResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.RemoveEnclaveScheduleArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getRemovedEnclaveNameAndUuidsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getShouldDestroyEnclaves();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * repeated EnclaveNameAndUuid removed_enclave_name_and_uuids = 1;
- * @return {!Array<!proto.engine_api.EnclaveNameAndUuid>}
+ * optional string name = 1;
+ * @return {string}
  */
-proto.engine_api.CleanResponse.prototype.getRemovedEnclaveNameAndUuidsList = function() {
-  return /** @type{!Array<!proto.engine_api.EnclaveNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.EnclaveNameAndUuid, 1));
+proto.engine_api.RemoveEnclaveScheduleArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.EnclaveNameAndUuid>} value
- * @return {!proto.engine_api.CleanResponse} returns this
-*/
-proto.engine_api.CleanResponse.prototype.setRemovedEnclaveNameAndUuidsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.engine_api.RemoveEnclaveScheduleArgs} returns this
+ */
+proto.engine_api.RemoveEnclaveScheduleArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveNameAndUuid=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.EnclaveNameAndUuid}
+ * optional bool should_destroy_enclaves = 2;
+ * @return {boolean}
  */
-proto.engine_api.CleanResponse.prototype.addRemovedEnclaveNameAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.engine_api.EnclaveNameAndUuid, opt_index);
+proto.engine_api.RemoveEnclaveScheduleArgs.prototype.getShouldDestroyEnclaves = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.CleanResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.engine_api.RemoveEnclaveScheduleArgs} returns this
  */
-proto.engine_api.CleanResponse.prototype.clearRemovedEnclaveNameAndUuidsList = function() {
-  return this.setRemovedEnclaveNameAndUuidsList([]);
+proto.engine_api.RemoveEnclaveScheduleArgs.prototype.setShouldDestroyEnclaves = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.engine_api.GetServiceLogsArgs.repeatedFields_ = [4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2954,8 +7184,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsArgs.toObject(opt_includeInstance, this);
+proto.engine_api.CopyFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CopyFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -2964,17 +7194,16 @@ proto.engine_api.GetServiceLogsArgs.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.CopyFilesArtifactArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.CopyFilesArtifactArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
-    followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance)
+    sourceEnclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    destinationEnclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    filesArtifactIdentifier: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    destinationFilesArtifactName: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -2988,23 +7217,23 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.CopyFilesArtifactArgs}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinary = function(bytes) {
+proto.engine_api.CopyFilesArtifactArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsArgs;
-  return proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CopyFilesArtifactArgs;
+  return proto.engine_api.CopyFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CopyFilesArtifactArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsArgs}
+ * @return {!proto.engine_api.CopyFilesArtifactArgs}
  */
-proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CopyFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3013,22 +7242,19 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveIdentifier(value);
+      msg.setSourceEnclaveIdentifier(value);
       break;
     case 2:
-      var value = msg.getServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDestinationEnclaveIdentifier(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setFollowLogs(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilesArtifactIdentifier(value);
       break;
     case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDestinationFilesArtifactName(value);
       break;
     default:
       reader.skipField();
@@ -3043,9 +7269,9 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
+proto.engine_api.CopyFilesArtifactArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CopyFilesArtifactArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3053,134 +7279,112 @@ proto.engine_api.GetServiceLogsArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsArgs} message
+ * @param {!proto.engine_api.CopyFilesArtifactArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CopyFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveIdentifier();
+  f = message.getSourceEnclaveIdentifier();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getDestinationEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
   }
-  f = message.getFollowLogs();
-  if (f) {
-    writer.writeBool(
+  f = message.getFilesArtifactIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getConjunctiveFiltersList();
+  f = message.getDestinationFilesArtifactName();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       4,
-      f,
-      proto.engine_api.LogLineFilter.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional string enclave_identifier = 1;
+ * optional string source_enclave_identifier = 1;
  * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getEnclaveIdentifier = function() {
+proto.engine_api.CopyFilesArtifactArgs.prototype.getSourceEnclaveIdentifier = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @return {!proto.engine_api.CopyFilesArtifactArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setEnclaveIdentifier = function(value) {
+proto.engine_api.CopyFilesArtifactArgs.prototype.setSourceEnclaveIdentifier = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * map<string, bool> service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
- */
-proto.engine_api.GetServiceLogsArgs.prototype.getServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
- */
-proto.engine_api.GetServiceLogsArgs.prototype.clearServiceUuidSetMap = function() {
-  this.getServiceUuidSetMap().clear();
-  return this;};
-
-
-/**
- * optional bool follow_logs = 3;
- * @return {boolean}
+ * optional string destination_enclave_identifier = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getFollowLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.engine_api.CopyFilesArtifactArgs.prototype.getDestinationEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CopyFilesArtifactArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.setFollowLogs = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.engine_api.CopyFilesArtifactArgs.prototype.setDestinationEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * repeated LogLineFilter conjunctive_filters = 4;
- * @return {!Array<!proto.engine_api.LogLineFilter>}
+ * optional string files_artifact_identifier = 3;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.getConjunctiveFiltersList = function() {
-  return /** @type{!Array<!proto.engine_api.LogLineFilter>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.engine_api.LogLineFilter, 4));
+proto.engine_api.CopyFilesArtifactArgs.prototype.getFilesArtifactIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {!Array<!proto.engine_api.LogLineFilter>} value
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
-*/
-proto.engine_api.GetServiceLogsArgs.prototype.setConjunctiveFiltersList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * @param {string} value
+ * @return {!proto.engine_api.CopyFilesArtifactArgs} returns this
+ */
+proto.engine_api.CopyFilesArtifactArgs.prototype.setFilesArtifactIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * @param {!proto.engine_api.LogLineFilter=} opt_value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLineFilter}
+ * optional string destination_files_artifact_name = 4;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsArgs.prototype.addConjunctiveFilters = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.engine_api.LogLineFilter, opt_index);
+proto.engine_api.CopyFilesArtifactArgs.prototype.getDestinationFilesArtifactName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CopyFilesArtifactArgs} returns this
  */
-proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = function() {
-  return this.setConjunctiveFiltersList([]);
+proto.engine_api.CopyFilesArtifactArgs.prototype.setDestinationFilesArtifactName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -3200,8 +7404,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.GetServiceLogsResponse.toObject(opt_includeInstance, this);
+proto.engine_api.CopyFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CopyFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3210,14 +7414,15 @@ proto.engine_api.GetServiceLogsResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.CopyFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.CopyFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceLogsByServiceUuidMap: (f = msg.getServiceLogsByServiceUuidMap()) ? f.toObject(includeInstance, proto.engine_api.LogLine.toObject) : [],
-    notFoundServiceUuidSetMap: (f = msg.getNotFoundServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : []
+    filesArtifactUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    filesArtifactName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    wasAlreadyStored: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -3231,23 +7436,23 @@ proto.engine_api.GetServiceLogsResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.CopyFilesArtifactResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinary = function(bytes) {
+proto.engine_api.CopyFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.GetServiceLogsResponse;
-  return proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CopyFilesArtifactResponse;
+  return proto.engine_api.CopyFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.GetServiceLogsResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CopyFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.GetServiceLogsResponse}
+ * @return {!proto.engine_api.CopyFilesArtifactResponse}
  */
-proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CopyFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3255,16 +7460,16 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceLogsByServiceUuidMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.engine_api.LogLine.deserializeBinaryFromReader, "", new proto.engine_api.LogLine());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilesArtifactUuid(value);
       break;
     case 2:
-      var value = msg.getNotFoundServiceUuidSetMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilesArtifactName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWasAlreadyStored(value);
       break;
     default:
       reader.skipField();
@@ -3279,9 +7484,9 @@ proto.engine_api.GetServiceLogsResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
+proto.engine_api.CopyFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CopyFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3289,74 +7494,90 @@ proto.engine_api.GetServiceLogsResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.GetServiceLogsResponse} message
+ * @param {!proto.engine_api.CopyFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.GetServiceLogsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CopyFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceLogsByServiceUuidMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.engine_api.LogLine.serializeBinaryToWriter);
+  f = message.getFilesArtifactUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
-  f = message.getNotFoundServiceUuidSetMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  f = message.getFilesArtifactName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getWasAlreadyStored();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, LogLine> service_logs_by_service_uuid = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.engine_api.LogLine>}
+ * optional string files_artifact_uuid = 1;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getServiceLogsByServiceUuidMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.engine_api.LogLine>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.engine_api.LogLine));
+proto.engine_api.CopyFilesArtifactResponse.prototype.getFilesArtifactUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CopyFilesArtifactResponse} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearServiceLogsByServiceUuidMap = function() {
-  this.getServiceLogsByServiceUuidMap().clear();
-  return this;};
+proto.engine_api.CopyFilesArtifactResponse.prototype.setFilesArtifactUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
 
 
 /**
- * map<string, bool> not_found_service_uuid_set = 2;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * optional string files_artifact_name = 2;
+ * @return {string}
  */
-proto.engine_api.GetServiceLogsResponse.prototype.getNotFoundServiceUuidSetMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
-      null));
+proto.engine_api.CopyFilesArtifactResponse.prototype.getFilesArtifactName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.engine_api.GetServiceLogsResponse} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.CopyFilesArtifactResponse} returns this
  */
-proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap = function() {
-  this.getNotFoundServiceUuidSetMap().clear();
-  return this;};
+proto.engine_api.CopyFilesArtifactResponse.prototype.setFilesArtifactName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
 
+/**
+ * optional bool was_already_stored = 3;
+ * @return {boolean}
+ */
+proto.engine_api.CopyFilesArtifactResponse.prototype.getWasAlreadyStored = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
 
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {boolean} value
+ * @return {!proto.engine_api.CopyFilesArtifactResponse} returns this
  */
-proto.engine_api.LogLine.repeatedFields_ = [1];
+proto.engine_api.CopyFilesArtifactResponse.prototype.setWasAlreadyStored = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -3373,8 +7594,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLine.toObject(opt_includeInstance, this);
+proto.engine_api.SetLogLevelArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.SetLogLevelArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3383,13 +7604,14 @@ proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLine} msg The msg instance to transform.
+ * @param {!proto.engine_api.SetLogLevelArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
+proto.engine_api.SetLogLevelArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    logLevel: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    revertAfterSeconds: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -3403,23 +7625,23 @@ proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.SetLogLevelArgs}
  */
-proto.engine_api.LogLine.deserializeBinary = function(bytes) {
+proto.engine_api.SetLogLevelArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLine;
-  return proto.engine_api.LogLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.SetLogLevelArgs;
+  return proto.engine_api.SetLogLevelArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLine} msg The message object to deserialize into.
+ * @param {!proto.engine_api.SetLogLevelArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLine}
+ * @return {!proto.engine_api.SetLogLevelArgs}
  */
-proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.SetLogLevelArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3428,7 +7650,11 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addLine(value);
+      msg.setLogLevel(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRevertAfterSeconds(value);
       break;
     default:
       reader.skipField();
@@ -3443,9 +7669,9 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLine.prototype.serializeBinary = function() {
+proto.engine_api.SetLogLevelArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLine.serializeBinaryToWriter(this, writer);
+  proto.engine_api.SetLogLevelArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3453,56 +7679,62 @@ proto.engine_api.LogLine.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLine} message
+ * @param {!proto.engine_api.SetLogLevelArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.SetLogLevelArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getLineList();
+  f = message.getLogLevel();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getRevertAfterSeconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * repeated string line = 1;
- * @return {!Array<string>}
+ * optional string log_level = 1;
+ * @return {string}
  */
-proto.engine_api.LogLine.prototype.getLineList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+proto.engine_api.SetLogLevelArgs.prototype.getLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.engine_api.LogLine} returns this
+ * @param {string} value
+ * @return {!proto.engine_api.SetLogLevelArgs} returns this
  */
-proto.engine_api.LogLine.prototype.setLineList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.engine_api.SetLogLevelArgs.prototype.setLogLevel = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.engine_api.LogLine} returns this
+ * optional uint32 revert_after_seconds = 2;
+ * @return {number}
  */
-proto.engine_api.LogLine.prototype.addLine = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+proto.engine_api.SetLogLevelArgs.prototype.getRevertAfterSeconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.engine_api.LogLine} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.SetLogLevelArgs} returns this
  */
-proto.engine_api.LogLine.prototype.clearLineList = function() {
-  return this.setLineList([]);
+proto.engine_api.SetLogLevelArgs.prototype.setRevertAfterSeconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -3522,8 +7754,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.LogLineFilter.toObject(opt_includeInstance, this);
+proto.engine_api.SetLogLevelResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.SetLogLevelResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3532,14 +7764,13 @@ proto.engine_api.LogLineFilter.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.LogLineFilter} msg The msg instance to transform.
+ * @param {!proto.engine_api.SetLogLevelResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
+proto.engine_api.SetLogLevelResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    operator: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    textPattern: jspb.Message.getFieldWithDefault(msg, 2, "")
+    previousLogLevel: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3553,23 +7784,23 @@ proto.engine_api.LogLineFilter.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.SetLogLevelResponse}
  */
-proto.engine_api.LogLineFilter.deserializeBinary = function(bytes) {
+proto.engine_api.SetLogLevelResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.LogLineFilter;
-  return proto.engine_api.LogLineFilter.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.SetLogLevelResponse;
+  return proto.engine_api.SetLogLevelResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.LogLineFilter} msg The message object to deserialize into.
+ * @param {!proto.engine_api.SetLogLevelResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.LogLineFilter}
+ * @return {!proto.engine_api.SetLogLevelResponse}
  */
-proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.SetLogLevelResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3577,12 +7808,8 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.engine_api.LogLineOperator} */ (reader.readEnum());
-      msg.setOperator(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTextPattern(value);
+      msg.setPreviousLogLevel(value);
       break;
     default:
       reader.skipField();
@@ -3597,9 +7824,9 @@ proto.engine_api.LogLineFilter.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
+proto.engine_api.SetLogLevelResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.LogLineFilter.serializeBinaryToWriter(this, writer);
+  proto.engine_api.SetLogLevelResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3607,23 +7834,16 @@ proto.engine_api.LogLineFilter.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.LogLineFilter} message
+ * @param {!proto.engine_api.SetLogLevelResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.SetLogLevelResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOperator();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      1,
-      f
-    );
-  }
-  f = message.getTextPattern();
+  f = message.getPreviousLogLevel();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -3631,41 +7851,32 @@ proto.engine_api.LogLineFilter.serializeBinaryToWriter = function(message, write
 
 
 /**
- * optional LogLineOperator operator = 1;
- * @return {!proto.engine_api.LogLineOperator}
- */
-proto.engine_api.LogLineFilter.prototype.getOperator = function() {
-  return /** @type {!proto.engine_api.LogLineOperator} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {!proto.engine_api.LogLineOperator} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * optional string previous_log_level = 1;
+ * @return {string}
  */
-proto.engine_api.LogLineFilter.prototype.setOperator = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.engine_api.SetLogLevelResponse.prototype.getPreviousLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional string text_pattern = 2;
- * @return {string}
+ * @param {string} value
+ * @return {!proto.engine_api.SetLogLevelResponse} returns this
  */
-proto.engine_api.LogLineFilter.prototype.getTextPattern = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.engine_api.SetLogLevelResponse.prototype.setPreviousLogLevel = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.LogLineFilter} returns this
+ * @enum {number}
  */
-proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.engine_api.EnclaveIpRangeReusePolicy = {
+  ENCLAVEIPRANGEREUSEPOLICY_IMMEDIATE: 0,
+  ENCLAVEIPRANGEREUSEPOLICY_AFTER_COOLDOWN: 1,
+  ENCLAVEIPRANGEREUSEPOLICY_NEVER: 2
 };
 
-
 /**
  * @enum {number}
  */
@@ -3694,4 +7905,14 @@ proto.engine_api.LogLineOperator = {
   LOGLINEOPERATOR_DOES_NOT_CONTAIN_MATCH_REGEX: 3
 };
 
+/**
+ * @enum {number}
+ */
+proto.engine_api.EnclaveLifecycleEventType = {
+  ENCLAVELIFECYCLEEVENTTYPE_CREATED: 0,
+  ENCLAVELIFECYCLEEVENTTYPE_STOPPED: 1,
+  ENCLAVELIFECYCLEEVENTTYPE_DESTROYED: 2,
+  ENCLAVELIFECYCLEEVENTTYPE_SERVICE_ADDED: 3
+};
+
 goog.object.extend(exports, proto.engine_api);
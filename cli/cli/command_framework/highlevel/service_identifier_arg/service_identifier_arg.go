@@ -16,6 +16,12 @@ const (
 	enclaveIdentifierArgKey         = "enclave"
 	validShortenedUuidOrNameMatches = 1
 	uuidDelimiter                   = ", "
+
+	// The characters that make a value a glob pattern (as understood by path.Match) rather than a service identifier
+	serviceNamePatternChars = "*?["
+
+	shouldSkipServiceNamePatterns    = true
+	shouldNotSkipServiceNamePatterns = false
 )
 
 func NewServiceIdentifierArg(
@@ -24,18 +30,55 @@ func NewServiceIdentifierArg(
 	isGreedy bool,
 ) *args.ArgConfig {
 
-	validate := getValidationFunc(argKey, isGreedy)
+	validate := getValidationFunc(argKey, isGreedy, shouldNotSkipServiceNamePatterns)
+
+	// Optional greedy args default to an empty list rather than an empty string
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
 
 	return &args.ArgConfig{
 		Key:                   argKey,
 		IsOptional:            isOptional,
-		DefaultValue:          "",
+		DefaultValue:          defaultValue,
+		IsGreedy:              isGreedy,
+		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletionsOfActiveServices),
+		ValidationFunc:        validate,
+	}
+}
+
+// NewServiceIdentifierOrPatternArg is like NewServiceIdentifierArg, but also accepts glob patterns matched against
+// the service names (e.g. 'node-*'), which are left unvalidated as they might not match any service
+func NewServiceIdentifierOrPatternArg(
+	argKey string,
+	isOptional bool,
+	isGreedy bool,
+) *args.ArgConfig {
+
+	validate := getValidationFunc(argKey, isGreedy, shouldSkipServiceNamePatterns)
+
+	var defaultValue interface{} = ""
+	if isGreedy {
+		defaultValue = []string{}
+	}
+
+	return &args.ArgConfig{
+		Key:                   argKey,
+		IsOptional:            isOptional,
+		DefaultValue:          defaultValue,
 		IsGreedy:              isGreedy,
 		ArgCompletionProvider: args.NewManualCompletionsProvider(getCompletionsOfActiveServices),
 		ValidationFunc:        validate,
 	}
 }
 
+// IsServiceNamePattern returns true if the given value is a glob pattern over the service names rather than a service
+// identifier
+func IsServiceNamePattern(value string) bool {
+	return strings.ContainsAny(value, serviceNamePatternChars)
+}
+
 // TODO we added this constructor for allowing 'service logs' command to disable the validation for consuming logs from removed or stopped enclaves
 // TODO after https://github.com/kurtosis-tech/kurtosis/issues/879 is done
 func NewHistoricalServiceIdentifierArgWithValidationDisabled(
@@ -157,6 +200,7 @@ func getServiceIdentifiersForValidation(ctx context.Context, _ *flags.ParsedFlag
 func getValidationFunc(
 	argKey string,
 	isGreedy bool,
+	shouldSkipPatterns bool,
 ) func(context.Context, *flags.ParsedFlags, *args.ParsedArgs) error {
 	return func(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
 		var serviceIdentifiersToValidate []string
@@ -180,6 +224,9 @@ func getValidationFunc(
 		}
 
 		for _, serviceIdentifierToValidate := range serviceIdentifiersToValidate {
+			if shouldSkipPatterns && IsServiceNamePattern(serviceIdentifierToValidate) {
+				continue
+			}
 			maybeServiceUuid := services.ServiceUUID(serviceIdentifierToValidate)
 			maybeServiceName := services.ServiceName(serviceIdentifierToValidate)
 			maybeShortenedUuid := serviceIdentifierToValidate
@@ -12,56 +12,83 @@ import (
 var KurtosisCmdStr = path.Base(os.Args[0])
 
 const (
-	Analytics               = "analytics"
-	CleanCmdStr             = "clean"
-	ClusterCmdStr           = "cluster"
-	ClusterSetCmdStr        = "set"
-	ClusterGetCmdStr        = "get"
-	ClusterLsCmdStr         = "ls"
-	ContextCmdStr           = "context"
-	ContextAddCmdStr        = "add"
-	ContextLsCmdStr         = "ls"
-	ContextRmCmdStr         = "rm"
-	ContextSwitchCmdStr     = "switch"
-	DiscordCmdStr           = "discord"
-	DocsCmdStr              = "docs"
-	EnclaveCmdStr           = "enclave"
-	EnclaveInspectCmdStr    = "inspect"
-	EnclaveLsCmdStr         = "ls"
-	EnclaveAddCmdStr        = "add"
-	EnclaveStopCmdStr       = "stop"
-	EnclaveRmCmdStr         = "rm"
-	EnclaveDumpCmdStr       = "dump"
-	EngineCmdStr            = "engine"
-	EngineLogsCmdStr        = "logs"
-	EngineStartCmdStr       = "start"
-	EngineStatusCmdStr      = "status"
-	EngineStopCmdStr        = "stop"
-	EngineRestartCmdStr     = "restart"
-	FeedbackCmdStr          = "feedback"
-	FilesCmdStr             = "files"
-	FilesUploadCmdStr       = "upload"
-	FilesDownloadCmdStr     = "download"
-	FilesStoreWebCmdStr     = "storeweb"
-	FilesStoreServiceCmdStr = "storeservice"
-	FilesRenderTemplate     = "rendertemplate"
-	KurtosisDumpCmdStr      = "dump"
-	PortalCmdStr            = "portal"
-	PortalStartCmdStr       = "start"
-	PortalStatusCmdStr      = "status"
-	PortalStopCmdStr        = "stop"
-	ServiceCmdStr           = "service"
-	ServiceAddCmdStr        = "add"
-	ServiceLogsCmdStr       = "logs"
-	ServiceRmCmdStr         = "rm"
-	ServiceShellCmdStr      = "shell"
-	StarlarkRunCmdStr       = "run"
-	TwitterCmdStr           = "twitter"
-	ConfigCmdStr            = "config"
-	InitCmdStr              = "init"
-	PathCmdStr              = "path"
-	VersionCmdStr           = "version"
-	GatewayCmdStr           = "gateway"
+	Analytics                  = "analytics"
+	CleanCmdStr                = "clean"
+	ClusterCmdStr              = "cluster"
+	ClusterSetCmdStr           = "set"
+	ClusterGetCmdStr           = "get"
+	ClusterLsCmdStr            = "ls"
+	ContextCmdStr              = "context"
+	ContextAddCmdStr           = "add"
+	ContextLsCmdStr            = "ls"
+	ContextRmCmdStr            = "rm"
+	ContextSwitchCmdStr        = "switch"
+	DiscordCmdStr              = "discord"
+	DocsCmdStr                 = "docs"
+	EnclaveCmdStr              = "enclave"
+	EnclaveInspectCmdStr       = "inspect"
+	EnclaveLsCmdStr            = "ls"
+	EnclaveAddCmdStr           = "add"
+	EnclaveStopCmdStr          = "stop"
+	EnclaveRmCmdStr            = "rm"
+	EnclaveDumpCmdStr          = "dump"
+	EnclaveLogsCmdStr          = "logs"
+	EnclaveExportCmdStr        = "export"
+	EnclaveNettestCmdStr       = "nettest"
+	EnclaveScrapeTargetsCmdStr = "scrape-targets"
+	EnclaveProfileCmdStr       = "profile"
+	EngineCmdStr               = "engine"
+	EngineLogsCmdStr           = "logs"
+	EngineStartCmdStr          = "start"
+	EngineStatusCmdStr         = "status"
+	EngineStopCmdStr           = "stop"
+	EngineRestartCmdStr        = "restart"
+	EngineSetLogLevelCmdStr    = "set-log-level"
+	FeedbackCmdStr             = "feedback"
+	FilesCmdStr                = "files"
+	FilesUploadCmdStr          = "upload"
+	FilesDownloadCmdStr        = "download"
+	FilesCpCmdStr              = "cp"
+	FilesStoreWebCmdStr        = "storeweb"
+	FilesStoreServiceCmdStr    = "storeservice"
+	FilesRenderTemplate        = "rendertemplate"
+	FilesLsCmdStr              = "ls"
+	FilesInspectCmdStr         = "inspect"
+	KurtosisDumpCmdStr         = "dump"
+	PackageCmdStr              = "package"
+	PackageCacheCmdStr         = "cache"
+	PackageCacheLsCmdStr       = "ls"
+	PackageCacheClearCmdStr    = "clear"
+	PackagePlanCmdStr          = "plan"
+	PackagePublishCmdStr       = "publish"
+	PackageSearchCmdStr        = "search"
+	PackageTestCmdStr          = "test"
+	PortalCmdStr               = "portal"
+	PortalStartCmdStr          = "start"
+	PortalStatusCmdStr         = "status"
+	PortalStopCmdStr           = "stop"
+	ScheduleCmdStr             = "schedule"
+	ScheduleAddCmdStr          = "add"
+	ScheduleLsCmdStr           = "ls"
+	ScheduleRmCmdStr           = "rm"
+	ServiceCmdStr              = "service"
+	ServiceAddCmdStr           = "add"
+	ServiceCurlCmdStr          = "curl"
+	ServiceExecCmdStr          = "exec"
+	ServiceLogsCmdStr          = "logs"
+	ServiceMetricsCmdStr       = "metrics"
+	ServicePauseCmdStr         = "pause"
+	ServiceRmCmdStr            = "rm"
+	ServiceShellCmdStr         = "shell"
+	ServiceUnpauseCmdStr       = "unpause"
+	StarlarkRunCmdStr          = "run"
+	ApplyCmdStr                = "apply"
+	TwitterCmdStr              = "twitter"
+	ConfigCmdStr               = "config"
+	InitCmdStr                 = "init"
+	PathCmdStr                 = "path"
+	VersionCmdStr              = "version"
+	GatewayCmdStr              = "gateway"
 )
 
 // TODO: added constant error message here, can we move to another file later.
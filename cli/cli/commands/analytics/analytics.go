@@ -2,12 +2,19 @@ package analytics
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/set_selection_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/local_analytics"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_user_id_store"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_send_metrics_election"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_send_metrics_election/user_metrics_election_event_backlog"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
@@ -20,22 +27,34 @@ const (
 	//Valid accept sending metrics inputs
 	enableSendingMetrics   = "enable"
 	disableSendingMetrics  = "disable"
+	localMetrics           = "local"
+	printMetricsStatus     = "status"
+	printLocalEvents       = "events"
 	printMetricsId         = "id"
 	enableDisableDelimiter = "|"
 
-	enableDisableStatus = enableSendingMetrics + enableDisableDelimiter + disableSendingMetrics + enableDisableDelimiter + printMetricsId
+	enableDisableStatus = enableSendingMetrics + enableDisableDelimiter + disableSendingMetrics + enableDisableDelimiter + localMetrics + enableDisableDelimiter + printMetricsStatus + enableDisableDelimiter + printLocalEvents + enableDisableDelimiter + printMetricsId
+
+	enabledStatusStr  = "enabled"
+	disabledStatusStr = "disabled"
+	localStatusStr    = "local (events are recorded on disk instead of sent)"
 )
 
 var validMetricsSendingToggleValue = map[string]bool{
 	enableSendingMetrics:  true,
 	disableSendingMetrics: true,
+	localMetrics:          true,
+	printMetricsStatus:    true,
+	printLocalEvents:      true,
 	printMetricsId:        true,
 }
 
 var AnalyticsCmd = &lowlevel.LowlevelKurtosisCommand{
 	CommandStr:       command_str_consts.Analytics,
 	ShortDescription: "Control Kurtosis's anonymous aggregate user behavior analytics",
-	LongDescription:  "Control Kurtosis's anonymous aggregate user behavior analytics. Read more at\n" + user_support_constants.MetricsPhilosophyDocs,
+	LongDescription: "Control Kurtosis's anonymous aggregate user behavior analytics, which are only sent if you opt in with '" + enableSendingMetrics + "'. " +
+		"The '" + localMetrics + "' mode records the exact events to disk instead of sending them, so you can audit them with '" + printLocalEvents + "' before enabling. " +
+		"Read more at\n" + user_support_constants.MetricsPhilosophyDocs,
 	Args: []*args.ArgConfig{
 		// the enableDisableStatus would appear as the name of the argument
 		set_selection_arg.NewSetSelectionArg(
@@ -50,39 +69,60 @@ var AnalyticsCmd = &lowlevel.LowlevelKurtosisCommand{
 }
 
 func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
-	didUserAcceptSendingMetricsStr, err := args.GetNonGreedyArg(enableDisableStatus)
+	analyticsActionStr, err := args.GetNonGreedyArg(enableDisableStatus)
 	if err != nil {
 		return stacktrace.Propagate(err, "Expected a value for non-greedy arg '%v' but none was found; this is a bug in Kurtosis!", enableDisableStatus)
 	}
 
 	// We get validation for free by virtue of the KurtosisCommand framework
-	var didUserAcceptSendingMetrics bool
-	justPrintMetricsId := false
-	if didUserAcceptSendingMetricsStr == enableSendingMetrics {
-		didUserAcceptSendingMetrics = true
-	} else if didUserAcceptSendingMetricsStr == disableSendingMetrics {
-		didUserAcceptSendingMetrics = false
-	} else if didUserAcceptSendingMetricsStr == printMetricsId {
-		justPrintMetricsId = true
-	} else {
-		// If this happens, there's something wrong with the validation being done via KurtosisCommand
-		return stacktrace.NewError(
-			"Encountered an unrecognized '%v' input string '%v', which should never happen; this is a bug in Kurtosis!",
-			enableDisableStatus,
-			didUserAcceptSendingMetricsStr,
-		)
-	}
-
-	if justPrintMetricsId {
+	switch analyticsActionStr {
+	case enableSendingMetrics:
+		if err := setAnalyticsMode(true, false); err != nil {
+			return stacktrace.Propagate(err, "An error occurred enabling the analytics")
+		}
+		trackSendMetricsElection()
+		logrus.Infof("Analytics tracking is now enabled")
+	case disableSendingMetrics:
+		if err := setAnalyticsMode(false, false); err != nil {
+			return stacktrace.Propagate(err, "An error occurred disabling the analytics")
+		}
+		logrus.Infof("Analytics tracking is now disabled")
+	case localMetrics:
+		if err := setAnalyticsMode(false, true); err != nil {
+			return stacktrace.Propagate(err, "An error occurred switching the analytics to local mode")
+		}
+		eventsFilepath, err := host_machine_directories.GetLocalAnalyticsEventsFilepath()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the local analytics events filepath")
+		}
+		logrus.Infof("Analytics events will now be recorded in '%v' instead of being sent; run 'kurtosis %v %v' to see them", eventsFilepath, command_str_consts.Analytics, printLocalEvents)
+	case printMetricsStatus:
+		if err := printStatus(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the analytics status")
+		}
+	case printLocalEvents:
+		if err := printRecordedEvents(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred printing the locally recorded analytics events")
+		}
+	case printMetricsId:
 		metricsUserIdStore := metrics_user_id_store.GetMetricsUserIDStore()
 		metricsUserId, err := metricsUserIdStore.GetUserID()
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred while getting the users metrics id")
 		}
 		out.PrintOutLn(metricsUserId)
-		return nil
+	default:
+		// If this happens, there's something wrong with the validation being done via KurtosisCommand
+		return stacktrace.NewError(
+			"Encountered an unrecognized '%v' input string '%v', which should never happen; this is a bug in Kurtosis!",
+			enableDisableStatus,
+			analyticsActionStr,
+		)
 	}
+	return nil
+}
 
+func setAnalyticsMode(didUserAcceptSendingMetrics bool, isLocalMode bool) error {
 	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
 	var kurtosisConfig *resolved_config.KurtosisConfig
 
@@ -108,7 +148,90 @@ func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) e
 		return stacktrace.Propagate(err, "An error occurred setting analytics configuration")
 	}
 
-	logrus.Infof("Analytics tracking is now %vd", didUserAcceptSendingMetricsStr)
+	if isLocalMode {
+		if err := local_analytics.EnableLocalMode(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred enabling the analytics local mode")
+		}
+	} else {
+		if err := local_analytics.DisableLocalMode(); err != nil {
+			return stacktrace.Propagate(err, "An error occurred disabling the analytics local mode")
+		}
+	}
+	return nil
+}
 
+// The election only gets tracked when the user opts in, since tracking an opt-out would send data without consent
+func trackSendMetricsElection() {
+	userMetricsElectionEventBacklog := user_metrics_election_event_backlog.GetUserMetricsElectionEventBacklog()
+	if err := userMetricsElectionEventBacklog.Set(true); err != nil {
+		//We don't want to interrupt users flow if something fails when tracking metrics
+		logrus.Debugf("An error occurred creating user-consent-to-send-metrics election file\n%v", err)
+		return
+	}
+	//Here we are trying to send this metric for first time, but if it fails we'll continue to retry every time the CLI runs
+	if err := user_send_metrics_election.SendAnyBackloggedUserMetricsElectionEvent(); err != nil {
+		//We don't want to interrupt users flow if something fails when tracking metrics
+		logrus.Debugf("An error occurred tracking user-consent-to-send-metrics election\n%v", err)
+	}
+}
+
+func printStatus() error {
+	isLocalModeEnabled, err := local_analytics.IsLocalModeEnabled()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking if the analytics are in local mode")
+	}
+
+	kurtosisConfigStore := kurtosis_config.GetKurtosisConfigStore()
+	hasConfig, err := kurtosisConfigStore.HasConfig()
+	if err != nil {
+		return stacktrace.NewError("An error occurred while determining whether configuration already exists")
+	}
+	shouldSendMetrics := defaults.SendMetricsByDefault
+	if hasConfig {
+		kurtosisConfig, err := kurtosisConfigStore.GetConfig()
+		if err != nil {
+			return stacktrace.NewError("An error occurred while fetching stored configuration")
+		}
+		shouldSendMetrics = kurtosisConfig.GetShouldSendMetrics()
+	}
+
+	statusStr := disabledStatusStr
+	if isLocalModeEnabled {
+		statusStr = localStatusStr
+	} else if shouldSendMetrics {
+		statusStr = enabledStatusStr
+	}
+	out.PrintOutLn(fmt.Sprintf("Analytics: %v", statusStr))
+
+	if isLocalModeEnabled {
+		eventsFilepath, err := host_machine_directories.GetLocalAnalyticsEventsFilepath()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the local analytics events filepath")
+		}
+		recordedEvents, err := local_analytics.GetRecordedEvents()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the locally recorded analytics events")
+		}
+		out.PrintOutLn(fmt.Sprintf("Recorded events: %v, in '%v'", len(recordedEvents), eventsFilepath))
+	}
+	return nil
+}
+
+func printRecordedEvents() error {
+	recordedEvents, err := local_analytics.GetRecordedEvents()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the locally recorded analytics events")
+	}
+	if len(recordedEvents) == 0 {
+		logrus.Infof("No analytics events have been recorded; events only get recorded after running 'kurtosis %v %v'", command_str_consts.Analytics, localMetrics)
+		return nil
+	}
+	for _, recordedEvent := range recordedEvents {
+		recordedEventBytes, err := json.Marshal(recordedEvent)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred serializing recorded event '%v' to JSON", recordedEvent.Event)
+		}
+		out.PrintOutLn(string(recordedEventBytes))
+	}
 	return nil
 }
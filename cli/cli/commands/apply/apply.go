@@ -0,0 +1,208 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_manifest"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"strings"
+)
+
+const (
+	manifestFilepathArgKey        = "manifest"
+	isManifestFilepathArgOptional = false
+
+	dryRunFlagKey = "dry-run"
+	defaultDryRun = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	noStarlarkParams   = "{}"
+	doNotDryRun        = false
+	defaultParallelism = 4
+	isNotStrict        = false
+)
+
+var ApplyCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ApplyCmdStr,
+	ShortDescription: "Makes an enclave match a YAML manifest",
+	LongDescription: "Creates the enclave the given YAML manifest describes if it doesn't exist yet, then adds, replaces and " +
+		"removes services and sets the subnetwork connections until the enclave matches the manifest. Manifests can be written " +
+		"by hand or with '" + command_str_consts.KurtosisCmdStr + " " + command_str_consts.EnclaveCmdStr + " " + command_str_consts.EnclaveExportCmdStr + "'",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       dryRunFlagKey,
+			Usage:     "If true, prints the changes and the Starlark script that would apply them without changing the enclave",
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultDryRun,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			manifestFilepathArgKey,
+			isManifestFilepathArgOptional,
+			noValidationException,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	metricsClient metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	manifestFilepath, err := args.GetNonGreedyArg(manifestFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the manifest filepath using arg key '%v'", manifestFilepathArgKey)
+	}
+	dryRun, err := flags.GetBool(dryRunFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the dry-run value using flag key '%v'", dryRunFlagKey)
+	}
+
+	manifestBytes, err := os.ReadFile(manifestFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading manifest file '%v'", manifestFilepath)
+	}
+	desiredManifest, err := enclave_manifest.ParseEnclaveManifest(manifestBytes)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing manifest file '%v'", manifestFilepath)
+	}
+	enclaveName := desiredManifest.Name
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	var enclaveCtx *enclaves.EnclaveContext
+	currentManifest := &enclave_manifest.EnclaveManifest{
+		Name:                  enclaveName,
+		FilesArtifacts:        nil,
+		Services:              nil,
+		SubnetworkConnections: nil,
+	}
+	if _, err := kurtosisCtx.GetEnclave(ctx, enclaveName); err == nil {
+		enclaveCtx, err = kurtosisCtx.GetEnclaveContext(ctx, enclaveName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveName)
+		}
+		currentManifest, err = enclave_manifest.NewEnclaveManifestFromEnclave(ctx, enclaveCtx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the current state of enclave '%v'", enclaveName)
+		}
+	}
+
+	// Files artifacts aren't part of the manifest, only their names are, so they must already be in the enclave
+	if missingFilesArtifacts := getMissingFilesArtifacts(currentManifest, desiredManifest); len(missingFilesArtifacts) > 0 {
+		return stacktrace.NewError(
+			"Enclave '%v' doesn't have the files artifacts '%v' that the manifest needs; upload them with '%v %v %v' before applying the manifest",
+			enclaveName,
+			strings.Join(missingFilesArtifacts, "', '"),
+			command_str_consts.KurtosisCmdStr,
+			command_str_consts.FilesCmdStr,
+			command_str_consts.FilesUploadCmdStr,
+		)
+	}
+
+	plan := enclave_manifest.NewReconciliationPlan(currentManifest, desiredManifest)
+	if plan.IsEmpty() {
+		logrus.Infof("Enclave '%v' already matches manifest '%v'; nothing to do", enclaveName, manifestFilepath)
+		return nil
+	}
+	printPlan(enclaveName, enclaveCtx == nil, plan)
+	if dryRun {
+		out.PrintOutLn("Starlark script that would apply the changes:")
+		out.PrintOutLn(plan.GetStarlarkScript())
+		return nil
+	}
+
+	if enclaveCtx == nil {
+		logrus.Infof("Creating enclave '%v'...", enclaveName)
+		enclaveCtx, err = kurtosisCtx.CreateEnclave(ctx, enclaveName, desiredManifest.UsesSubnetworks())
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating enclave '%v'", enclaveName)
+		}
+		if err = metricsClient.TrackCreateEnclave(enclaveName); err != nil {
+			logrus.Error("An error occurred while logging the create enclave event")
+		}
+	}
+
+	if err := runStarlarkScript(ctx, enclaveCtx, plan.GetStarlarkScript()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying manifest '%v' to enclave '%v'", manifestFilepath, enclaveName)
+	}
+	logrus.Infof("Enclave '%v' now matches manifest '%v'", enclaveName, manifestFilepath)
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func noValidationException(_ string) bool {
+	return false
+}
+
+func getMissingFilesArtifacts(currentManifest *enclave_manifest.EnclaveManifest, desiredManifest *enclave_manifest.EnclaveManifest) []string {
+	existingFilesArtifacts := map[string]bool{}
+	for _, filesArtifactName := range currentManifest.FilesArtifacts {
+		existingFilesArtifacts[filesArtifactName] = true
+	}
+	result := []string{}
+	for _, filesArtifactName := range desiredManifest.FilesArtifacts {
+		if !existingFilesArtifacts[filesArtifactName] {
+			result = append(result, filesArtifactName)
+		}
+	}
+	return result
+}
+
+func printPlan(enclaveName string, isNewEnclave bool, plan *enclave_manifest.ReconciliationPlan) {
+	if isNewEnclave {
+		out.PrintOutLn(fmt.Sprintf("Enclave '%v' doesn't exist and will be created. Changes:", enclaveName))
+	} else {
+		out.PrintOutLn(fmt.Sprintf("Changes to enclave '%v':", enclaveName))
+	}
+	for _, changeDescription := range plan.GetChangeDescriptions() {
+		out.PrintOutLn(fmt.Sprintf("  - %v", changeDescription))
+	}
+}
+
+func runStarlarkScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, script string) error {
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, script, noStarlarkParams, doNotDryRun, defaultParallelism, isNotStrict)
+	if err != nil {
+		return stacktrace.Propagate(err, "An unexpected error occurred running the Starlark script applying the manifest")
+	}
+	if runResult.InterpretationError != nil {
+		return stacktrace.NewError("An error occurred interpreting the Starlark script applying the manifest: %s", runResult.InterpretationError.GetErrorMessage())
+	}
+	if len(runResult.ValidationErrors) > 0 {
+		return stacktrace.NewError("An error occurred validating the Starlark script applying the manifest: %v", runResult.ValidationErrors)
+	}
+	if runResult.ExecutionError != nil {
+		return stacktrace.NewError("An error occurred executing the Starlark script applying the manifest: %s", runResult.ExecutionError.GetErrorMessage())
+	}
+	return nil
+}
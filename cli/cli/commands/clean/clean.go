@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grouped_errors_presenter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
@@ -16,6 +18,7 @@ import (
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"sort"
 	"strings"
 )
@@ -24,14 +27,19 @@ const (
 	shouldCleanRunningEnclavesFlagKey = "all"
 	defaultShouldCleanRunningEnclaves = "false"
 
+	shouldCleanImagesFlagKey = "images"
+	defaultShouldCleanImages = "false"
+
 	// Titles of the cleaning phases
 	// Should be lowercased as they'll go into a string like "Cleaning XXXXX...."
 	oldEngineCleaningPhaseTitle = "old Kurtosis engine containers"
 	enclavesCleaningPhaseTitle  = "enclaves"
+	imagesCleaningPhaseTitle    = "images pulled for enclaves that don't exist anymore"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 	uuidAndNameDelimiter  = "\t"
+	imageAndSizeDelimiter = "\t"
 
 	// this prefix converts the returned guid into a docker name that the user is more used to
 	kurtosisEngineGuidPrefix = "kurtosis-engine--"
@@ -41,7 +49,12 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 	CommandStr:       command_str_consts.CleanCmdStr,
 	ShortDescription: "Cleans up Kurtosis leftover artifacts",
 	LongDescription: fmt.Sprintf(
-		"Removes stopped enclaves (and live ones if the '%v' flag is set), as well as stopped engine containers",
+		"Removes stopped enclaves (and live ones if the '%v' flag is set), as well as stopped engine containers. "+
+			"If the '%v' flag is set, the images Kurtosis pulled for services of enclaves that don't exist anymore get removed too. "+
+			"The engine data volume 'kurtosis-engine-data-vol', which keeps the state of the engine across restarts, is "+
+			"never removed, even with the '%v' flag",
+		shouldCleanRunningEnclavesFlagKey,
+		shouldCleanImagesFlagKey,
 		shouldCleanRunningEnclavesFlagKey,
 	),
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
@@ -54,6 +67,12 @@ var CleanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldCleanRunningEnclaves,
 		},
+		{
+			Key:     shouldCleanImagesFlagKey,
+			Usage:   "If set, removes the images Kurtosis pulled for services that no remaining enclave uses",
+			Type:    flags.FlagType_Bool,
+			Default: defaultShouldCleanImages,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -72,22 +91,43 @@ func run(
 		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanAll)
 	}
 
-	// Map of cleaning_phase_title -> (successfully_destroyed_object_id, object_destruction_errors, clean_error)
-	cleaningPhaseFunctions := map[string]func() ([]string, []error, error){
-		oldEngineCleaningPhaseTitle: func() ([]string, []error, error) {
-			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
-			return cleanStoppedEngineContainers(ctx, kurtosisBackend)
+	shouldCleanImages, err := flags.GetBool(shouldCleanImagesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a boolean flag with key '%v' but none was found; this is an error in Kurtosis!", shouldCleanImagesFlagKey)
+	}
+
+	// In the order they run, as images can only be pruned once the enclaves using them are gone
+	cleaningPhases := []cleaningPhase{
+		{
+			title: oldEngineCleaningPhaseTitle,
+			cleaningFunc: func() ([]string, map[string]error, error) {
+				// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
+				return cleanStoppedEngineContainers(ctx, kurtosisBackend)
+			},
 		},
-		enclavesCleaningPhaseTitle: func() ([]string, []error, error) {
-			// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
-			return cleanEnclaves(ctx, engineClient, shouldCleanAll)
+		{
+			title: enclavesCleaningPhaseTitle,
+			cleaningFunc: func() ([]string, map[string]error, error) {
+				// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
+				return cleanEnclaves(ctx, engineClient, shouldCleanAll)
+			},
 		},
 	}
+	if shouldCleanImages {
+		cleaningPhases = append(cleaningPhases, cleaningPhase{
+			title: imagesCleaningPhaseTitle,
+			cleaningFunc: func() ([]string, map[string]error, error) {
+				// Don't use stacktrace b/c the only reason this function exists is to pass in the right args
+				return cleanImages(ctx, engineClient)
+			},
+		})
+	}
 
 	phasesWithErrors := []string{}
-	for phaseTitle, cleaningFunc := range cleaningPhaseFunctions {
+	for _, phase := range cleaningPhases {
+		phaseTitle := phase.title
 		logrus.Infof("Cleaning %v...", phaseTitle)
-		successfullyRemovedArtifactUuids, removalErrors, err := cleaningFunc()
+		successfullyRemovedArtifactUuids, removalErrors, err := phase.cleaningFunc()
 		if err != nil {
 			logrus.Errorf("Errors occurred cleaning %v:\n%v", phaseTitle, err)
 			phasesWithErrors = append(phasesWithErrors, phaseTitle)
@@ -104,10 +144,8 @@ func run(
 
 		if len(removalErrors) > 0 {
 			logrus.Errorf("Errors occurred removing the following %v:", phaseTitle)
-			for _, err := range removalErrors {
-				out.PrintErrLn("")
-				out.PrintErrLn(err.Error())
-			}
+			out.PrintErrLn("")
+			out.PrintErrLn(grouped_errors_presenter.PresentErrors(removalErrors, phaseTitle))
 			phasesWithErrors = append(phasesWithErrors, phaseTitle)
 			continue
 		}
@@ -121,12 +159,20 @@ func run(
 	return nil
 }
 
+type cleaningPhase struct {
+	// Should be lowercased as it'll go into a string like "Cleaning XXXXX...."
+	title string
+
+	// Returns (successfully_destroyed_object_id, object_destruction_errors_by_object_id, clean_error)
+	cleaningFunc func() ([]string, map[string]error, error)
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
 //
 // ====================================================================================================
-func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) ([]string, []error, error) {
+func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend) ([]string, map[string]error, error) {
 
 	engineFilters := &engine.EngineFilters{
 		GUIDs: nil,
@@ -145,10 +191,10 @@ func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_i
 		successfulEngineContainerNames = append(successfulEngineContainerNames, kurtosisEngineGuidPrefix+string(engineGuid))
 	}
 
-	removeEngineErrors := []error{}
+	removeEngineErrors := map[string]error{}
 	for engineGuid, err := range erroredEngineGuids {
 		wrappedErr := stacktrace.Propagate(err, "An error occurred destroying stopped engine '%v'", engineGuid)
-		removeEngineErrors = append(removeEngineErrors, wrappedErr)
+		removeEngineErrors[kurtosisEngineGuidPrefix+string(engineGuid)] = wrappedErr
 	}
 
 	if err != nil {
@@ -157,7 +203,7 @@ func cleanStoppedEngineContainers(ctx context.Context, kurtosisBackend backend_i
 	return successfulEngineContainerNames, removeEngineErrors, nil
 }
 
-func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool) ([]string, []error, error) {
+func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient, shouldCleanAll bool) ([]string, map[string]error, error) {
 	cleanArgs := &kurtosis_engine_rpc_api_bindings.CleanArgs{ShouldCleanAll: shouldCleanAll}
 	cleanResp, err := engineClient.Clean(ctx, cleanArgs)
 	if err != nil {
@@ -171,6 +217,33 @@ func cleanEnclaves(ctx context.Context, engineClient kurtosis_engine_rpc_api_bin
 	return successfullyDestroyedEnclaveUuidsAndNames, nil, nil
 }
 
+func cleanImages(ctx context.Context, engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient) ([]string, map[string]error, error) {
+	pruneResp, err := engineClient.PruneImages(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred while pruning images")
+	}
+
+	successfullyRemovedImagesAndSizes := []string{}
+	reclaimedBytes := int64(0)
+	for _, removedImage := range pruneResp.GetRemovedImages() {
+		successfullyRemovedImagesAndSizes = append(
+			successfullyRemovedImagesAndSizes,
+			fmt.Sprintf("%v%v%v", removedImage.GetImage(), imageAndSizeDelimiter, units.HumanSize(float64(removedImage.GetSizeBytes()))),
+		)
+		reclaimedBytes += removedImage.GetSizeBytes()
+	}
+	if reclaimedBytes > 0 {
+		// Images can share layers, so what got freed on disk may be less than the sum of their sizes
+		logrus.Infof("Reclaimed up to %v of disk space", units.HumanSize(float64(reclaimedBytes)))
+	}
+
+	removalErrors := map[string]error{}
+	for image, removalErrStr := range pruneResp.GetRemovalErrorsByImage() {
+		removalErrors[image] = errors.New(removalErrStr)
+	}
+	return successfullyRemovedImagesAndSizes, removalErrors, nil
+}
+
 func formattedUuidAndName(enclaveUuidWithName *kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid) string {
 	return fmt.Sprintf("%v%v%v", enclaveUuidWithName.Uuid, uuidAndNameDelimiter, enclaveUuidWithName.Name)
 }
@@ -14,10 +14,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"math"
+	"net"
 	"strings"
+	"time"
 )
 
 const (
@@ -25,8 +29,26 @@ const (
 	apiContainerLogLevelFlagKey = "api-container-log-level"
 	isSubnetworksEnabledFlagKey = "with-subnetworks"
 	enclaveNameFlagKey          = "name"
+	ipRangeReuseFlagKey         = "ip-range-reuse"
+	subnetFlagKey               = "subnet"
+	filesArtifactsTtlFlagKey    = "files-artifacts-ttl"
+	allowHostDirMountsFlagKey   = "allow-host-dir-mounts"
+	isIpv6EnabledFlagKey        = "with-ipv6"
 
 	defaultIsSubnetworksEnabled = "false"
+	defaultAllowHostDirMounts   = "false"
+	defaultIsIpv6Enabled        = "false"
+
+	immediateIpRangeReuseFlagValue     = "immediate"
+	afterCooldownIpRangeReuseFlagValue = "after-cooldown"
+	neverIpRangeReuseFlagValue         = "never"
+	defaultIpRangeReuse                = immediateIpRangeReuseFlagValue
+
+	// Tells the engine to give the enclave a random free subnet of the default size
+	defaultSubnet = ""
+
+	// Files artifacts are kept until they get deleted or the enclave gets destroyed
+	defaultFilesArtifactsTtl = ""
 
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
@@ -35,6 +57,12 @@ const (
 	engineClientCtxKey    = "engine-client"
 )
 
+var ipRangeReusePoliciesByFlagValue = map[string]kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy{
+	immediateIpRangeReuseFlagValue:     kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE,
+	afterCooldownIpRangeReuseFlagValue: kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_AFTER_COOLDOWN,
+	neverIpRangeReuseFlagValue:         kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_NEVER,
+}
+
 // EnclaveAddCmd Suppressing exhaustruct requirement because this struct has ~40 properties
 // nolint: exhaustruct
 var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -76,6 +104,48 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 				enclave_consts.AllowedEnclaveNameCharsRegexStr,
 			),
 			Type: flags.FlagType_String,
+		}, {
+			Key:     ipRangeReuseFlagKey,
+			Type:    flags.FlagType_String,
+			Default: defaultIpRangeReuse,
+			Usage: fmt.Sprintf(
+				"Whether the enclave may get an IP range freed by a destroyed enclave right away (%v), only after a cooldown (%v), or never (%v); "+
+					"ranges that conflict with this machine's network interfaces are never used",
+				immediateIpRangeReuseFlagValue,
+				afterCooldownIpRangeReuseFlagValue,
+				neverIpRangeReuseFlagValue,
+			),
+		}, {
+			Key:     subnetFlagKey,
+			Type:    flags.FlagType_String,
+			Default: defaultSubnet,
+			Usage: fmt.Sprintf(
+				"The subnet of the enclave's network, either a CIDR (e.g. '10.200.0.0/16') for the enclave to get exactly that subnet, "+
+					"or just a mask (e.g. '/16') for the enclave to get a random free subnet of that size; masks must have between %v and %v bits "+
+					"(emptystring gives the enclave a random free /%v subnet)",
+				enclave.MinEnclaveSubnetMaskBits,
+				enclave.MaxEnclaveSubnetMaskBits,
+				enclave.DefaultEnclaveSubnetMaskBits,
+			),
+		}, {
+			Key:     filesArtifactsTtlFlagKey,
+			Type:    flags.FlagType_String,
+			Default: defaultFilesArtifactsTtl,
+			Usage: "How long the files artifacts that no service has mounted are kept after they were last stored, downloaded or mounted, " +
+				"before the enclave deletes them to free up disk, as a duration (e.g. '24h') " +
+				"(emptystring keeps them until they get deleted or the enclave gets destroyed)",
+		}, {
+			Key:     allowHostDirMountsFlagKey,
+			Type:    flags.FlagType_Bool,
+			Default: defaultAllowHostDirMounts,
+			Usage: "If set to true then the services of the enclave can bind-mount directories of this machine with the 'host_dir_mounts' " +
+				"of their ServiceConfig, giving them access to whatever is in those directories",
+		}, {
+			Key:     isIpv6EnabledFlagKey,
+			Type:    flags.FlagType_Bool,
+			Default: defaultIsIpv6Enabled,
+			Usage: "If set to true then the enclave network also gets a random IPv6 unique local address subnet, and every service " +
+				"gets an IPv6 address on top of its IPv4 one (the Docker engine must support IPv6 networks)",
 		},
 	},
 }
@@ -109,6 +179,52 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
 	}
 
+	ipRangeReuseStr, err := flags.GetString(ipRangeReuseFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the IP range reuse policy using flag with key '%v'; this is a bug in Kurtosis", ipRangeReuseFlagKey)
+	}
+	ipRangeReusePolicy, found := ipRangeReusePoliciesByFlagValue[ipRangeReuseStr]
+	if !found {
+		return stacktrace.NewError(
+			"Invalid IP range reuse policy '%v'; valid values are '%v', '%v' and '%v'",
+			ipRangeReuseStr,
+			immediateIpRangeReuseFlagValue,
+			afterCooldownIpRangeReuseFlagValue,
+			neverIpRangeReuseFlagValue,
+		)
+	}
+
+	subnet, err := flags.GetString(subnetFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave subnet using flag with key '%v'; this is a bug in Kurtosis", subnetFlagKey)
+	}
+	isIpv6Enabled, err := flags.GetBool(isIpv6EnabledFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the with-ipv6 setting using flag key '%v'; this is a bug in Kurtosis", isIpv6EnabledFlagKey)
+	}
+	if _, err := enclave.ParseEnclaveSubnetSpec(subnet, isIpv6Enabled); err != nil {
+		return stacktrace.Propagate(err, "Invalid enclave subnet '%v'", subnet)
+	}
+
+	filesArtifactsTtlStr, err := flags.GetString(filesArtifactsTtlFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the files artifacts TTL using flag with key '%v'; this is a bug in Kurtosis", filesArtifactsTtlFlagKey)
+	}
+	filesArtifactsTtlSeconds, err := parseFilesArtifactsTtlSeconds(filesArtifactsTtlStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid files artifacts TTL '%v'", filesArtifactsTtlStr)
+	}
+
+	allowHostDirMounts, err := flags.GetBool(allowHostDirMountsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the allow-host-dir-mounts setting using flag key '%v'; this is a bug in Kurtosis", allowHostDirMountsFlagKey)
+	}
+
+	hostIpRanges, err := getHostIpRanges()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the IP ranges of this machine's network interfaces, which the enclave must not conflict with")
+	}
+
 	engineManager, err := engine_manager.NewEngineManager(ctx)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating an engine manager.")
@@ -130,10 +246,16 @@ func run(
 	}
 
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
-		EnclaveName:            enclaveName,
-		ApiContainerVersionTag: apiContainerVersion,
-		ApiContainerLogLevel:   kurtosisLogLevelStr,
-		IsPartitioningEnabled:  isPartitioningEnabled,
+		EnclaveName:              enclaveName,
+		ApiContainerVersionTag:   apiContainerVersion,
+		ApiContainerLogLevel:     kurtosisLogLevelStr,
+		IsPartitioningEnabled:    isPartitioningEnabled,
+		IpRangeReusePolicy:       ipRangeReusePolicy,
+		ExcludedIpRanges:         hostIpRanges,
+		Subnet:                   subnet,
+		FilesArtifactsTtlSeconds: filesArtifactsTtlSeconds,
+		AllowHostDirMounts:       allowHostDirMounts,
+		IsIpv6Enabled:            isIpv6Enabled,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -147,3 +269,40 @@ func run(
 
 	return nil
 }
+
+// parseFilesArtifactsTtlSeconds turns a duration like '24h' into the whole seconds the engine takes, 0 meaning no TTL
+func parseFilesArtifactsTtlSeconds(filesArtifactsTtlStr string) (uint32, error) {
+	if filesArtifactsTtlStr == defaultFilesArtifactsTtl {
+		return 0, nil
+	}
+	filesArtifactsTtl, err := time.ParseDuration(filesArtifactsTtlStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing '%v' as a duration", filesArtifactsTtlStr)
+	}
+	if filesArtifactsTtl < time.Second || filesArtifactsTtl > time.Duration(math.MaxUint32)*time.Second {
+		return 0, stacktrace.NewError("The files artifacts TTL must be between 1s and %v, but was '%v'", time.Duration(math.MaxUint32)*time.Second, filesArtifactsTtl)
+	}
+	return uint32(filesArtifactsTtl / time.Second), nil
+}
+
+// getHostIpRanges returns the IPv4 ranges of this machine's network interfaces, so the engine doesn't give the enclave a
+// range that would shadow them (e.g. a VPN's)
+func getHostIpRanges() ([]string, error) {
+	interfaceAddrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the addresses of the network interfaces")
+	}
+	hostIpRanges := []string{}
+	for _, interfaceAddr := range interfaceAddrs {
+		ipNet, ok := interfaceAddr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() || ipNet.IP.To4() == nil {
+			continue
+		}
+		hostIpRange := &net.IPNet{
+			IP:   ipNet.IP.Mask(ipNet.Mask),
+			Mask: ipNet.Mask,
+		}
+		hostIpRanges = append(hostIpRanges, hostIpRange.String())
+	}
+	return hostIpRanges, nil
+}
@@ -9,9 +9,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/add"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/dump"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/export"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/logs"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/nettest"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/profile"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/rm"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/scrape_targets"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/stop"
 	"github.com/spf13/cobra"
 )
@@ -31,4 +36,9 @@ func init() {
 	EnclaveCmd.AddCommand(stop.EnclaveStopCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(rm.EnclaveRmCmd.MustGetCobraCommand())
 	EnclaveCmd.AddCommand(dump.EnclaveDumpCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(logs.EnclaveLogsCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(export.EnclaveExportCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(nettest.EnclaveNettestCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(scrape_targets.EnclaveScrapeTargetsCmd.MustGetCobraCommand())
+	EnclaveCmd.AddCommand(profile.EnclaveProfileCmd.MustGetCobraCommand())
 }
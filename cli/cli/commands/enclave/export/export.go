@@ -0,0 +1,108 @@
+package export
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_manifest"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"strings"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputFilepathFlagKey = "output"
+	// Empty means the manifest gets printed to STDOUT
+	defaultOutputFilepath = ""
+
+	manifestFilePermissions os.FileMode = 0644
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var EnclaveExportCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveExportCmdStr,
+	ShortDescription: "Exports an enclave to a YAML manifest",
+	LongDescription: "Exports the services, their configs, the files artifacts they mount and the subnetwork connections of " +
+		"the enclave to a declarative YAML manifest, that '" + command_str_consts.KurtosisCmdStr + " " + command_str_consts.ApplyCmdStr + "' can recreate the enclave from",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       outputFilepathFlagKey,
+			Usage:     "The file to write the manifest to; if unset, the manifest is printed to STDOUT",
+			Shorthand: "o",
+			Type:      flags.FlagType_String,
+			Default:   defaultOutputFilepath,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	outputFilepath, err := flags.GetString(outputFilepathFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output filepath using flag key '%v'", outputFilepathFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	manifest, err := enclave_manifest.NewEnclaveManifestFromEnclave(ctx, enclaveCtx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the manifest of enclave '%v'", enclaveIdentifier)
+	}
+	manifestBytes, err := manifest.Serialize()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the manifest of enclave '%v'", enclaveIdentifier)
+	}
+
+	if outputFilepath == defaultOutputFilepath {
+		out.PrintOutLn(strings.TrimSuffix(string(manifestBytes), "\n"))
+		return nil
+	}
+	if err := os.WriteFile(outputFilepath, manifestBytes, manifestFilePermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the manifest of enclave '%v' to '%v'", enclaveIdentifier, outputFilepath)
+	}
+	logrus.Infof("Exported enclave '%v' to '%v'", enclaveIdentifier, outputFilepath)
+	return nil
+}
@@ -37,22 +37,28 @@ const (
 	enclaveNameTitleName         = "Name"
 	enclaveStatusTitleName       = "Status"
 	enclaveCreationTimeTitleName = "Creation Time"
+	enclaveIpRangeTitleName      = "IP Range"
 
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	showSystemFlagKey     = "show-system"
+	showSystemFlagDefault = "false"
+
 	headerWidthChars = 100
 	headerPadChar    = "="
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 
-	filesArtifactsHeader = "Files Artifacts"
+	filesArtifactsHeader   = "Files Artifacts"
+	systemContainersHeader = "Kurtosis System Containers"
 )
 
 var enclaveObjectPrintingFuncs = map[string]func(ctx context.Context, kurtosisCtx *kurtosis_context.KurtosisContext, kurtosisBackend backend_interface.KurtosisBackend, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuid bool, isAPIContainerRunning bool) error{
-	"User Services":      printUserServices,
-	filesArtifactsHeader: printFilesArtifacts,
+	"User Services":        printUserServices,
+	filesArtifactsHeader:   printFilesArtifacts,
+	systemContainersHeader: printSystemContainers,
 }
 
 var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -68,6 +74,12 @@ var EnclaveInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtos
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:     showSystemFlagKey,
+			Usage:   "If true then Kurtosis also prints the containers it runs for itself, e.g. the API container and networking sidecars, with their images and ports, to debug Kurtosis. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: showSystemFlagDefault,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -98,19 +110,24 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
+	showSystemContainers, err := flags.GetBool(showSystemFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", showSystemFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
 	}
 
-	if err = PrintEnclaveInspect(ctx, kurtosisBackend, kurtosisCtx, enclaveIdentifier, showFullUuids); err != nil {
+	if err = PrintEnclaveInspect(ctx, kurtosisBackend, kurtosisCtx, enclaveIdentifier, showFullUuids, showSystemContainers); err != nil {
 		// this is already wrapped up
 		return err
 	}
 	return nil
 }
 
-func PrintEnclaveInspect(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, showFullUuids bool) error {
+func PrintEnclaveInspect(ctx context.Context, kurtosisBackend backend_interface.KurtosisBackend, kurtosisCtx *kurtosis_context.KurtosisContext, enclaveIdentifier string, showFullUuids bool, showSystemContainers bool) error {
 	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the enclave for identifier '%v'", enclaveIdentifier)
@@ -143,6 +160,10 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisBackend backend_interface.
 		keyValuePrinter.AddPair(enclaveCreationTimeTitleName, enclaveCreationTimeStr)
 	}
 
+	if enclaveIpRange := enclaveInfo.GetIpRange(); enclaveIpRange != "" {
+		keyValuePrinter.AddPair(enclaveIpRangeTitleName, enclaveIpRange)
+	}
+
 	isApiContainerRunning := enclaveApiContainerStatus == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING
 
 	keyValuePrinter.Print()
@@ -150,10 +171,18 @@ func PrintEnclaveInspect(ctx context.Context, kurtosisBackend backend_interface.
 
 	sortedEnclaveObjHeaders := []string{}
 	for header := range enclaveObjectPrintingFuncs {
+		if header == systemContainersHeader {
+			continue
+		}
 		sortedEnclaveObjHeaders = append(sortedEnclaveObjHeaders, header)
 	}
 	sort.Strings(sortedEnclaveObjHeaders)
 
+	// The system containers get printed last, to keep them apart from what the user put in the enclave
+	if showSystemContainers {
+		sortedEnclaveObjHeaders = append(sortedEnclaveObjHeaders, systemContainersHeader)
+	}
+
 	headersWithPrintErrs := []string{}
 	for _, header := range sortedEnclaveObjHeaders {
 		if header == filesArtifactsHeader && !isApiContainerRunning {
@@ -0,0 +1,93 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+	"strings"
+)
+
+const (
+	systemContainerKindColHeader    = "Kind"
+	systemContainerNameColHeader    = "Name"
+	systemContainerImageColHeader   = "Image"
+	systemContainerVersionColHeader = "Version"
+	systemContainerStatusColHeader  = "Status"
+	systemContainerPortsColHeader   = "Ports"
+
+	imageTagSeparator    = ":"
+	imageDigestSeparator = "@"
+	imagePathSeparator   = "/"
+	// Docker pulls the 'latest' tag of images referenced without one
+	defaultImageVersion = "latest"
+
+	publishedPortSeparator = " -> "
+)
+
+func printSystemContainers(ctx context.Context, _ *kurtosis_context.KurtosisContext, kurtosisBackend backend_interface.KurtosisBackend, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, _ bool, _ bool) error {
+	enclaveUuid := enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())
+	systemContainers, err := kurtosisBackend.GetEnclaveSystemContainers(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the system containers of enclave '%v'", enclaveUuid)
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		systemContainerKindColHeader,
+		systemContainerNameColHeader,
+		systemContainerImageColHeader,
+		systemContainerVersionColHeader,
+		systemContainerStatusColHeader,
+		systemContainerPortsColHeader,
+	)
+	for _, systemContainer := range systemContainers {
+		portLines := getSystemContainerPortLines(systemContainer.GetPublishedPorts())
+		if err := tablePrinter.AddRow(
+			systemContainer.GetKind(),
+			systemContainer.GetName(),
+			systemContainer.GetImageName(),
+			getImageVersion(systemContainer.GetImageName()),
+			colorizeServiceStatus(systemContainer.GetStatus().String()),
+			portLines[0],
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding row for system container '%v' to the table printer", systemContainer.GetName())
+		}
+		for _, additionalPortLine := range portLines[1:] {
+			if err := tablePrinter.AddRow("", "", "", "", "", additionalPortLine); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding additional port row '%v' for system container '%v' to the table printer", additionalPortLine, systemContainer.GetName())
+			}
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+// getImageVersion returns the tag of the image, which is the version for the Kurtosis images
+func getImageVersion(imageName string) string {
+	if digestIndex := strings.Index(imageName, imageDigestSeparator); digestIndex >= 0 {
+		imageName = imageName[:digestIndex]
+	}
+	tagIndex := strings.LastIndex(imageName, imageTagSeparator)
+	// A colon before the last slash is the port of the registry, not a tag
+	if tagIndex < 0 || tagIndex < strings.LastIndex(imageName, imagePathSeparator) {
+		return defaultImageVersion
+	}
+	return imageName[tagIndex+1:]
+}
+
+func getSystemContainerPortLines(publishedPorts map[string]string) []string {
+	if len(publishedPorts) == 0 {
+		return []string{missingPortPlaceholder}
+	}
+	portLines := []string{}
+	for privatePort, publicAddress := range publishedPorts {
+		portLines = append(portLines, fmt.Sprintf("%s%s%s", privatePort, publishedPortSeparator, publicAddress))
+	}
+	sort.Strings(portLines)
+	return portLines
+}
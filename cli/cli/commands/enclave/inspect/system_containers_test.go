@@ -0,0 +1,22 @@
+package inspect
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetImageVersion(t *testing.T) {
+	require.Equal(t, "0.68.6", getImageVersion("kurtosistech/core:0.68.6"))
+	require.Equal(t, "0.68.6", getImageVersion("registry.example.com:5000/kurtosistech/engine:0.68.6"))
+	require.Equal(t, "latest", getImageVersion("registry.example.com:5000/kurtosistech/iproute2"))
+	require.Equal(t, "1.0", getImageVersion("alpine:1.0@sha256:0123456789abcdef"))
+}
+
+func TestGetSystemContainerPortLines(t *testing.T) {
+	require.Equal(t, []string{missingPortPlaceholder}, getSystemContainerPortLines(map[string]string{}))
+	portLines := getSystemContainerPortLines(map[string]string{
+		"7444/tcp": "127.0.0.1:53423",
+		"7443/tcp": "127.0.0.1:53422",
+	})
+	require.Equal(t, []string{"7443/tcp -> 127.0.0.1:53422", "7444/tcp -> 127.0.0.1:53423"}, portLines)
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc"
 	"sort"
 	"strings"
+	"time"
 )
 
 const (
@@ -25,12 +26,19 @@ const (
 	userServiceNameColHeader      = "Name"
 	userServicePortsColHeader     = "Ports"
 	userServiceStatusColHeader    = "Status"
+	userServiceStartupColHeader   = "Startup"
 	defaultEmptyIPAddrForServices = ""
 	emptyApplicationProtocol      = ""
 	missingPortPlaceholder        = "<none>"
 	linkDelimeter                 = "://"
 	defaultEmptyIPAddrForAPIC     = ""
 
+	missingStartupTimesPlaceholder = "<unknown>"
+	// The container creation time is only reported to the second by some backends
+	startupDurationPrecision       = time.Second
+	readinessWaitDurationPrecision = 100 * time.Millisecond
+	startupDurationsSeparator      = ", "
+
 	statusRunning = "RUNNING"
 	statusStopped = "STOPPED"
 )
@@ -68,6 +76,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		userServiceNameColHeader,
 		userServicePortsColHeader,
 		userServiceStatusColHeader,
+		userServiceStartupColHeader,
 	)
 	sortedUserServices := getSortedUserServiceSliceFromUserServiceMap(userServices)
 	for _, userService := range sortedUserServices {
@@ -83,12 +92,14 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		// Look for public port and IP information in API container map
 		maybePublicPortMapFromAPIC := map[string]*kurtosis_core_rpc_api_bindings.Port{}
 		maybePublicIpAddrFromAPIC := defaultEmptyIPAddrForAPIC
+		startupStr := missingStartupTimesPlaceholder
 		serviceInfoFromAPIC, found := serviceInfoMapFromAPIC[serviceIdStr]
 		if found {
 			// Set public port from API container information
 			maybePublicPortMapFromAPIC = serviceInfoFromAPIC.GetMaybePublicPorts()
 			// Set public IP address from API container information
 			maybePublicIpAddrFromAPIC = serviceInfoFromAPIC.GetMaybePublicIpAddr()
+			startupStr = getUserServiceStartupString(serviceInfoFromAPIC)
 		}
 
 		portBindingLines, err := getUserServicePortBindingStrings(userService, maybePublicPortMapFromAPIC, maybePublicIpAddrFromAPIC)
@@ -98,7 +109,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		firstPortBindingLine := portBindingLines[0]
 		additionalPortBindingLines := portBindingLines[1:]
 
-		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, serviceStatusStr); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, serviceStatusStr, startupStr); err != nil {
 			return stacktrace.Propagate(
 				err,
 				"An error occurred adding row for user service with UUID '%v' to the table printer",
@@ -107,7 +118,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		}
 
 		for _, additionalPortBindingLine := range additionalPortBindingLines {
-			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, ""); err != nil {
+			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, "", ""); err != nil {
 				return stacktrace.Propagate(
 					err,
 					"An error occurred adding additional port binding row '%v' for user service with UUID '%v' to the table printer",
@@ -194,6 +205,24 @@ func getUserServicePortBindingStrings(userService *service.Service,
 	return result, nil
 }
 
+// getUserServiceStartupString describes how long the service's container took to enter running after being created, and
+// how long the service then waited for its ready conditions to pass
+func getUserServiceStartupString(serviceInfo *kurtosis_core_rpc_api_bindings.ServiceInfo) string {
+	startupDurationStrs := []string{}
+	if serviceInfo.GetCreationTime() != nil && serviceInfo.GetRunningTime() != nil {
+		startupDuration := serviceInfo.GetRunningTime().AsTime().Sub(serviceInfo.GetCreationTime().AsTime())
+		startupDurationStrs = append(startupDurationStrs, fmt.Sprintf("running in %v", startupDuration.Round(startupDurationPrecision)))
+	}
+	if serviceInfo.GetRunningTime() != nil && serviceInfo.GetReadyTime() != nil {
+		readinessWaitDuration := serviceInfo.GetReadyTime().AsTime().Sub(serviceInfo.GetRunningTime().AsTime())
+		startupDurationStrs = append(startupDurationStrs, fmt.Sprintf("ready %v later", readinessWaitDuration.Round(readinessWaitDurationPrecision)))
+	}
+	if len(startupDurationStrs) == 0 {
+		return missingStartupTimesPlaceholder
+	}
+	return strings.Join(startupDurationStrs, startupDurationsSeparator)
+}
+
 func getUserServiceInfoMapFromAPIContainer(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	apicHostMachineIp, apicHostMachineGrpcPort, err := enclave_liveness_validator.ValidateEnclaveLiveness(enclaveInfo)
 	if err != nil {
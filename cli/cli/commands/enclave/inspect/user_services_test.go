@@ -0,0 +1,28 @@
+package inspect
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+	"time"
+)
+
+func TestUserServices_getUserServiceStartupString(t *testing.T) {
+	creationTime := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	runningTime := creationTime.Add(2*time.Second + 300*time.Millisecond)
+	readyTime := runningTime.Add(4*time.Second + 140*time.Millisecond)
+
+	serviceInfo := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		CreationTime: timestamppb.New(creationTime),
+		RunningTime:  timestamppb.New(runningTime),
+		ReadyTime:    timestamppb.New(readyTime),
+	}
+	require.Equal(t, "running in 2s, ready 4.1s later", getUserServiceStartupString(serviceInfo))
+
+	serviceInfo.ReadyTime = nil
+	require.Equal(t, "running in 2s", getUserServiceStartupString(serviceInfo))
+
+	serviceInfo.RunningTime = nil
+	require.Equal(t, missingStartupTimesPlaceholder, getUserServiceStartupString(serviceInfo))
+}
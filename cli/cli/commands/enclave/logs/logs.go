@@ -0,0 +1,415 @@
+package logs
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grouped_errors_presenter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputDirFlagKey = "output-dir"
+	// Empty means a directory named after the enclave, in the current directory
+	defaultOutputDir = ""
+
+	servicesFlagKey = "services"
+	// Empty means all the services, including the removed ones
+	defaultServices         = ""
+	serviceIdentifiersDelim = ","
+
+	gzipFlagKey = "gzip"
+
+	parallelismFlagKey = "parallelism"
+	defaultParallelism = "4"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	outputDirNameSeparator = "--"
+	outputDirNameSuffix    = "logs"
+	logFileNameSeparator   = "--"
+	logFileExtension       = ".log"
+	gzipFileExtension      = ".gz"
+
+	createdDirPerms  = 0755
+	createdFilePerms = 0644
+
+	shouldNotFollowLogs = false
+
+	servicesNoun = "services"
+)
+
+var defaultShouldGzip = strconv.FormatBool(false)
+
+var doNotFilterLogLines *kurtosis_context.LogLineFilter = nil
+
+var EnclaveLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveLogsCmdStr,
+	ShortDescription: "Downloads the logs of the services in an enclave",
+	LongDescription: fmt.Sprintf(
+		"Downloads the logs of all the services of an enclave (or only the ones passed in the '%v' flag), including "+
+			"the removed ones, to one file per service, e.g. to archive them as the build artifacts of a CI job",
+		servicesFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     outputDirFlagKey,
+			Usage:   "The directory to write the log files to; defaults to a directory named after the enclave in the current directory",
+			Type:    flags.FlagType_String,
+			Default: defaultOutputDir,
+		},
+		{
+			Key:     servicesFlagKey,
+			Usage:   fmt.Sprintf("Comma-separated identifiers (names, UUIDs or shortened UUIDs) of the services to download the logs of; defaults to all of them (e.g. 'service1%vservice2')", serviceIdentifiersDelim),
+			Type:    flags.FlagType_String,
+			Default: defaultServices,
+		},
+		{
+			Key:     gzipFlagKey,
+			Usage:   "Gzips the log files",
+			Type:    flags.FlagType_Bool,
+			Default: defaultShouldGzip,
+		},
+		{
+			Key:     parallelismFlagKey,
+			Usage:   "The number of services whose logs get downloaded at the same time",
+			Type:    flags.FlagType_Uint32,
+			Default: defaultParallelism,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+// writeLogsFunc writes all the logs of a service to the given writer
+type writeLogsFunc func(writer io.Writer) error
+
+func run(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	outputDirpath, err := flags.GetString(outputDirFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the output dir using flag key '%v'", outputDirFlagKey)
+	}
+
+	servicesStr, err := flags.GetString(servicesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services using flag key '%v'", servicesFlagKey)
+	}
+
+	shouldGzip, err := flags.GetBool(gzipFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the gzip flag using key '%v'", gzipFlagKey)
+	}
+
+	parallelism, err := flags.GetUint32(parallelismFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the parallelism using flag key '%v'", parallelismFlagKey)
+	}
+	if parallelism == 0 {
+		return stacktrace.NewError("The '%v' flag must be greater than zero", parallelismFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveInfo, err := kurtosisCtx.GetEnclave(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave for identifier '%v'", enclaveIdentifier)
+	}
+	enclaveUuid := enclaveInfo.GetEnclaveUuid()
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	serviceIdentifiers, err := enclaveCtx.GetExistingAndHistoricalServiceIdentifiers(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers of enclave '%v'", enclaveIdentifier)
+	}
+	serviceNamesByUuid, err := getServiceNamesByUuidToDownload(serviceIdentifiers, servicesStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to download the logs of")
+	}
+	if len(serviceNamesByUuid) == 0 {
+		logrus.Infof("Enclave '%v' has no services, so there are no logs to download", enclaveIdentifier)
+		return nil
+	}
+
+	if outputDirpath == defaultOutputDir {
+		outputDirpath = strings.Join([]string{enclaveInfo.GetName(), enclaveUuid, outputDirNameSuffix}, outputDirNameSeparator)
+	}
+	if err := os.MkdirAll(outputDirpath, createdDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating output directory '%v'", outputDirpath)
+	}
+
+	writeLogsFuncsByServiceUuid, err := getWriteLogsFuncs(ctx, kurtosisCtx, kurtosisBackend, enclaveUuid, serviceNamesByUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs of the services in enclave '%v'", enclaveIdentifier)
+	}
+
+	logrus.Infof("Downloading the logs of %v services of enclave '%v'...", len(serviceNamesByUuid), enclaveIdentifier)
+	logsDownloadErrors := downloadLogsInParallel(writeLogsFuncsByServiceUuid, serviceNamesByUuid, outputDirpath, shouldGzip, parallelism)
+	if len(logsDownloadErrors) > 0 {
+		errorStr := fmt.Sprintf(
+			"One or more errors occurred downloading the logs of the services:\n%v",
+			grouped_errors_presenter.PresentErrors(logsDownloadErrors, servicesNoun),
+		)
+		return errors.New(errorStr)
+	}
+
+	logrus.Infof("Downloaded the logs of enclave '%v' to directory '%v'", enclaveIdentifier, outputDirpath)
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getServiceNamesByUuidToDownload(serviceIdentifiers *services.ServiceIdentifiers, servicesStr string) (map[services.ServiceUUID]services.ServiceName, error) {
+	allServiceNamesByUuid := serviceIdentifiers.GetServiceNamesByUuid()
+	if servicesStr == defaultServices {
+		return allServiceNamesByUuid, nil
+	}
+
+	serviceNamesByUuid := map[services.ServiceUUID]services.ServiceName{}
+	for _, serviceIdentifier := range strings.Split(servicesStr, serviceIdentifiersDelim) {
+		serviceIdentifier = strings.TrimSpace(serviceIdentifier)
+		if serviceIdentifier == "" {
+			continue
+		}
+		serviceUuid, err := serviceIdentifiers.GetServiceUuidForIdentifier(serviceIdentifier)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of service '%v'", serviceIdentifier)
+		}
+		serviceNamesByUuid[serviceUuid] = allServiceNamesByUuid[serviceUuid]
+	}
+	return serviceNamesByUuid, nil
+}
+
+func getWriteLogsFuncs(
+	ctx context.Context,
+	kurtosisCtx *kurtosis_context.KurtosisContext,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid string,
+	serviceNamesByUuid map[services.ServiceUUID]services.ServiceName,
+) (map[services.ServiceUUID]writeLogsFunc, error) {
+	clusterConfig, err := kurtosis_config_getter.GetKurtosisClusterConfig()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Kurtosis cluster config")
+	}
+
+	writeLogsFuncsByServiceUuid := map[services.ServiceUUID]writeLogsFunc{}
+
+	//TODO the Kubernetes engine doesn't implement GetServiceLogs yet, so the logs get read straight from the backend, like
+	// 'service logs' does; related ticket: https://github.com/kurtosis-tech/kurtosis/issues/1069
+	if clusterConfig.GetClusterType() == resolved_config.KurtosisClusterType_Kubernetes {
+		userServiceFilters := &service.ServiceFilters{
+			Names:    nil,
+			UUIDs:    map[service.ServiceUUID]bool{},
+			Statuses: nil,
+		}
+		for serviceUuid := range serviceNamesByUuid {
+			userServiceFilters.UUIDs[service.ServiceUUID(serviceUuid)] = true
+		}
+		successfulUserServiceLogs, erroredUserServiceUuids, err := kurtosisBackend.GetUserServiceLogs(ctx, enclave.EnclaveUUID(enclaveUuid), userServiceFilters, shouldNotFollowLogs, service.NewUnboundedServiceLogsTimeRange())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting user service logs using filters '%+v'", userServiceFilters)
+		}
+		for serviceUuid, err := range erroredUserServiceUuids {
+			getLogsErr := err
+			writeLogsFuncsByServiceUuid[services.ServiceUUID(serviceUuid)] = func(_ io.Writer) error {
+				return stacktrace.Propagate(getLogsErr, "An error occurred getting the logs of the service")
+			}
+		}
+		for serviceUuid, userServiceLogsReadCloser := range successfulUserServiceLogs {
+			readCloser := userServiceLogsReadCloser
+			writeLogsFuncsByServiceUuid[services.ServiceUUID(serviceUuid)] = func(writer io.Writer) error {
+				defer func() {
+					if err := readCloser.Close(); err != nil {
+						logrus.Warnf("We tried to close the user service logs read-closer-object after we're done using it, but doing so threw an error:\n%v", err)
+					}
+				}()
+				if _, err := io.Copy(writer, readCloser); err != nil {
+					return stacktrace.Propagate(err, "An error occurred copying the service logs")
+				}
+				return nil
+			}
+		}
+		for serviceUuid := range serviceNamesByUuid {
+			if _, found := writeLogsFuncsByServiceUuid[serviceUuid]; !found {
+				// Removed services are gone from Kubernetes along with their logs
+				writeLogsFuncsByServiceUuid[serviceUuid] = func(_ io.Writer) error {
+					return stacktrace.NewError("No logs were found for the service; this can happen if it was removed")
+				}
+			}
+		}
+		return writeLogsFuncsByServiceUuid, nil
+	}
+
+	for serviceUuid := range serviceNamesByUuid {
+		serviceUuidToDownload := serviceUuid
+		writeLogsFuncsByServiceUuid[serviceUuid] = func(writer io.Writer) error {
+			return writeServiceLogsFromEngine(ctx, kurtosisCtx, enclaveUuid, serviceUuidToDownload, writer)
+		}
+	}
+	return writeLogsFuncsByServiceUuid, nil
+}
+
+func writeServiceLogsFromEngine(
+	ctx context.Context,
+	kurtosisCtx *kurtosis_context.KurtosisContext,
+	enclaveUuid string,
+	serviceUuid services.ServiceUUID,
+	writer io.Writer,
+) error {
+	userServiceUuids := map[services.ServiceUUID]bool{
+		serviceUuid: true,
+	}
+	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveUuid, userServiceUuids, shouldNotFollowLogs, doNotFilterLogLines)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs of the service")
+	}
+	defer cancelStreamUserServiceLogsFunc()
+
+	// The channel gets closed once the engine sent all the logs
+	for serviceLogsStreamContent := range serviceLogsStreamContentChan {
+		if _, found := serviceLogsStreamContent.GetNotFoundServiceUuids()[serviceUuid]; found {
+			return stacktrace.NewError("The Kurtosis centralized logs system doesn't contain any logs for the service")
+		}
+		for _, serviceLog := range serviceLogsStreamContent.GetServiceLogsByServiceUuids()[serviceUuid] {
+			if _, err := fmt.Fprintln(writer, serviceLog.GetContent()); err != nil {
+				return stacktrace.Propagate(err, "An error occurred writing a log line of the service")
+			}
+		}
+	}
+	return nil
+}
+
+func downloadLogsInParallel(
+	writeLogsFuncsByServiceUuid map[services.ServiceUUID]writeLogsFunc,
+	serviceNamesByUuid map[services.ServiceUUID]services.ServiceName,
+	outputDirpath string,
+	shouldGzip bool,
+	parallelism uint32,
+) map[string]error {
+	serviceUuids := []string{}
+	for serviceUuid := range writeLogsFuncsByServiceUuid {
+		serviceUuids = append(serviceUuids, string(serviceUuid))
+	}
+	sort.Strings(serviceUuids)
+
+	wg := sync.WaitGroup{}
+	concurrencyControlChan := make(chan bool, parallelism)
+	logsDownloadErrorsMutex := sync.Mutex{}
+	logsDownloadErrors := map[string]error{}
+	for _, serviceUuidStr := range serviceUuids {
+		serviceUuid := services.ServiceUUID(serviceUuidStr)
+		serviceName := serviceNamesByUuid[serviceUuid]
+		logFilepath := path.Join(outputDirpath, getLogFilename(serviceName, serviceUuid, shouldGzip))
+		writeLogs := writeLogsFuncsByServiceUuid[serviceUuid]
+
+		concurrencyControlChan <- true
+		wg.Add(1)
+		go func() {
+			defer func() {
+				wg.Done()
+				<-concurrencyControlChan
+			}()
+			if err := writeLogsFile(logFilepath, shouldGzip, writeLogs); err != nil {
+				logsDownloadErrorsMutex.Lock()
+				defer logsDownloadErrorsMutex.Unlock()
+				logsDownloadErrors[fmt.Sprintf("%v (%v)", serviceName, serviceUuid)] = stacktrace.Propagate(err, "An error occurred downloading the logs of service '%v' to '%v'", serviceName, logFilepath)
+				return
+			}
+			logrus.Debugf("Downloaded the logs of service '%v' to '%v'", serviceName, logFilepath)
+		}()
+	}
+	wg.Wait()
+	return logsDownloadErrors
+}
+
+// Names can get reused by services added after the removal of another one, so the filename has the UUID too
+func getLogFilename(serviceName services.ServiceName, serviceUuid services.ServiceUUID, shouldGzip bool) string {
+	filename := string(serviceName) + logFileNameSeparator + uuid_generator.ShortenedUUIDString(string(serviceUuid)) + logFileExtension
+	if shouldGzip {
+		filename += gzipFileExtension
+	}
+	return filename
+}
+
+func writeLogsFile(logFilepath string, shouldGzip bool, writeLogs writeLogsFunc) (resultErr error) {
+	logFile, err := os.OpenFile(logFilepath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, createdFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating log file '%v'", logFilepath)
+	}
+	defer func() {
+		if err := logFile.Close(); err != nil && resultErr == nil {
+			resultErr = stacktrace.Propagate(err, "An error occurred closing log file '%v'", logFilepath)
+		}
+	}()
+
+	if !shouldGzip {
+		return writeLogs(logFile)
+	}
+
+	gzipWriter := gzip.NewWriter(logFile)
+	if err := writeLogs(gzipWriter); err != nil {
+		return err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred finishing the gzipping of log file '%v'", logFilepath)
+	}
+	return nil
+}
@@ -7,6 +7,9 @@ package ls
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
@@ -15,10 +18,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/enclave_status_stringifier"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -28,6 +35,10 @@ const (
 	enclaveStatusColumnHeader       = "Status"
 	enclaveNameColumnHeader         = "Name"
 	enclaveCreationTimeColumnHeader = "Creation Time"
+	enclaveServicesColumnHeader     = "Services"
+	enclaveMemoryColumnHeader       = "Memory"
+	enclavePortsColumnHeader        = "Published Ports"
+	enclaveDiskColumnHeader         = "Disk"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -35,13 +46,48 @@ const (
 	fullUuidsFlagKey       = "full-uuids"
 	fullUuidFlagKeyDefault = "false"
 
+	outputFormatFlagKey   = "output"
+	tableOutputFormat     = "table"
+	jsonOutputFormat      = "json"
+	defaultOutputFormat   = tableOutputFormat
+	jsonOutputIndentation = "  "
+
 	emptyTimeForOldEnclaves = ""
+
+	// Shown in the resource columns when the backend couldn't report them
+	unknownResourceUsageStr = "?"
+
+	bytesInMegabyte = 1024 * 1024
 )
 
+// enclaveResourceUsageJson is how the resources used by an enclave, or by all of them, get printed in JSON
+type enclaveResourceUsageJson struct {
+	NumServices                    uint32 `json:"numServices"`
+	TotalMemoryAllocationMegabytes uint64 `json:"totalMemoryAllocationMegabytes"`
+	NumPublishedPorts              uint32 `json:"numPublishedPorts"`
+	// -1 if unknown
+	DiskUsageBytes int64 `json:"diskUsageBytes"`
+}
+
+type enclaveJson struct {
+	Uuid   string `json:"uuid"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Empty for old enclaves that didn't track it
+	CreationTime  string                    `json:"creationTime"`
+	ResourceUsage *enclaveResourceUsageJson `json:"resourceUsage"`
+}
+
+type enclavesListJson struct {
+	Enclaves []*enclaveJson `json:"enclaves"`
+	// Sum of the resources used by all the enclaves
+	Totals *enclaveResourceUsageJson `json:"totals"`
+}
+
 var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveLsCmdStr,
 	ShortDescription:          "Lists enclaves",
-	LongDescription:           "Lists the enclaves running in the Kurtosis engine",
+	LongDescription:           "Lists the enclaves running in the Kurtosis engine, along with the resources (services, memory allocation, published ports and disk) each of them uses",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -51,6 +97,12 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:    flags.FlagType_Bool,
 			Default: fullUuidFlagKeyDefault,
 		},
+		{
+			Key:     outputFormatFlagKey,
+			Usage:   fmt.Sprintf("The format to print the enclaves in: '%v', or '%v' for a machine-readable listing that includes the resource totals", tableOutputFormat, jsonOutputFormat),
+			Type:    flags.FlagType_String,
+			Default: defaultOutputFormat,
+		},
 	},
 	Args:    nil,
 	RunFunc: run,
@@ -58,7 +110,7 @@ var EnclaveLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 
 func run(
 	ctx context.Context,
-	_ backend_interface.KurtosisBackend,
+	kurtosisBackend backend_interface.KurtosisBackend,
 	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	_ metrics_client.MetricsClient,
 	flags *flags.ParsedFlags,
@@ -79,57 +131,161 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
 	}
 
-	tablePrinter := output_printers.NewTablePrinter(enclaveUuidColumnHeader, enclaveNameColumnHeader, enclaveStatusColumnHeader, enclaveCreationTimeColumnHeader)
+	outputFormat, err := flags.GetString(outputFormatFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputFormatFlagKey)
+	}
+	if outputFormat != tableOutputFormat && outputFormat != jsonOutputFormat {
+		return stacktrace.NewError("Invalid value '%v' for the '%v' flag; valid values are '%v' and '%v'", outputFormat, outputFormatFlagKey, tableOutputFormat, jsonOutputFormat)
+	}
+
+	resourceUsageByEnclaveUuid, err := kurtosisBackend.GetEnclavesResourceUsage(ctx, getAllEnclavesFilter())
+	if err != nil {
+		logrus.Warnf("An error occurred getting the resources used by the enclaves, so they won't be shown:\n%v", err)
+		resourceUsageByEnclaveUuid = map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage{}
+	}
+
 	orderedEnclaveInfoMaps, enclaveWithoutCreationTimeInfoMap := getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(enclaves.GetEnclavesByUuid())
 
+	enclavesToList := []*enclaveJson{}
 	//TODO remove this iteration after 2023-01-01 when we are sure that there is not any old enclave created without the creation time label
 	//This is for retro-compatibility, for those old enclave did not track enclave's creation time
 	for _, enclaveInfo := range enclaveWithoutCreationTimeInfoMap {
-		enclaveUuid := enclaveInfo.GetEnclaveUuid()
-		uuidToPrint := enclaveInfo.GetShortenedUuid()
-		if showFullUuids {
-			uuidToPrint = enclaveUuid
-		}
-
-		enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusStringifier(enclaveInfo.GetContainersStatus())
+		enclaveToList, err := newEnclaveJson(enclaveInfo, emptyTimeForOldEnclaves, showFullUuids, resourceUsageByEnclaveUuid)
 		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
-		}
-
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveInfo.Name, enclaveStatus, emptyTimeForOldEnclaves); err != nil {
-			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
+			return stacktrace.Propagate(err, "An error occurred getting the information to list of enclave '%v'", enclaveInfo.GetEnclaveUuid())
 		}
+		enclavesToList = append(enclavesToList, enclaveToList)
 	}
 	//Retro-compatibility ends
 
 	for _, enclaveInfo := range orderedEnclaveInfoMaps {
-
-		enclaveUuid := enclaveInfo.GetEnclaveUuid()
-		uuidToPrint := enclaveInfo.GetShortenedUuid()
-		if showFullUuids {
-			uuidToPrint = enclaveUuid
+		// The extra space is a hack till we figure out the table printer color + formatting story
+		enclaveCreationTime := " " + enclaveInfo.CreationTime.AsTime().Local().Format(time.RFC1123)
+		enclaveToList, err := newEnclaveJson(enclaveInfo, enclaveCreationTime, showFullUuids, resourceUsageByEnclaveUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the information to list of enclave '%v'", enclaveInfo.GetEnclaveUuid())
 		}
+		enclavesToList = append(enclavesToList, enclaveToList)
+	}
 
-		enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusStringifier(enclaveInfo.GetContainersStatus())
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
+	if outputFormat == jsonOutputFormat {
+		return printEnclavesJson(enclavesToList)
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		enclaveUuidColumnHeader,
+		enclaveNameColumnHeader,
+		enclaveStatusColumnHeader,
+		enclaveCreationTimeColumnHeader,
+		enclaveServicesColumnHeader,
+		enclaveMemoryColumnHeader,
+		enclavePortsColumnHeader,
+		enclaveDiskColumnHeader,
+	)
+	for _, enclaveToList := range enclavesToList {
+		servicesStr, memoryStr, portsStr, diskStr := getResourceUsageStrs(enclaveToList.ResourceUsage)
+		if err := tablePrinter.AddRow(enclaveToList.Uuid, enclaveToList.Name, enclaveToList.Status, enclaveToList.CreationTime, servicesStr, memoryStr, portsStr, diskStr); err != nil {
+			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveToList.Uuid)
 		}
+	}
 
-		// The extra space is a hack till we figure out the table printer color + formatting story
-		enclaveCreationTime := " " + enclaveInfo.CreationTime.AsTime().Local().Format(time.RFC1123)
+	tablePrinter.Print()
 
-		enclaveName := enclaveInfo.GetName()
+	return nil
+}
 
-		if err := tablePrinter.AddRow(uuidToPrint, enclaveName, enclaveStatus, enclaveCreationTime); err != nil {
-			return stacktrace.NewError("An error occurred adding row for enclave '%v' to the table printer", enclaveUuid)
+func newEnclaveJson(
+	enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo,
+	creationTime string,
+	showFullUuids bool,
+	resourceUsageByEnclaveUuid map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage,
+) (*enclaveJson, error) {
+	enclaveUuid := enclaveInfo.GetEnclaveUuid()
+	uuidToPrint := enclaveInfo.GetShortenedUuid()
+	if showFullUuids {
+		uuidToPrint = enclaveUuid
+	}
+
+	enclaveStatus, err := enclave_status_stringifier.EnclaveContainersStatusStringifier(enclaveInfo.GetContainersStatus())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when stringify enclave containers status '%v'", enclaveInfo.GetContainersStatus())
+	}
+
+	var resourceUsageJson *enclaveResourceUsageJson
+	if resourceUsage, found := resourceUsageByEnclaveUuid[enclave.EnclaveUUID(enclaveUuid)]; found {
+		resourceUsageJson = &enclaveResourceUsageJson{
+			NumServices:                    resourceUsage.GetNumServices(),
+			TotalMemoryAllocationMegabytes: resourceUsage.GetTotalMemoryAllocationMegabytes(),
+			NumPublishedPorts:              resourceUsage.GetNumPublishedPorts(),
+			DiskUsageBytes:                 resourceUsage.GetDiskUsageBytes(),
 		}
 	}
 
-	tablePrinter.Print()
+	return &enclaveJson{
+		Uuid:          uuidToPrint,
+		Name:          enclaveInfo.GetName(),
+		Status:        enclaveStatus,
+		CreationTime:  creationTime,
+		ResourceUsage: resourceUsageJson,
+	}, nil
+}
 
+func printEnclavesJson(enclavesToList []*enclaveJson) error {
+	totals := &enclaveResourceUsageJson{
+		NumServices:                    0,
+		TotalMemoryAllocationMegabytes: 0,
+		NumPublishedPorts:              0,
+		DiskUsageBytes:                 0,
+	}
+	for _, enclaveToList := range enclavesToList {
+		enclaveToList.CreationTime = strings.TrimSpace(enclaveToList.CreationTime)
+		resourceUsage := enclaveToList.ResourceUsage
+		if resourceUsage == nil {
+			continue
+		}
+		totals.NumServices += resourceUsage.NumServices
+		totals.TotalMemoryAllocationMegabytes += resourceUsage.TotalMemoryAllocationMegabytes
+		totals.NumPublishedPorts += resourceUsage.NumPublishedPorts
+		if resourceUsage.DiskUsageBytes == enclave.UnknownDiskUsageBytes || totals.DiskUsageBytes == enclave.UnknownDiskUsageBytes {
+			totals.DiskUsageBytes = enclave.UnknownDiskUsageBytes
+		} else {
+			totals.DiskUsageBytes += resourceUsage.DiskUsageBytes
+		}
+	}
+
+	enclavesListJsonBytes, err := json.MarshalIndent(&enclavesListJson{
+		Enclaves: enclavesToList,
+		Totals:   totals,
+	}, "", jsonOutputIndentation)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclaves to JSON")
+	}
+	out.PrintOutLn(string(enclavesListJsonBytes))
 	return nil
 }
 
+func getResourceUsageStrs(resourceUsage *enclaveResourceUsageJson) (string, string, string, string) {
+	if resourceUsage == nil {
+		return unknownResourceUsageStr, unknownResourceUsageStr, unknownResourceUsageStr, unknownResourceUsageStr
+	}
+	diskStr := unknownResourceUsageStr
+	if resourceUsage.DiskUsageBytes != enclave.UnknownDiskUsageBytes {
+		diskStr = units.HumanSize(float64(resourceUsage.DiskUsageBytes))
+	}
+	return strconv.FormatUint(uint64(resourceUsage.NumServices), 10),
+		units.HumanSize(float64(resourceUsage.TotalMemoryAllocationMegabytes * bytesInMegabyte)),
+		strconv.FormatUint(uint64(resourceUsage.NumPublishedPorts), 10),
+		diskStr
+}
+
+func getAllEnclavesFilter() *enclave.EnclaveFilters {
+	return &enclave.EnclaveFilters{
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+}
+
 func getOrderedEnclaveInfoMapAndEnclaveWithoutCreationTimeMap(
 	enclaveInfoMap map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo,
 ) (
@@ -0,0 +1,273 @@
+package nettest
+
+import (
+	"context"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+	"strconv"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifiersArgKey       = "services"
+	isServiceIdentifierArgOptional = true
+	isServiceIdentifierArgGreedy   = true
+
+	numProbesFlagKey       = "probes"
+	slowThresholdMsFlagKey = "slow-threshold-ms"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	sourceColumnHeader   = "FROM \\ TO"
+	sameServicePathCell  = "-"
+	blockedPathCell      = "blocked"
+	probeFailedPathCell  = "probe failed"
+	unknownPathCell      = "?"
+	entirePacketLoss     = float32(100)
+	noPacketLoss         = float32(0)
+	latencyDisplayFormat = "%.1fms"
+)
+
+type pathStatus int
+
+const (
+	pathStatusAsExpected pathStatus = iota
+	pathStatusSlow
+	pathStatusUnexpectedlyBlocked
+	pathStatusUnexpectedlyReachable
+	pathStatusProbeFailed
+)
+
+var (
+	defaultNumProbes       = strconv.FormatUint(3, 10)
+	defaultSlowThresholdMs = strconv.FormatUint(100, 10)
+
+	colorizeAsExpected = color.New(color.FgGreen).SprintFunc()
+	colorizeSlow       = color.New(color.FgYellow).SprintFunc()
+	colorizeMismatch   = color.New(color.FgRed).SprintFunc()
+	colorizeUnknown    = color.New(color.FgCyan).SprintFunc()
+)
+
+var EnclaveNettestCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveNettestCmdStr,
+	ShortDescription: "Tests the connectivity between services",
+	LongDescription: "Pings each of the given services, or all the services of the enclave if none is given, from " +
+		"every other one and prints a matrix of the observed paths, highlighting the ones that are slow or that don't " +
+		"match the subnetwork topology. Fails if a path is blocked while the topology allows it, or the other way around",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     numProbesFlagKey,
+			Usage:   "The number of pings sent along each path",
+			Type:    flags.FlagType_Uint32,
+			Default: defaultNumProbes,
+		},
+		{
+			Key:     slowThresholdMsFlagKey,
+			Usage:   "How many milliseconds the average round trip time of a path can exceed the delay expected by the subnetwork topology by before the path gets reported as slow",
+			Type:    flags.FlagType_Uint32,
+			Default: defaultSlowThresholdMs,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifiersArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers using key '%v'", serviceIdentifiersArgKey)
+	}
+
+	numProbes, err := flags.GetUint32(numProbesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the number of probes using key '%v'", numProbesFlagKey)
+	}
+	if numProbes == 0 {
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("The '--%s' flag must be at least 1", numProbesFlagKey)
+	}
+
+	slowThresholdMs, err := flags.GetUint32(slowThresholdMsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the slow threshold using key '%v'", slowThresholdMsFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceIdentifierSet := map[string]bool{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceIdentifierSet[serviceIdentifier] = true
+	}
+	probeResults, err := enclaveCtx.TestConnectivity(ctx, serviceIdentifierSet, numProbes)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred testing the connectivity between services '%v' in enclave '%v'", serviceIdentifiers, enclaveIdentifier)
+	}
+
+	serviceNameSet := map[string]bool{}
+	probeResultsByPath := map[string]map[string]*kurtosis_core_rpc_api_bindings.ConnectivityProbeResult{}
+	for _, probeResult := range probeResults {
+		serviceNameSet[probeResult.GetSourceServiceName()] = true
+		serviceNameSet[probeResult.GetTargetServiceName()] = true
+		if _, found := probeResultsByPath[probeResult.GetSourceServiceName()]; !found {
+			probeResultsByPath[probeResult.GetSourceServiceName()] = map[string]*kurtosis_core_rpc_api_bindings.ConnectivityProbeResult{}
+		}
+		probeResultsByPath[probeResult.GetSourceServiceName()][probeResult.GetTargetServiceName()] = probeResult
+	}
+	if len(serviceNameSet) == 0 {
+		out.PrintOutLn("There are fewer than two services to test the connectivity between")
+		return nil
+	}
+	serviceNames := []string{}
+	for serviceName := range serviceNameSet {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	tablePrinter := output_printers.NewTablePrinter(append([]string{sourceColumnHeader}, serviceNames...)...)
+	mismatchingPaths := []string{}
+	failedProbes := []string{}
+	for _, sourceServiceName := range serviceNames {
+		row := []string{sourceServiceName}
+		for _, targetServiceName := range serviceNames {
+			if sourceServiceName == targetServiceName {
+				row = append(row, sameServicePathCell)
+				continue
+			}
+			probeResult, found := probeResultsByPath[sourceServiceName][targetServiceName]
+			if !found {
+				row = append(row, colorizeUnknown(unknownPathCell))
+				continue
+			}
+			path := fmt.Sprintf("%v -> %v", sourceServiceName, targetServiceName)
+			status := getPathStatus(probeResult, slowThresholdMs)
+			switch status {
+			case pathStatusUnexpectedlyBlocked:
+				mismatchingPaths = append(mismatchingPaths, fmt.Sprintf("%v is blocked but the subnetwork topology allows it", path))
+			case pathStatusUnexpectedlyReachable:
+				mismatchingPaths = append(mismatchingPaths, fmt.Sprintf("%v is reachable but the subnetwork topology blocks it", path))
+			case pathStatusProbeFailed:
+				failedProbes = append(failedProbes, fmt.Sprintf("%v: %v", path, probeResult.GetProbeError()))
+			}
+			row = append(row, colorizePathCell(getPathCell(probeResult), status))
+		}
+		if err := tablePrinter.AddRow(row...); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding the row of service '%v' to the connectivity matrix", sourceServiceName)
+		}
+	}
+	tablePrinter.Print()
+
+	for _, failedProbe := range failedProbes {
+		out.PrintErrLn(fmt.Sprintf("Couldn't probe path %v", failedProbe))
+	}
+	if len(mismatchingPaths) > 0 {
+		for _, mismatchingPath := range mismatchingPaths {
+			out.PrintErrLn(mismatchingPath)
+		}
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("%v path(s) don't match the subnetwork topology", len(mismatchingPaths))
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getPathStatus(probeResult *kurtosis_core_rpc_api_bindings.ConnectivityProbeResult, slowThresholdMs uint32) pathStatus {
+	if probeResult.GetProbeError() != "" {
+		return pathStatusProbeFailed
+	}
+	isExpectedBlocked := probeResult.GetExpectedPacketLossPercentage() >= entirePacketLoss
+	isObservedBlocked := probeResult.GetObservedPacketLossPercentage() >= entirePacketLoss
+	if isObservedBlocked && !isExpectedBlocked {
+		return pathStatusUnexpectedlyBlocked
+	}
+	if !isObservedBlocked && isExpectedBlocked {
+		return pathStatusUnexpectedlyReachable
+	}
+	if !isObservedBlocked && probeResult.GetObservedAvgLatencyMs() > float32(probeResult.GetExpectedPacketDelayMs()+slowThresholdMs) {
+		return pathStatusSlow
+	}
+	return pathStatusAsExpected
+}
+
+func getPathCell(probeResult *kurtosis_core_rpc_api_bindings.ConnectivityProbeResult) string {
+	if probeResult.GetProbeError() != "" {
+		return probeFailedPathCell
+	}
+	if probeResult.GetObservedPacketLossPercentage() >= entirePacketLoss {
+		return blockedPathCell
+	}
+	cell := fmt.Sprintf(latencyDisplayFormat, probeResult.GetObservedAvgLatencyMs())
+	if probeResult.GetObservedPacketLossPercentage() > noPacketLoss {
+		cell = fmt.Sprintf("%v (%v%% loss)", cell, probeResult.GetObservedPacketLossPercentage())
+	}
+	return cell
+}
+
+func colorizePathCell(cell string, status pathStatus) string {
+	switch status {
+	case pathStatusAsExpected:
+		return colorizeAsExpected(cell)
+	case pathStatusSlow:
+		return colorizeSlow(cell)
+	case pathStatusProbeFailed:
+		return colorizeUnknown(cell)
+	default:
+		return colorizeMismatch(cell)
+	}
+}
@@ -0,0 +1,53 @@
+package nettest
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testSlowThresholdMs = 100
+)
+
+func TestGetPathStatus(t *testing.T) {
+	probeResult := &kurtosis_core_rpc_api_bindings.ConnectivityProbeResult{
+		ExpectedPacketLossPercentage: 0,
+		ExpectedPacketDelayMs:        500,
+		ObservedPacketLossPercentage: 0,
+		ObservedAvgLatencyMs:         550,
+	}
+	require.Equal(t, pathStatusAsExpected, getPathStatus(probeResult, testSlowThresholdMs))
+
+	probeResult.ObservedAvgLatencyMs = 650
+	require.Equal(t, pathStatusSlow, getPathStatus(probeResult, testSlowThresholdMs))
+
+	probeResult.ObservedPacketLossPercentage = 100
+	require.Equal(t, pathStatusUnexpectedlyBlocked, getPathStatus(probeResult, testSlowThresholdMs))
+
+	probeResult.ExpectedPacketLossPercentage = 100
+	require.Equal(t, pathStatusAsExpected, getPathStatus(probeResult, testSlowThresholdMs))
+
+	probeResult.ObservedPacketLossPercentage = 0
+	require.Equal(t, pathStatusUnexpectedlyReachable, getPathStatus(probeResult, testSlowThresholdMs))
+
+	probeResult.ProbeError = "ping: not found"
+	require.Equal(t, pathStatusProbeFailed, getPathStatus(probeResult, testSlowThresholdMs))
+}
+
+func TestGetPathCell(t *testing.T) {
+	probeResult := &kurtosis_core_rpc_api_bindings.ConnectivityProbeResult{
+		ObservedPacketLossPercentage: 0,
+		ObservedAvgLatencyMs:         0.075,
+	}
+	require.Equal(t, "0.1ms", getPathCell(probeResult))
+
+	probeResult.ObservedPacketLossPercentage = 33.3
+	require.Equal(t, "0.1ms (33.3% loss)", getPathCell(probeResult))
+
+	probeResult.ObservedPacketLossPercentage = 100
+	require.Equal(t, blockedPathCell, getPathCell(probeResult))
+
+	probeResult.ProbeError = "ping: not found"
+	require.Equal(t, probeFailedPathCell, getPathCell(probeResult))
+}
@@ -0,0 +1,181 @@
+package profile
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-units"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	runIdArgKey     = "run-id"
+	defaultRunIdArg = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	instructionColumnHeader = "Instruction"
+	positionColumnHeader    = "Position"
+	durationColumnHeader    = "Duration"
+	containersColumnHeader  = "Containers"
+	artifactsColumnHeader   = "Artifacts"
+	shareColumnHeader       = "Share Of Run Time"
+
+	durationDisplayPrecision = time.Millisecond
+
+	durationBarMaxWidth = 40
+	durationBarChar     = "█"
+
+	failedInstructionSuffix = " (failed)"
+)
+
+var EnclaveProfileCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveProfileCmdStr,
+	ShortDescription: "Shows where a run spent its time",
+	LongDescription: "Breaks down a past run of the enclave instruction by instruction: how long each instruction " +
+		"took and its share of the run time, along with the containers it created and the size of the files " +
+		"artifacts it stored, to find what makes a package slow. The ID of a run is printed when it finishes; the " +
+		"most recent run of the enclave is profiled if no run ID is given",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:          runIdArgKey,
+			IsOptional:   true,
+			DefaultValue: defaultRunIdArg,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	runId, err := args.GetNonGreedyArg(runIdArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the run ID using arg key '%v'", runIdArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	runProfile, err := enclaveCtx.GetStarlarkRunProfile(ctx, runId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the profile of the run of enclave '%v'", enclaveIdentifier)
+	}
+
+	out.PrintOutLn(formatRunSummary(runProfile))
+	if len(runProfile.GetInstructions()) == 0 {
+		return nil
+	}
+	totalDuration := getTotalDuration(runProfile)
+	tablePrinter := output_printers.NewTablePrinter(instructionColumnHeader, positionColumnHeader, durationColumnHeader, containersColumnHeader, artifactsColumnHeader, shareColumnHeader)
+	for index, instructionProfile := range runProfile.GetInstructions() {
+		instructionName := instructionProfile.GetInstructionName()
+		if !instructionProfile.GetIsSuccessful() {
+			instructionName += failedInstructionSuffix
+		}
+		if err := tablePrinter.AddRow(
+			fmt.Sprintf("%d. %s", index+1, instructionName),
+			formatPosition(instructionProfile.GetPosition()),
+			getDuration(instructionProfile).Round(durationDisplayPrecision).String(),
+			fmt.Sprintf("%d", instructionProfile.GetNumContainersCreated()),
+			units.BytesSize(float64(instructionProfile.GetArtifactBytesTransferred())),
+			formatShareOfRunTime(getDuration(instructionProfile), totalDuration),
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding instruction '%v' to the table to be displayed", instructionProfile.GetInstructionName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func formatRunSummary(runProfile *kurtosis_core_rpc_api_bindings.StarlarkRunProfile) string {
+	numContainersCreated := uint32(0)
+	artifactBytesTransferred := uint64(0)
+	for _, instructionProfile := range runProfile.GetInstructions() {
+		numContainersCreated += instructionProfile.GetNumContainersCreated()
+		artifactBytesTransferred += instructionProfile.GetArtifactBytesTransferred()
+	}
+	return fmt.Sprintf(
+		"Run '%s' of '%s', started at %s: %d instructions took %s, creating %d containers and storing %s of files artifacts",
+		runProfile.GetRunId(),
+		runProfile.GetPackageId(),
+		runProfile.GetStartedAt().AsTime().Local().Format(time.RFC3339),
+		len(runProfile.GetInstructions()),
+		getTotalDuration(runProfile).Round(durationDisplayPrecision),
+		numContainersCreated,
+		units.BytesSize(float64(artifactBytesTransferred)),
+	)
+}
+
+func formatPosition(position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition) string {
+	return fmt.Sprintf("%s[%d:%d]", position.GetFilename(), position.GetLine(), position.GetColumn())
+}
+
+// formatShareOfRunTime renders the share of the run time as a bar, so that the instructions the run spent the most
+// time on stand out
+func formatShareOfRunTime(duration time.Duration, totalDuration time.Duration) string {
+	if totalDuration <= 0 {
+		return ""
+	}
+	share := float64(duration) / float64(totalDuration)
+	barWidth := int(share*durationBarMaxWidth + 0.5)
+	return fmt.Sprintf("%s %.1f%%", strings.Repeat(durationBarChar, barWidth), share*100)
+}
+
+func getTotalDuration(runProfile *kurtosis_core_rpc_api_bindings.StarlarkRunProfile) time.Duration {
+	totalDuration := time.Duration(0)
+	for _, instructionProfile := range runProfile.GetInstructions() {
+		totalDuration += getDuration(instructionProfile)
+	}
+	return totalDuration
+}
+
+func getDuration(instructionProfile *kurtosis_core_rpc_api_bindings.StarlarkInstructionProfile) time.Duration {
+	return time.Duration(instructionProfile.GetDurationMicros()) * time.Microsecond
+}
@@ -0,0 +1,18 @@
+package profile
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatShareOfRunTime(t *testing.T) {
+	require.Equal(t, strings.Repeat(durationBarChar, 30)+" 75.0%", formatShareOfRunTime(3*time.Second, 4*time.Second))
+	require.Equal(t, " 0.0%", formatShareOfRunTime(0, 4*time.Second))
+	require.Equal(t, strings.Repeat(durationBarChar, durationBarMaxWidth)+" 100.0%", formatShareOfRunTime(4*time.Second, 4*time.Second))
+}
+
+func TestFormatShareOfRunTime_NoRunTime(t *testing.T) {
+	require.Empty(t, formatShareOfRunTime(0, 0))
+}
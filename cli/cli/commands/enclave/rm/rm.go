@@ -11,12 +11,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grouped_errors_presenter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/leak_check"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/mholt/archiver"
 	"github.com/sirupsen/logrus"
+	"os"
+	"path/filepath"
 	"sort"
-	"strings"
 )
 
 const (
@@ -27,8 +33,25 @@ const (
 	shouldForceRemoveFlagKey = "force"
 	defaultShouldForceRemove = "false"
 
+	coverageOutputDirpathFlagKey = "coverage-output-dir"
+	// Empty means the coverage of the enclaves doesn't get downloaded
+	defaultCoverageOutputDirpath = ""
+
+	// The files artifact the 'collect_coverage' instruction merges the coverage data files of the services into
+	coverageFilesArtifactName       = "coverage"
+	coverageFilesArtifactExtension  = ".tgz"
+	coverageFilesArtifactPermission = 0o644
+	coverageOutputDirPermission     = 0o755
+	coverageTmpDirPattern           = "tmp-dir-for-coverage-*"
+	defaultTmpDir                   = ""
+
+	shouldVerifyFlagKey = "verify"
+	defaultShouldVerify = "false"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
+
+	enclavesNoun = "enclaves"
 )
 
 var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -45,6 +68,22 @@ var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			Type:      flags.FlagType_Bool,
 			Default:   defaultShouldForceRemove,
 		},
+		{
+			Key: coverageOutputDirpathFlagKey,
+			Usage: "If set, the shutdown hooks of each running enclave are run first and the '" + coverageFilesArtifactName + "' files artifact " +
+				"that its 'collect_coverage' instruction collected gets extracted to a directory named after the enclave in this directory",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultCoverageOutputDirpath,
+		},
+		{
+			Key: shouldVerifyFlagKey,
+			Usage: "If true, checks that each enclave left none of its containers, volumes, networks or IP address " +
+				"registrations behind once destroyed, failing with the list of leaked resources otherwise",
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultShouldVerify,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewEnclaveIdentifierArg(
@@ -59,7 +98,7 @@ var EnclaveRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 
 func run(
 	ctx context.Context,
-	_ backend_interface.KurtosisBackend,
+	kurtosisBackend backend_interface.KurtosisBackend,
 	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	metricsClient metrics_client.MetricsClient,
 	flags *flags.ParsedFlags,
@@ -75,6 +114,16 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the force-removal flag value using key '%v'; this is a bug in Kurtosis!", shouldForceRemoveFlagKey)
 	}
 
+	coverageOutputDirpath, err := flags.GetString(coverageOutputDirpathFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the coverage output dirpath using flag key '%v'; this is a bug in Kurtosis!", coverageOutputDirpathFlagKey)
+	}
+
+	shouldVerify, err := flags.GetBool(shouldVerifyFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the verify flag value using key '%v'; this is a bug in Kurtosis!", shouldVerifyFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
@@ -89,27 +138,20 @@ func run(
 
 	logrus.Info("Destroying enclaves...")
 
-	enclaveDestructionErrorStrs := []string{}
+	enclaveDestructionErrors := map[string]error{}
 	for _, enclaveId := range enclaveIdsToDestroy {
 		if err = metricsClient.TrackDestroyEnclave(enclaveId); err != nil {
 			logrus.Warnf("An error occurred while logging the destroy enclave event for enclave '%v'", enclaveId)
 		}
-		if err := destroyEnclave(ctx, kurtosisCtx, enclaveId, shouldForceRemove); err != nil {
-			enclaveDestructionErrorStrs = append(
-				enclaveDestructionErrorStrs,
-				fmt.Sprintf(
-					">>>>>>>>>>>>>>>>> %v <<<<<<<<<<<<<<<<<\n%v",
-					enclaveId,
-					err.Error(),
-				),
-			)
+		if err := destroyEnclave(ctx, kurtosisCtx, kurtosisBackend, enclaveId, shouldForceRemove, coverageOutputDirpath, shouldVerify); err != nil {
+			enclaveDestructionErrors[enclaveId] = err
 		}
 	}
 
-	if len(enclaveDestructionErrorStrs) > 0 {
+	if len(enclaveDestructionErrors) > 0 {
 		errorStr := fmt.Sprintf(
 			"One or more errors occurred destroying the enclaves:\n%v",
-			strings.Join(enclaveDestructionErrorStrs, "\n\n"),
+			grouped_errors_presenter.PresentErrors(enclaveDestructionErrors, enclavesNoun),
 		)
 		return errors.New(errorStr)
 	}
@@ -141,8 +183,11 @@ func getUniqueSortedEnclaveIdentifiers(rawInput []string) []string {
 func destroyEnclave(
 	ctx context.Context,
 	kurtosisContext *kurtosis_context.KurtosisContext,
+	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveIdentifier string,
 	shouldForceRemove bool,
+	coverageOutputDirpath string,
+	shouldVerify bool,
 ) error {
 	enclaveInfo, err := kurtosisContext.GetEnclave(ctx, enclaveIdentifier)
 	if err != nil {
@@ -169,8 +214,94 @@ func destroyEnclave(
 		)
 	}
 
-	if err = kurtosisContext.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
+	// The coverage can only be downloaded while the API container of the enclave is still running
+	if coverageOutputDirpath != defaultCoverageOutputDirpath {
+		if enclaveInfo.GetApiContainerStatus() == kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING {
+			if err = downloadEnclaveCoverage(ctx, kurtosisContext, enclaveIdentifier, coverageOutputDirpath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred downloading the coverage of enclave '%v'; it was not destroyed", enclaveIdentifier)
+			}
+		} else {
+			logrus.Warnf("Can't download the coverage of enclave '%v' because its API container isn't running", enclaveIdentifier)
+		}
+	}
+
+	err = output_printers.PrintEnclaveTeardownProgress(enclaveIdentifier, func(progressCallback func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep)) (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary, error) {
+		return kurtosisContext.DestroyEnclaveWithProgress(ctx, enclaveIdentifier, progressCallback)
+	})
+	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred destroying enclave '%v'", enclaveIdentifier)
 	}
+
+	if shouldVerify {
+		// The identifier is gone along with the enclave, so the resources get looked up by the UUID it had
+		if err = leak_check.VerifyNoLeakedEnclaveResources(ctx, kurtosisBackend, enclave.EnclaveUUID(enclaveInfo.GetEnclaveUuid())); err != nil {
+			return stacktrace.Propagate(err, "Enclave '%v' got destroyed but verifying that it left nothing behind failed", enclaveIdentifier)
+		}
+		logrus.Infof("Verified that enclave '%v' left no resources behind", enclaveIdentifier)
+	}
+	return nil
+}
+
+// downloadEnclaveCoverage runs the shutdown hooks of the enclave, so that its coverage gets collected, then extracts
+// the collected coverage to a directory named after the enclave in the output directory
+func downloadEnclaveCoverage(
+	ctx context.Context,
+	kurtosisContext *kurtosis_context.KurtosisContext,
+	enclaveIdentifier string,
+	coverageOutputDirpath string,
+) error {
+	enclaveCtx, err := kurtosisContext.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	shutdownHookResults, err := enclaveCtx.RunShutdownHooks(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the shutdown hooks of enclave '%v'", enclaveIdentifier)
+	}
+	for _, shutdownHookResult := range shutdownHookResults {
+		if shutdownHookResult.Error != nil {
+			logrus.Warnf("Shutdown hook '%v' of enclave '%v' failed:\n%v", shutdownHookResult.GetDescription(), enclaveIdentifier, shutdownHookResult.GetError())
+		}
+	}
+
+	filesArtifactNamesAndUuids, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred listing the files artifacts of enclave '%v'", enclaveIdentifier)
+	}
+	hasCoverage := false
+	for _, filesArtifactNameAndUuid := range filesArtifactNamesAndUuids {
+		if filesArtifactNameAndUuid.GetFileName() == coverageFilesArtifactName {
+			hasCoverage = true
+			break
+		}
+	}
+	if !hasCoverage {
+		logrus.Warnf("Enclave '%v' has no '%v' files artifact, so there's no coverage to download; was 'collect_coverage' run in it?", enclaveIdentifier, coverageFilesArtifactName)
+		return nil
+	}
+
+	coverageTgzBytes, err := enclaveCtx.DownloadFilesArtifact(ctx, coverageFilesArtifactName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v' from enclave '%v'", coverageFilesArtifactName, enclaveIdentifier)
+	}
+	tmpDirpath, err := os.MkdirTemp(defaultTmpDir, coverageTmpDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary directory to download the coverage of enclave '%v' to", enclaveIdentifier)
+	}
+	defer os.RemoveAll(tmpDirpath)
+	coverageTgzFilepath := filepath.Join(tmpDirpath, coverageFilesArtifactName+coverageFilesArtifactExtension)
+	if err = os.WriteFile(coverageTgzFilepath, coverageTgzBytes, coverageFilesArtifactPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the coverage of enclave '%v' to '%v'", enclaveIdentifier, coverageTgzFilepath)
+	}
+
+	enclaveCoverageDirpath := filepath.Join(coverageOutputDirpath, enclaveIdentifier)
+	if err = os.MkdirAll(enclaveCoverageDirpath, coverageOutputDirPermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating coverage output directory '%v'", enclaveCoverageDirpath)
+	}
+	if err = archiver.Unarchive(coverageTgzFilepath, enclaveCoverageDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting the coverage of enclave '%v' to '%v'", enclaveIdentifier, enclaveCoverageDirpath)
+	}
+	logrus.Infof("Coverage of enclave '%v' extracted to '%v'", enclaveIdentifier, enclaveCoverageDirpath)
 	return nil
 }
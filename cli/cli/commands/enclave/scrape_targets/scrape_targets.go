@@ -0,0 +1,241 @@
+package scrape_targets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	usePrivateAddressesFlagKey = "private"
+	serveAddressFlagKey        = "serve"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	defaultUsePrivateAddresses = "false"
+	defaultServeAddress        = ""
+
+	enclaveLabelName     = "enclave"
+	serviceLabelName     = "service"
+	serviceUuidLabelName = "service_uuid"
+
+	jsonOutputPrefix = ""
+	jsonOutputIndent = "  "
+
+	contentTypeHeaderName = "Content-Type"
+	jsonContentType       = "application/json"
+
+	serverReadHeaderTimeout = 5 * time.Second
+	serverShutdownTimeout   = 5 * time.Second
+
+	interruptChanBufferSize = 1
+)
+
+var EnclaveScrapeTargetsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveScrapeTargetsCmdStr,
+	ShortDescription: "Lists the metrics endpoints of the services",
+	LongDescription: fmt.Sprintf(
+		"Prints the metrics endpoints of the services of the enclave in the Prometheus file_sd and http_sd format, so "+
+			"a Prometheus running outside of the enclave can scrape them. Uses the public addresses unless the '%v' flag "+
+			"is set. With the '%v' flag, serves the endpoints over HTTP on the given address instead, for Prometheus "+
+			"http_sd_configs to pick up the services as they come and go",
+		usePrivateAddressesFlagKey,
+		serveAddressFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     usePrivateAddressesFlagKey,
+			Usage:   "Use the addresses of the services inside the enclave, for a Prometheus running in the enclave network",
+			Type:    flags.FlagType_Bool,
+			Default: defaultUsePrivateAddresses,
+		},
+		{
+			Key:     serveAddressFlagKey,
+			Usage:   "If set, serves the endpoints on this address (e.g. 'localhost:9999') until interrupted rather than printing them",
+			Type:    flags.FlagType_String,
+			Default: defaultServeAddress,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+// targetGroup is a group of targets sharing the same labels, as expected by Prometheus file_sd and http_sd
+type targetGroup struct {
+	Targets []string          `json:"targets"`
+	Labels  map[string]string `json:"labels"`
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	usePrivateAddresses, err := flags.GetBool(usePrivateAddressesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the private addresses flag using key '%v'", usePrivateAddressesFlagKey)
+	}
+
+	serveAddress, err := flags.GetString(serveAddressFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the serve address using key '%v'", serveAddressFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	if serveAddress != defaultServeAddress {
+		if err := serveTargetGroups(enclaveCtx, serveAddress, usePrivateAddresses); err != nil {
+			return stacktrace.Propagate(err, "An error occurred serving the scrape targets of enclave '%v' on '%v'", enclaveIdentifier, serveAddress)
+		}
+		return nil
+	}
+
+	targetGroups, err := getTargetGroups(ctx, enclaveCtx, usePrivateAddresses)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the scrape targets of enclave '%v'", enclaveIdentifier)
+	}
+	targetGroupsJson, err := json.MarshalIndent(targetGroups, jsonOutputPrefix, jsonOutputIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the scrape targets of enclave '%v'", enclaveIdentifier)
+	}
+	out.PrintOutLn(string(targetGroupsJson))
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getTargetGroups(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, usePrivateAddresses bool) ([]*targetGroup, error) {
+	scrapeTargets, err := enclaveCtx.GetScrapeTargets(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the scrape targets")
+	}
+	targetGroups, servicesWithoutPublicAddress := newTargetGroups(enclaveCtx.GetEnclaveName(), scrapeTargets, usePrivateAddresses)
+	for _, serviceName := range servicesWithoutPublicAddress {
+		logrus.Warnf("Service '%v' isn't scraped as its metrics port has no public address; use the '--%v' flag from inside the enclave network to scrape it", serviceName, usePrivateAddressesFlagKey)
+	}
+	return targetGroups, nil
+}
+
+// newTargetGroups returns a target group per scrape target, along with the names of the services that got skipped as
+// they have no public address while public addresses are used
+func newTargetGroups(enclaveName string, scrapeTargets []*kurtosis_core_rpc_api_bindings.ScrapeTarget, usePrivateAddresses bool) ([]*targetGroup, []string) {
+	targetGroups := []*targetGroup{}
+	servicesWithoutPublicAddress := []string{}
+	for _, scrapeTarget := range scrapeTargets {
+		address := scrapeTarget.GetPublicAddress()
+		if usePrivateAddresses {
+			address = scrapeTarget.GetPrivateAddress()
+		}
+		if address == "" {
+			servicesWithoutPublicAddress = append(servicesWithoutPublicAddress, scrapeTarget.GetServiceName())
+			continue
+		}
+		targetGroups = append(targetGroups, &targetGroup{
+			Targets: []string{address},
+			Labels: map[string]string{
+				enclaveLabelName:     enclaveName,
+				serviceLabelName:     scrapeTarget.GetServiceName(),
+				serviceUuidLabelName: scrapeTarget.GetServiceUuid(),
+			},
+		})
+	}
+	return targetGroups, servicesWithoutPublicAddress
+}
+
+// serveTargetGroups serves the target groups for Prometheus http_sd until the user interrupts it, fetching them
+// from the enclave on every request so that Prometheus follows the services being added and removed
+func serveTargetGroups(enclaveCtx *enclaves.EnclaveContext, serveAddress string, usePrivateAddresses bool) error {
+	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		targetGroups, err := getTargetGroups(request.Context(), enclaveCtx, usePrivateAddresses)
+		if err != nil {
+			logrus.Errorf("An error occurred getting the scrape targets to serve:\n%v", err)
+			http.Error(writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writer.Header().Set(contentTypeHeaderName, jsonContentType)
+		if err := json.NewEncoder(writer).Encode(targetGroups); err != nil {
+			logrus.Errorf("An error occurred writing the scrape targets to the response:\n%v", err)
+		}
+	})
+	// nolint: exhaustruct
+	server := &http.Server{
+		Addr:              serveAddress,
+		Handler:           handler,
+		ReadHeaderTimeout: serverReadHeaderTimeout,
+	}
+
+	// This channel will receive a signal when the user presses an interrupt
+	interruptChan := make(chan os.Signal, interruptChanBufferSize)
+	signal.Notify(interruptChan, os.Interrupt)
+	defer signal.Stop(interruptChan)
+
+	serverErrChan := make(chan error, 1)
+	go func() {
+		serverErrChan <- server.ListenAndServe()
+	}()
+	logrus.Infof("Serving the scrape targets of enclave '%v' for Prometheus http_sd on 'http://%v'; press Ctrl-C to stop", enclaveCtx.GetEnclaveName(), serveAddress)
+
+	select {
+	case err := <-serverErrChan:
+		return stacktrace.Propagate(err, "The scrape targets server stopped unexpectedly")
+	case <-interruptChan:
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return stacktrace.Propagate(err, "An error occurred shutting down the scrape targets server")
+		}
+		return nil
+	}
+}
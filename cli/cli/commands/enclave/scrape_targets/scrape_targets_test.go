@@ -0,0 +1,46 @@
+package scrape_targets
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testEnclaveName = "test-enclave"
+)
+
+func TestNewTargetGroups(t *testing.T) {
+	scrapeTargets := []*kurtosis_core_rpc_api_bindings.ScrapeTarget{
+		{
+			ServiceName:    "api",
+			ServiceUuid:    "api-uuid",
+			MetricsPortId:  "metrics",
+			PrivateAddress: "172.16.4.2:9100",
+			PublicAddress:  "127.0.0.1:49152",
+		},
+		{
+			ServiceName:    "worker",
+			ServiceUuid:    "worker-uuid",
+			MetricsPortId:  "prometheus",
+			PrivateAddress: "172.16.4.3:9100",
+			PublicAddress:  "",
+		},
+	}
+
+	targetGroups, servicesWithoutPublicAddress := newTargetGroups(testEnclaveName, scrapeTargets, false)
+	require.Len(t, targetGroups, 1)
+	require.Equal(t, []string{"127.0.0.1:49152"}, targetGroups[0].Targets)
+	require.Equal(t, map[string]string{
+		enclaveLabelName:     testEnclaveName,
+		serviceLabelName:     "api",
+		serviceUuidLabelName: "api-uuid",
+	}, targetGroups[0].Labels)
+	require.Equal(t, []string{"worker"}, servicesWithoutPublicAddress)
+
+	targetGroups, servicesWithoutPublicAddress = newTargetGroups(testEnclaveName, scrapeTargets, true)
+	require.Len(t, targetGroups, 2)
+	require.Equal(t, []string{"172.16.4.2:9100"}, targetGroups[0].Targets)
+	require.Equal(t, []string{"172.16.4.3:9100"}, targetGroups[1].Targets)
+	require.Empty(t, servicesWithoutPublicAddress)
+}
@@ -4,16 +4,18 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grouped_errors_presenter"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
-	"strings"
 )
 
 const (
@@ -23,12 +25,14 @@ const (
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
+
+	enclavesNoun = "enclaves"
 )
 
 var EnclaveStopCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.EnclaveStopCmdStr,
 	ShortDescription:          "Stops enclaves",
-	LongDescription:           "Stops the enclaves with the given UUIDs",
+	LongDescription:           "Stops the enclaves with the given UUIDs, after running the shutdown hooks registered by the Starlark code run in them",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags:                     nil,
@@ -48,8 +52,8 @@ func init() {
 
 func run(
 	ctx context.Context,
-	kurtosisBackend backend_interface.KurtosisBackend,
-	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	metricsClient metrics_client.MetricsClient,
 	_ *flags.ParsedFlags,
 	args *args.ParsedArgs,
@@ -59,28 +63,32 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the enclave identifiers arg using key '%v'", enclaveIdentifiersArgKey)
 	}
 
+	// The Kurtosis context, rather than the engine client, runs the shutdown hooks of the enclaves before stopping them
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
 	logrus.Info("Stopping enclaves...")
-	stopEnclaveErrorStrs := []string{}
+	stopEnclaveErrors := map[string]error{}
 	for _, enclaveIdentifier := range enclaveIdentifiers {
-		stopArgs := &kurtosis_engine_rpc_api_bindings.StopEnclaveArgs{EnclaveIdentifier: enclaveIdentifier}
 		if err = metricsClient.TrackStopEnclave(enclaveIdentifier); err != nil {
 			logrus.Warnf("An error occurred while logging the stop enclave event for enclave '%v'", enclaveIdentifier)
 		}
-		if _, err := engineClient.StopEnclave(ctx, stopArgs); err != nil {
+		err := output_printers.PrintEnclaveTeardownProgress(enclaveIdentifier, func(progressCallback func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep)) (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary, error) {
+			return kurtosisCtx.StopEnclaveWithProgress(ctx, enclaveIdentifier, progressCallback)
+		})
+		if err != nil {
 			wrappedErr := stacktrace.Propagate(err, "An error occurred stopping enclave '%v'", enclaveIdentifier)
-			stopEnclaveErrorStrs = append(stopEnclaveErrorStrs, wrappedErr.Error())
+			stopEnclaveErrors[enclaveIdentifier] = wrappedErr
 		}
 	}
 
-	if len(stopEnclaveErrorStrs) > 0 {
-		joinedErrorsStr := strings.Join(
-			stopEnclaveErrorStrs,
-			"\n\n",
-		)
+	if len(stopEnclaveErrors) > 0 {
 		// We use this rather than stacktrace because stacktrace gets messy
 		return fmt.Errorf(
 			"One or more errors occurred when stopping enclaves:\n%v",
-			joinedErrorsStr,
+			grouped_errors_presenter.PresentErrors(stopEnclaveErrors, enclavesNoun),
 		)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/logs"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/restart"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/set_log_level"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/start"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/status"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/engine/stop"
@@ -24,4 +25,5 @@ func init() {
 	EngineCmd.AddCommand(stop.StopCmd)
 	EngineCmd.AddCommand(restart.RestartCmd)
 	EngineCmd.AddCommand(logs.EngineLogsCmd.MustGetCobraCommand())
+	EngineCmd.AddCommand(set_log_level.SetLogLevelCmd.MustGetCobraCommand())
 }
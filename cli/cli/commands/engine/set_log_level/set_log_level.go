@@ -0,0 +1,167 @@
+package set_log_level
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	logLevelArgKey = "log-level"
+
+	revertAfterFlagKey = "revert-after"
+	// Empty means the change is permanent
+	defaultRevertAfter = ""
+
+	enclaveIdentifierFlagKey = "enclave"
+	// Empty means the engine itself
+	defaultEnclaveIdentifier = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var SetLogLevelCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EngineSetLogLevelCmdStr,
+	ShortDescription: "Changes the log level of the running engine",
+	LongDescription: fmt.Sprintf(
+		"Changes the log level of the running engine (or of the API container of an enclave, with the '%v' flag) without "+
+			"restarting it, so the state needed to reproduce an issue is kept. Use the '%v' flag to only change it temporarily",
+		enclaveIdentifierFlagKey,
+		revertAfterFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     revertAfterFlagKey,
+			Usage:   "If set, the previous log level is restored after this duration (e.g. '10m')",
+			Type:    flags.FlagType_String,
+			Default: defaultRevertAfter,
+		},
+		{
+			Key:     enclaveIdentifierFlagKey,
+			Usage:   "If set, changes the log level of the API container of this enclave rather than the engine's",
+			Type:    flags.FlagType_String,
+			Default: defaultEnclaveIdentifier,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   logLevelArgKey,
+			ArgCompletionProvider: args.NewManualCompletionsProvider(getLogLevelCompletions),
+			ValidationFunc:        validateLogLevelArg,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	logLevelStr, err := args.GetNonGreedyArg(logLevelArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log level using arg key '%v'", logLevelArgKey)
+	}
+
+	revertAfterSeconds, err := getRevertAfterSeconds(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the duration after which the log level should be reverted")
+	}
+
+	enclaveIdentifier, err := flags.GetString(enclaveIdentifierFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using flag key '%v'", enclaveIdentifierFlagKey)
+	}
+
+	var targetDescription string
+	var previousLogLevel string
+	if enclaveIdentifier == defaultEnclaveIdentifier {
+		targetDescription = "the engine"
+		setLogLevelArgs := &kurtosis_engine_rpc_api_bindings.SetLogLevelArgs{
+			LogLevel:           logLevelStr,
+			RevertAfterSeconds: revertAfterSeconds,
+		}
+		response, err := engineClient.SetLogLevel(ctx, setLogLevelArgs)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred setting the log level of the engine to '%v'", logLevelStr)
+		}
+		previousLogLevel = response.GetPreviousLogLevel()
+	} else {
+		targetDescription = fmt.Sprintf("the API container of enclave '%v'", enclaveIdentifier)
+		kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+		}
+		enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+		}
+		previousLogLevel, err = enclaveCtx.SetLogLevel(ctx, logLevelStr, revertAfterSeconds)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred setting the log level of enclave '%v' to '%v'", enclaveIdentifier, logLevelStr)
+		}
+	}
+
+	logrus.Infof("Changed the log level of %v from '%v' to '%v'", targetDescription, previousLogLevel, logLevelStr)
+	if revertAfterSeconds > 0 {
+		logrus.Infof("It will be reverted to '%v' in %v", previousLogLevel, time.Duration(revertAfterSeconds)*time.Second)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func getLogLevelCompletions(_ context.Context, _ *flags.ParsedFlags, _ *args.ParsedArgs) ([]string, error) {
+	return logrus_log_levels.GetAcceptableLogLevelStrs(), nil
+}
+
+func validateLogLevelArg(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	logLevelStr, err := args.GetNonGreedyArg(logLevelArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log level using arg key '%v'", logLevelArgKey)
+	}
+	if _, err := logrus.ParseLevel(logLevelStr); err != nil {
+		return stacktrace.NewError("Invalid log level '%v'; valid log levels are: %v", logLevelStr, strings.Join(logrus_log_levels.GetAcceptableLogLevelStrs(), ", "))
+	}
+	return nil
+}
+
+func getRevertAfterSeconds(flags *flags.ParsedFlags) (uint32, error) {
+	revertAfterStr, err := flags.GetString(revertAfterFlagKey)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the revert duration using flag key '%v'", revertAfterFlagKey)
+	}
+	if revertAfterStr == defaultRevertAfter {
+		return 0, nil
+	}
+	revertAfter, err := time.ParseDuration(revertAfterStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing '%v' as a duration", revertAfterStr)
+	}
+	if revertAfter < time.Second || revertAfter.Seconds() > math.MaxUint32 {
+		return 0, stacktrace.NewError("The revert duration must be at least one second and at most %v seconds, but was '%v'", uint32(math.MaxUint32), revertAfterStr)
+	}
+	return uint32(revertAfter.Seconds()), nil
+}
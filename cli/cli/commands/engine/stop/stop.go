@@ -14,8 +14,11 @@ import (
 var StopCmd = &cobra.Command{
 	Use:   command_str_consts.EngineStopCmdStr,
 	Short: "Stops the Kurtosis engine",
-	Long:  "Stops the Kurtosis engine, doing nothing if no engine is running",
-	RunE:  run,
+	Long: "Stops the Kurtosis engine, doing nothing if no engine is running. The engine data volume " +
+		"'kurtosis-engine-data-vol' is deliberately left in place, so that the next engine picks up the state of this " +
+		"one (the enclave names already used, the IP ranges given to enclaves, the images pulled for them...); remove " +
+		"it with 'docker volume rm kurtosis-engine-data-vol' once the engine is stopped to start from a clean state",
+	RunE: run,
 }
 
 func run(cmd *cobra.Command, args []string) error {
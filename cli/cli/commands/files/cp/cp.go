@@ -0,0 +1,147 @@
+package cp
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strings"
+)
+
+const (
+	fromEnclaveIdentifierFlagKey = "from-enclave"
+	toEnclaveIdentifierFlagKey   = "to-enclave"
+	// Signifies that enclave identifier hasn't been passed
+	defaultEnclaveIdentifierKeyword = ""
+
+	nameFlagKey = "name"
+	// Signifies that the files artifact keeps its name
+	defaultName = ""
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	emptyArtifactIdentifier         = ""
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var FilesCpCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesCpCmdStr,
+	ShortDescription: "Copy a files artifact between enclaves",
+	LongDescription: "Copy the given files artifact from one enclave to another, without it going through your machine, " +
+		"e.g. to reuse an expensive generated genesis state. The files artifact and enclaves are specified by identifier " +
+		"(name, UUID, or shortened UUID). Read more about identifiers here: https://docs.kurtosis.com/reference/resource-identifier",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fromEnclaveIdentifierFlagKey,
+			Usage:   "The enclave the files artifact gets copied from. This is a required flag.",
+			Type:    flags.FlagType_String,
+			Default: defaultEnclaveIdentifierKeyword,
+		},
+		{
+			Key:     toEnclaveIdentifierFlagKey,
+			Usage:   "The enclave the files artifact gets copied to. This is a required flag.",
+			Type:    flags.FlagType_String,
+			Default: defaultEnclaveIdentifierKeyword,
+		},
+		{
+			Key:     nameFlagKey,
+			Usage:   "The name the files artifact gets in the enclave it gets copied to (default: its name in the enclave it gets copied from)",
+			Type:    flags.FlagType_String,
+			Default: defaultName,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   artifactIdentifierArgKey,
+			ValidationFunc:        validateArtifactIdentifier,
+			IsOptional:            isArtifactIdentifierArgOptional,
+			IsGreedy:              isArtifactIdentifierArgGreedy,
+			DefaultValue:          nil,
+			ArgCompletionProvider: nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	fromEnclaveIdentifier, err := flags.GetString(fromEnclaveIdentifierFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave to copy from using flag key '%s'", fromEnclaveIdentifierFlagKey)
+	}
+	if fromEnclaveIdentifier == defaultEnclaveIdentifierKeyword {
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("The enclave to copy from is a required flag; please pass a valid value using the '--%s' flag", fromEnclaveIdentifierFlagKey)
+	}
+
+	toEnclaveIdentifier, err := flags.GetString(toEnclaveIdentifierFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave to copy to using flag key '%s'", toEnclaveIdentifierFlagKey)
+	}
+	if toEnclaveIdentifier == defaultEnclaveIdentifierKeyword {
+		return fmt.Errorf("The enclave to copy to is a required flag; please pass a valid value using the '--%s' flag", toEnclaveIdentifierFlagKey)
+	}
+
+	name, err := flags.GetString(nameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the copy using flag key '%s'", nameFlagKey)
+	}
+
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the artifact identifier to copy using key '%v'", artifactIdentifierArgKey)
+	}
+
+	copyFilesArtifactArgs := &kurtosis_engine_rpc_api_bindings.CopyFilesArtifactArgs{
+		SourceEnclaveIdentifier:      fromEnclaveIdentifier,
+		DestinationEnclaveIdentifier: toEnclaveIdentifier,
+		FilesArtifactIdentifier:      artifactIdentifier,
+		DestinationFilesArtifactName: name,
+	}
+	response, err := engineClient.CopyFilesArtifact(ctx, copyFilesArtifactArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' from enclave '%v' to enclave '%v'", artifactIdentifier, fromEnclaveIdentifier, toEnclaveIdentifier)
+	}
+	if response.GetWasAlreadyStored() {
+		logrus.Infof("Enclave '%v' already stored the files of files artifact '%v', so nothing got transferred", toEnclaveIdentifier, artifactIdentifier)
+	}
+	logrus.Infof(
+		"Files artifact '%v' copied to enclave '%v' as files artifact '%v' with UUID '%v'",
+		artifactIdentifier,
+		toEnclaveIdentifier,
+		response.GetFilesArtifactName(),
+		response.GetFilesArtifactUuid(),
+	)
+	return nil
+}
+
+func validateArtifactIdentifier(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the identifier to validate using key '%v'", artifactIdentifierArgKey)
+	}
+
+	if strings.TrimSpace(artifactIdentifier) == emptyArtifactIdentifier {
+		return stacktrace.NewError("Artifact identifier cannot be an empty string")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package download
 import (
 	"context"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
@@ -15,7 +16,6 @@ import (
 	"github.com/mholt/archiver"
 	"github.com/sirupsen/logrus"
 	"os"
-	"path"
 	"path/filepath"
 	"strings"
 )
@@ -145,19 +145,13 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
 	}
 
-	artifactBytes, err := enclaveCtx.DownloadFilesArtifact(ctx, artifactIdentifier)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v'", artifactIdentifier, enclaveIdentifier)
-	}
-
 	fileNameToWriteTo := fmt.Sprintf("%v%v", artifactIdentifier, filesArtifactExtension)
-	destinationPathToDownloadFileTo := path.Join(absoluteDestinationPath, fileNameToWriteTo)
+	destinationPathToDownloadFileTo := filepath.Join(absoluteDestinationPath, fileNameToWriteTo)
 
 	// if the user doesn't want to extract, we just download and return
 	if shouldNotExtract {
-		err = os.WriteFile(destinationPathToDownloadFileTo, artifactBytes, filesArtifactPermission)
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred while writing bytes to file '%v' with permission '%v'", destinationPathToDownloadFileTo, filesArtifactPermission)
+		if err = downloadFilesArtifactToFile(ctx, enclaveCtx, artifactIdentifier, destinationPathToDownloadFileTo); err != nil {
+			return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v'", artifactIdentifier, enclaveIdentifier)
 		}
 		logrus.Infof("File package with identifier '%v' downloaded to '%v'", artifactIdentifier, destinationPathToDownloadFileTo)
 		return nil
@@ -173,10 +167,9 @@ func run(
 			os.RemoveAll(tmpDirPath)
 		}
 	}()
-	tmpFileToWriteTo := path.Join(tmpDirPath, fileNameToWriteTo)
-	err = os.WriteFile(tmpFileToWriteTo, artifactBytes, filesArtifactPermission)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while writing bytes to file '%v' with permission '%v'", tmpDirPath, filesArtifactPermission)
+	tmpFileToWriteTo := filepath.Join(tmpDirPath, fileNameToWriteTo)
+	if err = downloadFilesArtifactToFile(ctx, enclaveCtx, artifactIdentifier, tmpFileToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "An error occurred downloading files with identifier '%v' from enclave '%v'", artifactIdentifier, enclaveIdentifier)
 	}
 	err = archiver.Unarchive(tmpFileToWriteTo, absoluteDestinationPath)
 	if err != nil {
@@ -188,6 +181,23 @@ func run(
 	return nil
 }
 
+// downloadFilesArtifactToFile writes the files artifact to the file as it gets streamed, so that it never has to fit in
+// memory
+func downloadFilesArtifactToFile(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, artifactIdentifier string, destinationFilepath string) error {
+	file, err := os.OpenFile(destinationFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filesArtifactPermission)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while opening file '%v' with permission '%v' to write to", destinationFilepath, filesArtifactPermission)
+	}
+	if err = enclaveCtx.DownloadFilesArtifactToWriter(ctx, artifactIdentifier, file); err != nil {
+		file.Close()
+		return stacktrace.Propagate(err, "An error occurred downloading files artifact '%v' to file '%v'", artifactIdentifier, destinationFilepath)
+	}
+	if err = file.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing file '%v' after writing files artifact '%v' to it", destinationFilepath, artifactIdentifier)
+	}
+	return nil
+}
+
 func validateArtifactIdentifier(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
 	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
 	if err != nil {
@@ -2,7 +2,10 @@ package files
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/cp"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/download"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/rendertemplate"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeservice"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files/storeweb"
@@ -25,4 +28,7 @@ func init() {
 	FilesCmd.AddCommand(storeservice.FilesStoreServiceCmd.MustGetCobraCommand())
 	FilesCmd.AddCommand(rendertemplate.RenderTemplateCommand.MustGetCobraCommand())
 	FilesCmd.AddCommand(download.FilesUploadCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(cp.FilesCpCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(ls.FilesLsCmd.MustGetCobraCommand())
+	FilesCmd.AddCommand(inspect.FilesInspectCmd.MustGetCobraCommand())
 }
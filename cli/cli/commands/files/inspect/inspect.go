@@ -0,0 +1,133 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	artifactIdentifierArgKey        = "artifact-identifier"
+	isArtifactIdentifierArgOptional = false
+	isArtifactIdentifierArgGreedy   = false
+
+	pathColumnHeader = "Path"
+	sizeColumnHeader = "Size"
+
+	directoryPathSuffix = "/"
+	directorySizeStr    = "-"
+	bytesPerKilobyte    = float64(1024)
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var FilesInspectCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesInspectCmdStr,
+	ShortDescription: "Lists the files inside a files artifact",
+	LongDescription: "Lists the files and directories inside the given files artifact of the given enclave, with their " +
+		"uncompressed size, without downloading it. The files artifact and enclave are specified by identifier (name, " +
+		"UUID, or shortened UUID). Read more about identifiers here: https://docs.kurtosis.com/reference/resource-identifier",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:                   artifactIdentifierArgKey,
+			ValidationFunc:        validateArtifactIdentifier,
+			IsOptional:            isArtifactIdentifierArgOptional,
+			IsGreedy:              isArtifactIdentifierArgGreedy,
+			DefaultValue:          nil,
+			ArgCompletionProvider: nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact identifier using arg key '%v'", artifactIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	fileDescriptions, err := enclaveCtx.InspectFilesArtifactContents(ctx, artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting files artifact '%v' of enclave '%v'", artifactIdentifier, enclaveIdentifier)
+	}
+
+	if len(fileDescriptions) == 0 {
+		out.PrintOutLn(fmt.Sprintf("Files artifact '%v' is empty", artifactIdentifier))
+		return nil
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(pathColumnHeader, sizeColumnHeader)
+	for _, fileDescription := range fileDescriptions {
+		filePath := fileDescription.GetPath()
+		size := formatKilobytes(fileDescription.GetSizeBytes())
+		if fileDescription.GetIsDirectory() {
+			filePath = filePath + directoryPathSuffix
+			size = directorySizeStr
+		}
+		if err := tablePrinter.AddRow(filePath, size); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding file '%v' to the table to be displayed", filePath)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+func formatKilobytes(sizeBytes uint64) string {
+	return fmt.Sprintf("%.1f KB", float64(sizeBytes)/bytesPerKilobyte)
+}
+
+func validateArtifactIdentifier(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	artifactIdentifier, err := args.GetNonGreedyArg(artifactIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact identifier using arg key '%v'", artifactIdentifierArgKey)
+	}
+	if strings.TrimSpace(artifactIdentifier) == "" {
+		return stacktrace.NewError("Files artifact identifier cannot be an empty string")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package ls
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	fullUuidsFlagKey       = "full-uuids"
+	fullUuidFlagKeyDefault = "false"
+
+	uuidColumnHeader         = "UUID"
+	nameColumnHeader         = "Name"
+	sizeColumnHeader         = "Size"
+	creationTimeColumnHeader = "Created"
+
+	bytesPerKilobyte = float64(1024)
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var FilesLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.FilesLsCmdStr,
+	ShortDescription: "Lists the files artifacts of an enclave",
+	LongDescription: "Lists the files artifacts stored in an enclave, with their UUID, name, compressed size, and the " +
+		"time their contents were stored",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     fullUuidsFlagKey,
+			Usage:   "If true then Kurtosis prints full UUIDs instead of shortened UUIDs. Default false.",
+			Type:    flags.FlagType_Bool,
+			Default: fullUuidFlagKeyDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	showFullUuids, err := flags.GetBool(fullUuidsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", fullUuidsFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	filesArtifactNamesAndUuids, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifacts of enclave '%v'", enclaveIdentifier)
+	}
+
+	if len(filesArtifactNamesAndUuids) == 0 {
+		out.PrintOutLn("No files artifact is stored")
+		return nil
+	}
+
+	// Sorted by name so that the user finds the table easy to read
+	sort.Slice(filesArtifactNamesAndUuids, func(i, j int) bool {
+		return filesArtifactNamesAndUuids[i].GetFileName() < filesArtifactNamesAndUuids[j].GetFileName()
+	})
+	tablePrinter := output_printers.NewTablePrinter(uuidColumnHeader, nameColumnHeader, sizeColumnHeader, creationTimeColumnHeader)
+	for _, filesArtifactNameAndUuid := range filesArtifactNamesAndUuids {
+		uuid := filesArtifactNameAndUuid.GetFileUuid()
+		if !showFullUuids {
+			uuid = uuid_generator.ShortenedUUIDString(uuid)
+		}
+		creationTime := time.Unix(filesArtifactNameAndUuid.GetCreationTimeUnixSeconds(), 0).Format(time.RFC3339)
+		if err := tablePrinter.AddRow(uuid, filesArtifactNameAndUuid.GetFileName(), formatKilobytes(filesArtifactNameAndUuid.GetSizeBytes()), creationTime); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding files artifact '%v' to the table to be displayed", filesArtifactNameAndUuid.GetFileName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+func formatKilobytes(sizeBytes uint64) string {
+	return fmt.Sprintf("%.1f KB", float64(sizeBytes)/bytesPerKilobyte)
+}
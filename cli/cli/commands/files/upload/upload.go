@@ -2,6 +2,8 @@ package upload
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -9,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
@@ -26,14 +29,18 @@ const (
 	nameFlagKey = "name"
 	defaultName = ""
 
+	uploadProgressDescription = "Uploading files"
+
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 )
 
 var FilesUploadCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
-	CommandStr:                command_str_consts.FilesUploadCmdStr,
-	ShortDescription:          "Uploads files to an enclave",
-	LongDescription:           "Uploads the requested files to the enclave so they can be used by services within the enclave",
+	CommandStr:       command_str_consts.FilesUploadCmdStr,
+	ShortDescription: "Uploads files to an enclave",
+	LongDescription: "Uploads the requested files to the enclave so they can be used by services within the enclave. " +
+		"When uploading a directory, the paths matching the patterns of the '" + shared_utils.KurtosisIgnoreFilename + "' file " +
+		"at its root, which has the same syntax as a .gitignore file, are left out",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Flags: []*flags.FlagConfig{
@@ -90,7 +97,13 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the name to be given to the produced artifact")
 	}
 
-	filesArtifactUuid, fileArtifactName, err := enclaveCtx.UploadFiles(path, artifactName)
+	var filesArtifactUuid services.FilesArtifactUUID
+	var fileArtifactName services.FileArtifactName
+	err = output_printers.PrintBytesTransferProgress(uploadProgressDescription, func(progressCallback func(uploadedBytes uint64, totalBytes uint64)) error {
+		var uploadErr error
+		filesArtifactUuid, fileArtifactName, uploadErr = enclaveCtx.UploadFilesWithProgress(path, artifactName, progressCallback)
+		return uploadErr
+	})
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred uploading files at path '%v' to enclave '%v'", path, enclaveIdentifier)
 	}
@@ -10,7 +10,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"os"
-	"path"
+	"path/filepath"
 	"plugin"
 )
 
@@ -44,7 +44,11 @@ func run(cmd *cobra.Command, args []string) error {
 	}
 
 	// TODO Store kube config path in configuration and read from there
-	kubeConfigPath := path.Join(os.Getenv("HOME"), ".kube", "config")
+	homeDirpath, err := os.UserHomeDir()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the user home directory, which is required to find the Kubernetes configuration")
+	}
+	kubeConfigPath := filepath.Join(homeDirpath, ".kube", "config")
 
 	kubernetesConfig, err := clientcmd.BuildConfigFromFlags(emptyConfigMasterUrl, kubeConfigPath)
 	if err != nil {
@@ -0,0 +1,24 @@
+package cache
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/cache/clear"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/cache/ls"
+	"github.com/spf13/cobra"
+)
+
+// PackageCacheCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var PackageCacheCmd = &cobra.Command{
+	Use:   command_str_consts.PackageCacheCmdStr,
+	Short: "Manage the package cache of an enclave",
+	Long: "Contains actions for managing the packages an enclave keeps on disk after cloning them. The cache is kept " +
+		"under the size limit set by 'package-cache-max-size-in-megabytes' in the Kurtosis config, by evicting the " +
+		"packages that were used the least recently",
+	RunE: nil,
+}
+
+func init() {
+	PackageCacheCmd.AddCommand(ls.PackageCacheLsCmd.MustGetCobraCommand())
+	PackageCacheCmd.AddCommand(clear.PackageCacheClearCmd.MustGetCobraCommand())
+}
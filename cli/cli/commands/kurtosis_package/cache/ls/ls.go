@@ -0,0 +1,107 @@
+package ls
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	repositoryColumnHeader = "Repository"
+	sizeColumnHeader       = "Size"
+	lastUsedColumnHeader   = "Last Used"
+
+	bytesPerMegabyte = float64(1024 * 1024)
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PackageCacheLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.PackageCacheLsCmdStr,
+	ShortDescription: "Lists the packages cached by an enclave",
+	LongDescription: "Lists the packages an enclave keeps on disk, from the least to the most recently used, along " +
+		"with the size of the cache, its size limit, and its hit rate",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+	cacheInfo, err := enclaveCtx.GetPackageCacheInfo(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package cache info of enclave '%v'", enclaveIdentifier)
+	}
+
+	if len(cacheInfo.GetCachedPackages()) == 0 {
+		out.PrintOutLn("No package is cached")
+	} else {
+		tablePrinter := output_printers.NewTablePrinter(repositoryColumnHeader, sizeColumnHeader, lastUsedColumnHeader)
+		for _, cachedPackage := range cacheInfo.GetCachedPackages() {
+			lastUsed := time.Unix(cachedPackage.GetLastUsedUnixSeconds(), 0).Format(time.RFC3339)
+			if err := tablePrinter.AddRow(cachedPackage.GetRepository(), formatMegabytes(cachedPackage.GetSizeBytes()), lastUsed); err != nil {
+				return stacktrace.Propagate(err, "An error occurred adding package '%v' to the table to be displayed", cachedPackage.GetRepository())
+			}
+		}
+		tablePrinter.Print()
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Cache size: %v out of %v", formatMegabytes(cacheInfo.GetTotalSizeBytes()), formatMegabytes(cacheInfo.GetMaxSizeBytes())))
+	numLookups := cacheInfo.GetNumHits() + cacheInfo.GetNumMisses()
+	if numLookups == 0 {
+		out.PrintOutLn("Hit rate: no package was used yet")
+		return nil
+	}
+	hitRatePercent := float64(cacheInfo.GetNumHits()) * 100 / float64(numLookups)
+	out.PrintOutLn(fmt.Sprintf("Hit rate: %.1f%% (%v hits, %v misses)", hitRatePercent, cacheInfo.GetNumHits(), cacheInfo.GetNumMisses()))
+	return nil
+}
+
+func formatMegabytes(sizeBytes uint64) string {
+	return fmt.Sprintf("%.1f MB", float64(sizeBytes)/bytesPerMegabyte)
+}
@@ -0,0 +1,27 @@
+package kurtosis_package
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/cache"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/plan"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/publish"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/search"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package/test"
+	"github.com/spf13/cobra"
+)
+
+// PackageCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var PackageCmd = &cobra.Command{
+	Use:   command_str_consts.PackageCmdStr,
+	Short: "Discover, publish, test and cache Kurtosis packages",
+	RunE:  nil,
+}
+
+func init() {
+	PackageCmd.AddCommand(cache.PackageCacheCmd)
+	PackageCmd.AddCommand(plan.PackagePlanCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(publish.PackagePublishCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(search.PackageSearchCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(test.PackageTestCmd.MustGetCobraCommand())
+}
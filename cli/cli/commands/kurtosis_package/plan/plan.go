@@ -0,0 +1,209 @@
+package plan
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/sirupsen/logrus"
+	"os"
+	"strings"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = false
+	isPackageDirpathArgGreedy   = false
+
+	inputArgsArgKey        = "args"
+	isInputArgsArgOptional = true
+	isInputArgsArgGreedy   = false
+	defaultInputArgs       = "{}"
+
+	goldenFileFlagKey = "golden-file"
+	// Signifies that the plan should be printed rather than compared
+	defaultGoldenFile = ""
+
+	updateFlagKey = "update"
+	defaultUpdate = "false"
+
+	// Signifies that the enclave name should be auto-generated
+	autogenerateEnclaveName = ""
+	isPartitioningEnabled   = false
+
+	// The plan is only interpreted and validated, never executed
+	dryRun      = true
+	parallelism = 1
+	isNotStrict = false
+
+	goldenFilePerms = 0644
+
+	diffContextLines = 3
+	actualPlanLabel  = "actual plan"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PackagePlanCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.PackagePlanCmdStr,
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	ShortDescription:          "Print or check the plan of a package against a golden file",
+	LongDescription: "Dry-runs the package in the given directory with the given args in a temporary enclave and " +
+		"serializes the instructions it produces to stable JSON. Without the '" + goldenFileFlagKey + "' flag the plan " +
+		"is printed. With it, the plan is compared to the golden file and the command fails, printing a diff, if they " +
+		"differ; pass '--" + updateFlagKey + "' to overwrite the golden file with the current plan instead.",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       goldenFileFlagKey,
+			Usage:     "Path to the golden file containing the expected plan",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultGoldenFile,
+		},
+		{
+			Key:       updateFlagKey,
+			Usage:     "If true, the golden file is overwritten with the current plan rather than compared to it",
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultUpdate,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   packageDirpathArgKey,
+			IsOptional:            isPackageDirpathArgOptional,
+			DefaultValue:          nil,
+			IsGreedy:              isPackageDirpathArgGreedy,
+			ArgCompletionProvider: args.NewDefaultShellFileCompletionProvider(),
+			ValidationFunc:        nil,
+		},
+		{
+			Key:                   inputArgsArgKey,
+			IsOptional:            isInputArgsArgOptional,
+			DefaultValue:          defaultInputArgs,
+			IsGreedy:              isInputArgsArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument '%v'", packageDirpathArgKey)
+	}
+	serializedInputArgs, err := args.GetNonGreedyArg(inputArgsArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument '%v'", inputArgsArgKey)
+	}
+	goldenFilepath, err := flags.GetString(goldenFileFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", goldenFileFlagKey)
+	}
+	shouldUpdate, err := flags.GetBool(updateFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", updateFlagKey)
+	}
+	if shouldUpdate && goldenFilepath == defaultGoldenFile {
+		return stacktrace.NewError("The '%v' flag requires the '%v' flag to be set", updateFlagKey, goldenFileFlagKey)
+	}
+
+	serializedPlan, err := getSerializedPlan(ctx, packageDirpath, serializedInputArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the plan of the package in '%v'", packageDirpath)
+	}
+
+	if goldenFilepath == defaultGoldenFile {
+		out.PrintOutLn(strings.TrimSuffix(string(serializedPlan), "\n"))
+		return nil
+	}
+	if shouldUpdate {
+		if err := os.WriteFile(goldenFilepath, serializedPlan, goldenFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the plan to golden file '%v'", goldenFilepath)
+		}
+		logrus.Infof("Wrote the plan to golden file '%v'", goldenFilepath)
+		return nil
+	}
+
+	expectedSerializedPlan, err := os.ReadFile(goldenFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading golden file '%v'; pass '--%v' to create it", goldenFilepath, updateFlagKey)
+	}
+	if bytes.Equal(expectedSerializedPlan, serializedPlan) {
+		logrus.Infof("The plan matches golden file '%v'", goldenFilepath)
+		return nil
+	}
+	planDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(expectedSerializedPlan)),
+		B:        difflib.SplitLines(string(serializedPlan)),
+		FromFile: goldenFilepath,
+		FromDate: "",
+		ToFile:   actualPlanLabel,
+		ToDate:   "",
+		Eol:      "",
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred computing the difference between the plan and golden file '%v'", goldenFilepath)
+	}
+	out.PrintOutLn(planDiff)
+	return stacktrace.NewError("The plan differs from golden file '%v'; if the change is intended, pass '--%v' to update the golden file", goldenFilepath, updateFlagKey)
+}
+
+func getSerializedPlan(ctx context.Context, packageDirpath string, serializedInputArgs string) ([]byte, error) {
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName, isPartitioningEnabled)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the enclave to interpret the package in")
+	}
+	defer func() {
+		if err := kurtosisCtx.DestroyEnclave(context.Background(), enclaveCtx.GetEnclaveName()); err != nil {
+			logrus.Errorf("An error occurred destroying enclave '%v' the package was interpreted in; you'll need to destroy it manually:\n%v", enclaveCtx.GetEnclaveName(), err)
+		}
+	}()
+
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, serializedInputArgs, dryRun, parallelism, isNotStrict)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred dry-running the package")
+	}
+	if runResult.InterpretationError != nil {
+		return nil, stacktrace.NewError("The package failed to interpret:\n%v", runResult.InterpretationError.GetErrorMessage())
+	}
+	if len(runResult.ValidationErrors) > 0 {
+		validationErrorMessages := []string{}
+		for _, validationError := range runResult.ValidationErrors {
+			validationErrorMessages = append(validationErrorMessages, validationError.GetErrorMessage())
+		}
+		return nil, stacktrace.NewError("The plan of the package failed validation:\n%v", strings.Join(validationErrorMessages, "\n"))
+	}
+
+	serializedPlan, err := enclaves.SerializeStarlarkPlan(runResult.Instructions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the plan")
+	}
+	return serializedPlan, nil
+}
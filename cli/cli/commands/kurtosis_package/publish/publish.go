@@ -0,0 +1,117 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_catalog"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = false
+	isPackageDirpathArgGreedy   = false
+
+	versionArgKey        = "version"
+	isVersionArgOptional = false
+	isVersionArgGreedy   = false
+
+	descriptionFlagKey    = "description"
+	defaultDescription    = ""
+	catalogDirpathFlagKey = "catalog-dirpath"
+	defaultCatalogDirpath = "."
+)
+
+var PackagePublishCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackagePublishCmdStr,
+	ShortDescription: "Publish a package version to the package catalog",
+	LongDescription: "Registers the given version of the package in the given directory in a local clone of the package " +
+		"catalog repository (" + package_catalog.DefaultCatalogRepository + " by default). The version must be a " +
+		"semantic version matching a git tag of the package repository. Once the catalog is updated, commit the change " +
+		"and open a pull request against the catalog repository to make the version discoverable.",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       descriptionFlagKey,
+			Usage:     "Description of the package displayed in the catalog; the current description is kept if empty",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultDescription,
+		},
+		{
+			Key:       catalogDirpathFlagKey,
+			Usage:     "Path to the local clone of the package catalog repository",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   defaultCatalogDirpath,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   packageDirpathArgKey,
+			IsOptional:            isPackageDirpathArgOptional,
+			DefaultValue:          nil,
+			IsGreedy:              isPackageDirpathArgGreedy,
+			ArgCompletionProvider: args.NewDefaultShellFileCompletionProvider(),
+			ValidationFunc:        nil,
+		},
+		{
+			Key:                   versionArgKey,
+			IsOptional:            isVersionArgOptional,
+			DefaultValue:          nil,
+			IsGreedy:              isVersionArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument '%v'", packageDirpathArgKey)
+	}
+	version, err := args.GetNonGreedyArg(versionArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument '%v'", versionArgKey)
+	}
+	description, err := flags.GetString(descriptionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", descriptionFlagKey)
+	}
+	catalogDirpath, err := flags.GetString(catalogDirpathFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", catalogDirpathFlagKey)
+	}
+
+	packageName, err := package_catalog.GetPackageName(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the package in '%v'", packageDirpath)
+	}
+
+	if err := package_catalog.EnsureVersionIsTagged(ctx, packageName, version); err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking that version '%v' of package '%v' is tagged", version, packageName)
+	}
+
+	catalog, err := package_catalog.ReadLocalPackageCatalog(catalogDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the package catalog in '%v'", catalogDirpath)
+	}
+	if err := catalog.AddPackageVersion(packageName, description, version); err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding version '%v' of package '%v' to the catalog", version, packageName)
+	}
+	if err := package_catalog.WriteLocalPackageCatalog(catalogDirpath, catalog); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the updated package catalog in '%v'", catalogDirpath)
+	}
+
+	out.PrintOutLn(fmt.Sprintf("Added version '%v' of package '%v' to the package catalog in '%v'", version, packageName, catalogDirpath))
+	out.PrintOutLn(fmt.Sprintf("Commit the change to '%v' and open a pull request against the catalog repository to publish it", package_catalog.CatalogFilename))
+	return nil
+}
@@ -0,0 +1,91 @@
+package search
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_catalog"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	queryArgKey        = "query"
+	isQueryArgOptional = true
+	isQueryArgGreedy   = false
+	emptyQuery         = ""
+
+	catalogFlagKey = "catalog"
+
+	nameColumnHeader          = "Name"
+	latestVersionColumnHeader = "Latest Version"
+	descriptionColumnHeader   = "Description"
+
+	noPublishedVersion = "<none>"
+)
+
+var PackageSearchCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackageSearchCmdStr,
+	ShortDescription: "Search the package catalog",
+	LongDescription: "Lists the packages of the package catalog whose name or description contains the query (ignoring " +
+		"case), along with their latest published version. Without a query, all the packages of the catalog are listed.",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       catalogFlagKey,
+			Usage:     "The GitHub repository, of the form 'owner/name', containing the package catalog",
+			Shorthand: "",
+			Type:      flags.FlagType_String,
+			Default:   package_catalog.DefaultCatalogRepository,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   queryArgKey,
+			IsOptional:            isQueryArgOptional,
+			DefaultValue:          emptyQuery,
+			IsGreedy:              isQueryArgGreedy,
+			ArgCompletionProvider: nil,
+			ValidationFunc:        nil,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(ctx context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	query, err := args.GetNonGreedyArg(queryArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument '%v'", queryArgKey)
+	}
+	catalogRepository, err := flags.GetString(catalogFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of flag '%v'", catalogFlagKey)
+	}
+
+	catalog, err := package_catalog.GetRemotePackageCatalog(ctx, catalogRepository)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package catalog from repository '%v'", catalogRepository)
+	}
+
+	matchingPackages := catalog.Search(query)
+	if len(matchingPackages) == 0 {
+		out.PrintOutLn("No package matches the query")
+		return nil
+	}
+	tablePrinter := output_printers.NewTablePrinter(nameColumnHeader, latestVersionColumnHeader, descriptionColumnHeader)
+	for _, matchingPackage := range matchingPackages {
+		latestVersion := matchingPackage.GetLatestVersion()
+		if latestVersion == "" {
+			latestVersion = noPublishedVersion
+		}
+		if err := tablePrinter.AddRow(matchingPackage.Name, latestVersion, matchingPackage.Description); err != nil {
+			return stacktrace.Propagate(err, "An error occurred adding package '%v' to the table to be displayed", matchingPackage.Name)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_catalog"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_test_runner"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = true
+	isPackageDirpathArgGreedy   = false
+	defaultPackageDirpath       = "."
+
+	// Signifies that the enclave name should be auto-generated
+	autogenerateEnclaveName = ""
+	isPartitioningEnabled   = false
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	testPassedStatus = "PASS"
+	testFailedStatus = "FAIL"
+)
+
+var PackageTestCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.PackageTestCmdStr,
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	ShortDescription:          "Run the Starlark unit tests of a package",
+	LongDescription: "Runs every 'test_*' function of the '*" + package_test_runner.TestFileSuffix + "' files of the " +
+		"package in the given directory. Tests are only interpreted and validated, never executed, so no service " +
+		"container gets started. A test passes if it interprets successfully and the plan it produces matches the " +
+		"expectations it returns (e.g. 'return {\"instructions\": [\"add_service\"]}'). A test listed in the " +
+		"'EXPECTED_ERRORS' dict of its file passes if its interpretation fails with an error containing the given " +
+		"string. The tests run in a temporary enclave that is destroyed afterwards.",
+	Flags: []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		{
+			Key:                   packageDirpathArgKey,
+			IsOptional:            isPackageDirpathArgOptional,
+			DefaultValue:          defaultPackageDirpath,
+			IsGreedy:              isPackageDirpathArgGreedy,
+			ArgCompletionProvider: args.NewDefaultShellFileCompletionProvider(),
+			ValidationFunc:        nil,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of argument '%v'", packageDirpathArgKey)
+	}
+
+	packageName, err := package_catalog.GetPackageName(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the name of the package in '%v'", packageDirpath)
+	}
+	testFiles, err := package_test_runner.FindTestFiles(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred looking for the test files of package '%v'", packageName)
+	}
+	if len(testFiles) == 0 {
+		logrus.Infof("No '*%v' files found in package '%v'; nothing to test", package_test_runner.TestFileSuffix, packageName)
+		return nil
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, autogenerateEnclaveName, isPartitioningEnabled)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the enclave to run the tests in")
+	}
+	defer func() {
+		if err := kurtosisCtx.DestroyEnclave(context.Background(), enclaveCtx.GetEnclaveName()); err != nil {
+			logrus.Errorf("An error occurred destroying enclave '%v' the tests ran in; you'll need to destroy it manually:\n%v", enclaveCtx.GetEnclaveName(), err)
+		}
+	}()
+
+	numTests := 0
+	numFailedTests := 0
+	for _, testFile := range testFiles {
+		results, err := package_test_runner.RunTestFile(ctx, enclaveCtx, packageDirpath, packageName, testFile)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred running the tests of '%v'", testFile)
+		}
+		for _, result := range results {
+			numTests += 1
+			testId := testFile
+			if result.TestName != "" {
+				testId = fmt.Sprintf("%v::%v", testFile, result.TestName)
+			}
+			if result.Failure == nil {
+				out.PrintOutLn(fmt.Sprintf("%v  %v", testPassedStatus, testId))
+				continue
+			}
+			numFailedTests += 1
+			// '%#s' prints the failure without its stacktrace, which is only noise to the package author
+			out.PrintOutLn(fmt.Sprintf("%v  %v\n%#s", testFailedStatus, testId, result.Failure))
+		}
+	}
+
+	if numFailedTests > 0 {
+		return stacktrace.NewError("%d out of %d tests of package '%v' failed", numFailedTests, numTests, packageName)
+	}
+	logrus.Infof("All %d tests of package '%v' passed", numTests, packageName)
+	return nil
+}
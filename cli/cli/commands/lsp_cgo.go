@@ -0,0 +1,13 @@
+//go:build cgo
+
+package commands
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/lsp"
+)
+
+// The language server relies on tree-sitter, which is a C library. It's only registered when the CLI is built with
+// cgo, so that the rest of the CLI can still be built for platforms without a C toolchain (e.g. native Windows builds)
+func init() {
+	RootCmd.AddCommand(lsp.NewLspCommand())
+}
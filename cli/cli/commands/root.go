@@ -11,6 +11,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/analytics"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/apply"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/clean"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/cluster"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/config"
@@ -23,12 +24,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/files"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/gateway"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/lsp"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_package"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/portal"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/twitter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/version"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grouped_errors_presenter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/logrus_log_levels"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/user_send_metrics_election"
@@ -79,6 +82,7 @@ type GitHubReleaseReponse struct {
 
 var logLevelStr string
 var defaultLogLevelStr = logrus.InfoLevel.String()
+var shouldPresentVerboseErrors bool
 
 // RootCmd Suppressing exhaustruct requirement because this struct has ~40 properties
 // nolint: exhaustruct
@@ -101,8 +105,15 @@ func init() {
 		defaultLogLevelStr,
 		"Sets the level that the CLI will log at ("+strings.Join(logrus_log_levels.GetAcceptableLogLevelStrs(), "|")+")",
 	)
+	RootCmd.PersistentFlags().BoolVar(
+		&shouldPresentVerboseErrors,
+		grouped_errors_presenter.VerboseErrorsFlagKey,
+		false,
+		"Prints the full error of every object a bulk operation failed on, rather than grouping the errors by root cause",
+	)
 
 	RootCmd.AddCommand(analytics.AnalyticsCmd.MustGetCobraCommand())
+	RootCmd.AddCommand(apply.ApplyCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(clean.CleanCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(cluster.ClusterCmd)
 	RootCmd.AddCommand(kurtosis_context.ContextCmd)
@@ -115,12 +126,13 @@ func init() {
 	RootCmd.AddCommand(feedback.FeedbackCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(files.FilesCmd)
 	RootCmd.AddCommand(gateway.GatewayCmd)
+	RootCmd.AddCommand(kurtosis_package.PackageCmd)
 	RootCmd.AddCommand(portal.PortalCmd)
 	RootCmd.AddCommand(run.StarlarkRunCmd.MustGetCobraCommand())
+	RootCmd.AddCommand(schedule.ScheduleCmd)
 	RootCmd.AddCommand(service.ServiceCmd)
 	RootCmd.AddCommand(twitter.TwitterCmd.MustGetCobraCommand())
 	RootCmd.AddCommand(version.VersionCmd)
-	RootCmd.AddCommand(lsp.NewLspCommand())
 }
 
 // ====================================================================================================
@@ -132,6 +144,7 @@ func globalSetup(cmd *cobra.Command, args []string) error {
 	if err := setupCLILogs(cmd); err != nil {
 		return stacktrace.Propagate(err, "An error occurred setting up CLI logs")
 	}
+	grouped_errors_presenter.SetShouldPresentVerboseErrors(shouldPresentVerboseErrors)
 	checkCLIVersion(cmd)
 	//It is necessary to try track this metric on every execution to have at least one successful deliver
 	if err := user_send_metrics_election.SendAnyBackloggedUserMetricsElectionEvent(); err != nil {
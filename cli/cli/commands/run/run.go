@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
@@ -16,20 +17,23 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/kurtosis_config_getter"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/service_exit_code"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/webhook_notifier"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"os"
 	"os/signal"
-	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -38,6 +42,8 @@ const (
 
 	starlarkExtension = ".star"
 
+	doNotShowSystemContainers = false
+
 	inputArgsArgKey                  = "args"
 	inputArgsArgIsOptional           = true
 	inputArgsAreNonGreedy            = false
@@ -65,6 +71,18 @@ const (
 	parallelismFlagKey = "parallelism"
 	defaultParallelism = "4"
 
+	strictFlagKey = "strict"
+	defaultStrict = "false"
+
+	rmOnSuccessFlagKey = "rm-on-success"
+	defaultRmOnSuccess = "false"
+
+	rmAlwaysFlagKey = "rm-always"
+	defaultRmAlways = "false"
+
+	waitForServiceFlagKey = "wait-for"
+	noServiceToWaitFor    = ""
+
 	mapPortsFlagKey = "map-ports"
 	// we're mapping ports by default such that remote run and local run gives the exact same state: ports are reachable from local laptop
 	defaultMapPortsFlagKey = "true"
@@ -82,6 +100,8 @@ const (
 	runSucceeded = true
 
 	portMappingSeparatorForLogs = ", "
+
+	successfulServiceExitCode = 0
 )
 
 var (
@@ -131,6 +151,13 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Shorthand: "p",
 			Default:   defaultParallelism,
 		},
+		{
+			Key: strictFlagKey,
+			Usage: "If true then the run fails on the first deprecated Starlark feature it uses, instead of only " +
+				"warning about it, e.g. to make sure a package is ready for the next Kurtosis version. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: defaultStrict,
+		},
 		{
 			Key:       verbosityFlagKey,
 			Usage:     fmt.Sprintf("The verbosity of the command output: %s. If unset, it defaults to `brief` for a concise and explicit output. Use `detailed` to display the exhaustive list of arguments for each command. `executable` will generate executable Starlark instructions.", strings.Join(command_args_run.VerbosityStrings(), ", ")),
@@ -158,6 +185,26 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: defaultMapPortsFlagKey,
 		},
+		{
+			Key: rmOnSuccessFlagKey,
+			Usage: "If true then the enclave gets destroyed once the run succeeds, and kept for debugging if it fails, " +
+				"e.g. for CI smoke tests that don't need a separate cleanup step. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: defaultRmOnSuccess,
+		},
+		{
+			Key:     rmAlwaysFlagKey,
+			Usage:   "If true then the enclave gets destroyed once the run finishes, whether it succeeds or not. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: defaultRmAlways,
+		},
+		{
+			Key: waitForServiceFlagKey,
+			Usage: "The identifier of a service, e.g. one running a test suite, to wait for once the run succeeds. " +
+				"The enclave gets destroyed once the service exits, and the CLI exits with the exit code of the service",
+			Type:    flags.FlagType_String,
+			Default: noServiceToWaitFor,
+		},
 	},
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
@@ -217,6 +264,11 @@ func run(
 	}
 	castedParallelism := int32(parallelism)
 
+	isStrict, err := flags.GetBool(strictFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", strictFlagKey)
+	}
+
 	verbosity, err := parseVerbosityFlag(flags)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the verbosity using flag key '%s'", verbosityFlagKey)
@@ -237,6 +289,30 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", mapPortsFlagKey)
 	}
 
+	rmOnSuccess, err := flags.GetBool(rmOnSuccessFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", rmOnSuccessFlagKey)
+	}
+
+	rmAlways, err := flags.GetBool(rmAlwaysFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", rmAlwaysFlagKey)
+	}
+	if rmOnSuccess && rmAlways {
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("Only one of the '--%s' and '--%s' flags can be set", rmOnSuccessFlagKey, rmAlwaysFlagKey)
+	}
+
+	serviceToWaitFor, err := flags.GetString(waitForServiceFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", waitForServiceFlagKey)
+	}
+	isWaitingForService := serviceToWaitFor != noServiceToWaitFor
+	if isWaitingForService && dryRun {
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("The '--%s' flag can't be used with '--%s' as no service gets started", waitForServiceFlagKey, dryRunFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -247,16 +323,25 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", userRequestedEnclaveIdentifier)
 	}
 
+	// Nothing is left to inspect or report once the enclave got destroyed
+	isEnclaveDestroyed := false
 	if showEnclaveInspect {
 		defer func() {
-			if err = inspect.PrintEnclaveInspect(ctx, kurtosisBackend, kurtosisCtx, enclaveCtx.GetEnclaveName(), showFullUuids); err != nil {
+			if isEnclaveDestroyed {
+				return
+			}
+			if err = inspect.PrintEnclaveInspect(ctx, kurtosisBackend, kurtosisCtx, enclaveCtx.GetEnclaveName(), showFullUuids, doNotShowSystemContainers); err != nil {
 				logrus.Errorf("An error occurred while printing enclave status and contents:\n%s", err)
 			}
 		}()
 	}
 
 	if isNewEnclave {
-		defer output_printers.PrintEnclaveName(enclaveCtx.GetEnclaveName())
+		defer func() {
+			if !isEnclaveDestroyed {
+				output_printers.PrintEnclaveName(enclaveCtx.GetEnclaveName())
+			}
+		}()
 	}
 
 	var responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
@@ -265,8 +350,9 @@ func run(
 
 	isRemotePackage := strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix)
 	isStandAloneScript := false
+	isLocalPackage := false
 	if isRemotePackage {
-		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism)
+		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism, isStrict)
 	} else {
 		fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
 		if err != nil {
@@ -278,14 +364,15 @@ func run(
 			if !strings.HasSuffix(starlarkScriptOrPackagePath, starlarkExtension) {
 				return stacktrace.NewError("Expected a script with a '%s' extension but got file '%v' with a different extension", starlarkExtension, starlarkScriptOrPackagePath)
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism)
+			responseLineChan, cancelFunc, errRunningKurtosis = executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism, isStrict)
 		} else {
 			// if the path is a file with `kurtosis.yml` at the end it's a module dir
 			// we remove the `kurtosis.yml` to get just the Dir containing the module
 			if isKurtosisYMLFileInPackageDir(fileOrDir, kurtosisYMLFilePath) {
-				starlarkScriptOrPackagePath = path.Dir(starlarkScriptOrPackagePath)
+				starlarkScriptOrPackagePath = filepath.Dir(starlarkScriptOrPackagePath)
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism)
+			isLocalPackage = true
+			responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, serializedJsonArgs, dryRun, castedParallelism, isStrict)
 		}
 	}
 	if errRunningKurtosis != nil {
@@ -297,7 +384,11 @@ func run(
 		logrus.Warn("An error occurred tracking kurtosis run event")
 	}
 
-	errRunningKurtosis = readAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun)
+	resolvedPackageVersions, errRunningKurtosis := readAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun)
+	// A failed or dry run mustn't lock anything
+	if isLocalPackage && !dryRun && errRunningKurtosis == nil && len(resolvedPackageVersions) > 0 {
+		writePackageLockFile(starlarkScriptOrPackagePath, resolvedPackageVersions)
+	}
 	var runStatusForMetrics bool
 	if errRunningKurtosis != nil {
 		runStatusForMetrics = runFailed
@@ -305,6 +396,9 @@ func run(
 		runStatusForMetrics = runSucceeded
 	}
 
+	// The engine doesn't see runs finishing, so the CLI notifies the webhooks itself
+	notifyRunFinishedWebhooks(ctx, enclaveCtx, starlarkScriptOrPackagePath, errRunningKurtosis == nil)
+
 	servicesInEnclavePostRun, servicesInEnclaveForMetricsError := enclaveCtx.GetServices()
 	if servicesInEnclaveForMetricsError != nil {
 		logrus.Warn("Tried getting number of services in the enclave to log metrics but failed")
@@ -314,10 +408,45 @@ func run(
 		}
 	}
 
+	var serviceExitCode int32
+	if isWaitingForService && errRunningKurtosis == nil {
+		logrus.Infof("Waiting for service '%v' to exit...", serviceToWaitFor)
+		serviceExitCode, errRunningKurtosis = enclaveCtx.WaitForServiceExit(ctx, serviceToWaitFor)
+		if errRunningKurtosis != nil {
+			errRunningKurtosis = stacktrace.Propagate(errRunningKurtosis, "An error occurred waiting for service '%v' to exit", serviceToWaitFor)
+		} else {
+			logrus.Infof("Service '%v' exited with code %v", serviceToWaitFor, serviceExitCode)
+		}
+	}
+
+	// Waiting for a service ties the outcome to its exit code, so the enclave gets torn down whatever the code is
+	if shouldDestroyEnclaveAfterRun(rmOnSuccess || isWaitingForService, rmAlways, errRunningKurtosis == nil) {
+		enclaveName := enclaveCtx.GetEnclaveName()
+		logrus.Infof("Destroying enclave '%v' as the run finished...", enclaveName)
+		if err = kurtosisCtx.DestroyEnclave(ctx, enclaveName); err != nil {
+			// The outcome of the run is what the exit code must reflect, so a failed cleanup only fails a successful run
+			if errRunningKurtosis != nil {
+				logrus.Errorf("An error occurred destroying enclave '%v' after the failed run:\n%v", enclaveName, err)
+				return errRunningKurtosis
+			}
+			return stacktrace.Propagate(err, "The run succeeded but an error occurred destroying enclave '%v' afterwards", enclaveName)
+		}
+		isEnclaveDestroyed = true
+		logrus.Infof("Enclave '%v' destroyed successfully", enclaveName)
+	}
+
 	if errRunningKurtosis != nil {
 		return errRunningKurtosis
 	}
 
+	if serviceExitCode != successfulServiceExitCode {
+		return service_exit_code.NewServiceExitCodeError(serviceToWaitFor, serviceExitCode)
+	}
+
+	if isEnclaveDestroyed {
+		return nil
+	}
+
 	if servicesInEnclaveForMetricsError != nil {
 		logrus.Warnf("Unable to retrieve the services running inside the enclave so their ports will not be" +
 			" mapped to local ports.")
@@ -377,15 +506,15 @@ func run(
 //	Private Helper Functions
 //
 // ====================================================================================================
-func executeScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, scriptPath string, serializedParams string, dryRun bool, parallelism int32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func executeScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, scriptPath string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	fileContentBytes, err := os.ReadFile(scriptPath)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unable to read content of Starlark script file '%s'", scriptPath)
 	}
-	return enclaveCtx.RunStarlarkScript(ctx, string(fileContentBytes), serializedParams, dryRun, parallelism)
+	return enclaveCtx.RunStarlarkScript(ctx, string(fileContentBytes), serializedParams, dryRun, parallelism, isStrict)
 }
 
-func executePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packagePath string, serializedParams string, dryRun bool, parallelism int32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func executePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packagePath string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	// we get the absolute path so that the logs make more sense
 	absolutePackagePath, err := filepath.Abs(packagePath)
 	logrus.Infof("Executing Starlark package at '%v' as the passed argument '%v' looks like a directory", absolutePackagePath, packagePath)
@@ -393,14 +522,46 @@ func executePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, pa
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting the absolute path for '%v'", packagePath)
 	}
-	return enclaveCtx.RunStarlarkPackage(ctx, packagePath, serializedParams, dryRun, parallelism)
+	return enclaveCtx.RunStarlarkPackage(ctx, packagePath, serializedParams, dryRun, parallelism, isStrict)
+}
+
+func executeRemotePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packageId string, serializedParams string, dryRun bool, parallelism int32, isStrict bool) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, dryRun, parallelism, isStrict)
 }
 
-func executeRemotePackage(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packageId string, serializedParams string, dryRun bool, parallelism int32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
-	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, serializedParams, dryRun, parallelism)
+func notifyRunFinishedWebhooks(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, starlarkScriptOrPackagePath string, isSuccess bool) {
+	kurtosisConfig, err := kurtosis_config_getter.GetKurtosisConfig()
+	if err != nil {
+		logrus.Warnf("Couldn't get the Kurtosis config to notify the webhooks that the run finished:\n%v", err)
+		return
+	}
+	notifier, err := webhook_notifier.NewWebhookNotifier(kurtosisConfig.GetWebhooks())
+	if err != nil {
+		logrus.Warnf("Couldn't create the notifier of the webhooks to tell them that the run finished:\n%v", err)
+		return
+	}
+	if !notifier.IsNotifying(webhook_notifier.EventType_RunFinished) {
+		return
+	}
+	event := &webhook_notifier.Event{
+		Type:        webhook_notifier.EventType_RunFinished,
+		Timestamp:   time.Now(),
+		EnclaveUuid: string(enclaveCtx.GetEnclaveUuid()),
+		EnclaveName: enclaveCtx.GetEnclaveName(),
+		ServiceName: "",
+		ServiceUuid: "",
+		PackageId:   starlarkScriptOrPackagePath,
+		IsSuccess:   isSuccess,
+		Message:     "",
+	}
+	if err := notifier.Notify(ctx, event); err != nil {
+		logrus.Warnf("An error occurred notifying the webhooks that the run finished:\n%v", err)
+	}
 }
 
-func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool) error {
+// readAndPrintResponseLinesUntilClosed returns the tags the package version constraints got resolved to, as reported
+// when the run finished
+func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool) (map[string]string, error) {
 	defer cancelFunc()
 
 	// This channel will receive a signal when the user presses an interrupt
@@ -410,11 +571,12 @@ func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 
 	printer := output_printers.NewExecutionPrinter()
 	if err := printer.Start(); err != nil {
-		return stacktrace.Propagate(err, "Unable to start the printer for this execution. The execution will continue in the background but nothing will be printed.")
+		return nil, stacktrace.Propagate(err, "Unable to start the printer for this execution. The execution will continue in the background but nothing will be printed.")
 	}
 	defer printer.Stop()
 
 	isRunSuccessful := false // defaults to false such that we fail loudly if something unexpected happens
+	var resolvedPackageVersions map[string]string
 	for {
 		select {
 		case responseLine, isChanOpen := <-responseLineChan:
@@ -423,9 +585,9 @@ func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 					// This error thrown by the APIC is not informative right now as it just tells the user to look at errors
 					// in the above log. For this reason we're ignoring it and returning nil. This is exceptional to not clutter
 					// the CLI output. We should still use stacktrace.Propagate for other errors.
-					return stacktrace.Propagate(command_str_consts.ErrorMessageDueToStarlarkFailure, "Error occurred while running kurtosis package")
+					return resolvedPackageVersions, stacktrace.Propagate(command_str_consts.ErrorMessageDueToStarlarkFailure, "Error occurred while running kurtosis package")
 				}
-				return nil
+				return resolvedPackageVersions, nil
 			}
 			err := printer.PrintKurtosisExecutionResponseLineToStdOut(responseLine, verbosity, dryRun)
 			if err != nil {
@@ -434,13 +596,39 @@ func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 			// If the run finished, persist its status to the isRunSuccessful bool to throw an error and return a non-zero status code
 			if responseLine.GetRunFinishedEvent() != nil {
 				isRunSuccessful = responseLine.GetRunFinishedEvent().GetIsRunSuccessful()
+				resolvedPackageVersions = responseLine.GetRunFinishedEvent().GetResolvedPackageVersions()
 			}
 		case <-interruptChan:
-			return stacktrace.NewError("User manually interrupted the execution, returning. Note that the execution will continue in the Kurtosis enclave")
+			return nil, stacktrace.NewError("User manually interrupted the execution, returning. Note that the execution will continue in the Kurtosis enclave")
 		}
 	}
 }
 
+// writePackageLockFile records the versions the run resolved the package version constraints to in the lock file next
+// to the package, so that they get committed along with it and the next runs keep using them. The versions the lock
+// file already has are kept, as the packages cloned by earlier runs don't get their constraints resolved again.
+// Failing to do so doesn't fail the run
+func writePackageLockFile(packageDirpath string, resolvedPackageVersions map[string]string) {
+	lockFile, err := shared_utils.ReadPackageLockFile(packageDirpath)
+	if err != nil {
+		logrus.Warnf("An error occurred reading the '%v' file of the package, so the versions the package version constraints got resolved to won't be written to it; the next runs might resolve them to other versions:\n%v", shared_utils.PackageLockFilename, err)
+		return
+	}
+	for lockedVersionKey, lockedTagName := range resolvedPackageVersions {
+		lockFile.ResolvedVersions[lockedVersionKey] = lockedTagName
+	}
+	if err := shared_utils.WritePackageLockFile(packageDirpath, lockFile); err != nil {
+		logrus.Warnf("An error occurred writing the versions the package version constraints got resolved to in the '%v' file of the package; the next runs might resolve them to other versions:\n%v", shared_utils.PackageLockFilename, err)
+		return
+	}
+	logrus.Debugf("Wrote the versions the package version constraints got resolved to in the '%v' file of the package at '%v'", shared_utils.PackageLockFilename, packageDirpath)
+}
+
+// shouldDestroyEnclaveAfterRun tells whether the enclave the run happened in must be destroyed given the outcome of the run
+func shouldDestroyEnclaveAfterRun(rmOnSuccess bool, rmAlways bool, isRunSuccessful bool) bool {
+	return rmAlways || (rmOnSuccess && isRunSuccessful)
+}
+
 func getOrCreateEnclaveContext(
 	ctx context.Context,
 	enclaveIdentifierOrName string,
@@ -2,6 +2,7 @@ package run
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/stretchr/testify/require"
@@ -32,3 +33,33 @@ func TestValidateArgs_invalid(t *testing.T) {
 	err = validatePackageArgs(testCtx, testParsedFlags, parsedArgs)
 	require.NotNil(t, err)
 }
+
+func TestShouldDestroyEnclaveAfterRun(t *testing.T) {
+	require.False(t, shouldDestroyEnclaveAfterRun(false, false, runSucceeded))
+	require.False(t, shouldDestroyEnclaveAfterRun(false, false, runFailed))
+	require.True(t, shouldDestroyEnclaveAfterRun(true, false, runSucceeded))
+	require.False(t, shouldDestroyEnclaveAfterRun(true, false, runFailed))
+	require.True(t, shouldDestroyEnclaveAfterRun(false, true, runSucceeded))
+	require.True(t, shouldDestroyEnclaveAfterRun(false, true, runFailed))
+}
+
+func TestWritePackageLockFile_KeepsTheVersionsLockedByEarlierRuns(t *testing.T) {
+	packageDirpath := t.TempDir()
+	require.NoError(t, shared_utils.WritePackageLockFile(packageDirpath, &shared_utils.PackageLockFile{
+		ResolvedVersions: map[string]string{
+			"kurtosis-tech/first-package@^1.2":  "v1.2.0",
+			"kurtosis-tech/second-package@^2.0": "v2.0.0",
+		},
+	}))
+
+	writePackageLockFile(packageDirpath, map[string]string{
+		"kurtosis-tech/second-package@^2.0": "v2.1.0",
+	})
+
+	lockFile, err := shared_utils.ReadPackageLockFile(packageDirpath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"kurtosis-tech/first-package@^1.2":  "v1.2.0",
+		"kurtosis-tech/second-package@^2.0": "v2.1.0",
+	}, lockFile.ResolvedVersions)
+}
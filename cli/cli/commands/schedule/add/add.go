@@ -0,0 +1,129 @@
+package add
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	scheduleNameArgKey = "name"
+	packageIdArgKey    = "package"
+
+	cronExpressionFlagKey = "cron"
+	// Empty means the flag wasn't set, and it is required
+	defaultCronExpression = ""
+
+	packageArgsFlagKey = "args"
+	defaultPackageArgs = "{}"
+
+	retentionFlagKey = "retention"
+	// Zero means all the enclaves of the schedule are kept
+	defaultRetention = "0"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ScheduleAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ScheduleAddCmdStr,
+	ShortDescription: "Creates enclaves from a package on a cron schedule",
+	LongDescription: fmt.Sprintf(
+		"Makes the engine create an enclave named '<%v>-<yyyymmddhhmm>' and run the given remote package in it each time "+
+			"the '%v' expression (in UTC) matches. Use the '%v' flag to only keep the last enclaves of the schedule",
+		scheduleNameArgKey,
+		cronExpressionFlagKey,
+		retentionFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     cronExpressionFlagKey,
+			Usage:   "The cron expression the runs happen on, with the 5 standard fields (e.g. '0 3 * * *') or a macro like '@daily'",
+			Type:    flags.FlagType_String,
+			Default: defaultCronExpression,
+		},
+		{
+			Key:     packageArgsFlagKey,
+			Usage:   "The JSON-serialized arguments passed to the package on each run",
+			Type:    flags.FlagType_String,
+			Default: defaultPackageArgs,
+		},
+		{
+			Key:     retentionFlagKey,
+			Usage:   "How many of the last enclaves of the schedule to keep, the older ones being destroyed after each run; 0 keeps them all",
+			Type:    flags.FlagType_Uint32,
+			Default: defaultRetention,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key: scheduleNameArgKey,
+		},
+		{
+			Key: packageIdArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	scheduleName, err := args.GetNonGreedyArg(scheduleNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the schedule name using arg key '%v'", scheduleNameArgKey)
+	}
+	packageId, err := args.GetNonGreedyArg(packageIdArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package using arg key '%v'", packageIdArgKey)
+	}
+	cronExpression, err := flags.GetString(cronExpressionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the cron expression using flag key '%v'", cronExpressionFlagKey)
+	}
+	if cronExpression == defaultCronExpression {
+		return stacktrace.NewError("The '%v' flag is required", cronExpressionFlagKey)
+	}
+	packageArgs, err := flags.GetString(packageArgsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package arguments using flag key '%v'", packageArgsFlagKey)
+	}
+	if !json.Valid([]byte(packageArgs)) {
+		return stacktrace.NewError("The '%v' flag value '%v' isn't valid JSON", packageArgsFlagKey, packageArgs)
+	}
+	retention, err := flags.GetUint32(retentionFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the retention using flag key '%v'", retentionFlagKey)
+	}
+
+	addEnclaveScheduleArgs := &kurtosis_engine_rpc_api_bindings.AddEnclaveScheduleArgs{
+		Schedule: &kurtosis_engine_rpc_api_bindings.EnclaveSchedule{
+			Name:             scheduleName,
+			CronExpression:   cronExpression,
+			PackageId:        packageId,
+			SerializedParams: packageArgs,
+			Retention:        retention,
+		},
+	}
+	if _, err := engineClient.AddEnclaveSchedule(ctx, addEnclaveScheduleArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding enclave schedule '%v'", scheduleName)
+	}
+	logrus.Infof("Added schedule '%v', running package '%v' on '%v'", scheduleName, packageId, cronExpression)
+	return nil
+}
@@ -0,0 +1,113 @@
+package ls
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	scheduleNameColumnHeader   = "Name"
+	cronExpressionColumnHeader = "Cron"
+	packageIdColumnHeader      = "Package"
+	retentionColumnHeader      = "Retention"
+	nextRunTimeColumnHeader    = "Next Run"
+	lastRunStatusColumnHeader  = "Last Run"
+	enclavesColumnHeader       = "Enclaves"
+
+	// Shown when the value isn't known, e.g. the last run when the engine hasn't run the schedule since it started
+	unknownValueStr    = "-"
+	keepAllEnclavesStr = "all"
+	successfulRunStr   = "succeeded"
+	failedRunStr       = "failed"
+
+	enclaveNamesSeparator = ", "
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ScheduleLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.ScheduleLsCmdStr,
+	ShortDescription:          "Lists the schedules",
+	LongDescription:           "Lists the schedules of the engine, along with their next run, the status of the last one and the enclaves they created",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     nil,
+	Args:                      nil,
+	RunFunc:                   run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	_ *args.ParsedArgs,
+) error {
+	response, err := engineClient.GetEnclaveSchedules(ctx, &emptypb.Empty{})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave schedules")
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(
+		scheduleNameColumnHeader,
+		cronExpressionColumnHeader,
+		packageIdColumnHeader,
+		retentionColumnHeader,
+		nextRunTimeColumnHeader,
+		lastRunStatusColumnHeader,
+		enclavesColumnHeader,
+	)
+	for _, scheduleInfo := range response.GetSchedules() {
+		schedule := scheduleInfo.GetSchedule()
+		retentionStr := keepAllEnclavesStr
+		if schedule.GetRetention() > 0 {
+			retentionStr = strconv.FormatUint(uint64(schedule.GetRetention()), 10)
+		}
+		nextRunTimeStr := unknownValueStr
+		if scheduleInfo.GetNextRunTime() != nil {
+			nextRunTimeStr = scheduleInfo.GetNextRunTime().AsTime().Local().Format(time.RFC1123)
+		}
+		enclavesStr := unknownValueStr
+		if len(scheduleInfo.GetEnclaveNames()) > 0 {
+			enclavesStr = strings.Join(scheduleInfo.GetEnclaveNames(), enclaveNamesSeparator)
+		}
+		if err := tablePrinter.AddRow(
+			schedule.GetName(),
+			schedule.GetCronExpression(),
+			schedule.GetPackageId(),
+			retentionStr,
+			nextRunTimeStr,
+			getLastRunStatusStr(scheduleInfo),
+			enclavesStr,
+		); err != nil {
+			return stacktrace.NewError("An error occurred adding row for schedule '%v' to the table printer", schedule.GetName())
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
+
+func getLastRunStatusStr(scheduleInfo *kurtosis_engine_rpc_api_bindings.EnclaveScheduleInfo) string {
+	if scheduleInfo.LastRunTime == nil {
+		return unknownValueStr
+	}
+	status := successfulRunStr
+	if scheduleInfo.GetLastRunError() != "" {
+		status = failedRunStr
+	}
+	return scheduleInfo.GetLastRunTime().AsTime().Local().Format(time.RFC1123) + " (" + status + ")"
+}
@@ -0,0 +1,74 @@
+package rm
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	scheduleNameArgKey = "name"
+
+	shouldDestroyEnclavesFlagKey = "destroy-enclaves"
+	defaultShouldDestroyEnclaves = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ScheduleRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.ScheduleRmCmdStr,
+	ShortDescription:          "Removes a schedule",
+	LongDescription:           "Removes a schedule so that the engine stops creating enclaves for it; the enclaves it already created are kept unless the '" + shouldDestroyEnclavesFlagKey + "' flag is set",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     shouldDestroyEnclavesFlagKey,
+			Usage:   "Also destroys the enclaves the schedule created",
+			Type:    flags.FlagType_Bool,
+			Default: defaultShouldDestroyEnclaves,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key: scheduleNameArgKey,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	engineClient kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	scheduleName, err := args.GetNonGreedyArg(scheduleNameArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the schedule name using arg key '%v'", scheduleNameArgKey)
+	}
+	shouldDestroyEnclaves, err := flags.GetBool(shouldDestroyEnclavesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag", shouldDestroyEnclavesFlagKey)
+	}
+
+	removeEnclaveScheduleArgs := &kurtosis_engine_rpc_api_bindings.RemoveEnclaveScheduleArgs{
+		Name:                  scheduleName,
+		ShouldDestroyEnclaves: shouldDestroyEnclaves,
+	}
+	if _, err := engineClient.RemoveEnclaveSchedule(ctx, removeEnclaveScheduleArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing enclave schedule '%v'", scheduleName)
+	}
+	logrus.Infof("Removed schedule '%v'", scheduleName)
+	return nil
+}
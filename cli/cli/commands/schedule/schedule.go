@@ -0,0 +1,23 @@
+package schedule
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/schedule/rm"
+	"github.com/spf13/cobra"
+)
+
+// ScheduleCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var ScheduleCmd = &cobra.Command{
+	Use:   command_str_consts.ScheduleCmdStr,
+	Short: "Manage the schedules on which the engine creates enclaves from packages",
+	RunE:  nil,
+}
+
+func init() {
+	ScheduleCmd.AddCommand(add.ScheduleAddCmd.MustGetCobraCommand())
+	ScheduleCmd.AddCommand(ls.ScheduleLsCmd.MustGetCobraCommand())
+	ScheduleCmd.AddCommand(rm.ScheduleRmCmd.MustGetCobraCommand())
+}
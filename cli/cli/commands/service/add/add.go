@@ -88,6 +88,7 @@ const (
 	fullUuidFlagKeyDefault = "false"
 
 	defaultParallelism = 1
+	isNotStrict        = false
 
 	portMappingSeparatorForLogs = ", "
 )
@@ -298,7 +299,7 @@ func run(
 
 	// TODO Allow adding services to an already-repartitioned enclave
 	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, fmt.Sprintf(`def run(plan):
-	plan.add_service(name = "%s", config = %s)`, serviceName, serviceConfigStarlark), "", false, defaultParallelism)
+	plan.add_service(name = "%s", config = %s)`, serviceName, serviceConfigStarlark), "", false, defaultParallelism, isNotStrict)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error has occurred when running Starlark to add service")
 	}
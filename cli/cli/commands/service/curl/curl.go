@@ -0,0 +1,267 @@
+package curl
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+	"time"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey  = "service"
+	isServiceGuidArgOptional = false
+	isServiceGuidArgGreedy   = false
+
+	portIdArgKey = "port-id"
+
+	pathArgKey  = "path"
+	defaultPath = "/"
+
+	methodFlagKey = "method"
+	defaultMethod = "GET"
+
+	headersFlagKey              = "headers"
+	headerNameValueDelimiter    = ":"
+	headerDeclarationsDelimiter = ","
+	// Each header should be 'Name: value', which means we should have two components to each header declaration
+	expectedNumberNameValueComponentsInHeaderDeclaration = 2
+
+	dataFlagKey = "data"
+	defaultData = ""
+
+	useHttpsFlagKey = "https"
+	defaultUseHttps = "false"
+	insecureFlagKey = "insecure"
+	defaultInsecure = "false"
+	maxTimeFlagKey  = "max-time"
+	// Zero means the default timeout of the API container
+	defaultMaxTimeSeconds = "0"
+
+	requestLinePrefix  = "> "
+	responseLinePrefix = "< "
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServiceCurlCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceCurlCmdStr,
+	ShortDescription: "Sends an HTTP request to a service",
+	LongDescription: "Sends an HTTP request to a private port of a service from inside the enclave network, so services whose " +
+		"ports aren't published can be probed without installing curl in their image. The status, headers and timings of " +
+		"the response get printed to stderr, and its body to stdout",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       methodFlagKey,
+			Usage:     "The HTTP method of the request",
+			Shorthand: "X",
+			Type:      flags.FlagType_String,
+			Default:   defaultMethod,
+		},
+		{
+			Key: headersFlagKey,
+			Usage: fmt.Sprintf(
+				"The headers of the request, in the form 'NAME1%v VALUE1%vNAME2%v VALUE2'",
+				headerNameValueDelimiter,
+				headerDeclarationsDelimiter,
+				headerNameValueDelimiter,
+			),
+			Shorthand: "H",
+			Type:      flags.FlagType_String,
+			Default:   "",
+		},
+		{
+			Key:       dataFlagKey,
+			Usage:     "The body of the request",
+			Shorthand: "d",
+			Type:      flags.FlagType_String,
+			Default:   defaultData,
+		},
+		{
+			Key:       useHttpsFlagKey,
+			Usage:     "Uses HTTPS, which is already the case if the application protocol of the port is 'https'",
+			Shorthand: "",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultUseHttps,
+		},
+		{
+			Key:       insecureFlagKey,
+			Usage:     "Accepts any certificate when using HTTPS, e.g. a self-signed one",
+			Shorthand: "k",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultInsecure,
+		},
+		{
+			Key:       maxTimeFlagKey,
+			Usage:     "The maximum time in seconds the request can take; 0 uses the default of 30 seconds",
+			Shorthand: "m",
+			Type:      flags.FlagType_Uint32,
+			Default:   defaultMaxTimeSeconds,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			isServiceGuidArgOptional,
+			isServiceGuidArgGreedy,
+		),
+		{
+			Key: portIdArgKey,
+		},
+		{
+			Key:          pathArgKey,
+			IsOptional:   true,
+			DefaultValue: defaultPath,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier using arg key '%v'", serviceIdentifierArgKey)
+	}
+	portId, err := args.GetNonGreedyArg(portIdArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the port ID using arg key '%v'", portIdArgKey)
+	}
+	path, err := args.GetNonGreedyArg(pathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the path using arg key '%v'", pathArgKey)
+	}
+
+	method, err := flags.GetString(methodFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the method using flag key '%v'", methodFlagKey)
+	}
+	headersStr, err := flags.GetString(headersFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the headers using flag key '%v'", headersFlagKey)
+	}
+	headers, err := parseHeadersStr(headersStr)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the headers '%v'", headersStr)
+	}
+	data, err := flags.GetString(dataFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the body using flag key '%v'", dataFlagKey)
+	}
+	useHttps, err := flags.GetBool(useHttpsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag", useHttpsFlagKey)
+	}
+	insecure, err := flags.GetBool(insecureFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the value of the '%v' flag", insecureFlagKey)
+	}
+	maxTimeSeconds, err := flags.GetUint32(maxTimeFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the maximum time using flag key '%v'", maxTimeFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	httpRequestServiceArgs := &kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs{
+		ServiceIdentifier:   serviceIdentifier,
+		PortId:              portId,
+		Method:              method,
+		Path:                path,
+		Headers:             headers,
+		Body:                data,
+		UseHttps:            useHttps,
+		SkipTlsVerification: insecure,
+		TimeoutSeconds:      maxTimeSeconds,
+	}
+	response, err := enclaveCtx.HttpRequestService(ctx, httpRequestServiceArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending a '%v' request to port '%v' of service '%v'", method, portId, serviceIdentifier)
+	}
+
+	out.PrintErrLn(requestLinePrefix + strings.ToUpper(method) + " " + response.GetUrl())
+	out.PrintErrLn(responseLinePrefix + response.GetStatus())
+	for _, header := range response.GetHeaders() {
+		out.PrintErrLn(responseLinePrefix + header.GetName() + headerNameValueDelimiter + " " + header.GetValue())
+	}
+	out.PrintErrLn(fmt.Sprintf(
+		"Connection: %v, first byte: %v, total: %v",
+		microsToDuration(response.GetConnectionDurationMicros()),
+		microsToDuration(response.GetTimeToFirstByteMicros()),
+		microsToDuration(response.GetTotalDurationMicros()),
+	))
+	out.PrintOutLn(string(response.GetBody()))
+	if response.GetIsBodyTruncated() {
+		out.PrintErrLn(fmt.Sprintf("The body was truncated to its first %v bytes", len(response.GetBody())))
+	}
+	return nil
+}
+
+func parseHeadersStr(headersStr string) (map[string]string, error) {
+	result := map[string]string{}
+	if strings.TrimSpace(headersStr) == "" {
+		return result, nil
+	}
+
+	for _, headerDeclarationStr := range strings.Split(headersStr, headerDeclarationsDelimiter) {
+		if len(strings.TrimSpace(headerDeclarationStr)) == 0 {
+			continue
+		}
+		headerNameValueComponents := strings.SplitN(headerDeclarationStr, headerNameValueDelimiter, expectedNumberNameValueComponentsInHeaderDeclaration)
+		if len(headerNameValueComponents) < expectedNumberNameValueComponentsInHeaderDeclaration {
+			return nil, stacktrace.NewError("Header declaration string '%v' must be of the form NAME%v VALUE", headerDeclarationStr, headerNameValueDelimiter)
+		}
+		name := strings.TrimSpace(headerNameValueComponents[0])
+		if name == "" {
+			return nil, stacktrace.NewError("Header declaration string '%v' has an empty name", headerDeclarationStr)
+		}
+		result[name] = strings.TrimSpace(headerNameValueComponents[1])
+	}
+	return result, nil
+}
+
+func microsToDuration(micros uint64) time.Duration {
+	return time.Duration(micros) * time.Microsecond
+}
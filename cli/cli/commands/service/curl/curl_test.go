@@ -0,0 +1,25 @@
+package curl
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseHeadersStr(t *testing.T) {
+	headers, err := parseHeadersStr("Content-Type: application/json, Authorization:Bearer abc:def,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": "Bearer abc:def",
+	}, headers)
+
+	headers, err = parseHeadersStr("")
+	require.NoError(t, err)
+	require.Empty(t, headers)
+
+	_, err = parseHeadersStr("Content-Type")
+	require.Error(t, err)
+
+	_, err = parseHeadersStr(": value")
+	require.Error(t, err)
+}
@@ -31,6 +31,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"time"
 )
 
 const (
@@ -46,14 +47,22 @@ const (
 	matchTextFilterFlagKey   = "match"
 	matchRegexFilterFlagKey  = "regex-match"
 	invertMatchFilterFlagKey = "invert-match"
+	showRateFlagKey          = "show-rate"
+	sinceFlagKey             = "since"
+	untilFlagKey             = "until"
 
 	defaultMatchTextOrRegexFilterFlagValue = ""
+	defaultTimeFlagValue                   = ""
+
+	timeFlagsFormatDescription = "either an RFC 3339 timestamp, e.g. '2023-06-01T15:04:05Z', or a duration relative to now, e.g. '30m' or '2h'"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 
 	interruptChanBufferSize = 5
 
+	logLinesRateDisplayInterval = 5 * time.Second
+
 	commonInstructionInMatchFlags = "Important: " + matchTextFilterFlagKey + " and " + matchRegexFilterFlagKey + " flags cannot be used at the same time. You should either use one or the other."
 )
 
@@ -61,6 +70,7 @@ var doNotFilterLogLines *kurtosis_context.LogLineFilter = nil
 
 var defaultShouldFollowLogs = strconv.FormatBool(false)
 var defaultInvertMatchFilterFlagValue = strconv.FormatBool(false)
+var defaultShowRateFlagValue = strconv.FormatBool(false)
 
 var ServiceLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceLogsCmdStr,
@@ -106,6 +116,29 @@ var ServiceLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:      flags.FlagType_Bool,
 			Default:   defaultInvertMatchFilterFlagValue,
 		},
+		{
+			Key: showRateFlagKey,
+			Usage: fmt.Sprintf(
+				"Periodically prints to STDERR the rate at which log lines are being received, when following the logs with '%s'",
+				shouldFollowLogsFlagKey,
+			),
+			Type:    flags.FlagType_Bool,
+			Default: defaultShowRateFlagValue,
+		},
+		{
+			Key:     sinceFlagKey,
+			Usage:   fmt.Sprintf("Only show the log lines logged since this time, %s", timeFlagsFormatDescription),
+			Default: defaultTimeFlagValue,
+		},
+		{
+			Key: untilFlagKey,
+			Usage: fmt.Sprintf(
+				"Only show the log lines logged until this time, %s. Can't be used along with '%s'",
+				timeFlagsFormatDescription,
+				shouldFollowLogsFlagKey,
+			),
+			Default: defaultTimeFlagValue,
+		},
 	},
 	Args: []*args.ArgConfig{
 		//TODO disabling enclaveID validation and serviceUUID validation for allowing consuming logs from removed or stopped enclaves
@@ -164,6 +197,34 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the invert match flag using key '%v'", invertMatchFilterFlagKey)
 	}
 
+	shouldShowRate, err := flags.GetBool(showRateFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the show rate flag using key '%v'", showRateFlagKey)
+	}
+
+	sinceStr, err := flags.GetString(sinceFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the since flag using key '%v'", sinceFlagKey)
+	}
+
+	untilStr, err := flags.GetString(untilFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the until flag using key '%v'", untilFlagKey)
+	}
+
+	now := time.Now()
+	since, err := parseTimeFlagValue(sinceStr, now)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value '%v' of the '%v' flag", sinceStr, sinceFlagKey)
+	}
+	until, err := parseTimeFlagValue(untilStr, now)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value '%v' of the '%v' flag", untilStr, untilFlagKey)
+	}
+	if shouldFollowLogs && !until.IsZero() {
+		return stacktrace.NewError("The '%v' and '%v' flags can't be used together, as followed logs have no end", shouldFollowLogsFlagKey, untilFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -199,7 +260,7 @@ func run(
 			Statuses: nil,
 		}
 
-		successfulUserServiceLogs, erroredUserServiceUuids, err := kurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, userServiceFilters, shouldFollowLogs)
+		successfulUserServiceLogs, erroredUserServiceUuids, err := kurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, userServiceFilters, shouldFollowLogs, service.NewServiceLogsTimeRange(since, until))
 		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred getting user service logs using filters '%+v'", userServiceFilters)
 		}
@@ -237,7 +298,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the log line filter using these filter flag values '%s=%s', '%s=%s', '%s=%v'", matchTextFilterFlagKey, matchTextStr, matchRegexFilterFlagKey, matchRegexStr, invertMatchFilterFlagKey, invertMatch)
 	}
 
-	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter)
+	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogsInTimeRange(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter, since, until)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting user service logs from user services with UUIDs '%+v' in enclave '%v' and with follow logs value '%v'", userServiceUuids, enclaveIdentifier, shouldFollowLogs)
 	}
@@ -247,6 +308,17 @@ func run(
 	interruptChan := make(chan os.Signal, interruptChanBufferSize)
 	signal.Notify(interruptChan, os.Interrupt)
 
+	// A nil channel never receives, so the rate only gets displayed when asked for
+	var logLinesRateDisplayTickerChan <-chan time.Time
+	if shouldFollowLogs && shouldShowRate {
+		logLinesRateDisplayTicker := time.NewTicker(logLinesRateDisplayInterval)
+		defer logLinesRateDisplayTicker.Stop()
+		logLinesRateDisplayTickerChan = logLinesRateDisplayTicker.C
+	}
+	numLogLinesReceivedSinceLastRateDisplay := 0
+	numLogLinesDroppedSinceLastRateDisplay := uint32(0)
+	lastRateDisplayTime := time.Now()
+
 	for {
 		select {
 		case serviceLogsStreamContent, isChanOpen := <-serviceLogsStreamContentChan:
@@ -267,9 +339,22 @@ func run(
 				return stacktrace.NewError("Expected to find logs for user service with UUID '%v' on user service logs map '%+v' but was not found; this should never happen, and is a bug in Kurtosis", serviceUuid, userServiceLogsByUuid)
 			}
 
+			if numDroppedLogLines := serviceLogsStreamContent.GetNumDroppedLogLinesByServiceUuids()[serviceUuid]; numDroppedLogLines > 0 {
+				out.PrintErrLn(fmt.Sprintf("[%v log lines were dropped because the service produced them faster than they could be displayed]", numDroppedLogLines))
+				numLogLinesDroppedSinceLastRateDisplay += numDroppedLogLines
+			}
+
 			for _, serviceLog := range userServiceLogs {
 				out.PrintOutLn(serviceLog.GetContent())
 			}
+			numLogLinesReceivedSinceLastRateDisplay += len(userServiceLogs)
+		case <-logLinesRateDisplayTickerChan:
+			now := time.Now()
+			logLinesPerSecond := float64(numLogLinesReceivedSinceLastRateDisplay) / now.Sub(lastRateDisplayTime).Seconds()
+			out.PrintErrLn(fmt.Sprintf("[receiving %.1f log lines/s, %v dropped]", logLinesPerSecond, numLogLinesDroppedSinceLastRateDisplay))
+			numLogLinesReceivedSinceLastRateDisplay = 0
+			numLogLinesDroppedSinceLastRateDisplay = 0
+			lastRateDisplayTime = now
 		case <-interruptChan:
 			logrus.Debugf("Received signal interruption in service logs Kurtosis CLI command")
 			return nil
@@ -314,6 +399,25 @@ func getLogLineFilterFromFilterFlagValues(matchTextStr string, matchRegexStr str
 	)
 }
 
+// parseTimeFlagValue parses the value of a time flag, which can be a timestamp or a duration going back from now; the
+// zero time is returned for an empty value, which leaves that end of the time range unbounded
+func parseTimeFlagValue(value string, now time.Time) (time.Time, error) {
+	if value == defaultTimeFlagValue {
+		return time.Time{}, nil
+	}
+	if timestamp, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return timestamp, nil
+	}
+	durationAgo, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, stacktrace.NewError("'%v' is neither an RFC 3339 timestamp nor a duration", value)
+	}
+	if durationAgo < 0 {
+		return time.Time{}, stacktrace.NewError("Duration '%v' is negative, but it goes back from now so it must be positive", value)
+	}
+	return now.Add(-durationAgo), nil
+}
+
 // This function works makes a best effort to get the most accurate enclave uuid and service uuid for the passed valeus
 // defaults to assuming the passed value are uuids
 // this function will be a lot cleaner after the object ids are stored in a database
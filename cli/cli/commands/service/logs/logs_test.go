@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestDefiningLogLineFilterFromFlags_doNotFilter(t *testing.T) {
@@ -75,3 +76,25 @@ func TestDefiningLogLineFilterFromFlags_validInvertMatchRegex(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedLogLineFilter, logLineFilter)
 }
+
+func TestParseTimeFlagValue(t *testing.T) {
+	now := time.Date(2023, 6, 1, 15, 0, 0, 0, time.UTC)
+
+	unbounded, err := parseTimeFlagValue("", now)
+	require.NoError(t, err)
+	require.True(t, unbounded.IsZero())
+
+	timestamp, err := parseTimeFlagValue("2023-06-01T14:04:05Z", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 6, 1, 14, 4, 5, 0, time.UTC), timestamp)
+
+	durationAgo, err := parseTimeFlagValue("30m", now)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-30*time.Minute), durationAgo)
+
+	_, err = parseTimeFlagValue("-30m", now)
+	require.Error(t, err)
+
+	_, err = parseTimeFlagValue("yesterday", now)
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-units"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	cpuColumnHeader           = "CPU %"
+	memoryUsageColumnHeader   = "Memory Usage / Limit"
+	memoryPercentColumnHeader = "Memory %"
+	networkColumnHeader       = "Network I/O"
+
+	percentFactor = 100
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var ServiceMetricsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServiceMetricsCmdStr,
+	ShortDescription: "Shows the resource usage of a service",
+	LongDescription: "Shows a snapshot of the CPU, memory and network usage of the service with the given identifier, " +
+		"where 100% CPU is one full CPU of the host",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifierArgKey,
+			isServiceIdentifierArgGreedy,
+			isServiceIdentifierArgOptional,
+		),
+	},
+	Flags:   []*flags.FlagConfig{},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier value using key '%v'", serviceIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	metrics, err := enclaveCtx.GetServiceMetrics(ctx, serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the metrics of service '%v' in enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	}
+
+	memoryPercent := float64(0)
+	if metrics.GetMemoryLimitBytes() > 0 {
+		memoryPercent = float64(metrics.GetMemoryUsageBytes()) / float64(metrics.GetMemoryLimitBytes()) * percentFactor
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(cpuColumnHeader, memoryUsageColumnHeader, memoryPercentColumnHeader, networkColumnHeader)
+	if err := tablePrinter.AddRow(
+		fmt.Sprintf("%.2f%%", metrics.GetCpuUsagePercent()),
+		fmt.Sprintf("%v / %v", units.BytesSize(float64(metrics.GetMemoryUsageBytes())), units.BytesSize(float64(metrics.GetMemoryLimitBytes()))),
+		fmt.Sprintf("%.2f%%", memoryPercent),
+		fmt.Sprintf("%v / %v", units.HumanSize(float64(metrics.GetNetworkRxBytes())), units.HumanSize(float64(metrics.GetNetworkTxBytes()))),
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred adding the metrics of service '%v' to the table to be displayed", serviceIdentifier)
+	}
+	tablePrinter.Print()
+	return nil
+}
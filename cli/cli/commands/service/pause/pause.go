@@ -0,0 +1,122 @@
+package pause
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/service_identifier_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strconv"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceIdentifiersArgKey       = "services"
+	isServiceIdentifierArgOptional = true
+	isServiceIdentifierArgGreedy   = true
+
+	allServicesFlagKey = "all"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var defaultAllServices = strconv.FormatBool(false)
+
+var ServicePauseCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.ServicePauseCmdStr,
+	ShortDescription: "Pauses services",
+	LongDescription: "Pauses all the processes of the services with the given identifiers at once, or of all the " +
+		"services of the enclave with '--" + allServicesFlagKey + "', keeping their memory state, e.g. to freeze an " +
+		"entire subnetwork in a chaos test",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     allServicesFlagKey,
+			Usage:   "Pauses all the services of the enclave, in which case no service identifier must be given",
+			Type:    flags.FlagType_Bool,
+			Default: defaultAllServices,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		service_identifier_arg.NewServiceIdentifierArg(
+			serviceIdentifiersArgKey,
+			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
+	}
+
+	serviceIdentifiers, err := args.GetGreedyArg(serviceIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers using key '%v'", serviceIdentifiersArgKey)
+	}
+
+	allServices, err := flags.GetBool(allServicesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the all services flag value using key '%v'", allServicesFlagKey)
+	}
+	if allServices == (len(serviceIdentifiers) > 0) {
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("Either some service identifiers or the '--%s' flag must be given, but not both", allServicesFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceIdentifierSet := map[string]bool{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		serviceIdentifierSet[serviceIdentifier] = true
+	}
+	successfulServiceNames, failedServiceNames, err := enclaveCtx.PauseServices(ctx, serviceIdentifierSet, allServices)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred pausing services '%v' in enclave '%v'", serviceIdentifiers, enclaveIdentifier)
+	}
+	for serviceName := range successfulServiceNames {
+		logrus.Infof("Paused service '%v'", serviceName)
+	}
+	if len(failedServiceNames) > 0 {
+		return stacktrace.NewError("The following services failed to be paused:\n%v", failedServiceNames)
+	}
+	return nil
+}
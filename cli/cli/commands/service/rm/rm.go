@@ -2,9 +2,8 @@ package rm
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
-	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
@@ -13,9 +12,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/grouped_errors_presenter"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"strconv"
 )
 
 const (
@@ -23,25 +26,26 @@ const (
 	isEnclaveIdArgOptional  = false
 	isEnclaveIdArgGreedy    = false
 
-	serviceIdentifierArgKey        = "service"
-	isServiceIdentifierArgOptional = false
-	isServiceIdentifierArgGreedy   = false
+	serviceIdentifiersArgKey       = "services"
+	isServiceIdentifierArgOptional = true
+	isServiceIdentifierArgGreedy   = true
+
+	allServicesFlagKey = "all"
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
 
-	starlarkScript = `
-def run(plan, args):
-	plan.remove_service(name=args["service_name"])
-`
-	doNotDryRun        = false
-	defaultParallelism = 4
+	servicesNoun = "services"
 )
 
+var defaultAllServices = strconv.FormatBool(false)
+
 var ServiceRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
-	CommandStr:                command_str_consts.ServiceRmCmdStr,
-	ShortDescription:          "Removes a service from an enclave",
-	LongDescription:           "Removes the service with the given identifier from the given enclave",
+	CommandStr:       command_str_consts.ServiceRmCmdStr,
+	ShortDescription: "Removes services from an enclave",
+	LongDescription: "Removes the services with the given identifiers, or whose names match the given glob patterns " +
+		"(e.g. 'node-*'), from the given enclave at once, or all the services of the enclave with '--" +
+		allServicesFlagKey + "'",
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
 	EngineClientContextKey:    engineClientCtxKey,
 	Args: []*args.ArgConfig{
@@ -51,13 +55,20 @@ var ServiceRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCom
 			isEnclaveIdArgOptional,
 			isEnclaveIdArgGreedy,
 		),
-		service_identifier_arg.NewServiceIdentifierArg(
-			serviceIdentifierArgKey,
-			isServiceIdentifierArgGreedy,
+		service_identifier_arg.NewServiceIdentifierOrPatternArg(
+			serviceIdentifiersArgKey,
 			isServiceIdentifierArgOptional,
+			isServiceIdentifierArgGreedy,
 		),
 	},
-	Flags:   []*flags.FlagConfig{},
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     allServicesFlagKey,
+			Usage:   "Removes all the services of the enclave, in which case no service identifier or pattern must be given",
+			Type:    flags.FlagType_Bool,
+			Default: defaultAllServices,
+		},
+	},
 	RunFunc: run,
 }
 
@@ -66,7 +77,7 @@ func run(
 	_ backend_interface.KurtosisBackend,
 	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
 	_ metrics_client.MetricsClient,
-	_ *flags.ParsedFlags,
+	flags *flags.ParsedFlags,
 	args *args.ParsedArgs,
 ) error {
 	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
@@ -74,9 +85,18 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier value using key '%v'", enclaveIdentifierArgKey)
 	}
 
-	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	serviceIdentifiersAndPatterns, err := args.GetGreedyArg(serviceIdentifiersArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifiers using key '%v'", serviceIdentifiersArgKey)
+	}
+
+	allServices, err := flags.GetBool(allServicesFlagKey)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting the service ID value using key '%v'", serviceIdentifierArgKey)
+		return stacktrace.Propagate(err, "An error occurred getting the all services flag value using key '%v'", allServicesFlagKey)
+	}
+	if allServices == (len(serviceIdentifiersAndPatterns) > 0) {
+		// we don't use stack trace as its too much to read
+		return fmt.Errorf("Either some service identifiers or patterns, or the '--%s' flag must be given, but not both", allServicesFlagKey)
 	}
 
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
@@ -89,32 +109,50 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting an enclave context from enclave info for enclave '%v'", enclaveIdentifier)
 	}
 
-	serviceContext, err := enclaveCtx.GetServiceContext(serviceIdentifier)
+	serviceIdentifierSet, serviceNamePatterns := splitServiceIdentifiersAndPatterns(serviceIdentifiersAndPatterns)
+	removedServices, failedServiceNames, err := enclaveCtx.RemoveServices(ctx, serviceIdentifierSet, serviceNamePatterns, allServices)
 	if err != nil {
-		return stacktrace.NewError("Couldn't validate whether the service exists for identifier '%v'", serviceIdentifier)
+		return stacktrace.Propagate(err, "An error occurred removing services '%v' from enclave '%v'", serviceIdentifiersAndPatterns, enclaveIdentifier)
 	}
 
-	serviceName := serviceContext.GetServiceName()
+	removedServiceNames := []string{}
+	for serviceName := range removedServices {
+		removedServiceNames = append(removedServiceNames, string(serviceName))
+	}
+	sort.Strings(removedServiceNames)
+	for _, serviceName := range removedServiceNames {
+		logrus.Infof("Removed service '%v'", serviceName)
+	}
+	logrus.Infof("Removed %d services, %d failed", len(removedServices), len(failedServiceNames))
 
-	if err := removeServiceStarlarkCommand(ctx, enclaveCtx, serviceName); err != nil {
-		return stacktrace.Propagate(err, "An error occurred removing service '%v' from enclave '%v'", serviceIdentifier, enclaveIdentifier)
+	if len(failedServiceNames) > 0 {
+		serviceRemovalErrors := map[string]error{}
+		for serviceName, serviceErr := range failedServiceNames {
+			serviceRemovalErrors[string(serviceName)] = serviceErr
+		}
+		errorStr := fmt.Sprintf(
+			"One or more errors occurred removing the services:\n%v",
+			grouped_errors_presenter.PresentErrors(serviceRemovalErrors, servicesNoun),
+		)
+		return errors.New(errorStr)
 	}
 	return nil
 }
 
-func removeServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, starlarkScript, fmt.Sprintf(`{"service_name": "%s"}`, serviceName), doNotDryRun, defaultParallelism)
-	if err != nil {
-		return stacktrace.Propagate(err, "An unexpected error occurred on Starlark for rendering template")
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+func splitServiceIdentifiersAndPatterns(serviceIdentifiersAndPatterns []string) (map[string]bool, []string) {
+	serviceIdentifierSet := map[string]bool{}
+	serviceNamePatterns := []string{}
+	for _, value := range serviceIdentifiersAndPatterns {
+		if service_identifier_arg.IsServiceNamePattern(value) {
+			serviceNamePatterns = append(serviceNamePatterns, value)
+			continue
+		}
+		serviceIdentifierSet[value] = true
 	}
-	if runResult.ExecutionError != nil {
-		return stacktrace.NewError("An error occurred during Starlark script execution for rendering template: %s", runResult.ExecutionError.GetErrorMessage())
-	}
-	if runResult.InterpretationError != nil {
-		return stacktrace.NewError("An error occurred during Starlark script interpretation for rendering template: %s", runResult.InterpretationError.GetErrorMessage())
-	}
-	if len(runResult.ValidationErrors) > 0 {
-		return stacktrace.NewError("An error occurred during Starlark script validation for rendering template: %v", runResult.ValidationErrors)
-	}
-	return nil
+	return serviceIdentifierSet, serviceNamePatterns
 }
@@ -0,0 +1,12 @@
+package rm
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSplitServiceIdentifiersAndPatterns(t *testing.T) {
+	serviceIdentifierSet, serviceNamePatterns := splitServiceIdentifiersAndPatterns([]string{"database", "node-*", "6a2f8e3c9b1d", "web-?", "replica-[12]"})
+	require.Equal(t, map[string]bool{"database": true, "6a2f8e3c9b1d": true}, serviceIdentifierSet)
+	require.Equal(t, []string{"node-*", "web-?", "replica-[12]"}, serviceNamePatterns)
+}
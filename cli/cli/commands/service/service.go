@@ -8,9 +8,14 @@ package service
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/curl"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/exec"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/logs"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/metrics"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/pause"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/rm"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/shell"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/service/unpause"
 	"github.com/spf13/cobra"
 )
 
@@ -24,7 +29,12 @@ var ServiceCmd = &cobra.Command{
 
 func init() {
 	ServiceCmd.AddCommand(add.ServiceAddCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(curl.ServiceCurlCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(exec.ServiceExecCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(logs.ServiceLogsCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(metrics.ServiceMetricsCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(pause.ServicePauseCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(rm.ServiceRmCmd.MustGetCobraCommand())
 	ServiceCmd.AddCommand(shell.ServiceShellCmd.MustGetCobraCommand())
+	ServiceCmd.AddCommand(unpause.ServiceUnpauseCmd.MustGetCobraCommand())
 }
@@ -16,12 +16,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/raw_terminal_mode"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
 	"github.com/kurtosis-tech/stacktrace"
-	"golang.org/x/crypto/ssh/terminal"
 	"io"
 	"os"
 )
@@ -90,6 +90,14 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred while getting enclave context for enclave with identifier '%v' exists", enclaveIdentifier)
 	}
 
+	backendCapabilities, err := enclaveCtx.GetBackendCapabilities(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the capabilities of the backend of enclave '%v'", enclaveIdentifier)
+	}
+	if !backendCapabilities.GetIsExecTtySupported() {
+		return stacktrace.NewError("Opening a shell in a service isn't supported by the backend of enclave '%v' yet", enclaveIdentifier)
+	}
+
 	enclaveUuid := enclave.EnclaveUUID(enclaveCtx.GetEnclaveUuid())
 
 	serviceCtx, err := enclaveCtx.GetServiceContext(serviceIdentifier)
@@ -117,20 +125,13 @@ func run(
 	go io.Copy(os.Stderr, newReader)
 	go io.Copy(conn, os.Stdin)
 
-	stdinFd := int(os.Stdin.Fd())
-	var oldState *terminal.State
-	if terminal.IsTerminal(stdinFd) {
-		oldState, err = terminal.MakeRaw(stdinFd)
-		if err != nil {
-			// print error
-			return stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
-		}
-		defer terminal.Restore(stdinFd, oldState)
+	restoreTerminalFunc, err := raw_terminal_mode.EnableIfTerminal(os.Stdin, os.Stdout)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred making STDIN stream raw")
 	}
+	defer restoreTerminalFunc()
 
 	_ = <-finishChan
 
-	terminal.Restore(stdinFd, oldState)
-
 	return nil
 }
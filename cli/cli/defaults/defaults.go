@@ -13,7 +13,8 @@ const (
 	// If this version is passed to the engine, the engine will use its default version
 	DefaultAPIContainerVersion = ""
 	// TODO perhaps move this to the metrics library
-	SendMetricsByDefault = true
+	// Users have to explicitly opt in to sending metrics
+	SendMetricsByDefault = false
 )
 
 var DefaultApiContainerLogLevel = logrus.DebugLevel
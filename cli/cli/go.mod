@@ -30,7 +30,7 @@ require (
 	github.com/spf13/cobra v1.6.1-0.20230225213037-567ea8ebc9b4
 	github.com/spf13/pflag v1.0.5
 	github.com/stretchr/testify v1.7.4
-	golang.org/x/crypto v0.7.0
+	golang.org/x/crypto v0.7.0 // indirect
 	google.golang.org/grpc v1.41.0
 	google.golang.org/protobuf v1.29.1
 	k8s.io/apimachinery v0.24.0 // indirect
@@ -41,12 +41,16 @@ require github.com/bazelbuild/buildtools v0.0.0-20221110131218-762712d8ce3f
 
 require (
 	github.com/briandowns/spinner v1.20.0
+	github.com/docker/go-units v0.4.0
 	github.com/fatih/color v1.13.0
 	github.com/google/go-github/v50 v50.2.0
 	github.com/kurtosis-tech/kurtosis-portal/api/golang v0.0.0-20230328194643-b4dea3081e25
 	github.com/kurtosis-tech/vscode-kurtosis/starlark-lsp v0.0.0-20230331162141-5ee399f5426b
 	github.com/mholt/archiver v3.1.1+incompatible
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/savioxavier/termlink v1.2.1
+	golang.org/x/sys v0.6.0
+	golang.org/x/term v0.6.0
 )
 
 require (
@@ -57,7 +61,6 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/docker/docker v20.10.16+incompatible // indirect
 	github.com/docker/go-connections v0.4.0 // indirect
-	github.com/docker/go-units v0.4.0 // indirect
 	github.com/dsnet/compress v0.0.1 // indirect
 	github.com/francoispqt/gojay v1.2.13 // indirect
 	github.com/gammazero/deque v0.1.0 // indirect
@@ -82,7 +85,6 @@ require (
 	github.com/pascaldekloe/name v1.0.1 // indirect
 	github.com/pierrec/lz4 v2.6.1+incompatible // indirect
 	github.com/pkg/errors v0.9.1 // indirect
-	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/segmentio/backo-go v1.0.0 // indirect
 	github.com/segmentio/encoding v0.2.7 // indirect
 	github.com/smacker/go-tree-sitter v0.0.0-20230226123037-c459dbde1464 // indirect
@@ -102,8 +104,6 @@ require (
 	golang.org/x/net v0.8.0 // indirect
 	golang.org/x/oauth2 v0.6.0 // indirect
 	golang.org/x/sync v0.1.0 // indirect
-	golang.org/x/sys v0.6.0 // indirect
-	golang.org/x/term v0.6.0 // indirect
 	golang.org/x/text v0.8.0 // indirect
 	golang.org/x/time v0.0.0-20220210224613-90d013bbcef8 // indirect
 	golang.org/x/tools v0.6.0 // indirect
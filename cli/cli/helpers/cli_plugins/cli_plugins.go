@@ -0,0 +1,105 @@
+package cli_plugins
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"os"
+	"os/exec"
+	"regexp"
+)
+
+const (
+	// A plugin for subcommand 'foo' is a binary named 'kurtosis-foo' on the PATH, the same way git finds 'git-foo'
+	pluginBinaryPrefix = "kurtosis-"
+
+	// The engine is always reached on localhost, through the Kurtosis Portal when the context is remote
+	engineAddressIpStr = "127.0.0.1"
+
+	EngineAddressEnvVar = "KURTOSIS_ENGINE_ADDRESS"
+	ContextEnvVar       = "KURTOSIS_CONTEXT"
+	ClusterEnvVar       = "KURTOSIS_CLUSTER"
+
+	successExitCode = 0
+	errorExitCode   = 1
+)
+
+var (
+	// Restricting the names keeps a subcommand like '../foo' from resolving to a binary outside the PATH
+	pluginNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+	// Cobra only adds these commands to the root command once it executes, so they can't be found beforehand
+	cobraGeneratedCommandNames = map[string]bool{
+		"help":                          true,
+		"completion":                    true,
+		cobra.ShellCompRequestCmd:       true,
+		cobra.ShellCompNoDescRequestCmd: true,
+	}
+)
+
+// FindPlugin returns the path of the plugin binary to run for the args the CLI was invoked with, if the first arg is a
+// subcommand that isn't a builtin one and a 'kurtosis-<subcommand>' binary is on the PATH
+func FindPlugin(rootCmd *cobra.Command, args []string) (string, bool) {
+	if len(args) == 0 {
+		return "", false
+	}
+	pluginName := args[0]
+	if !pluginNameRegex.MatchString(pluginName) || cobraGeneratedCommandNames[pluginName] {
+		return "", false
+	}
+	for _, command := range rootCmd.Commands() {
+		if command.Name() == pluginName || command.HasAlias(pluginName) {
+			return "", false
+		}
+	}
+	pluginPath, err := exec.LookPath(pluginBinaryPrefix + pluginName)
+	if err != nil {
+		return "", false
+	}
+	return pluginPath, true
+}
+
+// RunPlugin runs the plugin binary with the rest of the args, attached to the terminal of the CLI and with the
+// connection details of the current context in its environment, and returns the exit code of the plugin
+func RunPlugin(pluginPath string, pluginArgs []string) (int, error) {
+	command := exec.Command(pluginPath, pluginArgs...)
+	command.Stdin = os.Stdin
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+	command.Env = append(os.Environ(), getPluginEnvVars()...)
+
+	err := command.Run()
+	if err == nil {
+		return successExitCode, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	}
+	return errorExitCode, stacktrace.Propagate(err, "An error occurred running plugin '%v'", pluginPath)
+}
+
+func getPluginEnvVars() []string {
+	envVars := []string{
+		fmt.Sprintf("%v=%v:%v", EngineAddressEnvVar, engineAddressIpStr, kurtosis_context.DefaultGrpcEngineServerPortNum),
+	}
+	// The context and cluster are best effort, so that a broken config doesn't keep plugins that don't need them from running
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		logrus.Debugf("An error occurred getting the current context to pass it to the plugin:\n%v", err)
+	} else {
+		envVars = append(envVars, fmt.Sprintf("%v=%v", ContextEnvVar, currentContext.GetName()))
+	}
+	clusterName, err := kurtosis_cluster_setting.GetKurtosisClusterSettingStore().GetClusterSetting()
+	if err != nil {
+		logrus.Debugf("An error occurred getting the current cluster to pass it to the plugin:\n%v", err)
+	} else {
+		envVars = append(envVars, fmt.Sprintf("%v=%v", ClusterEnvVar, clusterName))
+	}
+	return envVars
+}
@@ -0,0 +1,61 @@
+package cli_plugins
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	pluginFilePerms = 0755
+)
+
+func TestFindPlugin(t *testing.T) {
+	pathDirpath := t.TempDir()
+	pluginPath := filepath.Join(pathDirpath, pluginBinaryPrefix+"foo")
+	require.NoError(t, os.WriteFile(pluginPath, []byte("#!/bin/sh\n"), pluginFilePerms))
+	require.NoError(t, os.WriteFile(filepath.Join(pathDirpath, pluginBinaryPrefix+"enclave"), []byte("#!/bin/sh\n"), pluginFilePerms))
+	t.Setenv("PATH", pathDirpath)
+
+	rootCmd := newTestRootCmd()
+
+	foundPluginPath, found := FindPlugin(rootCmd, []string{"foo", "--bar"})
+	require.True(t, found)
+	require.Equal(t, pluginPath, foundPluginPath)
+
+	_, found = FindPlugin(rootCmd, []string{"unknown"})
+	require.False(t, found)
+}
+
+func TestFindPlugin_BuiltinCommandsWin(t *testing.T) {
+	pathDirpath := t.TempDir()
+	for _, name := range []string{"enclave", "en", "help"} {
+		require.NoError(t, os.WriteFile(filepath.Join(pathDirpath, pluginBinaryPrefix+name), []byte("#!/bin/sh\n"), pluginFilePerms))
+	}
+	t.Setenv("PATH", pathDirpath)
+
+	rootCmd := newTestRootCmd()
+
+	for _, name := range []string{"enclave", "en", "help"} {
+		_, found := FindPlugin(rootCmd, []string{name})
+		require.False(t, found, "Expected builtin command '%v' not to be run as a plugin", name)
+	}
+}
+
+func TestFindPlugin_InvalidNames(t *testing.T) {
+	rootCmd := newTestRootCmd()
+
+	for _, args := range [][]string{{}, {"--cli-log-level"}, {"../foo"}, {"foo/bar"}} {
+		_, found := FindPlugin(rootCmd, args)
+		require.False(t, found, "Expected args '%v' not to resolve to a plugin", args)
+	}
+}
+
+// nolint: exhaustruct
+func newTestRootCmd() *cobra.Command {
+	rootCmd := &cobra.Command{Use: "kurtosis"}
+	rootCmd.AddCommand(&cobra.Command{Use: "enclave", Aliases: []string{"en"}})
+	return rootCmd
+}
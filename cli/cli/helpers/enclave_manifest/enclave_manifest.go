@@ -0,0 +1,356 @@
+package enclave_manifest
+
+import (
+	"context"
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/stacktrace"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultSubnetworkName = "default"
+
+	numSubnetworksPerConnection = 2
+)
+
+// EnclaveManifest is the declarative description of an enclave, as written by 'kurtosis enclave export' and read by
+// 'kurtosis apply'. The keys mirror the Starlark ServiceConfig & ConnectionConfig arguments so the manifest reads
+// like the Starlark code that would produce the same enclave
+type EnclaveManifest struct {
+	Name string `yaml:"name"`
+
+	// The files artifacts the services mount, referenced by name. 'kurtosis apply' doesn't upload them: they must
+	// already exist in the enclave
+	FilesArtifacts []string `yaml:"files_artifacts,omitempty"`
+
+	Services map[string]*ServiceManifest `yaml:"services,omitempty"`
+
+	SubnetworkConnections *SubnetworkConnectionsManifest `yaml:"subnetwork_connections,omitempty"`
+}
+
+type ServiceManifest struct {
+	Image string `yaml:"image"`
+
+	Ports map[string]*PortManifest `yaml:"ports,omitempty"`
+
+	PublicPorts map[string]*PortManifest `yaml:"public_ports,omitempty"`
+
+	// Mountpoint on the container -> name of the files artifact to mount there
+	Files map[string]string `yaml:"files,omitempty"`
+
+	Entrypoint []string `yaml:"entrypoint,omitempty"`
+
+	Cmd []string `yaml:"cmd,omitempty"`
+
+	EnvVars map[string]string `yaml:"env_vars,omitempty"`
+
+	PrivateIpAddressPlaceholder string `yaml:"private_ip_address_placeholder,omitempty"`
+
+	Subnetwork string `yaml:"subnetwork,omitempty"`
+
+	// In millicpus
+	CpuAllocation uint64 `yaml:"cpu_allocation,omitempty"`
+
+	// In megabytes
+	MemoryAllocation uint64 `yaml:"memory_allocation,omitempty"`
+
+	Replicas uint32 `yaml:"replicas,omitempty"`
+
+	// Host CPUs, in the Linux cpuset list format
+	Cpuset string `yaml:"cpuset,omitempty"`
+
+	// Host NUMA nodes, in the Linux cpuset list format
+	NumaNodes string `yaml:"numa_nodes,omitempty"`
+
+	Ulimits map[string]*UlimitManifest `yaml:"ulimits,omitempty"`
+
+	Sysctls map[string]string `yaml:"sysctls,omitempty"`
+
+	// In megabytes
+	ShmSize uint64 `yaml:"shm_size,omitempty"`
+
+	// Mountpoint on the service -> absolute dirpath on the host
+	HostDirMounts map[string]string `yaml:"host_dir_mounts,omitempty"`
+
+	MetricsPortId string `yaml:"metrics_port_id,omitempty"`
+
+	KubernetesServiceAccount string `yaml:"kubernetes_service_account,omitempty"`
+
+	KubernetesServiceAccountAnnotations map[string]string `yaml:"kubernetes_service_account_annotations,omitempty"`
+}
+
+type PortManifest struct {
+	Number uint32 `yaml:"number"`
+
+	TransportProtocol string `yaml:"transport_protocol,omitempty"`
+
+	ApplicationProtocol string `yaml:"application_protocol,omitempty"`
+}
+
+// -1 means unlimited
+type UlimitManifest struct {
+	Soft int64 `yaml:"soft"`
+
+	Hard int64 `yaml:"hard"`
+}
+
+type SubnetworkConnectionsManifest struct {
+	// The connection between subnetworks that don't have an override; unset means a perfect connection
+	Default *ConnectionManifest `yaml:"default,omitempty"`
+
+	Overrides []*ConnectionOverrideManifest `yaml:"overrides,omitempty"`
+}
+
+type ConnectionManifest struct {
+	PacketLossPercentage float32 `yaml:"packet_loss_percentage,omitempty"`
+
+	PacketDelay *PacketDelayManifest `yaml:"packet_delay,omitempty"`
+
+	BandwidthLimitKbps uint32 `yaml:"bandwidth_limit_kbps,omitempty"`
+}
+
+type ConnectionOverrideManifest struct {
+	Subnetworks []string `yaml:"subnetworks"`
+
+	Connection *ConnectionManifest `yaml:"connection"`
+}
+
+type PacketDelayManifest struct {
+	MeanMs uint32 `yaml:"mean_ms"`
+
+	StdDevMs uint32 `yaml:"std_dev_ms,omitempty"`
+
+	Correlation float32 `yaml:"correlation,omitempty"`
+}
+
+// NewEnclaveManifestFromEnclave captures the current state of the enclave behind the given enclave context
+func NewEnclaveManifestFromEnclave(ctx context.Context, enclaveCtx *enclaves.EnclaveContext) (*EnclaveManifest, error) {
+	serviceConfigs, err := enclaveCtx.GetServiceConfigs(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the configs of the services in enclave '%v'", enclaveCtx.GetEnclaveName())
+	}
+	filesArtifactNamesAndUuids, err := enclaveCtx.GetAllFilesArtifactNamesAndUuids(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifacts in enclave '%v'", enclaveCtx.GetEnclaveName())
+	}
+	subnetworkConnections, err := enclaveCtx.GetSubnetworkConnections(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the subnetwork connections in enclave '%v'", enclaveCtx.GetEnclaveName())
+	}
+
+	filesArtifactNames := []string{}
+	for _, filesArtifactNameAndUuid := range filesArtifactNamesAndUuids {
+		filesArtifactNames = append(filesArtifactNames, filesArtifactNameAndUuid.GetFileName())
+	}
+	sort.Strings(filesArtifactNames)
+
+	services := map[string]*ServiceManifest{}
+	for serviceName, serviceConfig := range serviceConfigs {
+		services[string(serviceName)] = newServiceManifestFromServiceConfig(serviceConfig)
+	}
+
+	manifest := &EnclaveManifest{
+		Name:                  enclaveCtx.GetEnclaveName(),
+		FilesArtifacts:        filesArtifactNames,
+		Services:              services,
+		SubnetworkConnections: newSubnetworkConnectionsManifest(subnetworkConnections),
+	}
+	return manifest, nil
+}
+
+func ParseEnclaveManifest(manifestBytes []byte) (*EnclaveManifest, error) {
+	manifest := &EnclaveManifest{
+		Name:                  "",
+		FilesArtifacts:        nil,
+		Services:              nil,
+		SubnetworkConnections: nil,
+	}
+	if err := yaml.UnmarshalStrict(manifestBytes, manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the enclave manifest YAML")
+	}
+	if err := manifest.validate(); err != nil {
+		return nil, stacktrace.Propagate(err, "The enclave manifest is invalid")
+	}
+	return manifest, nil
+}
+
+func (manifest *EnclaveManifest) Serialize() ([]byte, error) {
+	manifestBytes, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the enclave manifest to YAML")
+	}
+	return manifestBytes, nil
+}
+
+// UsesSubnetworks returns whether the enclave the manifest describes needs subnetwork capabilities
+func (manifest *EnclaveManifest) UsesSubnetworks() bool {
+	for _, service := range manifest.Services {
+		if service.Subnetwork != "" && service.Subnetwork != defaultSubnetworkName {
+			return true
+		}
+	}
+	if manifest.SubnetworkConnections == nil {
+		return false
+	}
+	return manifest.SubnetworkConnections.Default != nil || len(manifest.SubnetworkConnections.Overrides) > 0
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (manifest *EnclaveManifest) validate() error {
+	if strings.TrimSpace(manifest.Name) == "" {
+		return stacktrace.NewError("The enclave manifest must have a name")
+	}
+	declaredFilesArtifacts := map[string]bool{}
+	for _, filesArtifactName := range manifest.FilesArtifacts {
+		declaredFilesArtifacts[filesArtifactName] = true
+	}
+	for serviceName, service := range manifest.Services {
+		if service == nil || service.Image == "" {
+			return stacktrace.NewError("Service '%v' must have an image", serviceName)
+		}
+		for portId, port := range service.Ports {
+			if port == nil || port.Number == 0 {
+				return stacktrace.NewError("Port '%v' of service '%v' must have a number", portId, serviceName)
+			}
+		}
+		for portId, port := range service.PublicPorts {
+			if port == nil || port.Number == 0 {
+				return stacktrace.NewError("Public port '%v' of service '%v' must have a number", portId, serviceName)
+			}
+		}
+		for mountpoint, filesArtifactName := range service.Files {
+			if !declaredFilesArtifacts[filesArtifactName] {
+				return stacktrace.NewError("Service '%v' mounts files artifact '%v' on '%v' but this files artifact isn't listed in the manifest files artifacts", serviceName, filesArtifactName, mountpoint)
+			}
+		}
+	}
+	if manifest.SubnetworkConnections != nil {
+		for _, override := range manifest.SubnetworkConnections.Overrides {
+			if override == nil || len(override.Subnetworks) != numSubnetworksPerConnection {
+				return stacktrace.NewError("Every subnetwork connection override must be between exactly %v subnetworks", numSubnetworksPerConnection)
+			}
+			if override.Connection == nil {
+				return stacktrace.NewError("The connection override between subnetworks '%v' and '%v' must have a connection", override.Subnetworks[0], override.Subnetworks[1])
+			}
+		}
+	}
+	return nil
+}
+
+func newServiceManifestFromServiceConfig(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) *ServiceManifest {
+	var replicas uint32
+	if serviceConfig.Replicas != nil {
+		replicas = serviceConfig.GetReplicas()
+	}
+	subnetwork := serviceConfig.GetSubnetwork()
+	if subnetwork == defaultSubnetworkName {
+		subnetwork = ""
+	}
+	return &ServiceManifest{
+		Image:                               serviceConfig.GetContainerImageName(),
+		Ports:                               newPortManifests(serviceConfig.GetPrivatePorts()),
+		PublicPorts:                         newPortManifests(serviceConfig.GetPublicPorts()),
+		Files:                               nilIfEmpty(serviceConfig.GetFilesArtifactMountpoints()),
+		Entrypoint:                          serviceConfig.GetEntrypointArgs(),
+		Cmd:                                 serviceConfig.GetCmdArgs(),
+		EnvVars:                             nilIfEmpty(serviceConfig.GetEnvVars()),
+		PrivateIpAddressPlaceholder:         serviceConfig.GetPrivateIpAddrPlaceholder(),
+		Subnetwork:                          subnetwork,
+		CpuAllocation:                       serviceConfig.GetCpuAllocationMillicpus(),
+		MemoryAllocation:                    serviceConfig.GetMemoryAllocationMegabytes(),
+		Replicas:                            replicas,
+		Cpuset:                              serviceConfig.GetCpuset(),
+		NumaNodes:                           serviceConfig.GetNumaNodes(),
+		Ulimits:                             newUlimitManifests(serviceConfig.GetUlimits()),
+		Sysctls:                             nilIfEmpty(serviceConfig.GetSysctls()),
+		ShmSize:                             serviceConfig.GetShmSizeMegabytes(),
+		HostDirMounts:                       nilIfEmpty(serviceConfig.GetHostDirMounts()),
+		MetricsPortId:                       serviceConfig.GetMetricsPortId(),
+		KubernetesServiceAccount:            serviceConfig.GetKubernetesServiceAccount(),
+		KubernetesServiceAccountAnnotations: nilIfEmpty(serviceConfig.GetKubernetesServiceAccountAnnotations()),
+	}
+}
+
+func newPortManifests(ports map[string]*kurtosis_core_rpc_api_bindings.Port) map[string]*PortManifest {
+	if len(ports) == 0 {
+		return nil
+	}
+	result := map[string]*PortManifest{}
+	for portId, port := range ports {
+		result[portId] = &PortManifest{
+			Number:              port.GetNumber(),
+			TransportProtocol:   port.GetTransportProtocol().String(),
+			ApplicationProtocol: port.GetMaybeApplicationProtocol(),
+		}
+	}
+	return result
+}
+
+func newUlimitManifests(ulimits map[string]*kurtosis_core_rpc_api_bindings.Ulimit) map[string]*UlimitManifest {
+	if len(ulimits) == 0 {
+		return nil
+	}
+	result := map[string]*UlimitManifest{}
+	for name, ulimit := range ulimits {
+		result[name] = &UlimitManifest{
+			Soft: ulimit.GetSoft(),
+			Hard: ulimit.GetHard(),
+		}
+	}
+	return result
+}
+
+func newSubnetworkConnectionsManifest(subnetworkConnections *kurtosis_core_rpc_api_bindings.GetSubnetworkConnectionsResponse) *SubnetworkConnectionsManifest {
+	overrides := []*ConnectionOverrideManifest{}
+	for _, override := range subnetworkConnections.GetConnectionOverrides() {
+		overrides = append(overrides, &ConnectionOverrideManifest{
+			Subnetworks: []string{override.GetSubnetwork1(), override.GetSubnetwork2()},
+			Connection:  newConnectionManifest(override.GetConnection()),
+		})
+	}
+	defaultConnection := newConnectionManifest(subnetworkConnections.GetDefaultConnection())
+	if isPerfectConnection(defaultConnection) && len(overrides) == 0 {
+		return nil
+	}
+	if isPerfectConnection(defaultConnection) {
+		defaultConnection = nil
+	}
+	return &SubnetworkConnectionsManifest{
+		Default:   defaultConnection,
+		Overrides: overrides,
+	}
+}
+
+func newConnectionManifest(connection *kurtosis_core_rpc_api_bindings.SubnetworkConnection) *ConnectionManifest {
+	var packetDelay *PacketDelayManifest
+	if connection.GetPacketDelayMeanMs() != 0 || connection.GetPacketDelayStdDevMs() != 0 {
+		packetDelay = &PacketDelayManifest{
+			MeanMs:      connection.GetPacketDelayMeanMs(),
+			StdDevMs:    connection.GetPacketDelayStdDevMs(),
+			Correlation: connection.GetPacketDelayCorrelation(),
+		}
+	}
+	return &ConnectionManifest{
+		PacketLossPercentage: connection.GetPacketLossPercentage(),
+		PacketDelay:          packetDelay,
+		BandwidthLimitKbps:   connection.GetBandwidthLimitKbps(),
+	}
+}
+
+func isPerfectConnection(connection *ConnectionManifest) bool {
+	return connection == nil || (connection.PacketLossPercentage == 0 && connection.PacketDelay == nil && connection.BandwidthLimitKbps == 0)
+}
+
+func nilIfEmpty(stringMap map[string]string) map[string]string {
+	if len(stringMap) == 0 {
+		return nil
+	}
+	return stringMap
+}
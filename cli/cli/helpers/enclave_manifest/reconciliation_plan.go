@@ -0,0 +1,390 @@
+package enclave_manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	starlarkIndent = "    "
+)
+
+// ReconciliationPlan lists the changes that turn an enclave as it currently is into the enclave a manifest describes
+type ReconciliationPlan struct {
+	// Services that are in the manifest but not in the enclave
+	ServicesToAdd []string
+
+	// Services whose config in the enclave differs from the manifest; they get removed and re-added
+	ServicesToUpdate []string
+
+	// Services that are in the enclave but not in the manifest
+	ServicesToRemove []string
+
+	// Nil if the default connection doesn't change
+	defaultConnectionToSet *ConnectionManifest
+
+	connectionOverridesToSet []*ConnectionOverrideManifest
+
+	connectionOverridesToRemove [][]string
+
+	desiredServices map[string]*ServiceManifest
+}
+
+func NewReconciliationPlan(currentManifest *EnclaveManifest, desiredManifest *EnclaveManifest) *ReconciliationPlan {
+	plan := &ReconciliationPlan{
+		ServicesToAdd:               []string{},
+		ServicesToUpdate:            []string{},
+		ServicesToRemove:            []string{},
+		defaultConnectionToSet:      nil,
+		connectionOverridesToSet:    []*ConnectionOverrideManifest{},
+		connectionOverridesToRemove: [][]string{},
+		desiredServices:             desiredManifest.Services,
+	}
+
+	for serviceName, desiredService := range desiredManifest.Services {
+		currentService, found := currentManifest.Services[serviceName]
+		if !found {
+			plan.ServicesToAdd = append(plan.ServicesToAdd, serviceName)
+			continue
+		}
+		if !reflect.DeepEqual(normalizeServiceManifest(currentService), normalizeServiceManifest(desiredService)) {
+			plan.ServicesToUpdate = append(plan.ServicesToUpdate, serviceName)
+		}
+	}
+	for serviceName := range currentManifest.Services {
+		if _, found := desiredManifest.Services[serviceName]; !found {
+			plan.ServicesToRemove = append(plan.ServicesToRemove, serviceName)
+		}
+	}
+	sort.Strings(plan.ServicesToAdd)
+	sort.Strings(plan.ServicesToUpdate)
+	sort.Strings(plan.ServicesToRemove)
+
+	currentDefaultConnection, currentOverrides := getConnections(currentManifest)
+	desiredDefaultConnection, desiredOverrides := getConnections(desiredManifest)
+	if !reflect.DeepEqual(currentDefaultConnection, desiredDefaultConnection) {
+		plan.defaultConnectionToSet = desiredDefaultConnection
+	}
+	for _, connectionId := range getSortedConnectionIds(desiredOverrides) {
+		desiredOverride := desiredOverrides[connectionId]
+		currentOverride, found := currentOverrides[connectionId]
+		if !found || !reflect.DeepEqual(currentOverride.Connection, desiredOverride.Connection) {
+			plan.connectionOverridesToSet = append(plan.connectionOverridesToSet, desiredOverride)
+		}
+	}
+	for _, connectionId := range getSortedConnectionIds(currentOverrides) {
+		if _, found := desiredOverrides[connectionId]; !found {
+			plan.connectionOverridesToRemove = append(plan.connectionOverridesToRemove, currentOverrides[connectionId].Subnetworks)
+		}
+	}
+	return plan
+}
+
+func (plan *ReconciliationPlan) IsEmpty() bool {
+	return len(plan.ServicesToAdd) == 0 &&
+		len(plan.ServicesToUpdate) == 0 &&
+		len(plan.ServicesToRemove) == 0 &&
+		plan.defaultConnectionToSet == nil &&
+		len(plan.connectionOverridesToSet) == 0 &&
+		len(plan.connectionOverridesToRemove) == 0
+}
+
+// GetChangeDescriptions returns one human-readable line per change of the plan
+func (plan *ReconciliationPlan) GetChangeDescriptions() []string {
+	result := []string{}
+	for _, serviceName := range plan.ServicesToAdd {
+		result = append(result, fmt.Sprintf("add service '%v'", serviceName))
+	}
+	for _, serviceName := range plan.ServicesToUpdate {
+		result = append(result, fmt.Sprintf("replace service '%v'", serviceName))
+	}
+	for _, serviceName := range plan.ServicesToRemove {
+		result = append(result, fmt.Sprintf("remove service '%v'", serviceName))
+	}
+	if plan.defaultConnectionToSet != nil {
+		result = append(result, "set the default subnetwork connection")
+	}
+	for _, override := range plan.connectionOverridesToSet {
+		result = append(result, fmt.Sprintf("set the connection between subnetworks '%v' and '%v'", override.Subnetworks[0], override.Subnetworks[1]))
+	}
+	for _, subnetworks := range plan.connectionOverridesToRemove {
+		result = append(result, fmt.Sprintf("remove the connection between subnetworks '%v' and '%v'", subnetworks[0], subnetworks[1]))
+	}
+	return result
+}
+
+// GetStarlarkScript returns the Starlark script applying the plan. Services to update get removed before the
+// connections change and re-added after, so that they start with the desired connections already in place
+func (plan *ReconciliationPlan) GetStarlarkScript() string {
+	scriptBuilder := &strings.Builder{}
+	scriptBuilder.WriteString("def run(plan):\n")
+	scriptBuilder.WriteString(starlarkIndent + "pass\n")
+
+	servicesToRemove := append(append([]string{}, plan.ServicesToRemove...), plan.ServicesToUpdate...)
+	sort.Strings(servicesToRemove)
+	for _, serviceName := range servicesToRemove {
+		writeStarlarkLine(scriptBuilder, fmt.Sprintf("plan.remove_service(name=%v)", quote(serviceName)))
+	}
+
+	if plan.defaultConnectionToSet != nil {
+		writeStarlarkLine(scriptBuilder, fmt.Sprintf("plan.set_connection(config=%v)", getConnectionConfigStarlark(plan.defaultConnectionToSet)))
+	}
+	for _, subnetworks := range plan.connectionOverridesToRemove {
+		writeStarlarkLine(scriptBuilder, fmt.Sprintf("plan.remove_connection(subnetworks=%v)", getSubnetworksStarlark(subnetworks)))
+	}
+	for _, override := range plan.connectionOverridesToSet {
+		writeStarlarkLine(scriptBuilder, fmt.Sprintf(
+			"plan.set_connection(subnetworks=%v, config=%v)",
+			getSubnetworksStarlark(override.Subnetworks),
+			getConnectionConfigStarlark(override.Connection),
+		))
+	}
+
+	servicesToAdd := append(append([]string{}, plan.ServicesToAdd...), plan.ServicesToUpdate...)
+	sort.Strings(servicesToAdd)
+	if len(servicesToAdd) > 0 {
+		writeStarlarkLine(scriptBuilder, "plan.add_services(configs={")
+		for _, serviceName := range servicesToAdd {
+			writeStarlarkLine(scriptBuilder, fmt.Sprintf("%v%v: %v,", starlarkIndent, quote(serviceName), getServiceConfigStarlark(plan.desiredServices[serviceName])))
+		}
+		writeStarlarkLine(scriptBuilder, "})")
+	}
+	return scriptBuilder.String()
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+// normalizeServiceManifest makes the manifests equal when they describe the same service but spell defaults differently
+func normalizeServiceManifest(service *ServiceManifest) ServiceManifest {
+	normalized := *service
+	if normalized.Subnetwork == defaultSubnetworkName {
+		normalized.Subnetwork = ""
+	}
+	if normalized.Replicas == 1 {
+		normalized.Replicas = 0
+	}
+	if len(normalized.Entrypoint) == 0 {
+		normalized.Entrypoint = nil
+	}
+	if len(normalized.Cmd) == 0 {
+		normalized.Cmd = nil
+	}
+	normalized.Files = nilIfEmpty(normalized.Files)
+	normalized.EnvVars = nilIfEmpty(normalized.EnvVars)
+	normalized.KubernetesServiceAccountAnnotations = nilIfEmpty(normalized.KubernetesServiceAccountAnnotations)
+	normalized.Sysctls = nilIfEmpty(normalized.Sysctls)
+	normalized.HostDirMounts = nilIfEmpty(normalized.HostDirMounts)
+	if len(normalized.Ulimits) == 0 {
+		normalized.Ulimits = nil
+	}
+	normalized.Ports = normalizePortManifests(normalized.Ports)
+	normalized.PublicPorts = normalizePortManifests(normalized.PublicPorts)
+	return normalized
+}
+
+func normalizePortManifests(ports map[string]*PortManifest) map[string]*PortManifest {
+	if len(ports) == 0 {
+		return nil
+	}
+	result := map[string]*PortManifest{}
+	for portId, port := range ports {
+		normalizedPort := *port
+		normalizedPort.TransportProtocol = strings.ToUpper(normalizedPort.TransportProtocol)
+		if normalizedPort.TransportProtocol == "" {
+			normalizedPort.TransportProtocol = "TCP"
+		}
+		result[portId] = &normalizedPort
+	}
+	return result
+}
+
+// getConnections returns the default connection, nil if perfect, and the overrides keyed by their sorted subnetworks
+func getConnections(manifest *EnclaveManifest) (*ConnectionManifest, map[string]*ConnectionOverrideManifest) {
+	overrides := map[string]*ConnectionOverrideManifest{}
+	if manifest.SubnetworkConnections == nil {
+		return nil, overrides
+	}
+	for _, override := range manifest.SubnetworkConnections.Overrides {
+		sortedSubnetworks := append([]string{}, override.Subnetworks...)
+		sort.Strings(sortedSubnetworks)
+		overrides[strings.Join(sortedSubnetworks, "\x00")] = &ConnectionOverrideManifest{
+			Subnetworks: sortedSubnetworks,
+			Connection:  override.Connection,
+		}
+	}
+	defaultConnection := manifest.SubnetworkConnections.Default
+	if isPerfectConnection(defaultConnection) {
+		defaultConnection = nil
+	}
+	return defaultConnection, overrides
+}
+
+func getSortedConnectionIds(overrides map[string]*ConnectionOverrideManifest) []string {
+	result := []string{}
+	for connectionId := range overrides {
+		result = append(result, connectionId)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func getServiceConfigStarlark(service *ServiceManifest) string {
+	arguments := []string{fmt.Sprintf("image=%v", quote(service.Image))}
+	if len(service.Ports) > 0 {
+		arguments = append(arguments, fmt.Sprintf("ports=%v", getPortsStarlark(service.Ports)))
+	}
+	if len(service.PublicPorts) > 0 {
+		arguments = append(arguments, fmt.Sprintf("public_ports=%v", getPortsStarlark(service.PublicPorts)))
+	}
+	if len(service.Files) > 0 {
+		arguments = append(arguments, fmt.Sprintf("files=%v", getStringDictStarlark(service.Files)))
+	}
+	if len(service.Entrypoint) > 0 {
+		arguments = append(arguments, fmt.Sprintf("entrypoint=%v", getStringListStarlark(service.Entrypoint)))
+	}
+	if len(service.Cmd) > 0 {
+		arguments = append(arguments, fmt.Sprintf("cmd=%v", getStringListStarlark(service.Cmd)))
+	}
+	if len(service.EnvVars) > 0 {
+		arguments = append(arguments, fmt.Sprintf("env_vars=%v", getStringDictStarlark(service.EnvVars)))
+	}
+	if service.PrivateIpAddressPlaceholder != "" {
+		arguments = append(arguments, fmt.Sprintf("private_ip_address_placeholder=%v", quote(service.PrivateIpAddressPlaceholder)))
+	}
+	if service.Subnetwork != "" {
+		arguments = append(arguments, fmt.Sprintf("subnetwork=%v", quote(service.Subnetwork)))
+	}
+	if service.CpuAllocation != 0 {
+		arguments = append(arguments, fmt.Sprintf("cpu_allocation=%v", service.CpuAllocation))
+	}
+	if service.MemoryAllocation != 0 {
+		arguments = append(arguments, fmt.Sprintf("memory_allocation=%v", service.MemoryAllocation))
+	}
+	if service.Replicas != 0 {
+		arguments = append(arguments, fmt.Sprintf("replicas=%v", service.Replicas))
+	}
+	if service.Cpuset != "" {
+		arguments = append(arguments, fmt.Sprintf("cpuset=%v", quote(service.Cpuset)))
+	}
+	if service.NumaNodes != "" {
+		arguments = append(arguments, fmt.Sprintf("numa_nodes=%v", quote(service.NumaNodes)))
+	}
+	if len(service.Ulimits) > 0 {
+		arguments = append(arguments, fmt.Sprintf("ulimits=%v", getUlimitsStarlark(service.Ulimits)))
+	}
+	if len(service.Sysctls) > 0 {
+		arguments = append(arguments, fmt.Sprintf("sysctls=%v", getStringDictStarlark(service.Sysctls)))
+	}
+	if service.ShmSize != 0 {
+		arguments = append(arguments, fmt.Sprintf("shm_size=%v", service.ShmSize))
+	}
+	if len(service.HostDirMounts) > 0 {
+		arguments = append(arguments, fmt.Sprintf("host_dir_mounts=%v", getStringDictStarlark(service.HostDirMounts)))
+	}
+	if service.MetricsPortId != "" {
+		arguments = append(arguments, fmt.Sprintf("metrics_port_id=%v", quote(service.MetricsPortId)))
+	}
+	if service.KubernetesServiceAccount != "" {
+		arguments = append(arguments, fmt.Sprintf("kubernetes_service_account=%v", quote(service.KubernetesServiceAccount)))
+	}
+	if len(service.KubernetesServiceAccountAnnotations) > 0 {
+		arguments = append(arguments, fmt.Sprintf("kubernetes_service_account_annotations=%v", getStringDictStarlark(service.KubernetesServiceAccountAnnotations)))
+	}
+	return fmt.Sprintf("ServiceConfig(%v)", strings.Join(arguments, ", "))
+}
+
+func getPortsStarlark(ports map[string]*PortManifest) string {
+	entries := []string{}
+	for _, portId := range getSortedKeys(ports) {
+		port := ports[portId]
+		arguments := []string{fmt.Sprintf("number=%v", port.Number)}
+		if port.TransportProtocol != "" {
+			arguments = append(arguments, fmt.Sprintf("transport_protocol=%v", quote(strings.ToUpper(port.TransportProtocol))))
+		}
+		if port.ApplicationProtocol != "" {
+			arguments = append(arguments, fmt.Sprintf("application_protocol=%v", quote(port.ApplicationProtocol)))
+		}
+		entries = append(entries, fmt.Sprintf("%v: PortSpec(%v)", quote(portId), strings.Join(arguments, ", ")))
+	}
+	return fmt.Sprintf("{%v}", strings.Join(entries, ", "))
+}
+
+func getUlimitsStarlark(ulimits map[string]*UlimitManifest) string {
+	entries := []string{}
+	for _, name := range getSortedKeys(ulimits) {
+		entries = append(entries, fmt.Sprintf("%v: {\"soft\": %v, \"hard\": %v}", quote(name), ulimits[name].Soft, ulimits[name].Hard))
+	}
+	return fmt.Sprintf("{%v}", strings.Join(entries, ", "))
+}
+
+func getConnectionConfigStarlark(connection *ConnectionManifest) string {
+	arguments := []string{}
+	if connection != nil && connection.PacketLossPercentage != 0 {
+		arguments = append(arguments, fmt.Sprintf("packet_loss_percentage=%v", formatFloat(connection.PacketLossPercentage)))
+	}
+	if connection != nil && connection.PacketDelay != nil {
+		arguments = append(arguments, fmt.Sprintf(
+			"packet_delay_distribution=NormalPacketDelayDistribution(mean_ms=%v, std_dev_ms=%v, correlation=%v)",
+			connection.PacketDelay.MeanMs,
+			connection.PacketDelay.StdDevMs,
+			formatFloat(connection.PacketDelay.Correlation),
+		))
+	}
+	if connection != nil && connection.BandwidthLimitKbps != 0 {
+		arguments = append(arguments, fmt.Sprintf("bandwidth_limit_kbps=%v", connection.BandwidthLimitKbps))
+	}
+	return fmt.Sprintf("ConnectionConfig(%v)", strings.Join(arguments, ", "))
+}
+
+func getSubnetworksStarlark(subnetworks []string) string {
+	return fmt.Sprintf("(%v, %v)", quote(subnetworks[0]), quote(subnetworks[1]))
+}
+
+func getStringDictStarlark(stringMap map[string]string) string {
+	entries := []string{}
+	for _, key := range getSortedKeys(stringMap) {
+		entries = append(entries, fmt.Sprintf("%v: %v", quote(key), quote(stringMap[key])))
+	}
+	return fmt.Sprintf("{%v}", strings.Join(entries, ", "))
+}
+
+func getStringListStarlark(strs []string) string {
+	quotedStrs := []string{}
+	for _, str := range strs {
+		quotedStrs = append(quotedStrs, quote(str))
+	}
+	return fmt.Sprintf("[%v]", strings.Join(quotedStrs, ", "))
+}
+
+func getSortedKeys[V any](m map[string]V) []string {
+	result := []string{}
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Go string literals are valid Starlark string literals
+func quote(str string) string {
+	return strconv.Quote(str)
+}
+
+// Always has a decimal point, so Starlark parses it as a float even when it's a round number
+func formatFloat(value float32) string {
+	formatted := strconv.FormatFloat(float64(value), 'f', -1, 32)
+	if !strings.Contains(formatted, ".") {
+		formatted = formatted + ".0"
+	}
+	return formatted
+}
+
+func writeStarlarkLine(scriptBuilder *strings.Builder, line string) {
+	scriptBuilder.WriteString(starlarkIndent + line + "\n")
+}
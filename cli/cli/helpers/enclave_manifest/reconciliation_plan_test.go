@@ -0,0 +1,110 @@
+package enclave_manifest
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	testManifestYaml = `
+name: test-enclave
+files_artifacts:
+  - genesis
+services:
+  datastore:
+    image: kurtosistech/example-datastore-server
+    ports:
+      grpc:
+        number: 1323
+        transport_protocol: TCP
+    subnetwork: backend
+  api:
+    image: kurtosistech/example-api-server
+    ports:
+      http:
+        number: 8080
+        application_protocol: http
+    files:
+      /genesis: genesis
+    env_vars:
+      DATASTORE: datastore
+subnetwork_connections:
+  overrides:
+    - subnetworks: [backend, default]
+      connection:
+        packet_loss_percentage: 50
+        bandwidth_limit_kbps: 1000
+`
+)
+
+func TestParseEnclaveManifest_RejectsUndeclaredFilesArtifact(t *testing.T) {
+	_, err := ParseEnclaveManifest([]byte(`
+name: test-enclave
+services:
+  api:
+    image: kurtosistech/example-api-server
+    files:
+      /genesis: genesis
+`))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "genesis")
+}
+
+func TestNewReconciliationPlan_SameEnclaveIsEmpty(t *testing.T) {
+	manifest, err := ParseEnclaveManifest([]byte(testManifestYaml))
+	require.NoError(t, err)
+	require.True(t, manifest.UsesSubnetworks())
+
+	serializedManifest, err := manifest.Serialize()
+	require.NoError(t, err)
+	reparsedManifest, err := ParseEnclaveManifest(serializedManifest)
+	require.NoError(t, err)
+
+	plan := NewReconciliationPlan(reparsedManifest, manifest)
+	require.True(t, plan.IsEmpty())
+	require.Empty(t, plan.GetChangeDescriptions())
+}
+
+func TestNewReconciliationPlan_AddsUpdatesAndRemoves(t *testing.T) {
+	desiredManifest, err := ParseEnclaveManifest([]byte(testManifestYaml))
+	require.NoError(t, err)
+	currentManifest, err := ParseEnclaveManifest([]byte(`
+name: test-enclave
+services:
+  datastore:
+    image: kurtosistech/example-datastore-server:0.1.0
+    subnetwork: backend
+  stale:
+    image: alpine
+`))
+	require.NoError(t, err)
+
+	plan := NewReconciliationPlan(currentManifest, desiredManifest)
+	require.Equal(t, []string{"api"}, plan.ServicesToAdd)
+	require.Equal(t, []string{"datastore"}, plan.ServicesToUpdate)
+	require.Equal(t, []string{"stale"}, plan.ServicesToRemove)
+
+	expectedScript := `def run(plan):
+    pass
+    plan.remove_service(name="datastore")
+    plan.remove_service(name="stale")
+    plan.set_connection(subnetworks=("backend", "default"), config=ConnectionConfig(packet_loss_percentage=50.0, bandwidth_limit_kbps=1000))
+    plan.add_services(configs={
+        "api": ServiceConfig(image="kurtosistech/example-api-server", ports={"http": PortSpec(number=8080, application_protocol="http")}, files={"/genesis": "genesis"}, env_vars={"DATASTORE": "datastore"}),
+        "datastore": ServiceConfig(image="kurtosistech/example-datastore-server", ports={"grpc": PortSpec(number=1323, transport_protocol="TCP")}, subnetwork="backend"),
+    })
+`
+	require.Equal(t, expectedScript, plan.GetStarlarkScript())
+}
+
+func TestNewReconciliationPlan_RemovesConnectionOverrides(t *testing.T) {
+	currentManifest, err := ParseEnclaveManifest([]byte(testManifestYaml))
+	require.NoError(t, err)
+	desiredManifest, err := ParseEnclaveManifest([]byte(testManifestYaml))
+	require.NoError(t, err)
+	desiredManifest.SubnetworkConnections = nil
+
+	plan := NewReconciliationPlan(currentManifest, desiredManifest)
+	require.Equal(t, []string{"remove the connection between subnetworks 'backend' and 'default'"}, plan.GetChangeDescriptions())
+	require.Contains(t, plan.GetStarlarkScript(), `plan.remove_connection(subnetworks=("backend", "default"))`)
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_user_id_store"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/resolved_config"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/stacktrace"
@@ -20,6 +22,8 @@ const (
 	// If set to empty, then we'll use whichever default version the launcher provides
 	defaultEngineImageVersionTag = ""
 
+	engineImagePullProgressDescription = "Pulling the engine image"
+
 	removeDeprecatedCentralizedLogsDockerCommands = "docker container rm --force kurtosis-logs-db && docker volume rm kurtosis-logs-db-vol --force && docker rm --force $(docker ps --format '{{.Names}}' | grep kurtosis-logs-collector) && docker volume rm --force $(docker volume ls --format '{{.Name}}' | grep kurtosis-logs-collector-vol)"
 )
 
@@ -48,6 +52,12 @@ type engineExistenceGuarantor struct {
 
 	kurtosisRemoteBackendConfigSupplier *engine_server_launcher.KurtosisRemoteBackendConfigSupplier
 
+	// The webhooks that any engine that gets started should notify
+	webhooks []*args.WebhookConfig
+
+	// The size limit of the package cache of the enclaves of any engine that gets started; 0 means the default
+	packageCacheMaxSizeBytes uint64
+
 	engineServerLauncher *engine_server_launcher.EngineServerLauncher
 
 	imageVersionTag string
@@ -73,6 +83,8 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	shouldSendMetrics bool,
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier,
 	kurtosisRemoteBackendConfigSupplier *engine_server_launcher.KurtosisRemoteBackendConfigSupplier,
+	webhooks []*args.WebhookConfig,
+	packageCacheMaxSizeBytes uint64,
 	logLevel logrus.Level,
 	maybeCurrentlyRunningEngineVersionTag string,
 	kurtosisClusterType resolved_config.KurtosisClusterType,
@@ -84,6 +96,8 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		shouldSendMetrics,
 		engineServerKurtosisBackendConfigSupplier,
 		kurtosisRemoteBackendConfigSupplier,
+		webhooks,
+		packageCacheMaxSizeBytes,
 		defaultEngineImageVersionTag,
 		logLevel,
 		maybeCurrentlyRunningEngineVersionTag,
@@ -98,6 +112,8 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	shouldSendMetrics bool,
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier,
 	kurtosisRemoteBackendConfigSupplier *engine_server_launcher.KurtosisRemoteBackendConfigSupplier,
+	webhooks []*args.WebhookConfig,
+	packageCacheMaxSizeBytes uint64,
 	imageVersionTag string,
 	logLevel logrus.Level,
 	maybeCurrentlyRunningEngineVersionTag string,
@@ -109,6 +125,8 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		kurtosisBackend:                      kurtosisBackend,
 		engineServerKurtosisBackendConfigSupplier: engineServerKurtosisBackendConfigSupplier,
 		kurtosisRemoteBackendConfigSupplier:       kurtosisRemoteBackendConfigSupplier,
+		webhooks:                                  webhooks,
+		packageCacheMaxSizeBytes:                  packageCacheMaxSizeBytes,
 		engineServerLauncher:                      engine_server_launcher.NewEngineServerLauncher(kurtosisBackend),
 		imageVersionTag:                           imageVersionTag,
 		logLevel:                                  logLevel,
@@ -133,6 +151,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 		return stacktrace.Propagate(err, "An error occurred getting metrics user id")
 	}
 
+	guarantor.pullEngineImageBestEffort()
+
 	var engineLaunchErr error
 	if guarantor.imageVersionTag == defaultEngineImageVersionTag {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithDefaultVersion(
@@ -144,6 +164,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.shouldSendMetrics,
 			guarantor.engineServerKurtosisBackendConfigSupplier,
 			guarantor.kurtosisRemoteBackendConfigSupplier,
+			guarantor.webhooks,
+			guarantor.packageCacheMaxSizeBytes,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -156,6 +178,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.shouldSendMetrics,
 			guarantor.engineServerKurtosisBackendConfigSupplier,
 			guarantor.kurtosisRemoteBackendConfigSupplier,
+			guarantor.webhooks,
+			guarantor.packageCacheMaxSizeBytes,
 		)
 	}
 	if engineLaunchErr != nil {
@@ -168,6 +192,22 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 	return nil
 }
 
+// pullEngineImageBestEffort pulls the engine image while showing the progress of the pull, which can take a while on
+// slow connections. Failing to pull isn't fatal, as launching the engine falls back on the image available locally
+func (guarantor *engineExistenceGuarantor) pullEngineImageBestEffort() {
+	imageVersionTag := guarantor.imageVersionTag
+	if imageVersionTag == defaultEngineImageVersionTag {
+		imageVersionTag = kurtosis_version.KurtosisVersion
+	}
+	err := output_printers.PrintBytesTransferProgress(engineImagePullProgressDescription, func(progressCallback func(pulledBytes uint64, totalBytes uint64)) error {
+		return guarantor.engineServerLauncher.PullImageWithProgress(guarantor.ctx, imageVersionTag, progressCallback)
+	})
+	if err != nil {
+		logrus.Warnf("Failed to pull the latest version of the engine image; you may be running an out-of-date version")
+		logrus.Debugf("Pulling the engine image failed with error:\n%v", err)
+	}
+}
+
 // We could potentially try to restart the engine ourselves here, but the case where the server isn't responding is very
 // unusual and very bad, so we'd rather fail loudly
 func (guarantor *engineExistenceGuarantor) VisitContainerRunningButServerNotResponding() error {
@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/engine_server_launcher"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -32,6 +33,8 @@ type EngineManager struct {
 	shouldSendMetrics                         bool
 	engineServerKurtosisBackendConfigSupplier engine_server_launcher.KurtosisBackendConfigSupplier
 	remoteBackendConfigSupplier               *engine_server_launcher.KurtosisRemoteBackendConfigSupplier
+	webhooks                                  []*args.WebhookConfig
+	packageCacheMaxSizeBytes                  uint64
 	clusterConfig                             *resolved_config.KurtosisClusterConfig
 	// Make engine IP, port, and protocol configurable in the future
 }
@@ -81,6 +84,8 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
 		remoteBackendConfigSupplier:               remoteBackendConfigSupplier,
+		webhooks:                                  kurtosisConfig.GetWebhooks(),
+		packageCacheMaxSizeBytes:                  kurtosisConfig.GetPackageCacheMaxSizeBytes(),
 		clusterConfig:                             clusterConfig,
 	}, nil
 }
@@ -147,6 +152,8 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(ctx cont
 		manager.shouldSendMetrics,
 		manager.engineServerKurtosisBackendConfigSupplier,
 		manager.remoteBackendConfigSupplier,
+		manager.webhooks,
+		manager.packageCacheMaxSizeBytes,
 		logLevel,
 		engineVersion,
 		clusterType,
@@ -175,6 +182,8 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		manager.shouldSendMetrics,
 		manager.engineServerKurtosisBackendConfigSupplier,
 		manager.remoteBackendConfigSupplier,
+		manager.webhooks,
+		manager.packageCacheMaxSizeBytes,
 		engineImageVersionTag,
 		logLevel,
 		engineVersion,
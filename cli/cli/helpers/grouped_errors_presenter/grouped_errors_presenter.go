@@ -0,0 +1,133 @@
+package grouped_errors_presenter
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// Name of the global CLI flag that disables the grouping
+	VerboseErrorsFlagKey = "verbose-errors"
+
+	causedByPrefix = "Caused by: "
+
+	// Past this, the objects sharing a root cause are summarized as "... and N more"
+	maxObjectIdsListedPerGroup = 10
+
+	representativeDetailsIndent = "    "
+	objectIdsSeparator          = ", "
+	errorsSeparator             = "\n\n"
+)
+
+var (
+	// Lines of the form ' --- at /path/to/file.go:123 (functionName) ---' that stacktrace adds to every wrapped error
+	stacktraceLocationLineRegex = regexp.MustCompile(`^\s*--- at .* ---\s*$`)
+
+	// Set from the global '--verbose-errors' flag
+	shouldPresentVerboseErrors = false
+)
+
+// SetShouldPresentVerboseErrors makes PresentErrors print every error in full rather than grouping them by root cause
+func SetShouldPresentVerboseErrors(isVerbose bool) {
+	shouldPresentVerboseErrors = isVerbose
+}
+
+type errorGroup struct {
+	rootCause string
+
+	// Sorted
+	objectIds []string
+}
+
+// PresentErrors turns the errors of a bulk operation, keyed by the ID of the object they happened on, into a readable
+// string where the objects failing with the same root cause are grouped together, e.g.
+// "38 services failed with: image pull timeout", followed by the full error of one of them
+// The objectsNoun is the plural of what the IDs identify, e.g. 'enclaves'
+func PresentErrors(errorsByObjectId map[string]error, objectsNoun string) string {
+	objectIds := make([]string, 0, len(errorsByObjectId))
+	for objectId := range errorsByObjectId {
+		objectIds = append(objectIds, objectId)
+	}
+	sort.Strings(objectIds)
+
+	if shouldPresentVerboseErrors {
+		verboseErrorStrs := []string{}
+		for _, objectId := range objectIds {
+			verboseErrorStrs = append(verboseErrorStrs, fmt.Sprintf(">>>>>>>>>>>>>>>>> %v <<<<<<<<<<<<<<<<<\n%v", objectId, errorsByObjectId[objectId].Error()))
+		}
+		return strings.Join(verboseErrorStrs, errorsSeparator)
+	}
+
+	groupsByRootCause := map[string]*errorGroup{}
+	groups := []*errorGroup{}
+	for _, objectId := range objectIds {
+		rootCause := getRootCause(errorsByObjectId[objectId].Error())
+		group, found := groupsByRootCause[rootCause]
+		if !found {
+			group = &errorGroup{
+				rootCause: rootCause,
+				objectIds: []string{},
+			}
+			groupsByRootCause[rootCause] = group
+			groups = append(groups, group)
+		}
+		group.objectIds = append(group.objectIds, objectId)
+	}
+	// Most widespread root cause first; ties keep the order of their first object ID
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].objectIds) > len(groups[j].objectIds)
+	})
+
+	groupStrs := []string{}
+	for _, group := range groups {
+		representativeObjectId := group.objectIds[0]
+		representativeDetails := indent(errorsByObjectId[representativeObjectId].Error())
+		groupStrs = append(groupStrs, fmt.Sprintf(
+			"%v %v failed with: %v\n  Affected: %v\n  Details for '%v':\n%v",
+			len(group.objectIds),
+			objectsNoun,
+			group.rootCause,
+			summarizeObjectIds(group.objectIds),
+			representativeObjectId,
+			representativeDetails,
+		))
+	}
+	if len(groups) < len(objectIds) {
+		groupStrs = append(groupStrs, fmt.Sprintf("(errors sharing a root cause were grouped; rerun with '--%v' to see all of them)", VerboseErrorsFlagKey))
+	}
+	return strings.Join(groupStrs, errorsSeparator)
+}
+
+// getRootCause returns the message of the innermost error of a stacktrace error chain, without its code location
+func getRootCause(errorChainStr string) string {
+	rootCause := errorChainStr
+	if causedByIdx := strings.LastIndex(errorChainStr, causedByPrefix); causedByIdx >= 0 {
+		rootCause = errorChainStr[causedByIdx+len(causedByPrefix):]
+	}
+	rootCauseLines := []string{}
+	for _, line := range strings.Split(rootCause, "\n") {
+		if stacktraceLocationLineRegex.MatchString(line) {
+			continue
+		}
+		rootCauseLines = append(rootCauseLines, line)
+	}
+	return strings.TrimSpace(strings.Join(rootCauseLines, "\n"))
+}
+
+func summarizeObjectIds(objectIds []string) string {
+	if len(objectIds) <= maxObjectIdsListedPerGroup {
+		return strings.Join(objectIds, objectIdsSeparator)
+	}
+	numOmittedObjectIds := len(objectIds) - maxObjectIdsListedPerGroup
+	return fmt.Sprintf("%v ... and %v more", strings.Join(objectIds[:maxObjectIdsListedPerGroup], objectIdsSeparator), numOmittedObjectIds)
+}
+
+func indent(str string) string {
+	lines := strings.Split(str, "\n")
+	for idx, line := range lines {
+		lines[idx] = representativeDetailsIndent + line
+	}
+	return strings.Join(lines, "\n")
+}
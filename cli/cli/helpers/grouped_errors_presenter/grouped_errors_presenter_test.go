@@ -0,0 +1,45 @@
+package grouped_errors_presenter
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const (
+	imagePullTimeoutErrFormat = "An error occurred starting service '%v'\n --- at /path/file.go:12 (startService) ---\nCaused by: image pull timeout\n --- at /path/other.go:34 (pull) ---"
+)
+
+func TestPresentErrors_GroupsIdenticalRootCauses(t *testing.T) {
+	errorsByObjectId := map[string]error{}
+	for idx := 0; idx < 12; idx++ {
+		serviceName := fmt.Sprintf("service-%02d", idx)
+		errorsByObjectId[serviceName] = errors.New(fmt.Sprintf(imagePullTimeoutErrFormat, serviceName))
+	}
+	errorsByObjectId["database"] = errors.New("An error occurred starting service 'database'\nCaused by: port 5432 already in use")
+
+	presentedErrors := PresentErrors(errorsByObjectId, "services")
+
+	require.True(t, strings.HasPrefix(presentedErrors, "12 services failed with: image pull timeout\n  Affected: service-00, service-01, service-02, service-03, service-04, service-05, service-06, service-07, service-08, service-09 ... and 2 more\n  Details for 'service-00':\n    An error occurred starting service 'service-00'"))
+	require.Contains(t, presentedErrors, "1 services failed with: port 5432 already in use\n  Affected: database\n")
+	require.NotContains(t, presentedErrors, "service-05'")
+	require.True(t, strings.HasSuffix(presentedErrors, "rerun with '--verbose-errors' to see all of them)"))
+}
+
+func TestPresentErrors_VerbosePrintsEveryError(t *testing.T) {
+	SetShouldPresentVerboseErrors(true)
+	defer SetShouldPresentVerboseErrors(false)
+
+	errorsByObjectId := map[string]error{
+		"b": errors.New("image pull timeout"),
+		"a": errors.New("image pull timeout"),
+	}
+
+	require.Equal(t, ">>>>>>>>>>>>>>>>> a <<<<<<<<<<<<<<<<<\nimage pull timeout\n\n>>>>>>>>>>>>>>>>> b <<<<<<<<<<<<<<<<<\nimage pull timeout", PresentErrors(errorsByObjectId, "enclaves"))
+}
+
+func TestGetRootCause_ErrorWithoutChain(t *testing.T) {
+	require.Equal(t, "No enclave 'foo' exists", getRootCause("No enclave 'foo' exists\n --- at /path/rm.go:150 (destroyEnclave) ---"))
+}
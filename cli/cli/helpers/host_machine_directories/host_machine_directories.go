@@ -19,6 +19,10 @@ const (
 
 	userSendMetricsElection = "user-send-metrics-election"
 
+	analyticsLocalModeFilename = "analytics-local-mode"
+
+	localAnalyticsEventsFilename = "local-analytics-events.jsonl"
+
 	kurtosisCliLogs = "kurtosis-cli.log"
 
 	LastPesteredUserAboutOldVersionFilename = "last-pestered-user-about-old-version"
@@ -85,6 +89,26 @@ func GetUserSendMetricsElectionFilepath() (string, error) {
 	return filepath, nil
 }
 
+// Get the filepath whose existence signals that the analytics events should be recorded locally instead of sent
+func GetAnalyticsLocalModeFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(analyticsLocalModeFilename)
+	filepath, err := xdg.DataFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the analytics local mode filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return filepath, nil
+}
+
+// Get the filepath where the analytics events get recorded when the analytics are in local mode
+func GetLocalAnalyticsEventsFilepath() (string, error) {
+	xdgRelFilepath := getRelativeFilepathForXDG(localAnalyticsEventsFilename)
+	filepath, err := xdg.DataFile(xdgRelFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the local analytics events filepath from relative path '%v'", xdgRelFilepath)
+	}
+	return filepath, nil
+}
+
 func GetLatestCLIReleaseVersionCacheFilepath() (string, error) {
 	xdgRelFilepath := getRelativeFilepathForXDG(latestCLIReleaseVersionCacheFilename)
 	latestCLIReleaseVersionCacheFilepath, err := xdg.CacheFile(xdgRelFilepath)
@@ -0,0 +1,48 @@
+package local_analytics
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+)
+
+const (
+	localModeFilePermissions os.FileMode = 0644
+)
+
+// IsLocalModeEnabled returns whether the analytics events get written to disk instead of sent, so users can audit them
+func IsLocalModeEnabled() (bool, error) {
+	filepath, err := host_machine_directories.GetAnalyticsLocalModeFilepath()
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the analytics local mode filepath")
+	}
+	if _, err := os.Stat(filepath); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred checking if filepath '%v' exists", filepath)
+	}
+	return true, nil
+}
+
+func EnableLocalMode() error {
+	filepath, err := host_machine_directories.GetAnalyticsLocalModeFilepath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the analytics local mode filepath")
+	}
+	if err := os.WriteFile(filepath, []byte{}, localModeFilePermissions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing file '%v'", filepath)
+	}
+	return nil
+}
+
+func DisableLocalMode() error {
+	filepath, err := host_machine_directories.GetAnalyticsLocalModeFilepath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the analytics local mode filepath")
+	}
+	if err := os.Remove(filepath); err != nil && !os.IsNotExist(err) {
+		return stacktrace.Propagate(err, "An error occurred removing file '%v'", filepath)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package local_analytics
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/host_machine_directories"
+	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/metrics-library/golang/lib/event"
+	"github.com/kurtosis-tech/metrics-library/golang/lib/source"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+const (
+	eventsFilePermissions os.FileMode = 0644
+
+	// These mirror the properties that the metrics library adds to every event it sends
+	isCIPropertyKey    = "is_ci"
+	osPropertyKey      = "os"
+	archPropertyKey    = "arch"
+	backendPropertyKey = "backend"
+
+	trueStr  = "true"
+	falseStr = "false"
+)
+
+// The environment variables the metrics library looks at to tell whether Kurtosis runs in CI
+var ciEnvironmentVariables = []string{
+	"TF_BUILD",
+	"BUILDKITE",
+	"CIRCLECI",
+	"CIRRUS_CI",
+	"CODEBUILD_ID",
+	"GITHUB_ACTIONS",
+	"GITLAB_ACTIONS",
+	"HEROKU_TEST_RUN_ID",
+	"BUILD_ID",
+	"TEAMCITY_VERSION",
+	"TRAVIS",
+	"CI",
+}
+
+// RecordedEvent is exactly what would have been sent if the user had enabled the analytics
+type RecordedEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	UserId string `json:"userId"`
+
+	Source string `json:"source"`
+
+	SourceVersion string `json:"sourceVersion"`
+
+	Event string `json:"event"`
+
+	Properties map[string]string `json:"properties"`
+}
+
+// localRecordingMetricsClient appends the events to a file on disk instead of sending them anywhere
+type localRecordingMetricsClient struct {
+	// Never sends anything; embedded so this client implements the whole metrics client interface
+	metrics_client.MetricsClient
+
+	eventsFilepath string
+
+	userId string
+
+	backendType string
+
+	mutex *sync.Mutex
+}
+
+func NewLocalRecordingMetricsClient(userId string, backendType string, callback metrics_client.Callback) (metrics_client.MetricsClient, func() error, error) {
+	eventsFilepath, err := host_machine_directories.GetLocalAnalyticsEventsFilepath()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the local analytics events filepath")
+	}
+	return newLocalRecordingMetricsClient(eventsFilepath, userId, backendType, callback)
+}
+
+func newLocalRecordingMetricsClient(eventsFilepath string, userId string, backendType string, callback metrics_client.Callback) (*localRecordingMetricsClient, func() error, error) {
+	doNothingClient, doNothingClientCloseFunc, err := metrics_client.CreateMetricsClient(
+		source.KurtosisCLISource,
+		kurtosis_version.KurtosisVersion,
+		userId,
+		backendType,
+		false,
+		false,
+		callback,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the do-nothing metrics client")
+	}
+	client := &localRecordingMetricsClient{
+		MetricsClient:  doNothingClient,
+		eventsFilepath: eventsFilepath,
+		userId:         userId,
+		backendType:    backendType,
+		mutex:          &sync.Mutex{},
+	}
+	return client, doNothingClientCloseFunc, nil
+}
+
+func (client *localRecordingMetricsClient) TrackShouldSendMetricsUserElection(didUserAcceptSendingMetrics bool) error {
+	if err := client.record(event.NewShouldSendMetricsUserElectionEvent(didUserAcceptSendingMetrics)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording should-send-metrics user election")
+	}
+	return nil
+}
+
+func (client *localRecordingMetricsClient) TrackCreateEnclave(enclaveId string) error {
+	if err := client.record(event.NewCreateEnclaveEvent(enclaveId)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording create enclave event")
+	}
+	return nil
+}
+
+func (client *localRecordingMetricsClient) TrackStopEnclave(enclaveId string) error {
+	if err := client.record(event.NewStopEnclaveEvent(enclaveId)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording stop enclave event")
+	}
+	return nil
+}
+
+func (client *localRecordingMetricsClient) TrackDestroyEnclave(enclaveId string) error {
+	if err := client.record(event.NewDestroyEnclaveEvent(enclaveId)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording destroy enclave event")
+	}
+	return nil
+}
+
+func (client *localRecordingMetricsClient) TrackKurtosisRun(packageId string, isRemote bool, isDryRun bool, isScript bool) error {
+	if err := client.record(event.NewKurtosisRunEvent(packageId, isRemote, isDryRun, isScript)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording run kurtosis event")
+	}
+	return nil
+}
+
+func (client *localRecordingMetricsClient) TrackKurtosisRunFinishedEvent(packageId string, numberOfServices int, isSuccess bool) error {
+	if err := client.record(event.NewKurtosisRunFinishedEvent(packageId, numberOfServices, isSuccess)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording kurtosis run finished event")
+	}
+	return nil
+}
+
+// GetRecordedEvents returns the events recorded while the analytics were in local mode, oldest first
+func GetRecordedEvents() ([]*RecordedEvent, error) {
+	eventsFilepath, err := host_machine_directories.GetLocalAnalyticsEventsFilepath()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the local analytics events filepath")
+	}
+	return getRecordedEventsFromFile(eventsFilepath)
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (client *localRecordingMetricsClient) record(eventToRecord *event.Event) error {
+	properties := map[string]string{}
+	for propertyKey, propertyValue := range eventToRecord.GetProperties() {
+		properties[propertyKey] = propertyValue
+	}
+	properties[isCIPropertyKey] = isCI()
+	properties[osPropertyKey] = runtime.GOOS
+	properties[archPropertyKey] = runtime.GOARCH
+	properties[backendPropertyKey] = client.backendType
+
+	recordedEvent := &RecordedEvent{
+		Timestamp:     time.Now(),
+		UserId:        client.userId,
+		Source:        source.KurtosisCLISource.GetKey(),
+		SourceVersion: kurtosis_version.KurtosisVersion,
+		Event:         eventToRecord.GetName(),
+		Properties:    properties,
+	}
+	recordedEventBytes, err := json.Marshal(recordedEvent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing event '%v' to JSON", eventToRecord.GetName())
+	}
+
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	eventsFile, err := os.OpenFile(client.eventsFilepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, eventsFilePermissions)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening local analytics events file '%v'", client.eventsFilepath)
+	}
+	defer eventsFile.Close()
+	if _, err := eventsFile.Write(append(recordedEventBytes, '\n')); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing event '%v' to local analytics events file '%v'", eventToRecord.GetName(), client.eventsFilepath)
+	}
+	return nil
+}
+
+func getRecordedEventsFromFile(eventsFilepath string) ([]*RecordedEvent, error) {
+	eventsFile, err := os.Open(eventsFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*RecordedEvent{}, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred opening local analytics events file '%v'", eventsFilepath)
+	}
+	defer eventsFile.Close()
+
+	result := []*RecordedEvent{}
+	scanner := bufio.NewScanner(eventsFile)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		recordedEvent := &RecordedEvent{
+			Timestamp:     time.Time{},
+			UserId:        "",
+			Source:        "",
+			SourceVersion: "",
+			Event:         "",
+			Properties:    nil,
+		}
+		if err := json.Unmarshal(scanner.Bytes(), recordedEvent); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing line '%v' of local analytics events file '%v'", scanner.Text(), eventsFilepath)
+		}
+		result = append(result, recordedEvent)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading local analytics events file '%v'", eventsFilepath)
+	}
+	return result, nil
+}
+
+func isCI() string {
+	for _, environmentVariable := range ciEnvironmentVariables {
+		if _, found := os.LookupEnv(environmentVariable); found {
+			return trueStr
+		}
+	}
+	return falseStr
+}
@@ -0,0 +1,52 @@
+package local_analytics
+
+import (
+	"github.com/stretchr/testify/require"
+	"path"
+	"runtime"
+	"testing"
+)
+
+const (
+	testUserId      = "user-id"
+	testBackendType = "docker"
+	testPackageId   = "github.com/kurtosis-tech/datastore-army-package"
+)
+
+type testCallback struct{}
+
+func (callback testCallback) Success()          {}
+func (callback testCallback) Failure(err error) {}
+
+func TestLocalRecordingMetricsClient_RecordsEventsInOrder(t *testing.T) {
+	eventsFilepath := path.Join(t.TempDir(), "events.jsonl")
+	client, closeFunc, err := newLocalRecordingMetricsClient(eventsFilepath, testUserId, testBackendType, testCallback{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, closeFunc())
+	}()
+
+	require.NoError(t, client.TrackKurtosisRun(testPackageId, true, false, false))
+	require.NoError(t, client.TrackKurtosisRunFinishedEvent(testPackageId, 3, true))
+
+	recordedEvents, err := getRecordedEventsFromFile(eventsFilepath)
+	require.NoError(t, err)
+	require.Len(t, recordedEvents, 2)
+
+	require.Equal(t, "kurtosis-run", recordedEvents[0].Event)
+	require.Equal(t, testUserId, recordedEvents[0].UserId)
+	// Package IDs get hashed before being sent, so they must be hashed in the recorded events too
+	require.NotEmpty(t, recordedEvents[0].Properties["package_id"])
+	require.NotEqual(t, testPackageId, recordedEvents[0].Properties["package_id"])
+	require.Equal(t, runtime.GOOS, recordedEvents[0].Properties[osPropertyKey])
+	require.Equal(t, testBackendType, recordedEvents[0].Properties[backendPropertyKey])
+
+	require.Equal(t, "kurtosis-run-finished", recordedEvents[1].Event)
+	require.Equal(t, "3", recordedEvents[1].Properties["num_services"])
+}
+
+func TestGetRecordedEventsFromFile_NoFileMeansNoEvents(t *testing.T) {
+	recordedEvents, err := getRecordedEventsFromFile(path.Join(t.TempDir(), "does-not-exist.jsonl"))
+	require.NoError(t, err)
+	require.Empty(t, recordedEvents)
+}
@@ -2,6 +2,7 @@ package metrics_client_factory
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/defaults"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/local_analytics"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/metrics_user_id_store"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_cluster_setting"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config"
@@ -53,6 +54,18 @@ func GetMetricsClient() (metrics_client.MetricsClient, func() error, error) {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting the users metrics id")
 	}
 
+	isLocalModeEnabled, err := local_analytics.IsLocalModeEnabled()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred checking if the analytics are in local mode")
+	}
+	if isLocalModeEnabled {
+		localMetricsClient, localMetricsClientCloseFunc, err := local_analytics.NewLocalRecordingMetricsClient(metricsUserId, clusterType, newDoNothingMetricsClientCallback())
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred while creating the local recording metrics client")
+		}
+		return localMetricsClient, localMetricsClientCloseFunc, nil
+	}
+
 	metricsClient, metricsClientCloseFunc, err := metrics_client.CreateMetricsClient(
 		source.KurtosisCLISource,
 		kurtosis_version.KurtosisVersion,
@@ -0,0 +1,45 @@
+package output_printers
+
+import (
+	"fmt"
+)
+
+const (
+	bytesTransferProgressTotalSteps = 100
+
+	bytesPerMegabyte = 1024 * 1024
+)
+
+// PrintBytesTransferProgress renders the progress of a transfer of bytes, like an image pull or a files upload, the way
+// the progress of Starlark runs gets rendered. The progress is reported in percents, as byte counts don't fit in steps
+func PrintBytesTransferProgress(
+	transferDescription string,
+	transferFunc func(progressCallback func(transferredBytes uint64, totalBytes uint64)) error,
+) error {
+	progressReporter := NewProgressReporter()
+	progressReporter.Start()
+	defer progressReporter.Stop()
+	return transferFunc(func(transferredBytes uint64, totalBytes uint64) {
+		progressReporter.ReportProgress(
+			getBytesTransferProgressStep(transferredBytes, totalBytes),
+			bytesTransferProgressTotalSteps,
+			[]string{formatBytesTransferProgress(transferDescription, transferredBytes, totalBytes)},
+		)
+	})
+}
+
+func getBytesTransferProgressStep(transferredBytes uint64, totalBytes uint64) uint32 {
+	if totalBytes == 0 || transferredBytes >= totalBytes {
+		return bytesTransferProgressTotalSteps
+	}
+	return uint32(transferredBytes * bytesTransferProgressTotalSteps / totalBytes)
+}
+
+func formatBytesTransferProgress(transferDescription string, transferredBytes uint64, totalBytes uint64) string {
+	return fmt.Sprintf(
+		"%s (%.1f/%.1f MB)",
+		transferDescription,
+		float64(transferredBytes)/bytesPerMegabyte,
+		float64(totalBytes)/bytesPerMegabyte,
+	)
+}
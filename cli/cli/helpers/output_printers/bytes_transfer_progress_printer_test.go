@@ -0,0 +1,18 @@
+package output_printers
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetBytesTransferProgressStep(t *testing.T) {
+	require.Equal(t, uint32(0), getBytesTransferProgressStep(0, 200))
+	require.Equal(t, uint32(50), getBytesTransferProgressStep(100, 200))
+	require.Equal(t, uint32(100), getBytesTransferProgressStep(200, 200))
+	require.Equal(t, uint32(100), getBytesTransferProgressStep(300, 200))
+	require.Equal(t, uint32(100), getBytesTransferProgressStep(0, 0))
+}
+
+func TestFormatBytesTransferProgress(t *testing.T) {
+	require.Equal(t, "Uploading files (1.5/3.0 MB)", formatBytesTransferProgress("Uploading files", 3*bytesPerMegabyte/2, 3*bytesPerMegabyte))
+}
@@ -0,0 +1,32 @@
+package output_printers
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/sirupsen/logrus"
+)
+
+// PrintEnclaveTeardownProgress renders the progress of stopping or destroying an enclave the way the progress of
+// Starlark runs gets rendered, then warns about the resources of the enclave that couldn't be stopped or removed. The
+// summary gets printed even when the teardown fails, as that's when it matters the most
+func PrintEnclaveTeardownProgress(
+	enclaveIdentifier string,
+	teardownFunc func(progressCallback func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep)) (*kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary, error),
+) error {
+	progressReporter := NewProgressReporter()
+	progressReporter.Start()
+	summary, teardownErr := teardownFunc(func(step *kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep) {
+		progressReporter.ReportProgress(step.GetCurrentStepNumber(), step.GetTotalSteps(), step.GetCurrentStepInfo())
+	})
+	progressReporter.Stop()
+
+	for _, leakedResource := range summary.GetLeakedResources() {
+		logrus.Warnf(
+			"The %v '%v' of enclave '%v' was left behind and needs to be removed manually:\n%v",
+			leakedResource.GetResourceType(),
+			leakedResource.GetResourceName(),
+			enclaveIdentifier,
+			leakedResource.GetError(),
+		)
+	}
+	return teardownErr
+}
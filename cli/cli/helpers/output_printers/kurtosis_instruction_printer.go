@@ -5,10 +5,11 @@ import (
 	"fmt"
 	"github.com/bazelbuild/buildtools/build"
 	"github.com/briandowns/spinner"
+	"github.com/docker/go-units"
 	"github.com/fatih/color"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/interactive_terminal_decider"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -30,6 +31,9 @@ const (
 	codeCommentPrefix = "# "
 
 	newlineChar = "\n"
+
+	resourceEstimateIndent = "  "
+	bytesInMegabyte        = 1024 * 1024
 )
 
 var (
@@ -37,6 +41,7 @@ var (
 	colorizeResult           = color.New(color.FgWhite).SprintFunc()
 	colorizeError            = color.New(color.FgRed).SprintFunc()
 	colorizeRunSuccessfulMsg = color.New(color.FgGreen).SprintFunc()
+	colorizeWarning          = color.New(color.FgYellow).SprintFunc()
 
 	colorizeProgressBarIsDone    = color.New(color.FgGreen).SprintFunc()
 	colorizeProgressBarRemaining = color.New(color.FgWhite).SprintFunc()
@@ -55,16 +60,14 @@ type ExecutionPrinter struct {
 
 	isStarted bool
 
-	isSpinnerBeingUsed bool
-	spinner            *spinner.Spinner
+	progressReporter ProgressReporter
 }
 
 func NewExecutionPrinter() *ExecutionPrinter {
 	return &ExecutionPrinter{
-		lock:               &sync.Mutex{},
-		isSpinnerBeingUsed: false,
-		spinner:            nil,
-		isStarted:          false,
+		lock:             &sync.Mutex{},
+		progressReporter: nil,
+		isStarted:        false,
 	}
 }
 
@@ -73,31 +76,22 @@ func (printer *ExecutionPrinter) Start() error {
 		return stacktrace.NewError("printer already started")
 	}
 	printer.isStarted = true
-	if !interactive_terminal_decider.IsInteractiveTerminal() {
-		printer.isSpinnerBeingUsed = false
-		logrus.Infof("Kurtosis CLI is running in a non interactive terminal. Everything will work but progress information and the progress bar will not be displayed.")
-		return nil
-	}
-
-	// spinner setup
-	printer.isSpinnerBeingUsed = true
-	printer.spinner = spinner.New(spinnerChar, spinnerSpeed, spinnerColor, spinner.WithWriter(writer), spinner.WithSuffix(spinnerDefaultSuffix))
-	printer.startSpinnerIfUsed()
+	printer.progressReporter = NewProgressReporter()
+	printer.progressReporter.Start()
 	return nil
 }
 
 func (printer *ExecutionPrinter) Stop() {
-	printer.stopSpinnerIfUsed()
+	if printer.progressReporter != nil {
+		printer.progressReporter.Stop()
+	}
 	printer.isStarted = false
 }
 
 // PrintKurtosisExecutionResponseLineToStdOut format and prints the instruction to StdOut.
 func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, verbosity run.Verbosity, dryRun bool) error {
-	// Printing is a 3 phase operation:
-	// 1. stop spinner to clear the ephemeral progress info
-	// 2. print whatever needs to be printed, could be nothing
-	// 3. restart the spinner, potentially with an updated content
-	// To avoid conflicts, we take a lock out of cautiousness (this method shouldn't be called concurrently anyway)
+	// Persistent lines are printed through the progress reporter so that they don't get mixed with the ephemeral
+	// progress info. To avoid conflicts, we take a lock out of cautiousness (this method shouldn't be called concurrently anyway)
 	printer.lock.Lock()
 	defer printer.lock.Unlock()
 
@@ -134,11 +128,18 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 			return stacktrace.Propagate(err, "An error happened executing Starlark code but the error couldn't be printed to the CLI output. Error message was: \n%v", errorMsg)
 		}
 	} else if responseLine.GetProgressInfo() != nil {
-		if printer.isSpinnerBeingUsed {
-			progress := responseLine.GetProgressInfo()
-			progressMessageStr := formatProgressMessage(progress.GetCurrentStepInfo())
-			progressBarStr := formatProgressBar(progress.GetCurrentStepNumber(), progress.GetTotalSteps(), progressBarChar)
-			printer.spinner.Suffix = fmt.Sprintf("   %s %s", progressBarStr, progressMessageStr)
+		progress := responseLine.GetProgressInfo()
+		printer.progressReporter.ReportProgress(progress.GetCurrentStepNumber(), progress.GetTotalSteps(), progress.GetCurrentStepInfo())
+	} else if responseLine.GetResourceEstimate() != nil {
+		formattedResourceEstimate := formatResourceEstimate(responseLine.GetResourceEstimate())
+		formattedResourceEstimateWithNewline := fmt.Sprintf("\n%s", formattedResourceEstimate)
+		if err := printer.printPersistentLineToStdOut(formattedResourceEstimateWithNewline); err != nil {
+			return stacktrace.Propagate(err, "Error printing the resource estimate: \n%v", formattedResourceEstimate)
+		}
+	} else if responseLine.GetDeprecationWarning() != nil {
+		formattedDeprecationWarning := formatDeprecationWarning(responseLine.GetDeprecationWarning())
+		if err := printer.printPersistentLineToStdOut(formattedDeprecationWarning); err != nil {
+			return stacktrace.Propagate(err, "Error printing the deprecation warning: \n%v", formattedDeprecationWarning)
 		}
 	} else if responseLine.GetRunFinishedEvent() != nil {
 		formattedRunOutputMessage := formatRunOutput(responseLine.GetRunFinishedEvent(), dryRun)
@@ -151,10 +152,7 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 }
 
 func (printer *ExecutionPrinter) printPersistentLineToStdOut(lineToPrint string) error {
-	// If spinner is being used, we have to stop spinner -> print -> start spinner in order to keep the spinner at the bottom of the output
-	printer.stopSpinnerIfUsed()
-	out.PrintOutLn(lineToPrint)
-	printer.startSpinnerIfUsed()
+	printer.progressReporter.PrintPersistentLine(lineToPrint)
 	return nil
 }
 
@@ -251,7 +249,7 @@ func formatRunOutput(runFinishedEvent *kurtosis_core_rpc_api_bindings.StarlarkRu
 		if dryRun {
 			return colorizeError("Error encountered running Starlark code in dry-run mode.")
 		}
-		return colorizeError("Error encountered running Starlark code.")
+		return colorizeError("Error encountered running Starlark code." + formatRunId(runFinishedEvent))
 	}
 	// run was successful
 	runSuccessMsg := strings.Builder{}
@@ -264,17 +262,83 @@ func formatRunOutput(runFinishedEvent *kurtosis_core_rpc_api_bindings.StarlarkRu
 	} else {
 		runSuccessMsg.WriteString(". No output was returned.")
 	}
+	runSuccessMsg.WriteString(formatRunId(runFinishedEvent))
 	return colorizeRunSuccessfulMsg(runSuccessMsg.String())
 }
 
-func (printer *ExecutionPrinter) startSpinnerIfUsed() {
-	if printer.isSpinnerBeingUsed {
-		printer.spinner.Start()
+// formatRunId tells the ID of the run, if it has one, for users to find out where it spent its time
+func formatRunId(runFinishedEvent *kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent) string {
+	if runFinishedEvent.RunId == nil {
+		return ""
+	}
+	return fmt.Sprintf("\nRun ID is '%s'; pass it to '%s %s %s' to see where the run spent its time.", runFinishedEvent.GetRunId(), command_str_consts.KurtosisCmdStr, command_str_consts.EnclaveCmdStr, command_str_consts.EnclaveProfileCmdStr)
+}
+
+func formatResourceEstimate(estimate *kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate) string {
+	lines := []string{
+		"Estimated resources:",
+		fmt.Sprintf("%sServices: %d, with %d ports of which %d are published on the host", resourceEstimateIndent, estimate.GetNumServices(), estimate.GetNumPorts(), estimate.GetNumPublicPorts()),
+		fmt.Sprintf(
+			"%sCPU: %d millicpus allocated%s",
+			resourceEstimateIndent,
+			estimate.GetCpuAllocationMillicpus(),
+			formatNumServicesWithoutAllocation(estimate.GetNumServicesWithoutCpuAllocation(), "CPU"),
+		),
+		fmt.Sprintf(
+			"%sMemory: %s allocated%s",
+			resourceEstimateIndent,
+			units.BytesSize(float64(estimate.GetMemoryAllocationMegabytes()*bytesInMegabyte)),
+			formatNumServicesWithoutAllocation(estimate.GetNumServicesWithoutMemoryAllocation(), "memory"),
+		),
+	}
+	if estimate.GetHostNumCpus() > 0 {
+		lines = append(lines, fmt.Sprintf("%sHost: %d CPUs, %s of memory", resourceEstimateIndent, estimate.GetHostNumCpus(), units.BytesSize(float64(estimate.GetHostMemoryBytes()))))
 	}
+
+	imageLines := []string{}
+	totalDownloadSizeBytes := uint64(0)
+	numImagesOfUnknownSize := 0
+	for _, imageDownload := range estimate.GetImageDownloads() {
+		var imageDownloadStr string
+		if imageDownload.GetIsPresentOnHost() {
+			imageDownloadStr = "already on the host"
+		} else if imageDownload.DownloadSizeBytes == nil {
+			imageDownloadStr = "unknown size"
+			numImagesOfUnknownSize++
+		} else {
+			imageDownloadStr = units.BytesSize(float64(imageDownload.GetDownloadSizeBytes()))
+			totalDownloadSizeBytes += imageDownload.GetDownloadSizeBytes()
+		}
+		imageLines = append(imageLines, fmt.Sprintf("%s%s%s: %s", resourceEstimateIndent, resourceEstimateIndent, imageDownload.GetImage(), imageDownloadStr))
+	}
+	imageDownloadsLine := fmt.Sprintf("%sImage downloads: %s", resourceEstimateIndent, units.BytesSize(float64(totalDownloadSizeBytes)))
+	if numImagesOfUnknownSize > 0 {
+		imageDownloadsLine += fmt.Sprintf(", plus %d images of unknown size", numImagesOfUnknownSize)
+	}
+	lines = append(lines, imageDownloadsLine)
+	lines = append(lines, imageLines...)
+
+	for _, warning := range estimate.GetWarnings() {
+		lines = append(lines, colorizeWarning(fmt.Sprintf("WARNING: %s", warning)))
+	}
+	return strings.Join(lines, newlineChar)
+}
+
+func formatDeprecationWarning(deprecationWarning *kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning) string {
+	position := deprecationWarning.GetPosition()
+	return colorizeWarning(fmt.Sprintf(
+		"DEPRECATED [%s] at %s[%d:%d]: %s",
+		deprecationWarning.GetCode(),
+		position.GetFilename(),
+		position.GetLine(),
+		position.GetColumn(),
+		deprecationWarning.GetMessage(),
+	))
 }
 
-func (printer *ExecutionPrinter) stopSpinnerIfUsed() {
-	if printer.isSpinnerBeingUsed {
-		printer.spinner.Stop()
+func formatNumServicesWithoutAllocation(numServicesWithoutAllocation uint32, resourceName string) string {
+	if numServicesWithoutAllocation == 0 {
+		return ""
 	}
+	return fmt.Sprintf(", plus %d services without a %s allocation that can use as much as they like", numServicesWithoutAllocation, resourceName)
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -153,3 +154,58 @@ func TestFormatRunOutput_Failure_ExecutedRun(t *testing.T) {
 	expectedMessage := `Error encountered running Starlark code.`
 	require.Equal(t, expectedMessage, message)
 }
+
+func TestFormatRunOutput_Successful_WithRunId_ExecutedRun(t *testing.T) {
+	runFinishedEvent := binding_constructors.NewStarlarkRunResponseLineFromExecutionSuccessEvent(``, "run-id").GetRunFinishedEvent()
+	message := formatRunOutput(runFinishedEvent, executedRun)
+	expectedMessage := fmt.Sprintf(`Starlark code successfully run. No output was returned.
+Run ID is 'run-id'; pass it to '%s enclave profile' to see where the run spent its time.`, command_str_consts.KurtosisCmdStr)
+	require.Equal(t, expectedMessage, message)
+}
+
+func TestFormatRunOutput_Failure_WithRunId_ExecutedRun(t *testing.T) {
+	runFinishedEvent := binding_constructors.NewStarlarkRunResponseLineFromExecutionFailureEvent("run-id").GetRunFinishedEvent()
+	message := formatRunOutput(runFinishedEvent, executedRun)
+	expectedMessage := fmt.Sprintf(`Error encountered running Starlark code.
+Run ID is 'run-id'; pass it to '%s enclave profile' to see where the run spent its time.`, command_str_consts.KurtosisCmdStr)
+	require.Equal(t, expectedMessage, message)
+}
+
+func TestFormatResourceEstimate(t *testing.T) {
+	downloadSizeBytes := uint64(50 * 1024 * 1024)
+	estimate := &kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate{
+		NumServices:                        2,
+		CpuAllocationMillicpus:             2000,
+		NumServicesWithoutCpuAllocation:    1,
+		MemoryAllocationMegabytes:          1024,
+		NumServicesWithoutMemoryAllocation: 0,
+		NumPorts:                           3,
+		NumPublicPorts:                     1,
+		ImageDownloads: []*kurtosis_core_rpc_api_bindings.StarlarkImageDownloadEstimate{
+			binding_constructors.NewStarlarkImageDownloadEstimate("postgres:15", true, nil),
+			binding_constructors.NewStarlarkImageDownloadEstimate("private/image:1", false, nil),
+			binding_constructors.NewStarlarkImageDownloadEstimate("redis:7", false, &downloadSizeBytes),
+		},
+		HostNumCpus:     4,
+		HostMemoryBytes: 8 * 1024 * 1024 * 1024,
+		Warnings:        []string{"not enough memory"},
+	}
+	expectedResourceEstimate := `Estimated resources:
+  Services: 2, with 3 ports of which 1 are published on the host
+  CPU: 2000 millicpus allocated, plus 1 services without a CPU allocation that can use as much as they like
+  Memory: 1GiB allocated
+  Host: 4 CPUs, 8GiB of memory
+  Image downloads: 50MiB, plus 1 images of unknown size
+    postgres:15: already on the host
+    private/image:1: unknown size
+    redis:7: 50MiB
+` + colorizeWarning("WARNING: not enough memory")
+	require.Equal(t, expectedResourceEstimate, formatResourceEstimate(estimate))
+}
+
+func TestFormatDeprecationWarning(t *testing.T) {
+	position := binding_constructors.NewStarlarkInstructionPosition("github.com/kurtosis-tech/package/main.star", 12, 20)
+	deprecationWarning := binding_constructors.NewStarlarkDeprecationWarning("SERVICE_CONFIG_PUBLIC_PORTS", "'public_ports' of 'ServiceConfig' is deprecated.", position)
+	expectedDeprecationWarning := colorizeWarning("DEPRECATED [SERVICE_CONFIG_PUBLIC_PORTS] at github.com/kurtosis-tech/package/main.star[12:20]: 'public_ports' of 'ServiceConfig' is deprecated.")
+	require.Equal(t, expectedDeprecationWarning, formatDeprecationWarning(deprecationWarning))
+}
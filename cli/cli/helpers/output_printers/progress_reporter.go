@@ -0,0 +1,198 @@
+package output_printers
+
+import (
+	"fmt"
+	"github.com/briandowns/spinner"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/interactive_terminal_decider"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// How often the progress gets printed when the CLI isn't running in an interactive terminal. Printing every progress
+	// update would flood CI logs, so we print the latest progress at this interval instead
+	plainTextProgressUpdateInterval = 5 * time.Second
+
+	plainTextProgressMessageSeparator = " - "
+)
+
+// ProgressReporter displays the progress of a long-running operation to the user.
+// When the CLI runs in an interactive terminal, progress is rendered as a spinner with a progress bar which gets
+// overwritten in place. Otherwise, the latest progress gets printed as a plain text line at a fixed interval so that
+// logs of non-interactive runs (CI, output piped to a file...) still show that the operation is moving forward.
+type ProgressReporter interface {
+	Start()
+
+	Stop()
+
+	// ReportProgress updates the progress currently displayed
+	ReportProgress(currentStep uint32, totalSteps uint32, messageLines []string)
+
+	// PrintPersistentLine prints a line that remains in the output, without mixing it with the progress information
+	PrintPersistentLine(line string)
+}
+
+// NewProgressReporter returns the ProgressReporter implementation suited to the terminal the CLI is running in
+func NewProgressReporter() ProgressReporter {
+	if interactive_terminal_decider.IsInteractiveTerminal() {
+		return newSpinnerProgressReporter()
+	}
+	return newPlainTextProgressReporter(out.PrintOutLn, plainTextProgressUpdateInterval)
+}
+
+// ====================================================================================================
+//
+//	Interactive terminals
+//
+// ====================================================================================================
+type spinnerProgressReporter struct {
+	spinner *spinner.Spinner
+}
+
+func newSpinnerProgressReporter() *spinnerProgressReporter {
+	return &spinnerProgressReporter{
+		spinner: spinner.New(spinnerChar, spinnerSpeed, spinnerColor, spinner.WithWriter(writer), spinner.WithSuffix(spinnerDefaultSuffix)),
+	}
+}
+
+func (reporter *spinnerProgressReporter) Start() {
+	reporter.spinner.Start()
+}
+
+func (reporter *spinnerProgressReporter) Stop() {
+	reporter.spinner.Stop()
+}
+
+func (reporter *spinnerProgressReporter) ReportProgress(currentStep uint32, totalSteps uint32, messageLines []string) {
+	progressMessageStr := formatProgressMessage(messageLines)
+	progressBarStr := formatProgressBar(currentStep, totalSteps, progressBarChar)
+	reporter.spinner.Lock()
+	reporter.spinner.Suffix = fmt.Sprintf("   %s %s", progressBarStr, progressMessageStr)
+	reporter.spinner.Unlock()
+}
+
+func (reporter *spinnerProgressReporter) PrintPersistentLine(line string) {
+	// The spinner has to be stopped -> print -> restarted in order to keep the spinner at the bottom of the output
+	reporter.spinner.Stop()
+	out.PrintOutLn(line)
+	reporter.spinner.Start()
+}
+
+// ====================================================================================================
+//
+//	Non-interactive terminals
+//
+// ====================================================================================================
+type plainTextProgressReporter struct {
+	lock *sync.Mutex
+
+	printLineFunc  func(line string)
+	updateInterval time.Duration
+
+	latestProgress          string
+	isLatestProgressPrinted bool
+
+	// Closed to stop the goroutine printing the progress, which closes the done channel once it has returned
+	stopChan chan struct{}
+	doneChan chan struct{}
+}
+
+func newPlainTextProgressReporter(printLineFunc func(line string), updateInterval time.Duration) *plainTextProgressReporter {
+	return &plainTextProgressReporter{
+		lock:                    &sync.Mutex{},
+		printLineFunc:           printLineFunc,
+		updateInterval:          updateInterval,
+		latestProgress:          "",
+		isLatestProgressPrinted: false,
+		stopChan:                nil,
+		doneChan:                nil,
+	}
+}
+
+func (reporter *plainTextProgressReporter) Start() {
+	reporter.lock.Lock()
+	defer reporter.lock.Unlock()
+	if reporter.stopChan != nil {
+		return
+	}
+	stopChan := make(chan struct{})
+	doneChan := make(chan struct{})
+	reporter.stopChan = stopChan
+	reporter.doneChan = doneChan
+
+	go func() {
+		defer close(doneChan)
+		ticker := time.NewTicker(reporter.updateInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				reporter.printLatestProgress()
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+}
+
+func (reporter *plainTextProgressReporter) Stop() {
+	reporter.lock.Lock()
+	stopChan := reporter.stopChan
+	doneChan := reporter.doneChan
+	reporter.stopChan = nil
+	reporter.doneChan = nil
+	reporter.lock.Unlock()
+
+	if stopChan != nil {
+		close(stopChan)
+		<-doneChan
+	}
+
+	// The progress reported since the last tick would otherwise never show up
+	reporter.lock.Lock()
+	defer reporter.lock.Unlock()
+	if reporter.latestProgress != "" && !reporter.isLatestProgressPrinted {
+		reporter.printLineFunc(reporter.latestProgress)
+		reporter.isLatestProgressPrinted = true
+	}
+}
+
+func (reporter *plainTextProgressReporter) ReportProgress(currentStep uint32, totalSteps uint32, messageLines []string) {
+	reporter.lock.Lock()
+	defer reporter.lock.Unlock()
+
+	progressStr := formatPlainTextProgress(currentStep, totalSteps, messageLines)
+	if progressStr == reporter.latestProgress {
+		return
+	}
+	reporter.latestProgress = progressStr
+	reporter.isLatestProgressPrinted = false
+}
+
+func (reporter *plainTextProgressReporter) PrintPersistentLine(line string) {
+	reporter.lock.Lock()
+	defer reporter.lock.Unlock()
+	reporter.printLineFunc(line)
+}
+
+// printLatestProgress prints the latest progress reported, even when it was already printed at the previous tick, so
+// that the logs show the operation is still ongoing
+func (reporter *plainTextProgressReporter) printLatestProgress() {
+	reporter.lock.Lock()
+	defer reporter.lock.Unlock()
+	if reporter.latestProgress == "" {
+		return
+	}
+	reporter.printLineFunc(reporter.latestProgress)
+	reporter.isLatestProgressPrinted = true
+}
+
+func formatPlainTextProgress(currentStep uint32, totalSteps uint32, messageLines []string) string {
+	progressStr := fmt.Sprintf("Progress: %d/%d", currentStep, totalSteps)
+	if len(messageLines) == 0 {
+		return progressStr
+	}
+	return progressStr + plainTextProgressMessageSeparator + strings.Join(messageLines, plainTextProgressMessageSeparator)
+}
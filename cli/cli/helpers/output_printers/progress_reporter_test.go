@@ -0,0 +1,93 @@
+package output_printers
+
+import (
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testUpdateInterval = 10 * time.Millisecond
+
+	waitForPrintTimeout = 5 * time.Second
+)
+
+type printedLinesRecorder struct {
+	lock  *sync.Mutex
+	lines []string
+}
+
+func newPrintedLinesRecorder() *printedLinesRecorder {
+	return &printedLinesRecorder{
+		lock:  &sync.Mutex{},
+		lines: []string{},
+	}
+}
+
+func (recorder *printedLinesRecorder) printLine(line string) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.lines = append(recorder.lines, line)
+}
+
+func (recorder *printedLinesRecorder) getLines() []string {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return append([]string{}, recorder.lines...)
+}
+
+func TestPlainTextProgressReporter_PrintsOnlyTheLatestProgressAtEachTick(t *testing.T) {
+	recorder := newPrintedLinesRecorder()
+	reporter := newPlainTextProgressReporter(recorder.printLine, time.Hour)
+
+	reporter.ReportProgress(1, 10, []string{"Adding service 'foo'"})
+	reporter.ReportProgress(2, 10, []string{"Adding service 'bar'"})
+	require.Empty(t, recorder.getLines())
+
+	reporter.printLatestProgress()
+	require.Equal(t, []string{"Progress: 2/10 - Adding service 'bar'"}, recorder.getLines())
+
+	// the progress keeps getting printed while it doesn't change, to show the operation is still ongoing
+	reporter.printLatestProgress()
+	require.Equal(t, []string{"Progress: 2/10 - Adding service 'bar'", "Progress: 2/10 - Adding service 'bar'"}, recorder.getLines())
+}
+
+func TestPlainTextProgressReporter_PrintsAtAFixedInterval(t *testing.T) {
+	recorder := newPrintedLinesRecorder()
+	reporter := newPlainTextProgressReporter(recorder.printLine, testUpdateInterval)
+	reporter.Start()
+	defer reporter.Stop()
+
+	reporter.ReportProgress(1, 2, []string{})
+	require.Eventually(t, func() bool {
+		return len(recorder.getLines()) >= 2
+	}, waitForPrintTimeout, testUpdateInterval)
+	require.Equal(t, "Progress: 1/2", recorder.getLines()[0])
+}
+
+func TestPlainTextProgressReporter_StopPrintsTheProgressNotPrintedYet(t *testing.T) {
+	recorder := newPrintedLinesRecorder()
+	reporter := newPlainTextProgressReporter(recorder.printLine, time.Hour)
+	reporter.Start()
+
+	reporter.ReportProgress(1, 2, []string{})
+	reporter.printLatestProgress()
+	reporter.ReportProgress(2, 2, []string{"Done"})
+	reporter.Stop()
+	require.Equal(t, []string{"Progress: 1/2", "Progress: 2/2 - Done"}, recorder.getLines())
+
+	// stopping again doesn't print the same progress twice
+	reporter.Stop()
+	require.Len(t, recorder.getLines(), 2)
+}
+
+func TestPlainTextProgressReporter_PersistentLinesAreAlwaysPrinted(t *testing.T) {
+	recorder := newPrintedLinesRecorder()
+	reporter := newPlainTextProgressReporter(recorder.printLine, time.Hour)
+
+	reporter.ReportProgress(1, 2, []string{"Step"})
+	reporter.PrintPersistentLine("> instruction")
+	reporter.PrintPersistentLine("result")
+	require.Equal(t, []string{"> instruction", "result"}, recorder.getLines())
+}
@@ -0,0 +1,238 @@
+package package_catalog
+
+import (
+	"context"
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-yaml/yaml"
+	"github.com/google/go-github/v50/github"
+	"github.com/kurtosis-tech/stacktrace"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	// The catalog is a plain git repository containing a single YAML file listing the published packages, so that
+	// publishing a package is just a pull request against it
+	DefaultCatalogRepository = "kurtosis-tech/package-catalog"
+	CatalogFilename          = "kurtosis-package-catalog.yml"
+
+	catalogRepositoryOwnerAndNameSeparator = "/"
+
+	githubPackageNamePrefix = "github.com/"
+	packageNameSeparator    = "/"
+	gitTagRefPrefix         = "tags/"
+
+	kurtosisYmlFilename = "kurtosis.yml"
+
+	catalogFilePerms = 0644
+)
+
+// Only the fields of the kurtosis.yml file needed to publish the package
+type kurtosisYml struct {
+	PackageName string `yaml:"name"`
+}
+
+type PackageCatalog struct {
+	Packages []*PackageCatalogEntry `yaml:"packages"`
+}
+
+type PackageCatalogEntry struct {
+	// Name of the package, as declared in its kurtosis.yml (e.g. github.com/kurtosis-tech/datastore-army-package)
+	Name string `yaml:"name"`
+
+	Description string `yaml:"description"`
+
+	// Git tags of the published versions, sorted from the newest to the oldest
+	Versions []string `yaml:"versions"`
+}
+
+// GetLatestVersion returns the newest published version of the package, or emptystring if none was published
+func (entry *PackageCatalogEntry) GetLatestVersion() string {
+	if len(entry.Versions) == 0 {
+		return ""
+	}
+	return entry.Versions[0]
+}
+
+// GetRemotePackageCatalog downloads the catalog from the default branch of the given GitHub repository, formatted as
+// 'owner/name'
+func GetRemotePackageCatalog(ctx context.Context, catalogRepository string) (*PackageCatalog, error) {
+	owner, repositoryName, found := strings.Cut(catalogRepository, catalogRepositoryOwnerAndNameSeparator)
+	if !found || owner == "" || repositoryName == "" {
+		return nil, stacktrace.NewError("Package catalog repository '%v' isn't of the form 'owner/name'", catalogRepository)
+	}
+	ghClient := github.NewClient(http.DefaultClient)
+	catalogFileContent, _, _, err := ghClient.Repositories.GetContents(ctx, owner, repositoryName, CatalogFilename, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching package catalog file '%v' from repository '%v'", CatalogFilename, catalogRepository)
+	}
+	if catalogFileContent == nil {
+		return nil, stacktrace.NewError("Package catalog file '%v' in repository '%v' isn't a file", CatalogFilename, catalogRepository)
+	}
+	catalogYamlStr, err := catalogFileContent.GetContent()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the content of package catalog file '%v' from repository '%v'", CatalogFilename, catalogRepository)
+	}
+	catalog, err := parsePackageCatalog([]byte(catalogYamlStr))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing package catalog file '%v' from repository '%v'", CatalogFilename, catalogRepository)
+	}
+	return catalog, nil
+}
+
+// ReadLocalPackageCatalog reads the catalog from a local clone of the catalog repository. If the clone doesn't contain
+// a catalog file yet, an empty catalog is returned
+func ReadLocalPackageCatalog(catalogDirpath string) (*PackageCatalog, error) {
+	catalogFilepath := path.Join(catalogDirpath, CatalogFilename)
+	catalogYaml, err := os.ReadFile(catalogFilepath)
+	if os.IsNotExist(err) {
+		return &PackageCatalog{Packages: []*PackageCatalogEntry{}}, nil
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading package catalog file '%v'", catalogFilepath)
+	}
+	catalog, err := parsePackageCatalog(catalogYaml)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing package catalog file '%v'", catalogFilepath)
+	}
+	return catalog, nil
+}
+
+// WriteLocalPackageCatalog writes the catalog to a local clone of the catalog repository
+func WriteLocalPackageCatalog(catalogDirpath string, catalog *PackageCatalog) error {
+	catalogFilepath := path.Join(catalogDirpath, CatalogFilename)
+	catalogYaml, err := yaml.Marshal(catalog)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the package catalog")
+	}
+	if err := os.WriteFile(catalogFilepath, catalogYaml, catalogFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing package catalog file '%v'", catalogFilepath)
+	}
+	return nil
+}
+
+// Search returns the packages whose name or description contains the query, ignoring case, sorted by name
+// An empty query matches all the packages
+func (catalog *PackageCatalog) Search(query string) []*PackageCatalogEntry {
+	lowercaseQuery := strings.ToLower(query)
+	result := []*PackageCatalogEntry{}
+	for _, entry := range catalog.Packages {
+		if strings.Contains(strings.ToLower(entry.Name), lowercaseQuery) || strings.Contains(strings.ToLower(entry.Description), lowercaseQuery) {
+			result = append(result, entry)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// AddPackageVersion registers a new version of the package in the catalog, adding the package to the catalog if it's
+// not there yet. The description is only updated if a non-empty one is given
+func (catalog *PackageCatalog) AddPackageVersion(packageName string, description string, version string) error {
+	newSemver, err := semver.NewVersion(version)
+	if err != nil {
+		return stacktrace.Propagate(err, "Version '%v' of package '%v' isn't a valid semantic version", version, packageName)
+	}
+
+	var entry *PackageCatalogEntry
+	for _, existingEntry := range catalog.Packages {
+		if existingEntry.Name == packageName {
+			entry = existingEntry
+			break
+		}
+	}
+	if entry == nil {
+		entry = &PackageCatalogEntry{
+			Name:        packageName,
+			Description: "",
+			Versions:    []string{},
+		}
+		catalog.Packages = append(catalog.Packages, entry)
+	}
+
+	// The catalog file can be edited by hand, so the versions already in it get validated too
+	semversByVersion := map[string]*semver.Version{
+		version: newSemver,
+	}
+	for _, existingVersion := range entry.Versions {
+		existingSemver, err := semver.NewVersion(existingVersion)
+		if err != nil {
+			return stacktrace.Propagate(err, "Version '%v' of package '%v' already in the catalog isn't a valid semantic version", existingVersion, packageName)
+		}
+		// Equal rather than string comparison, so that e.g. 'v1.0.0' and '1.0.0' are the same version
+		if existingSemver.Equal(newSemver) {
+			return stacktrace.NewError("Version '%v' of package '%v' is already published as '%v'", version, packageName, existingVersion)
+		}
+		semversByVersion[existingVersion] = existingSemver
+	}
+	if description != "" {
+		entry.Description = description
+	}
+	entry.Versions = append(entry.Versions, version)
+	sort.SliceStable(entry.Versions, func(i, j int) bool {
+		return semversByVersion[entry.Versions[i]].GreaterThan(semversByVersion[entry.Versions[j]])
+	})
+	sort.Slice(catalog.Packages, func(i, j int) bool {
+		return catalog.Packages[i].Name < catalog.Packages[j].Name
+	})
+	return nil
+}
+
+// EnsureVersionIsTagged checks that the version is a git tag of the GitHub repository the package lives in, so that
+// the published version can be fetched
+func EnsureVersionIsTagged(ctx context.Context, packageName string, version string) error {
+	owner, repositoryName, err := getPackageRepositoryOwnerAndName(packageName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the repository of package '%v'", packageName)
+	}
+	ghClient := github.NewClient(http.DefaultClient)
+	_, response, err := ghClient.Git.GetRef(ctx, owner, repositoryName, gitTagRefPrefix+version)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return stacktrace.NewError("Version '%v' of package '%v' isn't a git tag of repository '%v/%v'; push the tag before publishing it", version, packageName, owner, repositoryName)
+		}
+		return stacktrace.Propagate(err, "An error occurred fetching tag '%v' from repository '%v/%v'", version, owner, repositoryName)
+	}
+	return nil
+}
+
+// GetPackageName returns the name declared in the kurtosis.yml file of the package in the given directory
+func GetPackageName(packageDirpath string) (string, error) {
+	kurtosisYmlFilepath := path.Join(packageDirpath, kurtosisYmlFilename)
+	kurtosisYmlContent, err := os.ReadFile(kurtosisYmlFilepath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading '%v'; is '%v' a Kurtosis package directory?", kurtosisYmlFilepath, packageDirpath)
+	}
+	parsedKurtosisYml := &kurtosisYml{PackageName: ""}
+	if err := yaml.Unmarshal(kurtosisYmlContent, parsedKurtosisYml); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing '%v'", kurtosisYmlFilepath)
+	}
+	if parsedKurtosisYml.PackageName == "" {
+		return "", stacktrace.NewError("'%v' doesn't declare a package name", kurtosisYmlFilepath)
+	}
+	return parsedKurtosisYml.PackageName, nil
+}
+
+// Packages are named after the GitHub repository they live in, optionally followed by the path of the package in it
+func getPackageRepositoryOwnerAndName(packageName string) (string, string, error) {
+	if !strings.HasPrefix(packageName, githubPackageNamePrefix) {
+		return "", "", stacktrace.NewError("Package name '%v' doesn't start with '%v'", packageName, githubPackageNamePrefix)
+	}
+	nameParts := strings.Split(strings.TrimPrefix(packageName, githubPackageNamePrefix), packageNameSeparator)
+	if len(nameParts) < 2 || nameParts[0] == "" || nameParts[1] == "" {
+		return "", "", stacktrace.NewError("Package name '%v' isn't of the form '%vowner/name'", packageName, githubPackageNamePrefix)
+	}
+	return nameParts[0], nameParts[1], nil
+}
+
+func parsePackageCatalog(catalogYaml []byte) (*PackageCatalog, error) {
+	catalog := &PackageCatalog{Packages: []*PackageCatalogEntry{}}
+	if err := yaml.Unmarshal(catalogYaml, catalog); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred unmarshalling the package catalog YAML")
+	}
+	return catalog, nil
+}
@@ -0,0 +1,97 @@
+package package_catalog
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	datastorePackageName = "github.com/kurtosis-tech/datastore-army-package"
+	ethereumPackageName  = "github.com/kurtosis-tech/eth2-package"
+)
+
+func TestAddPackageVersion_KeepsVersionsSortedNewestFirst(t *testing.T) {
+	catalog := &PackageCatalog{Packages: []*PackageCatalogEntry{}}
+	require.NoError(t, catalog.AddPackageVersion(datastorePackageName, "Datastore army", "v0.2.0"))
+	require.NoError(t, catalog.AddPackageVersion(datastorePackageName, "", "v0.10.0"))
+	require.NoError(t, catalog.AddPackageVersion(datastorePackageName, "", "v0.9.1"))
+
+	require.Len(t, catalog.Packages, 1)
+	require.Equal(t, []string{"v0.10.0", "v0.9.1", "v0.2.0"}, catalog.Packages[0].Versions)
+	require.Equal(t, "v0.10.0", catalog.Packages[0].GetLatestVersion())
+	require.Equal(t, "Datastore army", catalog.Packages[0].Description)
+}
+
+func TestAddPackageVersion_RejectsInvalidAndDuplicateVersions(t *testing.T) {
+	catalog := &PackageCatalog{Packages: []*PackageCatalogEntry{}}
+	require.Error(t, catalog.AddPackageVersion(datastorePackageName, "", "main"))
+	require.NoError(t, catalog.AddPackageVersion(datastorePackageName, "", "1.0.0"))
+	require.Error(t, catalog.AddPackageVersion(datastorePackageName, "", "1.0.0"))
+	require.Error(t, catalog.AddPackageVersion(datastorePackageName, "", "v1.0.0"))
+	require.Equal(t, []string{"1.0.0"}, catalog.Packages[0].Versions)
+}
+
+func TestAddPackageVersion_RejectsCatalogWithInvalidVersion(t *testing.T) {
+	catalog := &PackageCatalog{Packages: []*PackageCatalogEntry{
+		{Name: datastorePackageName, Description: "", Versions: []string{"latest"}},
+	}}
+	require.Error(t, catalog.AddPackageVersion(datastorePackageName, "", "v1.0.0"))
+}
+
+func TestSearch_MatchesNameAndDescriptionIgnoringCase(t *testing.T) {
+	catalog := &PackageCatalog{Packages: []*PackageCatalogEntry{
+		{Name: ethereumPackageName, Description: "Ethereum testnet", Versions: []string{}},
+		{Name: datastorePackageName, Description: "An army of datastores", Versions: []string{}},
+	}}
+	require.Equal(t, []*PackageCatalogEntry{catalog.Packages[0]}, catalog.Search("ETHEREUM"))
+	require.Equal(t, []*PackageCatalogEntry{catalog.Packages[1]}, catalog.Search("army"))
+	require.Empty(t, catalog.Search("cassandra"))
+
+	// an empty query matches everything, sorted by name
+	require.Equal(t, []*PackageCatalogEntry{catalog.Packages[1], catalog.Packages[0]}, catalog.Search(""))
+}
+
+func TestLocalPackageCatalog_RoundTrip(t *testing.T) {
+	catalogDirpath := t.TempDir()
+
+	catalog, err := ReadLocalPackageCatalog(catalogDirpath)
+	require.NoError(t, err)
+	require.Empty(t, catalog.Packages)
+
+	require.NoError(t, catalog.AddPackageVersion(ethereumPackageName, "Ethereum testnet", "v1.0.0"))
+	require.NoError(t, WriteLocalPackageCatalog(catalogDirpath, catalog))
+
+	readCatalog, err := ReadLocalPackageCatalog(catalogDirpath)
+	require.NoError(t, err)
+	require.Equal(t, catalog, readCatalog)
+}
+
+func TestGetPackageName(t *testing.T) {
+	packageDirpath := t.TempDir()
+	_, err := GetPackageName(packageDirpath)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path.Join(packageDirpath, kurtosisYmlFilename), []byte("name: "+ethereumPackageName+"\n"), 0644))
+	packageName, err := GetPackageName(packageDirpath)
+	require.NoError(t, err)
+	require.Equal(t, ethereumPackageName, packageName)
+}
+
+func TestGetPackageRepositoryOwnerAndName(t *testing.T) {
+	owner, repositoryName, err := getPackageRepositoryOwnerAndName(ethereumPackageName)
+	require.NoError(t, err)
+	require.Equal(t, "kurtosis-tech", owner)
+	require.Equal(t, "eth2-package", repositoryName)
+
+	owner, repositoryName, err = getPackageRepositoryOwnerAndName("github.com/kurtosis-tech/packages/datastore")
+	require.NoError(t, err)
+	require.Equal(t, "kurtosis-tech", owner)
+	require.Equal(t, "packages", repositoryName)
+
+	_, _, err = getPackageRepositoryOwnerAndName("gitlab.com/kurtosis-tech/eth2-package")
+	require.Error(t, err)
+	_, _, err = getPackageRepositoryOwnerAndName("github.com/kurtosis-tech")
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package package_test_runner
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+const (
+	instructionNamesSeparator = ", "
+)
+
+// TestExpectations are the assertions on the instruction plan a test function can return, e.g.:
+//
+//	def test_deploys_two_nodes(plan):
+//	    lib.deploy(plan, 2)
+//	    return {"instructions": ["add_service", "add_service"]}
+type TestExpectations struct {
+	// Names of the instructions the test is expected to add to the plan, in order. Not checked if nil
+	Instructions []string `json:"instructions"`
+
+	// Number of instructions the test is expected to add to the plan. Not checked if nil
+	InstructionCount *int `json:"instruction_count"`
+}
+
+// ParseTestExpectations parses the serialized value returned by a test function. A test returning nothing doesn't
+// assert anything on the plan
+func ParseTestExpectations(serializedTestOutput string) (*TestExpectations, error) {
+	expectations := &TestExpectations{
+		Instructions:     nil,
+		InstructionCount: nil,
+	}
+	if strings.TrimSpace(serializedTestOutput) == "" {
+		return expectations, nil
+	}
+	decoder := json.NewDecoder(strings.NewReader(serializedTestOutput))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(expectations); err != nil {
+		return nil, stacktrace.Propagate(err, "Expected the test to return nothing or a dict with the expectations on the plan, but it returned '%v'", serializedTestOutput)
+	}
+	return expectations, nil
+}
+
+// AssertInstructionPlan checks that the instructions produced by the test match the expectations it returned
+func AssertInstructionPlan(expectations *TestExpectations, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction) error {
+	instructionNames := []string{}
+	for _, instruction := range instructions {
+		instructionNames = append(instructionNames, instruction.GetInstructionName())
+	}
+	if expectations.InstructionCount != nil && *expectations.InstructionCount != len(instructionNames) {
+		return stacktrace.NewError("Expected the plan to contain %d instructions but it contained %d: [%v]", *expectations.InstructionCount, len(instructionNames), strings.Join(instructionNames, instructionNamesSeparator))
+	}
+	if expectations.Instructions == nil {
+		return nil
+	}
+	expectedInstructionNamesStr := strings.Join(expectations.Instructions, instructionNamesSeparator)
+	actualInstructionNamesStr := strings.Join(instructionNames, instructionNamesSeparator)
+	if len(expectations.Instructions) != len(instructionNames) {
+		return stacktrace.NewError("Expected the plan to be [%v] but it was [%v]", expectedInstructionNamesStr, actualInstructionNamesStr)
+	}
+	for idx, expectedInstructionName := range expectations.Instructions {
+		if instructionNames[idx] != expectedInstructionName {
+			return stacktrace.NewError("Expected the plan to be [%v] but it was [%v]; instruction #%d is '%v' instead of '%v'", expectedInstructionNamesStr, actualInstructionNamesStr, idx+1, instructionNames[idx], expectedInstructionName)
+		}
+	}
+	return nil
+}
+
+// AssertInterpretationError checks the outcome of the interpretation of a test. If expectedErrorSubstring is empty the
+// test must interpret successfully, otherwise its interpretation must fail with an error containing the substring
+func AssertInterpretationError(expectedErrorSubstring string, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) error {
+	if expectedErrorSubstring == "" {
+		if interpretationError != nil {
+			return stacktrace.NewError("Expected the test to interpret successfully but it failed with:\n%v", interpretationError.GetErrorMessage())
+		}
+		return nil
+	}
+	if interpretationError == nil {
+		return stacktrace.NewError("Expected the test to fail with an error containing '%v' but it interpreted successfully", expectedErrorSubstring)
+	}
+	if !strings.Contains(interpretationError.GetErrorMessage(), expectedErrorSubstring) {
+		return stacktrace.NewError("Expected the test to fail with an error containing '%v' but it failed with:\n%v", expectedErrorSubstring, interpretationError.GetErrorMessage())
+	}
+	return nil
+}
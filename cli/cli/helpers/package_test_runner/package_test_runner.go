@@ -0,0 +1,250 @@
+package package_test_runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	TestFileSuffix = "_test.star"
+
+	// Tests are interpreted but never executed, so they don't start any container
+	dryRun      = true
+	parallelism = 1
+	noParams    = "{}"
+	isNotStrict = false
+
+	mainFilename = "main.star"
+
+	hiddenDirPrefix = "."
+
+	copiedFilePerms = 0644
+	copiedDirPerms  = 0755
+
+	// The test file is uploaded as part of the package and imported by a generated main.star; this one returns the test
+	// functions of the file and the errors some of them are expected to fail with
+	discoverTestsMainFileTemplate = `
+test_module = import_module("%s")
+
+def run(plan):
+	return {
+		"tests": [name for name in dir(test_module) if name.startswith("test_")],
+		"expected_errors": getattr(test_module, "EXPECTED_ERRORS", {}),
+	}
+`
+
+	// ...and this one runs a single test function against the plan
+	runTestMainFileTemplate = `
+test_module = import_module("%s")
+
+def run(plan):
+	return test_module.%s(plan)
+`
+)
+
+// TestResult is the outcome of a single test function of a test file
+type TestResult struct {
+	TestFileRelativeFilepath string
+
+	// Empty if the test file couldn't be loaded at all
+	TestName string
+
+	// Nil if the test passed
+	Failure error
+}
+
+// Returned by the discovery main.star
+type testFileContent struct {
+	Tests []string `json:"tests"`
+
+	// Test function name -> substring of the interpretation error the test is expected to fail with
+	ExpectedErrors map[string]string `json:"expected_errors"`
+}
+
+type runResult struct {
+	instructions        []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
+	interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
+	validationErrors    []*kurtosis_core_rpc_api_bindings.StarlarkValidationError
+	serializedOutput    string
+}
+
+// FindTestFiles returns the paths, relative to the package root, of the test files of the package sorted
+// alphabetically. Hidden directories are skipped
+func FindTestFiles(packageDirpath string) ([]string, error) {
+	testFileRelativeFilepaths := []string{}
+	err := filepath.WalkDir(packageDirpath, func(filepathInPackage string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if filepathInPackage != packageDirpath && strings.HasPrefix(entry.Name(), hiddenDirPrefix) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(entry.Name(), TestFileSuffix) {
+			return nil
+		}
+		relativeFilepath, err := filepath.Rel(packageDirpath, filepathInPackage)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", filepathInPackage, packageDirpath)
+		}
+		testFileRelativeFilepaths = append(testFileRelativeFilepaths, filepath.ToSlash(relativeFilepath))
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred looking for '*%v' files in '%v'", TestFileSuffix, packageDirpath)
+	}
+	sort.Strings(testFileRelativeFilepaths)
+	return testFileRelativeFilepaths, nil
+}
+
+// RunTestFile runs every test function of the test file in the enclave and returns their results. The package is
+// copied to a temporary directory first so that its main.star can be swapped for the generated ones
+func RunTestFile(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packageDirpath string, packageName string, testFileRelativeFilepath string) ([]*TestResult, error) {
+	packageCopyDirpath, err := os.MkdirTemp("", "kurtosis-package-test-")
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the temporary directory to copy package '%v' to", packageName)
+	}
+	defer os.RemoveAll(packageCopyDirpath)
+	if err = copyPackage(packageDirpath, packageCopyDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying package '%v' to '%v'", packageName, packageCopyDirpath)
+	}
+	testFileLocator := path.Join(packageName, testFileRelativeFilepath)
+
+	discoveryResult, err := runMainFile(ctx, enclaveCtx, packageCopyDirpath, fmt.Sprintf(discoverTestsMainFileTemplate, testFileLocator))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred loading the tests of '%v'", testFileRelativeFilepath)
+	}
+	if discoveryResult.interpretationError != nil {
+		return []*TestResult{{
+			TestFileRelativeFilepath: testFileRelativeFilepath,
+			TestName:                 "",
+			Failure:                  stacktrace.NewError("Test file couldn't be loaded:\n%v", discoveryResult.interpretationError.GetErrorMessage()),
+		}}, nil
+	}
+	content := &testFileContent{
+		Tests:          []string{},
+		ExpectedErrors: map[string]string{},
+	}
+	if err = json.Unmarshal([]byte(discoveryResult.serializedOutput), content); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the tests of '%v' from '%v'; is EXPECTED_ERRORS a dict of test names to strings?", testFileRelativeFilepath, discoveryResult.serializedOutput)
+	}
+
+	results := []*TestResult{}
+	for _, testName := range content.Tests {
+		testResult, err := runMainFile(ctx, enclaveCtx, packageCopyDirpath, fmt.Sprintf(runTestMainFileTemplate, testFileLocator, testName))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred running test '%v' of '%v'", testName, testFileRelativeFilepath)
+		}
+		results = append(results, &TestResult{
+			TestFileRelativeFilepath: testFileRelativeFilepath,
+			TestName:                 testName,
+			Failure:                  checkTestResult(content.ExpectedErrors[testName], testResult),
+		})
+	}
+	return results, nil
+}
+
+func checkTestResult(expectedErrorSubstring string, result *runResult) error {
+	if err := AssertInterpretationError(expectedErrorSubstring, result.interpretationError); err != nil {
+		return err
+	}
+	if result.interpretationError != nil {
+		// failed as expected; there's no plan to check
+		return nil
+	}
+	if len(result.validationErrors) > 0 {
+		validationErrorMessages := []string{}
+		for _, validationError := range result.validationErrors {
+			validationErrorMessages = append(validationErrorMessages, validationError.GetErrorMessage())
+		}
+		return stacktrace.NewError("The plan failed validation:\n%v", strings.Join(validationErrorMessages, "\n"))
+	}
+	expectations, err := ParseTestExpectations(result.serializedOutput)
+	if err != nil {
+		return err
+	}
+	return AssertInstructionPlan(expectations, result.instructions)
+}
+
+func runMainFile(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, packageCopyDirpath string, mainFileContent string) (*runResult, error) {
+	mainFilepath := path.Join(packageCopyDirpath, mainFilename)
+	if err := os.WriteFile(mainFilepath, []byte(mainFileContent), copiedFilePerms); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing the generated '%v'", mainFilepath)
+	}
+	responseLines, cancelFunc, err := enclaveCtx.RunStarlarkPackage(ctx, packageCopyDirpath, noParams, dryRun, parallelism, isNotStrict)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the package copied to '%v'", packageCopyDirpath)
+	}
+	defer cancelFunc()
+
+	result := &runResult{
+		instructions:        []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{},
+		interpretationError: nil,
+		validationErrors:    []*kurtosis_core_rpc_api_bindings.StarlarkValidationError{},
+		serializedOutput:    "",
+	}
+	for responseLine := range responseLines {
+		if responseLine.GetInstruction() != nil {
+			result.instructions = append(result.instructions, responseLine.GetInstruction())
+		} else if responseLine.GetError() != nil {
+			if responseLine.GetError().GetInterpretationError() != nil {
+				result.interpretationError = responseLine.GetError().GetInterpretationError()
+			} else if responseLine.GetError().GetValidationError() != nil {
+				result.validationErrors = append(result.validationErrors, responseLine.GetError().GetValidationError())
+			}
+		} else if responseLine.GetRunFinishedEvent() != nil {
+			result.serializedOutput = responseLine.GetRunFinishedEvent().GetSerializedOutput()
+		}
+	}
+	return result, nil
+}
+
+func copyPackage(srcDirpath string, destDirpath string) error {
+	return filepath.WalkDir(srcDirpath, func(srcFilepath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relativeFilepath, err := filepath.Rel(srcDirpath, srcFilepath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", srcFilepath, srcDirpath)
+		}
+		destFilepath := filepath.Join(destDirpath, relativeFilepath)
+		if entry.IsDir() {
+			return os.MkdirAll(destFilepath, copiedDirPerms)
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		return copyFile(srcFilepath, destFilepath)
+	})
+}
+
+func copyFile(srcFilepath string, destFilepath string) error {
+	srcFile, err := os.Open(srcFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening '%v'", srcFilepath)
+	}
+	defer srcFile.Close()
+	destFile, err := os.OpenFile(destFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, copiedFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating '%v'", destFilepath)
+	}
+	defer destFile.Close()
+	if _, err = io.Copy(destFile, srcFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying '%v' to '%v'", srcFilepath, destFilepath)
+	}
+	return nil
+}
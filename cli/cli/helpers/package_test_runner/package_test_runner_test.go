@@ -0,0 +1,76 @@
+package package_test_runner
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	addServiceInstructionName = "add_service"
+	execInstructionName       = "exec"
+)
+
+func TestFindTestFiles(t *testing.T) {
+	packageDirpath := t.TempDir()
+	for _, relativeFilepath := range []string{"main.star", "main_test.star", "lib/nodes.star", "lib/nodes_test.star", ".git/hooks_test.star"} {
+		filepath := path.Join(packageDirpath, relativeFilepath)
+		require.NoError(t, os.MkdirAll(path.Dir(filepath), 0755))
+		require.NoError(t, os.WriteFile(filepath, []byte{}, 0644))
+	}
+
+	testFiles, err := FindTestFiles(packageDirpath)
+	require.NoError(t, err)
+	require.Equal(t, []string{"lib/nodes_test.star", "main_test.star"}, testFiles)
+}
+
+func TestParseTestExpectations(t *testing.T) {
+	expectations, err := ParseTestExpectations("")
+	require.NoError(t, err)
+	require.Nil(t, expectations.Instructions)
+	require.Nil(t, expectations.InstructionCount)
+
+	expectations, err = ParseTestExpectations(`{"instructions": ["add_service", "exec"], "instruction_count": 2}`)
+	require.NoError(t, err)
+	require.Equal(t, []string{addServiceInstructionName, execInstructionName}, expectations.Instructions)
+	require.Equal(t, 2, *expectations.InstructionCount)
+
+	_, err = ParseTestExpectations(`{"unknown": true}`)
+	require.Error(t, err)
+}
+
+func TestAssertInstructionPlan(t *testing.T) {
+	instructions := []*kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		newInstruction(addServiceInstructionName),
+		newInstruction(execInstructionName),
+	}
+	two := 2
+	three := 3
+
+	require.NoError(t, AssertInstructionPlan(&TestExpectations{Instructions: nil, InstructionCount: nil}, instructions))
+	require.NoError(t, AssertInstructionPlan(&TestExpectations{Instructions: []string{addServiceInstructionName, execInstructionName}, InstructionCount: &two}, instructions))
+	require.Error(t, AssertInstructionPlan(&TestExpectations{Instructions: []string{execInstructionName, addServiceInstructionName}, InstructionCount: nil}, instructions))
+	require.Error(t, AssertInstructionPlan(&TestExpectations{Instructions: []string{addServiceInstructionName}, InstructionCount: nil}, instructions))
+	require.Error(t, AssertInstructionPlan(&TestExpectations{Instructions: nil, InstructionCount: &three}, instructions))
+}
+
+func TestAssertInterpretationError(t *testing.T) {
+	interpretationError := &kurtosis_core_rpc_api_bindings.StarlarkInterpretationError{ErrorMessage: "Evaluation error: count must be positive"}
+
+	require.NoError(t, AssertInterpretationError("", nil))
+	require.Error(t, AssertInterpretationError("", interpretationError))
+	require.NoError(t, AssertInterpretationError("must be positive", interpretationError))
+	require.Error(t, AssertInterpretationError("must be even", interpretationError))
+	require.Error(t, AssertInterpretationError("must be positive", nil))
+}
+
+func newInstruction(name string) *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
+	return &kurtosis_core_rpc_api_bindings.StarlarkInstruction{
+		Position:              nil,
+		InstructionName:       name,
+		Arguments:             nil,
+		ExecutableInstruction: "",
+	}
+}
@@ -12,12 +12,10 @@ import (
 	"os"
 	"os/exec"
 	"strconv"
-	"syscall"
 )
 
 const (
-	portalPidFileMode       = 0600
-	portalProcessPingSignal = 0
+	portalPidFileMode = 0600
 
 	pidNumberBase = 10
 
@@ -158,7 +156,7 @@ func (portalManager *PortalManager) StopExisting(_ context.Context) error {
 		}
 	}
 
-	if err = process.Signal(syscall.SIGINT); err != nil {
+	if err = interruptProcess(process); err != nil {
 		logrus.Warnf("Error stopping currently running portal on PID: '%d'. It might already be stopped. "+
 			"PID file will be removed. Error was: %s", pid, err.Error())
 	}
@@ -250,14 +248,9 @@ func getPIDFromPidFile() (int, error) {
 
 // getRunningProcessFromPID returns the os.Process object corresponding to the Portal process, or nil if the process is not running
 func getRunningProcessFromPID(pid int) (*os.Process, error) {
-	process, err := os.FindProcess(pid)
+	process, err := findRunningProcess(pid)
 	if err != nil {
-		// this should never happen on Unix system, see FindProcess docs
 		return nil, stacktrace.Propagate(err, "Unexpected error getting process attached to PID '%d'", pid)
 	}
-	if err = process.Signal(syscall.Signal(portalProcessPingSignal)); err != nil {
-		// PID file exists but process seem to be dead
-		return nil, nil
-	}
 	return process, nil
 }
@@ -0,0 +1,33 @@
+//go:build !windows
+
+package portal_manager
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"syscall"
+)
+
+const (
+	portalProcessPingSignal = 0
+)
+
+// findRunningProcess returns the process attached to the PID, or nil if no process is running with this PID
+func findRunningProcess(pid int) (*os.Process, error) {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		// this should never happen on Unix system, see FindProcess docs
+		return nil, stacktrace.Propagate(err, "An error occurred finding process with PID '%d'", pid)
+	}
+	// On Unix, FindProcess always succeeds regardless of whether the process exists, so we ping it with the null signal
+	if err = process.Signal(syscall.Signal(portalProcessPingSignal)); err != nil {
+		// PID file exists but process seem to be dead
+		return nil, nil
+	}
+	return process, nil
+}
+
+// The Portal shuts down gracefully when it receives an interrupt signal
+func interruptProcess(process *os.Process) error {
+	return process.Signal(syscall.SIGINT)
+}
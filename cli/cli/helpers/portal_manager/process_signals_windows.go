@@ -0,0 +1,22 @@
+//go:build windows
+
+package portal_manager
+
+import (
+	"os"
+)
+
+// findRunningProcess returns the process attached to the PID, or nil if no process is running with this PID
+func findRunningProcess(pid int) (*os.Process, error) {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		// On Windows, FindProcess opens a handle on the process and fails if no process is running with this PID
+		return nil, nil
+	}
+	return process, nil
+}
+
+// Windows doesn't support sending interrupt signals to other processes, so the only way to stop the Portal is killing it
+func interruptProcess(process *os.Process) error {
+	return process.Kill()
+}
@@ -0,0 +1,10 @@
+//go:build !windows
+
+package raw_terminal_mode
+
+import "os"
+
+// Unix terminals process escape sequences natively, so there's nothing to do here
+func enableEscapeSequencesProcessing(_ *os.File) (func(), error) {
+	return func() {}, nil
+}
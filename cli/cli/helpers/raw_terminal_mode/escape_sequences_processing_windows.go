@@ -0,0 +1,34 @@
+//go:build windows
+
+package raw_terminal_mode
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/windows"
+	"os"
+)
+
+// Windows consoles don't interpret the ANSI escape sequences that remote shells send (colors, cursor movements, etc.)
+// unless virtual terminal processing is explicitly enabled on the console output handle
+func enableEscapeSequencesProcessing(output *os.File) (func(), error) {
+	outputHandle := windows.Handle(output.Fd())
+
+	var originalMode uint32
+	if err := windows.GetConsoleMode(outputHandle, &originalMode); err != nil {
+		// Output isn't a console (e.g. it's redirected to a file), so there's nothing to enable
+		return func() {}, nil
+	}
+
+	newMode := originalMode | windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING
+	if err := windows.SetConsoleMode(outputHandle, newMode); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred enabling virtual terminal processing on the console output")
+	}
+
+	restoreFunc := func() {
+		if err := windows.SetConsoleMode(outputHandle, originalMode); err != nil {
+			logrus.Warnf("An error occurred restoring the console output mode; you may need to reset your terminal. Error was:\n%v", err)
+		}
+	}
+	return restoreFunc, nil
+}
@@ -0,0 +1,39 @@
+package raw_terminal_mode
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/term"
+	"os"
+)
+
+// EnableIfTerminal puts the given input file in raw mode if it's attached to a terminal, so that keystrokes are
+// forwarded one by one to the other end of an interactive session (e.g. a shell on a service) instead of line by line.
+// It also takes care of the platform-specific setup the output terminal needs to render the escape sequences sent
+// by the remote end (e.g. enabling virtual terminal processing on Windows consoles).
+// The returned function restores the terminals to their original state and must be called once the session is over.
+func EnableIfTerminal(input *os.File, output *os.File) (func(), error) {
+	inputFd := int(input.Fd())
+	if !term.IsTerminal(inputFd) {
+		return func() {}, nil
+	}
+
+	restoreOutputFunc, err := enableEscapeSequencesProcessing(output)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred enabling escape sequences processing on the output terminal")
+	}
+
+	oldInputState, err := term.MakeRaw(inputFd)
+	if err != nil {
+		restoreOutputFunc()
+		return nil, stacktrace.Propagate(err, "An error occurred making the input terminal raw")
+	}
+
+	restoreFunc := func() {
+		if err := term.Restore(inputFd, oldInputState); err != nil {
+			logrus.Warnf("An error occurred restoring the input terminal to its original state; you may need to reset your terminal. Error was:\n%v", err)
+		}
+		restoreOutputFunc()
+	}
+	return restoreFunc, nil
+}
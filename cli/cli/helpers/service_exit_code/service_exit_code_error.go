@@ -0,0 +1,38 @@
+package service_exit_code
+
+import (
+	"errors"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// ServiceExitCodeError gets returned by commands whose outcome is the exit code of a service, e.g. a service running a
+// test suite, so that the CLI exits with that same code
+type ServiceExitCodeError struct {
+	serviceIdentifier string
+	exitCode          int32
+}
+
+func NewServiceExitCodeError(serviceIdentifier string, exitCode int32) *ServiceExitCodeError {
+	return &ServiceExitCodeError{
+		serviceIdentifier: serviceIdentifier,
+		exitCode:          exitCode,
+	}
+}
+
+func (err *ServiceExitCodeError) Error() string {
+	return fmt.Sprintf("Service '%v' exited with code %v", err.serviceIdentifier, err.exitCode)
+}
+
+func (err *ServiceExitCodeError) GetExitCode() int32 {
+	return err.exitCode
+}
+
+// GetServiceExitCodeError returns the ServiceExitCodeError at the root of the given error, if any
+func GetServiceExitCodeError(err error) (*ServiceExitCodeError, bool) {
+	var serviceExitCodeErr *ServiceExitCodeError
+	if errors.As(stacktrace.RootCause(err), &serviceExitCodeErr) {
+		return serviceExitCodeErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,17 @@
+package service_exit_code
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetServiceExitCodeError(t *testing.T) {
+	err := stacktrace.Propagate(NewServiceExitCodeError("test-runner", 3), "An error occurred running the command")
+	serviceExitCodeErr, found := GetServiceExitCodeError(err)
+	require.True(t, found)
+	require.Equal(t, int32(3), serviceExitCodeErr.GetExitCode())
+
+	_, found = GetServiceExitCodeError(stacktrace.NewError("Some other error"))
+	require.False(t, found)
+}
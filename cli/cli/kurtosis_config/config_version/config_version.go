@@ -8,4 +8,8 @@ const (
 	ConfigVersion_v0 ConfigVersion = iota
 	ConfigVersion_v1
 	ConfigVersion_v2	// Fixed a typo in Kubernetes config, `enclave-size-in-Megabytes` -> `enclave-size-in-megabytes`
+	ConfigVersion_v3	// Added `networking-sidecar-image` to the cluster config
+	ConfigVersion_v4	// Added `webhooks`
+	ConfigVersion_v5	// Added `package-cache-max-size-in-megabytes`
+	ConfigVersion_v6	// Added `should-fall-back-to-ephemeral-port-on-host-port-conflict` to the cluster config
 )
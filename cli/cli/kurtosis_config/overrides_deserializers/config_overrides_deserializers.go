@@ -6,6 +6,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v0"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v1"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v4"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -20,6 +24,55 @@ type configOverridesDeserializer func(configFileBytes []byte) (interface{}, erro
 //  to the bottom each time
 // >>>>>>>>>>>>>>>>>>>>>>>>>>>>> INSTRUCTIONS <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<
 var AllConfigOverridesDeserializers = map[config_version.ConfigVersion]configOverridesDeserializer{
+	config_version.ConfigVersion_v6: func(configFileBytes []byte) (interface{}, error) {
+		overrides := &v6.KurtosisConfigV6{
+			ConfigVersion:                  0,
+			ShouldSendMetrics:              nil,
+			KurtosisClusters:               nil,
+			Webhooks:                       nil,
+			PackageCacheMaxSizeInMegabytes: nil,
+		}
+		if err := yaml.Unmarshal(configFileBytes, overrides); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred unmarshalling Kurtosis config YAML file content '%v'", string(configFileBytes))
+		}
+		return overrides, nil
+	},
+	config_version.ConfigVersion_v5: func(configFileBytes []byte) (interface{}, error) {
+		overrides := &v5.KurtosisConfigV5{
+			ConfigVersion:                  0,
+			ShouldSendMetrics:              nil,
+			KurtosisClusters:               nil,
+			Webhooks:                       nil,
+			PackageCacheMaxSizeInMegabytes: nil,
+		}
+		if err := yaml.Unmarshal(configFileBytes, overrides); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred unmarshalling Kurtosis config YAML file content '%v'", string(configFileBytes))
+		}
+		return overrides, nil
+	},
+	config_version.ConfigVersion_v4: func(configFileBytes []byte) (interface{}, error) {
+		overrides := &v4.KurtosisConfigV4{
+			ConfigVersion:     0,
+			ShouldSendMetrics: nil,
+			KurtosisClusters:  nil,
+			Webhooks:          nil,
+		}
+		if err := yaml.Unmarshal(configFileBytes, overrides); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred unmarshalling Kurtosis config YAML file content '%v'", string(configFileBytes))
+		}
+		return overrides, nil
+	},
+	config_version.ConfigVersion_v3: func(configFileBytes []byte) (interface{}, error) {
+		overrides := &v3.KurtosisConfigV3{
+			ConfigVersion:     0,
+			ShouldSendMetrics: nil,
+			KurtosisClusters:  nil,
+		}
+		if err := yaml.Unmarshal(configFileBytes, overrides); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred unmarshalling Kurtosis config YAML file content '%v'", string(configFileBytes))
+		}
+		return overrides, nil
+	},
 	config_version.ConfigVersion_v2: func(configFileBytes []byte) (interface{}, error) {
 		overrides := &v2.KurtosisConfigV2{
 			ConfigVersion:     0,
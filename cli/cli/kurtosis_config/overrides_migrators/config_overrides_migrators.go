@@ -5,6 +5,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v0"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v1"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v4"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -23,11 +27,223 @@ type configOverridesMigrator = func(uncastedOldConfig interface{}) (interface{},
 //  to the bottom each time
 // >>>>>>>>>>>>>>>>>>>>>>>>>>>>> INSTRUCTIONS <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<
 var AllConfigOverridesMigrators = map[config_version.ConfigVersion]configOverridesMigrator{
+	config_version.ConfigVersion_v5: migrateFromV5,
+	config_version.ConfigVersion_v4: migrateFromV4,
+	config_version.ConfigVersion_v3: migrateFromV3,
+	config_version.ConfigVersion_v2: migrateFromV2,
 	config_version.ConfigVersion_v1: migrateFromV1,
 	config_version.ConfigVersion_v0: migrateFromV0,
 }
 
 // vvvvvvvvvvvvvvvvvvvvvvv REVERSE chronological order so you don't have to scroll forever vvvvvvvvvvvvvvvvvvvv
+func migrateFromV5(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v5.KurtosisConfigV5)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v6.KurtosisClusterConfigV6
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v6.KurtosisClusterConfigV6{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v6.KubernetesClusterConfigV6
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v6.KubernetesClusterConfigV6{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+				}
+			}
+
+			newClusterConfig := &v6.KurtosisClusterConfigV6{
+				Type:                   oldClusterConfig.Type,
+				Config:                 newKubernetesConfig,
+				NetworkingSidecarImage: oldClusterConfig.NetworkingSidecarImage,
+				ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	// Migrate webhooks across
+	var newWebhooks []*v6.WebhookConfigV6
+	for _, oldWebhook := range castedOldConfig.Webhooks {
+		newWebhooks = append(newWebhooks, &v6.WebhookConfigV6{
+			Url:             oldWebhook.Url,
+			Kind:            oldWebhook.Kind,
+			Events:          oldWebhook.Events,
+			PayloadTemplate: oldWebhook.PayloadTemplate,
+		})
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v6.KurtosisConfigV6{
+		ConfigVersion:                  config_version.ConfigVersion_v6,
+		ShouldSendMetrics:              castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:               newClusters,
+		Webhooks:                       newWebhooks,
+		PackageCacheMaxSizeInMegabytes: castedOldConfig.PackageCacheMaxSizeInMegabytes,
+	}
+
+	return newConfig, nil
+}
+
+func migrateFromV4(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v4.KurtosisConfigV4)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v5.KurtosisClusterConfigV5
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v5.KurtosisClusterConfigV5{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v5.KubernetesClusterConfigV5
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v5.KubernetesClusterConfigV5{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+				}
+			}
+
+			newClusterConfig := &v5.KurtosisClusterConfigV5{
+				Type:                   oldClusterConfig.Type,
+				Config:                 newKubernetesConfig,
+				NetworkingSidecarImage: oldClusterConfig.NetworkingSidecarImage,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	// Migrate webhooks across
+	var newWebhooks []*v5.WebhookConfigV5
+	for _, oldWebhook := range castedOldConfig.Webhooks {
+		newWebhooks = append(newWebhooks, &v5.WebhookConfigV5{
+			Url:             oldWebhook.Url,
+			Kind:            oldWebhook.Kind,
+			Events:          oldWebhook.Events,
+			PayloadTemplate: oldWebhook.PayloadTemplate,
+		})
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v5.KurtosisConfigV5{
+		ConfigVersion:                  config_version.ConfigVersion_v5,
+		ShouldSendMetrics:              castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:               newClusters,
+		Webhooks:                       newWebhooks,
+		PackageCacheMaxSizeInMegabytes: nil,
+	}
+
+	return newConfig, nil
+}
+
+func migrateFromV3(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v3.KurtosisConfigV3)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v4.KurtosisClusterConfigV4
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v4.KurtosisClusterConfigV4{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v4.KubernetesClusterConfigV4
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v4.KubernetesClusterConfigV4{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+				}
+			}
+
+			newClusterConfig := &v4.KurtosisClusterConfigV4{
+				Type:                   oldClusterConfig.Type,
+				Config:                 newKubernetesConfig,
+				NetworkingSidecarImage: oldClusterConfig.NetworkingSidecarImage,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v4.KurtosisConfigV4{
+		ConfigVersion:     config_version.ConfigVersion_v4,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+		Webhooks:          nil,
+	}
+
+	return newConfig, nil
+}
+
+func migrateFromV2(uncastedConfig interface{}) (interface{}, error) {
+	// cast "uncastedConfig" to current version we're upgrading from
+	castedOldConfig, ok := uncastedConfig.(*v2.KurtosisConfigV2)
+	if !ok {
+		return nil, stacktrace.NewError(
+			"Failed to cast old configuration '%+v' to expected configuration struct",
+			uncastedConfig,
+		)
+	}
+
+	// Migrate cluster configs across
+	var newClusters map[string]*v3.KurtosisClusterConfigV3
+	if castedOldConfig.KurtosisClusters != nil {
+		newClusters = map[string]*v3.KurtosisClusterConfigV3{}
+		for oldClusterName, oldClusterConfig := range castedOldConfig.KurtosisClusters {
+			oldKubernetesConfig := oldClusterConfig.Config
+
+			var newKubernetesConfig *v3.KubernetesClusterConfigV3
+			if oldKubernetesConfig != nil {
+				newKubernetesConfig = &v3.KubernetesClusterConfigV3{
+					KubernetesClusterName:  oldKubernetesConfig.KubernetesClusterName,
+					StorageClass:           oldKubernetesConfig.StorageClass,
+					EnclaveSizeInMegabytes: oldKubernetesConfig.EnclaveSizeInMegabytes,
+				}
+			}
+
+			newClusterConfig := &v3.KurtosisClusterConfigV3{
+				Type:                   oldClusterConfig.Type,
+				Config:                 newKubernetesConfig,
+				NetworkingSidecarImage: nil,
+			}
+			newClusters[oldClusterName] = newClusterConfig
+		}
+	}
+
+	// create a new configuration object to represent the migrated work
+	newConfig := &v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v3,
+		ShouldSendMetrics: castedOldConfig.ShouldSendMetrics,
+		KurtosisClusters:  newClusters,
+	}
+
+	return newConfig, nil
+}
+
 func migrateFromV1(uncastedConfig interface{}) (interface{}, error) {
 	// cast "uncastedConfig" to current version we're upgrading from
 	castedOldConfig, ok := uncastedConfig.(*v1.KurtosisConfigV1)
@@ -5,6 +5,10 @@ import (
 	v0 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v0"
 	v1 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v1"
 	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
+	v4 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v4"
+	v5 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v5"
+	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
 )
 
 /*
@@ -17,6 +21,31 @@ For an explanation, see the docs on TestKurtosisConfigIsUsingLatestConfigStruct.
 */
 
 var AllConfigVersionEmptyStructs = map[config_version.ConfigVersion]interface{}{
+	config_version.ConfigVersion_v6: &v6.KurtosisConfigV6{
+		ConfigVersion:                  0,
+		ShouldSendMetrics:              nil,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: nil,
+	},
+	config_version.ConfigVersion_v5: &v5.KurtosisConfigV5{
+		ConfigVersion:                  0,
+		ShouldSendMetrics:              nil,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: nil,
+	},
+	config_version.ConfigVersion_v4: &v4.KurtosisConfigV4{
+		ConfigVersion:     0,
+		ShouldSendMetrics: nil,
+		KurtosisClusters:  nil,
+		Webhooks:          nil,
+	},
+	config_version.ConfigVersion_v3: &v3.KurtosisConfigV3{
+		ConfigVersion:     0,
+		ShouldSendMetrics: nil,
+		KurtosisClusters:  nil,
+	},
 	config_version.ConfigVersion_v2: &v2.KurtosisConfigV2{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
@@ -0,0 +1,18 @@
+package v4
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type KurtosisClusterConfigV4 struct {
+	Type *string                      `yaml:"type,omitempty"`
+	// If we ever get another type of cluster that has configuration, this will need to be polymorphically deserialized
+	Config *KubernetesClusterConfigV4 `yaml:"config,omitempty"`
+	// The image to create the networking sidecars from, e.g. a mirror of the default one for air-gapped installs
+	NetworkingSidecarImage *string `yaml:"networking-sidecar-image,omitempty"`
+}
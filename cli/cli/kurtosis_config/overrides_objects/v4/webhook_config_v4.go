@@ -0,0 +1,20 @@
+package v4
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type WebhookConfigV4 struct {
+	Url *string `yaml:"url,omitempty"`
+	// 'slack' or 'generic'
+	Kind *string `yaml:"kind,omitempty"`
+	// The event types sent to the webhook; all of them if empty
+	Events []string `yaml:"events,omitempty"`
+	// Go template rendering the request body from the event
+	PayloadTemplate *string `yaml:"payload-template,omitempty"`
+}
@@ -0,0 +1,20 @@
+package v6
+
+/*
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+                           DO NOT CHANGE THIS FILE!
+  If you change this file, it will break config for users who have instantiated an
+           overrides file with this version of config overrides!
+    Instead, to make changes, you will need to add a new version of the config
+!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! WARNING !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
+*/
+
+type KurtosisClusterConfigV6 struct {
+	Type *string                      `yaml:"type,omitempty"`
+	// If we ever get another type of cluster that has configuration, this will need to be polymorphically deserialized
+	Config *KubernetesClusterConfigV6 `yaml:"config,omitempty"`
+	// The image to create the networking sidecars from, e.g. a mirror of the default one for air-gapped installs
+	NetworkingSidecarImage *string `yaml:"networking-sidecar-image,omitempty"`
+	// Whether a service whose host port is already taken gets an ephemeral host port instead of failing to start
+	ShouldFallBackToEphemeralPortOnHostPortConflict *bool `yaml:"should-fall-back-to-ephemeral-port-on-host-port-conflict,omitempty"`
+}
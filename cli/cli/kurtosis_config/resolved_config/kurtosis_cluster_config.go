@@ -2,7 +2,8 @@ package resolved_config
 
 import (
 	"context"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/remote_context_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
@@ -14,6 +15,8 @@ import (
 
 const (
 	defaultKubernetesEnclaveDataVolumeSizeInMegabytes = uint(1024)
+
+	defaultShouldFallBackToEphemeralPortOnHostPortConflict = false
 )
 
 // Nil because the CLI will never operate in API container mode
@@ -28,7 +31,7 @@ type KurtosisClusterConfig struct {
 	clusterType                         KurtosisClusterType
 }
 
-func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v2.KurtosisClusterConfigV2) (*KurtosisClusterConfig, error) {
+func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v6.KurtosisClusterConfigV6) (*KurtosisClusterConfig, error) {
 	if overrides.Type == nil {
 		return nil, stacktrace.NewError("Kurtosis cluster must have a defined type")
 	}
@@ -44,7 +47,13 @@ func NewKurtosisClusterConfigFromOverrides(clusterId string, overrides *v2.Kurto
 		)
 	}
 
-	backendSupplier, engineBackendConfigSupplier, kurtosisRemoteBackendConfigSupplier, err := getSuppliers(clusterId, clusterType, overrides.Config)
+	backendSupplier, engineBackendConfigSupplier, kurtosisRemoteBackendConfigSupplier, err := getSuppliers(
+		clusterId,
+		clusterType,
+		overrides.Config,
+		overrides.NetworkingSidecarImage,
+		overrides.ShouldFallBackToEphemeralPortOnHostPortConflict,
+	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the suppliers that cluster '%v' will use", clusterId)
 	}
@@ -82,7 +91,7 @@ func (clusterConfig *KurtosisClusterConfig) GetClusterType() KurtosisClusterType
 //	Private Helpers
 //
 // ====================================================================================================
-func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v2.KubernetesClusterConfigV2) (
+func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesConfig *v6.KubernetesClusterConfigV6, networkingSidecarImage *string, shouldFallBackToEphemeralPortOnHostPortConflict *bool) (
 	kurtosisBackendSupplier,
 	engine_server_launcher.KurtosisBackendConfigSupplier,
 	*engine_server_launcher.KurtosisRemoteBackendConfigSupplier,
@@ -114,7 +123,15 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 			return backend, nil
 		}
 
-		engineConfigSupplier = engine_server_launcher.NewDockerKurtosisBackendConfigSupplier()
+		networkingSidecarImageStr := docker_kurtosis_backend.DefaultNetworkingSidecarImage
+		if networkingSidecarImage != nil {
+			networkingSidecarImageStr = *networkingSidecarImage
+		}
+		shouldFallBackToEphemeralPort := defaultShouldFallBackToEphemeralPortOnHostPortConflict
+		if shouldFallBackToEphemeralPortOnHostPortConflict != nil {
+			shouldFallBackToEphemeralPort = *shouldFallBackToEphemeralPortOnHostPortConflict
+		}
+		engineConfigSupplier = engine_server_launcher.NewDockerKurtosisBackendConfigSupplier(shouldFallBackToEphemeralPort, networkingSidecarImageStr)
 	case KurtosisClusterType_Kubernetes:
 		if kubernetesConfig == nil {
 			return nil, nil, nil, stacktrace.NewError(
@@ -123,6 +140,20 @@ func getSuppliers(clusterId string, clusterType KurtosisClusterType, kubernetesC
 				clusterType.String(),
 			)
 		}
+		if networkingSidecarImage != nil {
+			return nil, nil, nil, stacktrace.NewError(
+				"Cluster '%v' defines a networking sidecar image, but networking sidecars aren't supported when cluster type is '%v'",
+				clusterId,
+				clusterType.String(),
+			)
+		}
+		if shouldFallBackToEphemeralPortOnHostPortConflict != nil {
+			return nil, nil, nil, stacktrace.NewError(
+				"Cluster '%v' defines whether to fall back to an ephemeral port on host port conflict, but host ports aren't supported when cluster type is '%v'",
+				clusterId,
+				clusterType.String(),
+			)
+		}
 		if kubernetesConfig.KubernetesClusterName == nil {
 			return nil, nil, nil, stacktrace.NewError(
 				"Type of cluster '%v' is '%v' but has no Kubernetes cluster name in its config map",
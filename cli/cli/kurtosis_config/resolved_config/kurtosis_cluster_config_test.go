@@ -1,15 +1,17 @@
 package resolved_config
 
 import (
-	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
 
 func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
-		Type:   nil,
-		Config: nil,
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   nil,
+		Config:                 nil,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -17,9 +19,11 @@ func TestNewKurtosisClusterConfigEmptyOverrides(t *testing.T) {
 
 func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 	dockerType := KurtosisClusterType_Docker.String()
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
-		Type:   &dockerType,
-		Config: nil,
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &dockerType,
+		Config:                 nil,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
@@ -27,9 +31,11 @@ func TestNewKurtosisClusterConfigDockerType(t *testing.T) {
 
 func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
-		Type:   &kubernetesType,
-		Config: nil,
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &kubernetesType,
+		Config:                 nil,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -37,9 +43,11 @@ func TestNewKurtosisClusterConfigKubernetesNoConfig(t *testing.T) {
 
 func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 	clusterType := "gdsfgsdfvsf"
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
-		Type:   &clusterType,
-		Config: nil,
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &clusterType,
+		Config:                 nil,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -48,14 +56,16 @@ func TestNewKurtosisClusterConfigNonsenseType(t *testing.T) {
 func TestNewKurtosisClusterConfigKubernetesPartialConfig(t *testing.T) {
 	kubernetesType := KurtosisClusterType_Kubernetes.String()
 	kubernetesClusterName := "some-name"
-	kubernetesPartialConfig := v2.KubernetesClusterConfigV2{
+	kubernetesPartialConfig := v6.KubernetesClusterConfigV6{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           nil,
 		EnclaveSizeInMegabytes: nil,
 	}
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
-		Type:   &kubernetesType,
-		Config: &kubernetesPartialConfig,
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &kubernetesType,
+		Config:                 &kubernetesPartialConfig,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.Error(t, err)
@@ -66,15 +76,81 @@ func TestNewKurtosisClusterConfigKubernetesFullConfig(t *testing.T) {
 	kubernetesClusterName := "some-name"
 	kubernetesStorageClass := "some-storage-class"
 	kubernetesEnclaveSizeInMB := uint(5)
-	kubernetesFullConfig := v2.KubernetesClusterConfigV2{
+	kubernetesFullConfig := v6.KubernetesClusterConfigV6{
 		KubernetesClusterName:  &kubernetesClusterName,
 		StorageClass:           &kubernetesStorageClass,
 		EnclaveSizeInMegabytes: &kubernetesEnclaveSizeInMB,
 	}
-	kurtosisClusterConfigOverrides := v2.KurtosisClusterConfigV2{
-		Type:   &kubernetesType,
-		Config: &kubernetesFullConfig,
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &kubernetesType,
+		Config:                 &kubernetesFullConfig,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 	}
 	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
 	require.NoError(t, err)
 }
+
+func TestNewKurtosisClusterConfigDockerTypeWithNetworkingSidecarImage(t *testing.T) {
+	dockerType := KurtosisClusterType_Docker.String()
+	networkingSidecarImage := "registry.example.com/kurtosistech/iproute2"
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &dockerType,
+		Config:                 nil,
+		NetworkingSidecarImage: &networkingSidecarImage,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+}
+
+func TestNewKurtosisClusterConfigKubernetesWithNetworkingSidecarImage(t *testing.T) {
+	kubernetesType := KurtosisClusterType_Kubernetes.String()
+	kubernetesClusterName := "some-name"
+	kubernetesStorageClass := "some-storage-class"
+	networkingSidecarImage := "registry.example.com/kurtosistech/iproute2"
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type: &kubernetesType,
+		Config: &v6.KubernetesClusterConfigV6{
+			KubernetesClusterName:  &kubernetesClusterName,
+			StorageClass:           &kubernetesStorageClass,
+			EnclaveSizeInMegabytes: nil,
+		},
+		NetworkingSidecarImage:                          &networkingSidecarImage,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
+
+func TestNewKurtosisClusterConfigDockerTypeWithEphemeralPortFallback(t *testing.T) {
+	dockerType := KurtosisClusterType_Docker.String()
+	shouldFallBackToEphemeralPort := true
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type:                   &dockerType,
+		Config:                 nil,
+		NetworkingSidecarImage: nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: &shouldFallBackToEphemeralPort,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.NoError(t, err)
+}
+
+func TestNewKurtosisClusterConfigKubernetesWithEphemeralPortFallback(t *testing.T) {
+	kubernetesType := KurtosisClusterType_Kubernetes.String()
+	kubernetesClusterName := "some-name"
+	kubernetesStorageClass := "some-storage-class"
+	shouldFallBackToEphemeralPort := true
+	kurtosisClusterConfigOverrides := v6.KurtosisClusterConfigV6{
+		Type: &kubernetesType,
+		Config: &v6.KubernetesClusterConfigV6{
+			KubernetesClusterName:  &kubernetesClusterName,
+			StorageClass:           &kubernetesStorageClass,
+			EnclaveSizeInMegabytes: nil,
+		},
+		NetworkingSidecarImage:                          nil,
+		ShouldFallBackToEphemeralPortOnHostPortConflict: &shouldFallBackToEphemeralPort,
+	}
+	_, err := NewKurtosisClusterConfigFromOverrides("test", &kurtosisClusterConfigOverrides)
+	require.Error(t, err)
+}
@@ -2,7 +2,9 @@ package resolved_config
 
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v6 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/webhook_notifier"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
@@ -15,6 +17,8 @@ const (
 	defaultMinikubeClusterKubernetesClusterNameStr = "minikube"
 	defaultMinikubeStorageClass                    = "standard"
 	defaultMinikubeEnclaveDataVolumeMB             = uint(10)
+
+	bytesPerMegabyte = uint64(1024 * 1024)
 )
 
 /*
@@ -31,10 +35,13 @@ the overrides on top of the default config.
 */
 type KurtosisConfig struct {
 	// Only necessary to store for when we serialize overrides
-	overrides *v2.KurtosisConfigV2
+	overrides *v6.KurtosisConfigV6
 
 	shouldSendMetrics bool
 	clusters          map[string]*KurtosisClusterConfig
+	webhooks          []*args.WebhookConfig
+	// 0 lets the API containers use their default
+	packageCacheMaxSizeBytes uint64
 }
 
 // NewKurtosisConfigFromOverrides constructs a new KurtosisConfig that uses the given overrides
@@ -47,9 +54,11 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 	}
 
 	config := &KurtosisConfig{
-		overrides:         overrides,
-		shouldSendMetrics: false,
-		clusters:          nil,
+		overrides:                overrides,
+		shouldSendMetrics:        false,
+		clusters:                 nil,
+		webhooks:                 nil,
+		packageCacheMaxSizeBytes: 0,
 	}
 
 	// Get latest config version
@@ -88,19 +97,40 @@ func NewKurtosisConfigFromOverrides(uncastedOverrides interface{}) (*KurtosisCon
 		allClusterConfigs[clusterId] = clusterConfig
 	}
 
+	webhooks := []*args.WebhookConfig{}
+	for _, webhookOverrides := range overrides.Webhooks {
+		webhooks = append(webhooks, newWebhookConfigFromOverrides(webhookOverrides))
+	}
+	// The engine would refuse to start with invalid webhooks, so we catch them while reading the config instead
+	if _, err := webhook_notifier.NewWebhookNotifier(webhooks); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the webhooks")
+	}
+
+	packageCacheMaxSizeBytes := uint64(0)
+	if overrides.PackageCacheMaxSizeInMegabytes != nil {
+		if *overrides.PackageCacheMaxSizeInMegabytes == 0 {
+			return nil, stacktrace.NewError("The package cache max size must be greater than 0 megabytes; remove it from the config to use the default")
+		}
+		packageCacheMaxSizeBytes = *overrides.PackageCacheMaxSizeInMegabytes * bytesPerMegabyte
+	}
+
 	return &KurtosisConfig{
-		overrides:         overrides,
-		shouldSendMetrics: shouldSendMetrics,
-		clusters:          allClusterConfigs,
+		overrides:                overrides,
+		shouldSendMetrics:        shouldSendMetrics,
+		clusters:                 allClusterConfigs,
+		webhooks:                 webhooks,
+		packageCacheMaxSizeBytes: packageCacheMaxSizeBytes,
 	}, nil
 }
 
 // NOTE: We probably want to remove this function entirely
 func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfig, error) {
-	overrides := &v2.KurtosisConfigV2{
-		ConfigVersion:     0,
-		ShouldSendMetrics: &shouldSendMetrics,
-		KurtosisClusters:  nil,
+	overrides := &v6.KurtosisConfigV6{
+		ConfigVersion:                  0,
+		ShouldSendMetrics:              &shouldSendMetrics,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: nil,
 	}
 	result, err := NewKurtosisConfigFromOverrides(overrides)
 	if err != nil {
@@ -111,9 +141,11 @@ func NewKurtosisConfigFromRequiredFields(shouldSendMetrics bool) (*KurtosisConfi
 
 func NewKurtosisConfigWithMetricsSetFromExistingConfig(config *KurtosisConfig, shouldSendMetrics bool) *KurtosisConfig {
 	newConfig := &KurtosisConfig{
-		overrides:         config.overrides,
-		shouldSendMetrics: shouldSendMetrics,
-		clusters:          config.clusters,
+		overrides:                config.overrides,
+		shouldSendMetrics:        shouldSendMetrics,
+		clusters:                 config.clusters,
+		webhooks:                 config.webhooks,
+		packageCacheMaxSizeBytes: config.packageCacheMaxSizeBytes,
 	}
 	newConfig.overrides.ShouldSendMetrics = &shouldSendMetrics
 	return newConfig
@@ -127,7 +159,15 @@ func (kurtosisConfig *KurtosisConfig) GetKurtosisClusters() map[string]*Kurtosis
 	return kurtosisConfig.clusters
 }
 
-func (kurtosisConfig *KurtosisConfig) GetOverrides() *v2.KurtosisConfigV2 {
+func (kurtosisConfig *KurtosisConfig) GetWebhooks() []*args.WebhookConfig {
+	return kurtosisConfig.webhooks
+}
+
+func (kurtosisConfig *KurtosisConfig) GetPackageCacheMaxSizeBytes() uint64 {
+	return kurtosisConfig.packageCacheMaxSizeBytes
+}
+
+func (kurtosisConfig *KurtosisConfig) GetOverrides() *v6.KurtosisConfigV6 {
 	return kurtosisConfig.overrides
 }
 
@@ -137,33 +177,56 @@ func (kurtosisConfig *KurtosisConfig) GetOverrides() *v2.KurtosisConfigV2 {
 //
 // ====================================================================================================
 // This is a separate helper function so that we can use it to ensure that the
-func castUncastedOverrides(uncastedOverrides interface{}) (*v2.KurtosisConfigV2, error) {
-	castedOverrides, ok := uncastedOverrides.(*v2.KurtosisConfigV2)
+func castUncastedOverrides(uncastedOverrides interface{}) (*v6.KurtosisConfigV6, error) {
+	castedOverrides, ok := uncastedOverrides.(*v6.KurtosisConfigV6)
 	if !ok {
 		return nil, stacktrace.NewError("An error occurred casting the uncasted config overrides to the right version")
 	}
 	return castedOverrides, nil
 }
 
-func getDefaultKurtosisClusterConfigOverrides() map[string]*v2.KurtosisClusterConfigV2 {
+func newWebhookConfigFromOverrides(overrides *v6.WebhookConfigV6) *args.WebhookConfig {
+	result := &args.WebhookConfig{
+		Url:             "",
+		Kind:            "",
+		Events:          overrides.Events,
+		PayloadTemplate: "",
+	}
+	if overrides.Url != nil {
+		result.Url = *overrides.Url
+	}
+	if overrides.Kind != nil {
+		result.Kind = *overrides.Kind
+	}
+	if overrides.PayloadTemplate != nil {
+		result.PayloadTemplate = *overrides.PayloadTemplate
+	}
+	return result
+}
+
+func getDefaultKurtosisClusterConfigOverrides() map[string]*v6.KurtosisClusterConfigV6 {
 	dockerClusterType := KurtosisClusterType_Docker.String()
 	minikubeClusterType := KurtosisClusterType_Kubernetes.String()
 	minikubeKubernetesClusterName := defaultMinikubeClusterKubernetesClusterNameStr
 	minikubeStorageClass := defaultMinikubeStorageClass
 	minikubeEnclaveDataVolSizeMB := defaultMinikubeEnclaveDataVolumeMB
 
-	result := map[string]*v2.KurtosisClusterConfigV2{
+	result := map[string]*v6.KurtosisClusterConfigV6{
 		DefaultDockerClusterName: {
-			Type:   &dockerClusterType,
-			Config: nil, // Must be nil for Docker
+			Type:                   &dockerClusterType,
+			Config:                 nil, // Must be nil for Docker
+			NetworkingSidecarImage: nil,
+			ShouldFallBackToEphemeralPortOnHostPortConflict: nil,
 		},
 		defaultMinikubeClusterName: {
 			Type: &minikubeClusterType,
-			Config: &v2.KubernetesClusterConfigV2{
+			Config: &v6.KubernetesClusterConfigV6{
 				KubernetesClusterName:  &minikubeKubernetesClusterName,
 				StorageClass:           &minikubeStorageClass,
 				EnclaveSizeInMegabytes: &minikubeEnclaveDataVolSizeMB,
 			},
+			NetworkingSidecarImage:                          nil, // Must be nil for Kubernetes
+			ShouldFallBackToEphemeralPortOnHostPortConflict: nil, // Must be nil for Kubernetes
 		},
 	}
 
@@ -3,7 +3,7 @@ package resolved_config
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
-	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v6"
 	"github.com/stretchr/testify/require"
 	"sort"
 	"testing"
@@ -50,10 +50,12 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v2.KurtosisConfigV2{
-		ConfigVersion:     0,
-		ShouldSendMetrics: nil,
-		KurtosisClusters:  nil,
+	_, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+		ConfigVersion:                  0,
+		ShouldSendMetrics:              nil,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
@@ -62,10 +64,12 @@ func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
-	originalOverrides := v2.KurtosisConfigV2{
-		ConfigVersion:     version,
-		ShouldSendMetrics: &shouldSendMetrics,
-		KurtosisClusters:  nil,
+	originalOverrides := v6.KurtosisConfigV6{
+		ConfigVersion:                  version,
+		ShouldSendMetrics:              &shouldSendMetrics,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -87,3 +91,77 @@ func TestNewKurtosisConfigOverridesAreLatestVersion(t *testing.T) {
 	// check that overrides are actually the latest version
 	require.Equal(t, latestVersion, overrides.ConfigVersion.String())
 }
+
+func TestNewKurtosisConfigWithWebhooks(t *testing.T) {
+	shouldSendMetrics := true
+	url := "https://hooks.example.com/kurtosis"
+	kind := "slack"
+	config, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+		ConfigVersion:     0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		Webhooks: []*v6.WebhookConfigV6{
+			{
+				Url:             &url,
+				Kind:            &kind,
+				Events:          []string{"run_finished"},
+				PayloadTemplate: nil,
+			},
+		},
+		PackageCacheMaxSizeInMegabytes: nil,
+	})
+	require.NoError(t, err)
+
+	webhooks := config.GetWebhooks()
+	require.Len(t, webhooks, 1)
+	require.Equal(t, url, webhooks[0].Url)
+	require.Equal(t, kind, webhooks[0].Kind)
+	require.Equal(t, []string{"run_finished"}, webhooks[0].Events)
+}
+
+func TestNewKurtosisConfigWithInvalidWebhookEvent(t *testing.T) {
+	shouldSendMetrics := true
+	url := "https://hooks.example.com/kurtosis"
+	_, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+		ConfigVersion:     0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		Webhooks: []*v6.WebhookConfigV6{
+			{
+				Url:             &url,
+				Kind:            nil,
+				Events:          []string{"enclave_exploded"},
+				PayloadTemplate: nil,
+			},
+		},
+		PackageCacheMaxSizeInMegabytes: nil,
+	})
+	require.Error(t, err)
+}
+
+func TestNewKurtosisConfigWithPackageCacheMaxSize(t *testing.T) {
+	shouldSendMetrics := true
+	packageCacheMaxSizeInMegabytes := uint64(512)
+	config, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+		ConfigVersion:                  0,
+		ShouldSendMetrics:              &shouldSendMetrics,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: &packageCacheMaxSizeInMegabytes,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(512*1024*1024), config.GetPackageCacheMaxSizeBytes())
+}
+
+func TestNewKurtosisConfigWithZeroPackageCacheMaxSize(t *testing.T) {
+	shouldSendMetrics := true
+	packageCacheMaxSizeInMegabytes := uint64(0)
+	_, err := NewKurtosisConfigFromOverrides(&v6.KurtosisConfigV6{
+		ConfigVersion:                  0,
+		ShouldSendMetrics:              &shouldSendMetrics,
+		KurtosisClusters:               nil,
+		Webhooks:                       nil,
+		PackageCacheMaxSizeInMegabytes: &packageCacheMaxSizeInMegabytes,
+	})
+	require.Error(t, err)
+}
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/cli_plugins"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/service_exit_code"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -49,9 +51,22 @@ func main() {
 		CallerPrettyfier:          nil,
 	})
 
+	if pluginPath, found := cli_plugins.FindPlugin(commands.RootCmd, os.Args[1:]); found {
+		pluginExitCode, err := cli_plugins.RunPlugin(pluginPath, os.Args[2:])
+		if err != nil {
+			commands.RootCmd.PrintErrln(output_printers.FormatError(fmt.Sprintf("%v %v", errorPrefix, err.Error())))
+		}
+		os.Exit(pluginExitCode)
+	}
+
 	if err := commands.RootCmd.Execute(); err != nil {
+		exitCode := errorExitCode
+		// commands tied to the exit code of a service, e.g. a test runner, exit with that code
+		if serviceExitCodeErr, found := service_exit_code.GetServiceExitCodeError(err); found {
+			exitCode = int(serviceExitCodeErr.GetExitCode())
+		}
 		if !displayErrorMessageToCli(err) {
-			os.Exit(errorExitCode)
+			os.Exit(exitCode)
 		}
 
 		maybeCleanedError := out.GetErrorMessageToBeDisplayedOnCli(err)
@@ -65,7 +80,7 @@ func main() {
 			helpUsageText := fmt.Sprintf("Run '%v --help' for usage.\n", commands.RootCmd.CommandPath())
 			commands.RootCmd.PrintErrf(output_printers.FormatError(helpUsageText))
 		}
-		os.Exit(errorExitCode)
+		os.Exit(exitCode)
 	}
 	os.Exit(successExitCode)
 }
@@ -12,8 +12,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/network_helpers"
 	"github.com/kurtosis-tech/stacktrace"
 	"net"
+	"time"
+)
+
+const (
+	apiCallsStatsLoggingInterval = time.Minute
 )
 
 // TODO Delete this when we split up KurtosisBackend into various parts
@@ -23,6 +29,12 @@ type APIContainerModeArgs struct {
 	Context        context.Context
 	EnclaveID      enclave.EnclaveUUID
 	APIContainerIP net.IP
+
+	// If true, services requesting a host port that's already in use get published on an ephemeral host port instead
+	ShouldFallBackToEphemeralPortOnHostPortConflict bool
+
+	// The image to create the networking sidecars from; if empty, the default one gets used
+	NetworkingSidecarImage string
 }
 
 // GetLocalDockerKurtosisBackend is the entrypoint method we expect users of container-engine-lib to call
@@ -51,7 +63,14 @@ func GetDockerKurtosisBackend(
 	// If running within the API container context, detect the network that the API container is running inside
 	// so, we can create the free IP address trackers
 	enclaveFreeIpAddrTrackers := map[enclave.EnclaveUUID]*free_ip_addr_tracker.FreeIpAddrTracker{}
+	shouldFallBackToEphemeralPortOnHostPortConflict := false
+	networkingSidecarImage := docker_kurtosis_backend.DefaultNetworkingSidecarImage
 	if optionalApiContainerModeArgs != nil {
+		shouldFallBackToEphemeralPortOnHostPortConflict = optionalApiContainerModeArgs.ShouldFallBackToEphemeralPortOnHostPortConflict
+		if optionalApiContainerModeArgs.NetworkingSidecarImage != "" {
+			networkingSidecarImage = optionalApiContainerModeArgs.NetworkingSidecarImage
+		}
+
 		enclaveDb, err := enclave_db.GetOrCreateEnclaveDatabase()
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred opening local database")
@@ -87,9 +106,19 @@ func GetDockerKurtosisBackend(
 			network.GetGatewayIp():  true,
 			apiContainerIp.String(): true,
 		}
+		// Docker gives IPv6 addresses from the lower half of the IPv6 network to the containers that don't get a static
+		// one (the API container, files artifacts expanders, networking sidecars), so services get theirs from the upper half
+		var servicesIpv6Subnet *net.IPNet
+		if networkIpv6AndMask := network.GetIpv6AndMask(); networkIpv6AndMask != nil {
+			_, servicesIpv6Subnet, err = network_helpers.SplitSubnetInHalves(networkIpv6AndMask)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred splitting IPv6 network '%v' of enclave '%v' in halves", networkIpv6AndMask, enclaveUuid)
+			}
+		}
 
 		freeIpAddrProvider, err := free_ip_addr_tracker.GetOrCreateNewFreeIpAddrTracker(
 			network.GetIpAndMask(),
+			servicesIpv6Subnet,
 			alreadyTakenIps,
 			enclaveDb,
 		)
@@ -98,9 +127,12 @@ func GetDockerKurtosisBackend(
 		}
 
 		enclaveFreeIpAddrTrackers[enclaveUuid] = freeIpAddrProvider
+
+		// The API container is what starts services in parallel, so it's where the Docker engine struggling shows up
+		dockerManager.LogApiCallsStatsPeriodically(ctx, apiCallsStatsLoggingInterval)
 	}
 
-	dockerKurtosisBackend := docker_kurtosis_backend.NewDockerKurtosisBackend(dockerManager, enclaveFreeIpAddrTrackers)
+	dockerKurtosisBackend := docker_kurtosis_backend.NewDockerKurtosisBackend(dockerManager, enclaveFreeIpAddrTrackers, shouldFallBackToEphemeralPortOnHostPortConflict, networkingSidecarImage)
 
 	wrappedBackend := metrics_reporting.NewMetricsReportingKurtosisBackend(dockerKurtosisBackend)
 
@@ -14,10 +14,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/host_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
@@ -28,6 +30,15 @@ import (
 	"sync"
 )
 
+var dockerBackendCapabilities = backend_capabilities.NewBackendCapabilities(
+	true,
+	true,
+	true,
+	// Services can't mount volumes that outlive them yet
+	false,
+	true,
+)
+
 type DockerKurtosisBackend struct {
 	dockerManager *docker_manager.DockerManager
 
@@ -53,11 +64,20 @@ type DockerKurtosisBackend struct {
 
 	// Control concurrent access to serviceRegistrations
 	serviceRegistrationMutex *sync.Mutex
+
+	// If true, a service requesting a host port that's already in use gets published on an ephemeral host port (with
+	// a warning) rather than failing to start
+	shouldFallBackToEphemeralPortOnHostPortConflict bool
+
+	// The image the networking sidecars get created from
+	networkingSidecarImage string
 }
 
 func NewDockerKurtosisBackend(
 	dockerManager *docker_manager.DockerManager,
 	enclaveFreeIpProviders map[enclave.EnclaveUUID]*free_ip_addr_tracker.FreeIpAddrTracker,
+	shouldFallBackToEphemeralPortOnHostPortConflict bool,
+	networkingSidecarImage string,
 ) *DockerKurtosisBackend {
 	dockerNetworkAllocator := docker_network_allocator.NewDockerNetworkAllocator(dockerManager)
 	serviceRegistrations := map[enclave.EnclaveUUID]map[service.ServiceUUID]*service.ServiceRegistration{}
@@ -65,15 +85,21 @@ func NewDockerKurtosisBackend(
 		serviceRegistrations[enclaveUuid] = map[service.ServiceUUID]*service.ServiceRegistration{}
 	}
 	return &DockerKurtosisBackend{
-		dockerManager:            dockerManager,
-		dockerNetworkAllocator:   dockerNetworkAllocator,
-		objAttrsProvider:         object_attributes_provider.GetDockerObjectAttributesProvider(),
-		enclaveFreeIpProviders:   enclaveFreeIpProviders,
-		serviceRegistrations:     serviceRegistrations,
-		serviceRegistrationMutex: &sync.Mutex{},
+		dockerManager:                                   dockerManager,
+		dockerNetworkAllocator:                          dockerNetworkAllocator,
+		objAttrsProvider:                                object_attributes_provider.GetDockerObjectAttributesProvider(),
+		enclaveFreeIpProviders:                          enclaveFreeIpProviders,
+		serviceRegistrations:                            serviceRegistrations,
+		serviceRegistrationMutex:                        &sync.Mutex{},
+		shouldFallBackToEphemeralPortOnHostPortConflict: shouldFallBackToEphemeralPortOnHostPortConflict,
+		networkingSidecarImage:                          networkingSidecarImage,
 	}
 }
 
+func (backend *DockerKurtosisBackend) GetBackendCapabilities(_ context.Context) (*backend_capabilities.BackendCapabilities, error) {
+	return dockerBackendCapabilities, nil
+}
+
 func (backend *DockerKurtosisBackend) FetchImage(ctx context.Context, image string) error {
 	err := backend.dockerManager.FetchImage(ctx, image)
 	if err != nil {
@@ -82,6 +108,56 @@ func (backend *DockerKurtosisBackend) FetchImage(ctx context.Context, image stri
 	return nil
 }
 
+func (backend *DockerKurtosisBackend) PullImageWithProgress(ctx context.Context, image string, progressCallback func(pulledBytes uint64, totalBytes uint64)) error {
+	if err := backend.dockerManager.PullImageWithProgress(ctx, image, progressCallback); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pulling image '%v'", image)
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) GetImagesSizeBytes(ctx context.Context, images map[string]bool) (map[string]int64, error) {
+	result := map[string]int64{}
+	for image := range images {
+		sizeBytes, found, err := backend.dockerManager.GetImageSizeBytes(ctx, image)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the size of image '%v'", image)
+		}
+		if !found {
+			continue
+		}
+		result[image] = sizeBytes
+	}
+	return result, nil
+}
+
+func (backend *DockerKurtosisBackend) GetHostResources(ctx context.Context) (*host_resources.HostResources, error) {
+	numCpus, memoryBytes, err := backend.dockerManager.GetHostResources(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resources of the Docker host")
+	}
+	return host_resources.NewHostResources(numCpus, memoryBytes), nil
+}
+
+func (backend *DockerKurtosisBackend) RemoveImages(
+	ctx context.Context,
+	images map[string]bool,
+) (
+	successfulImages map[string]bool,
+	erroredImages map[string]error,
+	resultErr error,
+) {
+	successfulImages = map[string]bool{}
+	erroredImages = map[string]error{}
+	for image := range images {
+		if err := backend.dockerManager.RemoveImage(ctx, image); err != nil {
+			erroredImages[image] = stacktrace.Propagate(err, "An error occurred removing image '%v'", image)
+			continue
+		}
+		successfulImages[image] = true
+	}
+	return successfulImages, erroredImages, nil
+}
+
 func (backend *DockerKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -223,13 +299,50 @@ func (backend *DockerKurtosisBackend) StartRegisteredUserServices(ctx context.Co
 		serviceRegistrationsForEnclave,
 		backend.objAttrsProvider,
 		freeIpAddrProviderForEnclave,
-		backend.dockerManager)
+		backend.dockerManager,
+		backend.shouldFallBackToEphemeralPortOnHostPortConflict)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unexpected error while starting user service")
 	}
 	return successfullyStartedService, failedService, nil
 }
 
+func (backend *DockerKurtosisBackend) ReplaceUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	serviceRegistrationsForEnclave, found := backend.serviceRegistrations[enclaveUuid]
+	if !found {
+		return nil, nil, stacktrace.NewError(
+			"No service registrations are being tracked for enclave '%v'; this likely means that the replace "+
+				"request is being called where it shouldn't be (i.e. outside the API container)",
+			enclaveUuid,
+		)
+	}
+
+	freeIpAddrProviderForEnclave, found := backend.enclaveFreeIpProviders[enclaveUuid]
+	if !found {
+		return nil, nil, stacktrace.NewError(
+			"Received a request to replace services in enclave '%v', but no free IP address provider was "+
+				"defined for this enclave; this likely means that the replace request is being called where it shouldn't "+
+				"be (i.e. outside the API container)",
+			enclaveUuid,
+		)
+	}
+
+	successfullyReplacedServices, failedServices, err := user_service_functions.ReplaceUserServices(
+		ctx,
+		enclaveUuid,
+		services,
+		serviceRegistrationsForEnclave,
+		backend.serviceRegistrationMutex,
+		backend.objAttrsProvider,
+		freeIpAddrProviderForEnclave,
+		backend.dockerManager,
+		backend.shouldFallBackToEphemeralPortOnHostPortConflict)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Unexpected error while replacing user services")
+	}
+	return successfullyReplacedServices, failedServices, nil
+}
+
 func (backend *DockerKurtosisBackend) GetUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -246,12 +359,21 @@ func (backend *DockerKurtosisBackend) GetUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	filters *service.ServiceFilters,
 	shouldFollowLogs bool,
+	timeRange *service.ServiceLogsTimeRange,
 ) (
 	map[service.ServiceUUID]io.ReadCloser,
 	map[service.ServiceUUID]error,
 	error,
 ) {
-	return user_service_functions.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, backend.dockerManager)
+	return user_service_functions.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) GetServiceMetrics(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (*service.ServiceMetrics, error) {
+	return user_service_functions.GetServiceMetrics(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
 }
 
 func (backend *DockerKurtosisBackend) PauseService(
@@ -270,8 +392,57 @@ func (backend *DockerKurtosisBackend) UnpauseService(
 	return user_service_functions.UnpauseService(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
 }
 
-// TODO Switch these to streaming so that huge command outputs don't blow up the API container memory
-// NOTE: This function will block while the exec is ongoing; if we need more perf we can make it async
+func (backend *DockerKurtosisBackend) PauseServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.PauseServices(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) UnpauseServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return user_service_functions.UnpauseServices(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) RestartService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) error {
+	return user_service_functions.RestartService(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) WaitForServiceExit(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (int32, error) {
+	return user_service_functions.WaitForServiceExit(ctx, enclaveUuid, serviceUuid, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) SendSignalToService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	signal string,
+) error {
+	return user_service_functions.SendSignalToService(ctx, enclaveUuid, serviceUuid, signal, backend.dockerManager)
+}
+
+// NOTE: This function buffers the outputs in memory; use RunUserServiceExecCommandWithStreamedOutput for commands with
+// huge outputs. It will block while the exec is ongoing; if we need more perf we can make it async
 func (backend *DockerKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -284,6 +455,19 @@ func (backend *DockerKurtosisBackend) RunUserServiceExecCommands(
 	return user_service_functions.RunUserServiceExecCommands(ctx, enclaveUuid, userServiceCommands, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	command []string,
+	output io.Writer,
+) (
+	int32,
+	error,
+) {
+	return user_service_functions.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, command, output, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) GetConnectionWithUserService(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -306,6 +490,16 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+) error {
+	return user_service_functions.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnContainer, tarContent, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -0,0 +1,136 @@
+package docker_kurtosis_backend
+
+import (
+	"archive/tar"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// Must match the directory that the API container writes its crash diagnostics to, inside the enclave data volume
+	apiContainerCrashDiagnosticsDirname = "crash-diagnostics"
+
+	extractedCrashDiagnosticsDirPerms  = 0755
+	extractedCrashDiagnosticsFilePerms = 0644
+)
+
+// dumpApiContainersCrashDiagnostics copies the crash diagnostics that the API containers of the enclave wrote to the
+// enclave data volume next to the rest of their dumped info. The containers don't need to be running, so the diagnostics
+// of an API container that crashed or got OOM-killed can still be collected
+func (backend *DockerKurtosisBackend) dumpApiContainersCrashDiagnostics(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveContainers []*types.Container,
+	outputDirpath string,
+) error {
+	enclaveDataVolumeName, err := backend.getEnclaveDataVolumeByEnclaveUuid(ctx, enclaveUuid)
+	if err != nil {
+		// The rest of the dump is still useful, e.g. if the volume got removed by hand
+		logrus.Warnf("Couldn't get the enclave data volume of enclave '%v', so the crash diagnostics of its API container won't be dumped:\n%v", enclaveUuid, err)
+		return nil
+	}
+	for _, container := range enclaveContainers {
+		if container.GetLabels()[label_key_consts.ContainerTypeDockerLabelKey.GetString()] != label_value_consts.APIContainerContainerTypeDockerLabelValue.GetString() {
+			continue
+		}
+		if err := backend.dumpApiContainerCrashDiagnostics(ctx, container, enclaveDataVolumeName, outputDirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred dumping the crash diagnostics of API container '%v'", container.GetName())
+		}
+	}
+	return nil
+}
+
+func (backend *DockerKurtosisBackend) dumpApiContainerCrashDiagnostics(
+	ctx context.Context,
+	container *types.Container,
+	enclaveDataVolumeName string,
+	outputDirpath string,
+) error {
+	inspectResult, err := backend.dockerManager.InspectContainer(ctx, container.GetId())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting API container with ID '%v'", container.GetId())
+	}
+	enclaveDataVolumeDirpath := ""
+	for _, mount := range inspectResult.Mounts {
+		if mount.Name == enclaveDataVolumeName {
+			enclaveDataVolumeDirpath = mount.Destination
+			break
+		}
+	}
+	if enclaveDataVolumeDirpath == "" {
+		logrus.Debugf("API container '%v' doesn't mount the enclave data volume '%v'; no crash diagnostics to dump", container.GetName(), enclaveDataVolumeName)
+		return nil
+	}
+
+	crashDiagnosticsDirpath := path.Join(enclaveDataVolumeDirpath, apiContainerCrashDiagnosticsDirname)
+	tarStreamReadCloser, err := backend.dockerManager.CopyFromContainer(ctx, container.GetId(), crashDiagnosticsDirpath)
+	if err != nil {
+		// API containers started before crash diagnostics were recorded don't have the directory
+		logrus.Debugf("Couldn't copy the crash diagnostics of API container '%v', so they won't be dumped:\n%v", container.GetName(), err)
+		return nil
+	}
+	defer tarStreamReadCloser.Close()
+
+	// The TAR entries are prefixed by the name of the directory, so this creates it inside the output of the container
+	containerOutputDirpath := path.Join(outputDirpath, container.GetName())
+	if err := extractTarStream(tarStreamReadCloser, containerOutputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred extracting the crash diagnostics of API container '%v' to '%v'", container.GetName(), containerOutputDirpath)
+	}
+	return nil
+}
+
+// extractTarStream extracts the directories and regular files of the TAR stream into the destination directory, which
+// must exist
+func extractTarStream(tarStream io.Reader, destDirpath string) error {
+	tarReader := tar.NewReader(tarStream)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the next entry of the TAR stream")
+		}
+		entryFilepath := filepath.Join(destDirpath, header.Name)
+		if !strings.HasPrefix(entryFilepath, filepath.Clean(destDirpath)+string(os.PathSeparator)) {
+			return stacktrace.NewError("TAR entry '%v' would be extracted outside of '%v'", header.Name, destDirpath)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(entryFilepath, extractedCrashDiagnosticsDirPerms); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating directory '%v'", entryFilepath)
+			}
+		case tar.TypeReg:
+			if err := extractTarFile(tarReader, entryFilepath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred extracting TAR entry '%v' to '%v'", header.Name, entryFilepath)
+			}
+		default:
+			logrus.Debugf("Skipping TAR entry '%v' as it's neither a directory nor a regular file", header.Name)
+		}
+	}
+}
+
+func extractTarFile(tarReader *tar.Reader, destFilepath string) error {
+	if err := os.MkdirAll(filepath.Dir(destFilepath), extractedCrashDiagnosticsDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the parent directory of '%v'", destFilepath)
+	}
+	destFile, err := os.OpenFile(destFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, extractedCrashDiagnosticsFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating file '%v'", destFilepath)
+	}
+	defer destFile.Close()
+	if _, err := io.Copy(destFile, tarReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing file '%v'", destFilepath)
+	}
+	return nil
+}
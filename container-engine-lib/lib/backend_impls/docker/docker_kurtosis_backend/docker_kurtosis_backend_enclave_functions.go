@@ -2,6 +2,7 @@ package docker_kurtosis_backend
 
 import (
 	"context"
+	"fmt"
 	docker_types "github.com/docker/docker/api/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
@@ -11,8 +12,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/teardown_progress"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"net"
 	"strings"
 	"time"
 )
@@ -21,6 +24,13 @@ const (
 	shouldFetchStoppedContainersWhenGettingEnclaveStatus = true
 
 	shouldFetchStoppedContainersWhenDumpingEnclave = true
+
+	shouldFetchStoppedContainersWhenCheckingForLeaks = true
+
+	// Used in the creation context of leaked resources that don't say what kind of resource they are
+	unknownLeakedResourceKind = "unknown"
+
+	bytesInMegabyte = 1024 * 1024
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
@@ -32,7 +42,7 @@ type matchingNetworkInformation struct {
 	containers    []*types.Container
 }
 
-func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
+func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, scheduleName string, isPartitioningEnabled bool, excludedSubnets []*net.IPNet, subnetSpec *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error) {
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 
 	searchNetworkLabels := map[string]string{
@@ -68,7 +78,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 
 	creationTime := time.Now()
 
-	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, creationTime, isPartitioningEnabled)
+	enclaveNetworkAttrs, err := enclaveObjAttrsProvider.ForEnclaveNetwork(enclaveName, scheduleName, creationTime, isPartitioningEnabled)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -89,10 +99,12 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 	}
 
 	logrus.Debugf("Creating Docker network for enclave '%v'...", enclaveUuid)
-	networkId, err := backend.dockerNetworkAllocator.CreateNewNetwork(
+	networkId, networkSubnet, err := backend.dockerNetworkAllocator.CreateNewNetwork(
 		ctx,
 		enclaveNetworkName.GetString(),
 		enclaveNetworkLabels,
+		excludedSubnets,
+		subnetSpec,
 	)
 	if err != nil {
 		// TODO If the user Ctrl-C's while the CreateNetwork call is ongoing then the CreateNetwork will error saying
@@ -140,7 +152,7 @@ func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclave
 		}
 	}()
 
-	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime)
+	newEnclave := enclave.NewEnclave(enclaveUuid, enclaveName, enclave.EnclaveStatus_Empty, &creationTime, networkSubnet, scheduleName)
 
 	shouldDeleteNetwork = false
 	shouldDeleteVolume = false
@@ -176,6 +188,8 @@ func (backend *DockerKurtosisBackend) GetEnclaves(
 			enclaveName,
 			matchingNetworkInfo.enclaveStatus,
 			creationTime,
+			matchingNetworkInfo.dockerNetwork.GetIpAndMask(),
+			getEnclaveScheduleNameFromNetwork(matchingNetworkInfo.dockerNetwork),
 		)
 	}
 
@@ -200,16 +214,21 @@ func (backend *DockerKurtosisBackend) StopEnclaves(
 	// For all the enclaves to stop, gather all the containers that should be stopped
 	enclaveUuidsForContainerIdsToStop := map[string]enclave.EnclaveUUID{}
 	containerIdsToStop := map[string]bool{}
+	containerNamesById := map[string]string{}
 	for enclaveUuid, networkInfo := range matchingNetworkInfo {
 		for _, container := range networkInfo.containers {
 			containerId := container.GetId()
 			enclaveUuidsForContainerIdsToStop[containerId] = enclaveUuid
 			containerIdsToStop[containerId] = true
+			containerNamesById[containerId] = container.GetName()
 		}
 	}
 
+	progressTracker := teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Container, len(containerIdsToStop))
 	var stopEnclaveContainerOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
-		if err := dockerManager.KillContainer(ctx, dockerObjectId); err != nil {
+		err := dockerManager.KillContainer(ctx, dockerObjectId)
+		progressTracker.MarkHandled(containerNamesById[dockerObjectId], err)
+		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred killing enclave container with ID '%v'", dockerObjectId)
 		}
 		return nil
@@ -258,6 +277,92 @@ func (backend *DockerKurtosisBackend) StopEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+func (backend *DockerKurtosisBackend) GetEnclavesResourceUsage(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage,
+	error,
+) {
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave network info using filters '%+v'", filters)
+	}
+
+	// Computed once for all the enclaves, as Docker computes it for all its objects anyway
+	volumesDiskUsageBytes, err := backend.dockerManager.GetVolumesDiskUsageBytes(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the disk usage of the Docker volumes, so the disk usage of the enclaves will be unknown:\n%v", err)
+		volumesDiskUsageBytes = nil
+	}
+
+	result := map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage{}
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		numServices := uint32(0)
+		totalMemoryAllocationBytes := int64(0)
+		numPublishedPorts := uint32(0)
+		for _, container := range networkInfo.containers {
+			containerType, found := container.GetLabels()[label_key_consts.ContainerTypeDockerLabelKey.GetString()]
+			if !found || containerType != label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString() {
+				continue
+			}
+			numServices++
+			for _, hostPortBinding := range container.GetHostPortBindings() {
+				if hostPortBinding != nil && hostPortBinding.HostPort != "" {
+					numPublishedPorts++
+				}
+			}
+			containerInfo, err := backend.dockerManager.InspectContainer(ctx, container.GetId())
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred inspecting container '%v' of enclave '%v' to get its memory allocation", container.GetId(), enclaveUuid)
+			}
+			if containerInfo.HostConfig != nil {
+				totalMemoryAllocationBytes += containerInfo.HostConfig.Memory
+			}
+		}
+
+		diskUsageBytes, err := backend.getEnclaveVolumesDiskUsageBytes(ctx, enclaveUuid, volumesDiskUsageBytes)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the disk usage of the volumes of enclave '%v'", enclaveUuid)
+		}
+
+		result[enclaveUuid] = enclave.NewEnclaveResourceUsage(
+			numServices,
+			uint64(totalMemoryAllocationBytes/bytesInMegabyte),
+			numPublishedPorts,
+			diskUsageBytes,
+		)
+	}
+	return result, nil
+}
+
+func (backend *DockerKurtosisBackend) GetEnclavesPulledImages(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]map[string]bool,
+	error,
+) {
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave network info using filters '%+v'", filters)
+	}
+
+	result := map[enclave.EnclaveUUID]map[string]bool{}
+	for enclaveUuid, networkInfo := range matchingNetworkInfo {
+		pulledImages := map[string]bool{}
+		for _, container := range networkInfo.containers {
+			pulledImage, found := container.GetLabels()[label_key_consts.PulledImageDockerLabelKey.GetString()]
+			if !found {
+				continue
+			}
+			pulledImages[pulledImage] = true
+		}
+		result[enclaveUuid] = pulledImages
+	}
+	return result, nil
+}
+
 func (backend *DockerKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -282,6 +387,10 @@ func (backend *DockerKurtosisBackend) DumpEnclave(
 		return err
 	}
 
+	if err = backend.dumpApiContainersCrashDiagnostics(ctx, enclaveUuid, enclaveContainers, outputDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the crash diagnostics of the API container of enclave '%v'", enclaveUuid)
+	}
+
 	return nil
 }
 
@@ -327,6 +436,9 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 	}
 	for enclaveUuid, containerRemovalErr := range erroredContainerRemovalEnclaveUuids {
 		erroredEnclaveUuids[enclaveUuid] = containerRemovalErr
+		skippedCause := stacktrace.NewError("Not removed because some containers of enclave '%v' couldn't be removed", enclaveUuid)
+		reportSkippedEnclaveVolumes(ctx, backend.dockerManager, enclaveUuid, skippedCause)
+		teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Network, 0).MarkSkipped(matchingNetworkInfo[enclaveUuid].dockerNetwork.GetId(), skippedCause)
 	}
 
 	successfulVolumeRemovalEnclaveUuids, erroredVolumeRemovalEnclaveUuids, err := destroyVolumesInEnclaves(ctx, backend.dockerManager, successfulContainerRemovalEnclaveUuids)
@@ -335,6 +447,8 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 	}
 	for enclaveUuid, volumeRemovalErr := range erroredVolumeRemovalEnclaveUuids {
 		erroredEnclaveUuids[enclaveUuid] = volumeRemovalErr
+		skippedCause := stacktrace.NewError("Not removed because some volumes of enclave '%v' couldn't be removed", enclaveUuid)
+		teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Network, 0).MarkSkipped(matchingNetworkInfo[enclaveUuid].dockerNetwork.GetId(), skippedCause)
 	}
 
 	// Remove the networks
@@ -357,6 +471,71 @@ func (backend *DockerKurtosisBackend) DestroyEnclaves(
 	return successfulNetworkRemovalEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+func (backend *DockerKurtosisBackend) GetLeakedEnclaveResources(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (
+	[]*enclave.LeakedEnclaveResource,
+	error,
+) {
+	enclaveSearchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+	}
+
+	result := []*enclave.LeakedEnclaveResource{}
+
+	containers, err := backend.dockerManager.GetContainersByLabels(ctx, enclaveSearchLabels, shouldFetchStoppedContainersWhenCheckingForLeaks)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the containers left in enclave '%v'", enclaveUuid)
+	}
+	for _, container := range containers {
+		creationContext := fmt.Sprintf(
+			"%v container created at %v from image '%v', with status '%v'",
+			getLeakedResourceKind(container.GetLabels(), label_key_consts.ContainerTypeDockerLabelKey.GetString()),
+			container.GetCreationTime().Format(time.RFC3339),
+			container.GetImageName(),
+			container.GetStatus(),
+		)
+		result = append(result, enclave.NewLeakedEnclaveResource(enclave.LeakedEnclaveResourceType_Container, container.GetId(), container.GetName(), creationContext))
+	}
+
+	volumes, err := backend.dockerManager.GetVolumesByLabels(ctx, enclaveSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the volumes left in enclave '%v'", enclaveUuid)
+	}
+	for _, volume := range volumes {
+		creationContext := fmt.Sprintf(
+			"%v volume created at %v",
+			getLeakedResourceKind(volume.Labels, label_key_consts.VolumeTypeDockerLabelKey.GetString()),
+			volume.CreatedAt,
+		)
+		result = append(result, enclave.NewLeakedEnclaveResource(enclave.LeakedEnclaveResourceType_Volume, volume.Name, volume.Name, creationContext))
+	}
+
+	networks, err := backend.dockerManager.GetNetworksByLabels(ctx, enclaveSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networks left in enclave '%v'", enclaveUuid)
+	}
+	for _, network := range networks {
+		creationContext := fmt.Sprintf("enclave network with subnet '%v'", network.GetIpAndMask())
+		if enclaveCreationTime, err := getEnclaveCreationTimeFromNetwork(network); err == nil && enclaveCreationTime != nil {
+			creationContext = fmt.Sprintf("%v, created with the enclave at %v", creationContext, enclaveCreationTime.Format(time.RFC3339))
+		}
+		result = append(result, enclave.NewLeakedEnclaveResource(enclave.LeakedEnclaveResourceType_Network, network.GetId(), network.GetName(), creationContext))
+	}
+
+	// Only the backend of the API container of the enclave registers IP addresses, so elsewhere there are none to leak
+	backend.serviceRegistrationMutex.Lock()
+	defer backend.serviceRegistrationMutex.Unlock()
+	for serviceUuid, registration := range backend.serviceRegistrations[enclaveUuid] {
+		creationContext := fmt.Sprintf("IP address registered to service '%v' with UUID '%v'", registration.GetName(), serviceUuid)
+		result = append(result, enclave.NewLeakedEnclaveResource(enclave.LeakedEnclaveResourceType_IpAddrRegistration, registration.GetPrivateIP().String(), string(registration.GetName()), creationContext))
+	}
+
+	return result, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -422,6 +601,36 @@ func (backend *DockerKurtosisBackend) getMatchingEnclaveNetworkInfo(
 	return result, nil
 }
 
+// A nil volumesDiskUsageBytes means the disk usage is unknown
+func (backend *DockerKurtosisBackend) getEnclaveVolumesDiskUsageBytes(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	volumesDiskUsageBytes map[string]int64,
+) (int64, error) {
+	if volumesDiskUsageBytes == nil {
+		return enclave.UnknownDiskUsageBytes, nil
+	}
+
+	volumeSearchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+	}
+	enclaveVolumes, err := backend.dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the volumes of enclave '%v' using labels '%+v'", enclaveUuid, volumeSearchLabels)
+	}
+
+	totalDiskUsageBytes := int64(0)
+	for _, volume := range enclaveVolumes {
+		volumeDiskUsageBytes, found := volumesDiskUsageBytes[volume.Name]
+		if !found {
+			return enclave.UnknownDiskUsageBytes, nil
+		}
+		totalDiskUsageBytes += volumeDiskUsageBytes
+	}
+	return totalDiskUsageBytes, nil
+}
+
 func (backend *DockerKurtosisBackend) getEnclaveStatusAndContainers(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -508,16 +717,21 @@ func destroyContainersInEnclaves(
 	// For all the enclaves to destroy, gather all the containers that should be destroyed
 	enclaveUuidsForContainerIdsToRemove := map[string]enclave.EnclaveUUID{}
 	containerIdsToRemove := map[string]bool{}
+	containerNamesById := map[string]string{}
 	for enclaveUuid, networkInfo := range enclaves {
 		for _, container := range networkInfo.containers {
 			containerId := container.GetId()
 			enclaveUuidsForContainerIdsToRemove[containerId] = enclaveUuid
 			containerIdsToRemove[containerId] = true
+			containerNamesById[containerId] = container.GetName()
 		}
 	}
 
+	progressTracker := teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Container, len(containerIdsToRemove))
 	var removeEnclaveContainerOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
-		if err := dockerManager.RemoveContainer(ctx, dockerObjectId); err != nil {
+		err := dockerManager.RemoveContainer(ctx, dockerObjectId)
+		progressTracker.MarkHandled(containerNamesById[dockerObjectId], err)
+		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing enclave container with ID '%v'", dockerObjectId)
 		}
 		return nil
@@ -589,8 +803,12 @@ func destroyVolumesInEnclaves(
 		}
 	}
 
+	progressTracker := teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Volume, len(volumeIdsToRemove))
 	var removeEnclaveVolumeOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
-		if err := dockerManager.RemoveVolume(ctx, dockerObjectId); err != nil {
+		// Volume IDs are their names
+		err := dockerManager.RemoveVolume(ctx, dockerObjectId)
+		progressTracker.MarkHandled(dockerObjectId, err)
+		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing enclave volume with ID '%v'", dockerObjectId)
 		}
 		return nil
@@ -637,6 +855,24 @@ func destroyVolumesInEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// reportSkippedEnclaveVolumes is best-effort, as it only serves to tell the user what's left behind
+func reportSkippedEnclaveVolumes(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	enclaveUuid enclave.EnclaveUUID,
+	cause error,
+) {
+	enclaveVolumes, err := getAllEnclaveVolumes(ctx, dockerManager, enclaveUuid)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the volumes of enclave '%v' to report them as left behind:\n%v", enclaveUuid, err)
+		return
+	}
+	progressTracker := teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Volume, 0)
+	for _, volume := range enclaveVolumes {
+		progressTracker.MarkSkipped(volume.Name, cause)
+	}
+}
+
 func destroyEnclaveNetworks(
 	ctx context.Context,
 	dockerManager *docker_manager.DockerManager,
@@ -653,8 +889,11 @@ func destroyEnclaveNetworks(
 		enclaveUuidsForNetworkIds[networkId] = enclaveUuid
 	}
 
+	progressTracker := teardown_progress.NewTracker(ctx, teardown_progress.ResourceType_Network, len(networkIdsToRemove))
 	var removeNetworkOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
-		if err := dockerManager.RemoveNetwork(ctx, dockerObjectId); err != nil {
+		err := dockerManager.RemoveNetwork(ctx, dockerObjectId)
+		progressTracker.MarkHandled(dockerObjectId, err)
+		if err != nil {
 			return stacktrace.Propagate(err, "An error occurred removing enclave network with ID '%v'", dockerObjectId)
 		}
 		return nil
@@ -688,6 +927,16 @@ func destroyEnclaveNetworks(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// getLeakedResourceKind returns the kind of resource (e.g. the container type) from the given labels, so that a leak
+// report tells e.g. an API container apart from a user service one
+func getLeakedResourceKind(labels map[string]string, kindLabelKey string) string {
+	kind, found := labels[kindLabelKey]
+	if !found || kind == "" {
+		return unknownLeakedResourceKind
+	}
+	return kind
+}
+
 func getEnclaveUuidFromNetwork(network *types.Network) (enclave.EnclaveUUID, error) {
 	labels := network.GetLabels()
 	enclaveUuidLabelValue, found := labels[label_key_consts.EnclaveUUIDDockerLabelKey.GetString()]
@@ -728,3 +977,13 @@ func getEnclaveNameFromNetwork(network *types.Network) string {
 
 	return enclaveNameStr
 }
+
+func getEnclaveScheduleNameFromNetwork(network *types.Network) string {
+	labels := network.GetLabels()
+	// Only the enclaves created by a run of an enclave schedule have the label
+	scheduleNameStr, found := labels[label_key_consts.EnclaveScheduleDockerLabelKey.GetString()]
+	if !found {
+		return ""
+	}
+	return scheduleNameStr
+}
@@ -20,8 +20,11 @@ import (
 )
 
 const (
-	networkingSidecarImageName = "kurtosistech/iproute2"
-	skipAddingToBridgeNetwork  = true
+	// DefaultNetworkingSidecarImage is the image used for the networking sidecars unless a different one gets
+	// configured, e.g. to use a mirror of it in air-gapped installs
+	DefaultNetworkingSidecarImage = "kurtosistech/iproute2"
+
+	skipAddingToBridgeNetwork = true
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
@@ -95,7 +98,7 @@ func (backend *DockerKurtosisBackend) CreateNetworkingSidecar(
 	}()
 
 	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
-		networkingSidecarImageName,
+		backend.networkingSidecarImage,
 		containerName.GetString(),
 		enclaveNetwork.GetId(),
 	).WithAlias(
@@ -115,8 +118,8 @@ func (backend *DockerKurtosisBackend) CreateNetworkingSidecar(
 	).Build()
 
 	// Best-effort pull attempt
-	if err = backend.dockerManager.PullImage(ctx, networkingSidecarImageName); err != nil {
-		logrus.Warnf("Failed to pull the latest version of networking sidecar container image '%v'; you may be running an out-of-date version", networkingSidecarImageName)
+	if err = backend.dockerManager.PullImage(ctx, backend.networkingSidecarImage); err != nil {
+		logrus.Warnf("Failed to pull the latest version of networking sidecar container image '%v'; you may be running an out-of-date version", backend.networkingSidecarImage)
 	}
 
 	containerId, _, err := backend.dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
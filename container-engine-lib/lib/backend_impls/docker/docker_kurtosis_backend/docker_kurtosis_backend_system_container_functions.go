@@ -0,0 +1,101 @@
+package docker_kurtosis_backend
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/system_container"
+	"github.com/kurtosis-tech/stacktrace"
+	"net"
+	"sort"
+)
+
+const (
+	shouldShowStoppedSystemContainers = true
+)
+
+// GetEnclaveSystemContainers returns the containers Kurtosis runs for the enclave, i.e. all the containers of the enclave
+// but the user services, along with the engine-wide containers the enclave relies on: the engine and the logs database
+func (backend *DockerKurtosisBackend) GetEnclaveSystemContainers(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*system_container.SystemContainer, error) {
+	enclaveContainers, err := backend.dockerManager.GetContainersByLabels(
+		ctx,
+		map[string]string{
+			label_key_consts.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+			label_key_consts.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		},
+		shouldShowStoppedSystemContainers,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the containers of enclave '%v'", enclaveUuid)
+	}
+	containers := []*types.Container{}
+	for _, container := range enclaveContainers {
+		if container.GetLabels()[label_key_consts.ContainerTypeDockerLabelKey.GetString()] == label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString() {
+			continue
+		}
+		containers = append(containers, container)
+	}
+	for _, engineWideContainerType := range []string{
+		label_value_consts.EngineContainerTypeDockerLabelValue.GetString(),
+		label_value_consts.LogsDatabaseTypeDockerLabelValue.GetString(),
+	} {
+		engineWideContainers, err := backend.dockerManager.GetContainersByLabels(
+			ctx,
+			map[string]string{
+				label_key_consts.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+				label_key_consts.ContainerTypeDockerLabelKey.GetString(): engineWideContainerType,
+			},
+			shouldShowStoppedSystemContainers,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the containers of type '%v'", engineWideContainerType)
+		}
+		containers = append(containers, engineWideContainers...)
+	}
+
+	systemContainers := []*system_container.SystemContainer{}
+	for _, container := range containers {
+		systemContainer, err := getSystemContainerFromContainer(container)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the system container object of container '%v'", container.GetName())
+		}
+		systemContainers = append(systemContainers, systemContainer)
+	}
+	sort.Slice(systemContainers, func(i, j int) bool {
+		if systemContainers[i].GetKind() != systemContainers[j].GetKind() {
+			return systemContainers[i].GetKind() < systemContainers[j].GetKind()
+		}
+		return systemContainers[i].GetName() < systemContainers[j].GetName()
+	})
+	return systemContainers, nil
+}
+
+func getSystemContainerFromContainer(container *types.Container) (*system_container.SystemContainer, error) {
+	isContainerRunning, found := consts.IsContainerRunningDeterminer[container.GetStatus()]
+	if !found {
+		// This should never happen because we enforce completeness in a unit test
+		return nil, stacktrace.NewError("No is-running designation found for container status '%v'; this is a bug in Kurtosis!", container.GetStatus().String())
+	}
+	status := container_status.ContainerStatus_Stopped
+	if isContainerRunning {
+		status = container_status.ContainerStatus_Running
+	}
+
+	publishedPorts := map[string]string{}
+	for privatePort, hostPortBinding := range container.GetHostPortBindings() {
+		publishedPorts[string(privatePort)] = net.JoinHostPort(hostPortBinding.HostIP, hostPortBinding.HostPort)
+	}
+
+	return system_container.NewSystemContainer(
+		container.GetLabels()[label_key_consts.ContainerTypeDockerLabelKey.GetString()],
+		container.GetName(),
+		container.GetImageName(),
+		status,
+		container.GetCreationTime(),
+		publishedPorts,
+	), nil
+}
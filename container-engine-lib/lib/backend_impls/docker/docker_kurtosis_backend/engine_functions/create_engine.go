@@ -20,6 +20,9 @@ import (
 const (
 	maxWaitForEngineAvailabilityRetries         = 10
 	timeBetweenWaitForEngineAvailabilityRetries = 1 * time.Second
+
+	// The engine reaches the API containers through the ports they publish on the host machine
+	needsAccessToDockerHostMachine = true
 )
 
 func CreateEngine(
@@ -92,6 +95,24 @@ func CreateEngine(
 		privateGrpcProxyDockerPort: docker_manager.NewManualPublishingSpec(grpcProxyPortNum),
 	}
 
+	engineDataVolumeAttrs, err := objAttrsProvider.ForEngineDataVolume()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine data volume attributes")
+	}
+	engineDataVolumeName := engineDataVolumeAttrs.GetName().GetString()
+	engineDataVolumeLabelStrs := map[string]string{}
+	for labelKey, labelValue := range engineDataVolumeAttrs.GetLabels() {
+		engineDataVolumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	// Docker reuses the volume if it already exists, which is what lets the engine keep its state across restarts, so
+	// its creation isn't undone on failure either
+	if err := dockerManager.CreateVolume(ctx, engineDataVolumeName, engineDataVolumeLabelStrs); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating engine data volume '%v'", engineDataVolumeName)
+	}
+	volumeMounts := map[string]string{
+		engineDataVolumeName: engine.EngineDataDirpath,
+	}
+
 	bindMounts := map[string]string{
 		// Necessary so that the engine server can interact with the Docker engine
 		consts.DockerSocketFilepath: consts.DockerSocketFilepath,
@@ -122,10 +143,14 @@ func CreateEngine(
 		envVars,
 	).WithBindMounts(
 		bindMounts,
+	).WithVolumeMounts(
+		volumeMounts,
 	).WithUsedPorts(
 		usedPorts,
 	).WithLabels(
 		labelStrs,
+	).NeedsAccessToDockerHostMachine(
+		needsAccessToDockerHostMachine,
 	).Build()
 
 	// Best-effort pull attempt
@@ -58,6 +58,9 @@ const (
 // E.g. only used by start user services functions thus could go in start_user_services.go
 
 // Unfortunately, Docker doesn't have an enum for the protocols it supports, so we have to create this translation map
+// Docker services always run as a single container
+var noReplicaPrivateIps []net.IP
+
 var portSpecProtosToDockerPortProtos = map[port_spec.TransportProtocol]string{
 	port_spec.TransportProtocol_TCP:  "tcp",
 	port_spec.TransportProtocol_SCTP: "sctp",
@@ -219,12 +222,14 @@ func TransformPortSpecToDockerPort(portSpec *port_spec.PortSpec) (nat.Port, erro
 }
 
 // TODO Extract this to DockerKurtosisBackend and use it everywhere, for Engines and API containers?
+// The private IPv6 is nil if the container didn't get one, which is the case unless the enclave has IPv6 enabled
 func GetIpAndPortInfoFromContainer(
 	containerName string,
 	labels map[string]string,
 	hostMachinePortBindings map[nat.Port]*nat.PortBinding,
 ) (
 	resultPrivateIp net.IP,
+	resultPrivateIpv6 net.IP,
 	resultPrivatePortSpecs map[string]*port_spec.PortSpec,
 	resultPublicIp net.IP,
 	resultPublicPortSpecs map[string]*port_spec.PortSpec,
@@ -232,16 +237,24 @@ func GetIpAndPortInfoFromContainer(
 ) {
 	privateIpAddrStr, found := labels[label_key_consts.PrivateIPDockerLabelKey.GetString()]
 	if !found {
-		return nil, nil, nil, nil, stacktrace.NewError("Expected to find label '%v' on container '%v' but label was missing", label_key_consts.PrivateIPDockerLabelKey.GetString(), containerName)
+		return nil, nil, nil, nil, nil, stacktrace.NewError("Expected to find label '%v' on container '%v' but label was missing", label_key_consts.PrivateIPDockerLabelKey.GetString(), containerName)
 	}
 	privateIp := net.ParseIP(privateIpAddrStr)
 	if privateIp == nil {
-		return nil, nil, nil, nil, stacktrace.NewError("Couldn't parse private IP string '%v' on container '%v' to an IP address", privateIpAddrStr, containerName)
+		return nil, nil, nil, nil, nil, stacktrace.NewError("Couldn't parse private IP string '%v' on container '%v' to an IP address", privateIpAddrStr, containerName)
+	}
+
+	var privateIpv6 net.IP
+	if privateIpv6AddrStr, found := labels[label_key_consts.PrivateIPv6DockerLabelKey.GetString()]; found {
+		privateIpv6 = net.ParseIP(privateIpv6AddrStr)
+		if privateIpv6 == nil || privateIpv6.To4() != nil {
+			return nil, nil, nil, nil, nil, stacktrace.NewError("Couldn't parse private IPv6 string '%v' on container '%v' to an IPv6 address", privateIpv6AddrStr, containerName)
+		}
 	}
 
 	serializedPortSpecs, found := labels[label_key_consts.PortSpecsDockerLabelKey.GetString()]
 	if !found {
-		return nil, nil, nil, nil, stacktrace.NewError(
+		return nil, nil, nil, nil, nil, stacktrace.NewError(
 			"Expected to find port specs label '%v' on container '%v' but none was found",
 			containerName,
 			label_key_consts.PortSpecsDockerLabelKey.GetString(),
@@ -251,20 +264,20 @@ func GetIpAndPortInfoFromContainer(
 	privatePortSpecs, err := docker_port_spec_serializer.DeserializePortSpecs(serializedPortSpecs)
 	if err != nil {
 		if err != nil {
-			return nil, nil, nil, nil, stacktrace.Propagate(err, "Couldn't deserialize port spec string '%v'", serializedPortSpecs)
+			return nil, nil, nil, nil, nil, stacktrace.Propagate(err, "Couldn't deserialize port spec string '%v'", serializedPortSpecs)
 		}
 	}
 
 	var containerPublicIp net.IP
 	var publicPortSpecs map[string]*port_spec.PortSpec
 	if hostMachinePortBindings == nil || len(hostMachinePortBindings) == 0 {
-		return privateIp, privatePortSpecs, containerPublicIp, publicPortSpecs, nil
+		return privateIp, privateIpv6, privatePortSpecs, containerPublicIp, publicPortSpecs, nil
 	}
 
 	for portId, privatePortSpec := range privatePortSpecs {
 		portPublicIp, publicPortSpec, err := GetPublicPortBindingFromPrivatePortSpec(privatePortSpec, hostMachinePortBindings)
 		if err != nil {
-			return nil, nil, nil, nil, stacktrace.Propagate(
+			return nil, nil, nil, nil, nil, stacktrace.Propagate(
 				err,
 				"An error occurred getting public port spec for private port '%v' with spec '%v/%v' on container '%v'",
 				portId,
@@ -278,7 +291,7 @@ func GetIpAndPortInfoFromContainer(
 			containerPublicIp = portPublicIp
 		} else {
 			if !containerPublicIp.Equal(portPublicIp) {
-				return nil, nil, nil, nil, stacktrace.NewError(
+				return nil, nil, nil, nil, nil, stacktrace.NewError(
 					"Private port '%v' on container '%v' yielded a public IP '%v', which doesn't agree with "+
 						"previously-seen public IP '%v'",
 					portId,
@@ -295,7 +308,7 @@ func GetIpAndPortInfoFromContainer(
 		publicPortSpecs[portId] = publicPortSpec
 	}
 
-	return privateIp, privatePortSpecs, containerPublicIp, publicPortSpecs, nil
+	return privateIp, privateIpv6, privatePortSpecs, containerPublicIp, publicPortSpecs, nil
 }
 
 // Gets the service objects & Docker resources for services matching the given filters
@@ -653,7 +666,7 @@ func getUserServiceObjsFromDockerResources(
 		}
 		serviceName := service.ServiceName(serviceIdStr)
 
-		privateIp, privatePorts, maybePublicIp, maybePublicPorts, err := GetIpAndPortInfoFromContainer(
+		privateIp, privateIpv6, privatePorts, maybePublicIp, maybePublicPorts, err := GetIpAndPortInfoFromContainer(
 			containerName,
 			containerLabels,
 			container.GetHostPortBindings(),
@@ -662,11 +675,12 @@ func getUserServiceObjsFromDockerResources(
 			return nil, stacktrace.Propagate(err, "An error occurred getting IP & port info from container '%v'", container.GetName())
 		}
 
-		registration := service.NewServiceRegistration(
+		registration := service.NewDualStackServiceRegistration(
 			serviceName,
 			serviceUuid,
 			enclaveId,
 			privateIp,
+			privateIpv6,
 			string(serviceName), // in Docker, hostname = serviceName because we're setting the "alias" of the container to serviceName
 		)
 
@@ -680,12 +694,15 @@ func getUserServiceObjsFromDockerResources(
 			serviceStatus = container_status.ContainerStatus_Running
 		}
 
+		creationTime := container.GetCreationTime()
 		result[serviceUuid] = service.NewService(
 			registration,
 			serviceStatus,
 			privatePorts,
 			maybePublicIp,
 			maybePublicPorts,
+			noReplicaPrivateIps,
+			&creationTime,
 		)
 	}
 	return result, nil
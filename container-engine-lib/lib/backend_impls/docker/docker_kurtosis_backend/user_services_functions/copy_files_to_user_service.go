@@ -0,0 +1,39 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+)
+
+// CopyFilesToUserService extracts the given tar stream into the given directory of the user service container
+func CopyFilesToUserService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpathOnContainer string,
+	tarContent io.Reader,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, serviceDockerResources, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting user service with UUID '%v' in enclave with ID '%v'", serviceUuid, enclaveId)
+	}
+	container := serviceDockerResources.ServiceContainer
+
+	if err := dockerManager.CopyToContainer(ctx, container.GetId(), dstDirpathOnContainer, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying content to directory '%v' in container '%v' for user service '%v' in enclave '%v'",
+			dstDirpathOnContainer,
+			container.GetName(),
+			serviceUuid,
+			enclaveId,
+		)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"strings"
+	"time"
 )
 
 const (
@@ -20,6 +21,11 @@ const (
 
 	expanderContainerSuccessExitCode = 0
 
+	// Expanding even big files artifacts takes seconds, so an expander still running after this is most likely stuck
+	// on a wedged Docker engine
+	expanderContainerMaxWait               = 10 * time.Minute
+	expanderContainerWaitHeartbeatInterval = 30 * time.Second
+
 	skipAddingToBridgeNetwork = true
 )
 
@@ -186,7 +192,7 @@ func runFilesArtifactsExpander(
 		}
 	}()
 
-	exitCode, err := dockerManager.WaitForExit(ctx, containerId)
+	exitCode, err := dockerManager.WaitForExit(ctx, containerId, expanderContainerMaxWait, expanderContainerWaitHeartbeatInterval)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
@@ -0,0 +1,87 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/docker/docker/api/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	percentFactor = 100
+
+	// The page cache the kernel can reclaim gets counted in the memory usage reported by the cgroup, so it gets
+	// subtracted the same way 'docker stats' does; the key depends on the cgroup version of the host
+	cgroupV1InactiveFileMemoryStatKey = "total_inactive_file"
+	cgroupV2InactiveFileMemoryStatKey = "inactive_file"
+)
+
+func GetServiceMetrics(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dockerManager *docker_manager.DockerManager,
+) (*service.ServiceMetrics, error) {
+	_, dockerResources, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get information about service '%v' from Kurtosis", serviceUuid)
+	}
+	container := dockerResources.ServiceContainer
+	if container == nil {
+		return nil, stacktrace.NewError("Cannot get the metrics of service '%v' as it doesn't have a container", serviceUuid)
+	}
+	stats, err := dockerManager.GetContainerStats(ctx, container.GetId())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get the stats of container '%v' for service '%v'", container.GetName(), serviceUuid)
+	}
+	return newServiceMetricsFromDockerStats(stats), nil
+}
+
+func newServiceMetricsFromDockerStats(stats *types.StatsJSON) *service.ServiceMetrics {
+	networkRxBytes := uint64(0)
+	networkTxBytes := uint64(0)
+	for _, networkStats := range stats.Networks {
+		networkRxBytes += networkStats.RxBytes
+		networkTxBytes += networkStats.TxBytes
+	}
+	return service.NewServiceMetrics(
+		stats.Read,
+		getCpuUsagePercent(stats.CPUStats, stats.PreCPUStats),
+		getMemoryUsageBytes(stats.MemoryStats),
+		stats.MemoryStats.Limit,
+		networkRxBytes,
+		networkTxBytes,
+	)
+}
+
+// Same computation as 'docker stats': the share of the time of all the host CPUs the container used between the two
+// samples, scaled so that 100% is one full CPU
+func getCpuUsagePercent(cpuStats types.CPUStats, previousCpuStats types.CPUStats) float64 {
+	// The counters can go backwards if the container got restarted between the samples
+	if cpuStats.CPUUsage.TotalUsage <= previousCpuStats.CPUUsage.TotalUsage || cpuStats.SystemUsage <= previousCpuStats.SystemUsage {
+		return 0
+	}
+	containerCpuDelta := float64(cpuStats.CPUUsage.TotalUsage - previousCpuStats.CPUUsage.TotalUsage)
+	systemCpuDelta := float64(cpuStats.SystemUsage - previousCpuStats.SystemUsage)
+
+	numCpus := cpuStats.OnlineCPUs
+	if numCpus == 0 {
+		// Older Docker engines don't report the number of online CPUs
+		numCpus = uint32(len(cpuStats.CPUUsage.PercpuUsage))
+	}
+	return containerCpuDelta / systemCpuDelta * float64(numCpus) * percentFactor
+}
+
+func getMemoryUsageBytes(memoryStats types.MemoryStats) uint64 {
+	inactiveFileBytes, found := memoryStats.Stats[cgroupV1InactiveFileMemoryStatKey]
+	if !found {
+		inactiveFileBytes = memoryStats.Stats[cgroupV2InactiveFileMemoryStatKey]
+	}
+	if inactiveFileBytes > memoryStats.Usage {
+		return 0
+	}
+	return memoryStats.Usage - inactiveFileBytes
+}
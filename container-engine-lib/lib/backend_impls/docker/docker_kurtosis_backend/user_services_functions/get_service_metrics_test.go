@@ -0,0 +1,60 @@
+package user_service_functions
+
+import (
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetCpuUsagePercent(t *testing.T) {
+	previousCpuStats := newCpuStats(1_000, 100_000, 4)
+	cpuStats := newCpuStats(3_000, 110_000, 4)
+
+	// 2000ns out of the 10000ns of all the 4 CPUs is 80% of one CPU
+	require.InDelta(t, 80.0, getCpuUsagePercent(cpuStats, previousCpuStats), 0.0001)
+}
+
+func TestGetCpuUsagePercent_CountersWentBackwards(t *testing.T) {
+	previousCpuStats := newCpuStats(3_000, 110_000, 4)
+	cpuStats := newCpuStats(1_000, 120_000, 4)
+
+	require.Zero(t, getCpuUsagePercent(cpuStats, previousCpuStats))
+}
+
+func TestGetMemoryUsageBytes_LeavesOutPageCache(t *testing.T) {
+	cgroupV1MemoryStats := types.MemoryStats{ //nolint:exhaustruct
+		Usage: 1_000,
+		Stats: map[string]uint64{cgroupV1InactiveFileMemoryStatKey: 300},
+		Limit: 10_000,
+	}
+	require.Equal(t, uint64(700), getMemoryUsageBytes(cgroupV1MemoryStats))
+
+	cgroupV2MemoryStats := types.MemoryStats{ //nolint:exhaustruct
+		Usage: 1_000,
+		Stats: map[string]uint64{cgroupV2InactiveFileMemoryStatKey: 400},
+		Limit: 10_000,
+	}
+	require.Equal(t, uint64(600), getMemoryUsageBytes(cgroupV2MemoryStats))
+}
+
+func TestNewServiceMetricsFromDockerStats_SumsNetworks(t *testing.T) {
+	stats := &types.StatsJSON{ //nolint:exhaustruct
+		Networks: map[string]types.NetworkStats{
+			"eth0": {RxBytes: 10, TxBytes: 20}, //nolint:exhaustruct
+			"eth1": {RxBytes: 1, TxBytes: 2},   //nolint:exhaustruct
+		},
+	}
+	metrics := newServiceMetricsFromDockerStats(stats)
+	require.Equal(t, uint64(11), metrics.GetNetworkRxBytes())
+	require.Equal(t, uint64(22), metrics.GetNetworkTxBytes())
+}
+
+func newCpuStats(totalUsage uint64, systemUsage uint64, onlineCpus uint32) types.CPUStats {
+	return types.CPUStats{ //nolint:exhaustruct
+		CPUUsage: types.CPUUsage{ //nolint:exhaustruct
+			TotalUsage: totalUsage,
+		},
+		SystemUsage: systemUsage,
+		OnlineCPUs:  onlineCpus,
+	}
+}
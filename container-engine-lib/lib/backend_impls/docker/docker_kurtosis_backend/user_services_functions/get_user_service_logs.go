@@ -16,6 +16,7 @@ func GetUserServiceLogs(
 	enclaveId enclave.EnclaveUUID,
 	filters *service.ServiceFilters,
 	shouldFollowLogs bool,
+	timeRange *service.ServiceLogsTimeRange,
 	dockerManager *docker_manager.DockerManager,
 ) (
 	map[service.ServiceUUID]io.ReadCloser,
@@ -38,7 +39,7 @@ func GetUserServiceLogs(
 			continue
 		}
 
-		rawDockerLogStream, err := dockerManager.GetContainerLogs(ctx, container.GetId(), shouldFollowLogs)
+		rawDockerLogStream, err := dockerManager.GetContainerLogsInTimeRange(ctx, container.GetId(), shouldFollowLogs, timeRange.GetSince(), timeRange.GetUntil())
 		if err != nil {
 			serviceError := stacktrace.Propagate(err, "An error occurred getting logs for container '%v' for user service with UUID '%v'", container.GetName(), guid)
 			erroredUserServices[guid] = serviceError
@@ -127,6 +127,9 @@ func destroyUserServicesUnlocked(
 		if err = enclaveFreeIpProvidersForEnclave.ReleaseIpAddr(ipAddr); err != nil {
 			logrus.Errorf("Error releasing IP address '%v'", ipAddr)
 		}
+		if err = releaseIpv6AddrIfAny(registration, enclaveFreeIpProvidersForEnclave); err != nil {
+			logrus.Errorf("Error releasing IPv6 address '%v'", registration.GetPrivateIPv6())
+		}
 		delete(serviceRegistrationsForEnclave, uuid)
 	}
 
@@ -278,3 +281,74 @@ func extractServiceUUIDFromServiceObj(uncastedObj interface{}) (string, error) {
 	}
 	return string(castedObj.GetRegistration().GetUUID()), nil
 }
+
+// runContainerOperationOnMatchingUserServices runs the given operation in parallel on the containers of the user
+// services matching the given filters. Services without a container are reported as errored
+func runContainerOperationOnMatchingUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+	operationDescription string,
+	dockerOperation docker_operation_parallelizer.DockerOperation,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	allServiceObjs, allDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user services matching filters '%+v'", filters)
+	}
+
+	erroredUuids := map[service.ServiceUUID]error{}
+	servicesByContainerId := map[string]interface{}{}
+	for uuid, serviceResources := range allDockerResources {
+		serviceObj, found := allServiceObjs[uuid]
+		if !found {
+			// Should never happen; there should be a 1:1 mapping between service_objects:docker_resources by GUID
+			return nil, nil, stacktrace.NewError("No service object found for service '%v' that had Docker resources", uuid)
+		}
+		if serviceResources.ServiceContainer == nil {
+			erroredUuids[uuid] = stacktrace.NewError("Cannot %s service '%v' as it doesn't have a container", operationDescription, uuid)
+			continue
+		}
+		servicesByContainerId[serviceResources.ServiceContainer.GetId()] = serviceObj
+	}
+
+	successfulUuidStrs, erroredUuidStrs, err := docker_operation_parallelizer.RunDockerOperationInParallelForKurtosisObjects(
+		ctx,
+		servicesByContainerId,
+		dockerManager,
+		extractServiceUUIDFromServiceObj,
+		dockerOperation,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred running operation '%s' on user service containers matching filters '%+v'", operationDescription, filters)
+	}
+
+	successfulUuids := map[service.ServiceUUID]bool{}
+	for uuidStr := range successfulUuidStrs {
+		successfulUuids[service.ServiceUUID(uuidStr)] = true
+	}
+	for uuidStr, err := range erroredUuidStrs {
+		erroredUuids[service.ServiceUUID(uuidStr)] = stacktrace.Propagate(
+			err,
+			"An error occurred running operation '%s' on service '%v'",
+			operationDescription,
+			uuidStr,
+		)
+	}
+	return successfulUuids, erroredUuids, nil
+}
+
+func releaseIpv6AddrIfAny(registration *service.ServiceRegistration, freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker) error {
+	ipv6Addr := registration.GetPrivateIPv6()
+	if ipv6Addr == nil {
+		return nil
+	}
+	if err := freeIpAddrProvider.ReleaseIpAddr(ipv6Addr); err != nil {
+		return stacktrace.Propagate(err, "An error occurred releasing IPv6 address '%v' of service '%v'", ipv6Addr, registration.GetName())
+	}
+	return nil
+}
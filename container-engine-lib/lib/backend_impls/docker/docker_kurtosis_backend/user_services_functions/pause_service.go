@@ -4,11 +4,16 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
+const (
+	pauseOperationDescription = "pause"
+)
+
 func PauseService(
 	ctx context.Context,
 	enclaveId enclave.EnclaveUUID,
@@ -28,3 +33,31 @@ func PauseService(
 	}
 	return nil
 }
+
+// PauseServices pauses the containers of all the services matching the given filters, in parallel
+func PauseServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	resultSuccessfulServiceUUIDs map[service.ServiceUUID]bool,
+	resultErroredServiceUUIDs map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	var dockerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.PauseContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred pausing user service container with ID '%v'", dockerObjectId)
+		}
+		return nil
+	}
+	successfulUuids, erroredUuids, err := runContainerOperationOnMatchingUserServices(ctx, enclaveUuid, filters, dockerManager, pauseOperationDescription, dockerOperation)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred pausing user services matching filters '%+v'", filters)
+	}
+	return successfulUuids, erroredUuids, nil
+}
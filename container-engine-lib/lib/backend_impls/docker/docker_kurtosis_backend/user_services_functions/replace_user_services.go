@@ -0,0 +1,107 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/stacktrace"
+	"sync"
+)
+
+// ReplaceUserServices removes the Docker resources of started services and starts them again from their new configs.
+// Unlike DestroyUserServices, the registrations are left untouched, so the services keep their UUIDs and IP addresses.
+// Removing a container can't be undone, so a service that fails to start again is left registered without a container
+func ReplaceUserServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	services map[service.ServiceUUID]*service.ServiceConfig,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+	serviceRegistrationMutex *sync.Mutex,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	freeIpProviderForEnclave *free_ip_addr_tracker.FreeIpAddrTracker,
+	dockerManager *docker_manager.DockerManager,
+	shouldFallBackToEphemeralPortOnHostPortConflict bool,
+) (
+	map[service.ServiceUUID]*service.Service,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	// Held for the whole replacement so that the services can't get destroyed while they have no container
+	serviceRegistrationMutex.Lock()
+	defer serviceRegistrationMutex.Unlock()
+
+	successfulServicesPool := map[service.ServiceUUID]*service.Service{}
+	failedServicesPool := map[service.ServiceUUID]error{}
+
+	serviceConfigsToReplace := map[service.ServiceUUID]*service.ServiceConfig{}
+	for serviceUuid, serviceConfig := range services {
+		if _, found := serviceRegistrations[serviceUuid]; !found {
+			failedServicesPool[serviceUuid] = stacktrace.NewError("Attempted to replace service '%s' that is not registered to this enclave", serviceUuid)
+			continue
+		}
+		serviceConfigsToReplace[serviceUuid] = serviceConfig
+	}
+	// Same as when starting services, an empty filter would match all the services of the enclave
+	if len(serviceConfigsToReplace) == 0 {
+		return successfulServicesPool, failedServicesPool, nil
+	}
+
+	filters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    map[service.ServiceUUID]bool{},
+		Statuses: nil,
+	}
+	for serviceUuid := range serviceConfigsToReplace {
+		filters.UUIDs[serviceUuid] = true
+	}
+	serviceObjs, dockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services to replace matching filters '%+v'", filters)
+	}
+	for serviceUuid := range serviceConfigsToReplace {
+		if _, found := dockerResources[serviceUuid]; !found {
+			failedServicesPool[serviceUuid] = stacktrace.NewError("Service '%s' can't be replaced as it was never started", serviceUuid)
+			delete(serviceConfigsToReplace, serviceUuid)
+		}
+	}
+
+	removedServiceUuids, failedRemovals, err := removeUserServiceDockerResources(ctx, serviceObjs, dockerResources, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing the Docker resources of the services to replace")
+	}
+	for serviceUuid, removalErr := range failedRemovals {
+		failedServicesPool[serviceUuid] = stacktrace.Propagate(removalErr, "An error occurred removing the Docker resources of service '%s' to replace it", serviceUuid)
+		delete(serviceConfigsToReplace, serviceUuid)
+	}
+	for serviceUuid := range serviceConfigsToReplace {
+		if _, found := removedServiceUuids[serviceUuid]; !found {
+			failedServicesPool[serviceUuid] = stacktrace.NewError("Service '%s' was neither removed nor failed to be removed; this is a bug in Kurtosis", serviceUuid)
+			delete(serviceConfigsToReplace, serviceUuid)
+		}
+	}
+
+	startedServices, failedStarts, err := StartUserServices(
+		ctx,
+		enclaveUuid,
+		serviceConfigsToReplace,
+		serviceRegistrations,
+		objAttrsProvider,
+		freeIpProviderForEnclave,
+		dockerManager,
+		shouldFallBackToEphemeralPortOnHostPortConflict,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting the new containers of services '%v', which are now left without containers", filters.UUIDs)
+	}
+	for serviceUuid, startedService := range startedServices {
+		successfulServicesPool[serviceUuid] = startedService
+	}
+	for serviceUuid, startErr := range failedStarts {
+		failedServicesPool[serviceUuid] = stacktrace.Propagate(startErr, "An error occurred starting the new container of service '%s', which is now left without a container", serviceUuid)
+	}
+	return successfulServicesPool, failedServicesPool, nil
+}
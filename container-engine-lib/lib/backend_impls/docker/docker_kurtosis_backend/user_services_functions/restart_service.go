@@ -0,0 +1,36 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+const (
+	// Same as the 'docker restart' default: the service gets this long to exit after SIGTERM before being killed
+	restartStopTimeout = 10 * time.Second
+)
+
+func RestartService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, dockerResources, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get information about service '%v' from Kurtosis ", serviceUuid)
+	}
+	container := dockerResources.ServiceContainer
+	if container == nil {
+		return stacktrace.NewError("Cannot restart service '%v' as it doesn't have a container", serviceUuid)
+	}
+	if err = dockerManager.RestartContainer(ctx, container.GetId(), restartStopTimeout); err != nil {
+		return stacktrace.Propagate(err, "Failed to restart container '%v' for service '%v' ", container.GetName(), serviceUuid)
+	}
+	return nil
+}
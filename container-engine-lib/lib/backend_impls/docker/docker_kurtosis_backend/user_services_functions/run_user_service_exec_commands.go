@@ -9,10 +9,12 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
+	"io"
 )
 
-// TODO Switch these to streaming so that huge command outputs don't blow up the API container memory
-// NOTE: This function will block while the exec is ongoing; if we need more perf we can make it async
+// NOTE: The outputs get buffered in memory, so commands with huge outputs should go through
+// RunUserServiceExecCommandWithStreamedOutput instead. This function will block while the exec is ongoing; if we need
+// more perf we can make it async
 func RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveId enclave.EnclaveUUID,
@@ -77,3 +79,35 @@ func RunUserServiceExecCommands(
 
 	return succesfulUserServiceExecResults, erroredUserServiceGuids, nil
 }
+
+// RunUserServiceExecCommandWithStreamedOutput copies the output of the command to the given writer as Docker hands it
+// over, so the API container memory doesn't grow with the size of the output
+func RunUserServiceExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	command []string,
+	output io.Writer,
+	dockerManager *docker_manager.DockerManager,
+) (int32, error) {
+	_, dockerResources, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting service '%v' to execute command '%+v' on", serviceUuid, command)
+	}
+	container := dockerResources.ServiceContainer
+	if container == nil {
+		return 0, stacktrace.NewError("Cannot execute command '%+v' on service '%v' because it has no container", command, serviceUuid)
+	}
+
+	exitCode, err := dockerManager.RunExecCommand(ctx, container.GetId(), command, output)
+	if err != nil {
+		return 0, stacktrace.Propagate(
+			err,
+			"An error occurred executing command '%+v' on container '%v' for user service '%v'",
+			command,
+			container.GetName(),
+			serviceUuid,
+		)
+	}
+	return exitCode, nil
+}
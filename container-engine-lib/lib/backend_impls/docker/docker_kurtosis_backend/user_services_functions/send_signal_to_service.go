@@ -0,0 +1,31 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func SendSignalToService(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	signal string,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	_, dockerResources, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to get information about service '%v' from Kurtosis ", serviceUuid)
+	}
+	container := dockerResources.ServiceContainer
+	if container == nil {
+		return stacktrace.NewError("Cannot send signal '%v' to service '%v' as it doesn't have a container", signal, serviceUuid)
+	}
+	if err = dockerManager.SendSignalToContainer(ctx, container.GetId(), signal); err != nil {
+		return stacktrace.Propagate(err, "Failed to send signal '%v' to container '%v' for service '%v' ", signal, container.GetName(), serviceUuid)
+	}
+	return nil
+}
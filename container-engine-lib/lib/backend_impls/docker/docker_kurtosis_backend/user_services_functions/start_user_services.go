@@ -2,12 +2,17 @@ package user_service_functions
 
 import (
 	"context"
+	"fmt"
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	docker_manager_types "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/files_artifacts_expansion"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
@@ -15,13 +20,24 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"net"
+	"path"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
 	unlimitedReplacements                = -1
 	skipAddingUserServiceToBridgeNetwork = true
+
+	bindMountSeparator = ":"
+)
+
+var (
+	// Docker services always run as a single container
+	noReplicaPrivateIps []net.IP
 )
 
 func RegisterUserServices(
@@ -83,6 +99,8 @@ func UnregisterUserServices(
 		serviceIpAddr := serviceRegistration.GetPrivateIP()
 		if err := freeIpAddrProviderForEnclave.ReleaseIpAddr(serviceIpAddr); err != nil {
 			servicesFailed[serviceUuid] = err
+		} else if err := releaseIpv6AddrIfAny(serviceRegistration, freeIpAddrProviderForEnclave); err != nil {
+			servicesFailed[serviceUuid] = err
 		} else {
 			delete(enclaveServiceRegistrations, serviceUuid)
 			servicesSuccessfullyUnregistered[serviceUuid] = true
@@ -99,6 +117,7 @@ func StartUserServices(
 	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
 	freeIpProviderForEnclave *free_ip_addr_tracker.FreeIpAddrTracker,
 	dockerManager *docker_manager.DockerManager,
+	shouldFallBackToEphemeralPortOnHostPortConflict bool,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -142,6 +161,28 @@ func StartUserServices(
 			}
 		}
 	}
+
+	// Pre-flight check so that a service requesting a host port that's already taken fails early with a clear error
+	// instead of Docker's cryptic one, or gets published on an ephemeral port instead if the backend is configured to
+	hostPortsToCheck := map[uint16]bool{}
+	for _, serviceConfig := range serviceConfigsToStart {
+		for _, publicPortSpec := range serviceConfig.GetPublicPorts() {
+			hostPortsToCheck[publicPortSpec.GetNumber()] = true
+		}
+	}
+	containersPublishingHostPorts, err := dockerManager.GetContainersPublishingHostPorts(ctx, hostPortsToCheck)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred checking whether host ports '%v' requested by the services are already in use", hostPortsToCheck)
+	}
+	portIdsToPublishEphemerally, hostPortConflictErrs := resolveHostPortConflicts(
+		serviceConfigsToStart,
+		containersPublishingHostPorts,
+		shouldFallBackToEphemeralPortOnHostPortConflict,
+	)
+	for serviceUuid, conflictErr := range hostPortConflictErrs {
+		failedServicesPool[serviceUuid] = conflictErr
+		delete(serviceConfigsToStart, serviceUuid)
+	}
 	//TODO END huge hack to temporarily enable static ports for NEAR
 
 	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
@@ -155,6 +196,17 @@ func StartUserServices(
 		return nil, nil, stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
 	}
 
+	// Services very often share images, so each image gets pulled only once, up front, rather than by every service
+	// starting from it
+	serviceUuidsByImage := groupServiceUuidsByImage(serviceConfigsToStart)
+	pulledImages, imagesFailedToFetch := pullImagesInParallel(ctx, serviceUuidsByImage, dockerManager)
+	for imageName, fetchErr := range imagesFailedToFetch {
+		for serviceUuid := range serviceUuidsByImage[imageName] {
+			failedServicesPool[serviceUuid] = stacktrace.Propagate(fetchErr, "An error occurred fetching image '%v' of service with UUID '%v'", imageName, serviceUuid)
+			delete(serviceConfigsToStart, serviceUuid)
+		}
+	}
+
 	successfulStarts, failedStarts, err := runStartServiceOperationsInParallel(
 		ctx,
 		enclaveNetworkID,
@@ -163,6 +215,9 @@ func StartUserServices(
 		enclaveObjAttrsProvider,
 		freeIpProviderForEnclave,
 		dockerManager,
+		portIdsToPublishEphemerally,
+		pulledImages,
+		shouldFallBackToEphemeralPortOnHostPortConflict,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while trying to start services in parallel.")
@@ -194,6 +249,9 @@ func runStartServiceOperationsInParallel(
 	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
 	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
 	dockerManager *docker_manager.DockerManager,
+	portIdsToPublishEphemerally map[service.ServiceUUID]map[string]bool,
+	pulledImages map[string]bool,
+	shouldFallBackToEphemeralPortOnHostPortConflict bool,
 ) (
 	map[service.ServiceUUID]*service.Service,
 	map[service.ServiceUUID]error,
@@ -218,6 +276,9 @@ func runStartServiceOperationsInParallel(
 			enclaveObjAttrsProvider,
 			freeIpAddrProvider,
 			dockerManager,
+			portIdsToPublishEphemerally[serviceUuid],
+			pulledImages[config.GetContainerImageName()],
+			shouldFallBackToEphemeralPortOnHostPortConflict,
 		)
 	}
 
@@ -242,6 +303,60 @@ func runStartServiceOperationsInParallel(
 	return successfulServices, failedServices, nil
 }
 
+func groupServiceUuidsByImage(serviceConfigs map[service.ServiceUUID]*service.ServiceConfig) map[string]map[service.ServiceUUID]bool {
+	serviceUuidsByImage := map[string]map[service.ServiceUUID]bool{}
+	for serviceUuid, serviceConfig := range serviceConfigs {
+		imageName := serviceConfig.GetContainerImageName()
+		if _, found := serviceUuidsByImage[imageName]; !found {
+			serviceUuidsByImage[imageName] = map[service.ServiceUUID]bool{}
+		}
+		serviceUuidsByImage[imageName][serviceUuid] = true
+	}
+	return serviceUuidsByImage
+}
+
+// pullImagesInParallel makes a best-effort attempt at pulling the latest version of every image, falling back to the
+// version already on the host if the pull fails. It returns the images that got pulled, and the ones that are neither
+// pullable nor on the host
+func pullImagesInParallel(
+	ctx context.Context,
+	serviceUuidsByImage map[string]map[service.ServiceUUID]bool,
+	dockerManager *docker_manager.DockerManager,
+) (map[string]bool, map[string]error) {
+	pullImageOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for imageName := range serviceUuidsByImage {
+		pullImageOperations[operation_parallelizer.OperationID(imageName)] = createPullImageOperation(ctx, imageName, dockerManager)
+	}
+
+	successfulPulls, failedPulls := operation_parallelizer.RunOperationsInParallel(pullImageOperations)
+
+	pulledImages := map[string]bool{}
+	for imageName, data := range successfulPulls {
+		wasPulled, ok := data.(bool)
+		pulledImages[string(imageName)] = ok && wasPulled
+	}
+	imagesFailedToFetch := map[string]error{}
+	for imageName, err := range failedPulls {
+		imagesFailedToFetch[string(imageName)] = err
+	}
+	return pulledImages, imagesFailedToFetch
+}
+
+func createPullImageOperation(ctx context.Context, imageName string, dockerManager *docker_manager.DockerManager) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		pullErr := dockerManager.PullImage(ctx, imageName)
+		if pullErr == nil {
+			return true, nil
+		}
+		logrus.Warnf("Failed to pull the latest version of user service container image '%v'; you may be running an out-of-date version", imageName)
+		logrus.Debugf("Pulling image '%v' failed with error:\n%v", imageName, pullErr)
+		if err := dockerManager.FetchImage(ctx, imageName); err != nil {
+			return nil, stacktrace.Propagate(err, "Image '%v' couldn't be pulled and isn't available on the host either", imageName)
+		}
+		return false, nil
+	}
+}
+
 func createStartServiceOperation(
 	ctx context.Context,
 	serviceUUID service.ServiceUUID,
@@ -251,9 +366,13 @@ func createStartServiceOperation(
 	enclaveObjAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
 	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
 	dockerManager *docker_manager.DockerManager,
+	portIdsToPublishEphemerally map[string]bool,
+	wasImagePulled bool,
+	shouldFallBackToEphemeralPortOnHostPortConflict bool,
 ) operation_parallelizer.Operation {
 	id := serviceRegistration.GetName()
 	privateIpAddr := serviceRegistration.GetPrivateIP()
+	privateIpv6Addr := serviceRegistration.GetPrivateIPv6()
 
 	return func() (interface{}, error) {
 		filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
@@ -267,6 +386,21 @@ func createStartServiceOperation(
 		memoryAllocationMegabytes := serviceConfig.GetMemoryAllocationMegabytes()
 		privateIPAddrPlaceholder := serviceConfig.GetPrivateIPAddrPlaceholder()
 
+		if serviceConfig.GetReplicas() > 1 {
+			return nil, stacktrace.NewError("Service '%v' requested %d replicas but the Docker backend can only run a single one", id, serviceConfig.GetReplicas())
+		}
+		if serviceConfig.GetKubernetesServiceAccount() != "" {
+			logrus.Warnf("Service '%v' requested Kubernetes service account '%v', which is ignored by the Docker backend", id, serviceConfig.GetKubernetesServiceAccount())
+		}
+		if serviceConfig.GetKubernetesScheduling() != nil {
+			logrus.Warnf("Service '%v' requested Kubernetes scheduling constraints, which are ignored by the Docker backend", id)
+		}
+
+		bindMounts, err := getDockerBindMounts(serviceConfig.GetHostDirMounts(), filesArtifactsExpansion)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid host directory mounts for service '%v'", id)
+		}
+
 		// We replace the placeholder value with the actual private IP address
 		privateIPAddrStr := privateIpAddr.String()
 		for index := range entrypointArgs {
@@ -314,6 +448,7 @@ func createStartServiceOperation(
 			id,
 			serviceUUID,
 			privateIpAddr,
+			privateIpv6Addr,
 			privatePorts,
 		)
 		if err != nil {
@@ -333,7 +468,9 @@ func createStartServiceOperation(
 				return nil, stacktrace.Propagate(err, "An error occurred converting private port spec '%v' to a Docker port", portId)
 			}
 			//TODO this is a huge hack to temporarily enable static ports for NEAR until we have a more productized solution
-			if publicPorts != nil && len(publicPorts) > 0 {
+			if _, found := portIdsToPublishEphemerally[portId]; found {
+				dockerUsedPorts[dockerPort] = docker_manager.NewAutomaticPublishingSpec()
+			} else if publicPorts != nil && len(publicPorts) > 0 {
 				publicPortSpec, found := publicPorts[portId]
 				if !found {
 					return nil, stacktrace.NewError("Expected to receive public port with ID '%v' bound to private port number '%v', but it was not found", portId, privatePortSpec.GetNumber())
@@ -344,12 +481,18 @@ func createStartServiceOperation(
 			}
 		}
 
+		if wasImagePulled {
+			labelStrs[label_key_consts.PulledImageDockerLabelKey.GetString()] = containerImageName
+		}
+
 		createAndStartArgsBuilder := docker_manager.NewCreateAndStartContainerArgsBuilder(
 			containerImageName,
 			containerName.GetString(),
 			enclaveNetworkId,
 		).WithStaticIP(
 			privateIpAddr,
+		).WithStaticIPv6(
+			privateIpv6Addr,
 		).WithUsedPorts(
 			dockerUsedPorts,
 		).WithEnvironmentVariables(
@@ -362,6 +505,16 @@ func createStartServiceOperation(
 			cpuAllocationMillicpus,
 		).WithMemoryAllocationMegabytes(
 			memoryAllocationMegabytes,
+		).WithCpuset(
+			serviceConfig.GetCpuset(),
+		).WithNumaNodes(
+			serviceConfig.GetNumaNodes(),
+		).WithUlimits(
+			getDockerUlimits(serviceConfig.GetUlimits()),
+		).WithSysctls(
+			serviceConfig.GetSysctls(),
+		).WithShmSizeMegabytes(
+			serviceConfig.GetShmSizeMegabytes(),
 		).WithSkipAddingToBridgeNetworkIfStaticIpIsSet(
 			skipAddingUserServiceToBridgeNetwork,
 		)
@@ -372,18 +525,26 @@ func createStartServiceOperation(
 		if cmdArgs != nil {
 			createAndStartArgsBuilder.WithCmdArgs(cmdArgs)
 		}
+		if len(bindMounts) > 0 {
+			createAndStartArgsBuilder.WithBindMounts(bindMounts)
+		}
 		if volumeMounts != nil {
 			createAndStartArgsBuilder.WithVolumeMounts(volumeMounts)
 		}
 
 		createAndStartArgs := createAndStartArgsBuilder.Build()
 
-		// Best-effort pull attempt
-		if err = dockerManager.PullImage(ctx, containerImageName); err != nil {
-			logrus.Warnf("Failed to pull the latest version of user service container image '%v'; you may be running an out-of-date version", containerImageName)
-		}
-
 		containerId, hostMachinePortBindings, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+		// Host ports held by processes other than containers only show up when Docker fails to bind them
+		if err != nil && shouldFallBackToEphemeralPortOnHostPortConflict && docker_manager.IsHostPortConflictErr(err) {
+			logrus.Warnf("Some of the host ports requested by service with UUID '%v' are held by another process on the host; publishing them on ephemeral host ports instead:\n%v", serviceUUID, err)
+			ephemeralDockerUsedPorts := map[nat.Port]docker_manager.PortPublishSpec{}
+			for dockerPort := range dockerUsedPorts {
+				ephemeralDockerUsedPorts[dockerPort] = docker_manager.NewAutomaticPublishingSpec()
+			}
+			createAndStartArgs = createAndStartArgsBuilder.WithUsedPorts(ephemeralDockerUsedPorts).Build()
+			containerId, hostMachinePortBindings, err = dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+		}
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred starting the user service container for user service with UUID '%v'", serviceUUID)
 		}
@@ -405,7 +566,7 @@ func createStartServiceOperation(
 			}
 		}()
 
-		_, _, maybePublicIp, maybePublicPortSpecs, err := shared_helpers.GetIpAndPortInfoFromContainer(
+		_, _, _, maybePublicIp, maybePublicPortSpecs, err := shared_helpers.GetIpAndPortInfoFromContainer(
 			containerName.GetString(),
 			labelStrs,
 			hostMachinePortBindings,
@@ -414,12 +575,24 @@ func createStartServiceOperation(
 			return nil, stacktrace.Propagate(err, "An error occurred getting the public IP and ports from container '%v'", containerName)
 		}
 
+		// Unlike listing containers, inspecting the container gives us its creation time to the nanosecond
+		containerInfo, err := dockerManager.InspectContainer(ctx, containerId)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred inspecting container '%v' to get its creation time", containerName)
+		}
+		creationTime, err := time.Parse(time.RFC3339Nano, containerInfo.Created)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing creation time '%v' of container '%v'", containerInfo.Created, containerName)
+		}
+
 		serviceObjectPtr := service.NewService(
 			serviceRegistration,
 			container_status.ContainerStatus_Running,
 			privatePorts,
 			maybePublicIp,
-			maybePublicPortSpecs)
+			maybePublicPortSpecs,
+			noReplicaPrivateIps,
+			&creationTime)
 
 		shouldDeleteVolumes = false
 		shouldKillContainer = false
@@ -427,6 +600,27 @@ func createStartServiceOperation(
 	}
 }
 
+// getDockerUlimits converts the ulimits of a service, sorted by name so the container config is deterministic
+func getDockerUlimits(ulimits map[string]*service.Ulimit) []*units.Ulimit {
+	if len(ulimits) == 0 {
+		return nil
+	}
+	ulimitNames := make([]string, 0, len(ulimits))
+	for ulimitName := range ulimits {
+		ulimitNames = append(ulimitNames, ulimitName)
+	}
+	sort.Strings(ulimitNames)
+	dockerUlimits := make([]*units.Ulimit, 0, len(ulimits))
+	for _, ulimitName := range ulimitNames {
+		dockerUlimits = append(dockerUlimits, &units.Ulimit{
+			Name: ulimitName,
+			Hard: ulimits[ulimitName].GetHard(),
+			Soft: ulimits[ulimitName].GetSoft(),
+		})
+	}
+	return dockerUlimits
+}
+
 // Ensure that provided [privatePorts] and [publicPorts] are one to one by checking:
 // - There is a matching publicPort for every portID in privatePorts
 // - There are the same amount of private and public ports
@@ -444,7 +638,87 @@ func checkPrivateAndPublicPortsAreOneToOne(privatePorts map[string]*port_spec.Po
 	return nil
 }
 
-// Registers a user service for each given serviceName, allocating each an IP and ServiceUUID
+// Checks the host ports requested by the services against the ones already published by other containers, and against
+// each other. Depending on [shouldFallBackToEphemeralPort], a service with conflicting host ports either gets an error
+// describing the conflicts, or has the conflicting ports returned so that they get published on ephemeral host ports
+func resolveHostPortConflicts(
+	serviceConfigs map[service.ServiceUUID]*service.ServiceConfig,
+	containersPublishingHostPorts map[uint16]*docker_manager_types.Container,
+	shouldFallBackToEphemeralPort bool,
+) (
+	map[service.ServiceUUID]map[string]bool,
+	map[service.ServiceUUID]error,
+) {
+	portIdsToPublishEphemerally := map[service.ServiceUUID]map[string]bool{}
+	conflictErrs := map[service.ServiceUUID]error{}
+
+	// Sorted so that, when several services request the same host port, the same one always gets it
+	serviceUuids := []string{}
+	for serviceUuid := range serviceConfigs {
+		serviceUuids = append(serviceUuids, string(serviceUuid))
+	}
+	sort.Strings(serviceUuids)
+
+	hostPortsClaimedByServices := map[uint16]service.ServiceUUID{}
+	for _, serviceUuidStr := range serviceUuids {
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+		publicPorts := serviceConfigs[serviceUuid].GetPublicPorts()
+
+		portIds := []string{}
+		for portId := range publicPorts {
+			portIds = append(portIds, portId)
+		}
+		sort.Strings(portIds)
+
+		conflictingPortIds := map[string]bool{}
+		conflictDescriptions := []string{}
+		for _, portId := range portIds {
+			hostPort := publicPorts[portId].GetNumber()
+			if container, found := containersPublishingHostPorts[hostPort]; found {
+				conflictingPortIds[portId] = true
+				conflictDescriptions = append(conflictDescriptions, fmt.Sprintf(
+					"host port '%v' requested for port '%v' is already published by container '%v' with ID '%v'",
+					hostPort,
+					portId,
+					container.GetName(),
+					container.GetId(),
+				))
+				continue
+			}
+			if otherServiceUuid, found := hostPortsClaimedByServices[hostPort]; found && otherServiceUuid != serviceUuid {
+				conflictingPortIds[portId] = true
+				conflictDescriptions = append(conflictDescriptions, fmt.Sprintf(
+					"host port '%v' requested for port '%v' is also requested by service with UUID '%v'",
+					hostPort,
+					portId,
+					otherServiceUuid,
+				))
+				continue
+			}
+			hostPortsClaimedByServices[hostPort] = serviceUuid
+		}
+		if len(conflictDescriptions) == 0 {
+			continue
+		}
+
+		if !shouldFallBackToEphemeralPort {
+			conflictErrs[serviceUuid] = stacktrace.NewError(
+				"Service with UUID '%v' can't be started because some of the host ports it requests are unavailable:\n%v",
+				serviceUuid,
+				strings.Join(conflictDescriptions, "\n"),
+			)
+			continue
+		}
+		for _, conflictDescription := range conflictDescriptions {
+			logrus.Warnf("Service with UUID '%v': %v; publishing it on an ephemeral host port instead", serviceUuid, conflictDescription)
+		}
+		portIdsToPublishEphemerally[serviceUuid] = conflictingPortIds
+	}
+	return portIdsToPublishEphemerally, conflictErrs
+}
+
+// Registers a user service for each given serviceName, allocating each an IP and ServiceUUID, plus an IPv6 address if
+// the enclave network has IPv6 enabled
 func registerUserServices(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceNames map[service.ServiceName]bool,
@@ -461,6 +735,10 @@ func registerUserServices(
 	successfulRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
 	failedRegistrations := map[service.ServiceName]error{}
 	for serviceName := range serviceNames {
+		if err := service.ValidateServiceName(serviceName); err != nil {
+			failedRegistrations[serviceName] = stacktrace.Propagate(err, "Service name '%v' is invalid", serviceName)
+			continue
+		}
 		ipAddr, err := freeIpAddrProvider.GetFreeIpAddr()
 		if err != nil {
 			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred getting a free IP address to give to service '%v' in enclave '%v'", serviceName, enclaveUuid)
@@ -475,6 +753,23 @@ func registerUserServices(
 			}
 		}()
 
+		var ipv6Addr net.IP
+		if freeIpAddrProvider.IsIpv6Enabled() {
+			ipv6Addr, err = freeIpAddrProvider.GetFreeIpv6Addr()
+			if err != nil {
+				failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred getting a free IPv6 address to give to service '%v' in enclave '%v'", serviceName, enclaveUuid)
+				continue
+			}
+		}
+		shouldFreeIpv6 := ipv6Addr != nil
+		defer func() {
+			if shouldFreeIpv6 {
+				if err = freeIpAddrProvider.ReleaseIpAddr(ipv6Addr); err != nil {
+					logrus.Errorf("Error releasing IPv6 address '%v'", ipv6Addr)
+				}
+			}
+		}()
+
 		uuidStr, err := uuid_generator.GenerateUUIDString()
 		if err != nil {
 			failedRegistrations[serviceName] = stacktrace.Propagate(err, "An error occurred generating a UUID to use for the service UUID")
@@ -482,11 +777,12 @@ func registerUserServices(
 		}
 
 		serviceUuid := service.ServiceUUID(uuidStr)
-		registration := service.NewServiceRegistration(
+		registration := service.NewDualStackServiceRegistration(
 			serviceName,
 			serviceUuid,
 			enclaveUuid,
 			ipAddr,
+			ipv6Addr,
 			string(serviceName), // in Docker, hostname = serviceName because we're setting the "alias" of the container to serviceName
 		)
 
@@ -499,6 +795,7 @@ func registerUserServices(
 		}()
 
 		shouldFreeIp = false
+		shouldFreeIpv6 = false
 		shouldRemoveRegistration = false
 		successfulRegistrations[serviceName] = registration
 	}
@@ -514,3 +811,38 @@ func registerUserServices(
 
 	return successfulRegistrations, failedRegistrations, nil
 }
+
+// getDockerBindMounts turns the host directory mounts of a service, specified as mountpoint -> host dirpath, into the
+// host dirpath -> mountpoint bind mounts Docker takes, checking that Docker can actually mount them
+func getDockerBindMounts(
+	hostDirMounts map[string]string,
+	filesArtifactsExpansion *files_artifacts_expansion.FilesArtifactsExpansion,
+) (map[string]string, error) {
+	filesArtifactMountpoints := map[string]bool{}
+	if filesArtifactsExpansion != nil {
+		for _, mountpoint := range filesArtifactsExpansion.ExpanderDirpathsToServiceDirpaths {
+			filesArtifactMountpoints[mountpoint] = true
+		}
+	}
+
+	bindMounts := map[string]string{}
+	for mountpoint, hostDirpath := range hostDirMounts {
+		for _, dirpath := range []string{mountpoint, hostDirpath} {
+			if !path.IsAbs(dirpath) {
+				return nil, stacktrace.NewError("Host directory mount '%v' -> '%v' must only use absolute paths, but '%v' isn't", mountpoint, hostDirpath, dirpath)
+			}
+			// Docker bind mounts are passed as 'host_dirpath:mountpoint'
+			if strings.Contains(dirpath, bindMountSeparator) {
+				return nil, stacktrace.NewError("Host directory mount '%v' -> '%v' can't use '%v' in its paths, but '%v' does", mountpoint, hostDirpath, bindMountSeparator, dirpath)
+			}
+		}
+		if filesArtifactMountpoints[mountpoint] {
+			return nil, stacktrace.NewError("Mountpoint '%v' is used by both a host directory and a files artifact", mountpoint)
+		}
+		if otherMountpoint, found := bindMounts[hostDirpath]; found {
+			return nil, stacktrace.NewError("Host directory '%v' is mounted on both '%v' and '%v', but it can only be mounted once", hostDirpath, otherMountpoint, mountpoint)
+		}
+		bindMounts[hostDirpath] = mountpoint
+	}
+	return bindMounts, nil
+}
@@ -0,0 +1,128 @@
+package user_service_functions
+
+import (
+	"github.com/docker/go-units"
+	docker_manager_types "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/files_artifacts_expansion"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	firstServiceUuid  = service.ServiceUUID("aaaaaaaa")
+	secondServiceUuid = service.ServiceUUID("bbbbbbbb")
+
+	rpcPortId  = "rpc"
+	httpPortId = "http"
+
+	rpcPortNum  = uint16(8545)
+	httpPortNum = uint16(8080)
+)
+
+func TestResolveHostPortConflicts_ConflictWithExistingContainerFails(t *testing.T) {
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{
+		firstServiceUuid: newServiceConfigWithPublicPorts(t, map[string]uint16{rpcPortId: rpcPortNum, httpPortId: httpPortNum}),
+	}
+	containersPublishingHostPorts := map[uint16]*docker_manager_types.Container{
+		rpcPortNum: docker_manager_types.NewContainer("container-id", "other-container", "", nil, docker_manager_types.ContainerStatus_Running, nil, time.Time{}),
+	}
+
+	portIdsToPublishEphemerally, conflictErrs := resolveHostPortConflicts(serviceConfigs, containersPublishingHostPorts, false)
+	require.Empty(t, portIdsToPublishEphemerally)
+	require.Len(t, conflictErrs, 1)
+	require.Contains(t, conflictErrs[firstServiceUuid].Error(), "other-container")
+}
+
+func TestResolveHostPortConflicts_ConflictWithExistingContainerFallsBackToEphemeralPort(t *testing.T) {
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{
+		firstServiceUuid: newServiceConfigWithPublicPorts(t, map[string]uint16{rpcPortId: rpcPortNum, httpPortId: httpPortNum}),
+	}
+	containersPublishingHostPorts := map[uint16]*docker_manager_types.Container{
+		rpcPortNum: docker_manager_types.NewContainer("container-id", "other-container", "", nil, docker_manager_types.ContainerStatus_Running, nil, time.Time{}),
+	}
+
+	portIdsToPublishEphemerally, conflictErrs := resolveHostPortConflicts(serviceConfigs, containersPublishingHostPorts, true)
+	require.Empty(t, conflictErrs)
+	require.Equal(t, map[service.ServiceUUID]map[string]bool{firstServiceUuid: {rpcPortId: true}}, portIdsToPublishEphemerally)
+}
+
+func TestResolveHostPortConflicts_ServicesRequestingTheSameHostPort(t *testing.T) {
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{
+		firstServiceUuid:  newServiceConfigWithPublicPorts(t, map[string]uint16{rpcPortId: rpcPortNum}),
+		secondServiceUuid: newServiceConfigWithPublicPorts(t, map[string]uint16{rpcPortId: rpcPortNum}),
+	}
+
+	portIdsToPublishEphemerally, conflictErrs := resolveHostPortConflicts(serviceConfigs, map[uint16]*docker_manager_types.Container{}, false)
+	require.Empty(t, portIdsToPublishEphemerally)
+	require.Len(t, conflictErrs, 1)
+	require.Contains(t, conflictErrs[secondServiceUuid].Error(), string(firstServiceUuid))
+}
+
+func TestGetDockerUlimits(t *testing.T) {
+	ulimits := map[string]*service.Ulimit{
+		"nproc":  service.NewUlimit(4096, 8192),
+		"nofile": service.NewUlimit(65536, 65536),
+	}
+	expectedDockerUlimits := []*units.Ulimit{
+		{Name: "nofile", Hard: 65536, Soft: 65536},
+		{Name: "nproc", Hard: 8192, Soft: 4096},
+	}
+	require.Equal(t, expectedDockerUlimits, getDockerUlimits(ulimits))
+	require.Nil(t, getDockerUlimits(nil))
+}
+
+func TestGetDockerBindMounts(t *testing.T) {
+	bindMounts, err := getDockerBindMounts(map[string]string{"/app/src": "/home/dev/project/src"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"/home/dev/project/src": "/app/src"}, bindMounts)
+
+	_, err = getDockerBindMounts(map[string]string{"/app/src": "project/src"}, nil)
+	require.Error(t, err)
+
+	_, err = getDockerBindMounts(map[string]string{"/app/src": "/home/dev/project:src"}, nil)
+	require.Error(t, err)
+
+	_, err = getDockerBindMounts(map[string]string{"/app/src": "/home/dev/project/src", "/app/lib": "/home/dev/project/src"}, nil)
+	require.Error(t, err)
+
+	filesArtifactsExpansion := &files_artifacts_expansion.FilesArtifactsExpansion{
+		ExpanderImage:                     "expander",
+		ExpanderEnvVars:                   nil,
+		ExpanderDirpathsToServiceDirpaths: map[string]string{"/files-artifacts/config": "/app/src"},
+	}
+	_, err = getDockerBindMounts(map[string]string{"/app/src": "/home/dev/project/src"}, filesArtifactsExpansion)
+	require.Error(t, err)
+}
+
+func TestGroupServiceUuidsByImage(t *testing.T) {
+	thirdServiceUuid := service.ServiceUUID("cccccccc")
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{
+		firstServiceUuid:  newServiceConfigWithImage("postgres:15"),
+		secondServiceUuid: newServiceConfigWithImage("postgres:15"),
+		thirdServiceUuid:  newServiceConfigWithImage("redis:7"),
+	}
+	expectedServiceUuidsByImage := map[string]map[service.ServiceUUID]bool{
+		"postgres:15": {firstServiceUuid: true, secondServiceUuid: true},
+		"redis:7":     {thirdServiceUuid: true},
+	}
+	require.Equal(t, expectedServiceUuidsByImage, groupServiceUuidsByImage(serviceConfigs))
+}
+
+func newServiceConfigWithImage(imageName string) *service.ServiceConfig {
+	return service.NewServiceConfig(imageName, nil, nil, nil, nil, nil, nil, 0, 0, "", "", nil, nil, 0, "", "", nil, nil, 0, nil)
+}
+
+func newServiceConfigWithPublicPorts(t *testing.T, publicPortNums map[string]uint16) *service.ServiceConfig {
+	privatePorts := map[string]*port_spec.PortSpec{}
+	publicPorts := map[string]*port_spec.PortSpec{}
+	for portId, portNum := range publicPortNums {
+		portSpec, err := port_spec.NewPortSpec(portNum, port_spec.TransportProtocol_TCP, "")
+		require.NoError(t, err)
+		privatePorts[portId] = portSpec
+		publicPorts[portId] = portSpec
+	}
+	return service.NewServiceConfig("image", privatePorts, publicPorts, nil, nil, nil, nil, 0, 0, "", "", nil, nil, 0, "", "", nil, nil, 0, nil)
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/stacktrace"
 )
 
+const (
+	unpauseOperationDescription = "unpause"
+)
+
 func UnpauseService(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -28,3 +33,31 @@ func UnpauseService(
 	}
 	return nil
 }
+
+// UnpauseServices unpauses the containers of all the services matching the given filters, in parallel
+func UnpauseServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+	dockerManager *docker_manager.DockerManager,
+) (
+	resultSuccessfulServiceUUIDs map[service.ServiceUUID]bool,
+	resultErroredServiceUUIDs map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	var dockerOperation docker_operation_parallelizer.DockerOperation = func(
+		ctx context.Context,
+		dockerManager *docker_manager.DockerManager,
+		dockerObjectId string,
+	) error {
+		if err := dockerManager.UnpauseContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred unpausing user service container with ID '%v'", dockerObjectId)
+		}
+		return nil
+	}
+	successfulUuids, erroredUuids, err := runContainerOperationOnMatchingUserServices(ctx, enclaveUuid, filters, dockerManager, unpauseOperationDescription, dockerOperation)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred unpausing user services matching filters '%+v'", filters)
+	}
+	return successfulUuids, erroredUuids, nil
+}
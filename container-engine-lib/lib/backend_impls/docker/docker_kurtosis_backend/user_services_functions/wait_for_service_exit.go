@@ -0,0 +1,39 @@
+package user_service_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"time"
+)
+
+const (
+	// The service decides when it exits, so only the context bounds the wait
+	noServiceExitTimeout = 0
+
+	serviceExitWaitHeartbeatInterval = 1 * time.Minute
+)
+
+func WaitForServiceExit(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dockerManager *docker_manager.DockerManager,
+) (int32, error) {
+	_, dockerResources, err := shared_helpers.GetSingleUserServiceObjAndResourcesNoMutex(ctx, enclaveId, serviceUuid, dockerManager)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Failed to get information about service '%v' from Kurtosis ", serviceUuid)
+	}
+	container := dockerResources.ServiceContainer
+	if container == nil {
+		return 0, stacktrace.NewError("Cannot wait for service '%v' to exit as it doesn't have a container", serviceUuid)
+	}
+	exitCode, err := dockerManager.WaitForExit(ctx, container.GetId(), noServiceExitTimeout, serviceExitWaitHeartbeatInterval)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Failed to wait for container '%v' of service '%v' to exit", container.GetName(), serviceUuid)
+	}
+	return int32(exitCode), nil
+}
@@ -0,0 +1,59 @@
+package docker_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+	"strings"
+)
+
+const (
+	cpusetListItemSeparator  = ","
+	cpusetListRangeSeparator = "-"
+)
+
+// parseCpusetList parses a list in the Linux cpuset format (e.g. "0-3,6"), used for both CPUs and NUMA nodes, into the
+// IDs it contains
+func parseCpusetList(cpusetList string) ([]uint64, error) {
+	ids := []uint64{}
+	for _, item := range strings.Split(cpusetList, cpusetListItemSeparator) {
+		rangeStartStr, rangeEndStr, isRange := strings.Cut(item, cpusetListRangeSeparator)
+		rangeStart, err := strconv.ParseUint(rangeStartStr, 10, 64)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Item '%v' of cpuset list '%v' isn't an ID or a range of IDs like '0-3'", item, cpusetList)
+		}
+		rangeEnd := rangeStart
+		if isRange {
+			rangeEnd, err = strconv.ParseUint(rangeEndStr, 10, 64)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Item '%v' of cpuset list '%v' isn't an ID or a range of IDs like '0-3'", item, cpusetList)
+			}
+			if rangeEnd < rangeStart {
+				return nil, stacktrace.NewError("Range '%v' of cpuset list '%v' ends before it starts", item, cpusetList)
+			}
+		}
+		for id := rangeStart; id <= rangeEnd; id++ {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// validateCpusetAgainstHost checks that the CPUs of the cpuset list all exist on the host running Docker
+func (manager *DockerManager) validateCpusetAgainstHost(ctx context.Context, cpuset string) error {
+	cpuIds, err := parseCpusetList(cpuset)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing cpuset '%v'", cpuset)
+	}
+	dockerInfo, err := manager.dockerClient.Info(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the number of CPUs of the Docker host")
+	}
+	numHostCpus := uint64(dockerInfo.NCPU)
+	for _, cpuId := range cpuIds {
+		if cpuId >= numHostCpus {
+			return stacktrace.NewError("CPU '%v' doesn't exist as the Docker host only has %v CPUs, numbered from 0 to %v", cpuId, numHostCpus, numHostCpus-1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package docker_manager
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseCpusetList(t *testing.T) {
+	ids, err := parseCpusetList("0-3,6,8-9")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2, 3, 6, 8, 9}, ids)
+}
+
+func TestParseCpusetList_SingleId(t *testing.T) {
+	ids, err := parseCpusetList("2")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, ids)
+}
+
+func TestParseCpusetList_InvalidItem(t *testing.T) {
+	_, err := parseCpusetList("0-3,a")
+	require.Error(t, err)
+}
+
+func TestParseCpusetList_ReversedRange(t *testing.T) {
+	_, err := parseCpusetList("3-1")
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package docker_manager
 
 import (
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"net"
 )
 
@@ -13,6 +14,7 @@ type CreateAndStartContainerArgs struct {
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
+	staticIpv6                               net.IP
 	addedCapabilities                        map[ContainerCapability]bool
 	networkMode                              DockerManagerNetworkMode
 	usedPorts                                map[nat.Port]PortPublishSpec
@@ -25,6 +27,11 @@ type CreateAndStartContainerArgs struct {
 	labels                                   map[string]string
 	cpuAllocationMillicpus                   uint64
 	memoryAllocationMegabytes                uint64
+	cpuset                                   string
+	numaNodes                                string
+	ulimits                                  []*units.Ulimit
+	sysctls                                  map[string]string
+	shmSizeMegabytes                         uint64
 	loggingDriverConfig                      LoggingDriver
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
 }
@@ -37,6 +44,7 @@ type CreateAndStartContainerArgsBuilder struct {
 	interactiveModeTtySize                   *InteractiveModeTtySize // If nil interactive mode will be disabled; if non-nil then interactive mode will be enabled
 	networkId                                string
 	staticIp                                 net.IP
+	staticIpv6                               net.IP
 	addedCapabilities                        map[ContainerCapability]bool
 	networkMode                              DockerManagerNetworkMode
 	usedPorts                                map[nat.Port]PortPublishSpec
@@ -49,6 +57,11 @@ type CreateAndStartContainerArgsBuilder struct {
 	labels                                   map[string]string
 	cpuAllocationMillicpus                   uint64
 	memoryAllocationMegabytes                uint64
+	cpuset                                   string
+	numaNodes                                string
+	ulimits                                  []*units.Ulimit
+	sysctls                                  map[string]string
+	shmSizeMegabytes                         uint64
 	loggingDriverCnfg                        LoggingDriver
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
 }
@@ -68,6 +81,7 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		interactiveModeTtySize:                   nil,
 		networkId:                                networkId,
 		staticIp:                                 nil,
+		staticIpv6:                               nil,
 		addedCapabilities:                        map[ContainerCapability]bool{},
 		networkMode:                              DefaultNetworkMode,
 		usedPorts:                                map[nat.Port]PortPublishSpec{},
@@ -80,6 +94,11 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		labels:                                   map[string]string{},
 		cpuAllocationMillicpus:                   0,
 		memoryAllocationMegabytes:                0,
+		cpuset:                                   "",
+		numaNodes:                                "",
+		ulimits:                                  nil,
+		sysctls:                                  nil,
+		shmSizeMegabytes:                         0,
 		loggingDriverCnfg:                        nil,
 		skipAddingToBridgeNetworkIfStaticIpIsSet: false,
 	}
@@ -94,6 +113,7 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		interactiveModeTtySize:                   builder.interactiveModeTtySize,
 		networkId:                                builder.networkId,
 		staticIp:                                 builder.staticIp,
+		staticIpv6:                               builder.staticIpv6,
 		addedCapabilities:                        builder.addedCapabilities,
 		networkMode:                              builder.networkMode,
 		usedPorts:                                builder.usedPorts,
@@ -105,6 +125,11 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		needsAccessToDockerHostMachine:           builder.needsAccessToDockerHostMachine,
 		cpuAllocationMillicpus:                   builder.cpuAllocationMillicpus,
 		memoryAllocationMegabytes:                builder.memoryAllocationMegabytes,
+		cpuset:                                   builder.cpuset,
+		numaNodes:                                builder.numaNodes,
+		ulimits:                                  builder.ulimits,
+		sysctls:                                  builder.sysctls,
+		shmSizeMegabytes:                         builder.shmSizeMegabytes,
 		loggingDriverConfig:                      builder.loggingDriverCnfg,
 		skipAddingToBridgeNetworkIfStaticIpIsSet: builder.skipAddingToBridgeNetworkIfStaticIpIsSet,
 	}
@@ -129,6 +154,13 @@ func (builder *CreateAndStartContainerArgsBuilder) WithStaticIP(ip net.IP) *Crea
 	return builder
 }
 
+// IPv6 address the container will be assigned on top of its static IP, which only works if the network has IPv6 enabled
+// (leave nil for the container to only get an IPv4 address)
+func (builder *CreateAndStartContainerArgsBuilder) WithStaticIPv6(ip net.IP) *CreateAndStartContainerArgsBuilder {
+	builder.staticIpv6 = ip
+	return builder
+}
+
 // A "set" of capabilities to add to the container, corresponding to the --cap-add Docker flag
 // For more info, see the --cap-add section of https://docs.docker.com/engine/reference/run/
 func (builder *CreateAndStartContainerArgsBuilder) WithAddedCapabilities(capabilities map[ContainerCapability]bool) *CreateAndStartContainerArgsBuilder {
@@ -208,6 +240,43 @@ func (builder *CreateAndStartContainerArgsBuilder) WithMemoryAllocationMegabytes
 	return builder
 }
 
+// Corresponds to `--cpuset-cpus` in Docker: the host CPUs the container is pinned to, in the Linux cpuset list format
+// (e.g. "0-3,6"). The empty value means the container can run on any CPU
+// https://pkg.go.dev/github.com/docker/docker@v20.10.17+incompatible/api/types/container#Resources
+func (builder *CreateAndStartContainerArgsBuilder) WithCpuset(cpuset string) *CreateAndStartContainerArgsBuilder {
+	builder.cpuset = cpuset
+	return builder
+}
+
+// Corresponds to `--cpuset-mems` in Docker: the host NUMA nodes the container can allocate memory on, in the Linux
+// cpuset list format. The empty value means the container can use any NUMA node
+// https://pkg.go.dev/github.com/docker/docker@v20.10.17+incompatible/api/types/container#Resources
+func (builder *CreateAndStartContainerArgsBuilder) WithNumaNodes(numaNodes string) *CreateAndStartContainerArgsBuilder {
+	builder.numaNodes = numaNodes
+	return builder
+}
+
+// Corresponds to `--ulimit` in Docker: the resource limits of the processes of the container (leave as nil to keep
+// the defaults of the Docker daemon)
+func (builder *CreateAndStartContainerArgsBuilder) WithUlimits(ulimits []*units.Ulimit) *CreateAndStartContainerArgsBuilder {
+	builder.ulimits = ulimits
+	return builder
+}
+
+// Corresponds to `--sysctl` in Docker: the kernel parameters to set in the namespaces of the container (leave as nil
+// to keep the defaults). Docker only accepts the namespaced ones
+func (builder *CreateAndStartContainerArgsBuilder) WithSysctls(sysctls map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.sysctls = sysctls
+	return builder
+}
+
+// Corresponds to `--shm-size` in Docker: the size of the /dev/shm of the container, in megabytes. The zero value keeps
+// the default of the Docker daemon, usually 64MB
+func (builder *CreateAndStartContainerArgsBuilder) WithShmSizeMegabytes(shmSizeMegabytes uint64) *CreateAndStartContainerArgsBuilder {
+	builder.shmSizeMegabytes = shmSizeMegabytes
+	return builder
+}
+
 // Will configure the container to use and specific logging driver which can be configured using the different implementations
 func (builder *CreateAndStartContainerArgsBuilder) WithLoggingDriver(loggingDriverConfig LoggingDriver) *CreateAndStartContainerArgsBuilder {
 	builder.loggingDriverCnfg = loggingDriverConfig
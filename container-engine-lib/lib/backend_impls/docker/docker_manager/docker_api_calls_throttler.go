@@ -0,0 +1,210 @@
+package docker_manager
+
+import (
+	"context"
+	"errors"
+	"github.com/docker/docker/errdefs"
+	"github.com/sirupsen/logrus"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// How many Docker API calls can be in flight at once when the Docker engine is keeping up
+	initialConcurrentApiCallsLimit = 32
+	maxConcurrentApiCallsLimit     = 64
+	// The limit never goes below this, so that a struggling Docker engine still gets some work done
+	minConcurrentApiCallsLimit = 4
+
+	// After this many overload signals in a row, no new calls are let through for the cooldown, giving the Docker engine
+	// some breathing room before the calls queued in the meantime hit it
+	numConsecutiveOverloadSignalsToOpenCircuit = 5
+	circuitOpenCooldown                        = 2 * time.Second
+)
+
+// DockerApiCallsStats are counters of what the throttler of the Docker API calls did since the DockerManager got created
+type DockerApiCallsStats struct {
+	// The current number of calls that can be in flight at once
+	ConcurrencyLimit int
+
+	NumInFlightCalls int
+
+	// Calls waiting for a slot right now
+	NumQueuedCalls int
+
+	NumCompletedCalls uint64
+
+	// Calls that had to wait for a slot before going through
+	NumThrottledCalls uint64
+
+	// Calls that failed in a way that means the Docker engine is overloaded (timeouts, 5xx responses)
+	NumOverloadSignals uint64
+
+	// How many times the calls got halted for a cooldown because of consecutive overload signals
+	NumCircuitOpenings uint64
+}
+
+// dockerApiCallsThrottler limits how many Docker API calls are in flight at once, so that starting hundreds of
+// containers in parallel doesn't overwhelm the Docker engine into timing out every call. The limit adapts to how the
+// engine copes: it's halved on every overload signal and grows back by one after a limit's worth of successful calls
+type dockerApiCallsThrottler struct {
+	mutex *sync.Mutex
+
+	// Closed and replaced every time a slot gets freed or the circuit closes, to wake the calls waiting for a slot
+	slotsChangedChan chan struct{}
+
+	concurrencyLimit int
+
+	numSuccessfulCallsSinceLimitIncrease int
+
+	numConsecutiveOverloadSignals int
+
+	// Zero when the circuit is closed
+	circuitOpenUntil time.Time
+
+	stats DockerApiCallsStats
+}
+
+func newDockerApiCallsThrottler() *dockerApiCallsThrottler {
+	return &dockerApiCallsThrottler{
+		mutex:                                &sync.Mutex{},
+		slotsChangedChan:                     make(chan struct{}),
+		concurrencyLimit:                     initialConcurrentApiCallsLimit,
+		numSuccessfulCallsSinceLimitIncrease: 0,
+		numConsecutiveOverloadSignals:        0,
+		circuitOpenUntil:                     time.Time{},
+		stats: DockerApiCallsStats{
+			ConcurrencyLimit:   initialConcurrentApiCallsLimit,
+			NumInFlightCalls:   0,
+			NumQueuedCalls:     0,
+			NumCompletedCalls:  0,
+			NumThrottledCalls:  0,
+			NumOverloadSignals: 0,
+			NumCircuitOpenings: 0,
+		},
+	}
+}
+
+// acquire blocks until the call can go through, and returns the function to call with the result of the call once it
+// returns. If the context gets cancelled while waiting, it returns right away without taking a slot, so that the call
+// fails on the cancelled context by itself
+func (throttler *dockerApiCallsThrottler) acquire(ctx context.Context) func(callErr error) {
+	throttler.mutex.Lock()
+	hasWaited := false
+	for {
+		now := time.Now()
+		isCircuitOpen := now.Before(throttler.circuitOpenUntil)
+		if !isCircuitOpen && throttler.stats.NumInFlightCalls < throttler.concurrencyLimit {
+			break
+		}
+		if !hasWaited {
+			hasWaited = true
+			throttler.stats.NumThrottledCalls++
+			throttler.stats.NumQueuedCalls++
+		}
+		slotsChangedChan := throttler.slotsChangedChan
+		var circuitClosedChan <-chan time.Time
+		if isCircuitOpen {
+			circuitClosedChan = time.After(throttler.circuitOpenUntil.Sub(now))
+		}
+		throttler.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			throttler.mutex.Lock()
+			throttler.stats.NumQueuedCalls--
+			throttler.mutex.Unlock()
+			return func(_ error) {}
+		case <-slotsChangedChan:
+		case <-circuitClosedChan:
+		}
+		throttler.mutex.Lock()
+	}
+	if hasWaited {
+		throttler.stats.NumQueuedCalls--
+	}
+	throttler.stats.NumInFlightCalls++
+	throttler.mutex.Unlock()
+
+	var releaseOnce sync.Once
+	return func(callErr error) {
+		releaseOnce.Do(func() {
+			throttler.release(callErr)
+		})
+	}
+}
+
+// getStats returns a copy of the current counters
+func (throttler *dockerApiCallsThrottler) getStats() DockerApiCallsStats {
+	throttler.mutex.Lock()
+	defer throttler.mutex.Unlock()
+	return throttler.stats
+}
+
+func (throttler *dockerApiCallsThrottler) release(callErr error) {
+	throttler.mutex.Lock()
+	defer throttler.mutex.Unlock()
+
+	throttler.stats.NumInFlightCalls--
+	throttler.stats.NumCompletedCalls++
+	if isDockerEngineOverloadErr(callErr) {
+		throttler.stats.NumOverloadSignals++
+		throttler.numConsecutiveOverloadSignals++
+		throttler.numSuccessfulCallsSinceLimitIncrease = 0
+		newConcurrencyLimit := throttler.concurrencyLimit / 2
+		if newConcurrencyLimit < minConcurrentApiCallsLimit {
+			newConcurrencyLimit = minConcurrentApiCallsLimit
+		}
+		if newConcurrencyLimit != throttler.concurrencyLimit {
+			logrus.Warnf(
+				"The Docker engine looks overloaded (%v); lowering the number of concurrent Docker API calls from %v to %v (stats: %+v)",
+				callErr,
+				throttler.concurrencyLimit,
+				newConcurrencyLimit,
+				throttler.stats,
+			)
+			throttler.concurrencyLimit = newConcurrencyLimit
+		}
+		if throttler.numConsecutiveOverloadSignals >= numConsecutiveOverloadSignalsToOpenCircuit && !time.Now().Before(throttler.circuitOpenUntil) {
+			throttler.stats.NumCircuitOpenings++
+			logrus.Warnf(
+				"The last %v Docker API calls failed because the Docker engine looks overloaded; holding back new calls for %v (stats: %+v)",
+				throttler.numConsecutiveOverloadSignals,
+				circuitOpenCooldown,
+				throttler.stats,
+			)
+			throttler.circuitOpenUntil = time.Now().Add(circuitOpenCooldown)
+			throttler.numConsecutiveOverloadSignals = 0
+		}
+	} else {
+		throttler.numConsecutiveOverloadSignals = 0
+		throttler.numSuccessfulCallsSinceLimitIncrease++
+		if throttler.numSuccessfulCallsSinceLimitIncrease >= throttler.concurrencyLimit && throttler.concurrencyLimit < maxConcurrentApiCallsLimit {
+			throttler.concurrencyLimit++
+			throttler.numSuccessfulCallsSinceLimitIncrease = 0
+			logrus.Debugf("The Docker engine is keeping up; raising the number of concurrent Docker API calls to %v", throttler.concurrencyLimit)
+		}
+	}
+	throttler.stats.ConcurrencyLimit = throttler.concurrencyLimit
+
+	close(throttler.slotsChangedChan)
+	throttler.slotsChangedChan = make(chan struct{})
+}
+
+// isDockerEngineOverloadErr tells whether the call failed because the Docker engine couldn't cope, as opposed to e.g.
+// the object not existing or the request being invalid
+func isDockerEngineOverloadErr(err error) bool {
+	if err == nil || IsHostPortConflictErr(err) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	// These are what the Docker client turns 5xx responses into
+	return errdefs.IsSystem(err) || errdefs.IsUnavailable(err)
+}
@@ -0,0 +1,109 @@
+package docker_manager
+
+import (
+	"context"
+	"github.com/docker/docker/errdefs"
+	"github.com/sirupsen/logrus"
+	logrus_test "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	timeToWaitForBlockedCall = 50 * time.Millisecond
+)
+
+func TestDockerApiCallsThrottler_CallsWaitForAFreeSlot(t *testing.T) {
+	ctx := context.Background()
+	throttler := newDockerApiCallsThrottler()
+
+	releaseFuncs := []func(error){}
+	for i := 0; i < initialConcurrentApiCallsLimit; i++ {
+		releaseFuncs = append(releaseFuncs, throttler.acquire(ctx))
+	}
+
+	acquiredChan := make(chan func(error))
+	go func() {
+		acquiredChan <- throttler.acquire(ctx)
+	}()
+	select {
+	case <-acquiredChan:
+		require.Fail(t, "Expected the call over the limit to wait for a slot")
+	case <-time.After(timeToWaitForBlockedCall):
+	}
+	require.Equal(t, 1, throttler.getStats().NumQueuedCalls)
+
+	releaseFuncs[0](nil)
+	releaseLastCall := <-acquiredChan
+	releaseLastCall(nil)
+
+	stats := throttler.getStats()
+	require.Equal(t, initialConcurrentApiCallsLimit-1, stats.NumInFlightCalls)
+	require.Equal(t, 0, stats.NumQueuedCalls)
+	require.Equal(t, uint64(1), stats.NumThrottledCalls)
+}
+
+func TestDockerApiCallsThrottler_CancelledCallsStopWaiting(t *testing.T) {
+	throttler := newDockerApiCallsThrottler()
+	for i := 0; i < initialConcurrentApiCallsLimit; i++ {
+		throttler.acquire(context.Background())
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), timeToWaitForBlockedCall)
+	defer cancelFunc()
+	release := throttler.acquire(ctx)
+	release(ctx.Err())
+
+	stats := throttler.getStats()
+	require.Equal(t, initialConcurrentApiCallsLimit, stats.NumInFlightCalls)
+	require.Equal(t, 0, stats.NumQueuedCalls)
+	require.Equal(t, uint64(0), stats.NumOverloadSignals)
+}
+
+func TestDockerApiCallsThrottler_LimitAdaptsToOverload(t *testing.T) {
+	ctx := context.Background()
+	throttler := newDockerApiCallsThrottler()
+
+	throttler.acquire(ctx)(errdefs.Unavailable(context.DeadlineExceeded))
+	require.Equal(t, initialConcurrentApiCallsLimit/2, throttler.getStats().ConcurrencyLimit)
+
+	for i := 0; i < numConsecutiveOverloadSignalsToOpenCircuit; i++ {
+		throttler.acquire(ctx)(context.DeadlineExceeded)
+	}
+	stats := throttler.getStats()
+	require.Equal(t, minConcurrentApiCallsLimit, stats.ConcurrencyLimit)
+	require.Equal(t, uint64(1), stats.NumCircuitOpenings)
+
+	// Errors that aren't about the Docker engine coping don't lower the limit
+	throttler.circuitOpenUntil = time.Time{}
+	throttler.acquire(ctx)(errdefs.NotFound(context.Canceled))
+	for i := 0; i < minConcurrentApiCallsLimit; i++ {
+		throttler.acquire(ctx)(nil)
+	}
+	require.Equal(t, minConcurrentApiCallsLimit+1, throttler.getStats().ConcurrencyLimit)
+}
+
+func TestLogApiCallsStatsPeriodically_LogsAtInfoLevelWhenCallsGotThrottled(t *testing.T) {
+	logsHook := logrus_test.NewGlobal()
+	defer logsHook.Reset()
+
+	manager := NewDockerManager(nil)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	manager.LogApiCallsStatsPeriodically(ctx, time.Millisecond)
+
+	manager.apiCallsThrottler.mutex.Lock()
+	manager.apiCallsThrottler.stats.NumThrottledCalls++
+	manager.apiCallsThrottler.mutex.Unlock()
+
+	require.Eventually(t, func() bool {
+		for _, entry := range logsHook.AllEntries() {
+			if entry.Level == logrus.InfoLevel && strings.Contains(entry.Message, "NumThrottledCalls:1") {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+}
@@ -7,6 +7,7 @@ package docker_manager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
@@ -16,15 +17,17 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	docker_manager_types "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
-	"io/ioutil"
 	"math"
 	"net"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -85,6 +88,11 @@ const (
 	// Not sure why we'd ever want 'force' set to false when removing volumes & containers
 	shouldForceVolumeRemoval = true
 
+	// Images still used by a container, even a stopped one, must not be removed from under it
+	shouldForceImageRemoval = false
+	// So that the layers the removed image was the only one using get freed too
+	shouldPruneImageChildrenWhenRemovingImages = true
+
 	shouldRemoveAnonymousVolumesWhenRemovingContainers = true
 	shouldRemoveLinksWhenRemovingContainers            = false // We don't use container links
 	shouldKillContainersWhenRemovingContainers         = true
@@ -97,6 +105,9 @@ const (
 	shouldAttachStdoutWhenCreatingContainerExec               = true
 	shouldExecuteInDetachModeWhenCreatingContainerExec        = false
 
+	// A single snapshot is wanted, rather than a stream of them
+	shouldStreamContainerStats = false
+
 	megabytesToBytesFactor    = 1_000_000
 	millicpusToNanoCPUsFactor = 1_000_000
 
@@ -106,12 +117,25 @@ const (
 
 	cannotKillContainerErrMsg = "cannot kill container"
 
+	// Fragments of the errors Docker returns when a container can't be started because one of the host ports it
+	// should be published to is already taken by another container or process on the host machine
+	hostPortAlreadyAllocatedErrMsg = "port is already allocated"
+	hostAddressAlreadyInUseErrMsg  = "address already in use"
+
+	hostPortNumBase    = 10
+	hostPortNumBitSize = 16
+
 	defaultKillContainerMaxRetries         = uint8(3)
 	defaultKillContainerTimeBetweenRetries = 10 * time.Millisecond
 
 	successfulExitCode = 0
 
+	waitForExitHeartbeatInspectTimeout = 5 * time.Second
+
 	emptyNetworkAlias = ""
+
+	// Seconds and nanoseconds since the Unix epoch
+	containerLogsTimestampOptionFormat = "%d.%09d"
 )
 
 /*
@@ -130,6 +154,9 @@ A handle to interacting with the Docker environment running a test.
 type DockerManager struct {
 	// The underlying Docker client that will be used to modify the Docker environment
 	dockerClient *client.Client
+
+	// Shared by every call made through this manager, so that parallel operations don't overwhelm the Docker engine
+	apiCallsThrottler *dockerApiCallsThrottler
 }
 
 /*
@@ -142,10 +169,48 @@ Args:
 */
 func NewDockerManager(dockerClient *client.Client) *DockerManager {
 	return &DockerManager{
-		dockerClient: dockerClient,
+		dockerClient:      dockerClient,
+		apiCallsThrottler: newDockerApiCallsThrottler(),
 	}
 }
 
+/*
+GetApiCallsStats
+Returns the counters of the throttling of the calls this manager made to the Docker engine, e.g. to tell whether
+operations are slow because the Docker engine is overloaded.
+*/
+func (manager *DockerManager) GetApiCallsStats() DockerApiCallsStats {
+	return manager.apiCallsThrottler.getStats()
+}
+
+/*
+LogApiCallsStatsPeriodically
+Logs the counters of GetApiCallsStats every interval in the background, until the context gets cancelled. They get
+logged at info level when some calls got throttled or overloaded the Docker engine since the last time, and at debug
+level otherwise, so that a busy Docker engine shows up in the logs without the idle periods flooding them.
+*/
+func (manager *DockerManager) LogApiCallsStatsPeriodically(ctx context.Context, interval time.Duration) {
+	lastStats := manager.GetApiCallsStats()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats := manager.GetApiCallsStats()
+				if stats.NumThrottledCalls > lastStats.NumThrottledCalls || stats.NumOverloadSignals > lastStats.NumOverloadSignals {
+					logrus.Infof("Docker API calls stats: %+v", stats)
+				} else {
+					logrus.Debugf("Docker API calls stats: %+v", stats)
+				}
+				lastStats = stats
+			}
+		}
+	}()
+}
+
 /*
 CreateNetwork
 Creates a new Docker network with the given parameters; does nothing if a network with the given name already exists.
@@ -156,25 +221,38 @@ Args:
 	name: The name to give the new Docker network
 	subnetMask: The subnet mask defining allowed IPs for the Docker network
 	gatewayIP: The IP to give the network gateway
+	ipv6SubnetMask: The IPv6 subnet mask defining allowed IPv6 addresses for the Docker network; if empty, the network won't have IPv6 enabled
+	ipv6GatewayIP: The IPv6 address to give the network gateway, ignored if the IPv6 subnet mask is empty
+	ipv6IpRange: The part of the IPv6 subnet that Docker gives addresses from to containers without a static IPv6 address; if empty, the whole subnet
 	labels: Labels to give the network object
 
 Returns:
 
 	id: The Docker-managed ID of the network
 */
-func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, gatewayIP net.IP, labels map[string]string) (id string, err error) {
+func (manager *DockerManager) CreateNetwork(context context.Context, name string, subnetMask string, gatewayIP net.IP, ipv6SubnetMask string, ipv6GatewayIP net.IP, ipv6IpRange string, labels map[string]string) (id string, err error) {
 	ipamConfig := []network.IPAMConfig{{
 		Subnet:     subnetMask,
 		IPRange:    "",
 		Gateway:    gatewayIP.String(),
 		AuxAddress: nil,
 	}}
+	isIpv6Enabled := ipv6SubnetMask != ""
+	if isIpv6Enabled {
+		ipamConfig = append(ipamConfig, network.IPAMConfig{
+			Subnet:     ipv6SubnetMask,
+			IPRange:    ipv6IpRange,
+			Gateway:    ipv6GatewayIP.String(),
+			AuxAddress: nil,
+		})
+	}
 
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	resp, err := manager.dockerClient.NetworkCreate(context, name, types.NetworkCreate{
 		CheckDuplicate: false,
 		Driver:         dockerNetworkDriver,
 		Scope:          "",
-		EnableIPv6:     false,
+		EnableIPv6:     isIpv6Enabled,
 		IPAM: &network.IPAM{
 			Driver:  "",
 			Options: nil,
@@ -188,16 +266,19 @@ func (manager *DockerManager) CreateNetwork(context context.Context, name string
 		Options:    nil,
 		Labels:     labels,
 	})
+	releaseApiCallSlot(err)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "Failed to create network %s with subnet %s", name, subnetMask)
+		return "", stacktrace.Propagate(err, "Failed to create network %s with subnet %s and IPv6 subnet '%s'", name, subnetMask, ipv6SubnetMask)
 	}
 	return resp.ID, nil
 }
 
 func (manager *DockerManager) ListNetworks(ctx context.Context) ([]types.NetworkResource, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	networks, err := manager.dockerClient.NetworkList(ctx, types.NetworkListOptions{
 		Filters: filters.Args{},
 	})
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred listing the Docker networks")
 	}
@@ -250,10 +331,12 @@ func (manager *DockerManager) GetNetworksByLabels(ctx context.Context, labels ma
 }
 
 func (manager *DockerManager) GetContainerIdsConnectedToNetwork(context context.Context, networkId string) ([]string, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	inspectResponse, err := manager.dockerClient.NetworkInspect(context, networkId, types.NetworkInspectOptions{
 		Scope:   "",
 		Verbose: false,
 	})
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to get network information for network with ID '%v'", networkId)
 	}
@@ -278,7 +361,10 @@ Args:
 	networkId: ID of Docker network to remove
 */
 func (manager *DockerManager) RemoveNetwork(context context.Context, networkId string) error {
-	if err := manager.dockerClient.NetworkRemove(context, networkId); err != nil {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
+	err := manager.dockerClient.NetworkRemove(context, networkId)
+	releaseApiCallSlot(err)
+	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred removing the Docker network with ID %v", networkId)
 	}
 	return nil
@@ -310,7 +396,9 @@ func (manager *DockerManager) CreateVolume(context context.Context, volumeName s
 		so *this path is only a path inside the Docker VM* (meaning we can't use it to read/write files). AFAICT, the only way
 		to read/write data to a volume is to mount it in a container. ~ ktoday, 2020-07-01
 	*/
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	_, err := manager.dockerClient.VolumeCreate(context, volumeConfig)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return stacktrace.Propagate(err, "Could not create Docker volume for test controller")
 	}
@@ -336,7 +424,9 @@ func (manager *DockerManager) GetVolumesByName(ctx context.Context, volumeName s
 		Value: volumeName,
 	}
 	filterArgs := filters.NewArgs(nameFilter)
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	resp, err := manager.dockerClient.VolumeList(ctx, filterArgs)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred finding volumes with name matching '%v'", volumeName)
 	}
@@ -354,7 +444,9 @@ Gets the volumes matching the given labels
 */
 func (manager *DockerManager) GetVolumesByLabels(ctx context.Context, labels map[string]string) ([]*types.Volume, error) {
 	labelsFilterArgs := getLabelsFilterArgs(volumeLabelSearchFilterKey, labels)
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	resp, err := manager.dockerClient.VolumeList(ctx, labelsFilterArgs)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred finding volumes with labels '%+v'", labels)
 	}
@@ -367,6 +459,30 @@ func (manager *DockerManager) GetVolumesByLabels(ctx context.Context, labels map
 	return result, nil
 }
 
+/*
+GetVolumesDiskUsageBytes
+Gets the disk used by each volume, keyed by volume name; volumes whose usage Docker couldn't compute are left out
+NOTE: this makes Docker compute the disk usage of all its objects, so it can be slow on a host with lots of them
+*/
+func (manager *DockerManager) GetVolumesDiskUsageBytes(ctx context.Context) (map[string]int64, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	diskUsage, err := manager.dockerClient.DiskUsage(ctx)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Docker disk usage")
+	}
+
+	result := map[string]int64{}
+	for _, volume := range diskUsage.Volumes {
+		// Docker sets the size to -1 when it couldn't compute it
+		if volume.UsageData == nil || volume.UsageData.Size < 0 {
+			continue
+		}
+		result[volume.Name] = volume.UsageData.Size
+	}
+	return result, nil
+}
+
 /*
 RemoveVolume
 Removes a Docker volume identified by the given name, deleting it permanently
@@ -377,14 +493,19 @@ Args:
 	volumeName: The unique identifier used by Docker to identify the volume that will get removed
 */
 func (manager *DockerManager) RemoveVolume(ctx context.Context, volumeName string) error {
-	if err := manager.dockerClient.VolumeRemove(ctx, volumeName, shouldForceVolumeRemoval); err != nil {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	err := manager.dockerClient.VolumeRemove(ctx, volumeName, shouldForceVolumeRemoval)
+	releaseApiCallSlot(err)
+	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred removing volume '%v'", volumeName)
 	}
 	return nil
 }
 
 func (manager *DockerManager) InspectContainer(ctx context.Context, containerId string) (types.ContainerJSON, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	result, err := manager.dockerClient.ContainerInspect(ctx, containerId)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return types.ContainerJSON{}, stacktrace.Propagate(err, "An error occurred inspecting container '%v'", containerId)
 	}
@@ -435,6 +556,18 @@ func (manager *DockerManager) CreateAndStartContainer(
 		return "", nil, stacktrace.NewError("Kurtosis Docker network with ID %v matches several networks!", args.networkId)
 	}
 
+	if args.cpuset != "" {
+		if err := manager.validateCpusetAgainstHost(ctx, args.cpuset); err != nil {
+			return "", nil, stacktrace.Propagate(err, "The cpuset '%v' the container should be pinned to is invalid", args.cpuset)
+		}
+	}
+	if args.numaNodes != "" {
+		// Docker doesn't report the NUMA nodes of the host, so the daemon is left to check they exist
+		if _, err := parseCpusetList(args.numaNodes); err != nil {
+			return "", nil, stacktrace.Propagate(err, "The NUMA nodes '%v' the container should allocate memory on are invalid", args.numaNodes)
+		}
+	}
+
 	isInteractiveMode := args.interactiveModeTtySize != nil
 
 	usedPortsSet := nat.PortSet{}
@@ -463,6 +596,11 @@ func (manager *DockerManager) CreateAndStartContainer(
 		args.needsAccessToDockerHostMachine,
 		args.cpuAllocationMillicpus,
 		args.memoryAllocationMegabytes,
+		args.cpuset,
+		args.numaNodes,
+		args.ulimits,
+		args.sysctls,
+		args.shmSizeMegabytes,
 		args.loggingDriverConfig)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Failed to configure host to container mappings from service.")
@@ -471,7 +609,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// note a nil network config would connect to bridge network by default
 	var networkConfig *network.NetworkingConfig
 	if args.staticIp != nil && args.skipAddingToBridgeNetworkIfStaticIpIsSet {
-		targetNetworkEndPointSettings := getEndpointSettingsForIpAddress(args.staticIp.String(), args.alias)
+		targetNetworkEndPointSettings := getEndpointSettingsForIpAddresses(args.staticIp, args.staticIpv6, args.alias)
 		endpointSettingsByNetworkId := map[string]*network.EndpointSettings{}
 		endpointSettingsByNetworkId[args.networkId] = targetNetworkEndPointSettings
 		networkConfig = &network.NetworkingConfig{
@@ -486,7 +624,9 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// While starting the enclave, adding both bridge & enclave network to the networkConfig just fails
 	// I tried creating the container with networkConfig - nil & args.NetworkMode set to none but that stopped me from adding the container to a network
 	// using manager.ConnectContainerToNetwork
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	containerCreateResp, err := manager.dockerClient.ContainerCreate(ctx, containerConfigPtr, containerHostConfigPtr, networkConfig, nil, args.name)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Could not create Docker container '%v' from image '%v'", args.name, dockerImage)
 	}
@@ -503,7 +643,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 	// static ip is provided and the user wants the connection to bridge network to happen
 	// in the container start the bridge network got connected and now we connect to target network
 	if args.staticIp != nil && !args.skipAddingToBridgeNetworkIfStaticIpIsSet {
-		if err = manager.ConnectContainerToNetwork(ctx, args.networkId, containerId, args.staticIp, args.alias); err != nil {
+		if err = manager.connectContainerToNetwork(ctx, args.networkId, containerId, args.staticIp, args.staticIpv6, args.alias); err != nil {
 			return "", nil, stacktrace.Propagate(err, "Failed to connect container %s to network.", containerId)
 		}
 	}
@@ -513,7 +653,26 @@ func (manager *DockerManager) CreateAndStartContainer(
 		CheckpointID:  "",
 		CheckpointDir: "",
 	}
-	if err = manager.dockerClient.ContainerStart(ctx, containerId, options); err != nil {
+	releaseApiCallSlot = manager.apiCallsThrottler.acquire(ctx)
+	err = manager.dockerClient.ContainerStart(ctx, containerId, options)
+	releaseApiCallSlot(err)
+	if err != nil {
+		if IsHostPortConflictErr(err) {
+			// The container never ran, so there are no logs worth showing; it gets removed so that the caller can create
+			// it again under the same name with other host ports
+			if removeErr := manager.RemoveContainer(context.Background(), containerId); removeErr != nil {
+				logrus.Errorf("Container '%v' couldn't be started because of a host port conflict so we tried to remove it, but doing so threw an error:\n%v", containerId, removeErr)
+			}
+			return "", nil, stacktrace.Propagate(
+				err,
+				"Could not start Docker container '%v' from image '%v' because at least one of the host ports it requested "+
+					"(%v) is already in use by another container or process on the host machine. Free up the port or "+
+					"publish the service on a different host port.",
+				args.name,
+				dockerImage,
+				strings.Join(getManuallyPublishedHostPortStrs(args.usedPorts), ", "),
+			)
+		}
 		containerLogs := manager.getFailedContainerLogsOrErrorString(ctx, containerId)
 		containerLogsHeader := "\n--------------------- CONTAINER LOGS -----------------------\n"
 		containerLogsFooter := "\n------------------- END CONTAINER LOGS --------------------"
@@ -575,7 +734,9 @@ func (manager *DockerManager) CreateAndStartContainer(
 		//  from Docker
 		for i := 0; i < maxNumHostPortBindingChecks; i++ {
 			logrus.Tracef("Trying to get host machine port bindings (%v previous attempts)...", i)
+			releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 			containerInspectResp, err := manager.dockerClient.ContainerInspect(ctx, containerId)
+			releaseApiCallSlot(err)
 			if err != nil {
 				return "", nil, stacktrace.Propagate(
 					err,
@@ -656,7 +817,9 @@ NOTE: Yes, it's a testament to how poorly-designed the Docker API is that we nee
 	everywhere else in the Docker API uses network ID
 */
 func (manager *DockerManager) GetContainerIP(ctx context.Context, networkName string, containerId string) (string, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	resp, err := manager.dockerClient.ContainerInspect(ctx, containerId)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred inspecting container with ID '%v'", containerId)
 	}
@@ -695,7 +858,9 @@ Args:
 	timeout: How long to wait for container stoppage before throwing an error
 */
 func (manager *DockerManager) StopContainer(context context.Context, containerId string, timeout time.Duration) error {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	err := manager.dockerClient.ContainerStop(context, containerId, &timeout)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred stopping container with ID '%v'", containerId)
 	}
@@ -744,7 +909,10 @@ func (manager *DockerManager) RemoveContainer(ctx context.Context, containerId s
 		RemoveLinks:   shouldRemoveLinksWhenRemovingContainers,
 		Force:         shouldKillContainersWhenRemovingContainers,
 	}
-	if err := manager.dockerClient.ContainerRemove(ctx, containerId, removeOpts); err != nil {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	err := manager.dockerClient.ContainerRemove(ctx, containerId, removeOpts)
+	releaseApiCallSlot(err)
+	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred removing container with ID '%v'", containerId)
 	}
 	return nil
@@ -752,31 +920,60 @@ func (manager *DockerManager) RemoveContainer(ctx context.Context, containerId s
 
 /*
 WaitForExit
-Blocks until the given container exits or the context is cancelled.
+Blocks until the given container exits, the timeout elapses or the context is cancelled. While waiting, the state of the
+container is logged every heartbeat interval so that a wedged Docker engine doesn't look like a hang.
 
 Args:
 
-	context: Context the waiting will run in (useful for cancellation)
+	ctx: Context the waiting will run in (useful for cancellation)
 	containerId: The ID of the Docker container that should be waited on
+	timeout: How long to wait for the container to exit at most, 0 to wait forever
+	heartbeatInterval: How often to log that the container is still being waited on, 0 to never log it
 
 Returns:
 
 	exitCode: The exit code of the container if it stopped
-	err: The error if an error occurred waiting for exit
+	err: The error if an error occurred waiting for exit, the timeout elapsed or the context got cancelled
 */
-func (manager *DockerManager) WaitForExit(context context.Context, containerId string) (exitCode int64, err error) {
-	statusChannel, errChannel := manager.dockerClient.ContainerWait(context, containerId, container.WaitConditionNotRunning)
+func (manager *DockerManager) WaitForExit(ctx context.Context, containerId string, timeout time.Duration, heartbeatInterval time.Duration) (exitCode int64, err error) {
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancelWait context.CancelFunc
+		waitCtx, cancelWait = context.WithTimeout(ctx, timeout)
+		defer cancelWait()
+	}
+	statusChannel, errChannel := manager.dockerClient.ContainerWait(waitCtx, containerId, container.WaitConditionNotRunning)
+
+	var heartbeatChannel <-chan time.Time
+	if heartbeatInterval > 0 {
+		heartbeatTicker := time.NewTicker(heartbeatInterval)
+		defer heartbeatTicker.Stop()
+		heartbeatChannel = heartbeatTicker.C
+	}
+	waitStartTime := time.Now()
 
 	// Blocks until one of the channels returns
-	select {
-	case channErr := <-errChannel:
-		exitCode = 1
-		err = stacktrace.Propagate(channErr, "Failed to wait for container to return.")
-	case status := <-statusChannel:
-		exitCode = status.StatusCode
-		err = nil
+	for {
+		select {
+		case channErr := <-errChannel:
+			if ctx.Err() != nil {
+				return 1, stacktrace.Propagate(ctx.Err(), "Waiting for container '%v' to exit was cancelled", containerId)
+			}
+			if waitCtx.Err() != nil {
+				return 1, stacktrace.NewError("Container '%v' didn't exit within %v; it was last in state '%v'", containerId, timeout, manager.getContainerStateStrForWaitHeartbeat(ctx, containerId))
+			}
+			return 1, stacktrace.Propagate(channErr, "Failed to wait for container to return.")
+		case status := <-statusChannel:
+			return status.StatusCode, nil
+		case <-heartbeatChannel:
+			logrus.Infof(
+				"Still waiting for container '%v' to exit after %v; it is in state '%v'",
+				containerId,
+				time.Since(waitStartTime).Round(time.Second),
+				manager.getContainerStateStrForWaitHeartbeat(waitCtx, containerId),
+			)
+		}
 	}
-	return
 }
 
 /*
@@ -790,12 +987,27 @@ func (manager *DockerManager) GetContainerLogs(
 	ctx context.Context,
 	containerId string,
 	shouldFollowLogs bool,
+) (io.ReadCloser, error) {
+	return manager.GetContainerLogsInTimeRange(ctx, containerId, shouldFollowLogs, time.Time{}, time.Time{})
+}
+
+/*
+GetContainerLogsInTimeRange is GetContainerLogs, but only with the lines logged between since and until; the zero time
+leaves that end of the range unbounded. The filtering is done by the Docker engine, so the lines outside the range
+never get read
+*/
+func (manager *DockerManager) GetContainerLogsInTimeRange(
+	ctx context.Context,
+	containerId string,
+	shouldFollowLogs bool,
+	since time.Time,
+	until time.Time,
 ) (io.ReadCloser, error) {
 	containerLogOpts := types.ContainerLogsOptions{
 		ShowStdout: true,
 		ShowStderr: true,
-		Since:      "",
-		Until:      "",
+		Since:      getContainerLogsTimestampOption(since),
+		Until:      getContainerLogsTimestampOption(until),
 		Timestamps: false,
 		Follow:     shouldFollowLogs,
 		Tail:       "",
@@ -808,12 +1020,43 @@ func (manager *DockerManager) GetContainerLogs(
 	return readCloser, nil
 }
 
+// The Docker engine takes the bounds of the logs as Unix timestamps with fractional seconds, or empty for no bound
+func getContainerLogsTimestampOption(timestamp time.Time) string {
+	if timestamp.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf(containerLogsTimestampOptionFormat, timestamp.Unix(), timestamp.Nanosecond())
+}
+
+/*
+GetContainerStats
+Gets a snapshot of the resource usage of the given container. The Docker engine samples the CPU usage twice for it, so
+that the CPU share can be computed from the difference, which makes this call take about a second
+*/
+func (manager *DockerManager) GetContainerStats(ctx context.Context, containerId string) (*types.StatsJSON, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	containerStats, err := manager.dockerClient.ContainerStats(ctx, containerId, shouldStreamContainerStats)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the stats of container '%v'", containerId)
+	}
+	defer containerStats.Body.Close()
+
+	stats := &types.StatsJSON{} //nolint:exhaustruct
+	if err := json.NewDecoder(containerStats.Body).Decode(stats); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding the stats of container '%v'", containerId)
+	}
+	return stats, nil
+}
+
 /*
 PauseContainer
 Pauses all processes running in the given container, but does not shut it down.
 */
 func (manager *DockerManager) PauseContainer(context context.Context, containerId string) error {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	err := manager.dockerClient.ContainerPause(context, containerId)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return stacktrace.Propagate(err, "Docker client failed to pause container '%v'", containerId)
 	}
@@ -825,13 +1068,44 @@ UnpauseContainer
 Unpauses all processes running in the given container.
 */
 func (manager *DockerManager) UnpauseContainer(context context.Context, containerId string) error {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	err := manager.dockerClient.ContainerUnpause(context, containerId)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return stacktrace.Propagate(err, "Docker client failed to unpause container '%v'", containerId)
 	}
 	return nil
 }
 
+/*
+RestartContainer
+Stops the container with the given ID, waiting for the provided timeout before forcefully terminating it, and starts it
+again with the same config
+*/
+func (manager *DockerManager) RestartContainer(context context.Context, containerId string, timeout time.Duration) error {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
+	err := manager.dockerClient.ContainerRestart(context, containerId, &timeout)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return stacktrace.Propagate(err, "Docker client failed to restart container '%v'", containerId)
+	}
+	return nil
+}
+
+/*
+SendSignalToContainer
+Sends the given signal (e.g. SIGHUP) to the main process of the given container, without stopping the container
+*/
+func (manager *DockerManager) SendSignalToContainer(context context.Context, containerId string, signal string) error {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
+	err := manager.dockerClient.ContainerKill(context, containerId, signal)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return stacktrace.Propagate(err, "Docker client failed to send signal '%v' to container '%v'", signal, containerId)
+	}
+	return nil
+}
+
 /*
 RunExecCommand
 Executes the given command inside the container with the given ID, blocking until the command completes
@@ -915,25 +1189,27 @@ Connects the container with the given container ID to the network with the given
 If the IP address passed is nil then we get a random ip address
 */
 func (manager *DockerManager) ConnectContainerToNetwork(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, alias string) (err error) {
+	return manager.connectContainerToNetwork(ctx, networkId, containerId, staticIpAddr, nil, alias)
+}
+
+func (manager *DockerManager) connectContainerToNetwork(ctx context.Context, networkId string, containerId string, staticIpAddr net.IP, staticIpv6Addr net.IP, alias string) (err error) {
 	logrus.Tracef(
-		"Connecting container ID %v to network ID %v using static IP %v",
+		"Connecting container ID %v to network ID %v using static IP %v and static IPv6 %v",
 		containerId,
 		networkId,
-		staticIpAddr.String())
+		staticIpAddr.String(),
+		staticIpv6Addr.String())
 
-	staticIpAddressStr := ""
-	if staticIpAddr != nil {
-		staticIpAddressStr = staticIpAddr.String()
-	}
-
-	config := getEndpointSettingsForIpAddress(staticIpAddressStr, alias)
+	config := getEndpointSettingsForIpAddresses(staticIpAddr, staticIpv6Addr, alias)
 
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	err = manager.dockerClient.NetworkConnect(
 		ctx,
 		networkId,
 		containerId,
 		config,
 	)
+	releaseApiCallSlot(err)
 
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to connect container %s to network with ID %s.", containerId, networkId)
@@ -942,7 +1218,10 @@ func (manager *DockerManager) ConnectContainerToNetwork(ctx context.Context, net
 }
 
 func (manager *DockerManager) DisconnectContainerFromNetwork(ctx context.Context, containerId string, networkId string) error {
-	if err := manager.dockerClient.NetworkDisconnect(ctx, networkId, containerId, true); err != nil {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	err := manager.dockerClient.NetworkDisconnect(ctx, networkId, containerId, true)
+	releaseApiCallSlot(err)
+	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred disconnecting container '%v' from network '%v'", containerId, networkId)
 	}
 	return nil
@@ -1000,20 +1279,86 @@ func (manager *DockerManager) FetchImage(ctx context.Context, dockerImage string
 }
 
 func (manager *DockerManager) PullImage(context context.Context, imageName string) (err error) {
+	return manager.PullImageWithProgress(context, imageName, func(pulledBytes uint64, totalBytes uint64) {})
+}
+
+// PullImageWithProgress pulls the image, calling the progress callback with the bytes of the image layers downloaded so
+// far and their total size as the pull goes
+func (manager *DockerManager) PullImageWithProgress(context context.Context, imageName string, progressCallback func(pulledBytes uint64, totalBytes uint64)) (err error) {
 	logrus.Infof("Pulling image '%s'...", imageName)
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	out, err := manager.dockerClient.ImagePull(context, imageName, types.ImagePullOptions{
 		All:           false,
 		RegistryAuth:  "",
 		PrivilegeFunc: nil,
 		Platform:      "",
 	})
+	releaseApiCallSlot(err)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to pull image %s", imageName)
 	}
 	defer out.Close()
-	_, err = io.Copy(ioutil.Discard, out)
+	if err = readImagePullOutput(out, progressCallback); err != nil {
+		return stacktrace.Propagate(err, "Failed to pull image %s", imageName)
+	}
+	return nil
+}
+
+/*
+GetImageSizeBytes
+Gets the size on disk of the given image
+
+Returns:
+
+	sizeBytes: The size of the image, including the layers it shares with other images
+	found: False if the image isn't present on the host
+*/
+func (manager *DockerManager) GetImageSizeBytes(ctx context.Context, imageName string) (int64, bool, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	imageInfo, _, err := manager.dockerClient.ImageInspectWithRaw(ctx, imageName)
+	releaseApiCallSlot(err)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred discarding the output")
+		if client.IsErrNotFound(err) {
+			return 0, false, nil
+		}
+		return 0, false, stacktrace.Propagate(err, "An error occurred inspecting image '%v'", imageName)
+	}
+	return imageInfo.Size, true, nil
+}
+
+/*
+GetHostResources
+Gets the resources of the host running Docker
+
+Returns:
+
+	numCpus: The number of CPUs of the host
+	memoryBytes: The total memory of the host
+*/
+func (manager *DockerManager) GetHostResources(ctx context.Context) (uint64, uint64, error) {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	dockerInfo, err := manager.dockerClient.Info(ctx)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "An error occurred getting the info of the Docker host")
+	}
+	return uint64(dockerInfo.NCPU), uint64(dockerInfo.MemTotal), nil
+}
+
+/*
+RemoveImage
+Removes the given image from the host; this fails if a container, even a stopped one, still uses it
+*/
+func (manager *DockerManager) RemoveImage(ctx context.Context, imageName string) error {
+	removeOpts := types.ImageRemoveOptions{
+		Force:         shouldForceImageRemoval,
+		PruneChildren: shouldPruneImageChildrenWhenRemovingImages,
+	}
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	_, err := manager.dockerClient.ImageRemove(ctx, imageName, removeOpts)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing image '%v'", imageName)
 	}
 	return nil
 }
@@ -1033,7 +1378,9 @@ func (manager *DockerManager) CreateContainerExec(context context.Context, conta
 		Cmd:          cmd,
 	}
 
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(context)
 	response, err := manager.dockerClient.ContainerExecCreate(context, containerId, config)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while creating the ContainerExec in container with ID '%v'", containerId)
 	}
@@ -1060,10 +1407,12 @@ func (manager *DockerManager) CreateContainerExec(context context.Context, conta
 // The caller must close the result
 func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId string, srcPath string) (io.ReadCloser, error) {
 
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	tarStreamReadCloser, _, err := manager.dockerClient.CopyFromContainer(
 		ctx,
 		containerId,
 		srcPath)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred copying content '%v' from container with ID '%v'", srcPath, containerId)
 	}
@@ -1071,6 +1420,26 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 	return tarStreamReadCloser, nil
 }
 
+// CopyToContainer extracts the given TAR'd files, which can be gzipped, into the dstDirpath directory of the container
+func (manager *DockerManager) CopyToContainer(ctx context.Context, containerId string, dstDirpath string, content io.Reader) error {
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+	err := manager.dockerClient.CopyToContainer(
+		ctx,
+		containerId,
+		dstDirpath,
+		content,
+		types.CopyToContainerOptions{
+			AllowOverwriteDirWithFile: false,
+			CopyUIDGID:                false,
+		},
+	)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying content to '%v' in container with ID '%v'", dstDirpath, containerId)
+	}
+	return nil
+}
+
 // =================================================================================================================
 //
 //	INSTANCE HELPER FUNCTIONS
@@ -1079,12 +1448,14 @@ func (manager *DockerManager) CopyFromContainer(ctx context.Context, containerId
 func (manager *DockerManager) isImageAvailableLocally(ctx context.Context, imageName string) (bool, error) {
 	referenceArg := filters.Arg("reference", imageName)
 	filters := filters.NewArgs(referenceArg)
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	images, err := manager.dockerClient.ImageList(
 		ctx,
 		types.ImageListOptions{
 			All:     true,
 			Filters: filters,
 		})
+	releaseApiCallSlot(err)
 	if err != nil {
 		return false, stacktrace.Propagate(err, "Failed to list images.")
 	}
@@ -1105,11 +1476,13 @@ func (manager *DockerManager) getNetworksByFilterArgs(ctx context.Context, args
 	// NOTE: Even though this returns a `NetworkResource` object which has a Containers field on it, this is a lie!!
 	// For whatever insane reason, Docker doesn't fill this field out when NetworkList is used and there doesn't seem to
 	// be a way to get it to do so. Instead we'd have to do an InspectNetwork call.
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	networks, err := manager.dockerClient.NetworkList(
 		ctx,
 		types.NetworkListOptions{
 			Filters: args,
 		})
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to list networks while doing a filter search using args '%+v'", args)
 	}
@@ -1141,6 +1514,11 @@ func (manager *DockerManager) getContainerHostConfig(
 	needsToAccessDockerHostMachine bool,
 	cpuAllocationMillicpus uint64,
 	memoryAllocationMegabytes uint64,
+	cpuset string,
+	numaNodes string,
+	ulimits []*units.Ulimit,
+	sysctls map[string]string,
+	shmSizeMegabytes uint64,
 	loggingDriverConfig LoggingDriver,
 ) (hostConfig *container.HostConfig, err error) {
 
@@ -1218,8 +1596,8 @@ func (manager *DockerManager) getContainerHostConfig(
 		CPUQuota:             0,
 		CPURealtimePeriod:    0,
 		CPURealtimeRuntime:   0,
-		CpusetCpus:           "",
-		CpusetMems:           "",
+		CpusetCpus:           cpuset,
+		CpusetMems:           numaNodes,
 		Devices:              nil,
 		DeviceCgroupRules:    nil,
 		DeviceRequests:       nil,
@@ -1230,7 +1608,7 @@ func (manager *DockerManager) getContainerHostConfig(
 		MemorySwappiness:     nil,
 		OomKillDisable:       nil,
 		PidsLimit:            nil,
-		Ulimits:              nil,
+		Ulimits:              ulimits,
 		CPUCount:             0,
 		CPUPercent:           0,
 		IOMaximumIOps:        0,
@@ -1297,8 +1675,8 @@ func (manager *DockerManager) getContainerHostConfig(
 		Tmpfs:           nil,
 		UTSMode:         "",
 		UsernsMode:      "",
-		ShmSize:         0,
-		Sysctls:         nil,
+		ShmSize:         int64(convertMegabytesToBytes(shmSizeMegabytes)),
+		Sysctls:         sysctls,
 		Runtime:         "",
 		ConsoleSize:     [2]uint{},
 		Isolation:       "",
@@ -1366,7 +1744,10 @@ func (manager *DockerManager) killContainerWithRetriesWhenErrorResponseFromDeamo
 	var err error
 
 	for i := uint8(0); i < maxRetries; i++ {
-		if err = manager.dockerClient.ContainerKill(ctx, containerId, dockerKillSignal); err != nil {
+		releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
+		err = manager.dockerClient.ContainerKill(ctx, containerId, dockerKillSignal)
+		releaseApiCallSlot(err)
+		if err != nil {
 
 			errMsg := strings.ToLower(err.Error())
 
@@ -1389,6 +1770,35 @@ func (manager *DockerManager) killContainerWithRetriesWhenErrorResponseFromDeamo
 	return stacktrace.Propagate(err, "An error occurred killing container with ID '%v'", containerId)
 }
 
+// GetContainersPublishingHostPorts returns, for each of the given host ports that is already published by a running
+// container on the host machine, the container publishing it
+// Ports that aren't published by any container are absent from the result
+func (manager *DockerManager) GetContainersPublishingHostPorts(ctx context.Context, hostPorts map[uint16]bool) (map[uint16]*docker_manager_types.Container, error) {
+	result := map[uint16]*docker_manager_types.Container{}
+	if len(hostPorts) == 0 {
+		return result, nil
+	}
+
+	shouldShowStoppedContainers := false
+	runningContainers, err := manager.getContainersByFilterArgs(ctx, filters.NewArgs(), shouldShowStoppedContainers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the running containers to check which ones publish host ports '%v'", hostPorts)
+	}
+	for _, container := range runningContainers {
+		for _, hostPortBinding := range container.GetHostPortBindings() {
+			hostPortNum, err := strconv.ParseUint(hostPortBinding.HostPort, hostPortNumBase, hostPortNumBitSize)
+			if err != nil {
+				// Not a host port we could be looking for
+				continue
+			}
+			if _, found := hostPorts[uint16(hostPortNum)]; found {
+				result[uint16(hostPortNum)] = container
+			}
+		}
+	}
+	return result, nil
+}
+
 // Takes in a PortMap (as reported by Docker container inspect) and returns a map of the used ports -> host port binding on the expected interface
 // If no bindings for the interface are found, len(output) < len(input)
 func getHostPortBindingsOnExpectedInterface(hostPortBindingsOnAllInterfaces nat.PortMap) map[nat.Port]*nat.PortBinding {
@@ -1426,7 +1836,9 @@ func (manager *DockerManager) getContainersByFilterArgs(ctx context.Context, fil
 		Limit:   0,
 		Filters: filterArgs,
 	}
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(ctx)
 	dockerContainers, err := manager.dockerClient.ContainerList(ctx, opts)
+	releaseApiCallSlot(err)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the docker containers with filter args '%+v'", filterArgs)
 	}
@@ -1525,9 +1937,12 @@ func newContainerFromDockerContainer(dockerContainer types.Container) (*docker_m
 	newContainer := docker_manager_types.NewContainer(
 		dockerContainer.ID,
 		containerName,
+		dockerContainer.Image,
 		dockerContainer.Labels,
 		containerStatus,
 		containerHostPortBindings,
+		// Docker's ContainerList only reports the creation time to the second
+		time.Unix(dockerContainer.Created, 0),
 	)
 
 	return newContainer, nil
@@ -1580,23 +1995,39 @@ func newNetworkFromDockerNetwork(dockerNetwork types.NetworkResource) (*docker_m
 	if len(dockerNetwork.IPAM.Config) == 0 {
 		return nil, stacktrace.NewError("Kurtosis Docker network with ID %v does not contains any IPAM config.", dockerNetwork.ID)
 	}
-	if len(dockerNetwork.IPAM.Config) > 1 {
-		return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPAM config; this is a bug in Kurtosis itself", dockerNetwork.ID)
-	}
-	firstIpamConfig := dockerNetwork.IPAM.Config[0]
 
-	_, ipAndMask, err := net.ParseCIDR(firstIpamConfig.Subnet)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred parsing CIDR '%v'", firstIpamConfig.Subnet)
+	// Networks have an IPv4 IPAM config, plus an IPv6 one if they have IPv6 enabled
+	var ipAndMask *net.IPNet
+	var gatewayIp string
+	var ipv6AndMask *net.IPNet
+	var ipv6GatewayIp string
+	for _, ipamConfig := range dockerNetwork.IPAM.Config {
+		_, subnet, err := net.ParseCIDR(ipamConfig.Subnet)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing CIDR '%v'", ipamConfig.Subnet)
+		}
+		isIpv4Subnet := subnet.IP.To4() != nil
+		if isIpv4Subnet && ipAndMask == nil {
+			ipAndMask = subnet
+			gatewayIp = ipamConfig.Gateway
+		} else if !isIpv4Subnet && ipv6AndMask == nil {
+			ipv6AndMask = subnet
+			ipv6GatewayIp = ipamConfig.Gateway
+		} else {
+			return nil, stacktrace.NewError("This is an unexpected error Docker network with ID '%v' shouldn't have more than one IPAM config per IP family; this is a bug in Kurtosis itself", dockerNetwork.ID)
+		}
+	}
+	if ipAndMask == nil {
+		return nil, stacktrace.NewError("Kurtosis Docker network with ID %v does not contains any IPv4 IPAM config.", dockerNetwork.ID)
 	}
-
-	gatewayIp := firstIpamConfig.Gateway
 
 	networkWrapper := docker_manager_types.NewNetwork(
 		dockerNetwork.Name,
 		dockerNetwork.ID,
 		ipAndMask,
 		gatewayIp,
+		ipv6AndMask,
+		ipv6GatewayIp,
 		dockerNetwork.Labels,
 	)
 
@@ -1646,10 +2077,19 @@ func convertMillicpusToNanoCPUs(value uint64) uint64 {
 	return value * millicpusToNanoCPUsFactor
 }
 
-func getEndpointSettingsForIpAddress(ipAddress string, alias string) *network.EndpointSettings {
+// Either IP can be nil, for Docker to pick one (or none at all for the IPv6 one if the network doesn't have IPv6 enabled)
+func getEndpointSettingsForIpAddresses(ipAddress net.IP, ipv6Address net.IP, alias string) *network.EndpointSettings {
+	ipAddressStr := ""
+	if ipAddress != nil {
+		ipAddressStr = ipAddress.String()
+	}
+	ipv6AddressStr := ""
+	if ipv6Address != nil {
+		ipv6AddressStr = ipv6Address.String()
+	}
 	ipamConfig := &network.EndpointIPAMConfig{
-		IPv4Address:  ipAddress,
-		IPv6Address:  "",
+		IPv4Address:  ipAddressStr,
+		IPv6Address:  ipv6AddressStr,
 		LinkLocalIPs: nil,
 	}
 
@@ -1676,3 +2116,40 @@ func getEndpointSettingsForIpAddress(ipAddress string, alias string) *network.En
 
 	return config
 }
+
+// IsHostPortConflictErr tells whether starting a container failed because one of the host ports it publishes is taken,
+// either by another container or by some other process on the host
+func IsHostPortConflictErr(err error) bool {
+	errMsg := strings.ToLower(err.Error())
+	return strings.Contains(errMsg, hostPortAlreadyAllocatedErrMsg) || strings.Contains(errMsg, hostAddressAlreadyInUseErrMsg)
+}
+
+func getManuallyPublishedHostPortStrs(usedPorts map[nat.Port]PortPublishSpec) []string {
+	result := []string{}
+	for _, publishSpec := range usedPorts {
+		manualSpec, ok := publishSpec.(*manuallySpecifiedPortPublishSpec)
+		if !ok {
+			continue
+		}
+		result = append(result, strconv.FormatUint(uint64(manualSpec.getHostMachinePortNum()), hostPortNumBase))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// getContainerStateStrForWaitHeartbeat returns the state of the container, or why it couldn't be gotten, with its own
+// timeout so that a wedged Docker engine doesn't block the heartbeat too
+func (manager *DockerManager) getContainerStateStrForWaitHeartbeat(ctx context.Context, containerId string) string {
+	inspectCtx, cancelInspect := context.WithTimeout(ctx, waitForExitHeartbeatInspectTimeout)
+	defer cancelInspect()
+	releaseApiCallSlot := manager.apiCallsThrottler.acquire(inspectCtx)
+	containerInfo, err := manager.dockerClient.ContainerInspect(inspectCtx, containerId)
+	releaseApiCallSlot(err)
+	if err != nil {
+		return fmt.Sprintf("unknown, as inspecting the container failed: %v", err)
+	}
+	if containerInfo.State == nil {
+		return "unknown"
+	}
+	return containerInfo.State.Status
+}
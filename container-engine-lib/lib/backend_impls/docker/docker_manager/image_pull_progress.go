@@ -0,0 +1,99 @@
+package docker_manager
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+)
+
+const (
+	// Statuses of the layers that Docker reports while pulling an image
+	imageLayerDownloadingStatus      = "Downloading"
+	imageLayerDownloadCompleteStatus = "Download complete"
+	imageLayerPullCompleteStatus     = "Pull complete"
+)
+
+// imagePullMessage is one of the JSON messages that Docker streams while pulling an image
+type imagePullMessage struct {
+	Status         string `json:"status"`
+	LayerId        string `json:"id"`
+	ProgressDetail struct {
+		Current uint64 `json:"current"`
+		Total   uint64 `json:"total"`
+	} `json:"progressDetail"`
+	ErrorMessage string `json:"error"`
+}
+
+// imagePullProgressTracker sums the download progress of the layers of an image. The total grows as Docker starts
+// downloading more layers, as their sizes aren't known upfront
+type imagePullProgressTracker struct {
+	layerPulledBytes map[string]uint64
+	layerTotalBytes  map[string]uint64
+}
+
+func newImagePullProgressTracker() *imagePullProgressTracker {
+	return &imagePullProgressTracker{
+		layerPulledBytes: map[string]uint64{},
+		layerTotalBytes:  map[string]uint64{},
+	}
+}
+
+// readImagePullOutput reads the output of an image pull until its end, calling the progress callback each time the
+// progress changes
+func readImagePullOutput(output io.Reader, progressCallback func(pulledBytes uint64, totalBytes uint64)) error {
+	tracker := newImagePullProgressTracker()
+	decoder := json.NewDecoder(output)
+	for {
+		message := &imagePullMessage{}
+		if err := decoder.Decode(message); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return stacktrace.Propagate(err, "An error occurred decoding the output of the image pull")
+		}
+		if message.ErrorMessage != "" {
+			return stacktrace.NewError("The image pull failed with error: %v", message.ErrorMessage)
+		}
+		if tracker.update(message) {
+			progressCallback(tracker.getPulledBytes(), tracker.getTotalBytes())
+		}
+	}
+}
+
+// update returns true if the message changed the progress
+func (tracker *imagePullProgressTracker) update(message *imagePullMessage) bool {
+	switch message.Status {
+	case imageLayerDownloadingStatus:
+		if message.ProgressDetail.Total == 0 {
+			return false
+		}
+		tracker.layerPulledBytes[message.LayerId] = message.ProgressDetail.Current
+		tracker.layerTotalBytes[message.LayerId] = message.ProgressDetail.Total
+		return true
+	case imageLayerDownloadCompleteStatus, imageLayerPullCompleteStatus:
+		layerTotalBytes, found := tracker.layerTotalBytes[message.LayerId]
+		if !found || tracker.layerPulledBytes[message.LayerId] == layerTotalBytes {
+			return false
+		}
+		tracker.layerPulledBytes[message.LayerId] = layerTotalBytes
+		return true
+	default:
+		return false
+	}
+}
+
+func (tracker *imagePullProgressTracker) getPulledBytes() uint64 {
+	pulledBytes := uint64(0)
+	for _, layerPulledBytes := range tracker.layerPulledBytes {
+		pulledBytes += layerPulledBytes
+	}
+	return pulledBytes
+}
+
+func (tracker *imagePullProgressTracker) getTotalBytes() uint64 {
+	totalBytes := uint64(0)
+	for _, layerTotalBytes := range tracker.layerTotalBytes {
+		totalBytes += layerTotalBytes
+	}
+	return totalBytes
+}
@@ -0,0 +1,42 @@
+package docker_manager
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const (
+	imagePullOutput = `{"status":"Pulling from kurtosistech/engine","id":"1.0.0"}
+{"status":"Pulling fs layer","progressDetail":{},"id":"layer1"}
+{"status":"Pulling fs layer","progressDetail":{},"id":"layer2"}
+{"status":"Downloading","progressDetail":{"current":50,"total":100},"progress":"[==>  ]","id":"layer1"}
+{"status":"Downloading","progressDetail":{"current":100,"total":300},"progress":"[=>   ]","id":"layer2"}
+{"status":"Verifying Checksum","progressDetail":{},"id":"layer1"}
+{"status":"Download complete","progressDetail":{},"id":"layer1"}
+{"status":"Extracting","progressDetail":{"current":100,"total":100},"id":"layer1"}
+{"status":"Download complete","progressDetail":{},"id":"layer2"}
+{"status":"Pull complete","progressDetail":{},"id":"layer2"}
+{"status":"Status: Downloaded newer image for kurtosistech/engine:1.0.0"}
+`
+
+	failedImagePullOutput = `{"status":"Pulling from kurtosistech/engine","id":"1.0.0"}
+{"errorDetail":{"message":"manifest unknown"},"error":"manifest unknown"}
+`
+)
+
+func TestReadImagePullOutput_ReportsTheBytesPulledAcrossLayers(t *testing.T) {
+	reportedProgress := [][2]uint64{}
+	err := readImagePullOutput(strings.NewReader(imagePullOutput), func(pulledBytes uint64, totalBytes uint64) {
+		reportedProgress = append(reportedProgress, [2]uint64{pulledBytes, totalBytes})
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][2]uint64{{50, 100}, {150, 400}, {200, 400}, {400, 400}}, reportedProgress)
+}
+
+func TestReadImagePullOutput_FailsOnPullError(t *testing.T) {
+	err := readImagePullOutput(strings.NewReader(failedImagePullOutput), func(pulledBytes uint64, totalBytes uint64) {})
+	require.Error(t, err)
+	require.Contains(t, stacktrace.RootCause(err).Error(), "manifest unknown")
+}
@@ -2,29 +2,36 @@ package types
 
 import (
 	"github.com/docker/go-connections/nat"
+	"time"
 )
 
 type Container struct {
 	id               string
 	name             string
+	imageName        string
 	labels           map[string]string
 	status           ContainerStatus
 	hostPortBindings map[nat.Port]*nat.PortBinding
+	creationTime     time.Time
 }
 
 func NewContainer(
 	id string,
 	name string,
+	imageName string,
 	labels map[string]string,
 	status ContainerStatus,
 	hostPortBindings map[nat.Port]*nat.PortBinding,
+	creationTime time.Time,
 ) *Container {
 	return &Container{
 		id:               id,
 		name:             name,
+		imageName:        imageName,
 		labels:           labels,
 		status:           status,
 		hostPortBindings: hostPortBindings,
+		creationTime:     creationTime,
 	}
 }
 
@@ -36,6 +43,10 @@ func (c *Container) GetName() string {
 	return c.name
 }
 
+func (c *Container) GetImageName() string {
+	return c.imageName
+}
+
 func (c *Container) GetLabels() map[string]string {
 	return c.labels
 }
@@ -47,3 +58,7 @@ func (c *Container) GetStatus() ContainerStatus {
 func (c Container) GetHostPortBindings() map[nat.Port]*nat.PortBinding {
 	return c.hostPortBindings
 }
+
+func (c *Container) GetCreationTime() time.Time {
+	return c.creationTime
+}
@@ -3,16 +3,20 @@ package types
 import "net"
 
 type Network struct {
-	name      string
-	id        string
-	ipAndMask *net.IPNet
-	gatewayIpStr   string
-	labels           map[string]string
+	name         string
+	id           string
+	ipAndMask    *net.IPNet
+	gatewayIpStr string
 
+	// Nil if the network doesn't have IPv6 enabled
+	ipv6AndMask    *net.IPNet
+	ipv6GatewayStr string
+
+	labels map[string]string
 }
 
-func NewNetwork(name string, id string, ipAndMask *net.IPNet, gatewayIpStr string, labels map[string]string) *Network {
-	return &Network{name: name, id: id, ipAndMask: ipAndMask, gatewayIpStr: gatewayIpStr, labels: labels}
+func NewNetwork(name string, id string, ipAndMask *net.IPNet, gatewayIpStr string, ipv6AndMask *net.IPNet, ipv6GatewayStr string, labels map[string]string) *Network {
+	return &Network{name: name, id: id, ipAndMask: ipAndMask, gatewayIpStr: gatewayIpStr, ipv6AndMask: ipv6AndMask, ipv6GatewayStr: ipv6GatewayStr, labels: labels}
 }
 
 func (network Network) GetName() string {
@@ -31,6 +35,15 @@ func (network Network) GetGatewayIp() string {
 	return network.gatewayIpStr
 }
 
+// GetIpv6AndMask returns nil if the network doesn't have IPv6 enabled
+func (network Network) GetIpv6AndMask() *net.IPNet {
+	return network.ipv6AndMask
+}
+
+func (network Network) GetIpv6GatewayIp() string {
+	return network.ipv6GatewayStr
+}
+
 func (network Network) GetLabels() map[string]string {
 	return network.labels
 }
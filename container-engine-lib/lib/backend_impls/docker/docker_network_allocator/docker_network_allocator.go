@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/network_helpers"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -17,10 +18,6 @@ import (
 const (
 	supportedIpAddrBitLength = uint32(32)
 
-	// We hardcode this because the algorithm for finding slots for variable-sized networks is MUCH more complex
-	// This will give 4096 IPs per address; if this isn't enough we can up it in the future
-	networkWidthBits = uint32(12)
-
 	// Docker returns an error with this text when we try to create a network with a CIDR mask
 	//  that overlaps with a preexisting network
 	overlappingAddressSpaceErrStr = "Pool overlaps with other one on this address space"
@@ -28,10 +25,18 @@ const (
 	maxNumNetworkAllocationRetries = 10
 
 	timeBetweenNetworkCreationRetries = 1 * time.Second
+
+	// Enclaves with IPv6 enabled get a /64 unique local address (ULA) subnet, made of the 'fd' prefix, a random 40-bit
+	// global ID and a zero subnet ID, as advised by RFC 4193
+	// See https://www.rfc-editor.org/rfc/rfc4193#section-3.2
+	ipv6NetworkMaskBits          = 64
+	uniqueLocalAddressPrefixByte = 0xfd
+	ipv6GlobalIdStartByteIdx     = 1
+	ipv6GlobalIdEndByteIdx       = 6
+
+	maxNumIpv6NetworkPicks = 10
 )
 
-var networkCidrMask = net.CIDRMask(int(supportedIpAddrBitLength-networkWidthBits), int(supportedIpAddrBitLength))
-var networkWidthUint64 = uint64(math.Pow(float64(2), float64(networkWidthBits)))
 var maxUint32PlusOne = uint64(math.MaxUint32) + 1
 var emptyIpSet = map[string]bool{}
 
@@ -129,29 +134,38 @@ func NewDockerNetworkAllocator(dockerManager *docker_manager.DockerManager) *Doc
 	}
 }
 
+// CreateNewNetwork creates a network whose subnet overlaps neither with the existing Docker networks nor with the
+// excluded subnets (e.g. the ones of the host's network interfaces, which Docker doesn't know about)
+// If the subnet spec has a CIDR the network gets exactly that subnet, otherwise it gets a random free subnet of the
+// requested size
+// If the subnet spec has IPv6 enabled the network also gets a random free IPv6 ULA subnet, which can be read from the
+// network afterwards
 func (provider *DockerNetworkAllocator) CreateNewNetwork(
 	ctx context.Context,
 	networkName string,
 	labels map[string]string,
-) (resultNetworkId string, resultErr error) {
+	excludedSubnets []*net.IPNet,
+	subnetSpec *enclave.EnclaveSubnetSpec,
+) (resultNetworkId string, resultSubnet *net.IPNet, resultErr error) {
 	if !provider.isConstructedViaConstructor {
-		return "", stacktrace.NewError("This instance of Docker network allocator was constructed without the constructor, which means that the rand.Seed won't have been initialized!")
+		return "", nil, stacktrace.NewError("This instance of Docker network allocator was constructed without the constructor, which means that the rand.Seed won't have been initialized!")
 	}
 
 	numRetries := 0
 	for numRetries < maxNumNetworkAllocationRetries {
 		networks, err := provider.dockerManager.ListNetworks(ctx)
 		if err != nil {
-			return "", stacktrace.Propagate(err, "An error occurred listing the Docker networks")
+			return "", nil, stacktrace.Propagate(err, "An error occurred listing the Docker networks")
 		}
 
 		usedSubnets := []*net.IPNet{}
+		usedSubnets = append(usedSubnets, excludedSubnets...)
 		for _, network := range networks {
 			for _, ipamConfig := range network.IPAM.Config {
 				subnetCidrStr := ipamConfig.Subnet
 				_, parsedSubnet, err := net.ParseCIDR(subnetCidrStr)
 				if err != nil {
-					return "", stacktrace.Propagate(
+					return "", nil, stacktrace.Propagate(
 						err,
 						"An error occurred parsing CIDR string '%v' associated with network '%v'",
 						subnetCidrStr,
@@ -162,26 +176,67 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 			}
 		}
 
-		freeNetworkIpAndMask, err := findRandomFreeNetwork(usedSubnets)
-		if err != nil {
-			return "", stacktrace.Propagate(err, "An error occurred finding a free network")
+		requestedSubnet := subnetSpec.GetCidr()
+		if requestedSubnet != nil {
+			// The user picked this subnet on purpose, so it's fine if it's in a private range that we'd skip otherwise
+			for _, usedSubnet := range usedSubnets {
+				if doNetworksOverlap(requestedSubnet, usedSubnet) {
+					return "", nil, stacktrace.NewError(
+						"Network '%v' can't get the requested subnet '%v' because it overlaps with subnet '%v' which is already in use",
+						networkName,
+						requestedSubnet.String(),
+						usedSubnet.String(),
+					)
+				}
+			}
+		}
+
+		freeNetworkIpAndMask := requestedSubnet
+		if freeNetworkIpAndMask == nil {
+			freeNetworkIpAndMask, err = findRandomFreeNetwork(usedSubnets, uint32(subnetSpec.GetMaskBits()))
+			if err != nil {
+				return "", nil, stacktrace.Propagate(err, "An error occurred finding a free network")
+			}
 		}
 
 		gatewayIp, err := network_helpers.GetFreeIpAddrFromSubnet(emptyIpSet, freeNetworkIpAndMask)
 		if err != nil {
-			return "", stacktrace.Propagate(err, "An error occurred getting a free IP for the network gateway")
+			return "", nil, stacktrace.Propagate(err, "An error occurred getting a free IP for the network gateway")
+		}
+
+		ipv6NetworkIpAndMaskStr := ""
+		var ipv6GatewayIp net.IP
+		ipv6IpRangeStr := ""
+		if subnetSpec.IsIpv6Enabled() {
+			ipv6NetworkIpAndMask, err := findRandomFreeIpv6Network(usedSubnets)
+			if err != nil {
+				return "", nil, stacktrace.Propagate(err, "An error occurred finding a free IPv6 network")
+			}
+			ipv6GatewayIp, err = network_helpers.GetFreeIpAddrFromSubnet(map[string]bool{}, ipv6NetworkIpAndMask)
+			if err != nil {
+				return "", nil, stacktrace.Propagate(err, "An error occurred getting a free IPv6 address for the network gateway")
+			}
+			ipv6NetworkIpAndMaskStr = ipv6NetworkIpAndMask.String()
+			// Containers started without a static IPv6 address (e.g. the API container) get theirs from the lower half,
+			// leaving the upper half to the IPs that Kurtosis hands out to services
+			ipv6DynamicRange, _, err := network_helpers.SplitSubnetInHalves(ipv6NetworkIpAndMask)
+			if err != nil {
+				return "", nil, stacktrace.Propagate(err, "An error occurred splitting IPv6 network '%v' in halves", ipv6NetworkIpAndMask)
+			}
+			ipv6IpRangeStr = ipv6DynamicRange.String()
 		}
 
-		networkId, err := provider.dockerManager.CreateNetwork(ctx, networkName, freeNetworkIpAndMask.String(), gatewayIp, labels)
+		networkId, err := provider.dockerManager.CreateNetwork(ctx, networkName, freeNetworkIpAndMask.String(), gatewayIp, ipv6NetworkIpAndMaskStr, ipv6GatewayIp, ipv6IpRangeStr, labels)
 		if err == nil {
-			return networkId, nil
+			return networkId, freeNetworkIpAndMask, nil
 		}
 
 		// Docker does this weird thing where a newly-deleted network won't show up in DockerClient.ListNetworks, but its IPs
 		//  will still be counted as used for several seconds after deletion. The best we can do here is catch the "overlapping
 		//  IP pool" error and retry with a new random network
-		if !strings.Contains(err.Error(), overlappingAddressSpaceErrStr) {
-			return "", stacktrace.Propagate(
+		// Retrying won't help if the user asked for this exact subnet
+		if !strings.Contains(err.Error(), overlappingAddressSpaceErrStr) || requestedSubnet != nil {
+			return "", nil, stacktrace.Propagate(
 				err,
 				"A non-recoverable error occurred creating network '%v' with CIDR '%v'",
 				networkName,
@@ -207,7 +262,7 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 		time.Sleep(timeBetweenNetworkCreationRetries)
 	}
 
-	return "", stacktrace.NewError(
+	return "", nil, stacktrace.NewError(
 		"We couldn't allocate a new network even after retrying %v times with %v between retries",
 		maxNumNetworkAllocationRetries,
 		timeBetweenNetworkCreationRetries,
@@ -220,11 +275,15 @@ func (provider *DockerNetworkAllocator) CreateNewNetwork(
 //	subnets until you find a free one, which was the first iteration of this algo) then you get contention as the
 //	multiple instances are all trying to allocate the same networks at the same time. Therefore, we change the start
 //	to be different on every call
-func findRandomFreeNetwork(networks []*net.IPNet) (*net.IPNet, error) {
+func findRandomFreeNetwork(networks []*net.IPNet, networkMaskBits uint32) (*net.IPNet, error) {
+	networkWidthBits := supportedIpAddrBitLength - networkMaskBits
+	networkCidrMask := net.CIDRMask(int(networkMaskBits), int(supportedIpAddrBitLength))
+	networkWidthUint64 := uint64(math.Pow(float64(2), float64(networkWidthBits)))
+
 	var searchStartNetworkIpUint64 uint64
 	// There's no point in starting the search for a valid free network at a disallowed block, so keep rerolling
-	//  the random startpoint until we at least find a non-disallowed IP
-	for keepGoing := true; keepGoing; keepGoing = isIpInDisallowedRange(uint32(searchStartNetworkIpUint64)) {
+	//  the random startpoint until we at least find a non-disallowed network
+	for keepGoing := true; keepGoing; keepGoing = isNetworkInDisallowedRange(uint32(searchStartNetworkIpUint64), networkWidthUint64) {
 		searchStartNetworksOffsetUint64 := uint64(rand.Uint32()) / networkWidthUint64
 		searchStartNetworkIpUint64 = searchStartNetworksOffsetUint64 * networkWidthUint64
 	}
@@ -244,7 +303,7 @@ func findRandomFreeNetwork(networks []*net.IPNet) (*net.IPNet, error) {
 		}
 		resultNetworkIpUint32 := uint32(resultNetworkIpUint64)
 
-		if isIpInDisallowedRange(resultNetworkIpUint32) {
+		if isNetworkInDisallowedRange(resultNetworkIpUint32, networkWidthUint64) {
 			continue
 		}
 
@@ -257,7 +316,7 @@ func findRandomFreeNetwork(networks []*net.IPNet) (*net.IPNet, error) {
 
 		hasCollision := false
 		for _, network := range networks {
-			if resultNetwork.Contains(network.IP) || network.Contains(resultNetworkIp) {
+			if doNetworksOverlap(resultNetwork, network) {
 				hasCollision = true
 				break
 			}
@@ -270,15 +329,51 @@ func findRandomFreeNetwork(networks []*net.IPNet) (*net.IPNet, error) {
 	return nil, stacktrace.NewError("There is no IP address space available for a new network with %v bits of width", networkWidthBits)
 }
 
-func isIpInDisallowedRange(ipUint32 uint32) bool {
+// The ULA global IDs are random so that networks created on different hosts, which might get connected some day, don't
+// collide; checking against the used networks takes care of the ones on this host
+func findRandomFreeIpv6Network(networks []*net.IPNet) (*net.IPNet, error) {
+	for numPicks := 0; numPicks < maxNumIpv6NetworkPicks; numPicks++ {
+		networkIp := make(net.IP, net.IPv6len)
+		networkIp[0] = uniqueLocalAddressPrefixByte
+		// Never returns an error, as documented
+		_, _ = rand.Read(networkIp[ipv6GlobalIdStartByteIdx:ipv6GlobalIdEndByteIdx])
+		resultNetwork := &net.IPNet{
+			IP:   networkIp,
+			Mask: net.CIDRMask(ipv6NetworkMaskBits, net.IPv6len*8),
+		}
+
+		hasCollision := false
+		for _, network := range networks {
+			if doNetworksOverlap(resultNetwork, network) {
+				hasCollision = true
+				break
+			}
+		}
+		if !hasCollision {
+			return resultNetwork, nil
+		}
+	}
+	return nil, stacktrace.NewError("Couldn't find a free IPv6 network even after picking %v random ones", maxNumIpv6NetworkPicks)
+}
+
+// Networks wider than the disallowed ranges can contain them without starting inside them, so the whole network
+// gets checked rather than just its first IP
+func isNetworkInDisallowedRange(networkIpUint32 uint32, networkWidthUint64 uint64) bool {
+	networkStartUint64 := uint64(networkIpUint32)
+	networkEndUint64 := networkStartUint64 + networkWidthUint64 - 1
 	for _, disallowedRange := range disallowedIpRanges {
 		rangeStartBytes := disallowedRange[0]
-		rangeStartUint32 := binary.BigEndian.Uint32(rangeStartBytes)
+		rangeStartUint64 := uint64(binary.BigEndian.Uint32(rangeStartBytes))
 		rangeEndBytes := disallowedRange[1]
-		rangeEndUint32 := binary.BigEndian.Uint32(rangeEndBytes)
-		if rangeStartUint32 <= ipUint32 && ipUint32 <= rangeEndUint32 {
+		rangeEndUint64 := uint64(binary.BigEndian.Uint32(rangeEndBytes))
+		if networkStartUint64 <= rangeEndUint64 && rangeStartUint64 <= networkEndUint64 {
 			return true
 		}
 	}
 	return false
 }
+
+// CIDR blocks either contain one another or don't overlap at all
+func doNetworksOverlap(network *net.IPNet, otherNetwork *net.IPNet) bool {
+	return network.Contains(otherNetwork.IP) || otherNetwork.Contains(network.IP)
+}
@@ -2,17 +2,41 @@ package docker_network_allocator
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/stretchr/testify/assert"
 	"net"
 	"testing"
 )
 
+const defaultNetworkMaskBits = uint32(enclave.DefaultEnclaveSubnetMaskBits)
+
 func TestErrorOnInstantiationWithoutConstructor(t *testing.T) {
 	allocator := DockerNetworkAllocator{
 		isConstructedViaConstructor: false,
 		dockerManager:               nil,
 	}
-	_, err := allocator.CreateNewNetwork(context.Background(), "", map[string]string{})
+	_, _, err := allocator.CreateNewNetwork(context.Background(), "", map[string]string{}, nil, enclave.NewDefaultEnclaveSubnetSpec())
+	assert.Error(t, err)
+}
+
+func TestFindRandomFreeIpv6Network(t *testing.T) {
+	_, uniqueLocalAddressRange, err := net.ParseCIDR("fd00::/8")
+	assert.NoError(t, err)
+	networks := parseNetworks(t, []string{"10.0.0.0/8", "fd12:3456:789a::/64"})
+
+	network, err := findRandomFreeIpv6Network(networks)
+	assert.NoError(t, err)
+	assert.True(t, uniqueLocalAddressRange.Contains(network.IP))
+	maskBits, _ := network.Mask.Size()
+	assert.Equal(t, ipv6NetworkMaskBits, maskBits)
+	for _, usedNetwork := range networks {
+		assert.False(t, doNetworksOverlap(network, usedNetwork))
+	}
+}
+
+func TestErrorOnNoFreeIpv6Networks(t *testing.T) {
+	networks := parseNetworks(t, []string{"fd00::/8"})
+	_, err := findRandomFreeIpv6Network(networks)
 	assert.Error(t, err)
 }
 
@@ -21,7 +45,7 @@ func TestErrorOnNoFreeIps(t *testing.T) {
 		"0.0.0.0/0",
 	}
 	networks := parseNetworks(t, cidrs)
-	_, err := findRandomFreeNetwork(networks)
+	_, err := findRandomFreeNetwork(networks, defaultNetworkMaskBits)
 	assert.Error(t, err)
 
 }
@@ -50,7 +74,75 @@ func TestExactHole(t *testing.T) {
 		"64.0.0.0/2",
 		"128.0.0.0/1",
 	}
-	assertExpectedResultGivenCidrs(t, cidrs, []byte{1, 0, 0, 0})
+	assertExpectedResultGivenCidrs(t, cidrs, defaultNetworkMaskBits, []byte{1, 0, 0, 0})
+}
+
+func TestExactHoleForSmallerNetwork(t *testing.T) {
+	// This has exactly one hole - at 1.0.0.0/24
+	cidrs := []string{
+		"1.0.1.0/24",
+		"1.0.2.0/23",
+		"1.0.4.0/22",
+		"1.0.8.0/21",
+		"1.0.16.0/20",
+		"1.0.32.0/19",
+		"1.0.64.0/18",
+		"1.0.128.0/17",
+		"1.1.0.0/16",
+		"1.2.0.0/15",
+		"1.4.0.0/14",
+		"1.8.0.0/13",
+		"1.16.0.0/12",
+		"1.32.0.0/11",
+		"1.64.0.0/10",
+		"1.128.0.0/9",
+		"2.0.0.0/7",
+		"4.0.0.0/6",
+		"8.0.0.0/5",
+		"16.0.0.0/4",
+		"32.0.0.0/3",
+		"64.0.0.0/2",
+		"128.0.0.0/1",
+	}
+	assertExpectedResultGivenCidrs(t, cidrs, 24, []byte{1, 0, 0, 0})
+}
+
+func TestNoHoleBigEnoughForWiderNetwork(t *testing.T) {
+	// The only hole is a /20, which can't fit a /16
+	cidrs := []string{
+		"1.0.16.0/20",
+		"1.0.32.0/19",
+		"1.0.64.0/18",
+		"1.0.128.0/17",
+		"1.1.0.0/16",
+		"1.2.0.0/15",
+		"1.4.0.0/14",
+		"1.8.0.0/13",
+		"1.16.0.0/12",
+		"1.32.0.0/11",
+		"1.64.0.0/10",
+		"1.128.0.0/9",
+		"2.0.0.0/7",
+		"4.0.0.0/6",
+		"8.0.0.0/5",
+		"16.0.0.0/4",
+		"32.0.0.0/3",
+		"64.0.0.0/2",
+		"128.0.0.0/1",
+	}
+	networks := parseNetworks(t, cidrs)
+	_, err := findRandomFreeNetwork(networks, 16)
+	assert.Error(t, err)
+}
+
+func TestWideNetworkDoesntContainDisallowedRange(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		result, err := findRandomFreeNetwork([]*net.IPNet{}, 8)
+		assert.NoError(t, err)
+		for _, disallowedRange := range disallowedIpRanges {
+			assert.False(t, result.Contains(disallowedRange[0]), "Network '%v' contains disallowed IP '%v'", result, net.IP(disallowedRange[0]))
+		}
+	}
 }
 
 func TestNetworkFoundOnVariousCases(t *testing.T) {
@@ -89,20 +181,20 @@ func TestNetworkFoundOnVariousCases(t *testing.T) {
 	}
 	for _, cidrs := range successfulCases {
 		parsedNetworks := parseNetworks(t, cidrs)
-		_, err := findRandomFreeNetwork(parsedNetworks)
+		_, err := findRandomFreeNetwork(parsedNetworks, defaultNetworkMaskBits)
 		assert.NoError(t, err, "Got an unexpected error when finding a free network with already-occupied CIDRS %+v", cidrs)
 	}
 }
 
-func assertExpectedResultGivenCidrs(t *testing.T, cidrs []string, expectedIp net.IP) {
+func assertExpectedResultGivenCidrs(t *testing.T, cidrs []string, networkMaskBits uint32, expectedIp net.IP) {
 	networks := parseNetworks(t, cidrs)
-	result, err := findRandomFreeNetwork(networks)
+	result, err := findRandomFreeNetwork(networks, networkMaskBits)
 	assert.NoError(t, err)
 
 	assert.Equal(t, expectedIp, result.IP)
 
-	maskNumOnes, maskTotalBits := result.Mask.Size()
-	assert.Equal(t, networkWidthBits, uint32(maskTotalBits-maskNumOnes))
+	maskNumOnes, _ := result.Mask.Size()
+	assert.Equal(t, networkMaskBits, uint32(maskNumOnes))
 }
 
 func parseNetworks(t *testing.T, cidrs []string) []*net.IPNet {
@@ -11,6 +11,9 @@ const (
 	// The maximum number of bytes that a label value can be
 	// See https://github.com/docker/for-mac/issues/2208
 	maxLabelValueBytes = 65518
+
+	// Oversized label values can be huge, so errors only quote their beginning
+	numOversizedLabelValueBytesInErrors = 200
 )
 var dockerLabelValueRegex = regexp.MustCompile(dockerLabelValueRegexStr)
 
@@ -37,10 +40,10 @@ func CreateNewDockerLabelValue(str string) (*DockerLabelValue, error) {
 	numBytes := len(strBytes)
 	if numBytes > maxLabelValueBytes {
 		return nil, stacktrace.NewError(
-			"The following label value string is '%v' bytes, which is greater than the max limit '%v':\n%v",
+			"The label value string starting with the following is '%v' bytes, which is greater than the max limit '%v':\n%v...",
 			numBytes,
 			maxLabelValueBytes,
-			str,
+			string(strBytes[:numOversizedLabelValueBytesInErrors]),
 		)
 	}
 
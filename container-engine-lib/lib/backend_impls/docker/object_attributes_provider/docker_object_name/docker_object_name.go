@@ -1,6 +1,8 @@
 package docker_object_name
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/kurtosis-tech/stacktrace"
 	"regexp"
 )
@@ -10,6 +12,11 @@ const (
 
 	// We couldn't find any actual limit, but this is very sensible
 	maxLength = 256
+
+	// Number of hex characters of the hash of the full name that truncated names end with, so that names sharing a long
+	// prefix don't collide
+	truncatedNameHashLength    = 8
+	truncatedNameHashSeparator = "-"
 )
 var dockerObjectNameRegex = regexp.MustCompile(dockerObjectNameRegexStr)
 
@@ -30,6 +37,19 @@ func CreateNewDockerObjectName(str string) (*DockerObjectName, error) {
 
 	return &DockerObjectName{value: str}, nil
 }
+
+// CreateNewTruncatedDockerObjectName is like CreateNewDockerObjectName, but names that are too long get truncated and
+// suffixed with a hash of the full name instead of being rejected
+func CreateNewTruncatedDockerObjectName(str string) (*DockerObjectName, error) {
+	if len(str) <= maxLength {
+		return CreateNewDockerObjectName(str)
+	}
+	fullNameHash := sha256.Sum256([]byte(str))
+	hashSuffix := truncatedNameHashSeparator + hex.EncodeToString(fullNameHash[:])[:truncatedNameHashLength]
+	truncatedStr := str[:maxLength-len(hashSuffix)] + hashSuffix
+	return CreateNewDockerObjectName(truncatedStr)
+}
+
 func (key *DockerObjectName) GetString() string {
 	return key.value
 }
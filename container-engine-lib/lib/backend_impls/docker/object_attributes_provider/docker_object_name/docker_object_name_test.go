@@ -36,3 +36,19 @@ func TestTooLongLabel(t *testing.T) {
 	_, err := CreateNewDockerObjectName(invalidLabel)
 	require.Error(t, err)
 }
+
+func TestTruncatedNamesKeepTheirPrefixAndDontCollide(t *testing.T) {
+	longPrefix := strings.Repeat("a", maxLength)
+	name1, err := CreateNewTruncatedDockerObjectName(longPrefix + "1")
+	require.NoError(t, err)
+	name2, err := CreateNewTruncatedDockerObjectName(longPrefix + "2")
+	require.NoError(t, err)
+
+	require.Len(t, name1.GetString(), maxLength)
+	require.True(t, strings.HasPrefix(name1.GetString(), longPrefix[:maxLength-truncatedNameHashLength-1]))
+	require.NotEqual(t, name1.GetString(), name2.GetString())
+
+	shortName, err := CreateNewTruncatedDockerObjectName("foo-bar")
+	require.NoError(t, err)
+	require.Equal(t, "foo-bar", shortName.GetString())
+}
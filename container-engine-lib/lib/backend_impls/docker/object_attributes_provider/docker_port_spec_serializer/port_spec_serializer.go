@@ -1,10 +1,14 @@
 package docker_port_spec_serializer
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/stacktrace"
+	"io"
 	"regexp"
 	"strconv"
 	"strings"
@@ -33,6 +37,10 @@ const (
 	// The maximum number of bytes that a label value can be
 	// See https://github.com/docker/for-mac/issues/2208
 	maxLabelValueBytes = 65518
+
+	// Port specs strings that don't fit in a label value get gzipped & base64-encoded, and are marked with this prefix
+	// No plain port specs string can start with it, as port IDs can't contain the port num & protocol separator
+	compressedPortSpecsPrefix = "gzip" + portNumAndProtocolSeparator
 )
 
 // "Set" of the disallowed characters for a port ID
@@ -95,13 +103,21 @@ func SerializePortSpecs(ports map[string]*port_spec.PortSpec) (*docker_label_val
 		portIdAndSpecStrs = append(portIdAndSpecStrs, portIdAndSpecStr)
 	}
 	resultStr := strings.Join(portIdAndSpecStrs, portSpecsSeparator)
-	numResultBytes := len([]byte(resultStr))
-	if numResultBytes > maxLabelValueBytes {
-		return nil, stacktrace.NewError(
-			"The port specs label value string is %v bytes long, but the max number of label value bytes is %v; the number of ports this container is listening on must be reduced",
-			numResultBytes,
-			maxLabelValueBytes,
-		)
+	if numResultBytes := len([]byte(resultStr)); numResultBytes > maxLabelValueBytes {
+		compressedResultStr, err := compressPortSpecsStr(resultStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred compressing the %v-byte port specs string", numResultBytes)
+		}
+		if numCompressedResultBytes := len([]byte(compressedResultStr)); numCompressedResultBytes > maxLabelValueBytes {
+			return nil, stacktrace.NewError(
+				"The port specs label value string for the %v ports is %v bytes long (%v bytes once compressed), but the max number of label value bytes is %v; the number of ports this container is listening on must be reduced",
+				len(ports),
+				numResultBytes,
+				numCompressedResultBytes,
+				maxLabelValueBytes,
+			)
+		}
+		resultStr = compressedResultStr
 	}
 	result, err := docker_label_value.CreateNewDockerLabelValue(resultStr)
 	if err != nil {
@@ -111,6 +127,14 @@ func SerializePortSpecs(ports map[string]*port_spec.PortSpec) (*docker_label_val
 }
 
 func DeserializePortSpecs(specsStr string) (map[string]*port_spec.PortSpec, error) {
+	if strings.HasPrefix(specsStr, compressedPortSpecsPrefix) {
+		decompressedSpecsStr, err := decompressPortSpecsStr(specsStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred decompressing the compressed port specs string")
+		}
+		specsStr = decompressedSpecsStr
+	}
+
 	resultUsingNewDelimiters, err := deserializePortSpecStrUsingDelimiters(
 		specsStr,
 		portSpecsSeparator,
@@ -255,3 +279,32 @@ func validatePortSpec(portId string, spec *port_spec.PortSpec) error {
 
 	return nil
 }
+
+func compressPortSpecsStr(specsStr string) (string, error) {
+	compressedBytes := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(compressedBytes)
+	if _, err := gzipWriter.Write([]byte(specsStr)); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred writing the port specs string to the gzip writer")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred closing the gzip writer")
+	}
+	return compressedPortSpecsPrefix + base64.StdEncoding.EncodeToString(compressedBytes.Bytes()), nil
+}
+
+func decompressPortSpecsStr(compressedSpecsStr string) (string, error) {
+	compressedBytes, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(compressedSpecsStr, compressedPortSpecsPrefix))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred base64-decoding the compressed port specs string")
+	}
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressedBytes))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating a gzip reader for the compressed port specs")
+	}
+	defer gzipReader.Close()
+	decompressedBytes, err := io.ReadAll(gzipReader)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading the decompressed port specs")
+	}
+	return string(decompressedBytes), nil
+}
@@ -241,3 +241,27 @@ func TestDeSerializeMethod_ValidPortSpecs(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, expectedSpecs, actualPortSpec2)
 }
+
+func TestManyPortsGetCompressedAndRoundTrip(t *testing.T) {
+	input := map[string]*port_spec.PortSpec{}
+	for portNum := uint16(1); portNum <= 6000; portNum++ {
+		portSpec, err := port_spec.NewPortSpec(portNum, port_spec.TransportProtocol_TCP, "http")
+		require.NoError(t, err)
+		input[fmt.Sprintf("a-rather-long-port-identifier-%v", portNum)] = portSpec
+	}
+
+	serialized, err := SerializePortSpecs(input)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(serialized.GetString(), compressedPortSpecsPrefix))
+	require.LessOrEqual(t, len(serialized.GetString()), maxLabelValueBytes)
+
+	output, err := DeserializePortSpecs(serialized.GetString())
+	require.NoError(t, err)
+	require.Len(t, output, len(input))
+	for portId, expectedPortSpec := range input {
+		actualPortSpec, found := output[portId]
+		require.True(t, found, "Port '%v' was lost in the round trip", portId)
+		require.Equal(t, expectedPortSpec.GetNumber(), actualPortSpec.GetNumber())
+		require.Equal(t, expectedPortSpec.GetMaybeApplicationProtocol(), actualPortSpec.GetMaybeApplicationProtocol())
+	}
+}
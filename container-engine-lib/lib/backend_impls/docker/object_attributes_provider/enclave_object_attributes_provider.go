@@ -27,7 +27,7 @@ const (
 )
 
 type DockerEnclaveObjectAttributesProvider interface {
-	ForEnclaveNetwork(enclaveName string, creationTime time.Time, isPartitioningEnabled bool) (DockerObjectAttributes, error)
+	ForEnclaveNetwork(enclaveName string, scheduleName string, creationTime time.Time, isPartitioningEnabled bool) (DockerObjectAttributes, error)
 	ForEnclaveDataVolume() (DockerObjectAttributes, error)
 	ForApiContainer(
 		ipAddr net.IP,
@@ -40,6 +40,8 @@ type DockerEnclaveObjectAttributesProvider interface {
 		serviceName service.ServiceName,
 		serviceUuid service.ServiceUUID,
 		privateIpAddr net.IP,
+		// Nil if the service doesn't have an IPv6 address
+		privateIpv6Addr net.IP,
 		privatePorts map[string]*port_spec.PortSpec,
 	) (DockerObjectAttributes, error)
 	ForNetworkingSidecarContainer(
@@ -68,7 +70,7 @@ func newDockerEnclaveObjectAttributesProviderImpl(
 	}
 }
 
-func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, creationTime time.Time, isPartitioningEnabled bool) (DockerObjectAttributes, error) {
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enclaveName string, scheduleName string, creationTime time.Time, isPartitioningEnabled bool) (DockerObjectAttributes, error) {
 	enclaveIdStr := provider.enclaveId.GetString()
 	name, err := docker_object_name.CreateNewDockerObjectName(enclaveIdStr)
 	if err != nil {
@@ -113,6 +115,18 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForEnclaveNetwork(enc
 	labels[label_key_consts.EnclaveCreationTimeLabelKey] = creationTimeLabelValue
 	labels[label_key_consts.EnclaveNameDockerLabelKey] = enclaveNameLabelValue
 
+	if scheduleName != "" {
+		scheduleNameLabelValue, err := docker_label_value.CreateNewDockerLabelValue(scheduleName)
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred creating a Docker label value object from enclave schedule name string '%v'",
+				scheduleName,
+			)
+		}
+		labels[label_key_consts.EnclaveScheduleDockerLabelKey] = scheduleNameLabelValue
+	}
+
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -205,6 +219,7 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	serviceName service.ServiceName,
 	serviceUuid service.ServiceUUID,
 	privateIpAddr net.IP,
+	privateIpv6Addr net.IP,
 	privatePorts map[string]*port_spec.PortSpec,
 ) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForUserServiceContainer(
@@ -217,7 +232,7 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 
 	serializedPortsSpec, err := docker_port_spec_serializer.SerializePortSpecs(privatePorts)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred serializing the following user service ports object to a string for storing in the ports label: %+v", privatePorts)
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the %v ports of user service '%v' to a string for storing in the ports label", len(privatePorts), serviceName)
 	}
 
 	privateIpLabelValue, err := docker_label_value.CreateNewDockerLabelValue(privateIpAddr.String())
@@ -239,6 +254,17 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.UserServiceContainerTypeDockerLabelValue
 	labels[label_key_consts.PortSpecsDockerLabelKey] = serializedPortsSpec
 	labels[label_key_consts.PrivateIPDockerLabelKey] = privateIpLabelValue
+	if privateIpv6Addr != nil {
+		privateIpv6LabelValue, err := docker_label_value.CreateNewDockerLabelValue(privateIpv6Addr.String())
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred creating a Docker label value object from user service container private IPv6 address '%v'",
+				privateIpv6Addr.String(),
+			)
+		}
+		labels[label_key_consts.PrivateIPv6DockerLabelKey] = privateIpv6LabelValue
+	}
 
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
@@ -427,7 +453,7 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) getNameForEnclaveObje
 		elems,
 		objectNameElementSeparator,
 	)
-	name, err := docker_object_name.CreateNewDockerObjectName(nameStr)
+	name, err := docker_object_name.CreateNewTruncatedDockerObjectName(nameStr)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating Docker object name from string '%v'", nameStr)
 	}
@@ -442,7 +468,7 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) getNameForUserService
 		},
 		objectNameElementSeparator,
 	)
-	name, err := docker_object_name.CreateNewDockerObjectName(nameStr)
+	name, err := docker_object_name.CreateNewTruncatedDockerObjectName(nameStr)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating Docker object name from string '%v'", nameStr)
 	}
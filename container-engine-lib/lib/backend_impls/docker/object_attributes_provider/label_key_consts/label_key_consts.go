@@ -34,9 +34,16 @@ const (
 
 	enclaveCreationTime = labelNamespaceStr + "enclave-creation-time"
 
+	// Set on the networks of the enclaves created by a run of an enclave schedule, to the name of the schedule
+	enclaveScheduleLabelKeyStr = labelNamespaceStr + "enclave-schedule"
+
 	isNetworkPartitioningEnabledKeyStr = labelNamespaceStr + "is-network-partitioning-enabled"
 
-	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
+	privateIpAddrLabelKeyStr   = labelNamespaceStr + "private-ip"
+	privateIpv6AddrLabelKeyStr = labelNamespaceStr + "private-ipv6"
+
+	// Set on user service containers whose image Kurtosis pulled, so that the image can be pruned once no enclave uses it
+	pulledImageLabelKeyStr = labelNamespaceStr + "pulled-image"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -57,6 +64,9 @@ var PortSpecsDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(portS
 var EnclaveUUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveIdLabelKeyStr)
 var EnclaveNameDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveCreationTime)
+var EnclaveScheduleDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveScheduleLabelKeyStr)
 var IsNetworkPartitioningEnabledDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(isNetworkPartitioningEnabledKeyStr)
 var PrivateIPDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
+var PrivateIPv6DockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(privateIpv6AddrLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
+var PulledImageDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(pulledImageLabelKeyStr)
@@ -26,6 +26,7 @@ const (
 	filesArtifactExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
 	logsDatabaseVolumeTypeLabelValueStr           = "logs-db"
 	logsCollectorVolumeTypeLabelValueStr          = "logs-collector-data"
+	engineDataVolumeTypeLabelValueStr             = "engine-data"
 
 	trueValueStr  = "true"
 	falseValueStr = "false"
@@ -55,3 +56,4 @@ var EnclaveDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDock
 var FilesArtifactExpansionVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(filesArtifactExpansionVolumeTypeLabelValueStr)
 var LogsDatabaseVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsDatabaseVolumeTypeLabelValueStr)
 var LogsCollectorVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(logsCollectorVolumeTypeLabelValueStr)
+var EngineDataVolumeTypeDockerLabelValue = docker_label_value.MustCreateNewDockerLabelValue(engineDataVolumeTypeLabelValueStr)
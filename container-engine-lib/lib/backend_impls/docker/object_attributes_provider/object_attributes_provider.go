@@ -21,6 +21,9 @@ const (
 	//We always use the same name because we are going to have only one instance of this volume,
 	//so when the engine is restarted it mounts the same volume with the previous logs
 	logsDatabaseVolumeName = logsDatabaseName + "-vol"
+
+	//Same as the logs database volume, so that a restarted engine finds the state the previous one persisted
+	engineDataVolumeName = engineServerNamePrefix + "-data-vol"
 )
 
 type DockerObjectAttributesProvider interface {
@@ -37,6 +40,7 @@ type DockerObjectAttributesProvider interface {
 		httpApiPortSpec *port_spec.PortSpec,
 	) (DockerObjectAttributes, error)
 	ForLogsDatabaseVolume() (DockerObjectAttributes, error)
+	ForEngineDataVolume() (DockerObjectAttributes, error)
 }
 
 func GetDockerObjectAttributesProvider() DockerObjectAttributesProvider {
@@ -162,3 +166,22 @@ func (provider *dockerObjectAttributesProviderImpl) ForLogsDatabaseVolume() (Doc
 
 	return objectAttributes, nil
 }
+
+func (provider *dockerObjectAttributesProviderImpl) ForEngineDataVolume() (DockerObjectAttributes, error) {
+	nameStr := engineDataVolumeName
+	name, err := docker_object_name.CreateNewDockerObjectName(nameStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker object name object from string '%v'", nameStr)
+	}
+
+	labels := map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue{
+		label_key_consts.VolumeTypeDockerLabelKey: label_value_consts.EngineDataVolumeTypeDockerLabelValue,
+	}
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
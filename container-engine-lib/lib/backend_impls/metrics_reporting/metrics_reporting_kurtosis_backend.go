@@ -4,13 +4,16 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/host_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/system_container"
 	"github.com/kurtosis-tech/stacktrace"
 	"io"
 	"net"
@@ -25,6 +28,14 @@ func NewMetricsReportingKurtosisBackend(underlying backend_interface.KurtosisBac
 	return &MetricsReportingKurtosisBackend{underlying: underlying}
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetBackendCapabilities(ctx context.Context) (*backend_capabilities.BackendCapabilities, error) {
+	capabilities, err := backend.underlying.GetBackendCapabilities(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the capabilities of the backend")
+	}
+	return capabilities, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context, image string) error {
 	if err := backend.underlying.FetchImage(ctx, image); err != nil {
 		return stacktrace.Propagate(err, "An error occurred pulling image '%v'", image)
@@ -32,6 +43,58 @@ func (backend *MetricsReportingKurtosisBackend) FetchImage(ctx context.Context,
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) PullImageWithProgress(ctx context.Context, image string, progressCallback func(pulledBytes uint64, totalBytes uint64)) error {
+	if err := backend.underlying.PullImageWithProgress(ctx, image, progressCallback); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pulling image '%v'", image)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetEnclavesPulledImages(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]map[string]bool,
+	error,
+) {
+	results, err := backend.underlying.GetEnclavesPulledImages(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the images pulled for enclaves using filters: %+v", filters)
+	}
+	return results, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetImagesSizeBytes(ctx context.Context, images map[string]bool) (map[string]int64, error) {
+	results, err := backend.underlying.GetImagesSizeBytes(ctx, images)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the size of images '%+v'", images)
+	}
+	return results, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetHostResources(ctx context.Context) (*host_resources.HostResources, error) {
+	result, err := backend.underlying.GetHostResources(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resources of the host")
+	}
+	return result, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) RemoveImages(
+	ctx context.Context,
+	images map[string]bool,
+) (
+	successfulImages map[string]bool,
+	erroredImages map[string]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.RemoveImages(ctx, images)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing images '%+v'", images)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateEngine(
 	ctx context.Context,
 	imageOrgAndRepo string,
@@ -101,8 +164,8 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 	return nil
 }
 
-func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
-	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, scheduleName string, isPartitioningEnabled bool, excludedSubnets []*net.IPNet, subnetSpec *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error) {
+	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v' and is-partitioning-enabled value '%v'", enclaveUuid, isPartitioningEnabled)
 	}
@@ -138,6 +201,20 @@ func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetEnclavesResourceUsage(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage,
+	error,
+) {
+	results, err := backend.underlying.GetEnclavesResourceUsage(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the resource usage of enclaves using filters: %+v", filters)
+	}
+	return results, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -164,6 +241,20 @@ func (backend *MetricsReportingKurtosisBackend) DestroyEnclaves(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetLeakedEnclaveResources(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (
+	[]*enclave.LeakedEnclaveResource,
+	error,
+) {
+	leakedResources, err := backend.underlying.GetLeakedEnclaveResources(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the leaked resources of enclave '%v'", enclaveUuid)
+	}
+	return leakedResources, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 	ctx context.Context,
 	image string,
@@ -247,6 +338,14 @@ func (backend *MetricsReportingKurtosisBackend) StartRegisteredUserServices(ctx
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) ReplaceUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	successes, failures, err := backend.underlying.ReplaceUserServices(ctx, enclaveUuid, services)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred replacing services in enclave '%v' with the following service ids: %+v", enclaveUuid, services)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) GetUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -267,18 +366,31 @@ func (backend *MetricsReportingKurtosisBackend) GetUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	filters *service.ServiceFilters,
 	shouldFollowLogs bool,
+	timeRange *service.ServiceLogsTimeRange,
 ) (
 	map[service.ServiceUUID]io.ReadCloser,
 	map[service.ServiceUUID]error,
 	error,
 ) {
-	userServiceLogs, erroredUserServices, err := backend.underlying.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs)
+	userServiceLogs, erroredUserServices, err := backend.underlying.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred getting user service logs in enclave '%v' using filters '%+v'", enclaveUuid, filters)
 	}
 	return userServiceLogs, erroredUserServices, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetServiceMetrics(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+) (*service.ServiceMetrics, error) {
+	metrics, err := backend.underlying.GetServiceMetrics(ctx, enclaveUuid, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get the metrics of service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	return metrics, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) PauseService(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -303,6 +415,75 @@ func (backend *MetricsReportingKurtosisBackend) UnpauseService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) PauseServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	successfulUserServiceUuids map[service.ServiceUUID]bool,
+	erroredUserServiceUuids map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.PauseServices(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred pausing user services in enclave '%v' using filters: %+v", enclaveUuid, filters)
+	}
+	return successes, failures, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) UnpauseServices(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *service.ServiceFilters,
+) (
+	successfulUserServiceUuids map[service.ServiceUUID]bool,
+	erroredUserServiceUuids map[service.ServiceUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.UnpauseServices(ctx, enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred unpausing user services in enclave '%v' using filters: %+v", enclaveUuid, filters)
+	}
+	return successes, failures, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) RestartService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceId service.ServiceUUID,
+) error {
+	err := backend.underlying.RestartService(ctx, enclaveUuid, serviceId)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to restart service '%v' in enclave '%v'", serviceId, enclaveUuid)
+	}
+	return nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) WaitForServiceExit(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceId service.ServiceUUID,
+) (int32, error) {
+	exitCode, err := backend.underlying.WaitForServiceExit(ctx, enclaveUuid, serviceId)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Failed to wait for service '%v' in enclave '%v' to exit", serviceId, enclaveUuid)
+	}
+	return exitCode, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) SendSignalToService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceId service.ServiceUUID,
+	signal string,
+) error {
+	err := backend.underlying.SendSignalToService(ctx, enclaveUuid, serviceId, signal)
+	if err != nil {
+		return stacktrace.Propagate(err, "Failed to send signal '%v' to service '%v' in enclave '%v'", signal, serviceId, enclaveUuid)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -324,6 +505,29 @@ func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommands(
 	return succesfulUserServiceExecResults, erroredUserServiceUuids, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	command []string,
+	output io.Writer,
+) (
+	exitCode int32,
+	resultErr error,
+) {
+	exitCode, err := backend.underlying.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, command, output)
+	if err != nil {
+		return 0, stacktrace.Propagate(
+			err,
+			"An error occurred running exec command '%+v' with streamed output on service '%v' in enclave '%v'",
+			command,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return exitCode, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) GetConnectionWithUserService(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -358,6 +562,25 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CopyFilesToUserService(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	dstDirpath string,
+	tarContent io.Reader,
+) error {
+	if err := backend.underlying.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpath, tarContent); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files to directory '%v' in user service with UUID '%v' in enclave with UUID '%v'",
+			dstDirpath,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -535,6 +758,14 @@ func (backend *MetricsReportingKurtosisBackend) DestroyLogsCollectorForEnclave(c
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetEnclaveSystemContainers(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*system_container.SystemContainer, error) {
+	systemContainers, err := backend.underlying.GetEnclaveSystemContainers(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the system containers of enclave '%v'", enclaveUuid)
+	}
+	return systemContainers, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DestroyDeprecatedCentralizedLogsResources(ctx context.Context) error {
 	if err := backend.underlying.DestroyDeprecatedCentralizedLogsResources(ctx); err != nil {
 		return stacktrace.Propagate(err, "An error occurred while destroying deprecated logs collector")
@@ -4,13 +4,16 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/host_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/system_container"
 	"github.com/kurtosis-tech/stacktrace"
 	"golang.org/x/sync/errgroup"
 	"io"
@@ -41,6 +44,11 @@ func newRemoteContextKurtosisBackend(
 	}
 }
 
+// The user services run in the remote backend
+func (backend *RemoteContextKurtosisBackend) GetBackendCapabilities(ctx context.Context) (*backend_capabilities.BackendCapabilities, error) {
+	return backend.remoteKurtosisBackend.GetBackendCapabilities(ctx)
+}
+
 func (backend *RemoteContextKurtosisBackend) FetchImage(ctx context.Context, image string) error {
 	// Without knowing which backend will need the image, it's tricky to filter which one should fetch it.
 	// As said in the above comment, in practice, this is not the end of the world because this dual-context backend
@@ -60,6 +68,29 @@ func (backend *RemoteContextKurtosisBackend) FetchImage(ctx context.Context, ima
 	return nil
 }
 
+// Images get pulled with progress by the CLI, for the engine, which runs in the local backend like in CreateEngine
+func (backend *RemoteContextKurtosisBackend) PullImageWithProgress(ctx context.Context, image string, progressCallback func(pulledBytes uint64, totalBytes uint64)) error {
+	return backend.localKurtosisBackend.PullImageWithProgress(ctx, image, progressCallback)
+}
+
+// The enclaves, and therefore the images pulled for their services, live in the remote backend
+func (backend *RemoteContextKurtosisBackend) GetEnclavesPulledImages(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]map[string]bool, error) {
+	return backend.remoteKurtosisBackend.GetEnclavesPulledImages(ctx, filters)
+}
+
+func (backend *RemoteContextKurtosisBackend) GetImagesSizeBytes(ctx context.Context, images map[string]bool) (map[string]int64, error) {
+	return backend.remoteKurtosisBackend.GetImagesSizeBytes(ctx, images)
+}
+
+// The user services run in the remote backend
+func (backend *RemoteContextKurtosisBackend) GetHostResources(ctx context.Context) (*host_resources.HostResources, error) {
+	return backend.remoteKurtosisBackend.GetHostResources(ctx)
+}
+
+func (backend *RemoteContextKurtosisBackend) RemoveImages(ctx context.Context, images map[string]bool) (successfulImages map[string]bool, erroredImages map[string]error, resultErr error) {
+	return backend.remoteKurtosisBackend.RemoveImages(ctx, images)
+}
+
 func (backend *RemoteContextKurtosisBackend) CreateEngine(ctx context.Context, imageOrgAndRepo string, imageVersionTag string, grpcPortNum uint16, grpcProxyPortNum uint16, envVars map[string]string) (*engine.Engine, error) {
 	return backend.localKurtosisBackend.CreateEngine(ctx, imageOrgAndRepo, imageVersionTag, grpcPortNum, grpcProxyPortNum, envVars)
 }
@@ -84,8 +115,8 @@ func (backend *RemoteContextKurtosisBackend) DumpKurtosis(ctx context.Context, o
 	return backend.localKurtosisBackend.DumpKurtosis(ctx, outputDirpath)
 }
 
-func (backend *RemoteContextKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
-	return backend.remoteKurtosisBackend.CreateEnclave(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+func (backend *RemoteContextKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, scheduleName string, isPartitioningEnabled bool, excludedSubnets []*net.IPNet, subnetSpec *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error) {
+	return backend.remoteKurtosisBackend.CreateEnclave(ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 }
 
 func (backend *RemoteContextKurtosisBackend) GetEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.Enclave, error) {
@@ -96,6 +127,10 @@ func (backend *RemoteContextKurtosisBackend) StopEnclaves(ctx context.Context, f
 	return backend.remoteKurtosisBackend.StopEnclaves(ctx, filters)
 }
 
+func (backend *RemoteContextKurtosisBackend) GetEnclavesResourceUsage(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage, error) {
+	return backend.remoteKurtosisBackend.GetEnclavesResourceUsage(ctx, filters)
+}
+
 func (backend *RemoteContextKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
 	return backend.remoteKurtosisBackend.DumpEnclave(ctx, enclaveUuid, outputDirpath)
 }
@@ -104,6 +139,10 @@ func (backend *RemoteContextKurtosisBackend) DestroyEnclaves(ctx context.Context
 	return backend.remoteKurtosisBackend.DestroyEnclaves(ctx, filters)
 }
 
+func (backend *RemoteContextKurtosisBackend) GetLeakedEnclaveResources(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*enclave.LeakedEnclaveResource, error) {
+	return backend.remoteKurtosisBackend.GetLeakedEnclaveResources(ctx, enclaveUuid)
+}
+
 func (backend *RemoteContextKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, grpcProxyPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string) (*api_container.APIContainer, error) {
 	return backend.remoteKurtosisBackend.CreateAPIContainer(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
 }
@@ -132,12 +171,20 @@ func (backend *RemoteContextKurtosisBackend) StartRegisteredUserServices(ctx con
 	return backend.remoteKurtosisBackend.StartRegisteredUserServices(ctx, enclaveUuid, services)
 }
 
+func (backend *RemoteContextKurtosisBackend) ReplaceUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	return backend.remoteKurtosisBackend.ReplaceUserServices(ctx, enclaveUuid, services)
+}
+
 func (backend *RemoteContextKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error) {
 	return backend.remoteKurtosisBackend.GetUserServices(ctx, enclaveUuid, filters)
 }
 
-func (backend *RemoteContextKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool) (successfulUserServiceLogs map[service.ServiceUUID]io.ReadCloser, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
-	return backend.remoteKurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs)
+func (backend *RemoteContextKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool, timeRange *service.ServiceLogsTimeRange) (successfulUserServiceLogs map[service.ServiceUUID]io.ReadCloser, erroredUserServiceUuids map[service.ServiceUUID]error, resultError error) {
+	return backend.remoteKurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
+}
+
+func (backend *RemoteContextKurtosisBackend) GetServiceMetrics(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) (*service.ServiceMetrics, error) {
+	return backend.remoteKurtosisBackend.GetServiceMetrics(ctx, enclaveUuid, serviceUUID)
 }
 
 func (backend *RemoteContextKurtosisBackend) PauseService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) (resultErr error) {
@@ -148,10 +195,34 @@ func (backend *RemoteContextKurtosisBackend) UnpauseService(ctx context.Context,
 	return backend.remoteKurtosisBackend.UnpauseService(ctx, enclaveUuid, serviceUUID)
 }
 
+func (backend *RemoteContextKurtosisBackend) PauseServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) {
+	return backend.remoteKurtosisBackend.PauseServices(ctx, enclaveUuid, filters)
+}
+
+func (backend *RemoteContextKurtosisBackend) UnpauseServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) {
+	return backend.remoteKurtosisBackend.UnpauseServices(ctx, enclaveUuid, filters)
+}
+
+func (backend *RemoteContextKurtosisBackend) RestartService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) (resultErr error) {
+	return backend.remoteKurtosisBackend.RestartService(ctx, enclaveUuid, serviceUUID)
+}
+
+func (backend *RemoteContextKurtosisBackend) WaitForServiceExit(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) (exitCode int32, resultErr error) {
+	return backend.remoteKurtosisBackend.WaitForServiceExit(ctx, enclaveUuid, serviceUUID)
+}
+
+func (backend *RemoteContextKurtosisBackend) SendSignalToService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID, signal string) (resultErr error) {
+	return backend.remoteKurtosisBackend.SendSignalToService(ctx, enclaveUuid, serviceUUID, signal)
+}
+
 func (backend *RemoteContextKurtosisBackend) RunUserServiceExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, userServiceCommands map[service.ServiceUUID][]string) (succesfulUserServiceExecResults map[service.ServiceUUID]*exec_result.ExecResult, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) {
 	return backend.remoteKurtosisBackend.RunUserServiceExecCommands(ctx, enclaveUuid, userServiceCommands)
 }
 
+func (backend *RemoteContextKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, command []string, output io.Writer) (exitCode int32, resultErr error) {
+	return backend.remoteKurtosisBackend.RunUserServiceExecCommandWithStreamedOutput(ctx, enclaveUuid, serviceUuid, command, output)
+}
+
 func (backend *RemoteContextKurtosisBackend) GetConnectionWithUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (resultConn net.Conn, resultErr error) {
 	return backend.remoteKurtosisBackend.GetConnectionWithUserService(ctx, enclaveUuid, serviceUuid)
 }
@@ -160,6 +231,10 @@ func (backend *RemoteContextKurtosisBackend) CopyFilesFromUserService(ctx contex
 	return backend.remoteKurtosisBackend.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
 }
 
+func (backend *RemoteContextKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	return backend.remoteKurtosisBackend.CopyFilesToUserService(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+}
+
 func (backend *RemoteContextKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) {
 	return backend.remoteKurtosisBackend.StopUserServices(ctx, enclaveUuid, filters)
 }
@@ -214,6 +289,10 @@ func (backend *RemoteContextKurtosisBackend) DestroyLogsCollectorForEnclave(ctx
 	return backend.remoteKurtosisBackend.DestroyLogsCollectorForEnclave(ctx, enclaveUuid)
 }
 
+func (backend *RemoteContextKurtosisBackend) GetEnclaveSystemContainers(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*system_container.SystemContainer, error) {
+	return backend.remoteKurtosisBackend.GetEnclaveSystemContainers(ctx, enclaveUuid)
+}
+
 func (backend *RemoteContextKurtosisBackend) DestroyDeprecatedCentralizedLogsResources(ctx context.Context) error {
 	return backend.remoteKurtosisBackend.DestroyDeprecatedCentralizedLogsResources(ctx)
 }
@@ -3,13 +3,16 @@ package backend_interface
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/host_resources"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_database"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/system_container"
 	"io"
 	"net"
 )
@@ -25,8 +28,40 @@ import (
 // The heuristic for "do I need a method in KurtosisBackend?" here is "will I make one or more calls to
 // the underlying container engine?"
 type KurtosisBackend interface {
+	// Gets which of the optional features of Kurtosis this backend supports
+	GetBackendCapabilities(ctx context.Context) (*backend_capabilities.BackendCapabilities, error)
+
 	FetchImage(ctx context.Context, image string) error
 
+	// Pulls the latest version of the image, calling the progress callback with the bytes downloaded so far and the
+	// total bytes to download as the pull goes
+	PullImageWithProgress(ctx context.Context, image string, progressCallback func(pulledBytes uint64, totalBytes uint64)) error
+
+	// Gets the images that Kurtosis pulled for the user services of the enclaves matching the given filters, keyed by enclave
+	GetEnclavesPulledImages(
+		ctx context.Context,
+		filters *enclave.EnclaveFilters,
+	) (
+		map[enclave.EnclaveUUID]map[string]bool,
+		error,
+	)
+
+	// Gets the size on disk of the given images; images that aren't present on the host are left out
+	GetImagesSizeBytes(ctx context.Context, images map[string]bool) (map[string]int64, error)
+
+	// Gets the total CPUs and memory of the host the containers run on
+	GetHostResources(ctx context.Context) (*host_resources.HostResources, error)
+
+	// Removes the given images from the host; images still used by a container fail to be removed
+	RemoveImages(
+		ctx context.Context,
+		images map[string]bool,
+	) (
+		successfulImages map[string]bool,
+		erroredImages map[string]error,
+		resultErr error,
+	)
+
 	// Creates an engine with the given parameters
 	CreateEngine(
 		ctx context.Context,
@@ -69,8 +104,9 @@ type KurtosisBackend interface {
 	// Dumps all of Kurtosis (engines + all enclaves)
 	DumpKurtosis(ctx context.Context, outputDirpath string) error
 
-	// Creates an enclave with the given enclave ID
-	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error)
+	// Creates an enclave with the given enclave ID, whose IP range won't overlap with any of the excluded subnets and
+	//  is picked according to the subnet spec. The schedule name is empty unless a run of an enclave schedule creates it
+	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, scheduleName string, isPartitioningEnabled bool, excludedSubnets []*net.IPNet, subnetSpec *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error)
 
 	// Gets enclaves matching the given filters
 	GetEnclaves(
@@ -91,6 +127,16 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Aggregates the resources (services, memory allocation, published ports, disk) used by the enclaves matching the
+	// given filters, so operators can tell which enclaves are eating a shared host
+	GetEnclavesResourceUsage(
+		ctx context.Context,
+		filters *enclave.EnclaveFilters,
+	) (
+		map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage,
+		error,
+	)
+
 	// Dumps the contents of the given enclave to the given directory
 	// TODO add this to K8S
 	DumpEnclave(
@@ -109,6 +155,16 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Gets the Kurtosis resources (containers, volumes, networks, IP address registrations) that are still around for
+	// the given enclave; meant to be called after the enclave got destroyed, in which case there should be none
+	GetLeakedEnclaveResources(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+	) (
+		[]*enclave.LeakedEnclaveResource,
+		error,
+	)
+
 	CreateAPIContainer(
 		ctx context.Context,
 		image string,
@@ -217,6 +273,18 @@ type KurtosisBackend interface {
 		error, // represents an error with the function itself, rather than the user services
 	)
 
+	// ReplaceUserServices destroys the containers of started services and creates new ones from the given service
+	// configs, keeping the service registrations so that the services keep their UUIDs and IP addresses
+	ReplaceUserServices(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		services map[service.ServiceUUID]*service.ServiceConfig,
+	) (
+		map[service.ServiceUUID]*service.Service, // "set" of user UUIDs that were successfully replaced
+		map[service.ServiceUUID]error, // "set" of user service UUIDs that errored when attempting to replace them, with the error
+		error, // represents an error with the function itself, rather than the user services
+	)
+
 	// Gets user services using the given filters, returning a map of matched user services identified by their UUID
 	GetUserServices(
 		ctx context.Context,
@@ -228,18 +296,30 @@ type KurtosisBackend interface {
 	)
 
 	// Get user service logs using the given filters, returning a map of matched user services identified by their GUID and a readCloser object for each one
+	// Only the lines logged within the time range are returned, the filtering being done by the container engine
 	// User is responsible for closing the 'ReadCloser' object returned in the successfulUserServiceLogs map
 	GetUserServiceLogs(
 		ctx context.Context,
 		enclaveUuid enclave.EnclaveUUID,
 		filters *service.ServiceFilters,
 		shouldFollowLogs bool,
+		timeRange *service.ServiceLogsTimeRange,
 	) (
 		successfulUserServiceLogs map[service.ServiceUUID]io.ReadCloser,
 		erroredUserServiceUuids map[service.ServiceUUID]error,
 		resultError error,
 	)
 
+	// Gets a snapshot of the CPU, memory and network usage of the container of a service
+	GetServiceMetrics(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUUID service.ServiceUUID,
+	) (
+		*service.ServiceMetrics,
+		error,
+	)
+
 	// Pauses execution of all processes on a service, but does not shut down the service (memory state is preserved)
 	PauseService(
 		ctx context.Context,
@@ -258,6 +338,57 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Pauses all the services matching the given filters at once, e.g. to freeze an entire partition
+	PauseServices(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceUuids map[service.ServiceUUID]bool, // "set" of user service UUIDs that were successfully paused
+		erroredUserServiceUuids map[service.ServiceUUID]error, // "set" of user service UUIDs that errored when pausing, with the error
+		resultErr error, // Represents an error with the function itself, rather than the user services
+	)
+
+	// Unpauses all the services matching the given filters at once
+	UnpauseServices(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *service.ServiceFilters,
+	) (
+		successfulUserServiceUuids map[service.ServiceUUID]bool, // "set" of user service UUIDs that were successfully unpaused
+		erroredUserServiceUuids map[service.ServiceUUID]error, // "set" of user service UUIDs that errored when unpausing, with the error
+		resultErr error, // Represents an error with the function itself, rather than the user services
+	)
+
+	// Restarts the container of a service in place, keeping its config, IP address and files
+	RestartService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUUID service.ServiceUUID,
+	) (
+		resultErr error,
+	)
+
+	// Blocks until the container of a service exits, e.g. a service running a test suite, returning its exit code
+	WaitForServiceExit(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUUID service.ServiceUUID,
+	) (
+		exitCode int32,
+		resultErr error,
+	)
+
+	// Sends a signal (e.g. SIGHUP) to the main process of a service, without restarting the service
+	SendSignalToService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUUID service.ServiceUUID,
+		signal string,
+	) (
+		resultErr error,
+	)
+
 	// Executes a shell command inside an user service instance indenfified by its ID
 	RunUserServiceExecCommands(
 		ctx context.Context,
@@ -269,6 +400,19 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Executes a shell command inside an user service instance, writing its output to the given writer as it gets
+	// produced rather than holding it in memory, so commands with huge outputs can be run
+	RunUserServiceExecCommandWithStreamedOutput(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		command []string,
+		output io.Writer,
+	) (
+		exitCode int32,
+		resultErr error,
+	)
+
 	// Get a connection with user service to execute commands in
 	GetConnectionWithUserService(
 		ctx context.Context,
@@ -288,6 +432,16 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// Copy files, packaged as a TAR that can be gzipped, into the given directory of the given user service. The directory
+	// must already exist on the service
+	CopyFilesToUserService(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		dstDirpathOnService string,
+		tarContent io.Reader,
+	) error
+
 	// StopUserServices stops the user containers for the services matching the given filters
 	// A stopped service cannot be activated again as of 2022-05-14
 	StopUserServices(
@@ -390,6 +544,10 @@ type KurtosisBackend interface {
 	// Destroy the logs collector for enclave with UUID
 	DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error
 
+	// Gets the containers Kurtosis runs for itself that the enclave relies on, e.g. its API container and networking
+	// sidecars as well as the engine, to debug Kurtosis itself
+	GetEnclaveSystemContainers(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*system_container.SystemContainer, error)
+
 	// Destroy the centralized logs resources
 	// TODO(centralized-logs-resources-deprecation) remove this once we know people are on > 0.68.0
 	DestroyDeprecatedCentralizedLogsResources(ctx context.Context) error
@@ -7,12 +7,16 @@ import (
 
 	api_container "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 
+	backend_capabilities "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
+
 	enclave "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 
 	engine "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 
 	exec_result "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 
+	host_resources "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/host_resources"
+
 	io "io"
 
 	logs_collector "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
@@ -26,6 +30,8 @@ import (
 	networking_sidecar "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+
+	system_container "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/system_container"
 )
 
 // MockKurtosisBackend is an autogenerated mock type for the KurtosisBackend type
@@ -87,6 +93,52 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) RunAndReturn(run fu
 	return _c
 }
 
+// CopyFilesToUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent
+func (_m *MockKurtosisBackend) CopyFilesToUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesToUserService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesToUserService'
+type MockKurtosisBackend_CopyFilesToUserService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesToUserService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - dstDirpathOnService string
+//   - tarContent io.Reader
+func (_e *MockKurtosisBackend_Expecter) CopyFilesToUserService(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, dstDirpathOnService interface{}, tarContent interface{}) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	return &MockKurtosisBackend_CopyFilesToUserService_Call{Call: _e.mock.On("CopyFilesToUserService", ctx, enclaveUuid, serviceUuid, dstDirpathOnService, tarContent)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, dstDirpathOnService string, tarContent io.Reader)) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string), args[4].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesToUserService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string, io.Reader) error) *MockKurtosisBackend_CopyFilesToUserService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars
 func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, grpcProxyPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string) (*api_container.APIContainer, error) {
 	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, grpcProxyPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
@@ -148,25 +200,25 @@ func (_c *MockKurtosisBackend_CreateAPIContainer_Call) RunAndReturn(run func(con
 	return _c
 }
 
-// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, isPartitioningEnabled
-func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool) (*enclave.Enclave, error) {
-	ret := _m.Called(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec
+func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, scheduleName string, isPartitioningEnabled bool, excludedSubnets []*net.IPNet, subnetSpec *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error) {
+	ret := _m.Called(ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 
 	var r0 *enclave.Enclave
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, bool) (*enclave.Enclave, error)); ok {
-		return rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, string, bool, []*net.IPNet, *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error)); ok {
+		return rf(ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, bool) *enclave.Enclave); ok {
-		r0 = rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, string, bool, []*net.IPNet, *enclave.EnclaveSubnetSpec) *enclave.Enclave); ok {
+		r0 = rf(ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*enclave.Enclave)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, bool) error); ok {
-		r1 = rf(ctx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, string, bool, []*net.IPNet, *enclave.EnclaveSubnetSpec) error); ok {
+		r1 = rf(ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -183,14 +235,17 @@ type MockKurtosisBackend_CreateEnclave_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - enclaveName string
+//   - scheduleName string
 //   - isPartitioningEnabled bool
-func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, isPartitioningEnabled interface{}) *MockKurtosisBackend_CreateEnclave_Call {
-	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, isPartitioningEnabled)}
+//   - excludedSubnets []*net.IPNet
+//   - subnetSpec *enclave.EnclaveSubnetSpec
+func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, scheduleName interface{}, isPartitioningEnabled interface{}, excludedSubnets interface{}, subnetSpec interface{}) *MockKurtosisBackend_CreateEnclave_Call {
+	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)}
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, isPartitioningEnabled bool)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, scheduleName string, isPartitioningEnabled bool, excludedSubnets []*net.IPNet, subnetSpec *enclave.EnclaveSubnetSpec)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(bool))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(string), args[4].(bool), args[5].([]*net.IPNet), args[6].(*enclave.EnclaveSubnetSpec))
 	})
 	return _c
 }
@@ -200,7 +255,7 @@ func (_c *MockKurtosisBackend_CreateEnclave_Call) Return(_a0 *enclave.Enclave, _
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, bool) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, string, bool, []*net.IPNet, *enclave.EnclaveSubnetSpec) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1065,6 +1120,60 @@ func (_c *MockKurtosisBackend_GetAPIContainers_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetBackendCapabilities provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetBackendCapabilities(ctx context.Context) (*backend_capabilities.BackendCapabilities, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *backend_capabilities.BackendCapabilities
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*backend_capabilities.BackendCapabilities, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *backend_capabilities.BackendCapabilities); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*backend_capabilities.BackendCapabilities)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetBackendCapabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBackendCapabilities'
+type MockKurtosisBackend_GetBackendCapabilities_Call struct {
+	*mock.Call
+}
+
+// GetBackendCapabilities is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) GetBackendCapabilities(ctx interface{}) *MockKurtosisBackend_GetBackendCapabilities_Call {
+	return &MockKurtosisBackend_GetBackendCapabilities_Call{Call: _e.mock.On("GetBackendCapabilities", ctx)}
+}
+
+func (_c *MockKurtosisBackend_GetBackendCapabilities_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetBackendCapabilities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetBackendCapabilities_Call) Return(_a0 *backend_capabilities.BackendCapabilities, _a1 error) *MockKurtosisBackend_GetBackendCapabilities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetBackendCapabilities_Call) RunAndReturn(run func(context.Context) (*backend_capabilities.BackendCapabilities, error)) *MockKurtosisBackend_GetBackendCapabilities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetConnectionWithUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
 func (_m *MockKurtosisBackend) GetConnectionWithUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (net.Conn, error) {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
@@ -1121,6 +1230,61 @@ func (_c *MockKurtosisBackend_GetConnectionWithUserService_Call) RunAndReturn(ru
 	return _c
 }
 
+// GetEnclaveSystemContainers provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) GetEnclaveSystemContainers(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*system_container.SystemContainer, error) {
+	ret := _m.Called(ctx, enclaveUuid)
+
+	var r0 []*system_container.SystemContainer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) ([]*system_container.SystemContainer, error)); ok {
+		return rf(ctx, enclaveUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) []*system_container.SystemContainer); ok {
+		r0 = rf(ctx, enclaveUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*system_container.SystemContainer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetEnclaveSystemContainers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnclaveSystemContainers'
+type MockKurtosisBackend_GetEnclaveSystemContainers_Call struct {
+	*mock.Call
+}
+
+// GetEnclaveSystemContainers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) GetEnclaveSystemContainers(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_GetEnclaveSystemContainers_Call {
+	return &MockKurtosisBackend_GetEnclaveSystemContainers_Call{Call: _e.mock.On("GetEnclaveSystemContainers", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_GetEnclaveSystemContainers_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_GetEnclaveSystemContainers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetEnclaveSystemContainers_Call) Return(_a0 []*system_container.SystemContainer, _a1 error) *MockKurtosisBackend_GetEnclaveSystemContainers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetEnclaveSystemContainers_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID) ([]*system_container.SystemContainer, error)) *MockKurtosisBackend_GetEnclaveSystemContainers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEnclaves provides a mock function with given fields: ctx, filters
 func (_m *MockKurtosisBackend) GetEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.Enclave, error) {
 	ret := _m.Called(ctx, filters)
@@ -1176,6 +1340,116 @@ func (_c *MockKurtosisBackend_GetEnclaves_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// GetEnclavesPulledImages provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) GetEnclavesPulledImages(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]map[string]bool, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[enclave.EnclaveUUID]map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]map[string]bool, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]map[string]bool); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enclave.EnclaveUUID]map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *enclave.EnclaveFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetEnclavesPulledImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnclavesPulledImages'
+type MockKurtosisBackend_GetEnclavesPulledImages_Call struct {
+	*mock.Call
+}
+
+// GetEnclavesPulledImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *enclave.EnclaveFilters
+func (_e *MockKurtosisBackend_Expecter) GetEnclavesPulledImages(ctx interface{}, filters interface{}) *MockKurtosisBackend_GetEnclavesPulledImages_Call {
+	return &MockKurtosisBackend_GetEnclavesPulledImages_Call{Call: _e.mock.On("GetEnclavesPulledImages", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetEnclavesPulledImages_Call) Run(run func(ctx context.Context, filters *enclave.EnclaveFilters)) *MockKurtosisBackend_GetEnclavesPulledImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*enclave.EnclaveFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetEnclavesPulledImages_Call) Return(_a0 map[enclave.EnclaveUUID]map[string]bool, _a1 error) *MockKurtosisBackend_GetEnclavesPulledImages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetEnclavesPulledImages_Call) RunAndReturn(run func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]map[string]bool, error)) *MockKurtosisBackend_GetEnclavesPulledImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEnclavesResourceUsage provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) GetEnclavesResourceUsage(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *enclave.EnclaveFilters) error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetEnclavesResourceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnclavesResourceUsage'
+type MockKurtosisBackend_GetEnclavesResourceUsage_Call struct {
+	*mock.Call
+}
+
+// GetEnclavesResourceUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *enclave.EnclaveFilters
+func (_e *MockKurtosisBackend_Expecter) GetEnclavesResourceUsage(ctx interface{}, filters interface{}) *MockKurtosisBackend_GetEnclavesResourceUsage_Call {
+	return &MockKurtosisBackend_GetEnclavesResourceUsage_Call{Call: _e.mock.On("GetEnclavesResourceUsage", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetEnclavesResourceUsage_Call) Run(run func(ctx context.Context, filters *enclave.EnclaveFilters)) *MockKurtosisBackend_GetEnclavesResourceUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*enclave.EnclaveFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetEnclavesResourceUsage_Call) Return(_a0 map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage, _a1 error) *MockKurtosisBackend_GetEnclavesResourceUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetEnclavesResourceUsage_Call) RunAndReturn(run func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]*enclave.EnclaveResourceUsage, error)) *MockKurtosisBackend_GetEnclavesResourceUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetEngineLogs provides a mock function with given fields: ctx, outputDirpath
 func (_m *MockKurtosisBackend) GetEngineLogs(ctx context.Context, outputDirpath string) error {
 	ret := _m.Called(ctx, outputDirpath)
@@ -1274,25 +1548,25 @@ func (_c *MockKurtosisBackend_GetEngines_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
-// GetLogsCollectorForEnclave provides a mock function with given fields: ctx, enclaveUuid
-func (_m *MockKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
-	ret := _m.Called(ctx, enclaveUuid)
+// GetHostResources provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetHostResources(ctx context.Context) (*host_resources.HostResources, error) {
+	ret := _m.Called(ctx)
 
-	var r0 *logs_collector.LogsCollector
+	var r0 *host_resources.HostResources
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) (*logs_collector.LogsCollector, error)); ok {
-		return rf(ctx, enclaveUuid)
+	if rf, ok := ret.Get(0).(func(context.Context) (*host_resources.HostResources, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) *logs_collector.LogsCollector); ok {
-		r0 = rf(ctx, enclaveUuid)
+	if rf, ok := ret.Get(0).(func(context.Context) *host_resources.HostResources); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*logs_collector.LogsCollector)
+			r0 = ret.Get(0).(*host_resources.HostResources)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID) error); ok {
-		r1 = rf(ctx, enclaveUuid)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1300,54 +1574,53 @@ func (_m *MockKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, e
 	return r0, r1
 }
 
-// MockKurtosisBackend_GetLogsCollectorForEnclave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsCollectorForEnclave'
-type MockKurtosisBackend_GetLogsCollectorForEnclave_Call struct {
+// MockKurtosisBackend_GetHostResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHostResources'
+type MockKurtosisBackend_GetHostResources_Call struct {
 	*mock.Call
 }
 
-// GetLogsCollectorForEnclave is a helper method to define mock.On call
+// GetHostResources is a helper method to define mock.On call
 //   - ctx context.Context
-//   - enclaveUuid enclave.EnclaveUUID
-func (_e *MockKurtosisBackend_Expecter) GetLogsCollectorForEnclave(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
-	return &MockKurtosisBackend_GetLogsCollectorForEnclave_Call{Call: _e.mock.On("GetLogsCollectorForEnclave", ctx, enclaveUuid)}
+func (_e *MockKurtosisBackend_Expecter) GetHostResources(ctx interface{}) *MockKurtosisBackend_GetHostResources_Call {
+	return &MockKurtosisBackend_GetHostResources_Call{Call: _e.mock.On("GetHostResources", ctx)}
 }
 
-func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+func (_c *MockKurtosisBackend_GetHostResources_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetHostResources_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) Return(_a0 *logs_collector.LogsCollector, _a1 error) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+func (_c *MockKurtosisBackend_GetHostResources_Call) Return(_a0 *host_resources.HostResources, _a1 error) *MockKurtosisBackend_GetHostResources_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID) (*logs_collector.LogsCollector, error)) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+func (_c *MockKurtosisBackend_GetHostResources_Call) RunAndReturn(run func(context.Context) (*host_resources.HostResources, error)) *MockKurtosisBackend_GetHostResources_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLogsDatabase provides a mock function with given fields: ctx
-func (_m *MockKurtosisBackend) GetLogsDatabase(ctx context.Context) (*logs_database.LogsDatabase, error) {
-	ret := _m.Called(ctx)
+// GetImagesSizeBytes provides a mock function with given fields: ctx, images
+func (_m *MockKurtosisBackend) GetImagesSizeBytes(ctx context.Context, images map[string]bool) (map[string]int64, error) {
+	ret := _m.Called(ctx, images)
 
-	var r0 *logs_database.LogsDatabase
+	var r0 map[string]int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*logs_database.LogsDatabase, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) (map[string]int64, error)); ok {
+		return rf(ctx, images)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *logs_database.LogsDatabase); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) map[string]int64); ok {
+		r0 = rf(ctx, images)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*logs_database.LogsDatabase)
+			r0 = ret.Get(0).(map[string]int64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool) error); ok {
+		r1 = rf(ctx, images)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1355,52 +1628,217 @@ func (_m *MockKurtosisBackend) GetLogsDatabase(ctx context.Context) (*logs_datab
 	return r0, r1
 }
 
-// MockKurtosisBackend_GetLogsDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsDatabase'
-type MockKurtosisBackend_GetLogsDatabase_Call struct {
+// MockKurtosisBackend_GetImagesSizeBytes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImagesSizeBytes'
+type MockKurtosisBackend_GetImagesSizeBytes_Call struct {
 	*mock.Call
 }
 
-// GetLogsDatabase is a helper method to define mock.On call
+// GetImagesSizeBytes is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockKurtosisBackend_Expecter) GetLogsDatabase(ctx interface{}) *MockKurtosisBackend_GetLogsDatabase_Call {
-	return &MockKurtosisBackend_GetLogsDatabase_Call{Call: _e.mock.On("GetLogsDatabase", ctx)}
+//   - images map[string]bool
+func (_e *MockKurtosisBackend_Expecter) GetImagesSizeBytes(ctx interface{}, images interface{}) *MockKurtosisBackend_GetImagesSizeBytes_Call {
+	return &MockKurtosisBackend_GetImagesSizeBytes_Call{Call: _e.mock.On("GetImagesSizeBytes", ctx, images)}
 }
 
-func (_c *MockKurtosisBackend_GetLogsDatabase_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetLogsDatabase_Call {
+func (_c *MockKurtosisBackend_GetImagesSizeBytes_Call) Run(run func(ctx context.Context, images map[string]bool)) *MockKurtosisBackend_GetImagesSizeBytes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(map[string]bool))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetLogsDatabase_Call) Return(_a0 *logs_database.LogsDatabase, _a1 error) *MockKurtosisBackend_GetLogsDatabase_Call {
+func (_c *MockKurtosisBackend_GetImagesSizeBytes_Call) Return(_a0 map[string]int64, _a1 error) *MockKurtosisBackend_GetImagesSizeBytes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetLogsDatabase_Call) RunAndReturn(run func(context.Context) (*logs_database.LogsDatabase, error)) *MockKurtosisBackend_GetLogsDatabase_Call {
+func (_c *MockKurtosisBackend_GetImagesSizeBytes_Call) RunAndReturn(run func(context.Context, map[string]bool) (map[string]int64, error)) *MockKurtosisBackend_GetImagesSizeBytes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetNetworkingSidecars provides a mock function with given fields: ctx, filters
-func (_m *MockKurtosisBackend) GetNetworkingSidecars(ctx context.Context, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
-	ret := _m.Called(ctx, filters)
+// GetLeakedEnclaveResources provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) GetLeakedEnclaveResources(ctx context.Context, enclaveUuid enclave.EnclaveUUID) ([]*enclave.LeakedEnclaveResource, error) {
+	ret := _m.Called(ctx, enclaveUuid)
 
-	var r0 map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar
+	var r0 []*enclave.LeakedEnclaveResource
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)); ok {
-		return rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) ([]*enclave.LeakedEnclaveResource, error)); ok {
+		return rf(ctx, enclaveUuid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar); ok {
-		r0 = rf(ctx, filters)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) []*enclave.LeakedEnclaveResource); ok {
+		r0 = rf(ctx, enclaveUuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar)
+			r0 = ret.Get(0).([]*enclave.LeakedEnclaveResource)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *networking_sidecar.NetworkingSidecarFilters) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetLeakedEnclaveResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeakedEnclaveResources'
+type MockKurtosisBackend_GetLeakedEnclaveResources_Call struct {
+	*mock.Call
+}
+
+// GetLeakedEnclaveResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) GetLeakedEnclaveResources(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_GetLeakedEnclaveResources_Call {
+	return &MockKurtosisBackend_GetLeakedEnclaveResources_Call{Call: _e.mock.On("GetLeakedEnclaveResources", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_GetLeakedEnclaveResources_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_GetLeakedEnclaveResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLeakedEnclaveResources_Call) Return(_a0 []*enclave.LeakedEnclaveResource, _a1 error) *MockKurtosisBackend_GetLeakedEnclaveResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLeakedEnclaveResources_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID) ([]*enclave.LeakedEnclaveResource, error)) *MockKurtosisBackend_GetLeakedEnclaveResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLogsCollectorForEnclave provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
+	ret := _m.Called(ctx, enclaveUuid)
+
+	var r0 *logs_collector.LogsCollector
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) (*logs_collector.LogsCollector, error)); ok {
+		return rf(ctx, enclaveUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) *logs_collector.LogsCollector); ok {
+		r0 = rf(ctx, enclaveUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logs_collector.LogsCollector)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetLogsCollectorForEnclave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsCollectorForEnclave'
+type MockKurtosisBackend_GetLogsCollectorForEnclave_Call struct {
+	*mock.Call
+}
+
+// GetLogsCollectorForEnclave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) GetLogsCollectorForEnclave(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+	return &MockKurtosisBackend_GetLogsCollectorForEnclave_Call{Call: _e.mock.On("GetLogsCollectorForEnclave", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) Return(_a0 *logs_collector.LogsCollector, _a1 error) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID) (*logs_collector.LogsCollector, error)) *MockKurtosisBackend_GetLogsCollectorForEnclave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLogsDatabase provides a mock function with given fields: ctx
+func (_m *MockKurtosisBackend) GetLogsDatabase(ctx context.Context) (*logs_database.LogsDatabase, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *logs_database.LogsDatabase
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*logs_database.LogsDatabase, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *logs_database.LogsDatabase); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logs_database.LogsDatabase)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetLogsDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsDatabase'
+type MockKurtosisBackend_GetLogsDatabase_Call struct {
+	*mock.Call
+}
+
+// GetLogsDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockKurtosisBackend_Expecter) GetLogsDatabase(ctx interface{}) *MockKurtosisBackend_GetLogsDatabase_Call {
+	return &MockKurtosisBackend_GetLogsDatabase_Call{Call: _e.mock.On("GetLogsDatabase", ctx)}
+}
+
+func (_c *MockKurtosisBackend_GetLogsDatabase_Call) Run(run func(ctx context.Context)) *MockKurtosisBackend_GetLogsDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLogsDatabase_Call) Return(_a0 *logs_database.LogsDatabase, _a1 error) *MockKurtosisBackend_GetLogsDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetLogsDatabase_Call) RunAndReturn(run func(context.Context) (*logs_database.LogsDatabase, error)) *MockKurtosisBackend_GetLogsDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNetworkingSidecars provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) GetNetworkingSidecars(ctx context.Context, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *networking_sidecar.NetworkingSidecarFilters) error); ok {
 		r1 = rf(ctx, filters)
 	} else {
 		r1 = ret.Error(1)
@@ -1438,34 +1876,90 @@ func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) RunAndReturn(run func(
 	return _c
 }
 
-// GetUserServiceLogs provides a mock function with given fields: ctx, enclaveUuid, filters, shouldFollowLogs
-func (_m *MockKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error) {
-	ret := _m.Called(ctx, enclaveUuid, filters, shouldFollowLogs)
+// GetServiceMetrics provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
+func (_m *MockKurtosisBackend) GetServiceMetrics(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*service.ServiceMetrics, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
+
+	var r0 *service.ServiceMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (*service.ServiceMetrics, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) *service.ServiceMetrics); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.ServiceMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetServiceMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceMetrics'
+type MockKurtosisBackend_GetServiceMetrics_Call struct {
+	*mock.Call
+}
+
+// GetServiceMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+func (_e *MockKurtosisBackend_Expecter) GetServiceMetrics(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}) *MockKurtosisBackend_GetServiceMetrics_Call {
+	return &MockKurtosisBackend_GetServiceMetrics_Call{Call: _e.mock.On("GetServiceMetrics", ctx, enclaveUuid, serviceUuid)}
+}
+
+func (_c *MockKurtosisBackend_GetServiceMetrics_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID)) *MockKurtosisBackend_GetServiceMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetServiceMetrics_Call) Return(_a0 *service.ServiceMetrics, _a1 error) *MockKurtosisBackend_GetServiceMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetServiceMetrics_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (*service.ServiceMetrics, error)) *MockKurtosisBackend_GetServiceMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserServiceLogs provides a mock function with given fields: ctx, enclaveUuid, filters, shouldFollowLogs, timeRange
+func (_m *MockKurtosisBackend) GetUserServiceLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool, timeRange *service.ServiceLogsTimeRange) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
 
 	var r0 map[service.ServiceUUID]io.ReadCloser
 	var r1 map[service.ServiceUUID]error
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error)); ok {
-		return rf(ctx, enclaveUuid, filters, shouldFollowLogs)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool, *service.ServiceLogsTimeRange) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool) map[service.ServiceUUID]io.ReadCloser); ok {
-		r0 = rf(ctx, enclaveUuid, filters, shouldFollowLogs)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool, *service.ServiceLogsTimeRange) map[service.ServiceUUID]io.ReadCloser); ok {
+		r0 = rf(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[service.ServiceUUID]io.ReadCloser)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool) map[service.ServiceUUID]error); ok {
-		r1 = rf(ctx, enclaveUuid, filters, shouldFollowLogs)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool, *service.ServiceLogsTimeRange) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(map[service.ServiceUUID]error)
 		}
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool) error); ok {
-		r2 = rf(ctx, enclaveUuid, filters, shouldFollowLogs)
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool, *service.ServiceLogsTimeRange) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -1483,13 +1977,14 @@ type MockKurtosisBackend_GetUserServiceLogs_Call struct {
 //   - enclaveUuid enclave.EnclaveUUID
 //   - filters *service.ServiceFilters
 //   - shouldFollowLogs bool
-func (_e *MockKurtosisBackend_Expecter) GetUserServiceLogs(ctx interface{}, enclaveUuid interface{}, filters interface{}, shouldFollowLogs interface{}) *MockKurtosisBackend_GetUserServiceLogs_Call {
-	return &MockKurtosisBackend_GetUserServiceLogs_Call{Call: _e.mock.On("GetUserServiceLogs", ctx, enclaveUuid, filters, shouldFollowLogs)}
+//   - timeRange *service.ServiceLogsTimeRange
+func (_e *MockKurtosisBackend_Expecter) GetUserServiceLogs(ctx interface{}, enclaveUuid interface{}, filters interface{}, shouldFollowLogs interface{}, timeRange interface{}) *MockKurtosisBackend_GetUserServiceLogs_Call {
+	return &MockKurtosisBackend_GetUserServiceLogs_Call{Call: _e.mock.On("GetUserServiceLogs", ctx, enclaveUuid, filters, shouldFollowLogs, timeRange)}
 }
 
-func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool)) *MockKurtosisBackend_GetUserServiceLogs_Call {
+func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters, shouldFollowLogs bool, timeRange *service.ServiceLogsTimeRange)) *MockKurtosisBackend_GetUserServiceLogs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters), args[3].(bool))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters), args[3].(bool), args[4].(*service.ServiceLogsTimeRange))
 	})
 	return _c
 }
@@ -1499,7 +1994,7 @@ func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) Return(successfulUserServ
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceLogs_Call {
+func (_c *MockKurtosisBackend_GetUserServiceLogs_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters, bool, *service.ServiceLogsTimeRange) (map[service.ServiceUUID]io.ReadCloser, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_GetUserServiceLogs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1521,52 +2016,249 @@ func (_m *MockKurtosisBackend) GetUserServices(ctx context.Context, enclaveUuid
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
-		r1 = rf(ctx, enclaveUuid, filters)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetUserServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServices'
+type MockKurtosisBackend_GetUserServices_Call struct {
+	*mock.Call
+}
+
+// GetUserServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) GetUserServices(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServices_Call {
+	return &MockKurtosisBackend_GetUserServices_Call{Call: _e.mock.On("GetUserServices", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetUserServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServices_Call) Return(_a0 map[service.ServiceUUID]*service.Service, _a1 error) *MockKurtosisBackend_GetUserServices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetUserServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error)) *MockKurtosisBackend_GetUserServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseService provides a mock function with given fields: ctx, enclaveUuid, serviceUUID
+func (_m *MockKurtosisBackend) PauseService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUUID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_PauseService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseService'
+type MockKurtosisBackend_PauseService_Call struct {
+	*mock.Call
+}
+
+// PauseService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUUID service.ServiceUUID
+func (_e *MockKurtosisBackend_Expecter) PauseService(ctx interface{}, enclaveUuid interface{}, serviceUUID interface{}) *MockKurtosisBackend_PauseService_Call {
+	return &MockKurtosisBackend_PauseService_Call{Call: _e.mock.On("PauseService", ctx, enclaveUuid, serviceUUID)}
+}
+
+func (_c *MockKurtosisBackend_PauseService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID)) *MockKurtosisBackend_PauseService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PauseService_Call) Return(resultErr error) *MockKurtosisBackend_PauseService_Call {
+	_c.Call.Return(resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PauseService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error) *MockKurtosisBackend_PauseService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseServices provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) PauseServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]bool
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]bool); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_PauseServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseServices'
+type MockKurtosisBackend_PauseServices_Call struct {
+	*mock.Call
+}
+
+// PauseServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) PauseServices(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_PauseServices_Call {
+	return &MockKurtosisBackend_PauseServices_Call{Call: _e.mock.On("PauseServices", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_PauseServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_PauseServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PauseServices_Call) Return(successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_PauseServices_Call {
+	_c.Call.Return(successfulUserServiceUuids, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PauseServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_PauseServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PullImageWithProgress provides a mock function with given fields: ctx, image, progressCallback
+func (_m *MockKurtosisBackend) PullImageWithProgress(ctx context.Context, image string, progressCallback func(uint64, uint64)) error {
+	ret := _m.Called(ctx, image, progressCallback)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(uint64, uint64)) error); ok {
+		r0 = rf(ctx, image, progressCallback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_PullImageWithProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PullImageWithProgress'
+type MockKurtosisBackend_PullImageWithProgress_Call struct {
+	*mock.Call
+}
+
+// PullImageWithProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - image string
+//   - progressCallback func(uint64, uint64)
+func (_e *MockKurtosisBackend_Expecter) PullImageWithProgress(ctx interface{}, image interface{}, progressCallback interface{}) *MockKurtosisBackend_PullImageWithProgress_Call {
+	return &MockKurtosisBackend_PullImageWithProgress_Call{Call: _e.mock.On("PullImageWithProgress", ctx, image, progressCallback)}
+}
+
+func (_c *MockKurtosisBackend_PullImageWithProgress_Call) Run(run func(ctx context.Context, image string, progressCallback func(uint64, uint64))) *MockKurtosisBackend_PullImageWithProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(func(uint64, uint64)))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PullImageWithProgress_Call) Return(_a0 error) *MockKurtosisBackend_PullImageWithProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_PullImageWithProgress_Call) RunAndReturn(run func(context.Context, string, func(uint64, uint64)) error) *MockKurtosisBackend_PullImageWithProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestartService provides a mock function with given fields: ctx, enclaveUuid, serviceUUID
+func (_m *MockKurtosisBackend) RestartService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUUID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUUID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockKurtosisBackend_GetUserServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserServices'
-type MockKurtosisBackend_GetUserServices_Call struct {
+// MockKurtosisBackend_RestartService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartService'
+type MockKurtosisBackend_RestartService_Call struct {
 	*mock.Call
 }
 
-// GetUserServices is a helper method to define mock.On call
+// RestartService is a helper method to define mock.On call
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
-//   - filters *service.ServiceFilters
-func (_e *MockKurtosisBackend_Expecter) GetUserServices(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetUserServices_Call {
-	return &MockKurtosisBackend_GetUserServices_Call{Call: _e.mock.On("GetUserServices", ctx, enclaveUuid, filters)}
+//   - serviceUUID service.ServiceUUID
+func (_e *MockKurtosisBackend_Expecter) RestartService(ctx interface{}, enclaveUuid interface{}, serviceUUID interface{}) *MockKurtosisBackend_RestartService_Call {
+	return &MockKurtosisBackend_RestartService_Call{Call: _e.mock.On("RestartService", ctx, enclaveUuid, serviceUUID)}
 }
 
-func (_c *MockKurtosisBackend_GetUserServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_GetUserServices_Call {
+func (_c *MockKurtosisBackend_RestartService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID)) *MockKurtosisBackend_RestartService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetUserServices_Call) Return(_a0 map[service.ServiceUUID]*service.Service, _a1 error) *MockKurtosisBackend_GetUserServices_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockKurtosisBackend_RestartService_Call) Return(resultErr error) *MockKurtosisBackend_RestartService_Call {
+	_c.Call.Return(resultErr)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_GetUserServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]*service.Service, error)) *MockKurtosisBackend_GetUserServices_Call {
+func (_c *MockKurtosisBackend_RestartService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error) *MockKurtosisBackend_RestartService_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PauseService provides a mock function with given fields: ctx, enclaveUuid, serviceUUID
-func (_m *MockKurtosisBackend) PauseService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) error {
-	ret := _m.Called(ctx, enclaveUuid, serviceUUID)
+// SendSignalToService provides a mock function with given fields: ctx, enclaveUuid, serviceUUID, signal
+func (_m *MockKurtosisBackend) SendSignalToService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID, signal string) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUUID, signal)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
-		r0 = rf(ctx, enclaveUuid, serviceUUID)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUUID, signal)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1574,32 +2266,33 @@ func (_m *MockKurtosisBackend) PauseService(ctx context.Context, enclaveUuid enc
 	return r0
 }
 
-// MockKurtosisBackend_PauseService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseService'
-type MockKurtosisBackend_PauseService_Call struct {
+// MockKurtosisBackend_SendSignalToService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendSignalToService'
+type MockKurtosisBackend_SendSignalToService_Call struct {
 	*mock.Call
 }
 
-// PauseService is a helper method to define mock.On call
+// SendSignalToService is a helper method to define mock.On call
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - serviceUUID service.ServiceUUID
-func (_e *MockKurtosisBackend_Expecter) PauseService(ctx interface{}, enclaveUuid interface{}, serviceUUID interface{}) *MockKurtosisBackend_PauseService_Call {
-	return &MockKurtosisBackend_PauseService_Call{Call: _e.mock.On("PauseService", ctx, enclaveUuid, serviceUUID)}
+//   - signal string
+func (_e *MockKurtosisBackend_Expecter) SendSignalToService(ctx interface{}, enclaveUuid interface{}, serviceUUID interface{}, signal interface{}) *MockKurtosisBackend_SendSignalToService_Call {
+	return &MockKurtosisBackend_SendSignalToService_Call{Call: _e.mock.On("SendSignalToService", ctx, enclaveUuid, serviceUUID, signal)}
 }
 
-func (_c *MockKurtosisBackend_PauseService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID)) *MockKurtosisBackend_PauseService_Call {
+func (_c *MockKurtosisBackend_SendSignalToService_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID, signal string)) *MockKurtosisBackend_SendSignalToService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *MockKurtosisBackend_PauseService_Call) Return(resultErr error) *MockKurtosisBackend_PauseService_Call {
+func (_c *MockKurtosisBackend_SendSignalToService_Call) Return(resultErr error) *MockKurtosisBackend_SendSignalToService_Call {
 	_c.Call.Return(resultErr)
 	return _c
 }
 
-func (_c *MockKurtosisBackend_PauseService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error) *MockKurtosisBackend_PauseService_Call {
+func (_c *MockKurtosisBackend_SendSignalToService_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, string) error) *MockKurtosisBackend_SendSignalToService_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1669,6 +2362,135 @@ func (_c *MockKurtosisBackend_RegisterUserServices_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RemoveImages provides a mock function with given fields: ctx, images
+func (_m *MockKurtosisBackend) RemoveImages(ctx context.Context, images map[string]bool) (map[string]bool, map[string]error, error) {
+	ret := _m.Called(ctx, images)
+
+	var r0 map[string]bool
+	var r1 map[string]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) (map[string]bool, map[string]error, error)); ok {
+		return rf(ctx, images)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) map[string]bool); ok {
+		r0 = rf(ctx, images)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool) map[string]error); ok {
+		r1 = rf(ctx, images)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, map[string]bool) error); ok {
+		r2 = rf(ctx, images)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_RemoveImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveImages'
+type MockKurtosisBackend_RemoveImages_Call struct {
+	*mock.Call
+}
+
+// RemoveImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - images map[string]bool
+func (_e *MockKurtosisBackend_Expecter) RemoveImages(ctx interface{}, images interface{}) *MockKurtosisBackend_RemoveImages_Call {
+	return &MockKurtosisBackend_RemoveImages_Call{Call: _e.mock.On("RemoveImages", ctx, images)}
+}
+
+func (_c *MockKurtosisBackend_RemoveImages_Call) Run(run func(ctx context.Context, images map[string]bool)) *MockKurtosisBackend_RemoveImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]bool))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RemoveImages_Call) Return(successfulImages map[string]bool, erroredImages map[string]error, resultErr error) *MockKurtosisBackend_RemoveImages_Call {
+	_c.Call.Return(successfulImages, erroredImages, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RemoveImages_Call) RunAndReturn(run func(context.Context, map[string]bool) (map[string]bool, map[string]error, error)) *MockKurtosisBackend_RemoveImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceUserServices provides a mock function with given fields: ctx, enclaveUuid, services
+func (_m *MockKurtosisBackend) ReplaceUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, services)
+
+	var r0 map[service.ServiceUUID]*service.Service
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, services)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID]*service.ServiceConfig) map[service.ServiceUUID]*service.Service); ok {
+		r0 = rf(ctx, enclaveUuid, services)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*service.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID]*service.ServiceConfig) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, services)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID]*service.ServiceConfig) error); ok {
+		r2 = rf(ctx, enclaveUuid, services)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_ReplaceUserServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceUserServices'
+type MockKurtosisBackend_ReplaceUserServices_Call struct {
+	*mock.Call
+}
+
+// ReplaceUserServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - services map[service.ServiceUUID]*service.ServiceConfig
+func (_e *MockKurtosisBackend_Expecter) ReplaceUserServices(ctx interface{}, enclaveUuid interface{}, services interface{}) *MockKurtosisBackend_ReplaceUserServices_Call {
+	return &MockKurtosisBackend_ReplaceUserServices_Call{Call: _e.mock.On("ReplaceUserServices", ctx, enclaveUuid, services)}
+}
+
+func (_c *MockKurtosisBackend_ReplaceUserServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig)) *MockKurtosisBackend_ReplaceUserServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(map[service.ServiceUUID]*service.ServiceConfig))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ReplaceUserServices_Call) Return(_a0 map[service.ServiceUUID]*service.Service, _a1 map[service.ServiceUUID]error, _a2 error) *MockKurtosisBackend_ReplaceUserServices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_ReplaceUserServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_ReplaceUserServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunNetworkingSidecarExecCommands provides a mock function with given fields: ctx, enclaveUuid, networkingSidecarsCommands
 func (_m *MockKurtosisBackend) RunNetworkingSidecarExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarsCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, networkingSidecarsCommands)
@@ -1734,6 +2556,62 @@ func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) RunAndRetur
 	return _c
 }
 
+// RunUserServiceExecCommandWithStreamedOutput provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, command, output
+func (_m *MockKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, command []string, output io.Writer) (int32, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, command, output)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) (int32, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid, command, output)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) int32); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, command, output)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, command, output)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunUserServiceExecCommandWithStreamedOutput'
+type MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call struct {
+	*mock.Call
+}
+
+// RunUserServiceExecCommandWithStreamedOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - command []string
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) RunUserServiceExecCommandWithStreamedOutput(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, command interface{}, output interface{}) *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call {
+	return &MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call{Call: _e.mock.On("RunUserServiceExecCommandWithStreamedOutput", ctx, enclaveUuid, serviceUuid, command, output)}
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, command []string, output io.Writer)) *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].([]string), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call) Return(exitCode int32, resultErr error) *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call {
+	_c.Call.Return(exitCode, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, []string, io.Writer) (int32, error)) *MockKurtosisBackend_RunUserServiceExecCommandWithStreamedOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunUserServiceExecCommands provides a mock function with given fields: ctx, enclaveUuid, userServiceCommands
 func (_m *MockKurtosisBackend) RunUserServiceExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, userServiceCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, userServiceCommands)
@@ -2229,6 +3107,71 @@ func (_c *MockKurtosisBackend_UnpauseService_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UnpauseServices provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) UnpauseServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]bool
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]bool); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_UnpauseServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpauseServices'
+type MockKurtosisBackend_UnpauseServices_Call struct {
+	*mock.Call
+}
+
+// UnpauseServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *service.ServiceFilters
+func (_e *MockKurtosisBackend_Expecter) UnpauseServices(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_UnpauseServices_Call {
+	return &MockKurtosisBackend_UnpauseServices_Call{Call: _e.mock.On("UnpauseServices", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_UnpauseServices_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters)) *MockKurtosisBackend_UnpauseServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*service.ServiceFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UnpauseServices_Call) Return(successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_UnpauseServices_Call {
+	_c.Call.Return(successfulUserServiceUuids, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_UnpauseServices_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_UnpauseServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) UnregisterUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]bool) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
@@ -2294,6 +3237,60 @@ func (_c *MockKurtosisBackend_UnregisterUserServices_Call) RunAndReturn(run func
 	return _c
 }
 
+// WaitForServiceExit provides a mock function with given fields: ctx, enclaveUuid, serviceUUID
+func (_m *MockKurtosisBackend) WaitForServiceExit(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID) (int32, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUUID)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (int32, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) int32); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUUID)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_WaitForServiceExit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForServiceExit'
+type MockKurtosisBackend_WaitForServiceExit_Call struct {
+	*mock.Call
+}
+
+// WaitForServiceExit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUUID service.ServiceUUID
+func (_e *MockKurtosisBackend_Expecter) WaitForServiceExit(ctx interface{}, enclaveUuid interface{}, serviceUUID interface{}) *MockKurtosisBackend_WaitForServiceExit_Call {
+	return &MockKurtosisBackend_WaitForServiceExit_Call{Call: _e.mock.On("WaitForServiceExit", ctx, enclaveUuid, serviceUUID)}
+}
+
+func (_c *MockKurtosisBackend_WaitForServiceExit_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUUID service.ServiceUUID)) *MockKurtosisBackend_WaitForServiceExit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_WaitForServiceExit_Call) Return(exitCode int32, resultErr error) *MockKurtosisBackend_WaitForServiceExit_Call {
+	_c.Call.Return(exitCode, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_WaitForServiceExit_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (int32, error)) *MockKurtosisBackend_WaitForServiceExit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockKurtosisBackend interface {
 	mock.TestingT
 	Cleanup(func())
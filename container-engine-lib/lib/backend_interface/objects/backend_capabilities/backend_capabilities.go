@@ -0,0 +1,53 @@
+package backend_capabilities
+
+// BackendCapabilities tells which of the optional features of Kurtosis a backend supports, so that using a feature that
+// isn't supported fails upfront rather than deep down while the enclave is being set up
+type BackendCapabilities struct {
+	isNetworkPartitioningSupported bool
+
+	isServicePausingSupported bool
+
+	// Whether services can ask for the port numbers their ports get published on
+	areStaticPublicPortsSupported bool
+
+	arePersistentVolumesSupported bool
+
+	// Whether interactive shells with a TTY can be opened in services
+	isExecTtySupported bool
+}
+
+func NewBackendCapabilities(
+	isNetworkPartitioningSupported bool,
+	isServicePausingSupported bool,
+	areStaticPublicPortsSupported bool,
+	arePersistentVolumesSupported bool,
+	isExecTtySupported bool,
+) *BackendCapabilities {
+	return &BackendCapabilities{
+		isNetworkPartitioningSupported: isNetworkPartitioningSupported,
+		isServicePausingSupported:      isServicePausingSupported,
+		areStaticPublicPortsSupported:  areStaticPublicPortsSupported,
+		arePersistentVolumesSupported:  arePersistentVolumesSupported,
+		isExecTtySupported:             isExecTtySupported,
+	}
+}
+
+func (capabilities *BackendCapabilities) IsNetworkPartitioningSupported() bool {
+	return capabilities.isNetworkPartitioningSupported
+}
+
+func (capabilities *BackendCapabilities) IsServicePausingSupported() bool {
+	return capabilities.isServicePausingSupported
+}
+
+func (capabilities *BackendCapabilities) AreStaticPublicPortsSupported() bool {
+	return capabilities.areStaticPublicPortsSupported
+}
+
+func (capabilities *BackendCapabilities) ArePersistentVolumesSupported() bool {
+	return capabilities.arePersistentVolumesSupported
+}
+
+func (capabilities *BackendCapabilities) IsExecTtySupported() bool {
+	return capabilities.isExecTtySupported
+}
@@ -1,6 +1,9 @@
 package enclave
 
-import "time"
+import (
+	"net"
+	"time"
+)
 
 type EnclaveUUID string
 
@@ -9,10 +12,14 @@ type Enclave struct {
 	name         string
 	status       EnclaveStatus
 	creationTime *time.Time
+	// Nil if the backend doesn't report it
+	subnet *net.IPNet
+	// Empty if the enclave wasn't created by a run of an enclave schedule
+	scheduleName string
 }
 
-func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time) *Enclave {
-	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime}
+func NewEnclave(id EnclaveUUID, name string, status EnclaveStatus, creationTime *time.Time, subnet *net.IPNet, scheduleName string) *Enclave {
+	return &Enclave{uuid: id, name: name, status: status, creationTime: creationTime, subnet: subnet, scheduleName: scheduleName}
 }
 
 func (enclave *Enclave) GetUUID() EnclaveUUID {
@@ -30,3 +37,11 @@ func (enclave *Enclave) GetCreationTime() *time.Time {
 func (enclave *Enclave) GetName() string {
 	return enclave.name
 }
+
+func (enclave *Enclave) GetSubnet() *net.IPNet {
+	return enclave.subnet
+}
+
+func (enclave *Enclave) GetScheduleName() string {
+	return enclave.scheduleName
+}
@@ -0,0 +1,44 @@
+package enclave
+
+const (
+	// Used when the container engine couldn't tell how much disk the enclave uses
+	UnknownDiskUsageBytes int64 = -1
+)
+
+// EnclaveResourceUsage aggregates the resources allocated to the services of an enclave
+type EnclaveResourceUsage struct {
+	numServices uint32
+
+	// Services without a memory limit don't add to this
+	totalMemoryAllocationMegabytes uint64
+
+	numPublishedPorts uint32
+
+	// Disk used by the enclave's volumes (e.g. the enclave data directory), or UnknownDiskUsageBytes
+	diskUsageBytes int64
+}
+
+func NewEnclaveResourceUsage(numServices uint32, totalMemoryAllocationMegabytes uint64, numPublishedPorts uint32, diskUsageBytes int64) *EnclaveResourceUsage {
+	return &EnclaveResourceUsage{
+		numServices:                    numServices,
+		totalMemoryAllocationMegabytes: totalMemoryAllocationMegabytes,
+		numPublishedPorts:              numPublishedPorts,
+		diskUsageBytes:                 diskUsageBytes,
+	}
+}
+
+func (usage *EnclaveResourceUsage) GetNumServices() uint32 {
+	return usage.numServices
+}
+
+func (usage *EnclaveResourceUsage) GetTotalMemoryAllocationMegabytes() uint64 {
+	return usage.totalMemoryAllocationMegabytes
+}
+
+func (usage *EnclaveResourceUsage) GetNumPublishedPorts() uint32 {
+	return usage.numPublishedPorts
+}
+
+func (usage *EnclaveResourceUsage) GetDiskUsageBytes() int64 {
+	return usage.diskUsageBytes
+}
@@ -0,0 +1,112 @@
+package enclave
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Gives 4096 IP addresses per enclave, which is plenty for most enclaves while leaving room for many of them
+	DefaultEnclaveSubnetMaskBits = 20
+
+	// Enclaves need at least a gateway, the API container and a few services
+	MaxEnclaveSubnetMaskBits = 28
+	// Bigger subnets would hardly ever find a free spot in the private address space
+	MinEnclaveSubnetMaskBits = 8
+
+	ipv4BitLength = 32
+
+	maskOnlySubnetSpecPrefix = "/"
+)
+
+// EnclaveSubnetSpec tells which subnet the network of a new enclave gets
+type EnclaveSubnetSpec struct {
+	// If set, the enclave gets exactly this subnet, and creating the enclave fails if it's taken
+	cidr *net.IPNet
+
+	// Otherwise, the enclave gets a random free subnet whose mask has this many bits
+	maskBits int
+
+	// If set, the enclave network also gets a random IPv6 ULA subnet on top of its IPv4 one, for services to get an
+	// address of each family
+	isIpv6Enabled bool
+}
+
+// NewDefaultEnclaveSubnetSpec gives the enclave a random free subnet of the default size
+func NewDefaultEnclaveSubnetSpec() *EnclaveSubnetSpec {
+	return &EnclaveSubnetSpec{
+		cidr:          nil,
+		maskBits:      DefaultEnclaveSubnetMaskBits,
+		isIpv6Enabled: false,
+	}
+}
+
+// ParseEnclaveSubnetSpec parses either a CIDR (e.g. '10.200.0.0/16') for the enclave to get exactly that subnet, or a
+// mask only (e.g. '/16') for the enclave to get a random free subnet of that size; the empty string means the default
+// The subnet is always an IPv4 one, and enabling IPv6 gives the enclave a random IPv6 subnet on top of it
+func ParseEnclaveSubnetSpec(subnetSpecStr string, isIpv6Enabled bool) (*EnclaveSubnetSpec, error) {
+	subnetSpecStr = strings.TrimSpace(subnetSpecStr)
+	if subnetSpecStr == "" {
+		spec := NewDefaultEnclaveSubnetSpec()
+		spec.isIpv6Enabled = isIpv6Enabled
+		return spec, nil
+	}
+
+	var cidr *net.IPNet
+	var maskBits int
+	if strings.HasPrefix(subnetSpecStr, maskOnlySubnetSpecPrefix) {
+		parsedMaskBits, err := strconv.Atoi(strings.TrimPrefix(subnetSpecStr, maskOnlySubnetSpecPrefix))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the mask of enclave subnet '%v', which should look like '/16'", subnetSpecStr)
+		}
+		maskBits = parsedMaskBits
+	} else {
+		ip, parsedCidr, err := net.ParseCIDR(subnetSpecStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing enclave subnet '%v', which should be a CIDR like '10.200.0.0/16' or a mask like '/16'", subnetSpecStr)
+		}
+		if ip.To4() == nil {
+			return nil, stacktrace.NewError("Enclave subnet '%v' isn't an IPv4 subnet; enable IPv6 on the enclave instead for it to get an IPv6 subnet too", subnetSpecStr)
+		}
+		if !ip.Equal(parsedCidr.IP) {
+			return nil, stacktrace.NewError("Enclave subnet '%v' has host bits set; did you mean '%v'?", subnetSpecStr, parsedCidr.String())
+		}
+		cidr = parsedCidr
+		maskBits, _ = parsedCidr.Mask.Size()
+	}
+
+	if maskBits < MinEnclaveSubnetMaskBits || maskBits > MaxEnclaveSubnetMaskBits {
+		return nil, stacktrace.NewError(
+			"Enclave subnet '%v' has a mask of %v bits, but it must have between %v and %v bits",
+			subnetSpecStr,
+			maskBits,
+			MinEnclaveSubnetMaskBits,
+			MaxEnclaveSubnetMaskBits,
+		)
+	}
+	return &EnclaveSubnetSpec{
+		cidr:          cidr,
+		maskBits:      maskBits,
+		isIpv6Enabled: isIpv6Enabled,
+	}, nil
+}
+
+// GetCidr returns nil if the enclave can get any free subnet of the requested size
+func (spec *EnclaveSubnetSpec) GetCidr() *net.IPNet {
+	return spec.cidr
+}
+
+func (spec *EnclaveSubnetSpec) GetMaskBits() int {
+	return spec.maskBits
+}
+
+// GetMask returns the mask of the subnet the enclave gets
+func (spec *EnclaveSubnetSpec) GetMask() net.IPMask {
+	return net.CIDRMask(spec.maskBits, ipv4BitLength)
+}
+
+func (spec *EnclaveSubnetSpec) IsIpv6Enabled() bool {
+	return spec.isIpv6Enabled
+}
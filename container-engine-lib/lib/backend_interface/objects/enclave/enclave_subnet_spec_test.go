@@ -0,0 +1,62 @@
+package enclave
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseEnclaveSubnetSpec(t *testing.T) {
+	spec, err := ParseEnclaveSubnetSpec("", false)
+	require.NoError(t, err)
+	require.Nil(t, spec.GetCidr())
+	require.Equal(t, DefaultEnclaveSubnetMaskBits, spec.GetMaskBits())
+
+	spec, err = ParseEnclaveSubnetSpec("/16", false)
+	require.NoError(t, err)
+	require.Nil(t, spec.GetCidr())
+	require.Equal(t, 16, spec.GetMaskBits())
+
+	spec, err = ParseEnclaveSubnetSpec("10.200.0.0/16", false)
+	require.NoError(t, err)
+	require.Equal(t, "10.200.0.0/16", spec.GetCidr().String())
+	require.Equal(t, 16, spec.GetMaskBits())
+	require.False(t, spec.IsIpv6Enabled())
+}
+
+func TestParseEnclaveSubnetSpecWithIpv6(t *testing.T) {
+	spec, err := ParseEnclaveSubnetSpec("", true)
+	require.NoError(t, err)
+	require.Nil(t, spec.GetCidr())
+	require.True(t, spec.IsIpv6Enabled())
+
+	spec, err = ParseEnclaveSubnetSpec("10.200.0.0/16", true)
+	require.NoError(t, err)
+	require.Equal(t, "10.200.0.0/16", spec.GetCidr().String())
+	require.True(t, spec.IsIpv6Enabled())
+
+	_, err = ParseEnclaveSubnetSpec("fd00::/64", true)
+	require.Error(t, err)
+}
+
+func TestParseEnclaveSubnetSpecErrors(t *testing.T) {
+	_, err := ParseEnclaveSubnetSpec("/abc", false)
+	require.Error(t, err)
+
+	_, err = ParseEnclaveSubnetSpec("10.200.0.0", false)
+	require.Error(t, err)
+
+	_, err = ParseEnclaveSubnetSpec("fd00::/64", false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't an IPv4 subnet")
+
+	_, err = ParseEnclaveSubnetSpec("10.200.0.1/16", false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "did you mean '10.200.0.0/16'")
+
+	_, err = ParseEnclaveSubnetSpec("/30", false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must have between 8 and 28 bits")
+
+	_, err = ParseEnclaveSubnetSpec("10.0.0.0/4", false)
+	require.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package enclave
+
+type LeakedEnclaveResourceType string
+
+const (
+	LeakedEnclaveResourceType_Container          LeakedEnclaveResourceType = "container"
+	LeakedEnclaveResourceType_Volume             LeakedEnclaveResourceType = "volume"
+	LeakedEnclaveResourceType_Network            LeakedEnclaveResourceType = "network"
+	LeakedEnclaveResourceType_IpAddrRegistration LeakedEnclaveResourceType = "IP address registration"
+)
+
+// LeakedEnclaveResource is a resource of an enclave that's still around after the enclave got destroyed
+type LeakedEnclaveResource struct {
+	resourceType LeakedEnclaveResourceType
+
+	// The ID the container engine knows the resource by (e.g. the container ID, or the IP address of a registration)
+	id string
+
+	name string
+
+	// Whatever the backend knows about how the resource came to be (e.g. the kind of container and when it got
+	// created), so whoever reads a leak report can tell which code path forgot to clean it up
+	creationContext string
+}
+
+func NewLeakedEnclaveResource(resourceType LeakedEnclaveResourceType, id string, name string, creationContext string) *LeakedEnclaveResource {
+	return &LeakedEnclaveResource{
+		resourceType:    resourceType,
+		id:              id,
+		name:            name,
+		creationContext: creationContext,
+	}
+}
+
+func (resource *LeakedEnclaveResource) GetResourceType() LeakedEnclaveResourceType {
+	return resource.resourceType
+}
+
+func (resource *LeakedEnclaveResource) GetId() string {
+	return resource.id
+}
+
+func (resource *LeakedEnclaveResource) GetName() string {
+	return resource.name
+}
+
+func (resource *LeakedEnclaveResource) GetCreationContext() string {
+	return resource.creationContext
+}
@@ -6,6 +6,10 @@ import (
 	"net"
 )
 
+// Where the engine server keeps the state it persists across restarts; backends that can persist it mount a volume
+// there, otherwise the directory doesn't exist and the engine keeps its state in memory
+const EngineDataDirpath = "/kurtosis-engine-data"
+
 type EngineGUID string
 
 // Object that represents POINT-IN-TIME information about an engine server
@@ -0,0 +1,19 @@
+package host_resources
+
+// HostResources are the resources of the host that the backend runs the containers on
+type HostResources struct {
+	numCpus     uint64
+	memoryBytes uint64
+}
+
+func NewHostResources(numCpus uint64, memoryBytes uint64) *HostResources {
+	return &HostResources{numCpus: numCpus, memoryBytes: memoryBytes}
+}
+
+func (resources *HostResources) GetNumCpus() uint64 {
+	return resources.numCpus
+}
+
+func (resources *HostResources) GetMemoryBytes() uint64 {
+	return resources.memoryBytes
+}
@@ -0,0 +1,90 @@
+package service
+
+// KubernetesScheduling holds the node scheduling controls of a service's pod; only used by the Kubernetes backend
+type KubernetesScheduling struct {
+	// Labels a node must have for the pod to be scheduled on it
+	nodeSelector map[string]string
+
+	tolerations []*KubernetesToleration
+
+	// JSON of a Kubernetes core/v1 Affinity object, passed through as-is; empty if there's no affinity
+	serializedAffinity string
+
+	// JSON of a list of Kubernetes core/v1 TopologySpreadConstraint objects, passed through as-is; empty if there are none
+	serializedTopologySpreadConstraints string
+}
+
+func NewKubernetesScheduling(
+	nodeSelector map[string]string,
+	tolerations []*KubernetesToleration,
+	serializedAffinity string,
+	serializedTopologySpreadConstraints string,
+) *KubernetesScheduling {
+	return &KubernetesScheduling{
+		nodeSelector:                        nodeSelector,
+		tolerations:                         tolerations,
+		serializedAffinity:                  serializedAffinity,
+		serializedTopologySpreadConstraints: serializedTopologySpreadConstraints,
+	}
+}
+
+func (scheduling *KubernetesScheduling) GetNodeSelector() map[string]string {
+	return scheduling.nodeSelector
+}
+
+func (scheduling *KubernetesScheduling) GetTolerations() []*KubernetesToleration {
+	return scheduling.tolerations
+}
+
+func (scheduling *KubernetesScheduling) GetSerializedAffinity() string {
+	return scheduling.serializedAffinity
+}
+
+func (scheduling *KubernetesScheduling) GetSerializedTopologySpreadConstraints() string {
+	return scheduling.serializedTopologySpreadConstraints
+}
+
+type KubernetesToleration struct {
+	key string
+
+	// 'Equal' or 'Exists'
+	operator string
+
+	value string
+
+	// 'NoSchedule', 'PreferNoSchedule' or 'NoExecute'; empty to tolerate every effect
+	effect string
+
+	// Nil to tolerate the taint forever
+	tolerationSeconds *int64
+}
+
+func NewKubernetesToleration(key string, operator string, value string, effect string, tolerationSeconds *int64) *KubernetesToleration {
+	return &KubernetesToleration{
+		key:               key,
+		operator:          operator,
+		value:             value,
+		effect:            effect,
+		tolerationSeconds: tolerationSeconds,
+	}
+}
+
+func (toleration *KubernetesToleration) GetKey() string {
+	return toleration.key
+}
+
+func (toleration *KubernetesToleration) GetOperator() string {
+	return toleration.operator
+}
+
+func (toleration *KubernetesToleration) GetValue() string {
+	return toleration.value
+}
+
+func (toleration *KubernetesToleration) GetEffect() string {
+	return toleration.effect
+}
+
+func (toleration *KubernetesToleration) GetTolerationSeconds() *int64 {
+	return toleration.tolerationSeconds
+}
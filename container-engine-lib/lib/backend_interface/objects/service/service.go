@@ -1,15 +1,20 @@
 package service
 
 import (
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"net"
 	"regexp"
+	"time"
 )
 
 const (
 	ServiceNameRegex            = "[a-zA-Z0-9-_]+"
 	wordWrappedServiceNameRegex = "^" + ServiceNameRegex + "$"
+
+	// MaxServiceNameLength is the maximum length of a DNS label, as the service name is used as its hostname
+	MaxServiceNameLength = 63
 )
 
 var (
@@ -41,10 +46,20 @@ type Service struct {
 	// - The service's status is running
 	// - The backend type is Docker
 	maybePublicPorts map[string]*port_spec.PortSpec
+
+	// The private IPs of the individual replicas of the service, which each listen on the private ports. The private IP
+	// of the registration load-balances between them
+	// This will only be non-empty if the service was started with more than one replica, which only the Kubernetes
+	// backend supports
+	replicaPrivateIps []net.IP
+
+	// When the backend created the service's container
+	// This will be nil if the backend doesn't report it
+	maybeCreationTime *time.Time
 }
 
-func NewService(registration *ServiceRegistration, status container_status.ContainerStatus, privatePorts map[string]*port_spec.PortSpec, maybePublicIp net.IP, maybePublicPorts map[string]*port_spec.PortSpec) *Service {
-	return &Service{registration: registration, status: status, privatePorts: privatePorts, maybePublicIp: maybePublicIp, maybePublicPorts: maybePublicPorts}
+func NewService(registration *ServiceRegistration, status container_status.ContainerStatus, privatePorts map[string]*port_spec.PortSpec, maybePublicIp net.IP, maybePublicPorts map[string]*port_spec.PortSpec, replicaPrivateIps []net.IP, maybeCreationTime *time.Time) *Service {
+	return &Service{registration: registration, status: status, privatePorts: privatePorts, maybePublicIp: maybePublicIp, maybePublicPorts: maybePublicPorts, replicaPrivateIps: replicaPrivateIps, maybeCreationTime: maybeCreationTime}
 }
 
 func (service *Service) GetRegistration() *ServiceRegistration {
@@ -67,6 +82,49 @@ func (service *Service) GetMaybePublicPorts() map[string]*port_spec.PortSpec {
 	return service.maybePublicPorts
 }
 
+func (service *Service) GetReplicaPrivateIPs() []net.IP {
+	return service.replicaPrivateIps
+}
+
+func (service *Service) GetMaybeCreationTime() *time.Time {
+	return service.maybeCreationTime
+}
+
 func IsServiceNameValid(serviceName ServiceName) bool {
-	return compiledWordWrappedServiceNameRegex.MatchString(string(serviceName))
+	return ValidateServiceName(serviceName) == nil
+}
+
+// ValidateServiceName checks the service name against the naming rules, returning an error that states exactly which
+// one it breaks. The error is a plain one, without Go stacktrace, as it's meant to be surfaced as-is to the user:
+//   - it must not be empty
+//   - it must be at most MaxServiceNameLength characters long
+//   - it can only contain the characters 'a-z', 'A-Z', '0-9', '-' and '_'
+func ValidateServiceName(serviceName ServiceName) error {
+	serviceNameStr := string(serviceName)
+	if serviceNameStr == "" {
+		return fmt.Errorf("Service names can't be empty")
+	}
+	if len(serviceNameStr) > MaxServiceNameLength {
+		return fmt.Errorf(
+			"Service name '%v' is %d characters long, but service names can be at most %d characters long as they are used as hostnames",
+			serviceNameStr,
+			len(serviceNameStr),
+			MaxServiceNameLength,
+		)
+	}
+	if compiledWordWrappedServiceNameRegex.MatchString(serviceNameStr) {
+		return nil
+	}
+	for idx, char := range serviceNameStr {
+		if !compiledWordWrappedServiceNameRegex.MatchString(string(char)) {
+			return fmt.Errorf(
+				"Service name '%v' contains disallowed character '%c' at position %d; service names can only contain characters 'a-z', 'A-Z', '0-9', '-' and '_'",
+				serviceNameStr,
+				char,
+				idx+1,
+			)
+		}
+	}
+	// Can't happen as a string that doesn't match the regex has at least one disallowed character, but better safe than sorry
+	return fmt.Errorf("Service name '%v' doesn't match regex '%v'", serviceNameStr, wordWrappedServiceNameRegex)
 }
@@ -27,6 +27,36 @@ type ServiceConfig struct {
 	memoryAllocationMegabytes uint64
 
 	privateIPAddrPlaceholder string
+
+	// Only used by the Kubernetes backend; leave empty to run the service as the default service account of the enclave
+	kubernetesServiceAccount string
+
+	// Annotations of the above service account (e.g. to bind it to a cloud IAM role); leave as nil if there are none
+	kubernetesServiceAccountAnnotations map[string]string
+
+	// Only used by the Kubernetes backend; leave as nil to let the pod be scheduled on any node
+	kubernetesScheduling *KubernetesScheduling
+
+	// Number of replicas of the service; 0 and 1 both mean a single instance. Only the Kubernetes backend supports more
+	replicas uint32
+
+	// CPUs of the host the service is pinned to, in the Linux cpuset list format (e.g. "0-3,6"); leave empty to run on any
+	cpuset string
+
+	// NUMA nodes of the host the service can allocate memory on, in the Linux cpuset list format; leave empty to use any
+	numaNodes string
+
+	// Resource limits of the processes of the service, keyed by name (e.g. "nofile"); leave as nil to keep the defaults
+	ulimits map[string]*Ulimit
+
+	// Kernel parameters set in the namespaces of the service (e.g. "net.core.somaxconn"); leave as nil to keep the defaults
+	sysctls map[string]string
+
+	// Size of the service's /dev/shm, in megabytes; leave as 0 to keep the default of the container engine
+	shmSizeMegabytes uint64
+
+	// Directories of the host bind-mounted into the service, specified as mountpoint -> absolute host dirpath
+	hostDirMounts map[string]string
 }
 
 func NewServiceConfig(
@@ -39,18 +69,38 @@ func NewServiceConfig(
 	filesArtifactExpansion *files_artifacts_expansion.FilesArtifactsExpansion,
 	cpuAllocationMillicpus uint64,
 	memoryAllocationMegabytes uint64,
-	privateIPAddrPlaceholder string) *ServiceConfig {
+	privateIPAddrPlaceholder string,
+	kubernetesServiceAccount string,
+	kubernetesServiceAccountAnnotations map[string]string,
+	kubernetesScheduling *KubernetesScheduling,
+	replicas uint32,
+	cpuset string,
+	numaNodes string,
+	ulimits map[string]*Ulimit,
+	sysctls map[string]string,
+	shmSizeMegabytes uint64,
+	hostDirMounts map[string]string) *ServiceConfig {
 	return &ServiceConfig{
-		containerImageName:        containerImageName,
-		privatePorts:              privatePorts,
-		publicPorts:               publicPorts,
-		entrypointArgs:            entrypointArgs,
-		cmdArgs:                   cmdArgs,
-		envVars:                   envVars,
-		filesArtifactExpansion:    filesArtifactExpansion,
-		cpuAllocationMillicpus:    cpuAllocationMillicpus,
-		memoryAllocationMegabytes: memoryAllocationMegabytes,
-		privateIPAddrPlaceholder:  privateIPAddrPlaceholder,
+		containerImageName:                  containerImageName,
+		privatePorts:                        privatePorts,
+		publicPorts:                         publicPorts,
+		entrypointArgs:                      entrypointArgs,
+		cmdArgs:                             cmdArgs,
+		envVars:                             envVars,
+		filesArtifactExpansion:              filesArtifactExpansion,
+		cpuAllocationMillicpus:              cpuAllocationMillicpus,
+		memoryAllocationMegabytes:           memoryAllocationMegabytes,
+		privateIPAddrPlaceholder:            privateIPAddrPlaceholder,
+		kubernetesServiceAccount:            kubernetesServiceAccount,
+		kubernetesServiceAccountAnnotations: kubernetesServiceAccountAnnotations,
+		kubernetesScheduling:                kubernetesScheduling,
+		replicas:                            replicas,
+		cpuset:                              cpuset,
+		numaNodes:                           numaNodes,
+		ulimits:                             ulimits,
+		sysctls:                             sysctls,
+		shmSizeMegabytes:                    shmSizeMegabytes,
+		hostDirMounts:                       hostDirMounts,
 	}
 }
 
@@ -93,3 +143,43 @@ func (serviceConfig *ServiceConfig) GetMemoryAllocationMegabytes() uint64 {
 func (serviceConfig *ServiceConfig) GetPrivateIPAddrPlaceholder() string {
 	return serviceConfig.privateIPAddrPlaceholder
 }
+
+func (serviceConfig *ServiceConfig) GetKubernetesServiceAccount() string {
+	return serviceConfig.kubernetesServiceAccount
+}
+
+func (serviceConfig *ServiceConfig) GetKubernetesServiceAccountAnnotations() map[string]string {
+	return serviceConfig.kubernetesServiceAccountAnnotations
+}
+
+func (serviceConfig *ServiceConfig) GetKubernetesScheduling() *KubernetesScheduling {
+	return serviceConfig.kubernetesScheduling
+}
+
+func (serviceConfig *ServiceConfig) GetReplicas() uint32 {
+	return serviceConfig.replicas
+}
+
+func (serviceConfig *ServiceConfig) GetCpuset() string {
+	return serviceConfig.cpuset
+}
+
+func (serviceConfig *ServiceConfig) GetNumaNodes() string {
+	return serviceConfig.numaNodes
+}
+
+func (serviceConfig *ServiceConfig) GetUlimits() map[string]*Ulimit {
+	return serviceConfig.ulimits
+}
+
+func (serviceConfig *ServiceConfig) GetSysctls() map[string]string {
+	return serviceConfig.sysctls
+}
+
+func (serviceConfig *ServiceConfig) GetShmSizeMegabytes() uint64 {
+	return serviceConfig.shmSizeMegabytes
+}
+
+func (serviceConfig *ServiceConfig) GetHostDirMounts() map[string]string {
+	return serviceConfig.hostDirMounts
+}
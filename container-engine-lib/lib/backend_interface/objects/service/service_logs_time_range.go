@@ -0,0 +1,33 @@
+package service
+
+import "time"
+
+// ServiceLogsTimeRange bounds the log lines returned for services to the ones logged within it, so that the logs of
+// long-running services don't have to be read whole
+type ServiceLogsTimeRange struct {
+	// Log lines logged before are left out; the zero time means no lower bound
+	since time.Time
+
+	// Log lines logged after are left out; the zero time means no upper bound
+	until time.Time
+}
+
+func NewServiceLogsTimeRange(since time.Time, until time.Time) *ServiceLogsTimeRange {
+	return &ServiceLogsTimeRange{
+		since: since,
+		until: until,
+	}
+}
+
+// NewUnboundedServiceLogsTimeRange returns a time range matching all log lines
+func NewUnboundedServiceLogsTimeRange() *ServiceLogsTimeRange {
+	return NewServiceLogsTimeRange(time.Time{}, time.Time{})
+}
+
+func (timeRange *ServiceLogsTimeRange) GetSince() time.Time {
+	return timeRange.since
+}
+
+func (timeRange *ServiceLogsTimeRange) GetUntil() time.Time {
+	return timeRange.until
+}
@@ -0,0 +1,64 @@
+package service
+
+import "time"
+
+// ServiceMetrics is a snapshot of the resources consumed by the container of a service
+type ServiceMetrics struct {
+	// When the container engine took the snapshot
+	timestamp time.Time
+
+	// Share of the host CPUs used by the service, where 100% is one full CPU (so it can exceed 100% on multicore hosts)
+	cpuUsagePercent float64
+
+	// Memory used by the service, leaving out the page cache that the kernel can reclaim
+	memoryUsageBytes uint64
+
+	// Memory the service can use before getting OOM-killed; services without a memory limit get the host memory
+	memoryLimitBytes uint64
+
+	// Bytes received and sent by the service across all its network interfaces
+	networkRxBytes uint64
+	networkTxBytes uint64
+}
+
+func NewServiceMetrics(
+	timestamp time.Time,
+	cpuUsagePercent float64,
+	memoryUsageBytes uint64,
+	memoryLimitBytes uint64,
+	networkRxBytes uint64,
+	networkTxBytes uint64,
+) *ServiceMetrics {
+	return &ServiceMetrics{
+		timestamp:        timestamp,
+		cpuUsagePercent:  cpuUsagePercent,
+		memoryUsageBytes: memoryUsageBytes,
+		memoryLimitBytes: memoryLimitBytes,
+		networkRxBytes:   networkRxBytes,
+		networkTxBytes:   networkTxBytes,
+	}
+}
+
+func (metrics *ServiceMetrics) GetTimestamp() time.Time {
+	return metrics.timestamp
+}
+
+func (metrics *ServiceMetrics) GetCpuUsagePercent() float64 {
+	return metrics.cpuUsagePercent
+}
+
+func (metrics *ServiceMetrics) GetMemoryUsageBytes() uint64 {
+	return metrics.memoryUsageBytes
+}
+
+func (metrics *ServiceMetrics) GetMemoryLimitBytes() uint64 {
+	return metrics.memoryLimitBytes
+}
+
+func (metrics *ServiceMetrics) GetNetworkRxBytes() uint64 {
+	return metrics.networkRxBytes
+}
+
+func (metrics *ServiceMetrics) GetNetworkTxBytes() uint64 {
+	return metrics.networkTxBytes
+}
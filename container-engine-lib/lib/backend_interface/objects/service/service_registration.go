@@ -20,6 +20,10 @@ type ServiceRegistration struct {
 	// with the service
 	privateIp net.IP
 
+	// Nil unless the enclave network has IPv6 enabled, in which case services also get an IPv6 address within the
+	// enclave on top of their private IP
+	privateIpv6 net.IP
+
 	// The hostname differs whether Kurtosis is using a Kubernetes backend of a Docker backend.
 	// In Docker, we set the serviceName as the hostname, whereas in Kubernetes the hostname is automatically assigned
 	// to the "Kubernetes Service Name", which is something like user-services-<SERVICE_UUID>
@@ -28,12 +32,18 @@ type ServiceRegistration struct {
 }
 
 func NewServiceRegistration(name ServiceName, guid ServiceUUID, enclaveId enclave.EnclaveUUID, privateIp net.IP, hostname string) *ServiceRegistration {
+	return NewDualStackServiceRegistration(name, guid, enclaveId, privateIp, nil, hostname)
+}
+
+// NewDualStackServiceRegistration is for services of enclaves with IPv6 enabled, which get an IPv6 address too
+func NewDualStackServiceRegistration(name ServiceName, guid ServiceUUID, enclaveId enclave.EnclaveUUID, privateIp net.IP, privateIpv6 net.IP, hostname string) *ServiceRegistration {
 	return &ServiceRegistration{
-		name:      name,
-		uuid:      guid,
-		enclaveId: enclaveId,
-		privateIp: privateIp,
-		hostname:  hostname,
+		name:        name,
+		uuid:        guid,
+		enclaveId:   enclaveId,
+		privateIp:   privateIp,
+		privateIpv6: privateIpv6,
+		hostname:    hostname,
 	}
 }
 
@@ -53,6 +63,11 @@ func (registration *ServiceRegistration) GetPrivateIP() net.IP {
 	return registration.privateIp
 }
 
+// GetPrivateIPv6 returns nil if the enclave network doesn't have IPv6 enabled
+func (registration *ServiceRegistration) GetPrivateIPv6() net.IP {
+	return registration.privateIpv6
+}
+
 func (registration *ServiceRegistration) GetHostname() string {
 	return registration.hostname
 }
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestValidateServiceName(t *testing.T) {
+	require.NoError(t, ValidateServiceName("my_service-1"))
+	require.NoError(t, ValidateServiceName(ServiceName(strings.Repeat("a", MaxServiceNameLength))))
+
+	err := ValidateServiceName("")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can't be empty")
+
+	err = ValidateServiceName(ServiceName(strings.Repeat("a", MaxServiceNameLength+1)))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is 64 characters long")
+
+	err = ValidateServiceName("my.service")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "disallowed character '.' at position 3")
+}
@@ -0,0 +1,25 @@
+package service
+
+// Ulimit is a resource limit of the processes of a service, e.g. the number of open files
+type Ulimit struct {
+	// The limit processes start with and can raise themselves up to the hard limit; -1 means unlimited
+	soft int64
+
+	// The ceiling of the soft limit; -1 means unlimited
+	hard int64
+}
+
+func NewUlimit(soft int64, hard int64) *Ulimit {
+	return &Ulimit{
+		soft: soft,
+		hard: hard,
+	}
+}
+
+func (ulimit *Ulimit) GetSoft() int64 {
+	return ulimit.soft
+}
+
+func (ulimit *Ulimit) GetHard() int64 {
+	return ulimit.hard
+}
@@ -0,0 +1,58 @@
+package system_container
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"time"
+)
+
+// SystemContainer represents point-in-time information about a container that Kurtosis runs for itself, e.g. the
+// engine, an API container or a networking sidecar, as opposed to the user services
+type SystemContainer struct {
+	// What the container is to Kurtosis, e.g. 'api-container' or 'networking-sidecar'
+	kind string
+
+	name      string
+	imageName string
+	status    container_status.ContainerStatus
+
+	creationTime time.Time
+
+	// The ports of the container published on the host machine, from the port inside the container, e.g. '7443/tcp',
+	// to the address it is published on, e.g. '127.0.0.1:53422'
+	publishedPorts map[string]string
+}
+
+func NewSystemContainer(kind string, name string, imageName string, status container_status.ContainerStatus, creationTime time.Time, publishedPorts map[string]string) *SystemContainer {
+	return &SystemContainer{
+		kind:           kind,
+		name:           name,
+		imageName:      imageName,
+		status:         status,
+		creationTime:   creationTime,
+		publishedPorts: publishedPorts,
+	}
+}
+
+func (container *SystemContainer) GetKind() string {
+	return container.kind
+}
+
+func (container *SystemContainer) GetName() string {
+	return container.name
+}
+
+func (container *SystemContainer) GetImageName() string {
+	return container.imageName
+}
+
+func (container *SystemContainer) GetStatus() container_status.ContainerStatus {
+	return container.status
+}
+
+func (container *SystemContainer) GetCreationTime() time.Time {
+	return container.creationTime
+}
+
+func (container *SystemContainer) GetPublishedPorts() map[string]string {
+	return container.publishedPorts
+}
@@ -11,7 +11,11 @@ import (
 )
 
 type FreeIpAddrTracker struct {
-	subnet    *net.IPNet
+	subnet *net.IPNet
+
+	// Nil if the enclave network doesn't have IPv6 enabled
+	ipv6Subnet *net.IPNet
+
 	enclaveDb *enclave_db.EnclaveDB
 }
 
@@ -20,24 +24,29 @@ var (
 )
 
 func (tracker *FreeIpAddrTracker) GetFreeIpAddr() (net.IP, error) {
-	var ipAddr net.IP
-	err := tracker.enclaveDb.Update(func(tx *bolt.Tx) error {
-		takenIps, err := getTakenIpAddrs(tx)
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred while getting taken IP addresses")
-		}
-		ipAddr, err = network_helpers.GetFreeIpAddrFromSubnet(takenIps, tracker.subnet)
-		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred while getting a free IP address from subnet")
-		}
-		return tx.Bucket(takenIpAddressBucketName).Put([]byte(ipAddr.String()), consts.EmptyValueForKeySet)
-	})
+	ipAddr, err := tracker.getFreeIpAddrFromSubnet(tracker.subnet)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while getting a free IP address")
 	}
 	return ipAddr, nil
 }
 
+func (tracker *FreeIpAddrTracker) IsIpv6Enabled() bool {
+	return tracker.ipv6Subnet != nil
+}
+
+// GetFreeIpv6Addr gets a free IPv6 address, which gets released through ReleaseIpAddr like the IPv4 ones
+func (tracker *FreeIpAddrTracker) GetFreeIpv6Addr() (net.IP, error) {
+	if !tracker.IsIpv6Enabled() {
+		return nil, stacktrace.NewError("Can't get a free IPv6 address because the enclave network doesn't have IPv6 enabled")
+	}
+	ipAddr, err := tracker.getFreeIpAddrFromSubnet(tracker.ipv6Subnet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting a free IPv6 address")
+	}
+	return ipAddr, nil
+}
+
 func (tracker *FreeIpAddrTracker) ReleaseIpAddr(ip net.IP) error {
 	err := tracker.enclaveDb.Update(func(tx *bolt.Tx) error {
 		return tx.Bucket(takenIpAddressBucketName).Delete([]byte(ip.String()))
@@ -48,7 +57,8 @@ func (tracker *FreeIpAddrTracker) ReleaseIpAddr(ip net.IP) error {
 	return nil
 }
 
-func GetOrCreateNewFreeIpAddrTracker(subnet *net.IPNet, alreadyTakenIps map[string]bool, db *enclave_db.EnclaveDB) (*FreeIpAddrTracker, error) {
+// The IPv6 subnet is nil if the enclave network doesn't have IPv6 enabled; the already taken IPs can be of both families
+func GetOrCreateNewFreeIpAddrTracker(subnet *net.IPNet, ipv6Subnet *net.IPNet, alreadyTakenIps map[string]bool, db *enclave_db.EnclaveDB) (*FreeIpAddrTracker, error) {
 	bucketExists := false
 	err := db.Update(func(tx *bolt.Tx) error {
 		bucket, err := tx.CreateBucket(takenIpAddressBucketName)
@@ -74,11 +84,32 @@ func GetOrCreateNewFreeIpAddrTracker(subnet *net.IPNet, alreadyTakenIps map[stri
 		logrus.Debugf("Taken IP addresses saved to database")
 	}
 	return &FreeIpAddrTracker{
-		subnet,
-		db,
+		subnet:     subnet,
+		ipv6Subnet: ipv6Subnet,
+		enclaveDb:  db,
 	}, nil
 }
 
+// Both families share the same bucket, as their IPs can't collide
+func (tracker *FreeIpAddrTracker) getFreeIpAddrFromSubnet(subnet *net.IPNet) (net.IP, error) {
+	var ipAddr net.IP
+	err := tracker.enclaveDb.Update(func(tx *bolt.Tx) error {
+		takenIps, err := getTakenIpAddrs(tx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting taken IP addresses")
+		}
+		ipAddr, err = network_helpers.GetFreeIpAddrFromSubnet(takenIps, subnet)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting a free IP address from subnet")
+		}
+		return tx.Bucket(takenIpAddressBucketName).Put([]byte(ipAddr.String()), consts.EmptyValueForKeySet)
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting a free IP address from subnet '%v'", subnet)
+	}
+	return ipAddr, nil
+}
+
 func getTakenIpAddrs(tx *bolt.Tx) (map[string]bool, error) {
 	takenIps := map[string]bool{}
 	bucket := tx.Bucket(takenIpAddressBucketName)
@@ -19,7 +19,7 @@ func TestGetIp(t *testing.T) {
 	subnetMask := "1.2.3.4/16"
 	_, parsedSubnetMask, err := net.ParseCIDR(subnetMask)
 	require.Nil(t, err)
-	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, map[string]bool{
+	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, nil, map[string]bool{
 		"1.2.0.2": true,
 		"1.2.0.3": true,
 	}, enclaveDb)
@@ -45,7 +45,7 @@ func TestReleaseIp(t *testing.T) {
 	subnetMask := "1.2.3.4/16"
 	_, parsedSubnetMask, err := net.ParseCIDR(subnetMask)
 	require.Nil(t, err)
-	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, map[string]bool{}, enclaveDb)
+	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, nil, map[string]bool{}, enclaveDb)
 	require.Nil(t, err)
 
 	ip, err := addrTracker.GetFreeIpAddr()
@@ -71,7 +71,7 @@ func TestIpTrackerDiskPersistence(t *testing.T) {
 	subnetMask := "1.2.3.4/16"
 	_, parsedSubnetMask, err := net.ParseCIDR(subnetMask)
 	require.Nil(t, err)
-	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, map[string]bool{
+	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, nil, map[string]bool{
 		"1.2.0.2": true,
 		"1.2.0.3": true,
 	}, enclaveDb)
@@ -81,10 +81,55 @@ func TestIpTrackerDiskPersistence(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "1.2.0.1", ip.String())
 
-	addrTracker2, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, map[string]bool{}, enclaveDb)
+	addrTracker2, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, nil, map[string]bool{}, enclaveDb)
 	require.Nil(t, err)
 
 	ip2, err := addrTracker2.GetFreeIpAddr()
 	require.Nil(t, err)
 	require.Equal(t, "1.2.0.4", ip2.String())
 }
+
+func TestGetIpv6(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	_, parsedSubnetMask, err := net.ParseCIDR("1.2.3.4/16")
+	require.Nil(t, err)
+	_, parsedIpv6SubnetMask, err := net.ParseCIDR("fd12:3456:789a::/64")
+	require.Nil(t, err)
+	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, parsedIpv6SubnetMask, map[string]bool{
+		"1.2.0.1":           true,
+		"fd12:3456:789a::1": true,
+	}, enclaveDb)
+	require.Nil(t, err)
+	require.True(t, addrTracker.IsIpv6Enabled())
+
+	ip, err := addrTracker.GetFreeIpAddr()
+	require.Nil(t, err)
+	require.Equal(t, "1.2.0.2", ip.String())
+
+	ipv6, err := addrTracker.GetFreeIpv6Addr()
+	require.Nil(t, err)
+	require.Equal(t, "fd12:3456:789a::2", ipv6.String())
+
+	err = addrTracker.ReleaseIpAddr(ipv6)
+	require.Nil(t, err)
+
+	ipv6Again, err := addrTracker.GetFreeIpv6Addr()
+	require.Nil(t, err)
+	require.Equal(t, "fd12:3456:789a::2", ipv6Again.String())
+}
+
+func TestGetIpv6FailsWithoutIpv6Subnet(t *testing.T) {
+	enclaveDb, cleaningFunction, err := test_helpers.CreateEnclaveDbForTesting()
+	require.Nil(t, err)
+	defer cleaningFunction()
+	_, parsedSubnetMask, err := net.ParseCIDR("1.2.3.4/16")
+	require.Nil(t, err)
+	addrTracker, err := GetOrCreateNewFreeIpAddrTracker(parsedSubnetMask, nil, map[string]bool{}, enclaveDb)
+	require.Nil(t, err)
+	require.False(t, addrTracker.IsIpv6Enabled())
+
+	_, err = addrTracker.GetFreeIpv6Addr()
+	require.NotNil(t, err)
+}
@@ -21,4 +21,6 @@ var EmptyPartitionConnection PartitionConnection
 type PartitionConnection struct {
 	PacketLoss              float32           `json:"packet_loss"`
 	PacketDelayDistribution DelayDistribution `json:"delay_distribution"`
+	// 0 means no limit, which is also what connections stored before bandwidth limits existed get
+	BandwidthLimitKbps uint32 `json:"bandwidth_limit_kbps"`
 }
@@ -0,0 +1,48 @@
+package leak_check
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+// VerifyNoLeakedEnclaveResources checks that destroying the given enclave left none of its resources behind, returning
+// an error that lists each leaked resource along with how it came to be otherwise. Meant to be run after an enclave got
+// destroyed, e.g. at the end of a backend test or by 'enclave rm --verify'
+func VerifyNoLeakedEnclaveResources(
+	ctx context.Context,
+	backend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+) error {
+	leakedResources, err := backend.GetLeakedEnclaveResources(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the leaked resources of enclave '%v'", enclaveUuid)
+	}
+	if len(leakedResources) == 0 {
+		return nil
+	}
+	return stacktrace.NewError(
+		"Found %v resource(s) of enclave '%v' left behind after it got destroyed:\n%v",
+		len(leakedResources),
+		enclaveUuid,
+		FormatLeakedEnclaveResources(leakedResources),
+	)
+}
+
+// FormatLeakedEnclaveResources renders the leaked resources one per line
+func FormatLeakedEnclaveResources(leakedResources []*enclave.LeakedEnclaveResource) string {
+	lines := []string{}
+	for _, leakedResource := range leakedResources {
+		lines = append(lines, fmt.Sprintf(
+			" - %v '%v' (ID '%v'): %v",
+			leakedResource.GetResourceType(),
+			leakedResource.GetName(),
+			leakedResource.GetId(),
+			leakedResource.GetCreationContext(),
+		))
+	}
+	return strings.Join(lines, "\n")
+}
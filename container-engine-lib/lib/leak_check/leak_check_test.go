@@ -0,0 +1,36 @@
+package leak_check
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	enclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+)
+
+func TestVerifyNoLeakedEnclaveResources_NoLeaks(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	backend.EXPECT().GetLeakedEnclaveResources(ctx, enclaveUuid).Return([]*enclave.LeakedEnclaveResource{}, nil)
+
+	require.NoError(t, VerifyNoLeakedEnclaveResources(ctx, backend, enclaveUuid))
+}
+
+func TestVerifyNoLeakedEnclaveResources_ReportsLeaksWithTheirCreationContext(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	backend.EXPECT().GetLeakedEnclaveResources(ctx, enclaveUuid).Return([]*enclave.LeakedEnclaveResource{
+		enclave.NewLeakedEnclaveResource(enclave.LeakedEnclaveResourceType_Container, "container-id", "api-container", "api-container container created at 2023-01-01T00:00:00Z"),
+		enclave.NewLeakedEnclaveResource(enclave.LeakedEnclaveResourceType_IpAddrRegistration, "10.1.0.3", "db", "IP address registered to service 'db'"),
+	}, nil)
+
+	err := VerifyNoLeakedEnclaveResources(ctx, backend, enclaveUuid)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Found 2 resource(s) of enclave 'enclave-uuid' left behind")
+	require.Contains(t, err.Error(), " - container 'api-container' (ID 'container-id'): api-container container created at 2023-01-01T00:00:00Z")
+	require.Contains(t, err.Error(), " - IP address registration 'db' (ID '10.1.0.3'): IP address registered to service 'db'")
+}
@@ -0,0 +1,92 @@
+package log_correlation
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Names of the fields attached to the log entries, so a single operation can be traced across the engine and
+	// the API containers by grepping for them
+	RequestIdLogField   = "request_id"
+	EnclaveUuidLogField = "enclave_uuid"
+	ServiceUuidLogField = "service_uuid"
+
+	// RequestIdGrpcHeaderKey is the gRPC response header the servers send the ID of each request back in, so that users
+	// can point maintainers to the log lines of the request that failed
+	RequestIdGrpcHeaderKey = "kurtosis-request-id"
+)
+
+type contextKey string
+
+var contextKeysByLogField = map[string]contextKey{
+	RequestIdLogField:   contextKey(RequestIdLogField),
+	EnclaveUuidLogField: contextKey(EnclaveUuidLogField),
+	ServiceUuidLogField: contextKey(ServiceUuidLogField),
+}
+
+// NewRequestId generates a short ID, unique enough to tell apart the requests found in a log file
+func NewRequestId() (string, error) {
+	uuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred generating a request ID")
+	}
+	return uuid_generator.ShortenedUUIDString(uuid), nil
+}
+
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, contextKeysByLogField[RequestIdLogField], requestId)
+}
+
+func WithEnclaveUuid(ctx context.Context, enclaveUuid string) context.Context {
+	return context.WithValue(ctx, contextKeysByLogField[EnclaveUuidLogField], enclaveUuid)
+}
+
+func WithServiceUuid(ctx context.Context, serviceUuid string) context.Context {
+	return context.WithValue(ctx, contextKeysByLogField[ServiceUuidLogField], serviceUuid)
+}
+
+// GetRequestId returns the ID of the request the context belongs to, or the empty string if it has none
+func GetRequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(contextKeysByLogField[RequestIdLogField]).(string)
+	return requestId
+}
+
+// CorrelationHook is a logrus hook that attaches the correlation IDs to every log entry: the static ones (e.g. the
+// UUID of the enclave an API container runs in) always, and the ones stored in the context of the entry when it was
+// logged through logrus.WithContext
+type CorrelationHook struct {
+	staticFields logrus.Fields
+}
+
+func NewCorrelationHook(staticFields logrus.Fields) *CorrelationHook {
+	return &CorrelationHook{
+		staticFields: staticFields,
+	}
+}
+
+func (hook *CorrelationHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire gets called on a copy of the entry, so it's safe to add fields to it
+func (hook *CorrelationHook) Fire(entry *logrus.Entry) error {
+	if entry.Context != nil {
+		for logField, key := range contextKeysByLogField {
+			if _, found := entry.Data[logField]; found {
+				continue
+			}
+			if value, ok := entry.Context.Value(key).(string); ok && value != "" {
+				entry.Data[logField] = value
+			}
+		}
+	}
+	for logField, value := range hook.staticFields {
+		if _, found := entry.Data[logField]; !found {
+			entry.Data[logField] = value
+		}
+	}
+	return nil
+}
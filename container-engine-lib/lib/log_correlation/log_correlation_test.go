@@ -0,0 +1,47 @@
+package log_correlation
+
+import (
+	"bytes"
+	"context"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCorrelationHook_AddsStaticAndContextFields(t *testing.T) {
+	logger, output := newTestLogger(logrus.Fields{EnclaveUuidLogField: "enclave-uuid"})
+
+	ctx := WithServiceUuid(WithRequestId(context.Background(), "request-id"), "service-uuid")
+	logger.WithContext(ctx).Info("Starting service")
+
+	require.Contains(t, output.String(), `"enclave_uuid":"enclave-uuid"`)
+	require.Contains(t, output.String(), `"request_id":"request-id"`)
+	require.Contains(t, output.String(), `"service_uuid":"service-uuid"`)
+}
+
+func TestCorrelationHook_DoesntOverrideExplicitFields(t *testing.T) {
+	logger, output := newTestLogger(logrus.Fields{EnclaveUuidLogField: "enclave-uuid"})
+
+	ctx := WithEnclaveUuid(context.Background(), "context-enclave-uuid")
+	logger.WithContext(ctx).WithField(EnclaveUuidLogField, "explicit-enclave-uuid").Info("Destroying enclave")
+
+	require.Contains(t, output.String(), `"enclave_uuid":"explicit-enclave-uuid"`)
+	require.NotContains(t, output.String(), "request_id")
+}
+
+func TestCorrelationHook_NoContext(t *testing.T) {
+	logger, output := newTestLogger(logrus.Fields{})
+
+	logger.Info("Running server...")
+
+	require.NotContains(t, output.String(), "enclave_uuid")
+}
+
+func newTestLogger(staticFields logrus.Fields) (*logrus.Logger, *bytes.Buffer) {
+	output := &bytes.Buffer{}
+	logger := logrus.New()
+	logger.SetOutput(output)
+	logger.SetFormatter(new(logrus.JSONFormatter))
+	logger.AddHook(NewCorrelationHook(staticFields))
+	return logger, output
+}
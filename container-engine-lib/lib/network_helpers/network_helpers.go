@@ -1,14 +1,13 @@
 package network_helpers
 
 import (
-	"encoding/binary"
 	"github.com/kurtosis-tech/stacktrace"
 	"net"
 )
 
 /*
 GetFreeIpAddrFromSubnet
-Gets a free IP address from the subnet that the IP tracker was initialized with.
+Gets a free IP address from the subnet that the IP tracker was initialized with. Works for both IPv4 and IPv6 subnets.
 
 Returns:
 
@@ -16,33 +15,16 @@ Returns:
 		actual IP returned is undefined.
 */
 func GetFreeIpAddrFromSubnet(takenIps map[string]bool, subnet *net.IPNet) (net.IP, error) {
-	// NOTE: This whole function will need to be rewritten if we support IPv6
-
-	// convert IPNet struct mask and address to uint32
-	// network is BigEndian
-	mask := binary.BigEndian.Uint32(subnet.Mask)
-
-	subnetIp := subnet.IP
-
-	// The IP can be either 4 bytes or 16 bytes long; we need to handle both!
+	// The IP can be either 4 bytes or 16 bytes long even for IPv4 subnets; we need to handle both!
 	// See https://gist.github.com/ammario/649d4c0da650162efd404af23e25b86b
-	var intIp uint32
-	if len(subnetIp) == 16 {
-		intIp = binary.BigEndian.Uint32(subnetIp[12:16])
-	} else {
-		intIp = binary.BigEndian.Uint32(subnetIp)
+	networkIp := subnet.IP.Mask(subnet.Mask)
+	if networkIp == nil {
+		return nil, stacktrace.NewError("Subnet '%v' has a mask that doesn't match its IP", subnet)
 	}
 
-	// We remove the zeroth IP because it's only used for specifying the network itself
-	start := intIp + 1
-
-	// find the final address
-	finish := (start & mask) | (mask ^ 0xffffffff)
-	// loop through addresses as uint32
-	for i := start; i <= finish; i++ {
-		// convert back to net.IP
-		ip := make(net.IP, 4)
-		binary.BigEndian.PutUint32(ip, i)
+	// We skip the zeroth IP because it's only used for specifying the network itself
+	// IPv6 subnets are way too wide to ever be full, and the first free IP is found as soon as the taken ones are skipped
+	for ip := getNextIp(networkIp); ip != nil && subnet.Contains(ip); ip = getNextIp(ip) {
 		ipStr := ip.String()
 		if !takenIps[ipStr] {
 			takenIps[ipStr] = true
@@ -51,3 +33,45 @@ func GetFreeIpAddrFromSubnet(takenIps map[string]bool, subnet *net.IPNet) (net.I
 	}
 	return nil, stacktrace.NewError("Failed to allocate IpAddr on subnet %v - all taken.", subnet)
 }
+
+// getNextIp returns nil once the whole address space got used up
+func getNextIp(ip net.IP) net.IP {
+	nextIp := make(net.IP, len(ip))
+	copy(nextIp, ip)
+	for byteIdx := len(nextIp) - 1; byteIdx >= 0; byteIdx-- {
+		nextIp[byteIdx]++
+		if nextIp[byteIdx] != 0 {
+			return nextIp
+		}
+	}
+	return nil
+}
+
+// SplitSubnetInHalves returns the lower and upper halves of the subnet, so that one of them can be left to Docker to
+// assign addresses from while the other one gets handed out by Kurtosis
+func SplitSubnetInHalves(subnet *net.IPNet) (*net.IPNet, *net.IPNet, error) {
+	maskOnes, maskBits := subnet.Mask.Size()
+	if maskOnes >= maskBits {
+		return nil, nil, stacktrace.NewError("Subnet '%v' is a single address, so it can't be split in halves", subnet)
+	}
+	halfMask := net.CIDRMask(maskOnes+1, maskBits)
+
+	lowerHalfIp := subnet.IP.Mask(subnet.Mask)
+	if lowerHalfIp == nil {
+		return nil, nil, stacktrace.NewError("Subnet '%v' has a mask that doesn't match its IP", subnet)
+	}
+	upperHalfIp := make(net.IP, len(lowerHalfIp))
+	copy(upperHalfIp, lowerHalfIp)
+	// The first bit outside the original mask is the one telling the halves apart
+	upperHalfIp[maskOnes/8] |= 0x80 >> (maskOnes % 8)
+
+	lowerHalf := &net.IPNet{
+		IP:   lowerHalfIp,
+		Mask: halfMask,
+	}
+	upperHalf := &net.IPNet{
+		IP:   upperHalfIp,
+		Mask: halfMask,
+	}
+	return lowerHalf, upperHalf, nil
+}
@@ -0,0 +1,44 @@
+package network_helpers
+
+import (
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+func TestSplitSubnetInHalves(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("fd12:3456:789a:1::/64")
+	require.NoError(t, err)
+
+	lowerHalf, upperHalf, err := SplitSubnetInHalves(subnet)
+	require.NoError(t, err)
+	require.Equal(t, "fd12:3456:789a:1::/65", lowerHalf.String())
+	require.Equal(t, "fd12:3456:789a:1:8000::/65", upperHalf.String())
+}
+
+func TestSplitSubnetInHalves_Ipv4(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("172.16.0.0/22")
+	require.NoError(t, err)
+
+	lowerHalf, upperHalf, err := SplitSubnetInHalves(subnet)
+	require.NoError(t, err)
+	require.Equal(t, "172.16.0.0/23", lowerHalf.String())
+	require.Equal(t, "172.16.2.0/23", upperHalf.String())
+}
+
+func TestSplitSubnetInHalves_SingleAddressFails(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("172.16.0.1/32")
+	require.NoError(t, err)
+
+	_, _, err = SplitSubnetInHalves(subnet)
+	require.Error(t, err)
+}
+
+func TestGetFreeIpAddrFromSubnet_SkipsTakenIps(t *testing.T) {
+	_, subnet, err := net.ParseCIDR("fd12:3456:789a:1:8000::/65")
+	require.NoError(t, err)
+
+	ip, err := GetFreeIpAddrFromSubnet(map[string]bool{"fd12:3456:789a:1:8000::1": true}, subnet)
+	require.NoError(t, err)
+	require.Equal(t, "fd12:3456:789a:1:8000::2", ip.String())
+}
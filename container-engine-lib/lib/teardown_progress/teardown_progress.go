@@ -0,0 +1,90 @@
+package teardown_progress
+
+import (
+	"context"
+	"sync"
+)
+
+type ResourceType string
+
+const (
+	ResourceType_Container ResourceType = "container"
+	ResourceType_Volume    ResourceType = "volume"
+	ResourceType_Network   ResourceType = "network"
+)
+
+type contextKey string
+
+const reporterContextKey = contextKey("teardown_progress_reporter")
+
+// Reporter receives the progress of stopping or destroying enclaves, one resource at a time, so that callers can show
+// that a teardown taking minutes isn't hung. Backends call it from several goroutines at once
+type Reporter interface {
+	// ReportProgress gets called once before the resources of a type start getting handled, and then after each of
+	// them got handled, whether that succeeded or not
+	ReportProgress(resourceType ResourceType, numHandled uint32, numTotal uint32)
+
+	// ReportLeakedResource gets called for each resource that couldn't be removed (or stopped), along with why
+	ReportLeakedResource(resourceType ResourceType, resourceName string, cause error)
+}
+
+// WithReporter makes the backend calls done with the returned context report their teardown progress to the reporter;
+// carrying it in the context spares every backend method from taking it as an argument
+func WithReporter(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, reporterContextKey, reporter)
+}
+
+// Tracker counts the resources of a type as they get handled, reporting to the reporter of the context it was created
+// with, if any
+type Tracker struct {
+	mutex *sync.Mutex
+
+	reporter Reporter
+
+	resourceType ResourceType
+
+	numHandled uint32
+
+	numTotal uint32
+}
+
+func NewTracker(ctx context.Context, resourceType ResourceType, numTotal int) *Tracker {
+	reporter, _ := ctx.Value(reporterContextKey).(Reporter)
+	tracker := &Tracker{
+		mutex:        &sync.Mutex{},
+		reporter:     reporter,
+		resourceType: resourceType,
+		numHandled:   0,
+		numTotal:     uint32(numTotal),
+	}
+	if reporter != nil && numTotal > 0 {
+		reporter.ReportProgress(resourceType, 0, tracker.numTotal)
+	}
+	return tracker
+}
+
+// MarkHandled records that a resource got handled, with the error that prevented it from being removed if any
+func (tracker *Tracker) MarkHandled(resourceName string, err error) {
+	if tracker.reporter == nil {
+		return
+	}
+	// The lock is held while reporting so that the reporter sees the number of handled resources increase
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.numHandled++
+	if err != nil {
+		tracker.reporter.ReportLeakedResource(tracker.resourceType, resourceName, err)
+	}
+	tracker.reporter.ReportProgress(tracker.resourceType, tracker.numHandled, tracker.numTotal)
+}
+
+// MarkSkipped records that a resource was left alone because of an earlier failure, e.g. the volumes of an enclave
+// whose containers couldn't be removed; it doesn't count towards the progress
+func (tracker *Tracker) MarkSkipped(resourceName string, cause error) {
+	if tracker.reporter == nil {
+		return
+	}
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.reporter.ReportLeakedResource(tracker.resourceType, resourceName, cause)
+}
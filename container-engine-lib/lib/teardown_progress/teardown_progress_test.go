@@ -0,0 +1,65 @@
+package teardown_progress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+type recordingReporter struct {
+	progress []string
+
+	leakedResources []string
+}
+
+func (reporter *recordingReporter) ReportProgress(resourceType ResourceType, numHandled uint32, numTotal uint32) {
+	reporter.progress = append(reporter.progress, fmt.Sprintf("%v %v/%v", resourceType, numHandled, numTotal))
+}
+
+func (reporter *recordingReporter) ReportLeakedResource(resourceType ResourceType, resourceName string, cause error) {
+	reporter.leakedResources = append(reporter.leakedResources, fmt.Sprintf("%v %v: %v", resourceType, resourceName, cause))
+}
+
+func TestTracker_ReportsProgressAndLeaks(t *testing.T) {
+	reporter := &recordingReporter{progress: nil, leakedResources: nil}
+	ctx := WithReporter(context.Background(), reporter)
+
+	tracker := NewTracker(ctx, ResourceType_Container, 2)
+	tracker.MarkHandled("container-1", nil)
+	tracker.MarkHandled("container-2", errors.New("device busy"))
+	NewTracker(ctx, ResourceType_Volume, 0).MarkSkipped("volume-1", errors.New("containers still exist"))
+
+	require.Equal(t, []string{"container 0/2", "container 1/2", "container 2/2"}, reporter.progress)
+	require.Equal(t, []string{"container container-2: device busy", "volume volume-1: containers still exist"}, reporter.leakedResources)
+}
+
+func TestTracker_ProgressIncreasesWhenHandledConcurrently(t *testing.T) {
+	reporter := &recordingReporter{progress: nil, leakedResources: nil}
+	ctx := WithReporter(context.Background(), reporter)
+
+	numContainers := 50
+	tracker := NewTracker(ctx, ResourceType_Container, numContainers)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < numContainers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tracker.MarkHandled(fmt.Sprintf("container-%v", i), nil)
+		}(i)
+	}
+	wg.Wait()
+
+	require.Len(t, reporter.progress, numContainers+1)
+	for i, progress := range reporter.progress {
+		require.Equal(t, fmt.Sprintf("container %v/%v", i, numContainers), progress)
+	}
+}
+
+func TestTracker_DoesNothingWithoutReporter(t *testing.T) {
+	tracker := NewTracker(context.Background(), ResourceType_Network, 1)
+	tracker.MarkHandled("network-1", errors.New("network in use"))
+	tracker.MarkSkipped("network-2", errors.New("volumes still exist"))
+}
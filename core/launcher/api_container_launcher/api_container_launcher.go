@@ -41,6 +41,9 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
+	packageCacheMaxSizeBytes uint64,
+	filesArtifactsTtlSeconds uint32,
+	allowHostDirMounts bool,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -56,6 +59,9 @@ func (launcher ApiContainerLauncher) LaunchWithDefaultVersion(
 		metricsUserID,
 		didUserAcceptSendingMetrics,
 		backendConfigSupplier,
+		packageCacheMaxSizeBytes,
+		filesArtifactsTtlSeconds,
+		allowHostDirMounts,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -74,6 +80,9 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
+	packageCacheMaxSizeBytes uint64,
+	filesArtifactsTtlSeconds uint32,
+	allowHostDirMounts bool,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -91,6 +100,9 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		enclaveDataVolumeDirpath,
 		kurtosisBackendType,
 		kurtosisBackendConfig,
+		packageCacheMaxSizeBytes,
+		filesArtifactsTtlSeconds,
+		allowHostDirMounts,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the API container args")
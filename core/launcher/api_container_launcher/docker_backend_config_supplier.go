@@ -11,13 +11,21 @@ import (
 )
 
 type DockerBackendConfigSupplier struct {
+	shouldFallBackToEphemeralPortOnHostPortConflict bool
+	networkingSidecarImage                          string
 }
 
-func NewDockerKurtosisBackendConfigSupplier() DockerBackendConfigSupplier {
-	return DockerBackendConfigSupplier{}
+func NewDockerKurtosisBackendConfigSupplier(shouldFallBackToEphemeralPortOnHostPortConflict bool, networkingSidecarImage string) DockerBackendConfigSupplier {
+	return DockerBackendConfigSupplier{
+		shouldFallBackToEphemeralPortOnHostPortConflict: shouldFallBackToEphemeralPortOnHostPortConflict,
+		networkingSidecarImage:                          networkingSidecarImage,
+	}
 }
 
 func (backendConfigSupplier DockerBackendConfigSupplier) getKurtosisBackendConfig() (args.KurtosisBackendType, interface{}) {
-	dockerBackendConfig := kurtosis_backend_config.DockerBackendConfig{}
+	dockerBackendConfig := kurtosis_backend_config.DockerBackendConfig{
+		ShouldFallBackToEphemeralPortOnHostPortConflict: backendConfigSupplier.shouldFallBackToEphemeralPortOnHostPortConflict,
+		NetworkingSidecarImage:                          backendConfigSupplier.networkingSidecarImage,
+	}
 	return args.KurtosisBackendType_Docker, dockerBackendConfig
 }
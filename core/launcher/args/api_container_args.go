@@ -43,6 +43,17 @@ type APIContainerArgs struct {
 
 	// Should be deserialized differently depending on value of KurtosisBackendType
 	KurtosisBackendConfig interface{} `json:"kurtosisBackendConfig"`
+
+	// Size limit of the directory the packages get cloned into, past which the least recently used ones get evicted
+	// 0 means the default limit, which is also what API containers started by engines unaware of this field get
+	PackageCacheMaxSizeBytes uint64 `json:"packageCacheMaxSizeBytes,omitempty"`
+
+	// How long the files artifacts that no service has mounted are kept after they were last used, before getting deleted
+	// 0 means they're kept until they get deleted or the enclave gets destroyed
+	FilesArtifactsTtlSeconds uint32 `json:"filesArtifactsTtlSeconds,omitempty"`
+
+	// Whether the services of the enclave can bind-mount directories of the host
+	AllowHostDirMounts bool `json:"allowHostDirMounts,omitempty"`
 }
 
 func (args *APIContainerArgs) UnmarshalJSON(data []byte) error {
@@ -91,6 +102,9 @@ func NewAPIContainerArgs(
 	enclaveDataVolumeDirpath string,
 	kurtosisBackendType KurtosisBackendType,
 	kurtosisBackendConfig interface{},
+	packageCacheMaxSizeBytes uint64,
+	filesArtifactsTtlSeconds uint32,
+	allowHostDirMounts bool,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
 		Version:                     version,
@@ -104,6 +118,9 @@ func NewAPIContainerArgs(
 		EnclaveDataVolumeDirpath:    enclaveDataVolumeDirpath,
 		KurtosisBackendType:         kurtosisBackendType,
 		KurtosisBackendConfig:       kurtosisBackendConfig,
+		PackageCacheMaxSizeBytes:    packageCacheMaxSizeBytes,
+		FilesArtifactsTtlSeconds:    filesArtifactsTtlSeconds,
+		AllowHostDirMounts:          allowHostDirMounts,
 	}
 
 	if err := result.validate(); err != nil {
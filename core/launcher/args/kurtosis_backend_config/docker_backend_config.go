@@ -5,4 +5,12 @@
 
 package kurtosis_backend_config
 
-type DockerBackendConfig struct {}
+type DockerBackendConfig struct {
+	// If true, user services requesting a host port that's already in use get published on an ephemeral host port
+	// instead of failing to start
+	ShouldFallBackToEphemeralPortOnHostPortConflict bool `json:"shouldFallBackToEphemeralPortOnHostPortConflict"`
+
+	// The image to create the networking sidecars from (e.g. a mirror of the default one in air-gapped installs); if
+	// empty, the default one gets used
+	NetworkingSidecarImage string `json:"networkingSidecarImage,omitempty"`
+}
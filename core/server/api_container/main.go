@@ -12,19 +12,28 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/log_correlation"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/crash_diagnostics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/error_redaction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/request_correlation"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/run_profiles"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secrets"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"net"
 	"os"
 	"path"
@@ -39,8 +48,17 @@ const (
 
 	grpcServerStopGracePeriod = 5 * time.Second
 
+	// Often enough that the last snapshot is still relevant when the API container gets OOM-killed
+	crashDiagnosticsStateSnapshotInterval = 10 * time.Second
+	servicesCrashDiagnosticsStateName     = "services"
+
+	// How often the files artifacts unused for longer than the TTL of the enclave get looked for, if it has one
+	filesArtifactsSweepInterval = time.Minute
+
 	forceColors   = true
 	fullTimestamp = true
+	// Millisecond precision, so the lines of the engine and the API containers can be interleaved when tracing an operation
+	logTimestampFormat = "2006-01-02T15:04:05.000Z07:00"
 
 	logMethodAlongWithLogLine = true
 	functionPathSeparator     = "."
@@ -59,7 +77,7 @@ func main() {
 		EnvironmentOverrideColors: false,
 		DisableTimestamp:          false,
 		FullTimestamp:             fullTimestamp,
-		TimestampFormat:           "",
+		TimestampFormat:           logTimestampFormat,
 		DisableSorting:            false,
 		SortingFunc:               nil,
 		DisableLevelTruncation:    false,
@@ -86,6 +104,8 @@ func main() {
 
 func runMain() error {
 	ctx := context.Background()
+	crashDiagnosticsRecorder := crash_diagnostics.GetCrashDiagnosticsRecorder()
+	defer crashDiagnosticsRecorder.RecoverAndRecordPanic()
 
 	serverArgs, ownIpAddress, err := args.GetArgsFromEnv()
 	if err != nil {
@@ -97,20 +117,33 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred parsing the log level string '%v':", serverArgs.LogLevel)
 	}
 	logrus.SetLevel(logLevel)
+	// Every line this API container logs belongs to its enclave, so maintainers can tell which enclave a line is from
+	logrus.AddHook(log_correlation.NewCorrelationHook(logrus.Fields{log_correlation.EnclaveUuidLogField: serverArgs.EnclaveUUID}))
 
 	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(serverArgs.EnclaveDataVolumeDirpath)
 
+	crashDiagnosticsDirpath, err := enclaveDataDir.GetCrashDiagnosticsDirpath()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the crash diagnostics directory")
+	}
+	crashDiagnosticsRecorder.SetDirpath(crashDiagnosticsDirpath)
+
 	filesArtifactStore, err := enclaveDataDir.GetFilesArtifactStore()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
 	}
+	if serverArgs.FilesArtifactsTtlSeconds > 0 {
+		filesArtifactsTtl := time.Duration(serverArgs.FilesArtifactsTtlSeconds) * time.Second
+		stopFilesArtifactsSweeps := filesArtifactStore.StartExpiredFilesSweeps(filesArtifactsTtl, filesArtifactsSweepInterval)
+		defer stopFilesArtifactsSweeps()
+	}
 
 	clusterConfig := serverArgs.KurtosisBackendConfig
 	if clusterConfig == nil {
 		return stacktrace.NewError("Kurtosis backend type is '%v' but cluster configuration parameters are null.", args.KurtosisBackendType_Kubernetes.String())
 	}
 
-	gitPackageContentProvider, err := enclaveDataDir.GetGitPackageContentProvider()
+	gitPackageContentProvider, err := enclaveDataDir.GetGitPackageContentProvider(serverArgs.PackageCacheMaxSizeBytes)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while creating the Git module content provider")
 	}
@@ -124,10 +157,19 @@ func runMain() error {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	switch serverArgs.KurtosisBackendType {
 	case args.KurtosisBackendType_Docker:
+		dockerConfig, ok := (clusterConfig).(kurtosis_backend_config.DockerBackendConfig)
+		if !ok {
+			return stacktrace.NewError(
+				"Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'",
+				args.KurtosisBackendType_Docker.String(),
+			)
+		}
 		apiContainerModeArgs := &backend_creator.APIContainerModeArgs{
 			Context:        ctx,
 			EnclaveID:      enclave.EnclaveUUID(serverArgs.EnclaveUUID),
 			APIContainerIP: ownIpAddress,
+			ShouldFallBackToEphemeralPortOnHostPortConflict: dockerConfig.ShouldFallBackToEphemeralPortOnHostPortConflict,
+			NetworkingSidecarImage:                          dockerConfig.NetworkingSidecarImage,
 		}
 		kurtosisBackend, err = backend_creator.GetLocalDockerKurtosisBackend(apiContainerModeArgs)
 		if err != nil {
@@ -169,12 +211,24 @@ func runMain() error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the service network")
 	}
+	crashDiagnosticsRecorder.AddStateSupplier(servicesCrashDiagnosticsStateName, func() (interface{}, error) {
+		return getServiceRegistrationsState(serviceNetwork), nil
+	})
+	stopCrashDiagnosticsStateSnapshots := crashDiagnosticsRecorder.StartStateSnapshots(crashDiagnosticsStateSnapshotInterval)
+	defer stopCrashDiagnosticsStateSnapshots()
+
+	enclaveInfo, err := getEnclaveInfo(ctx, kurtosisBackend, serverArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the info of the enclave to expose to packages")
+	}
+
+	runProfileStore := run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles)
 
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
 	startosisRunner := startosis_engine.NewStartosisRunner(
-		startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtime_value_store.NewRuntimeValueStore()),
+		startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtime_value_store.NewRuntimeValueStore(), enclaveInfo),
 		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
-		startosis_engine.NewStartosisExecutor())
+		startosis_engine.NewStartosisExecutor(runProfileStore))
 
 	//Creation of ApiContainerService
 	apiContainerService, err := server.NewApiContainerService(
@@ -182,19 +236,27 @@ func runMain() error {
 		serviceNetwork,
 		startosisRunner,
 		gitPackageContentProvider,
+		kurtosisBackend,
+		runProfileStore,
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the API container service")
 	}
 
 	apiContainerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_core_rpc_api_bindings.RegisterApiContainerServiceServer(grpcServer, apiContainerService)
+		// Equivalent to RegisterApiContainerServiceServer, but keeping secrets out of the errors returned to clients and
+		// giving every request an ID that gets attached to its log lines, and recording the crash diagnostics of panics
+		panicRecordingServiceDesc := crash_diagnostics.WrapServiceDesc(kurtosis_core_rpc_api_bindings.ApiContainerService_ServiceDesc, crashDiagnosticsRecorder)
+		requestCorrelatingServiceDesc := request_correlation.WrapServiceDesc(*panicRecordingServiceDesc)
+		redactingServiceDesc := error_redaction.WrapServiceDesc(*requestCorrelatingServiceDesc, secrets.GetSecretsRegistry())
+		grpcServer.RegisterService(redactingServiceDesc, apiContainerService)
 	}
 	apiContainerServer := minimal_grpc_server.NewMinimalGRPCServer(
 		serverArgs.GrpcListenPortNum,
 		grpcServerStopGracePeriod,
 		[]func(*grpc.Server){
 			apiContainerServiceRegistrationFunc,
+			grpcReflectionRegistrationFunc,
 		},
 	)
 
@@ -228,6 +290,7 @@ func createServiceNetwork(
 		args.GrpcListenPortNum,
 		args.Version,
 		isPartitioningEnabled,
+		args.AllowHostDirMounts,
 		kurtosisBackend,
 		enclaveDataDir,
 		networkingSidecarManager,
@@ -240,6 +303,46 @@ func createServiceNetwork(
 	return serviceNetwork, nil
 }
 
+// getEnclaveInfo gathers what packages get to know about the enclave through the `kurtosis.enclave` Starlark struct
+func getEnclaveInfo(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	args *args.APIContainerArgs,
+) (*builtins.EnclaveInfo, error) {
+	enclaveUuid := enclave.EnclaveUUID(args.EnclaveUUID)
+	enclaves, err := kurtosisBackend.GetEnclaves(ctx, &enclave.EnclaveFilters{
+		UUIDs: map[enclave.EnclaveUUID]bool{
+			enclaveUuid: true,
+		},
+		Statuses: nil,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclave '%v' from the backend", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' of this API container wasn't found in the backend", enclaveUuid)
+	}
+	return builtins.NewEnclaveInfo(enclaveObj.GetName(), string(enclaveUuid), args.KurtosisBackendType.String(), args.Version), nil
+}
+
+// getServiceRegistrationsState describes the services registered in the enclave, for the crash diagnostics
+func getServiceRegistrationsState(serviceNetwork service_network.ServiceNetwork) map[service.ServiceName]map[string]string {
+	serviceRegistrations := map[service.ServiceName]map[string]string{}
+	for serviceName := range serviceNetwork.GetServiceNames() {
+		serviceRegistration, found := serviceNetwork.GetServiceRegistration(serviceName)
+		if !found {
+			continue
+		}
+		serviceRegistrations[serviceName] = map[string]string{
+			"uuid":       string(serviceRegistration.GetUUID()),
+			"private_ip": serviceRegistration.GetPrivateIP().String(),
+			"hostname":   serviceRegistration.GetHostname(),
+		}
+	}
+	return serviceRegistrations
+}
+
 func formatFilenameFunctionForLogs(filename string, functionName string) string {
 	var output strings.Builder
 	output.WriteString("[")
@@ -249,3 +352,9 @@ func formatFilenameFunctionForLogs(filename string, functionName string) string
 	output.WriteString("]")
 	return output.String()
 }
+
+// grpcReflectionRegistrationFunc enables gRPC server reflection, so that tools like grpcurl can list and call the
+// endpoints of the server without having its protobuf definitions
+func grpcReflectionRegistrationFunc(grpcServer *grpc.Server) {
+	reflection.Register(grpcServer)
+}
@@ -6,22 +6,29 @@
 package server
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/dependency_recovery"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/run_profiles"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/shutdown_hooks"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_deprecations"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
@@ -29,10 +36,13 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 )
@@ -49,11 +59,19 @@ const (
 
 	defaultStartosisDryRun = false
 
+	defaultStartosisStrict = false
+
 	// Overwrite existing module with new module, this allows user to iterate on an enclave with a
 	// given module
 	doOverwriteExistingModule = true
 
 	defaultParallelism = 4
+
+	// Kept below the default 4MB gRPC message limit, with room to spare for the rest of the message
+	filesArtifactStreamChunkSizeBytes = 3 * 1024 * 1024
+
+	// Some tarballs have an entry for the root directory itself, which isn't a file of the files artifact
+	filesArtifactRootDir = "."
 )
 
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
@@ -71,6 +89,12 @@ type ApiContainerService struct {
 	startosisRunner *startosis_engine.StartosisRunner
 
 	startosisModuleContentProvider startosis_packages.PackageContentProvider
+
+	logLevelSetter *runtimeLogLevelSetter
+
+	kurtosisBackend backend_interface.KurtosisBackend
+
+	runProfileStore *run_profiles.RunProfileStore
 }
 
 func NewApiContainerService(
@@ -78,12 +102,17 @@ func NewApiContainerService(
 	serviceNetwork service_network.ServiceNetwork,
 	startosisRunner *startosis_engine.StartosisRunner,
 	startosisModuleContentProvider startosis_packages.PackageContentProvider,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	runProfileStore *run_profiles.RunProfileStore,
 ) (*ApiContainerService, error) {
 	service := &ApiContainerService{
 		filesArtifactStore:             filesArtifactStore,
 		serviceNetwork:                 serviceNetwork,
 		startosisRunner:                startosisRunner,
 		startosisModuleContentProvider: startosisModuleContentProvider,
+		logLevelSetter:                 newRuntimeLogLevelSetter(),
+		kurtosisBackend:                kurtosisBackend,
+		runProfileStore:                runProfileStore,
 	}
 
 	return service, nil
@@ -94,8 +123,9 @@ func (apicService ApiContainerService) RunStarlarkScript(args *kurtosis_core_rpc
 	serializedParams := args.GetSerializedParams()
 	parallelism := int(args.GetParallelism())
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
+	isStrict := shared_utils.GetOrDefaultBool(args.Strict, defaultStartosisStrict)
 
-	apicService.runStarlark(parallelism, dryRun, startosis_constants.PackageIdPlaceholderForStandaloneScript, serializedStarlarkScript, serializedParams, stream)
+	apicService.runStarlark(parallelism, dryRun, isStrict, startosis_constants.PackageIdPlaceholderForStandaloneScript, serializedStarlarkScript, serializedParams, stream)
 	return nil
 }
 
@@ -106,6 +136,7 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 	parallelism := int(args.GetParallelism())
 	serializedParams := args.SerializedParams
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
+	isStrict := shared_utils.GetOrDefaultBool(args.Strict, defaultStartosisStrict)
 
 	scriptWithRunFunction, interpretationError := apicService.runStarlarkPackageSetup(packageId, isRemote, moduleContentIfLocal)
 	if interpretationError != nil {
@@ -114,7 +145,7 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 		}
 		return nil
 	}
-	apicService.runStarlark(parallelism, dryRun, packageId, scriptWithRunFunction, serializedParams, stream)
+	apicService.runStarlark(parallelism, dryRun, isStrict, packageId, scriptWithRunFunction, serializedParams, stream)
 	return nil
 }
 
@@ -163,7 +194,8 @@ func (apicService ApiContainerService) StartServices(ctx context.Context, args *
 			publicIpAddrStr = maybePublicIpAddr.String()
 		}
 
-		serviceNamesToServiceInfo[string(serviceName)] = binding_constructors.NewServiceInfo(serviceUuidStr, string(serviceName), uuid_generator.ShortenedUUIDString(serviceUuidStr), privateServiceIpStr, privateApiPorts, publicIpAddrStr, publicApiPorts)
+		creationTime, runningTime, readyTime := apicService.getServiceStartupTimestamps(startedService)
+		serviceNamesToServiceInfo[string(serviceName)] = binding_constructors.NewServiceInfo(serviceUuidStr, string(serviceName), uuid_generator.ShortenedUUIDString(serviceUuidStr), privateServiceIpStr, getMaybePrivateIpv6AddrStr(startedService), privateApiPorts, publicIpAddrStr, publicApiPorts, getReplicaPrivateIpAddrStrs(startedService), creationTime, runningTime, readyTime)
 		serviceStartLoglineSuffix := ""
 		if len(publicServicePortSpecs) > 0 {
 			serviceStartLoglineSuffix = fmt.Sprintf(
@@ -193,6 +225,29 @@ func (apicService ApiContainerService) RemoveService(ctx context.Context, args *
 	return binding_constructors.NewRemoveServiceResponse(string(serviceUuid)), nil
 }
 
+func (apicService ApiContainerService) RemoveServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RemoveServicesArgs) (*kurtosis_core_rpc_api_bindings.RemoveServicesResponse, error) {
+	serviceNamesToRemove, unmatchedServiceErrors, err := apicService.getServiceNamesToRemove(args.GetServiceIdentifiers(), args.GetServiceNamePatterns(), args.GetAllServices())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services to remove")
+	}
+	removedServiceUuids, failedServices, err := apicService.serviceNetwork.RemoveServices(ctx, serviceNamesToRemove)
+	if err != nil {
+		// TODO IP: Leaks internal information about the API container
+		return nil, stacktrace.Propagate(err, "An error occurred removing services '%v'", serviceNamesToRemove)
+	}
+
+	removedServiceNamesToUuids := map[string]string{}
+	for serviceName, serviceUuid := range removedServiceUuids {
+		dependency_recovery.GetDependencyRecoveryRegistry().UnregisterService(serviceName)
+		removedServiceNamesToUuids[string(serviceName)] = string(serviceUuid)
+	}
+	failedServiceNamesToErrorStr := unmatchedServiceErrors
+	for serviceName, serviceErr := range failedServices {
+		failedServiceNamesToErrorStr[string(serviceName)] = serviceErr.Error()
+	}
+	return binding_constructors.NewRemoveServicesResponse(removedServiceNamesToUuids, failedServiceNamesToErrorStr), nil
+}
+
 func (apicService ApiContainerService) Repartition(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RepartitionArgs) (*emptypb.Empty, error) {
 	// No need to check for dupes here - that happens at the lowest-level call to ServiceNetwork.Repartition (as it should)
 	partitionServices := map[service_network_types.PartitionID]map[kurtosis_backend_service.ServiceName]bool{}
@@ -219,15 +274,11 @@ func (apicService ApiContainerService) Repartition(ctx context.Context, args *ku
 					partitionBId)
 			}
 
-			//TODO: We will be removing Repartition method completely from sdks (golang and typescript) so not needed in PartitionInfo
-			partitionConnection := partition_topology.NewPartitionConnection(partition_topology.NewPacketLoss(connectionInfo.PacketLossPercentage), partition_topology.ConnectionWithNoPacketDelay)
-			partitionConnections[partitionConnectionId] = partitionConnection
+			partitionConnections[partitionConnectionId] = newPartitionConnectionFromApi(connectionInfo)
 		}
 	}
 
-	defaultConnectionInfo := args.DefaultConnection
-	//TODO: We will be removing Repartition method completely from sdks (golang and typescript) so not needed in PartitionInfo
-	defaultConnection := partition_topology.NewPartitionConnection(partition_topology.NewPacketLoss(defaultConnectionInfo.PacketLossPercentage), partition_topology.ConnectionWithNoPacketDelay)
+	defaultConnection := newPartitionConnectionFromApi(args.DefaultConnection)
 
 	if err := apicService.serviceNetwork.Repartition(
 		ctx,
@@ -239,6 +290,69 @@ func (apicService ApiContainerService) Repartition(ctx context.Context, args *ku
 	return &emptypb.Empty{}, nil
 }
 
+func (apicService ApiContainerService) AddPartition(ctx context.Context, args *kurtosis_core_rpc_api_bindings.AddPartitionArgs) (*emptypb.Empty, error) {
+	partitionId := service_network_types.PartitionID(args.GetPartitionId())
+	serviceNames := newServiceNameSetFromApi(args.GetServiceNameSet())
+	if err := apicService.serviceNetwork.AddPartition(ctx, partitionId, serviceNames); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding partition '%s' with services '%v'", partitionId, serviceNames)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) MovePartition(ctx context.Context, args *kurtosis_core_rpc_api_bindings.MovePartitionArgs) (*emptypb.Empty, error) {
+	partitionId := service_network_types.PartitionID(args.GetPartitionId())
+	serviceNames := newServiceNameSetFromApi(args.GetServiceNameSet())
+	if err := apicService.serviceNetwork.MovePartition(ctx, partitionId, serviceNames); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred moving services '%v' to partition '%s'", serviceNames, partitionId)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) UpdateConnection(ctx context.Context, args *kurtosis_core_rpc_api_bindings.UpdateConnectionArgs) (*emptypb.Empty, error) {
+	partitionIdA := service_network_types.PartitionID(args.GetPartitionIdA())
+	partitionIdB := service_network_types.PartitionID(args.GetPartitionIdB())
+	if args.GetConnectionInfo() == nil {
+		return nil, stacktrace.NewError("The connection between partitions '%s' and '%s' to update to is missing", partitionIdA, partitionIdB)
+	}
+	connection := newPartitionConnectionFromApi(args.GetConnectionInfo())
+	if err := apicService.serviceNetwork.SetConnection(ctx, partitionIdA, partitionIdB, connection); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating the connection between partitions '%s' and '%s'", partitionIdA, partitionIdB)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) TestConnectivity(ctx context.Context, args *kurtosis_core_rpc_api_bindings.TestConnectivityArgs) (*kurtosis_core_rpc_api_bindings.TestConnectivityResponse, error) {
+	probeResultsBySource, err := apicService.serviceNetwork.TestConnectivity(ctx, args.GetServiceIdentifiers(), args.GetNumProbes())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred testing the connectivity between services '%v'", args.GetServiceIdentifiers())
+	}
+	probeResults := []*kurtosis_core_rpc_api_bindings.ConnectivityProbeResult{}
+	for sourceServiceName, probeResultsByTarget := range probeResultsBySource {
+		for targetServiceName, probeResult := range probeResultsByTarget {
+			probeErrStr := ""
+			if probeResult.GetMaybeProbeErr() != nil {
+				probeErrStr = probeResult.GetMaybeProbeErr().Error()
+			}
+			probeResults = append(probeResults, binding_constructors.NewConnectivityProbeResult(
+				string(sourceServiceName),
+				string(targetServiceName),
+				probeResult.GetExpectedPacketLossPercentage(),
+				probeResult.GetExpectedPacketDelayMs(),
+				probeResult.GetObservedPacketLossPercentage(),
+				probeResult.GetObservedAvgLatencyMs(),
+				probeErrStr,
+			))
+		}
+	}
+	sort.Slice(probeResults, func(i, j int) bool {
+		if probeResults[i].GetSourceServiceName() != probeResults[j].GetSourceServiceName() {
+			return probeResults[i].GetSourceServiceName() < probeResults[j].GetSourceServiceName()
+		}
+		return probeResults[i].GetTargetServiceName() < probeResults[j].GetTargetServiceName()
+	})
+	return binding_constructors.NewTestConnectivityResponse(probeResults), nil
+}
+
 func (service ApiContainerService) PauseService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.PauseServiceArgs) (*emptypb.Empty, error) {
 	serviceIdentifier := args.ServiceIdentifier
 	err := service.serviceNetwork.PauseService(ctx, serviceIdentifier)
@@ -257,6 +371,51 @@ func (service ApiContainerService) UnpauseService(ctx context.Context, args *kur
 	return &emptypb.Empty{}, nil
 }
 
+func (service ApiContainerService) PauseServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.PauseServicesArgs) (*kurtosis_core_rpc_api_bindings.PauseServicesResponse, error) {
+	serviceIdentifiers := service.getServiceIdentifiersForBulkOperation(args.GetServiceIdentifiers(), args.GetAllServices())
+	successfulServiceNames, failedServiceNames, err := service.serviceNetwork.PauseServices(ctx, serviceIdentifiers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to pause services '%v'", serviceIdentifiers)
+	}
+	successfulServiceNameStrs, failedServiceNameStrs := serviceNameResultsToApi(successfulServiceNames, failedServiceNames)
+	return binding_constructors.NewPauseServicesResponse(successfulServiceNameStrs, failedServiceNameStrs), nil
+}
+
+func (service ApiContainerService) UnpauseServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.UnpauseServicesArgs) (*kurtosis_core_rpc_api_bindings.UnpauseServicesResponse, error) {
+	serviceIdentifiers := service.getServiceIdentifiersForBulkOperation(args.GetServiceIdentifiers(), args.GetAllServices())
+	successfulServiceNames, failedServiceNames, err := service.serviceNetwork.UnpauseServices(ctx, serviceIdentifiers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to unpause services '%v'", serviceIdentifiers)
+	}
+	successfulServiceNameStrs, failedServiceNameStrs := serviceNameResultsToApi(successfulServiceNames, failedServiceNames)
+	return binding_constructors.NewUnpauseServicesResponse(successfulServiceNameStrs, failedServiceNameStrs), nil
+}
+
+func (service ApiContainerService) WaitForServiceExit(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForServiceExitArgs) (*kurtosis_core_rpc_api_bindings.WaitForServiceExitResponse, error) {
+	serviceIdentifier := args.GetServiceIdentifier()
+	exitCode, err := service.serviceNetwork.WaitForServiceExit(ctx, serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to wait for service '%v' to exit", serviceIdentifier)
+	}
+	return binding_constructors.NewWaitForServiceExitResponse(exitCode), nil
+}
+
+func (service ApiContainerService) GetServiceMetrics(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetServiceMetricsArgs) (*kurtosis_core_rpc_api_bindings.GetServiceMetricsResponse, error) {
+	serviceIdentifier := args.ServiceIdentifier
+	metrics, err := service.serviceNetwork.GetServiceMetrics(ctx, serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get the metrics of service '%v'", serviceIdentifier)
+	}
+	return binding_constructors.NewGetServiceMetricsResponse(
+		timestamppb.New(metrics.GetTimestamp()),
+		metrics.GetCpuUsagePercent(),
+		metrics.GetMemoryUsageBytes(),
+		metrics.GetMemoryLimitBytes(),
+		metrics.GetNetworkRxBytes(),
+		metrics.GetNetworkTxBytes(),
+	), nil
+}
+
 func (apicService ApiContainerService) ExecCommand(ctx context.Context, args *kurtosis_core_rpc_api_bindings.ExecCommandArgs) (*kurtosis_core_rpc_api_bindings.ExecCommandResponse, error) {
 	serviceIdentifier := args.ServiceIdentifier
 	command := args.CommandArgs
@@ -284,6 +443,24 @@ func (apicService ApiContainerService) ExecCommand(ctx context.Context, args *ku
 	return resp, nil
 }
 
+func (apicService ApiContainerService) ExecCommandWithStreamedOutput(args *kurtosis_core_rpc_api_bindings.ExecCommandArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_ExecCommandWithStreamedOutputServer) error {
+	serviceIdentifier := args.ServiceIdentifier
+	command := args.CommandArgs
+	outputWriter := newExecOutputStreamWriter(stream.Send)
+	exitCode, err := apicService.serviceNetwork.ExecCommandWithStreamedOutput(stream.Context(), serviceIdentifier, command, outputWriter)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred running exec command '%v' with streamed output against service '%v' in the service network",
+			command,
+			serviceIdentifier)
+	}
+	if err := stream.Send(binding_constructors.NewExecCommandStreamedOutputChunkFromExitCode(exitCode)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the exit code '%v' of exec command '%v' to the client", exitCode, command)
+	}
+	return nil
+}
+
 func (apicService ApiContainerService) WaitForHttpGetEndpointAvailability(ctx context.Context, args *kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 
 	serviceIdentifier := args.ServiceIdentifier
@@ -369,21 +546,121 @@ func (apicService ApiContainerService) GetExistingAndHistoricalServiceIdentifier
 	return &kurtosis_core_rpc_api_bindings.GetExistingAndHistoricalServiceIdentifiersResponse{AllIdentifiers: allIdentifiers}, nil
 }
 
+func (apicService ApiContainerService) GetServiceConfigs(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetServiceConfigsResponse, error) {
+	serviceConfigs := map[string]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serviceConfig := range apicService.serviceNetwork.GetServiceConfigs() {
+		serviceConfigs[string(serviceName)] = serviceConfig
+	}
+	return &kurtosis_core_rpc_api_bindings.GetServiceConfigsResponse{ServiceConfigs: serviceConfigs}, nil
+}
+
+func (apicService ApiContainerService) GetScrapeTargets(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetScrapeTargetsResponse, error) {
+	scrapeTargets, err := apicService.serviceNetwork.GetScrapeTargets(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the scrape targets of the enclave")
+	}
+	return &kurtosis_core_rpc_api_bindings.GetScrapeTargetsResponse{ScrapeTargets: scrapeTargets}, nil
+}
+
+func (apicService ApiContainerService) GetSubnetworkConnections(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetSubnetworkConnectionsResponse, error) {
+	defaultConnection, connectionOverrides, err := apicService.serviceNetwork.GetSubnetworkConnections()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the connections between subnetworks")
+	}
+	connectionOverridesApi := []*kurtosis_core_rpc_api_bindings.SubnetworkConnectionOverride{}
+	for connectionId, connection := range connectionOverrides {
+		connectionOverridesApi = append(connectionOverridesApi, &kurtosis_core_rpc_api_bindings.SubnetworkConnectionOverride{
+			Subnetwork1: string(connectionId.GetFirst()),
+			Subnetwork2: string(connectionId.GetSecond()),
+			Connection:  newSubnetworkConnectionApi(connection),
+		})
+	}
+	sort.Slice(connectionOverridesApi, func(i, j int) bool {
+		if connectionOverridesApi[i].Subnetwork1 != connectionOverridesApi[j].Subnetwork1 {
+			return connectionOverridesApi[i].Subnetwork1 < connectionOverridesApi[j].Subnetwork1
+		}
+		return connectionOverridesApi[i].Subnetwork2 < connectionOverridesApi[j].Subnetwork2
+	})
+	return &kurtosis_core_rpc_api_bindings.GetSubnetworkConnectionsResponse{
+		DefaultConnection:   newSubnetworkConnectionApi(defaultConnection),
+		ConnectionOverrides: connectionOverridesApi,
+	}, nil
+}
+
 func (apicService ApiContainerService) UploadFilesArtifact(_ context.Context, args *kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs) (*kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse, error) {
 	maybeArtifactName := args.GetName()
 	if maybeArtifactName == "" {
 		maybeArtifactName = apicService.filesArtifactStore.GenerateUniqueNameForFileArtifact()
 	}
 
+	if args.ContentHash != nil {
+		filesArtifactUuid, isAlreadyStored, err := apicService.filesArtifactStore.StoreFileWithKnownContentHash(args.GetContentHash(), maybeArtifactName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred looking up the files artifact with content hash '%v'", args.GetContentHash())
+		}
+		if isAlreadyStored {
+			logrus.Debugf("Skipped storing files artifact '%v' as its contents are already stored as files artifact '%v'", maybeArtifactName, filesArtifactUuid)
+			return binding_constructors.NewUploadFilesArtifactResponse(string(filesArtifactUuid), maybeArtifactName, isAlreadyStored), nil
+		}
+		if len(args.GetData()) == 0 {
+			// The caller only sent the hash, and has to upload the data now that it knows the contents aren't stored
+			return binding_constructors.NewUploadFilesArtifactResponse("", maybeArtifactName, isAlreadyStored), nil
+		}
+	}
+
 	filesArtifactUuid, err := apicService.serviceNetwork.UploadFilesArtifact(args.Data, maybeArtifactName)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to upload the file")
 	}
 
-	response := &kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse{Uuid: string(filesArtifactUuid), Name: maybeArtifactName}
+	response := binding_constructors.NewUploadFilesArtifactResponse(string(filesArtifactUuid), maybeArtifactName, false)
 	return response, nil
 }
 
+// UploadFilesArtifactStream stores the chunks as they come in, so the files artifact never has to fit in memory nor in a
+// single gRPC message. The store only locks itself once all the chunks are written, so slow uploads don't block it
+func (apicService ApiContainerService) UploadFilesArtifactStream(stream kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactStreamServer) error {
+	firstChunk, err := stream.Recv()
+	if err == io.EOF {
+		return stacktrace.NewError("Cannot upload a files artifact without receiving any chunk of it")
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the first chunk of the files artifact to upload")
+	}
+	artifactName := firstChunk.GetName()
+	if artifactName == "" {
+		artifactName = apicService.filesArtifactStore.GenerateUniqueNameForFileArtifact()
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	var filesArtifactUuid enclave_data_directory.FilesArtifactUUID
+	var storeErr error
+	storeDone := make(chan struct{})
+	go func() {
+		defer close(storeDone)
+		filesArtifactUuid, storeErr = apicService.filesArtifactStore.StoreFile(pipeReader, artifactName)
+		// Unblocks the receiving loop if the store stopped reading before the end of the stream
+		pipeReader.Close()
+	}()
+
+	receiveErr := receiveFilesArtifactChunks(stream, firstChunk, pipeWriter)
+	// A nil error makes the store see the end of the data
+	pipeWriter.CloseWithError(receiveErr)
+	<-storeDone
+	if receiveErr != nil {
+		return stacktrace.Propagate(receiveErr, "An error occurred receiving the chunks of files artifact '%v'", artifactName)
+	}
+	if storeErr != nil {
+		return stacktrace.Propagate(storeErr, "An error occurred storing the uploaded files artifact '%v'", artifactName)
+	}
+
+	response := binding_constructors.NewUploadFilesArtifactResponse(string(filesArtifactUuid), artifactName, false)
+	if err := stream.SendAndClose(response); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the response of the upload of files artifact '%v'", artifactName)
+	}
+	return nil
+}
+
 func (apicService ApiContainerService) DownloadFilesArtifact(ctx context.Context, args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs) (*kurtosis_core_rpc_api_bindings.DownloadFilesArtifactResponse, error) {
 	artifactIdentifier := args.Identifier
 	if strings.TrimSpace(artifactIdentifier) == "" {
@@ -404,6 +681,42 @@ func (apicService ApiContainerService) DownloadFilesArtifact(ctx context.Context
 	return resp, nil
 }
 
+// DownloadFilesArtifactStream reads the files artifact from disk one chunk at a time, so it never has to fit in memory
+// nor in a single gRPC message
+func (apicService ApiContainerService) DownloadFilesArtifactStream(args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadFilesArtifactStreamServer) error {
+	artifactIdentifier := args.Identifier
+	if strings.TrimSpace(artifactIdentifier) == "" {
+		return stacktrace.NewError("Cannot download file with empty files artifact identifier")
+	}
+
+	filesArtifact, err := apicService.filesArtifactStore.GetFile(artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+	}
+
+	file, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the file of files artifact '%v'", artifactIdentifier)
+	}
+	defer file.Close()
+
+	chunkBytes := make([]byte, filesArtifactStreamChunkSizeBytes)
+	for {
+		numReadBytes, readErr := file.Read(chunkBytes)
+		if numReadBytes > 0 {
+			if err := stream.Send(binding_constructors.NewFilesArtifactStreamChunk(chunkBytes[:numReadBytes], artifactIdentifier)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending a chunk of files artifact '%v' to the client", artifactIdentifier)
+			}
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return stacktrace.Propagate(readErr, "An error occurred reading the file of files artifact '%v'", artifactIdentifier)
+		}
+	}
+}
+
 func (apicService ApiContainerService) StoreWebFilesArtifact(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs) (*kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse, error) {
 	url := args.Url
 	artifactName := args.Name
@@ -452,15 +765,142 @@ func (apicService ApiContainerService) ListFilesArtifactNamesAndUuids(_ context.
 	filesArtifactsNamesAndUuids := apicService.filesArtifactStore.GetFileNamesAndUuids()
 	var filesArtifactNamesAndUuids []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid
 	for _, nameAndUuid := range filesArtifactsNamesAndUuids {
+		filesArtifactUuid := nameAndUuid.GetUuid()
+		file, err := apicService.filesArtifactStore.PeekFile(string(filesArtifactUuid))
+		if err != nil {
+			// The files artifact got deleted since it was listed
+			logrus.Debugf("Skipping files artifact '%v' as it couldn't be found anymore:\n%v", filesArtifactUuid, err)
+			continue
+		}
+		fileInfo, err := os.Stat(file.GetAbsoluteFilepath())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the size of files artifact '%v'", filesArtifactUuid)
+		}
 		fileNameAndUuidGrpcType := &kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{
-			FileName: nameAndUuid.GetName(),
-			FileUuid: string(nameAndUuid.GetUuid()),
+			FileName:  nameAndUuid.GetName(),
+			FileUuid:  string(filesArtifactUuid),
+			SizeBytes: uint64(fileInfo.Size()),
+			// Files artifacts are never modified once stored
+			CreationTimeUnixSeconds: fileInfo.ModTime().Unix(),
 		}
 		filesArtifactNamesAndUuids = append(filesArtifactNamesAndUuids, fileNameAndUuidGrpcType)
 	}
 	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse{FileNamesAndUuids: filesArtifactNamesAndUuids}, nil
 }
 
+func (apicService ApiContainerService) InspectFilesArtifactContents(_ context.Context, args *kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsArgs) (*kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse, error) {
+	file, err := apicService.filesArtifactStore.PeekFile(args.GetIdentifier())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", args.GetIdentifier())
+	}
+	fileDescriptions, err := getFilesArtifactContentsFileDescriptions(file.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the contents of files artifact '%v'", args.GetIdentifier())
+	}
+	return &kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse{FileDescriptions: fileDescriptions}, nil
+}
+
+func (apicService ApiContainerService) DeleteFilesArtifact(_ context.Context, args *kurtosis_core_rpc_api_bindings.DeleteFilesArtifactArgs) (*emptypb.Empty, error) {
+	if err := apicService.filesArtifactStore.DeleteFile(args.GetIdentifier()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred deleting files artifact '%v'", args.GetIdentifier())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) SetLogLevel(_ context.Context, args *kurtosis_core_rpc_api_bindings.SetLogLevelArgs) (*kurtosis_core_rpc_api_bindings.SetLogLevelResponse, error) {
+	revertAfter := time.Duration(args.GetRevertAfterSeconds()) * time.Second
+	previousLogLevel, err := apicService.logLevelSetter.setLogLevel(args.GetLogLevel(), revertAfter)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting the log level of the API container to '%v'", args.GetLogLevel())
+	}
+	return &kurtosis_core_rpc_api_bindings.SetLogLevelResponse{PreviousLogLevel: previousLogLevel.String()}, nil
+}
+
+func (apicService ApiContainerService) RunShutdownHooks(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.RunShutdownHooksResponse, error) {
+	results := []*kurtosis_core_rpc_api_bindings.ShutdownHookResult{}
+	for _, hookResult := range shutdown_hooks.GetShutdownHooksRegistry().RunHooks(ctx) {
+		var maybeErrStr *string
+		if hookErr := hookResult.GetError(); hookErr != nil {
+			errStr := hookErr.Error()
+			maybeErrStr = &errStr
+		}
+		results = append(results, &kurtosis_core_rpc_api_bindings.ShutdownHookResult{
+			Description: hookResult.GetDescription(),
+			Error:       maybeErrStr,
+		})
+	}
+	return &kurtosis_core_rpc_api_bindings.RunShutdownHooksResponse{Results: results}, nil
+}
+
+func (apicService ApiContainerService) HttpRequestService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs) (*kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse, error) {
+	serviceIdentifier := args.GetServiceIdentifier()
+	serviceObj, err := apicService.serviceNetwork.GetService(ctx, serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceIdentifier)
+	}
+	port, found := serviceObj.GetPrivatePorts()[args.GetPortId()]
+	if !found {
+		return nil, stacktrace.NewError("Service '%v' has no private port with ID '%v'", serviceIdentifier, args.GetPortId())
+	}
+	if port.GetTransportProtocol() != port_spec.TransportProtocol_TCP {
+		return nil, stacktrace.NewError("Port '%v' of service '%v' uses transport protocol '%v', but HTTP requests can only be sent over '%v'", args.GetPortId(), serviceIdentifier, port.GetTransportProtocol(), port_spec.TransportProtocol_TCP)
+	}
+
+	url := getServiceHttpRequestUrl(serviceObj.GetRegistration().GetPrivateIP(), port, args.GetUseHttps(), args.GetPath())
+	response, err := sendServiceHttpRequest(ctx, url, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending an HTTP request to port '%v' of service '%v'", args.GetPortId(), serviceIdentifier)
+	}
+	return response, nil
+}
+
+func (apicService ApiContainerService) GetBackendCapabilities(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetBackendCapabilitiesResponse, error) {
+	capabilities, err := apicService.kurtosisBackend.GetBackendCapabilities(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the capabilities of the backend")
+	}
+	return binding_constructors.NewGetBackendCapabilitiesResponse(
+		capabilities.IsNetworkPartitioningSupported(),
+		capabilities.IsServicePausingSupported(),
+		capabilities.AreStaticPublicPortsSupported(),
+		capabilities.ArePersistentVolumesSupported(),
+		capabilities.IsExecTtySupported(),
+	), nil
+}
+
+func (apicService ApiContainerService) GetPackageCacheInfo(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error) {
+	cacheInfo, err := apicService.startosisModuleContentProvider.GetCacheInfo()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info about the package cache")
+	}
+	return cacheInfo, nil
+}
+
+func (apicService ApiContainerService) ClearPackageCache(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ClearPackageCacheResponse, error) {
+	removedRepositories, err := apicService.startosisModuleContentProvider.ClearCache()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred clearing the package cache")
+	}
+	logrus.Infof("Cleared the package cache, removing %v packages", len(removedRepositories))
+	return binding_constructors.NewClearPackageCacheResponse(removedRepositories), nil
+}
+
+func (apicService ApiContainerService) GetStarlarkRunProfile(_ context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunProfileArgs) (*kurtosis_core_rpc_api_bindings.StarlarkRunProfile, error) {
+	runProfile, err := apicService.runProfileStore.GetRunProfile(args.GetRunId())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the profile of Starlark run '%v'", args.GetRunId())
+	}
+	return runProfile, nil
+}
+
+func (apicService ApiContainerService) GetDeprecatedFeatures(_ context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.GetDeprecatedFeaturesResponse, error) {
+	deprecatedFeatures := []*kurtosis_core_rpc_api_bindings.DeprecatedFeature{}
+	for _, deprecation := range startosis_deprecations.GetDeprecations() {
+		deprecatedFeatures = append(deprecatedFeatures, binding_constructors.NewDeprecatedFeature(string(deprecation.Code), deprecation.Description))
+	}
+	return binding_constructors.NewGetDeprecatedFeaturesResponse(deprecatedFeatures), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -632,18 +1072,57 @@ func (apicService ApiContainerService) getServiceInfo(ctx context.Context, servi
 		}
 	}
 
+	creationTime, runningTime, readyTime := apicService.getServiceStartupTimestamps(serviceObj)
 	serviceInfoResponse := binding_constructors.NewServiceInfo(
 		serviceUuidStr,
 		serviceNameStr,
 		uuid_generator.ShortenedUUIDString(serviceUuidStr),
 		privateIp.String(),
+		getMaybePrivateIpv6AddrStr(serviceObj),
 		privateApiPorts,
 		publicIpAddrStr,
 		publicApiPorts,
+		getReplicaPrivateIpAddrStrs(serviceObj),
+		creationTime,
+		runningTime,
+		readyTime,
 	)
 	return serviceInfoResponse, nil
 }
 
+// getServiceStartupTimestamps returns when the service's container was created, when it entered running and when
+// the service became ready; each is nil if it isn't known
+func (apicService ApiContainerService) getServiceStartupTimestamps(serviceObj *kurtosis_backend_service.Service) (*timestamppb.Timestamp, *timestamppb.Timestamp, *timestamppb.Timestamp) {
+	var creationTime, runningTime, readyTime *timestamppb.Timestamp
+	if maybeCreationTime := serviceObj.GetMaybeCreationTime(); maybeCreationTime != nil {
+		creationTime = timestamppb.New(*maybeCreationTime)
+	}
+	if startupTimes, found := apicService.serviceNetwork.GetServiceStartupTimes(serviceObj.GetRegistration().GetName()); found {
+		runningTime = timestamppb.New(startupTimes.GetRunningTime())
+		if maybeReadyTime := startupTimes.GetMaybeReadyTime(); maybeReadyTime != nil {
+			readyTime = timestamppb.New(*maybeReadyTime)
+		}
+	}
+	return creationTime, runningTime, readyTime
+}
+
+func getReplicaPrivateIpAddrStrs(serviceObj *kurtosis_backend_service.Service) []string {
+	replicaPrivateIpAddrStrs := []string{}
+	for _, replicaPrivateIp := range serviceObj.GetReplicaPrivateIPs() {
+		replicaPrivateIpAddrStrs = append(replicaPrivateIpAddrStrs, replicaPrivateIp.String())
+	}
+	return replicaPrivateIpAddrStrs
+}
+
+// The service only has an IPv6 address if its enclave was created with IPv6 enabled
+func getMaybePrivateIpv6AddrStr(serviceObj *kurtosis_backend_service.Service) string {
+	maybePrivateIpv6 := serviceObj.GetRegistration().GetPrivateIPv6()
+	if maybePrivateIpv6 == nil {
+		return ""
+	}
+	return maybePrivateIpv6.String()
+}
+
 func (apicService ApiContainerService) runStarlarkPackageSetup(packageId string, isRemote bool, moduleContentIfLocal []byte) (string, *startosis_errors.InterpretationError) {
 	var packageRootPathOnDisk string
 	var interpretationError *startosis_errors.InterpretationError
@@ -669,8 +1148,10 @@ func (apicService ApiContainerService) runStarlarkPackageSetup(packageId string,
 	return string(mainScriptToExecute), nil
 }
 
-func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool, packageId string, serializedStarlark string, serializedParams string, stream grpc.ServerStream) {
-	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, serializedStarlark, serializedParams)
+func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool, isStrict bool, packageId string, serializedStarlark string, serializedParams string, stream grpc.ServerStream) {
+	// Packages may have been cloned outside of a run (e.g. to inspect them), so those resolutions aren't this run's
+	apicService.startosisModuleContentProvider.PopResolvedPackageVersions()
+	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, isStrict, packageId, serializedStarlark, serializedParams)
 	for {
 		select {
 		case <-stream.Context().Done():
@@ -684,6 +1165,14 @@ func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool,
 				logrus.Info("Startosis script execution returned, no more output to stream.")
 				return
 			}
+			// The CLI writes the versions this run resolved to the lock file next to the package, as that's where the
+			// user can commit them; a failed or dry run mustn't lock anything
+			if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+				resolvedPackageVersions := apicService.startosisModuleContentProvider.PopResolvedPackageVersions()
+				if runFinishedEvent.GetIsRunSuccessful() && !dryRun {
+					runFinishedEvent.ResolvedPackageVersions = resolvedPackageVersions
+				}
+			}
 			// in addition to send the msg to the RPC stream, we also print the lines to the APIC logs at debug level
 			logrus.Debugf("Received response line from Starlark runner: '%v'", responseLine)
 			if err := stream.SendMsg(responseLine); err != nil {
@@ -692,3 +1181,171 @@ func (apicService ApiContainerService) runStarlark(parallelism int, dryRun bool,
 		}
 	}
 }
+
+func newSubnetworkConnectionApi(connection partition_topology.PartitionConnection) *kurtosis_core_rpc_api_bindings.SubnetworkConnection {
+	packetLoss := connection.GetPacketLossPercentage()
+	packetDelay := connection.GetPacketDelay()
+	bandwidthLimit := connection.GetBandwidthLimit()
+	return &kurtosis_core_rpc_api_bindings.SubnetworkConnection{
+		PacketLossPercentage:   packetLoss.GetPercentage(),
+		PacketDelayMeanMs:      packetDelay.GetAvgDelayMs(),
+		PacketDelayStdDevMs:    packetDelay.GetJitterMs(),
+		PacketDelayCorrelation: packetDelay.GetCorrelation(),
+		BandwidthLimitKbps:     bandwidthLimit.GetKbps(),
+	}
+}
+
+// getServiceIdentifiersForBulkOperation returns the given service identifiers, or the names of all the services if
+// the operation targets all of them
+func (service ApiContainerService) getServiceIdentifiersForBulkOperation(serviceIdentifiers map[string]bool, allServices bool) map[string]bool {
+	if !allServices {
+		return serviceIdentifiers
+	}
+	allServiceIdentifiers := map[string]bool{}
+	for serviceName := range service.serviceNetwork.GetServiceNames() {
+		allServiceIdentifiers[string(serviceName)] = true
+	}
+	return allServiceIdentifiers
+}
+
+// getServiceNamesToRemove resolves the given service identifiers and service name patterns to the names of the services
+// to remove, or returns the names of all the services if allServices is set. Identifiers and patterns that don't match
+// any service are returned along with the error explaining why, so they can be reported back as failures
+func (apicService ApiContainerService) getServiceNamesToRemove(serviceIdentifiers map[string]bool, serviceNamePatterns []string, allServices bool) (map[string]bool, map[string]string, error) {
+	unmatchedServiceErrors := map[string]string{}
+	if allServices {
+		return apicService.getServiceIdentifiersForBulkOperation(nil, allServices), unmatchedServiceErrors, nil
+	}
+
+	serviceNamesByIdentifier := map[string]string{}
+	for serviceName := range apicService.serviceNetwork.GetServiceNames() {
+		registration, found := apicService.serviceNetwork.GetServiceRegistration(serviceName)
+		if !found {
+			continue
+		}
+		serviceUuidStr := string(registration.GetUUID())
+		serviceNamesByIdentifier[string(serviceName)] = string(serviceName)
+		serviceNamesByIdentifier[serviceUuidStr] = string(serviceName)
+		serviceNamesByIdentifier[uuid_generator.ShortenedUUIDString(serviceUuidStr)] = string(serviceName)
+	}
+
+	serviceNamesToRemove := map[string]bool{}
+	for serviceIdentifier := range serviceIdentifiers {
+		serviceName, found := serviceNamesByIdentifier[serviceIdentifier]
+		if !found {
+			unmatchedServiceErrors[serviceIdentifier] = fmt.Sprintf("No service with identifier '%v' exists in the enclave", serviceIdentifier)
+			continue
+		}
+		serviceNamesToRemove[serviceName] = true
+	}
+	for _, serviceNamePattern := range serviceNamePatterns {
+		isPatternMatched := false
+		for serviceName := range apicService.serviceNetwork.GetServiceNames() {
+			isMatch, err := path.Match(serviceNamePattern, string(serviceName))
+			if err != nil {
+				return nil, nil, stacktrace.Propagate(err, "Service name pattern '%v' is malformed", serviceNamePattern)
+			}
+			if isMatch {
+				serviceNamesToRemove[string(serviceName)] = true
+				isPatternMatched = true
+			}
+		}
+		if !isPatternMatched {
+			unmatchedServiceErrors[serviceNamePattern] = fmt.Sprintf("No service name in the enclave matches pattern '%v'", serviceNamePattern)
+		}
+	}
+	return serviceNamesToRemove, unmatchedServiceErrors, nil
+}
+
+func serviceNameResultsToApi(successfulServiceNames map[kurtosis_backend_service.ServiceName]bool, failedServiceNames map[kurtosis_backend_service.ServiceName]error) (map[string]bool, map[string]string) {
+	successfulServiceNameStrs := map[string]bool{}
+	for serviceName := range successfulServiceNames {
+		successfulServiceNameStrs[string(serviceName)] = true
+	}
+	failedServiceNameStrs := map[string]string{}
+	for serviceName, serviceErr := range failedServiceNames {
+		failedServiceNameStrs[string(serviceName)] = serviceErr.Error()
+	}
+	return successfulServiceNameStrs, failedServiceNameStrs
+}
+
+func newServiceNameSetFromApi(serviceNameSet map[string]bool) map[kurtosis_backend_service.ServiceName]bool {
+	serviceNames := map[kurtosis_backend_service.ServiceName]bool{}
+	for serviceNameStr := range serviceNameSet {
+		serviceNames[kurtosis_backend_service.ServiceName(serviceNameStr)] = true
+	}
+	return serviceNames
+}
+
+func newPartitionConnectionFromApi(connectionInfo *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo) partition_topology.PartitionConnection {
+	return partition_topology.NewPartitionConnection(
+		partition_topology.NewPacketLoss(connectionInfo.GetPacketLossPercentage()),
+		partition_topology.NewNormalPacketDelayDistribution(connectionInfo.GetPacketDelayMeanMs(), connectionInfo.GetPacketDelayStdDevMs(), connectionInfo.GetPacketDelayCorrelation()),
+		partition_topology.NewBandwidthLimit(connectionInfo.GetBandwidthLimitKbps()),
+	)
+}
+
+// receiveFilesArtifactChunks writes the data of the already received first chunk, then of every chunk after it, to the
+// output until the client closes the stream
+func receiveFilesArtifactChunks(
+	stream kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactStreamServer,
+	firstChunk *kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk,
+	output io.Writer,
+) error {
+	chunk := firstChunk
+	for {
+		if _, err := output.Write(chunk.GetData()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing a chunk of the files artifact to the store")
+		}
+		nextChunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred receiving a chunk of the files artifact")
+		}
+		chunk = nextChunk
+	}
+}
+
+// getFilesArtifactContentsFileDescriptions reads the headers of the gzipped tarball a files artifact is stored as
+func getFilesArtifactContentsFileDescriptions(filesArtifactFilepath string) ([]*kurtosis_core_rpc_api_bindings.FilesArtifactContentsFileDescription, error) {
+	filesArtifactFile, err := os.Open(filesArtifactFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening files artifact file '%v'", filesArtifactFilepath)
+	}
+	defer filesArtifactFile.Close()
+	gzipReader, err := gzip.NewReader(filesArtifactFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading files artifact file '%v' as a gzip file", filesArtifactFilepath)
+	}
+	defer gzipReader.Close()
+
+	fileDescriptions := []*kurtosis_core_rpc_api_bindings.FilesArtifactContentsFileDescription{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading the next file of files artifact file '%v'", filesArtifactFilepath)
+		}
+		// Cleaning also drops the './' some tarballs have their paths start with
+		filePath := path.Clean(header.Name)
+		if filePath == filesArtifactRootDir {
+			continue
+		}
+		isDirectory := header.Typeflag == tar.TypeDir
+		sizeBytes := uint64(0)
+		if !isDirectory {
+			sizeBytes = uint64(header.Size)
+		}
+		fileDescriptions = append(fileDescriptions, &kurtosis_core_rpc_api_bindings.FilesArtifactContentsFileDescription{
+			Path:        filePath,
+			SizeBytes:   sizeBytes,
+			IsDirectory: isDirectory,
+		})
+	}
+	return fileDescriptions, nil
+}
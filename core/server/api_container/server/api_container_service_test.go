@@ -6,12 +6,37 @@
 package server
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path"
 	"testing"
 )
 
+// fakeUploadFilesArtifactStream hands out the given chunks, then the end of the stream
+type fakeUploadFilesArtifactStream struct {
+	kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactStreamServer
+
+	chunks []*kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk
+}
+
+func (stream *fakeUploadFilesArtifactStream) Recv() (*kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk, error) {
+	if len(stream.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := stream.chunks[0]
+	stream.chunks = stream.chunks[1:]
+	return chunk, nil
+}
+
 func TestOneToOneApiAndPortSpecProtoMapping(t *testing.T) {
 	// Ensure all port spec protos are covered
 	require.Equal(t, len(kurtosis_core_rpc_api_bindings.Port_TransportProtocol_name), len(apiContainerPortProtoToPortSpecPortProto))
@@ -35,3 +60,83 @@ func TestOneToOneApiAndPortSpecProtoMapping(t *testing.T) {
 		seenPortSpecProtos[portSpecProto] = apiPortProto
 	}
 }
+
+func TestReceiveFilesArtifactChunks_WritesAllChunksInOrder(t *testing.T) {
+	firstChunk := binding_constructors.NewFilesArtifactStreamChunk([]byte("first-"), "artifact")
+	stream := &fakeUploadFilesArtifactStream{
+		ApiContainerService_UploadFilesArtifactStreamServer: nil,
+		chunks: []*kurtosis_core_rpc_api_bindings.FilesArtifactStreamChunk{
+			binding_constructors.NewFilesArtifactStreamChunk([]byte("second-"), ""),
+			binding_constructors.NewFilesArtifactStreamChunk([]byte("third"), ""),
+		},
+	}
+	output := &bytes.Buffer{}
+
+	require.NoError(t, receiveFilesArtifactChunks(stream, firstChunk, output))
+	require.Equal(t, "first-second-third", output.String())
+}
+
+func TestGetFilesArtifactContentsFileDescriptions_ListsFilesAndDirectories(t *testing.T) {
+	tarballBuffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(tarballBuffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	fileContents := []byte("Long Live Kurtosis!")
+	require.Nil(t, tarWriter.WriteHeader(&tar.Header{Name: "./", Typeflag: tar.TypeDir, Mode: 0o755}))
+	require.Nil(t, tarWriter.WriteHeader(&tar.Header{Name: "./config/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	require.Nil(t, tarWriter.WriteHeader(&tar.Header{Name: "./config/genesis.json", Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(fileContents))}))
+	_, err := tarWriter.Write(fileContents)
+	require.Nil(t, err)
+	require.Nil(t, tarWriter.Close())
+	require.Nil(t, gzipWriter.Close())
+	filesArtifactFilepath := path.Join(t.TempDir(), "artifact.tgz")
+	require.Nil(t, os.WriteFile(filesArtifactFilepath, tarballBuffer.Bytes(), 0o644))
+
+	fileDescriptions, err := getFilesArtifactContentsFileDescriptions(filesArtifactFilepath)
+	require.Nil(t, err)
+	require.Len(t, fileDescriptions, 2)
+	require.Equal(t, "config", fileDescriptions[0].GetPath())
+	require.True(t, fileDescriptions[0].GetIsDirectory())
+	require.Equal(t, "config/genesis.json", fileDescriptions[1].GetPath())
+	require.False(t, fileDescriptions[1].GetIsDirectory())
+	require.Equal(t, uint64(len(fileContents)), fileDescriptions[1].GetSizeBytes())
+}
+
+func TestGetServiceNamesToRemove_ResolvesIdentifiersAndPatterns(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	registrations := map[service.ServiceName]*service.ServiceRegistration{
+		"node-1":   service.NewServiceRegistration("node-1", "6a2f8e3c9b1d4f7a8e5c2b9d1f4a7e3c", "enclave", nil, "node-1"),
+		"node-2":   service.NewServiceRegistration("node-2", "1b3d5f7a9c2e4a6b8d0f1a3c5e7b9d2f", "enclave", nil, "node-2"),
+		"database": service.NewServiceRegistration("database", "9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b", "enclave", nil, "database"),
+	}
+	serviceNames := map[service.ServiceName]bool{}
+	for serviceName, registration := range registrations {
+		serviceNames[serviceName] = true
+		serviceNetwork.EXPECT().GetServiceRegistration(serviceName).Maybe().Return(registration, true)
+	}
+	serviceNetwork.EXPECT().GetServiceNames().Return(serviceNames)
+	apicService := ApiContainerService{serviceNetwork: serviceNetwork} //nolint:exhaustruct
+
+	serviceNamesToRemove, unmatchedServiceErrors, err := apicService.getServiceNamesToRemove(
+		map[string]bool{"9e8d7c6b5a4f": true, "missing": true},
+		[]string{"node-*", "web-*"},
+		false,
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"node-1": true, "node-2": true, "database": true}, serviceNamesToRemove)
+	require.Len(t, unmatchedServiceErrors, 2)
+	require.Contains(t, unmatchedServiceErrors, "missing")
+	require.Contains(t, unmatchedServiceErrors, "web-*")
+}
+
+func TestGetServiceNamesToRemove_FailsOnMalformedPattern(t *testing.T) {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().GetServiceNames().Return(map[service.ServiceName]bool{"node-1": true})
+	serviceNetwork.EXPECT().GetServiceRegistration(service.ServiceName("node-1")).Return(
+		service.NewServiceRegistration("node-1", "6a2f8e3c9b1d4f7a8e5c2b9d1f4a7e3c", "enclave", nil, "node-1"),
+		true,
+	)
+	apicService := ApiContainerService{serviceNetwork: serviceNetwork} //nolint:exhaustruct
+
+	_, _, err := apicService.getServiceNamesToRemove(map[string]bool{}, []string{"node-["}, false)
+	require.Error(t, err)
+}
@@ -0,0 +1,315 @@
+package crash_diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// Written periodically, so that the last known state of the API container survives it getting OOM-killed
+	StateSnapshotFilename = "state.json"
+
+	panicReportFilenamePrefix = "panic-"
+	panicReportFilenameSuffix = ".log"
+	// No colons, so that the filenames can be extracted on any OS
+	panicReportTimestampFormat = "20060102T150405.000Z0700"
+
+	// Big enough for the stacks of all the goroutines of a busy API container
+	goroutineDumpBufferSize = 8 * 1024 * 1024
+
+	// A state supplier can need a lock held by the goroutine that panicked, so we don't wait for them forever
+	stateSuppliersTimeout = 2 * time.Second
+
+	diagnosticsFilePerms = 0644
+	tmpFileSuffix        = ".tmp"
+
+	stateSnapshotJsonIndent = "  "
+)
+
+var (
+	defaultCrashDiagnosticsRecorder = NewCrashDiagnosticsRecorder()
+)
+
+// StateSupplierFunc returns a piece of the in-memory state of the API container, to be serialized as JSON in the
+// diagnostics. It must not panic nor block for long
+type StateSupplierFunc func() (interface{}, error)
+
+// CurrentRun is the Starlark run that the API container was doing when the diagnostics got written
+type CurrentRun struct {
+	PackageId string    `json:"package_id"`
+	StartTime time.Time `json:"start_time"`
+}
+
+// StateSnapshot is what the API container knew about itself when the diagnostics got written
+type StateSnapshot struct {
+	Timestamp       time.Time              `json:"timestamp"`
+	NumGoroutines   int                    `json:"num_goroutines"`
+	HeapAllocBytes  uint64                 `json:"heap_alloc_bytes"`
+	HeapSysBytes    uint64                 `json:"heap_sys_bytes"`
+	TotalSysBytes   uint64                 `json:"total_sys_bytes"`
+	NumGC           uint32                 `json:"num_gc"`
+	CurrentRun      *CurrentRun            `json:"current_run"`
+	SuppliedState   map[string]interface{} `json:"supplied_state"`
+	SupplierErrors  map[string]string      `json:"supplier_errors,omitempty"`
+	UnansweredState []string               `json:"unanswered_state,omitempty"`
+}
+
+// CrashDiagnosticsRecorder writes what's needed to debug an API container that died to the enclave data directory, which
+// outlives the container: a report with the stacks of all goroutines when it panics, and a periodic snapshot of its state
+// for when it gets killed without getting a chance to write anything, e.g. when running out of memory
+type CrashDiagnosticsRecorder struct {
+	mutex *sync.Mutex
+
+	// Empty until the enclave data directory is known, in which case nothing gets written
+	dirpath string
+
+	stateSuppliers map[string]StateSupplierFunc
+
+	currentRun *CurrentRun
+}
+
+func NewCrashDiagnosticsRecorder() *CrashDiagnosticsRecorder {
+	return &CrashDiagnosticsRecorder{
+		mutex:          &sync.Mutex{},
+		dirpath:        "",
+		stateSuppliers: map[string]StateSupplierFunc{},
+		currentRun:     nil,
+	}
+}
+
+// GetCrashDiagnosticsRecorder returns the recorder shared by everything in the API container that can panic or has
+// state worth recording
+func GetCrashDiagnosticsRecorder() *CrashDiagnosticsRecorder {
+	return defaultCrashDiagnosticsRecorder
+}
+
+// SetDirpath sets the directory, which must exist, that the diagnostics get written to
+func (recorder *CrashDiagnosticsRecorder) SetDirpath(dirpath string) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.dirpath = dirpath
+}
+
+// AddStateSupplier adds a piece of state to the diagnostics, under the name; adding a supplier with the same name as
+// an existing one replaces it
+func (recorder *CrashDiagnosticsRecorder) AddStateSupplier(name string, supplier StateSupplierFunc) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.stateSuppliers[name] = supplier
+}
+
+// StartRun records that a Starlark run of the package started, and returns the function to call when it ends
+func (recorder *CrashDiagnosticsRecorder) StartRun(packageId string) func() {
+	run := &CurrentRun{
+		PackageId: packageId,
+		StartTime: time.Now(),
+	}
+	recorder.mutex.Lock()
+	recorder.currentRun = run
+	recorder.mutex.Unlock()
+	return func() {
+		recorder.mutex.Lock()
+		defer recorder.mutex.Unlock()
+		// Runs don't overlap in practice, but a run ending mustn't clear the one that replaced it
+		if recorder.currentRun == run {
+			recorder.currentRun = nil
+		}
+	}
+}
+
+// RecoverAndRecordPanic writes a panic report if the calling goroutine is panicking, and then keeps panicking so that
+// the API container still crashes the way it would have. It must be called directly by a defer statement
+func (recorder *CrashDiagnosticsRecorder) RecoverAndRecordPanic() {
+	panicValue := recover()
+	if panicValue == nil {
+		return
+	}
+	reportFilepath, err := recorder.writePanicReport(panicValue)
+	if err != nil {
+		logrus.Errorf("An error occurred writing the crash diagnostics of a panic:\n%v", err)
+	} else if reportFilepath != "" {
+		logrus.Errorf("The API container is panicking; its crash diagnostics got written to '%v'", reportFilepath)
+	}
+	panic(panicValue)
+}
+
+// StartStateSnapshots writes a state snapshot every interval until the returned function gets called
+func (recorder *CrashDiagnosticsRecorder) StartStateSnapshots(interval time.Duration) func() {
+	stopChan := make(chan struct{})
+	stopOnce := &sync.Once{}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			if err := recorder.WriteStateSnapshot(); err != nil {
+				logrus.Debugf("An error occurred writing the state snapshot of the crash diagnostics:\n%v", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+	return func() {
+		stopOnce.Do(func() {
+			close(stopChan)
+		})
+	}
+}
+
+// WriteStateSnapshot replaces the state snapshot in the diagnostics directory with the current state
+func (recorder *CrashDiagnosticsRecorder) WriteStateSnapshot() error {
+	dirpath := recorder.getDirpath()
+	if dirpath == "" {
+		return nil
+	}
+	snapshotBytes, err := json.MarshalIndent(recorder.GetStateSnapshot(), "", stateSnapshotJsonIndent)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the state snapshot")
+	}
+	// Written to a temporary file first, so that getting killed halfway doesn't leave a truncated snapshot behind
+	snapshotFilepath := path.Join(dirpath, StateSnapshotFilename)
+	tmpSnapshotFilepath := snapshotFilepath + tmpFileSuffix
+	if err := os.WriteFile(tmpSnapshotFilepath, snapshotBytes, diagnosticsFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the state snapshot to '%v'", tmpSnapshotFilepath)
+	}
+	if err := os.Rename(tmpSnapshotFilepath, snapshotFilepath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving the state snapshot from '%v' to '%v'", tmpSnapshotFilepath, snapshotFilepath)
+	}
+	return nil
+}
+
+// GetStateSnapshot collects the current state; suppliers that don't answer in time are listed as unanswered
+func (recorder *CrashDiagnosticsRecorder) GetStateSnapshot() *StateSnapshot {
+	recorder.mutex.Lock()
+	var currentRun *CurrentRun
+	if recorder.currentRun != nil {
+		currentRunCopy := *recorder.currentRun
+		currentRun = &currentRunCopy
+	}
+	stateSuppliers := make(map[string]StateSupplierFunc, len(recorder.stateSuppliers))
+	for name, supplier := range recorder.stateSuppliers {
+		stateSuppliers[name] = supplier
+	}
+	recorder.mutex.Unlock()
+
+	memStats := &runtime.MemStats{} // nolint: exhaustruct
+	runtime.ReadMemStats(memStats)
+
+	suppliedState, supplierErrors, unansweredState := collectSuppliedState(stateSuppliers)
+	return &StateSnapshot{
+		Timestamp:       time.Now(),
+		NumGoroutines:   runtime.NumGoroutine(),
+		HeapAllocBytes:  memStats.HeapAlloc,
+		HeapSysBytes:    memStats.HeapSys,
+		TotalSysBytes:   memStats.Sys,
+		NumGC:           memStats.NumGC,
+		CurrentRun:      currentRun,
+		SuppliedState:   suppliedState,
+		SupplierErrors:  supplierErrors,
+		UnansweredState: unansweredState,
+	}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (recorder *CrashDiagnosticsRecorder) getDirpath() string {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.dirpath
+}
+
+// Returns the path of the report written, or an empty string if the diagnostics directory isn't set
+func (recorder *CrashDiagnosticsRecorder) writePanicReport(panicValue interface{}) (string, error) {
+	dirpath := recorder.getDirpath()
+	if dirpath == "" {
+		return "", nil
+	}
+	now := time.Now()
+	goroutineStacks := getAllGoroutineStacks()
+
+	snapshotStr := ""
+	snapshotBytes, err := json.MarshalIndent(recorder.GetStateSnapshot(), "", stateSnapshotJsonIndent)
+	if err != nil {
+		snapshotStr = fmt.Sprintf("An error occurred serializing the state snapshot: %v", err)
+	} else {
+		snapshotStr = string(snapshotBytes)
+	}
+
+	report := fmt.Sprintf(
+		"Panic at %v: %v\n\n===== State =====\n%v\n\n===== Goroutines =====\n%v\n",
+		now.Format(time.RFC3339Nano),
+		panicValue,
+		snapshotStr,
+		goroutineStacks,
+	)
+	reportFilepath := path.Join(dirpath, panicReportFilenamePrefix+now.UTC().Format(panicReportTimestampFormat)+panicReportFilenameSuffix)
+	if err := os.WriteFile(reportFilepath, []byte(report), diagnosticsFilePerms); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred writing the panic report to '%v'", reportFilepath)
+	}
+	return reportFilepath, nil
+}
+
+func getAllGoroutineStacks() string {
+	buffer := make([]byte, goroutineDumpBufferSize)
+	numBytes := runtime.Stack(buffer, true)
+	return string(buffer[:numBytes])
+}
+
+func collectSuppliedState(stateSuppliers map[string]StateSupplierFunc) (map[string]interface{}, map[string]string, []string) {
+	type supplierResult struct {
+		name  string
+		state interface{}
+		err   error
+	}
+	resultsChan := make(chan *supplierResult, len(stateSuppliers))
+	for name, supplier := range stateSuppliers {
+		go func(name string, supplier StateSupplierFunc) {
+			defer func() {
+				if panicValue := recover(); panicValue != nil {
+					resultsChan <- &supplierResult{name: name, state: nil, err: stacktrace.NewError("The state supplier panicked: %v", panicValue)}
+				}
+			}()
+			state, err := supplier()
+			resultsChan <- &supplierResult{name: name, state: state, err: err}
+		}(name, supplier)
+	}
+
+	suppliedState := map[string]interface{}{}
+	supplierErrors := map[string]string{}
+	answered := map[string]bool{}
+	timeout := time.After(stateSuppliersTimeout)
+	for len(answered) < len(stateSuppliers) {
+		select {
+		case result := <-resultsChan:
+			answered[result.name] = true
+			if result.err != nil {
+				supplierErrors[result.name] = result.err.Error()
+				continue
+			}
+			suppliedState[result.name] = result.state
+		case <-timeout:
+			unansweredState := []string{}
+			for name := range stateSuppliers {
+				if !answered[name] {
+					unansweredState = append(unansweredState, name)
+				}
+			}
+			sort.Strings(unansweredState)
+			return suppliedState, supplierErrors, unansweredState
+		}
+	}
+	return suppliedState, supplierErrors, nil
+}
@@ -0,0 +1,132 @@
+package crash_diagnostics
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+const (
+	testPackageId = "github.com/kurtosis-tech/some-package"
+)
+
+func TestRecoverAndRecordPanic_WritesReportAndKeepsPanicking(t *testing.T) {
+	recorder := NewCrashDiagnosticsRecorder()
+	dirpath := t.TempDir()
+	recorder.SetDirpath(dirpath)
+	recorder.AddStateSupplier("services", func() (interface{}, error) {
+		return []string{"postgres"}, nil
+	})
+	endRun := recorder.StartRun(testPackageId)
+	defer endRun()
+
+	require.PanicsWithValue(t, "boom", func() {
+		defer recorder.RecoverAndRecordPanic()
+		panic("boom")
+	})
+
+	entries, err := os.ReadDir(dirpath)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.True(t, strings.HasPrefix(entries[0].Name(), panicReportFilenamePrefix))
+	reportBytes, err := os.ReadFile(path.Join(dirpath, entries[0].Name()))
+	require.NoError(t, err)
+	report := string(reportBytes)
+	require.Contains(t, report, "boom")
+	require.Contains(t, report, testPackageId)
+	require.Contains(t, report, "postgres")
+	require.Contains(t, report, "TestRecoverAndRecordPanic_WritesReportAndKeepsPanicking")
+}
+
+func TestRecoverAndRecordPanic_RecordsRunEndedByEarlierDefer(t *testing.T) {
+	recorder := NewCrashDiagnosticsRecorder()
+	dirpath := t.TempDir()
+	recorder.SetDirpath(dirpath)
+
+	require.PanicsWithValue(t, "boom", func() {
+		endRun := recorder.StartRun(testPackageId)
+		defer endRun()
+		defer recorder.RecoverAndRecordPanic()
+		panic("boom")
+	})
+
+	entries, err := os.ReadDir(dirpath)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	reportBytes, err := os.ReadFile(path.Join(dirpath, entries[0].Name()))
+	require.NoError(t, err)
+	require.Contains(t, string(reportBytes), testPackageId)
+}
+
+func TestRecoverAndRecordPanic_DoesNothingWithoutPanic(t *testing.T) {
+	recorder := NewCrashDiagnosticsRecorder()
+	dirpath := t.TempDir()
+	recorder.SetDirpath(dirpath)
+
+	require.NotPanics(t, func() {
+		defer recorder.RecoverAndRecordPanic()
+	})
+
+	entries, err := os.ReadDir(dirpath)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestWriteStateSnapshot(t *testing.T) {
+	recorder := NewCrashDiagnosticsRecorder()
+	dirpath := t.TempDir()
+	recorder.SetDirpath(dirpath)
+	recorder.AddStateSupplier("services", func() (interface{}, error) {
+		return map[string]string{"postgres": "RUNNING"}, nil
+	})
+	recorder.AddStateSupplier("broken", func() (interface{}, error) {
+		return nil, stacktrace.NewError("Couldn't get state")
+	})
+	endRun := recorder.StartRun(testPackageId)
+
+	require.NoError(t, recorder.WriteStateSnapshot())
+	snapshot := readStateSnapshot(t, dirpath)
+	require.NotNil(t, snapshot.CurrentRun)
+	require.Equal(t, testPackageId, snapshot.CurrentRun.PackageId)
+	require.Equal(t, map[string]interface{}{"postgres": "RUNNING"}, snapshot.SuppliedState["services"])
+	require.Contains(t, snapshot.SupplierErrors["broken"], "Couldn't get state")
+	require.Positive(t, snapshot.NumGoroutines)
+
+	endRun()
+	require.NoError(t, recorder.WriteStateSnapshot())
+	require.Nil(t, readStateSnapshot(t, dirpath).CurrentRun)
+}
+
+func TestWriteStateSnapshot_WithoutDirpathDoesNothing(t *testing.T) {
+	recorder := NewCrashDiagnosticsRecorder()
+	require.NoError(t, recorder.WriteStateSnapshot())
+}
+
+func TestGetStateSnapshot_ListsHangingSuppliersAsUnanswered(t *testing.T) {
+	recorder := NewCrashDiagnosticsRecorder()
+	unblockSupplier := make(chan struct{})
+	defer close(unblockSupplier)
+	recorder.AddStateSupplier("hanging", func() (interface{}, error) {
+		<-unblockSupplier
+		return nil, nil
+	})
+	recorder.AddStateSupplier("services", func() (interface{}, error) {
+		return 1, nil
+	})
+
+	snapshot := recorder.GetStateSnapshot()
+	require.Equal(t, []string{"hanging"}, snapshot.UnansweredState)
+	require.Equal(t, 1, snapshot.SuppliedState["services"])
+}
+
+func readStateSnapshot(t *testing.T, dirpath string) *StateSnapshot {
+	snapshotBytes, err := os.ReadFile(path.Join(dirpath, StateSnapshotFilename))
+	require.NoError(t, err)
+	snapshot := &StateSnapshot{} // nolint: exhaustruct
+	require.NoError(t, json.Unmarshal(snapshotBytes, snapshot))
+	return snapshot
+}
@@ -0,0 +1,51 @@
+package crash_diagnostics
+
+import (
+	"context"
+	"google.golang.org/grpc"
+)
+
+// WrapServiceDesc returns a copy of the gRPC service description whose handlers record the crash diagnostics when they
+// panic, as the gRPC server runs each of them in its own goroutine and the panics never reach the main one
+func WrapServiceDesc(serviceDesc grpc.ServiceDesc, recorder *CrashDiagnosticsRecorder) *grpc.ServiceDesc {
+	wrappedMethods := make([]grpc.MethodDesc, len(serviceDesc.Methods))
+	for idx, method := range serviceDesc.Methods {
+		wrappedMethods[idx] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    wrapUnaryHandler(method.Handler, recorder),
+		}
+	}
+	wrappedStreams := make([]grpc.StreamDesc, len(serviceDesc.Streams))
+	for idx, stream := range serviceDesc.Streams {
+		wrappedStreams[idx] = grpc.StreamDesc{
+			StreamName:    stream.StreamName,
+			Handler:       wrapStreamHandler(stream.Handler, recorder),
+			ServerStreams: stream.ServerStreams,
+			ClientStreams: stream.ClientStreams,
+		}
+	}
+	return &grpc.ServiceDesc{
+		ServiceName: serviceDesc.ServiceName,
+		HandlerType: serviceDesc.HandlerType,
+		Methods:     wrappedMethods,
+		Streams:     wrappedStreams,
+		Metadata:    serviceDesc.Metadata,
+	}
+}
+
+// Same signature as grpc.MethodDesc.Handler, whose type isn't exported
+type unaryHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+func wrapUnaryHandler(handler unaryHandler, recorder *CrashDiagnosticsRecorder) unaryHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		defer recorder.RecoverAndRecordPanic()
+		return handler(srv, ctx, dec, interceptor)
+	}
+}
+
+func wrapStreamHandler(handler grpc.StreamHandler, recorder *CrashDiagnosticsRecorder) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		defer recorder.RecoverAndRecordPanic()
+		return handler(srv, stream)
+	}
+}
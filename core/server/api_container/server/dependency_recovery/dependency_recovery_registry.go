@@ -0,0 +1,174 @@
+package dependency_recovery
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"sync"
+)
+
+var (
+	defaultDependencyRecoveryRegistry = NewDependencyRecoveryRegistry()
+)
+
+// RestartFunc restarts the given service in place
+type RestartFunc func(ctx context.Context, serviceName service.ServiceName) error
+
+// ReadinessCheckFunc blocks until the service it was registered for passes its ready conditions again
+type ReadinessCheckFunc func(ctx context.Context) error
+
+// RecoveryHookFunc does the work a dependent needs once its dependency is back, e.g. reconnecting to it
+type RecoveryHookFunc func(ctx context.Context) error
+
+// RecoveryAction is what happens to a dependent once its dependency got restarted and became ready again: either the
+// dependent gets restarted too, or a hook gets run
+type RecoveryAction struct {
+	dependent service.ServiceName
+
+	// nil if the dependent gets restarted
+	hook RecoveryHookFunc
+}
+
+func NewRestartRecoveryAction(dependent service.ServiceName) *RecoveryAction {
+	return &RecoveryAction{
+		dependent: dependent,
+		hook:      nil,
+	}
+}
+
+func NewHookRecoveryAction(dependent service.ServiceName, hook RecoveryHookFunc) *RecoveryAction {
+	return &RecoveryAction{
+		dependent: dependent,
+		hook:      hook,
+	}
+}
+
+// DependencyRecoveryRegistry keeps what needs to happen to the dependents of a service when the service gets restarted,
+// so that restarting a dependency (e.g. to test fault tolerance) doesn't leave its dependents stuck on a dead connection
+type DependencyRecoveryRegistry struct {
+	mutex *sync.Mutex
+
+	readinessChecks map[service.ServiceName]ReadinessCheckFunc
+
+	// Keyed by dependency, in registration order which is the order they get run in
+	recoveryActions map[service.ServiceName][]*RecoveryAction
+}
+
+func NewDependencyRecoveryRegistry() *DependencyRecoveryRegistry {
+	return &DependencyRecoveryRegistry{
+		mutex:           &sync.Mutex{},
+		readinessChecks: map[service.ServiceName]ReadinessCheckFunc{},
+		recoveryActions: map[service.ServiceName][]*RecoveryAction{},
+	}
+}
+
+// GetDependencyRecoveryRegistry returns the registry shared by the Starlark instructions adding, restarting and
+// removing services
+func GetDependencyRecoveryRegistry() *DependencyRecoveryRegistry {
+	return defaultDependencyRecoveryRegistry
+}
+
+// RegisterService sets how to wait for the service to be ready again after a restart (nil if it has no ready
+// conditions) and what to do to it when each of its dependencies gets restarted, replacing what was registered for
+// the service before
+func (registry *DependencyRecoveryRegistry) RegisterService(
+	serviceName service.ServiceName,
+	readinessCheck ReadinessCheckFunc,
+	recoveryActionsByDependency map[service.ServiceName]*RecoveryAction,
+) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.unregisterServiceUnlocked(serviceName)
+	if readinessCheck != nil {
+		registry.readinessChecks[serviceName] = readinessCheck
+	}
+	dependencies := []string{}
+	for dependency := range recoveryActionsByDependency {
+		dependencies = append(dependencies, string(dependency))
+	}
+	sort.Strings(dependencies)
+	for _, dependency := range dependencies {
+		dependencyName := service.ServiceName(dependency)
+		registry.recoveryActions[dependencyName] = append(registry.recoveryActions[dependencyName], recoveryActionsByDependency[dependencyName])
+	}
+}
+
+// UnregisterService forgets the readiness check of the service and what to do to it when its dependencies get
+// restarted, e.g. once it gets removed
+func (registry *DependencyRecoveryRegistry) UnregisterService(serviceName service.ServiceName) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.unregisterServiceUnlocked(serviceName)
+}
+
+// RestartAndRecover restarts the service, waits for it to be ready again and then recovers its dependents, restarting
+// them the same way (along with their own dependents) or running their hooks. A service is restarted at most once per
+// recovery, even if it depends on several restarted services. It returns what was done to the dependents
+func (registry *DependencyRecoveryRegistry) RestartAndRecover(ctx context.Context, serviceName service.ServiceName, restart RestartFunc) ([]string, error) {
+	recoveryDescriptions := []string{}
+	restartedServices := map[service.ServiceName]bool{}
+	if err := registry.restartAndRecover(ctx, serviceName, restart, restartedServices, &recoveryDescriptions); err != nil {
+		return recoveryDescriptions, err
+	}
+	return recoveryDescriptions, nil
+}
+
+func (registry *DependencyRecoveryRegistry) restartAndRecover(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	restart RestartFunc,
+	restartedServices map[service.ServiceName]bool,
+	recoveryDescriptions *[]string,
+) error {
+	restartedServices[serviceName] = true
+	if err := restart(ctx, serviceName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred restarting service '%v'", serviceName)
+	}
+
+	registry.mutex.Lock()
+	readinessCheck := registry.readinessChecks[serviceName]
+	recoveryActions := append([]*RecoveryAction{}, registry.recoveryActions[serviceName]...)
+	registry.mutex.Unlock()
+
+	if readinessCheck != nil {
+		if err := readinessCheck(ctx); err != nil {
+			return stacktrace.Propagate(err, "Service '%v' was restarted but didn't become ready again", serviceName)
+		}
+	}
+
+	for _, action := range recoveryActions {
+		if action.hook == nil {
+			if restartedServices[action.dependent] {
+				continue
+			}
+			logrus.Infof("Restarting service '%v' as its dependency '%v' was restarted", action.dependent, serviceName)
+			*recoveryDescriptions = append(*recoveryDescriptions, fmt.Sprintf("restarted service '%v'", action.dependent))
+			if err := registry.restartAndRecover(ctx, action.dependent, restart, restartedServices, recoveryDescriptions); err != nil {
+				return stacktrace.Propagate(err, "An error occurred recovering service '%v' after its dependency '%v' was restarted", action.dependent, serviceName)
+			}
+			continue
+		}
+		logrus.Infof("Running the recovery hook of service '%v' as its dependency '%v' was restarted", action.dependent, serviceName)
+		if err := action.hook(ctx); err != nil {
+			return stacktrace.Propagate(err, "The recovery hook of service '%v' failed after its dependency '%v' was restarted", action.dependent, serviceName)
+		}
+		*recoveryDescriptions = append(*recoveryDescriptions, fmt.Sprintf("ran the recovery hook of service '%v'", action.dependent))
+	}
+	return nil
+}
+
+func (registry *DependencyRecoveryRegistry) unregisterServiceUnlocked(serviceName service.ServiceName) {
+	delete(registry.readinessChecks, serviceName)
+	for dependency, actions := range registry.recoveryActions {
+		remainingActions := []*RecoveryAction{}
+		for _, action := range actions {
+			if action.dependent != serviceName {
+				remainingActions = append(remainingActions, action)
+			}
+		}
+		registry.recoveryActions[dependency] = remainingActions
+	}
+}
@@ -0,0 +1,83 @@
+package dependency_recovery
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	db     = service.ServiceName("db")
+	api    = service.ServiceName("api")
+	worker = service.ServiceName("worker")
+	proxy  = service.ServiceName("proxy")
+)
+
+func TestRestartAndRecover_RestartsDependentsTransitivelyAndRunsHooks(t *testing.T) {
+	registry := NewDependencyRecoveryRegistry()
+	events := []string{}
+	registry.RegisterService(db, func(ctx context.Context) error {
+		events = append(events, "db ready")
+		return nil
+	}, nil)
+	registry.RegisterService(api, nil, map[service.ServiceName]*RecoveryAction{
+		db: NewRestartRecoveryAction(api),
+	})
+	registry.RegisterService(worker, nil, map[service.ServiceName]*RecoveryAction{
+		db: NewHookRecoveryAction(worker, func(ctx context.Context) error {
+			events = append(events, "worker hook")
+			return nil
+		}),
+	})
+	// The proxy depends on both the db and the api, but only gets restarted once
+	registry.RegisterService(proxy, nil, map[service.ServiceName]*RecoveryAction{
+		api: NewRestartRecoveryAction(proxy),
+		db:  NewRestartRecoveryAction(proxy),
+	})
+
+	descriptions, err := registry.RestartAndRecover(context.Background(), db, func(ctx context.Context, serviceName service.ServiceName) error {
+		events = append(events, "restart "+string(serviceName))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"restart db", "db ready", "restart api", "restart proxy", "worker hook"}, events)
+	require.Equal(t, []string{"restarted service 'api'", "restarted service 'proxy'", "ran the recovery hook of service 'worker'"}, descriptions)
+}
+
+func TestRestartAndRecover_DoesntRecoverDependentsIfNotReady(t *testing.T) {
+	registry := NewDependencyRecoveryRegistry()
+	registry.RegisterService(db, func(ctx context.Context) error {
+		return stacktrace.NewError("Ready condition failed")
+	}, nil)
+	registry.RegisterService(api, nil, map[service.ServiceName]*RecoveryAction{
+		db: NewRestartRecoveryAction(api),
+	})
+
+	restartedServices := []service.ServiceName{}
+	_, err := registry.RestartAndRecover(context.Background(), db, func(ctx context.Context, serviceName service.ServiceName) error {
+		restartedServices = append(restartedServices, serviceName)
+		return nil
+	})
+	require.Error(t, err)
+	require.Equal(t, []service.ServiceName{db}, restartedServices)
+}
+
+func TestRegisterService_ReplacesPreviousRegistration(t *testing.T) {
+	registry := NewDependencyRecoveryRegistry()
+	registry.RegisterService(api, nil, map[service.ServiceName]*RecoveryAction{
+		db: NewRestartRecoveryAction(api),
+	})
+	registry.RegisterService(api, nil, nil)
+	registry.RegisterService(worker, nil, map[service.ServiceName]*RecoveryAction{
+		db: NewRestartRecoveryAction(worker),
+	})
+	registry.UnregisterService(worker)
+
+	descriptions, err := registry.RestartAndRecover(context.Background(), db, func(ctx context.Context, serviceName service.ServiceName) error {
+		return nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, descriptions)
+}
@@ -0,0 +1,75 @@
+package error_redaction
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	causedByPrefix = "Caused by: "
+
+	// Chains longer than this keep only their outermost and innermost errors, the latter being the root cause
+	maxErrorsInSummary   = 6
+	numOuterErrorsToKeep = 3
+
+	// Error messages routinely embed whole service configs or command outputs, so each one gets cut at this length
+	maxErrorMessageLengthInSummary = 2000
+
+	fullErrorChainHint = "(this error was summarized; set the '%v' gRPC metadata header to 'true' to get the full error chain)"
+)
+
+// Lines of the form ' --- at /path/to/file.go:123 (functionName) ---' that stacktrace adds to every wrapped error
+var stacktraceLocationLineRegex = regexp.MustCompile(`^\s*--- at .* ---\s*$`)
+
+// SummarizeErrorChain turns the string of a (potentially gigantic) stacktrace error chain into a readable summary:
+// code locations are dropped, only the outermost errors and the root cause are kept, and overly long messages are
+// truncated. The string is returned as-is if it didn't need summarizing
+func SummarizeErrorChain(errorChainStr string, fullErrorChainMetadataKey string) string {
+	errorMessages := splitErrorChain(errorChainStr)
+
+	wasSummarized := false
+	if len(errorMessages) > maxErrorsInSummary {
+		numInnerErrorsToKeep := maxErrorsInSummary - numOuterErrorsToKeep
+		numOmittedErrors := len(errorMessages) - maxErrorsInSummary
+		summarizedMessages := []string{}
+		summarizedMessages = append(summarizedMessages, errorMessages[:numOuterErrorsToKeep]...)
+		summarizedMessages = append(summarizedMessages, fmt.Sprintf("... %v wrapped errors omitted ...", numOmittedErrors))
+		summarizedMessages = append(summarizedMessages, errorMessages[len(errorMessages)-numInnerErrorsToKeep:]...)
+		errorMessages = summarizedMessages
+		wasSummarized = true
+	}
+
+	for idx, message := range errorMessages {
+		if len(message) > maxErrorMessageLengthInSummary {
+			numOmittedChars := len(message) - maxErrorMessageLengthInSummary
+			errorMessages[idx] = fmt.Sprintf("%v... (%v characters omitted)", message[:maxErrorMessageLengthInSummary], numOmittedChars)
+			wasSummarized = true
+		}
+	}
+
+	if !wasSummarized {
+		return errorChainStr
+	}
+	summary := strings.Join(errorMessages, "\n"+causedByPrefix)
+	return summary + "\n" + fmt.Sprintf(fullErrorChainHint, fullErrorChainMetadataKey)
+}
+
+// splitErrorChain returns the messages of the errors in the chain, from the outermost to the root cause, without the
+// code locations
+func splitErrorChain(errorChainStr string) []string {
+	errorMessages := []string{}
+	currentMessageLines := []string{}
+	for _, line := range strings.Split(errorChainStr, "\n") {
+		if stacktraceLocationLineRegex.MatchString(line) {
+			continue
+		}
+		if strings.HasPrefix(line, causedByPrefix) {
+			errorMessages = append(errorMessages, strings.Join(currentMessageLines, "\n"))
+			currentMessageLines = []string{strings.TrimPrefix(line, causedByPrefix)}
+			continue
+		}
+		currentMessageLines = append(currentMessageLines, line)
+	}
+	return append(errorMessages, strings.Join(currentMessageLines, "\n"))
+}
@@ -0,0 +1,37 @@
+package error_redaction
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const (
+	testMetadataKey = "test-full-error-chain"
+)
+
+func TestSummarizeErrorChain_ShortChainIsUnchanged(t *testing.T) {
+	errorChain := "An error occurred adding the service\n --- at /path/file.go:12 (addService) ---\nCaused by: image pull timeout\n --- at /path/other.go:34 (pull) ---"
+	require.Equal(t, errorChain, SummarizeErrorChain(errorChain, testMetadataKey))
+}
+
+func TestSummarizeErrorChain_LongChainKeepsOuterErrorsAndRootCause(t *testing.T) {
+	errorMessages := []string{}
+	for idx := 0; idx < 10; idx++ {
+		errorMessages = append(errorMessages, fmt.Sprintf("error %v\n --- at /path/file.go:%v (func%v) ---", idx, idx, idx))
+	}
+	errorChain := strings.Join(errorMessages, "\nCaused by: ")
+
+	expectedSummary := "error 0\nCaused by: error 1\nCaused by: error 2\nCaused by: ... 4 wrapped errors omitted ...\nCaused by: error 7\nCaused by: error 8\nCaused by: error 9\n" +
+		fmt.Sprintf(fullErrorChainHint, testMetadataKey)
+	require.Equal(t, expectedSummary, SummarizeErrorChain(errorChain, testMetadataKey))
+}
+
+func TestSummarizeErrorChain_LongMessageIsTruncated(t *testing.T) {
+	errorChain := "An error occurred with config " + strings.Repeat("x", maxErrorMessageLengthInSummary)
+
+	summary := SummarizeErrorChain(errorChain, testMetadataKey)
+	require.True(t, strings.HasPrefix(summary, errorChain[:maxErrorMessageLengthInSummary]+"... (30 characters omitted)"))
+	require.True(t, strings.HasSuffix(summary, fmt.Sprintf(fullErrorChainHint, testMetadataKey)))
+}
@@ -0,0 +1,86 @@
+package error_redaction
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secrets"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// WrapServiceDesc returns a copy of the gRPC service description whose handlers redact the secrets from the errors
+// returned to the client, and summarize them unless the client asked for the full error chain
+// This is how errors get processed as they cross the gRPC boundary, as the gRPC server doesn't let us add interceptors
+func WrapServiceDesc(serviceDesc grpc.ServiceDesc, secretsRegistry *secrets.SecretsRegistry) *grpc.ServiceDesc {
+	wrappedMethods := make([]grpc.MethodDesc, len(serviceDesc.Methods))
+	for idx, method := range serviceDesc.Methods {
+		wrappedMethods[idx] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    wrapUnaryHandler(method.Handler, secretsRegistry),
+		}
+	}
+	wrappedStreams := make([]grpc.StreamDesc, len(serviceDesc.Streams))
+	for idx, stream := range serviceDesc.Streams {
+		wrappedStreams[idx] = grpc.StreamDesc{
+			StreamName:    stream.StreamName,
+			Handler:       wrapStreamHandler(stream.Handler, secretsRegistry),
+			ServerStreams: stream.ServerStreams,
+			ClientStreams: stream.ClientStreams,
+		}
+	}
+	return &grpc.ServiceDesc{
+		ServiceName: serviceDesc.ServiceName,
+		HandlerType: serviceDesc.HandlerType,
+		Methods:     wrappedMethods,
+		Streams:     wrappedStreams,
+		Metadata:    serviceDesc.Metadata,
+	}
+}
+
+// Same signature as grpc.MethodDesc.Handler, whose type isn't exported
+type unaryHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+func wrapUnaryHandler(handler unaryHandler, secretsRegistry *secrets.SecretsRegistry) unaryHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		resp, err := handler(srv, ctx, dec, interceptor)
+		if err != nil {
+			return resp, processErrorForClient(ctx, err, secretsRegistry)
+		}
+		return resp, nil
+	}
+}
+
+func wrapStreamHandler(handler grpc.StreamHandler, secretsRegistry *secrets.SecretsRegistry) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		if err := handler(srv, stream); err != nil {
+			return processErrorForClient(stream.Context(), err, secretsRegistry)
+		}
+		return nil
+	}
+}
+
+func processErrorForClient(ctx context.Context, err error, secretsRegistry *secrets.SecretsRegistry) error {
+	// Errors that aren't gRPC statuses already get sent with code Unknown by the gRPC server, so we keep doing that
+	grpcStatus, _ := status.FromError(err)
+	redactedErrorChain := secretsRegistry.Redact(grpcStatus.Message())
+	if isFullErrorChainRequested(ctx) {
+		return status.Error(grpcStatus.Code(), redactedErrorChain)
+	}
+	logrus.Debugf("Summarizing the following error before returning it to the client:\n%v", redactedErrorChain)
+	return status.Error(grpcStatus.Code(), SummarizeErrorChain(redactedErrorChain, enclaves.FullErrorChainMetadataKey))
+}
+
+func isFullErrorChainRequested(ctx context.Context) bool {
+	incomingMetadata, found := metadata.FromIncomingContext(ctx)
+	if !found {
+		return false
+	}
+	for _, value := range incomingMetadata.Get(enclaves.FullErrorChainMetadataKey) {
+		if value == enclaves.FullErrorChainMetadataValue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	// Well below the 4MB gRPC limit on message sizes
+	maxExecOutputChunkSizeBytes = 1024 * 1024
+)
+
+// execOutputStreamWriter sends everything written to it as output chunks of a streamed exec, so that the output of the
+// command never sits in the API container memory
+type execOutputStreamWriter struct {
+	sendChunk func(chunk *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk) error
+}
+
+func newExecOutputStreamWriter(sendChunk func(chunk *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk) error) *execOutputStreamWriter {
+	return &execOutputStreamWriter{
+		sendChunk: sendChunk,
+	}
+}
+
+func (writer *execOutputStreamWriter) Write(output []byte) (int, error) {
+	numBytesSent := 0
+	for numBytesSent < len(output) {
+		chunkEnd := numBytesSent + maxExecOutputChunkSizeBytes
+		if chunkEnd > len(output) {
+			chunkEnd = len(output)
+		}
+		// The caller is free to reuse the slice once Write returns, so the chunk can't point to it
+		chunkOutput := make([]byte, chunkEnd-numBytesSent)
+		copy(chunkOutput, output[numBytesSent:chunkEnd])
+		if err := writer.sendChunk(binding_constructors.NewExecCommandStreamedOutputChunkFromOutput(chunkOutput)); err != nil {
+			return numBytesSent, stacktrace.Propagate(err, "An error occurred sending a chunk of exec output to the client")
+		}
+		numBytesSent = chunkEnd
+	}
+	return numBytesSent, nil
+}
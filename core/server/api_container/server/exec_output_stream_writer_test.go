@@ -0,0 +1,53 @@
+package server
+
+import (
+	"bytes"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExecOutputStreamWriter_SplitsBigWritesIntoChunks(t *testing.T) {
+	chunks := []*kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk{}
+	writer := newExecOutputStreamWriter(func(chunk *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+
+	output := bytes.Repeat([]byte("a"), 2*maxExecOutputChunkSizeBytes+1)
+	numBytesWritten, err := writer.Write(output)
+	require.NoError(t, err)
+	require.Equal(t, len(output), numBytesWritten)
+
+	require.Len(t, chunks, 3)
+	require.Len(t, chunks[0].GetOutput(), maxExecOutputChunkSizeBytes)
+	require.Len(t, chunks[1].GetOutput(), maxExecOutputChunkSizeBytes)
+	require.Len(t, chunks[2].GetOutput(), 1)
+}
+
+func TestExecOutputStreamWriter_ChunksDontShareTheWrittenSlice(t *testing.T) {
+	chunks := []*kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk{}
+	writer := newExecOutputStreamWriter(func(chunk *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+
+	output := []byte("hello")
+	_, err := writer.Write(output)
+	require.NoError(t, err)
+	copy(output, "world")
+
+	require.Len(t, chunks, 1)
+	require.Equal(t, []byte("hello"), chunks[0].GetOutput())
+}
+
+func TestExecOutputStreamWriter_FailsWhenTheChunkCantBeSent(t *testing.T) {
+	writer := newExecOutputStreamWriter(func(chunk *kurtosis_core_rpc_api_bindings.ExecCommandStreamedOutputChunk) error {
+		return stacktrace.NewError("The client went away")
+	})
+
+	numBytesWritten, err := writer.Write([]byte("hello"))
+	require.Error(t, err)
+	require.Equal(t, 0, numBytesWritten)
+}
@@ -0,0 +1,68 @@
+package run_profiles
+
+import (
+	"context"
+	"sync"
+)
+
+type contextKey string
+
+const (
+	recorderContextKey contextKey = "instruction-resources-recorder"
+)
+
+// InstructionRecorder adds up the resources the instruction being executed consumes, as reported by the code doing
+// the work through the context of the instruction
+type InstructionRecorder struct {
+	mutex                    *sync.Mutex
+	numContainersCreated     uint32
+	artifactBytesTransferred uint64
+}
+
+func NewInstructionRecorder() *InstructionRecorder {
+	return &InstructionRecorder{
+		mutex:                    &sync.Mutex{},
+		numContainersCreated:     0,
+		artifactBytesTransferred: 0,
+	}
+}
+
+func (recorder *InstructionRecorder) GetNumContainersCreated() uint32 {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.numContainersCreated
+}
+
+func (recorder *InstructionRecorder) GetArtifactBytesTransferred() uint64 {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.artifactBytesTransferred
+}
+
+func WithInstructionRecorder(ctx context.Context, recorder *InstructionRecorder) context.Context {
+	return context.WithValue(ctx, recorderContextKey, recorder)
+}
+
+// RecordContainersCreated records service containers started by the instruction being executed with the context, if
+// it has a recorder
+func RecordContainersCreated(ctx context.Context, numContainers uint32) {
+	recorder, found := ctx.Value(recorderContextKey).(*InstructionRecorder)
+	if !found {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.numContainersCreated += numContainers
+}
+
+// RecordArtifactBytesTransferred records the size of a files artifact stored by the instruction being executed with
+// the context, if it has a recorder
+func RecordArtifactBytesTransferred(ctx context.Context, numBytes uint64) {
+	recorder, found := ctx.Value(recorderContextKey).(*InstructionRecorder)
+	if !found {
+		return
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.artifactBytesTransferred += numBytes
+}
@@ -0,0 +1,59 @@
+package run_profiles
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"sync"
+)
+
+const (
+	// Profiles are small, but an enclave running the same package in a loop shouldn't grow the API container memory
+	DefaultMaxNumRunProfiles = 50
+)
+
+// RunProfileStore keeps the profiles of the most recent Starlark runs of the enclave, for users to find out after
+// the fact where a run spent its time
+type RunProfileStore struct {
+	mutex *sync.RWMutex
+
+	maxNumRunProfiles int
+
+	// From the oldest to the most recent run
+	runProfiles []*kurtosis_core_rpc_api_bindings.StarlarkRunProfile
+}
+
+func NewRunProfileStore(maxNumRunProfiles int) *RunProfileStore {
+	return &RunProfileStore{
+		mutex:             &sync.RWMutex{},
+		maxNumRunProfiles: maxNumRunProfiles,
+		runProfiles:       []*kurtosis_core_rpc_api_bindings.StarlarkRunProfile{},
+	}
+}
+
+// AddRunProfile stores the profile of a run, forgetting the oldest one if the store is full
+func (store *RunProfileStore) AddRunProfile(runProfile *kurtosis_core_rpc_api_bindings.StarlarkRunProfile) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.runProfiles = append(store.runProfiles, runProfile)
+	if numRunProfilesOverLimit := len(store.runProfiles) - store.maxNumRunProfiles; numRunProfilesOverLimit > 0 {
+		store.runProfiles = store.runProfiles[numRunProfilesOverLimit:]
+	}
+}
+
+// GetRunProfile returns the profile of the run with the given ID, or of the most recent run if the ID is empty
+func (store *RunProfileStore) GetRunProfile(runId string) (*kurtosis_core_rpc_api_bindings.StarlarkRunProfile, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	if len(store.runProfiles) == 0 {
+		return nil, stacktrace.NewError("No Starlark run got executed in the enclave yet")
+	}
+	if runId == "" {
+		return store.runProfiles[len(store.runProfiles)-1], nil
+	}
+	for _, runProfile := range store.runProfiles {
+		if runProfile.GetRunId() == runId {
+			return runProfile, nil
+		}
+	}
+	return nil, stacktrace.NewError("No profile was found for run '%v'; only the %d most recent runs are kept", runId, store.maxNumRunProfiles)
+}
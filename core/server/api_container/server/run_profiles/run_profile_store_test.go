@@ -0,0 +1,45 @@
+package run_profiles
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetRunProfile(t *testing.T) {
+	store := NewRunProfileStore(2)
+	_, err := store.GetRunProfile("")
+	require.Error(t, err)
+
+	for _, runId := range []string{"run-1", "run-2", "run-3"} {
+		store.AddRunProfile(&kurtosis_core_rpc_api_bindings.StarlarkRunProfile{RunId: runId}) //nolint:exhaustruct
+	}
+
+	latestRunProfile, err := store.GetRunProfile("")
+	require.NoError(t, err)
+	require.Equal(t, "run-3", latestRunProfile.GetRunId())
+
+	runProfile, err := store.GetRunProfile("run-2")
+	require.NoError(t, err)
+	require.Equal(t, "run-2", runProfile.GetRunId())
+
+	// The oldest run got forgotten to keep the store under its limit
+	_, err = store.GetRunProfile("run-1")
+	require.Error(t, err)
+}
+
+func TestRecord(t *testing.T) {
+	recorder := NewInstructionRecorder()
+	ctx := WithInstructionRecorder(context.Background(), recorder)
+
+	RecordContainersCreated(ctx, 2)
+	RecordContainersCreated(ctx, 1)
+	RecordArtifactBytesTransferred(ctx, 1024)
+	require.Equal(t, uint32(3), recorder.GetNumContainersCreated())
+	require.Equal(t, uint64(1024), recorder.GetArtifactBytesTransferred())
+
+	require.NotPanics(t, func() {
+		RecordContainersCreated(context.Background(), 1)
+	})
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+// runtimeLogLevelSetter changes the level of the global logger while the API container is running, optionally
+// restoring the previous level after a while so that debug logging can be turned on just for the duration of a repro
+type runtimeLogLevelSetter struct {
+	mutex *sync.Mutex
+
+	// Nil if no revert is pending
+	revertTimer *time.Timer
+}
+
+func newRuntimeLogLevelSetter() *runtimeLogLevelSetter {
+	return &runtimeLogLevelSetter{
+		mutex:       &sync.Mutex{},
+		revertTimer: nil,
+	}
+}
+
+// setLogLevel returns the log level before the change; a revertAfter of zero makes the change permanent
+func (setter *runtimeLogLevelSetter) setLogLevel(logLevelStr string, revertAfter time.Duration) (logrus.Level, error) {
+	newLogLevel, err := logrus.ParseLevel(logLevelStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing the log level string '%v'", logLevelStr)
+	}
+
+	setter.mutex.Lock()
+	defer setter.mutex.Unlock()
+
+	// A pending revert would restore the level from before the previous change, so this change supersedes it
+	if setter.revertTimer != nil {
+		setter.revertTimer.Stop()
+		setter.revertTimer = nil
+	}
+
+	previousLogLevel := logrus.GetLevel()
+	logrus.SetLevel(newLogLevel)
+	logrus.Infof("Log level changed from '%v' to '%v'", previousLogLevel, newLogLevel)
+
+	if revertAfter > 0 {
+		var revertTimer *time.Timer
+		revertTimer = time.AfterFunc(revertAfter, func() {
+			setter.mutex.Lock()
+			defer setter.mutex.Unlock()
+			// The timer may have fired right as another change was stopping it
+			if setter.revertTimer != revertTimer {
+				return
+			}
+			logrus.SetLevel(previousLogLevel)
+			logrus.Infof("Log level reverted to '%v' after %v", previousLogLevel, revertAfter)
+			setter.revertTimer = nil
+		})
+		setter.revertTimer = revertTimer
+	}
+	return previousLogLevel, nil
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestSetLogLevel_RevertsAfterDuration(t *testing.T) {
+	initialLogLevel := logrus.GetLevel()
+	defer logrus.SetLevel(initialLogLevel)
+	logrus.SetLevel(logrus.InfoLevel)
+
+	setter := newRuntimeLogLevelSetter()
+	previousLogLevel, err := setter.setLogLevel("debug", 10*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, logrus.InfoLevel, previousLogLevel)
+	require.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+
+	require.Eventually(t, func() bool {
+		return logrus.GetLevel() == logrus.InfoLevel
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSetLogLevel_NewChangeCancelsPendingRevert(t *testing.T) {
+	initialLogLevel := logrus.GetLevel()
+	defer logrus.SetLevel(initialLogLevel)
+	logrus.SetLevel(logrus.InfoLevel)
+
+	setter := newRuntimeLogLevelSetter()
+	_, err := setter.setLogLevel("debug", 10*time.Millisecond)
+	require.NoError(t, err)
+	previousLogLevel, err := setter.setLogLevel("trace", 0)
+	require.NoError(t, err)
+	require.Equal(t, logrus.DebugLevel, previousLogLevel)
+
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, logrus.TraceLevel, logrus.GetLevel())
+}
+
+func TestSetLogLevel_InvalidLevel(t *testing.T) {
+	setter := newRuntimeLogLevelSetter()
+	_, err := setter.setLogLevel("verbose", 0)
+	require.Error(t, err)
+}
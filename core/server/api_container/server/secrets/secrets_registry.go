@@ -0,0 +1,102 @@
+package secrets
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	RedactedValuePlaceholder = "<REDACTED>"
+
+	// Shorter values are too likely to be a substring of unrelated text (e.g. a port number or a boolean) to be redacted
+	minSecretValueLength = 4
+)
+
+var (
+	// Names of environment variables, flags and keys whose values are treated as secrets
+	sensitiveKeyPattern    = `[\w.-]*(?i:password|passwd|secret|token|api[_-]?key|private[_-]?key|credential)[\w.-]*`
+	sensitiveKeyExactRegex = regexp.MustCompile(`^` + sensitiveKeyPattern + `$`)
+
+	// Matches 'KEY=value', 'KEY: value' and 'KEY:value' (the latter being how Go prints maps) as well as
+	// '--key value', for every sensitive key
+	sensitiveKeyValueRegex = regexp.MustCompile(`(` + sensitiveKeyPattern + `)(\s*[=:]\s*|\s+)("[^"]*"|[^\s,\]}]+)`)
+	sensitiveFlagPrefix    = "-"
+
+	defaultSecretsRegistry = NewSecretsRegistry()
+)
+
+// SecretsRegistry keeps track of the secret values that services were configured with, so that they can be redacted
+// from the errors and logs that leave the API container
+type SecretsRegistry struct {
+	mutex *sync.RWMutex
+
+	secretValues map[string]bool
+}
+
+func NewSecretsRegistry() *SecretsRegistry {
+	return &SecretsRegistry{
+		mutex:        &sync.RWMutex{},
+		secretValues: map[string]bool{},
+	}
+}
+
+// GetSecretsRegistry returns the registry shared by everything in the API container that needs to redact secrets
+func GetSecretsRegistry() *SecretsRegistry {
+	return defaultSecretsRegistry
+}
+
+// RegisterSecret marks the value as a secret; values too short to be safely redacted are ignored
+func (registry *SecretsRegistry) RegisterSecret(value string) {
+	if len(value) < minSecretValueLength {
+		return
+	}
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.secretValues[value] = true
+}
+
+// RegisterSecretsFromEnvVars registers the values of the environment variables whose names look sensitive
+// (e.g. 'POSTGRES_PASSWORD' or 'GITHUB_TOKEN')
+func (registry *SecretsRegistry) RegisterSecretsFromEnvVars(envVars map[string]string) {
+	for key, value := range envVars {
+		if IsSensitiveKey(key) {
+			registry.RegisterSecret(value)
+		}
+	}
+}
+
+// Redact replaces every registered secret value, and the value of every sensitive 'key=value' pair, in the string
+func (registry *SecretsRegistry) Redact(str string) string {
+	registry.mutex.RLock()
+	secretValues := make([]string, 0, len(registry.secretValues))
+	for value := range registry.secretValues {
+		secretValues = append(secretValues, value)
+	}
+	registry.mutex.RUnlock()
+
+	// Longest first so that a secret containing another one gets fully redacted
+	sort.Slice(secretValues, func(i, j int) bool {
+		return len(secretValues[i]) > len(secretValues[j])
+	})
+	result := str
+	for _, value := range secretValues {
+		result = strings.ReplaceAll(result, value, RedactedValuePlaceholder)
+	}
+
+	return sensitiveKeyValueRegex.ReplaceAllStringFunc(result, func(match string) string {
+		submatches := sensitiveKeyValueRegex.FindStringSubmatch(match)
+		key, separator := submatches[1], submatches[2]
+		// A whitespace separator only denotes a value for flags; otherwise it's just prose like 'the token expired'
+		if strings.TrimSpace(separator) == "" && !strings.HasPrefix(key, sensitiveFlagPrefix) {
+			return match
+		}
+		return key + separator + RedactedValuePlaceholder
+	})
+}
+
+// IsSensitiveKey returns true if the name of an environment variable, flag or key suggests its value is a secret
+func IsSensitiveKey(key string) bool {
+	return sensitiveKeyExactRegex.MatchString(key)
+}
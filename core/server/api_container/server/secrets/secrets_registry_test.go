@@ -0,0 +1,43 @@
+package secrets
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRedact_RegisteredSecretsFromEnvVars(t *testing.T) {
+	registry := NewSecretsRegistry()
+	registry.RegisterSecretsFromEnvVars(map[string]string{
+		"POSTGRES_PASSWORD": "hunter2-hunter2",
+		"POSTGRES_USER":     "postgres",
+	})
+
+	redacted := registry.Redact("An error occurred starting the service with config map[POSTGRES_PASSWORD:hunter2-hunter2 POSTGRES_USER:postgres] and command 'psql hunter2-hunter2'")
+	require.Equal(t, "An error occurred starting the service with config map[POSTGRES_PASSWORD:<REDACTED> POSTGRES_USER:postgres] and command 'psql <REDACTED>'", redacted)
+}
+
+func TestRedact_SensitiveKeyValuePairs(t *testing.T) {
+	registry := NewSecretsRegistry()
+
+	require.Equal(t, "--api-key <REDACTED> --verbose", registry.Redact("--api-key abcdef --verbose"))
+	require.Equal(t, "GITHUB_TOKEN=<REDACTED>, PORT=8080", registry.Redact("GITHUB_TOKEN=ghp_abcdef, PORT=8080"))
+	require.Equal(t, `secret: <REDACTED>`, registry.Redact(`secret: "with spaces"`))
+	require.Equal(t, "the token expired", registry.Redact("the token expired"))
+}
+
+func TestRegisterSecret_IgnoresShortValues(t *testing.T) {
+	registry := NewSecretsRegistry()
+	registry.RegisterSecretsFromEnvVars(map[string]string{
+		"DB_PASSWORD": "1",
+	})
+
+	require.Equal(t, "listening on port 1", registry.Redact("listening on port 1"))
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	require.True(t, IsSensitiveKey("AWS_SECRET_ACCESS_KEY"))
+	require.True(t, IsSensitiveKey("private_key"))
+	require.True(t, IsSensitiveKey("Password"))
+	require.False(t, IsSensitiveKey("PORT"))
+	require.False(t, IsSensitiveKey("KEYCLOAK_URL"))
+}
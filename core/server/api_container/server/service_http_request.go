@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultServiceHttpRequestMethod  = http.MethodGet
+	defaultServiceHttpRequestTimeout = 30 * time.Second
+
+	// Same limit as the exec output, to keep the responses of the API container reasonably small
+	maxServiceHttpResponseBodySizeBytes = maxLogOutputSizeBytes
+
+	httpsApplicationProtocol = "https"
+	httpScheme               = "http"
+	httpsScheme              = "https"
+)
+
+func getServiceHttpRequestUrl(privateIp net.IP, port *port_spec.PortSpec, useHttps bool, path string) string {
+	scheme := httpScheme
+	maybeApplicationProtocol := port.GetMaybeApplicationProtocol()
+	if useHttps || (maybeApplicationProtocol != nil && strings.EqualFold(*maybeApplicationProtocol, httpsApplicationProtocol)) {
+		scheme = httpsScheme
+	}
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fmt.Sprintf("%v://%v%v", scheme, net.JoinHostPort(privateIp.String(), fmt.Sprint(port.GetNumber())), path)
+}
+
+// sendServiceHttpRequest sends the request and reads its response, timing the connection, the first byte and the whole
+// exchange like curl does
+func sendServiceHttpRequest(ctx context.Context, url string, args *kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs) (*kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse, error) {
+	timeout := defaultServiceHttpRequestTimeout
+	if args.GetTimeoutSeconds() > 0 {
+		timeout = time.Duration(args.GetTimeoutSeconds()) * time.Second
+	}
+	requestCtx, cancelRequestCtx := context.WithTimeout(ctx, timeout)
+	defer cancelRequestCtx()
+
+	method := defaultServiceHttpRequestMethod
+	if args.GetMethod() != "" {
+		method = strings.ToUpper(args.GetMethod())
+	}
+
+	var connectionStartTime, connectionEndTime, firstByteTime time.Time
+	// Suppressing exhaustruct requirement because only the hooks needed for the timings are set
+	// nolint: exhaustruct
+	trace := &httptrace.ClientTrace{
+		ConnectStart: func(_, _ string) {
+			connectionStartTime = time.Now()
+		},
+		GotConn: func(_ httptrace.GotConnInfo) {
+			connectionEndTime = time.Now()
+		},
+		GotFirstResponseByte: func() {
+			firstByteTime = time.Now()
+		},
+	}
+	request, err := http.NewRequestWithContext(httptrace.WithClientTrace(requestCtx, trace), method, url, strings.NewReader(args.GetBody()))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the '%v' request to '%v'", method, url)
+	}
+	for headerName, headerValue := range args.GetHeaders() {
+		request.Header.Set(headerName, headerValue)
+	}
+
+	// A dedicated transport so that each request opens its own connection, and its timings are always complete
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableKeepAlives = true
+	// Suppressing exhaustruct requirement because this struct has ~30 properties
+	// nolint: exhaustruct, gosec
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: args.GetSkipTlsVerification(),
+	}
+	client := &http.Client{
+		Transport:     transport,
+		CheckRedirect: nil,
+		Jar:           nil,
+		// The request context carries the timeout
+		Timeout: 0,
+	}
+	startTime := time.Now()
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the '%v' request to '%v'", method, url)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxServiceHttpResponseBodySizeBytes+1))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the body of the response to the '%v' request to '%v'", method, url)
+	}
+	endTime := time.Now()
+	isBodyTruncated := len(body) > maxServiceHttpResponseBodySizeBytes
+	if isBodyTruncated {
+		body = body[:maxServiceHttpResponseBodySizeBytes]
+	}
+
+	return &kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse{
+		Url:                      url,
+		Status:                   response.Status,
+		StatusCode:               int32(response.StatusCode),
+		Headers:                  getSortedHttpHeaders(response.Header),
+		Body:                     body,
+		IsBodyTruncated:          isBodyTruncated,
+		ConnectionDurationMicros: getDurationMicros(connectionStartTime, connectionEndTime),
+		TimeToFirstByteMicros:    getDurationMicros(startTime, firstByteTime),
+		TotalDurationMicros:      getDurationMicros(startTime, endTime),
+	}, nil
+}
+
+func getSortedHttpHeaders(header http.Header) []*kurtosis_core_rpc_api_bindings.HttpHeader {
+	headerNames := []string{}
+	for headerName := range header {
+		headerNames = append(headerNames, headerName)
+	}
+	sort.Strings(headerNames)
+
+	result := []*kurtosis_core_rpc_api_bindings.HttpHeader{}
+	for _, headerName := range headerNames {
+		for _, headerValue := range header[headerName] {
+			result = append(result, &kurtosis_core_rpc_api_bindings.HttpHeader{
+				Name:  headerName,
+				Value: headerValue,
+			})
+		}
+	}
+	return result
+}
+
+// getDurationMicros returns 0 if either time wasn't recorded
+func getDurationMicros(startTime time.Time, endTime time.Time) uint64 {
+	if startTime.IsZero() || endTime.IsZero() || endTime.Before(startTime) {
+		return 0
+	}
+	return uint64(endTime.Sub(startTime).Microseconds())
+}
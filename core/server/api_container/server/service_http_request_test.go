@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetServiceHttpRequestUrl(t *testing.T) {
+	privateIp := net.ParseIP("172.16.0.4")
+	httpPort, err := port_spec.NewPortSpec(8080, port_spec.TransportProtocol_TCP, "http")
+	require.NoError(t, err)
+	httpsPort, err := port_spec.NewPortSpec(8443, port_spec.TransportProtocol_TCP, "HTTPS")
+	require.NoError(t, err)
+
+	require.Equal(t, "http://172.16.0.4:8080/health?verbose=true", getServiceHttpRequestUrl(privateIp, httpPort, false, "/health?verbose=true"))
+	require.Equal(t, "http://172.16.0.4:8080/health", getServiceHttpRequestUrl(privateIp, httpPort, false, "health"))
+	require.Equal(t, "http://172.16.0.4:8080", getServiceHttpRequestUrl(privateIp, httpPort, false, ""))
+	require.Equal(t, "https://172.16.0.4:8080/", getServiceHttpRequestUrl(privateIp, httpPort, true, "/"))
+	require.Equal(t, "https://172.16.0.4:8443/", getServiceHttpRequestUrl(privateIp, httpsPort, false, "/"))
+}
+
+func TestSendServiceHttpRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestBody, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		writer.Header().Add("X-Method", request.Method)
+		writer.Header().Add("X-Echo", request.Header.Get("X-Input"))
+		writer.Header().Add("X-Echo", "second")
+		writer.WriteHeader(http.StatusCreated)
+		_, err = writer.Write(requestBody)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	args := &kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs{
+		ServiceIdentifier:   "service",
+		PortId:              "http",
+		Method:              "post",
+		Path:                "/",
+		Headers:             map[string]string{"X-Input": "first"},
+		Body:                "hello",
+		UseHttps:            false,
+		SkipTlsVerification: false,
+		TimeoutSeconds:      0,
+	}
+	response, err := sendServiceHttpRequest(context.Background(), server.URL, args)
+	require.NoError(t, err)
+
+	require.Equal(t, int32(http.StatusCreated), response.GetStatusCode())
+	require.Equal(t, "201 Created", response.GetStatus())
+	require.Equal(t, []byte("hello"), response.GetBody())
+	require.False(t, response.GetIsBodyTruncated())
+	headerValues := map[string][]string{}
+	for _, header := range response.GetHeaders() {
+		headerValues[header.GetName()] = append(headerValues[header.GetName()], header.GetValue())
+	}
+	require.Equal(t, []string{"first", "second"}, headerValues["X-Echo"])
+	require.Equal(t, []string{http.MethodPost}, headerValues["X-Method"])
+	require.NotZero(t, response.GetTotalDurationMicros())
+	require.LessOrEqual(t, response.GetTimeToFirstByteMicros(), response.GetTotalDurationMicros())
+}
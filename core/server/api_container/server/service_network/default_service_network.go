@@ -6,6 +6,7 @@
 package service_network
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
@@ -15,27 +16,36 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/files_artifacts_expansion"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/log_correlation"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/run_profiles"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secrets"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 	"io"
 	"math"
 	"net"
 	"net/http"
 	"os"
 	"path"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 )
 
 const (
@@ -56,6 +66,14 @@ const (
 	exactlyOneShortenedUuidMatch = 1
 
 	singleServiceStartupBatch = 1
+
+	defaultNumConnectivityProbes     = 3
+	connectivityProbeIntervalSeconds = 0.2
+	connectivityProbeTimeoutSeconds  = 1
+	connectivityProbeTargetMarker    = "kurtosis-connectivity-probe-target="
+
+	portAvailabilityDialTimeout    = 2 * time.Second
+	portAvailabilityUdpReadTimeout = 500 * time.Millisecond
 )
 
 var (
@@ -67,6 +85,11 @@ var (
 	}
 
 	emptyServiceNamesSetToUpdateAllConnections = map[service.ServiceName]bool{}
+
+	// e.g. '3 packets transmitted, 3 packets received, 0% packet loss'
+	pingPacketLossRegex = regexp.MustCompile(`([0-9.]+)% packet loss`)
+	// e.g. 'round-trip min/avg/max = 0.063/0.075/0.089 ms'
+	pingAvgRoundTripRegex = regexp.MustCompile(`= [0-9.]+/([0-9.]+)/`)
 )
 
 type storeFilesArtifactResult struct {
@@ -74,6 +97,18 @@ type storeFilesArtifactResult struct {
 	filesArtifactUuid enclave_data_directory.FilesArtifactUUID
 }
 
+// byteCountingReader counts the bytes read through it, to know the size of files artifacts streamed into the store
+type byteCountingReader struct {
+	reader   io.Reader
+	numBytes uint64
+}
+
+func (countingReader *byteCountingReader) Read(p []byte) (int, error) {
+	numBytesRead, err := countingReader.reader.Read(p)
+	countingReader.numBytes += uint64(numBytesRead)
+	return numBytesRead, err
+}
+
 // DefaultServiceNetwork is the in-memory representation of the service network that the API container will manipulate.
 // To make any changes to the test network, this struct must be used.
 type DefaultServiceNetwork struct {
@@ -83,11 +118,20 @@ type DefaultServiceNetwork struct {
 	apiContainerGrpcPortNum uint16
 	apiContainerVersion     string
 
-	mutex *sync.Mutex // VERY IMPORTANT TO CHECK AT THE START OF EVERY METHOD!
+	// Guards the topology and the maps describing the services below. It's only held for as long as those get read or
+	// changed, while the operations on the containers of the services are serialized by the lock of each service, so
+	// that a long operation on one service doesn't block the operations on the others.
+	// To avoid deadlocks, the locks of the services are always taken BEFORE this one, never while holding it
+	mutex *sync.RWMutex
+
+	serviceLocks *serviceLocks
 
 	// Whether partitioning has been enabled for this particular test
 	isPartitioningEnabled bool
 
+	// Whether the enclave was created allowing its services to bind-mount directories of the host
+	areHostDirMountsAllowed bool
+
 	kurtosisBackend backend_interface.KurtosisBackend
 
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory
@@ -100,12 +144,24 @@ type DefaultServiceNetwork struct {
 	networkingSidecars  map[service.ServiceName]networking_sidecar.NetworkingSidecarWrapper
 	networkSidecarsLock *sync.Mutex
 
+	// The services whose containers are getting started without the network lock being held. They have no container
+	// to apply connections to yet, so the topology updates made in the meantime skip them; they get their connections
+	// applied by the start itself once their container is up
+	servicesBeingStarted     map[service.ServiceName]bool
+	servicesBeingStartedLock *sync.Mutex
+
 	networkingSidecarManager networking_sidecar.NetworkingSidecarManager
 
 	// Technically we SHOULD query the backend rather than ever storing any of this information, but we're able to get away with
 	// this because the API container is the only client that modifies service state
 	registeredServiceInfo map[service.ServiceName]*service.ServiceRegistration
 
+	// The configs the services were started with, kept so the enclave can be exported declaratively
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+
+	// When the services entered running and passed their ready conditions, kept to track how long environments take to come up
+	serviceStartupTimes map[service.ServiceName]*service_network_types.ServiceStartupTimes
+
 	// This contains all service identifiers ever successfully created, this is append only
 	allExistingAndHistoricalIdentifiers []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers
 }
@@ -116,6 +172,7 @@ func NewDefaultServiceNetwork(
 	apiContainerGrpcPortNum uint16,
 	apiContainerVersion string,
 	isPartitioningEnabled bool,
+	areHostDirMountsAllowed bool,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	networkingSidecarManager networking_sidecar.NetworkingSidecarManager,
@@ -134,15 +191,21 @@ func NewDefaultServiceNetwork(
 		apiContainerIpAddress:               apiContainerIpAddr,
 		apiContainerGrpcPortNum:             apiContainerGrpcPortNum,
 		apiContainerVersion:                 apiContainerVersion,
-		mutex:                               &sync.Mutex{},
+		mutex:                               &sync.RWMutex{},
+		serviceLocks:                        newServiceLocks(),
 		isPartitioningEnabled:               isPartitioningEnabled,
+		areHostDirMountsAllowed:             areHostDirMountsAllowed,
 		kurtosisBackend:                     kurtosisBackend,
 		enclaveDataDir:                      enclaveDataDir,
 		topology:                            networkTopology,
 		networkingSidecars:                  map[service.ServiceName]networking_sidecar.NetworkingSidecarWrapper{},
 		networkSidecarsLock:                 &sync.Mutex{},
+		servicesBeingStarted:                map[service.ServiceName]bool{},
+		servicesBeingStartedLock:            &sync.Mutex{},
 		networkingSidecarManager:            networkingSidecarManager,
 		registeredServiceInfo:               map[service.ServiceName]*service.ServiceRegistration{},
+		serviceConfigs:                      map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{},
+		serviceStartupTimes:                 map[service.ServiceName]*service_network_types.ServiceStartupTimes{},
 		allExistingAndHistoricalIdentifiers: []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers{},
 	}, nil
 }
@@ -173,6 +236,70 @@ func (network *DefaultServiceNetwork) Repartition(
 	return nil
 }
 
+// AddPartition creates a new partition with the default connection to every other partition and moves the given
+// services to it. Unlike Repartition, it only updates the sidecars of the services whose connections change
+func (network *DefaultServiceNetwork) AddPartition(
+	ctx context.Context,
+	partitionId service_network_types.PartitionID,
+	serviceNames map[service.ServiceName]bool,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	isOperationSuccessful := false
+
+	if !network.isPartitioningEnabled {
+		return stacktrace.NewError("Cannot add partition; partitioning is not enabled")
+	}
+
+	if err := network.topology.CreateEmptyPartitionWithDefaultConnection(partitionId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating partition '%s'", partitionId)
+	}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		if err := network.topology.RemovePartition(partitionId); err != nil {
+			logrus.Errorf("Partition '%s' was created as part of an AddPartition call, but due to a failure "+
+				"it should be removed. Unfortunately, the removal failed for the following reason so the "+
+				"partition will remain in place:\n%v", partitionId, err.Error())
+		}
+	}()
+
+	if err := network.moveServicesToPartitionUnlocked(ctx, partitionId, serviceNames); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving services '%v' to new partition '%s'", serviceNames, partitionId)
+	}
+	isOperationSuccessful = true
+	return nil
+}
+
+// MovePartition moves the given services to an existing partition. Unlike Repartition, it only updates the sidecars
+// of the services whose connections change
+func (network *DefaultServiceNetwork) MovePartition(
+	ctx context.Context,
+	partitionId service_network_types.PartitionID,
+	serviceNames map[service.ServiceName]bool,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if !network.isPartitioningEnabled {
+		return stacktrace.NewError("Cannot move services to partition; partitioning is not enabled")
+	}
+
+	currentPartitions, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all partitions")
+	}
+	if _, found := currentPartitions[partitionId]; !found {
+		return stacktrace.NewError("Cannot move services '%v' to partition '%s' as the partition doesn't exist", serviceNames, partitionId)
+	}
+
+	if err = network.moveServicesToPartitionUnlocked(ctx, partitionId, serviceNames); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving services '%v' to partition '%s'", serviceNames, partitionId)
+	}
+	return nil
+}
+
 func (network *DefaultServiceNetwork) SetConnection(
 	ctx context.Context,
 	partition1 service_network_types.PartitionID,
@@ -241,7 +368,7 @@ func (network *DefaultServiceNetwork) SetConnection(
 		}
 	}()
 
-	if err = network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
+	if err = network.updateConnectionsOfPartitionsFromTopology(ctx, map[service_network_types.PartitionID]bool{partition1: true, partition2: true}); err != nil {
 		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
 	isOperationSuccessful = true
@@ -295,7 +422,7 @@ func (network *DefaultServiceNetwork) UnsetConnection(
 		}
 	}()
 
-	if err = network.updateConnectionsFromTopology(ctx, emptyServiceNamesSetToUpdateAllConnections); err != nil {
+	if err = network.updateConnectionsOfPartitionsFromTopology(ctx, map[service_network_types.PartitionID]bool{partition1: true, partition2: true}); err != nil {
 		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
 	isOperationSuccessful = true
@@ -376,8 +503,14 @@ func (network *DefaultServiceNetwork) StartServices(
 	map[service.ServiceName]error,
 	error,
 ) {
+	serviceNames := make([]service.ServiceName, 0, len(serviceConfigs))
+	for serviceName := range serviceConfigs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	unlockServices := network.serviceLocks.lock(serviceNames...)
+	defer unlockServices()
+
 	network.mutex.Lock()
-	defer network.mutex.Unlock()
 	batchSuccessfullyStarted := false
 	startedServices := map[service.ServiceName]*service.Service{}
 	failedServices := map[service.ServiceName]error{}
@@ -388,22 +521,29 @@ func (network *DefaultServiceNetwork) StartServices(
 		currentlyRunningServicesInEnclave[serviceName] = true
 	}
 
-	// We register all the services one by one
-	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
-	servicesToStart := map[service.ServiceUUID]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	// We register all the services at once
+	servicePartitionIds := map[service.ServiceName]service_network_types.PartitionID{}
 	for serviceName, serviceConfig := range serviceConfigs {
-		servicePartitionId := partition_topology.ParsePartitionId(serviceConfig.Subnetwork)
-		serviceRegistration, err := network.registerService(ctx, serviceName, servicePartitionId)
-		if err != nil {
-			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
-		}
-		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
-		servicesToStart[serviceRegistration.GetUUID()] = serviceConfig
+		servicePartitionIds[serviceName] = partition_topology.ParsePartitionId(serviceConfig.Subnetwork)
+	}
+	serviceSuccessfullyRegistered, failedRegistrations, err := network.registerServices(ctx, servicePartitionIds)
+	if err != nil {
+		network.mutex.Unlock()
+		return nil, nil, stacktrace.Propagate(err, "An error occurred registering services '%v'", servicePartitionIds)
+	}
+	for serviceName, registrationErr := range failedRegistrations {
+		failedServices[serviceName] = stacktrace.Propagate(registrationErr, "Failed registering service with name: '%s'", serviceName)
+	}
+	servicesToStart := map[service.ServiceUUID]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	for serviceName, serviceRegistration := range serviceSuccessfullyRegistered {
+		servicesToStart[serviceRegistration.GetUUID()] = serviceConfigs[serviceName]
 	}
 	defer func() {
 		if batchSuccessfullyStarted {
 			return
 		}
+		network.mutex.Lock()
+		defer network.mutex.Unlock()
 		for serviceName := range serviceSuccessfullyRegistered {
 			if err := network.unregisterService(ctx, serviceName); err != nil {
 				logrus.Errorf("Error unregistering service '%s' from the service network. Error was: %v", serviceName, err)
@@ -411,6 +551,7 @@ func (network *DefaultServiceNetwork) StartServices(
 		}
 	}()
 	if len(failedServices) > 0 {
+		network.mutex.Unlock()
 		return map[service.ServiceName]*service.Service{}, failedServices, nil
 	}
 
@@ -418,12 +559,24 @@ func (network *DefaultServiceNetwork) StartServices(
 	// to communicate to services they should not communicate with.
 	if network.isPartitioningEnabled && len(currentlyRunningServicesInEnclave) > 0 {
 		if err := network.updateConnectionsFromTopology(ctx, currentlyRunningServicesInEnclave); err != nil {
+			network.mutex.Unlock()
 			return nil, nil, stacktrace.Propagate(err, "Failure updating the network connections of the existing "+
 				"services prior to starting the new services. Starting the following services will be aborted: %v. "+
 				"Existing services in enclave: '%v'", serviceConfigs, currentlyRunningServicesInEnclave)
 		}
 	}
+	for serviceName := range serviceSuccessfullyRegistered {
+		network.setServiceBeingStarted(serviceName, true)
+	}
+	defer func() {
+		for serviceName := range serviceSuccessfullyRegistered {
+			network.setServiceBeingStarted(serviceName, false)
+		}
+	}()
+	network.mutex.Unlock()
 
+	// The containers get started without holding the network lock, so that the other services can be operated on in
+	// the meantime; the services being started stay locked until they are fully started or rolled back
 	startedServicesPerUuid, failedServicePerUuid := network.startRegisteredServices(ctx, servicesToStart, batchSize)
 
 	for serviceName, serviceRegistration := range serviceSuccessfullyRegistered {
@@ -446,6 +599,8 @@ func (network *DefaultServiceNetwork) StartServices(
 		if batchSuccessfullyStarted {
 			return
 		}
+		network.mutex.Lock()
+		defer network.mutex.Unlock()
 		for serviceName, startedService := range startedServices {
 			if err := network.destroyService(ctx, serviceName, startedService.GetRegistration().GetUUID()); err != nil {
 				logrus.Errorf("One or more services failed to be started for this batch. Kurtosis tries to"+
@@ -457,6 +612,9 @@ func (network *DefaultServiceNetwork) StartServices(
 		return map[service.ServiceName]*service.Service{}, failedServices, nil
 	}
 
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
 	if len(startedServices) != len(serviceConfigs) {
 		var requested []service.ServiceName
 		for serviceName := range serviceConfigs {
@@ -480,11 +638,21 @@ func (network *DefaultServiceNetwork) StartServices(
 		})
 	}
 
+	runningTime := time.Now()
+	for serviceName := range startedServices {
+		network.serviceConfigs[serviceName] = serviceConfigs[serviceName]
+		network.serviceStartupTimes[serviceName] = service_network_types.NewServiceStartupTimes(runningTime, nil)
+		network.addFilesArtifactsReferrerUnlocked(serviceName, serviceConfigs[serviceName])
+	}
+
 	batchSuccessfullyStarted = true
+	run_profiles.RecordContainersCreated(ctx, uint32(len(startedServices)))
 	return startedServices, map[service.ServiceName]error{}, nil
 }
 
 // UpdateService This is purely called from a Starlark context so this only works with Names
+// Updating the image, ports or environment variables of a service recreates its container, but the service keeps its
+// registration, so its UUID and private IP address don't change
 func (network *DefaultServiceNetwork) UpdateService(
 	ctx context.Context,
 	updateServiceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig,
@@ -493,12 +661,36 @@ func (network *DefaultServiceNetwork) UpdateService(
 	map[service.ServiceName]error,
 	error,
 ) {
+	serviceNames := make([]service.ServiceName, 0, len(updateServiceConfigs))
+	for serviceName := range updateServiceConfigs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	unlockServices := network.serviceLocks.lock(serviceNames...)
+	defer unlockServices()
+
+	// The topology is updated along with the containers, so the whole network stays locked
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
 	failedServicesPool := map[service.ServiceName]error{}
 	successfullyUpdatedService := map[service.ServiceName]bool{}
 
+	for serviceName, updateServiceConfig := range updateServiceConfigs {
+		if !doesUpdateReplaceContainer(updateServiceConfig) {
+			continue
+		}
+		if err := network.replaceServiceContainerUnlocked(ctx, serviceName, updateServiceConfig); err != nil {
+			failedServicesPool[serviceName] = stacktrace.Propagate(err, "An error occurred recreating the container of service '%s'", serviceName)
+			continue
+		}
+		run_profiles.RecordContainersCreated(ctx, 1)
+	}
+
 	previousServicePartitions := map[service.ServiceName]service_network_types.PartitionID{}
 	partitionCreatedDuringThisOperation := map[service_network_types.PartitionID]bool{}
 	for serviceName, updateServiceConfig := range updateServiceConfigs {
+		if _, found := failedServicesPool[serviceName]; found {
+			continue
+		}
 		if updateServiceConfig.Subnetwork == nil {
 			// nothing to do for this service
 			continue
@@ -599,11 +791,16 @@ func (network *DefaultServiceNetwork) UpdateService(
 		return nil, nil, stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
 	}
 
-	for serviceName := range updateServiceConfigs {
+	for serviceName, updateServiceConfig := range updateServiceConfigs {
 		if _, found := failedServicesPool[serviceName]; found {
 			continue
 		}
 		successfullyUpdatedService[serviceName] = true
+		if serviceConfig, found := network.serviceConfigs[serviceName]; found && updateServiceConfig.Subnetwork != nil {
+			updatedServiceConfig := proto.Clone(serviceConfig).(*kurtosis_core_rpc_api_bindings.ServiceConfig)
+			updatedServiceConfig.Subnetwork = updateServiceConfig.Subnetwork
+			network.serviceConfigs[serviceName] = updatedServiceConfig
+		}
 	}
 	return successfullyUpdatedService, failedServicesPool, nil
 }
@@ -612,26 +809,24 @@ func (network *DefaultServiceNetwork) RemoveService(
 	ctx context.Context,
 	serviceIdentifier string,
 ) (service.ServiceUUID, error) {
-	network.mutex.Lock()
-	defer network.mutex.Unlock()
-
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	serviceToRemove, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, false)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
-	}
-
-	serviceToRemove, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return "", stacktrace.NewError("No service found with ID '%v'", serviceName)
+		return "", stacktrace.Propagate(err, "An error occurred getting the service to remove for identifier '%v'", serviceIdentifier)
 	}
+	defer unlockService()
+	serviceName := serviceToRemove.GetName()
 	serviceUuid := serviceToRemove.GetUUID()
+	logCtx := log_correlation.WithServiceUuid(ctx, string(serviceUuid))
+	logrus.WithContext(logCtx).Debugf("Removing service '%v'", serviceName)
 
+	network.mutex.Lock()
 	err = network.topology.RemoveService(serviceName)
 	if err != nil {
+		network.mutex.Unlock()
 		return "", stacktrace.Propagate(err, "An error occurred while removing service '%v' from the network topology", serviceName)
 	}
-
 	network.cleanupInternalMapsUnlocked(serviceName)
+	network.mutex.Unlock()
 
 	// We stop the service, rather than destroying it, so that we can keep logs around
 	stopServiceFilters := &service.ServiceFilters{
@@ -649,7 +844,9 @@ func (network *DefaultServiceNetwork) RemoveService(
 		return "", stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceUuid)
 	}
 
+	network.networkSidecarsLock.Lock()
 	sidecar, foundSidecar := network.networkingSidecars[serviceName]
+	network.networkSidecarsLock.Unlock()
 	if network.isPartitioningEnabled && foundSidecar {
 		// NOTE: As of 2020-12-31, we don't need to update the iptables of the other services in the network to
 		//  clear the now-removed service's IP because:
@@ -659,27 +856,113 @@ func (network *DefaultServiceNetwork) RemoveService(
 		if err := network.networkingSidecarManager.Remove(ctx, sidecar); err != nil {
 			return "", stacktrace.Propagate(err, "An error occurred destroying the sidecar for service with name '%v'", serviceName)
 		}
+		network.networkSidecarsLock.Lock()
 		delete(network.networkingSidecars, serviceName)
-		logrus.Debugf("Successfully removed sidecar attached to service with name '%v'", serviceName)
+		network.networkSidecarsLock.Unlock()
+		logrus.WithContext(logCtx).Debugf("Successfully removed sidecar attached to service with name '%v'", serviceName)
 	}
 
 	return serviceUuid, nil
 }
 
-// TODO we could switch this to be a bulk command; the backend would support it
-func (network *DefaultServiceNetwork) PauseService(ctx context.Context, serviceIdentifier string) error {
+// RemoveServices removes all the services with the given identifiers, stopping their containers with a single call to
+// the backend so that they get stopped in parallel rather than one after the other
+func (network *DefaultServiceNetwork) RemoveServices(
+	ctx context.Context,
+	serviceIdentifiers map[string]bool,
+) (
+	map[service.ServiceName]service.ServiceUUID,
+	map[service.ServiceName]error,
+	error,
+) {
+	removedServiceUuids := map[service.ServiceName]service.ServiceUUID{}
+	failedServices := map[service.ServiceName]error{}
+	if len(serviceIdentifiers) == emptyCollectionLength {
+		// empty filters would match all the services of the enclave
+		return removedServiceUuids, failedServices, nil
+	}
+
+	servicesToRemove, unlockServices, err := network.lockServicesForIdentifiers(serviceIdentifiers, false)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services to remove")
+	}
+	defer unlockServices()
+
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	serviceUuidsToStop := map[service.ServiceUUID]bool{}
 	network.mutex.Lock()
-	defer network.mutex.Unlock()
+	for serviceName, serviceToRemove := range servicesToRemove {
+		serviceUuid := serviceToRemove.GetUUID()
+		serviceNamesByUuid[serviceUuid] = serviceName
+		logCtx := log_correlation.WithServiceUuid(ctx, string(serviceUuid))
+		logrus.WithContext(logCtx).Debugf("Removing service '%v'", serviceName)
+		if err := network.topology.RemoveService(serviceName); err != nil {
+			failedServices[serviceName] = stacktrace.Propagate(err, "An error occurred while removing service '%v' from the network topology", serviceName)
+			continue
+		}
+		network.cleanupInternalMapsUnlocked(serviceName)
+		serviceUuidsToStop[serviceUuid] = true
+	}
+	network.mutex.Unlock()
+	if len(serviceUuidsToStop) == emptyCollectionLength {
+		return removedServiceUuids, failedServices, nil
+	}
 
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	// We stop the services, rather than destroying them, so that we can keep logs around
+	stopServiceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuidsToStop,
+		Statuses: nil,
+	}
+	_, erroredUuids, err := network.kurtosisBackend.StopUserServices(ctx, network.enclaveUuid, stopServiceFilters)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
+		return nil, nil, stacktrace.Propagate(err, "An error occurred during the call to stop services '%v'", serviceUuidsToStop)
 	}
 
-	serviceObj, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return stacktrace.NewError("No service with name '%v' exists in the network", serviceName)
+	for serviceUuid := range serviceUuidsToStop {
+		serviceName := serviceNamesByUuid[serviceUuid]
+		if err, found := erroredUuids[serviceUuid]; found {
+			failedServices[serviceName] = stacktrace.Propagate(err, "An error occurred stopping service '%v'", serviceUuid)
+			continue
+		}
+		network.networkSidecarsLock.Lock()
+		sidecar, foundSidecar := network.networkingSidecars[serviceName]
+		network.networkSidecarsLock.Unlock()
+		if network.isPartitioningEnabled && foundSidecar {
+			// See RemoveService for why the iptables of the other services don't need to be updated
+			if err := network.networkingSidecarManager.Remove(ctx, sidecar); err != nil {
+				failedServices[serviceName] = stacktrace.Propagate(err, "An error occurred destroying the sidecar for service with name '%v'", serviceName)
+				continue
+			}
+			network.networkSidecarsLock.Lock()
+			delete(network.networkingSidecars, serviceName)
+			network.networkSidecarsLock.Unlock()
+		}
+		removedServiceUuids[serviceName] = serviceUuid
+	}
+	return removedServiceUuids, failedServices, nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceMetrics(ctx context.Context, serviceIdentifier string) (*service.ServiceMetrics, error) {
+	serviceUuid, err := network.getServiceUuidForIdentifier(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of service '%v'", serviceIdentifier)
+	}
+
+	// The network isn't locked while the container engine samples the usage, as that takes about a second
+	metrics, err := network.kurtosisBackend.GetServiceMetrics(ctx, network.enclaveUuid, serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the metrics of service '%v'", serviceIdentifier)
+	}
+	return metrics, nil
+}
+
+func (network *DefaultServiceNetwork) PauseService(ctx context.Context, serviceIdentifier string) error {
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, false)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceIdentifier)
 	}
+	defer unlockService()
 
 	if err := network.kurtosisBackend.PauseService(ctx, network.enclaveUuid, serviceObj.GetUUID()); err != nil {
 		return stacktrace.Propagate(err, "Failed to pause service '%v'", serviceIdentifier)
@@ -687,20 +970,12 @@ func (network *DefaultServiceNetwork) PauseService(ctx context.Context, serviceI
 	return nil
 }
 
-// TODO we could switch this to be a bulk command; the backend would support it
 func (network *DefaultServiceNetwork) UnpauseService(ctx context.Context, serviceIdentifier string) error {
-	network.mutex.Lock()
-	defer network.mutex.Unlock()
-
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, false)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
-	}
-
-	serviceObj, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return stacktrace.NewError("No service with name '%v' exists in the network", serviceName)
+		return stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceIdentifier)
 	}
+	defer unlockService()
 
 	if err := network.kurtosisBackend.UnpauseService(ctx, network.enclaveUuid, serviceObj.GetUUID()); err != nil {
 		return stacktrace.Propagate(err, "Failed to unpause service '%v'", serviceIdentifier)
@@ -708,27 +983,92 @@ func (network *DefaultServiceNetwork) UnpauseService(ctx context.Context, servic
 	return nil
 }
 
-func (network *DefaultServiceNetwork) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
-	// NOTE: This will block all other operations while this command is running!!!! We might need to change this so it's
-	// asynchronous
+// PauseServices pauses all the services with the given identifiers with a single call to the backend
+func (network *DefaultServiceNetwork) PauseServices(
+	ctx context.Context,
+	serviceIdentifiers map[string]bool,
+) (
+	map[service.ServiceName]bool,
+	map[service.ServiceName]error,
+	error,
+) {
+	successfulServiceNames, failedServiceNames, err := network.runBulkServiceOperation(ctx, serviceIdentifiers, network.kurtosisBackend.PauseServices)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Failed to pause services '%v'", serviceIdentifiers)
+	}
+	return successfulServiceNames, failedServiceNames, nil
+}
+
+// UnpauseServices unpauses all the services with the given identifiers with a single call to the backend
+func (network *DefaultServiceNetwork) UnpauseServices(
+	ctx context.Context,
+	serviceIdentifiers map[string]bool,
+) (
+	map[service.ServiceName]bool,
+	map[service.ServiceName]error,
+	error,
+) {
+	successfulServiceNames, failedServiceNames, err := network.runBulkServiceOperation(ctx, serviceIdentifiers, network.kurtosisBackend.UnpauseServices)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Failed to unpause services '%v'", serviceIdentifiers)
+	}
+	return successfulServiceNames, failedServiceNames, nil
+}
+
+func (network *DefaultServiceNetwork) RestartService(ctx context.Context, serviceIdentifier string) error {
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, false)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceIdentifier)
+	}
+	defer unlockService()
+
+	if err := network.kurtosisBackend.RestartService(ctx, network.enclaveUuid, serviceObj.GetUUID()); err != nil {
+		return stacktrace.Propagate(err, "Failed to restart service '%v'", serviceIdentifier)
+	}
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
+	serviceName := serviceObj.GetName()
+	if _, found := network.serviceStartupTimes[serviceName]; found {
+		network.serviceStartupTimes[serviceName] = service_network_types.NewServiceStartupTimes(time.Now(), nil)
+	}
+	return nil
+}
 
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+func (network *DefaultServiceNetwork) WaitForServiceExit(ctx context.Context, serviceIdentifier string) (int32, error) {
+	serviceUuid, err := network.getServiceUuidForIdentifier(serviceIdentifier)
 	if err != nil {
-		return 0, "", stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
+		return 0, stacktrace.Propagate(err, "An error occurred getting the UUID of service '%v'", serviceIdentifier)
 	}
 
-	serviceObj, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return 0, "", stacktrace.NewError(
-			"Service '%v does not exist in the network",
-			serviceIdentifier,
-		)
+	// The network isn't locked while waiting, as the service can run for as long as it wants
+	exitCode, err := network.kurtosisBackend.WaitForServiceExit(ctx, network.enclaveUuid, serviceUuid)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Failed to wait for service '%v' to exit", serviceIdentifier)
 	}
+	return exitCode, nil
+}
+
+func (network *DefaultServiceNetwork) SendSignalToService(ctx context.Context, serviceIdentifier string, signal string) error {
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, false)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceIdentifier)
+	}
+	defer unlockService()
+
+	if err := network.kurtosisBackend.SendSignalToService(ctx, network.enclaveUuid, serviceObj.GetUUID(), signal); err != nil {
+		return stacktrace.Propagate(err, "Failed to send signal '%v' to service '%v'", signal, serviceIdentifier)
+	}
+	return nil
+}
 
-	// NOTE: This is a SYNCHRONOUS command, meaning that the entire network will be blocked until the command finishes
-	// In the future, this will likely be insufficient
+func (network *DefaultServiceNetwork) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
+	// Only the service the command runs in is locked, and only for reading, so the command doesn't block the
+	// operations on the other services nor the other commands run in the same service
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, true)
+	if err != nil {
+		return 0, "", stacktrace.Propagate(err, "An error occurred getting the service to exec command '%v' against", command)
+	}
+	defer unlockService()
 
 	serviceUuid := serviceObj.GetUUID()
 	userServiceCommand := map[service.ServiceUUID][]string{
@@ -776,6 +1116,39 @@ func (network *DefaultServiceNetwork) ExecCommand(ctx context.Context, serviceId
 	return execResult.GetExitCode(), execResult.GetOutput(), nil
 }
 
+func (network *DefaultServiceNetwork) ExecCommandWithStreamedOutput(ctx context.Context, serviceIdentifier string, command []string, output io.Writer) (int32, error) {
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, true)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the service to exec command '%v' against", command)
+	}
+	defer unlockService()
+
+	exitCode, err := network.kurtosisBackend.RunUserServiceExecCommandWithStreamedOutput(ctx, network.enclaveUuid, serviceObj.GetUUID(), command, output)
+	if err != nil {
+		return 0, stacktrace.Propagate(
+			err,
+			"An error occurred calling kurtosis backend to exec command '%v' against service '%v'",
+			command,
+			serviceIdentifier)
+	}
+	return exitCode, nil
+}
+
+func (network *DefaultServiceNetwork) getServiceUuidForIdentifier(serviceIdentifier string) (service.ServiceUUID, error) {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+
+	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
+	}
+	serviceObj, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return "", stacktrace.NewError("Service '%v' does not exist in the network", serviceIdentifier)
+	}
+	return serviceObj.GetUUID(), nil
+}
+
 func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error) {
 	logrus.Debugf("Making a request '%v' '%v' '%v' '%v' '%v' '%v'", serviceIdentifier, portId, method, contentType, endpoint, body)
 	service, getServiceErr := network.GetService(ctx, serviceIdentifier)
@@ -804,19 +1177,43 @@ func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, se
 	}
 }
 
-func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
-	network.mutex.Lock()
-	defer network.mutex.Unlock()
-
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+func (network *DefaultServiceNetwork) CheckServicePortAvailability(ctx context.Context, serviceIdentifier string, portId string) error {
+	service, err := network.GetService(ctx, serviceIdentifier)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
+		return stacktrace.Propagate(err, "An error occurred when getting service '%v' to check the availability of its port '%v'", serviceIdentifier, portId)
 	}
-
-	registration, found := network.registeredServiceInfo[serviceName]
+	port, found := service.GetPrivatePorts()[portId]
 	if !found {
-		return nil, stacktrace.NewError("No service with name '%v' exists in network", serviceName)
+		return stacktrace.NewError("Service '%v' has no port '%v' to check the availability of", serviceIdentifier, portId)
+	}
+	address := net.JoinHostPort(service.GetRegistration().GetPrivateIP().String(), strconv.Itoa(int(port.GetNumber())))
+	dialer := &net.Dialer{Timeout: portAvailabilityDialTimeout}
+	switch port.GetTransportProtocol() {
+	case port_spec.TransportProtocol_TCP:
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return stacktrace.Propagate(err, "Port '%v' of service '%v' doesn't accept TCP connections on '%v' yet", portId, serviceIdentifier, address)
+		}
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the TCP connection to port '%v' of service '%v':\n%v", portId, serviceIdentifier, err)
+		}
+		return nil
+	case port_spec.TransportProtocol_UDP:
+		if err := checkUdpPortAvailability(ctx, dialer, address); err != nil {
+			return stacktrace.Propagate(err, "Port '%v' of service '%v' doesn't accept UDP datagrams on '%v' yet", portId, serviceIdentifier, address)
+		}
+		return nil
+	default:
+		return stacktrace.NewError("Port '%v' of service '%v' uses transport protocol '%v'; only the availability of TCP and UDP ports can be checked", portId, serviceIdentifier, port.GetTransportProtocol().String())
+	}
+}
+
+func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
+	registration, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, true)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the registration of service '%v'", serviceIdentifier)
 	}
+	defer unlockService()
 	serviceUuid := registration.GetUUID()
 
 	getServiceFilters := &service.ServiceFilters{
@@ -849,6 +1246,8 @@ func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIde
 }
 
 func (network *DefaultServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
 
 	serviceNames := make(map[service.ServiceName]bool, len(network.registeredServiceInfo))
 
@@ -861,32 +1260,326 @@ func (network *DefaultServiceNetwork) GetServiceNames() map[service.ServiceName]
 }
 
 func (network *DefaultServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, true)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred while fetching name for service identifier '%v'", serviceIdentifier)
+		return "", stacktrace.Propagate(err, "An error occurred getting the service to copy files from for identifier '%v'", serviceIdentifier)
 	}
+	defer unlockService()
 
-	filesArtifactUuid, err := network.copyFilesFromServiceUnlocked(ctx, serviceName, srcPath, artifactName)
+	filesArtifactUuid, err := network.copyFilesFromService(ctx, serviceObj, srcPath, artifactName)
 	if err != nil {
 		return "", stacktrace.Propagate(err, "There was an error in copying files over to disk")
 	}
 	return filesArtifactUuid, nil
 }
 
-func (network *DefaultServiceNetwork) GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool) {
-	network.mutex.Lock()
-	defer network.mutex.Unlock()
-	registration, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return nil, false
+// CopyFilesFromServices copies the given path out of each of the given services and stores them all in a single files
+// artifact, where the files of each service live under a directory named after the service
+func (network *DefaultServiceNetwork) CopyFilesFromServices(ctx context.Context, srcPathsByService map[service.ServiceName]string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	serviceNames := make([]service.ServiceName, 0, len(srcPathsByService))
+	for serviceName := range srcPathsByService {
+		serviceNames = append(serviceNames, serviceName)
 	}
-	return registration, true
-}
+	unlockServices := network.serviceLocks.rLock(serviceNames...)
+	defer unlockServices()
 
-func (network *DefaultServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	filesArtifactUuid, err := network.renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath, artifactName)
-	if err != nil {
-		return "", stacktrace.Propagate(err, "There was an error in rendering templates to disk")
+	network.mutex.RLock()
+	serviceUuids := map[service.ServiceName]service.ServiceUUID{}
+	for serviceName := range srcPathsByService {
+		serviceObj, found := network.registeredServiceInfo[serviceName]
+		if !found {
+			network.mutex.RUnlock()
+			return "", stacktrace.NewError("Cannot copy files from service '%v' because it does not exist in the network", serviceName)
+		}
+		serviceUuids[serviceName] = serviceObj.GetUUID()
+	}
+	network.mutex.RUnlock()
+
+	sortedServiceNames := make([]string, 0, len(srcPathsByService))
+	for serviceName := range srcPathsByService {
+		sortedServiceNames = append(sortedServiceNames, string(serviceName))
+	}
+	sort.Strings(sortedServiceNames)
+
+	mergedTgz := &bytes.Buffer{}
+	gzippingOutput := gzip.NewWriter(mergedTgz)
+	mergedTar := tar.NewWriter(gzippingOutput)
+	for _, serviceNameStr := range sortedServiceNames {
+		serviceName := service.ServiceName(serviceNameStr)
+		srcPath := srcPathsByService[serviceName]
+		if err := network.copyFilesFromServiceToTarUnderDir(ctx, serviceUuids[serviceName], srcPath, mergedTar, serviceNameStr); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred copying path '%v' from service '%v'", srcPath, serviceName)
+		}
+	}
+	if err := mergedTar.Close(); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred closing the TAR of the files copied from the services")
+	}
+	if err := gzippingOutput.Close(); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred closing the gzip of the files copied from the services")
+	}
+
+	filesArtifactUuid, err := network.uploadFilesArtifactUnlocked(mergedTgz.Bytes(), artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing the files copied from the services in files artifact '%v'", artifactName)
+	}
+	run_profiles.RecordArtifactBytesTransferred(ctx, uint64(mergedTgz.Len()))
+	return filesArtifactUuid, nil
+}
+
+// CopyFilesArtifactToService extracts the content of the files artifact into the given directory of a running service,
+// which must already exist on the service
+func (network *DefaultServiceNetwork) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, artifactIdentifier string, dstDirpath string) error {
+	serviceObj, unlockService, err := network.lockServiceForIdentifier(serviceIdentifier, false)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service to copy files to for identifier '%v'", serviceIdentifier)
+	}
+	defer unlockService()
+	serviceName := serviceObj.GetName()
+
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+	filesArtifact, err := store.GetFile(artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactIdentifier)
+	}
+	filesArtifactTgz, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the file of files artifact '%v'", artifactIdentifier)
+	}
+	defer filesArtifactTgz.Close()
+
+	if err := network.kurtosisBackend.CopyFilesToUserService(ctx, network.enclaveUuid, serviceObj.GetUUID(), dstDirpath, filesArtifactTgz); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying files artifact '%v' to directory '%v' of service '%v'", artifactIdentifier, dstDirpath, serviceName)
+	}
+	return nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool) {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+	registration, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return nil, false
+	}
+	return registration, true
+}
+
+func (network *DefaultServiceNetwork) GetServiceConfigs() map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+	serviceConfigs := make(map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, len(network.serviceConfigs))
+	for serviceName, serviceConfig := range network.serviceConfigs {
+		serviceConfigs[serviceName] = proto.Clone(serviceConfig).(*kurtosis_core_rpc_api_bindings.ServiceConfig)
+	}
+	return serviceConfigs
+}
+
+func (network *DefaultServiceNetwork) GetScrapeTargets(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error) {
+	network.mutex.RLock()
+	metricsPortIdsByServiceUuid := map[service.ServiceUUID]string{}
+	for serviceName, serviceConfig := range network.serviceConfigs {
+		metricsPortId, found := GetMetricsPortId(serviceConfig)
+		if !found {
+			continue
+		}
+		registration, found := network.registeredServiceInfo[serviceName]
+		if !found {
+			continue
+		}
+		metricsPortIdsByServiceUuid[registration.GetUUID()] = metricsPortId
+	}
+	network.mutex.RUnlock()
+
+	scrapeTargets := []*kurtosis_core_rpc_api_bindings.ScrapeTarget{}
+	if len(metricsPortIdsByServiceUuid) == 0 {
+		return scrapeTargets, nil
+	}
+
+	getServiceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    map[service.ServiceUUID]bool{},
+		Statuses: nil,
+	}
+	for serviceUuid := range metricsPortIdsByServiceUuid {
+		getServiceFilters.UUIDs[serviceUuid] = true
+	}
+	matchingServices, err := network.kurtosisBackend.GetUserServices(ctx, network.enclaveUuid, getServiceFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services that have a metrics port")
+	}
+	for serviceUuid, matchingService := range matchingServices {
+		metricsPortId := metricsPortIdsByServiceUuid[serviceUuid]
+		privatePort, found := matchingService.GetPrivatePorts()[metricsPortId]
+		if !found {
+			continue
+		}
+		privateAddress := net.JoinHostPort(matchingService.GetRegistration().GetPrivateIP().String(), strconv.Itoa(int(privatePort.GetNumber())))
+		publicAddress := ""
+		if publicPort, found := matchingService.GetMaybePublicPorts()[metricsPortId]; found && matchingService.GetMaybePublicIP() != nil {
+			publicAddress = net.JoinHostPort(matchingService.GetMaybePublicIP().String(), strconv.Itoa(int(publicPort.GetNumber())))
+		}
+		scrapeTargets = append(scrapeTargets, &kurtosis_core_rpc_api_bindings.ScrapeTarget{
+			ServiceName:    string(matchingService.GetRegistration().GetName()),
+			ServiceUuid:    string(serviceUuid),
+			MetricsPortId:  metricsPortId,
+			PrivateAddress: privateAddress,
+			PublicAddress:  publicAddress,
+		})
+	}
+	sort.Slice(scrapeTargets, func(i, j int) bool {
+		return scrapeTargets[i].GetServiceName() < scrapeTargets[j].GetServiceName()
+	})
+	return scrapeTargets, nil
+}
+
+func (network *DefaultServiceNetwork) MarkServiceAsReady(serviceName service.ServiceName) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	startupTimes, found := network.serviceStartupTimes[serviceName]
+	if !found {
+		logrus.Warnf("Service '%v' was marked as ready but it wasn't started by this API container; its ready time won't be recorded", serviceName)
+		return
+	}
+	readyTime := time.Now()
+	network.serviceStartupTimes[serviceName] = service_network_types.NewServiceStartupTimes(startupTimes.GetRunningTime(), &readyTime)
+}
+
+func (network *DefaultServiceNetwork) GetServiceStartupTimes(serviceName service.ServiceName) (*service_network_types.ServiceStartupTimes, bool) {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+	startupTimes, found := network.serviceStartupTimes[serviceName]
+	return startupTimes, found
+}
+
+func (network *DefaultServiceNetwork) GetSubnetworkConnections() (partition_topology.PartitionConnection, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, error) {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+	partitionServices, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return partition_topology.ConnectionAllowed, nil, stacktrace.Propagate(err, "An error occurred getting the partitions of the topology")
+	}
+	connectionOverrides := map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection{}
+	for partition1 := range partitionServices {
+		for partition2 := range partitionServices {
+			if partition1 >= partition2 {
+				continue
+			}
+			isDefaultConnection, connection, err := network.topology.GetPartitionConnection(partition1, partition2)
+			if err != nil {
+				return partition_topology.ConnectionAllowed, nil, stacktrace.Propagate(err, "An error occurred getting the connection between partitions '%v' and '%v'", partition1, partition2)
+			}
+			if isDefaultConnection {
+				continue
+			}
+			connectionOverrides[*service_network_types.NewPartitionConnectionID(partition1, partition2)] = connection
+		}
+	}
+	return network.topology.GetDefaultConnection(), connectionOverrides, nil
+}
+
+// TestConnectivity pings each of the given services from every other one, from the networking sidecar of the source
+// service if it has one (as it shares the network of the service, its pings are subject to the same traffic rules) or
+// from the service container otherwise. The network isn't locked while the pings are sent
+func (network *DefaultServiceNetwork) TestConnectivity(
+	ctx context.Context,
+	serviceIdentifiers map[string]bool,
+	numProbes uint32,
+) (map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, error) {
+	if numProbes == 0 {
+		numProbes = defaultNumConnectivityProbes
+	}
+	serviceRegistrations, expectedConnections, servicesWithSidecar, err := network.getConnectivityTestSubjects(serviceIdentifiers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services to test the connectivity between")
+	}
+
+	userServiceCommands := map[service.ServiceUUID][]string{}
+	sidecarCommands := map[service.ServiceUUID][]string{}
+	for sourceServiceName, sourceServiceRegistration := range serviceRegistrations {
+		probeCommand := newConnectivityProbeCommand(sourceServiceName, serviceRegistrations, numProbes)
+		if servicesWithSidecar[sourceServiceName] {
+			sidecarCommands[sourceServiceRegistration.GetUUID()] = probeCommand
+		} else {
+			userServiceCommands[sourceServiceRegistration.GetUUID()] = probeCommand
+		}
+	}
+
+	execResults := map[service.ServiceUUID]*exec_result.ExecResult{}
+	execErrs := map[service.ServiceUUID]error{}
+	if len(userServiceCommands) > emptyCollectionLength {
+		successfulExecResults, failedExecs, err := network.kurtosisBackend.RunUserServiceExecCommands(ctx, network.enclaveUuid, userServiceCommands)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred running the connectivity probes in the service containers")
+		}
+		for serviceUuid, execResult := range successfulExecResults {
+			execResults[serviceUuid] = execResult
+		}
+		for serviceUuid, execErr := range failedExecs {
+			execErrs[serviceUuid] = execErr
+		}
+	}
+	if len(sidecarCommands) > emptyCollectionLength {
+		successfulExecResults, failedExecs, err := network.kurtosisBackend.RunNetworkingSidecarExecCommands(ctx, network.enclaveUuid, sidecarCommands)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred running the connectivity probes in the networking sidecars")
+		}
+		for serviceUuid, execResult := range successfulExecResults {
+			execResults[serviceUuid] = execResult
+		}
+		for serviceUuid, execErr := range failedExecs {
+			execErrs[serviceUuid] = execErr
+		}
+	}
+
+	probeResults := map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult{}
+	for sourceServiceName, sourceServiceRegistration := range serviceRegistrations {
+		sourceServiceUuid := sourceServiceRegistration.GetUUID()
+		var probeOutputsByTarget map[service.ServiceName]string
+		var sourceProbeErr error
+		if execErr, found := execErrs[sourceServiceUuid]; found {
+			sourceProbeErr = stacktrace.Propagate(execErr, "An error occurred running the connectivity probe from service '%v'", sourceServiceName)
+		} else if execResult, found := execResults[sourceServiceUuid]; found {
+			probeOutputsByTarget = splitConnectivityProbeOutputByTarget(execResult.GetOutput())
+		} else {
+			sourceProbeErr = stacktrace.NewError("No result was returned for the connectivity probe run from service '%v'; this is a bug in Kurtosis", sourceServiceName)
+		}
+
+		probeResults[sourceServiceName] = map[service.ServiceName]*service_network_types.ConnectivityProbeResult{}
+		for targetServiceName := range serviceRegistrations {
+			if targetServiceName == sourceServiceName {
+				continue
+			}
+			expectedConnection := partition_topology.ConnectionAllowed
+			if connection, found := expectedConnections[sourceServiceName][targetServiceName]; found {
+				// services without a connection between them are in the same partition
+				expectedConnection = *connection
+			}
+			expectedPacketDelay := expectedConnection.GetPacketDelay()
+			expectedPacketLoss := expectedConnection.GetPacketLossPercentage()
+
+			var observedPacketLossPercentage, observedAvgLatencyMs float32
+			probeErr := sourceProbeErr
+			if probeErr == nil {
+				observedPacketLossPercentage, observedAvgLatencyMs, probeErr = parsePingSummary(probeOutputsByTarget[targetServiceName])
+			}
+			probeResults[sourceServiceName][targetServiceName] = service_network_types.NewConnectivityProbeResult(
+				expectedPacketLoss.GetPercentage(),
+				expectedPacketDelay.GetAvgDelayMs(),
+				observedPacketLossPercentage,
+				observedAvgLatencyMs,
+				probeErr,
+			)
+		}
+	}
+	return probeResults, nil
+}
+
+func (network *DefaultServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	filesArtifactUuid, err := network.renderTemplatesUnlocked(templatesAndDataByDestinationRelFilepath, artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "There was an error in rendering templates to disk")
 	}
 	return filesArtifactUuid, nil
 }
@@ -904,7 +1597,11 @@ func (network *DefaultServiceNetwork) IsNetworkPartitioningEnabled() bool {
 }
 
 func (network *DefaultServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers {
-	return network.allExistingAndHistoricalIdentifiers
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+	serviceIdentifiers := make([]*kurtosis_core_rpc_api_bindings.ServiceIdentifiers, len(network.allExistingAndHistoricalIdentifiers))
+	copy(serviceIdentifiers, network.allExistingAndHistoricalIdentifiers)
+	return serviceIdentifiers
 }
 
 // GetUniqueNameForFileArtifact : this will return unique artifact name after 5 retries, same as enclave id generator
@@ -923,6 +1620,8 @@ func (network *DefaultServiceNetwork) GetUniqueNameForFileArtifact() (string, er
 // updateConnectionsFromTopology reads the current topology and updates the connections for the provided service names
 // according to it.
 // if serviceNames is empty, it updates the connection for all the services within the enclave
+// Sidecars are created lazily here: a service only gets one once its partition has a connection other than 'allowed',
+// so that enclaves with partitioning enabled don't run one extra container per service that no rule ever touches
 func (network *DefaultServiceNetwork) updateConnectionsFromTopology(ctx context.Context, serviceNames map[service.ServiceName]bool) error {
 	availablePartitionConnectionConfigsPerServiceNames, err := network.topology.GetServicePartitionConnectionConfigByServiceName()
 	if err != nil {
@@ -930,6 +1629,15 @@ func (network *DefaultServiceNetwork) updateConnectionsFromTopology(ctx context.
 			" to know what packet loss updates to apply")
 	}
 
+	servicePartitions, err := network.topology.GetServicePartitions()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the partition of each service")
+	}
+	partitionsWithRestrictedConnections, err := network.topology.GetPartitionsWithRestrictedConnections()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the partitions with connections to enforce")
+	}
+
 	var serviceNamesToUpdate map[service.ServiceName]bool
 	if len(serviceNames) == emptyCollectionLength {
 		// we add all the services currently stored in the topology to update everything
@@ -947,13 +1655,152 @@ func (network *DefaultServiceNetwork) updateConnectionsFromTopology(ctx context.
 		if !found {
 			return stacktrace.NewError("A service about to be updated could not be found in the connection config service map: '%s' (connection config service map was: '%v')", serviceName, availablePartitionConnectionConfigsPerServiceNames)
 		}
-		if err = updateTrafficControlConfiguration(ctx, serviceName, otherServiceConnectionConfig, network.registeredServiceInfo, network.networkingSidecars); err != nil {
+		if network.isServiceBeingStarted(serviceName) {
+			logrus.Debugf("Not updating the connections of service '%s' as its container is still getting started", serviceName)
+			continue
+		}
+		network.networkSidecarsLock.Lock()
+		_, hasSidecar := network.networkingSidecars[serviceName]
+		network.networkSidecarsLock.Unlock()
+		if !hasSidecar {
+			if _, isRestricted := partitionsWithRestrictedConnections[servicePartitions[serviceName]]; !isRestricted {
+				// Nothing to enforce, so there's no need for a sidecar yet
+				continue
+			}
+			serviceRegistration, found := network.registeredServiceInfo[serviceName]
+			if !found {
+				return stacktrace.NewError("Service '%s' needs a networking sidecar, but it doesn't have service registration info associated with it", serviceName)
+			}
+			if err = network.createSidecarAndAddToMap(ctx, serviceRegistration); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the networking sidecar for service '%s', now that a partitioning rule targets it", serviceName)
+			}
+		}
+		// The sidecars of the services of other batches can be getting created concurrently, so the map of sidecars
+		// isn't handed over as is
+		serviceNetworkingSidecars := map[service.ServiceName]networking_sidecar.NetworkingSidecarWrapper{}
+		network.networkSidecarsLock.Lock()
+		if networkingSidecar, found := network.networkingSidecars[serviceName]; found {
+			serviceNetworkingSidecars[serviceName] = networkingSidecar
+		}
+		network.networkSidecarsLock.Unlock()
+		if err = updateTrafficControlConfiguration(ctx, serviceName, otherServiceConnectionConfig, network.registeredServiceInfo, serviceNetworkingSidecars); err != nil {
 			return stacktrace.Propagate(err, "An error occurred applying the traffic control configuration to partition off new nodes.")
 		}
 	}
 	return nil
 }
 
+// updateConnectionsOfPartitionsFromTopology updates the connections of the services in the given partitions only,
+// which are the only services whose traffic control rules change when the connections of those partitions change
+func (network *DefaultServiceNetwork) updateConnectionsOfPartitionsFromTopology(ctx context.Context, partitionIds map[service_network_types.PartitionID]bool) error {
+	partitionServices, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all partitions")
+	}
+	serviceNamesToUpdate := map[service.ServiceName]bool{}
+	for partitionId := range partitionIds {
+		for serviceName := range partitionServices[partitionId] {
+			serviceNamesToUpdate[serviceName] = true
+		}
+	}
+	if len(serviceNamesToUpdate) == emptyCollectionLength {
+		// an empty set would update all the services, whereas there's nothing to update here
+		return nil
+	}
+	if err = network.updateConnectionsFromTopology(ctx, serviceNamesToUpdate); err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the connections of the services in partitions '%v'", partitionIds)
+	}
+	return nil
+}
+
+// moveServicesToPartitionUnlocked moves the given services to the given partition and updates the connections of the
+// services affected by the move, i.e. the services in the partitions the services are moved from and to, and the
+// services in any partition whose connection to the partition the services are moved from differs from its
+// connection to the partition they are moved to.
+// It is all or nothing: if anything fails, the services are moved back to their previous partition in the topology
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) moveServicesToPartitionUnlocked(
+	ctx context.Context,
+	partitionId service_network_types.PartitionID,
+	serviceNames map[service.ServiceName]bool,
+) error {
+	servicePartitions, err := network.topology.GetServicePartitions()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching service partitions mapping")
+	}
+	previousPartitionsByServiceName := map[service.ServiceName]service_network_types.PartitionID{}
+	for serviceName := range serviceNames {
+		previousPartition, found := servicePartitions[serviceName]
+		if !found {
+			return stacktrace.NewError("Service '%s' can't be moved to partition '%s' as it isn't in the topology", serviceName, partitionId)
+		}
+		if previousPartition == partitionId {
+			continue
+		}
+		previousPartitionsByServiceName[serviceName] = previousPartition
+	}
+	if len(previousPartitionsByServiceName) == emptyCollectionLength {
+		return nil
+	}
+
+	allPartitions, err := network.topology.GetPartitionServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting all partitions")
+	}
+	partitionsToUpdate := map[service_network_types.PartitionID]bool{
+		partitionId: true,
+	}
+	for _, previousPartition := range previousPartitionsByServiceName {
+		if partitionsToUpdate[previousPartition] {
+			continue
+		}
+		partitionsToUpdate[previousPartition] = true
+		for otherPartition := range allPartitions {
+			if otherPartition == previousPartition || otherPartition == partitionId {
+				continue
+			}
+			_, previousConnection, err := network.topology.GetPartitionConnection(previousPartition, otherPartition)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to fetch current connection between '%s' and '%s'", previousPartition, otherPartition)
+			}
+			_, newConnection, err := network.topology.GetPartitionConnection(partitionId, otherPartition)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to fetch current connection between '%s' and '%s'", partitionId, otherPartition)
+			}
+			if previousConnection != newConnection {
+				partitionsToUpdate[otherPartition] = true
+			}
+		}
+	}
+
+	isOperationSuccessful := false
+	movedServicePreviousPartitions := map[service.ServiceName]service_network_types.PartitionID{}
+	defer func() {
+		if isOperationSuccessful {
+			return
+		}
+		for serviceName, previousPartition := range movedServicePreviousPartitions {
+			if err := network.moveServiceToPartitionInTopology(serviceName, previousPartition); err != nil {
+				logrus.Errorf("Service '%s' was moved to partition '%s', but due to a failure it should be moved "+
+					"back to partition '%s'. Unfortunately, this failed for the following reason so the service "+
+					"will remain in place:\n%v", serviceName, partitionId, previousPartition, err.Error())
+			}
+		}
+	}()
+	for serviceName, previousPartition := range previousPartitionsByServiceName {
+		if err = network.moveServiceToPartitionInTopology(serviceName, partitionId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred moving service '%s' to partition '%s'", serviceName, partitionId)
+		}
+		movedServicePreviousPartitions[serviceName] = previousPartition
+	}
+
+	if err = network.updateConnectionsOfPartitionsFromTopology(ctx, partitionsToUpdate); err != nil {
+		return stacktrace.Propagate(err, "Unable to update connections between the different partitions of the topology")
+	}
+	isOperationSuccessful = true
+	return nil
+}
+
 // Updates the traffic control configuration of the services with the given Names to match the target services packet loss configuration
 // NOTE: This is not thread-safe, so it must be within a function that locks mutex!
 func updateTrafficControlConfiguration(
@@ -975,6 +1822,10 @@ func updateTrafficControlConfiguration(
 		}
 
 		partitionConnectionConfigPerIpAddress[connectedService.GetPrivateIP().String()] = partitionConnectionConfig
+		// Otherwise the partition could be bypassed by reaching the service over IPv6
+		if connectedServiceIpv6 := connectedService.GetPrivateIPv6(); connectedServiceIpv6 != nil {
+			partitionConnectionConfigPerIpAddress[connectedServiceIpv6.String()] = partitionConnectionConfig
+		}
 	}
 
 	sidecar, found := networkingSidecars[serviceName]
@@ -993,113 +1844,140 @@ func updateTrafficControlConfiguration(
 	return nil
 }
 
-// registerService handles all the operations necessary to register a service before is can be started with
-// startRegisteredService. If something fails along the way, the function takes care of rolling back the previous
-// changes such that the enclave remains in the state before the call
-// TODO: The approach is naive here as we register a single service, so it needs to be called within a loop
-//
-//	to register multiple services. We could leverage the fact that the BE handles registering multiple services
-//	with a single call. For now, as registering a service is fairly low lift, it's fine this way
-func (network *DefaultServiceNetwork) registerService(
+// registerServices handles all the operations necessary to register services before they can be started with
+// startRegisteredServices. The services get registered with a single call to the backend, so that registering a big
+// batch of services doesn't cost a round trip per service.
+// It is all or nothing: if any of the services fails to be registered, the changes made for the others are rolled back
+// such that the enclave remains in the state before the call, and the failures are returned per service
+func (network *DefaultServiceNetwork) registerServices(
 	ctx context.Context,
-	serviceName service.ServiceName,
-	partitionId service_network_types.PartitionID,
+	servicePartitionIds map[service.ServiceName]service_network_types.PartitionID,
 ) (
-	*service.ServiceRegistration,
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]error,
 	error,
 ) {
-	serviceSuccessfullyRegistered := false
+	servicesSuccessfullyRegistered := false
+	failedServices := map[service.ServiceName]error{}
+
+	if len(servicePartitionIds) == 0 {
+		return map[service.ServiceName]*service.ServiceRegistration{}, failedServices, nil
+	}
 
 	partitionServices, err := network.topology.GetPartitionServices()
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred while getting partition services")
+		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting partition services")
 	}
 
-	if _, found := partitionServices[partitionId]; !found {
+	createdPartitionIds := map[service_network_types.PartitionID]bool{}
+	// undo partitions creation if registering the services fails downstream
+	defer func() {
+		if servicesSuccessfullyRegistered {
+			return
+		}
+		for partitionId := range createdPartitionIds {
+			if err := network.topology.RemovePartition(partitionId); err != nil {
+				logrus.Errorf("Paritition '%s' needs to be removed as it is empty, but its deletion failed with an unexpected error. Partition will remain in the topology. This is not critical but might be a sign of another more critical failure", partitionId)
+			}
+		}
+	}()
+	servicesToRegister := map[service.ServiceName]bool{}
+	for serviceName, partitionId := range servicePartitionIds {
+		servicesToRegister[serviceName] = true
+		if _, found := partitionServices[partitionId]; found || createdPartitionIds[partitionId] {
+			continue
+		}
 		logrus.Debugf("Paritition with ID '%s' does not exist in current topology. Creating it to be able to "+
 			"add service '%s' to it when it's created", partitionId, serviceName)
-
 		if err := network.topology.CreateEmptyPartitionWithDefaultConnection(partitionId); err != nil {
-			return nil, stacktrace.Propagate(
+			failedServices[serviceName] = stacktrace.Propagate(
 				err,
 				"Cannot register service '%s' because its partition '%s' failed to be created",
 				serviceName,
 				partitionId,
 			)
+			continue
+		}
+		if partitionId != partition_topology.DefaultPartitionId {
+			createdPartitionIds[partitionId] = true
 		}
-		// undo partition creation if starting the something fails downstream
-		defer func() {
-			if serviceSuccessfullyRegistered || partitionId == partition_topology.DefaultPartitionId {
-				return
-			}
-			if err := network.topology.RemovePartition(partitionId); err != nil {
-				logrus.Errorf("Paritition '%s' needs to be removed as it is empty, but its deletion failed with an unexpected error. Partition will remain in the topology. This is not critical but might be a sign of another more critical failure", partitionId)
-			}
-		}()
 	}
-
-	serviceToRegister := map[service.ServiceName]bool{
-		serviceName: true,
+	if len(failedServices) > 0 {
+		return nil, failedServices, nil
 	}
-	servicesSuccessfullyRegistered, serviceFailedRegistration, err := network.kurtosisBackend.RegisterUserServices(ctx, network.enclaveUuid, serviceToRegister)
+
+	registeredServices, failedRegistrations, err := network.kurtosisBackend.RegisterUserServices(ctx, network.enclaveUuid, servicesToRegister)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unexpected error happened registering service '%s'", serviceName)
-	}
-	if serviceRegistrationErr, found := serviceFailedRegistration[serviceName]; found {
-		return nil, stacktrace.Propagate(serviceRegistrationErr, "Error registering service '%s'", serviceName)
-	}
-	serviceRegistration, found := servicesSuccessfullyRegistered[serviceName]
-	if !found {
-		return nil, stacktrace.NewError("Unexpected error while registering service '%s'. It was not flagged as neither failed nor successfully registered. This is a Kurtosis internal bug.", serviceName)
+		return nil, nil, stacktrace.Propagate(err, "Unexpected error happened registering services '%v'", servicesToRegister)
 	}
 	defer func() {
-		if serviceSuccessfullyRegistered {
+		if servicesSuccessfullyRegistered || len(registeredServices) == 0 {
 			return
 		}
-		serviceUuid := serviceRegistration.GetUUID()
-		serviceToUnregister := map[service.ServiceUUID]bool{
-			serviceUuid: true,
+		servicesToUnregister := map[service.ServiceUUID]bool{}
+		for _, serviceRegistration := range registeredServices {
+			servicesToUnregister[serviceRegistration.GetUUID()] = true
 		}
-		_, failedService, unexpectedErr := network.kurtosisBackend.UnregisterUserServices(ctx, network.enclaveUuid, serviceToUnregister)
+		_, failedUnregistrations, unexpectedErr := network.kurtosisBackend.UnregisterUserServices(ctx, network.enclaveUuid, servicesToUnregister)
 		if unexpectedErr != nil {
-			logrus.Errorf("An unexpected error happened unregistering service '%s' after it failed starting. It"+
-				"is possible the service is still registered to the enclave.", serviceName)
+			logrus.Errorf("An unexpected error happened unregistering services '%v' after some services of their batch "+
+				"failed being registered. It is possible the services are still registered to the enclave.", servicesToUnregister)
 			return
 		}
-		if unregisteringErr, found := failedService[serviceUuid]; found {
-			logrus.Errorf("An error happened unregistering service '%s' after it failed starting. It"+
-				"is possible the service is still registered to the enclave. The error was\n%v",
-				serviceName, unregisteringErr.Error())
-			return
+		for serviceUuid, unregisteringErr := range failedUnregistrations {
+			logrus.Errorf("An error happened unregistering service '%s' after some services of its batch failed being "+
+				"registered. It is possible the service is still registered to the enclave. The error was\n%v",
+				serviceUuid, unregisteringErr.Error())
 		}
 	}()
+	for serviceName := range servicesToRegister {
+		if serviceRegistrationErr, found := failedRegistrations[serviceName]; found {
+			failedServices[serviceName] = stacktrace.Propagate(serviceRegistrationErr, "Error registering service '%s'", serviceName)
+			continue
+		}
+		if _, found := registeredServices[serviceName]; !found {
+			failedServices[serviceName] = stacktrace.NewError("Unexpected error while registering service '%s'. It was not flagged as neither failed nor successfully registered. This is a Kurtosis internal bug.", serviceName)
+		}
+	}
+	if len(failedServices) > 0 {
+		return nil, failedServices, nil
+	}
 
-	network.registeredServiceInfo[serviceName] = serviceRegistration
-	// remove service from the registered service map is something fails downstream
+	for serviceName, serviceRegistration := range registeredServices {
+		network.registeredServiceInfo[serviceName] = serviceRegistration
+	}
+	// remove services from the registered service map is something fails downstream
 	defer func() {
-		if serviceSuccessfullyRegistered {
+		if servicesSuccessfullyRegistered {
 			return
 		}
-		network.cleanupInternalMapsUnlocked(serviceName)
+		for serviceName := range registeredServices {
+			network.cleanupInternalMapsUnlocked(serviceName)
+		}
 	}()
 
-	err = network.addServiceToTopology(serviceName, partitionId)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Error adding service '%s' to partition '%s' in network topology", serviceName, partitionId)
-	}
-	logrus.Debugf("Successfully added service with name '%v' to topology", serviceName)
-	// remove service from topology is something fails downstream
+	servicesAddedToTopology := map[service.ServiceName]bool{}
+	// remove services from topology is something fails downstream
 	defer func() {
-		if serviceSuccessfullyRegistered {
+		if servicesSuccessfullyRegistered {
 			return
 		}
-		err = network.topology.RemoveService(serviceName)
-		if err != nil {
-			logrus.Errorf("An error occurred while removing service '%v' from the partition toplogy", serviceName)
+		for serviceName := range servicesAddedToTopology {
+			if err := network.topology.RemoveService(serviceName); err != nil {
+				logrus.Errorf("An error occurred while removing service '%v' from the partition toplogy", serviceName)
+			}
 		}
 	}()
-	serviceSuccessfullyRegistered = true
-	return serviceRegistration, nil
+	for serviceName := range registeredServices {
+		partitionId := servicePartitionIds[serviceName]
+		if err := network.addServiceToTopology(serviceName, partitionId); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Error adding service '%s' to partition '%s' in network topology", serviceName, partitionId)
+		}
+		servicesAddedToTopology[serviceName] = true
+		logrus.Debugf("Successfully added service with name '%v' to topology", serviceName)
+	}
+	servicesSuccessfullyRegistered = true
+	return registeredServices, failedServices, nil
 }
 
 // unregisterService is the opposite of register service. It cleans up everything is can to property unregister a
@@ -1152,21 +2030,57 @@ func (network *DefaultServiceNetwork) unregisterService(ctx context.Context, ser
 	return nil
 }
 
-// startRegisteredService handles the logistic of starting a service in the relevant Kurtosis backend:
-// Convert API ServiceConfig's to service.ServiceConfig's by:
-// - converting API Ports to PortSpec's
-// - converting files artifacts mountpoints to FilesArtifactsExpansion's'
-// - passing down other data (eg. container image name, args, etc.)
-// If network partitioning is enabled, it also takes care of starting the sidecar corresponding to this service
-func (network *DefaultServiceNetwork) startRegisteredService(
-	ctx context.Context,
+// applyConnectionsOfStartedService takes care of what's left to do once the container of a service got started by the
+// backend: if network partitioning is enabled, it applies the connections of the service, which creates its sidecar if
+// a rule targets it. The service gets destroyed if this fails
+func (network *DefaultServiceNetwork) applyConnectionsOfStartedService(
+	ctx context.Context,
+	startedService *service.Service,
+) error {
+	serviceUuid := startedService.GetRegistration().GetUUID()
+	serviceName := startedService.GetRegistration().GetName()
+	logCtx := log_correlation.WithServiceUuid(ctx, string(serviceUuid))
+
+	// The service has a container now, so the topology updates made from now on apply to it too
+	network.setServiceBeingStarted(serviceName, false)
+
+	if !network.isPartitioningEnabled {
+		return nil
+	}
+	serviceNameSet := map[service.ServiceName]bool{
+		serviceName: true,
+	}
+	// update the connection for this service only, with the topology locked for reading as the containers of the
+	// batch get started without holding the network lock
+	network.mutex.RLock()
+	err := network.updateConnectionsFromTopology(ctx, serviceNameSet)
+	network.mutex.RUnlock()
+	if err != nil {
+		userServiceFilters := &service.ServiceFilters{
+			Names: nil,
+			UUIDs: map[service.ServiceUUID]bool{
+				serviceUuid: true,
+			},
+			Statuses: nil,
+		}
+		_, failedToDestroyUuids, destroyErr := network.kurtosisBackend.DestroyUserServices(context.Background(), network.enclaveUuid, userServiceFilters)
+		if destroyErr != nil {
+			logrus.WithContext(logCtx).Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceUuid, destroyErr)
+		} else if failedToDestroyErr, found := failedToDestroyUuids[serviceUuid]; found {
+			logrus.WithContext(logCtx).Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceUuid, failedToDestroyErr)
+		}
+		return stacktrace.Propagate(err, "Error updating the networking rules for this service '%s' (UUID: '%s')", serviceName, serviceUuid)
+	}
+	logrus.WithContext(logCtx).Debugf("Successfully applied the networking rules for service with ID '%v'", serviceUuid)
+	return nil
+}
+
+// toBackendServiceConfig converts the API config of a service to the one the backend starts its container from,
+// setting up the expansion of the files artifacts mounted on it
+func (network *DefaultServiceNetwork) toBackendServiceConfig(
 	serviceUuid service.ServiceUUID,
 	serviceConfigApi *kurtosis_core_rpc_api_bindings.ServiceConfig,
-) (
-	*service.Service,
-	error,
-) {
-	serviceStartedSuccessfully := false
+) (*service.ServiceConfig, error) {
 	var serviceConfig *service.ServiceConfig
 
 	// Docker and K8s requires the minimum memory limit to be 6 megabytes to we make sure the allocation is at least that amount
@@ -1175,12 +2089,25 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 		return nil, stacktrace.NewError("Memory allocation, `%d`, is too low. Kurtosis requires the memory limit to be at least `%d` megabytes for service with UUID '%v'.", serviceConfigApi.MemoryAllocationMegabytes, minMemoryLimit, serviceUuid)
 	}
 
+	if metricsPortId := serviceConfigApi.GetMetricsPortId(); metricsPortId != "" {
+		if _, found := serviceConfigApi.GetPrivatePorts()[metricsPortId]; !found {
+			return nil, stacktrace.NewError("The metrics port ID of service with UUID '%v' is '%v', but the service has no private port with that ID", serviceUuid, metricsPortId)
+		}
+	}
+
+	if len(serviceConfigApi.GetHostDirMounts()) > 0 && !network.areHostDirMountsAllowed {
+		return nil, stacktrace.NewError("Service with UUID '%v' mounts directories of the host, but the enclave wasn't created allowing host directory mounts; recreate it with 'kurtosis enclave add --allow-host-dir-mounts' to use them", serviceUuid)
+	}
+
 	// Convert ports
 	privateServicePortSpecs, requestedPublicServicePortSpecs, err := convertAPIPortsToPortSpecs(serviceConfigApi.PrivatePorts, serviceConfigApi.PublicPorts)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to convert public and private API ports to port specs for service with UUID '%v'", serviceUuid)
 	}
 
+	kubernetesScheduling := convertApiKubernetesScheduling(serviceConfigApi.GetKubernetesScheduling())
+	ulimits := convertApiUlimits(serviceConfigApi.GetUlimits())
+
 	// Creates files artifacts expansions
 	var filesArtifactsExpansion *files_artifacts_expansion.FilesArtifactsExpansion
 	if len(serviceConfigApi.FilesArtifactMountpoints) == 0 {
@@ -1195,7 +2122,17 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 			filesArtifactsExpansion,
 			serviceConfigApi.CpuAllocationMillicpus,
 			serviceConfigApi.MemoryAllocationMegabytes,
-			serviceConfigApi.PrivateIpAddrPlaceholder)
+			serviceConfigApi.PrivateIpAddrPlaceholder,
+			serviceConfigApi.GetKubernetesServiceAccount(),
+			serviceConfigApi.KubernetesServiceAccountAnnotations,
+			kubernetesScheduling,
+			serviceConfigApi.GetReplicas(),
+			serviceConfigApi.GetCpuset(),
+			serviceConfigApi.GetNumaNodes(),
+			ulimits,
+			serviceConfigApi.GetSysctls(),
+			serviceConfigApi.GetShmSizeMegabytes(),
+			serviceConfigApi.GetHostDirMounts())
 	} else {
 		filesArtifactsExpansions := []args.FilesArtifactExpansion{}
 		expanderDirpathToUserServiceDirpathMap := map[string]string{}
@@ -1246,67 +2183,91 @@ func (network *DefaultServiceNetwork) startRegisteredService(
 			filesArtifactsExpansion,
 			serviceConfigApi.CpuAllocationMillicpus,
 			serviceConfigApi.MemoryAllocationMegabytes,
-			serviceConfigApi.PrivateIpAddrPlaceholder)
+			serviceConfigApi.PrivateIpAddrPlaceholder,
+			serviceConfigApi.GetKubernetesServiceAccount(),
+			serviceConfigApi.KubernetesServiceAccountAnnotations,
+			kubernetesScheduling,
+			serviceConfigApi.GetReplicas(),
+			serviceConfigApi.GetCpuset(),
+			serviceConfigApi.GetNumaNodes(),
+			ulimits,
+			serviceConfigApi.GetSysctls(),
+			serviceConfigApi.GetShmSizeMegabytes(),
+			serviceConfigApi.GetHostDirMounts())
+	}
+
+	return serviceConfig, nil
+}
+
+// replaceServiceContainerUnlocked recreates the container of a service from its current config with the update
+// applied, keeping the registration of the service so that services depending on it can keep using its IP address
+// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
+func (network *DefaultServiceNetwork) replaceServiceContainerUnlocked(
+	ctx context.Context,
+	serviceName service.ServiceName,
+	updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig,
+) error {
+	serviceRegistration, found := network.registeredServiceInfo[serviceName]
+	if !found {
+		return stacktrace.NewError("Error updating service '%s' as this service does not exist", serviceName)
+	}
+	serviceUuid := serviceRegistration.GetUUID()
+	currentServiceConfig, found := network.serviceConfigs[serviceName]
+	if !found {
+		return stacktrace.NewError("The config of service '%s' isn't known, so its container can't be recreated", serviceName)
 	}
 
-	// TODO(gb): make the backend also handle starting service sequentially to simplify the logic there as well
-	serviceConfigMap := map[service.ServiceUUID]*service.ServiceConfig{
-		serviceUuid: serviceConfig,
+	updatedServiceConfig := proto.Clone(currentServiceConfig).(*kurtosis_core_rpc_api_bindings.ServiceConfig)
+	if updateServiceConfig.ContainerImageName != nil {
+		updatedServiceConfig.ContainerImageName = updateServiceConfig.GetContainerImageName()
 	}
-	successfulServices, failedServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigMap)
-	if err != nil {
-		return nil, err
+	if len(updateServiceConfig.GetPrivatePorts()) > 0 {
+		updatedServiceConfig.PrivatePorts = updateServiceConfig.GetPrivatePorts()
+		// Public ports have to match the private ones one to one, so they'd be wrong as soon as the private ones change
+		updatedServiceConfig.PublicPorts = nil
+	}
+	if len(updateServiceConfig.GetEnvVars()) > 0 {
+		secrets.GetSecretsRegistry().RegisterSecretsFromEnvVars(updateServiceConfig.GetEnvVars())
+		updatedServiceConfig.EnvVars = updateServiceConfig.GetEnvVars()
 	}
-	if failedServiceErr, isFailed := failedServices[serviceUuid]; isFailed {
-		return nil, failedServiceErr
+	backendServiceConfig, err := network.toBackendServiceConfig(serviceUuid, updatedServiceConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred converting the updated config of service '%s'", serviceName)
 	}
 
-	startedService, isSuccessful := successfulServices[serviceUuid]
-	if !isSuccessful {
-		return nil, stacktrace.NewError("Service '%s' did not start properly but no error was thrown. This is a Kurtosis internal bug", serviceUuid)
+	// The networking sidecar shares the network namespace of the container being replaced, so it's useless once the
+	// container is gone. It gets recreated, if needed, when the connections get updated from the topology
+	network.networkSidecarsLock.Lock()
+	networkingSidecar, found := network.networkingSidecars[serviceName]
+	if found {
+		delete(network.networkingSidecars, serviceName)
 	}
-	defer func() {
-		if serviceStartedSuccessfully {
-			return
-		}
-		serviceToDestroyUuid := startedService.GetRegistration().GetUUID()
-		userServiceFilters := &service.ServiceFilters{
-			Names: nil,
-			UUIDs: map[service.ServiceUUID]bool{
-				serviceToDestroyUuid: true,
-			},
-			Statuses: nil,
-		}
-		_, failedToDestroyUuids, err := network.kurtosisBackend.DestroyUserServices(context.Background(), network.enclaveUuid, userServiceFilters)
-		if err != nil {
-			logrus.Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceToDestroyUuid, err)
-			return
-		}
-		if failedToDestroyErr, found := failedToDestroyUuids[serviceToDestroyUuid]; found {
-			logrus.Errorf("Attempted to destroy the services with UUIDs '%v' but had no success. You must manually destroy the services! The following error had occurred:\n'%v'", serviceToDestroyUuid, failedToDestroyErr)
+	network.networkSidecarsLock.Unlock()
+	if found {
+		if err := network.networkingSidecarManager.Remove(ctx, networkingSidecar); err != nil {
+			logrus.Warnf("An error occurred removing the networking sidecar of service '%s' before recreating its container:\n%v", serviceName, err)
 		}
-	}()
+	}
 
-	// if partition is enabled, create a sidecar associated with this service
-	if network.isPartitioningEnabled {
-		if err := network.createSidecarAndAddToMap(ctx, startedService); err != nil {
-			return nil, stacktrace.Propagate(err, "Error creating sidecar for service '%s'", serviceUuid)
-		}
-		serviceNameSet := map[service.ServiceName]bool{
-			startedService.GetRegistration().GetName(): true,
-		}
-		// update the connection for this service only
-		if err := network.updateConnectionsFromTopology(ctx, serviceNameSet); err != nil {
-			return nil, stacktrace.Propagate(err, "Error updating the networking rules for this service '%s' (UUID: '%s')", startedService.GetRegistration().GetName(), serviceUuid)
-		}
-		logrus.Debugf("Successfully created sidecars for service with ID '%v'", serviceUuid)
+	replacedServices, failedServices, err := network.kurtosisBackend.ReplaceUserServices(ctx, network.enclaveUuid, map[service.ServiceUUID]*service.ServiceConfig{
+		serviceUuid: backendServiceConfig,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the container of service '%s'", serviceName)
+	}
+	if failedServiceErr, found := failedServices[serviceUuid]; found {
+		return failedServiceErr
+	}
+	if _, found := replacedServices[serviceUuid]; !found {
+		return stacktrace.NewError("The container of service '%s' wasn't accounted as replaced nor failed. This is a Kurtosis internal bug", serviceName)
 	}
 
-	serviceStartedSuccessfully = true
-	return startedService, nil
+	network.serviceConfigs[serviceName] = updatedServiceConfig
+	network.serviceStartupTimes[serviceName] = service_network_types.NewServiceStartupTimes(time.Now(), nil)
+	return nil
 }
 
-// destroyService is the opposite of startRegisteredService. It removes a started service from the enclave. Note that it does not
+// destroyService is the opposite of startRegisteredServices. It removes a started service from the enclave. Note that it does not
 // take care of unregistering the service. For this, unregisterService should be called
 // Similar to unregisterService, it is expected that the service passed to destroyService has been properly started.
 // the function might fail if the service is half-started
@@ -1330,9 +2291,13 @@ func (network *DefaultServiceNetwork) destroyService(ctx context.Context, servic
 	}
 
 	// deleting the sidecar
+	network.networkSidecarsLock.Lock()
 	networkingSidecar, found := network.networkingSidecars[serviceName]
 	if found {
 		delete(network.networkingSidecars, serviceName)
+	}
+	network.networkSidecarsLock.Unlock()
+	if found {
 		err = network.networkingSidecarManager.Remove(ctx, networkingSidecar)
 		if errorResult == nil && err != nil {
 			errorResult = stacktrace.Propagate(err, "Attempted to clean up the sidecar for service with name '%s' but an error occurred.", serviceName)
@@ -1341,79 +2306,112 @@ func (network *DefaultServiceNetwork) destroyService(ctx context.Context, servic
 	return errorResult
 }
 
-// startRegisteredServices starts multiple services in parallel
-//
-// It iterates over all the services to start and kicks off a go subroutine for each of them.
-// The subroutine will block until it can write to concurrencyControlChan. concurrencyControlChan is a simple buffered
-// channel that can contain a max of 4 values. It's a common way in go to control concurrency to make sure no more than
-// X subroutine is running at the same time.
-//
-// Once the for loops has started all the subroutine, we use a WaitGroup for this method to block until all subroutines
-// have completed
+func (network *DefaultServiceNetwork) setServiceBeingStarted(serviceName service.ServiceName, isBeingStarted bool) {
+	network.servicesBeingStartedLock.Lock()
+	defer network.servicesBeingStartedLock.Unlock()
+	if isBeingStarted {
+		network.servicesBeingStarted[serviceName] = true
+		return
+	}
+	delete(network.servicesBeingStarted, serviceName)
+}
+
+func (network *DefaultServiceNetwork) isServiceBeingStarted(serviceName service.ServiceName) bool {
+	network.servicesBeingStartedLock.Lock()
+	defer network.servicesBeingStartedLock.Unlock()
+	return network.servicesBeingStarted[serviceName]
+}
+
+// startRegisteredServices handles the logistic of starting services in the relevant Kurtosis backend:
+// Convert API ServiceConfig's to service.ServiceConfig's by:
+// - converting API Ports to PortSpec's
+// - converting files artifacts mountpoints to FilesArtifactsExpansion's'
+// - passing down other data (eg. container image name, args, etc.)
 //
-// The subroutine accounts for its result populating the startedServices and failedServices maps (which are be accessed
-// behind a mutex as those are not concurrent maps) and finishes by release a permit from the WaitGroup
+// The whole batch is handed to the backend in a single call, so that it can share the work between the services (e.g.
+// pulling an image only once for all the services using it). Once their containers are started, the connections of the
+// services get applied in parallel, with no more than batchSize of them being applied at the same time.
+// If a service fails to start, the others aren't taken any further, as the full batch gets reverted anyway
 func (network *DefaultServiceNetwork) startRegisteredServices(
 	ctx context.Context,
 	serviceConfigs map[service.ServiceUUID]*kurtosis_core_rpc_api_bindings.ServiceConfig,
 	batchSize int,
 ) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error) {
-	wg := sync.WaitGroup{}
-
-	concurrencyControlChan := make(chan bool, batchSize)
-	defer close(concurrencyControlChan)
-
 	startedServices := map[service.ServiceUUID]*service.Service{}
 	failedServices := map[service.ServiceUUID]error{}
-	mapWriteMutex := sync.Mutex{}
 
-	// async kick off all the routines one by one
-	for serviceUuid, serviceConfig := range serviceConfigs {
-		serviceToStartUuid := serviceUuid
-		serviceToStartConfig := serviceConfig
+	backendServiceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
+	for serviceUuid, serviceConfigApi := range serviceConfigs {
+		// Errors about this service may embed its config, so its secrets need to be known before anything can fail
+		secrets.GetSecretsRegistry().RegisterSecretsFromEnvVars(serviceConfigApi.EnvVars)
 
-		if len(failedServices) > 0 {
-			// stop scheduling more service start
-			// as one already failed, the full batch will be reverted anyway so no need to continue any further
-			break
+		serviceConfig, err := network.toBackendServiceConfig(serviceUuid, serviceConfigApi)
+		if err != nil {
+			failedServices[serviceUuid] = err
+			continue
+		}
+		backendServiceConfigs[serviceUuid] = serviceConfig
+	}
+	if len(failedServices) > 0 {
+		return startedServices, failedServices
+	}
+
+	successfulServices, failedBackendServices, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, backendServiceConfigs)
+	if err != nil {
+		for serviceUuid := range backendServiceConfigs {
+			failedServices[serviceUuid] = stacktrace.Propagate(err, "An error occurred starting the batch of services service '%s' was part of", serviceUuid)
+		}
+		return startedServices, failedServices
+	}
+	for serviceUuid := range backendServiceConfigs {
+		if failedServiceErr, isFailed := failedBackendServices[serviceUuid]; isFailed {
+			failedServices[serviceUuid] = failedServiceErr
+			continue
+		}
+		startedService, isSuccessful := successfulServices[serviceUuid]
+		if !isSuccessful {
+			failedServices[serviceUuid] = stacktrace.NewError("Service '%s' did not start properly but no error was thrown. This is a Kurtosis internal bug", serviceUuid)
+			continue
 		}
-		// The concurrencyControlChan will block if the buffer is currently full, i.e. if maxConcurrentServiceStart
-		// subroutines are already running in the background
+		startedServices[serviceUuid] = startedService
+	}
+	if len(failedServices) > 0 {
+		// the started services are returned so that they get destroyed along with the rest of the batch
+		return startedServices, failedServices
+	}
+
+	wg := sync.WaitGroup{}
+	// The concurrencyControlChan blocks once batchSize subroutines are already running in the background
+	concurrencyControlChan := make(chan bool, batchSize)
+	defer close(concurrencyControlChan)
+	mapWriteMutex := sync.Mutex{}
+	for serviceUuid, startedService := range startedServices {
+		startedServiceUuid := serviceUuid
+		serviceToApplyConnectionsOf := startedService
 		concurrencyControlChan <- true
 		wg.Add(1)
 		go func() {
 			defer func() {
-				// at the end, make sure the subroutine releases one permit from the WaitGroup, and make sure to
-				// also pop a value from the concurrencyControlChan to allow any potentially waiting subroutine to
-				// start
 				wg.Done()
 				<-concurrencyControlChan
 			}()
-			logrus.Debugf("Starting service '%s'", serviceToStartUuid)
-			startedService, err := network.startRegisteredService(ctx, serviceToStartUuid, serviceToStartConfig)
-			mapWriteMutex.Lock()
-			defer mapWriteMutex.Unlock()
-			if err != nil {
-				failedServices[serviceToStartUuid] = err
-				logrus.Debugf("Service '%s' could not start due to some errors", serviceToStartUuid)
-			} else {
-				startedServices[serviceToStartUuid] = startedService
-				logrus.Debugf("Service '%s' started successfully", serviceToStartUuid)
+			if err := network.applyConnectionsOfStartedService(ctx, serviceToApplyConnectionsOf); err != nil {
+				mapWriteMutex.Lock()
+				defer mapWriteMutex.Unlock()
+				failedServices[startedServiceUuid] = err
 			}
 		}()
 	}
-
-	// wait for all subroutines to complete and return
 	wg.Wait()
+	// the services whose connections couldn't be applied got destroyed already, so they mustn't be accounted as started
+	for serviceUuid := range failedServices {
+		delete(startedServices, serviceUuid)
+	}
 	return startedServices, failedServices
 }
 
-// This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
-func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.Context, serviceName service.ServiceName, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
-	serviceObj, found := network.registeredServiceInfo[serviceName]
-	if !found {
-		return "", stacktrace.NewError("Cannot copy files from service '%v' because it does not exist in the network", serviceName)
-	}
+// The service must be locked, at least for reading, by the caller
+func (network *DefaultServiceNetwork) copyFilesFromService(ctx context.Context, serviceObj *service.ServiceRegistration, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	serviceUuid := serviceObj.GetUUID()
 
 	store, err := network.enclaveDataDir.GetFilesArtifactStore()
@@ -1425,12 +2423,17 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 	defer pipeReader.Close()
 	defer pipeWriter.Close()
 
-	storeFilesArtifactResultChan := make(chan storeFilesArtifactResult)
+	// Buffered so that the goroutine doesn't block forever if copying out of the service fails and nobody reads the result
+	storeFilesArtifactResultChan := make(chan storeFilesArtifactResult, 1)
+	byteCountingPipeReader := &byteCountingReader{
+		reader:   pipeReader,
+		numBytes: 0,
+	}
 	go func() {
 		defer pipeReader.Close()
 
 		//And finally pass it the .tgz file to the artifact file store
-		filesArtifactUuid, storeFileErr := store.StoreFile(pipeReader, artifactName)
+		filesArtifactUuid, storeFileErr := store.StoreFile(byteCountingPipeReader, artifactName)
 		storeFilesArtifactResultChan <- storeFilesArtifactResult{
 			err:               storeFileErr,
 			filesArtifactUuid: filesArtifactUuid,
@@ -1444,12 +2447,13 @@ func (network *DefaultServiceNetwork) copyFilesFromServiceUnlocked(ctx context.C
 	storeFileResult := <-storeFilesArtifactResultChan
 	if storeFileResult.err != nil {
 		return "", stacktrace.Propagate(
-			err,
+			storeFileResult.err,
 			"An error occurred storing files from path '%v' on service '%v' in in the files artifact store",
 			srcPath,
 			serviceUuid,
 		)
 	}
+	run_profiles.RecordArtifactBytesTransferred(ctx, byteCountingPipeReader.numBytes)
 
 	return storeFileResult.filesArtifactUuid, nil
 }
@@ -1473,6 +2477,53 @@ func (network *DefaultServiceNetwork) gzipAndPushTarredFileBytesToOutput(
 	return nil
 }
 
+// copyFilesFromServiceToTarUnderDir writes the TAR'd contents of the path on the service to the output TAR, prefixing every
+// entry with the given directory
+func (network *DefaultServiceNetwork) copyFilesFromServiceToTarUnderDir(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	srcPathOnContainer string,
+	output *tar.Writer,
+	dirInOutput string,
+) error {
+	pipeReader, pipeWriter := io.Pipe()
+	defer pipeReader.Close()
+
+	copyErrChan := make(chan error, 1)
+	go func() {
+		copyErr := network.kurtosisBackend.CopyFilesFromUserService(ctx, network.enclaveUuid, serviceUuid, srcPathOnContainer, pipeWriter)
+		pipeWriter.CloseWithError(copyErr)
+		copyErrChan <- copyErr
+	}()
+
+	tarReader := tar.NewReader(pipeReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred reading the TAR'd files of path '%v' on service with UUID '%v'", srcPathOnContainer, serviceUuid)
+		}
+		header.Name = path.Join(dirInOutput, header.Name)
+		if err := output.WriteHeader(header); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the TAR header of '%v'", header.Name)
+		}
+		if _, err := io.Copy(output, tarReader); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the contents of '%v' to the TAR", header.Name)
+		}
+	}
+	// The TAR may be padded past its last entry; it must be read for the copy to finish
+	if _, err := io.Copy(io.Discard, pipeReader); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the end of the TAR'd files of path '%v' on service with UUID '%v'", srcPathOnContainer, serviceUuid)
+	}
+
+	if err := <-copyErrChan; err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying source '%v' from user service with UUID '%v' in enclave with UUID '%v'", srcPathOnContainer, serviceUuid, network.enclaveUuid)
+	}
+	return nil
+}
+
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
 func (network *DefaultServiceNetwork) addServiceToTopology(serviceName service.ServiceName, partitionID service_network_types.PartitionID) error {
 	if err := network.topology.AddService(serviceName, partitionID); err != nil {
@@ -1528,8 +2579,7 @@ func (network *DefaultServiceNetwork) moveServiceToPartitionInTopology(serviceNa
 }
 
 // This method is not thread safe. Only call this from a method where there is a mutex lock on the network.
-func (network *DefaultServiceNetwork) createSidecarAndAddToMap(ctx context.Context, service *service.Service) error {
-	serviceRegistration := service.GetRegistration()
+func (network *DefaultServiceNetwork) createSidecarAndAddToMap(ctx context.Context, serviceRegistration *service.ServiceRegistration) error {
 	serviceUUID := serviceRegistration.GetUUID()
 	serviceName := serviceRegistration.GetName()
 
@@ -1616,7 +2666,7 @@ func (network *DefaultServiceNetwork) renderTemplatesUnlocked(templatesAndDataBy
 	shouldDeleteFilesArtifact := true
 	defer func() {
 		if shouldDeleteFilesArtifact {
-			if err = store.RemoveFile(string(filesArtifactUuid)); err != nil {
+			if err = store.RemoveFile(artifactName); err != nil {
 				logrus.Errorf("We tried to clean up the files artifact '%v' we had stored but failed:\n%v", artifactName, err)
 			}
 		}
@@ -1649,10 +2699,196 @@ func (network *DefaultServiceNetwork) cleanupInternalMapsUnlocked(serviceName se
 	if !found {
 		return
 	}
+	serviceConfig := network.serviceConfigs[serviceName]
 	delete(network.registeredServiceInfo, serviceName)
+	delete(network.serviceConfigs, serviceName)
+	delete(network.serviceStartupTimes, serviceName)
+	if len(serviceConfig.GetFilesArtifactMountpoints()) > 0 {
+		network.removeFilesArtifactsReferrerUnlocked(serviceName)
+	}
+}
+
+// This isn't thread safe and must be called from a thread safe context
+// addFilesArtifactsReferrerUnlocked records the service as a referrer of the files artifacts mounted on it, so that they
+// don't get deleted from under it. Failing to do so doesn't fail the service, only leaves its artifacts unprotected
+func (network *DefaultServiceNetwork) addFilesArtifactsReferrerUnlocked(serviceName service.ServiceName, serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) {
+	if len(serviceConfig.GetFilesArtifactMountpoints()) == 0 {
+		return
+	}
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		logrus.Warnf("An error occurred getting the files artifact store to record the files artifacts mounted on service '%s'; they could get deleted while it uses them:\n%v", serviceName, err)
+		return
+	}
+	for _, filesArtifactIdentifier := range serviceConfig.GetFilesArtifactMountpoints() {
+		if err := store.AddReferrer(filesArtifactIdentifier, string(serviceName)); err != nil {
+			logrus.Warnf("An error occurred recording files artifact '%s' as mounted on service '%s'; it could get deleted while the service uses it:\n%v", filesArtifactIdentifier, serviceName, err)
+		}
+	}
 }
 
 // This isn't thread safe and must be called from a thread safe context
+func (network *DefaultServiceNetwork) removeFilesArtifactsReferrerUnlocked(serviceName service.ServiceName) {
+	store, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		logrus.Warnf("An error occurred getting the files artifact store to release the files artifacts mounted on service '%s'; they won't get swept:\n%v", serviceName, err)
+		return
+	}
+	store.RemoveReferrer(string(serviceName))
+}
+
+// runBulkServiceOperation resolves the given service identifiers and runs the given filter-based backend operation on
+// the matching services with all of them locked, returning the results by service name
+func (network *DefaultServiceNetwork) runBulkServiceOperation(
+	ctx context.Context,
+	serviceIdentifiers map[string]bool,
+	backendOperation func(context.Context, enclave.EnclaveUUID, *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error),
+) (
+	map[service.ServiceName]bool,
+	map[service.ServiceName]error,
+	error,
+) {
+	successfulServiceNames := map[service.ServiceName]bool{}
+	failedServiceNames := map[service.ServiceName]error{}
+	if len(serviceIdentifiers) == emptyCollectionLength {
+		// empty filters would match all the services of the enclave
+		return successfulServiceNames, failedServiceNames, nil
+	}
+
+	serviceRegistrations, unlockServices, err := network.lockServicesForIdentifiers(serviceIdentifiers, false)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the services to run the operation on")
+	}
+	defer unlockServices()
+
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceName, serviceRegistration := range serviceRegistrations {
+		serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceName
+		serviceUuids[serviceRegistration.GetUUID()] = true
+	}
+
+	filters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	successfulUuids, failedUuids, err := backendOperation(ctx, network.enclaveUuid, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred running the operation on services '%v'", serviceUuids)
+	}
+	for serviceUuid := range successfulUuids {
+		successfulServiceNames[serviceNamesByUuid[serviceUuid]] = true
+	}
+	for serviceUuid, serviceErr := range failedUuids {
+		failedServiceNames[serviceNamesByUuid[serviceUuid]] = serviceErr
+	}
+	return successfulServiceNames, failedServiceNames, nil
+}
+
+// getConnectivityTestSubjects returns the registrations of the services to test the connectivity between, the
+// connections the topology expects between them, and which of them have a networking sidecar to send the pings from
+func (network *DefaultServiceNetwork) getConnectivityTestSubjects(serviceIdentifiers map[string]bool) (
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]map[service.ServiceName]*partition_topology.PartitionConnection,
+	map[service.ServiceName]bool,
+	error,
+) {
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+
+	serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+	if len(serviceIdentifiers) == emptyCollectionLength {
+		for serviceName, serviceRegistration := range network.registeredServiceInfo {
+			serviceRegistrations[serviceName] = serviceRegistration
+		}
+	}
+	for serviceIdentifier := range serviceIdentifiers {
+		serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
+		}
+		serviceRegistrations[serviceName] = network.registeredServiceInfo[serviceName]
+	}
+
+	expectedConnections, err := network.topology.GetServicePartitionConnectionConfigByServiceName()
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the connections between the services from the topology")
+	}
+
+	servicesWithSidecar := map[service.ServiceName]bool{}
+	network.networkSidecarsLock.Lock()
+	defer network.networkSidecarsLock.Unlock()
+	for serviceName := range serviceRegistrations {
+		if _, found := network.networkingSidecars[serviceName]; found {
+			servicesWithSidecar[serviceName] = true
+		}
+	}
+	return serviceRegistrations, expectedConnections, servicesWithSidecar, nil
+}
+
+// lockServiceForIdentifier takes the lock of the service with the given identifier, for reading only if isReadOnly is
+// set, and returns its registration along with the function releasing the lock.
+// The network must NOT be locked by the caller, as the lock of the service might only be acquired once another
+// operation on the service holding the network lock completes
+func (network *DefaultServiceNetwork) lockServiceForIdentifier(serviceIdentifier string, isReadOnly bool) (*service.ServiceRegistration, func(), error) {
+	serviceRegistrations, unlockServices, err := network.lockServicesForIdentifiers(map[string]bool{serviceIdentifier: true}, isReadOnly)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred locking service '%v'", serviceIdentifier)
+	}
+	for _, serviceRegistration := range serviceRegistrations {
+		return serviceRegistration, unlockServices, nil
+	}
+	unlockServices()
+	return nil, nil, stacktrace.NewError("No service was locked for identifier '%v'; this is a bug in Kurtosis", serviceIdentifier)
+}
+
+// lockServicesForIdentifiers is the same as lockServiceForIdentifier for multiple services at once, returning their
+// registrations by name
+func (network *DefaultServiceNetwork) lockServicesForIdentifiers(serviceIdentifiers map[string]bool, isReadOnly bool) (map[service.ServiceName]*service.ServiceRegistration, func(), error) {
+	network.mutex.RLock()
+	serviceUuidsByName := map[service.ServiceName]service.ServiceUUID{}
+	for serviceIdentifier := range serviceIdentifiers {
+		serviceName, err := network.getServiceNameForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			network.mutex.RUnlock()
+			return nil, nil, stacktrace.Propagate(err, "An error occurred while getting service name for identifier '%v'", serviceIdentifier)
+		}
+		serviceRegistration, found := network.registeredServiceInfo[serviceName]
+		if !found {
+			network.mutex.RUnlock()
+			return nil, nil, stacktrace.NewError("No service with name '%v' exists in the network", serviceName)
+		}
+		serviceUuidsByName[serviceName] = serviceRegistration.GetUUID()
+	}
+	network.mutex.RUnlock()
+
+	serviceNames := make([]service.ServiceName, 0, len(serviceUuidsByName))
+	for serviceName := range serviceUuidsByName {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	var unlockServices func()
+	if isReadOnly {
+		unlockServices = network.serviceLocks.rLock(serviceNames...)
+	} else {
+		unlockServices = network.serviceLocks.lock(serviceNames...)
+	}
+
+	// The services might have been removed, or replaced by new ones with the same names, while waiting on their locks
+	network.mutex.RLock()
+	defer network.mutex.RUnlock()
+	serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+	for serviceName, serviceUuid := range serviceUuidsByName {
+		serviceRegistration, found := network.registeredServiceInfo[serviceName]
+		if !found || serviceRegistration.GetUUID() != serviceUuid {
+			unlockServices()
+			return nil, nil, stacktrace.NewError("Service '%v' was removed from the network while waiting to operate on it", serviceName)
+		}
+		serviceRegistrations[serviceName] = serviceRegistration
+	}
+	return serviceRegistrations, unlockServices, nil
+}
+
 func (network *DefaultServiceNetwork) getServiceNameForIdentifierUnlocked(serviceIdentifier string) (service.ServiceName, error) {
 	maybeServiceUuid := service.ServiceUUID(serviceIdentifier)
 	serviceUuidToServiceName := map[service.ServiceUUID]service.ServiceName{}
@@ -1755,6 +2991,38 @@ func transformApiPortToPortSpec(port *kurtosis_core_rpc_api_bindings.Port) (*por
 // - There is a matching publicPort for every portID in privatePorts
 // - There are the same amount of private and public ports
 // If error is nil, the public and private ports are one to one.
+func convertApiKubernetesScheduling(scheduling *kurtosis_core_rpc_api_bindings.KubernetesScheduling) *service.KubernetesScheduling {
+	if scheduling == nil {
+		return nil
+	}
+	tolerations := []*service.KubernetesToleration{}
+	for _, toleration := range scheduling.GetTolerations() {
+		var tolerationSeconds *int64
+		if toleration.TolerationSeconds != nil {
+			tolerationSecondsValue := toleration.GetTolerationSeconds()
+			tolerationSeconds = &tolerationSecondsValue
+		}
+		tolerations = append(tolerations, service.NewKubernetesToleration(toleration.GetKey(), toleration.GetOperator(), toleration.GetValue(), toleration.GetEffect(), tolerationSeconds))
+	}
+	return service.NewKubernetesScheduling(
+		scheduling.GetNodeSelector(),
+		tolerations,
+		scheduling.GetSerializedAffinity(),
+		scheduling.GetSerializedTopologySpreadConstraints(),
+	)
+}
+
+func convertApiUlimits(apiUlimits map[string]*kurtosis_core_rpc_api_bindings.Ulimit) map[string]*service.Ulimit {
+	if len(apiUlimits) == 0 {
+		return nil
+	}
+	ulimits := map[string]*service.Ulimit{}
+	for name, apiUlimit := range apiUlimits {
+		ulimits[name] = service.NewUlimit(apiUlimit.GetSoft(), apiUlimit.GetHard())
+	}
+	return ulimits
+}
+
 func checkPrivateAndPublicPortsAreOneToOne(privatePorts map[string]*kurtosis_core_rpc_api_bindings.Port, publicPorts map[string]*kurtosis_core_rpc_api_bindings.Port) error {
 	if len(privatePorts) != len(publicPorts) {
 		return stacktrace.NewError("The received private ports length and the public ports length are not equal. Received '%v' private ports and '%v' public ports", len(privatePorts), len(publicPorts))
@@ -1791,3 +3059,105 @@ func renderTemplateToFile(templateAsAString string, templateData interface{}, de
 	}
 	return nil
 }
+
+// doesUpdateReplaceContainer returns whether the update changes something the container of the service is created
+// with, as opposed to only moving the service to another subnetwork
+func doesUpdateReplaceContainer(updateServiceConfig *kurtosis_core_rpc_api_bindings.UpdateServiceConfig) bool {
+	return updateServiceConfig.ContainerImageName != nil ||
+		len(updateServiceConfig.GetPrivatePorts()) > 0 ||
+		len(updateServiceConfig.GetEnvVars()) > 0
+}
+
+// newConnectivityProbeCommand builds a shell command pinging every service but the source one, printing a marker line
+// with the name of the target before the output of each ping so that the output can be split by target
+func newConnectivityProbeCommand(
+	sourceServiceName service.ServiceName,
+	serviceRegistrations map[service.ServiceName]*service.ServiceRegistration,
+	numProbes uint32,
+) []string {
+	targetServiceNames := []string{}
+	for serviceName := range serviceRegistrations {
+		if serviceName != sourceServiceName {
+			targetServiceNames = append(targetServiceNames, string(serviceName))
+		}
+	}
+	sort.Strings(targetServiceNames)
+
+	pingCommands := []string{}
+	for _, targetServiceName := range targetServiceNames {
+		pingCommands = append(pingCommands, fmt.Sprintf(
+			"echo '%v%v'; ping -q -c %v -i %v -W %v %v 2>&1",
+			connectivityProbeTargetMarker,
+			targetServiceName,
+			numProbes,
+			connectivityProbeIntervalSeconds,
+			connectivityProbeTimeoutSeconds,
+			serviceRegistrations[service.ServiceName(targetServiceName)].GetPrivateIP(),
+		))
+	}
+	return []string{"sh", "-c", strings.Join(pingCommands, "; ")}
+}
+
+func splitConnectivityProbeOutputByTarget(probeOutput string) map[service.ServiceName]string {
+	outputsByTarget := map[service.ServiceName]string{}
+	var currentTarget service.ServiceName
+	for _, line := range strings.Split(probeOutput, "\n") {
+		if strings.HasPrefix(line, connectivityProbeTargetMarker) {
+			currentTarget = service.ServiceName(strings.TrimSpace(strings.TrimPrefix(line, connectivityProbeTargetMarker)))
+			continue
+		}
+		if currentTarget != "" {
+			outputsByTarget[currentTarget] += line + "\n"
+		}
+	}
+	return outputsByTarget
+}
+
+// parsePingSummary reads the packet loss and the average round trip time from the summary printed by ping, which
+// looks the same for the busybox and iputils versions of it
+func parsePingSummary(pingOutput string) (float32, float32, error) {
+	packetLossMatch := pingPacketLossRegex.FindStringSubmatch(pingOutput)
+	if packetLossMatch == nil {
+		return 0, 0, stacktrace.NewError("Couldn't find the packet loss in the output of the ping; the 'ping' binary is likely missing in the container. Output was:\n%v", pingOutput)
+	}
+	packetLossPercentage, err := strconv.ParseFloat(packetLossMatch[1], 32)
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "An error occurred parsing packet loss '%v'", packetLossMatch[1])
+	}
+	// no round trip time gets printed if no ping got a reply
+	var avgLatencyMs float64
+	if avgRoundTripMatch := pingAvgRoundTripRegex.FindStringSubmatch(pingOutput); avgRoundTripMatch != nil {
+		avgLatencyMs, err = strconv.ParseFloat(avgRoundTripMatch[1], 32)
+		if err != nil {
+			return 0, 0, stacktrace.Propagate(err, "An error occurred parsing average round trip time '%v'", avgRoundTripMatch[1])
+		}
+	}
+	return float32(packetLossPercentage), float32(avgLatencyMs), nil
+}
+
+// checkUdpPortAvailability sends an empty datagram to the address; as UDP has no handshake, the port is only known to
+// be unavailable when the host answers it with an ICMP port unreachable, which surfaces as an error reading the reply
+func checkUdpPortAvailability(ctx context.Context, dialer *net.Dialer, address string) error {
+	conn, err := dialer.DialContext(ctx, "udp", address)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred dialing '%v'", address)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the UDP connection to '%v':\n%v", address, err)
+		}
+	}()
+	if _, err := conn.Write([]byte{}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending a datagram to '%v'", address)
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(portAvailabilityUdpReadTimeout)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the read deadline of the UDP connection to '%v'", address)
+	}
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return nil
+		}
+		return stacktrace.Propagate(err, "The datagram sent to '%v' was refused", address)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@
 package service_network
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
@@ -28,10 +30,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
+	"io"
 	"net"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -39,6 +44,7 @@ const (
 
 	enclaveName             = enclave.EnclaveUUID("test-enclave")
 	partitioningEnabled     = true
+	hostDirMountsAllowed    = false
 	fakeApiContainerVersion = "0.0.0"
 	apiContainerPort        = uint16(1234)
 	testContainerImageName  = "kurtosistech/test-container"
@@ -63,7 +69,7 @@ func TestStartService_Successful(t *testing.T) {
 	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
 	successfulServiceIp := testIpFromInt(serviceInternalTestId)
 	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
-	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{})
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
 	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(servicePartitionId)).Build()
 
 	file, err := os.CreateTemp("/tmp", "*.db")
@@ -80,12 +86,15 @@ func TestStartService_Successful(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
 		enclaveDb,
 	)
 	require.Nil(t, err)
+	// Services in partitions with restricted connections get a sidecar when they start
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
 
 	// The service is registered before being started
 	backend.EXPECT().RegisterUserServices(
@@ -170,6 +179,105 @@ func TestStartService_Successful(t *testing.T) {
 	require.Equal(t, expectedPartitionsInTopolody, partitionServices)
 }
 
+func TestStartService_SidecarIsOnlyCreatedWhenARuleTargetsTheService(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceInternalTestId := 1
+	serviceName := testServiceNameFromInt(serviceInternalTestId)
+	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+	successfulServiceIp := testIpFromInt(serviceInternalTestId)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).Build()
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	backend.EXPECT().RegisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceName]bool{
+			serviceName: true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			serviceName: serviceRegistration,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+
+	startedService, err := network.StartService(ctx, serviceName, serviceConfig)
+	require.Nil(t, err)
+	require.NotNil(t, startedService)
+
+	// No rule targets the service yet, so it doesn't get a sidecar
+	require.Empty(t, network.networkingSidecars)
+
+	otherServiceName := testServiceNameFromInt(2)
+	otherServiceIp := testIpFromInt(2)
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection("other-partition"))
+	require.Nil(t, network.topology.AddService(otherServiceName, "other-partition"))
+	network.registeredServiceInfo[otherServiceName] = service.NewServiceRegistration(otherServiceName, testServiceUuidFromInt(2), enclaveName, otherServiceIp, string(otherServiceName))
+	network.networkingSidecars[otherServiceName] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+
+	// A default connection with packet loss now targets the service, which creates its sidecar
+	backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, serviceUuid).Times(1).Return(
+		lib_networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveName, container_status.ContainerStatus_Running),
+		nil)
+
+	// Once to initialize the traffic control, once to apply the new default connection
+	backend.EXPECT().RunNetworkingSidecarExecCommands(
+		ctx,
+		enclaveName,
+		mock.MatchedBy(func(commands map[service.ServiceUUID][]string) bool {
+			_, foundService := commands[serviceUuid]
+			return len(commands) == 1 && foundService
+		})).Times(2).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			serviceUuid: exec_result.NewExecResult(0, ""),
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+
+	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
+	require.Nil(t, network.SetDefaultConnection(ctx, newDefaultConnection))
+
+	require.Len(t, network.networkingSidecars, 2)
+	require.Contains(t, network.networkingSidecars, serviceName)
+}
+
 func TestStartService_FailedToStart(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -196,6 +304,7 @@ func TestStartService_FailedToStart(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -294,7 +403,7 @@ func TestStartService_SidecarFailedToStart(t *testing.T) {
 	serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
 	successfulServiceIp := testIpFromInt(serviceInternalTestId)
 	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, successfulServiceIp, string(serviceName))
-	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{})
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
 	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(servicePartitionId)).Build()
 
 	file, err := os.CreateTemp("/tmp", "*.db")
@@ -311,12 +420,15 @@ func TestStartService_SidecarFailedToStart(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
 		enclaveDb,
 	)
 	require.Nil(t, err)
+	// Services in partitions with restricted connections get a sidecar when they start
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
 
 	// The service is registered before being started
 	backend.EXPECT().RegisterUserServices(
@@ -419,7 +531,7 @@ func TestStartServices_Success(t *testing.T) {
 	successfulServiceUuid := testServiceUuidFromInt(successfulServiceIndex)
 	successfulServiceIp := testIpFromInt(successfulServiceIndex)
 	successfulServiceRegistration := service.NewServiceRegistration(successfulServiceName, successfulServiceUuid, enclaveName, successfulServiceIp, string(successfulServiceName))
-	successfulService := service.NewService(successfulServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{})
+	successfulService := service.NewService(successfulServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
 	successfulServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(successfulServicePartitionId)).Build()
 
 	file, err := os.CreateTemp("/tmp", "*.db")
@@ -436,16 +548,19 @@ func TestStartServices_Success(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
 		enclaveDb,
 	)
 	require.Nil(t, err)
+	// Services in partitions with restricted connections get a sidecar when they start
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
 
 	// Configure the mock to also be testing that the right functions are called along the way
 
-	// The services are registered one by one before being started
+	// The services are all registered at once before being started
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
@@ -512,6 +627,9 @@ func TestStartServices_Success(t *testing.T) {
 	require.Len(t, network.registeredServiceInfo, 1)
 	require.Contains(t, network.registeredServiceInfo, successfulServiceName)
 
+	require.Len(t, network.serviceConfigs, 1)
+	require.Contains(t, network.serviceConfigs, successfulServiceName)
+
 	require.Len(t, network.allExistingAndHistoricalIdentifiers, 1)
 
 	require.Len(t, network.networkingSidecars, 1)
@@ -539,18 +657,9 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 	successfulServiceUuid := testServiceUuidFromInt(successfulServiceIndex)
 	successfulServiceIp := testIpFromInt(successfulServiceIndex)
 	successfulServiceRegistration := service.NewServiceRegistration(successfulServiceName, successfulServiceUuid, enclaveName, successfulServiceIp, string(successfulServiceName))
-	successfulService := service.NewService(successfulServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{})
+	successfulService := service.NewService(successfulServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
 	successfulServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(successfulServicePartitionId)).Build()
 
-	// One service will fail to be started
-	failedServiceIndex := 2
-	failedServicePartitionId := testPartitionIdFromInt(failedServiceIndex)
-	failedServiceName := testServiceNameFromInt(failedServiceIndex)
-	failedServiceUuid := testServiceUuidFromInt(failedServiceIndex)
-	failedServiceIp := testIpFromInt(failedServiceIndex)
-	failedServiceRegistration := service.NewServiceRegistration(failedServiceName, failedServiceUuid, enclaveName, failedServiceIp, string(failedServiceName))
-	failedServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(failedServicePartitionId)).Build()
-
 	// One service will be successfully started but its sidecar will fail to start
 	sidecarFailedServiceIndex := 3
 	sidecarFailedServicePartitionId := testPartitionIdFromInt(sidecarFailedServiceIndex)
@@ -558,7 +667,7 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 	sidecarFailedServiceUuid := testServiceUuidFromInt(sidecarFailedServiceIndex)
 	sidecarFailedServiceIp := testIpFromInt(sidecarFailedServiceIndex)
 	sidecarFailedServiceRegistration := service.NewServiceRegistration(sidecarFailedServiceName, sidecarFailedServiceUuid, enclaveName, sidecarFailedServiceIp, string(sidecarFailedServiceName))
-	sidecarFailedService := service.NewService(sidecarFailedServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, sidecarFailedServiceIp, map[string]*port_spec.PortSpec{})
+	sidecarFailedService := service.NewService(sidecarFailedServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, sidecarFailedServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
 	sidecarFailedServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(sidecarFailedServicePartitionId)).Build()
 
 	file, err := os.CreateTemp("/tmp", "*.db")
@@ -575,95 +684,48 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
 		enclaveDb,
 	)
 	require.Nil(t, err)
+	// Services in partitions with restricted connections get a sidecar when they start
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
 
 	// Configure the mock to also be testing that the right functions are called along the way
 
-	// The services are registered one by one before being started
-	backend.EXPECT().RegisterUserServices(
-		ctx,
-		enclaveName,
-		map[service.ServiceName]bool{
-			successfulServiceName: true,
-		},
-	).Times(1).Return(
-		map[service.ServiceName]*service.ServiceRegistration{
-			successfulServiceName: successfulServiceRegistration,
-		},
-		map[service.ServiceName]error{},
-		nil,
-	)
-	backend.EXPECT().RegisterUserServices(
-		ctx,
-		enclaveName,
-		map[service.ServiceName]bool{
-			failedServiceName: true,
-		},
-	).Times(1).Return(
-		map[service.ServiceName]*service.ServiceRegistration{
-			failedServiceName: failedServiceRegistration,
-		},
-		map[service.ServiceName]error{},
-		nil,
-	)
+	// The services are all registered at once before being started
 	backend.EXPECT().RegisterUserServices(
 		ctx,
 		enclaveName,
 		map[service.ServiceName]bool{
+			successfulServiceName:    true,
 			sidecarFailedServiceName: true,
 		},
 	).Times(1).Return(
 		map[service.ServiceName]*service.ServiceRegistration{
+			successfulServiceName:    successfulServiceRegistration,
 			sidecarFailedServiceName: sidecarFailedServiceRegistration,
 		},
 		map[service.ServiceName]error{},
 		nil,
 	)
 
-	// StartUserService will be called three times, with all the provided services
+	// StartRegisteredUserServices will be called once, with all the provided services
 	backend.EXPECT().StartRegisteredUserServices(
 		ctx,
 		enclaveName,
 		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
-			// Matcher function returning true iff the services map arg contains exactly the following key:
-			// {successfulServiceName}
+			// Matcher function returning true iff the services map arg contains exactly the following keys:
+			// {successfulServiceUuid, sidecarFailedServiceUuid}
 			_, foundSuccessfulService := services[successfulServiceUuid]
-			return len(services) == 1 && foundSuccessfulService
-		})).Times(1).Return(
-		map[service.ServiceUUID]*service.Service{
-			successfulServiceUuid: successfulService,
-		},
-		map[service.ServiceUUID]error{},
-		nil)
-	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
-		enclaveName,
-		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
-			// Matcher function returning true iff the services map arg contains exactly the following key:
-			// {failedServiceName}
-			_, foundFailedService := services[failedServiceUuid]
-			return len(services) == 1 && foundFailedService
-		})).Times(1).Return(
-		map[service.ServiceUUID]*service.Service{},
-		map[service.ServiceUUID]error{
-			failedServiceUuid: stacktrace.NewError("Failed starting service"),
-		},
-		nil)
-	backend.EXPECT().StartRegisteredUserServices(
-		ctx,
-		enclaveName,
-		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
-			// Matcher function returning true iff the services map arg contains exactly the following key:
-			// {sidecarFailedServiceName}
 			_, foundSidecarFailedService := services[sidecarFailedServiceUuid]
-			return len(services) == 1 && foundSidecarFailedService
+			return len(services) == 2 && foundSuccessfulService && foundSidecarFailedService
 		})).Times(1).Return(
 		map[service.ServiceUUID]*service.Service{
+			successfulServiceUuid:    successfulService,
 			sidecarFailedServiceUuid: sidecarFailedService,
 		},
 		map[service.ServiceUUID]error{},
@@ -723,7 +785,7 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		map[service.ServiceUUID]error{},
 		nil)
 
-	// Both failedService and sidecarFailedService are unregistered in the deferred functions
+	// Both successfulService and sidecarFailedService are unregistered in the deferred functions
 	backend.EXPECT().UnregisterUserServices(
 		ctx,
 		enclaveName,
@@ -737,19 +799,6 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		map[service.ServiceUUID]error{},
 		nil,
 	)
-	backend.EXPECT().UnregisterUserServices(
-		ctx,
-		enclaveName,
-		map[service.ServiceUUID]bool{
-			failedServiceUuid: true,
-		},
-	).Times(1).Return(
-		map[service.ServiceUUID]bool{
-			failedServiceUuid: true,
-		},
-		map[service.ServiceUUID]error{},
-		nil,
-	)
 	backend.EXPECT().UnregisterUserServices(
 		ctx,
 		enclaveName,
@@ -785,15 +834,13 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		ctx,
 		map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
 			successfulServiceName:    successfulServiceConfig,
-			failedServiceName:        failedServiceConfig,
 			sidecarFailedServiceName: sidecarFailedServiceConfig,
 		},
 		2,
 	)
 	require.Nil(t, err)
 	require.Empty(t, success) // as the full batch failed, the successful service should have been destroyed
-	require.Len(t, failure, 2)
-	require.Contains(t, failure, failedServiceName)
+	require.Len(t, failure, 1)
 	require.Contains(t, failure, sidecarFailedServiceName)
 
 	require.Empty(t, network.registeredServiceInfo)
@@ -809,10 +856,29 @@ func TestStartServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 	require.Equal(t, expectedPartitionsInTopolody, partitionServices)
 }
 
-func TestUpdateService(t *testing.T) {
+func TestStartServices_BackendFailureRollsBackTheEntireBatch(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
 
+	// One service will be started successfully
+	successfulServiceIndex := 1
+	successfulServicePartitionId := testPartitionIdFromInt(successfulServiceIndex)
+	successfulServiceName := testServiceNameFromInt(successfulServiceIndex)
+	successfulServiceUuid := testServiceUuidFromInt(successfulServiceIndex)
+	successfulServiceIp := testIpFromInt(successfulServiceIndex)
+	successfulServiceRegistration := service.NewServiceRegistration(successfulServiceName, successfulServiceUuid, enclaveName, successfulServiceIp, string(successfulServiceName))
+	successfulService := service.NewService(successfulServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, successfulServiceIp, map[string]*port_spec.PortSpec{}, nil, nil)
+	successfulServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(successfulServicePartitionId)).Build()
+
+	// One service will fail to be started
+	failedServiceIndex := 2
+	failedServicePartitionId := testPartitionIdFromInt(failedServiceIndex)
+	failedServiceName := testServiceNameFromInt(failedServiceIndex)
+	failedServiceUuid := testServiceUuidFromInt(failedServiceIndex)
+	failedServiceIp := testIpFromInt(failedServiceIndex)
+	failedServiceRegistration := service.NewServiceRegistration(failedServiceName, failedServiceUuid, enclaveName, failedServiceIp, string(failedServiceName))
+	failedServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(failedServicePartitionId)).Build()
+
 	file, err := os.CreateTemp("/tmp", "*.db")
 	defer os.Remove(file.Name())
 	require.Nil(t, err)
@@ -827,62 +893,269 @@ func TestUpdateService(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
 		enclaveDb,
 	)
 	require.Nil(t, err)
+	// Services in partitions with restricted connections get a sidecar when they start
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
 
-	partition0 := service_network_types.PartitionID("partition0")
-	partition1 := service_network_types.PartitionID("partition1")
-	partition2 := service_network_types.PartitionID("partition2")
-
-	// service that will be successfully moved from partition1 to partition2
-	successfulServiceIndex := 1
-	successfulService := service.NewServiceRegistration(
-		testServiceNameFromInt(successfulServiceIndex),
-		testServiceUuidFromInt(successfulServiceIndex),
-		enclaveName,
-		testIpFromInt(successfulServiceIndex),
-		testServiceHostnameFromInt(successfulServiceIndex))
-
-	// service that will be in partition0 from the start and be updated to partition0 (i.e. it will no-op)
-	serviceAlreadyInPartitionIndex := 2
-	serviceAlreadyInPartition := service.NewServiceRegistration(
-		testServiceNameFromInt(serviceAlreadyInPartitionIndex),
-		testServiceUuidFromInt(serviceAlreadyInPartitionIndex),
+	backend.EXPECT().RegisterUserServices(
+		ctx,
 		enclaveName,
-		testIpFromInt(serviceAlreadyInPartitionIndex),
-		testServiceHostnameFromInt(serviceAlreadyInPartitionIndex))
+		map[service.ServiceName]bool{
+			successfulServiceName: true,
+			failedServiceName:     true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			successfulServiceName: successfulServiceRegistration,
+			failedServiceName:     failedServiceRegistration,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
 
-	// service that does not exist, and yet we will try to update it. It should fail
-	nonExistentServiceIndex := 3
-	nonExistentService := service.NewServiceRegistration(
-		testServiceNameFromInt(nonExistentServiceIndex),
-		testServiceUuidFromInt(nonExistentServiceIndex),
+	// The whole batch is handed to the backend at once
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
 		enclaveName,
-		testIpFromInt(nonExistentServiceIndex),
-		testServiceHostnameFromInt(nonExistentServiceIndex))
+		mock.MatchedBy(func(services map[service.ServiceUUID]*service.ServiceConfig) bool {
+			_, foundSuccessfulService := services[successfulServiceUuid]
+			_, foundFailedService := services[failedServiceUuid]
+			return len(services) == 2 && foundSuccessfulService && foundFailedService
+		})).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			successfulServiceUuid: successfulService,
+		},
+		map[service.ServiceUUID]error{
+			failedServiceUuid: stacktrace.NewError("Failed starting service"),
+		},
+		nil)
 
-	// service that will be moved from default partition to partition2
-	serviceToMoveOutOfDefaultPartitionIndex := 4
-	serviceToMoveOutOfDefaultPartition := service.NewServiceRegistration(
-		testServiceNameFromInt(serviceToMoveOutOfDefaultPartitionIndex),
-		testServiceUuidFromInt(serviceToMoveOutOfDefaultPartitionIndex),
+	// As the batch failed, no sidecar gets created and the successfully started service gets destroyed
+	backend.EXPECT().DestroyUserServices(
+		ctx,
 		enclaveName,
-		testIpFromInt(serviceToMoveOutOfDefaultPartitionIndex),
-		testServiceHostnameFromInt(serviceToMoveOutOfDefaultPartitionIndex))
+		mock.MatchedBy(func(filters *service.ServiceFilters) bool {
+			_, foundSuccessfulService := filters.UUIDs[successfulServiceUuid]
+			return len(filters.Statuses) == 0 && len(filters.Names) == 0 && len(filters.UUIDs) == 1 && foundSuccessfulService
+		})).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			successfulServiceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil)
 
-	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
-	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition0))
-	require.Nil(t, network.topology.AddService(successfulService.GetName(), partition1))
-	require.Nil(t, network.topology.AddService(serviceAlreadyInPartition.GetName(), partition0))
-	require.Nil(t, network.topology.AddService(serviceToMoveOutOfDefaultPartition.GetName(), partition_topology.DefaultPartitionId))
+	for _, serviceUuid := range []service.ServiceUUID{successfulServiceUuid, failedServiceUuid} {
+		backend.EXPECT().UnregisterUserServices(
+			ctx,
+			enclaveName,
+			map[service.ServiceUUID]bool{
+				serviceUuid: true,
+			},
+		).Times(1).Return(
+			map[service.ServiceUUID]bool{
+				serviceUuid: true,
+			},
+			map[service.ServiceUUID]error{},
+			nil,
+		)
+	}
 
-	network.registeredServiceInfo[successfulService.GetName()] = successfulService
-	network.registeredServiceInfo[serviceAlreadyInPartition.GetName()] = serviceAlreadyInPartition
-	network.registeredServiceInfo[serviceToMoveOutOfDefaultPartition.GetName()] = serviceToMoveOutOfDefaultPartition
+	success, failure, err := network.StartServices(
+		ctx,
+		map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+			successfulServiceName: successfulServiceConfig,
+			failedServiceName:     failedServiceConfig,
+		},
+		2,
+	)
+	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Len(t, failure, 1)
+	require.Contains(t, failure, failedServiceName)
+
+	require.Empty(t, network.registeredServiceInfo)
+	require.Empty(t, network.networkingSidecars)
+	require.Empty(t, network.servicesBeingStarted)
+}
+
+func TestStartServices_RegistrationFailureRollsBackTheEntireBatch(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	// One service will be registered successfully
+	registeredServiceIndex := 1
+	registeredServicePartitionId := testPartitionIdFromInt(registeredServiceIndex)
+	registeredServiceName := testServiceNameFromInt(registeredServiceIndex)
+	registeredServiceUuid := testServiceUuidFromInt(registeredServiceIndex)
+	registeredServiceIp := testIpFromInt(registeredServiceIndex)
+	registeredServiceRegistration := service.NewServiceRegistration(registeredServiceName, registeredServiceUuid, enclaveName, registeredServiceIp, string(registeredServiceName))
+	registeredServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(registeredServicePartitionId)).Build()
+
+	// One service will fail to be registered
+	failedServiceIndex := 2
+	failedServicePartitionId := testPartitionIdFromInt(failedServiceIndex)
+	failedServiceName := testServiceNameFromInt(failedServiceIndex)
+	failedServiceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithSubnetwork(string(failedServicePartitionId)).Build()
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	backend.EXPECT().RegisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceName]bool{
+			registeredServiceName: true,
+			failedServiceName:     true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]*service.ServiceRegistration{
+			registeredServiceName: registeredServiceRegistration,
+		},
+		map[service.ServiceName]error{
+			failedServiceName: stacktrace.NewError("No more free IP addresses"),
+		},
+		nil,
+	)
+
+	// The service that got registered is unregistered as the batch can't be started, and none gets started
+	backend.EXPECT().UnregisterUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]bool{
+			registeredServiceUuid: true,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			registeredServiceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	success, failure, err := network.StartServices(
+		ctx,
+		map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+			registeredServiceName: registeredServiceConfig,
+			failedServiceName:     failedServiceConfig,
+		},
+		2,
+	)
+	require.Nil(t, err)
+	require.Empty(t, success)
+	require.Len(t, failure, 1)
+	require.Contains(t, failure, failedServiceName)
+
+	require.Empty(t, network.registeredServiceInfo)
+	require.Empty(t, network.allExistingAndHistoricalIdentifiers)
+
+	expectedPartitionsInTopolody := map[service_network_types.PartitionID]map[service.ServiceName]bool{
+		partition_topology.DefaultPartitionId: {},
+	}
+	partitionServices, err := network.topology.GetPartitionServices()
+	require.Nil(t, err)
+	require.Equal(t, expectedPartitionsInTopolody, partitionServices)
+}
+
+func TestUpdateService(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition0 := service_network_types.PartitionID("partition0")
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	// service that will be successfully moved from partition1 to partition2
+	successfulServiceIndex := 1
+	successfulService := service.NewServiceRegistration(
+		testServiceNameFromInt(successfulServiceIndex),
+		testServiceUuidFromInt(successfulServiceIndex),
+		enclaveName,
+		testIpFromInt(successfulServiceIndex),
+		testServiceHostnameFromInt(successfulServiceIndex))
+
+	// service that will be in partition0 from the start and be updated to partition0 (i.e. it will no-op)
+	serviceAlreadyInPartitionIndex := 2
+	serviceAlreadyInPartition := service.NewServiceRegistration(
+		testServiceNameFromInt(serviceAlreadyInPartitionIndex),
+		testServiceUuidFromInt(serviceAlreadyInPartitionIndex),
+		enclaveName,
+		testIpFromInt(serviceAlreadyInPartitionIndex),
+		testServiceHostnameFromInt(serviceAlreadyInPartitionIndex))
+
+	// service that does not exist, and yet we will try to update it. It should fail
+	nonExistentServiceIndex := 3
+	nonExistentService := service.NewServiceRegistration(
+		testServiceNameFromInt(nonExistentServiceIndex),
+		testServiceUuidFromInt(nonExistentServiceIndex),
+		enclaveName,
+		testIpFromInt(nonExistentServiceIndex),
+		testServiceHostnameFromInt(nonExistentServiceIndex))
+
+	// service that will be moved from default partition to partition2
+	serviceToMoveOutOfDefaultPartitionIndex := 4
+	serviceToMoveOutOfDefaultPartition := service.NewServiceRegistration(
+		testServiceNameFromInt(serviceToMoveOutOfDefaultPartitionIndex),
+		testServiceUuidFromInt(serviceToMoveOutOfDefaultPartitionIndex),
+		enclaveName,
+		testIpFromInt(serviceToMoveOutOfDefaultPartitionIndex),
+		testServiceHostnameFromInt(serviceToMoveOutOfDefaultPartitionIndex))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition0))
+	require.Nil(t, network.topology.AddService(successfulService.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(serviceAlreadyInPartition.GetName(), partition0))
+	require.Nil(t, network.topology.AddService(serviceToMoveOutOfDefaultPartition.GetName(), partition_topology.DefaultPartitionId))
+
+	network.registeredServiceInfo[successfulService.GetName()] = successfulService
+	network.registeredServiceInfo[serviceAlreadyInPartition.GetName()] = serviceAlreadyInPartition
+	network.registeredServiceInfo[serviceToMoveOutOfDefaultPartition.GetName()] = serviceToMoveOutOfDefaultPartition
 	// nonExistentService don't get added here as it is supposed to be an unknown service
 
 	network.networkingSidecars[successfulService.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
@@ -938,6 +1211,7 @@ func TestUpdateService_FullBatchFailureRollBack(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -970,8 +1244,12 @@ func TestUpdateService_FullBatchFailureRollBack(t *testing.T) {
 	network.registeredServiceInfo[failingService.GetName()] = failingService
 	network.registeredServiceInfo[successfulService.GetName()] = successfulService
 
-	// do not add sidecar for failingService so that it fails updating the connections
+	// do not add sidecar for failingService, and make creating it fail so that it fails updating the connections
 	network.networkingSidecars[successfulService.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
+	backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, failingService.GetUUID()).Times(1).Return(
+		nil,
+		stacktrace.NewError("Failed creating sidecar"))
 
 	success, failure, err := network.UpdateService(ctx, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
 		failingService.GetName():    binding_constructors.NewUpdateServiceConfig(string(partition2)),
@@ -996,6 +1274,75 @@ func TestUpdateService_FullBatchFailureRollBack(t *testing.T) {
 	require.Equal(t, expectedPartitions, partitionServices)
 }
 
+func TestUpdateService_RecreatesContainerKeepingRegistration(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	serviceIndex := 1
+	serviceRegistration := service.NewServiceRegistration(
+		testServiceNameFromInt(serviceIndex),
+		testServiceUuidFromInt(serviceIndex),
+		enclaveName,
+		testIpFromInt(serviceIndex),
+		testServiceHostnameFromInt(serviceIndex))
+	require.Nil(t, network.topology.AddService(serviceRegistration.GetName(), partition_topology.DefaultPartitionId))
+	network.registeredServiceInfo[serviceRegistration.GetName()] = serviceRegistration
+	network.serviceConfigs[serviceRegistration.GetName()] = services.NewServiceConfigBuilder(testContainerImageName).WithEnvVars(map[string]string{
+		"LOG_LEVEL": "info",
+	}).Build()
+
+	updatedContainerImageName := testContainerImageName + ":updated"
+	updatedEnvVars := map[string]string{
+		"LOG_LEVEL": "debug",
+	}
+	backend.EXPECT().ReplaceUserServices(ctx, enclaveName, mock.MatchedBy(func(serviceConfigs map[service.ServiceUUID]*service.ServiceConfig) bool {
+		serviceConfig, found := serviceConfigs[serviceRegistration.GetUUID()]
+		return found && len(serviceConfigs) == 1 &&
+			serviceConfig.GetContainerImageName() == updatedContainerImageName &&
+			serviceConfig.GetEnvVars()["LOG_LEVEL"] == "debug"
+	})).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceRegistration.GetUUID(): service.NewService(serviceRegistration, container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	success, failure, err := network.UpdateService(ctx, map[service.ServiceName]*kurtosis_core_rpc_api_bindings.UpdateServiceConfig{
+		serviceRegistration.GetName(): binding_constructors.NewContainerUpdateServiceConfig(nil, &updatedContainerImageName, nil, updatedEnvVars),
+	})
+	require.Nil(t, err)
+	require.Empty(t, failure)
+	require.Contains(t, success, serviceRegistration.GetName())
+
+	updatedServiceConfig := network.serviceConfigs[serviceRegistration.GetName()]
+	require.Equal(t, updatedContainerImageName, updatedServiceConfig.GetContainerImageName())
+	require.Equal(t, updatedEnvVars, updatedServiceConfig.GetEnvVars())
+	require.Equal(t, serviceRegistration, network.registeredServiceInfo[serviceRegistration.GetName()])
+}
+
 func TestSetDefaultConnection(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -1014,6 +1361,7 @@ func TestSetDefaultConnection(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1025,7 +1373,7 @@ func TestSetDefaultConnection(t *testing.T) {
 	require.Nil(t, network.topology.AddService("test-service", "test-partition"))
 	network.networkingSidecars["test-service"] = networking_sidecar.NewMockNetworkingSidecarWrapper()
 
-	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 	err = network.SetDefaultConnection(ctx, newDefaultConnection)
 	require.Nil(t, err)
 	require.Equal(t, network.topology.GetDefaultConnection(), newDefaultConnection)
@@ -1049,6 +1397,7 @@ func TestSetDefaultConnection_FailureRollbackDefaultConnection(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1060,7 +1409,7 @@ func TestSetDefaultConnection_FailureRollbackDefaultConnection(t *testing.T) {
 	require.Nil(t, network.topology.AddService("test-service", "test-partition"))
 	// not add the sidecar such that it won't be able to update the networking rules
 
-	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	newDefaultConnection := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 	err = network.SetDefaultConnection(ctx, newDefaultConnection)
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
@@ -1086,6 +1435,7 @@ func TestSetConnection(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1122,7 +1472,7 @@ func TestSetConnection(t *testing.T) {
 	network.networkingSidecars[service1.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
 	network.networkingSidecars[service2.GetName()] = networking_sidecar.NewMockNetworkingSidecarWrapper()
 
-	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay)
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 	err = network.SetConnection(ctx, partition1, partition2, connectionOverride)
 	require.Nil(t, err)
 
@@ -1150,6 +1500,7 @@ func TestSetConnection_FailureRollsBackChanges(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1183,9 +1534,12 @@ func TestSetConnection_FailureRollsBackChanges(t *testing.T) {
 	network.registeredServiceInfo[service1.GetName()] = service1
 	network.registeredServiceInfo[service2.GetName()] = service2
 
-	// do not add any sidecar such that updating network traffic will throw an exception
+	// do not add any sidecar, and make creating them fail such that updating network traffic will throw an exception
+	backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, mock.Anything).Times(1).Return(
+		nil,
+		stacktrace.NewError("Failed creating sidecar"))
 
-	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 	err = network.SetConnection(ctx, partition1, partition2, connectionOverride)
 	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
 
@@ -1212,6 +1566,7 @@ func TestUnsetConnection(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1237,7 +1592,7 @@ func TestUnsetConnection(t *testing.T) {
 		testIpFromInt(service2Index),
 		testServiceHostnameFromInt(service2Index))
 
-	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay)
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
 	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
 	require.Nil(t, network.topology.SetConnection(partition1, partition2, connectionOverride))
@@ -1258,6 +1613,49 @@ func TestUnsetConnection(t *testing.T) {
 	require.Equal(t, partition_topology.ConnectionAllowed, currentConnectionOverride)
 }
 
+func TestGetSubnetworkConnections(t *testing.T) {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+	partition3 := service_network_types.PartitionID("partition3")
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, connectionWithSomeConstantDelay, partition_topology.ConnectionWithNoBandwidthLimit)
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition3))
+	require.Nil(t, network.topology.SetConnection(partition2, partition1, connectionOverride))
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
+
+	defaultConnection, connectionOverrides, err := network.GetSubnetworkConnections()
+	require.Nil(t, err)
+	require.Equal(t, partition_topology.ConnectionBlocked, defaultConnection)
+	expectedConnectionOverrides := map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection{
+		*service_network_types.NewPartitionConnectionID(partition1, partition2): connectionOverride,
+	}
+	require.Equal(t, expectedConnectionOverrides, connectionOverrides)
+}
+
 func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -1276,6 +1674,7 @@ func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 		apiContainerPort,
 		fakeApiContainerVersion,
 		partitioningEnabled,
+		hostDirMountsAllowed,
 		backend,
 		unusedEnclaveDataDir,
 		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
@@ -1301,7 +1700,7 @@ func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 		testIpFromInt(service2Index),
 		testServiceHostnameFromInt(service2Index))
 
-	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay)
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
 	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition2))
 	require.Nil(t, network.topology.SetConnection(partition1, partition2, connectionOverride))
@@ -1311,7 +1710,12 @@ func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 	network.registeredServiceInfo[service1.GetName()] = service1
 	network.registeredServiceInfo[service2.GetName()] = service2
 
-	// do not add any sidecar such that updating network traffic will throw an exception
+	// do not add any sidecar, and make creating them fail such that updating network traffic will throw an exception.
+	// The default connection is blocked so that the partitions still need sidecars once the override is unset
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
+	backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, mock.Anything).Times(1).Return(
+		nil,
+		stacktrace.NewError("Failed creating sidecar"))
 
 	err = network.UnsetConnection(ctx, partition1, partition2)
 	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
@@ -1322,20 +1726,744 @@ func TestUnsetConnection_FailureRollsBackChanges(t *testing.T) {
 	require.Equal(t, connectionOverride, currentConnectionOverride)
 }
 
-func TestUpdateTrafficControl(t *testing.T) {
+func TestSetConnection_OnlyUpdatesServicesInTheConnectedPartitions(t *testing.T) {
 	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
 
-	enclaveId := enclave.EnclaveUUID("test")
-
-	sidecars := map[service.ServiceName]networking_sidecar.NetworkingSidecarWrapper{}
-	registrations := map[service.ServiceName]*service.ServiceRegistration{}
-	mockSidecars := map[service.ServiceName]*networking_sidecar.MockNetworkingSidecarWrapper{}
-	for i := 0; i < numServices; i++ {
-		serviceName := testServiceNameFromInt(i)
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
 
-		sidecar := networking_sidecar.NewMockNetworkingSidecarWrapper()
-		sidecars[serviceName] = sidecar
-		mockSidecars[serviceName] = sidecar
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	// one partition and one service per index, each with a sidecar recording its updates
+	mockSidecars := map[service.ServiceName]*networking_sidecar.MockNetworkingSidecarWrapper{}
+	for i := 1; i <= 3; i++ {
+		serviceRegistration := service.NewServiceRegistration(
+			testServiceNameFromInt(i),
+			testServiceUuidFromInt(i),
+			enclaveName,
+			testIpFromInt(i),
+			testServiceHostnameFromInt(i))
+		require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(testPartitionIdFromInt(i)))
+		require.Nil(t, network.topology.AddService(serviceRegistration.GetName(), testPartitionIdFromInt(i)))
+		network.registeredServiceInfo[serviceRegistration.GetName()] = serviceRegistration
+		sidecar := networking_sidecar.NewMockNetworkingSidecarWrapper()
+		network.networkingSidecars[serviceRegistration.GetName()] = sidecar
+		mockSidecars[serviceRegistration.GetName()] = sidecar
+	}
+
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
+	require.Nil(t, network.SetConnection(ctx, testPartitionIdFromInt(1), testPartitionIdFromInt(2), connectionOverride))
+
+	require.Len(t, mockSidecars[testServiceNameFromInt(1)].GetRecordedUpdatedPacketConnectionConfig(), 1)
+	require.Len(t, mockSidecars[testServiceNameFromInt(2)].GetRecordedUpdatedPacketConnectionConfig(), 1)
+	require.Empty(t, mockSidecars[testServiceNameFromInt(3)].GetRecordedUpdatedPacketConnectionConfig())
+}
+
+func TestSetConnection_SkipsServicesBeingStarted(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	for i := 1; i <= 2; i++ {
+		serviceRegistration := service.NewServiceRegistration(
+			testServiceNameFromInt(i),
+			testServiceUuidFromInt(i),
+			enclaveName,
+			testIpFromInt(i),
+			testServiceHostnameFromInt(i))
+		require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(testPartitionIdFromInt(i)))
+		require.Nil(t, network.topology.AddService(serviceRegistration.GetName(), testPartitionIdFromInt(i)))
+		network.registeredServiceInfo[serviceRegistration.GetName()] = serviceRegistration
+	}
+	runningServiceSidecar := networking_sidecar.NewMockNetworkingSidecarWrapper()
+	network.networkingSidecars[testServiceNameFromInt(1)] = runningServiceSidecar
+	// the second service has no container yet, so trying to create its sidecar would hit the backend mock
+	network.setServiceBeingStarted(testServiceNameFromInt(2), true)
+
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
+	require.Nil(t, network.SetConnection(ctx, testPartitionIdFromInt(1), testPartitionIdFromInt(2), connectionOverride))
+
+	require.Len(t, runningServiceSidecar.GetRecordedUpdatedPacketConnectionConfig(), 1)
+	require.NotContains(t, network.networkingSidecars, testServiceNameFromInt(2))
+}
+
+func TestMovePartition_OnlyUpdatesAffectedServices(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	// partition1: service1, partition2: service2, partition3: service3 and service4, partition4: service5
+	servicePartitionIndices := map[int]int{1: 1, 2: 2, 3: 3, 4: 3, 5: 4}
+	for i := 1; i <= 4; i++ {
+		require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(testPartitionIdFromInt(i)))
+	}
+	mockSidecars := map[service.ServiceName]*networking_sidecar.MockNetworkingSidecarWrapper{}
+	for serviceIndex, partitionIndex := range servicePartitionIndices {
+		serviceRegistration := service.NewServiceRegistration(
+			testServiceNameFromInt(serviceIndex),
+			testServiceUuidFromInt(serviceIndex),
+			enclaveName,
+			testIpFromInt(serviceIndex),
+			testServiceHostnameFromInt(serviceIndex))
+		require.Nil(t, network.topology.AddService(serviceRegistration.GetName(), testPartitionIdFromInt(partitionIndex)))
+		network.registeredServiceInfo[serviceRegistration.GetName()] = serviceRegistration
+		sidecar := networking_sidecar.NewMockNetworkingSidecarWrapper()
+		network.networkingSidecars[serviceRegistration.GetName()] = sidecar
+		mockSidecars[serviceRegistration.GetName()] = sidecar
+	}
+	connectionOverride := partition_topology.NewPartitionConnection(connectionWithSomePacketLoss, partition_topology.ConnectionWithNoPacketDelay, partition_topology.ConnectionWithNoBandwidthLimit)
+	require.Nil(t, network.topology.SetConnection(testPartitionIdFromInt(1), testPartitionIdFromInt(2), connectionOverride))
+
+	// moving service4 from partition3 to partition2 changes the connection of partition1 to it, but not the one of partition4
+	err = network.MovePartition(ctx, testPartitionIdFromInt(2), map[service.ServiceName]bool{testServiceNameFromInt(4): true})
+	require.Nil(t, err)
+
+	servicePartitions, err := network.topology.GetServicePartitions()
+	require.Nil(t, err)
+	require.Equal(t, testPartitionIdFromInt(2), servicePartitions[testServiceNameFromInt(4)])
+	for serviceIndex := 1; serviceIndex <= 4; serviceIndex++ {
+		require.Len(t, mockSidecars[testServiceNameFromInt(serviceIndex)].GetRecordedUpdatedPacketConnectionConfig(), 1)
+	}
+	require.Empty(t, mockSidecars[testServiceNameFromInt(5)].GetRecordedUpdatedPacketConnectionConfig())
+
+	expectedService1Connections := map[string]*partition_topology.PartitionConnection{
+		testIpFromInt(2).String(): &connectionOverride,
+		testIpFromInt(3).String(): &partition_topology.ConnectionAllowed,
+		testIpFromInt(4).String(): &connectionOverride,
+		testIpFromInt(5).String(): &partition_topology.ConnectionAllowed,
+	}
+	require.Equal(t, expectedService1Connections, mockSidecars[testServiceNameFromInt(1)].GetRecordedUpdatedPacketConnectionConfig()[0])
+}
+
+func TestAddPartition_FailureRollsBackChanges(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	partition1 := service_network_types.PartitionID("partition1")
+	partition2 := service_network_types.PartitionID("partition2")
+
+	service1Index := 1
+	service1 := service.NewServiceRegistration(
+		testServiceNameFromInt(service1Index),
+		testServiceUuidFromInt(service1Index),
+		enclaveName,
+		testIpFromInt(service1Index),
+		testServiceHostnameFromInt(service1Index))
+	service2Index := 2
+	service2 := service.NewServiceRegistration(
+		testServiceNameFromInt(service2Index),
+		testServiceUuidFromInt(service2Index),
+		enclaveName,
+		testIpFromInt(service2Index),
+		testServiceHostnameFromInt(service2Index))
+
+	require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(partition1))
+	require.Nil(t, network.topology.AddService(service1.GetName(), partition1))
+	require.Nil(t, network.topology.AddService(service2.GetName(), partition1))
+
+	network.registeredServiceInfo[service1.GetName()] = service1
+	network.registeredServiceInfo[service2.GetName()] = service2
+
+	// do not add any sidecar, and make creating them fail such that updating network traffic will throw an exception.
+	// The default connection is blocked so that the services need sidecars once they are in different partitions
+	network.topology.SetDefaultConnection(partition_topology.ConnectionBlocked)
+	backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, mock.Anything).Times(1).Return(
+		nil,
+		stacktrace.NewError("Failed creating sidecar"))
+
+	err = network.AddPartition(ctx, partition2, map[service.ServiceName]bool{service2.GetName(): true})
+	require.Contains(t, err.Error(), "Unable to update connections between the different partitions of the topology")
+
+	partitionServices, err := network.topology.GetPartitionServices()
+	require.Nil(t, err)
+	require.NotContains(t, partitionServices, partition2)
+	require.Equal(t, map[service.ServiceName]bool{service1.GetName(): true, service2.GetName(): true}, partitionServices[partition1])
+}
+
+func TestPauseServices(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	for i := 1; i <= 3; i++ {
+		network.registeredServiceInfo[testServiceNameFromInt(i)] = service.NewServiceRegistration(
+			testServiceNameFromInt(i),
+			testServiceUuidFromInt(i),
+			enclaveName,
+			testIpFromInt(i),
+			testServiceHostnameFromInt(i))
+	}
+
+	// service 1 is identified by name and service 2 by UUID; service 3 must be left alone
+	expectedFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			testServiceUuidFromInt(1): true,
+			testServiceUuidFromInt(2): true,
+		},
+		Statuses: nil,
+	}
+	pauseErr := stacktrace.NewError("Failed pausing the container")
+	backend.EXPECT().PauseServices(ctx, enclaveName, expectedFilters).Times(1).Return(
+		map[service.ServiceUUID]bool{testServiceUuidFromInt(1): true},
+		map[service.ServiceUUID]error{testServiceUuidFromInt(2): pauseErr},
+		nil)
+
+	serviceIdentifiers := map[string]bool{
+		string(testServiceNameFromInt(1)): true,
+		string(testServiceUuidFromInt(2)): true,
+	}
+	successfulServices, failedServices, err := network.PauseServices(ctx, serviceIdentifiers)
+	require.Nil(t, err)
+	require.Equal(t, map[service.ServiceName]bool{testServiceNameFromInt(1): true}, successfulServices)
+	require.Equal(t, map[service.ServiceName]error{testServiceNameFromInt(2): pauseErr}, failedServices)
+}
+
+func TestPauseServices_NoIdentifierPausesNothing(t *testing.T) {
+	ctx := context.Background()
+	// the mock fails the test if the backend gets called, as empty filters would match all the services
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	successfulServices, failedServices, err := network.PauseServices(ctx, map[string]bool{})
+	require.Nil(t, err)
+	require.Empty(t, successfulServices)
+	require.Empty(t, failedServices)
+}
+
+func TestRemoveServices(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	for i := 1; i <= 3; i++ {
+		network.registeredServiceInfo[testServiceNameFromInt(i)] = service.NewServiceRegistration(
+			testServiceNameFromInt(i),
+			testServiceUuidFromInt(i),
+			enclaveName,
+			testIpFromInt(i),
+			testServiceHostnameFromInt(i))
+	}
+
+	// service 1 is identified by name and service 2 by UUID; both get stopped with a single call, and service 3 must
+	// be left alone
+	expectedFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			testServiceUuidFromInt(1): true,
+			testServiceUuidFromInt(2): true,
+		},
+		Statuses: nil,
+	}
+	stopErr := stacktrace.NewError("Failed stopping the container")
+	backend.EXPECT().StopUserServices(ctx, enclaveName, expectedFilters).Times(1).Return(
+		map[service.ServiceUUID]bool{testServiceUuidFromInt(1): true},
+		map[service.ServiceUUID]error{testServiceUuidFromInt(2): stopErr},
+		nil)
+
+	serviceIdentifiers := map[string]bool{
+		string(testServiceNameFromInt(1)): true,
+		string(testServiceUuidFromInt(2)): true,
+	}
+	removedServices, failedServices, err := network.RemoveServices(ctx, serviceIdentifiers)
+	require.Nil(t, err)
+	require.Equal(t, map[service.ServiceName]service.ServiceUUID{testServiceNameFromInt(1): testServiceUuidFromInt(1)}, removedServices)
+	require.Len(t, failedServices, 1)
+	require.Contains(t, failedServices, testServiceNameFromInt(2))
+
+	require.NotContains(t, network.registeredServiceInfo, testServiceNameFromInt(1))
+	require.Contains(t, network.registeredServiceInfo, testServiceNameFromInt(3))
+}
+
+func TestTestConnectivity(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	// partition1: service1, which has a sidecar, partition2: service2 and service3, blocked from partition1
+	servicePartitionIndices := map[int]int{1: 1, 2: 2, 3: 2}
+	for i := 1; i <= 2; i++ {
+		require.Nil(t, network.topology.CreateEmptyPartitionWithDefaultConnection(testPartitionIdFromInt(i)))
+	}
+	for serviceIndex, partitionIndex := range servicePartitionIndices {
+		serviceRegistration := service.NewServiceRegistration(
+			testServiceNameFromInt(serviceIndex),
+			testServiceUuidFromInt(serviceIndex),
+			enclaveName,
+			testIpFromInt(serviceIndex),
+			testServiceHostnameFromInt(serviceIndex))
+		require.Nil(t, network.topology.AddService(serviceRegistration.GetName(), testPartitionIdFromInt(partitionIndex)))
+		network.registeredServiceInfo[serviceRegistration.GetName()] = serviceRegistration
+	}
+	network.networkingSidecars[testServiceNameFromInt(1)] = networking_sidecar.NewMockNetworkingSidecarWrapper()
+	require.Nil(t, network.topology.SetConnection(testPartitionIdFromInt(1), testPartitionIdFromInt(2), partition_topology.ConnectionBlocked))
+
+	blockedPingOutput := "3 packets transmitted, 0 packets received, 100% packet loss\n"
+	allowedPingOutput := "3 packets transmitted, 3 packets received, 0% packet loss\nround-trip min/avg/max = 0.063/0.075/0.089 ms\n"
+	backend.EXPECT().RunNetworkingSidecarExecCommands(ctx, enclaveName, mock.MatchedBy(func(commands map[service.ServiceUUID][]string) bool {
+		_, found := commands[testServiceUuidFromInt(1)]
+		return len(commands) == 1 && found
+	})).Times(1).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			testServiceUuidFromInt(1): exec_result.NewExecResult(1, connectivityProbeTargetMarker+string(testServiceNameFromInt(2))+"\n"+blockedPingOutput+
+				connectivityProbeTargetMarker+string(testServiceNameFromInt(3))+"\n"+blockedPingOutput),
+		},
+		map[service.ServiceUUID]error{},
+		nil)
+	execErr := stacktrace.NewError("Failed running the command")
+	backend.EXPECT().RunUserServiceExecCommands(ctx, enclaveName, mock.MatchedBy(func(commands map[service.ServiceUUID][]string) bool {
+		_, foundService2 := commands[testServiceUuidFromInt(2)]
+		_, foundService3 := commands[testServiceUuidFromInt(3)]
+		return len(commands) == 2 && foundService2 && foundService3
+	})).Times(1).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			// the ping to service1 output nothing, e.g. because 'ping' is missing in the container
+			testServiceUuidFromInt(2): exec_result.NewExecResult(0, connectivityProbeTargetMarker+string(testServiceNameFromInt(1))+"\n"+
+				connectivityProbeTargetMarker+string(testServiceNameFromInt(3))+"\n"+allowedPingOutput),
+		},
+		map[service.ServiceUUID]error{testServiceUuidFromInt(3): execErr},
+		nil)
+
+	probeResults, err := network.TestConnectivity(ctx, map[string]bool{}, 0)
+	require.Nil(t, err)
+	require.Len(t, probeResults, 3)
+
+	service1ToService2 := probeResults[testServiceNameFromInt(1)][testServiceNameFromInt(2)]
+	require.Nil(t, service1ToService2.GetMaybeProbeErr())
+	require.Equal(t, float32(100), service1ToService2.GetExpectedPacketLossPercentage())
+	require.Equal(t, float32(100), service1ToService2.GetObservedPacketLossPercentage())
+
+	service2ToService3 := probeResults[testServiceNameFromInt(2)][testServiceNameFromInt(3)]
+	require.Nil(t, service2ToService3.GetMaybeProbeErr())
+	require.Equal(t, float32(0), service2ToService3.GetExpectedPacketLossPercentage())
+	require.Equal(t, float32(0), service2ToService3.GetObservedPacketLossPercentage())
+	require.Equal(t, float32(0.075), service2ToService3.GetObservedAvgLatencyMs())
+
+	require.NotNil(t, probeResults[testServiceNameFromInt(2)][testServiceNameFromInt(1)].GetMaybeProbeErr())
+	for _, service3ProbeResult := range probeResults[testServiceNameFromInt(3)] {
+		require.Contains(t, service3ProbeResult.GetMaybeProbeErr().Error(), execErr.Error())
+	}
+}
+
+func TestCopyFilesFromServices(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	enclaveDataDirpath, err := os.MkdirTemp("", "enclave-data-dir-*")
+	require.Nil(t, err)
+	defer os.RemoveAll(enclaveDataDirpath)
+	enclaveDataDir := enclave_data_directory.NewEnclaveDataDirectory(enclaveDataDirpath)
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		enclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	srcPath := "/coverage"
+	srcPathsByService := map[service.ServiceName]string{}
+	for i := 0; i < 2; i++ {
+		registration := service.NewServiceRegistration(testServiceNameFromInt(i), testServiceUuidFromInt(i), enclaveName, testIpFromInt(i), testServiceHostnameFromInt(i))
+		network.registeredServiceInfo[registration.GetName()] = registration
+		srcPathsByService[registration.GetName()] = srcPath
+
+		fileContent := fmt.Sprintf("coverage of service %v", i)
+		backend.EXPECT().CopyFilesFromUserService(mock.Anything, enclaveName, registration.GetUUID(), srcPath, mock.Anything).RunAndReturn(
+			func(_ context.Context, _ enclave.EnclaveUUID, _ service.ServiceUUID, _ string, output io.Writer) error {
+				tarWriter := tar.NewWriter(output)
+				require.Nil(t, tarWriter.WriteHeader(&tar.Header{Name: "coverage/cover.out", Mode: 0644, Size: int64(len(fileContent))}))
+				_, err := tarWriter.Write([]byte(fileContent))
+				require.Nil(t, err)
+				return tarWriter.Close()
+			}).Times(1)
+	}
+
+	artifactName := "merged-coverage"
+	_, err = network.CopyFilesFromServices(ctx, srcPathsByService, artifactName)
+	require.Nil(t, err)
+
+	filesArtifactStore, err := enclaveDataDir.GetFilesArtifactStore()
+	require.Nil(t, err)
+	storedFile, err := filesArtifactStore.GetFile(artifactName)
+	require.Nil(t, err)
+	storedTgz, err := os.Open(storedFile.GetAbsoluteFilepath())
+	require.Nil(t, err)
+	defer storedTgz.Close()
+	gzipReader, err := gzip.NewReader(storedTgz)
+	require.Nil(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	storedFileContents := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(t, err)
+		content, err := io.ReadAll(tarReader)
+		require.Nil(t, err)
+		storedFileContents[header.Name] = string(content)
+	}
+	expectedFileContents := map[string]string{
+		fmt.Sprintf("%v/coverage/cover.out", testServiceNameFromInt(0)): "coverage of service 0",
+		fmt.Sprintf("%v/coverage/cover.out", testServiceNameFromInt(1)): "coverage of service 1",
+	}
+	require.Equal(t, expectedFileContents, storedFileContents)
+}
+
+func TestCopyFilesFromServices_UnknownService(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	_, err = network.CopyFilesFromServices(ctx, map[service.ServiceName]string{testServiceNameFromInt(0): "/coverage"}, "merged-coverage")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "does not exist in the network")
+}
+
+func TestExecCommandWithStreamedOutput(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	registration := service.NewServiceRegistration(testServiceNameFromInt(0), testServiceUuidFromInt(0), enclaveName, testIpFromInt(0), testServiceHostnameFromInt(0))
+	network.registeredServiceInfo[registration.GetName()] = registration
+
+	command := []string{"cat", "/data/dump.sql"}
+	backend.EXPECT().RunUserServiceExecCommandWithStreamedOutput(mock.Anything, enclaveName, registration.GetUUID(), command, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, _ service.ServiceUUID, _ []string, output io.Writer) (int32, error) {
+			for i := 0; i < 3; i++ {
+				_, err := output.Write([]byte(fmt.Sprintf("line %v\n", i)))
+				require.Nil(t, err)
+			}
+			return 2, nil
+		}).Times(1)
+
+	output := &strings.Builder{}
+	exitCode, err := network.ExecCommandWithStreamedOutput(ctx, string(registration.GetName()), command, output)
+	require.Nil(t, err)
+	require.Equal(t, int32(2), exitCode)
+	require.Equal(t, "line 0\nline 1\nline 2\n", output.String())
+
+	_, err = network.ExecCommandWithStreamedOutput(ctx, string(testServiceNameFromInt(1)), command, output)
+	require.NotNil(t, err)
+}
+
+func TestExecCommand_OnlyBlocksTheOperationsChangingTheSameService(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	execRegistration := service.NewServiceRegistration(testServiceNameFromInt(0), testServiceUuidFromInt(0), enclaveName, testIpFromInt(0), testServiceHostnameFromInt(0))
+	network.registeredServiceInfo[execRegistration.GetName()] = execRegistration
+	otherRegistration := service.NewServiceRegistration(testServiceNameFromInt(1), testServiceUuidFromInt(1), enclaveName, testIpFromInt(1), testServiceHostnameFromInt(1))
+	network.registeredServiceInfo[otherRegistration.GetName()] = otherRegistration
+
+	command := []string{"sleep", "3600"}
+	execStarted := make(chan bool)
+	execCanFinish := make(chan bool)
+	backend.EXPECT().RunUserServiceExecCommands(mock.Anything, enclaveName, map[service.ServiceUUID][]string{execRegistration.GetUUID(): command}).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, _ map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+			close(execStarted)
+			<-execCanFinish
+			return map[service.ServiceUUID]*exec_result.ExecResult{
+				execRegistration.GetUUID(): exec_result.NewExecResult(0, ""),
+			}, map[service.ServiceUUID]error{}, nil
+		}).Times(1)
+	backend.EXPECT().GetUserServices(mock.Anything, enclaveName, mock.Anything).Return(map[service.ServiceUUID]*service.Service{
+		otherRegistration.GetUUID(): service.NewService(otherRegistration, container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+	}, nil).Times(1)
+	backend.EXPECT().PauseService(mock.Anything, enclaveName, otherRegistration.GetUUID()).Return(nil).Times(1)
+	backend.EXPECT().PauseService(mock.Anything, enclaveName, execRegistration.GetUUID()).Return(nil).Times(1)
+
+	execErrChan := make(chan error, 1)
+	go func() {
+		_, _, err := network.ExecCommand(ctx, string(execRegistration.GetName()), command)
+		execErrChan <- err
+	}()
+	<-execStarted
+
+	// the other service can be operated on while the command runs
+	otherService, err := network.GetService(ctx, string(otherRegistration.GetName()))
+	require.Nil(t, err)
+	require.Equal(t, otherRegistration.GetUUID(), otherService.GetRegistration().GetUUID())
+	require.Nil(t, network.PauseService(ctx, string(otherRegistration.GetName())))
+	require.Len(t, network.GetServiceNames(), 2)
+
+	// whereas changing the service the command runs in waits for the command to complete
+	pauseErrChan := make(chan error, 1)
+	go func() {
+		pauseErrChan <- network.PauseService(ctx, string(execRegistration.GetName()))
+	}()
+	select {
+	case <-pauseErrChan:
+		require.Fail(t, "The service was paused while a command was running in it")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(execCanFinish)
+	require.Nil(t, <-execErrChan)
+	require.Nil(t, <-pauseErrChan)
+}
+
+func TestUpdateTrafficControl(t *testing.T) {
+	ctx := context.Background()
+
+	enclaveId := enclave.EnclaveUUID("test")
+
+	sidecars := map[service.ServiceName]networking_sidecar.NetworkingSidecarWrapper{}
+	registrations := map[service.ServiceName]*service.ServiceRegistration{}
+	mockSidecars := map[service.ServiceName]*networking_sidecar.MockNetworkingSidecarWrapper{}
+	for i := 0; i < numServices; i++ {
+		serviceName := testServiceNameFromInt(i)
+
+		sidecar := networking_sidecar.NewMockNetworkingSidecarWrapper()
+		sidecars[serviceName] = sidecar
+		mockSidecars[serviceName] = sidecar
 
 		ip := testIpFromInt(i)
 		serviceUuid := testServiceUuidFromInt(i)
@@ -1363,7 +2491,7 @@ func TestUpdateTrafficControl(t *testing.T) {
 				}
 
 				blockedServiceId := testServiceNameFromInt(j)
-				connectionConfig := partition_topology.NewPartitionConnection(packetLossConfigForBlockedPartition, partitionDelay)
+				connectionConfig := partition_topology.NewPartitionConnection(packetLossConfigForBlockedPartition, partitionDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 				partitionConnectionBetweenServices[blockedServiceId] = &connectionConfig
 			}
 		}
@@ -1389,7 +2517,7 @@ func TestUpdateTrafficControl(t *testing.T) {
 				}
 
 				ip := testIpFromInt(j)
-				connectionConfig := partition_topology.NewPartitionConnection(packetLossConfigForBlockedPartition, partitionDelay)
+				connectionConfig := partition_topology.NewPartitionConnection(packetLossConfigForBlockedPartition, partitionDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 				expected[ip.String()] = &connectionConfig
 			}
 		}
@@ -1403,6 +2531,103 @@ func TestUpdateTrafficControl(t *testing.T) {
 	}
 }
 
+func TestCheckUdpPortAvailability(t *testing.T) {
+	ctx := context.Background()
+	dialer := &net.Dialer{Timeout: portAvailabilityDialTimeout}
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listeningAddress := listener.LocalAddr().String()
+	require.NoError(t, checkUdpPortAvailability(ctx, dialer, listeningAddress))
+
+	// once nothing listens on the port anymore, the host refuses the datagrams sent to it
+	require.NoError(t, listener.Close())
+	require.Error(t, checkUdpPortAvailability(ctx, dialer, listeningAddress))
+}
+
+func TestToBackendServiceConfig_HostDirMountsRequireTheEnclaveToAllowThem(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	serviceUuid := testServiceUuidFromInt(1)
+	hostDirMounts := map[string]string{"/app/src": "/home/dev/project/src"}
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithHostDirMounts(hostDirMounts).Build()
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	for _, areHostDirMountsAllowed := range []bool{false, true} {
+		network, err := NewDefaultServiceNetwork(
+			enclaveName,
+			ip,
+			apiContainerPort,
+			fakeApiContainerVersion,
+			partitioningEnabled,
+			areHostDirMountsAllowed,
+			backend,
+			unusedEnclaveDataDir,
+			networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+			enclaveDb,
+		)
+		require.Nil(t, err)
+
+		backendServiceConfig, err := network.toBackendServiceConfig(serviceUuid, serviceConfig)
+		if !areHostDirMountsAllowed {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "--allow-host-dir-mounts")
+			continue
+		}
+		require.Nil(t, err)
+		require.Equal(t, hostDirMounts, backendServiceConfig.GetHostDirMounts())
+	}
+}
+
+func TestToBackendServiceConfig_MetricsPortIdMustBeAPrivatePort(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	serviceUuid := testServiceUuidFromInt(1)
+	privatePorts := map[string]*kurtosis_core_rpc_api_bindings.Port{
+		"prometheus": binding_constructors.NewPort(9100, kurtosis_core_rpc_api_bindings.Port_TCP, ""),
+	}
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		ip,
+		apiContainerPort,
+		fakeApiContainerVersion,
+		partitioningEnabled,
+		hostDirMountsAllowed,
+		backend,
+		unusedEnclaveDataDir,
+		networking_sidecar.NewStandardNetworkingSidecarManager(backend, enclaveName),
+		enclaveDb,
+	)
+	require.Nil(t, err)
+
+	serviceConfig := services.NewServiceConfigBuilder(testContainerImageName).WithPrivatePorts(privatePorts).WithMetricsPortId(DefaultMetricsPortId).Build()
+	_, err = network.toBackendServiceConfig(serviceUuid, serviceConfig)
+	require.Error(t, err)
+	_, found := GetMetricsPortId(serviceConfig)
+	require.False(t, found)
+
+	serviceConfig = services.NewServiceConfigBuilder(testContainerImageName).WithPrivatePorts(privatePorts).WithMetricsPortId("prometheus").Build()
+	_, err = network.toBackendServiceConfig(serviceUuid, serviceConfig)
+	require.Nil(t, err)
+	metricsPortId, found := GetMetricsPortId(serviceConfig)
+	require.True(t, found)
+	require.Equal(t, "prometheus", metricsPortId)
+}
+
 func testIpFromInt(i int) net.IP {
 	return []byte{1, 1, 1, byte(i)}
 }
@@ -6,6 +6,8 @@ import (
 	context "context"
 	http "net/http"
 
+	io "io"
+
 	enclave_data_directory "github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 
 	kurtosis_core_rpc_api_bindings "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
@@ -32,6 +34,139 @@ func (_m *MockServiceNetwork) EXPECT() *MockServiceNetwork_Expecter {
 	return &MockServiceNetwork_Expecter{mock: &_m.Mock}
 }
 
+// AddPartition provides a mock function with given fields: ctx, partitionId, serviceNames
+func (_m *MockServiceNetwork) AddPartition(ctx context.Context, partitionId service_network_types.PartitionID, serviceNames map[service.ServiceName]bool) error {
+	ret := _m.Called(ctx, partitionId, serviceNames)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service_network_types.PartitionID, map[service.ServiceName]bool) error); ok {
+		r0 = rf(ctx, partitionId, serviceNames)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_AddPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPartition'
+type MockServiceNetwork_AddPartition_Call struct {
+	*mock.Call
+}
+
+// AddPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partitionId service_network_types.PartitionID
+//   - serviceNames map[service.ServiceName]bool
+func (_e *MockServiceNetwork_Expecter) AddPartition(ctx interface{}, partitionId interface{}, serviceNames interface{}) *MockServiceNetwork_AddPartition_Call {
+	return &MockServiceNetwork_AddPartition_Call{Call: _e.mock.On("AddPartition", ctx, partitionId, serviceNames)}
+}
+
+func (_c *MockServiceNetwork_AddPartition_Call) Run(run func(ctx context.Context, partitionId service_network_types.PartitionID, serviceNames map[service.ServiceName]bool)) *MockServiceNetwork_AddPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service_network_types.PartitionID), args[2].(map[service.ServiceName]bool))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_AddPartition_Call) Return(_a0 error) *MockServiceNetwork_AddPartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_AddPartition_Call) RunAndReturn(run func(context.Context, service_network_types.PartitionID, map[service.ServiceName]bool) error) *MockServiceNetwork_AddPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckServicePortAvailability provides a mock function with given fields: ctx, serviceIdentifier, portId
+func (_m *MockServiceNetwork) CheckServicePortAvailability(ctx context.Context, serviceIdentifier string, portId string) error {
+	ret := _m.Called(ctx, serviceIdentifier, portId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, serviceIdentifier, portId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CheckServicePortAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckServicePortAvailability'
+type MockServiceNetwork_CheckServicePortAvailability_Call struct {
+	*mock.Call
+}
+
+// CheckServicePortAvailability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - portId string
+func (_e *MockServiceNetwork_Expecter) CheckServicePortAvailability(ctx interface{}, serviceIdentifier interface{}, portId interface{}) *MockServiceNetwork_CheckServicePortAvailability_Call {
+	return &MockServiceNetwork_CheckServicePortAvailability_Call{Call: _e.mock.On("CheckServicePortAvailability", ctx, serviceIdentifier, portId)}
+}
+
+func (_c *MockServiceNetwork_CheckServicePortAvailability_Call) Run(run func(ctx context.Context, serviceIdentifier string, portId string)) *MockServiceNetwork_CheckServicePortAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CheckServicePortAvailability_Call) Return(_a0 error) *MockServiceNetwork_CheckServicePortAvailability_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CheckServicePortAvailability_Call) RunAndReturn(run func(context.Context, string, string) error) *MockServiceNetwork_CheckServicePortAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyFilesArtifactToService provides a mock function with given fields: ctx, serviceIdentifier, artifactIdentifier, dstDirpath
+func (_m *MockServiceNetwork) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, artifactIdentifier string, dstDirpath string) error {
+	ret := _m.Called(ctx, serviceIdentifier, artifactIdentifier, dstDirpath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, serviceIdentifier, artifactIdentifier, dstDirpath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_CopyFilesArtifactToService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesArtifactToService'
+type MockServiceNetwork_CopyFilesArtifactToService_Call struct {
+	*mock.Call
+}
+
+// CopyFilesArtifactToService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - artifactIdentifier string
+//   - dstDirpath string
+func (_e *MockServiceNetwork_Expecter) CopyFilesArtifactToService(ctx interface{}, serviceIdentifier interface{}, artifactIdentifier interface{}, dstDirpath interface{}) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	return &MockServiceNetwork_CopyFilesArtifactToService_Call{Call: _e.mock.On("CopyFilesArtifactToService", ctx, serviceIdentifier, artifactIdentifier, dstDirpath)}
+}
+
+func (_c *MockServiceNetwork_CopyFilesArtifactToService_Call) Run(run func(ctx context.Context, serviceIdentifier string, artifactIdentifier string, dstDirpath string)) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesArtifactToService_Call) Return(_a0 error) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesArtifactToService_Call) RunAndReturn(run func(context.Context, string, string, string) error) *MockServiceNetwork_CopyFilesArtifactToService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromService provides a mock function with given fields: ctx, serviceIdentifier, srcPath, artifactName
 func (_m *MockServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	ret := _m.Called(ctx, serviceIdentifier, srcPath, artifactName)
@@ -87,6 +222,60 @@ func (_c *MockServiceNetwork_CopyFilesFromService_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// CopyFilesFromServices provides a mock function with given fields: ctx, srcPathsByService, artifactName
+func (_m *MockServiceNetwork) CopyFilesFromServices(ctx context.Context, srcPathsByService map[service.ServiceName]string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	ret := _m.Called(ctx, srcPathsByService, artifactName)
+
+	var r0 enclave_data_directory.FilesArtifactUUID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]string, string) (enclave_data_directory.FilesArtifactUUID, error)); ok {
+		return rf(ctx, srcPathsByService, artifactName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]string, string) enclave_data_directory.FilesArtifactUUID); ok {
+		r0 = rf(ctx, srcPathsByService, artifactName)
+	} else {
+		r0 = ret.Get(0).(enclave_data_directory.FilesArtifactUUID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[service.ServiceName]string, string) error); ok {
+		r1 = rf(ctx, srcPathsByService, artifactName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_CopyFilesFromServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesFromServices'
+type MockServiceNetwork_CopyFilesFromServices_Call struct {
+	*mock.Call
+}
+
+// CopyFilesFromServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - srcPathsByService map[service.ServiceName]string
+//   - artifactName string
+func (_e *MockServiceNetwork_Expecter) CopyFilesFromServices(ctx interface{}, srcPathsByService interface{}, artifactName interface{}) *MockServiceNetwork_CopyFilesFromServices_Call {
+	return &MockServiceNetwork_CopyFilesFromServices_Call{Call: _e.mock.On("CopyFilesFromServices", ctx, srcPathsByService, artifactName)}
+}
+
+func (_c *MockServiceNetwork_CopyFilesFromServices_Call) Run(run func(ctx context.Context, srcPathsByService map[service.ServiceName]string, artifactName string)) *MockServiceNetwork_CopyFilesFromServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[service.ServiceName]string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesFromServices_Call) Return(_a0 enclave_data_directory.FilesArtifactUUID, _a1 error) *MockServiceNetwork_CopyFilesFromServices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_CopyFilesFromServices_Call) RunAndReturn(run func(context.Context, map[service.ServiceName]string, string) (enclave_data_directory.FilesArtifactUUID, error)) *MockServiceNetwork_CopyFilesFromServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecCommand provides a mock function with given fields: ctx, serviceIdentifier, command
 func (_m *MockServiceNetwork) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
 	ret := _m.Called(ctx, serviceIdentifier, command)
@@ -148,6 +337,61 @@ func (_c *MockServiceNetwork_ExecCommand_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ExecCommandWithStreamedOutput provides a mock function with given fields: ctx, serviceIdentifier, command, output
+func (_m *MockServiceNetwork) ExecCommandWithStreamedOutput(ctx context.Context, serviceIdentifier string, command []string, output io.Writer) (int32, error) {
+	ret := _m.Called(ctx, serviceIdentifier, command, output)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, io.Writer) (int32, error)); ok {
+		return rf(ctx, serviceIdentifier, command, output)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, io.Writer) int32); ok {
+		r0 = rf(ctx, serviceIdentifier, command, output)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, io.Writer) error); ok {
+		r1 = rf(ctx, serviceIdentifier, command, output)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_ExecCommandWithStreamedOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecCommandWithStreamedOutput'
+type MockServiceNetwork_ExecCommandWithStreamedOutput_Call struct {
+	*mock.Call
+}
+
+// ExecCommandWithStreamedOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - command []string
+//   - output io.Writer
+func (_e *MockServiceNetwork_Expecter) ExecCommandWithStreamedOutput(ctx interface{}, serviceIdentifier interface{}, command interface{}, output interface{}) *MockServiceNetwork_ExecCommandWithStreamedOutput_Call {
+	return &MockServiceNetwork_ExecCommandWithStreamedOutput_Call{Call: _e.mock.On("ExecCommandWithStreamedOutput", ctx, serviceIdentifier, command, output)}
+}
+
+func (_c *MockServiceNetwork_ExecCommandWithStreamedOutput_Call) Run(run func(ctx context.Context, serviceIdentifier string, command []string, output io.Writer)) *MockServiceNetwork_ExecCommandWithStreamedOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_ExecCommandWithStreamedOutput_Call) Return(_a0 int32, _a1 error) *MockServiceNetwork_ExecCommandWithStreamedOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_ExecCommandWithStreamedOutput_Call) RunAndReturn(run func(context.Context, string, []string, io.Writer) (int32, error)) *MockServiceNetwork_ExecCommandWithStreamedOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExistingAndHistoricalServiceIdentifiers provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers {
 	ret := _m.Called()
@@ -191,6 +435,60 @@ func (_c *MockServiceNetwork_GetExistingAndHistoricalServiceIdentifiers_Call) Ru
 	return _c
 }
 
+// GetScrapeTargets provides a mock function with given fields: ctx
+func (_m *MockServiceNetwork) GetScrapeTargets(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*kurtosis_core_rpc_api_bindings.ScrapeTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*kurtosis_core_rpc_api_bindings.ScrapeTarget); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*kurtosis_core_rpc_api_bindings.ScrapeTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetScrapeTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScrapeTargets'
+type MockServiceNetwork_GetScrapeTargets_Call struct {
+	*mock.Call
+}
+
+// GetScrapeTargets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockServiceNetwork_Expecter) GetScrapeTargets(ctx interface{}) *MockServiceNetwork_GetScrapeTargets_Call {
+	return &MockServiceNetwork_GetScrapeTargets_Call{Call: _e.mock.On("GetScrapeTargets", ctx)}
+}
+
+func (_c *MockServiceNetwork_GetScrapeTargets_Call) Run(run func(ctx context.Context)) *MockServiceNetwork_GetScrapeTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetScrapeTargets_Call) Return(_a0 []*kurtosis_core_rpc_api_bindings.ScrapeTarget, _a1 error) *MockServiceNetwork_GetScrapeTargets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetScrapeTargets_Call) RunAndReturn(run func(context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error)) *MockServiceNetwork_GetScrapeTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -246,6 +544,61 @@ func (_c *MockServiceNetwork_GetService_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetServiceMetrics provides a mock function with given fields: ctx, serviceIdentifier
+func (_m *MockServiceNetwork) GetServiceMetrics(ctx context.Context, serviceIdentifier string) (*service.ServiceMetrics, error) {
+	ret := _m.Called(ctx, serviceIdentifier)
+
+	var r0 *service.ServiceMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*service.ServiceMetrics, error)); ok {
+		return rf(ctx, serviceIdentifier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *service.ServiceMetrics); ok {
+		r0 = rf(ctx, serviceIdentifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service.ServiceMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceIdentifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceMetrics'
+type MockServiceNetwork_GetServiceMetrics_Call struct {
+	*mock.Call
+}
+
+// GetServiceMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+func (_e *MockServiceNetwork_Expecter) GetServiceMetrics(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_GetServiceMetrics_Call {
+	return &MockServiceNetwork_GetServiceMetrics_Call{Call: _e.mock.On("GetServiceMetrics", ctx, serviceIdentifier)}
+}
+
+func (_c *MockServiceNetwork_GetServiceMetrics_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_GetServiceMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceMetrics_Call) Return(_a0 *service.ServiceMetrics, _a1 error) *MockServiceNetwork_GetServiceMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceMetrics_Call) RunAndReturn(run func(context.Context, string) (*service.ServiceMetrics, error)) *MockServiceNetwork_GetServiceMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServiceNames provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetServiceNames() map[service.ServiceName]bool {
 	ret := _m.Called()
@@ -289,6 +642,49 @@ func (_c *MockServiceNetwork_GetServiceNames_Call) RunAndReturn(run func() map[s
 	return _c
 }
 
+// GetServiceConfigs provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetServiceConfigs() map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig {
+	ret := _m.Called()
+
+	var r0 map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+	if rf, ok := ret.Get(0).(func() map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig)
+		}
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_GetServiceConfigs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceConfigs'
+type MockServiceNetwork_GetServiceConfigs_Call struct {
+	*mock.Call
+}
+
+// GetServiceConfigs is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetServiceConfigs() *MockServiceNetwork_GetServiceConfigs_Call {
+	return &MockServiceNetwork_GetServiceConfigs_Call{Call: _e.mock.On("GetServiceConfigs")}
+}
+
+func (_c *MockServiceNetwork_GetServiceConfigs_Call) Run(run func()) *MockServiceNetwork_GetServiceConfigs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfigs_Call) Return(_a0 map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) *MockServiceNetwork_GetServiceConfigs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceConfigs_Call) RunAndReturn(run func() map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) *MockServiceNetwork_GetServiceConfigs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServiceRegistration provides a mock function with given fields: serviceName
 func (_m *MockServiceNetwork) GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool) {
 	ret := _m.Called(serviceName)
@@ -343,6 +739,120 @@ func (_c *MockServiceNetwork_GetServiceRegistration_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetServiceStartupTimes provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetServiceStartupTimes(serviceName service.ServiceName) (*service_network_types.ServiceStartupTimes, bool) {
+	ret := _m.Called(serviceName)
+
+	var r0 *service_network_types.ServiceStartupTimes
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (*service_network_types.ServiceStartupTimes, bool)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) *service_network_types.ServiceStartupTimes); ok {
+		r0 = rf(serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service_network_types.ServiceStartupTimes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) bool); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceStartupTimes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceStartupTimes'
+type MockServiceNetwork_GetServiceStartupTimes_Call struct {
+	*mock.Call
+}
+
+// GetServiceStartupTimes is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceStartupTimes(serviceName interface{}) *MockServiceNetwork_GetServiceStartupTimes_Call {
+	return &MockServiceNetwork_GetServiceStartupTimes_Call{Call: _e.mock.On("GetServiceStartupTimes", serviceName)}
+}
+
+func (_c *MockServiceNetwork_GetServiceStartupTimes_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetServiceStartupTimes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceStartupTimes_Call) Return(_a0 *service_network_types.ServiceStartupTimes, _a1 bool) *MockServiceNetwork_GetServiceStartupTimes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceStartupTimes_Call) RunAndReturn(run func(service.ServiceName) (*service_network_types.ServiceStartupTimes, bool)) *MockServiceNetwork_GetServiceStartupTimes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSubnetworkConnections provides a mock function with given fields:
+func (_m *MockServiceNetwork) GetSubnetworkConnections() (partition_topology.PartitionConnection, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, error) {
+	ret := _m.Called()
+
+	var r0 partition_topology.PartitionConnection
+	var r1 map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (partition_topology.PartitionConnection, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() partition_topology.PartitionConnection); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(partition_topology.PartitionConnection)
+	}
+
+	if rf, ok := ret.Get(1).(func() map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_GetSubnetworkConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubnetworkConnections'
+type MockServiceNetwork_GetSubnetworkConnections_Call struct {
+	*mock.Call
+}
+
+// GetSubnetworkConnections is a helper method to define mock.On call
+func (_e *MockServiceNetwork_Expecter) GetSubnetworkConnections() *MockServiceNetwork_GetSubnetworkConnections_Call {
+	return &MockServiceNetwork_GetSubnetworkConnections_Call{Call: _e.mock.On("GetSubnetworkConnections")}
+}
+
+func (_c *MockServiceNetwork_GetSubnetworkConnections_Call) Run(run func()) *MockServiceNetwork_GetSubnetworkConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetSubnetworkConnections_Call) Return(_a0 partition_topology.PartitionConnection, _a1 map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, _a2 error) *MockServiceNetwork_GetSubnetworkConnections_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetSubnetworkConnections_Call) RunAndReturn(run func() (partition_topology.PartitionConnection, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, error)) *MockServiceNetwork_GetSubnetworkConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUniqueNameForFileArtifact provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
 	ret := _m.Called()
@@ -495,45 +1005,186 @@ func (_c *MockServiceNetwork_IsNetworkPartitioningEnabled_Call) RunAndReturn(run
 	return _c
 }
 
-// PauseService provides a mock function with given fields: ctx, serviceIdentifier
-func (_m *MockServiceNetwork) PauseService(ctx context.Context, serviceIdentifier string) error {
-	ret := _m.Called(ctx, serviceIdentifier)
+// MarkServiceAsReady provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) MarkServiceAsReady(serviceName service.ServiceName) {
+	_m.Called(serviceName)
+}
+
+// MockServiceNetwork_MarkServiceAsReady_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkServiceAsReady'
+type MockServiceNetwork_MarkServiceAsReady_Call struct {
+	*mock.Call
+}
+
+// MarkServiceAsReady is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) MarkServiceAsReady(serviceName interface{}) *MockServiceNetwork_MarkServiceAsReady_Call {
+	return &MockServiceNetwork_MarkServiceAsReady_Call{Call: _e.mock.On("MarkServiceAsReady", serviceName)}
+}
+
+func (_c *MockServiceNetwork_MarkServiceAsReady_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_MarkServiceAsReady_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_MarkServiceAsReady_Call) Return() *MockServiceNetwork_MarkServiceAsReady_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockServiceNetwork_MarkServiceAsReady_Call) RunAndReturn(run func(service.ServiceName)) *MockServiceNetwork_MarkServiceAsReady_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MovePartition provides a mock function with given fields: ctx, partitionId, serviceNames
+func (_m *MockServiceNetwork) MovePartition(ctx context.Context, partitionId service_network_types.PartitionID, serviceNames map[service.ServiceName]bool) error {
+	ret := _m.Called(ctx, partitionId, serviceNames)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service_network_types.PartitionID, map[service.ServiceName]bool) error); ok {
+		r0 = rf(ctx, partitionId, serviceNames)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_MovePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MovePartition'
+type MockServiceNetwork_MovePartition_Call struct {
+	*mock.Call
+}
+
+// MovePartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partitionId service_network_types.PartitionID
+//   - serviceNames map[service.ServiceName]bool
+func (_e *MockServiceNetwork_Expecter) MovePartition(ctx interface{}, partitionId interface{}, serviceNames interface{}) *MockServiceNetwork_MovePartition_Call {
+	return &MockServiceNetwork_MovePartition_Call{Call: _e.mock.On("MovePartition", ctx, partitionId, serviceNames)}
+}
+
+func (_c *MockServiceNetwork_MovePartition_Call) Run(run func(ctx context.Context, partitionId service_network_types.PartitionID, serviceNames map[service.ServiceName]bool)) *MockServiceNetwork_MovePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service_network_types.PartitionID), args[2].(map[service.ServiceName]bool))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_MovePartition_Call) Return(_a0 error) *MockServiceNetwork_MovePartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_MovePartition_Call) RunAndReturn(run func(context.Context, service_network_types.PartitionID, map[service.ServiceName]bool) error) *MockServiceNetwork_MovePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseService provides a mock function with given fields: ctx, serviceIdentifier
+func (_m *MockServiceNetwork) PauseService(ctx context.Context, serviceIdentifier string) error {
+	ret := _m.Called(ctx, serviceIdentifier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, serviceIdentifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_PauseService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseService'
+type MockServiceNetwork_PauseService_Call struct {
+	*mock.Call
+}
+
+// PauseService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+func (_e *MockServiceNetwork_Expecter) PauseService(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_PauseService_Call {
+	return &MockServiceNetwork_PauseService_Call{Call: _e.mock.On("PauseService", ctx, serviceIdentifier)}
+}
+
+func (_c *MockServiceNetwork_PauseService_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_PauseService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_PauseService_Call) Return(_a0 error) *MockServiceNetwork_PauseService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_PauseService_Call) RunAndReturn(run func(context.Context, string) error) *MockServiceNetwork_PauseService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseServices provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) PauseServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error) {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 map[service.ServiceName]bool
+	var r1 map[service.ServiceName]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error)); ok {
+		return rf(ctx, serviceIdentifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) map[service.ServiceName]bool); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool) map[service.ServiceName]error); ok {
+		r1 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceName]error)
+		}
+	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, serviceIdentifier)
+	if rf, ok := ret.Get(2).(func(context.Context, map[string]bool) error); ok {
+		r2 = rf(ctx, serviceIdentifiers)
 	} else {
-		r0 = ret.Error(0)
+		r2 = ret.Error(2)
 	}
 
-	return r0
+	return r0, r1, r2
 }
 
-// MockServiceNetwork_PauseService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseService'
-type MockServiceNetwork_PauseService_Call struct {
+// MockServiceNetwork_PauseServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseServices'
+type MockServiceNetwork_PauseServices_Call struct {
 	*mock.Call
 }
 
-// PauseService is a helper method to define mock.On call
+// PauseServices is a helper method to define mock.On call
 //   - ctx context.Context
-//   - serviceIdentifier string
-func (_e *MockServiceNetwork_Expecter) PauseService(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_PauseService_Call {
-	return &MockServiceNetwork_PauseService_Call{Call: _e.mock.On("PauseService", ctx, serviceIdentifier)}
+//   - serviceIdentifiers map[string]bool
+func (_e *MockServiceNetwork_Expecter) PauseServices(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_PauseServices_Call {
+	return &MockServiceNetwork_PauseServices_Call{Call: _e.mock.On("PauseServices", ctx, serviceIdentifiers)}
 }
 
-func (_c *MockServiceNetwork_PauseService_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_PauseService_Call {
+func (_c *MockServiceNetwork_PauseServices_Call) Run(run func(ctx context.Context, serviceIdentifiers map[string]bool)) *MockServiceNetwork_PauseServices_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(map[string]bool))
 	})
 	return _c
 }
 
-func (_c *MockServiceNetwork_PauseService_Call) Return(_a0 error) *MockServiceNetwork_PauseService_Call {
-	_c.Call.Return(_a0)
+func (_c *MockServiceNetwork_PauseServices_Call) Return(_a0 map[service.ServiceName]bool, _a1 map[service.ServiceName]error, _a2 error) *MockServiceNetwork_PauseServices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockServiceNetwork_PauseService_Call) RunAndReturn(run func(context.Context, string) error) *MockServiceNetwork_PauseService_Call {
+func (_c *MockServiceNetwork_PauseServices_Call) RunAndReturn(run func(context.Context, map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error)) *MockServiceNetwork_PauseServices_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -591,6 +1242,70 @@ func (_c *MockServiceNetwork_RemoveService_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RemoveServices provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) RemoveServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]service.ServiceUUID, map[service.ServiceName]error, error) {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 map[service.ServiceName]service.ServiceUUID
+	var r1 map[service.ServiceName]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) (map[service.ServiceName]service.ServiceUUID, map[service.ServiceName]error, error)); ok {
+		return rf(ctx, serviceIdentifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) map[service.ServiceName]service.ServiceUUID); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]service.ServiceUUID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool) map[service.ServiceName]error); ok {
+		r1 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceName]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, map[string]bool) error); ok {
+		r2 = rf(ctx, serviceIdentifiers)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_RemoveServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveServices'
+type MockServiceNetwork_RemoveServices_Call struct {
+	*mock.Call
+}
+
+// RemoveServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers map[string]bool
+func (_e *MockServiceNetwork_Expecter) RemoveServices(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_RemoveServices_Call {
+	return &MockServiceNetwork_RemoveServices_Call{Call: _e.mock.On("RemoveServices", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_RemoveServices_Call) Run(run func(ctx context.Context, serviceIdentifiers map[string]bool)) *MockServiceNetwork_RemoveServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]bool))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveServices_Call) Return(_a0 map[service.ServiceName]service.ServiceUUID, _a1 map[service.ServiceName]error, _a2 error) *MockServiceNetwork_RemoveServices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RemoveServices_Call) RunAndReturn(run func(context.Context, map[string]bool) (map[service.ServiceName]service.ServiceUUID, map[service.ServiceName]error, error)) *MockServiceNetwork_RemoveServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RenderTemplates provides a mock function with given fields: templatesAndDataByDestinationRelFilepath, artifactName
 func (_m *MockServiceNetwork) RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
 	ret := _m.Called(templatesAndDataByDestinationRelFilepath, artifactName)
@@ -689,6 +1404,93 @@ func (_c *MockServiceNetwork_Repartition_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// RestartService provides a mock function with given fields: ctx, serviceIdentifier
+func (_m *MockServiceNetwork) RestartService(ctx context.Context, serviceIdentifier string) error {
+	ret := _m.Called(ctx, serviceIdentifier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, serviceIdentifier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_RestartService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartService'
+type MockServiceNetwork_RestartService_Call struct {
+	*mock.Call
+}
+
+// RestartService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+func (_e *MockServiceNetwork_Expecter) RestartService(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_RestartService_Call {
+	return &MockServiceNetwork_RestartService_Call{Call: _e.mock.On("RestartService", ctx, serviceIdentifier)}
+}
+
+func (_c *MockServiceNetwork_RestartService_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_RestartService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_RestartService_Call) Return(_a0 error) *MockServiceNetwork_RestartService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_RestartService_Call) RunAndReturn(run func(context.Context, string) error) *MockServiceNetwork_RestartService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendSignalToService provides a mock function with given fields: ctx, serviceIdentifier, signal
+func (_m *MockServiceNetwork) SendSignalToService(ctx context.Context, serviceIdentifier string, signal string) error {
+	ret := _m.Called(ctx, serviceIdentifier, signal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, serviceIdentifier, signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SendSignalToService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendSignalToService'
+type MockServiceNetwork_SendSignalToService_Call struct {
+	*mock.Call
+}
+
+// SendSignalToService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - signal string
+func (_e *MockServiceNetwork_Expecter) SendSignalToService(ctx interface{}, serviceIdentifier interface{}, signal interface{}) *MockServiceNetwork_SendSignalToService_Call {
+	return &MockServiceNetwork_SendSignalToService_Call{Call: _e.mock.On("SendSignalToService", ctx, serviceIdentifier, signal)}
+}
+
+func (_c *MockServiceNetwork_SendSignalToService_Call) Run(run func(ctx context.Context, serviceIdentifier string, signal string)) *MockServiceNetwork_SendSignalToService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SendSignalToService_Call) Return(_a0 error) *MockServiceNetwork_SendSignalToService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SendSignalToService_Call) RunAndReturn(run func(context.Context, string, string) error) *MockServiceNetwork_SendSignalToService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetConnection provides a mock function with given fields: ctx, partition1, partition2, connection
 func (_m *MockServiceNetwork) SetConnection(ctx context.Context, partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID, connection partition_topology.PartitionConnection) error {
 	ret := _m.Called(ctx, partition1, partition2, connection)
@@ -898,6 +1700,62 @@ func (_c *MockServiceNetwork_StartServices_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// TestConnectivity provides a mock function with given fields: ctx, serviceIdentifiers, numProbes
+func (_m *MockServiceNetwork) TestConnectivity(ctx context.Context, serviceIdentifiers map[string]bool, numProbes uint32) (map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, error) {
+	ret := _m.Called(ctx, serviceIdentifiers, numProbes)
+
+	var r0 map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool, uint32) (map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, error)); ok {
+		return rf(ctx, serviceIdentifiers, numProbes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool, uint32) map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult); ok {
+		r0 = rf(ctx, serviceIdentifiers, numProbes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool, uint32) error); ok {
+		r1 = rf(ctx, serviceIdentifiers, numProbes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_TestConnectivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestConnectivity'
+type MockServiceNetwork_TestConnectivity_Call struct {
+	*mock.Call
+}
+
+// TestConnectivity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers map[string]bool
+//   - numProbes uint32
+func (_e *MockServiceNetwork_Expecter) TestConnectivity(ctx interface{}, serviceIdentifiers interface{}, numProbes interface{}) *MockServiceNetwork_TestConnectivity_Call {
+	return &MockServiceNetwork_TestConnectivity_Call{Call: _e.mock.On("TestConnectivity", ctx, serviceIdentifiers, numProbes)}
+}
+
+func (_c *MockServiceNetwork_TestConnectivity_Call) Run(run func(ctx context.Context, serviceIdentifiers map[string]bool, numProbes uint32)) *MockServiceNetwork_TestConnectivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]bool), args[2].(uint32))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_TestConnectivity_Call) Return(_a0 map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, _a1 error) *MockServiceNetwork_TestConnectivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_TestConnectivity_Call) RunAndReturn(run func(context.Context, map[string]bool, uint32) (map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, error)) *MockServiceNetwork_TestConnectivity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnpauseService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) UnpauseService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -941,6 +1799,70 @@ func (_c *MockServiceNetwork_UnpauseService_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// UnpauseServices provides a mock function with given fields: ctx, serviceIdentifiers
+func (_m *MockServiceNetwork) UnpauseServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error) {
+	ret := _m.Called(ctx, serviceIdentifiers)
+
+	var r0 map[service.ServiceName]bool
+	var r1 map[service.ServiceName]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error)); ok {
+		return rf(ctx, serviceIdentifiers)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]bool) map[service.ServiceName]bool); ok {
+		r0 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceName]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]bool) map[service.ServiceName]error); ok {
+		r1 = rf(ctx, serviceIdentifiers)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceName]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, map[string]bool) error); ok {
+		r2 = rf(ctx, serviceIdentifiers)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockServiceNetwork_UnpauseServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnpauseServices'
+type MockServiceNetwork_UnpauseServices_Call struct {
+	*mock.Call
+}
+
+// UnpauseServices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifiers map[string]bool
+func (_e *MockServiceNetwork_Expecter) UnpauseServices(ctx interface{}, serviceIdentifiers interface{}) *MockServiceNetwork_UnpauseServices_Call {
+	return &MockServiceNetwork_UnpauseServices_Call{Call: _e.mock.On("UnpauseServices", ctx, serviceIdentifiers)}
+}
+
+func (_c *MockServiceNetwork_UnpauseServices_Call) Run(run func(ctx context.Context, serviceIdentifiers map[string]bool)) *MockServiceNetwork_UnpauseServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]bool))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnpauseServices_Call) Return(_a0 map[service.ServiceName]bool, _a1 map[service.ServiceName]error, _a2 error) *MockServiceNetwork_UnpauseServices_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnpauseServices_Call) RunAndReturn(run func(context.Context, map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error)) *MockServiceNetwork_UnpauseServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnsetConnection provides a mock function with given fields: ctx, partition1, partition2
 func (_m *MockServiceNetwork) UnsetConnection(ctx context.Context, partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID) error {
 	ret := _m.Called(ctx, partition1, partition2)
@@ -1102,6 +2024,59 @@ func (_c *MockServiceNetwork_UploadFilesArtifact_Call) RunAndReturn(run func([]b
 	return _c
 }
 
+// WaitForServiceExit provides a mock function with given fields: ctx, serviceIdentifier
+func (_m *MockServiceNetwork) WaitForServiceExit(ctx context.Context, serviceIdentifier string) (int32, error) {
+	ret := _m.Called(ctx, serviceIdentifier)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int32, error)); ok {
+		return rf(ctx, serviceIdentifier)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int32); ok {
+		r0 = rf(ctx, serviceIdentifier)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceIdentifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_WaitForServiceExit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForServiceExit'
+type MockServiceNetwork_WaitForServiceExit_Call struct {
+	*mock.Call
+}
+
+// WaitForServiceExit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+func (_e *MockServiceNetwork_Expecter) WaitForServiceExit(ctx interface{}, serviceIdentifier interface{}) *MockServiceNetwork_WaitForServiceExit_Call {
+	return &MockServiceNetwork_WaitForServiceExit_Call{Call: _e.mock.On("WaitForServiceExit", ctx, serviceIdentifier)}
+}
+
+func (_c *MockServiceNetwork_WaitForServiceExit_Call) Run(run func(ctx context.Context, serviceIdentifier string)) *MockServiceNetwork_WaitForServiceExit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_WaitForServiceExit_Call) Return(_a0 int32, _a1 error) *MockServiceNetwork_WaitForServiceExit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_WaitForServiceExit_Call) RunAndReturn(run func(context.Context, string) (int32, error)) *MockServiceNetwork_WaitForServiceExit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 type mockConstructorTestingTNewMockServiceNetwork interface {
 	mock.TestingT
 	Cleanup(func())
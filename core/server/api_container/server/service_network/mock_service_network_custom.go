@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"io"
 	"net"
 	"net/http"
 )
@@ -47,6 +48,16 @@ func (m *MockServiceNetworkCustom) Repartition(ctx context.Context, newPartition
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) AddPartition(ctx context.Context, partitionId service_network_types.PartitionID, serviceNames map[service.ServiceName]bool) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) MovePartition(ctx context.Context, partitionId service_network_types.PartitionID, serviceNames map[service.ServiceName]bool) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) SetConnection(ctx context.Context, partition1 service_network_types.PartitionID, partition2 service_network_types.PartitionID, connection partition_topology.PartitionConnection) error {
 	//TODO implement me
 	panic(unimplementedMsg)
@@ -97,6 +108,16 @@ func (m *MockServiceNetworkCustom) RemoveService(ctx context.Context, serviceIde
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) RemoveServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]service.ServiceUUID, map[service.ServiceName]error, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetServiceMetrics(ctx context.Context, serviceIdentifier string) (*service.ServiceMetrics, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) PauseService(ctx context.Context, serviceIdentifier string) error {
 	//TODO implement me
 	panic(unimplementedMsg)
@@ -107,16 +128,56 @@ func (m *MockServiceNetworkCustom) UnpauseService(ctx context.Context, serviceId
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) PauseServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) UnpauseServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) RestartService(ctx context.Context, serviceIdentifier string) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) WaitForServiceExit(ctx context.Context, serviceIdentifier string) (int32, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) SendSignalToService(ctx context.Context, serviceIdentifier string, signal string) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error) {
 	//TODO implement me
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) ExecCommandWithStreamedOutput(ctx context.Context, serviceIdentifier string, command []string, output io.Writer) (int32, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error) {
 	//TODO implement me
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) GetScrapeTargets(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) CheckServicePortAvailability(ctx context.Context, serviceIdentifier string, portId string) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
 	//TODO implement me
 	panic(unimplementedMsg)
@@ -127,6 +188,16 @@ func (m *MockServiceNetworkCustom) CopyFilesFromService(ctx context.Context, ser
 	panic(unimplementedMsg)
 }
 
+func (m *MockServiceNetworkCustom) CopyFilesFromServices(ctx context.Context, srcPathsByService map[service.ServiceName]string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, artifactIdentifier string, dstDirpath string) error {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
 func (m *MockServiceNetworkCustom) GetServiceNames() map[service.ServiceName]bool {
 	//TODO implement me
 	panic(unimplementedMsg)
@@ -166,3 +237,28 @@ func generateMockServiceRegistration(serviceName service.ServiceName, ipAddress
 		string(serviceName),
 	)
 }
+
+func (m *MockServiceNetworkCustom) GetServiceConfigs() map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) MarkServiceAsReady(serviceName service.ServiceName) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetServiceStartupTimes(serviceName service.ServiceName) (*service_network_types.ServiceStartupTimes, bool) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) GetSubnetworkConnections() (partition_topology.PartitionConnection, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
+
+func (m *MockServiceNetworkCustom) TestConnectivity(ctx context.Context, serviceIdentifiers map[string]bool, numProbes uint32) (map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, error) {
+	//TODO implement me
+	panic(unimplementedMsg)
+}
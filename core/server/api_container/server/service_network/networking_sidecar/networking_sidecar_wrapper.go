@@ -27,6 +27,7 @@ const (
 	tcQdiscTypeNetem            = "netem"
 	tcQdiscTypeNetemOptionLoss  = "loss"
 	tcQdiscTypeNetemOptionDelay = "delay"
+	tcQdiscTypeNetemOptionRate  = "rate"
 	tcClassCommand              = "class"
 	tcFilterCommand             = "filter"
 	tcFilterProtocolCommand     = "protocol"
@@ -44,6 +45,12 @@ const (
 	tcClassIDCommand            = "classid"
 	tcRateCommand               = "rate"
 
+	// The filters for the IPv6 addresses of the services
+	tcFilterIPv6Command          = "ipv6"
+	tcFilterIPv6MatchTypeCommand = "ip6"
+	// Filters of different protocols can't share a priority under the same parent
+	ipv6FilterPriority = "2"
+
 	rootQdiscName                 = "root"
 	defaultDockerNetworkInterface = "eth0"
 
@@ -162,12 +169,13 @@ func (sidecarWrapper *StandardNetworkingSidecarWrapper) UpdateTrafficControl(ctx
 	// if this variable is true, set delay as 0 ms and packet loss to 0%
 	shouldResetToDefaultNetworkSettings := false
 
-	// if at least one connection has either packet delay or packet loss set, run the tc update statement
+	// if at least one connection has either packet delay, packet loss or a bandwidth limit set, run the tc update statement
 	// else re-initialize the q discs
 	for _, connectionConfig := range partitionConnectionConfigPerIpAddress {
 		packetLoss := connectionConfig.GetPacketLossPercentage()
 		packetDelay := connectionConfig.GetPacketDelay()
-		if packetLoss.IsSet() || packetDelay.IsSet() {
+		bandwidthLimit := connectionConfig.GetBandwidthLimit()
+		if packetLoss.IsSet() || packetDelay.IsSet() || bandwidthLimit.IsSet() {
 			shouldResetToDefaultNetworkSettings = true
 			break
 		}
@@ -374,10 +382,11 @@ func generateTcAddQdiscBCmd() []string {
 	return generateTcAddQdiscCmd(rootClassBClassID, qdiscBID, tcQdiscTypeHtb)
 }
 
-// This method generates the command for packet loss and packet delay
+// This method generates the command for packet loss, packet delay and bandwidth limit
 func generateTCAddNetemQdiscWithPacketConnectionCmd(parentClassId classID, qdiscId qdiscID, connectionConfig *partition_topology.PartitionConnection) []string {
 	packetLoss := connectionConfig.GetPacketLossPercentage()
 	packetDelay := connectionConfig.GetPacketDelay()
+	bandwidthLimit := connectionConfig.GetBandwidthLimit()
 
 	resultCmd := generateTcAddQdiscCmd(parentClassId, qdiscId, tcQdiscTypeNetem)
 	resultCmd = append(resultCmd, tcQdiscTypeNetemOptionLoss)
@@ -388,6 +397,11 @@ func generateTCAddNetemQdiscWithPacketConnectionCmd(parentClassId classID, qdisc
 		resultCmd = append(resultCmd, packetDelay.GetTcCommand())
 	}
 
+	if bandwidthLimit.IsSet() {
+		resultCmd = append(resultCmd, tcQdiscTypeNetemOptionRate)
+		resultCmd = append(resultCmd, bandwidthLimit.GetTcCommand())
+	}
+
 	return resultCmd
 }
 
@@ -429,6 +443,15 @@ func generateTcAddClassCmd(parentQdiscId qdiscID, classId classID) []string {
 }
 
 func generateTCAddFilterByIpCmd(parentQdiscId qdiscID, classId classID, ipAddress string) []string {
+	// IPv4 filters don't match IPv6 packets, so the IPv6 addresses of the services need filters of their own
+	filterProtocol := tcFilterIPCommand
+	filterPriority := maxFilterPriority
+	filterMatchType := tcFilterIPMatchTypeCommand
+	if parsedIpAddress := net.ParseIP(ipAddress); parsedIpAddress != nil && parsedIpAddress.To4() == nil {
+		filterProtocol = tcFilterIPv6Command
+		filterPriority = ipv6FilterPriority
+		filterMatchType = tcFilterIPv6MatchTypeCommand
+	}
 
 	resultCmd := []string{
 		tcCommand,
@@ -439,14 +462,14 @@ func generateTCAddFilterByIpCmd(parentQdiscId qdiscID, classId classID, ipAddres
 		tcParentCommand,
 		string(parentQdiscId),
 		tcFilterProtocolCommand,
-		tcFilterIPCommand,
+		filterProtocol,
 		tcFilterPrioCommand,
-		maxFilterPriority,
+		filterPriority,
 		tcU32FilterTypeCommand,
 		tcFilterFlowIDCommand,
 		string(classId),
 		tcFilterMatchCommand,
-		tcFilterIPMatchTypeCommand,
+		filterMatchType,
 		tcFilterIPDestCommand,
 		ipAddress,
 	}
@@ -144,6 +144,22 @@ const (
 		"tc qdisc add dev eth0 parent 3:4 handle b: netem loss 0% delay 500ms 0ms 0% && " +
 		"tc filter replace dev eth0 parent 1: handle 1:0 basic flowid 1:2"
 
+	expectedCommandsForExecutingSoftPartitionWithBandwidthLimitInQdiscB = "tc qdisc del dev eth0 parent 1:2 handle 3: htb && " +
+		"tc qdisc add dev eth0 parent 1:2 handle 3: htb && " +
+		"tc class add dev eth0 parent 3: classid 3:1 htb rate 100% && " +
+		"tc filter add dev eth0 parent 3: protocol ip prio 1 u32 flowid 3:1 match ip dst 1.1.1.1 && " +
+		"tc qdisc add dev eth0 parent 3:1 handle 5: netem loss 0% rate 1000kbit && " +
+		"tc class add dev eth0 parent 3: classid 3:2 htb rate 100% && " +
+		"tc filter add dev eth0 parent 3: protocol ip prio 1 u32 flowid 3:2 match ip dst 2.2.2.2 && " +
+		"tc qdisc add dev eth0 parent 3:2 handle 7: netem loss 0% rate 1000kbit && " +
+		"tc class add dev eth0 parent 3: classid 3:3 htb rate 100% && " +
+		"tc filter add dev eth0 parent 3: protocol ip prio 1 u32 flowid 3:3 match ip dst 3.3.3.3 && " +
+		"tc qdisc add dev eth0 parent 3:3 handle 9: netem loss 0% rate 1000kbit && " +
+		"tc class add dev eth0 parent 3: classid 3:4 htb rate 100% && " +
+		"tc filter add dev eth0 parent 3: protocol ip prio 1 u32 flowid 3:4 match ip dst 4.4.4.4 && " +
+		"tc qdisc add dev eth0 parent 3:4 handle b: netem loss 0% rate 1000kbit && " +
+		"tc filter replace dev eth0 parent 1: handle 1:0 basic flowid 1:2"
+
 	stringSeparatorInCommand = " "
 )
 
@@ -467,6 +483,24 @@ func TestUpdateTrafficControl_CreateWithSoftPartitionWithDelayDistributionAndThe
 	require.Equal(t, expectedCommandsForExecutingSoftPartitionWithDelayInQdiscA, actualSecondExecutedMergedCmd)
 }
 
+func TestUpdateTrafficControl_CreatePartitionWithBandwidthLimit(t *testing.T) {
+	//Initial state
+	ctx := context.Background()
+	sidecar, execCmdExecutor := createNewStandardNetworkingSidecarAndMockedExecCmdExecutor(t)
+	require.Empty(t, sidecar.qdiscInUse)
+	sidecar.qdiscInUse = initialKurtosisQdiscId
+
+	allUserServicePacketConnectionConfigurationsWithBandwidthLimit := getAllUserServicePacketConnectionConfigurationsWithBandwidthLimit()
+
+	err := sidecar.UpdateTrafficControl(ctx, allUserServicePacketConnectionConfigurationsWithBandwidthLimit)
+	require.NoError(t, err, "An error occurred updating qdisc configuration for partition with bandwidth limit")
+	require.Equal(t, qdiscBID, sidecar.qdiscInUse)
+	require.Equal(t, 1, len(execCmdExecutor.commands))
+
+	actualExecutedMergedCmd := mergeCommandsInOneLine(execCmdExecutor.commands[0])
+	require.Equal(t, expectedCommandsForExecutingSoftPartitionWithBandwidthLimitInQdiscB, actualExecutedMergedCmd)
+}
+
 func TestUpdateTrafficControl_UndefinedQdiscInUseError(t *testing.T) {
 	//Initial state
 	ctx := context.Background()
@@ -552,6 +586,14 @@ func TestNewClassId_QdiscBChildren(t *testing.T) {
 	}
 }
 
+func TestGenerateTCAddFilterByIpCmd_MatchesIPv6AddressesWithIPv6Filters(t *testing.T) {
+	ipv4FilterCmd := generateTCAddFilterByIpCmd(qdiscAID, classID(qdiscAID)+"1", "1.1.1.1")
+	require.Equal(t, "tc filter add dev eth0 parent 2: protocol ip prio 1 u32 flowid 2:1 match ip dst 1.1.1.1", strings.Join(ipv4FilterCmd, " "))
+
+	ipv6FilterCmd := generateTCAddFilterByIpCmd(qdiscAID, classID(qdiscAID)+"2", "fd00::2")
+	require.Equal(t, "tc filter add dev eth0 parent 2: protocol ipv6 prio 2 u32 flowid 2:2 match ip6 dst fd00::2", strings.Join(ipv6FilterCmd, " "))
+}
+
 func TestConcurrencySafety(t *testing.T) {
 	//Initial state
 	ctx := context.Background()
@@ -570,7 +612,7 @@ func TestConcurrencySafety(t *testing.T) {
 		iByte := byte(i)
 		ip := net.IP{iByte, iByte, iByte, iByte}
 		allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForBlockedPartition, connectionWithNoLatency)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForBlockedPartition, connectionWithNoLatency, partition_topology.ConnectionWithNoBandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 		go func() {
 			err := sidecar.UpdateTrafficControl(ctx, allUserServicePacketConnectionConfigurations)
@@ -609,7 +651,7 @@ func getAllUserServicePacketConnectionConfigurationsSoftPartitionWithDistributio
 	allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
 	packetDelay := partition_topology.NewNormalPacketDelayDistribution(500, 10, 20.5)
 	for _, ip := range allUserServiceTestIPAddresses {
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForSoftPartition, packetDelay)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForSoftPartition, packetDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 	}
 	return allUserServicePacketConnectionConfigurations
@@ -619,7 +661,7 @@ func getAllUserServicePacketConnectionConfigurationsSoftPartitionWithConstantDel
 	allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
 	packetDelay := partition_topology.NewUniformPacketDelayDistribution(500)
 	for _, ip := range allUserServiceTestIPAddresses {
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForSoftPartition, packetDelay)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForSoftPartition, packetDelay, partition_topology.ConnectionWithNoBandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 	}
 	return allUserServicePacketConnectionConfigurations
@@ -628,7 +670,7 @@ func getAllUserServicePacketConnectionConfigurationsSoftPartitionWithConstantDel
 func getAllUserServicePacketConnectionConfigurationsForSoftPartition() map[string]*partition_topology.PartitionConnection {
 	allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
 	for _, ip := range allUserServiceTestIPAddresses {
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForSoftPartition, connectionWithNoLatency)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForSoftPartition, connectionWithNoLatency, partition_topology.ConnectionWithNoBandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 	}
 	return allUserServicePacketConnectionConfigurations
@@ -637,7 +679,7 @@ func getAllUserServicePacketConnectionConfigurationsForSoftPartition() map[strin
 func getAllUserServicePacketConnectionConfigurationsForBlockedPartition() map[string]*partition_topology.PartitionConnection {
 	allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
 	for _, ip := range allUserServiceTestIPAddresses {
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForBlockedPartition, connectionWithNoLatency)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForBlockedPartition, connectionWithNoLatency, partition_topology.ConnectionWithNoBandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 	}
 	return allUserServicePacketConnectionConfigurations
@@ -646,7 +688,7 @@ func getAllUserServicePacketConnectionConfigurationsForBlockedPartition() map[st
 func getAllUserServicePacketConnectionConfigurationsForUnblockedPartition() map[string]*partition_topology.PartitionConnection {
 	allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
 	for _, ip := range allUserServiceTestIPAddresses {
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForUnblockedPartition, connectionWithNoLatency)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForUnblockedPartition, connectionWithNoLatency, partition_topology.ConnectionWithNoBandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 	}
 	return allUserServicePacketConnectionConfigurations
@@ -657,7 +699,17 @@ func getAllUserServicePacketConnectionConfigurationsForUnblockedPartitionWithDel
 	packetDelay := partition_topology.NewUniformPacketDelayDistribution(500)
 
 	for _, ip := range allUserServiceTestIPAddresses {
-		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForUnblockedPartition, packetDelay)
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForUnblockedPartition, packetDelay, partition_topology.ConnectionWithNoBandwidthLimit)
+		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
+	}
+	return allUserServicePacketConnectionConfigurations
+}
+
+func getAllUserServicePacketConnectionConfigurationsWithBandwidthLimit() map[string]*partition_topology.PartitionConnection {
+	allUserServicePacketConnectionConfigurations := map[string]*partition_topology.PartitionConnection{}
+	bandwidthLimit := partition_topology.NewBandwidthLimit(1000)
+	for _, ip := range allUserServiceTestIPAddresses {
+		connectionConfig := partition_topology.NewPartitionConnection(packetConnectionPercentageValueForUnblockedPartition, connectionWithNoLatency, bandwidthLimit)
 		allUserServicePacketConnectionConfigurations[ip.String()] = &connectionConfig
 	}
 	return allUserServicePacketConnectionConfigurations
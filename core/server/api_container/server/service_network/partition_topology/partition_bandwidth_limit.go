@@ -0,0 +1,34 @@
+package partition_topology
+
+import "fmt"
+
+const kilobitPerSecondSuffix = "kbit"
+
+var (
+	ConnectionWithNoBandwidthLimit = NewBandwidthLimit(0)
+)
+
+// BandwidthLimit caps the rate at which packets get sent over a connection, see the 'rate' option of
+// https://man7.org/linux/man-pages/man8/tc-netem.8.html
+type BandwidthLimit struct {
+	kbps uint32
+}
+
+func NewBandwidthLimit(kbps uint32) BandwidthLimit {
+	return BandwidthLimit{
+		kbps: kbps,
+	}
+}
+
+func (bandwidthLimit *BandwidthLimit) GetKbps() uint32 {
+	return bandwidthLimit.kbps
+}
+
+// IsSet This method checks whether we need to limit the bandwidth, default value is 0 meaning no limit
+func (bandwidthLimit *BandwidthLimit) IsSet() bool {
+	return bandwidthLimit.kbps > 0
+}
+
+func (bandwidthLimit *BandwidthLimit) GetTcCommand() string {
+	return fmt.Sprintf("%v%v", bandwidthLimit.kbps, kilobitPerSecondSuffix)
+}
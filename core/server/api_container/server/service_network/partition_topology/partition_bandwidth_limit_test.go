@@ -0,0 +1,14 @@
+package partition_topology
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBandwidthLimit_GetTcCommand(t *testing.T) {
+	bandwidthLimit := NewBandwidthLimit(1000)
+	require.True(t, bandwidthLimit.IsSet())
+	require.Equal(t, "1000kbit", bandwidthLimit.GetTcCommand())
+
+	require.False(t, ConnectionWithNoBandwidthLimit.IsSet())
+}
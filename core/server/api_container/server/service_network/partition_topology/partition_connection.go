@@ -5,17 +5,19 @@ import "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_acce
 type PartitionConnection struct {
 	packetLoss              PacketLoss
 	packetDelayDistribution PacketDelayDistribution
+	bandwidthLimit          BandwidthLimit
 }
 
 var (
-	ConnectionAllowed = NewPartitionConnection(ConnectionWithNoPacketLoss, ConnectionWithNoPacketDelay)
-	ConnectionBlocked = NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
+	ConnectionAllowed = NewPartitionConnection(ConnectionWithNoPacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
+	ConnectionBlocked = NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 )
 
-func NewPartitionConnection(packetLoss PacketLoss, packetDelay PacketDelayDistribution) PartitionConnection {
+func NewPartitionConnection(packetLoss PacketLoss, packetDelay PacketDelayDistribution, bandwidthLimit BandwidthLimit) PartitionConnection {
 	return PartitionConnection{
 		packetLoss:              packetLoss,
 		packetDelayDistribution: packetDelay,
+		bandwidthLimit:          bandwidthLimit,
 	}
 }
 
@@ -27,6 +29,10 @@ func (partitionConnection *PartitionConnection) GetPacketDelay() PacketDelayDist
 	return partitionConnection.packetDelayDistribution
 }
 
+func (partitionConnection *PartitionConnection) GetBandwidthLimit() BandwidthLimit {
+	return partitionConnection.bandwidthLimit
+}
+
 func newPartitionConnectionFromDbType(currentPartitionConnectionDbType partition_connection_overrides.PartitionConnection) PartitionConnection {
-	return NewPartitionConnection(NewPacketLoss(currentPartitionConnectionDbType.PacketLoss), NewNormalPacketDelayDistribution(currentPartitionConnectionDbType.PacketDelayDistribution.AvgDelayMs, currentPartitionConnectionDbType.PacketDelayDistribution.Jitter, currentPartitionConnectionDbType.PacketDelayDistribution.Correlation))
+	return NewPartitionConnection(NewPacketLoss(currentPartitionConnectionDbType.PacketLoss), NewNormalPacketDelayDistribution(currentPartitionConnectionDbType.PacketDelayDistribution.AvgDelayMs, currentPartitionConnectionDbType.PacketDelayDistribution.Jitter, currentPartitionConnectionDbType.PacketDelayDistribution.Correlation), NewBandwidthLimit(currentPartitionConnectionDbType.BandwidthLimitKbps))
 }
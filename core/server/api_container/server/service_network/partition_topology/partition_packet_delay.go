@@ -34,6 +34,18 @@ func NewNormalPacketDelayDistribution(avgDelayMs uint32, jitter uint32, correlat
 	}
 }
 
+func (packetDelay *PacketDelayDistribution) GetAvgDelayMs() uint32 {
+	return packetDelay.avgDelayMs
+}
+
+func (packetDelay *PacketDelayDistribution) GetJitterMs() uint32 {
+	return packetDelay.jitter
+}
+
+func (packetDelay *PacketDelayDistribution) GetCorrelation() float32 {
+	return packetDelay.correlation
+}
+
 // IsSet This method checks whether we require to set packet delay using tc command
 func (packetDelay *PacketDelayDistribution) IsSet() bool {
 	return packetDelay.avgDelayMs != 0 || packetDelay.jitter != 0 || packetDelay.correlation != 0
@@ -19,6 +19,10 @@ func NewPacketLoss(packetLossPercentage float32) PacketLoss {
 	}
 }
 
+func (packetLoss *PacketLoss) GetPercentage() float32 {
+	return packetLoss.packetLossPercentage
+}
+
 // IsSet This method checks whether we need to set loss percentage, default value is 0
 func (packetLoss *PacketLoss) IsSet() bool {
 	return packetLoss.packetLossPercentage > 0
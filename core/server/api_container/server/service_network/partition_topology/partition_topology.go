@@ -500,6 +500,34 @@ func (topology *PartitionTopology) GetServicePartitionConnectionConfigByServiceN
 	return result, nil
 }
 
+// GetPartitionsWithRestrictedConnections returns the partitions that have a connection other than 'allowed' with at
+// least another partition, i.e. the partitions whose services have traffic rules to enforce
+func (topology *PartitionTopology) GetPartitionsWithRestrictedConnections() (map[service_network_types.PartitionID]bool, error) {
+	topology.lock.RLock()
+	defer topology.lock.RUnlock()
+	allPartitions, err := topology.partitionServices.GetAllPartitions()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while reading all partitions")
+	}
+	result := map[service_network_types.PartitionID]bool{}
+	for partitionId := range allPartitions {
+		for otherPartitionId := range allPartitions {
+			if partitionId == otherPartitionId {
+				continue
+			}
+			connection, err := topology.getPartitionConnectionUnlocked(service_network_types.PartitionID(partitionId), service_network_types.PartitionID(otherPartitionId))
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Couldn't get connection between partitions '%v' and '%v'", partitionId, otherPartitionId)
+			}
+			if connection != ConnectionAllowed {
+				result[service_network_types.PartitionID(partitionId)] = true
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
 // ================================================================================================
 //
 //	Private Helper Methods
@@ -575,6 +603,7 @@ func partitionConnectionDbTypeFromPartitionConnection(connection PartitionConnec
 			Jitter:      connection.packetDelayDistribution.jitter,
 			Correlation: connection.packetDelayDistribution.correlation,
 		},
+		BandwidthLimitKbps: connection.bandwidthLimit.kbps,
 	}
 }
 
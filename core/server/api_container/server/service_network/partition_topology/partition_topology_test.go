@@ -19,7 +19,7 @@ import (
 	"testing"
 )
 
-var connectionWithSomeConstantLatency = NewPartitionConnection(ConnectionWithNoPacketLoss, NewUniformPacketDelayDistribution(500))
+var connectionWithSomeConstantLatency = NewPartitionConnection(ConnectionWithNoPacketLoss, NewUniformPacketDelayDistribution(500), ConnectionWithNoBandwidthLimit)
 var connectionWithSoftPacketLoss = NewPacketLoss(50)
 
 const (
@@ -531,7 +531,7 @@ func TestSetConnection(t *testing.T) {
 		map[service_network_types.PartitionConnectionID]PartitionConnection{},
 		ConnectionBlocked)
 
-	connectionOverride := NewPartitionConnection(connectionWithSoftPacketLoss, ConnectionWithNoPacketDelay)
+	connectionOverride := NewPartitionConnection(connectionWithSoftPacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 	err := topology.SetConnection(partition1, partition2, connectionOverride)
 	require.Nil(t, err)
 
@@ -581,7 +581,7 @@ func TestSetConnection_FailureUnknownPartition(t *testing.T) {
 		map[service_network_types.PartitionConnectionID]PartitionConnection{},
 		ConnectionBlocked)
 
-	connectionOverride := NewPartitionConnection(connectionWithSoftPacketLoss, ConnectionWithNoPacketDelay)
+	connectionOverride := NewPartitionConnection(connectionWithSoftPacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 	err := topology.SetConnection(partition1, "unknownPartition", connectionOverride)
 	require.Contains(t, err.Error(), "About to set a connection between 'partition1' and 'unknownPartition' but 'unknownPartition' does not exist")
 }
@@ -590,7 +590,7 @@ func TestUnsetConnection(t *testing.T) {
 	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
 	defer closerFunc()
 
-	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
+	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 
 	repartition(
 		t,
@@ -638,7 +638,7 @@ func TestUnsetConnection_FailurePartitionNotFound(t *testing.T) {
 	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
 	defer closerFunc()
 
-	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
+	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 
 	repartition(
 		t,
@@ -659,7 +659,7 @@ func TestGetConnection(t *testing.T) {
 	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
 	defer closerFunc()
 
-	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
+	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 	repartition(
 		t,
 		topology,
@@ -691,7 +691,7 @@ func TestGetConnection_FailurePartitionNotFound(t *testing.T) {
 	topology, closerFunc := get3NodeTestTopology(t, ConnectionBlocked)
 	defer closerFunc()
 
-	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
+	connectionOverride := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 	repartition(
 		t,
 		topology,
@@ -722,7 +722,7 @@ func TestSetDefaultConnection(t *testing.T) {
 		map[service_network_types.PartitionConnectionID]PartitionConnection{},
 		ConnectionBlocked)
 
-	newDefaultConnection := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay)
+	newDefaultConnection := NewPartitionConnection(ConnectionWithEntirePacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
 	topology.SetDefaultConnection(newDefaultConnection)
 
 	require.Equal(t, newDefaultConnection, topology.GetDefaultConnection())
@@ -919,6 +919,34 @@ func TestRemovePartition_FailureRemovingDefaultDisallowed(t *testing.T) {
 	require.Contains(t, err.Error(), "Default partition cannot be removed")
 }
 
+func TestGetPartitionsWithRestrictedConnections(t *testing.T) {
+	topology, closerFunc := get3NodeTestTopology(t, ConnectionAllowed)
+	defer closerFunc()
+
+	repartition(
+		t,
+		topology,
+		serviceSetWithService1,
+		serviceSetWithService2,
+		serviceSetWithService3,
+		map[service_network_types.PartitionConnectionID]PartitionConnection{},
+		ConnectionAllowed)
+
+	restrictedPartitions, err := topology.GetPartitionsWithRestrictedConnections()
+	require.Nil(t, err)
+	require.Empty(t, restrictedPartitions)
+
+	connectionOverride := NewPartitionConnection(connectionWithSoftPacketLoss, ConnectionWithNoPacketDelay, ConnectionWithNoBandwidthLimit)
+	require.Nil(t, topology.SetConnection(partition1, partition2, connectionOverride))
+
+	restrictedPartitions, err = topology.GetPartitionsWithRestrictedConnections()
+	require.Nil(t, err)
+	require.Equal(t, map[service_network_types.PartitionID]bool{
+		partition1: true,
+		partition2: true,
+	}, restrictedPartitions)
+}
+
 // ===========================================================================================
 //
 //	Private helper methods
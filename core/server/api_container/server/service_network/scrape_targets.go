@@ -0,0 +1,24 @@
+package service_network
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+const (
+	// DefaultMetricsPortId is the ID of the port the metrics of a service are scraped from when its config doesn't set
+	// a metrics port ID
+	DefaultMetricsPortId = "metrics"
+)
+
+// GetMetricsPortId returns the ID of the private port the service serves Prometheus metrics on: the metrics port ID of
+// its config or, by convention, its port with ID 'metrics'. It returns false if the service has no metrics port.
+func GetMetricsPortId(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) (string, bool) {
+	metricsPortId := serviceConfig.GetMetricsPortId()
+	if metricsPortId == "" {
+		metricsPortId = DefaultMetricsPortId
+	}
+	if _, found := serviceConfig.GetPrivatePorts()[metricsPortId]; !found {
+		return "", false
+	}
+	return metricsPortId, true
+}
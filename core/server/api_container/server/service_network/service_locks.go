@@ -0,0 +1,80 @@
+package service_network
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"sort"
+	"sync"
+)
+
+// serviceLocks hands out a read-write lock per service name, so that operations on different services don't wait on
+// each other. Operations only reading a service (exec, copying files out of it, ...) take the read lock while
+// operations changing it (pause, restart, removal, ...) take the write lock.
+// The lock of a name is kept once created, so that an operation waiting on it while the service gets removed and
+// re-added under the same name still serializes with the new service
+type serviceLocks struct {
+	mutex *sync.Mutex
+
+	locks map[service.ServiceName]*sync.RWMutex
+}
+
+func newServiceLocks() *serviceLocks {
+	return &serviceLocks{
+		mutex: &sync.Mutex{},
+		locks: map[service.ServiceName]*sync.RWMutex{},
+	}
+}
+
+// lock takes the write lock of all the given services and returns the function releasing them. The locks are always
+// taken in the same order so that two operations on overlapping sets of services can't deadlock
+func (serviceLocks *serviceLocks) lock(serviceNames ...service.ServiceName) func() {
+	locks := serviceLocks.getSortedLocks(serviceNames)
+	for _, lock := range locks {
+		lock.Lock()
+	}
+	return func() {
+		for idx := len(locks) - 1; idx >= 0; idx-- {
+			locks[idx].Unlock()
+		}
+	}
+}
+
+// rLock takes the read lock of all the given services and returns the function releasing them
+func (serviceLocks *serviceLocks) rLock(serviceNames ...service.ServiceName) func() {
+	locks := serviceLocks.getSortedLocks(serviceNames)
+	for _, lock := range locks {
+		lock.RLock()
+	}
+	return func() {
+		for idx := len(locks) - 1; idx >= 0; idx-- {
+			locks[idx].RUnlock()
+		}
+	}
+}
+
+func (serviceLocks *serviceLocks) getSortedLocks(serviceNames []service.ServiceName) []*sync.RWMutex {
+	sortedServiceNames := make([]service.ServiceName, 0, len(serviceNames))
+	seenServiceNames := map[service.ServiceName]bool{}
+	for _, serviceName := range serviceNames {
+		if seenServiceNames[serviceName] {
+			continue
+		}
+		seenServiceNames[serviceName] = true
+		sortedServiceNames = append(sortedServiceNames, serviceName)
+	}
+	sort.Slice(sortedServiceNames, func(i, j int) bool {
+		return sortedServiceNames[i] < sortedServiceNames[j]
+	})
+
+	serviceLocks.mutex.Lock()
+	defer serviceLocks.mutex.Unlock()
+	locks := make([]*sync.RWMutex, 0, len(sortedServiceNames))
+	for _, serviceName := range sortedServiceNames {
+		lock, found := serviceLocks.locks[serviceName]
+		if !found {
+			lock = &sync.RWMutex{}
+			serviceLocks.locks[serviceName] = lock
+		}
+		locks = append(locks, lock)
+	}
+	return locks
+}
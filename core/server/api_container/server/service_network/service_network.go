@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_network_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"io"
 	"net/http"
 )
 
@@ -18,6 +19,22 @@ type ServiceNetwork interface {
 		newDefaultConnection partition_topology.PartitionConnection,
 	) error
 
+	// AddPartition creates a new partition with the default connection to every other partition and moves the given
+	// services to it. Only the sidecars of the services whose connections change get updated
+	AddPartition(
+		ctx context.Context,
+		partitionId service_network_types.PartitionID,
+		serviceNames map[service.ServiceName]bool,
+	) error
+
+	// MovePartition moves the given services to an existing partition. Only the sidecars of the services whose
+	// connections change get updated
+	MovePartition(
+		ctx context.Context,
+		partitionId service_network_types.PartitionID,
+		serviceNames map[service.ServiceName]bool,
+	) error
+
 	SetConnection(
 		ctx context.Context,
 		partition1 service_network_types.PartitionID,
@@ -66,24 +83,78 @@ type ServiceNetwork interface {
 
 	RemoveService(ctx context.Context, serviceIdentifier string) (service.ServiceUUID, error)
 
+	// RemoveServices removes all the services with the given identifiers, stopping their containers in parallel,
+	// returning the UUIDs of the services that got removed and the ones that failed to, with the error
+	RemoveServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]service.ServiceUUID, map[service.ServiceName]error, error)
+
+	// GetServiceMetrics gets a snapshot of the CPU, memory and network usage of the service
+	GetServiceMetrics(ctx context.Context, serviceIdentifier string) (*service.ServiceMetrics, error)
+
 	PauseService(ctx context.Context, serviceIdentifier string) error
 
 	UnpauseService(ctx context.Context, serviceIdentifier string) error
 
+	// PauseServices pauses all the services with the given identifiers at once, e.g. to freeze an entire partition,
+	// returning the services that got paused and the ones that failed to, with the error
+	PauseServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error)
+
+	// UnpauseServices unpauses all the services with the given identifiers at once
+	UnpauseServices(ctx context.Context, serviceIdentifiers map[string]bool) (map[service.ServiceName]bool, map[service.ServiceName]error, error)
+
+	// RestartService restarts the container of the service in place; the service isn't considered ready anymore until
+	// it gets marked as ready again
+	RestartService(ctx context.Context, serviceIdentifier string) error
+
+	// WaitForServiceExit blocks until the container of the service exits, returning its exit code
+	WaitForServiceExit(ctx context.Context, serviceIdentifier string) (int32, error)
+
+	SendSignalToService(ctx context.Context, serviceIdentifier string, signal string) error
+
 	ExecCommand(ctx context.Context, serviceIdentifier string, command []string) (int32, string, error)
 
+	// ExecCommandWithStreamedOutput writes the output of the command to the given writer as it gets produced, so that
+	// commands with outputs too big to hold in memory can be run
+	ExecCommandWithStreamedOutput(ctx context.Context, serviceIdentifier string, command []string, output io.Writer) (int32, error)
+
 	HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error)
 
+	// CheckServicePortAvailability returns an error if the private port of the service doesn't accept connections yet
+	CheckServicePortAvailability(ctx context.Context, serviceIdentifier string, portId string) error
+
 	GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error)
 
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
+	CopyFilesFromServices(ctx context.Context, srcPathsByService map[service.ServiceName]string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
+
+	CopyFilesArtifactToService(ctx context.Context, serviceIdentifier string, artifactIdentifier string, dstDirpath string) error
+
 	GetServiceNames() map[service.ServiceName]bool
 
 	GetExistingAndHistoricalServiceIdentifiers() []*kurtosis_core_rpc_api_bindings.ServiceIdentifiers
 
 	GetServiceRegistration(serviceName service.ServiceName) (*service.ServiceRegistration, bool)
 
+	// GetServiceConfigs returns the configs the services currently in the network were started with
+	GetServiceConfigs() map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+
+	// GetScrapeTargets returns the endpoints to scrape the Prometheus metrics of the services from, sorted by service name
+	GetScrapeTargets(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.ScrapeTarget, error)
+
+	// MarkServiceAsReady records that the ready conditions of the service just passed
+	MarkServiceAsReady(serviceName service.ServiceName)
+
+	// GetServiceStartupTimes returns when the service's container entered running and when the service became ready
+	GetServiceStartupTimes(serviceName service.ServiceName) (*service_network_types.ServiceStartupTimes, bool)
+
+	// GetSubnetworkConnections returns the default connection between subnetworks, and the connections between pairs of
+	// subnetworks that override it
+	GetSubnetworkConnections() (partition_topology.PartitionConnection, map[service_network_types.PartitionConnectionID]partition_topology.PartitionConnection, error)
+
+	// TestConnectivity pings each of the given services (all of them if none is given) from every other one, returning
+	// what got observed for every pair of services next to what the partition topology expects, by source then target
+	TestConnectivity(ctx context.Context, serviceIdentifiers map[string]bool, numProbes uint32) (map[service.ServiceName]map[service.ServiceName]*service_network_types.ConnectivityProbeResult, error)
+
 	RenderTemplates(templatesAndDataByDestinationRelFilepath map[string]*kurtosis_core_rpc_api_bindings.RenderTemplatesToFilesArtifactArgs_TemplateAndData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	UploadFilesArtifact(data []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
@@ -0,0 +1,51 @@
+package service_network_types
+
+// ConnectivityProbeResult compares what got observed pinging a service from another one with what the partition
+// topology says the connection between them should be
+type ConnectivityProbeResult struct {
+	expectedPacketLossPercentage float32
+	expectedPacketDelayMs        uint32
+
+	observedPacketLossPercentage float32
+	// Average round trip time of the pings that got a reply; 0 if none did
+	observedAvgLatencyMs float32
+
+	// Set if the pings couldn't be sent at all, in which case the observed values are meaningless
+	maybeProbeErr error
+}
+
+func NewConnectivityProbeResult(
+	expectedPacketLossPercentage float32,
+	expectedPacketDelayMs uint32,
+	observedPacketLossPercentage float32,
+	observedAvgLatencyMs float32,
+	maybeProbeErr error,
+) *ConnectivityProbeResult {
+	return &ConnectivityProbeResult{
+		expectedPacketLossPercentage: expectedPacketLossPercentage,
+		expectedPacketDelayMs:        expectedPacketDelayMs,
+		observedPacketLossPercentage: observedPacketLossPercentage,
+		observedAvgLatencyMs:         observedAvgLatencyMs,
+		maybeProbeErr:                maybeProbeErr,
+	}
+}
+
+func (result *ConnectivityProbeResult) GetExpectedPacketLossPercentage() float32 {
+	return result.expectedPacketLossPercentage
+}
+
+func (result *ConnectivityProbeResult) GetExpectedPacketDelayMs() uint32 {
+	return result.expectedPacketDelayMs
+}
+
+func (result *ConnectivityProbeResult) GetObservedPacketLossPercentage() float32 {
+	return result.observedPacketLossPercentage
+}
+
+func (result *ConnectivityProbeResult) GetObservedAvgLatencyMs() float32 {
+	return result.observedAvgLatencyMs
+}
+
+func (result *ConnectivityProbeResult) GetMaybeProbeErr() error {
+	return result.maybeProbeErr
+}
@@ -0,0 +1,29 @@
+package service_network_types
+
+import (
+	"time"
+)
+
+// ServiceStartupTimes records the points in time a service went through while being brought up
+type ServiceStartupTimes struct {
+	// When the backend reported the service's container running
+	runningTime time.Time
+
+	// When the service's ready conditions passed; nil if it has none or they haven't passed yet
+	maybeReadyTime *time.Time
+}
+
+func NewServiceStartupTimes(runningTime time.Time, maybeReadyTime *time.Time) *ServiceStartupTimes {
+	return &ServiceStartupTimes{
+		runningTime:    runningTime,
+		maybeReadyTime: maybeReadyTime,
+	}
+}
+
+func (times *ServiceStartupTimes) GetRunningTime() time.Time {
+	return times.runningTime
+}
+
+func (times *ServiceStartupTimes) GetMaybeReadyTime() *time.Time {
+	return times.maybeReadyTime
+}
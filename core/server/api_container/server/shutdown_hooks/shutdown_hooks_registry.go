@@ -0,0 +1,116 @@
+package shutdown_hooks
+
+import (
+	"context"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+var (
+	defaultShutdownHooksRegistry = NewShutdownHooksRegistry()
+)
+
+// ShutdownHookFunc does the work of a shutdown hook, e.g. flushing a database or dumping metrics; it must stop when
+// the context gets cancelled
+type ShutdownHookFunc func(ctx context.Context) error
+
+type shutdownHook struct {
+	description string
+	timeout     time.Duration
+	run         ShutdownHookFunc
+}
+
+// ShutdownHookResult is the outcome of running a shutdown hook; the error is nil if the hook succeeded
+type ShutdownHookResult struct {
+	description string
+	err         error
+}
+
+func (result *ShutdownHookResult) GetDescription() string {
+	return result.description
+}
+
+func (result *ShutdownHookResult) GetError() error {
+	return result.err
+}
+
+// ShutdownHooksRegistry keeps the hooks that packages registered to run when the enclave is stopped or destroyed, so
+// that they can collect results before the containers go away
+type ShutdownHooksRegistry struct {
+	mutex *sync.Mutex
+
+	// In registration order, which is the order they get run in
+	hooks []*shutdownHook
+}
+
+func NewShutdownHooksRegistry() *ShutdownHooksRegistry {
+	return &ShutdownHooksRegistry{
+		mutex: &sync.Mutex{},
+		hooks: []*shutdownHook{},
+	}
+}
+
+// GetShutdownHooksRegistry returns the registry shared by the Starlark instructions registering hooks and the API
+// that runs them
+func GetShutdownHooksRegistry() *ShutdownHooksRegistry {
+	return defaultShutdownHooksRegistry
+}
+
+// RegisterHook adds a hook to run on shutdown, bounded by the timeout. Registering a hook with the same description as
+// an existing one replaces it, so that running the same package twice doesn't run its hooks twice
+func (registry *ShutdownHooksRegistry) RegisterHook(description string, timeout time.Duration, run ShutdownHookFunc) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	newHook := &shutdownHook{
+		description: description,
+		timeout:     timeout,
+		run:         run,
+	}
+	for idx, hook := range registry.hooks {
+		if hook.description == description {
+			registry.hooks[idx] = newHook
+			return
+		}
+	}
+	registry.hooks = append(registry.hooks, newHook)
+}
+
+// RunHooks runs the registered hooks one after the other, in registration order. A hook failing or timing out doesn't
+// prevent the next ones from running. The hooks are only ever run once: they are unregistered as they get run
+func (registry *ShutdownHooksRegistry) RunHooks(ctx context.Context) []*ShutdownHookResult {
+	registry.mutex.Lock()
+	hooks := registry.hooks
+	registry.hooks = []*shutdownHook{}
+	registry.mutex.Unlock()
+
+	results := []*ShutdownHookResult{}
+	for _, hook := range hooks {
+		logrus.Infof("Running shutdown hook '%v'", hook.description)
+		err := runHook(ctx, hook)
+		if err != nil {
+			logrus.Warnf("Shutdown hook '%v' failed:\n%v", hook.description, err)
+		}
+		results = append(results, &ShutdownHookResult{
+			description: hook.description,
+			err:         err,
+		})
+	}
+	return results
+}
+
+func runHook(ctx context.Context, hook *shutdownHook) error {
+	hookCtx, cancelHook := context.WithTimeout(ctx, hook.timeout)
+	defer cancelHook()
+	hookErrChan := make(chan error, 1)
+	go func() {
+		hookErrChan <- hook.run(hookCtx)
+	}()
+	select {
+	case err := <-hookErrChan:
+		return err
+	case <-hookCtx.Done():
+		return stacktrace.NewError("Shutdown hook '%v' didn't complete within its timeout of '%v'", hook.description, hook.timeout)
+	}
+}
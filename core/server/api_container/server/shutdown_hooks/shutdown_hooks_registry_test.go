@@ -0,0 +1,64 @@
+package shutdown_hooks
+
+import (
+	"context"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	testHookTimeout = time.Second
+)
+
+func TestRunHooks_RunsAllHooksInRegistrationOrderOnce(t *testing.T) {
+	registry := NewShutdownHooksRegistry()
+	hooksRun := []string{}
+	registry.RegisterHook("flush-db", testHookTimeout, func(ctx context.Context) error {
+		hooksRun = append(hooksRun, "flush-db")
+		return stacktrace.NewError("Flushing failed")
+	})
+	registry.RegisterHook("dump-metrics", testHookTimeout, func(ctx context.Context) error {
+		hooksRun = append(hooksRun, "dump-metrics")
+		return nil
+	})
+
+	results := registry.RunHooks(context.Background())
+	require.Equal(t, []string{"flush-db", "dump-metrics"}, hooksRun)
+	require.Len(t, results, 2)
+	require.Equal(t, "flush-db", results[0].GetDescription())
+	require.Error(t, results[0].GetError())
+	require.Equal(t, "dump-metrics", results[1].GetDescription())
+	require.NoError(t, results[1].GetError())
+
+	require.Empty(t, registry.RunHooks(context.Background()))
+}
+
+func TestRegisterHook_ReplacesHookWithSameDescription(t *testing.T) {
+	registry := NewShutdownHooksRegistry()
+	hookRuns := 0
+	for i := 0; i < 2; i++ {
+		registry.RegisterHook("flush-db", testHookTimeout, func(ctx context.Context) error {
+			hookRuns++
+			return nil
+		})
+	}
+
+	require.Len(t, registry.RunHooks(context.Background()), 1)
+	require.Equal(t, 1, hookRuns)
+}
+
+func TestRunHooks_TimesOutHook(t *testing.T) {
+	registry := NewShutdownHooksRegistry()
+	registry.RegisterHook("hanging", 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	results := registry.RunHooks(context.Background())
+	require.Len(t, results, 1)
+	require.Error(t, results[0].GetError())
+	require.Contains(t, results[0].GetError().Error(), "timeout")
+}
@@ -0,0 +1,64 @@
+package builtins
+
+import (
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	enclaveStructName = "enclave"
+
+	enclaveNameAttrName                = "name"
+	enclaveUuidAttrName                = "uuid"
+	enclaveBackendTypeAttrName         = "backend_type"
+	enclaveApiContainerVersionAttrName = "api_container_version"
+)
+
+// EnclaveInfo is what packages get to know about the enclave they run in, as the read-only `kurtosis.enclave` struct,
+// so that they can adapt to it, e.g. skipping the features that are only available on Docker
+type EnclaveInfo struct {
+	name string
+
+	uuid string
+
+	// "docker" or "kubernetes"
+	backendType string
+
+	apiContainerVersion string
+}
+
+func NewEnclaveInfo(name string, uuid string, backendType string, apiContainerVersion string) *EnclaveInfo {
+	return &EnclaveInfo{
+		name:                name,
+		uuid:                uuid,
+		backendType:         backendType,
+		apiContainerVersion: apiContainerVersion,
+	}
+}
+
+func (enclaveInfo *EnclaveInfo) GetName() string {
+	return enclaveInfo.name
+}
+
+func (enclaveInfo *EnclaveInfo) GetUuid() string {
+	return enclaveInfo.uuid
+}
+
+func (enclaveInfo *EnclaveInfo) GetBackendType() string {
+	return enclaveInfo.backendType
+}
+
+func (enclaveInfo *EnclaveInfo) GetApiContainerVersion() string {
+	return enclaveInfo.apiContainerVersion
+}
+
+func newEnclaveStruct(enclaveInfo *EnclaveInfo) *starlarkstruct.Struct {
+	enclaveStruct := starlarkstruct.FromStringDict(starlark.String(enclaveStructName), starlark.StringDict{
+		enclaveNameAttrName:                starlark.String(enclaveInfo.name),
+		enclaveUuidAttrName:                starlark.String(enclaveInfo.uuid),
+		enclaveBackendTypeAttrName:         starlark.String(enclaveInfo.backendType),
+		enclaveApiContainerVersionAttrName: starlark.String(enclaveInfo.apiContainerVersion),
+	})
+	enclaveStruct.Freeze()
+	return enclaveStruct
+}
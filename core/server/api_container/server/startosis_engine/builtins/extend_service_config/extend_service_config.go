@@ -0,0 +1,106 @@
+package extend_service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ExtendServiceConfigBuiltinName = "extend_service_config"
+
+	BaseArgName = "base"
+)
+
+// NewExtendServiceConfigHelper creates the helper that instantiates a ServiceConfig template (any ServiceConfig, usually
+// declared in a module shared across packages) with overrides. It accepts every ServiceConfig argument as an optional
+// override: dictionaries (ports, env vars, files, etc.) get merged key by key with the override winning, while any
+// other value replaces the one of the template. The result gets validated like any other ServiceConfig.
+func NewExtendServiceConfigHelper() *kurtosis_helper.KurtosisHelper {
+	arguments := []*builtin_argument.BuiltinArgument{
+		{
+			Name:              BaseArgName,
+			IsOptional:        false,
+			ZeroValueProvider: builtin_argument.ZeroValueProvider[*service_config.ServiceConfig],
+			Validator:         nil,
+		},
+	}
+	for _, serviceConfigArgument := range service_config.NewServiceConfigType().Arguments {
+		arguments = append(arguments, &builtin_argument.BuiltinArgument{
+			Name:              serviceConfigArgument.Name,
+			IsOptional:        true,
+			ZeroValueProvider: serviceConfigArgument.ZeroValueProvider,
+			Validator:         serviceConfigArgument.Validator,
+		})
+	}
+
+	return &kurtosis_helper.KurtosisHelper{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name:      ExtendServiceConfigBuiltinName,
+			Arguments: arguments,
+		},
+
+		Capabilities: &extendServiceConfigCapabilities{},
+	}
+}
+
+type extendServiceConfigCapabilities struct{}
+
+func (builtin *extendServiceConfigCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	base, err := builtin_argument.ExtractArgumentValue[*service_config.ServiceConfig](arguments, BaseArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", BaseArgName)
+	}
+	mergedAttrs := starlark.StringDict{}
+	base.ToStringDict(mergedAttrs)
+
+	serviceConfigType := service_config.NewServiceConfigType()
+	for _, serviceConfigArgument := range serviceConfigType.Arguments {
+		attrName := serviceConfigArgument.Name
+		if !arguments.IsSet(attrName) {
+			continue
+		}
+		override, err := builtin_argument.ExtractArgumentValue[starlark.Value](arguments, attrName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for arg '%s'", attrName)
+		}
+		mergedValue, interpretationErr := mergeAttrValues(attrName, mergedAttrs[attrName], override)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		mergedAttrs[attrName] = mergedValue
+	}
+
+	var kwargs []starlark.Tuple
+	for _, serviceConfigArgument := range serviceConfigType.Arguments {
+		if value, found := mergedAttrs[serviceConfigArgument.Name]; found {
+			kwargs = append(kwargs, starlark.Tuple{starlark.String(serviceConfigArgument.Name), value})
+		}
+	}
+	serviceConfigArguments, interpretationErr := builtin_argument.CreateNewArgumentValuesSet(service_config.ServiceConfigTypeName, serviceConfigType.Arguments, starlark.Tuple{}, kwargs)
+	if interpretationErr != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "The '%s' extended with the given overrides is invalid", service_config.ServiceConfigTypeName)
+	}
+	return serviceConfigType.Instantiate(serviceConfigArguments)
+}
+
+// mergeAttrValues merges the override into the base value if both are dictionaries, otherwise the override wins
+func mergeAttrValues(attrName string, baseValue starlark.Value, override starlark.Value) (starlark.Value, *startosis_errors.InterpretationError) {
+	baseDict, isBaseDict := baseValue.(*starlark.Dict)
+	overrideDict, isOverrideDict := override.(*starlark.Dict)
+	if !isBaseDict || !isOverrideDict {
+		return override, nil
+	}
+	mergedDict := starlark.NewDict(baseDict.Len() + overrideDict.Len())
+	for _, dict := range []*starlark.Dict{baseDict, overrideDict} {
+		for _, item := range dict.Items() {
+			if err := mergedDict.SetKey(item[0], item[1]); err != nil {
+				return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred merging key '%v' of '%s'", item[0], attrName)
+			}
+		}
+	}
+	return mergedDict, nil
+}
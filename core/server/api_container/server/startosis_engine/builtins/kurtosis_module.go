@@ -15,7 +15,7 @@ const (
 	connectionSubmoduleAllowedAttrName = "ALLOWED"
 )
 
-func KurtosisModule() (*starlarkstruct.Module, *startosis_errors.InterpretationError) {
+func KurtosisModule(enclaveInfo *EnclaveInfo) (*starlarkstruct.Module, *startosis_errors.InterpretationError) {
 	connectionModule, interpretationErr := newConnectionModule()
 	if interpretationErr != nil {
 		return nil, interpretationErr
@@ -24,6 +24,7 @@ func KurtosisModule() (*starlarkstruct.Module, *startosis_errors.InterpretationE
 		Name: KurtosisModuleName,
 		Members: starlark.StringDict{
 			connectionSubmoduleName: connectionModule,
+			enclaveStructName:       newEnclaveStruct(enclaveInfo),
 		},
 	}, nil
 }
@@ -0,0 +1,58 @@
+package execution_progress
+
+import (
+	"context"
+	"sync"
+)
+
+type contextKey string
+
+const (
+	reporterContextKey contextKey = "execution-progress-reporter"
+)
+
+// Reporter sends the progress of the instruction being executed to the run response line stream, e.g. so that users
+// can see what a long wait is waiting on. Reports made once the instruction is done get dropped, as the stream may be
+// closed by then while goroutines started by the instruction are still winding down
+type Reporter struct {
+	mutex  *sync.Mutex
+	isDone bool
+	report func(progressLines []string)
+}
+
+func NewReporter(report func(progressLines []string)) *Reporter {
+	return &Reporter{
+		mutex:  &sync.Mutex{},
+		isDone: false,
+		report: report,
+	}
+}
+
+// Done stops the reporter; it waits for the report in flight, if any, to be sent
+func (reporter *Reporter) Done() {
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	reporter.isDone = true
+}
+
+func (reporter *Reporter) reportProgress(progressLines []string) {
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+	if reporter.isDone {
+		return
+	}
+	reporter.report(progressLines)
+}
+
+func WithReporter(ctx context.Context, reporter *Reporter) context.Context {
+	return context.WithValue(ctx, reporterContextKey, reporter)
+}
+
+// Report reports the progress of the instruction being executed with the context, if it has a reporter
+func Report(ctx context.Context, progressLines ...string) {
+	reporter, found := ctx.Value(reporterContextKey).(*Reporter)
+	if !found {
+		return
+	}
+	reporter.reportProgress(progressLines)
+}
@@ -0,0 +1,28 @@
+package execution_progress
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	reportedProgress := [][]string{}
+	reporter := NewReporter(func(progressLines []string) {
+		reportedProgress = append(reportedProgress, progressLines)
+	})
+	ctx := WithReporter(context.Background(), reporter)
+
+	Report(ctx, "Waiting for service 'api'", "Last failure: connection refused")
+	require.Equal(t, [][]string{{"Waiting for service 'api'", "Last failure: connection refused"}}, reportedProgress)
+
+	reporter.Done()
+	Report(ctx, "Reported once the instruction is done")
+	require.Len(t, reportedProgress, 1)
+}
+
+func TestReport_NoReporterIsANoOp(t *testing.T) {
+	require.NotPanics(t, func() {
+		Report(context.Background(), "Nobody is listening")
+	})
+}
@@ -0,0 +1,239 @@
+package add_service
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	AddDebugEchoServiceBuiltinName = "add_debug_echo_service"
+
+	DnsRecordsArgName = "dns_records"
+	SubnetworkArgName = "subnetwork"
+
+	// Pinned so that connectivity tests behave the same from one run to the next; BusyBox ships the HTTP server, the
+	// netcat listener and the DNS responder all in one tiny image
+	DefaultDebugEchoImage = "busybox:1.36.1"
+
+	DebugEchoHttpPortId       = "http"
+	DebugEchoHttpPortNumber   = 80
+	DebugEchoTcpPortId        = "tcp-echo"
+	DebugEchoTcpPortNumber    = 7
+	DebugEchoDnsPortId        = "dns"
+	DebugEchoDnsPortNumber    = 53
+	debugEchoHttpProtocol     = "http"
+	debugEchoHttpRootDirpath  = "/www"
+	debugEchoDnsdConfFilepath = "/etc/dnsd.conf"
+
+	// Always answered by the DNS responder, so that it can be queried without declaring any record
+	DefaultDebugEchoDnsRecordName    = "echo.kurtosis"
+	DefaultDebugEchoDnsRecordAddress = "127.0.0.1"
+)
+
+var dnsRecordNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+
+// NewAddDebugEchoService starts a tiny service that answers on HTTP (echoing the request back), on TCP (echoing
+// whatever gets sent) and on DNS (resolving the given records), so that connectivity and partition tests don't need to
+// pick an image of their own. Under the hood it is an add_service call with a generated config
+func NewAddDebugEchoService(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: AddDebugEchoServiceBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              DnsRecordsArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, err := getDnsRecords(value)
+						return err
+					},
+				},
+				{
+					Name:              SubnetworkArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SubnetworkArgName)
+					},
+				},
+				{
+					Name:              ImageArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &AddDebugEchoServiceCapabilities{
+				AddServiceCapabilities: &AddServiceCapabilities{
+					serviceNetwork:    serviceNetwork,
+					runtimeValueStore: runtimeValueStore,
+
+					serviceName:   "",  // populated at interpretation time
+					serviceConfig: nil, // populated at interpretation time
+
+					resultUuid:           "", // populated at interpretation time
+					readyConditions:      nil,
+					dependencyRecoveries: nil,
+				},
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			DnsRecordsArgName:  true,
+			SubnetworkArgName:  true,
+		},
+	}
+}
+
+// AddDebugEchoServiceCapabilities only differs from AddServiceCapabilities in how the service config gets built
+type AddDebugEchoServiceCapabilities struct {
+	*AddServiceCapabilities
+}
+
+func (builtin *AddDebugEchoServiceCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	dnsRecords := map[string]string{}
+	if arguments.IsSet(DnsRecordsArgName) {
+		dnsRecordsDict, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, DnsRecordsArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", DnsRecordsArgName)
+		}
+		var interpretationErr *startosis_errors.InterpretationError
+		if dnsRecords, interpretationErr = getDnsRecords(dnsRecordsDict); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	subnetwork := ""
+	if arguments.IsSet(SubnetworkArgName) {
+		subnetworkValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SubnetworkArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SubnetworkArgName)
+		}
+		subnetwork = subnetworkValue.GoString()
+	}
+	image := DefaultDebugEchoImage
+	if arguments.IsSet(ImageArgName) {
+		imageValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ImageArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ImageArgName)
+		}
+		image = imageValue.GoString()
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.serviceConfig = getDebugEchoServiceConfig(image, subnetwork, dnsRecords)
+	builtin.resultUuid, err = builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddDebugEchoServiceBuiltinName)
+	}
+
+	return makeAddServiceInterpretationReturnValue(serviceName, builtin.serviceConfig, builtin.resultUuid)
+}
+
+func getDebugEchoServiceConfig(image string, subnetwork string, dnsRecords map[string]string) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	serviceConfigBuilder := services.NewServiceConfigBuilder(
+		image,
+	).WithPrivatePorts(map[string]*kurtosis_core_rpc_api_bindings.Port{
+		DebugEchoHttpPortId: binding_constructors.NewPort(DebugEchoHttpPortNumber, kurtosis_core_rpc_api_bindings.Port_TCP, debugEchoHttpProtocol),
+		DebugEchoTcpPortId:  binding_constructors.NewPort(DebugEchoTcpPortNumber, kurtosis_core_rpc_api_bindings.Port_TCP, ""),
+		DebugEchoDnsPortId:  binding_constructors.NewPort(DebugEchoDnsPortNumber, kurtosis_core_rpc_api_bindings.Port_UDP, ""),
+	}).WithEntryPointArgs(
+		[]string{"sh", "-c"},
+	).WithCmdArgs(
+		[]string{getDebugEchoScript(dnsRecords)},
+	)
+	if subnetwork != "" {
+		serviceConfigBuilder = serviceConfigBuilder.WithSubnetwork(subnetwork)
+	}
+	return serviceConfigBuilder.Build()
+}
+
+// getDebugEchoScript sets up the HTTP echo CGI script and the DNS records, and then runs the three responders
+func getDebugEchoScript(dnsRecords map[string]string) string {
+	allDnsRecords := map[string]string{
+		DefaultDebugEchoDnsRecordName: DefaultDebugEchoDnsRecordAddress,
+	}
+	for name, address := range dnsRecords {
+		allDnsRecords[name] = address
+	}
+	sortedDnsRecordNames := []string{}
+	for name := range allDnsRecords {
+		sortedDnsRecordNames = append(sortedDnsRecordNames, name)
+	}
+	sort.Strings(sortedDnsRecordNames)
+	dnsdConfLines := []string{}
+	for _, name := range sortedDnsRecordNames {
+		dnsdConfLines = append(dnsdConfLines, fmt.Sprintf("%s %s", name, allDnsRecords[name]))
+	}
+
+	scriptLines := []string{
+		fmt.Sprintf("mkdir -p %s/cgi-bin", debugEchoHttpRootDirpath),
+		fmt.Sprintf("cat > %s/cgi-bin/index.cgi <<'EOF'", debugEchoHttpRootDirpath),
+		"#!/bin/sh",
+		`printf 'Content-Type: text/plain\r\n\r\n'`,
+		`echo "$REQUEST_METHOD $REQUEST_URI"`,
+		`head -c "${CONTENT_LENGTH:-0}"`,
+		"EOF",
+		fmt.Sprintf("chmod +x %s/cgi-bin/index.cgi", debugEchoHttpRootDirpath),
+		fmt.Sprintf("cat > %s <<'EOF'", debugEchoDnsdConfFilepath),
+	}
+	scriptLines = append(scriptLines, dnsdConfLines...)
+	scriptLines = append(scriptLines,
+		"EOF",
+		fmt.Sprintf("httpd -f -p %d -h %s &", DebugEchoHttpPortNumber, debugEchoHttpRootDirpath),
+		fmt.Sprintf("nc -lk -p %d -e cat &", DebugEchoTcpPortNumber),
+		fmt.Sprintf("dnsd -c %s -p %d &", debugEchoDnsdConfFilepath, DebugEchoDnsPortNumber),
+		"wait",
+	)
+	return strings.Join(scriptLines, "\n")
+}
+
+func getDnsRecords(value starlark.Value) (map[string]string, *startosis_errors.InterpretationError) {
+	dnsRecords, interpretationErr := kurtosis_types.SafeCastToMapStringString(value, DnsRecordsArgName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for name, address := range dnsRecords {
+		if !dnsRecordNameRegexp.MatchString(name) {
+			return nil, startosis_errors.NewInterpretationError("DNS record name '%s' in '%s' is invalid; it must be a valid hostname", name, DnsRecordsArgName)
+		}
+		if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+			return nil, startosis_errors.NewInterpretationError("DNS record '%s' in '%s' points to '%s', which isn't an IPv4 address", name, DnsRecordsArgName, address)
+		}
+	}
+	return dnsRecords, nil
+}
@@ -0,0 +1,317 @@
+package add_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	AddObjectStorageBuiltinName = "add_object_storage"
+
+	BucketsArgName     = "buckets"
+	BucketFilesArgName = "bucket_files"
+	ImageArgName       = "image"
+
+	// The MinIO image ships the 'mc' client, which seeds the buckets and exports them back to files artifacts
+	DefaultObjectStorageImage = "minio/minio:RELEASE.2024-01-16T16-07-38Z"
+
+	ObjectStorageApiPortId     = "api"
+	ObjectStorageApiPortNumber = 9000
+	objectStorageConsolePortId = "console"
+	objectStorageConsolePort   = 9001
+	objectStorageHttpProtocol  = "http"
+
+	// The storage is meant to be used by the services of the enclave only, so the credentials don't need to be secret
+	ObjectStorageAccessKey = "kurtosis"
+	ObjectStorageSecretKey = "kurtosis-secret"
+
+	// The alias under which the MinIO client of the storage service reaches the storage
+	ObjectStorageClientAlias = "local"
+
+	objectStorageDataDirpath   = "/data"
+	objectStorageSeedDirpath   = "/kurtosis-bucket-files"
+	objectStorageReadyFilepath = "/tmp/kurtosis-object-storage-ready"
+
+	objectStorageSetupTimeout       = 2 * time.Minute
+	objectStorageSetupCheckInterval = 1 * time.Second
+	objectStorageSetupCheckExitCode = 0
+
+	objectStorageServiceAttr   = "service"
+	objectStorageEndpointAttr  = "endpoint"
+	objectStorageAccessKeyAttr = "access_key"
+	objectStorageSecretKeyAttr = "secret_key"
+)
+
+// Bucket names are restricted to what every S3 implementation accepts, which also makes them safe to use in the setup
+// script unquoted
+var bucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// NewAddObjectStorage starts an S3-compatible storage (MinIO) in the enclave, with the given buckets created and
+// optionally seeded from files artifacts. Under the hood it is an add_service call with a generated config, and the
+// instruction only completes once the buckets are ready
+func NewAddObjectStorage(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: AddObjectStorageBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              BucketsArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, err := getBucketNames(value)
+						return err
+					},
+				},
+				{
+					Name:              BucketFilesArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, err := getBucketFiles(value)
+						return err
+					},
+				},
+				{
+					Name:              ImageArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &AddObjectStorageCapabilities{
+				AddServiceCapabilities: &AddServiceCapabilities{
+					serviceNetwork:    serviceNetwork,
+					runtimeValueStore: runtimeValueStore,
+
+					serviceName:   "",  // populated at interpretation time
+					serviceConfig: nil, // populated at interpretation time
+
+					resultUuid: "", // populated at interpretation time
+					// The instruction waits for the setup script rather than for ready conditions
+					readyConditions:      nil,
+					dependencyRecoveries: nil,
+				},
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			BucketsArgName:     true,
+			BucketFilesArgName: true,
+		},
+	}
+}
+
+// AddObjectStorageCapabilities only differs from AddServiceCapabilities in how the service config gets built, and in
+// waiting for the buckets to be set up
+type AddObjectStorageCapabilities struct {
+	*AddServiceCapabilities
+}
+
+func (builtin *AddObjectStorageCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	bucketNames := []string{}
+	if arguments.IsSet(BucketsArgName) {
+		buckets, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, BucketsArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BucketsArgName)
+		}
+		var interpretationErr *startosis_errors.InterpretationError
+		if bucketNames, interpretationErr = getBucketNames(buckets); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	bucketFiles := map[string]string{}
+	if arguments.IsSet(BucketFilesArgName) {
+		bucketFilesDict, err := builtin_argument.ExtractArgumentValue[*starlark.Dict](arguments, BucketFilesArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BucketFilesArgName)
+		}
+		var interpretationErr *startosis_errors.InterpretationError
+		if bucketFiles, interpretationErr = getBucketFiles(bucketFilesDict); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	image := DefaultObjectStorageImage
+	if arguments.IsSet(ImageArgName) {
+		imageValue, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ImageArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ImageArgName)
+		}
+		image = imageValue.GoString()
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.serviceConfig = getObjectStorageServiceConfig(image, bucketNames, bucketFiles)
+	builtin.resultUuid, err = builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddObjectStorageBuiltinName)
+	}
+
+	serviceObject, interpretationErr := makeAddServiceInterpretationReturnValue(serviceName, builtin.serviceConfig, builtin.resultUuid)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return makeAddObjectStorageInterpretationReturnValue(serviceObject, builtin.resultUuid), nil
+}
+
+func (builtin *AddObjectStorageCapabilities) Execute(ctx context.Context, arguments *builtin_argument.ArgumentValuesSet) (string, error) {
+	if _, err := builtin.AddServiceCapabilities.Execute(ctx, arguments); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred starting the object storage service '%s'", builtin.serviceName)
+	}
+	if err := waitForObjectStorageSetup(ctx, builtin.serviceNetwork, builtin.serviceName); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred waiting for the buckets of object storage service '%s' to be set up", builtin.serviceName)
+	}
+	return fmt.Sprintf("Object storage '%s' added with its buckets set up", builtin.serviceName), nil
+}
+
+func getObjectStorageServiceConfig(image string, bucketNames []string, bucketFiles map[string]string) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	filesArtifactMountpoints := map[string]string{}
+	for bucketName, artifactName := range bucketFiles {
+		filesArtifactMountpoints[objectStorageSeedDirpath+"/"+bucketName] = artifactName
+	}
+	return services.NewServiceConfigBuilder(
+		image,
+	).WithPrivatePorts(map[string]*kurtosis_core_rpc_api_bindings.Port{
+		ObjectStorageApiPortId:     binding_constructors.NewPort(ObjectStorageApiPortNumber, kurtosis_core_rpc_api_bindings.Port_TCP, objectStorageHttpProtocol),
+		objectStorageConsolePortId: binding_constructors.NewPort(objectStorageConsolePort, kurtosis_core_rpc_api_bindings.Port_TCP, objectStorageHttpProtocol),
+	}).WithEnvVars(map[string]string{
+		"MINIO_ROOT_USER":     ObjectStorageAccessKey,
+		"MINIO_ROOT_PASSWORD": ObjectStorageSecretKey,
+	}).WithEntryPointArgs(
+		[]string{"sh", "-c"},
+	).WithCmdArgs(
+		[]string{getObjectStorageSetupScript(bucketNames, bucketFiles)},
+	).WithFilesArtifactMountDirpaths(
+		filesArtifactMountpoints,
+	).Build()
+}
+
+// getObjectStorageSetupScript starts MinIO in the background, creates and seeds the buckets once it accepts
+// connections, and then marks the setup as done for the instruction to complete
+func getObjectStorageSetupScript(bucketNames []string, bucketFiles map[string]string) string {
+	allBucketNames := map[string]bool{}
+	for _, bucketName := range bucketNames {
+		allBucketNames[bucketName] = true
+	}
+	for bucketName := range bucketFiles {
+		allBucketNames[bucketName] = true
+	}
+	sortedBucketNames := []string{}
+	for bucketName := range allBucketNames {
+		sortedBucketNames = append(sortedBucketNames, bucketName)
+	}
+	sort.Strings(sortedBucketNames)
+
+	scriptLines := []string{
+		fmt.Sprintf("minio server %s --console-address :%d &", objectStorageDataDirpath, objectStorageConsolePort),
+		fmt.Sprintf(`until mc alias set %s http://127.0.0.1:%d "$MINIO_ROOT_USER" "$MINIO_ROOT_PASSWORD" > /dev/null 2>&1; do sleep 1; done`, ObjectStorageClientAlias, ObjectStorageApiPortNumber),
+	}
+	for _, bucketName := range sortedBucketNames {
+		scriptLines = append(scriptLines, fmt.Sprintf("mc mb --ignore-existing %s/%s || exit 1", ObjectStorageClientAlias, bucketName))
+		if _, found := bucketFiles[bucketName]; found {
+			scriptLines = append(scriptLines, fmt.Sprintf("mc cp --recursive %s/%s/ %s/%s/ || exit 1", objectStorageSeedDirpath, bucketName, ObjectStorageClientAlias, bucketName))
+		}
+	}
+	scriptLines = append(scriptLines, "touch "+objectStorageReadyFilepath, "wait")
+	return strings.Join(scriptLines, "\n")
+}
+
+func waitForObjectStorageSetup(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName service.ServiceName) error {
+	setupCheckCommand := []string{"test", "-f", objectStorageReadyFilepath}
+	deadline := time.Now().Add(objectStorageSetupTimeout)
+	for {
+		exitCode, _, err := serviceNetwork.ExecCommand(ctx, string(serviceName), setupCheckCommand)
+		if err == nil && exitCode == objectStorageSetupCheckExitCode {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return stacktrace.NewError("The buckets still weren't set up after %v; the logs of the service should tell why", objectStorageSetupTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "The wait for the buckets to be set up got cancelled")
+		case <-time.After(objectStorageSetupCheckInterval):
+		}
+	}
+}
+
+func makeAddObjectStorageInterpretationReturnValue(serviceObject *kurtosis_types.Service, resultUuid string) starlark.Value {
+	hostname := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, hostnameRuntimeValue)
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		objectStorageServiceAttr:   serviceObject,
+		objectStorageEndpointAttr:  starlark.String(fmt.Sprintf("http://%s:%d", hostname, ObjectStorageApiPortNumber)),
+		objectStorageAccessKeyAttr: starlark.String(ObjectStorageAccessKey),
+		objectStorageSecretKeyAttr: starlark.String(ObjectStorageSecretKey),
+	})
+}
+
+func getBucketNames(value starlark.Value) ([]string, *startosis_errors.InterpretationError) {
+	bucketNames, interpretationErr := kurtosis_types.SafeCastToStringSlice(value, BucketsArgName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for _, bucketName := range bucketNames {
+		if err := validateBucketName(bucketName, BucketsArgName); err != nil {
+			return nil, err
+		}
+	}
+	return bucketNames, nil
+}
+
+func getBucketFiles(value starlark.Value) (map[string]string, *startosis_errors.InterpretationError) {
+	bucketFiles, interpretationErr := kurtosis_types.SafeCastToMapStringString(value, BucketFilesArgName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for bucketName := range bucketFiles {
+		if err := validateBucketName(bucketName, BucketFilesArgName); err != nil {
+			return nil, err
+		}
+	}
+	return bucketFiles, nil
+}
+
+func validateBucketName(bucketName string, argNameForLogging string) *startosis_errors.InterpretationError {
+	if !bucketNameRegexp.MatchString(bucketName) {
+		return startosis_errors.NewInterpretationError("Bucket name '%s' in '%s' is invalid; it must be 3 to 63 lowercase alphanumeric characters, '.' or '-', starting and ending with an alphanumeric character", bucketName, argNameForLogging)
+	}
+	return nil
+}
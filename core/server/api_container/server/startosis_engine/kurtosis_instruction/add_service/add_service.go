@@ -63,8 +63,9 @@ func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueSt
 				serviceName:   "",  // populated at interpretation time
 				serviceConfig: nil, // populated at interpretation time
 
-				resultUuid:     "",  // populated at interpretation time
-				readyCondition: nil, // populated at interpretation time
+				resultUuid:           "",  // populated at interpretation time
+				readyConditions:      nil, // populated at interpretation time
+				dependencyRecoveries: nil, // populated at interpretation time
 			}
 		},
 
@@ -79,9 +80,11 @@ type AddServiceCapabilities struct {
 	serviceNetwork    service_network.ServiceNetwork
 	runtimeValueStore *runtime_value_store.RuntimeValueStore
 
-	serviceName    service.ServiceName
-	serviceConfig  *kurtosis_core_rpc_api_bindings.ServiceConfig
-	readyCondition *service_config.ReadyCondition
+	serviceName     service.ServiceName
+	serviceConfig   *kurtosis_core_rpc_api_bindings.ServiceConfig
+	readyConditions *service_config.ServiceReadyConditions
+
+	dependencyRecoveries map[service.ServiceName]*service_config.DependencyRecovery
 
 	resultUuid string
 }
@@ -96,14 +99,18 @@ func (builtin *AddServiceCapabilities) Interpret(arguments *builtin_argument.Arg
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceConfigArgName)
 	}
-	apiServiceConfig, readyCondition, interpretationErr := validateAndConvertConfigAndReadyCondition(serviceConfig)
+	apiServiceConfig, readyConditions, interpretationErr := validateAndConvertConfigAndReadyCondition(serviceConfig)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
-	builtin.readyCondition = readyCondition
+	builtin.readyConditions = readyConditions
+	builtin.dependencyRecoveries, interpretationErr = serviceConfig.GetDependencyRecoveries()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
 	builtin.resultUuid, err = builtin.runtimeValueStore.CreateValue()
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddServiceBuiltinName)
@@ -121,6 +128,9 @@ func (builtin *AddServiceCapabilities) Validate(_ *builtin_argument.ArgumentValu
 	if validationErr := validateSingleService(validatorEnvironment, builtin.serviceName, builtin.serviceConfig); validationErr != nil {
 		return validationErr
 	}
+	if validationErr := validateDependencyRecoveries(validatorEnvironment, builtin.serviceName, builtin.dependencyRecoveries); validationErr != nil {
+		return validationErr
+	}
 	return nil
 }
 
@@ -139,10 +149,11 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 		builtin.serviceNetwork,
 		builtin.runtimeValueStore,
 		replacedServiceName,
-		builtin.readyCondition,
+		builtin.readyConditions,
 	); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", replacedServiceName)
 	}
+	registerDependencyRecovery(builtin.serviceNetwork, builtin.runtimeValueStore, replacedServiceName, builtin.readyConditions, builtin.dependencyRecoveries)
 
 	fillAddServiceReturnValueWithRuntimeValues(startedService, builtin.resultUuid, builtin.runtimeValueStore)
 	instructionResult := fmt.Sprintf("Service '%s' added with service UUID '%s'", replacedServiceName, startedService.GetRegistration().GetUUID())
@@ -151,7 +162,7 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 
 func validateAndConvertConfigAndReadyCondition(
 	rawConfig starlark.Value,
-) (*kurtosis_core_rpc_api_bindings.ServiceConfig, *service_config.ReadyCondition, *startosis_errors.InterpretationError) {
+) (*kurtosis_core_rpc_api_bindings.ServiceConfig, *service_config.ServiceReadyConditions, *startosis_errors.InterpretationError) {
 	config, ok := rawConfig.(*service_config.ServiceConfig)
 	if !ok {
 		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument is not a ServiceConfig (was '%s').", ConfigsArgName, reflect.TypeOf(rawConfig))
@@ -161,10 +172,10 @@ func validateAndConvertConfigAndReadyCondition(
 		return nil, nil, interpretationErr
 	}
 
-	readyCondition, interpretationErr := config.GetReadyCondition()
+	readyConditions, interpretationErr := config.GetReadyConditions()
 	if interpretationErr != nil {
 		return nil, nil, interpretationErr
 	}
 
-	return apiServiceConfig, readyCondition, nil
+	return apiServiceConfig, readyConditions, nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/dependency_recovery"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
@@ -13,12 +14,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
+	"strings"
 	"time"
 )
 
@@ -64,8 +67,11 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 			return startosis_errors.NewValidationError("Service was about to be started inside subnetwork '%s' but the Kurtosis enclave was started with subnetwork capabilities disabled. Make sure to run the Starlark code with subnetwork enabled.", *serviceConfig.Subnetwork)
 		}
 	}
-	if isValidServiceName := service.IsServiceNameValid(serviceName); !isValidServiceName {
-		return startosis_errors.NewValidationError("Service name '%v' is invalid as it contains disallowed characters. Service names can only contain characters 'a-z', 'A-Z', '0-9', '-' & '_'", serviceName)
+	if err := service.ValidateServiceName(serviceName); err != nil {
+		return startosis_errors.WrapWithValidationError(err, "Service name '%v' is invalid", serviceName)
+	}
+	if len(serviceConfig.GetPublicPorts()) > 0 && !validatorEnvironment.GetBackendCapabilities().AreStaticPublicPortsSupported() {
+		return startosis_errors.NewValidationError("Service '%s' sets public ports, but the backend of this enclave doesn't support choosing the ports services get published on yet", serviceName)
 	}
 
 	if validatorEnvironment.DoesServiceNameExist(serviceName) {
@@ -77,10 +83,31 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 		}
 	}
 	validatorEnvironment.AddServiceName(serviceName)
+	validatorEnvironment.AddServiceResources(
+		serviceName,
+		serviceConfig.GetCpuAllocationMillicpus(),
+		serviceConfig.GetMemoryAllocationMegabytes(),
+		uint32(len(serviceConfig.GetPrivatePorts())),
+		uint32(len(serviceConfig.GetPublicPorts())),
+	)
 	validatorEnvironment.AppendRequiredContainerImage(serviceConfig.ContainerImageName)
 	return nil
 }
 
+// validateDependencyRecoveries checks the dependencies of the service exist, so it must run once the services added
+// along with it are in the validator environment
+func validateDependencyRecoveries(validatorEnvironment *startosis_validator.ValidatorEnvironment, serviceName service.ServiceName, dependencyRecoveries map[service.ServiceName]*service_config.DependencyRecovery) *startosis_errors.ValidationError {
+	for dependency := range dependencyRecoveries {
+		if dependency == serviceName {
+			return startosis_errors.NewValidationError("Service '%s' can't recover from its own restart as it's set as its own dependency in '%s'", serviceName, service_config.OnDependencyRecoveryAttr)
+		}
+		if !validatorEnvironment.DoesServiceNameExist(dependency) {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as dependency '%s' of service '%s' doesn't exist", AddServiceBuiltinName, dependency, serviceName)
+		}
+	}
+	return nil
+}
+
 func replaceMagicStrings(
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	serviceName service.ServiceName,
@@ -141,51 +168,230 @@ func replaceMagicStrings(
 	return service.ServiceName(serviceNameStr), serviceConfigBuilder.Build(), nil
 }
 
+// registerDependencyRecovery lets the service be restarted by restart_service and recovered when its dependencies get
+// restarted, replacing what was registered for a previous service with the same name
+func registerDependencyRecovery(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	readyConditions *service_config.ServiceReadyConditions,
+	dependencyRecoveries map[service.ServiceName]*service_config.DependencyRecovery,
+) {
+	var readinessCheck dependency_recovery.ReadinessCheckFunc
+	if readyConditions != nil {
+		readinessCheck = func(ctx context.Context) error {
+			return runServiceReadinessCheck(ctx, serviceNetwork, runtimeValueStore, serviceName, readyConditions)
+		}
+	}
+	recoveryActions := map[service.ServiceName]*dependency_recovery.RecoveryAction{}
+	for dependency, recovery := range dependencyRecoveries {
+		if recovery.IsRestart() {
+			recoveryActions[dependency] = dependency_recovery.NewRestartRecoveryAction(serviceName)
+			continue
+		}
+		execRecipe := recovery.GetExecRecipe()
+		recoveryActions[dependency] = dependency_recovery.NewHookRecoveryAction(serviceName, func(ctx context.Context) error {
+			return runRecoveryExecRecipe(ctx, serviceNetwork, runtimeValueStore, serviceName, execRecipe)
+		})
+	}
+	dependency_recovery.GetDependencyRecoveryRegistry().RegisterService(serviceName, readinessCheck, recoveryActions)
+}
+
+func runRecoveryExecRecipe(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	execRecipe *recipe.ExecRecipe,
+) error {
+	result, err := execRecipe.Execute(ctx, serviceNetwork, runtimeValueStore, serviceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running recovery exec recipe '%v' on service '%v'", execRecipe.String(), serviceName)
+	}
+	exitCode, err := execRecipe.GetExitCode(result)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the exit code of recovery exec recipe '%v' on service '%v'", execRecipe.String(), serviceName)
+	}
+	if exitCode != 0 {
+		return stacktrace.NewError("Recovery exec recipe '%v' on service '%v' failed. %s", execRecipe.String(), serviceName, execRecipe.ResultMapToString(result))
+	}
+	return nil
+}
+
 func runServiceReadinessCheck(
 	ctx context.Context,
 	serviceNetwork service_network.ServiceNetwork,
 	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	serviceName service.ServiceName,
-	readyConditions *service_config.ReadyCondition,
+	readyConditions *service_config.ServiceReadyConditions,
 ) error {
-	if readyConditions != nil {
+	if readyConditions == nil {
+		return nil
+	}
+	if err := checkServiceReadyConditions(ctx, serviceNetwork, runtimeValueStore, serviceName, readyConditions); err != nil {
+		return err
+	}
+	serviceNetwork.MarkServiceAsReady(serviceName)
+	return nil
+}
 
-		recipe, intepretationErr := readyConditions.GetRecipe()
-		if intepretationErr != nil {
-			return stacktrace.Propagate(intepretationErr, "An error occurred getting the recipe value from ready conditions '%v'", readyConditions)
-		}
+func checkServiceReadyConditions(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	readyConditions *service_config.ServiceReadyConditions,
+) error {
+	conditions := readyConditions.GetConditions()
+	if len(conditions) == 1 {
+		return runReadyConditionCheck(ctx, serviceNetwork, runtimeValueStore, serviceName, conditions[0])
+	}
 
-		field, intepretationErr := readyConditions.GetField()
-		if intepretationErr != nil {
-			return stacktrace.Propagate(intepretationErr, "An error occurred getting the field value from ready conditions '%v'", readyConditions)
-		}
+	// All conditions get checked in parallel so that their time-outs run concurrently, and the checks that are left
+	// get cancelled as soon as the outcome is known
+	checksCtx, cancelChecks := context.WithCancel(ctx)
+	defer cancelChecks()
+	checkErrs := make(chan error, len(conditions))
+	for _, condition := range conditions {
+		go func(condition service_config.ServiceReadyCondition) {
+			checkErrs <- runReadyConditionCheck(checksCtx, serviceNetwork, runtimeValueStore, serviceName, condition)
+		}(condition)
+	}
 
-		assertion, intepretationErr := readyConditions.GetAssertion()
-		if intepretationErr != nil {
-			return stacktrace.Propagate(intepretationErr, "An error occurred getting the assertion value from ready conditions '%v'", readyConditions)
+	failedChecksErrs := []error{}
+	for range conditions {
+		checkErr := <-checkErrs
+		switch {
+		case checkErr == nil && readyConditions.GetMode() == service_config.AnyReadyConditionsMode:
+			logrus.Infof("Service '%v' passed one of its %d ready conditions, which is enough to consider it ready", serviceName, len(conditions)) //TODO change to debug
+			return nil
+		case checkErr != nil && readyConditions.GetMode() == service_config.AllReadyConditionsMode:
+			return stacktrace.Propagate(checkErr, "Service '%v' failed one of its %d ready conditions, all of which must pass", serviceName, len(conditions))
+		case checkErr != nil:
+			failedChecksErrs = append(failedChecksErrs, checkErr)
 		}
-
-		target, intepretationErr := readyConditions.GetTarget()
-		if intepretationErr != nil {
-			return stacktrace.Propagate(intepretationErr, "An error occurred getting the target value from ready conditions '%v'", readyConditions)
+	}
+	if len(failedChecksErrs) > 0 {
+		failedChecksErrStrs := []string{}
+		for _, failedCheckErr := range failedChecksErrs {
+			failedChecksErrStrs = append(failedChecksErrStrs, failedCheckErr.Error())
 		}
+		return stacktrace.NewError("Service '%v' failed all of its %d ready conditions, at least one of which must pass:\n%s", serviceName, len(conditions), strings.Join(failedChecksErrStrs, "\n"))
+	}
+	return nil
+}
 
-		interval, intepretationErr := readyConditions.GetInterval()
-		if intepretationErr != nil {
-			return stacktrace.Propagate(intepretationErr, "An error occurred getting the interval value from ready conditions '%v'", readyConditions)
-		}
+func runReadyConditionCheck(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	readyCondition service_config.ServiceReadyCondition,
+) error {
+	switch castedReadyCondition := readyCondition.(type) {
+	case *service_config.ReadyCondition:
+		return runRecipeReadyConditionCheck(ctx, serviceNetwork, runtimeValueStore, serviceName, castedReadyCondition)
+	case *service_config.PortAvailabilityReadyCondition:
+		return runPortAvailabilityReadyConditionCheck(ctx, serviceNetwork, serviceName, castedReadyCondition)
+	}
+	return stacktrace.NewError("Unexpected type '%T' of ready condition '%v' for service '%v'; this is a bug in Kurtosis", readyCondition, readyCondition, serviceName)
+}
 
-		timeout, intepretationErr := readyConditions.GetTimeout()
-		if intepretationErr != nil {
-			return stacktrace.Propagate(intepretationErr, "An error occurred getting the timeout value from ready conditions '%v'", readyConditions)
-		}
+func runPortAvailabilityReadyConditionCheck(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	serviceName service.ServiceName,
+	readyCondition *service_config.PortAvailabilityReadyCondition,
+) error {
+	portId, intepretationErr := readyCondition.GetPortId()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the port ID value from ready conditions '%v'", readyCondition)
+	}
+
+	interval, intepretationErr := readyCondition.GetInterval()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the interval value from ready conditions '%v'", readyCondition)
+	}
+
+	timeout, intepretationErr := readyCondition.GetTimeout()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the timeout value from ready conditions '%v'", readyCondition)
+	}
 
-		startTime := time.Now()
-		logrus.Infof("Checking service readiness for '%s' at '%v'", serviceName, startTime) //TODO change to debug
-		lastResult, tries, err := shared_helpers.ExecuteServiceAssertionWithRecipe(
-			ctx,
-			serviceNetwork,
-			runtimeValueStore,
+	startTime := time.Now()
+	logrus.Infof("Checking service readiness for '%s' at '%v'", serviceName, startTime) //TODO change to debug
+	tries, err := shared_helpers.WaitForServicePortAvailability(ctx, serviceNetwork, serviceName, portId, interval, timeout)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred checking if service '%v' is ready, waiting for port '%v' to become available with "+
+				"interval '%s' and time-out '%s'.",
+			serviceName,
+			portId,
+			interval,
+			timeout,
+		)
+	}
+	logrus.Infof("Checking if service '%v' is ready took %d tries (%v in total).", serviceName, tries, time.Since(startTime)) //TODO change to debug
+	return nil
+}
+
+func runRecipeReadyConditionCheck(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	readyCondition *service_config.ReadyCondition,
+) error {
+	recipe, intepretationErr := readyCondition.GetRecipe()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the recipe value from ready conditions '%v'", readyCondition)
+	}
+
+	field, intepretationErr := readyCondition.GetField()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the field value from ready conditions '%v'", readyCondition)
+	}
+
+	assertion, intepretationErr := readyCondition.GetAssertion()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the assertion value from ready conditions '%v'", readyCondition)
+	}
+
+	target, intepretationErr := readyCondition.GetTarget()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the target value from ready conditions '%v'", readyCondition)
+	}
+
+	interval, intepretationErr := readyCondition.GetInterval()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the interval value from ready conditions '%v'", readyCondition)
+	}
+
+	timeout, intepretationErr := readyCondition.GetTimeout()
+	if intepretationErr != nil {
+		return stacktrace.Propagate(intepretationErr, "An error occurred getting the timeout value from ready conditions '%v'", readyCondition)
+	}
+
+	startTime := time.Now()
+	logrus.Infof("Checking service readiness for '%s' at '%v'", serviceName, startTime) //TODO change to debug
+	lastResult, tries, err := shared_helpers.ExecuteServiceAssertionWithRecipe(
+		ctx,
+		serviceNetwork,
+		runtimeValueStore,
+		serviceName,
+		recipe,
+		field,
+		assertion,
+		target,
+		interval,
+		timeout,
+	)
+	if err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred checking if service '%v' is ready, using "+
+				"recipe '%+v', value field '%v', assertion '%v', target '%v', interval '%s' and time-out '%s'.",
 			serviceName,
 			recipe,
 			field,
@@ -194,28 +400,14 @@ func runServiceReadinessCheck(
 			interval,
 			timeout,
 		)
-		if err != nil {
-			return stacktrace.Propagate(
-				err,
-				"An error occurred checking if service '%v' is ready, using "+
-					"recipe '%+v', value field '%v', assertion '%v', target '%v', interval '%s' and time-out '%s'.",
-				serviceName,
-				recipe,
-				field,
-				assertion,
-				target,
-				interval,
-				timeout,
-			)
-		}
-		//TODO change to debug
-		logrus.Infof("Checking if service '%v' is ready took %d tries (%v in total). "+
-			"Assertion passed with following:\n%s",
-			serviceName,
-			tries,
-			time.Since(startTime),
-			recipe.ResultMapToString(lastResult),
-		)
 	}
+	//TODO change to debug
+	logrus.Infof("Checking if service '%v' is ready took %d tries (%v in total). "+
+		"Assertion passed with following:\n%s",
+		serviceName,
+		tries,
+		time.Since(startTime),
+		recipe.ResultMapToString(lastResult),
+	)
 	return nil
 }
@@ -2,10 +2,14 @@ package add_service
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/stretchr/testify/require"
 	"go.starlark.net/starlark"
 	"testing"
@@ -106,3 +110,20 @@ func TestAddServiceShared_ServiceNameWithRuntimeValuesAreReplaced(t *testing.T)
 	require.Nil(t, err)
 	require.Equal(t, service.ServiceName("database-1"), replacedServiceName)
 }
+
+func TestAddServiceShared_PublicPortsFailValidationWhenTheBackendDoesNotSupportThem(t *testing.T) {
+	serviceName := service.ServiceName("example-datastore-server-2")
+	serviceConfig := services.NewServiceConfigBuilder(
+		testContainerImageName,
+	).WithPublicPorts(map[string]*kurtosis_core_rpc_api_bindings.Port{
+		"grpc": binding_constructors.NewPort(1323, kurtosis_core_rpc_api_bindings.Port_TCP, ""),
+	}).Build()
+
+	backendWithoutStaticPublicPorts := backend_capabilities.NewBackendCapabilities(false, false, false, false, false)
+	validatorEnvironment := startosis_validator.NewValidatorEnvironment(false, backendWithoutStaticPublicPorts, map[service.ServiceName]bool{}, map[string]bool{})
+	require.NotNil(t, validateSingleService(validatorEnvironment, serviceName, serviceConfig))
+
+	backendWithStaticPublicPorts := backend_capabilities.NewBackendCapabilities(false, false, true, false, false)
+	validatorEnvironment = startosis_validator.NewValidatorEnvironment(false, backendWithStaticPublicPorts, map[service.ServiceName]bool{}, map[string]bool{})
+	require.Nil(t, validateSingleService(validatorEnvironment, serviceName, serviceConfig))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -57,8 +58,9 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 
 				serviceConfigs: nil, // populated at interpretation time
 
-				resultUuids:     map[service.ServiceName]string{}, // populated at interpretation time
-				readyConditions: nil,                              // populated at interpretation time
+				resultUuids:          map[service.ServiceName]string{}, // populated at interpretation time
+				readyConditions:      nil,                              // populated at interpretation time
+				dependencyRecoveries: nil,                              // populated at interpretation time
 			}
 		},
 
@@ -76,7 +78,9 @@ type AddServicesCapabilities struct {
 
 	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
 
-	readyConditions map[service.ServiceName]*service_config.ReadyCondition
+	readyConditions map[service.ServiceName]*service_config.ServiceReadyConditions
+
+	dependencyRecoveries map[service.ServiceName]map[service.ServiceName]*service_config.DependencyRecovery
 
 	resultUuids map[service.ServiceName]string
 }
@@ -92,6 +96,10 @@ func (builtin *AddServicesCapabilities) Interpret(arguments *builtin_argument.Ar
 	}
 	builtin.serviceConfigs = serviceConfigs
 	builtin.readyConditions = readyConditions
+	builtin.dependencyRecoveries, interpretationErr = getDependencyRecoveriesByServiceName(ServiceConfigsDict)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
 
 	resultUuids, returnValue, interpretationErr := makeAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.runtimeValueStore)
 	if interpretationErr != nil {
@@ -107,6 +115,12 @@ func (builtin *AddServicesCapabilities) Validate(_ *builtin_argument.ArgumentVal
 			return err
 		}
 	}
+	// dependencies are validated once all the services of the batch are in the environment, as they can depend on each other
+	for serviceName, dependencyRecoveries := range builtin.dependencyRecoveries {
+		if err := validateDependencyRecoveries(validatorEnvironment, serviceName, dependencyRecoveries); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -142,13 +156,8 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 	//TODO we should move the readiness check functionality to the default service network to improve performance
 	///TODO because we won't have to wait for all services to start for checking readiness, but first we have to
 	//TODO propagate the Recipes to this layer too and probably move the wait instruction also
-	if failedServicesChecks := builtin.allServicesReadinessCheck(ctx, startedServices, parallelism); len(failedServicesChecks) > 0 {
-		var allServiceChecksErrMsg string
-		for serviceName, serviceErr := range failedServicesChecks {
-			serviceMsg := fmt.Sprintf("Service '%v' error:\n%v\n", serviceName, serviceErr)
-			allServiceChecksErrMsg = allServiceChecksErrMsg + serviceMsg
-		}
-		return "", stacktrace.NewError("An error occurred while checking al service, these are the errors by service:\n%s", allServiceChecksErrMsg)
+	if err := builtin.allServicesReadinessCheck(ctx, startedServices, parallelism); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking the readiness of the services added by '%s'", AddServicesBuiltinName)
 	}
 	defer func() {
 		if shouldDeleteAllStartedServices {
@@ -163,6 +172,9 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' added with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID()))
 
 	}
+	for serviceName := range startedServices {
+		registerDependencyRecovery(builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, builtin.readyConditions[serviceName], builtin.dependencyRecoveries[serviceName])
+	}
 	shouldDeleteAllStartedServices = false
 	return instructionResult.String(), nil
 }
@@ -180,62 +192,31 @@ func (builtin *AddServicesCapabilities) removeAllStartedServices(
 	}
 }
 
+// allServicesReadinessCheck checks the readiness of all the started services, running up to batchSize checks at a
+// time. It waits for every check to finish and returns an error listing every service that isn't ready, if any
 func (builtin *AddServicesCapabilities) allServicesReadinessCheck(
 	ctx context.Context,
 	startedServices map[service.ServiceName]*service.Service,
 	batchSize int,
-) map[service.ServiceName]error {
+) error {
 	logrus.Debugf("Checking for all services readiness...")
 
-	concurrencyControlChan := make(chan bool, batchSize)
-	defer close(concurrencyControlChan)
-
-	failedServiceChecksSyncMap := &sync.Map{}
-
-	wg := &sync.WaitGroup{}
+	serviceNames := []service.ServiceName{}
 	for serviceName := range startedServices {
-		wg.Add(1)
-		// The concurrencyControlChan will block if the buffer is currently full
-		concurrencyControlChan <- true
-		go builtin.runServiceReadinessCheck(ctx, wg, concurrencyControlChan, serviceName, failedServiceChecksSyncMap)
+		serviceNames = append(serviceNames, serviceName)
+	}
+	if err := runAllServicesReadinessChecks(ctx, serviceNames, batchSize, builtin.runServiceReadinessCheck); err != nil {
+		return err
 	}
-	wg.Wait()
-
-	failedServiceChecksRegularMap := map[service.ServiceName]error{}
-
-	failedServiceChecksSyncMap.Range(func(serviceNameAny any, serviceErrorAny any) bool {
-		if serviceErrorAny != nil {
-			serviceName := serviceNameAny.(service.ServiceName)
-			serviceError := serviceErrorAny.(error)
-			failedServiceChecksRegularMap[serviceName] = serviceError
-		}
-		return true
-	})
 
 	logrus.Debug("All services are ready")
-
-	return failedServiceChecksRegularMap
+	return nil
 }
 
-func (builtin *AddServicesCapabilities) runServiceReadinessCheck(
-	ctx context.Context,
-	wg *sync.WaitGroup,
-	concurrencyControlChan chan bool,
-	serviceName service.ServiceName,
-	failedServiceChecks *sync.Map,
-) {
-	var serviceErr error
-	defer func() {
-		failedServiceChecks.Store(serviceName, serviceErr)
-		wg.Done()
-		//pop a value from the concurrencyControlChan to allow any potentially waiting subroutine to start
-		<-concurrencyControlChan
-	}()
-
+func (builtin *AddServicesCapabilities) runServiceReadinessCheck(ctx context.Context, serviceName service.ServiceName) error {
 	readyConditions, found := builtin.readyConditions[serviceName]
 	if !found {
-		serviceErr = stacktrace.NewError("Expected to find ready conditions for service '%s' in map '%+v', but none was found; this is a bug in Kurtosis", serviceName, builtin.readyConditions)
-		return
+		return stacktrace.NewError("Expected to find ready conditions for service '%s' in map '%+v', but none was found; this is a bug in Kurtosis", serviceName, builtin.readyConditions)
 	}
 
 	if err := runServiceReadinessCheck(
@@ -245,16 +226,92 @@ func (builtin *AddServicesCapabilities) runServiceReadinessCheck(
 		serviceName,
 		readyConditions,
 	); err != nil {
-		serviceErr = stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", serviceName)
-		return
+		return stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", serviceName)
 	}
+	return nil
+}
+
+// runAllServicesReadinessChecks runs the readiness check of every service, up to parallelism at a time, and waits for
+// all of them so that every unready service gets reported at once. If the context gets cancelled, the checks still
+// running get cancelled along with it, and the ones not started yet get reported as failed
+func runAllServicesReadinessChecks(
+	ctx context.Context,
+	serviceNames []service.ServiceName,
+	parallelism int,
+	readinessCheck func(ctx context.Context, serviceName service.ServiceName) error,
+) error {
+	checksCtx, cancelChecks := context.WithCancel(ctx)
+	defer cancelChecks()
+
+	concurrencyControlChan := make(chan bool, parallelism)
+	defer close(concurrencyControlChan)
+
+	failedChecksErrs := map[service.ServiceName]error{}
+	failedChecksErrsMutex := &sync.Mutex{}
+	recordFailedCheck := func(serviceName service.ServiceName, err error) {
+		failedChecksErrsMutex.Lock()
+		defer failedChecksErrsMutex.Unlock()
+		failedChecksErrs[serviceName] = err
+	}
+
+	wg := &sync.WaitGroup{}
+	for _, serviceName := range serviceNames {
+		// Sending to the concurrencyControlChan blocks while the buffer is full, unless the checks get cancelled
+		select {
+		case concurrencyControlChan <- true:
+		case <-checksCtx.Done():
+			recordFailedCheck(serviceName, stacktrace.Propagate(checksCtx.Err(), "The readiness check of service '%v' was cancelled before it started", serviceName))
+			continue
+		}
+		wg.Add(1)
+		go func(serviceName service.ServiceName) {
+			defer func() {
+				wg.Done()
+				//pop a value from the concurrencyControlChan to allow any potentially waiting subroutine to start
+				<-concurrencyControlChan
+			}()
+			if err := readinessCheck(checksCtx, serviceName); err != nil {
+				recordFailedCheck(serviceName, err)
+			}
+		}(serviceName)
+	}
+	wg.Wait()
+
+	if len(failedChecksErrs) > 0 {
+		return &servicesReadinessError{
+			numCheckedServices: len(serviceNames),
+			errsByServiceName:  failedChecksErrs,
+		}
+	}
+	return nil
+}
+
+// servicesReadinessError aggregates the errors of all the services that failed their readiness check
+type servicesReadinessError struct {
+	numCheckedServices int
+	errsByServiceName  map[service.ServiceName]error
+}
+
+func (readinessErr *servicesReadinessError) Error() string {
+	serviceNames := []string{}
+	for serviceName := range readinessErr.errsByServiceName {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+
+	errMsg := strings.Builder{}
+	errMsg.WriteString(fmt.Sprintf("%d out of %d services aren't ready: '%s'", len(serviceNames), readinessErr.numCheckedServices, strings.Join(serviceNames, "', '")))
+	for _, serviceName := range serviceNames {
+		errMsg.WriteString(fmt.Sprintf("\nService '%s' error:\n%v", serviceName, readinessErr.errsByServiceName[service.ServiceName(serviceName)]))
+	}
+	return errMsg.String()
 }
 
 func validateAndConvertConfigsAndReadyConditions(
 	configs starlark.Value,
 ) (
 	map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig,
-	map[service.ServiceName]*service_config.ReadyCondition,
+	map[service.ServiceName]*service_config.ServiceReadyConditions,
 	*startosis_errors.InterpretationError,
 ) {
 	configsDict, ok := configs.(*starlark.Dict)
@@ -265,7 +322,7 @@ func validateAndConvertConfigsAndReadyConditions(
 		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
 	}
 	convertedServiceConfigs := map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
-	readyConditionsByServiceName := map[service.ServiceName]*service_config.ReadyCondition{}
+	readyConditionsByServiceName := map[service.ServiceName]*service_config.ServiceReadyConditions{}
 	for _, serviceName := range configsDict.Keys() {
 		serviceNameStr, isServiceNameAString := serviceName.(starlark.String)
 		if !isServiceNameAString {
@@ -286,7 +343,7 @@ func validateAndConvertConfigsAndReadyConditions(
 		}
 		convertedServiceConfigs[service.ServiceName(serviceNameStr.GoString())] = apiServiceConfig
 
-		readyConditions, interpretationErr := serviceConfig.GetReadyCondition()
+		readyConditions, interpretationErr := serviceConfig.GetReadyConditions()
 		if interpretationErr != nil {
 			return nil, nil, interpretationErr
 		}
@@ -296,6 +353,28 @@ func validateAndConvertConfigsAndReadyConditions(
 	return convertedServiceConfigs, readyConditionsByServiceName, nil
 }
 
+// getDependencyRecoveriesByServiceName expects the configs dict to have been validated already by
+// validateAndConvertConfigsAndReadyConditions
+func getDependencyRecoveriesByServiceName(configsDict *starlark.Dict) (map[service.ServiceName]map[service.ServiceName]*service_config.DependencyRecovery, *startosis_errors.InterpretationError) {
+	dependencyRecoveriesByServiceName := map[service.ServiceName]map[service.ServiceName]*service_config.DependencyRecovery{}
+	for _, item := range configsDict.Items() {
+		serviceName, ok := item[0].(starlark.String)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s')", ConfigsArgName, reflect.TypeOf(item[0]))
+		}
+		serviceConfig, ok := item[1].(*service_config.ServiceConfig)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s')", ConfigsArgName, reflect.TypeOf(item[1]))
+		}
+		dependencyRecoveries, interpretationErr := serviceConfig.GetDependencyRecoveries()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		dependencyRecoveriesByServiceName[service.ServiceName(serviceName.GoString())] = dependencyRecoveries
+	}
+	return dependencyRecoveriesByServiceName, nil
+}
+
 func makeAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig, runtimeValueStore *runtime_value_store.RuntimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
 	servicesObjectDict := starlark.NewDict(len(serviceConfigs))
 	resultUuids := map[service.ServiceName]string{}
@@ -0,0 +1,66 @@
+package add_service
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testParallelism = 2
+
+	testCancellationDelay = 100 * time.Millisecond
+)
+
+var testServiceNames = []service.ServiceName{"service-c", "service-a", "service-b"}
+
+func TestRunAllServicesReadinessChecks_ReportsEveryUnreadyService(t *testing.T) {
+	numChecks := int32(0)
+	readinessCheck := func(ctx context.Context, serviceName service.ServiceName) error {
+		atomic.AddInt32(&numChecks, 1)
+		if serviceName == "service-b" {
+			return nil
+		}
+		return stacktrace.NewError("Service '%v' isn't ready", serviceName)
+	}
+
+	err := runAllServicesReadinessChecks(context.Background(), testServiceNames, testParallelism, readinessCheck)
+	require.Error(t, err)
+	require.Equal(t, int32(len(testServiceNames)), atomic.LoadInt32(&numChecks))
+
+	readinessErr, ok := err.(*servicesReadinessError)
+	require.True(t, ok)
+	require.Len(t, readinessErr.errsByServiceName, 2)
+	require.Contains(t, err.Error(), "2 out of 3 services aren't ready: 'service-a', 'service-c'")
+	require.Contains(t, err.Error(), "Service 'service-a' isn't ready")
+	require.Contains(t, err.Error(), "Service 'service-c' isn't ready")
+	require.NotContains(t, err.Error(), "Service 'service-b' error")
+}
+
+func TestRunAllServicesReadinessChecks_SucceedsWhenAllServicesAreReady(t *testing.T) {
+	readinessCheck := func(ctx context.Context, serviceName service.ServiceName) error {
+		return nil
+	}
+	require.NoError(t, runAllServicesReadinessChecks(context.Background(), testServiceNames, testParallelism, readinessCheck))
+}
+
+func TestRunAllServicesReadinessChecks_CancelsOutstandingChecks(t *testing.T) {
+	readinessCheck := func(ctx context.Context, serviceName service.ServiceName) error {
+		<-ctx.Done()
+		return stacktrace.Propagate(ctx.Err(), "The readiness check of service '%v' got cancelled", serviceName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testCancellationDelay)
+	defer cancel()
+	// With a parallelism of 1 the checks would take forever if they didn't get cancelled
+	err := runAllServicesReadinessChecks(ctx, testServiceNames, 1, readinessCheck)
+	require.Error(t, err)
+
+	readinessErr, ok := err.(*servicesReadinessError)
+	require.True(t, ok)
+	require.Len(t, readinessErr.errsByServiceName, len(testServiceNames))
+}
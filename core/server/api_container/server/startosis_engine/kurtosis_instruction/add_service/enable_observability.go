@@ -0,0 +1,337 @@
+package add_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	EnableObservabilityBuiltinName = "enable_observability"
+
+	JaegerArgName = "jaeger"
+
+	PrometheusServiceName = service.ServiceName("prometheus")
+	GrafanaServiceName    = service.ServiceName("grafana")
+	JaegerServiceName     = service.ServiceName("jaeger")
+
+	DefaultPrometheusImage = "prom/prometheus:v2.48.1"
+	DefaultGrafanaImage    = "grafana/grafana:10.2.3"
+	DefaultJaegerImage     = "jaegertracing/all-in-one:1.52"
+
+	PrometheusPortId     = "http"
+	prometheusPortNumber = 9090
+	GrafanaPortId        = "dashboard"
+	grafanaPortNumber    = 3000
+	JaegerUiPortId       = "ui"
+	jaegerUiPortNumber   = 16686
+	jaegerOtlpGrpcPortId = "otlp-grpc"
+	JaegerOtlpGrpcPort   = 4317
+	jaegerOtlpHttpPortId = "otlp-http"
+	jaegerOtlpHttpPort   = 4318
+	observabilityHttp    = "http"
+
+	prometheusConfigFilepath      = "/tmp/kurtosis-prometheus.yml"
+	prometheusScrapeInterval      = "15s"
+	prometheusScrapeJobName       = "kurtosis-services"
+	prometheusServiceLabel        = "service"
+	grafanaProvisioningDirpath    = "/tmp/kurtosis-grafana-provisioning"
+	grafanaDatasourcesRelFilepath = "datasources/kurtosis.yml"
+
+	observabilityReadinessTimeout       = 2 * time.Minute
+	observabilityReadinessCheckInterval = 1 * time.Second
+
+	observabilityPrometheusUrlAttr   = "prometheus_url"
+	observabilityGrafanaUrlAttr      = "grafana_url"
+	observabilityOtlpEndpointAttr    = "otlp_endpoint"
+	observabilityHostForUserDisplay  = "127.0.0.1"
+	observabilityServiceUrlFormat    = "http://%s:%d"
+	observabilityNoMetricsServiceMsg = "no service has a metrics port yet"
+)
+
+// NewEnableObservability starts Prometheus and Grafana in the enclave, and optionally Jaeger, with Prometheus scraping
+// the services that have a port with ID 'metrics' and Grafana set up to query Prometheus (and Jaeger). Like
+// add_object_storage, it's a generated add_service under the hood, and it only completes once the stack is ready
+func NewEnableObservability(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: EnableObservabilityBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              JaegerArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &EnableObservabilityCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				isJaegerEnabled: false, // populated at interpretation time
+				serviceConfigs:  nil,   // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			JaegerArgName: true,
+		},
+	}
+}
+
+type EnableObservabilityCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	isJaegerEnabled bool
+
+	// The Prometheus config gets its scrape targets at execution time, once the services of the plan before this
+	// instruction are up
+	serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig
+}
+
+func (builtin *EnableObservabilityCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	if arguments.IsSet(JaegerArgName) {
+		isJaegerEnabled, err := builtin_argument.ExtractArgumentValue[starlark.Bool](arguments, JaegerArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", JaegerArgName)
+		}
+		builtin.isJaegerEnabled = bool(isJaegerEnabled)
+	}
+
+	builtin.serviceConfigs = map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{
+		PrometheusServiceName: getPrometheusServiceConfig(nil),
+		GrafanaServiceName:    getGrafanaServiceConfig(builtin.isJaegerEnabled),
+	}
+	otlpEndpoint := starlark.Value(starlark.None)
+	if builtin.isJaegerEnabled {
+		builtin.serviceConfigs[JaegerServiceName] = getJaegerServiceConfig()
+		otlpEndpoint = starlark.String(fmt.Sprintf(observabilityServiceUrlFormat, JaegerServiceName, JaegerOtlpGrpcPort))
+	}
+
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		observabilityPrometheusUrlAttr: starlark.String(fmt.Sprintf(observabilityServiceUrlFormat, PrometheusServiceName, prometheusPortNumber)),
+		observabilityGrafanaUrlAttr:    starlark.String(fmt.Sprintf(observabilityServiceUrlFormat, GrafanaServiceName, grafanaPortNumber)),
+		observabilityOtlpEndpointAttr:  otlpEndpoint,
+	}), nil
+}
+
+func (builtin *EnableObservabilityCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, serviceName := range getSortedServiceNames(builtin.serviceConfigs) {
+		if validationErr := validateSingleService(validatorEnvironment, serviceName, builtin.serviceConfigs[serviceName]); validationErr != nil {
+			return validationErr
+		}
+	}
+	return nil
+}
+
+func (builtin *EnableObservabilityCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	scrapeTargets, err := builtin.serviceNetwork.GetScrapeTargets(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the services to scrape the metrics of")
+	}
+	builtin.serviceConfigs[PrometheusServiceName] = getPrometheusServiceConfig(scrapeTargets)
+
+	startedServices := map[service.ServiceName]*service.Service{}
+	shouldRemoveStartedServices := true
+	defer func() {
+		if !shouldRemoveStartedServices {
+			return
+		}
+		for serviceName, startedService := range startedServices {
+			if _, err := builtin.serviceNetwork.RemoveService(ctx, string(startedService.GetRegistration().GetUUID())); err != nil {
+				logrus.Errorf("Enabling observability failed so we tried to remove service '%s' that we started, but doing so threw an error:\n%v", serviceName, err)
+			}
+		}
+	}()
+
+	readinessEndpointsByServiceName := map[service.ServiceName]string{
+		PrometheusServiceName: "/-/ready",
+		GrafanaServiceName:    "/api/health",
+		JaegerServiceName:     "/",
+	}
+	readinessPortIdsByServiceName := map[service.ServiceName]string{
+		PrometheusServiceName: PrometheusPortId,
+		GrafanaServiceName:    GrafanaPortId,
+		JaegerServiceName:     JaegerUiPortId,
+	}
+	for _, serviceName := range getSortedServiceNames(builtin.serviceConfigs) {
+		startedService, err := builtin.serviceNetwork.StartService(ctx, serviceName, builtin.serviceConfigs[serviceName])
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred starting observability service '%s'", serviceName)
+		}
+		startedServices[serviceName] = startedService
+	}
+	for _, serviceName := range getSortedServiceNames(builtin.serviceConfigs) {
+		if err := waitForObservabilityServiceReadiness(ctx, builtin.serviceNetwork, serviceName, readinessPortIdsByServiceName[serviceName], readinessEndpointsByServiceName[serviceName]); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred waiting for observability service '%s' to be ready", serviceName)
+		}
+		builtin.serviceNetwork.MarkServiceAsReady(serviceName)
+	}
+	shouldRemoveStartedServices = false
+
+	scrapedServicesMsg := observabilityNoMetricsServiceMsg
+	if len(scrapeTargets) > 0 {
+		scrapedServiceNames := []string{}
+		for _, scrapeTarget := range scrapeTargets {
+			scrapedServiceNames = append(scrapedServiceNames, scrapeTarget.GetServiceName())
+		}
+		scrapedServicesMsg = fmt.Sprintf("scraping '%s'", strings.Join(scrapedServiceNames, "', '"))
+	}
+	return fmt.Sprintf(
+		"Observability enabled, %s; Grafana dashboard available at %s",
+		scrapedServicesMsg,
+		getGrafanaDashboardUrl(startedServices[GrafanaServiceName]),
+	), nil
+}
+
+func getPrometheusServiceConfig(scrapeTargets []*kurtosis_core_rpc_api_bindings.ScrapeTarget) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	configLines := []string{
+		"global:",
+		"  scrape_interval: " + prometheusScrapeInterval,
+		"scrape_configs:",
+		"  - job_name: " + prometheusScrapeJobName,
+		"    static_configs:",
+	}
+	if len(scrapeTargets) == 0 {
+		configLines = append(configLines, "      []")
+	}
+	for _, scrapeTarget := range scrapeTargets {
+		configLines = append(configLines,
+			fmt.Sprintf("      - targets: ['%s']", scrapeTarget.GetPrivateAddress()),
+			fmt.Sprintf("        labels: {%s: '%s'}", prometheusServiceLabel, scrapeTarget.GetServiceName()),
+		)
+	}
+	script := strings.Join([]string{
+		fmt.Sprintf("cat > %s <<'EOF'\n%s\nEOF", prometheusConfigFilepath, strings.Join(configLines, "\n")),
+		fmt.Sprintf("exec /bin/prometheus --config.file=%s --storage.tsdb.path=/prometheus --web.listen-address=:%d", prometheusConfigFilepath, prometheusPortNumber),
+	}, "\n")
+
+	return services.NewServiceConfigBuilder(
+		DefaultPrometheusImage,
+	).WithPrivatePorts(map[string]*kurtosis_core_rpc_api_bindings.Port{
+		PrometheusPortId: binding_constructors.NewPort(prometheusPortNumber, kurtosis_core_rpc_api_bindings.Port_TCP, observabilityHttp),
+	}).WithEntryPointArgs(
+		[]string{"sh", "-c"},
+	).WithCmdArgs(
+		[]string{script},
+	).Build()
+}
+
+// getGrafanaServiceConfig provisions Prometheus (and Jaeger) as datasources, and lets anyone reaching Grafana in as an
+// admin since enclaves are throwaway environments
+func getGrafanaServiceConfig(isJaegerEnabled bool) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	datasourceLines := []string{
+		"apiVersion: 1",
+		"datasources:",
+		"  - name: Prometheus",
+		"    type: prometheus",
+		"    access: proxy",
+		fmt.Sprintf("    url: "+observabilityServiceUrlFormat, PrometheusServiceName, prometheusPortNumber),
+		"    isDefault: true",
+	}
+	if isJaegerEnabled {
+		datasourceLines = append(datasourceLines,
+			"  - name: Jaeger",
+			"    type: jaeger",
+			"    access: proxy",
+			fmt.Sprintf("    url: "+observabilityServiceUrlFormat, JaegerServiceName, jaegerUiPortNumber),
+		)
+	}
+	datasourcesFilepath := grafanaProvisioningDirpath + "/" + grafanaDatasourcesRelFilepath
+	script := strings.Join([]string{
+		fmt.Sprintf("mkdir -p %s/datasources %s/dashboards %s/plugins %s/notifiers %s/alerting", grafanaProvisioningDirpath, grafanaProvisioningDirpath, grafanaProvisioningDirpath, grafanaProvisioningDirpath, grafanaProvisioningDirpath),
+		fmt.Sprintf("cat > %s <<'EOF'\n%s\nEOF", datasourcesFilepath, strings.Join(datasourceLines, "\n")),
+		"exec /run.sh",
+	}, "\n")
+
+	return services.NewServiceConfigBuilder(
+		DefaultGrafanaImage,
+	).WithPrivatePorts(map[string]*kurtosis_core_rpc_api_bindings.Port{
+		GrafanaPortId: binding_constructors.NewPort(grafanaPortNumber, kurtosis_core_rpc_api_bindings.Port_TCP, observabilityHttp),
+	}).WithEnvVars(map[string]string{
+		"GF_PATHS_PROVISIONING":      grafanaProvisioningDirpath,
+		"GF_AUTH_ANONYMOUS_ENABLED":  "true",
+		"GF_AUTH_ANONYMOUS_ORG_ROLE": "Admin",
+		"GF_AUTH_DISABLE_LOGIN_FORM": "true",
+	}).WithEntryPointArgs(
+		[]string{"sh", "-c"},
+	).WithCmdArgs(
+		[]string{script},
+	).Build()
+}
+
+func getJaegerServiceConfig() *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	return services.NewServiceConfigBuilder(
+		DefaultJaegerImage,
+	).WithPrivatePorts(map[string]*kurtosis_core_rpc_api_bindings.Port{
+		JaegerUiPortId:       binding_constructors.NewPort(jaegerUiPortNumber, kurtosis_core_rpc_api_bindings.Port_TCP, observabilityHttp),
+		jaegerOtlpGrpcPortId: binding_constructors.NewPort(JaegerOtlpGrpcPort, kurtosis_core_rpc_api_bindings.Port_TCP, ""),
+		jaegerOtlpHttpPortId: binding_constructors.NewPort(jaegerOtlpHttpPort, kurtosis_core_rpc_api_bindings.Port_TCP, observabilityHttp),
+	}).WithEnvVars(map[string]string{
+		"COLLECTOR_OTLP_ENABLED": "true",
+	}).Build()
+}
+
+func waitForObservabilityServiceReadiness(ctx context.Context, serviceNetwork service_network.ServiceNetwork, serviceName service.ServiceName, portId string, endpoint string) error {
+	deadline := time.Now().Add(observabilityReadinessTimeout)
+	for {
+		response, err := serviceNetwork.HttpRequestService(ctx, string(serviceName), portId, http.MethodGet, "", endpoint, "")
+		if err == nil {
+			isReady := response.StatusCode == http.StatusOK
+			response.Body.Close()
+			if isReady {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			return stacktrace.NewError("Service '%s' still didn't answer '%s' on port '%s' after %v; its logs should tell why", serviceName, endpoint, portId, observabilityReadinessTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "The wait for service '%s' to be ready got cancelled", serviceName)
+		case <-time.After(observabilityReadinessCheckInterval):
+		}
+	}
+}
+
+// getGrafanaDashboardUrl returns the URL of Grafana on this machine if its port got published, or within the enclave
+// otherwise
+func getGrafanaDashboardUrl(grafanaService *service.Service) string {
+	if grafanaService != nil {
+		if publicPort, found := grafanaService.GetMaybePublicPorts()[GrafanaPortId]; found {
+			return fmt.Sprintf(observabilityServiceUrlFormat, observabilityHostForUserDisplay, publicPort.GetNumber())
+		}
+	}
+	return fmt.Sprintf(observabilityServiceUrlFormat+" (within the enclave)", GrafanaServiceName, grafanaPortNumber)
+}
+
+func getSortedServiceNames(serviceConfigs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) []service.ServiceName {
+	serviceNames := make([]service.ServiceName, 0, len(serviceConfigs))
+	for serviceName := range serviceConfigs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Slice(serviceNames, func(i, j int) bool {
+		return serviceNames[i] < serviceNames[j]
+	})
+	return serviceNames
+}
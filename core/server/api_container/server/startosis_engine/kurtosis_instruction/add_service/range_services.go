@@ -0,0 +1,228 @@
+package add_service
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	RangeServicesBuiltinName = "range_services"
+
+	CountArgName      = "count"
+	StartIndexArgName = "start_index"
+
+	// IndexPlaceholder gets replaced by the index of each service in its name and in the string fields of its config
+	IndexPlaceholder = "{index}"
+
+	serviceNameIndexSeparator = "-"
+
+	minRangeServicesCount = 1
+	// Above that, the batch is most likely a mistake rather than an actual need
+	maxRangeServicesCount = 1000
+)
+
+// NewRangeServices declares N services from a single config, replacing the index placeholder in their name and config.
+// Under the hood it is an add_services call with the generated configs, so the services get started in parallel and the
+// whole batch gets rolled back if one of them fails
+func NewRangeServices(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RangeServicesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              CountArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, CountArgName, minRangeServicesCount, maxRangeServicesCount)
+					},
+				},
+				{
+					Name:              ServiceConfigArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*service_config.ServiceConfig],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						if _, _, err := validateAndConvertConfigAndReadyCondition(value); err != nil {
+							return err
+						}
+						return nil
+					},
+				},
+				{
+					Name:              StartIndexArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, StartIndexArgName, 0, math.MaxInt32)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &RangeServicesCapabilities{
+				AddServicesCapabilities: &AddServicesCapabilities{
+					serviceNetwork:    serviceNetwork,
+					runtimeValueStore: runtimeValueStore,
+
+					serviceConfigs: nil, // populated at interpretation time
+
+					resultUuids:          map[service.ServiceName]string{}, // populated at interpretation time
+					readyConditions:      nil,                              // populated at interpretation time
+					dependencyRecoveries: nil,                              // populated at interpretation time
+				},
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName:   true,
+			CountArgName:         true,
+			ServiceConfigArgName: true,
+		},
+	}
+}
+
+// RangeServicesCapabilities only differs from AddServicesCapabilities in how the service configs get interpreted
+type RangeServicesCapabilities struct {
+	*AddServicesCapabilities
+}
+
+func (builtin *RangeServicesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceNameTemplate, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	count, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, CountArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", CountArgName)
+	}
+	countInt, ok := count.Int64()
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Unable to convert '%s' argument '%v' to an integer", CountArgName, count)
+	}
+	startIndexInt := int64(0)
+	if arguments.IsSet(StartIndexArgName) {
+		startIndex, err := builtin_argument.ExtractArgumentValue[starlark.Int](arguments, StartIndexArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", StartIndexArgName)
+		}
+		if startIndexInt, ok = startIndex.Int64(); !ok {
+			return nil, startosis_errors.NewInterpretationError("Unable to convert '%s' argument '%v' to an integer", StartIndexArgName, startIndex)
+		}
+	}
+	serviceConfigTemplate, err := builtin_argument.ExtractArgumentValue[*service_config.ServiceConfig](arguments, ServiceConfigArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceConfigArgName)
+	}
+	apiServiceConfigTemplate, readyConditions, interpretationErr := validateAndConvertConfigAndReadyCondition(serviceConfigTemplate)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	dependencyRecoveries, interpretationErr := serviceConfigTemplate.GetDependencyRecoveries()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	serviceNames := []service.ServiceName{}
+	builtin.serviceConfigs = map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig{}
+	builtin.readyConditions = map[service.ServiceName]*service_config.ServiceReadyConditions{}
+	builtin.dependencyRecoveries = map[service.ServiceName]map[service.ServiceName]*service_config.DependencyRecovery{}
+	for index := startIndexInt; index < startIndexInt+countInt; index++ {
+		serviceName := getRangeServiceName(serviceNameTemplate.GoString(), index)
+		if _, found := builtin.serviceConfigs[serviceName]; found {
+			return nil, startosis_errors.NewInterpretationError("Service name '%s' is generated more than once by '%s'; make sure the '%s' argument contains the '%s' placeholder at most once or not at all", serviceName, RangeServicesBuiltinName, ServiceNameArgName, IndexPlaceholder)
+		}
+		serviceNames = append(serviceNames, serviceName)
+		builtin.serviceConfigs[serviceName] = replaceIndexPlaceholderInServiceConfig(apiServiceConfigTemplate, index)
+		builtin.readyConditions[serviceName] = readyConditions
+		builtin.dependencyRecoveries[serviceName] = dependencyRecoveries
+	}
+
+	resultUuids, servicesObjectDict, interpretationErr := makeAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.runtimeValueStore)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	builtin.resultUuids = resultUuids
+
+	// Returned as a list in index order, as that's how the services are typically consumed
+	serviceObjects := []starlark.Value{}
+	for _, serviceName := range serviceNames {
+		serviceObject, found, err := servicesObjectDict.Get(starlark.String(serviceName))
+		if err != nil || !found {
+			return nil, startosis_errors.NewInterpretationError("Could not find the object of service '%s' in the '%s' return value. This is a Kurtosis bug", serviceName, RangeServicesBuiltinName)
+		}
+		serviceObjects = append(serviceObjects, serviceObject)
+	}
+	return starlark.NewList(serviceObjects), nil
+}
+
+// getRangeServiceName replaces the index placeholder in the name template, or suffixes the template with the index if it
+// has no placeholder
+func getRangeServiceName(serviceNameTemplate string, index int64) service.ServiceName {
+	indexStr := strconv.FormatInt(index, 10)
+	if !strings.Contains(serviceNameTemplate, IndexPlaceholder) {
+		return service.ServiceName(serviceNameTemplate + serviceNameIndexSeparator + indexStr)
+	}
+	return service.ServiceName(strings.ReplaceAll(serviceNameTemplate, IndexPlaceholder, indexStr))
+}
+
+func replaceIndexPlaceholderInServiceConfig(serviceConfigTemplate *kurtosis_core_rpc_api_bindings.ServiceConfig, index int64) *kurtosis_core_rpc_api_bindings.ServiceConfig {
+	indexStr := strconv.FormatInt(index, 10)
+	replaceIndexPlaceholder := func(value string) string {
+		return strings.ReplaceAll(value, IndexPlaceholder, indexStr)
+	}
+
+	serviceConfigBuilder := services.NewServiceConfigBuilderFromServiceConfig(serviceConfigTemplate)
+	if serviceConfigTemplate.EntrypointArgs != nil {
+		entrypointArgs := make([]string, len(serviceConfigTemplate.EntrypointArgs))
+		for argIdx, entrypointArg := range serviceConfigTemplate.EntrypointArgs {
+			entrypointArgs[argIdx] = replaceIndexPlaceholder(entrypointArg)
+		}
+		serviceConfigBuilder.WithEntryPointArgs(entrypointArgs)
+	}
+	if serviceConfigTemplate.CmdArgs != nil {
+		cmdArgs := make([]string, len(serviceConfigTemplate.CmdArgs))
+		for argIdx, cmdArg := range serviceConfigTemplate.CmdArgs {
+			cmdArgs[argIdx] = replaceIndexPlaceholder(cmdArg)
+		}
+		serviceConfigBuilder.WithCmdArgs(cmdArgs)
+	}
+	if serviceConfigTemplate.EnvVars != nil {
+		envVars := make(map[string]string, len(serviceConfigTemplate.EnvVars))
+		for envVarName, envVarValue := range serviceConfigTemplate.EnvVars {
+			envVars[envVarName] = replaceIndexPlaceholder(envVarValue)
+		}
+		serviceConfigBuilder.WithEnvVars(envVars)
+	}
+	if serviceConfigTemplate.FilesArtifactMountpoints != nil {
+		filesArtifactMountpoints := make(map[string]string, len(serviceConfigTemplate.FilesArtifactMountpoints))
+		for mountpoint, artifactName := range serviceConfigTemplate.FilesArtifactMountpoints {
+			filesArtifactMountpoints[mountpoint] = replaceIndexPlaceholder(artifactName)
+		}
+		serviceConfigBuilder.WithFilesArtifactMountDirpaths(filesArtifactMountpoints)
+	}
+	serviceConfigBuilder.WithSubnetwork(replaceIndexPlaceholder(serviceConfigTemplate.GetSubnetwork()))
+	return serviceConfigBuilder.Build()
+}
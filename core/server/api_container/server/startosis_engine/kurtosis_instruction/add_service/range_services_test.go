@@ -0,0 +1,36 @@
+package add_service
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetRangeServiceName(t *testing.T) {
+	require.Equal(t, service.ServiceName("node-3-el"), getRangeServiceName("node-{index}-el", 3))
+	require.Equal(t, service.ServiceName("node-3"), getRangeServiceName("node", 3))
+}
+
+func TestReplaceIndexPlaceholderInServiceConfig(t *testing.T) {
+	serviceConfigTemplate := services.NewServiceConfigBuilder(
+		"image",
+	).WithCmdArgs(
+		[]string{"--id={index}"},
+	).WithEntryPointArgs(
+		[]string{"/run-{index}.sh"},
+	).WithEnvVars(
+		map[string]string{"NODE_INDEX": "{index}"},
+	).WithFilesArtifactMountDirpaths(
+		map[string]string{"/genesis": "genesis-{index}"},
+	).Build()
+
+	serviceConfig := replaceIndexPlaceholderInServiceConfig(serviceConfigTemplate, 7)
+	require.Equal(t, []string{"--id=7"}, serviceConfig.CmdArgs)
+	require.Equal(t, []string{"/run-7.sh"}, serviceConfig.EntrypointArgs)
+	require.Equal(t, map[string]string{"NODE_INDEX": "7"}, serviceConfig.EnvVars)
+	require.Equal(t, map[string]string{"/genesis": "genesis-7"}, serviceConfig.FilesArtifactMountpoints)
+
+	// the template must be left untouched so that it can be used for the other indices
+	require.Equal(t, []string{"--id={index}"}, serviceConfigTemplate.CmdArgs)
+}
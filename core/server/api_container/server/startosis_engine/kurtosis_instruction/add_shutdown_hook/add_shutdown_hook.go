@@ -0,0 +1,171 @@
+package add_shutdown_hook
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/shutdown_hooks"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"time"
+)
+
+const (
+	AddShutdownHookBuiltinName = "add_shutdown_hook"
+
+	ServiceNameArgName = "service_name"
+	RecipeArgName      = "recipe"
+	TimeoutArgName     = "timeout"
+
+	defaultTimeout = 30 * time.Second
+
+	// Both the exec and the HTTP request recipes return their exit or status code under this key
+	recipeResultCodeKey = "code"
+
+	successfulExecExitCode = 0
+	minSuccessfulHttpCode  = 200
+	maxSuccessfulHttpCode  = 299
+)
+
+func NewAddShutdownHook(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: AddShutdownHookBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              RecipeArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         nil,
+				},
+				{
+					Name:              TimeoutArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &AddShutdownHookCapabilities{
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
+
+				serviceName: "",  // populated at interpretation time
+				recipe:      nil, // populated at interpretation time
+				timeout:     0,   // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			RecipeArgName:      true,
+			TimeoutArgName:     false,
+		},
+	}
+}
+
+type AddShutdownHookCapabilities struct {
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	serviceName service.ServiceName
+	recipe      recipe.Recipe
+	timeout     time.Duration
+}
+
+func (builtin *AddShutdownHookCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+
+	httpRecipe, err := builtin_argument.ExtractArgumentValue[*recipe.HttpRequestRecipe](arguments, RecipeArgName)
+	if err != nil {
+		execRecipe, err := builtin_argument.ExtractArgumentValue[*recipe.ExecRecipe](arguments, RecipeArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RecipeArgName)
+		}
+		builtin.recipe = execRecipe
+	} else {
+		builtin.recipe = httpRecipe
+	}
+
+	builtin.timeout = defaultTimeout
+	if arguments.IsSet(TimeoutArgName) {
+		timeoutStr, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TimeoutArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TimeoutArgName)
+		}
+		timeout, parseErr := time.ParseDuration(timeoutStr.GoString())
+		if parseErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing timeout '%v'", timeoutStr.GoString())
+		}
+		builtin.timeout = timeout
+	}
+	return starlark.None, nil
+}
+
+func (builtin *AddShutdownHookCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", AddShutdownHookBuiltinName, builtin.serviceName)
+	}
+	return nil
+}
+
+func (builtin *AddShutdownHookCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	hookDescription := fmt.Sprintf("%v on service '%v'", builtin.recipe, builtin.serviceName)
+	shutdown_hooks.GetShutdownHooksRegistry().RegisterHook(hookDescription, builtin.timeout, builtin.runHook)
+	instructionResult := fmt.Sprintf("Added shutdown hook running %s, with a timeout of '%v'", hookDescription, builtin.timeout)
+	return instructionResult, nil
+}
+
+func (builtin *AddShutdownHookCapabilities) runHook(ctx context.Context) error {
+	result, err := builtin.recipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the recipe of the shutdown hook on service '%v'", builtin.serviceName)
+	}
+	codeValue, found := result[recipeResultCodeKey]
+	if !found {
+		return stacktrace.NewError("The result of the recipe of the shutdown hook on service '%v' has no '%v'; this is a bug in Kurtosis", builtin.serviceName, recipeResultCodeKey)
+	}
+	code, ok := codeValue.(starlark.Int)
+	if !ok {
+		return stacktrace.NewError("Expected the '%v' of the result of the recipe of the shutdown hook on service '%v' to be an int but was '%v'; this is a bug in Kurtosis", recipeResultCodeKey, builtin.serviceName, codeValue.Type())
+	}
+	codeInt64, ok := code.Int64()
+	if !ok {
+		return stacktrace.NewError("The '%v' of the result of the recipe of the shutdown hook on service '%v' doesn't fit in an int64", recipeResultCodeKey, builtin.serviceName)
+	}
+	if _, isExecRecipe := builtin.recipe.(*recipe.ExecRecipe); isExecRecipe {
+		if codeInt64 != successfulExecExitCode {
+			return stacktrace.NewError("The command of the shutdown hook on service '%v' exited with code '%v'; its output was:\n%v", builtin.serviceName, codeInt64, builtin.recipe.ResultMapToString(result))
+		}
+		return nil
+	}
+	if codeInt64 < minSuccessfulHttpCode || codeInt64 > maxSuccessfulHttpCode {
+		return stacktrace.NewError("The request of the shutdown hook on service '%v' got status code '%v'; the response was:\n%v", builtin.serviceName, codeInt64, builtin.recipe.ResultMapToString(result))
+	}
+	return nil
+}
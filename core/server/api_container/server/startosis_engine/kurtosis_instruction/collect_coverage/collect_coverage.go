@@ -0,0 +1,175 @@
+package collect_coverage
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/shutdown_hooks"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"strings"
+	"time"
+)
+
+const (
+	CollectCoverageBuiltinName = "collect_coverage"
+
+	ServiceNamesArgName = "service_names"
+	PathArgName         = "path"
+	TimeoutArgName      = "timeout"
+
+	// By convention, services under test write their coverage data files (e.g. the Go coverage files of GOCOVERDIR
+	// or the JaCoCo exec files) to this directory
+	DefaultCoverageDirpath = "/kurtosis-coverage"
+
+	// The files artifact the coverage data files of all the services get merged into when the enclave is torn down
+	CoverageFilesArtifactName = "coverage"
+
+	defaultTimeout = 2 * time.Minute
+
+	// There's only ever one coverage collection per enclave, so every call to the instruction replaces the last one
+	coverageShutdownHookDescription = "coverage collection into files artifact '" + CoverageFilesArtifactName + "'"
+)
+
+func NewCollectCoverage(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: CollectCoverageBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNamesArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              PathArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PathArgName)
+					},
+				},
+				{
+					Name:              TimeoutArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &CollectCoverageCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceNames: nil, // populated at interpretation time
+				path:         "",  // populated at interpretation time
+				timeout:      0,   // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNamesArgName: true,
+			PathArgName:         true,
+			TimeoutArgName:      false,
+		},
+	}
+}
+
+type CollectCoverageCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceNames []service.ServiceName
+	path         string
+	timeout      time.Duration
+}
+
+func (builtin *CollectCoverageCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceNamesList, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, ServiceNamesArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNamesArgName)
+	}
+	if serviceNamesList.Len() == 0 {
+		return nil, startosis_errors.NewInterpretationError("'%s' argument should list at least one service", ServiceNamesArgName)
+	}
+	serviceNames := make([]service.ServiceName, 0, serviceNamesList.Len())
+	for idx := 0; idx < serviceNamesList.Len(); idx++ {
+		serviceName, ok := serviceNamesList.Index(idx).(starlark.String)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("'%s' argument should only contain strings but item at index '%d' was a '%s'", ServiceNamesArgName, idx, serviceNamesList.Index(idx).Type())
+		}
+		serviceNames = append(serviceNames, service.ServiceName(serviceName.GoString()))
+	}
+	builtin.serviceNames = serviceNames
+
+	builtin.path = DefaultCoverageDirpath
+	if arguments.IsSet(PathArgName) {
+		path, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, PathArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", PathArgName)
+		}
+		builtin.path = path.GoString()
+	}
+
+	builtin.timeout = defaultTimeout
+	if arguments.IsSet(TimeoutArgName) {
+		timeoutStr, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TimeoutArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TimeoutArgName)
+		}
+		timeout, parseErr := time.ParseDuration(timeoutStr.GoString())
+		if parseErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing timeout '%v'", timeoutStr.GoString())
+		}
+		builtin.timeout = timeout
+	}
+	return starlark.None, nil
+}
+
+func (builtin *CollectCoverageCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, serviceName := range builtin.serviceNames {
+		if !validatorEnvironment.DoesServiceNameExist(serviceName) {
+			return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", CollectCoverageBuiltinName, serviceName)
+		}
+	}
+	if validatorEnvironment.DoesArtifactNameExist(CoverageFilesArtifactName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as a files artifact named '%v', which the coverage gets collected into, already exists", CollectCoverageBuiltinName, CoverageFilesArtifactName)
+	}
+	return nil
+}
+
+func (builtin *CollectCoverageCapabilities) Execute(_ context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	shutdown_hooks.GetShutdownHooksRegistry().RegisterHook(coverageShutdownHookDescription, builtin.timeout, builtin.collectCoverage)
+	serviceNameStrs := make([]string, 0, len(builtin.serviceNames))
+	for _, serviceName := range builtin.serviceNames {
+		serviceNameStrs = append(serviceNameStrs, string(serviceName))
+	}
+	instructionResult := fmt.Sprintf(
+		"Coverage data files in '%s' on services '%s' will be collected into files artifact '%s' when the enclave is torn down",
+		builtin.path,
+		strings.Join(serviceNameStrs, "', '"),
+		CoverageFilesArtifactName,
+	)
+	return instructionResult, nil
+}
+
+func (builtin *CollectCoverageCapabilities) collectCoverage(ctx context.Context) error {
+	srcPathsByService := map[service.ServiceName]string{}
+	for _, serviceName := range builtin.serviceNames {
+		srcPathsByService[serviceName] = builtin.path
+	}
+	if _, err := builtin.serviceNetwork.CopyFilesFromServices(ctx, srcPathsByService, CoverageFilesArtifactName); err != nil {
+		return stacktrace.Propagate(err, "An error occurred collecting the coverage data files in '%v' into files artifact '%v'", builtin.path, CoverageFilesArtifactName)
+	}
+	return nil
+}
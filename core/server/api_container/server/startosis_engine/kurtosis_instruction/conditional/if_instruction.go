@@ -0,0 +1,227 @@
+package conditional
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/assert"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"strings"
+)
+
+const (
+	IfBuiltinName = "if_"
+
+	RuntimeValueArgName = "value"
+	AssertionArgName    = "assertion"
+	TargetArgName       = "target_value"
+	ThenArgName         = "then"
+	ElseArgName         = "else_"
+
+	thenBranchName = "then"
+	elseBranchName = "else_"
+
+	branchInstructionsSeparator = ", "
+	branchOutputsSeparator      = "\n"
+)
+
+// IfInstruction runs the instructions of one of two branches, depending on whether an assertion on a runtime value
+// holds when it executes
+//
+// Unlike the other plan instructions, it can't be built with the KurtosisPlanInstruction framework as it needs to call
+// the Starlark functions declaring the branches at interpretation time, capturing the instructions they add to the plan
+// instead of adding them to the plan directly
+type IfInstruction struct {
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	position *kurtosis_starlark_framework.KurtosisBuiltinPosition
+
+	runtimeValue starlark.String
+	assertion    starlark.String
+	target       starlark.Comparable
+
+	thenInstructions []kurtosis_instruction.KurtosisInstruction
+	elseInstructions []kurtosis_instruction.KurtosisInstruction
+}
+
+// NewIfBuiltin returns the 'if_' builtin, which adds an IfInstruction to the instruction queue
+func NewIfBuiltin(instructionsQueue *[]kurtosis_instruction.KurtosisInstruction, runtimeValueStore *runtime_value_store.RuntimeValueStore) func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	return func(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var runtimeValue starlark.String
+		var assertion starlark.String
+		var target starlark.Comparable
+		var thenCallable starlark.Callable
+		var elseCallable starlark.Callable
+		if err := starlark.UnpackArgs(
+			b.Name(),
+			args,
+			kwargs,
+			RuntimeValueArgName, &runtimeValue,
+			AssertionArgName, &assertion,
+			TargetArgName, &target,
+			ThenArgName, &thenCallable,
+			ElseArgName+"?", &elseCallable,
+		); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments passed to '%s'", IfBuiltinName)
+		}
+		if interpretationErr := assert.ValidateAssertionToken(assertion); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if _, ok := target.(starlark.Iterable); (assertion.GoString() == assert.InCollectionAssertionToken || assertion.GoString() == assert.NotInCollectionAssertionToken) && !ok {
+			return nil, startosis_errors.NewInterpretationError("'%v' assertion requires an iterable for target values, got '%v'", assertion.GoString(), target.Type())
+		}
+
+		thenInstructions, interpretationErr := interpretBranch(thread, instructionsQueue, thenBranchName, thenCallable)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		elseInstructions, interpretationErr := interpretBranch(thread, instructionsQueue, elseBranchName, elseCallable)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+
+		callFrame := thread.CallStack().At(1)
+		position := kurtosis_starlark_framework.NewKurtosisBuiltinPosition(callFrame.Pos.Filename(), callFrame.Pos.Line, callFrame.Pos.Col)
+		*instructionsQueue = append(*instructionsQueue, &IfInstruction{
+			runtimeValueStore: runtimeValueStore,
+			position:          position,
+			runtimeValue:      runtimeValue,
+			assertion:         assertion,
+			target:            target,
+			thenInstructions:  thenInstructions,
+			elseInstructions:  elseInstructions,
+		})
+		return starlark.None, nil
+	}
+}
+
+func (instruction *IfInstruction) GetPositionInOriginalScript() *kurtosis_starlark_framework.KurtosisBuiltinPosition {
+	return instruction.position
+}
+
+func (instruction *IfInstruction) GetCanonicalInstruction() *kurtosis_core_rpc_api_bindings.StarlarkInstruction {
+	args := []*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg{
+		binding_constructors.NewStarlarkInstructionKwarg(builtin_argument.StringifyArgumentValue(instruction.runtimeValue), RuntimeValueArgName, true),
+		binding_constructors.NewStarlarkInstructionKwarg(builtin_argument.StringifyArgumentValue(instruction.assertion), AssertionArgName, true),
+		binding_constructors.NewStarlarkInstructionKwarg(builtin_argument.StringifyArgumentValue(instruction.target), TargetArgName, true),
+		binding_constructors.NewStarlarkInstructionKwarg(stringifyBranch(instruction.thenInstructions), ThenArgName, true),
+		binding_constructors.NewStarlarkInstructionKwarg(stringifyBranch(instruction.elseInstructions), ElseArgName, true),
+	}
+	return binding_constructors.NewStarlarkInstruction(instruction.position.ToAPIType(), IfBuiltinName, instruction.String(), args)
+}
+
+func (instruction *IfInstruction) Execute(ctx context.Context) (*string, error) {
+	doesConditionHold, err := instruction.evaluateCondition()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred evaluating the condition of '%s'", IfBuiltinName)
+	}
+	branchName := thenBranchName
+	branchInstructions := instruction.thenInstructions
+	if !doesConditionHold {
+		branchName = elseBranchName
+		branchInstructions = instruction.elseInstructions
+	}
+
+	branchOutputs := []string{
+		fmt.Sprintf("Condition '%s %s %s' evaluated to %v; executing the %d instruction(s) of the '%s' branch", instruction.runtimeValue.GoString(), instruction.assertion.GoString(), instruction.target.String(), doesConditionHold, len(branchInstructions), branchName),
+	}
+	for index, branchInstruction := range branchInstructions {
+		branchInstructionOutput, err := branchInstruction.Execute(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred executing instruction (number %d) of the '%s' branch at %v:\n%v", index+1, branchName, branchInstruction.GetPositionInOriginalScript().String(), branchInstruction.String())
+		}
+		if branchInstructionOutput != nil {
+			branchOutputs = append(branchOutputs, *branchInstructionOutput)
+		}
+	}
+	instructionResult := strings.Join(branchOutputs, branchOutputsSeparator)
+	return &instructionResult, nil
+}
+
+func (instruction *IfInstruction) String() string {
+	return fmt.Sprintf(
+		"%s(%s=%s, %s=%s, %s=%s, %s=%s, %s=%s)",
+		IfBuiltinName,
+		RuntimeValueArgName, builtin_argument.StringifyArgumentValue(instruction.runtimeValue),
+		AssertionArgName, builtin_argument.StringifyArgumentValue(instruction.assertion),
+		TargetArgName, builtin_argument.StringifyArgumentValue(instruction.target),
+		ThenArgName, stringifyBranch(instruction.thenInstructions),
+		ElseArgName, stringifyBranch(instruction.elseInstructions),
+	)
+}
+
+// ValidateAndUpdateEnvironment validates both branches up front, as which one executes is only known at execution time
+func (instruction *IfInstruction) ValidateAndUpdateEnvironment(environment *startosis_validator.ValidatorEnvironment) error {
+	thenEnvironment := environment.Copy()
+	for _, branchInstruction := range instruction.thenInstructions {
+		if err := branchInstruction.ValidateAndUpdateEnvironment(thenEnvironment); err != nil {
+			return startosis_errors.WrapWithValidationError(err, "Error while validating instruction %v of the '%s' branch. The instruction can be found at %v", branchInstruction.String(), thenBranchName, branchInstruction.GetPositionInOriginalScript().String())
+		}
+	}
+	elseEnvironment := environment.Copy()
+	for _, branchInstruction := range instruction.elseInstructions {
+		if err := branchInstruction.ValidateAndUpdateEnvironment(elseEnvironment); err != nil {
+			return startosis_errors.WrapWithValidationError(err, "Error while validating instruction %v of the '%s' branch. The instruction can be found at %v", branchInstruction.String(), elseBranchName, branchInstruction.GetPositionInOriginalScript().String())
+		}
+	}
+	environment.MergeBranches(thenEnvironment, elseEnvironment)
+	return nil
+}
+
+func (instruction *IfInstruction) evaluateCondition() (bool, error) {
+	currentValue, err := magic_string_helper.GetOrReplaceRuntimeValueFromString(instruction.runtimeValue.GoString(), instruction.runtimeValueStore)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred resolving value '%s'", instruction.runtimeValue.GoString())
+	}
+	target := instruction.target
+	if targetStr, ok := target.(starlark.String); ok {
+		target, err = magic_string_helper.GetOrReplaceRuntimeValueFromString(targetStr.GoString(), instruction.runtimeValueStore)
+		if err != nil {
+			return false, stacktrace.Propagate(err, "An error occurred resolving target value '%s'", targetStr.GoString())
+		}
+	}
+	// A type mismatch is a mistake in the script rather than a condition that doesn't hold
+	if _, isComparison := assert.StringTokenToComparisonStarlarkToken[instruction.assertion.GoString()]; isComparison && currentValue.Type() != target.Type() {
+		return false, stacktrace.NewError("Cannot compare '%v' of type '%v' to '%v' of type '%v'", currentValue, currentValue.Type(), target, target.Type())
+	}
+	return assert.Assert(currentValue, instruction.assertion.GoString(), target) == nil, nil
+}
+
+// interpretBranch calls the function declaring a branch and returns the instructions it added, keeping them out of
+// the instruction queue
+func interpretBranch(
+	thread *starlark.Thread,
+	instructionsQueue *[]kurtosis_instruction.KurtosisInstruction,
+	branchName string,
+	branchCallable starlark.Callable,
+) ([]kurtosis_instruction.KurtosisInstruction, *startosis_errors.InterpretationError) {
+	if branchCallable == nil {
+		return []kurtosis_instruction.KurtosisInstruction{}, nil
+	}
+	queuedInstructions := *instructionsQueue
+	*instructionsQueue = []kurtosis_instruction.KurtosisInstruction{}
+	defer func() {
+		*instructionsQueue = queuedInstructions
+	}()
+	if _, err := starlark.Call(thread, branchCallable, starlark.Tuple{}, nil); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred interpreting the '%s' branch of '%s'", branchName, IfBuiltinName)
+	}
+	return *instructionsQueue, nil
+}
+
+func stringifyBranch(branchInstructions []kurtosis_instruction.KurtosisInstruction) string {
+	branchInstructionStrs := []string{}
+	for _, branchInstruction := range branchInstructions {
+		branchInstructionStrs = append(branchInstructionStrs, branchInstruction.String())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(branchInstructionStrs, branchInstructionsSeparator))
+}
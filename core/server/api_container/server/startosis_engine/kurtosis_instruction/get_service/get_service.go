@@ -0,0 +1,98 @@
+package get_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	GetServiceBuiltinName = "get_service"
+
+	ServiceNameArgName = "name"
+)
+
+func NewGetService(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: GetServiceBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &GetServiceCapabilities{
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
+
+				serviceName: "", // populated at interpretation time
+				resultUuid:  "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+		},
+	}
+}
+
+type GetServiceCapabilities struct {
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	serviceName service.ServiceName
+
+	resultUuid string
+}
+
+func (builtin *GetServiceCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.resultUuid, err = builtin.runtimeValueStore.CreateValue()
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", GetServiceBuiltinName)
+	}
+
+	returnValue, interpretationErr := makeGetServiceInterpretationReturnValue(builtin.serviceNetwork, builtin.serviceName, builtin.resultUuid)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return returnValue, nil
+}
+
+func (builtin *GetServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%s' as service name '%s' doesn't exist", GetServiceBuiltinName, builtin.serviceName)
+	}
+	return nil
+}
+
+func (builtin *GetServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := fillGetServiceReturnValueWithRuntimeValues(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName, builtin.resultUuid); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred resolving the values of service '%s'", builtin.serviceName)
+	}
+	instructionResult := fmt.Sprintf("Fetched service '%s'", builtin.serviceName)
+	return instructionResult, nil
+}
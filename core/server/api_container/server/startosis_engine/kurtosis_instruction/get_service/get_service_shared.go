@@ -0,0 +1,86 @@
+package get_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	ipAddressRuntimeValue = "ip_address"
+	hostnameRuntimeValue  = "hostname"
+)
+
+// makeGetServiceInterpretationReturnValue builds the Service object returned at interpretation time. The ports are taken
+// from the service as it exists in the enclave right now, as they are needed to write the rest of the script, while the
+// hostname and IP address are future references that get resolved when the instruction executes
+func makeGetServiceInterpretationReturnValue(
+	serviceNetwork service_network.ServiceNetwork,
+	serviceName service.ServiceName,
+	resultUuid string,
+) (*kurtosis_types.Service, *startosis_errors.InterpretationError) {
+	// Interpretation has no context of its own; this only reads the service from the backend
+	existingService, err := serviceNetwork.GetService(context.Background(), string(serviceName))
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(
+			err,
+			"An error occurred getting service '%s' from the enclave; note that only services that exist in the "+
+				"enclave before the script runs can be looked up, services added by the script itself should be "+
+				"referenced using the value returned by 'add_service'",
+			serviceName,
+		)
+	}
+
+	ports := existingService.GetPrivatePorts()
+	portSpecsDict := starlark.NewDict(len(ports))
+	for portId, port := range ports {
+		number := uint32(port.GetNumber())
+		transportProtocol := kurtosis_core_rpc_api_bindings.Port_TransportProtocol(kurtosis_core_rpc_api_bindings.Port_TransportProtocol_value[port.GetTransportProtocol().String()])
+		maybeApplicationProtocol := ""
+		if port.GetMaybeApplicationProtocol() != nil {
+			maybeApplicationProtocol = *port.GetMaybeApplicationProtocol()
+		}
+
+		portSpec, interpretationErr := port_spec.CreatePortSpec(number, transportProtocol, maybeApplicationProtocol)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if err := portSpecsDict.SetKey(starlark.String(portId), portSpec); err != nil {
+			return nil, startosis_errors.NewInterpretationError("An error occurred while creating a port spec for values "+
+				"(number: '%v', transport_protocol: '%v', application_protocol: '%v') for service '%s'",
+				number, transportProtocol, maybeApplicationProtocol, serviceName)
+		}
+	}
+	ipAddress := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, ipAddressRuntimeValue))
+	hostname := starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, hostnameRuntimeValue))
+	return kurtosis_types.NewService(starlark.String(serviceName), hostname, ipAddress, portSpecsDict), nil
+}
+
+// fillGetServiceReturnValueWithRuntimeValues resolves the future references of the returned Service object against the
+// service as it exists when the instruction executes
+func fillGetServiceReturnValueWithRuntimeValues(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+	resultUuid string,
+) error {
+	existingService, err := serviceNetwork.GetService(ctx, string(serviceName))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceName)
+	}
+	runtimeValueStore.SetValue(resultUuid, map[string]starlark.Comparable{
+		ipAddressRuntimeValue: starlark.String(existingService.GetRegistration().GetPrivateIP().String()),
+		hostnameRuntimeValue:  starlark.String(existingService.GetRegistration().GetHostname()),
+	})
+	return nil
+}
@@ -0,0 +1,104 @@
+package get_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	GetServicesBuiltinName = "get_services"
+)
+
+func NewGetServices(serviceNetwork service_network.ServiceNetwork, runtimeValueStore *runtime_value_store.RuntimeValueStore) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name:      GetServicesBuiltinName,
+			Arguments: []*builtin_argument.BuiltinArgument{},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &GetServicesCapabilities{
+				serviceNetwork:    serviceNetwork,
+				runtimeValueStore: runtimeValueStore,
+
+				serviceNames:            nil, // populated at interpretation time
+				resultUuidByServiceName: nil, // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{},
+	}
+}
+
+type GetServicesCapabilities struct {
+	serviceNetwork    service_network.ServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+
+	// The services that existed in the enclave at interpretation time, sorted by name
+	serviceNames []service.ServiceName
+
+	resultUuidByServiceName map[service.ServiceName]string
+}
+
+func (builtin *GetServicesCapabilities) Interpret(_ *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	builtin.serviceNames = []service.ServiceName{}
+	for serviceName := range builtin.serviceNetwork.GetServiceNames() {
+		builtin.serviceNames = append(builtin.serviceNames, serviceName)
+	}
+	sort.Slice(builtin.serviceNames, func(i, j int) bool {
+		return builtin.serviceNames[i] < builtin.serviceNames[j]
+	})
+
+	builtin.resultUuidByServiceName = map[service.ServiceName]string{}
+	returnValues := []starlark.Value{}
+	for _, serviceName := range builtin.serviceNames {
+		resultUuid, err := builtin.runtimeValueStore.CreateValue()
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", GetServicesBuiltinName)
+		}
+		builtin.resultUuidByServiceName[serviceName] = resultUuid
+
+		returnValue, interpretationErr := makeGetServiceInterpretationReturnValue(builtin.serviceNetwork, serviceName, resultUuid)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		returnValues = append(returnValues, returnValue)
+	}
+	return starlark.NewList(returnValues), nil
+}
+
+func (builtin *GetServicesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, serviceName := range builtin.serviceNames {
+		if !validatorEnvironment.DoesServiceNameExist(serviceName) {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as service '%s' no longer exists at this point of the plan", GetServicesBuiltinName, serviceName)
+		}
+	}
+	return nil
+}
+
+func (builtin *GetServicesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	serviceNameStrs := []string{}
+	for _, serviceName := range builtin.serviceNames {
+		if err := fillGetServiceReturnValueWithRuntimeValues(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, serviceName, builtin.resultUuidByServiceName[serviceName]); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred resolving the values of service '%s'", serviceName)
+		}
+		serviceNameStrs = append(serviceNameStrs, string(serviceName))
+	}
+	if len(serviceNameStrs) == 0 {
+		return "Fetched no services as the enclave has none", nil
+	}
+	instructionResult := fmt.Sprintf("Fetched services '%s'", strings.Join(serviceNameStrs, "', '"))
+	return instructionResult, nil
+}
@@ -2,7 +2,9 @@ package plan_module
 
 import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/conditional"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"go.starlark.net/starlark"
 	"go.starlark.net/starlarkstruct"
 )
@@ -14,12 +16,15 @@ const (
 func PlanModule(
 	instructionsQueue *[]kurtosis_instruction.KurtosisInstruction,
 	kurtosisPlanInstructions []*kurtosis_plan_instruction.KurtosisPlanInstruction,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 ) *starlarkstruct.Module {
 	moduleBuiltins := starlark.StringDict{}
 	for _, planInstruction := range kurtosisPlanInstructions {
 		wrappedPlanInstruction := kurtosis_plan_instruction.NewKurtosisPlanInstructionWrapper(planInstruction, instructionsQueue)
 		moduleBuiltins[planInstruction.GetName()] = starlark.NewBuiltin(planInstruction.GetName(), wrappedPlanInstruction.CreateBuiltin())
 	}
+	// if_ captures the instructions of its branches, so it can't be a regular KurtosisPlanInstruction
+	moduleBuiltins[conditional.IfBuiltinName] = starlark.NewBuiltin(conditional.IfBuiltinName, conditional.NewIfBuiltin(instructionsQueue, runtimeValueStore))
 
 	return &starlarkstruct.Module{
 		Name:    planModuleName,
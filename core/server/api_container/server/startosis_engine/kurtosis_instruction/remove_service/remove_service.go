@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/dependency_recovery"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
@@ -81,6 +82,7 @@ func (builtin *RemoveServiceCapabilities) Execute(ctx context.Context, _ *builti
 	if err != nil {
 		return "", stacktrace.Propagate(err, "Failed removing service with unexpected error")
 	}
+	dependency_recovery.GetDependencyRecoveryRegistry().UnregisterService(builtin.serviceName)
 	instructionResult := fmt.Sprintf("Service '%s' with service UUID '%s' removed", builtin.serviceName, serviceUUID)
 	return instructionResult, nil
 }
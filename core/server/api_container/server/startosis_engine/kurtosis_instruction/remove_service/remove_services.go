@@ -0,0 +1,126 @@
+package remove_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/dependency_recovery"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	RemoveServicesBuiltinName = "remove_services"
+
+	ServiceNamesArgName = "names"
+)
+
+func NewRemoveServices(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RemoveServicesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNamesArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &RemoveServicesCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceNames: nil, // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNamesArgName: true,
+		},
+	}
+}
+
+type RemoveServicesCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceNames []service.ServiceName
+}
+
+func (builtin *RemoveServicesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceNamesList, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, ServiceNamesArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNamesArgName)
+	}
+	if serviceNamesList.Len() == 0 {
+		return nil, startosis_errors.NewInterpretationError("'%s' argument should list at least one service", ServiceNamesArgName)
+	}
+	serviceNames := make([]service.ServiceName, 0, serviceNamesList.Len())
+	serviceNameSet := map[service.ServiceName]bool{}
+	for idx := 0; idx < serviceNamesList.Len(); idx++ {
+		serviceNameStr, ok := serviceNamesList.Index(idx).(starlark.String)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("'%s' argument should only contain strings but item at index '%d' was a '%s'", ServiceNamesArgName, idx, serviceNamesList.Index(idx).Type())
+		}
+		serviceName := service.ServiceName(serviceNameStr.GoString())
+		if serviceNameSet[serviceName] {
+			return nil, startosis_errors.NewInterpretationError("'%s' argument lists service '%s' more than once", ServiceNamesArgName, serviceName)
+		}
+		serviceNameSet[serviceName] = true
+		serviceNames = append(serviceNames, serviceName)
+	}
+	builtin.serviceNames = serviceNames
+	return starlark.None, nil
+}
+
+func (builtin *RemoveServicesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	for _, serviceName := range builtin.serviceNames {
+		if !validatorEnvironment.DoesServiceNameExist(serviceName) {
+			return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", RemoveServicesBuiltinName, serviceName)
+		}
+	}
+	for _, serviceName := range builtin.serviceNames {
+		validatorEnvironment.RemoveServiceName(serviceName)
+	}
+	return nil
+}
+
+func (builtin *RemoveServicesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	serviceIdentifiers := map[string]bool{}
+	for _, serviceName := range builtin.serviceNames {
+		serviceIdentifiers[string(serviceName)] = true
+	}
+	removedServiceUuids, failedServices, err := builtin.serviceNetwork.RemoveServices(ctx, serviceIdentifiers)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed removing services '%v' with unexpected error", builtin.serviceNames)
+	}
+	for serviceName := range removedServiceUuids {
+		dependency_recovery.GetDependencyRecoveryRegistry().UnregisterService(serviceName)
+	}
+	if len(failedServices) > 0 {
+		failedServiceErrStrs := []string{}
+		for serviceName, serviceErr := range failedServices {
+			failedServiceErrStrs = append(failedServiceErrStrs, fmt.Sprintf("Service '%s' error:\n%v", serviceName, serviceErr))
+		}
+		sort.Strings(failedServiceErrStrs)
+		return "", stacktrace.NewError("Some errors occurred removing %d of the %d services; errors were:\n%s", len(failedServices), len(builtin.serviceNames), strings.Join(failedServiceErrStrs, "\n"))
+	}
+
+	instructionResult := strings.Builder{}
+	instructionResult.WriteString(fmt.Sprintf("Successfully removed the following '%d' services:", len(removedServiceUuids)))
+	for _, serviceName := range builtin.serviceNames {
+		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' with service UUID '%s' removed", serviceName, removedServiceUuids[serviceName]))
+	}
+	return instructionResult.String(), nil
+}
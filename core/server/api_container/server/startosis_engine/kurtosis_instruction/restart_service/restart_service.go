@@ -0,0 +1,94 @@
+package restart_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/dependency_recovery"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"strings"
+)
+
+const (
+	RestartServiceBuiltinName = "restart_service"
+
+	ServiceNameArgName = "service_name"
+)
+
+func NewRestartService(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RestartServiceBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &RestartServiceCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName: "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+		},
+	}
+}
+
+type RestartServiceCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName service.ServiceName
+}
+
+func (builtin *RestartServiceCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	return starlark.None, nil
+}
+
+func (builtin *RestartServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", RestartServiceBuiltinName, builtin.serviceName)
+	}
+	return nil
+}
+
+// Execute restarts the service, waits for its ready conditions and then recovers the services depending on it as set by
+// their 'on_dependency_recovery' attribute
+func (builtin *RestartServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	recoveredDependents, err := dependency_recovery.GetDependencyRecoveryRegistry().RestartAndRecover(ctx, builtin.serviceName, func(ctx context.Context, serviceName service.ServiceName) error {
+		return builtin.serviceNetwork.RestartService(ctx, string(serviceName))
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed restarting service '%v' and recovering its dependents", builtin.serviceName)
+	}
+	instructionResult := strings.Builder{}
+	instructionResult.WriteString(fmt.Sprintf("Service '%s' restarted", builtin.serviceName))
+	for _, recoveredDependent := range recoveredDependents {
+		instructionResult.WriteString(fmt.Sprintf("\n  Dependent recovered: %s", recoveredDependent))
+	}
+	return instructionResult.String(), nil
+}
@@ -0,0 +1,379 @@
+package seed_database
+
+import (
+	"context"
+	"fmt"
+	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	SeedDatabaseBuiltinName = "seed_database"
+
+	ServiceNameArgName = "service_name"
+	FilesArgName       = "files"
+	EngineArgName      = "engine"
+	UserArgName        = "user"
+	PasswordArgName    = "password"
+	DatabaseArgName    = "database"
+	TimeoutArgName     = "timeout"
+
+	PostgresEngine = "postgres"
+	MysqlEngine    = "mysql"
+
+	defaultPostgresUser     = "postgres"
+	defaultPostgresDatabase = "postgres"
+	defaultMysqlUser        = "root"
+
+	defaultTimeout = 2 * time.Minute
+
+	// Where the files artifacts get copied to in the database container, each in a directory named after its index
+	SeedFilesDirpath = "/tmp/kurtosis-seed-database"
+
+	sqlFilesPattern = "*.sql"
+
+	// The databases get reached over TCP rather than over their socket, as the official images start a server only
+	// listening on the socket while they initialize, which would let the SQL files be applied to a database that then
+	// gets restarted
+	databaseHost = "127.0.0.1"
+
+	readinessCheckInterval = 1 * time.Second
+
+	successExitCode = 0
+)
+
+// NewSeedDatabase waits for a database to accept connections and then applies the SQL files of files artifacts to it,
+// each in its own transaction, which covers the common 'create the schema and load some data' step of setting up an
+// enclave without having to script it with exec
+func NewSeedDatabase(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SeedDatabaseBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              FilesArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, err := getFilesArtifactNames(value)
+						return err
+					},
+				},
+				{
+					Name:              EngineArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, EngineArgName, []string{PostgresEngine, MysqlEngine})
+					},
+				},
+				{
+					Name:              UserArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, UserArgName)
+					},
+				},
+				{
+					Name:              PasswordArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+				{
+					Name:              DatabaseArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+				{
+					Name:              TimeoutArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &SeedDatabaseCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName:        "",  // populated at interpretation time
+				filesArtifactNames: nil, // populated at interpretation time
+				engine:             "",  // populated at interpretation time
+				user:               "",  // populated at interpretation time
+				password:           "",  // populated at interpretation time
+				database:           "",  // populated at interpretation time
+				timeout:            0,   // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			FilesArgName:       true,
+			EngineArgName:      true,
+			DatabaseArgName:    true,
+		},
+	}
+}
+
+type SeedDatabaseCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName        kurtosis_backend_service.ServiceName
+	filesArtifactNames []string
+	engine             string
+	user               string
+	password           string
+	database           string
+	timeout            time.Duration
+}
+
+func (builtin *SeedDatabaseCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	files, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, FilesArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", FilesArgName)
+	}
+	filesArtifactNames, interpretationErr := getFilesArtifactNames(files)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	builtin.engine = PostgresEngine
+	if arguments.IsSet(EngineArgName) {
+		engine, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, EngineArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", EngineArgName)
+		}
+		builtin.engine = engine.GoString()
+	}
+	builtin.user = defaultPostgresUser
+	builtin.database = defaultPostgresDatabase
+	if builtin.engine == MysqlEngine {
+		builtin.user = defaultMysqlUser
+		// MySQL has no database to connect to by default, the files then have to select theirs with USE
+		builtin.database = ""
+	}
+	if arguments.IsSet(UserArgName) {
+		user, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, UserArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", UserArgName)
+		}
+		builtin.user = user.GoString()
+	}
+	if arguments.IsSet(PasswordArgName) {
+		password, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, PasswordArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", PasswordArgName)
+		}
+		builtin.password = password.GoString()
+	}
+	if arguments.IsSet(DatabaseArgName) {
+		database, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, DatabaseArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", DatabaseArgName)
+		}
+		builtin.database = database.GoString()
+	}
+	builtin.timeout = defaultTimeout
+	if arguments.IsSet(TimeoutArgName) {
+		timeoutStr, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, TimeoutArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", TimeoutArgName)
+		}
+		timeout, parseErr := time.ParseDuration(timeoutStr.GoString())
+		if parseErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing timeout '%v'", timeoutStr.GoString())
+		}
+		builtin.timeout = timeout
+	}
+
+	builtin.serviceName = kurtosis_backend_service.ServiceName(serviceName.GoString())
+	builtin.filesArtifactNames = filesArtifactNames
+	return starlark.None, nil
+}
+
+func (builtin *SeedDatabaseCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' with service name '%v' that does not exist", SeedDatabaseBuiltinName, builtin.serviceName)
+	}
+	for _, artifactName := range builtin.filesArtifactNames {
+		if !validatorEnvironment.DoesArtifactNameExist(artifactName) {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as artifact name '%s' does not exist", SeedDatabaseBuiltinName, artifactName)
+		}
+	}
+	return nil
+}
+
+func (builtin *SeedDatabaseCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.waitForDatabase(ctx); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred waiting for the database of service '%s' to accept connections", builtin.serviceName)
+	}
+
+	if _, err := builtin.execInDatabaseService(ctx, []string{"rm", "-rf", SeedFilesDirpath}); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred cleaning up the SQL files of a previous seeding of service '%s'", builtin.serviceName)
+	}
+	defer func() {
+		if _, err := builtin.execInDatabaseService(ctx, []string{"rm", "-rf", SeedFilesDirpath}); err != nil {
+			logrus.Warnf("An error occurred cleaning up the SQL files copied to service '%s':\n%v", builtin.serviceName, err)
+		}
+	}()
+
+	appliedFilepaths := []string{}
+	for artifactIndex, artifactName := range builtin.filesArtifactNames {
+		artifactDirpath := path.Join(SeedFilesDirpath, fmt.Sprint(artifactIndex))
+		sqlFilepaths, err := builtin.copySqlFilesToDatabaseService(ctx, artifactName, artifactDirpath)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred copying the SQL files of files artifact '%s' to service '%s'", artifactName, builtin.serviceName)
+		}
+		for _, sqlFilepath := range sqlFilepaths {
+			relativeSqlFilepath := strings.TrimPrefix(sqlFilepath, artifactDirpath+"/")
+			exitCode, output, err := builtin.serviceNetwork.ExecCommand(ctx, string(builtin.serviceName), builtin.getApplySqlFileCommand(sqlFilepath))
+			if err != nil {
+				return "", stacktrace.Propagate(err, "An error occurred applying SQL file '%s' of files artifact '%s' to service '%s'", relativeSqlFilepath, artifactName, builtin.serviceName)
+			}
+			if exitCode != successExitCode {
+				return "", stacktrace.NewError(
+					"Applying SQL file '%s' of files artifact '%s' to service '%s' failed with exit code '%d', so its transaction got rolled back; the %d files before it were applied: %v. The output was:\n%s",
+					relativeSqlFilepath,
+					artifactName,
+					builtin.serviceName,
+					exitCode,
+					len(appliedFilepaths),
+					appliedFilepaths,
+					output,
+				)
+			}
+			appliedFilepaths = append(appliedFilepaths, fmt.Sprintf("%s/%s", artifactName, relativeSqlFilepath))
+		}
+	}
+	instructionResult := fmt.Sprintf("Applied %d SQL files to the database of service '%s': %v", len(appliedFilepaths), builtin.serviceName, appliedFilepaths)
+	return instructionResult, nil
+}
+
+// waitForDatabase runs a query until the database answers it, as the database may still be starting up
+func (builtin *SeedDatabaseCapabilities) waitForDatabase(ctx context.Context) error {
+	readinessCommand := builtin.getRunSqlCommand([]string{"-e", "SELECT 1"})
+	if builtin.engine == PostgresEngine {
+		readinessCommand = builtin.getRunSqlCommand([]string{"-c", "SELECT 1"})
+	}
+	deadline := time.Now().Add(builtin.timeout)
+	for {
+		exitCode, output, err := builtin.serviceNetwork.ExecCommand(ctx, string(builtin.serviceName), readinessCommand)
+		if err == nil && exitCode == successExitCode {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return stacktrace.Propagate(err, "The database still didn't accept connections after %v", builtin.timeout)
+			}
+			return stacktrace.NewError("The database still didn't accept connections after %v; the last attempt exited with code '%d' and output:\n%s", builtin.timeout, exitCode, output)
+		}
+		select {
+		case <-ctx.Done():
+			return stacktrace.Propagate(ctx.Err(), "The wait for the database to accept connections got cancelled")
+		case <-time.After(readinessCheckInterval):
+		}
+	}
+}
+
+// copySqlFilesToDatabaseService copies the files artifact to the given directory of the database service, and returns
+// the paths of the SQL files it contains there, in the order they should be applied in
+func (builtin *SeedDatabaseCapabilities) copySqlFilesToDatabaseService(ctx context.Context, artifactName string, artifactDirpath string) ([]string, error) {
+	if _, err := builtin.execInDatabaseService(ctx, []string{"mkdir", "-p", artifactDirpath}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating directory '%s'", artifactDirpath)
+	}
+	if err := builtin.serviceNetwork.CopyFilesArtifactToService(ctx, string(builtin.serviceName), artifactName, artifactDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying files artifact '%s' to directory '%s'", artifactName, artifactDirpath)
+	}
+	output, err := builtin.execInDatabaseService(ctx, []string{"find", artifactDirpath, "-type", "f", "-name", sqlFilesPattern})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the SQL files in directory '%s'", artifactDirpath)
+	}
+	sqlFilepaths := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if sqlFilepath := strings.TrimSpace(line); sqlFilepath != "" {
+			sqlFilepaths = append(sqlFilepaths, sqlFilepath)
+		}
+	}
+	if len(sqlFilepaths) == 0 {
+		return nil, stacktrace.NewError("Files artifact '%s' doesn't contain any file matching '%s'", artifactName, sqlFilesPattern)
+	}
+	sort.Strings(sqlFilepaths)
+	return sqlFilepaths, nil
+}
+
+// getApplySqlFileCommand returns the command applying the SQL file in a single transaction, stopping at the first error
+func (builtin *SeedDatabaseCapabilities) getApplySqlFileCommand(sqlFilepath string) []string {
+	if builtin.engine == MysqlEngine {
+		return builtin.getRunSqlCommand([]string{"-e", fmt.Sprintf("START TRANSACTION; source %s; COMMIT;", sqlFilepath)})
+	}
+	return builtin.getRunSqlCommand([]string{"-v", "ON_ERROR_STOP=1", "--single-transaction", "-q", "-f", sqlFilepath})
+}
+
+// getRunSqlCommand returns the command running the client of the database with the given args, passing the password
+// through the environment for it not to end up in the output
+func (builtin *SeedDatabaseCapabilities) getRunSqlCommand(clientArgs []string) []string {
+	var command []string
+	if builtin.engine == MysqlEngine {
+		command = []string{"env", "MYSQL_PWD=" + builtin.password, "mysql", "-h", databaseHost, "-u", builtin.user}
+		if builtin.database != "" {
+			command = append(command, "-D", builtin.database)
+		}
+	} else {
+		command = []string{"env", "PGPASSWORD=" + builtin.password, "psql", "-h", databaseHost, "-U", builtin.user, "-d", builtin.database}
+	}
+	return append(command, clientArgs...)
+}
+
+func (builtin *SeedDatabaseCapabilities) execInDatabaseService(ctx context.Context, command []string) (string, error) {
+	exitCode, output, err := builtin.serviceNetwork.ExecCommand(ctx, string(builtin.serviceName), command)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred running '%v'", command)
+	}
+	if exitCode != successExitCode {
+		return "", stacktrace.NewError("'%v' exited with code '%d' and output:\n%s", command, exitCode, output)
+	}
+	return output, nil
+}
+
+func getFilesArtifactNames(value starlark.Value) ([]string, *startosis_errors.InterpretationError) {
+	filesArtifactNames, interpretationErr := kurtosis_types.SafeCastToStringSlice(value, FilesArgName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if len(filesArtifactNames) == 0 {
+		return nil, startosis_errors.NewInterpretationError("The '%s' argument must contain at least one files artifact", FilesArgName)
+	}
+	return filesArtifactNames, nil
+}
@@ -0,0 +1,144 @@
+package send_signal
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	SendSignalBuiltinName = "send_signal"
+
+	ServiceNameArgName = "service_name"
+	SignalArgName      = "signal"
+
+	signalPrefix = "SIG"
+)
+
+// The signals a service's main process can be sent, e.g. to make it reload its config with SIGHUP
+var supportedSignals = map[string]bool{
+	"SIGHUP":   true,
+	"SIGINT":   true,
+	"SIGQUIT":  true,
+	"SIGUSR1":  true,
+	"SIGUSR2":  true,
+	"SIGTERM":  true,
+	"SIGKILL":  true,
+	"SIGSTOP":  true,
+	"SIGCONT":  true,
+	"SIGALRM":  true,
+	"SIGWINCH": true,
+}
+
+func NewSendSignal(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SendSignalBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              SignalArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateSignal(value)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &SendSignalCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName: "", // populated at interpretation time
+				signal:      "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			SignalArgName:      true,
+		},
+	}
+}
+
+type SendSignalCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName service.ServiceName
+	signal      string
+}
+
+func (builtin *SendSignalCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	signal, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, SignalArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SignalArgName)
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.signal = normalizeSignal(signal.GoString())
+	return starlark.None, nil
+}
+
+func (builtin *SendSignalCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", SendSignalBuiltinName, builtin.serviceName)
+	}
+	return nil
+}
+
+func (builtin *SendSignalCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.SendSignalToService(ctx, string(builtin.serviceName), builtin.signal); err != nil {
+		return "", stacktrace.Propagate(err, "Failed sending signal '%v' to service '%v' with unexpected error", builtin.signal, builtin.serviceName)
+	}
+	instructionResult := fmt.Sprintf("Signal '%s' sent to service '%s'", builtin.signal, builtin.serviceName)
+	return instructionResult, nil
+}
+
+// normalizeSignal accepts the signal names with or without the 'SIG' prefix, in any case, e.g. 'hup' becomes 'SIGHUP'
+func normalizeSignal(signal string) string {
+	normalizedSignal := strings.ToUpper(strings.TrimSpace(signal))
+	if !strings.HasPrefix(normalizedSignal, signalPrefix) {
+		normalizedSignal = signalPrefix + normalizedSignal
+	}
+	return normalizedSignal
+}
+
+func validateSignal(value starlark.Value) *startosis_errors.InterpretationError {
+	signal, ok := value.(starlark.String)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be a starlark.String but was '%s'", SignalArgName, value.Type())
+	}
+	if supportedSignals[normalizeSignal(signal.GoString())] {
+		return nil
+	}
+	supportedSignalNames := []string{}
+	for supportedSignal := range supportedSignals {
+		supportedSignalNames = append(supportedSignalNames, supportedSignal)
+	}
+	sort.Strings(supportedSignalNames)
+	return startosis_errors.NewInterpretationError("Signal '%s' is not supported; supported signals are '%s'", signal.GoString(), strings.Join(supportedSignalNames, "', '"))
+}
@@ -2,17 +2,28 @@ package shared_helpers
 
 import (
 	"context"
+	"fmt"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/execution_progress"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/assert"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
+	"strings"
 	"time"
 )
 
+const (
+	// How often waits report how they're doing, so users can tell a slow service from a wrong assertion
+	waitProgressReportInterval = 5 * time.Second
+
+	maxProgressExcerptLength = 200
+	progressExcerptEllipsis  = "..."
+)
+
 func ExecuteServiceAssertionWithRecipe(
 	ctx context.Context,
 	serviceNetwork service_network.ServiceNetwork,
@@ -33,6 +44,7 @@ func ExecuteServiceAssertionWithRecipe(
 	startTime := time.Now()
 
 	backoffObj := backoff.NewConstantBackOff(interval)
+	lastProgressReportTime := startTime
 
 	//TODO check if we can refactor this portion in order to use the time.Ticker(backoffDuration) pattern here:
 	for {
@@ -42,9 +54,16 @@ func ExecuteServiceAssertionWithRecipe(
 			timedOut = true
 			break
 		}
+		if ctx.Err() != nil {
+			return lastResult, tries, stacktrace.Propagate(ctx.Err(), "Stopped waiting for the assertion to become valid on service '%v' after '%v'", serviceName, time.Since(startTime))
+		}
 		lastResult, requestErr = recipe.Execute(ctx, serviceNetwork, runtimeValueStore, serviceName)
 		if requestErr != nil {
-			time.Sleep(backoffDuration)
+			if time.Since(lastProgressReportTime) >= waitProgressReportInterval {
+				reportWaitProgress(ctx, serviceName, tries, startTime, timeout, requestErr, "")
+				lastProgressReportTime = time.Now()
+			}
+			sleepUnlessCancelled(ctx, backoffDuration)
 			continue
 		}
 		value, found := lastResult[valueField]
@@ -55,7 +74,11 @@ func ExecuteServiceAssertionWithRecipe(
 		if assertErr == nil {
 			break
 		}
-		time.Sleep(backoffDuration)
+		if time.Since(lastProgressReportTime) >= waitProgressReportInterval {
+			reportWaitProgress(ctx, serviceName, tries, startTime, timeout, assertErr, recipe.ResultMapToString(lastResult))
+			lastProgressReportTime = time.Now()
+		}
+		sleepUnlessCancelled(ctx, backoffDuration)
 	}
 	if timedOut {
 		return lastResult, tries, stacktrace.NewError("Recipe execution timed-out waiting for the assertion to become valid on service '%v'. Waited for '%v'. Last assertion error was: \n%v", serviceName, time.Since(startTime), assertErr)
@@ -69,3 +92,61 @@ func ExecuteServiceAssertionWithRecipe(
 
 	return lastResult, tries, nil
 }
+
+// WaitForServicePortAvailability polls the private port of the service until it accepts connections, returning how
+// many tries it took
+func WaitForServicePortAvailability(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	serviceName service.ServiceName,
+	portId string,
+	interval time.Duration,
+	timeout time.Duration,
+) (int, error) {
+	tries := 0
+	startTime := time.Now()
+	for {
+		tries += 1
+		if ctx.Err() != nil {
+			return tries, stacktrace.Propagate(ctx.Err(), "Stopped waiting for port '%v' of service '%v' to become available after '%v'", portId, serviceName, time.Since(startTime))
+		}
+		availabilityErr := serviceNetwork.CheckServicePortAvailability(ctx, string(serviceName), portId)
+		if availabilityErr == nil {
+			return tries, nil
+		}
+		if time.Since(startTime) > timeout {
+			return tries, stacktrace.Propagate(availabilityErr, "Timed-out waiting for port '%v' of service '%v' to become available. Waited for '%v'", portId, serviceName, time.Since(startTime))
+		}
+		sleepUnlessCancelled(ctx, interval)
+	}
+}
+
+// reportWaitProgress reports how many tries a wait took so far, why the last one failed and, if the recipe ran, an
+// excerpt of its last response
+func reportWaitProgress(ctx context.Context, serviceName service.ServiceName, tries int, startTime time.Time, timeout time.Duration, lastErr error, lastResponse string) {
+	progressLines := []string{
+		fmt.Sprintf("Waiting on service '%v': %d tries in %v (times out after %v)", serviceName, tries, time.Since(startTime).Round(time.Second), timeout),
+		fmt.Sprintf("Last failure: %s", getProgressExcerpt(stacktrace.RootCause(lastErr).Error())),
+	}
+	if lastResponse != "" {
+		progressLines = append(progressLines, fmt.Sprintf("Last response: %s", getProgressExcerpt(lastResponse)))
+	}
+	execution_progress.Report(ctx, progressLines...)
+}
+
+// getProgressExcerpt fits the string on a single, reasonably short progress line
+func getProgressExcerpt(str string) string {
+	excerpt := []rune(strings.Join(strings.Fields(str), " "))
+	if len(excerpt) <= maxProgressExcerptLength {
+		return string(excerpt)
+	}
+	return string(excerpt[:maxProgressExcerptLength-len(progressExcerptEllipsis)]) + progressExcerptEllipsis
+}
+
+// sleepUnlessCancelled returns early if the context gets cancelled, e.g. once another ready condition of the service passed
+func sleepUnlessCancelled(ctx context.Context, duration time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(duration):
+	}
+}
@@ -0,0 +1,15 @@
+package shared_helpers
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestGetProgressExcerpt(t *testing.T) {
+	require.Equal(t, "Request had response code '503' and body { \"status\": \"starting\" }", getProgressExcerpt("Request had response code '503' and body {\n  \"status\": \"starting\"\n}"))
+
+	excerpt := getProgressExcerpt(strings.Repeat("é", 2*maxProgressExcerptLength))
+	require.Equal(t, maxProgressExcerptLength, len([]rune(excerpt)))
+	require.True(t, strings.HasSuffix(excerpt, progressExcerptEllipsis))
+}
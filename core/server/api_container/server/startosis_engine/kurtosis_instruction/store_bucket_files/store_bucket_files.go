@@ -0,0 +1,184 @@
+package store_bucket_files
+
+import (
+	"context"
+	"fmt"
+	kurtosis_backend_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"strings"
+)
+
+const (
+	StoreBucketFilesBuiltinName = "store_bucket_files"
+
+	ServiceNameArgName  = "service_name"
+	BucketArgName       = "bucket"
+	PrefixArgName       = "prefix"
+	ArtifactNameArgName = "name"
+
+	// Where the objects get downloaded to in the storage container, before being copied to the files artifact
+	exportDirpath = "/tmp/kurtosis-bucket-export"
+
+	successExitCode = 0
+)
+
+// NewStoreBucketFiles copies the objects of a bucket of an object storage started by add_object_storage into a files
+// artifact, so that what the services of the enclave wrote there can be mounted in other services or downloaded
+func NewStoreBucketFiles(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: StoreBucketFilesBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              BucketArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, BucketArgName)
+					},
+				},
+				{
+					Name:              PrefixArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+				{
+					Name:              ArtifactNameArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &StoreBucketFilesCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName:  "", // populated at interpretation time
+				bucket:       "", // populated at interpretation time
+				prefix:       "", // populated at interpretation time
+				artifactName: "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName:  true,
+			BucketArgName:       true,
+			PrefixArgName:       true,
+			ArtifactNameArgName: true,
+		},
+	}
+}
+
+type StoreBucketFilesCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName  kurtosis_backend_service.ServiceName
+	bucket       string
+	prefix       string
+	artifactName string
+}
+
+func (builtin *StoreBucketFilesCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	if !arguments.IsSet(ArtifactNameArgName) {
+		natureThemeName, err := builtin.serviceNetwork.GetUniqueNameForFileArtifact()
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to auto generate name '%s' argument", ArtifactNameArgName)
+		}
+		builtin.artifactName = natureThemeName
+	} else {
+		artifactName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ArtifactNameArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ArtifactNameArgName)
+		}
+		builtin.artifactName = artifactName.GoString()
+	}
+
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	bucket, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, BucketArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", BucketArgName)
+	}
+	if arguments.IsSet(PrefixArgName) {
+		prefix, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, PrefixArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", PrefixArgName)
+		}
+		builtin.prefix = strings.TrimPrefix(prefix.GoString(), "/")
+	}
+
+	builtin.serviceName = kurtosis_backend_service.ServiceName(serviceName.GoString())
+	builtin.bucket = bucket.GoString()
+	return starlark.String(builtin.artifactName), nil
+}
+
+func (builtin *StoreBucketFilesCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' with service name '%v' that does not exist", StoreBucketFilesBuiltinName, builtin.serviceName)
+	}
+	if validatorEnvironment.DoesArtifactNameExist(builtin.artifactName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as artifact name '%v' already exists", StoreBucketFilesBuiltinName, builtin.artifactName)
+	}
+	validatorEnvironment.AddArtifactName(builtin.artifactName)
+	return nil
+}
+
+func (builtin *StoreBucketFilesCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	src := fmt.Sprintf("%s/%s/%s", add_service.ObjectStorageClientAlias, builtin.bucket, builtin.prefix)
+	exportCommands := [][]string{
+		{"rm", "-rf", exportDirpath},
+		{"mkdir", "-p", exportDirpath},
+		{"mc", "cp", "--recursive", src, exportDirpath + "/"},
+	}
+	for _, exportCommand := range exportCommands {
+		if err := builtin.execInStorageService(ctx, exportCommand); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred downloading '%s' in object storage service '%s'", src, builtin.serviceName)
+		}
+	}
+	defer func() {
+		if err := builtin.execInStorageService(ctx, []string{"rm", "-rf", exportDirpath}); err != nil {
+			logrus.Warnf("An error occurred cleaning up the objects downloaded from '%s' in object storage service '%s':\n%v", src, builtin.serviceName, err)
+		}
+	}()
+
+	artifactUuid, err := builtin.serviceNetwork.CopyFilesFromService(ctx, string(builtin.serviceName), exportDirpath, builtin.artifactName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to copy the objects of '%s' from object storage service '%s'", src, builtin.serviceName)
+	}
+	instructionResult := fmt.Sprintf("Files of bucket '%s' with artifact name '%s' uploaded with artifact UUID '%s'", builtin.bucket, builtin.artifactName, artifactUuid)
+	return instructionResult, nil
+}
+
+func (builtin *StoreBucketFilesCapabilities) execInStorageService(ctx context.Context, command []string) error {
+	exitCode, output, err := builtin.serviceNetwork.ExecCommand(ctx, string(builtin.serviceName), command)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running '%v'", command)
+	}
+	if exitCode != successExitCode {
+		return stacktrace.NewError("'%v' exited with code '%d' and output:\n%s", command, exitCode, output)
+	}
+	return nil
+}
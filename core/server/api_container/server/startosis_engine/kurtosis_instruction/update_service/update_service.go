@@ -107,6 +107,9 @@ func (builtin *UpdateServiceCapabilities) Validate(_ *builtin_argument.ArgumentV
 	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
 		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' does not exist", UpdateServiceBuiltinName, builtin.serviceName)
 	}
+	if builtin.updateServiceConfig.ContainerImageName != nil {
+		validatorEnvironment.AppendRequiredContainerImage(builtin.updateServiceConfig.GetContainerImageName())
+	}
 	return nil
 }
 
@@ -125,7 +128,7 @@ func (builtin *UpdateServiceCapabilities) Execute(ctx context.Context, _ *builti
 		return "", stacktrace.Propagate(err, "Failed updating service '%s' with an unexpected error", builtin.serviceName)
 	}
 	if failure, found := serviceFailed[builtin.serviceName]; found {
-		return "", stacktrace.Propagate(failure, "Failed updating service '%s'", builtin.serviceNetwork)
+		return "", stacktrace.Propagate(failure, "Failed updating service '%s'", builtin.serviceName)
 	}
 	_, found := serviceSuccessful[builtin.serviceName]
 	if !found {
@@ -0,0 +1,156 @@
+package wait_for_port
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"time"
+)
+
+const (
+	WaitForPortBuiltinName = "wait_for_port"
+
+	ServiceNameArgName = "service_name"
+	PortIdArgName      = "port_id"
+	IntervalArgName    = "interval"
+	TimeoutArgName     = "timeout"
+
+	defaultInterval = 1 * time.Second
+	defaultTimeout  = 2 * time.Minute
+)
+
+func NewWaitForPort(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: WaitForPortBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              PortIdArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdArgName)
+					},
+				},
+				{
+					Name:              IntervalArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, IntervalArgName)
+					},
+				},
+				{
+					Name:              TimeoutArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutArgName)
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &WaitForPortCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName: "", // populated at interpretation time
+				portId:      "", // populated at interpretation time
+				interval:    0,  // populated at interpretation time
+				timeout:     0,  // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName: true,
+			PortIdArgName:      true,
+			IntervalArgName:    false,
+			TimeoutArgName:     false,
+		},
+	}
+}
+
+type WaitForPortCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName service.ServiceName
+	portId      string
+	interval    time.Duration
+	timeout     time.Duration
+}
+
+func (builtin *WaitForPortCapabilities) Interpret(arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	portId, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, PortIdArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", PortIdArgName)
+	}
+	interval, interpretationErr := extractDurationArgumentValue(arguments, IntervalArgName, defaultInterval)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	timeout, interpretationErr := extractDurationArgumentValue(arguments, TimeoutArgName, defaultTimeout)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.portId = portId.GoString()
+	builtin.interval = interval
+	builtin.timeout = timeout
+	return starlark.None, nil
+}
+
+func (builtin *WaitForPortCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if !validatorEnvironment.DoesServiceNameExist(builtin.serviceName) {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", WaitForPortBuiltinName, builtin.serviceName)
+	}
+	return nil
+}
+
+func (builtin *WaitForPortCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	startTime := time.Now()
+	tries, err := shared_helpers.WaitForServicePortAvailability(ctx, builtin.serviceNetwork, builtin.serviceName, builtin.portId, builtin.interval, builtin.timeout)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred waiting for port '%v' of service '%v' to become available", builtin.portId, builtin.serviceName)
+	}
+	instructionResult := fmt.Sprintf("Port '%s' of service '%s' became available after %d tries (%v in total)", builtin.portId, builtin.serviceName, tries, time.Since(startTime).Round(time.Millisecond))
+	return instructionResult, nil
+}
+
+func extractDurationArgumentValue(arguments *builtin_argument.ArgumentValuesSet, argName string, defaultDuration time.Duration) (time.Duration, *startosis_errors.InterpretationError) {
+	if !arguments.IsSet(argName) {
+		return defaultDuration, nil
+	}
+	durationStr, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, argName)
+	if err != nil {
+		return 0, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", argName)
+	}
+	duration, parseErr := time.ParseDuration(durationStr.GoString())
+	if parseErr != nil {
+		return 0, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing %s '%v'", argName, durationStr.GoString())
+	}
+	return duration, nil
+}
@@ -3,6 +3,7 @@ package kurtosis_starlark_framework
 import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_deprecations"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
 )
@@ -39,6 +40,11 @@ func WrapKurtosisBaseBuiltin(baseBuiltin *KurtosisBaseBuiltin, thread *starlark.
 	callFrame := thread.CallStack().At(1)
 	position := NewKurtosisBuiltinPosition(callFrame.Pos.Filename(), callFrame.Pos.Line, callFrame.Pos.Col)
 
+	// Third check the deprecated features used by the call, which fails it if the run is strict
+	if interpretationErr = startosis_deprecations.CheckBuiltinCall(thread, baseBuiltin.Name, arguments.IsSet, position.ToAPIType()); interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
 	return &KurtosisBaseBuiltinInternal{
 		builtinName: baseBuiltin.Name,
 		position:    position,
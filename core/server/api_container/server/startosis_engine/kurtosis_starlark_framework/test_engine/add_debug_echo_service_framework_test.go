@@ -0,0 +1,85 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	addDebugEchoServiceDnsRecordName    = "api.example.test"
+	addDebugEchoServiceDnsRecordAddress = "10.1.2.3"
+)
+
+type addDebugEchoServiceTestCase struct {
+	*testing.T
+}
+
+func newAddDebugEchoServiceTestCase(t *testing.T) *addDebugEchoServiceTestCase {
+	return &addDebugEchoServiceTestCase{
+		T: t,
+	}
+}
+
+func (t *addDebugEchoServiceTestCase) GetId() string {
+	return add_service.AddDebugEchoServiceBuiltinName
+}
+
+func (t *addDebugEchoServiceTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		TestServiceName,
+		mock.MatchedBy(func(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			assert.Equal(t, add_service.DefaultDebugEchoImage, serviceConfig.GetContainerImageName())
+			assert.Equal(t, string(TestSubnetwork), serviceConfig.GetSubnetwork())
+			assert.Contains(t, serviceConfig.GetPrivatePorts(), add_service.DebugEchoHttpPortId)
+			assert.Contains(t, serviceConfig.GetPrivatePorts(), add_service.DebugEchoTcpPortId)
+			assert.Equal(t, kurtosis_core_rpc_api_bindings.Port_UDP, serviceConfig.GetPrivatePorts()[add_service.DebugEchoDnsPortId].GetTransportProtocol())
+			require.Len(t, serviceConfig.GetCmdArgs(), 1)
+			script := serviceConfig.GetCmdArgs()[0]
+			assert.Contains(t, script, fmt.Sprintf("%s %s\n", addDebugEchoServiceDnsRecordName, addDebugEchoServiceDnsRecordAddress))
+			assert.Contains(t, script, fmt.Sprintf("%s %s\n", add_service.DefaultDebugEchoDnsRecordName, add_service.DefaultDebugEchoDnsRecordAddress))
+			assert.Contains(t, script, "nc -lk -p 7 -e cat &")
+			return true
+		}),
+	).Times(1).Return(
+		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+		nil,
+	)
+
+	return add_service.NewAddDebugEchoService(serviceNetwork, runtimeValueStore)
+}
+
+func (t *addDebugEchoServiceTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(
+		"%s(%s=%q, %s={%q: %q}, %s=%q)",
+		add_service.AddDebugEchoServiceBuiltinName,
+		add_service.ServiceNameArgName, TestServiceName,
+		add_service.DnsRecordsArgName, addDebugEchoServiceDnsRecordName, addDebugEchoServiceDnsRecordAddress,
+		add_service.SubnetworkArgName, TestSubnetwork,
+	)
+}
+
+func (t *addDebugEchoServiceTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addDebugEchoServiceTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Contains(t, interpretationResult.String(), fmt.Sprintf(`name = "%v"`, TestServiceName))
+	require.Contains(t, interpretationResult.String(), `"tcp-echo": PortSpec(number=7`)
+
+	require.Equal(t, fmt.Sprintf("Service '%s' added with service UUID '%s'", TestServiceName, TestServiceUuid), *executionResult)
+}
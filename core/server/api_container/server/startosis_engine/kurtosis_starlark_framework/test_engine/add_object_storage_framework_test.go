@@ -0,0 +1,90 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	addObjectStorageEmptyBucketName  = "results"
+	addObjectStorageSeededBucketName = "fixtures"
+)
+
+type addObjectStorageTestCase struct {
+	*testing.T
+}
+
+func newAddObjectStorageTestCase(t *testing.T) *addObjectStorageTestCase {
+	return &addObjectStorageTestCase{
+		T: t,
+	}
+}
+
+func (t *addObjectStorageTestCase) GetId() string {
+	return add_service.AddObjectStorageBuiltinName
+}
+
+func (t *addObjectStorageTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		TestServiceName,
+		mock.MatchedBy(func(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			assert.Equal(t, add_service.DefaultObjectStorageImage, serviceConfig.GetContainerImageName())
+			assert.Contains(t, serviceConfig.GetPrivatePorts(), add_service.ObjectStorageApiPortId)
+			assert.Equal(t, map[string]string{"/kurtosis-bucket-files/" + addObjectStorageSeededBucketName: TestArtifactName}, serviceConfig.GetFilesArtifactMountpoints())
+			require.Len(t, serviceConfig.GetCmdArgs(), 1)
+			setupScript := serviceConfig.GetCmdArgs()[0]
+			assert.Contains(t, setupScript, "mc mb --ignore-existing local/"+addObjectStorageEmptyBucketName)
+			assert.Contains(t, setupScript, "mc mb --ignore-existing local/"+addObjectStorageSeededBucketName)
+			assert.Contains(t, setupScript, "mc cp --recursive /kurtosis-bucket-files/fixtures/ local/fixtures/")
+			return true
+		}),
+	).Times(1).Return(
+		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+		nil,
+	)
+
+	serviceNetwork.EXPECT().ExecCommand(
+		mock.Anything,
+		string(TestServiceName),
+		[]string{"test", "-f", "/tmp/kurtosis-object-storage-ready"},
+	).Times(1).Return(int32(0), "", nil)
+
+	return add_service.NewAddObjectStorage(serviceNetwork, runtimeValueStore)
+}
+
+func (t *addObjectStorageTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(
+		"%s(%s=%q, %s=[%q], %s={%q: %q})",
+		add_service.AddObjectStorageBuiltinName,
+		add_service.ServiceNameArgName, TestServiceName,
+		add_service.BucketsArgName, addObjectStorageEmptyBucketName,
+		add_service.BucketFilesArgName, addObjectStorageSeededBucketName, TestArtifactName,
+	)
+}
+
+func (t *addObjectStorageTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addObjectStorageTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Regexp(t, `endpoint = "http://\{\{kurtosis:[0-9a-f]{32}:hostname\.runtime_value\}\}:9000"`, interpretationResult.String())
+	require.Contains(t, interpretationResult.String(), fmt.Sprintf(`access_key = %q`, add_service.ObjectStorageAccessKey))
+	require.Contains(t, interpretationResult.String(), fmt.Sprintf(`name = "%v"`, TestServiceName))
+
+	require.Equal(t, fmt.Sprintf("Object storage '%s' added with its buckets set up", TestServiceName), *executionResult)
+}
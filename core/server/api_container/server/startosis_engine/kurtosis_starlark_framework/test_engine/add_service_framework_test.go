@@ -73,7 +73,7 @@ func (t *addServiceTestCase) GetInstruction() *kurtosis_plan_instruction.Kurtosi
 			return true
 		}),
 	).Times(1).Return(
-		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil),
+		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
 		nil,
 	)
 
@@ -105,6 +105,8 @@ func (t *addServiceTestCase) GetInstruction() *kurtosis_plan_instruction.Kurtosi
 		TLS:              nil,
 	}, nil)
 
+	serviceNetwork.EXPECT().MarkServiceAsReady(TestServiceName).Times(1).Return()
+
 	return add_service.NewAddService(serviceNetwork, runtimeValueStore)
 }
 
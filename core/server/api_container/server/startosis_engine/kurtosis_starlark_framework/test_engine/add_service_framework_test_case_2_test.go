@@ -0,0 +1,119 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type addServiceTestCase2 struct {
+	*testing.T
+}
+
+func newAddServiceTestCase2(t *testing.T) *addServiceTestCase2 {
+	return &addServiceTestCase2{
+		T: t,
+	}
+}
+
+func (t *addServiceTestCase2) GetId() string {
+	return add_service.AddServiceBuiltinName
+}
+
+func (t *addServiceTestCase2) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		TestServiceName,
+		mock.AnythingOfType("*kurtosis_core_rpc_api_bindings.ServiceConfig"),
+	).Times(1).Return(
+		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+		nil,
+	)
+
+	// both ready conditions must pass as the mode is "all"
+	serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(TestServiceName),
+		TestReadyConditionsRecipePortId,
+		TestGetRequestMethod,
+		"",
+		TestReadyConditionsRecipeEndpoint,
+		"",
+	).Times(1).Return(newAddServiceTestCase2Response(200, "200 OK"), nil)
+	serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(TestServiceName),
+		TestReadyConditions2RecipePortId,
+		TestGetRequestMethod,
+		"",
+		TestReadyConditions2RecipeEndpoint,
+		"",
+	).Times(1).Return(newAddServiceTestCase2Response(201, "201 Created"), nil)
+
+	serviceNetwork.EXPECT().MarkServiceAsReady(TestServiceName).Times(1).Return()
+
+	return add_service.NewAddService(serviceNetwork, runtimeValueStore)
+}
+
+func (t *addServiceTestCase2) GetStarlarkCode() string {
+	readyConditionStarlarkStrTemplate := "ReadyCondition(" +
+		"recipe=GetHttpRequestRecipe(port_id=%q, endpoint=%q, extract={}), " +
+		"field=%q, " +
+		"assertion=%q, " +
+		"target_value=%s)"
+	readyCondition1 := fmt.Sprintf(readyConditionStarlarkStrTemplate, TestReadyConditionsRecipePortId, TestReadyConditionsRecipeEndpoint, TestReadyConditionsField, TestReadyConditionsAssertion, TestReadyConditionsTarget)
+	readyCondition2 := fmt.Sprintf(readyConditionStarlarkStrTemplate, TestReadyConditions2RecipePortId, TestReadyConditions2RecipeEndpoint, TestReadyConditions2Field, TestReadyConditions2Assertion, TestReadyConditions2Target)
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q, ready_conditions=[%s, %s], ready_conditions_mode=%q)", TestContainerImageName, readyCondition1, readyCondition2, "all")
+	return fmt.Sprintf(`%s(%s=%q, %s=%s)`, add_service.AddServiceBuiltinName, add_service.ServiceNameArgName, TestServiceName, add_service.ServiceConfigArgName, serviceConfig)
+}
+
+func (t *addServiceTestCase2) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addServiceTestCase2) Assert(interpretationResult starlark.Value, executionResult *string) {
+	serviceObj, ok := interpretationResult.(*kurtosis_types.Service)
+	require.True(t, ok, "interpretation result should be a service")
+	require.NotNil(t, serviceObj)
+
+	expectedExecutionResult := fmt.Sprintf("Service '%s' added with service UUID '%s'", TestServiceName, TestServiceUuid)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
+
+func newAddServiceTestCase2Response(statusCode int, status string) *http.Response {
+	return &http.Response{
+		Status:     status,
+		StatusCode: statusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Request: &http.Request{
+			Method: TestGetRequestMethod,
+			URL:    &url.URL{},
+		},
+		Close:            true,
+		ContentLength:    -1,
+		Body:             io.NopCloser(strings.NewReader("{}")),
+		Trailer:          nil,
+		TransferEncoding: nil,
+		Uncompressed:     true,
+		TLS:              nil,
+	}
+}
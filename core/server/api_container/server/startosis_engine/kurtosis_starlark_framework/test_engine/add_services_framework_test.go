@@ -57,8 +57,8 @@ func (t *addServicesTestCase) GetInstruction() *kurtosis_plan_instruction.Kurtos
 		mock.Anything,
 	).Times(1).Return(
 		map[service.ServiceName]*service.Service{
-			TestServiceName:  service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil),
-			TestServiceName2: service.NewService(service.NewServiceRegistration(TestServiceName2, TestServiceUuid2, TestEnclaveUuid, nil, string(TestServiceName2)), container_status.ContainerStatus_Running, nil, nil, nil),
+			TestServiceName:  service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+			TestServiceName2: service.NewService(service.NewServiceRegistration(TestServiceName2, TestServiceUuid2, TestEnclaveUuid, nil, string(TestServiceName2)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
 		},
 		map[service.ServiceName]error{},
 		nil,
@@ -150,6 +150,9 @@ func (t *addServicesTestCase) GetInstruction() *kurtosis_plan_instruction.Kurtos
 		TLS:              nil,
 	}, nil)
 
+	serviceNetwork.EXPECT().MarkServiceAsReady(TestServiceName).Times(1).Return()
+	serviceNetwork.EXPECT().MarkServiceAsReady(TestServiceName2).Times(1).Return()
+
 	return add_service.NewAddServices(serviceNetwork, runtimeValueStore)
 }
 
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_shutdown_hook"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testShutdownHookRecipe  = `ExecRecipe(command=["pg_dump", "-f", "/results/dump.sql"])`
+	testShutdownHookTimeout = "10s"
+)
+
+type addShutdownHookTestCase struct {
+	*testing.T
+}
+
+func newAddShutdownHookTestCase(t *testing.T) *addShutdownHookTestCase {
+	return &addShutdownHookTestCase{
+		T: t,
+	}
+}
+
+func (t *addShutdownHookTestCase) GetId() string {
+	return add_shutdown_hook.AddShutdownHookBuiltinName
+}
+
+func (t *addShutdownHookTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	// Adding the hook doesn't run its recipe, that only happens when the enclave is stopped or destroyed
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	return add_shutdown_hook.NewAddShutdownHook(serviceNetwork, runtimeValueStore)
+}
+
+func (t *addShutdownHookTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%s, %s=%q)", add_shutdown_hook.AddShutdownHookBuiltinName, add_shutdown_hook.ServiceNameArgName, TestServiceName, add_shutdown_hook.RecipeArgName, testShutdownHookRecipe, add_shutdown_hook.TimeoutArgName, testShutdownHookTimeout)
+}
+
+func (t *addShutdownHookTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *addShutdownHookTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Added shutdown hook running %s on service '%s', with a timeout of '%s'", testShutdownHookRecipe, TestServiceName, testShutdownHookTimeout)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,50 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/collect_coverage"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testCoverageDirpath = "/tmp/coverage"
+)
+
+type collectCoverageTestCase struct {
+	*testing.T
+}
+
+func newCollectCoverageTestCase(t *testing.T) *collectCoverageTestCase {
+	return &collectCoverageTestCase{
+		T: t,
+	}
+}
+
+func (t *collectCoverageTestCase) GetId() string {
+	return collect_coverage.CollectCoverageBuiltinName
+}
+
+func (t *collectCoverageTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	// The coverage only gets copied out of the services when the enclave is stopped or destroyed
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	return collect_coverage.NewCollectCoverage(serviceNetwork)
+}
+
+func (t *collectCoverageTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=[%q, %q], %s=%q)", collect_coverage.CollectCoverageBuiltinName, collect_coverage.ServiceNamesArgName, TestServiceName, TestServiceName2, collect_coverage.PathArgName, testCoverageDirpath)
+}
+
+func (t *collectCoverageTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *collectCoverageTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Coverage data files in '%s' on services '%s', '%s' will be collected into files artifact '%s' when the enclave is torn down", testCoverageDirpath, TestServiceName, TestServiceName2, collect_coverage.CoverageFilesArtifactName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
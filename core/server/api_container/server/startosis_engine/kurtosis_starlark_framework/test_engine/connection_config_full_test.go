@@ -31,7 +31,7 @@ func (t *connectionConfigFullTestCase) GetTypeConstructor() *kurtosis_type_const
 
 func (t *connectionConfigFullTestCase) GetStarlarkCode() string {
 	packetDelayArg := fmt.Sprintf("%s(%s=%d, %s=%d)", packet_delay_distribution.NormalPacketDelayDistributionTypeName, packet_delay_distribution.MeanAttr, 100, packet_delay_distribution.StdDevAttr, 10)
-	return fmt.Sprintf("%s(%s=%s, %s=%s)", connection_config.ConnectionConfigTypeName, connection_config.PacketLossPercentageAttr, "50.0", connection_config.PacketDelayDistributionAttr, packetDelayArg)
+	return fmt.Sprintf("%s(%s=%s, %s=%s, %s=%d)", connection_config.ConnectionConfigTypeName, connection_config.PacketLossPercentageAttr, "50.0", connection_config.PacketDelayDistributionAttr, packetDelayArg, connection_config.BandwidthLimitKbpsAttr, 1000)
 }
 
 func (t *connectionConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
@@ -42,6 +42,7 @@ func (t *connectionConfigFullTestCase) Assert(typeValue builtin_argument.Kurtosi
 
 	expectedConnectionConfig := partition_topology.NewPartitionConnection(
 		partition_topology.NewPacketLoss(50),
-		partition_topology.NewNormalPacketDelayDistribution(100, 10, 0))
+		partition_topology.NewNormalPacketDelayDistribution(100, 10, 0),
+		partition_topology.NewBandwidthLimit(1000))
 	require.Equal(t, expectedConnectionConfig, *connectionConfig)
 }
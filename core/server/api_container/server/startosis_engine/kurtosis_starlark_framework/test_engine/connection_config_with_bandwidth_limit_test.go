@@ -0,0 +1,46 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type connectionConfigWithBandwidthLimitTestCase struct {
+	*testing.T
+}
+
+func newConnectionConfigWithBandwidthLimitTestCase(t *testing.T) *connectionConfigWithBandwidthLimitTestCase {
+	return &connectionConfigWithBandwidthLimitTestCase{
+		T: t,
+	}
+}
+
+func (t *connectionConfigWithBandwidthLimitTestCase) GetId() string {
+	return fmt.Sprintf("%s_%s", connection_config.ConnectionConfigTypeName, "WithBandwidthLimit")
+}
+
+func (t *connectionConfigWithBandwidthLimitTestCase) GetTypeConstructor() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return connection_config.NewConnectionConfigType()
+}
+
+func (t *connectionConfigWithBandwidthLimitTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%d)", connection_config.ConnectionConfigTypeName, connection_config.BandwidthLimitKbpsAttr, 1000)
+}
+
+func (t *connectionConfigWithBandwidthLimitTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	connectionConfigStarlark, ok := typeValue.(*connection_config.ConnectionConfig)
+	require.True(t, ok)
+	connectionConfig, err := connectionConfigStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	expectedConnectionConfig := partition_topology.NewPartitionConnection(
+		partition_topology.NewPacketLoss(0),
+		partition_topology.NewUniformPacketDelayDistribution(0),
+		partition_topology.NewBandwidthLimit(1000))
+	require.Equal(t, expectedConnectionConfig, *connectionConfig)
+}
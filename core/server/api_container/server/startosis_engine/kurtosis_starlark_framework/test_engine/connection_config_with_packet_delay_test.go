@@ -42,6 +42,7 @@ func (t *connectionConfigWithPacketDelayTestCase) Assert(typeValue builtin_argum
 
 	expectedConnectionConfig := partition_topology.NewPartitionConnection(
 		partition_topology.NewPacketLoss(0),
-		partition_topology.NewUniformPacketDelayDistribution(10))
+		partition_topology.NewUniformPacketDelayDistribution(10),
+		partition_topology.ConnectionWithNoBandwidthLimit)
 	require.Equal(t, expectedConnectionConfig, *connectionConfig)
 }
@@ -40,6 +40,7 @@ func (t *connectionConfigWithPacketLossTestCase) Assert(typeValue builtin_argume
 
 	expectedConnectionConfig := partition_topology.NewPartitionConnection(
 		partition_topology.NewPacketLoss(50),
-		partition_topology.NewUniformPacketDelayDistribution(0))
+		partition_topology.NewUniformPacketDelayDistribution(0),
+		partition_topology.ConnectionWithNoBandwidthLimit)
 	require.Equal(t, expectedConnectionConfig, *connectionConfig)
 }
@@ -0,0 +1,109 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	enableObservabilityScrapeTargetAddress = "172.16.4.2:9100"
+	enableObservabilityGrafanaPublicPort   = uint16(49152)
+)
+
+type enableObservabilityTestCase struct {
+	*testing.T
+}
+
+func newEnableObservabilityTestCase(t *testing.T) *enableObservabilityTestCase {
+	return &enableObservabilityTestCase{
+		T: t,
+	}
+}
+
+func (t *enableObservabilityTestCase) GetId() string {
+	return add_service.EnableObservabilityBuiltinName
+}
+
+func (t *enableObservabilityTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().GetScrapeTargets(mock.Anything).Times(1).Return([]*kurtosis_core_rpc_api_bindings.ScrapeTarget{
+		{
+			ServiceName:    string(TestServiceName),
+			ServiceUuid:    string(TestServiceUuid),
+			MetricsPortId:  service_network.DefaultMetricsPortId,
+			PrivateAddress: enableObservabilityScrapeTargetAddress,
+			PublicAddress:  "",
+		},
+	}, nil)
+
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		add_service.PrometheusServiceName,
+		mock.MatchedBy(func(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			if serviceConfig.GetContainerImageName() != add_service.DefaultPrometheusImage {
+				return false
+			}
+			require.Len(t, serviceConfig.GetCmdArgs(), 1)
+			assert.Contains(t, serviceConfig.GetCmdArgs()[0], fmt.Sprintf("- targets: ['%s']", enableObservabilityScrapeTargetAddress))
+			assert.Contains(t, serviceConfig.GetCmdArgs()[0], fmt.Sprintf("labels: {service: '%s'}", TestServiceName))
+			return true
+		}),
+	).Times(1).Return(
+		service.NewService(service.NewServiceRegistration(add_service.PrometheusServiceName, "prometheus-uuid", TestEnclaveUuid, nil, string(add_service.PrometheusServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+		nil,
+	)
+	grafanaPublicPort, err := port_spec.NewPortSpec(enableObservabilityGrafanaPublicPort, port_spec.TransportProtocol_TCP, "")
+	require.NoError(t, err)
+	serviceNetwork.EXPECT().StartService(
+		mock.Anything,
+		add_service.GrafanaServiceName,
+		mock.MatchedBy(func(serviceConfig *kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			if serviceConfig.GetContainerImageName() != add_service.DefaultGrafanaImage {
+				return false
+			}
+			require.Len(t, serviceConfig.GetCmdArgs(), 1)
+			assert.Contains(t, serviceConfig.GetCmdArgs()[0], "url: http://prometheus:9090")
+			assert.NotContains(t, serviceConfig.GetCmdArgs()[0], "type: jaeger")
+			return true
+		}),
+	).Times(1).Return(
+		service.NewService(service.NewServiceRegistration(add_service.GrafanaServiceName, "grafana-uuid", TestEnclaveUuid, nil, string(add_service.GrafanaServiceName)), container_status.ContainerStatus_Running, nil, nil, map[string]*port_spec.PortSpec{add_service.GrafanaPortId: grafanaPublicPort}, nil, nil),
+		nil,
+	)
+
+	serviceNetwork.EXPECT().HttpRequestService(mock.Anything, string(add_service.PrometheusServiceName), add_service.PrometheusPortId, TestGetRequestMethod, "", "/-/ready", "").Times(1).Return(newAddServiceTestCase2Response(200, "200 OK"), nil)
+	serviceNetwork.EXPECT().HttpRequestService(mock.Anything, string(add_service.GrafanaServiceName), add_service.GrafanaPortId, TestGetRequestMethod, "", "/api/health", "").Times(1).Return(newAddServiceTestCase2Response(200, "200 OK"), nil)
+	serviceNetwork.EXPECT().MarkServiceAsReady(add_service.PrometheusServiceName).Times(1).Return()
+	serviceNetwork.EXPECT().MarkServiceAsReady(add_service.GrafanaServiceName).Times(1).Return()
+
+	return add_service.NewEnableObservability(serviceNetwork)
+}
+
+func (t *enableObservabilityTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s()", add_service.EnableObservabilityBuiltinName)
+}
+
+func (t *enableObservabilityTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *enableObservabilityTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Contains(t, interpretationResult.String(), `grafana_url = "http://grafana:3000"`)
+	require.Contains(t, interpretationResult.String(), `prometheus_url = "http://prometheus:9090"`)
+	require.Contains(t, interpretationResult.String(), `otlp_endpoint = None`)
+
+	expectedExecutionResult := fmt.Sprintf("Observability enabled, scraping '%s'; Grafana dashboard available at http://127.0.0.1:%d", TestServiceName, enableObservabilityGrafanaPublicPort)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
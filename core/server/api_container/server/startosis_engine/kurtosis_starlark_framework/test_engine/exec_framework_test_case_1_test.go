@@ -35,13 +35,13 @@ func (t execTestCase1) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanI
 	serviceNetwork := service_network.NewMockServiceNetwork(t)
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 
-	serviceNetwork.EXPECT().ExecCommand(
+	serviceNetwork.EXPECT().ExecCommandWithStreamedOutput(
 		mock.Anything,
 		string(execServiceName),
 		[]string{"mkdir", "-p", "/tmp/store"},
+		mock.Anything,
 	).Times(1).Return(
 		int32(0),
-		"",
 		nil,
 	)
 
@@ -12,7 +12,7 @@ import (
 	"testing"
 )
 
-//This test case is for testing positional arguments
+// This test case is for testing positional arguments
 type execTestCase2 struct {
 	*testing.T
 }
@@ -31,13 +31,13 @@ func (t execTestCase2) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanI
 	serviceNetwork := service_network.NewMockServiceNetwork(t)
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 
-	serviceNetwork.EXPECT().ExecCommand(
+	serviceNetwork.EXPECT().ExecCommandWithStreamedOutput(
 		mock.Anything,
 		string(execServiceName),
 		[]string{"mkdir", "-p", "/tmp/store"},
+		mock.Anything,
 	).Times(1).Return(
 		int32(0),
-		"",
 		nil,
 	)
 
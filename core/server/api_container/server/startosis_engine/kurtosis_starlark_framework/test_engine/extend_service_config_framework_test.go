@@ -0,0 +1,72 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/extend_service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	extendServiceConfigTestImageName = "kurtosistech/example-datastore-server:0.2.0"
+	extendServiceConfigTestEnvValue2 = "OVERRIDDEN_VALUE_2"
+)
+
+type extendServiceConfigTestCase struct {
+	*testing.T
+}
+
+func newExtendServiceConfigTestCase(t *testing.T) *extendServiceConfigTestCase {
+	return &extendServiceConfigTestCase{
+		T: t,
+	}
+}
+
+func (t *extendServiceConfigTestCase) GetId() string {
+	return extend_service_config.ExtendServiceConfigBuiltinName
+}
+
+func (t *extendServiceConfigTestCase) GetHelper() *kurtosis_helper.KurtosisHelper {
+	return extend_service_config.NewExtendServiceConfigHelper()
+}
+
+func (t *extendServiceConfigTestCase) GetStarlarkCode() string {
+	baseServiceConfig := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, TestContainerImageName,
+		service_config.CmdAttr, fmt.Sprintf("[%q, %q, %q]", TestCmdSlice[0], TestCmdSlice[1], TestCmdSlice[2]),
+		service_config.EnvVarsAttr, fmt.Sprintf("{%q: %q, %q: %q}", TestEnvVarName1, TestEnvVarValue1, TestEnvVarName2, TestEnvVarValue2),
+	)
+	return fmt.Sprintf("%s(%s=%s, %s=%q, %s=%s)",
+		extend_service_config.ExtendServiceConfigBuiltinName,
+		extend_service_config.BaseArgName, baseServiceConfig,
+		service_config.ImageAttr, extendServiceConfigTestImageName,
+		service_config.EnvVarsAttr, fmt.Sprintf("{%q: %q}", TestEnvVarName2, extendServiceConfigTestEnvValue2),
+	)
+}
+
+func (t *extendServiceConfigTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *extendServiceConfigTestCase) Assert(result starlark.Value) {
+	serviceConfigStarlark, ok := result.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, err := serviceConfigStarlark.ToKurtosisType()
+	require.Nil(t, err)
+
+	expectedServiceConfig := services.NewServiceConfigBuilder(
+		extendServiceConfigTestImageName,
+	).WithCmdArgs(
+		TestCmdSlice,
+	).WithEnvVars(map[string]string{
+		TestEnvVarName1: TestEnvVarValue1,
+		TestEnvVarName2: extendServiceConfigTestEnvValue2,
+	}).Build()
+	require.Equal(t, expectedServiceConfig, serviceConfig)
+}
@@ -0,0 +1,81 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/get_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"net"
+	"testing"
+)
+
+type getServiceTestCase struct {
+	*testing.T
+}
+
+func newGetServiceTestCase(t *testing.T) *getServiceTestCase {
+	return &getServiceTestCase{
+		T: t,
+	}
+}
+
+func (t *getServiceTestCase) GetId() string {
+	return get_service.GetServiceBuiltinName
+}
+
+func (t *getServiceTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	// once at interpretation time for the ports, once at execution time for the future references
+	serviceNetwork.EXPECT().GetService(
+		mock.Anything,
+		string(TestServiceName),
+	).Times(2).Return(newTestExistingService(t.T, TestServiceName, TestServiceUuid), nil)
+
+	return get_service.NewGetService(serviceNetwork, runtimeValueStore)
+}
+
+func (t *getServiceTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q)", get_service.GetServiceBuiltinName, get_service.ServiceNameArgName, TestServiceName)
+}
+
+func (t *getServiceTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *getServiceTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	serviceObj, ok := interpretationResult.(*kurtosis_types.Service)
+	require.True(t, ok, "interpretation result should be a service")
+	expectedServiceObj := fmt.Sprintf(`Service\(hostname = "{{kurtosis:[0-9a-f]{32}:hostname.runtime_value}}", ip_address = "{{kurtosis:[0-9a-f]{32}:ip_address.runtime_value}}", name = "%v", ports = {%q: PortSpec\(number=%d, transport_protocol=%q, application_protocol=%q\)}\)`, TestServiceName, TestPrivatePortId, TestPrivatePortNumber, TestPrivatePortProtocolStr, TestPrivateApplicationProtocol)
+	require.Regexp(t, expectedServiceObj, serviceObj.String())
+
+	expectedExecutionResult := fmt.Sprintf("Fetched service '%s'", TestServiceName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
+
+func newTestExistingService(t *testing.T, serviceName service.ServiceName, serviceUuid service.ServiceUUID) *service.Service {
+	applicationProtocol := TestPrivateApplicationProtocol
+	privatePortSpec, err := port_spec.NewPortSpec(uint16(TestPrivatePortNumber), port_spec.TransportProtocol_TCP, applicationProtocol)
+	require.NoError(t, err)
+	registration := service.NewServiceRegistration(serviceName, serviceUuid, TestEnclaveUuid, net.ParseIP("172.16.0.2"), string(serviceName))
+	return service.NewService(
+		registration,
+		container_status.ContainerStatus_Running,
+		map[string]*port_spec.PortSpec{
+			TestPrivatePortId: privatePortSpec,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,67 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/get_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type getServicesTestCase struct {
+	*testing.T
+}
+
+func newGetServicesTestCase(t *testing.T) *getServicesTestCase {
+	return &getServicesTestCase{
+		T: t,
+	}
+}
+
+func (t *getServicesTestCase) GetId() string {
+	return get_service.GetServicesBuiltinName
+}
+
+func (t *getServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	serviceNetwork.EXPECT().GetServiceNames().Times(1).Return(map[service.ServiceName]bool{
+		TestServiceName2: true,
+		TestServiceName:  true,
+	})
+	serviceNetwork.EXPECT().GetService(
+		mock.Anything,
+		string(TestServiceName),
+	).Times(2).Return(newTestExistingService(t.T, TestServiceName, TestServiceUuid), nil)
+	serviceNetwork.EXPECT().GetService(
+		mock.Anything,
+		string(TestServiceName2),
+	).Times(2).Return(newTestExistingService(t.T, TestServiceName2, TestServiceUuid2), nil)
+
+	return get_service.NewGetServices(serviceNetwork, runtimeValueStore)
+}
+
+func (t *getServicesTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s()", get_service.GetServicesBuiltinName)
+}
+
+func (t *getServicesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *getServicesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	serviceObjs, ok := interpretationResult.(*starlark.List)
+	require.True(t, ok, "interpretation result should be a list")
+	require.Equal(t, 2, serviceObjs.Len())
+	require.Regexp(t, fmt.Sprintf(`name = "%v"`, TestServiceName), serviceObjs.Index(0).String())
+	require.Regexp(t, fmt.Sprintf(`name = "%v"`, TestServiceName2), serviceObjs.Index(1).String())
+
+	expectedExecutionResult := fmt.Sprintf("Fetched services '%s', '%s'", TestServiceName, TestServiceName2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
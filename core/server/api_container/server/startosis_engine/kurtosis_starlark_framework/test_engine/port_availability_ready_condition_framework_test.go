@@ -0,0 +1,63 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type portAvailabilityReadyConditionTestCase struct {
+	*testing.T
+}
+
+func newPortAvailabilityReadyConditionTestCase(t *testing.T) *portAvailabilityReadyConditionTestCase {
+	return &portAvailabilityReadyConditionTestCase{
+		T: t,
+	}
+}
+
+func (t *portAvailabilityReadyConditionTestCase) GetId() string {
+	return service_config.PortAvailabilityReadyConditionTypeName
+}
+
+func (t *portAvailabilityReadyConditionTestCase) GetTypeConstructor() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return service_config.NewPortAvailabilityReadyConditionType()
+}
+
+func (t *portAvailabilityReadyConditionTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q)",
+		service_config.PortAvailabilityReadyConditionTypeName,
+		service_config.PortIdAttr,
+		TestReadyConditionsRecipePortId,
+		service_config.TimeoutAttr,
+		TestReadyConditionsTimeout,
+	)
+}
+
+func (t *portAvailabilityReadyConditionTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	receivedReadyCondition, ok := typeValue.(*service_config.PortAvailabilityReadyCondition)
+	require.True(t, ok)
+
+	portId, err := receivedReadyCondition.GetPortId()
+	if assert.Nil(t, err) {
+		require.Equal(t, TestReadyConditionsRecipePortId, portId)
+	}
+
+	interval, err := receivedReadyCondition.GetInterval()
+	if assert.Nil(t, err) {
+		require.Equal(t, time.Second, interval)
+	}
+
+	timeout, err := receivedReadyCondition.GetTimeout()
+	if assert.Nil(t, err) {
+		expectedTimeout, err := time.ParseDuration(TestReadyConditionsTimeout)
+		if assert.Nil(t, err) {
+			require.Equal(t, expectedTimeout, timeout)
+		}
+	}
+}
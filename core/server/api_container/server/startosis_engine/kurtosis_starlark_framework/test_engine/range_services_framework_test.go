@@ -0,0 +1,101 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	rangeServicesNameTemplate = "node-{index}"
+	rangeServicesStartIndex   = 1
+
+	rangeServicesCmdArgTemplate = "--node-id={index}"
+)
+
+var (
+	rangeServicesServiceName1 = service.ServiceName("node-1")
+	rangeServicesServiceUuid1 = service.ServiceUUID("node-1-uuid")
+	rangeServicesServiceName2 = service.ServiceName("node-2")
+	rangeServicesServiceUuid2 = service.ServiceUUID("node-2-uuid")
+)
+
+type rangeServicesTestCase struct {
+	*testing.T
+}
+
+func newRangeServicesTestCase(t *testing.T) *rangeServicesTestCase {
+	return &rangeServicesTestCase{
+		T: t,
+	}
+}
+
+func (t *rangeServicesTestCase) GetId() string {
+	return add_service.RangeServicesBuiltinName
+}
+
+func (t *rangeServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+
+	serviceNetwork.EXPECT().StartServices(
+		mock.Anything,
+		mock.MatchedBy(func(configs map[service.ServiceName]*kurtosis_core_rpc_api_bindings.ServiceConfig) bool {
+			require.Len(t, configs, 2)
+			expectedServiceConfig1 := services.NewServiceConfigBuilder(TestContainerImageName).WithCmdArgs([]string{"--node-id=1"}).Build()
+			assert.Equal(t, expectedServiceConfig1, services.NewServiceConfigBuilderFromServiceConfig(configs[rangeServicesServiceName1]).Build())
+			expectedServiceConfig2 := services.NewServiceConfigBuilder(TestContainerImageName).WithCmdArgs([]string{"--node-id=2"}).Build()
+			assert.Equal(t, expectedServiceConfig2, services.NewServiceConfigBuilderFromServiceConfig(configs[rangeServicesServiceName2]).Build())
+			return true
+		}),
+		mock.Anything,
+	).Times(1).Return(
+		map[service.ServiceName]*service.Service{
+			rangeServicesServiceName1: service.NewService(service.NewServiceRegistration(rangeServicesServiceName1, rangeServicesServiceUuid1, TestEnclaveUuid, nil, string(rangeServicesServiceName1)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+			rangeServicesServiceName2: service.NewService(service.NewServiceRegistration(rangeServicesServiceName2, rangeServicesServiceUuid2, TestEnclaveUuid, nil, string(rangeServicesServiceName2)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+
+	return add_service.NewRangeServices(serviceNetwork, runtimeValueStore)
+}
+
+func (t *rangeServicesTestCase) GetStarlarkCode() string {
+	serviceConfig := fmt.Sprintf("ServiceConfig(image=%q, cmd=[%q])", TestContainerImageName, rangeServicesCmdArgTemplate)
+	return fmt.Sprintf(
+		"%s(%s=%q, %s=%d, %s=%s, %s=%d)",
+		add_service.RangeServicesBuiltinName,
+		add_service.ServiceNameArgName, rangeServicesNameTemplate,
+		add_service.CountArgName, 2,
+		add_service.ServiceConfigArgName, serviceConfig,
+		add_service.StartIndexArgName, rangeServicesStartIndex,
+	)
+}
+
+func (t *rangeServicesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *rangeServicesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	resultList, ok := interpretationResult.(*starlark.List)
+	require.True(t, ok, "interpretation result should be a list")
+	require.Equal(t, 2, resultList.Len())
+	require.Regexp(t, fmt.Sprintf(`name = "%v"`, rangeServicesServiceName1), resultList.Index(0).String())
+	require.Regexp(t, fmt.Sprintf(`name = "%v"`, rangeServicesServiceName2), resultList.Index(1).String())
+
+	require.Contains(t, *executionResult, "Successfully added the following '2' services:")
+	require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' added with UUID '%s'", rangeServicesServiceName1, rangeServicesServiceUuid1))
+	require.Contains(t, *executionResult, fmt.Sprintf("Service '%s' added with UUID '%s'", rangeServicesServiceName2, rangeServicesServiceUuid2))
+}
@@ -0,0 +1,64 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type removeServicesTestCase struct {
+	*testing.T
+}
+
+func newRemoveServicesTestCase(t *testing.T) *removeServicesTestCase {
+	return &removeServicesTestCase{
+		T: t,
+	}
+}
+
+func (t removeServicesTestCase) GetId() string {
+	return remove_service.RemoveServicesBuiltinName
+}
+
+func (t removeServicesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().RemoveServices(
+		mock.Anything,
+		map[string]bool{
+			string(TestServiceName):  true,
+			string(TestServiceName2): true,
+		},
+	).Times(1).Return(
+		map[service.ServiceName]service.ServiceUUID{
+			TestServiceName:  TestServiceUuid,
+			TestServiceName2: TestServiceUuid2,
+		},
+		map[service.ServiceName]error{},
+		nil,
+	)
+	return remove_service.NewRemoveServices(serviceNetwork)
+}
+
+func (t removeServicesTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=[%q, %q])", remove_service.RemoveServicesBuiltinName, remove_service.ServiceNamesArgName, TestServiceName, TestServiceName2)
+}
+
+func (t *removeServicesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t removeServicesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf(`Successfully removed the following '2' services:
+  Service '%s' with service UUID '%s' removed
+  Service '%s' with service UUID '%s' removed`, TestServiceName, TestServiceUuid, TestServiceName2, TestServiceUuid2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
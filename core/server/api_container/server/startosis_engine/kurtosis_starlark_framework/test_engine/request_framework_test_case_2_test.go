@@ -15,7 +15,7 @@ import (
 	"testing"
 )
 
-//This test case is for testing positional arguments
+// This test case is for testing positional arguments
 type requestTestCase2 struct {
 	*testing.T
 }
@@ -0,0 +1,53 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/restart_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type restartServiceTestCase struct {
+	*testing.T
+}
+
+func newRestartServiceTestCase(t *testing.T) *restartServiceTestCase {
+	return &restartServiceTestCase{
+		T: t,
+	}
+}
+
+func (t restartServiceTestCase) GetId() string {
+	return restart_service.RestartServiceBuiltinName
+}
+
+func (t restartServiceTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().RestartService(
+		mock.Anything,
+		string(TestServiceName),
+	).Times(1).Return(
+		nil,
+	)
+	return restart_service.NewRestartService(serviceNetwork)
+}
+
+func (t restartServiceTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q)", restart_service.RestartServiceBuiltinName, restart_service.ServiceNameArgName, TestServiceName)
+}
+
+func (t *restartServiceTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t restartServiceTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Service '%s' restarted", TestServiceName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,86 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/seed_database"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	seedDatabaseUser     = "app"
+	seedDatabasePassword = "secret"
+	seedDatabaseDatabase = "appdb"
+
+	seedDatabaseArtifactDirpath = "/tmp/kurtosis-seed-database/0"
+)
+
+type seedDatabaseTestCase struct {
+	*testing.T
+}
+
+func newSeedDatabaseTestCase(t *testing.T) *seedDatabaseTestCase {
+	return &seedDatabaseTestCase{
+		T: t,
+	}
+}
+
+func (t *seedDatabaseTestCase) GetId() string {
+	return seed_database.SeedDatabaseBuiltinName
+}
+
+func (t *seedDatabaseTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	psqlCommand := []string{"env", "PGPASSWORD=" + seedDatabasePassword, "psql", "-h", "127.0.0.1", "-U", seedDatabaseUser, "-d", seedDatabaseDatabase}
+
+	serviceNetwork.EXPECT().ExecCommand(mock.Anything, string(TestServiceName), append(psqlCommand, "-c", "SELECT 1")).Times(1).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().ExecCommand(mock.Anything, string(TestServiceName), []string{"rm", "-rf", seed_database.SeedFilesDirpath}).Times(2).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().ExecCommand(mock.Anything, string(TestServiceName), []string{"mkdir", "-p", seedDatabaseArtifactDirpath}).Times(1).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().CopyFilesArtifactToService(mock.Anything, string(TestServiceName), TestArtifactName, seedDatabaseArtifactDirpath).Times(1).Return(nil)
+	serviceNetwork.EXPECT().ExecCommand(
+		mock.Anything,
+		string(TestServiceName),
+		[]string{"find", seedDatabaseArtifactDirpath, "-type", "f", "-name", "*.sql"},
+	).Times(1).Return(int32(0), seedDatabaseArtifactDirpath+"/02-data.sql\n"+seedDatabaseArtifactDirpath+"/01-schema.sql\n", nil)
+
+	applySchemaCall := serviceNetwork.EXPECT().ExecCommand(
+		mock.Anything,
+		string(TestServiceName),
+		append(psqlCommand, "-v", "ON_ERROR_STOP=1", "--single-transaction", "-q", "-f", seedDatabaseArtifactDirpath+"/01-schema.sql"),
+	).Times(1).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().ExecCommand(
+		mock.Anything,
+		string(TestServiceName),
+		append(psqlCommand, "-v", "ON_ERROR_STOP=1", "--single-transaction", "-q", "-f", seedDatabaseArtifactDirpath+"/02-data.sql"),
+	).Times(1).Return(int32(0), "", nil).NotBefore(applySchemaCall)
+
+	return seed_database.NewSeedDatabase(serviceNetwork)
+}
+
+func (t *seedDatabaseTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(
+		"%s(%s=%q, %s=[%q], %s=%q, %s=%q, %s=%q, %s=%q)",
+		seed_database.SeedDatabaseBuiltinName,
+		seed_database.ServiceNameArgName, TestServiceName,
+		seed_database.FilesArgName, TestArtifactName,
+		seed_database.EngineArgName, seed_database.PostgresEngine,
+		seed_database.UserArgName, seedDatabaseUser,
+		seed_database.PasswordArgName, seedDatabasePassword,
+		seed_database.DatabaseArgName, seedDatabaseDatabase,
+	)
+}
+
+func (t *seedDatabaseTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *seedDatabaseTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Applied 2 SQL files to the database of service '%s': [%s/01-schema.sql %s/02-data.sql]", TestServiceName, TestArtifactName, TestArtifactName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
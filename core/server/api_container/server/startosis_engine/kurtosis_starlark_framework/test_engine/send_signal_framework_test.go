@@ -0,0 +1,58 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/send_signal"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	testSignal = "SIGHUP"
+)
+
+type sendSignalTestCase struct {
+	*testing.T
+}
+
+func newSendSignalTestCase(t *testing.T) *sendSignalTestCase {
+	return &sendSignalTestCase{
+		T: t,
+	}
+}
+
+func (t sendSignalTestCase) GetId() string {
+	return send_signal.SendSignalBuiltinName
+}
+
+func (t sendSignalTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().SendSignalToService(
+		mock.Anything,
+		string(TestServiceName),
+		testSignal,
+	).Times(1).Return(
+		nil,
+	)
+	return send_signal.NewSendSignal(serviceNetwork)
+}
+
+func (t sendSignalTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q)", send_signal.SendSignalBuiltinName, send_signal.ServiceNameArgName, TestServiceName, send_signal.SignalArgName, testSignal)
+}
+
+func (t *sendSignalTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t sendSignalTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Signal '%s' sent to service '%s'", testSignal, TestServiceName)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
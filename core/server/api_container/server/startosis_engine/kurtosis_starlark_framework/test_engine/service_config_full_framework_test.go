@@ -31,7 +31,7 @@ func (t *serviceConfigFullTestCase) GetTypeConstructor() *kurtosis_type_construc
 }
 
 func (t *serviceConfigFullTestCase) GetStarlarkCode() string {
-	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%q, %s=%q, %s=%d, %s=%d, %s=%s)",
+	starlarkCode := fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%q, %s=%q, %s=%d, %s=%d, %s=%s, %s=%d, %s=%q, %s=%q, %s=%s, %s=%s, %s=%d, %s=%s, %s=%q, %s=%q, %s=%s, %s=%s, %s=%s, %s=%s)",
 		service_config.ServiceConfigTypeName,
 		service_config.ImageAttr, TestContainerImageName,
 		service_config.PortsAttr, fmt.Sprintf("{%q: PortSpec(number=%d, transport_protocol=%q, application_protocol=%q)}", TestPrivatePortId, TestPrivatePortNumber, TestPrivatePortProtocolStr, TestPrivateApplicationProtocol),
@@ -46,6 +46,19 @@ func (t *serviceConfigFullTestCase) GetStarlarkCode() string {
 		service_config.MemoryAllocationAttr, TestMemoryAllocation,
 		service_config.ReadyConditionsAttr,
 		getDefaultReadyConditionsScriptPart(),
+		service_config.ReplicasAttr, TestReplicas,
+		service_config.CpusetAttr, TestCpuset,
+		service_config.NumaNodesAttr, TestNumaNodes,
+		service_config.UlimitsAttr, fmt.Sprintf("{%q: {%q: %d, %q: %d}}", TestUlimitName, "hard", TestUlimitHard, "soft", TestUlimitSoft),
+		service_config.SysctlsAttr, fmt.Sprintf("{%q: %q}", TestSysctlName, TestSysctlValue),
+		service_config.ShmSizeAttr, TestShmSizeMegabytes,
+		service_config.HostDirMountsAttr, fmt.Sprintf("{%q: %q}", TestHostDirMountpoint, TestHostDirpath),
+		service_config.MetricsPortIdAttr, TestPrivatePortId,
+		service_config.KubernetesServiceAccountAttr, TestKubernetesServiceAccount,
+		service_config.KubernetesServiceAccountAnnotationsAttr, fmt.Sprintf("{%q: %q}", TestKubernetesServiceAccountAnnotationKey, TestKubernetesServiceAccountAnnotationValue),
+		service_config.KubernetesNodeSelectorAttr, fmt.Sprintf("{%q: %q}", TestKubernetesNodeSelectorKey, TestKubernetesNodeSelectorValue),
+		service_config.KubernetesTolerationsAttr, fmt.Sprintf("[{%q: %q, %q: %q, %q: %q}]", "effect", TestKubernetesTolerationEffect, "key", TestKubernetesTolerationKey, "operator", "Exists"),
+		service_config.KubernetesAffinityAttr, TestKubernetesAffinity,
 	)
 	return starlarkCode
 }
@@ -81,6 +94,34 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 		TestCpuAllocation,
 	).WithMemoryAllocationMegabytes(
 		TestMemoryAllocation,
+	).WithReplicas(
+		TestReplicas,
+	).WithCpuset(
+		TestCpuset,
+	).WithNumaNodes(
+		TestNumaNodes,
+	).WithUlimits(
+		map[string]*kurtosis_core_rpc_api_bindings.Ulimit{TestUlimitName: binding_constructors.NewUlimit(TestUlimitSoft, TestUlimitHard)},
+	).WithSysctls(
+		map[string]string{TestSysctlName: TestSysctlValue},
+	).WithShmSizeMegabytes(
+		TestShmSizeMegabytes,
+	).WithHostDirMounts(
+		map[string]string{TestHostDirMountpoint: TestHostDirpath},
+	).WithMetricsPortId(
+		TestPrivatePortId,
+	).WithKubernetesServiceAccount(
+		TestKubernetesServiceAccount,
+		map[string]string{TestKubernetesServiceAccountAnnotationKey: TestKubernetesServiceAccountAnnotationValue},
+	).WithKubernetesScheduling(
+		binding_constructors.NewKubernetesScheduling(
+			map[string]string{TestKubernetesNodeSelectorKey: TestKubernetesNodeSelectorValue},
+			[]*kurtosis_core_rpc_api_bindings.KubernetesToleration{
+				binding_constructors.NewKubernetesToleration(TestKubernetesTolerationKey, "Exists", "", TestKubernetesTolerationEffect, nil),
+			},
+			`{"nodeAffinity":{"requiredDuringSchedulingIgnoredDuringExecution":{"nodeSelectorTerms":[{"matchExpressions":[{"key":"gpu-type","operator":"In","values":["a100"]}]}]}}}`,
+			"",
+		),
 	)
 	require.Equal(t, expectedServiceConfig.Build(), serviceConfig)
 }
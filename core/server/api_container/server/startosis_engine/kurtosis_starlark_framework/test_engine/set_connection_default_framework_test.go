@@ -35,7 +35,8 @@ func (t setConnectionDefaultTestCase) GetInstruction() *kurtosis_plan_instructio
 		mock.MatchedBy(func(actualPartitionConnection partition_topology.PartitionConnection) bool {
 			expectedPartitionConnection := partition_topology.NewPartitionConnection(
 				partition_topology.NewPacketLoss(50),
-				partition_topology.NewNormalPacketDelayDistribution(100, 10, 10.0))
+				partition_topology.NewNormalPacketDelayDistribution(100, 10, 10.0),
+				partition_topology.ConnectionWithNoBandwidthLimit)
 			assert.Equal(t, expectedPartitionConnection, actualPartitionConnection)
 			return true
 		}),
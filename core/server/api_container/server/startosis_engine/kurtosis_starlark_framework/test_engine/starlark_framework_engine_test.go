@@ -23,20 +23,34 @@ const (
 )
 
 func TestAllRegisteredBuiltins(t *testing.T) {
+	testKurtosisPlanInstruction(t, newAddDebugEchoServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newAddObjectStorageTestCase(t))
 	testKurtosisPlanInstruction(t, newAddServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newAddServiceTestCase2(t))
 	testKurtosisPlanInstruction(t, newAddServicesTestCase(t))
+	testKurtosisPlanInstruction(t, newAddShutdownHookTestCase(t))
 	testKurtosisPlanInstruction(t, newAssertTestCase(t))
+	testKurtosisPlanInstruction(t, newCollectCoverageTestCase(t))
+	testKurtosisPlanInstruction(t, newEnableObservabilityTestCase(t))
 	testKurtosisPlanInstruction(t, newExecTestCase1(t))
 	testKurtosisPlanInstruction(t, newExecTestCase2(t))
+	testKurtosisPlanInstruction(t, newGetServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newGetServicesTestCase(t))
 	testKurtosisPlanInstruction(t, newSetConnectionTestCase(t))
 	testKurtosisPlanInstruction(t, newSetConnectionDefaultTestCase(t))
 	testKurtosisPlanInstruction(t, newPrintTestCase(t))
+	testKurtosisPlanInstruction(t, newRangeServicesTestCase(t))
 	testKurtosisPlanInstruction(t, newRemoveConnectionTestCase(t))
 	testKurtosisPlanInstruction(t, newRemoveServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newRemoveServicesTestCase(t))
 	testKurtosisPlanInstruction(t, newRenderSingleTemplateTestCase(t))
 	testKurtosisPlanInstruction(t, newRenderMultipleTemplatesTestCase(t))
 	testKurtosisPlanInstruction(t, newRequestTestCase1(t))
 	testKurtosisPlanInstruction(t, newRequestTestCase2(t))
+	testKurtosisPlanInstruction(t, newRestartServiceTestCase(t))
+	testKurtosisPlanInstruction(t, newSeedDatabaseTestCase(t))
+	testKurtosisPlanInstruction(t, newSendSignalTestCase(t))
+	testKurtosisPlanInstruction(t, newStoreBucketFilesTestCase(t))
 	testKurtosisPlanInstruction(t, newStoreServiceFilesTestCase(t))
 	testKurtosisPlanInstruction(t, newStoreServiceFilesWithoutNameTestCase(t))
 	testKurtosisPlanInstruction(t, newUpdateServiceTestCase(t))
@@ -44,13 +58,16 @@ func TestAllRegisteredBuiltins(t *testing.T) {
 	testKurtosisPlanInstruction(t, newUploadFilesWithoutNameTestCase(t))
 	testKurtosisPlanInstruction(t, newWaitTestCase1(t))
 	testKurtosisPlanInstruction(t, newWaitTestCase2(t))
+	testKurtosisPlanInstruction(t, newWaitForPortTestCase(t))
 
 	testKurtosisHelper(t, newReadFileTestCase(t))
 	testKurtosisHelper(t, newImportModuleTestCase(t))
+	testKurtosisHelper(t, newExtendServiceConfigTestCase(t))
 
 	testKurtosisTypeConstructor(t, newConnectionConfigFullTestCase(t))
 	testKurtosisTypeConstructor(t, newConnectionConfigWithPacketDelayTestCase(t))
 	testKurtosisTypeConstructor(t, newConnectionConfigWithPacketLossTestCase(t))
+	testKurtosisTypeConstructor(t, newConnectionConfigWithBandwidthLimitTestCase(t))
 	testKurtosisTypeConstructor(t, newNormalPacketDelayDistributionFullTestCase(t))
 	testKurtosisTypeConstructor(t, newNormalPacketDelayDistributionMinimalTestCase(t))
 	testKurtosisTypeConstructor(t, newPortSpecFullTestCase(t))
@@ -59,7 +76,9 @@ func TestAllRegisteredBuiltins(t *testing.T) {
 	testKurtosisTypeConstructor(t, newServiceConfigFullTestCase(t))
 	testKurtosisTypeConstructor(t, newUniformPacketDelayDistributionTestCase(t))
 	testKurtosisTypeConstructor(t, newUpdateServiceConfigTestCase(t))
+	testKurtosisTypeConstructor(t, newUpdateServiceConfigFullTestCase(t))
 	testKurtosisTypeConstructor(t, newReadyConditionsTestCase(t))
+	testKurtosisTypeConstructor(t, newPortAvailabilityReadyConditionTestCase(t))
 }
 
 func testKurtosisPlanInstruction(t *testing.T, builtin KurtosisPlanInstructionBaseTest) {
@@ -142,7 +161,7 @@ func testKurtosisTypeConstructor(t *testing.T, builtin KurtosisTypeConstructorBa
 }
 
 func getBasePredeclaredDict(t *testing.T) starlark.StringDict {
-	kurtosisModule, err := builtins.KurtosisModule()
+	kurtosisModule, err := builtins.KurtosisModule(builtins.NewEnclaveInfo("test-enclave", "test-enclave-uuid", "docker", "X.Y.Z"))
 	require.Nil(t, err)
 	// TODO: refactor this with the one we have in the interpreter
 	predeclared := starlark.StringDict{
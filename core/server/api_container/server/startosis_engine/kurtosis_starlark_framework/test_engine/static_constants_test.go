@@ -67,6 +67,32 @@ var (
 
 	TestMemoryAllocation = uint64(1024)
 
+	TestReplicas = uint32(3)
+
+	TestCpuset    = "0-1,4"
+	TestNumaNodes = "0"
+
+	TestUlimitName  = "nofile"
+	TestUlimitSoft  = int64(1024)
+	TestUlimitHard  = int64(65536)
+	TestSysctlName  = "net.core.somaxconn"
+	TestSysctlValue = "1024"
+
+	TestShmSizeMegabytes = uint64(256)
+
+	TestHostDirMountpoint = "/app/src"
+	TestHostDirpath       = "/home/dev/my-app/src"
+
+	TestKubernetesServiceAccount                = "s3-reader"
+	TestKubernetesServiceAccountAnnotationKey   = "eks.amazonaws.com/role-arn"
+	TestKubernetesServiceAccountAnnotationValue = "arn:aws:iam::111122223333:role/s3-reader"
+
+	TestKubernetesNodeSelectorKey   = "node-pool"
+	TestKubernetesNodeSelectorValue = "gpu"
+	TestKubernetesTolerationKey     = "nvidia.com/gpu"
+	TestKubernetesTolerationEffect  = "NoSchedule"
+	TestKubernetesAffinity          = `{"nodeAffinity": {"requiredDuringSchedulingIgnoredDuringExecution": {"nodeSelectorTerms": [{"matchExpressions": [{"key": "gpu-type", "operator": "In", "values": ["a100"]}]}]}}}`
+
 	TestReadyConditionsRecipePortId   = "http"
 	TestReadyConditionsRecipeEndpoint = "/endpoint?input=data"
 	TestReadyConditionsRecipeExtract  = "{}"
@@ -0,0 +1,77 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_bucket_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	storeBucketFilesBucket        = "results"
+	storeBucketFilesPrefix        = "run-1/"
+	storeBucketFilesExportDirpath = "/tmp/kurtosis-bucket-export"
+)
+
+type storeBucketFilesTestCase struct {
+	*testing.T
+}
+
+func newStoreBucketFilesTestCase(t *testing.T) *storeBucketFilesTestCase {
+	return &storeBucketFilesTestCase{
+		T: t,
+	}
+}
+
+func (t *storeBucketFilesTestCase) GetId() string {
+	return store_bucket_files.StoreBucketFilesBuiltinName
+}
+
+func (t *storeBucketFilesTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	serviceNetwork.EXPECT().ExecCommand(mock.Anything, string(TestServiceName), []string{"rm", "-rf", storeBucketFilesExportDirpath}).Times(2).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().ExecCommand(mock.Anything, string(TestServiceName), []string{"mkdir", "-p", storeBucketFilesExportDirpath}).Times(1).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().ExecCommand(
+		mock.Anything,
+		string(TestServiceName),
+		[]string{"mc", "cp", "--recursive", "local/" + storeBucketFilesBucket + "/" + storeBucketFilesPrefix, storeBucketFilesExportDirpath + "/"},
+	).Times(1).Return(int32(0), "", nil)
+	serviceNetwork.EXPECT().CopyFilesFromService(
+		mock.Anything,
+		string(TestServiceName),
+		storeBucketFilesExportDirpath,
+		TestArtifactName,
+	).Times(1).Return(
+		TestArtifactUuid,
+		nil,
+	)
+
+	return store_bucket_files.NewStoreBucketFiles(serviceNetwork)
+}
+
+func (t *storeBucketFilesTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf(
+		"%s(%s=%q, %s=%q, %s=%q, %s=%q)",
+		store_bucket_files.StoreBucketFilesBuiltinName,
+		store_bucket_files.ServiceNameArgName, TestServiceName,
+		store_bucket_files.BucketArgName, storeBucketFilesBucket,
+		store_bucket_files.PrefixArgName, storeBucketFilesPrefix,
+		store_bucket_files.ArtifactNameArgName, TestArtifactName,
+	)
+}
+
+func (t *storeBucketFilesTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *storeBucketFilesTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.String(TestArtifactName), interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Files of bucket '%s' with artifact name '%s' uploaded with artifact UUID '%s'", storeBucketFilesBucket, TestArtifactName, TestArtifactUuid)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
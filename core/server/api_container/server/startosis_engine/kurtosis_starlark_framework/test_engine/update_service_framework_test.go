@@ -36,7 +36,7 @@ func (t *updateServiceTestCase) GetInstruction() *kurtosis_plan_instruction.Kurt
 		mock.Anything,
 		string(TestServiceName),
 	).Times(1).Return(
-		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil),
+		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil, nil, nil),
 		nil,
 	)
 
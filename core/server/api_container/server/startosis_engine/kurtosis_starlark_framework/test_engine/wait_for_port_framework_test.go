@@ -0,0 +1,59 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/wait_for_port"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	waitForPortPortId   = "postgres"
+	waitForPortInterval = "10ms"
+)
+
+type waitForPortTestCase struct {
+	*testing.T
+}
+
+func newWaitForPortTestCase(t *testing.T) *waitForPortTestCase {
+	return &waitForPortTestCase{
+		T: t,
+	}
+}
+
+func (t *waitForPortTestCase) GetId() string {
+	return wait_for_port.WaitForPortBuiltinName
+}
+
+func (t *waitForPortTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+
+	// the port only becomes available on the second try
+	serviceNetwork.EXPECT().CheckServicePortAvailability(mock.Anything, string(TestServiceName), waitForPortPortId).Times(1).Return(
+		fmt.Errorf("connection refused"),
+	)
+	serviceNetwork.EXPECT().CheckServicePortAvailability(mock.Anything, string(TestServiceName), waitForPortPortId).Times(1).Return(
+		nil,
+	)
+	return wait_for_port.NewWaitForPort(serviceNetwork)
+}
+
+func (t *waitForPortTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q)", wait_for_port.WaitForPortBuiltinName, wait_for_port.ServiceNameArgName, TestServiceName, wait_for_port.PortIdArgName, waitForPortPortId, wait_for_port.IntervalArgName, waitForPortInterval)
+}
+
+func (t *waitForPortTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *waitForPortTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResultPrefix := fmt.Sprintf("Port '%s' of service '%s' became available after 2 tries", waitForPortPortId, TestServiceName)
+	require.Contains(t, *executionResult, expectedExecutionResultPrefix)
+}
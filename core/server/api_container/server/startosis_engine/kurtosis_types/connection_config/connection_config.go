@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/packet_delay_distribution"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
+	"math"
 )
 
 const (
@@ -15,6 +16,7 @@ const (
 
 	PacketLossPercentageAttr    = "packet_loss_percentage"
 	PacketDelayDistributionAttr = "packet_delay_distribution"
+	BandwidthLimitKbpsAttr      = "bandwidth_limit_kbps"
 )
 
 func NewConnectionConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -37,6 +39,14 @@ func NewConnectionConfigType() *kurtosis_type_constructor.KurtosisTypeConstructo
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[packet_delay_distribution.PacketDelayDistribution],
 					Validator:         nil,
 				},
+				{
+					Name:              BandwidthLimitKbpsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, BandwidthLimitKbpsAttr, 0, math.MaxUint32)
+					},
+				},
 			},
 		},
 
@@ -62,6 +72,7 @@ func CreateConnectionConfig(packetLossPercentage starlark.Float) (*ConnectionCon
 	args := []starlark.Value{
 		packetLossPercentage,
 		nil, // no delay distribution as we don't need it
+		nil, // no bandwidth limit as we don't need it
 	}
 	argumentDefinitions := NewConnectionConfigType().KurtosisBaseBuiltin.Arguments
 	argumentValuesSet := builtin_argument.NewArgumentValuesSet(argumentDefinitions, args)
@@ -108,9 +119,25 @@ func (connectionConfig *ConnectionConfig) ToKurtosisType() (*partition_topology.
 	} else {
 		packetDelayDistribution = partition_topology.NewUniformPacketDelayDistribution(0)
 	}
+
+	bandwidthLimitKbpsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		connectionConfig.KurtosisValueTypeDefault, BandwidthLimitKbpsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	bandwidthLimit := partition_topology.ConnectionWithNoBandwidthLimit
+	if found {
+		bandwidthLimitKbps, ok := bandwidthLimitKbpsStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Argument '%s' on '%s' was out of bounds", BandwidthLimitKbpsAttr, ConnectionConfigTypeName)
+		}
+		bandwidthLimit = partition_topology.NewBandwidthLimit(uint32(bandwidthLimitKbps))
+	}
+
 	partitionConnection := partition_topology.NewPartitionConnection(
 		partition_topology.NewPacketLoss(packetLossPct),
 		packetDelayDistribution,
+		bandwidthLimit,
 	)
 	return &partitionConnection, nil
 }
@@ -0,0 +1,65 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// Restarts the service once its dependency got restarted and became ready again
+	RestartDependencyRecovery = "restart"
+)
+
+// DependencyRecovery is what happens to a service once one of its dependencies got restarted and became ready again:
+// either the service gets restarted, or the exec recipe gets run on it
+type DependencyRecovery struct {
+	// nil if the service gets restarted
+	execRecipe *recipe.ExecRecipe
+}
+
+func (recovery *DependencyRecovery) IsRestart() bool {
+	return recovery.execRecipe == nil
+}
+
+func (recovery *DependencyRecovery) GetExecRecipe() *recipe.ExecRecipe {
+	return recovery.execRecipe
+}
+
+func validateDependencyRecovery(value starlark.Value) *startosis_errors.InterpretationError {
+	_, interpretationErr := convertDependencyRecovery(value)
+	return interpretationErr
+}
+
+// convertDependencyRecovery converts a dict of dependency name to either "restart" or an ExecRecipe, e.g.
+// {"db": "restart", "cache": ExecRecipe(command=["reconnect.sh"])}
+func convertDependencyRecovery(value starlark.Value) (map[service.ServiceName]*DependencyRecovery, *startosis_errors.InterpretationError) {
+	recoveryDict, ok := value.(*starlark.Dict)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a dict, got '%s'", OnDependencyRecoveryAttr, value.Type())
+	}
+	recoveries := map[service.ServiceName]*DependencyRecovery{}
+	for _, item := range recoveryDict.Items() {
+		dependency, ok := item[0].(starlark.String)
+		if !ok || dependency.GoString() == "" {
+			return nil, startosis_errors.NewInterpretationError("Keys of attribute '%s' are expected to be non-empty service names, got '%v'", OnDependencyRecoveryAttr, item[0])
+		}
+		switch recoveryValue := item[1].(type) {
+		case starlark.String:
+			if recoveryValue.GoString() != RestartDependencyRecovery {
+				return nil, startosis_errors.NewInterpretationError("The recovery of dependency '%s' in attribute '%s' is expected to be '%s' or an ExecRecipe, got '%s'", dependency.GoString(), OnDependencyRecoveryAttr, RestartDependencyRecovery, recoveryValue.GoString())
+			}
+			recoveries[service.ServiceName(dependency.GoString())] = &DependencyRecovery{
+				execRecipe: nil,
+			}
+		case *recipe.ExecRecipe:
+			recoveries[service.ServiceName(dependency.GoString())] = &DependencyRecovery{
+				execRecipe: recoveryValue,
+			}
+		default:
+			return nil, startosis_errors.NewInterpretationError("The recovery of dependency '%s' in attribute '%s' is expected to be '%s' or an ExecRecipe, got '%s'", dependency.GoString(), OnDependencyRecoveryAttr, RestartDependencyRecovery, item[1].Type())
+		}
+	}
+	return recoveries, nil
+}
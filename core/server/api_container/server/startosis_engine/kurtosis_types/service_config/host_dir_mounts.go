@@ -0,0 +1,41 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"path"
+)
+
+const (
+	rootDirpath = "/"
+)
+
+func validateHostDirMounts(value starlark.Value) *startosis_errors.InterpretationError {
+	_, interpretationErr := convertHostDirMounts(value)
+	return interpretationErr
+}
+
+// convertHostDirMounts converts a dict of mountpoint on the service to absolute dirpath on the host, e.g.
+// {"/app/src": "/home/me/my-app/src"}
+func convertHostDirMounts(value starlark.Value) (map[string]string, *startosis_errors.InterpretationError) {
+	hostDirMountsDict, ok := value.(*starlark.Dict)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a dict, got '%s'", HostDirMountsAttr, value.Type())
+	}
+	hostDirMounts, interpretationErr := kurtosis_types.SafeCastToMapStringString(hostDirMountsDict, HostDirMountsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for mountpoint, hostDirpath := range hostDirMounts {
+		for _, dirpath := range []string{mountpoint, hostDirpath} {
+			if !path.IsAbs(dirpath) {
+				return nil, startosis_errors.NewInterpretationError("'%s' -> '%s' in attribute '%s' must only use absolute paths, but '%s' isn't", mountpoint, hostDirpath, HostDirMountsAttr, dirpath)
+			}
+			if path.Clean(dirpath) == rootDirpath {
+				return nil, startosis_errors.NewInterpretationError("'%s' -> '%s' in attribute '%s' can't mount to or from the root directory", mountpoint, hostDirpath, HostDirMountsAttr)
+			}
+		}
+	}
+	return hostDirMounts, nil
+}
@@ -0,0 +1,193 @@
+package service_config
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkjson"
+)
+
+const (
+	tolerationKeyKey               = "key"
+	tolerationOperatorKey          = "operator"
+	tolerationValueKey             = "value"
+	tolerationEffectKey            = "effect"
+	tolerationTolerationSecondsKey = "toleration_seconds"
+
+	tolerationEqualOperator  = "Equal"
+	tolerationExistsOperator = "Exists"
+
+	noExecuteTaintEffect = "NoExecute"
+
+	jsonEncoderKey = "encode"
+)
+
+var (
+	validTolerationOperators = map[string]bool{
+		tolerationEqualOperator:  true,
+		tolerationExistsOperator: true,
+	}
+
+	// An empty effect tolerates every effect
+	validTaintEffects = map[string]bool{
+		"":                   true,
+		"NoSchedule":         true,
+		"PreferNoSchedule":   true,
+		noExecuteTaintEffect: true,
+	}
+)
+
+// extractKubernetesScheduling returns nil if none of the Kubernetes scheduling attributes are set
+func (config *ServiceConfig) extractKubernetesScheduling() (*kurtosis_core_rpc_api_bindings.KubernetesScheduling, *startosis_errors.InterpretationError) {
+	isSchedulingSet := false
+
+	nodeSelector := map[string]string{}
+	nodeSelectorStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, KubernetesNodeSelectorAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && nodeSelectorStarlark.Len() > 0 {
+		nodeSelector, interpretationErr = kurtosis_types.SafeCastToMapStringString(nodeSelectorStarlark, KubernetesNodeSelectorAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		isSchedulingSet = true
+	}
+
+	tolerations := []*kurtosis_core_rpc_api_bindings.KubernetesToleration{}
+	tolerationsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, KubernetesTolerationsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && tolerationsStarlark.Len() > 0 {
+		for index := 0; index < tolerationsStarlark.Len(); index++ {
+			toleration, interpretationErr := convertToleration(tolerationsStarlark.Index(index), fmt.Sprintf("%v[%v]", KubernetesTolerationsAttr, index))
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			tolerations = append(tolerations, toleration)
+		}
+		isSchedulingSet = true
+	}
+
+	serializedAffinity := ""
+	affinityStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, KubernetesAffinityAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && affinityStarlark.Len() > 0 {
+		serializedAffinity, interpretationErr = encodeAsJson(affinityStarlark, KubernetesAffinityAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		isSchedulingSet = true
+	}
+
+	serializedTopologySpreadConstraints := ""
+	topologySpreadConstraintsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, KubernetesTopologySpreadConstraintsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && topologySpreadConstraintsStarlark.Len() > 0 {
+		for index := 0; index < topologySpreadConstraintsStarlark.Len(); index++ {
+			if _, ok := topologySpreadConstraintsStarlark.Index(index).(*starlark.Dict); !ok {
+				return nil, startosis_errors.NewInterpretationError("Expected '%v[%v]' to be a dict, got '%v'", KubernetesTopologySpreadConstraintsAttr, index, topologySpreadConstraintsStarlark.Index(index).Type())
+			}
+		}
+		serializedTopologySpreadConstraints, interpretationErr = encodeAsJson(topologySpreadConstraintsStarlark, KubernetesTopologySpreadConstraintsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		isSchedulingSet = true
+	}
+
+	if !isSchedulingSet {
+		return nil, nil
+	}
+	return binding_constructors.NewKubernetesScheduling(nodeSelector, tolerations, serializedAffinity, serializedTopologySpreadConstraints), nil
+}
+
+// convertToleration converts a toleration dict following the Kubernetes pod spec, e.g.
+// {"key": "nvidia.com/gpu", "operator": "Exists", "effect": "NoSchedule"}
+func convertToleration(value starlark.Value, attrNameForLogging string) (*kurtosis_core_rpc_api_bindings.KubernetesToleration, *startosis_errors.InterpretationError) {
+	tolerationDict, ok := value.(*starlark.Dict)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Expected '%v' to be a dict, got '%v'", attrNameForLogging, value.Type())
+	}
+
+	tolerationStrings := map[string]string{
+		tolerationKeyKey:      "",
+		tolerationOperatorKey: tolerationEqualOperator,
+		tolerationValueKey:    "",
+		tolerationEffectKey:   "",
+	}
+	var tolerationSeconds *int64
+	for _, item := range tolerationDict.Items() {
+		key, interpretationErr := kurtosis_types.SafeCastToString(item[0], fmt.Sprintf("%v.key:%v", attrNameForLogging, item[0]))
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if key == tolerationTolerationSecondsKey {
+			tolerationSecondsStarlark, ok := item[1].(starlark.Int)
+			if !ok {
+				return nil, startosis_errors.NewInterpretationError("Expected '%v[\"%v\"]' to be an int, got '%v'", attrNameForLogging, key, item[1].Type())
+			}
+			tolerationSecondsValue, ok := tolerationSecondsStarlark.Int64()
+			if !ok {
+				return nil, startosis_errors.NewInterpretationError("Value '%v' of '%v[\"%v\"]' doesn't fit in an int64", tolerationSecondsStarlark, attrNameForLogging, key)
+			}
+			tolerationSeconds = &tolerationSecondsValue
+			continue
+		}
+		if _, found := tolerationStrings[key]; !found {
+			return nil, startosis_errors.NewInterpretationError("Unknown key '%v' in '%v'; valid keys are '%v', '%v', '%v', '%v' and '%v'",
+				key, attrNameForLogging, tolerationKeyKey, tolerationOperatorKey, tolerationValueKey, tolerationEffectKey, tolerationTolerationSecondsKey)
+		}
+		tolerationStrings[key], interpretationErr = kurtosis_types.SafeCastToString(item[1], fmt.Sprintf("%v[\"%v\"]", attrNameForLogging, key))
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	key := tolerationStrings[tolerationKeyKey]
+	operator := tolerationStrings[tolerationOperatorKey]
+	tolerationValue := tolerationStrings[tolerationValueKey]
+	effect := tolerationStrings[tolerationEffectKey]
+	if !validTolerationOperators[operator] {
+		return nil, startosis_errors.NewInterpretationError("Invalid operator '%v' in '%v'; expected '%v' or '%v'", operator, attrNameForLogging, tolerationEqualOperator, tolerationExistsOperator)
+	}
+	if !validTaintEffects[effect] {
+		return nil, startosis_errors.NewInterpretationError("Invalid effect '%v' in '%v'; expected 'NoSchedule', 'PreferNoSchedule' or '%v'", effect, attrNameForLogging, noExecuteTaintEffect)
+	}
+	if operator == tolerationExistsOperator && tolerationValue != "" {
+		return nil, startosis_errors.NewInterpretationError("'%v' has operator '%v' so it can't have a value", attrNameForLogging, tolerationExistsOperator)
+	}
+	if key == "" && operator != tolerationExistsOperator {
+		return nil, startosis_errors.NewInterpretationError("'%v' has no key, which requires operator '%v'", attrNameForLogging, tolerationExistsOperator)
+	}
+	if tolerationSeconds != nil && effect != noExecuteTaintEffect {
+		return nil, startosis_errors.NewInterpretationError("'%v' sets '%v', which only applies to effect '%v'", attrNameForLogging, tolerationTolerationSecondsKey, noExecuteTaintEffect)
+	}
+	return binding_constructors.NewKubernetesToleration(key, operator, tolerationValue, effect, tolerationSeconds), nil
+}
+
+// encodeAsJson serializes values passed through to the Kubernetes pod spec as-is
+func encodeAsJson(value starlark.Value, attrNameForLogging string) (string, *startosis_errors.InterpretationError) {
+	encoder, ok := starlarkjson.Module.Members[jsonEncoderKey].(*starlark.Builtin)
+	if !ok {
+		return "", startosis_errors.NewInterpretationError("Unable to serialize '%v' because the Starlark JSON serializer could not be loaded", attrNameForLogging)
+	}
+	serializedValue, err := encoder.CallInternal(&starlark.Thread{}, starlark.Tuple{value}, nil) //nolint:exhaustruct
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "Unable to serialize '%v' to JSON", attrNameForLogging)
+	}
+	serializedValueStr, ok := serializedValue.(starlark.String)
+	if !ok {
+		return "", startosis_errors.NewInterpretationError("Serializing '%v' to JSON returned '%v' instead of a string", attrNameForLogging, serializedValue.Type())
+	}
+	return serializedValueStr.GoString(), nil
+}
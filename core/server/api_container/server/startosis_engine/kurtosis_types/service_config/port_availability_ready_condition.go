@@ -0,0 +1,112 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"time"
+)
+
+const (
+	PortAvailabilityReadyConditionTypeName = "PortAvailabilityReadyCondition"
+
+	PortIdAttr = "port_id"
+)
+
+func NewPortAvailabilityReadyConditionType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: PortAvailabilityReadyConditionTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              IntervalAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, IntervalAttr)
+					},
+				},
+				{
+					Name:              TimeoutAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutAttr)
+					},
+				},
+			},
+		},
+		Instantiate: instantiatePortAvailabilityReadyCondition,
+	}
+}
+
+func instantiatePortAvailabilityReadyCondition(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, err := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(PortAvailabilityReadyConditionTypeName, arguments)
+	if err != nil {
+		return nil, err
+	}
+	return &PortAvailabilityReadyCondition{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// PortAvailabilityReadyCondition is a starlark.Value for a service being ready once one of its private ports accepts
+// connections, for services like databases that don't have an HTTP endpoint to check
+type PortAvailabilityReadyCondition struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (readyCondition *PortAvailabilityReadyCondition) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := readyCondition.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &PortAvailabilityReadyCondition{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (readyCondition *PortAvailabilityReadyCondition) GetPortId() (string, *startosis_errors.InterpretationError) {
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](readyCondition.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
+			PortIdAttr, PortAvailabilityReadyConditionTypeName)
+	}
+	return portId.GoString(), nil
+}
+
+func (readyCondition *PortAvailabilityReadyCondition) GetInterval() (time.Duration, *startosis_errors.InterpretationError) {
+	return extractDurationAttrValue(readyCondition.KurtosisValueTypeDefault, IntervalAttr, defaultInterval)
+}
+
+func (readyCondition *PortAvailabilityReadyCondition) GetTimeout() (time.Duration, *startosis_errors.InterpretationError) {
+	return extractDurationAttrValue(readyCondition.KurtosisValueTypeDefault, TimeoutAttr, defaultTimeout)
+}
+
+func extractDurationAttrValue(valueType *kurtosis_type_constructor.KurtosisValueTypeDefault, attrName string, defaultDuration time.Duration) (time.Duration, *startosis_errors.InterpretationError) {
+	durationStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](valueType, attrName)
+	if interpretationErr != nil {
+		return defaultDuration, interpretationErr
+	}
+	if !found {
+		return defaultDuration, nil
+	}
+	duration, parseErr := time.ParseDuration(durationStr.GoString())
+	if parseErr != nil {
+		return defaultDuration, startosis_errors.WrapWithInterpretationError(parseErr, "An error occurred when parsing %s '%v'", attrName, durationStr.GoString())
+	}
+	return duration, nil
+}
@@ -3,6 +3,7 @@ package service_config
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
@@ -28,6 +29,28 @@ const (
 	CpuAllocationAttr               = "cpu_allocation"
 	MemoryAllocationAttr            = "memory_allocation"
 	ReadyConditionsAttr             = "ready_conditions"
+	ReadyConditionsModeAttr         = "ready_conditions_mode"
+	ReplicasAttr                    = "replicas"
+	CpusetAttr                      = "cpuset"
+	NumaNodesAttr                   = "numa_nodes"
+	UlimitsAttr                     = "ulimits"
+	SysctlsAttr                     = "sysctls"
+	ShmSizeAttr                     = "shm_size"
+	HostDirMountsAttr               = "host_dir_mounts"
+	MetricsPortIdAttr               = "metrics_port_id"
+	OnDependencyRecoveryAttr        = "on_dependency_recovery"
+
+	minReplicas = 1
+
+	// A list of IDs or ranges of IDs, e.g. "0-3,6", as used by the Linux cpusets
+	cpusetListRegexp = `^\d+(-\d+)?(,\d+(-\d+)?)*$`
+
+	KubernetesServiceAccountAttr            = "kubernetes_service_account"
+	KubernetesServiceAccountAnnotationsAttr = "kubernetes_service_account_annotations"
+	KubernetesNodeSelectorAttr              = "kubernetes_node_selector"
+	KubernetesTolerationsAttr               = "kubernetes_tolerations"
+	KubernetesAffinityAttr                  = "kubernetes_affinity"
+	KubernetesTopologySpreadConstraintsAttr = "kubernetes_topology_spread_constraints"
 )
 
 func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -115,7 +138,115 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 				{
 					Name:              ReadyConditionsAttr,
 					IsOptional:        true,
-					ZeroValueProvider: builtin_argument.ZeroValueProvider[*ReadyCondition],
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateReadyConditions,
+				},
+				{
+					Name:              ReadyConditionsModeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         validateReadyConditionsMode,
+				},
+				{
+					Name:              ReplicasAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, ReplicasAttr, minReplicas, math.MaxUint32)
+					},
+				},
+				{
+					Name:              CpusetAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringRegexp(value, CpusetAttr, cpusetListRegexp)
+					},
+				},
+				{
+					Name:              NumaNodesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringRegexp(value, NumaNodesAttr, cpusetListRegexp)
+					},
+				},
+				{
+					Name:              UlimitsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         validateUlimits,
+				},
+				{
+					Name:              SysctlsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         validateSysctls,
+				},
+				{
+					Name:              ShmSizeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, ShmSizeAttr, 1, math.MaxUint64)
+					},
+				},
+				{
+					Name:              HostDirMountsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         validateHostDirMounts,
+				},
+				{
+					Name:              MetricsPortIdAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, MetricsPortIdAttr)
+					},
+				},
+				{
+					Name:              OnDependencyRecoveryAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         validateDependencyRecovery,
+				},
+				{
+					Name:              KubernetesServiceAccountAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, KubernetesServiceAccountAttr)
+					},
+				},
+				{
+					Name:              KubernetesServiceAccountAnnotationsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              KubernetesNodeSelectorAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              KubernetesTolerationsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              KubernetesAffinityAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              KubernetesTopologySpreadConstraintsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
 					Validator:         nil,
 				},
 			},
@@ -282,19 +413,167 @@ func (config *ServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.S
 		builder.WithMemoryAllocationMegabytes(memoryAllocation)
 	}
 
+	replicasStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ReplicasAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		replicas, ok := replicasStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", ReplicasAttr, replicasStarlark)
+		}
+		builder.WithReplicas(uint32(replicas))
+	}
+
+	cpusetStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, CpusetAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		builder.WithCpuset(cpusetStarlark.GoString())
+	}
+
+	numaNodesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, NumaNodesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		builder.WithNumaNodes(numaNodesStarlark.GoString())
+	}
+
+	ulimitsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, UlimitsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && ulimitsStarlark.Len() > 0 {
+		ulimits, interpretationErr := convertUlimits(ulimitsStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builder.WithUlimits(ulimits)
+	}
+
+	sysctlsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, SysctlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && sysctlsStarlark.Len() > 0 {
+		sysctls, interpretationErr := convertSysctls(sysctlsStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builder.WithSysctls(sysctls)
+	}
+
+	shmSizeStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ShmSizeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		shmSize, ok := shmSizeStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", ShmSizeAttr, shmSizeStarlark)
+		}
+		builder.WithShmSizeMegabytes(shmSize)
+	}
+
+	hostDirMountsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, HostDirMountsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && hostDirMountsStarlark.Len() > 0 {
+		hostDirMounts, interpretationErr := convertHostDirMounts(hostDirMountsStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builder.WithHostDirMounts(hostDirMounts)
+	}
+
+	metricsPortIdStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, MetricsPortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		isMetricsPortDeclared := false
+		if portsStarlark != nil {
+			_, isMetricsPortDeclared, _ = portsStarlark.Get(metricsPortIdStarlark)
+		}
+		if !isMetricsPortDeclared {
+			return nil, startosis_errors.NewInterpretationError("Attribute '%s' is '%s', but there is no port with that ID in attribute '%s'", MetricsPortIdAttr, metricsPortIdStarlark.GoString(), PortsAttr)
+		}
+		builder.WithMetricsPortId(metricsPortIdStarlark.GoString())
+	}
+
+	kubernetesServiceAccountStarlark, foundKubernetesServiceAccount, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, KubernetesServiceAccountAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	kubernetesServiceAccountAnnotationsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, KubernetesServiceAccountAnnotationsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	kubernetesServiceAccountAnnotations := map[string]string{}
+	if found && kubernetesServiceAccountAnnotationsStarlark.Len() > 0 {
+		if !foundKubernetesServiceAccount {
+			return nil, startosis_errors.NewInterpretationError("Attribute '%s' can only be set along with attribute '%s' on type '%s'",
+				KubernetesServiceAccountAnnotationsAttr, KubernetesServiceAccountAttr, ServiceConfigTypeName)
+		}
+		kubernetesServiceAccountAnnotations, interpretationErr = kurtosis_types.SafeCastToMapStringString(kubernetesServiceAccountAnnotationsStarlark, KubernetesServiceAccountAnnotationsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+	if foundKubernetesServiceAccount {
+		builder.WithKubernetesServiceAccount(kubernetesServiceAccountStarlark.GoString(), kubernetesServiceAccountAnnotations)
+	}
+
+	kubernetesScheduling, interpretationErr := config.extractKubernetesScheduling()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if kubernetesScheduling != nil {
+		builder.WithKubernetesScheduling(kubernetesScheduling)
+	}
+
 	return builder.Build(), nil
 }
 
-func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_errors.InterpretationError) {
-	readyConditions, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*ReadyCondition](config.KurtosisValueTypeDefault, ReadyConditionsAttr)
+// GetReadyConditions returns nil if the service has no ready conditions
+func (config *ServiceConfig) GetReadyConditions() (*ServiceReadyConditions, *startosis_errors.InterpretationError) {
+	rawReadyConditions, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Value](config.KurtosisValueTypeDefault, ReadyConditionsAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 	if !found {
 		return nil, nil
 	}
+	readyConditions, interpretationErr := convertReadyConditions(rawReadyConditions)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	mode := AllReadyConditionsMode
+	rawMode, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, ReadyConditionsModeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		mode = ReadyConditionsMode(rawMode.GoString())
+	}
+	return NewServiceReadyConditions(readyConditions, mode), nil
+}
 
-	return readyConditions, nil
+// GetDependencyRecoveries returns what happens to the service when each of its dependencies gets restarted, keyed by
+// dependency; empty if nothing happens
+func (config *ServiceConfig) GetDependencyRecoveries() (map[service.ServiceName]*DependencyRecovery, *startosis_errors.InterpretationError) {
+	recoveriesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, OnDependencyRecoveryAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return map[service.ServiceName]*DependencyRecovery{}, nil
+	}
+	return convertDependencyRecovery(recoveriesStarlark)
 }
 
 func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value starlark.Value, dictForLogging *starlark.Dict) (string, *kurtosis_core_rpc_api_bindings.Port, *startosis_errors.InterpretationError) {
@@ -0,0 +1,101 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"reflect"
+	"time"
+)
+
+type ReadyConditionsMode string
+
+const (
+	// AllReadyConditionsMode means the service is ready once all its ready conditions pass
+	AllReadyConditionsMode ReadyConditionsMode = "all"
+
+	// AnyReadyConditionsMode means the service is ready as soon as one of its ready conditions passes
+	AnyReadyConditionsMode ReadyConditionsMode = "any"
+)
+
+// ServiceReadyCondition is a single condition a service must meet, either a ReadyCondition checking a recipe or a
+// PortAvailabilityReadyCondition
+type ServiceReadyCondition interface {
+	starlark.Value
+
+	GetInterval() (time.Duration, *startosis_errors.InterpretationError)
+
+	GetTimeout() (time.Duration, *startosis_errors.InterpretationError)
+}
+
+// ServiceReadyConditions are the conditions a service must meet before Kurtosis considers it ready
+type ServiceReadyConditions struct {
+	conditions []ServiceReadyCondition
+
+	mode ReadyConditionsMode
+}
+
+func NewServiceReadyConditions(conditions []ServiceReadyCondition, mode ReadyConditionsMode) *ServiceReadyConditions {
+	return &ServiceReadyConditions{
+		conditions: conditions,
+		mode:       mode,
+	}
+}
+
+func (readyConditions *ServiceReadyConditions) GetConditions() []ServiceReadyCondition {
+	return readyConditions.conditions
+}
+
+func (readyConditions *ServiceReadyConditions) GetMode() ReadyConditionsMode {
+	return readyConditions.mode
+}
+
+// validateReadyConditions accepts either a single ready condition or a non-empty list of them, where ready conditions
+// are ReadyCondition or PortAvailabilityReadyCondition
+func validateReadyConditions(value starlark.Value) *startosis_errors.InterpretationError {
+	_, interpretationErr := convertReadyConditions(value)
+	return interpretationErr
+}
+
+func validateReadyConditionsMode(value starlark.Value) *startosis_errors.InterpretationError {
+	mode, ok := value.(starlark.String)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be a starlark.String but was '%s'", ReadyConditionsModeAttr, reflect.TypeOf(value))
+	}
+	switch ReadyConditionsMode(mode.GoString()) {
+	case AllReadyConditionsMode, AnyReadyConditionsMode:
+		return nil
+	}
+	return startosis_errors.NewInterpretationError("Invalid value '%s' for '%s'; valid values are '%s' and '%s'", mode.GoString(), ReadyConditionsModeAttr, AllReadyConditionsMode, AnyReadyConditionsMode)
+}
+
+func convertReadyConditions(value starlark.Value) ([]ServiceReadyCondition, *startosis_errors.InterpretationError) {
+	if readyCondition, ok := castReadyCondition(value); ok {
+		return []ServiceReadyCondition{readyCondition}, nil
+	}
+	readyConditionsList, ok := value.(*starlark.List)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Value for '%s' was expected to be a %s, a %s or a list of them but was '%s'", ReadyConditionsAttr, ReadyConditionTypeName, PortAvailabilityReadyConditionTypeName, reflect.TypeOf(value))
+	}
+	if readyConditionsList.Len() == 0 {
+		return nil, startosis_errors.NewInterpretationError("The list of '%s' must contain at least one %s or %s", ReadyConditionsAttr, ReadyConditionTypeName, PortAvailabilityReadyConditionTypeName)
+	}
+	result := []ServiceReadyCondition{}
+	for idx := 0; idx < readyConditionsList.Len(); idx++ {
+		readyCondition, ok := castReadyCondition(readyConditionsList.Index(idx))
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Element #%d of '%s' was expected to be a %s or a %s but was '%s'", idx, ReadyConditionsAttr, ReadyConditionTypeName, PortAvailabilityReadyConditionTypeName, reflect.TypeOf(readyConditionsList.Index(idx)))
+		}
+		result = append(result, readyCondition)
+	}
+	return result, nil
+}
+
+func castReadyCondition(value starlark.Value) (ServiceReadyCondition, bool) {
+	switch readyCondition := value.(type) {
+	case *ReadyCondition:
+		return readyCondition, true
+	case *PortAvailabilityReadyCondition:
+		return readyCondition, true
+	}
+	return nil, false
+}
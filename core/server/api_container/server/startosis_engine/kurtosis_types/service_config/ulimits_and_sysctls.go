@@ -0,0 +1,175 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"sort"
+	"strings"
+)
+
+const (
+	ulimitSoftKey = "soft"
+	ulimitHardKey = "hard"
+
+	unlimitedUlimit = -1
+)
+
+var (
+	// The resource limits of setrlimit(2), which is what the container runtime sets them with
+	validUlimitNames = map[string]bool{
+		"core":       true,
+		"cpu":        true,
+		"data":       true,
+		"fsize":      true,
+		"locks":      true,
+		"memlock":    true,
+		"msgqueue":   true,
+		"nice":       true,
+		"nofile":     true,
+		"nproc":      true,
+		"rss":        true,
+		"rtprio":     true,
+		"rttime":     true,
+		"sigpending": true,
+		"stack":      true,
+	}
+
+	// Only the sysctls of the kernel namespaces a container gets its own copy of (IPC and network) can be set per
+	// container; the other ones, e.g. vm.max_map_count, are shared with the whole host
+	namespacedSysctlPrefixes = []string{
+		"kernel.msg",
+		"kernel.sem",
+		"kernel.shm",
+		"fs.mqueue.",
+		"net.",
+	}
+)
+
+func validateUlimits(value starlark.Value) *startosis_errors.InterpretationError {
+	_, interpretationErr := convertUlimits(value)
+	return interpretationErr
+}
+
+func validateSysctls(value starlark.Value) *startosis_errors.InterpretationError {
+	_, interpretationErr := convertSysctls(value)
+	return interpretationErr
+}
+
+// convertUlimits converts a dict of ulimit name to either a single limit used as both the soft and hard limit, or a
+// {"soft": ..., "hard": ...} dict, e.g. {"nofile": 65536, "nproc": {"soft": 4096, "hard": 8192}}
+func convertUlimits(value starlark.Value) (map[string]*kurtosis_core_rpc_api_bindings.Ulimit, *startosis_errors.InterpretationError) {
+	ulimitsDict, ok := value.(*starlark.Dict)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a dict, got '%s'", UlimitsAttr, value.Type())
+	}
+	ulimits := map[string]*kurtosis_core_rpc_api_bindings.Ulimit{}
+	for _, item := range ulimitsDict.Items() {
+		name, ok := item[0].(starlark.String)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("Keys of attribute '%s' are expected to be strings, got '%s'", UlimitsAttr, item[0].Type())
+		}
+		if !validUlimitNames[name.GoString()] {
+			return nil, startosis_errors.NewInterpretationError("'%s' in attribute '%s' isn't a valid ulimit name; valid ones are '%s'", name.GoString(), UlimitsAttr, strings.Join(getSortedKeys(validUlimitNames), "', '"))
+		}
+		ulimit, interpretationErr := convertUlimit(name.GoString(), item[1])
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		ulimits[name.GoString()] = ulimit
+	}
+	return ulimits, nil
+}
+
+func convertUlimit(name string, value starlark.Value) (*kurtosis_core_rpc_api_bindings.Ulimit, *startosis_errors.InterpretationError) {
+	var soft, hard int64
+	switch ulimitValue := value.(type) {
+	case starlark.Int:
+		limit, interpretationErr := convertUlimitValue(name, ulimitValue)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		soft, hard = limit, limit
+	case *starlark.Dict:
+		for _, item := range ulimitValue.Items() {
+			key, ok := item[0].(starlark.String)
+			if !ok || (key.GoString() != ulimitSoftKey && key.GoString() != ulimitHardKey) {
+				return nil, startosis_errors.NewInterpretationError("Ulimit '%s' can only have keys '%s' and '%s', got '%v'", name, ulimitSoftKey, ulimitHardKey, item[0])
+			}
+		}
+		var interpretationErr *startosis_errors.InterpretationError
+		if soft, interpretationErr = extractUlimitDictValue(name, ulimitValue, ulimitSoftKey); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		if hard, interpretationErr = extractUlimitDictValue(name, ulimitValue, ulimitHardKey); interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	default:
+		return nil, startosis_errors.NewInterpretationError("Ulimit '%s' is expected to be an int or a dict with keys '%s' and '%s', got '%s'", name, ulimitSoftKey, ulimitHardKey, value.Type())
+	}
+	if hard != unlimitedUlimit && (soft == unlimitedUlimit || soft > hard) {
+		return nil, startosis_errors.NewInterpretationError("The soft limit of ulimit '%s' can't be higher than its hard limit, got soft limit '%d' and hard limit '%d'", name, soft, hard)
+	}
+	return binding_constructors.NewUlimit(soft, hard), nil
+}
+
+func extractUlimitDictValue(name string, ulimitDict *starlark.Dict, key string) (int64, *startosis_errors.InterpretationError) {
+	value, found, err := ulimitDict.Get(starlark.String(key))
+	if err != nil {
+		return 0, startosis_errors.WrapWithInterpretationError(err, "An error occurred getting key '%s' of ulimit '%s'", key, name)
+	}
+	if !found {
+		return 0, startosis_errors.NewInterpretationError("Ulimit '%s' is missing key '%s'", name, key)
+	}
+	limit, ok := value.(starlark.Int)
+	if !ok {
+		return 0, startosis_errors.NewInterpretationError("Key '%s' of ulimit '%s' is expected to be an int, got '%s'", key, name, value.Type())
+	}
+	return convertUlimitValue(name, limit)
+}
+
+func convertUlimitValue(name string, value starlark.Int) (int64, *startosis_errors.InterpretationError) {
+	limit, ok := value.Int64()
+	if !ok || limit < unlimitedUlimit {
+		return 0, startosis_errors.NewInterpretationError("Limits of ulimit '%s' are expected to be positive, or %d for unlimited, got '%v'", name, unlimitedUlimit, value)
+	}
+	return limit, nil
+}
+
+// convertSysctls converts a dict of sysctl name to value, e.g. {"net.core.somaxconn": "1024"}
+func convertSysctls(value starlark.Value) (map[string]string, *startosis_errors.InterpretationError) {
+	sysctlsDict, ok := value.(*starlark.Dict)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("Attribute '%s' is expected to be a dict, got '%s'", SysctlsAttr, value.Type())
+	}
+	sysctls, interpretationErr := kurtosis_types.SafeCastToMapStringString(sysctlsDict, SysctlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for name := range sysctls {
+		if !isNamespacedSysctl(name) {
+			return nil, startosis_errors.NewInterpretationError("Sysctl '%s' in attribute '%s' isn't namespaced, so it applies to the whole host and can't be set per service; set it on the host instead. Only sysctls starting with '%s' can be set per service", name, SysctlsAttr, strings.Join(namespacedSysctlPrefixes, "', '"))
+		}
+	}
+	return sysctls, nil
+}
+
+func isNamespacedSysctl(name string) bool {
+	for _, prefix := range namespacedSysctlPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func getSortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
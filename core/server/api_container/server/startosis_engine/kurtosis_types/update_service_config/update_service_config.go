@@ -6,6 +6,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
 )
@@ -13,6 +15,9 @@ import (
 const (
 	UpdateServiceConfigTypeName = "UpdateServiceConfig"
 	SubnetworkAttr              = "subnetwork"
+	ImageAttr                   = "image"
+	PortsAttr                   = "ports"
+	EnvVarsAttr                 = "env_vars"
 )
 
 func NewUpdateServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -23,12 +28,32 @@ func NewUpdateServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstru
 			Arguments: []*builtin_argument.BuiltinArgument{
 				{
 					Name:              SubnetworkAttr,
-					IsOptional:        false,
+					IsOptional:        true,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
 						return builtin_argument.NonEmptyString(value, SubnetworkAttr)
 					},
 				},
+				{
+					Name:              ImageAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ImageAttr)
+					},
+				},
+				{
+					Name:              PortsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              EnvVarsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -60,15 +85,72 @@ func (config *UpdateServiceConfig) Copy() (builtin_argument.KurtosisValueType, e
 	}, nil
 }
 
+// ToKurtosisType returns the update to apply to the service. Setting the image, the ports or the environment
+// variables recreates the container of the service, while setting only the subnetwork moves it without restarting it
 func (config *UpdateServiceConfig) ToKurtosisType() (*kurtosis_core_rpc_api_bindings.UpdateServiceConfig, *startosis_errors.InterpretationError) {
+	var subnetwork *string
 	subnetworkStarlarkValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
 		config.KurtosisValueTypeDefault, SubnetworkAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	if !found {
-		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'",
-			SubnetworkAttr, UpdateServiceConfigTypeName)
+	if found {
+		subnetworkStr := subnetworkStarlarkValue.GoString()
+		subnetwork = &subnetworkStr
+	}
+
+	var containerImageName *string
+	imageStarlarkValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		config.KurtosisValueTypeDefault, ImageAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		imageStr := imageStarlarkValue.GoString()
+		containerImageName = &imageStr
+	}
+
+	var privatePorts map[string]*kurtosis_core_rpc_api_bindings.Port
+	portsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		config.KurtosisValueTypeDefault, PortsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && portsStarlark.Len() > 0 {
+		privatePorts = make(map[string]*kurtosis_core_rpc_api_bindings.Port, portsStarlark.Len())
+		for _, portItem := range portsStarlark.Items() {
+			portId, ok := portItem[0].(starlark.String)
+			if !ok {
+				return nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", PortsAttr, portsStarlark)
+			}
+			portSpec, ok := portItem[1].(*port_spec.PortSpec)
+			if !ok {
+				return nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a port object", PortsAttr, portsStarlark)
+			}
+			apiPortSpec, interpretationErr := portSpec.ToKurtosisType()
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			privatePorts[portId.GoString()] = apiPortSpec
+		}
+	}
+
+	var envVars map[string]string
+	envVarsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		config.KurtosisValueTypeDefault, EnvVarsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && envVarsStarlark.Len() > 0 {
+		envVars, interpretationErr = kurtosis_types.SafeCastToMapStringString(envVarsStarlark, EnvVarsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	if subnetwork == nil && containerImageName == nil && len(privatePorts) == 0 && len(envVars) == 0 {
+		return nil, startosis_errors.NewInterpretationError("At least one of '%s', '%s', '%s' or '%s' needs to be set on type '%s'",
+			SubnetworkAttr, ImageAttr, PortsAttr, EnvVarsAttr, UpdateServiceConfigTypeName)
 	}
-	return binding_constructors.NewUpdateServiceConfig(subnetworkStarlarkValue.GoString()), nil
+	return binding_constructors.NewContainerUpdateServiceConfig(subnetwork, containerImageName, privatePorts, envVars), nil
 }
@@ -24,6 +24,10 @@ const (
 	commandKey     = "command"
 	serviceNameKey = "service_name"
 	ExecRecipeName = "ExecRecipe"
+
+	// The output ends up in the runtime value store and in the responses of the API container, so only its beginning is
+	// kept; the command still runs to completion however big its output is
+	maxExecOutputSizeBytes = 10 * 1024 * 1024
 )
 
 // TODO: maybe change command to startlark.List once remove backward compatability support
@@ -108,16 +112,33 @@ func (recipe *ExecRecipe) Execute(
 		return nil, stacktrace.NewError("The service name parameter can't be an empty string")
 	}
 
-	exitCode, commandOutput, err := serviceNetwork.ExecCommand(ctx, serviceNameStr, commandWithRuntimeValue)
+	commandOutput := newTruncatingWriter(maxExecOutputSizeBytes)
+	exitCode, err := serviceNetwork.ExecCommandWithStreamedOutput(ctx, serviceNameStr, commandWithRuntimeValue, commandOutput)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to execute command '%v' on service '%v'", recipe.command, serviceName)
 	}
+	if commandOutput.numDiscardedBytes > 0 {
+		logrus.Warnf("The output of command '%v' on service '%v' was truncated to its first %v bytes; %v bytes were discarded", recipe.command, serviceName, maxExecOutputSizeBytes, commandOutput.numDiscardedBytes)
+	}
 	return map[string]starlark.Comparable{
-		execOutputKey:   starlark.String(commandOutput),
+		execOutputKey:   starlark.String(commandOutput.output.String()),
 		execExitCodeKey: starlark.MakeInt(int(exitCode)),
 	}, nil
 }
 
+// GetExitCode returns the exit code of the command from the result of Execute
+func (recipe *ExecRecipe) GetExitCode(resultMap map[string]starlark.Comparable) (int, error) {
+	exitCode, ok := resultMap[execExitCodeKey].(starlark.Int)
+	if !ok {
+		return 0, stacktrace.NewError("Expected the result of exec recipe '%v' to have an int '%v' but it was '%v'", recipe.String(), execExitCodeKey, resultMap[execExitCodeKey])
+	}
+	exitCodeInt, ok := exitCode.Int64()
+	if !ok {
+		return 0, stacktrace.NewError("Exit code '%v' of exec recipe '%v' doesn't fit an int", exitCode, recipe.String())
+	}
+	return int(exitCodeInt), nil
+}
+
 func (recipe *ExecRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
 	exitCode := resultMap[execExitCodeKey]
 	rawOutput := resultMap[execOutputKey]
@@ -178,3 +199,35 @@ func convertListToStarlarkList(inputList []string) *starlark.List {
 	}
 	return starlark.NewList(elems)
 }
+
+// truncatingWriter keeps the first bytes written to it and discards the rest without failing, so the writes of the
+// command output never block or error on it
+type truncatingWriter struct {
+	output *strings.Builder
+
+	maxSizeBytes int
+
+	numDiscardedBytes int
+}
+
+func newTruncatingWriter(maxSizeBytes int) *truncatingWriter {
+	return &truncatingWriter{
+		output:            &strings.Builder{},
+		maxSizeBytes:      maxSizeBytes,
+		numDiscardedBytes: 0,
+	}
+}
+
+func (writer *truncatingWriter) Write(bytes []byte) (int, error) {
+	numBytesToKeep := writer.maxSizeBytes - writer.output.Len()
+	if numBytesToKeep > len(bytes) {
+		numBytesToKeep = len(bytes)
+	}
+	if numBytesToKeep > 0 {
+		writer.output.Write(bytes[:numBytesToKeep])
+	} else {
+		numBytesToKeep = 0
+	}
+	writer.numDiscardedBytes += len(bytes) - numBytesToKeep
+	return len(bytes), nil
+}
@@ -13,3 +13,20 @@ func TestExecRecipe_String(t *testing.T) {
 	execRecipeString := execRecipe.String()
 	require.Equal(t, expectedRecipeString, execRecipeString)
 }
+
+func TestTruncatingWriter_KeepsOnlyTheBeginningOfTheOutput(t *testing.T) {
+	writer := newTruncatingWriter(8)
+
+	numBytesWritten, err := writer.Write([]byte("hello "))
+	require.NoError(t, err)
+	require.Equal(t, 6, numBytesWritten)
+	numBytesWritten, err = writer.Write([]byte("world"))
+	require.NoError(t, err)
+	require.Equal(t, 5, numBytesWritten)
+	numBytesWritten, err = writer.Write([]byte("!"))
+	require.NoError(t, err)
+	require.Equal(t, 1, numBytesWritten)
+
+	require.Equal(t, "hello wo", writer.output.String())
+	require.Equal(t, 4, writer.numDiscardedBytes)
+}
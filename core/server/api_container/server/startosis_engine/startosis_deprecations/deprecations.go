@@ -0,0 +1,51 @@
+package startosis_deprecations
+
+type DeprecationCode string
+
+const (
+	ServiceConfigPublicPortsCode DeprecationCode = "SERVICE_CONFIG_PUBLIC_PORTS"
+
+	// The builtins are referenced by name rather than through their constants so that this package can be used by
+	// the Starlark framework without creating an import cycle with the builtins
+	serviceConfigTypeName           = "ServiceConfig"
+	serviceConfigPublicPortsArgName = "public_ports"
+)
+
+// Deprecation describes a Starlark feature that still works but will get removed. Runs using it get a warning with
+// its code, or fail when run in strict mode
+type Deprecation struct {
+	Code DeprecationCode
+
+	BuiltinName string
+
+	// Empty when the whole builtin is deprecated
+	ArgumentName string
+
+	Description string
+}
+
+var deprecations = []*Deprecation{
+	{
+		Code:         ServiceConfigPublicPortsCode,
+		BuiltinName:  serviceConfigTypeName,
+		ArgumentName: serviceConfigPublicPortsArgName,
+		Description:  "Static public ports only work on Docker and will get removed; declare the ports under 'ports' and read the ports they got published on from the service",
+	},
+}
+
+// GetDeprecations returns all the Starlark features deprecated in this version of Kurtosis
+func GetDeprecations() []*Deprecation {
+	deprecationsCopy := make([]*Deprecation, len(deprecations))
+	copy(deprecationsCopy, deprecations)
+	return deprecationsCopy
+}
+
+func getBuiltinDeprecations(builtinName string) []*Deprecation {
+	var builtinDeprecations []*Deprecation
+	for _, deprecation := range deprecations {
+		if deprecation.BuiltinName == builtinName {
+			builtinDeprecations = append(builtinDeprecations, deprecation)
+		}
+	}
+	return builtinDeprecations
+}
@@ -0,0 +1,113 @@
+package startosis_deprecations
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"sync"
+)
+
+type contextKey string
+
+const (
+	trackerContextKey contextKey = "deprecation-tracker"
+
+	trackerThreadLocalKey = "kurtosis-deprecation-tracker"
+
+	strictModeErrorMsg = "Deprecated feature '%s' used at %s[%d:%d] and the run is in strict mode, which turns deprecation warnings into errors. %s"
+)
+
+// Tracker collects the deprecated features used by a Starlark run while it gets interpreted, warning about each of them
+// once only, wherever it got first used
+type Tracker struct {
+	mutex *sync.Mutex
+
+	isStrict bool
+
+	reportedCodes map[DeprecationCode]bool
+
+	warnings []*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning
+}
+
+func NewTracker(isStrict bool) *Tracker {
+	return &Tracker{
+		mutex:         &sync.Mutex{},
+		isStrict:      isStrict,
+		reportedCodes: map[DeprecationCode]bool{},
+		warnings:      []*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning{},
+	}
+}
+
+// GetWarnings returns the warnings of the deprecated features used so far, in the order they got first used
+func (tracker *Tracker) GetWarnings() []*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	warningsCopy := make([]*kurtosis_core_rpc_api_bindings.StarlarkDeprecationWarning, len(tracker.warnings))
+	copy(warningsCopy, tracker.warnings)
+	return warningsCopy
+}
+
+func (tracker *Tracker) record(deprecation *Deprecation, position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition) *startosis_errors.InterpretationError {
+	message := getWarningMessage(deprecation)
+	if tracker.isStrict {
+		return startosis_errors.NewInterpretationError(strictModeErrorMsg, deprecation.Code, position.GetFilename(), position.GetLine(), position.GetColumn(), message)
+	}
+
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	if tracker.reportedCodes[deprecation.Code] {
+		return nil
+	}
+	tracker.reportedCodes[deprecation.Code] = true
+	tracker.warnings = append(tracker.warnings, binding_constructors.NewStarlarkDeprecationWarning(string(deprecation.Code), message, position))
+	return nil
+}
+
+func WithTracker(ctx context.Context, tracker *Tracker) context.Context {
+	return context.WithValue(ctx, trackerContextKey, tracker)
+}
+
+// GetTracker returns the tracker of the run the context belongs to, or nil if it has none
+func GetTracker(ctx context.Context) *Tracker {
+	tracker, found := ctx.Value(trackerContextKey).(*Tracker)
+	if !found {
+		return nil
+	}
+	return tracker
+}
+
+// SetTrackerOnThread makes the builtins called by the thread report the deprecated features they use to the tracker
+func SetTrackerOnThread(thread *starlark.Thread, tracker *Tracker) {
+	if tracker == nil {
+		return
+	}
+	thread.SetLocal(trackerThreadLocalKey, tracker)
+}
+
+// CheckBuiltinCall records the deprecated features used by a call to the builtin, if the thread making it has a
+// tracker. It returns an error when one is used and the run is in strict mode
+func CheckBuiltinCall(thread *starlark.Thread, builtinName string, isArgumentSet func(argumentName string) bool, position *kurtosis_core_rpc_api_bindings.StarlarkInstructionPosition) *startosis_errors.InterpretationError {
+	tracker, found := thread.Local(trackerThreadLocalKey).(*Tracker)
+	if !found {
+		return nil
+	}
+	for _, deprecation := range getBuiltinDeprecations(builtinName) {
+		if deprecation.ArgumentName != "" && !isArgumentSet(deprecation.ArgumentName) {
+			continue
+		}
+		if interpretationErr := tracker.record(deprecation, position); interpretationErr != nil {
+			return interpretationErr
+		}
+	}
+	return nil
+}
+
+func getWarningMessage(deprecation *Deprecation) string {
+	if deprecation.ArgumentName == "" {
+		return fmt.Sprintf("'%s' is deprecated. %s", deprecation.BuiltinName, deprecation.Description)
+	}
+	return fmt.Sprintf("'%s' of '%s' is deprecated. %s", deprecation.ArgumentName, deprecation.BuiltinName, deprecation.Description)
+}
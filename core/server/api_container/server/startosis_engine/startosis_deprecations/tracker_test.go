@@ -0,0 +1,67 @@
+package startosis_deprecations
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+var (
+	testPosition = binding_constructors.NewStarlarkInstructionPosition("main.star", 3, 5)
+)
+
+func isPublicPortsSet(argumentName string) bool {
+	return argumentName == serviceConfigPublicPortsArgName
+}
+
+func isNothingSet(_ string) bool {
+	return false
+}
+
+func TestCheckBuiltinCall_WarnsOncePerCode(t *testing.T) {
+	tracker := NewTracker(false)
+	thread := &starlark.Thread{}
+	SetTrackerOnThread(thread, tracker)
+
+	require.Nil(t, CheckBuiltinCall(thread, serviceConfigTypeName, isPublicPortsSet, testPosition))
+	require.Nil(t, CheckBuiltinCall(thread, serviceConfigTypeName, isPublicPortsSet, binding_constructors.NewStarlarkInstructionPosition("main.star", 8, 5)))
+
+	warnings := tracker.GetWarnings()
+	require.Len(t, warnings, 1)
+	require.Equal(t, string(ServiceConfigPublicPortsCode), warnings[0].GetCode())
+	require.Equal(t, testPosition, warnings[0].GetPosition())
+}
+
+func TestCheckBuiltinCall_IgnoresDeprecatedArgumentsNotSet(t *testing.T) {
+	tracker := NewTracker(true)
+	thread := &starlark.Thread{}
+	SetTrackerOnThread(thread, tracker)
+
+	require.Nil(t, CheckBuiltinCall(thread, serviceConfigTypeName, isNothingSet, testPosition))
+	require.Empty(t, tracker.GetWarnings())
+}
+
+func TestCheckBuiltinCall_FailsInStrictMode(t *testing.T) {
+	tracker := NewTracker(true)
+	thread := &starlark.Thread{}
+	SetTrackerOnThread(thread, tracker)
+
+	interpretationErr := CheckBuiltinCall(thread, serviceConfigTypeName, isPublicPortsSet, testPosition)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), string(ServiceConfigPublicPortsCode))
+	require.Empty(t, tracker.GetWarnings())
+}
+
+func TestCheckBuiltinCall_NoopWithoutTracker(t *testing.T) {
+	thread := &starlark.Thread{}
+	require.Nil(t, CheckBuiltinCall(thread, serviceConfigTypeName, isPublicPortsSet, testPosition))
+}
+
+func TestGetDeprecations_CodesAreUnique(t *testing.T) {
+	seenCodes := map[DeprecationCode]bool{}
+	for _, deprecation := range GetDeprecations() {
+		require.False(t, seenCodes[deprecation.Code], "Code '%v' is used by several deprecations", deprecation.Code)
+		seenCodes[deprecation.Code] = true
+	}
+}
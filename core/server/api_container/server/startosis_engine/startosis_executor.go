@@ -4,9 +4,17 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/crash_diagnostics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/run_profiles"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/secrets"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/execution_progress"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"sync"
+	"time"
 )
 
 const (
@@ -16,15 +24,18 @@ const (
 
 type StartosisExecutor struct {
 	mutex *sync.Mutex
+
+	runProfileStore *run_profiles.RunProfileStore
 }
 
 type ExecutionError struct {
 	Error string
 }
 
-func NewStartosisExecutor() *StartosisExecutor {
+func NewStartosisExecutor(runProfileStore *run_profiles.RunProfileStore) *StartosisExecutor {
 	return &StartosisExecutor{
-		mutex: &sync.Mutex{},
+		mutex:           &sync.Mutex{},
+		runProfileStore: runProfileStore,
 	}
 }
 
@@ -35,7 +46,10 @@ func NewStartosisExecutor() *StartosisExecutor {
 // - A regular KurtosisInstruction that was successfully executed
 // - A KurtosisExecutionError if the execution failed
 // - A ProgressInfo to update the current "state" of the execution
-func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, instructions []kurtosis_instruction.KurtosisInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+//
+// Runs executed for real get profiled: the time each instruction took and what it created get stored under the run ID
+// sent in the RunFinishedEvent
+func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, packageId string, instructions []kurtosis_instruction.KurtosisInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	ctxWithParallelism := context.WithValue(ctx, ParallelismParam, parallelism)
@@ -44,6 +58,15 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 			executor.mutex.Unlock()
 			close(starlarkRunResponseLineStream)
 		}()
+		// Instructions run here rather than in the runner's goroutine, so their panics have to be recorded here too
+		defer crash_diagnostics.GetCrashDiagnosticsRecorder().RecoverAndRecordPanic()
+
+		runId, err := uuid_generator.GenerateUUIDString()
+		if err != nil {
+			// The run isn't worth failing for its profile, which just won't be findable by the ID
+			logrus.Warnf("An error occurred generating the ID of the run, its profile won't be retrievable by ID:\n%v", err)
+		}
+		runProfile := binding_constructors.NewStarlarkRunProfile(runId, packageId, timestamppb.Now(), []*kurtosis_core_rpc_api_bindings.StarlarkInstructionProfile{})
 
 		totalNumberOfInstructions := uint32(len(instructions))
 		for index, instruction := range instructions {
@@ -52,17 +75,30 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 				progressMsg, instructionNumber, totalNumberOfInstructions)
 			starlarkRunResponseLineStream <- progress
 
-			canonicalInstruction := binding_constructors.NewStarlarkRunResponseLineFromInstruction(instruction.GetCanonicalInstruction())
-			starlarkRunResponseLineStream <- canonicalInstruction
+			canonicalInstruction := instruction.GetCanonicalInstruction()
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstruction(canonicalInstruction)
 
 			if !dryRun {
-				instructionOutput, err := instruction.Execute(ctxWithParallelism)
+				// Instructions that take long, like waits, report what they're doing along with the progress message
+				progressReporter := execution_progress.NewReporter(func(progressLines []string) {
+					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromMultilineProgressInfo(
+						append([]string{progressMsg}, progressLines...), instructionNumber, totalNumberOfInstructions)
+				})
+				instructionRecorder := run_profiles.NewInstructionRecorder()
+				instructionCtx := run_profiles.WithInstructionRecorder(execution_progress.WithReporter(ctxWithParallelism, progressReporter), instructionRecorder)
+				instructionStartTime := time.Now()
+				instructionOutput, err := instruction.Execute(instructionCtx)
+				instructionDuration := time.Since(instructionStartTime)
+				progressReporter.Done()
+				runProfile.Instructions = append(runProfile.Instructions, newInstructionProfile(instructionNumber, canonicalInstruction, instructionDuration, instructionRecorder, err == nil))
 				if err != nil {
 
 					propagatedError := stacktrace.Propagate(err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
-					serializedError := binding_constructors.NewStarlarkExecutionError(propagatedError.Error())
+					serializedError := binding_constructors.NewStarlarkExecutionError(secrets.GetSecretsRegistry().Redact(propagatedError.Error()))
 					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionError(serializedError)
-					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+					// Stored before the run finishes so that it's there for clients asking for it as soon as it finishes
+					executor.runProfileStore.AddRunProfile(runProfile)
+					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionFailureEvent(runId)
 					return
 				}
 				if instructionOutput != nil {
@@ -72,7 +108,41 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 		}
 
 		// TODO(gb): we should run magic string replacement on the output
-		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(serializedScriptOutput)
+		if dryRun {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(serializedScriptOutput)
+			return
+		}
+		executor.runProfileStore.AddRunProfile(runProfile)
+		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromExecutionSuccessEvent(serializedScriptOutput, runId)
 	}()
 	return starlarkRunResponseLineStream
 }
+
+// newInstructionProfile creates the profile of an executed instruction, logging it so that it's in the API container
+// logs too
+func newInstructionProfile(
+	instructionNumber uint32,
+	canonicalInstruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction,
+	duration time.Duration,
+	instructionRecorder *run_profiles.InstructionRecorder,
+	isSuccessful bool,
+) *kurtosis_core_rpc_api_bindings.StarlarkInstructionProfile {
+	instructionProfile := binding_constructors.NewStarlarkInstructionProfile(
+		canonicalInstruction.GetPosition(),
+		canonicalInstruction.GetInstructionName(),
+		uint64(duration.Microseconds()),
+		instructionRecorder.GetNumContainersCreated(),
+		instructionRecorder.GetArtifactBytesTransferred(),
+		isSuccessful,
+	)
+	logrus.Infof(
+		"Instruction %d '%s' took %v, created %d containers and stored %d bytes of files artifacts (successful: %v)",
+		instructionNumber,
+		instructionProfile.GetInstructionName(),
+		duration,
+		instructionProfile.GetNumContainersCreated(),
+		instructionProfile.GetArtifactBytesTransferred(),
+		isSuccessful,
+	)
+	return instructionProfile
+}
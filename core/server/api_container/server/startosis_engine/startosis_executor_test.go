@@ -5,6 +5,8 @@ import (
 	"errors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/run_profiles"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/execution_progress"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
@@ -23,6 +25,7 @@ const (
 
 	noScriptOutputObject = ""
 	noParallelism        = 1
+	testPackageId        = "github.com/kurtosis-tech/test-package"
 )
 
 var (
@@ -32,7 +35,7 @@ var (
 
 func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 
-	executor := NewStartosisExecutor()
+	executor := NewStartosisExecutor(run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles))
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
@@ -57,7 +60,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 }
 
 func TestExecuteKurtosisInstructions_ExecuteForReal_FailureHalfWay(t *testing.T) {
-	executor := NewStartosisExecutor()
+	executor := NewStartosisExecutor(run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles))
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", throwOnExecute)
@@ -94,7 +97,7 @@ instruction2()
 }
 
 func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
-	executor := NewStartosisExecutor()
+	executor := NewStartosisExecutor(run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles))
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
@@ -119,6 +122,86 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	require.Equal(t, serializedInstruction, expectedSerializedInstructions)
 }
 
+func TestExecuteKurtosisInstructions_InstructionsReportTheirProgress(t *testing.T) {
+	executor := NewStartosisExecutor(run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles))
+
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+	instruction.EXPECT().GetCanonicalInstruction().Maybe().Return(binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), "wait", "wait()", noInstructionArgsForTesting))
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(func(ctx context.Context) (*string, error) {
+		execution_progress.Report(ctx, "Waiting on service 'api'", "Last failure: connection refused")
+		return nil, nil
+	}).Times(1)
+
+	progressInfos := []*kurtosis_core_rpc_api_bindings.StarlarkRunProgress{}
+	executionResponseLines := executor.Execute(context.Background(), executeForReal, noParallelism, testPackageId, []kurtosis_instruction.KurtosisInstruction{instruction}, noScriptOutputObject)
+	for executionResponseLine := range executionResponseLines {
+		if executionResponseLine.GetProgressInfo() != nil {
+			progressInfos = append(progressInfos, executionResponseLine.GetProgressInfo())
+		}
+	}
+
+	// the first progress info is sent when the instruction starts, the second one by the instruction itself
+	require.Len(t, progressInfos, 2)
+	require.Equal(t, []string{progressMsg, "Waiting on service 'api'", "Last failure: connection refused"}, progressInfos[1].GetCurrentStepInfo())
+	require.Equal(t, uint32(1), progressInfos[1].GetCurrentStepNumber())
+	require.Equal(t, uint32(1), progressInfos[1].GetTotalSteps())
+}
+
+func TestExecuteKurtosisInstructions_RunGetsProfiled(t *testing.T) {
+	runProfileStore := run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles)
+	executor := NewStartosisExecutor(runProfileStore)
+
+	instruction1 := mock_instruction.NewMockKurtosisInstruction(t)
+	instruction1.EXPECT().GetCanonicalInstruction().Maybe().Return(binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), "add_service", "add_service()", noInstructionArgsForTesting))
+	instruction1.EXPECT().Execute(mock.Anything).RunAndReturn(func(ctx context.Context) (*string, error) {
+		run_profiles.RecordContainersCreated(ctx, 1)
+		return nil, nil
+	}).Times(1)
+	instruction2 := mock_instruction.NewMockKurtosisInstruction(t)
+	instruction2.EXPECT().GetCanonicalInstruction().Maybe().Return(binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), "upload_files", "upload_files()", noInstructionArgsForTesting))
+	instruction2.EXPECT().Execute(mock.Anything).RunAndReturn(func(ctx context.Context) (*string, error) {
+		run_profiles.RecordArtifactBytesTransferred(ctx, 2048)
+		return nil, nil
+	}).Times(1)
+
+	var runFinishedEvent *kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent
+	executionResponseLines := executor.Execute(context.Background(), executeForReal, noParallelism, testPackageId, []kurtosis_instruction.KurtosisInstruction{instruction1, instruction2}, noScriptOutputObject)
+	for executionResponseLine := range executionResponseLines {
+		if executionResponseLine.GetRunFinishedEvent() != nil {
+			runFinishedEvent = executionResponseLine.GetRunFinishedEvent()
+		}
+	}
+	require.NotNil(t, runFinishedEvent)
+	require.True(t, runFinishedEvent.GetIsRunSuccessful())
+	require.NotEmpty(t, runFinishedEvent.GetRunId())
+
+	runProfile, err := runProfileStore.GetRunProfile(runFinishedEvent.GetRunId())
+	require.NoError(t, err)
+	require.Equal(t, testPackageId, runProfile.GetPackageId())
+	require.Len(t, runProfile.GetInstructions(), 2)
+	require.Equal(t, "add_service", runProfile.GetInstructions()[0].GetInstructionName())
+	require.Equal(t, uint32(1), runProfile.GetInstructions()[0].GetNumContainersCreated())
+	require.Zero(t, runProfile.GetInstructions()[0].GetArtifactBytesTransferred())
+	require.True(t, runProfile.GetInstructions()[0].GetIsSuccessful())
+	require.Equal(t, "upload_files", runProfile.GetInstructions()[1].GetInstructionName())
+	require.Equal(t, uint64(2048), runProfile.GetInstructions()[1].GetArtifactBytesTransferred())
+}
+
+func TestExecuteKurtosisInstructions_DryRunDoesntGetProfiled(t *testing.T) {
+	runProfileStore := run_profiles.NewRunProfileStore(run_profiles.DefaultMaxNumRunProfiles)
+	executor := NewStartosisExecutor(runProfileStore)
+
+	instruction := createMockInstruction(t, "instruction1", executeSuccessfully)
+	_, _, err := executeSynchronously(t, executor, doDryRun, []kurtosis_instruction.KurtosisInstruction{instruction})
+	require.Nil(t, err)
+
+	_, profileErr := runProfileStore.GetRunProfile("")
+	require.Error(t, profileErr)
+}
+
 func createMockInstruction(t *testing.T, instructionName string, executeSuccessfully bool) *mock_instruction.MockKurtosisInstruction {
 	instruction := mock_instruction.NewMockKurtosisInstruction(t)
 
@@ -142,7 +225,7 @@ func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool
 	scriptOutput := strings.Builder{}
 	var serializedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
 
-	executionResponseLines := executor.Execute(context.Background(), dryRun, noParallelism, instructions, noScriptOutputObject)
+	executionResponseLines := executor.Execute(context.Background(), dryRun, noParallelism, testPackageId, instructions, noScriptOutputObject)
 	for executionResponseLine := range executionResponseLines {
 		if executionResponseLine.GetError() != nil {
 			return scriptOutput.String(), serializedInstructions, executionResponseLine.GetError().GetExecutionError()
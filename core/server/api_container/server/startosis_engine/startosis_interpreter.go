@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/package_io"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_deprecations"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/sirupsen/logrus"
@@ -59,17 +60,19 @@ type StartosisInterpreter struct {
 	moduleGlobalsCache map[string]*startosis_packages.ModuleCacheEntry
 	// TODO AUTH there will be a leak here in case people with different repo visibility access a module
 	moduleContentProvider startosis_packages.PackageContentProvider
+	enclaveInfo           *builtins.EnclaveInfo
 }
 
 type SerializedInterpretationOutput string
 
-func NewStartosisInterpreter(serviceNetwork service_network.ServiceNetwork, moduleContentProvider startosis_packages.PackageContentProvider, runtimeValueStore *runtime_value_store.RuntimeValueStore) *StartosisInterpreter {
+func NewStartosisInterpreter(serviceNetwork service_network.ServiceNetwork, moduleContentProvider startosis_packages.PackageContentProvider, runtimeValueStore *runtime_value_store.RuntimeValueStore, enclaveInfo *builtins.EnclaveInfo) *StartosisInterpreter {
 	return &StartosisInterpreter{
 		mutex:                 &sync.Mutex{},
 		serviceNetwork:        serviceNetwork,
 		recipeExecutor:        runtimeValueStore,
 		moduleGlobalsCache:    make(map[string]*startosis_packages.ModuleCacheEntry),
 		moduleContentProvider: moduleContentProvider,
+		enclaveInfo:           enclaveInfo,
 	}
 }
 
@@ -78,12 +81,14 @@ func NewStartosisInterpreter(serviceNetwork service_network.ServiceNetwork, modu
 //     code, inconsistent). Can be nil if the script was successfully interpreted
 //   - The list of Kurtosis instructions that was generated based on the interpretation of the script. It can be empty
 //     if the interpretation of the script failed
-func (interpreter *StartosisInterpreter) Interpret(_ context.Context, packageId string, serializedStarlark string, serializedJsonParams string) (string, []kurtosis_instruction.KurtosisInstruction, *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) {
+func (interpreter *StartosisInterpreter) Interpret(ctx context.Context, packageId string, serializedStarlark string, serializedJsonParams string) (string, []kurtosis_instruction.KurtosisInstruction, *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) {
 	interpreter.mutex.Lock()
 	defer interpreter.mutex.Unlock()
 	var instructionsQueue []kurtosis_instruction.KurtosisInstruction
+	// Nil when the caller doesn't track the deprecated features used by the script
+	deprecationTracker := startosis_deprecations.GetTracker(ctx)
 	logrus.Debugf("Interpreting package '%v' with contents '%v' and params '%v'", packageId, serializedStarlark, serializedJsonParams)
-	globalVariables, interpretationErr := interpreter.interpretInternal(packageId, serializedStarlark, &instructionsQueue)
+	globalVariables, interpretationErr := interpreter.interpretInternal(packageId, serializedStarlark, &instructionsQueue, deprecationTracker)
 	if interpretationErr != nil {
 		return startosis_constants.NoOutputObject, nil, interpretationErr.ToAPIType()
 	}
@@ -100,7 +105,7 @@ func (interpreter *StartosisInterpreter) Interpret(_ context.Context, packageId
 		return missingRunFunctionReturnValue(packageId)
 	}
 
-	runFunctionExecutionThread := newStarlarkThread(starlarkGoThreadName)
+	runFunctionExecutionThread := newStarlarkThread(starlarkGoThreadName, deprecationTracker)
 
 	if runFunction.NumParams() > maximumParamsAllowedForRunFunction {
 		return "", nil, startosis_errors.NewInterpretationError("The 'run' entrypoint function can have at most '%v' argument got '%v'", maximumParamsAllowedForRunFunction, runFunction.NumParams()).ToAPIType()
@@ -113,7 +118,7 @@ func (interpreter *StartosisInterpreter) Interpret(_ context.Context, packageId
 			return "", nil, startosis_errors.NewInterpretationError(unexpectedArgNameError, planParamIndex, planParamName, paramName).ToAPIType()
 		}
 		kurtosisPlanInstructions := KurtosisPlanInstructions(interpreter.serviceNetwork, interpreter.recipeExecutor, interpreter.moduleContentProvider)
-		planModule := plan_module.PlanModule(&instructionsQueue, kurtosisPlanInstructions)
+		planModule := plan_module.PlanModule(&instructionsQueue, kurtosisPlanInstructions, interpreter.recipeExecutor)
 		argsTuple = append(argsTuple, planModule)
 	}
 
@@ -146,12 +151,12 @@ func (interpreter *StartosisInterpreter) Interpret(_ context.Context, packageId
 	return startosis_constants.NoOutputObject, instructionsQueue, nil
 }
 
-func (interpreter *StartosisInterpreter) interpretInternal(packageId string, serializedStarlark string, instructionsQueue *[]kurtosis_instruction.KurtosisInstruction) (starlark.StringDict, *startosis_errors.InterpretationError) {
+func (interpreter *StartosisInterpreter) interpretInternal(packageId string, serializedStarlark string, instructionsQueue *[]kurtosis_instruction.KurtosisInstruction, deprecationTracker *startosis_deprecations.Tracker) (starlark.StringDict, *startosis_errors.InterpretationError) {
 	// We spin up a new thread for every call to interpreterInternal such that the stacktrace provided by the Starlark
 	// Go interpreter is relative to each individual thread, and we don't keep accumulating stacktrace entries from the
 	// previous calls inside the same thread
-	thread := newStarlarkThread(packageId)
-	predeclared, interpretationErr := interpreter.buildBindings(instructionsQueue)
+	thread := newStarlarkThread(packageId, deprecationTracker)
+	predeclared, interpretationErr := interpreter.buildBindings(instructionsQueue, deprecationTracker)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -164,16 +169,16 @@ func (interpreter *StartosisInterpreter) interpretInternal(packageId string, ser
 	return globalVariables, nil
 }
 
-func (interpreter *StartosisInterpreter) buildBindings(instructionsQueue *[]kurtosis_instruction.KurtosisInstruction) (*starlark.StringDict, *startosis_errors.InterpretationError) {
+func (interpreter *StartosisInterpreter) buildBindings(instructionsQueue *[]kurtosis_instruction.KurtosisInstruction, deprecationTracker *startosis_deprecations.Tracker) (*starlark.StringDict, *startosis_errors.InterpretationError) {
 	recursiveInterpretForModuleLoading := func(moduleId string, serializedStartosis string) (starlark.StringDict, *startosis_errors.InterpretationError) {
-		result, err := interpreter.interpretInternal(moduleId, serializedStartosis, instructionsQueue)
+		result, err := interpreter.interpretInternal(moduleId, serializedStartosis, instructionsQueue, deprecationTracker)
 		if err != nil {
 			return nil, err
 		}
 		return result, nil
 	}
 
-	kurtosisModule, interpretationErr := builtins.KurtosisModule()
+	kurtosisModule, interpretationErr := builtins.KurtosisModule(interpreter.enclaveInfo)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -279,12 +284,14 @@ func missingRunFunctionReturnValue(packageId string) (string, []kurtosis_instruc
 	return "", nil, startosis_errors.NewInterpretationError("No 'run' function found in file '%v/main.star'; a 'run' entrypoint function with the signature `run(args)` or `run()` is required in the main.star file of any Kurtosis package", packageId).ToAPIType()
 }
 
-func newStarlarkThread(threadName string) *starlark.Thread {
-	return &starlark.Thread{
+func newStarlarkThread(threadName string, deprecationTracker *startosis_deprecations.Tracker) *starlark.Thread {
+	thread := &starlark.Thread{
 		Name:       threadName,
 		Print:      makePrintFunction(),
 		Load:       makeLoadFunction(),
 		OnMaxSteps: nil,
 		Steps:      0,
 	}
+	startosis_deprecations.SetTrackerOnThread(thread, deprecationTracker)
+	return thread
 }
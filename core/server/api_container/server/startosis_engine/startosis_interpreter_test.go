@@ -7,15 +7,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/print_builtin"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/conditional"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/kurtosis_print"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_deprecations"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
 	"github.com/stretchr/testify/require"
@@ -27,6 +30,7 @@ import (
 var (
 	testServiceNetwork   = service_network.NewMockServiceNetworkCustom(map[service.ServiceName]net.IP{testServiceName: testServiceIpAddress})
 	testServiceIpAddress = net.ParseIP("127.0.0.1")
+	testEnclaveInfo      = builtins.NewEnclaveInfo("test-enclave", "test-enclave-uuid", "docker", "X.Y.Z")
 )
 
 const (
@@ -40,7 +44,7 @@ func TestStartosisInterpreter_SimplePrintScript(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 	defer packageContentProvider.RemoveAll()
-	startosisInterpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	startosisInterpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	interpreter := startosisInterpreter
 	script := `
 def run(plan):
@@ -56,11 +60,46 @@ def run(plan):
 	validateScriptOutputFromPrintInstructions(t, instructions, expectedOutput)
 }
 
+func TestStartosisInterpreter_EnclaveInfo(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	defer packageContentProvider.RemoveAll()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
+	script := `
+def run(plan):
+	enclave = kurtosis.enclave
+	plan.print(enclave.name + " " + enclave.uuid + " " + enclave.backend_type + " " + enclave.api_container_version)
+`
+
+	_, instructions, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.Nil(t, interpretationError)
+	require.Len(t, instructions, 1)
+
+	expectedOutput := `test-enclave test-enclave-uuid docker X.Y.Z
+`
+	validateScriptOutputFromPrintInstructions(t, instructions, expectedOutput)
+}
+
+func TestStartosisInterpreter_EnclaveInfoIsReadOnly(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	defer packageContentProvider.RemoveAll()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
+	script := `
+def run(plan):
+	kurtosis.enclave.backend_type = "kubernetes"
+`
+
+	_, instructions, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.NotNil(t, interpretationError)
+	require.Empty(t, instructions)
+}
+
 func TestStartosisInterpreter_Test(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
 	defer packageContentProvider.RemoveAll()
-	startosisInterpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	startosisInterpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	interpreter := startosisInterpreter
 	script := `
 def run(plan):
@@ -84,7 +123,7 @@ func TestStartosisInterpreter_DefineFactAndWait(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	get_recipe = GetHttpRequestRecipe(
@@ -106,7 +145,7 @@ func TestStartosisInterpreter_ScriptFailingSingleError(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Starting Startosis script!")
@@ -130,7 +169,7 @@ func TestStartosisInterpreter_ScriptFailingMultipleErrors(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Starting Startosis script!")
@@ -157,7 +196,7 @@ func TestStartosisInterpreter_ScriptFailingSyntaxError(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run():
 	plan.print("Starting Startosis script!")
@@ -180,7 +219,7 @@ func TestStartosisInterpreter_ValidSimpleScriptWithInstruction(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	privateIPAddressPlaceholder := "MAGICAL_PLACEHOLDER_TO_REPLACE"
 	script := `
 def run(plan):
@@ -219,7 +258,7 @@ func TestStartosisInterpreter_ValidSimpleScriptWithApplicationProtocol(t *testin
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	privateIPAddressPlaceholder := "MAGICAL_PLACEHOLDER_TO_REPLACE"
 	script := `
 def run(plan):
@@ -257,7 +296,7 @@ func TestStartosisInterpreter_ValidSimpleScriptWithInstructionMissingContainerNa
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Starting Startosis script!")
@@ -292,7 +331,7 @@ func TestStartosisInterpreter_ValidSimpleScriptWithInstructionTypoInProtocol(t *
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Starting Startosis script!")
@@ -326,7 +365,7 @@ func TestStartosisInterpreter_ValidSimpleScriptWithInstructionPortNumberAsString
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Starting Startosis script!")
@@ -360,7 +399,7 @@ func TestStartosisInterpreter_ValidScriptWithMultipleInstructions(t *testing.T)
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 service_name = "example-datastore-server"
 ports = [1323, 1324, 1325]	
@@ -413,7 +452,7 @@ func TestStartosisInterpreter_LoadStatementIsDisallowedInKurtosis(t *testing.T)
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 load("` + barModulePath + `", "a")
 def run(plan):
@@ -440,7 +479,7 @@ func TestStartosisInterpreter_SimpleImport(t *testing.T) {
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 my_module = import_module("` + barModulePath + `")
 def run(plan):
@@ -467,7 +506,7 @@ b = "Hello " + module_bar.a
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 module_doo = import_module("` + moduleDooWhichLoadsModuleBar + `")
 def run(plan):
@@ -497,7 +536,7 @@ b = "Hello " + module_bar.a
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `module_doo = import_module("` + moduleDooLoadsModuleBar + `")
 def run(plan):
 	plan.print(module_doo.b)
@@ -520,7 +559,7 @@ func TestStartosisInterpreter_FailsOnNonExistentModule(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	nonExistentModule := "github.com/non/existent/module.star"
 	script := `
 my_module = import_module("` + nonExistentModule + `")
@@ -545,7 +584,7 @@ def run(plan):
 func TestStartosisInterpreter_RequestInstruction(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
-	startosisInterpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtime_value_store.NewRuntimeValueStore())
+	startosisInterpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtime_value_store.NewRuntimeValueStore(), testEnclaveInfo)
 	interpreter := startosisInterpreter
 	script := `
 def run(plan):
@@ -569,7 +608,7 @@ func TestStartosisInterpreter_ImportingAValidModuleThatPreviouslyFailedToLoadSuc
 	defer packageContentProvider.RemoveAll()
 	barModulePath := "github.com/foo/bar/lib.star"
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 my_module = import_module("` + barModulePath + `")
 def run(plan):
@@ -608,7 +647,7 @@ config = ServiceConfig(
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 module_bar = import_module("` + moduleBar + `")
 def run(plan):
@@ -655,7 +694,7 @@ def deploy_datastore_services(plan):
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 datastore_module = import_module("` + moduleBar + `")
 
@@ -691,7 +730,7 @@ func TestStartosisInterpreter_ImportModuleWithNoGlobalVariables(t *testing.T) {
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 my_module = import_module("` + barModulePath + `")
 def run(plan):
@@ -727,7 +766,7 @@ def deploy_service(plan):
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seedModules))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	scriptA := `
 deployer = import_module("` + moduleBar + `")
 def run(plan):
@@ -776,7 +815,7 @@ func TestStartosisInterpreter_ValidExecScript(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	testRuntimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, testRuntimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, testRuntimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Executing mkdir!")
@@ -795,7 +834,7 @@ func TestStartosisInterpreter_InvalidExecRecipeMissingRequiredCommand(t *testing
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	testRuntimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, testRuntimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, testRuntimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Executing mkdir!")
@@ -826,7 +865,7 @@ func TestStartosisInterpreter_ReadFileFromGithub(t *testing.T) {
 	defer packageContentProvider.RemoveAll()
 	require.Nil(t, packageContentProvider.BulkAddFileContent(seed))
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Reading file from GitHub!")
@@ -848,7 +887,7 @@ func TestStartosisInterpreter_RenderTemplates(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 template_data = {
 			"Name" : "Stranger",
@@ -910,7 +949,7 @@ def call_store_for_me(plan):
 	require.Nil(t, err)
 
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	call_store_for_me_module = import_module("github.com/kurtosis/foo.star")
@@ -935,7 +974,7 @@ func TestStartosisInterpreter_ValidSimpleRemoveService(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Starting Startosis script!")
@@ -961,7 +1000,7 @@ func TestStartosisInterpreter_NoPanicIfUploadIsPassedAPathNotOnDisk(t *testing.T
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.upload_files("` + filePath + `")
@@ -975,7 +1014,7 @@ func TestStartosisInterpreter_RunWithoutArgsNoArgsPassed(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Hello World!")
@@ -994,7 +1033,7 @@ func TestStartosisInterpreter_RunWithoutArgsArgsPassed(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	plan.print("Hello World!")
@@ -1013,7 +1052,7 @@ func TestStartosisInterpreter_RunWithArgsArgsPassed(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan, args):
 	plan.print("My favorite number is {0}".format(args["number"]))
@@ -1032,7 +1071,7 @@ func TestStartosisInterpreter_RunWithArgsNoArgsPassed(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan, args):
 	if hasattr(args, "number"):
@@ -1054,7 +1093,7 @@ func TestStartosisInterpreter_RunWithMoreThanExpectedParams(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan, args, invalid_arg):
 	plan.print("this wouldn't interpret so the text here doesnt matter")
@@ -1073,7 +1112,7 @@ func TestStartosisInterpreter_PrintWithoutPlanErrorsNicely(t *testing.T) {
 	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
 	defer packageContentProvider.RemoveAll()
 	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
-	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore)
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
 	script := `
 def run(plan):
 	print("this doesnt matter")
@@ -1089,6 +1128,93 @@ def run(plan):
 //	TEST HELPERS
 //
 // #####################################################################################################################
+func TestStartosisInterpreter_IfCapturesBranchInstructions(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
+	script := `
+def run(plan):
+	plan.print("Before")
+	plan.if_(
+		value = "initialized",
+		assertion = "==",
+		target_value = "initialized",
+		then = lambda: plan.print("Skipping bootstrap"),
+		else_ = lambda: plan.print("Bootstrapping"),
+	)
+	plan.print("After")
+`
+
+	_, instructions, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.Nil(t, interpretationError)
+	require.Len(t, instructions, 3) // the branch instructions are held by the if_ instruction
+
+	assertInstructionTypeAndPosition(t, instructions[1], conditional.IfBuiltinName, startosis_constants.PackageIdPlaceholderForStandaloneScript, 4, 10)
+
+	instructionOutput, err := instructions[1].Execute(context.Background())
+	require.Nil(t, err)
+	require.Contains(t, *instructionOutput, "Skipping bootstrap")
+	require.NotContains(t, *instructionOutput, "Bootstrapping")
+}
+
+func TestStartosisInterpreter_IfWithInvalidAssertionFails(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
+	script := `
+def run(plan):
+	plan.if_(value = "a", assertion = "=~", target_value = "a", then = lambda: plan.print("a"))
+`
+
+	_, instructions, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.NotNil(t, interpretationError)
+	require.Empty(t, instructions)
+}
+
+func TestStartosisInterpreter_DeprecatedFeatureIsWarnedAboutOnce(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
+	script := `
+def run(plan):
+	ServiceConfig(image = "` + testContainerImageName + `")
+	ServiceConfig(image = "` + testContainerImageName + `", public_ports = {"grpc": PortSpec(number = 1323)})
+	ServiceConfig(image = "` + testContainerImageName + `", public_ports = {"grpc": PortSpec(number = 1324)})
+`
+
+	deprecationTracker := startosis_deprecations.NewTracker(false)
+	ctx := startosis_deprecations.WithTracker(context.Background(), deprecationTracker)
+	_, _, interpretationError := interpreter.Interpret(ctx, startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.Nil(t, interpretationError)
+
+	warnings := deprecationTracker.GetWarnings()
+	require.Len(t, warnings, 1)
+	require.Equal(t, string(startosis_deprecations.ServiceConfigPublicPortsCode), warnings[0].GetCode())
+	expectedPosition := binding_constructors.NewStarlarkInstructionPosition(startosis_constants.PackageIdPlaceholderForStandaloneScript, 4, 15)
+	require.Equal(t, expectedPosition, warnings[0].GetPosition())
+}
+
+func TestStartosisInterpreter_DeprecatedFeatureFailsStrictRun(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+	runtimeValueStore := runtime_value_store.NewRuntimeValueStore()
+	interpreter := NewStartosisInterpreter(testServiceNetwork, packageContentProvider, runtimeValueStore, testEnclaveInfo)
+	script := `
+def run(plan):
+	ServiceConfig(image = "` + testContainerImageName + `", public_ports = {"grpc": PortSpec(number = 1323)})
+`
+
+	deprecationTracker := startosis_deprecations.NewTracker(true)
+	ctx := startosis_deprecations.WithTracker(context.Background(), deprecationTracker)
+	_, instructions, interpretationError := interpreter.Interpret(ctx, startosis_constants.PackageIdPlaceholderForStandaloneScript, script, startosis_constants.EmptyInputArgs)
+	require.NotNil(t, interpretationError)
+	require.Contains(t, interpretationError.GetErrorMessage(), string(startosis_deprecations.ServiceConfigPublicPortsCode))
+	require.Empty(t, instructions)
+}
+
 func validateScriptOutputFromPrintInstructions(t *testing.T, instructions []kurtosis_instruction.KurtosisInstruction, expectedOutput string) {
 	scriptOutput := strings.Builder{}
 	for _, instruction := range instructions {
@@ -4,15 +4,19 @@ import (
 	"errors"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/mholt/archiver"
 	"github.com/sirupsen/logrus"
 	"io"
 	"os"
 	"path"
 	"strings"
+	"sync"
 )
 
 const (
@@ -41,12 +45,23 @@ const (
 type GitPackageContentProvider struct {
 	packagesTmpDir string
 	packagesDir    string
+
+	cache *packageCache
+
+	// The versions the constraints of the packages cloned since the last time they were popped got resolved to
+	resolvedPackageVersions     map[string]string
+	resolvedPackageVersionsLock *sync.Mutex
 }
 
-func NewGitPackageContentProvider(moduleDir string, tmpDir string) *GitPackageContentProvider {
+// NewGitPackageContentProvider uses DefaultPackageCacheMaxSizeBytes as the size limit of the packages directory when
+// cacheMaxSizeBytes is 0
+func NewGitPackageContentProvider(moduleDir string, tmpDir string, cacheMaxSizeBytes uint64) *GitPackageContentProvider {
 	return &GitPackageContentProvider{
-		packagesDir:    moduleDir,
-		packagesTmpDir: tmpDir,
+		packagesDir:                 moduleDir,
+		packagesTmpDir:              tmpDir,
+		cache:                       newPackageCache(moduleDir, cacheMaxSizeBytes),
+		resolvedPackageVersions:     map[string]string{},
+		resolvedPackageVersionsLock: &sync.Mutex{},
 	}
 }
 
@@ -60,6 +75,7 @@ func (provider *GitPackageContentProvider) ClonePackage(packageId string) (strin
 	if interpretationError != nil {
 		return "", interpretationError
 	}
+	provider.cache.markAdded(parsedURL.relativeRepoPath, true)
 
 	relPackagePathToPackagesDir := getPathToPackageRoot(parsedURL)
 	packageAbsolutePathOnDisk := path.Join(provider.packagesDir, relPackagePathToPackagesDir)
@@ -89,6 +105,7 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsideP
 
 	// Return the file path straight if it exists
 	if _, err := os.Stat(pathToFileOnDisk); err == nil {
+		provider.cache.markHit(parsedURL.relativeRepoPath)
 		return pathToFileOnDisk, nil
 	}
 
@@ -104,6 +121,7 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsideP
 	if interpretationError != nil {
 		return "", interpretationError
 	}
+	provider.cache.markAdded(parsedURL.relativeRepoPath, true)
 
 	// check whether kurtosis yaml exists in th path
 	maybeKurtosisYamlPath, err := getKurtosisYamlPathForFileUrl(pathToFileOnDisk, provider.packagesDir)
@@ -175,12 +193,42 @@ func (provider *GitPackageContentProvider) StorePackageContents(packageId string
 	if err != nil {
 		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred while unarchiving '%v' to '%v'", tempFile.Name(), packageAbsolutePathOnDisk)
 	}
+	if err = lockVersionsOfUploadedPackage(provider.packagesDir, packageAbsolutePathOnDisk); err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred locking the versions the lock file of package '%v' says", packageId)
+	}
+	// Packages uploaded from the machine of the user count against the size limit, but aren't cache misses
+	provider.cache.markAdded(parsedPackageId.relativeRepoPath, false)
 
 	return packageAbsolutePathOnDisk, nil
 }
 
+func (provider *GitPackageContentProvider) PopResolvedPackageVersions() map[string]string {
+	provider.resolvedPackageVersionsLock.Lock()
+	defer provider.resolvedPackageVersionsLock.Unlock()
+	resolvedPackageVersions := provider.resolvedPackageVersions
+	provider.resolvedPackageVersions = map[string]string{}
+	return resolvedPackageVersions
+}
+
+func (provider *GitPackageContentProvider) GetCacheInfo() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error) {
+	cacheInfo, err := provider.cache.getInfo()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting info about the package cache")
+	}
+	return cacheInfo, nil
+}
+
+func (provider *GitPackageContentProvider) ClearCache() ([]string, error) {
+	removedRepositories, err := provider.cache.clear()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred clearing the package cache")
+	}
+	return removedRepositories, nil
+}
+
 // atomicClone This first clones to a temporary directory and then moves it
-// TODO make this support versioning via tags, commit hashes or branches
+// The version after the '@' of the URL can be a tag, a branch, a semantic version constraint resolved against the tags
+// of the repository, or a commit, in that order
 func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL) *startosis_errors.InterpretationError {
 	// First we clone into a temporary directory
 	tempRepoDirPath, err := os.MkdirTemp(provider.packagesTmpDir, temporaryRepoDirPattern)
@@ -219,9 +267,16 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 
 	if parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit {
 		referenceTagOrBranch, found, referenceErr := getReferenceName(repo, parsedURL)
-		if err != nil {
+		if referenceErr != nil {
 			return referenceErr
 		}
+		if !found && isVersionConstraint(parsedURL.tagBranchOrCommit) {
+			referenceTagOrBranch, referenceErr = provider.getTagForVersionConstraint(repo, parsedURL)
+			if referenceErr != nil {
+				return referenceErr
+			}
+			found = true
+		}
 
 		checkoutOptions := &git.CheckoutOptions{
 			Hash:   plumbing.Hash{},
@@ -271,6 +326,47 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 	return nil
 }
 
+// getTagForVersionConstraint resolves the version constraint of the URL to a tag of the repository. The tag the
+// constraint was resolved to the first time is recorded in the package lock file and reused afterwards, as long as it
+// still exists
+func (provider *GitPackageContentProvider) getTagForVersionConstraint(repo *git.Repository, parsedURL *ParsedGitURL) (plumbing.ReferenceName, *startosis_errors.InterpretationError) {
+	lockFile, err := shared_utils.ReadPackageLockFile(provider.packagesDir)
+	if err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred reading the package lock file while resolving version constraint '%v' of repository '%v'", parsedURL.tagBranchOrCommit, parsedURL.gitURL)
+	}
+	lockedVersionKey := getLockedVersionKey(parsedURL)
+	if lockedTagName, found := lockFile.ResolvedVersions[lockedVersionKey]; found {
+		lockedTag, err := repo.Tag(lockedTagName)
+		if err == nil {
+			provider.recordResolvedPackageVersion(lockedVersionKey, lockedTagName)
+			return lockedTag.Name(), nil
+		}
+		logrus.Warnf("Version constraint '%v' of repository '%v' was locked to tag '%v' which doesn't exist anymore; resolving it again", parsedURL.tagBranchOrCommit, parsedURL.gitURL, lockedTagName)
+	}
+
+	resolvedTag, found, interpretationErr := resolveVersionConstraint(repo, parsedURL)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("No tag of repository '%v' is a semantic version satisfying version constraint '%v'", parsedURL.gitURL, parsedURL.tagBranchOrCommit)
+	}
+	logrus.Infof("Resolved version constraint '%v' of repository '%v' to tag '%v'", parsedURL.tagBranchOrCommit, parsedURL.gitURL, resolvedTag.Short())
+
+	lockFile.ResolvedVersions[lockedVersionKey] = resolvedTag.Short()
+	if err = shared_utils.WritePackageLockFile(provider.packagesDir, lockFile); err != nil {
+		return "", startosis_errors.WrapWithInterpretationError(err, "An error occurred recording in the package lock file that version constraint '%v' of repository '%v' was resolved to tag '%v'", parsedURL.tagBranchOrCommit, parsedURL.gitURL, resolvedTag.Short())
+	}
+	provider.recordResolvedPackageVersion(lockedVersionKey, resolvedTag.Short())
+	return resolvedTag, nil
+}
+
+func (provider *GitPackageContentProvider) recordResolvedPackageVersion(lockedVersionKey string, tagName string) {
+	provider.resolvedPackageVersionsLock.Lock()
+	defer provider.resolvedPackageVersionsLock.Unlock()
+	provider.resolvedPackageVersions[lockedVersionKey] = tagName
+}
+
 // methods checks whether the root of the package is same as repository root
 // or it is a sub-folder under it
 func getPathToPackageRoot(parsedPackagePath *ParsedGitURL) string {
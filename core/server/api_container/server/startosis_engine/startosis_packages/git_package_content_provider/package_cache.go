@@ -0,0 +1,225 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// Used when no limit is configured, so that long-lived enclaves running many packages don't silently fill the disk
+	DefaultPackageCacheMaxSizeBytes = uint64(2 * 1024 * 1024 * 1024)
+)
+
+// packageCache keeps the repositories in the packages directory under a size limit, by evicting the ones that were
+// used the least recently. Repositories live in '<packages dir>/<author>/<repository>', and the last time they got
+// used is recorded as the modification time of their directory, so that it survives restarts of the API container
+type packageCache struct {
+	// Held while repositories get added to or removed from the packages directory
+	mutex *sync.Mutex
+
+	packagesDir string
+
+	maxSizeBytes uint64
+
+	// A hit is a file of a repository that was already on disk; a miss is a repository that had to be cloned
+	numHits   uint64
+	numMisses uint64
+}
+
+type packageCacheEntry struct {
+	// e.g. 'kurtosis-tech/eth2-package'
+	relativeRepoPath string
+
+	sizeBytes uint64
+
+	lastUsed time.Time
+}
+
+func newPackageCache(packagesDir string, maxSizeBytes uint64) *packageCache {
+	if maxSizeBytes == 0 {
+		maxSizeBytes = DefaultPackageCacheMaxSizeBytes
+	}
+	return &packageCache{
+		mutex:        &sync.Mutex{},
+		packagesDir:  packagesDir,
+		maxSizeBytes: maxSizeBytes,
+		numHits:      0,
+		numMisses:    0,
+	}
+}
+
+// markHit records that a repository already on disk got used
+func (cache *packageCache) markHit(relativeRepoPath string) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.numHits++
+	cache.touchUnlocked(relativeRepoPath)
+}
+
+// markAdded records that a repository was just written to disk, then evicts the least recently used repositories
+// until the cache is back under its size limit. The repository that was just added never gets evicted, even when
+// it's bigger than the limit by itself, as it's about to be used
+func (cache *packageCache) markAdded(relativeRepoPath string, isMiss bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if isMiss {
+		cache.numMisses++
+	}
+	cache.touchUnlocked(relativeRepoPath)
+
+	entries, err := cache.listEntriesUnlocked()
+	if err != nil {
+		logrus.Warnf("An error occurred listing the packages on disk; the package cache won't be checked against its size limit:\n%v", err)
+		return
+	}
+	totalSizeBytes := uint64(0)
+	for _, entry := range entries {
+		totalSizeBytes += entry.sizeBytes
+	}
+	// Entries are sorted from the least to the most recently used
+	for _, entry := range entries {
+		if totalSizeBytes <= cache.maxSizeBytes {
+			return
+		}
+		if entry.relativeRepoPath == relativeRepoPath {
+			continue
+		}
+		if err := cache.removeEntryUnlocked(entry.relativeRepoPath); err != nil {
+			logrus.Warnf("An error occurred evicting package '%v' from the package cache:\n%v", entry.relativeRepoPath, err)
+			continue
+		}
+		logrus.Infof("Evicted package '%v', last used at '%v', as the package cache exceeded its size limit of %v bytes", entry.relativeRepoPath, entry.lastUsed.Format(time.RFC3339), cache.maxSizeBytes)
+		totalSizeBytes -= entry.sizeBytes
+	}
+}
+
+func (cache *packageCache) getInfo() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	entries, err := cache.listEntriesUnlocked()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the packages on disk")
+	}
+	cachedPackages := []*kurtosis_core_rpc_api_bindings.CachedPackage{}
+	totalSizeBytes := uint64(0)
+	for _, entry := range entries {
+		cachedPackages = append(cachedPackages, binding_constructors.NewCachedPackage(entry.relativeRepoPath, entry.sizeBytes, entry.lastUsed.Unix()))
+		totalSizeBytes += entry.sizeBytes
+	}
+	return binding_constructors.NewPackageCacheInfo(cachedPackages, totalSizeBytes, cache.maxSizeBytes, cache.numHits, cache.numMisses), nil
+}
+
+// clear removes all the repositories from disk, returning the ones that were removed. The package lock file is kept,
+// so that version constraints keep resolving to the same tags once the repositories get cloned again
+func (cache *packageCache) clear() ([]string, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	entries, err := cache.listEntriesUnlocked()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the packages on disk")
+	}
+	removedRepositories := []string{}
+	for _, entry := range entries {
+		if err := cache.removeEntryUnlocked(entry.relativeRepoPath); err != nil {
+			return removedRepositories, stacktrace.Propagate(err, "An error occurred removing package '%v' from disk", entry.relativeRepoPath)
+		}
+		removedRepositories = append(removedRepositories, entry.relativeRepoPath)
+	}
+	return removedRepositories, nil
+}
+
+func (cache *packageCache) touchUnlocked(relativeRepoPath string) {
+	now := time.Now()
+	if err := os.Chtimes(path.Join(cache.packagesDir, relativeRepoPath), now, now); err != nil {
+		logrus.Debugf("An error occurred recording that package '%v' got used; it might get evicted earlier than it should:\n%v", relativeRepoPath, err)
+	}
+}
+
+// listEntriesUnlocked returns the repositories on disk, from the least to the most recently used
+func (cache *packageCache) listEntriesUnlocked() ([]*packageCacheEntry, error) {
+	entries := []*packageCacheEntry{}
+	authorDirEntries, err := os.ReadDir(cache.packagesDir)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading packages directory '%v'", cache.packagesDir)
+	}
+	for _, authorDirEntry := range authorDirEntries {
+		// The package lock file lives next to the author directories
+		if !authorDirEntry.IsDir() {
+			continue
+		}
+		authorDirpath := path.Join(cache.packagesDir, authorDirEntry.Name())
+		repoDirEntries, err := os.ReadDir(authorDirpath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading author directory '%v'", authorDirpath)
+		}
+		for _, repoDirEntry := range repoDirEntries {
+			if !repoDirEntry.IsDir() {
+				continue
+			}
+			repoDirInfo, err := repoDirEntry.Info()
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting info about repository directory '%v'", repoDirEntry.Name())
+			}
+			repoDirpath := path.Join(authorDirpath, repoDirEntry.Name())
+			sizeBytes, err := getDirSizeBytes(repoDirpath)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred computing the size of repository directory '%v'", repoDirpath)
+			}
+			entries = append(entries, &packageCacheEntry{
+				relativeRepoPath: path.Join(authorDirEntry.Name(), repoDirEntry.Name()),
+				sizeBytes:        sizeBytes,
+				lastUsed:         repoDirInfo.ModTime(),
+			})
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].lastUsed.Before(entries[j].lastUsed)
+	})
+	return entries, nil
+}
+
+func (cache *packageCache) removeEntryUnlocked(relativeRepoPath string) error {
+	repoDirpath := path.Join(cache.packagesDir, relativeRepoPath)
+	if err := os.RemoveAll(repoDirpath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing repository directory '%v'", repoDirpath)
+	}
+	// The author directory only gets removed if no other repository of the author is left
+	authorDirpath := path.Dir(repoDirpath)
+	if remainingEntries, err := os.ReadDir(authorDirpath); err == nil && len(remainingEntries) == 0 {
+		if err := os.Remove(authorDirpath); err != nil {
+			logrus.Debugf("An error occurred removing empty author directory '%v':\n%v", authorDirpath, err)
+		}
+	}
+	return nil
+}
+
+func getDirSizeBytes(dirpath string) (uint64, error) {
+	sizeBytes := uint64(0)
+	err := filepath.WalkDir(dirpath, func(_ string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEntry.IsDir() {
+			return nil
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return err
+		}
+		sizeBytes += uint64(info.Size())
+		return nil
+	})
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred walking directory '%v'", dirpath)
+	}
+	return sizeBytes, nil
+}
@@ -0,0 +1,91 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	testRepoFileSizeBytes = 100
+	testFilePermission    = 0644
+)
+
+func TestPackageCache_EvictsLeastRecentlyUsedRepositories(t *testing.T) {
+	packagesDir := t.TempDir()
+	cache := newPackageCache(packagesDir, 2*testRepoFileSizeBytes)
+
+	now := time.Now()
+	writeTestRepo(t, packagesDir, "kurtosis-tech/oldest", now.Add(-2*time.Hour))
+	writeTestRepo(t, packagesDir, "kurtosis-tech/recent", now.Add(-1*time.Hour))
+	writeTestRepo(t, packagesDir, "someone-else/cloned", now)
+	cache.markAdded("someone-else/cloned", true)
+
+	cacheInfo, err := cache.getInfo()
+	require.NoError(t, err)
+	require.Len(t, cacheInfo.GetCachedPackages(), 2)
+	require.Equal(t, "kurtosis-tech/recent", cacheInfo.GetCachedPackages()[0].GetRepository())
+	require.Equal(t, "someone-else/cloned", cacheInfo.GetCachedPackages()[1].GetRepository())
+	require.Equal(t, uint64(2*testRepoFileSizeBytes), cacheInfo.GetTotalSizeBytes())
+	require.Equal(t, uint64(1), cacheInfo.GetNumMisses())
+}
+
+func TestPackageCache_NeverEvictsTheRepositoryJustAdded(t *testing.T) {
+	packagesDir := t.TempDir()
+	cache := newPackageCache(packagesDir, testRepoFileSizeBytes/2)
+
+	writeTestRepo(t, packagesDir, "kurtosis-tech/old", time.Now().Add(-time.Hour))
+	writeTestRepo(t, packagesDir, "kurtosis-tech/huge", time.Now())
+	cache.markAdded("kurtosis-tech/huge", true)
+
+	cacheInfo, err := cache.getInfo()
+	require.NoError(t, err)
+	require.Len(t, cacheInfo.GetCachedPackages(), 1)
+	require.Equal(t, "kurtosis-tech/huge", cacheInfo.GetCachedPackages()[0].GetRepository())
+}
+
+func TestPackageCache_HitsMakeRepositoriesTheMostRecentlyUsed(t *testing.T) {
+	packagesDir := t.TempDir()
+	cache := newPackageCache(packagesDir, 2*testRepoFileSizeBytes)
+
+	writeTestRepo(t, packagesDir, "kurtosis-tech/first", time.Now().Add(-2*time.Hour))
+	writeTestRepo(t, packagesDir, "kurtosis-tech/second", time.Now().Add(-1*time.Hour))
+	cache.markHit("kurtosis-tech/first")
+	writeTestRepo(t, packagesDir, "kurtosis-tech/third", time.Now().Add(time.Minute))
+	cache.markAdded("kurtosis-tech/third", true)
+
+	cacheInfo, err := cache.getInfo()
+	require.NoError(t, err)
+	require.Len(t, cacheInfo.GetCachedPackages(), 2)
+	require.Equal(t, "kurtosis-tech/first", cacheInfo.GetCachedPackages()[0].GetRepository())
+	require.Equal(t, uint64(1), cacheInfo.GetNumHits())
+}
+
+func TestPackageCache_ClearKeepsThePackageLockFile(t *testing.T) {
+	packagesDir := t.TempDir()
+	cache := newPackageCache(packagesDir, DefaultPackageCacheMaxSizeBytes)
+
+	writeTestRepo(t, packagesDir, "kurtosis-tech/first", time.Now())
+	writeTestRepo(t, packagesDir, "kurtosis-tech/second", time.Now())
+	lockFilePath := path.Join(packagesDir, shared_utils.PackageLockFilename)
+	require.NoError(t, os.WriteFile(lockFilePath, []byte("resolved_versions: {}\n"), testFilePermission))
+
+	removedRepositories, err := cache.clear()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"kurtosis-tech/first", "kurtosis-tech/second"}, removedRepositories)
+
+	remainingEntries, err := os.ReadDir(packagesDir)
+	require.NoError(t, err)
+	require.Len(t, remainingEntries, 1)
+	require.Equal(t, shared_utils.PackageLockFilename, remainingEntries[0].Name())
+}
+
+func writeTestRepo(t *testing.T, packagesDir string, relativeRepoPath string, lastUsed time.Time) {
+	repoDirpath := path.Join(packagesDir, relativeRepoPath)
+	require.NoError(t, os.MkdirAll(repoDirpath, moduleDirPermission))
+	require.NoError(t, os.WriteFile(path.Join(repoDirpath, "main.star"), make([]byte, testRepoFileSizeBytes), testFilePermission))
+	require.NoError(t, os.Chtimes(repoDirpath, lastUsed, lastUsed))
+}
@@ -0,0 +1,38 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	lockedVersionKeySeparator = "@"
+)
+
+func getLockedVersionKey(parsedURL *ParsedGitURL) string {
+	return parsedURL.relativeRepoPath + lockedVersionKeySeparator + parsedURL.tagBranchOrCommit
+}
+
+// lockVersionsOfUploadedPackage merges the versions locked by the lock file at the root of a package uploaded from the
+// machine of the user into the lock file of the enclave, which lives in the packages directory. The versions of the
+// uploaded package win, as its lock file is the one the user commits
+func lockVersionsOfUploadedPackage(packagesDir string, packageDirpath string) error {
+	packageLockFile, err := shared_utils.ReadPackageLockFile(packageDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the lock file of the package at '%v'", packageDirpath)
+	}
+	if len(packageLockFile.ResolvedVersions) == 0 {
+		return nil
+	}
+	enclaveLockFile, err := shared_utils.ReadPackageLockFile(packagesDir)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred reading the lock file of the enclave")
+	}
+	for lockedVersionKey, lockedTagName := range packageLockFile.ResolvedVersions {
+		enclaveLockFile.ResolvedVersions[lockedVersionKey] = lockedTagName
+	}
+	if err = shared_utils.WritePackageLockFile(packagesDir, enclaveLockFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the lock file of the enclave")
+	}
+	return nil
+}
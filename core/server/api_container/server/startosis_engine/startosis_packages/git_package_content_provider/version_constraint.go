@@ -0,0 +1,66 @@
+package git_package_content_provider
+
+import (
+	"github.com/Masterminds/semver/v3"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"strings"
+)
+
+const (
+	// A version is only treated as a constraint if it contains one of these, so that exact versions, branch names and
+	// commit hashes (some of which happen to be valid constraints, e.g. '1234567') keep their meaning
+	versionConstraintCharacters = "^~<>=*|, xX"
+)
+
+// isVersionConstraint returns true if the version following the '@' of a locator is a semantic version constraint
+// (e.g. '^1.2', '~1.2.3', '>=1.0, <2.0', '1.x') that should get resolved against the tags of the repository
+func isVersionConstraint(maybeConstraint string) bool {
+	if !strings.ContainsAny(maybeConstraint, versionConstraintCharacters) {
+		return false
+	}
+	_, err := semver.NewConstraint(maybeConstraint)
+	return err == nil
+}
+
+// resolveVersionConstraint returns the reference of the highest tag of the repository that is a semantic version
+// satisfying the constraint. The boolean is false if no tag satisfies it
+func resolveVersionConstraint(repo *git.Repository, parsedURL *ParsedGitURL) (plumbing.ReferenceName, bool, *startosis_errors.InterpretationError) {
+	constraint, err := semver.NewConstraint(parsedURL.tagBranchOrCommit)
+	if err != nil {
+		return "", false, startosis_errors.WrapWithInterpretationError(err, "'%v' isn't a valid semantic version constraint", parsedURL.tagBranchOrCommit)
+	}
+
+	tags, err := repo.Tags()
+	if err != nil {
+		return "", false, startosis_errors.WrapWithInterpretationError(err, "An error occurred while fetching the tags of repository '%v'", parsedURL.gitURL)
+	}
+
+	var highestMatchingVersion *semver.Version
+	var highestMatchingTag plumbing.ReferenceName
+	err = tags.ForEach(func(tag *plumbing.Reference) error {
+		version, err := semver.NewVersion(tag.Name().Short())
+		if err != nil {
+			// not a versioned tag
+			return nil
+		}
+		if !constraint.Check(version) {
+			return nil
+		}
+		if highestMatchingVersion == nil || version.GreaterThan(highestMatchingVersion) {
+			highestMatchingVersion = version
+			highestMatchingTag = tag.Name()
+		}
+		return nil
+	})
+	// checking the error even though the above can't ever error
+	if err != nil {
+		return "", false, startosis_errors.WrapWithInterpretationError(err, "An error occurred while iterating through the tags of repository '%v'; This is a bug in Kurtosis", parsedURL.gitURL)
+	}
+
+	if highestMatchingVersion == nil {
+		return "", false, nil
+	}
+	return highestMatchingTag, true, nil
+}
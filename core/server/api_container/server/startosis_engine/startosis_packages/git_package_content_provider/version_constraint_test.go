@@ -0,0 +1,146 @@
+package git_package_content_provider
+
+import (
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	testRepoURL = "github.com/kurtosis-tech/sample-startosis-load"
+)
+
+func TestIsVersionConstraint(t *testing.T) {
+	require.True(t, isVersionConstraint("^1.2"))
+	require.True(t, isVersionConstraint("~1.2.3"))
+	require.True(t, isVersionConstraint(">=1.0, <2.0"))
+	require.True(t, isVersionConstraint("1.x"))
+
+	// exact versions, branches and commits aren't constraints
+	require.False(t, isVersionConstraint("1.2.3"))
+	require.False(t, isVersionConstraint("v1.2.3"))
+	require.False(t, isVersionConstraint("main"))
+	require.False(t, isVersionConstraint("fix-bug"))
+	require.False(t, isVersionConstraint("1234567"))
+}
+
+func TestResolveVersionConstraint_PicksHighestMatchingTag(t *testing.T) {
+	repo := createRepoWithTags(t, "v1.1.0", "v1.2.0", "v1.3.5", "v2.0.0", "not-a-version")
+
+	resolvedTag, found, err := resolveVersionConstraint(repo, parseTestURL(t, testRepoURL+"@^1.2"))
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, plumbing.NewTagReferenceName("v1.3.5"), resolvedTag)
+
+	resolvedTag, found, err = resolveVersionConstraint(repo, parseTestURL(t, testRepoURL+"@~1.2"))
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, plumbing.NewTagReferenceName("v1.2.0"), resolvedTag)
+
+	_, found, err = resolveVersionConstraint(repo, parseTestURL(t, testRepoURL+"@^3.0"))
+	require.Nil(t, err)
+	require.False(t, found)
+}
+
+func TestGetTagForVersionConstraint_UsesLockedVersion(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), DefaultPackageCacheMaxSizeBytes)
+	repo := createRepoWithTags(t, "v1.2.0")
+	parsedURL := parseTestURL(t, testRepoURL+"@^1.2")
+
+	resolvedTag, err := provider.getTagForVersionConstraint(repo, parsedURL)
+	require.Nil(t, err)
+	require.Equal(t, plumbing.NewTagReferenceName("v1.2.0"), resolvedTag)
+
+	// a newer matching tag got pushed, but the constraint stays locked to the version it was first resolved to
+	createTag(t, repo, "v1.4.0")
+	resolvedTag, err = provider.getTagForVersionConstraint(repo, parsedURL)
+	require.Nil(t, err)
+	require.Equal(t, plumbing.NewTagReferenceName("v1.2.0"), resolvedTag)
+
+	lockFile, readErr := shared_utils.ReadPackageLockFile(provider.packagesDir)
+	require.NoError(t, readErr)
+	require.Equal(t, map[string]string{"kurtosis-tech/sample-startosis-load@^1.2": "v1.2.0"}, lockFile.ResolvedVersions)
+}
+
+func TestGetTagForVersionConstraint_UsesVersionLockedByUploadedPackage(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), DefaultPackageCacheMaxSizeBytes)
+	repo := createRepoWithTags(t, "v1.2.0", "v1.4.0")
+	parsedURL := parseTestURL(t, testRepoURL+"@^1.2")
+
+	uploadedPackageDirpath := t.TempDir()
+	require.NoError(t, shared_utils.WritePackageLockFile(uploadedPackageDirpath, &shared_utils.PackageLockFile{
+		ResolvedVersions: map[string]string{"kurtosis-tech/sample-startosis-load@^1.2": "v1.2.0"},
+	}))
+	require.NoError(t, lockVersionsOfUploadedPackage(provider.packagesDir, uploadedPackageDirpath))
+
+	resolvedTag, err := provider.getTagForVersionConstraint(repo, parsedURL)
+	require.Nil(t, err)
+	require.Equal(t, plumbing.NewTagReferenceName("v1.2.0"), resolvedTag)
+
+	require.Equal(t, map[string]string{"kurtosis-tech/sample-startosis-load@^1.2": "v1.2.0"}, provider.PopResolvedPackageVersions())
+}
+
+func TestLockVersionsOfUploadedPackage_NoLockFileLocksNothing(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), DefaultPackageCacheMaxSizeBytes)
+
+	require.NoError(t, lockVersionsOfUploadedPackage(provider.packagesDir, t.TempDir()))
+
+	lockFile, err := shared_utils.ReadPackageLockFile(provider.packagesDir)
+	require.NoError(t, err)
+	require.Empty(t, lockFile.ResolvedVersions)
+}
+
+func TestPopResolvedPackageVersions_OnlyReturnsTheVersionsResolvedSinceThePreviousPop(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), DefaultPackageCacheMaxSizeBytes)
+	repo := createRepoWithTags(t, "v1.2.0", "v2.3.0")
+
+	_, err := provider.getTagForVersionConstraint(repo, parseTestURL(t, testRepoURL+"@^1.2"))
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"kurtosis-tech/sample-startosis-load@^1.2": "v1.2.0"}, provider.PopResolvedPackageVersions())
+	require.Empty(t, provider.PopResolvedPackageVersions())
+
+	_, err = provider.getTagForVersionConstraint(repo, parseTestURL(t, testRepoURL+"@^2.0"))
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{"kurtosis-tech/sample-startosis-load@^2.0": "v2.3.0"}, provider.PopResolvedPackageVersions())
+
+	// the enclave lock file keeps all of them
+	lockFile, readErr := shared_utils.ReadPackageLockFile(provider.packagesDir)
+	require.NoError(t, readErr)
+	require.Len(t, lockFile.ResolvedVersions, 2)
+}
+
+func parseTestURL(t *testing.T, url string) *ParsedGitURL {
+	parsedURL, err := parseGitURL(url)
+	require.Nil(t, err)
+	return parsedURL
+}
+
+func createRepoWithTags(t *testing.T, tags ...string) *git.Repository {
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	require.NoError(t, err)
+	for _, tag := range tags {
+		createTag(t, repo, tag)
+	}
+	return repo
+}
+
+func createTag(t *testing.T, repo *git.Repository, tag string) {
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+	commitHash, err := workTree.Commit(tag, &git.CommitOptions{
+		All:       false,
+		Author:    &object.Signature{Name: "test", Email: "test@test.com", When: time.Now()},
+		Committer: nil,
+		Parents:   nil,
+		SignKey:   nil,
+	})
+	require.NoError(t, err)
+	_, err = repo.CreateTag(tag, commitHash, nil)
+	require.NoError(t, err)
+}
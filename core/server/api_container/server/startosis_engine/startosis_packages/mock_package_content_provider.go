@@ -3,6 +3,7 @@
 package startosis_packages
 
 import (
+	kurtosis_core_rpc_api_bindings "github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	startosis_errors "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -20,6 +21,59 @@ func (_m *MockPackageContentProvider) EXPECT() *MockPackageContentProvider_Expec
 	return &MockPackageContentProvider_Expecter{mock: &_m.Mock}
 }
 
+// ClearCache provides a mock function with given fields:
+func (_m *MockPackageContentProvider) ClearCache() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPackageContentProvider_ClearCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearCache'
+type MockPackageContentProvider_ClearCache_Call struct {
+	*mock.Call
+}
+
+// ClearCache is a helper method to define mock.On call
+func (_e *MockPackageContentProvider_Expecter) ClearCache() *MockPackageContentProvider_ClearCache_Call {
+	return &MockPackageContentProvider_ClearCache_Call{Call: _e.mock.On("ClearCache")}
+}
+
+func (_c *MockPackageContentProvider_ClearCache_Call) Run(run func()) *MockPackageContentProvider_ClearCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_ClearCache_Call) Return(_a0 []string, _a1 error) *MockPackageContentProvider_ClearCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_ClearCache_Call) RunAndReturn(run func() ([]string, error)) *MockPackageContentProvider_ClearCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ClonePackage provides a mock function with given fields: packageId
 func (_m *MockPackageContentProvider) ClonePackage(packageId string) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(packageId)
@@ -74,6 +128,59 @@ func (_c *MockPackageContentProvider_ClonePackage_Call) RunAndReturn(run func(st
 	return _c
 }
 
+// GetCacheInfo provides a mock function with given fields:
+func (_m *MockPackageContentProvider) GetCacheInfo() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error) {
+	ret := _m.Called()
+
+	var r0 *kurtosis_core_rpc_api_bindings.PackageCacheInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *kurtosis_core_rpc_api_bindings.PackageCacheInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*kurtosis_core_rpc_api_bindings.PackageCacheInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPackageContentProvider_GetCacheInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCacheInfo'
+type MockPackageContentProvider_GetCacheInfo_Call struct {
+	*mock.Call
+}
+
+// GetCacheInfo is a helper method to define mock.On call
+func (_e *MockPackageContentProvider_Expecter) GetCacheInfo() *MockPackageContentProvider_GetCacheInfo_Call {
+	return &MockPackageContentProvider_GetCacheInfo_Call{Call: _e.mock.On("GetCacheInfo")}
+}
+
+func (_c *MockPackageContentProvider_GetCacheInfo_Call) Run(run func()) *MockPackageContentProvider_GetCacheInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_GetCacheInfo_Call) Return(_a0 *kurtosis_core_rpc_api_bindings.PackageCacheInfo, _a1 error) *MockPackageContentProvider_GetCacheInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_GetCacheInfo_Call) RunAndReturn(run func() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error)) *MockPackageContentProvider_GetCacheInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetModuleContents provides a mock function with given fields: _a0
 func (_m *MockPackageContentProvider) GetModuleContents(_a0 string) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(_a0)
@@ -182,6 +289,49 @@ func (_c *MockPackageContentProvider_GetOnDiskAbsoluteFilePath_Call) RunAndRetur
 	return _c
 }
 
+// PopResolvedPackageVersions provides a mock function with given fields:
+func (_m *MockPackageContentProvider) PopResolvedPackageVersions() map[string]string {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	return r0
+}
+
+// MockPackageContentProvider_PopResolvedPackageVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PopResolvedPackageVersions'
+type MockPackageContentProvider_PopResolvedPackageVersions_Call struct {
+	*mock.Call
+}
+
+// PopResolvedPackageVersions is a helper method to define mock.On call
+func (_e *MockPackageContentProvider_Expecter) PopResolvedPackageVersions() *MockPackageContentProvider_PopResolvedPackageVersions_Call {
+	return &MockPackageContentProvider_PopResolvedPackageVersions_Call{Call: _e.mock.On("PopResolvedPackageVersions")}
+}
+
+func (_c *MockPackageContentProvider_PopResolvedPackageVersions_Call) Run(run func()) *MockPackageContentProvider_PopResolvedPackageVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_PopResolvedPackageVersions_Call) Return(_a0 map[string]string) *MockPackageContentProvider_PopResolvedPackageVersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_PopResolvedPackageVersions_Call) RunAndReturn(run func() map[string]string) *MockPackageContentProvider_PopResolvedPackageVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorePackageContents provides a mock function with given fields: _a0, _a1, _a2
 func (_m *MockPackageContentProvider) StorePackageContents(_a0 string, _a1 []byte, _a2 bool) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -1,6 +1,7 @@
 package mock_package_content_provider
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/stacktrace"
 	"os"
@@ -45,6 +46,18 @@ func (provider *MockPackageContentProvider) StorePackageContents(string, []byte,
 	panic(unimplementedMessage)
 }
 
+func (provider *MockPackageContentProvider) GetCacheInfo() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error) {
+	panic(unimplementedMessage)
+}
+
+func (provider *MockPackageContentProvider) ClearCache() ([]string, error) {
+	panic(unimplementedMessage)
+}
+
+func (provider *MockPackageContentProvider) PopResolvedPackageVersions() map[string]string {
+	return map[string]string{}
+}
+
 func (provider *MockPackageContentProvider) GetModuleContents(packageId string) (string, *startosis_errors.InterpretationError) {
 	absFilePath, found := provider.starlarkPackages[packageId]
 	if !found {
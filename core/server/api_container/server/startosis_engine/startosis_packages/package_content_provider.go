@@ -1,6 +1,9 @@
 package startosis_packages
 
-import "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+)
 
 // PackageContentProvider A package content provider allows you to get a Startosis package given a URL
 // It fetches the contents of the package for you
@@ -17,4 +20,14 @@ type PackageContentProvider interface {
 
 	// ClonePackage clones the package with the given id and returns the absolute path on disk
 	ClonePackage(packageId string) (string, *startosis_errors.InterpretationError)
+
+	// GetCacheInfo lists the packages on disk, along with the size limit of the cache and how often it got hit
+	GetCacheInfo() (*kurtosis_core_rpc_api_bindings.PackageCacheInfo, error)
+
+	// ClearCache removes all the packages from disk and returns the repositories that were removed
+	ClearCache() ([]string, error)
+
+	// PopResolvedPackageVersions returns the tags the version constraints of the packages cloned since the previous call
+	// got resolved to, keyed by '<repository path>@<constraint>', and forgets them
+	PopResolvedPackageVersions() map[string]string
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/crash_diagnostics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_deprecations"
 	"github.com/sirupsen/logrus"
 )
 
@@ -31,19 +33,29 @@ func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisV
 	}
 }
 
-func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism int, packageId string, serializedStartosis string, serializedParams string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism int, isStrict bool, packageId string, serializedStartosis string, serializedParams string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	// TODO(gb): add metric tracking maybe?
 	starlarkRunResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
 	go func() {
 		defer close(starlarkRunResponseLines)
+		crashDiagnosticsRecorder := crash_diagnostics.GetCrashDiagnosticsRecorder()
+		endRun := crashDiagnosticsRecorder.StartRun(packageId)
+		defer endRun()
+		// Deferred after ending the run, so that it runs first and the panic report still has the run that crashed
+		defer crashDiagnosticsRecorder.RecoverAndRecordPanic()
 
 		// Interpretation starts > send progress info (this line will be invisible as interpretation is super quick)
 		progressInfo := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			startingInterpretationMsg, defaultCurrentStepNumber, defaultTotalStepsNumber)
 		starlarkRunResponseLines <- progressInfo
 
-		serializedScriptOutput, instructionsList, interpretationError := runner.startosisInterpreter.Interpret(ctx, packageId, serializedStartosis, serializedParams)
+		deprecationTracker := startosis_deprecations.NewTracker(isStrict)
+		serializedScriptOutput, instructionsList, interpretationError := runner.startosisInterpreter.Interpret(startosis_deprecations.WithTracker(ctx, deprecationTracker), packageId, serializedStartosis, serializedParams)
+		// The deprecated features used before the interpretation failed are worth fixing too
+		for _, deprecationWarning := range deprecationTracker.GetWarnings() {
+			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromDeprecationWarning(deprecationWarning)
+		}
 		if interpretationError != nil {
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError)
 			starlarkRunResponseLines <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
@@ -58,7 +70,7 @@ func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism
 			startingValidationMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
 		starlarkRunResponseLines <- progressInfo
 
-		validationErrorsChan := runner.startosisValidator.Validate(ctx, instructionsList)
+		validationErrorsChan := runner.startosisValidator.Validate(ctx, dryRun, instructionsList)
 		if isRunFinished := forwardKurtosisResponseLineChannelUntilSourceIsClosed(validationErrorsChan, starlarkRunResponseLines); isRunFinished {
 			return
 		}
@@ -69,7 +81,7 @@ func (runner *StartosisRunner) Run(ctx context.Context, dryRun bool, parallelism
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
 		starlarkRunResponseLines <- progressInfo
 
-		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, instructionsList, serializedScriptOutput)
+		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, packageId, instructionsList, serializedScriptOutput)
 		if isRunFinished := forwardKurtosisResponseLineChannelUntilSourceIsClosed(executionResponseLinesChan, starlarkRunResponseLines); !isRunFinished {
 			logrus.Warnf("Execution finished but no 'RunFinishedEvent' was received through the stream. This is unexpected as every execution should be terminal.")
 		}
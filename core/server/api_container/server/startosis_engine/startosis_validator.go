@@ -15,26 +15,36 @@ import (
 )
 
 const (
-	validationInProgressMsg = "Validating Starlark code and downloading container images - execution will begin shortly"
+	validationInProgressMsg         = "Validating Starlark code and downloading container images - execution will begin shortly"
+	resourceEstimationInProgressMsg = "Estimating the resources the plan would consume"
 )
 
 type StartosisValidator struct {
+	kurtosisBackend *backend_interface.KurtosisBackend
+
 	dockerImagesValidator *startosis_validator.DockerImagesValidator
 
+	resourceEstimator *startosis_validator.ResourceEstimator
+
 	serviceNetwork    service_network.ServiceNetwork
 	fileArtifactStore *enclave_data_directory.FilesArtifactStore
 }
 
 func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
 	dockerImagesValidator := startosis_validator.NewDockerImagesValidator(kurtosisBackend)
+	resourceEstimator := startosis_validator.NewResourceEstimator(kurtosisBackend)
 	return &StartosisValidator{
+		kurtosisBackend,
 		dockerImagesValidator,
+		resourceEstimator,
 		serviceNetwork,
 		fileArtifactStore,
 	}
 }
 
-func (validator *StartosisValidator) Validate(ctx context.Context, instructions []kurtosis_instruction.KurtosisInstruction) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+// Validate validates the instructions and downloads the images they need. On dry runs, it also sends an estimate of the
+// resources the instructions would consume, before downloading the images so that the sizes of the downloads are known
+func (validator *StartosisValidator) Validate(ctx context.Context, dryRun bool, instructions []kurtosis_instruction.KurtosisInstruction) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	go func() {
 		defer close(starlarkRunResponseLineStream)
@@ -42,8 +52,16 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructions
 
 		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 			validationInProgressMsg, defaultCurrentStepNumber, defaultTotalStepsNumber)
+		backendCapabilities, err := (*validator.kurtosisBackend).GetBackendCapabilities(ctx)
+		if err != nil {
+			validationErr := startosis_errors.WrapWithValidationError(err, "An error occurred getting the capabilities of the backend to validate the instructions against")
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(validationErr.ToAPIType())
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent()
+			return
+		}
 		environment := startosis_validator.NewValidatorEnvironment(
 			validator.serviceNetwork.IsNetworkPartitioningEnabled(),
+			backendCapabilities,
 			validator.serviceNetwork.GetServiceNames(),
 			validator.fileArtifactStore.ListFiles())
 
@@ -51,6 +69,13 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructions
 			validator.validateAnUpdateEnvironment(instructions, environment, starlarkRunResponseLineStream)
 		logrus.Debug("Finished validating environment. Validating and downloading container images.")
 
+		if dryRun && !isValidationFailure {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
+				resourceEstimationInProgressMsg, defaultCurrentStepNumber, defaultTotalStepsNumber)
+			resourceEstimate := validator.resourceEstimator.Estimate(ctx, environment)
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromResourceEstimate(resourceEstimate)
+		}
+
 		isValidationFailure = isValidationFailure ||
 			validator.downloadAndValidateImagesAccountingForProgress(ctx, environment, starlarkRunResponseLineStream)
 
@@ -0,0 +1,259 @@
+package startosis_validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/docker/distribution/reference"
+	"github.com/kurtosis-tech/stacktrace"
+	"net/http"
+	"net/url"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	imageRegistryScheme = "https"
+
+	dockerHubDomain         = "docker.io"
+	dockerHubRegistryDomain = "registry-1.docker.io"
+
+	imageRegistryRequestsTimeout = 10 * time.Second
+
+	dockerManifestMediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+	ociManifestMediaType        = "application/vnd.oci.image.manifest.v1+json"
+	ociImageIndexMediaType      = "application/vnd.oci.image.index.v1+json"
+
+	acceptHeaderKey          = "Accept"
+	authorizationHeaderKey   = "Authorization"
+	wwwAuthenticateHeaderKey = "Www-Authenticate"
+
+	bearerAuthScheme = "Bearer"
+
+	linuxOs = "linux"
+)
+
+var acceptedManifestMediaTypes = []string{
+	dockerManifestMediaType,
+	dockerManifestListMediaType,
+	ociManifestMediaType,
+	ociImageIndexMediaType,
+}
+
+// Holds the fields of both image manifests and manifest lists (a.k.a. image indexes) that matter to compute the size of
+// an image
+type imageManifest struct {
+	Config imageManifestDescriptor `json:"config"`
+
+	Layers []imageManifestDescriptor `json:"layers"`
+
+	Manifests []imageManifestDescriptor `json:"manifests"`
+}
+
+type imageManifestDescriptor struct {
+	Digest string `json:"digest"`
+
+	Size uint64 `json:"size"`
+
+	Platform *struct {
+		Architecture string `json:"architecture"`
+		Os           string `json:"os"`
+	} `json:"platform,omitempty"`
+}
+
+type imageRegistryTokenResponse struct {
+	Token string `json:"token"`
+
+	AccessToken string `json:"access_token"`
+}
+
+// imageRegistryClient reads image manifests from the registries with the Docker Registry HTTP API V2, anonymously, so
+// only the sizes of public images can be known
+type imageRegistryClient struct {
+	httpClient *http.Client
+
+	scheme string
+}
+
+func newImageRegistryClient() *imageRegistryClient {
+	return &imageRegistryClient{
+		httpClient: &http.Client{
+			Timeout: imageRegistryRequestsTimeout,
+		},
+		scheme: imageRegistryScheme,
+	}
+}
+
+// getImageDownloadSizeBytes returns the sum of the compressed sizes of the layers of the image, for the architecture of
+// the host, which is what pulling the image downloads when none of its layers are on the host yet
+func (client *imageRegistryClient) getImageDownloadSizeBytes(ctx context.Context, image string) (uint64, error) {
+	namedImage, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing image name '%v'", image)
+	}
+	namedImage = reference.TagNameOnly(namedImage)
+	registryDomain := reference.Domain(namedImage)
+	if registryDomain == dockerHubDomain {
+		registryDomain = dockerHubRegistryDomain
+	}
+	repository := reference.Path(namedImage)
+	var manifestReference string
+	if digestedImage, isDigested := namedImage.(reference.Digested); isDigested {
+		manifestReference = digestedImage.Digest().String()
+	} else if taggedImage, isTagged := namedImage.(reference.Tagged); isTagged {
+		manifestReference = taggedImage.Tag()
+	}
+
+	manifest, err := client.getManifest(ctx, registryDomain, repository, manifestReference)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred getting the manifest of image '%v'", image)
+	}
+	if len(manifest.Manifests) > 0 {
+		platformManifestDigest := ""
+		for _, platformManifest := range manifest.Manifests {
+			if platformManifest.Platform != nil && platformManifest.Platform.Os == linuxOs && platformManifest.Platform.Architecture == runtime.GOARCH {
+				platformManifestDigest = platformManifest.Digest
+				break
+			}
+		}
+		if platformManifestDigest == "" {
+			return 0, stacktrace.NewError("Image '%v' isn't available for platform '%v/%v'", image, linuxOs, runtime.GOARCH)
+		}
+		manifest, err = client.getManifest(ctx, registryDomain, repository, platformManifestDigest)
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the manifest of image '%v' for platform '%v/%v'", image, linuxOs, runtime.GOARCH)
+		}
+	}
+
+	downloadSizeBytes := manifest.Config.Size
+	for _, layer := range manifest.Layers {
+		downloadSizeBytes += layer.Size
+	}
+	return downloadSizeBytes, nil
+}
+
+func (client *imageRegistryClient) getManifest(ctx context.Context, registryDomain string, repository string, manifestReference string) (*imageManifest, error) {
+	manifestUrl := fmt.Sprintf("%v://%v/v2/%v/manifests/%v", client.scheme, registryDomain, repository, manifestReference)
+	response, err := client.doManifestRequest(ctx, manifestUrl, "")
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred requesting manifest '%v'", manifestUrl)
+	}
+	if response.StatusCode == http.StatusUnauthorized {
+		// Even public images require a token on most registries, which gets handed out anonymously
+		authChallenge := response.Header.Get(wwwAuthenticateHeaderKey)
+		response.Body.Close()
+		token, err := client.getAnonymousToken(ctx, authChallenge)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting a token to read manifest '%v'", manifestUrl)
+		}
+		response, err = client.doManifestRequest(ctx, manifestUrl, token)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred requesting manifest '%v' with a token", manifestUrl)
+		}
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, stacktrace.NewError("Requesting manifest '%v' returned status '%v'", manifestUrl, response.Status)
+	}
+
+	manifest := &imageManifest{
+		Config:    imageManifestDescriptor{Digest: "", Size: 0, Platform: nil},
+		Layers:    nil,
+		Manifests: nil,
+	}
+	if err := json.NewDecoder(response.Body).Decode(manifest); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred decoding manifest '%v'", manifestUrl)
+	}
+	return manifest, nil
+}
+
+func (client *imageRegistryClient) doManifestRequest(ctx context.Context, manifestUrl string, token string) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestUrl, nil)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the request")
+	}
+	request.Header.Set(acceptHeaderKey, strings.Join(acceptedManifestMediaTypes, ","))
+	if token != "" {
+		request.Header.Set(authorizationHeaderKey, bearerAuthScheme+" "+token)
+	}
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the request")
+	}
+	return response, nil
+}
+
+// getAnonymousToken answers a challenge like 'Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/redis:pull"'
+func (client *imageRegistryClient) getAnonymousToken(ctx context.Context, authChallenge string) (string, error) {
+	challengeParams, err := parseBearerAuthChallenge(authChallenge)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing authentication challenge '%v'", authChallenge)
+	}
+	realm, found := challengeParams["realm"]
+	if !found {
+		return "", stacktrace.NewError("Authentication challenge '%v' has no realm to get a token from", authChallenge)
+	}
+	tokenUrl, err := url.Parse(realm)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing token realm '%v'", realm)
+	}
+	query := tokenUrl.Query()
+	for _, paramKey := range []string{"service", "scope"} {
+		if paramValue, found := challengeParams[paramKey]; found {
+			query.Set(paramKey, paramValue)
+		}
+	}
+	tokenUrl.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenUrl.String(), nil)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred building the token request")
+	}
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred requesting a token from '%v'", tokenUrl.String())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", stacktrace.NewError("Requesting a token from '%v' returned status '%v'", tokenUrl.String(), response.Status)
+	}
+	tokenResponse := &imageRegistryTokenResponse{Token: "", AccessToken: ""}
+	if err := json.NewDecoder(response.Body).Decode(tokenResponse); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred decoding the token returned by '%v'", tokenUrl.String())
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	if tokenResponse.AccessToken != "" {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", stacktrace.NewError("'%v' returned no token", tokenUrl.String())
+}
+
+func parseBearerAuthChallenge(authChallenge string) (map[string]string, error) {
+	scheme, paramsStr, found := strings.Cut(strings.TrimSpace(authChallenge), " ")
+	if !found || !strings.EqualFold(scheme, bearerAuthScheme) {
+		return nil, stacktrace.NewError("Only the '%v' authentication scheme is supported", bearerAuthScheme)
+	}
+	// Values are quoted and can contain commas, e.g. scope="repository:foo:pull,push"
+	params := map[string]string{}
+	remainingParamsStr := paramsStr
+	for {
+		key, afterKey, found := strings.Cut(strings.TrimLeft(remainingParamsStr, ", "), "=")
+		if !found {
+			return params, nil
+		}
+		var value string
+		if strings.HasPrefix(afterKey, "\"") {
+			value, remainingParamsStr, found = strings.Cut(afterKey[1:], "\"")
+			if !found {
+				return nil, stacktrace.NewError("The value of parameter '%v' is missing its closing quote", key)
+			}
+		} else {
+			value, remainingParamsStr, _ = strings.Cut(afterKey, ",")
+		}
+		params[key] = value
+	}
+}
@@ -0,0 +1,67 @@
+package startosis_validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"runtime"
+	"testing"
+)
+
+const (
+	testToken = "test-token"
+
+	testPlatformManifestDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+func TestGetImageDownloadSizeBytes_SumsTheLayersOfThePlatformManifest(t *testing.T) {
+	var registryUrl string
+	registry := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/token":
+			require.Equal(t, "repository:kurtosis/redis:pull", request.URL.Query().Get("scope"))
+			fmt.Fprintf(writer, `{"token": "%v"}`, testToken)
+		case "/v2/kurtosis/redis/manifests/7":
+			if request.Header.Get(authorizationHeaderKey) != bearerAuthScheme+" "+testToken {
+				writer.Header().Set(wwwAuthenticateHeaderKey, fmt.Sprintf(`Bearer realm="%v/token",service="registry",scope="repository:kurtosis/redis:pull"`, registryUrl))
+				writer.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprintf(writer, `{"manifests": [
+				{"digest": "sha256:other", "size": 1, "platform": {"architecture": "someotherarch", "os": "linux"}},
+				{"digest": "%v", "size": 1, "platform": {"architecture": "%v", "os": "linux"}}
+			]}`, testPlatformManifestDigest, runtime.GOARCH)
+		case "/v2/kurtosis/redis/manifests/" + testPlatformManifestDigest:
+			fmt.Fprint(writer, `{"config": {"size": 100}, "layers": [{"size": 1000}, {"size": 2000}]}`)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer registry.Close()
+	registryUrl = registry.URL
+	parsedRegistryUrl, err := url.Parse(registryUrl)
+	require.NoError(t, err)
+
+	client := newImageRegistryClient()
+	client.scheme = parsedRegistryUrl.Scheme
+	downloadSizeBytes, err := client.getImageDownloadSizeBytes(context.Background(), parsedRegistryUrl.Host+"/kurtosis/redis:7")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3100), downloadSizeBytes)
+}
+
+func TestParseBearerAuthChallenge(t *testing.T) {
+	params, err := parseBearerAuthChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/redis:pull,push"`)
+	require.NoError(t, err)
+	expectedParams := map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/redis:pull,push",
+	}
+	require.Equal(t, expectedParams, params)
+
+	_, err = parseBearerAuthChallenge(`Basic realm="registry"`)
+	require.Error(t, err)
+}
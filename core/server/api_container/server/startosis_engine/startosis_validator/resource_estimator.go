@@ -0,0 +1,145 @@
+package startosis_validator
+
+import (
+	"context"
+	"fmt"
+	"github.com/docker/go-units"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+	"sort"
+	"sync"
+)
+
+const (
+	millicpusPerCpu = 1000
+
+	bytesPerMegabyte = 1024 * 1024
+)
+
+// ResourceEstimator estimates what running a plan would consume, from the resources its services declare and the
+// images they need, so that dry runs can tell upfront when the host is too small for the plan
+type ResourceEstimator struct {
+	kurtosisBackend *backend_interface.KurtosisBackend
+
+	imageRegistryClient *imageRegistryClient
+}
+
+func NewResourceEstimator(kurtosisBackend *backend_interface.KurtosisBackend) *ResourceEstimator {
+	return &ResourceEstimator{
+		kurtosisBackend:     kurtosisBackend,
+		imageRegistryClient: newImageRegistryClient(),
+	}
+}
+
+// Estimate is best-effort: what can't be found out, like the size of a private image, is left out of the estimate
+// rather than failing it
+func (estimator *ResourceEstimator) Estimate(ctx context.Context, environment *ValidatorEnvironment) *kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate {
+	estimate := sumServiceResources(environment.serviceResources)
+	estimate.ImageDownloads = estimator.estimateImageDownloads(ctx, environment.requiredDockerImages)
+
+	hostResources, err := (*estimator.kurtosisBackend).GetHostResources(ctx)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the resources of the host; the resource estimate won't be checked against them:\n%v", err)
+	} else {
+		estimate.HostNumCpus = hostResources.GetNumCpus()
+		estimate.HostMemoryBytes = hostResources.GetMemoryBytes()
+	}
+	estimate.Warnings = getInsufficientHostResourcesWarnings(estimate)
+	return estimate
+}
+
+func sumServiceResources(resourcesByService map[service.ServiceName]*serviceResources) *kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate {
+	estimate := &kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate{
+		NumServices:                        uint32(len(resourcesByService)),
+		CpuAllocationMillicpus:             0,
+		NumServicesWithoutCpuAllocation:    0,
+		MemoryAllocationMegabytes:          0,
+		NumServicesWithoutMemoryAllocation: 0,
+		NumPorts:                           0,
+		NumPublicPorts:                     0,
+		ImageDownloads:                     nil,
+		HostNumCpus:                        0,
+		HostMemoryBytes:                    0,
+		Warnings:                           nil,
+	}
+	for _, resources := range resourcesByService {
+		if resources.cpuAllocationMillicpus == 0 {
+			estimate.NumServicesWithoutCpuAllocation++
+		}
+		if resources.memoryAllocationMegabytes == 0 {
+			estimate.NumServicesWithoutMemoryAllocation++
+		}
+		estimate.CpuAllocationMillicpus += resources.cpuAllocationMillicpus
+		estimate.MemoryAllocationMegabytes += resources.memoryAllocationMegabytes
+		estimate.NumPorts += resources.numPorts
+		estimate.NumPublicPorts += resources.numPublicPorts
+	}
+	return estimate
+}
+
+// estimateImageDownloads asks the registries for the sizes of the images that aren't on the host yet, in parallel
+func (estimator *ResourceEstimator) estimateImageDownloads(ctx context.Context, images map[string]bool) []*kurtosis_core_rpc_api_bindings.StarlarkImageDownloadEstimate {
+	imagesSizeBytesOnHost, err := (*estimator.kurtosisBackend).GetImagesSizeBytes(ctx, images)
+	if err != nil {
+		logrus.Warnf("An error occurred checking which images are already on the host; all of them will be estimated as needing a download:\n%v", err)
+		imagesSizeBytesOnHost = map[string]int64{}
+	}
+
+	imageDownloads := []*kurtosis_core_rpc_api_bindings.StarlarkImageDownloadEstimate{}
+	imageDownloadsMutex := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	concurrentRequests := make(chan bool, maxNumberOfConcurrentDownloads)
+	for image := range images {
+		if _, isPresentOnHost := imagesSizeBytesOnHost[image]; isPresentOnHost {
+			imageDownloadsMutex.Lock()
+			imageDownloads = append(imageDownloads, binding_constructors.NewStarlarkImageDownloadEstimate(image, true, nil))
+			imageDownloadsMutex.Unlock()
+			continue
+		}
+		wg.Add(1)
+		go func(image string) {
+			defer wg.Done()
+			concurrentRequests <- true
+			defer func() { <-concurrentRequests }()
+
+			var downloadSizeBytes *uint64
+			sizeBytes, err := estimator.imageRegistryClient.getImageDownloadSizeBytes(ctx, image)
+			if err != nil {
+				logrus.Debugf("Couldn't get the download size of image '%v' from its registry:\n%v", image, err)
+			} else {
+				downloadSizeBytes = &sizeBytes
+			}
+			imageDownloadsMutex.Lock()
+			defer imageDownloadsMutex.Unlock()
+			imageDownloads = append(imageDownloads, binding_constructors.NewStarlarkImageDownloadEstimate(image, false, downloadSizeBytes))
+		}(image)
+	}
+	wg.Wait()
+
+	sort.Slice(imageDownloads, func(i, j int) bool {
+		return imageDownloads[i].GetImage() < imageDownloads[j].GetImage()
+	})
+	return imageDownloads
+}
+
+func getInsufficientHostResourcesWarnings(estimate *kurtosis_core_rpc_api_bindings.StarlarkResourceEstimate) []string {
+	warnings := []string{}
+	if estimate.GetHostNumCpus() > 0 && estimate.GetCpuAllocationMillicpus() > estimate.GetHostNumCpus()*millicpusPerCpu {
+		warnings = append(warnings, fmt.Sprintf(
+			"The services get allocated %v millicpus in total, more than the %v CPUs of the host, so they'll compete for CPU time",
+			estimate.GetCpuAllocationMillicpus(),
+			estimate.GetHostNumCpus(),
+		))
+	}
+	if estimate.GetHostMemoryBytes() > 0 && estimate.GetMemoryAllocationMegabytes()*bytesPerMegabyte > estimate.GetHostMemoryBytes() {
+		warnings = append(warnings, fmt.Sprintf(
+			"The services get allocated %v of memory in total, more than the %v of the host, so some of them might get killed for running out of memory",
+			units.BytesSize(float64(estimate.GetMemoryAllocationMegabytes()*bytesPerMegabyte)),
+			units.BytesSize(float64(estimate.GetHostMemoryBytes())),
+		))
+	}
+	return warnings
+}
@@ -0,0 +1,44 @@
+package startosis_validator
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var testBackendCapabilities = backend_capabilities.NewBackendCapabilities(true, true, true, false, true)
+
+func TestSumServiceResources(t *testing.T) {
+	environment := NewValidatorEnvironment(false, testBackendCapabilities, map[service.ServiceName]bool{}, map[string]bool{})
+	environment.AddServiceResources("database", 2000, 4096, 1, 1)
+	environment.AddServiceResources("node", 1000, 0, 3, 0)
+	environment.AddServiceResources("removed", 8000, 8192, 1, 0)
+	environment.RemoveServiceName("removed")
+
+	estimate := sumServiceResources(environment.serviceResources)
+	require.Equal(t, uint32(2), estimate.GetNumServices())
+	require.Equal(t, uint64(3000), estimate.GetCpuAllocationMillicpus())
+	require.Equal(t, uint32(0), estimate.GetNumServicesWithoutCpuAllocation())
+	require.Equal(t, uint64(4096), estimate.GetMemoryAllocationMegabytes())
+	require.Equal(t, uint32(1), estimate.GetNumServicesWithoutMemoryAllocation())
+	require.Equal(t, uint32(4), estimate.GetNumPorts())
+	require.Equal(t, uint32(1), estimate.GetNumPublicPorts())
+}
+
+func TestGetInsufficientHostResourcesWarnings(t *testing.T) {
+	environment := NewValidatorEnvironment(false, testBackendCapabilities, map[service.ServiceName]bool{}, map[string]bool{})
+	environment.AddServiceResources("node", 6000, 1024, 0, 0)
+	estimate := sumServiceResources(environment.serviceResources)
+
+	estimate.HostNumCpus = 4
+	estimate.HostMemoryBytes = 2 * 1024 * bytesPerMegabyte
+	warnings := getInsufficientHostResourcesWarnings(estimate)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "4 CPUs")
+
+	// Unknown host resources don't raise warnings
+	estimate.HostNumCpus = 0
+	estimate.HostMemoryBytes = 0
+	require.Empty(t, getInsufficientHostResourcesWarnings(estimate))
+}
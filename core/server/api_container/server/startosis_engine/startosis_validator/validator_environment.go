@@ -1,23 +1,41 @@
 package startosis_validator
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/backend_capabilities"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 )
 
 // ValidatorEnvironment fields are not exported so that only validators can access its fields
 type ValidatorEnvironment struct {
 	isNetworkPartitioningEnabled bool
+	backendCapabilities          *backend_capabilities.BackendCapabilities
 	requiredDockerImages         map[string]bool
 	serviceNames                 map[service.ServiceName]bool
 	artifactNames                map[string]bool
+	serviceResources             map[service.ServiceName]*serviceResources
 }
 
-func NewValidatorEnvironment(isNetworkPartitioningEnabled bool, serviceNames map[service.ServiceName]bool, artifactNames map[string]bool) *ValidatorEnvironment {
+// serviceResources are the resources a service declares it needs, which add up to the resource estimate of dry runs
+type serviceResources struct {
+	// Zero if the service didn't declare any
+	cpuAllocationMillicpus uint64
+
+	// Zero if the service didn't declare any
+	memoryAllocationMegabytes uint64
+
+	numPorts uint32
+
+	numPublicPorts uint32
+}
+
+func NewValidatorEnvironment(isNetworkPartitioningEnabled bool, backendCapabilities *backend_capabilities.BackendCapabilities, serviceNames map[service.ServiceName]bool, artifactNames map[string]bool) *ValidatorEnvironment {
 	return &ValidatorEnvironment{
 		isNetworkPartitioningEnabled: isNetworkPartitioningEnabled,
+		backendCapabilities:          backendCapabilities,
 		requiredDockerImages:         map[string]bool{},
 		serviceNames:                 serviceNames,
 		artifactNames:                artifactNames,
+		serviceResources:             map[service.ServiceName]*serviceResources{},
 	}
 }
 
@@ -35,6 +53,17 @@ func (environment *ValidatorEnvironment) AddServiceName(serviceName service.Serv
 
 func (environment *ValidatorEnvironment) RemoveServiceName(serviceName service.ServiceName) {
 	delete(environment.serviceNames, serviceName)
+	delete(environment.serviceResources, serviceName)
+}
+
+// AddServiceResources records the resources the service declares, for the resource estimate of dry runs
+func (environment *ValidatorEnvironment) AddServiceResources(serviceName service.ServiceName, cpuAllocationMillicpus uint64, memoryAllocationMegabytes uint64, numPorts uint32, numPublicPorts uint32) {
+	environment.serviceResources[serviceName] = &serviceResources{
+		cpuAllocationMillicpus:    cpuAllocationMillicpus,
+		memoryAllocationMegabytes: memoryAllocationMegabytes,
+		numPorts:                  numPorts,
+		numPublicPorts:            numPublicPorts,
+	}
 }
 
 func (environment *ValidatorEnvironment) DoesServiceNameExist(serviceName service.ServiceName) bool {
@@ -58,3 +87,80 @@ func (environment *ValidatorEnvironment) DoesArtifactNameExist(artifactName stri
 func (environment *ValidatorEnvironment) IsNetworkPartitioningEnabled() bool {
 	return environment.isNetworkPartitioningEnabled
 }
+
+func (environment *ValidatorEnvironment) GetBackendCapabilities() *backend_capabilities.BackendCapabilities {
+	return environment.backendCapabilities
+}
+
+// Copy returns an independent copy of the environment, so that a branch of the plan that might not execute can be
+// validated without affecting the environment the rest of the plan gets validated against
+func (environment *ValidatorEnvironment) Copy() *ValidatorEnvironment {
+	requiredDockerImages := map[string]bool{}
+	for containerImage := range environment.requiredDockerImages {
+		requiredDockerImages[containerImage] = true
+	}
+	serviceNames := map[service.ServiceName]bool{}
+	for serviceName := range environment.serviceNames {
+		serviceNames[serviceName] = true
+	}
+	artifactNames := map[string]bool{}
+	for artifactName := range environment.artifactNames {
+		artifactNames[artifactName] = true
+	}
+	resourcesByService := map[service.ServiceName]*serviceResources{}
+	for serviceName, resources := range environment.serviceResources {
+		resourcesByService[serviceName] = resources
+	}
+	return &ValidatorEnvironment{
+		isNetworkPartitioningEnabled: environment.isNetworkPartitioningEnabled,
+		backendCapabilities:          environment.backendCapabilities,
+		requiredDockerImages:         requiredDockerImages,
+		serviceNames:                 serviceNames,
+		artifactNames:                artifactNames,
+		serviceResources:             resourcesByService,
+	}
+}
+
+// MergeBranches updates the environment to reflect that exactly one of the branches, which were validated against
+// copies of it, will execute. As it's unknown which one, a service or artifact is considered to exist if it exists
+// after any of them, and the images of all of them are required. A service added by several branches is estimated to
+// need the most any of them declares
+func (environment *ValidatorEnvironment) MergeBranches(branches ...*ValidatorEnvironment) {
+	environment.serviceNames = map[service.ServiceName]bool{}
+	environment.artifactNames = map[string]bool{}
+	environment.serviceResources = map[service.ServiceName]*serviceResources{}
+	for _, branch := range branches {
+		for serviceName, branchResources := range branch.serviceResources {
+			environment.serviceResources[serviceName] = getMaxServiceResources(environment.serviceResources[serviceName], branchResources)
+		}
+		for containerImage := range branch.requiredDockerImages {
+			environment.requiredDockerImages[containerImage] = true
+		}
+		for serviceName := range branch.serviceNames {
+			environment.serviceNames[serviceName] = true
+		}
+		for artifactName := range branch.artifactNames {
+			environment.artifactNames[artifactName] = true
+		}
+	}
+}
+
+func getMaxServiceResources(resources *serviceResources, otherResources *serviceResources) *serviceResources {
+	if resources == nil {
+		return otherResources
+	}
+	maxResources := *resources
+	if otherResources.cpuAllocationMillicpus > maxResources.cpuAllocationMillicpus {
+		maxResources.cpuAllocationMillicpus = otherResources.cpuAllocationMillicpus
+	}
+	if otherResources.memoryAllocationMegabytes > maxResources.memoryAllocationMegabytes {
+		maxResources.memoryAllocationMegabytes = otherResources.memoryAllocationMegabytes
+	}
+	if otherResources.numPorts > maxResources.numPorts {
+		maxResources.numPorts = otherResources.numPorts
+	}
+	if otherResources.numPublicPorts > maxResources.numPublicPorts {
+		maxResources.numPublicPorts = otherResources.numPublicPorts
+	}
+	return &maxResources
+}
@@ -24,6 +24,10 @@ const (
 	// We place the temp folder here so that the move to the final destination is atomic
 	// Move from places outside of the enclave data dir are not atomic as they're over the network
 	tmpPackageStoreDirname = "tmp-startosis-packages"
+
+	// The name of the directory INSIDE THE ENCLAVE DATA DIR where the API container writes what's needed to debug it
+	// if it crashes, as the enclave data dir outlives the API container
+	CrashDiagnosticsDirname = "crash-diagnostics"
 )
 
 // A directory containing all the data associated with a certain enclave (i.e. a Docker subnetwork where services are spun up)
@@ -58,7 +62,7 @@ func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, er
 	return currentFilesArtifactStore, nil
 }
 
-func (dir EnclaveDataDirectory) GetGitPackageContentProvider() (*git_package_content_provider.GitPackageContentProvider, error) {
+func (dir EnclaveDataDirectory) GetGitPackageContentProvider(packageCacheMaxSizeBytes uint64) (*git_package_content_provider.GitPackageContentProvider, error) {
 	packageStoreDirpath := path.Join(dir.absMountDirpath, startosisPackageStoreDirname)
 	if err := ensureDirpathExists(packageStoreDirpath); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark package store dirpath '%v' exists.", packageStoreDirpath)
@@ -69,5 +73,13 @@ func (dir EnclaveDataDirectory) GetGitPackageContentProvider() (*git_package_con
 		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark temporary package store dirpath '%v' exists.", tempPackageStoreDirpath)
 	}
 
-	return git_package_content_provider.NewGitPackageContentProvider(packageStoreDirpath, tempPackageStoreDirpath), nil
+	return git_package_content_provider.NewGitPackageContentProvider(packageStoreDirpath, tempPackageStoreDirpath, packageCacheMaxSizeBytes), nil
+}
+
+func (dir EnclaveDataDirectory) GetCrashDiagnosticsDirpath() (string, error) {
+	crashDiagnosticsDirpath := path.Join(dir.absMountDirpath, CrashDiagnosticsDirname)
+	if err := ensureDirpathExists(crashDiagnosticsDirpath); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred ensuring the crash diagnostics dirpath '%v' exists.", crashDiagnosticsDirpath)
+	}
+	return crashDiagnosticsDirpath, nil
 }
@@ -6,7 +6,6 @@
 package enclave_data_directory
 
 import (
-	"fmt"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
@@ -15,6 +14,11 @@ import (
 	"sync"
 )
 
+const (
+	// Files being added are written to temporary files named after their key, e.g. 'key.123456.tmp'
+	tmpFilePatternSuffix = ".*.tmp"
+)
+
 // Represents a write-only file cache, backed by a directory inside the enclave data dir
 type FileCache struct {
 	absoluteDirpath              string
@@ -32,42 +36,53 @@ func newFileCache(absoluteDirpath string, dirpathRelativeToDataDirRoot string) *
 	}
 }
 
+// AddFile writes the contents to a temporary file first, without holding the cache lock, so that slow readers (e.g. an
+// upload coming over the network) don't block the other users of the cache; the file only gets moved under the key once
+// it's complete
 func (cache *FileCache) AddFile(key string, reader io.Reader) (*EnclaveDataDirFile, error) {
-	cache.mutex.Lock()
-	defer cache.mutex.Unlock()
-
 	newFileObj := cache.getFileObjFromKey(key)
 	destAbsFilepath := newFileObj.absoluteFilepath
-	if _, err := os.Stat(destAbsFilepath); err == nil {
+	if cache.doesFileExist(destAbsFilepath) {
 		return nil, stacktrace.NewError("Cannot add file with key '%v' to the cache; a file with that key already exists", key)
 	}
 
-	shouldDeleteFile := true
-	fp, err := os.Create(destAbsFilepath)
+	fp, err := os.CreateTemp(cache.absoluteDirpath, key+tmpFilePatternSuffix)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred opening the filepointer of the new file with key '%v' being added to the cache", key)
 	}
+	tmpAbsFilepath := fp.Name()
+	shouldDeleteTmpFile := true
 	defer func() {
-		if shouldDeleteFile {
-			if err := os.Remove(destAbsFilepath); err != nil {
+		if shouldDeleteTmpFile {
+			if err := os.Remove(tmpAbsFilepath); err != nil && !os.IsNotExist(err) {
 				logrus.Errorf(
 					"We encountered an error adding file with key '%v' to the cache so we tried to remove "+
-						"the file we created, but got an error removing it:",
+						"the temporary file '%v' we created, but got an error removing it:\n%v",
 					key,
+					tmpAbsFilepath,
+					err,
 				)
-				fmt.Fprintln(logrus.StandardLogger().Out, err)
-				logrus.Errorf("!!!! ATTENTION !!!!! This means that the key '%v' will be corrupted!!", key)
 			}
 		}
 	}()
-	defer fp.Close()
 
 	bytesLength, err := io.Copy(fp, reader)
+	if closeErr := fp.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Writing could not be completed. Stopped writing at %v bytes.", bytesLength)
 	}
 
-	shouldDeleteFile = false
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.doesFileExist(destAbsFilepath) {
+		return nil, stacktrace.NewError("Cannot add file with key '%v' to the cache; a file with that key was added while writing it", key)
+	}
+	if err := os.Rename(tmpAbsFilepath, destAbsFilepath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred moving the new file with key '%v' from '%v' to '%v'", key, tmpAbsFilepath, destAbsFilepath)
+	}
+	shouldDeleteTmpFile = false
 	return newFileObj, nil
 }
 
@@ -99,6 +114,11 @@ func (cache *FileCache) RemoveFile(key string) error {
 	return nil
 }
 
+func (cache *FileCache) doesFileExist(absFilepath string) bool {
+	_, err := os.Stat(absFilepath)
+	return err == nil
+}
+
 func (cache *FileCache) getFileObjFromKey(key string) *EnclaveDataDirFile {
 	absoluteFilepath := path.Join(cache.absoluteDirpath, key)
 	relativeFilepath := path.Join(cache.dirpathRelativeToDataDirRoot, key)
@@ -6,14 +6,18 @@
 package enclave_data_directory
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -31,6 +35,20 @@ type FilesArtifactStore struct {
 	shortenedUuidToFullUuid         map[string][]FilesArtifactUUID
 	maxRetriesToGetFileArtifactName int
 	generateNatureThemeName         func() string
+
+	// Artifacts with the same contents share the same UUID and file, so that storing the same files again and again
+	// (e.g. the static files of a package run repeatedly) neither takes up more disk nor needs uploading them again
+	contentHashToArtifactUuid map[string]FilesArtifactUUID
+
+	// The names given to each stored file; the file only gets removed along with its last name
+	artifactUuidToArtifactNames map[FilesArtifactUUID]map[string]bool
+
+	// The services that have each artifact mounted, which keep it from getting deleted. Tracked by name, as the names
+	// sharing a file can belong to unrelated runs
+	artifactNameToReferrers map[string]map[string]bool
+
+	// When each artifact was last stored, downloaded or mounted, so that the ones nobody used in a while can be swept
+	artifactNameToLastUsedTime map[string]time.Time
 }
 
 func newFilesArtifactStore(absoluteDirpath string, dirpathRelativeToDataDirRoot string) *FilesArtifactStore {
@@ -41,6 +59,10 @@ func newFilesArtifactStore(absoluteDirpath string, dirpathRelativeToDataDirRoot
 		shortenedUuidToFullUuid:         make(map[string][]FilesArtifactUUID),
 		maxRetriesToGetFileArtifactName: maxFileArtifactNameRetriesDefault,
 		generateNatureThemeName:         name_generator.GenerateNatureThemeNameForFileArtifacts,
+		contentHashToArtifactUuid:       make(map[string]FilesArtifactUUID),
+		artifactUuidToArtifactNames:     make(map[FilesArtifactUUID]map[string]bool),
+		artifactNameToReferrers:         make(map[string]map[string]bool),
+		artifactNameToLastUsedTime:      make(map[string]time.Time),
 	}
 }
 
@@ -53,6 +75,13 @@ func NewFilesArtifactStoreForTesting(
 	maxRetry int,
 	nameGeneratorMock func() string,
 ) *FilesArtifactStore {
+	artifactUuidToArtifactNames := make(map[FilesArtifactUUID]map[string]bool)
+	for artifactName, artifactUuid := range artifactNameToArtifactUuid {
+		if _, found := artifactUuidToArtifactNames[artifactUuid]; !found {
+			artifactUuidToArtifactNames[artifactUuid] = map[string]bool{}
+		}
+		artifactUuidToArtifactNames[artifactUuid][artifactName] = true
+	}
 	return &FilesArtifactStore{
 		fileCache:                       newFileCache(absoluteDirpath, dirpathRelativeToDataDirRoot),
 		mutex:                           &sync.RWMutex{},
@@ -60,79 +89,213 @@ func NewFilesArtifactStoreForTesting(
 		shortenedUuidToFullUuid:         shortenedUuidToFullUuid,
 		maxRetriesToGetFileArtifactName: maxRetry,
 		generateNatureThemeName:         nameGeneratorMock,
+		contentHashToArtifactUuid:       make(map[string]FilesArtifactUUID),
+		artifactUuidToArtifactNames:     artifactUuidToArtifactNames,
+		artifactNameToReferrers:         make(map[string]map[string]bool),
+		artifactNameToLastUsedTime:      make(map[string]time.Time),
 	}
 }
 
-// StoreFile Saves file to disk.
+// StoreFile Saves file to disk. If an artifact with the same contents is already stored, the name is given to it
+// instead, and its UUID gets returned
+// The contents get written and hashed without holding the store lock, as they can come from a slow reader like an
+// upload; the lock is only taken to register the file once it's complete
 func (store FilesArtifactStore) StoreFile(reader io.Reader, artifactName string) (FilesArtifactUUID, error) {
+	filesArtifactUuid, contentHash, err := store.writeFileToNewArtifactUuid(reader)
+	if err != nil {
+		return "", err
+	}
+
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
+	shortenedUuid := uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))
+	store.shortenedUuidToFullUuid[shortenedUuid] = append(store.shortenedUuidToFullUuid[shortenedUuid], filesArtifactUuid)
+
+	if existingFilesArtifactUuid, found := store.contentHashToArtifactUuid[contentHash]; found {
+		if err := store.removeStoredFileUnlocked(filesArtifactUuid); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred removing files artifact '%v', whose contents are already stored as files artifact '%v'", filesArtifactUuid, existingFilesArtifactUuid)
+		}
+		logrus.Debugf("The contents of files artifact '%v' are already stored as files artifact '%v', so the name is given to the latter", artifactName, existingFilesArtifactUuid)
+		return store.nameFileUnlocked(existingFilesArtifactUuid, artifactName)
+	}
+
 	if _, found := store.artifactNameToArtifactUuid[artifactName]; found {
+		if err := store.removeStoredFileUnlocked(filesArtifactUuid); err != nil {
+			logrus.Errorf("Files artifact name '%v' has already been used, so we tried to remove the files we had stored for it but failed:\n%v", artifactName, err)
+		}
 		return "", stacktrace.NewError("Files artifact name '%v' has already been used", artifactName)
 	}
-	filesArtifactUuid, err := store.storeFilesToArtifactUuidUnlocked(reader)
+	store.contentHashToArtifactUuid[contentHash] = filesArtifactUuid
+	return store.nameFileUnlocked(filesArtifactUuid, artifactName)
+}
+
+// StoreFileWithKnownContentHash gives the name to the artifact with the given content hash (the hex-encoded SHA-256 of
+// its contents) if one is stored, so that the caller can skip sending the contents. Returns false if none is stored, in
+// which case the contents have to be stored with StoreFile
+func (store FilesArtifactStore) StoreFileWithKnownContentHash(contentHash string, artifactName string) (FilesArtifactUUID, bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	filesArtifactUuid, found := store.contentHashToArtifactUuid[contentHash]
+	if !found {
+		return "", false, nil
+	}
+	filesArtifactUuid, err := store.nameFileUnlocked(filesArtifactUuid, artifactName)
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
-	store.artifactNameToArtifactUuid[artifactName] = filesArtifactUuid
-	return filesArtifactUuid, nil
+	return filesArtifactUuid, true, nil
 }
 
 // GetFile Get the file by uuid, then by shortened uuid and finally by name
 func (store FilesArtifactStore) GetFile(artifactIdentifier string) (*EnclaveDataDirFile, error) {
-	store.mutex.RLock()
-	defer store.mutex.RUnlock()
+	// Not a read lock, as getting the file counts as using it
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
 
-	filesArtifactUuid := FilesArtifactUUID(artifactIdentifier)
+	filesArtifactUuid, artifactNames, err := store.resolveArtifactNamesUnlocked(artifactIdentifier)
+	if err != nil {
+		return nil, err
+	}
 	file, err := store.getFileUnlocked(filesArtifactUuid)
-	if err == nil {
-		return file, nil
+	if err != nil {
+		return nil, err
 	}
-
-	filesArtifactUuids, found := store.shortenedUuidToFullUuid[artifactIdentifier]
-	if found {
-		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
-			return nil, stacktrace.NewError("Tried using the shortened uuid '%v' to get file but found multiple matches '%v'. Use a complete uuid to be specific about what to get.", artifactIdentifier, filesArtifactUuids)
-		}
-		filesArtifactUuid := filesArtifactUuids[0]
-		return store.getFileUnlocked(filesArtifactUuid)
+	now := time.Now()
+	for _, artifactName := range artifactNames {
+		store.artifactNameToLastUsedTime[artifactName] = now
 	}
+	return file, nil
+}
 
-	filesArtifactUuid, found = store.artifactNameToArtifactUuid[artifactIdentifier]
-	if found {
-		return store.getFileUnlocked(filesArtifactUuid)
-	}
+// PeekFile is GetFile for looking at the artifact (e.g. to list its contents) rather than using it, so it doesn't keep
+// the artifact from getting swept
+func (store FilesArtifactStore) PeekFile(artifactIdentifier string) (*EnclaveDataDirFile, error) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
 
-	return nil, stacktrace.NewError("Couldn't find file for identifier '%v' tried, tried looking up UUID, shortened UUID and by name", artifactIdentifier)
+	filesArtifactUuid, _, err := store.resolveArtifactNamesUnlocked(artifactIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	return store.getFileUnlocked(filesArtifactUuid)
 }
 
 // RemoveFile Remove the file by uuid, then by shortened uuid and then by name
+// The stored file only goes away along with its last name, and a UUID shared by several names can't be removed, as it
+// doesn't tell which of them should go
 func (store FilesArtifactStore) RemoveFile(artifactIdentifier string) error {
 	store.mutex.Lock()
 	defer store.mutex.Unlock()
-	var filesArtifactUuid FilesArtifactUUID
+	return store.removeFileByIdentifierUnlocked(artifactIdentifier)
+}
 
-	filesArtifactUuid = FilesArtifactUUID(artifactIdentifier)
-	err := store.removeFileUnlocked(filesArtifactUuid)
-	if err == nil {
-		return nil
+// DeleteFile is RemoveFile for the artifacts users ask to delete, which refuses to remove an artifact that's mounted on
+// services, as they'd lose their files when restarted
+func (store FilesArtifactStore) DeleteFile(artifactIdentifier string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	_, artifactNames, err := store.resolveArtifactNamesUnlocked(artifactIdentifier)
+	if err != nil {
+		return err
+	}
+	if referrers := store.getReferrersUnlocked(artifactNames); len(referrers) > 0 {
+		return stacktrace.NewError("Files artifact '%v' can't be deleted because it's mounted on services '%v'; remove them first", artifactIdentifier, referrers)
 	}
+	return store.removeFileByIdentifierUnlocked(artifactIdentifier)
+}
 
-	filesArtifactUuids, found := store.shortenedUuidToFullUuid[artifactIdentifier]
-	if found {
-		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
-			return stacktrace.NewError("Tried using the shortened uuid '%v' to remove file but found multiple matches '%v'. Use a complete uuid to be specific about what to delete.", artifactIdentifier, filesArtifactUuids)
+// AddReferrer records that the given referrer (i.e. a service) has the artifact mounted, which keeps the artifact from
+// getting deleted or swept until RemoveReferrer gets called for it
+func (store FilesArtifactStore) AddReferrer(artifactIdentifier string, referrer string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	// Mounting by UUID keeps all the names of the file, as there's no telling which one the referrer meant
+	_, artifactNames, err := store.resolveArtifactNamesUnlocked(artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred finding files artifact '%v' mounted by '%v'", artifactIdentifier, referrer)
+	}
+	now := time.Now()
+	for _, artifactName := range artifactNames {
+		referrers, found := store.artifactNameToReferrers[artifactName]
+		if !found {
+			referrers = map[string]bool{}
+			store.artifactNameToReferrers[artifactName] = referrers
+		}
+		referrers[referrer] = true
+		store.artifactNameToLastUsedTime[artifactName] = now
+	}
+	return nil
+}
+
+// RemoveReferrer drops the given referrer from all the artifacts it had mounted; the artifacts count as used up to now,
+// so the TTL of those nobody else has mounted starts running from here
+func (store FilesArtifactStore) RemoveReferrer(referrer string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := time.Now()
+	for artifactName, referrers := range store.artifactNameToReferrers {
+		if !referrers[referrer] {
+			continue
+		}
+		delete(referrers, referrer)
+		if len(referrers) == 0 {
+			delete(store.artifactNameToReferrers, artifactName)
 		}
-		filesArtifactUuid = filesArtifactUuids[0]
-		return store.removeFileUnlocked(filesArtifactUuid)
+		store.artifactNameToLastUsedTime[artifactName] = now
 	}
+}
 
-	filesArtifactUuid, found = store.artifactNameToArtifactUuid[artifactIdentifier]
-	if found {
-		return store.removeFileUnlocked(filesArtifactUuid)
+// RemoveFilesUnusedSince removes the artifacts that no service has mounted and that haven't been used since the given
+// time, returning the names of the ones it removed. The contents shared with names still in use are kept
+func (store FilesArtifactStore) RemoveFilesUnusedSince(cutoff time.Time) ([]string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	removedArtifactNames := []string{}
+	for artifactName, lastUsedTime := range store.artifactNameToLastUsedTime {
+		if !lastUsedTime.Before(cutoff) || len(store.artifactNameToReferrers[artifactName]) > 0 {
+			continue
+		}
+		if err := store.removeNameUnlocked(artifactName); err != nil {
+			return removedArtifactNames, stacktrace.Propagate(err, "An error occurred removing files artifact '%v', unused since '%v'", artifactName, lastUsedTime)
+		}
+		removedArtifactNames = append(removedArtifactNames, artifactName)
 	}
+	sort.Strings(removedArtifactNames)
+	return removedArtifactNames, nil
+}
 
-	return stacktrace.NewError("Couldn't find file for identifier '%v' tried, tried looking up UUID, shortened UUID and by name", artifactIdentifier)
+// StartExpiredFilesSweeps removes, every interval, the artifacts that no service has mounted and that haven't been used
+// for the given TTL, until the returned function gets called
+func (store FilesArtifactStore) StartExpiredFilesSweeps(ttl time.Duration, interval time.Duration) func() {
+	stopChan := make(chan struct{})
+	stopOnce := &sync.Once{}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-stopChan:
+				return
+			}
+			removedArtifactNames, err := store.RemoveFilesUnusedSince(time.Now().Add(-ttl))
+			if err != nil {
+				logrus.Errorf("An error occurred sweeping the files artifacts unused for '%v':\n%v", ttl, err)
+			}
+			if len(removedArtifactNames) > 0 {
+				logrus.Infof("Removed files artifacts '%v' as they were unused for '%v'", removedArtifactNames, ttl)
+			}
+		}
+	}()
+	return func() {
+		stopOnce.Do(func() {
+			close(stopChan)
+		})
+	}
 }
 
 func (store FilesArtifactStore) ListFiles() map[string]bool {
@@ -197,30 +360,138 @@ func (store FilesArtifactStore) GenerateUniqueNameForFileArtifact() string {
 	return maybeUniqueNameWithRandomNumber
 }
 
-// storeFilesToArtifactUuidUnlocked this is an non thread method to be used from thread safe contexts
-func (store FilesArtifactStore) storeFilesToArtifactUuidUnlocked(reader io.Reader) (FilesArtifactUUID, error) {
+// removeFileByIdentifierUnlocked this is not thread safe, must be used from a thread safe context
+// Removing a name of contents shared with other names only removes the name
+func (store FilesArtifactStore) removeFileByIdentifierUnlocked(artifactIdentifier string) error {
+	filesArtifactUuid, artifactNames, err := store.resolveArtifactNamesUnlocked(artifactIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred finding the files artifact to remove")
+	}
+	switch len(artifactNames) {
+	case 0:
+		return store.removeStoredFileUnlocked(filesArtifactUuid)
+	case 1:
+		return store.removeNameUnlocked(artifactNames[0])
+	default:
+		return stacktrace.NewError("Files artifact '%v' holds the contents of files artifacts '%v'; remove them by name instead", artifactIdentifier, artifactNames)
+	}
+}
+
+// resolveArtifactNamesUnlocked this is not thread safe, must be used from a thread safe context
+// Looks the artifact up by uuid, then by shortened uuid and finally by name, returning its uuid along with the names the
+// identifier stands for: the given name, or all the names of the file if identified by uuid
+func (store FilesArtifactStore) resolveArtifactNamesUnlocked(artifactIdentifier string) (FilesArtifactUUID, []string, error) {
+	filesArtifactUuid := FilesArtifactUUID(artifactIdentifier)
+	if _, err := store.getFileUnlocked(filesArtifactUuid); err == nil {
+		return filesArtifactUuid, store.getArtifactNamesUnlocked(filesArtifactUuid), nil
+	}
+
+	filesArtifactUuids, found := store.shortenedUuidToFullUuid[artifactIdentifier]
+	if found {
+		if len(filesArtifactUuids) > maxAllowedMatchesAgainstShortenedUuid {
+			return "", nil, stacktrace.NewError("Tried using the shortened uuid '%v' to get file but found multiple matches '%v'. Use a complete uuid to be specific about what to get.", artifactIdentifier, filesArtifactUuids)
+		}
+		return filesArtifactUuids[0], store.getArtifactNamesUnlocked(filesArtifactUuids[0]), nil
+	}
+
+	filesArtifactUuid, found = store.artifactNameToArtifactUuid[artifactIdentifier]
+	if found {
+		return filesArtifactUuid, []string{artifactIdentifier}, nil
+	}
+
+	return "", nil, stacktrace.NewError("Couldn't find file for identifier '%v' tried, tried looking up UUID, shortened UUID and by name", artifactIdentifier)
+}
+
+// getArtifactNamesUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getArtifactNamesUnlocked(filesArtifactUuid FilesArtifactUUID) []string {
+	artifactNames := []string{}
+	for artifactName := range store.artifactUuidToArtifactNames[filesArtifactUuid] {
+		artifactNames = append(artifactNames, artifactName)
+	}
+	sort.Strings(artifactNames)
+	return artifactNames
+}
+
+// getReferrersUnlocked this is not thread safe, must be used from a thread safe context
+func (store FilesArtifactStore) getReferrersUnlocked(artifactNames []string) []string {
+	referrerSet := map[string]bool{}
+	for _, artifactName := range artifactNames {
+		for referrer := range store.artifactNameToReferrers[artifactName] {
+			referrerSet[referrer] = true
+		}
+	}
+	referrers := []string{}
+	for referrer := range referrerSet {
+		referrers = append(referrers, referrer)
+	}
+	sort.Strings(referrers)
+	return referrers
+}
+
+// writeFileToNewArtifactUuid writes the contents to a file named after a new UUID, which nothing knows about until
+// it gets registered, so it's safe to call without holding the store lock
+// Returns the hex-encoded SHA-256 of the contents along with the UUID, which gets computed while writing them
+func (store FilesArtifactStore) writeFileToNewArtifactUuid(reader io.Reader) (FilesArtifactUUID, string, error) {
 	filesArtifactUuid, err := NewFilesArtifactUUID()
 	if err != nil {
-		return "", stacktrace.Propagate(err, "An error occurred creating new files artifact UUID")
+		return "", "", stacktrace.Propagate(err, "An error occurred creating new files artifact UUID")
 	}
 
 	filename := strings.Join(
 		[]string{string(filesArtifactUuid), artifactExtension},
 		".",
 	)
-	_, err = store.fileCache.AddFile(filename, reader)
+	hasher := sha256.New()
+	_, err = store.fileCache.AddFile(filename, io.TeeReader(reader, hasher))
 	if err != nil {
-		return "", stacktrace.Propagate(
+		return "", "", stacktrace.Propagate(
 			err,
 			"Could not store file '%s' to the file cache",
 			filename,
 		)
 	}
-	shortenedUuidSlice := store.shortenedUuidToFullUuid[uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))]
-	store.shortenedUuidToFullUuid[uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))] = append(shortenedUuidSlice, filesArtifactUuid)
+	return filesArtifactUuid, hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// nameFileUnlocked this is not thread safe, must be used from a thread safe context
+// Naming a files artifact again with the name it already has is a no-op, so that storing the same files under the same
+// name again succeeds
+func (store FilesArtifactStore) nameFileUnlocked(filesArtifactUuid FilesArtifactUUID, artifactName string) (FilesArtifactUUID, error) {
+	if namedFilesArtifactUuid, found := store.artifactNameToArtifactUuid[artifactName]; found {
+		if namedFilesArtifactUuid != filesArtifactUuid {
+			return "", stacktrace.NewError("Files artifact name '%v' has already been used", artifactName)
+		}
+		store.artifactNameToLastUsedTime[artifactName] = time.Now()
+		return filesArtifactUuid, nil
+	}
+	store.artifactNameToArtifactUuid[artifactName] = filesArtifactUuid
+	artifactNames, found := store.artifactUuidToArtifactNames[filesArtifactUuid]
+	if !found {
+		artifactNames = map[string]bool{}
+		store.artifactUuidToArtifactNames[filesArtifactUuid] = artifactNames
+	}
+	artifactNames[artifactName] = true
+	store.artifactNameToLastUsedTime[artifactName] = time.Now()
 	return filesArtifactUuid, nil
 }
 
+// removeNameUnlocked this is not thread safe, must be used from a thread safe context
+// Removes the stored file too if it was its last name
+func (store FilesArtifactStore) removeNameUnlocked(artifactName string) error {
+	filesArtifactUuid, found := store.artifactNameToArtifactUuid[artifactName]
+	if !found {
+		return stacktrace.NewError("No files artifact is named '%v'", artifactName)
+	}
+	if len(store.artifactUuidToArtifactNames[filesArtifactUuid]) <= 1 {
+		return store.removeStoredFileUnlocked(filesArtifactUuid)
+	}
+	delete(store.artifactNameToArtifactUuid, artifactName)
+	delete(store.artifactUuidToArtifactNames[filesArtifactUuid], artifactName)
+	delete(store.artifactNameToReferrers, artifactName)
+	delete(store.artifactNameToLastUsedTime, artifactName)
+	return nil
+}
+
 // getFileUnlocked this is not thread safe, must be used from a thread safe context
 func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactUUID) (*EnclaveDataDirFile, error) {
 	filename := strings.Join(
@@ -239,8 +510,9 @@ func (store FilesArtifactStore) getFileUnlocked(filesArtifactUuid FilesArtifactU
 
 }
 
-// removeFileUnlocked this is not thread safe, must be used from a thread safe context
-func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifactUUID) error {
+// removeStoredFileUnlocked this is not thread safe, must be used from a thread safe context
+// Removes the stored file along with all of its names
+func (store FilesArtifactStore) removeStoredFileUnlocked(filesArtifactUuid FilesArtifactUUID) error {
 	filename := strings.Join(
 		[]string{string(filesArtifactUuid), artifactExtension},
 		".",
@@ -249,9 +521,15 @@ func (store FilesArtifactStore) removeFileUnlocked(filesArtifactUuid FilesArtifa
 	if err := store.fileCache.RemoveFile(filename); err != nil {
 		return stacktrace.Propagate(err, "There was an error in removing '%v' from the file store", filename)
 	}
-	for name, artifactUuid := range store.artifactNameToArtifactUuid {
+	for name := range store.artifactUuidToArtifactNames[filesArtifactUuid] {
+		delete(store.artifactNameToArtifactUuid, name)
+		delete(store.artifactNameToReferrers, name)
+		delete(store.artifactNameToLastUsedTime, name)
+	}
+	delete(store.artifactUuidToArtifactNames, filesArtifactUuid)
+	for contentHash, artifactUuid := range store.contentHashToArtifactUuid {
 		if artifactUuid == filesArtifactUuid {
-			delete(store.artifactNameToArtifactUuid, name)
+			delete(store.contentHashToArtifactUuid, contentHash)
 		}
 	}
 	shortenedUuid := uuid_generator.ShortenedUUIDString(string(filesArtifactUuid))
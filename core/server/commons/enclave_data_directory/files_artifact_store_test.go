@@ -6,12 +6,16 @@
 package enclave_data_directory
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/stretchr/testify/require"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestFileStore_StoreFileSimpleCase(t *testing.T) {
@@ -158,6 +162,239 @@ func TestFilesArtifactStore_GetFileNamesAndUuids(t *testing.T) {
 	require.Contains(t, fileNameAndUuids, FileNameAndUuid{uuid: anotherUUID, name: testArtifact2})
 }
 
+func TestFileStore_StoringSameContentsReusesArtifact(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-1")
+	require.Nil(t, err)
+
+	anotherUuid, err := fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-2")
+	require.Nil(t, err)
+	require.Equal(t, uuid, anotherUuid)
+	require.Len(t, fileStore.artifactNameToArtifactUuid, 2)
+	require.Len(t, fileStore.shortenedUuidToFullUuid, 1)
+
+	storedFiles, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, storedFiles, 1)
+}
+
+func TestFileStore_StoringSameContentsWithSameNameSucceeds(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	testArtifactName := "test-artifact-name"
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), testArtifactName)
+	require.Nil(t, err)
+
+	anotherUuid, err := fileStore.StoreFile(strings.NewReader(testContent), testArtifactName)
+	require.Nil(t, err)
+	require.Equal(t, uuid, anotherUuid)
+	require.Len(t, fileStore.artifactNameToArtifactUuid, 1)
+}
+
+func TestFileStore_StoringDifferentContentsWithExistingNameLeavesNoFileBehind(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testArtifactName := "test-artifact-name"
+	_, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), testArtifactName)
+	require.Nil(t, err)
+
+	_, err = fileStore.StoreFile(strings.NewReader("This one should fail"), testArtifactName)
+	require.NotNil(t, err)
+
+	storedFiles, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, storedFiles, 1)
+	require.Len(t, fileStore.shortenedUuidToFullUuid, 1)
+}
+
+func TestFileStore_StoreFileWithKnownContentHash(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	contentHash := sha256.Sum256([]byte(testContent))
+	contentHashStr := hex.EncodeToString(contentHash[:])
+
+	_, found, err := fileStore.StoreFileWithKnownContentHash(contentHashStr, "test-artifact-1")
+	require.Nil(t, err)
+	require.False(t, found)
+	require.Empty(t, fileStore.artifactNameToArtifactUuid)
+
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-1")
+	require.Nil(t, err)
+
+	anotherUuid, found, err := fileStore.StoreFileWithKnownContentHash(contentHashStr, "test-artifact-2")
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uuid, anotherUuid)
+	require.Equal(t, uuid, fileStore.artifactNameToArtifactUuid["test-artifact-2"])
+}
+
+func TestFileStore_RemovingNameOfSharedContentsKeepsThem(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-1")
+	require.Nil(t, err)
+	_, err = fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-2")
+	require.Nil(t, err)
+
+	err = fileStore.RemoveFile("test-artifact-1")
+	require.Nil(t, err)
+	require.Len(t, fileStore.artifactNameToArtifactUuid, 1)
+	_, err = fileStore.GetFile("test-artifact-2")
+	require.Nil(t, err)
+
+	err = fileStore.RemoveFile("test-artifact-2")
+	require.Nil(t, err)
+	_, err = fileStore.GetFile(string(uuid))
+	require.NotNil(t, err)
+	require.Empty(t, fileStore.contentHashToArtifactUuid)
+}
+
+func TestFileStore_DeletingMountedFileFails(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testArtifactName := "test-artifact"
+	uuid, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), testArtifactName)
+	require.Nil(t, err)
+	require.Nil(t, fileStore.AddReferrer(testArtifactName, "test-service"))
+
+	err = fileStore.DeleteFile(string(uuid))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "test-service")
+	_, err = fileStore.GetFile(testArtifactName)
+	require.Nil(t, err)
+
+	fileStore.RemoveReferrer("test-service")
+	require.Nil(t, fileStore.DeleteFile(string(uuid)))
+	_, err = fileStore.GetFile(testArtifactName)
+	require.NotNil(t, err)
+	require.Empty(t, fileStore.artifactNameToLastUsedTime)
+}
+
+func TestFileStore_RemoveFilesUnusedSinceSkipsMountedAndRecentlyUsedFiles(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	_, err := fileStore.StoreFile(strings.NewReader("unused"), "unused-artifact")
+	require.Nil(t, err)
+	mountedUuid, err := fileStore.StoreFile(strings.NewReader("mounted"), "mounted-artifact")
+	require.Nil(t, err)
+	require.Nil(t, fileStore.AddReferrer("mounted-artifact", "test-service"))
+	recentlyUsedUuid, err := fileStore.StoreFile(strings.NewReader("recently used"), "recently-used-artifact")
+	require.Nil(t, err)
+
+	cutoff := time.Now().Add(-time.Hour)
+	fileStore.artifactNameToLastUsedTime["unused-artifact"] = cutoff.Add(-time.Minute)
+	fileStore.artifactNameToLastUsedTime["mounted-artifact"] = cutoff.Add(-time.Minute)
+
+	removedArtifactNames, err := fileStore.RemoveFilesUnusedSince(cutoff)
+	require.Nil(t, err)
+	require.Equal(t, []string{"unused-artifact"}, removedArtifactNames)
+	_, err = fileStore.GetFile("unused-artifact")
+	require.NotNil(t, err)
+	_, err = fileStore.GetFile(string(mountedUuid))
+	require.Nil(t, err)
+	_, err = fileStore.GetFile(string(recentlyUsedUuid))
+	require.Nil(t, err)
+}
+
+func TestFileStore_PeekFileDoesNotMarkFileAsUsed(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	_, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), "test-artifact")
+	require.Nil(t, err)
+	lastUsedTime := time.Now().Add(-time.Hour)
+	fileStore.artifactNameToLastUsedTime["test-artifact"] = lastUsedTime
+
+	_, err = fileStore.PeekFile("test-artifact")
+	require.Nil(t, err)
+	require.Equal(t, lastUsedTime, fileStore.artifactNameToLastUsedTime["test-artifact"])
+
+	_, err = fileStore.GetFile("test-artifact")
+	require.Nil(t, err)
+	require.True(t, fileStore.artifactNameToLastUsedTime["test-artifact"].After(lastUsedTime))
+}
+
+func TestFileStore_RemovingSharedContentsByUuidFails(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-1")
+	require.Nil(t, err)
+	_, err = fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-2")
+	require.Nil(t, err)
+
+	require.NotNil(t, fileStore.RemoveFile(string(uuid)))
+	require.NotNil(t, fileStore.DeleteFile(string(uuid)))
+	require.Len(t, fileStore.artifactNameToArtifactUuid, 2)
+	_, err = fileStore.GetFile(string(uuid))
+	require.Nil(t, err)
+}
+
+func TestFileStore_DeletingUnmountedNameOfMountedContentsSucceeds(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-1")
+	require.Nil(t, err)
+	_, err = fileStore.StoreFile(strings.NewReader(testContent), "test-artifact-2")
+	require.Nil(t, err)
+	require.Nil(t, fileStore.AddReferrer("test-artifact-1", "test-service"))
+
+	require.Nil(t, fileStore.DeleteFile("test-artifact-2"))
+	require.NotNil(t, fileStore.DeleteFile("test-artifact-1"))
+	_, err = fileStore.GetFile(string(uuid))
+	require.Nil(t, err)
+}
+
+func TestFileStore_RemoveFilesUnusedSinceKeepsContentsSharedWithUsedNames(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	testContent := "Long Live Kurtosis!"
+	uuid, err := fileStore.StoreFile(strings.NewReader(testContent), "old-run-artifact")
+	require.Nil(t, err)
+	_, err = fileStore.StoreFile(strings.NewReader(testContent), "new-run-artifact")
+	require.Nil(t, err)
+
+	cutoff := time.Now().Add(-time.Hour)
+	fileStore.artifactNameToLastUsedTime["old-run-artifact"] = cutoff.Add(-time.Minute)
+
+	removedArtifactNames, err := fileStore.RemoveFilesUnusedSince(cutoff)
+	require.Nil(t, err)
+	require.Equal(t, []string{"old-run-artifact"}, removedArtifactNames)
+	_, err = fileStore.GetFile("new-run-artifact")
+	require.Nil(t, err)
+	_, err = fileStore.GetFile(string(uuid))
+	require.Nil(t, err)
+}
+
+func TestFileStore_StoringFromSlowReaderDoesNotBlockOtherCalls(t *testing.T) {
+	fileStore := getTestFileStore(t)
+	_, err := fileStore.StoreFile(strings.NewReader("Long Live Kurtosis!"), "stored-artifact")
+	require.Nil(t, err)
+
+	pipeReader, pipeWriter := io.Pipe()
+	storeDone := make(chan error)
+	go func() {
+		_, storeErr := fileStore.StoreFile(pipeReader, "uploaded-artifact")
+		storeDone <- storeErr
+	}()
+	_, err = pipeWriter.Write([]byte("first chunk, "))
+	require.Nil(t, err)
+
+	getDone := make(chan error)
+	go func() {
+		_, getErr := fileStore.GetFile("stored-artifact")
+		getDone <- getErr
+	}()
+	select {
+	case getErr := <-getDone:
+		require.Nil(t, getErr)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Getting a files artifact got blocked by an upload in progress")
+	}
+
+	_, err = pipeWriter.Write([]byte("last chunk"))
+	require.Nil(t, err)
+	require.Nil(t, pipeWriter.Close())
+	require.Nil(t, <-storeDone)
+	storedFiles, err := os.ReadDir(fileStore.fileCache.absoluteDirpath)
+	require.Nil(t, err)
+	require.Len(t, storedFiles, 2)
+}
+
 func getTestFileStore(t *testing.T) *FilesArtifactStore {
 	absDirpath, err := ioutil.TempDir("", "")
 	require.Nil(t, err)
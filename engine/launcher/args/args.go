@@ -42,6 +42,17 @@ type EngineServerArgs struct {
 	// the local backend and the remote backend using this configuration and the above KurtosisLocalBackendConfig
 	// Is nil when Kurtosis is used in a local-only context
 	KurtosisRemoteBackendConfig *remote_context_backend.KurtosisRemoteBackendConfig `json:"kurtosisRemoteBackendConfig,omitempty"`
+
+	// EngineStateStoreConfig corresponds to the config of an optional external store for the engine state. It is
+	// required to run more than one replica of the engine
+	// Is nil when the engine keeps its state in memory
+	EngineStateStoreConfig *EngineStateStoreConfig `json:"engineStateStoreConfig,omitempty"`
+
+	// Webhooks notified of the enclave lifecycle events and the engine errors
+	Webhooks []*WebhookConfig `json:"webhooks,omitempty"`
+
+	// Size limit of the package cache of each enclave; 0 means the default of the API containers
+	PackageCacheMaxSizeBytes uint64 `json:"packageCacheMaxSizeBytes,omitempty"`
 }
 
 func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
@@ -87,6 +98,9 @@ func NewEngineServerArgs(
 	kurtosisBackendType KurtosisBackendType,
 	kurtosisLocalBackendConfig interface{},
 	kurtosisRemoteBackendConfig *remote_context_backend.KurtosisRemoteBackendConfig,
+	engineStateStoreConfig *EngineStateStoreConfig,
+	webhooks []*WebhookConfig,
+	packageCacheMaxSizeBytes uint64,
 ) (*EngineServerArgs, error) {
 	result := &EngineServerArgs{
 		GrpcListenPortNum:           grpcListenPortNum,
@@ -98,6 +112,9 @@ func NewEngineServerArgs(
 		KurtosisBackendType:         kurtosisBackendType,
 		KurtosisLocalBackendConfig:  kurtosisLocalBackendConfig,
 		KurtosisRemoteBackendConfig: kurtosisRemoteBackendConfig,
+		EngineStateStoreConfig:      engineStateStoreConfig,
+		Webhooks:                    webhooks,
+		PackageCacheMaxSizeBytes:    packageCacheMaxSizeBytes,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
@@ -0,0 +1,16 @@
+package args
+
+// WebhookConfig configures an HTTP endpoint that gets notified of Kurtosis events, e.g. a Slack incoming webhook
+type WebhookConfig struct {
+	// URL that the events get POSTed to
+	Url string `json:"url"`
+
+	// 'slack' to post the events as Slack messages, or 'generic' to post them as JSON objects; 'generic' if empty
+	Kind string `json:"kind,omitempty"`
+
+	// The types of the events that get sent to the webhook, e.g. 'run_finished'; all of them if empty
+	Events []string `json:"events,omitempty"`
+
+	// Go template that renders the request body from the event, replacing the default payload of the kind
+	PayloadTemplate string `json:"payloadTemplate,omitempty"`
+}
@@ -7,6 +7,7 @@ package engine_server_launcher
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
@@ -21,6 +22,12 @@ const (
 	containerImage = "kurtosistech/engine"
 )
 
+var (
+	// Engines launched here are single replicas, so they keep their state in memory; an external state store is only
+	// needed when the engine is deployed with several replicas
+	noEngineStateStoreConfig *args.EngineStateStoreConfig = nil
+)
+
 type EngineServerLauncher struct {
 	kurtosisBackend backend_interface.KurtosisBackend
 }
@@ -29,6 +36,20 @@ func NewEngineServerLauncher(kurtosisBackend backend_interface.KurtosisBackend)
 	return &EngineServerLauncher{kurtosisBackend: kurtosisBackend}
 }
 
+// PullImageWithProgress pulls the engine image of the given version ahead of launching it, so that the progress of the
+// pull can be shown; the launch then reuses the pulled image
+func (launcher *EngineServerLauncher) PullImageWithProgress(
+	ctx context.Context,
+	imageVersionTag string,
+	progressCallback func(pulledBytes uint64, totalBytes uint64),
+) error {
+	image := fmt.Sprintf("%v:%v", containerImage, imageVersionTag)
+	if err := launcher.kurtosisBackend.PullImageWithProgress(ctx, image, progressCallback); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pulling engine image '%v'", image)
+	}
+	return nil
+}
+
 func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	ctx context.Context,
 	logLevel logrus.Level,
@@ -38,6 +59,10 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	didUserAcceptSendingMetrics bool,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
 	kurtosisRemoteBackendConfigSupplier *KurtosisRemoteBackendConfigSupplier,
+	// Webhooks the engine will notify of the enclave lifecycle events and its errors
+	webhooks []*args.WebhookConfig,
+	// Size limit of the package cache of each enclave; 0 means the default of the API containers
+	packageCacheMaxSizeBytes uint64,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -54,6 +79,8 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		didUserAcceptSendingMetrics,
 		backendConfigSupplier,
 		kurtosisRemoteBackendConfigSupplier,
+		webhooks,
+		packageCacheMaxSizeBytes,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -71,6 +98,10 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	didUserAcceptSendingMetrics bool,
 	backendConfigSupplier KurtosisBackendConfigSupplier,
 	kurtosisRemoteBackendConfigSupplier *KurtosisRemoteBackendConfigSupplier,
+	// Webhooks the engine will notify of the enclave lifecycle events and its errors
+	webhooks []*args.WebhookConfig,
+	// Size limit of the package cache of each enclave; 0 means the default of the API containers
+	packageCacheMaxSizeBytes uint64,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -91,6 +122,9 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		kurtosisBackendType,
 		kurtosisBackendConfig,
 		remoteBackendConfigMaybe,
+		noEngineStateStoreConfig,
+		webhooks,
+		packageCacheMaxSizeBytes,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
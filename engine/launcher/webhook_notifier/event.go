@@ -0,0 +1,81 @@
+package webhook_notifier
+
+import (
+	"fmt"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventType_EnclaveCreated   EventType = "enclave_created"
+	EventType_EnclaveStopped   EventType = "enclave_stopped"
+	EventType_EnclaveDestroyed EventType = "enclave_destroyed"
+	EventType_ServiceAdded     EventType = "service_added"
+	EventType_RunFinished      EventType = "run_finished"
+	EventType_EngineError      EventType = "engine_error"
+)
+
+var allEventTypes = []EventType{
+	EventType_EnclaveCreated,
+	EventType_EnclaveStopped,
+	EventType_EnclaveDestroyed,
+	EventType_ServiceAdded,
+	EventType_RunFinished,
+	EventType_EngineError,
+}
+
+// Event is what gets sent to the webhooks; its fields are what the payload templates can use
+type Event struct {
+	Type EventType `json:"type"`
+
+	Timestamp time.Time `json:"timestamp"`
+
+	// Empty for the engine error events
+	EnclaveUuid string `json:"enclaveUuid,omitempty"`
+	EnclaveName string `json:"enclaveName,omitempty"`
+
+	// Only set for the service added events
+	ServiceName string `json:"serviceName,omitempty"`
+	ServiceUuid string `json:"serviceUuid,omitempty"`
+
+	// The script or package that was run; only set for the run finished events
+	PackageId string `json:"packageId,omitempty"`
+
+	// Whether the run succeeded; only meaningful for the run finished events
+	IsSuccess bool `json:"isSuccess"`
+
+	// The error message of the engine error events
+	Message string `json:"message,omitempty"`
+}
+
+// Summary describes the event in a human-readable sentence, e.g. for chat messages
+func (event *Event) Summary() string {
+	switch event.Type {
+	case EventType_EnclaveCreated:
+		return fmt.Sprintf("Enclave '%v' was created", event.EnclaveName)
+	case EventType_EnclaveStopped:
+		return fmt.Sprintf("Enclave '%v' was stopped", event.EnclaveName)
+	case EventType_EnclaveDestroyed:
+		return fmt.Sprintf("Enclave '%v' was destroyed", event.EnclaveName)
+	case EventType_ServiceAdded:
+		return fmt.Sprintf("Service '%v' was added to enclave '%v'", event.ServiceName, event.EnclaveName)
+	case EventType_RunFinished:
+		if event.IsSuccess {
+			return fmt.Sprintf("Running '%v' in enclave '%v' succeeded", event.PackageId, event.EnclaveName)
+		}
+		return fmt.Sprintf("Running '%v' in enclave '%v' failed", event.PackageId, event.EnclaveName)
+	case EventType_EngineError:
+		return fmt.Sprintf("The Kurtosis engine hit an error: %v", event.Message)
+	}
+	return fmt.Sprintf("Kurtosis event '%v' happened", event.Type)
+}
+
+func isValidEventType(eventType EventType) bool {
+	for _, validEventType := range allEventTypes {
+		if eventType == validEventType {
+			return true
+		}
+	}
+	return false
+}
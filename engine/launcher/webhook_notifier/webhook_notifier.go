@@ -0,0 +1,196 @@
+package webhook_notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+const (
+	WebhookKind_Generic = "generic"
+	WebhookKind_Slack   = "slack"
+
+	webhookRequestTimeout = 10 * time.Second
+
+	jsonContentType = "application/json"
+
+	webhookErrorsSeparator = "\n"
+)
+
+// The functions available in the payload templates, on top of the builtin ones
+var payloadTemplateFuncs = template.FuncMap{
+	// Renders the value as a JSON value, e.g. to safely embed a string in a JSON payload: {"text": {{ json .Summary }}}
+	"json": func(value interface{}) (string, error) {
+		jsonBytes, err := json.Marshal(value)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred serializing value '%v' to JSON", value)
+		}
+		return string(jsonBytes), nil
+	},
+}
+
+type webhook struct {
+	config *args.WebhookConfig
+
+	// Empty when the webhook wants every event
+	eventTypes map[EventType]bool
+
+	// Nil when the webhook uses the default payload of its kind
+	maybePayloadTemplate *template.Template
+}
+
+// WebhookNotifier sends Kurtosis events to the webhooks interested in them
+type WebhookNotifier struct {
+	webhooks []*webhook
+
+	httpClient *http.Client
+}
+
+func NewWebhookNotifier(webhookConfigs []*args.WebhookConfig) (*WebhookNotifier, error) {
+	webhooks := []*webhook{}
+	for _, webhookConfig := range webhookConfigs {
+		if webhookConfig.Url == "" {
+			return nil, stacktrace.NewError("Webhooks must have a URL")
+		}
+		switch webhookConfig.Kind {
+		case "", WebhookKind_Generic, WebhookKind_Slack:
+		default:
+			return nil, stacktrace.NewError("Webhook '%v' has kind '%v', but the valid kinds are '%v' and '%v'", webhookConfig.Url, webhookConfig.Kind, WebhookKind_Generic, WebhookKind_Slack)
+		}
+
+		eventTypes := map[EventType]bool{}
+		for _, eventTypeStr := range webhookConfig.Events {
+			eventType := EventType(eventTypeStr)
+			if !isValidEventType(eventType) {
+				return nil, stacktrace.NewError("Webhook '%v' wants unknown event type '%v'; the valid event types are: %v", webhookConfig.Url, eventTypeStr, strings.Join(getAllEventTypeStrs(), ", "))
+			}
+			eventTypes[eventType] = true
+		}
+
+		var maybePayloadTemplate *template.Template
+		if webhookConfig.PayloadTemplate != "" {
+			payloadTemplate, err := template.New(webhookConfig.Url).Funcs(payloadTemplateFuncs).Parse(webhookConfig.PayloadTemplate)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred parsing the payload template of webhook '%v'", webhookConfig.Url)
+			}
+			maybePayloadTemplate = payloadTemplate
+		}
+
+		webhooks = append(webhooks, &webhook{
+			config:               webhookConfig,
+			eventTypes:           eventTypes,
+			maybePayloadTemplate: maybePayloadTemplate,
+		})
+	}
+	httpClient := &http.Client{
+		Transport:     nil,
+		CheckRedirect: nil,
+		Jar:           nil,
+		Timeout:       webhookRequestTimeout,
+	}
+	return &WebhookNotifier{
+		webhooks:   webhooks,
+		httpClient: httpClient,
+	}, nil
+}
+
+// IsNotifying returns whether any webhook wants events of the given type, so the events that nobody wants don't need to
+// be gathered at all
+func (notifier *WebhookNotifier) IsNotifying(eventType EventType) bool {
+	for _, webhook := range notifier.webhooks {
+		if webhook.wants(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify sends the event to every webhook that wants it, waiting for all of them to answer
+func (notifier *WebhookNotifier) Notify(ctx context.Context, event *Event) error {
+	wg := &sync.WaitGroup{}
+	errorsMutex := &sync.Mutex{}
+	errorStrs := []string{}
+	for _, webhookToNotify := range notifier.webhooks {
+		if !webhookToNotify.wants(event.Type) {
+			continue
+		}
+		wg.Add(1)
+		go func(webhookToNotify *webhook) {
+			defer wg.Done()
+			if err := notifier.send(ctx, webhookToNotify, event); err != nil {
+				errorsMutex.Lock()
+				defer errorsMutex.Unlock()
+				errorStrs = append(errorStrs, err.Error())
+			}
+		}(webhookToNotify)
+	}
+	wg.Wait()
+
+	if len(errorStrs) > 0 {
+		return stacktrace.NewError("Errors occurred notifying the webhooks of event '%v':\n%v", event.Type, strings.Join(errorStrs, webhookErrorsSeparator))
+	}
+	return nil
+}
+
+func (notifier *WebhookNotifier) send(ctx context.Context, webhookToNotify *webhook, event *Event) error {
+	payload, err := webhookToNotify.renderPayload(event)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred rendering the payload of webhook '%v'", webhookToNotify.config.Url)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookToNotify.config.Url, bytes.NewReader(payload))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the request to webhook '%v'", webhookToNotify.config.Url)
+	}
+	request.Header.Set("Content-Type", jsonContentType)
+	response, err := notifier.httpClient.Do(request)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending event '%v' to webhook '%v'", event.Type, webhookToNotify.config.Url)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return stacktrace.NewError("Webhook '%v' answered event '%v' with status '%v'", webhookToNotify.config.Url, event.Type, response.Status)
+	}
+	logrus.Debugf("Sent event '%v' to webhook '%v'", event.Type, webhookToNotify.config.Url)
+	return nil
+}
+
+func (webhook *webhook) wants(eventType EventType) bool {
+	return len(webhook.eventTypes) == 0 || webhook.eventTypes[eventType]
+}
+
+func (webhook *webhook) renderPayload(event *Event) ([]byte, error) {
+	if webhook.maybePayloadTemplate != nil {
+		payload := &bytes.Buffer{}
+		if err := webhook.maybePayloadTemplate.Execute(payload, event); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred executing the payload template")
+		}
+		return payload.Bytes(), nil
+	}
+
+	var payloadObj interface{} = event
+	if webhook.config.Kind == WebhookKind_Slack {
+		payloadObj = map[string]string{"text": event.Summary()}
+	}
+	payload, err := json.Marshal(payloadObj)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the payload to JSON")
+	}
+	return payload, nil
+}
+
+func getAllEventTypeStrs() []string {
+	result := []string{}
+	for _, eventType := range allEventTypes {
+		result = append(result, fmt.Sprintf("'%v'", eventType))
+	}
+	return result
+}
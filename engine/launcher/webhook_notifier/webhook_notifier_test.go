@@ -0,0 +1,112 @@
+package webhook_notifier
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testEvent = &Event{
+	Type:        EventType_RunFinished,
+	Timestamp:   time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+	EnclaveUuid: "enclave-uuid",
+	EnclaveName: "nightly",
+	ServiceName: "",
+	ServiceUuid: "",
+	PackageId:   "github.com/kurtosis-tech/eth2-package",
+	IsSuccess:   false,
+	Message:     "",
+}
+
+func TestNotify_SendsDefaultPayloadsOfEachKind(t *testing.T) {
+	genericServer, genericBodies := newRecordingServer(t)
+	slackServer, slackBodies := newRecordingServer(t)
+	notifier, err := NewWebhookNotifier([]*args.WebhookConfig{
+		newWebhookConfig(genericServer.URL, "", nil, ""),
+		newWebhookConfig(slackServer.URL, WebhookKind_Slack, nil, ""),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, notifier.Notify(context.Background(), testEvent))
+
+	genericPayload := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(<-genericBodies, &genericPayload))
+	require.Equal(t, "run_finished", genericPayload["type"])
+	require.Equal(t, "nightly", genericPayload["enclaveName"])
+	require.Equal(t, false, genericPayload["isSuccess"])
+
+	slackPayload := map[string]string{}
+	require.NoError(t, json.Unmarshal(<-slackBodies, &slackPayload))
+	require.Equal(t, "Running 'github.com/kurtosis-tech/eth2-package' in enclave 'nightly' failed", slackPayload["text"])
+}
+
+func TestNotify_OnlySendsWantedEventsAndRendersTemplates(t *testing.T) {
+	server, bodies := newRecordingServer(t)
+	notifier, err := NewWebhookNotifier([]*args.WebhookConfig{
+		newWebhookConfig(server.URL, WebhookKind_Generic, []string{string(EventType_RunFinished)}, `{"msg": {{ json .Summary }}, "enclave": "{{ .EnclaveName }}"}`),
+	})
+	require.NoError(t, err)
+	require.True(t, notifier.IsNotifying(EventType_RunFinished))
+	require.False(t, notifier.IsNotifying(EventType_EnclaveCreated))
+
+	unwantedEvent := *testEvent
+	unwantedEvent.Type = EventType_EnclaveCreated
+	require.NoError(t, notifier.Notify(context.Background(), &unwantedEvent))
+	require.NoError(t, notifier.Notify(context.Background(), testEvent))
+
+	require.Len(t, bodies, 1)
+	require.JSONEq(t, `{"msg": "Running 'github.com/kurtosis-tech/eth2-package' in enclave 'nightly' failed", "enclave": "nightly"}`, string(<-bodies))
+}
+
+func TestNotify_ReportsFailingWebhooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	notifier, err := NewWebhookNotifier([]*args.WebhookConfig{newWebhookConfig(server.URL, "", nil, "")})
+	require.NoError(t, err)
+
+	err = notifier.Notify(context.Background(), testEvent)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "500")
+}
+
+func TestNewWebhookNotifier_RejectsInvalidConfigs(t *testing.T) {
+	_, err := NewWebhookNotifier([]*args.WebhookConfig{newWebhookConfig("", "", nil, "")})
+	require.Error(t, err)
+
+	_, err = NewWebhookNotifier([]*args.WebhookConfig{newWebhookConfig("http://localhost", "teams", nil, "")})
+	require.Error(t, err)
+
+	_, err = NewWebhookNotifier([]*args.WebhookConfig{newWebhookConfig("http://localhost", "", []string{"enclave_reaped"}, "")})
+	require.Error(t, err)
+
+	_, err = NewWebhookNotifier([]*args.WebhookConfig{newWebhookConfig("http://localhost", "", nil, "{{ .Unclosed ")})
+	require.Error(t, err)
+}
+
+func newWebhookConfig(url string, kind string, events []string, payloadTemplate string) *args.WebhookConfig {
+	return &args.WebhookConfig{
+		Url:             url,
+		Kind:            kind,
+		Events:          events,
+		PayloadTemplate: payloadTemplate,
+	}
+}
+
+func newRecordingServer(t *testing.T) (*httptest.Server, chan []byte) {
+	bodies := make(chan []byte, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		bodies <- body
+	}))
+	t.Cleanup(server.Close)
+	return server, bodies
+}
@@ -0,0 +1,57 @@
+package api_container_clients
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// ApiContainerConnector connects the engine to the API containers of the enclaves.
+// The engine reaches an API container on the given host at the port the API container publishes on the host machine,
+// which is how it gets out of its own network on Docker ('host.docker.internal'); if the host is empty, the API
+// container is reached on its IP inside the enclave, which is routable from the engine on Kubernetes
+type ApiContainerConnector struct {
+	apiContainerHostMachineHost string
+}
+
+func NewApiContainerConnector(apiContainerHostMachineHost string) *ApiContainerConnector {
+	return &ApiContainerConnector{
+		apiContainerHostMachineHost: apiContainerHostMachineHost,
+	}
+}
+
+// Connect returns a client of the API container of the enclave, along with a function to close the connection
+func (connector *ApiContainerConnector) Connect(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) (kurtosis_core_rpc_api_bindings.ApiContainerServiceClient, func(), error) {
+	apiContainerUrl, err := getApiContainerUrl(enclaveInfo, connector.apiContainerHostMachineHost)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the URL of the API container of enclave '%v'", enclaveInfo.GetName())
+	}
+	conn, err := grpc.Dial(apiContainerUrl, grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v' at '%v'", enclaveInfo.GetName(), apiContainerUrl)
+	}
+	closeConnFunc := func() {
+		if err := conn.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the connection to the API container of enclave '%v':\n%v", enclaveInfo.GetName(), err)
+		}
+	}
+	return kurtosis_core_rpc_api_bindings.NewApiContainerServiceClient(conn), closeConnFunc, nil
+}
+
+func getApiContainerUrl(enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, apiContainerHostMachineHost string) (string, error) {
+	if apiContainerHostMachineHost == "" {
+		apiContainerInfo := enclaveInfo.GetApiContainerInfo()
+		if apiContainerInfo == nil {
+			return "", stacktrace.NewError("Enclave '%v' has no API container info", enclaveInfo.GetName())
+		}
+		return fmt.Sprintf("%v:%v", apiContainerInfo.GetIpInsideEnclave(), apiContainerInfo.GetGrpcPortInsideEnclave()), nil
+	}
+	apiContainerHostMachineInfo := enclaveInfo.GetApiContainerHostMachineInfo()
+	if apiContainerHostMachineInfo == nil {
+		return "", stacktrace.NewError("Enclave '%v' has no API container host machine info; is the API container running?", enclaveInfo.GetName())
+	}
+	return fmt.Sprintf("%v:%v", apiContainerHostMachineHost, apiContainerHostMachineInfo.GetGrpcPortOnHostMachine()), nil
+}
@@ -0,0 +1,98 @@
+package api_container_clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"strings"
+)
+
+const (
+	// Tells the destination API container to generate a name for the files artifact
+	autogenerateFilesArtifactName = ""
+)
+
+// CopyFilesArtifact copies a files artifact from the API container of one enclave to the API container of another one,
+// returning the UUID and name of the files artifact in the destination enclave and whether the destination enclave
+// already stored the same files. If the destination name is blank, the files artifact keeps its name.
+func CopyFilesArtifact(
+	ctx context.Context,
+	sourceApiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient,
+	destinationApiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient,
+	filesArtifactIdentifier string,
+	destinationFilesArtifactName string,
+) (string, string, bool, error) {
+	if destinationFilesArtifactName == "" {
+		sourceFilesArtifactName, err := getFilesArtifactName(ctx, sourceApiContainerClient, filesArtifactIdentifier)
+		if err != nil {
+			return "", "", false, stacktrace.Propagate(err, "An error occurred getting the name of files artifact '%v' in the source enclave", filesArtifactIdentifier)
+		}
+		destinationFilesArtifactName = sourceFilesArtifactName
+	}
+
+	downloadResponse, err := sourceApiContainerClient.DownloadFilesArtifact(ctx, binding_constructors.DownloadFilesArtifactArgs(filesArtifactIdentifier))
+	if err != nil {
+		return "", "", false, stacktrace.Propagate(err, "An error occurred downloading files artifact '%v' from the source enclave", filesArtifactIdentifier)
+	}
+	content := downloadResponse.GetData()
+
+	// The hash gets sent first, so that the transfer can be skipped if the destination enclave already stores the same files
+	contentHash := sha256.Sum256(content)
+	contentHashStr := hex.EncodeToString(contentHash[:])
+	hashOnlyArgs := binding_constructors.NewUploadFilesArtifactArgsWithContentHash(nil, destinationFilesArtifactName, contentHashStr)
+	uploadResponse, err := destinationApiContainerClient.UploadFilesArtifact(ctx, hashOnlyArgs)
+	if err != nil {
+		return "", "", false, stacktrace.Propagate(err, "An error occurred checking whether the destination enclave already stores files artifact '%v'", filesArtifactIdentifier)
+	}
+	if uploadResponse.GetIsAlreadyStored() {
+		logrus.Debugf("Skipped transferring files artifact '%v' as the destination enclave already stores the same files", filesArtifactIdentifier)
+		return uploadResponse.GetUuid(), uploadResponse.GetName(), true, nil
+	}
+
+	uploadArgs := binding_constructors.NewUploadFilesArtifactArgsWithContentHash(content, uploadResponse.GetName(), contentHashStr)
+	uploadResponse, err = destinationApiContainerClient.UploadFilesArtifact(ctx, uploadArgs)
+	if err != nil {
+		return "", "", false, stacktrace.Propagate(err, "An error occurred uploading files artifact '%v' to the destination enclave", filesArtifactIdentifier)
+	}
+	return uploadResponse.GetUuid(), uploadResponse.GetName(), false, nil
+}
+
+// getFilesArtifactName returns the name of the files artifact with the given name, UUID or shortened UUID, or the
+// empty string if the files artifact has no name that can be told for sure
+func getFilesArtifactName(
+	ctx context.Context,
+	apiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient,
+	filesArtifactIdentifier string,
+) (string, error) {
+	response, err := apiContainerClient.ListFilesArtifactNamesAndUuids(ctx, &emptypb.Empty{})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred listing the files artifacts")
+	}
+	filesArtifactNamesAndUuids := response.GetFileNamesAndUuids()
+	for _, filesArtifactNameAndUuid := range filesArtifactNamesAndUuids {
+		if filesArtifactNameAndUuid.GetFileName() == filesArtifactIdentifier {
+			return filesArtifactIdentifier, nil
+		}
+	}
+
+	matchingNames := map[string]bool{}
+	for _, filesArtifactNameAndUuid := range filesArtifactNamesAndUuids {
+		if strings.HasPrefix(filesArtifactNameAndUuid.GetFileUuid(), filesArtifactIdentifier) {
+			matchingNames[filesArtifactNameAndUuid.GetFileName()] = true
+		}
+	}
+	if len(matchingNames) != 1 {
+		// Either the identifier matches no files artifact, which the download will report, or the same files go by
+		// several names, in which case none of them is more right than the others
+		return autogenerateFilesArtifactName, nil
+	}
+	for matchingName := range matchingNames {
+		return matchingName, nil
+	}
+	return autogenerateFilesArtifactName, nil
+}
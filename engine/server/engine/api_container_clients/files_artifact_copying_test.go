@@ -0,0 +1,122 @@
+package api_container_clients
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"testing"
+)
+
+const (
+	filesArtifactName = "genesis"
+	filesArtifactUuid = "6d1c2f5e8a7b4c3d9e0f1a2b3c4d5e6f"
+)
+
+var filesArtifactContent = []byte("genesis-state")
+
+// Only implements the calls the copy makes; any other call panics
+type fakeApiContainerClient struct {
+	kurtosis_core_rpc_api_bindings.ApiContainerServiceClient
+
+	filesArtifactNamesAndUuids []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid
+	contentByUuid              map[string][]byte
+	uploads                    []*kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs
+}
+
+func newFakeApiContainerClient() *fakeApiContainerClient {
+	return &fakeApiContainerClient{
+		ApiContainerServiceClient:  nil,
+		filesArtifactNamesAndUuids: []*kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{},
+		contentByUuid:              map[string][]byte{},
+		uploads:                    []*kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs{},
+	}
+}
+
+func (client *fakeApiContainerClient) store(name string, uuid string, content []byte) {
+	client.filesArtifactNamesAndUuids = append(client.filesArtifactNamesAndUuids, &kurtosis_core_rpc_api_bindings.FilesArtifactNameAndUuid{FileName: name, FileUuid: uuid})
+	client.contentByUuid[uuid] = content
+}
+
+func (client *fakeApiContainerClient) ListFilesArtifactNamesAndUuids(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse, error) {
+	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse{FileNamesAndUuids: client.filesArtifactNamesAndUuids}, nil
+}
+
+func (client *fakeApiContainerClient) DownloadFilesArtifact(_ context.Context, args *kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, _ ...grpc.CallOption) (*kurtosis_core_rpc_api_bindings.DownloadFilesArtifactResponse, error) {
+	for _, filesArtifactNameAndUuid := range client.filesArtifactNamesAndUuids {
+		if args.GetIdentifier() == filesArtifactNameAndUuid.GetFileName() || args.GetIdentifier() == filesArtifactNameAndUuid.GetFileUuid() {
+			return &kurtosis_core_rpc_api_bindings.DownloadFilesArtifactResponse{Data: client.contentByUuid[filesArtifactNameAndUuid.GetFileUuid()]}, nil
+		}
+	}
+	panic("unknown files artifact " + args.GetIdentifier())
+}
+
+func (client *fakeApiContainerClient) UploadFilesArtifact(_ context.Context, args *kurtosis_core_rpc_api_bindings.UploadFilesArtifactArgs, _ ...grpc.CallOption) (*kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse, error) {
+	client.uploads = append(client.uploads, args)
+	for uuid, content := range client.contentByUuid {
+		contentHash := sha256.Sum256(content)
+		if hex.EncodeToString(contentHash[:]) == args.GetContentHash() {
+			return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse{Uuid: uuid, Name: args.GetName(), IsAlreadyStored: true}, nil
+		}
+	}
+	if len(args.GetData()) == 0 {
+		return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse{Uuid: "", Name: args.GetName(), IsAlreadyStored: false}, nil
+	}
+	return &kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse{Uuid: "new-uuid", Name: args.GetName(), IsAlreadyStored: false}, nil
+}
+
+func TestCopyFilesArtifact_KeepsNameOfFilesArtifactIdentifiedByUuid(t *testing.T) {
+	source := newFakeApiContainerClient()
+	source.store(filesArtifactName, filesArtifactUuid, filesArtifactContent)
+	destination := newFakeApiContainerClient()
+
+	uuid, name, wasAlreadyStored, err := CopyFilesArtifact(context.Background(), source, destination, filesArtifactUuid, "")
+	require.NoError(t, err)
+	require.Equal(t, "new-uuid", uuid)
+	require.Equal(t, filesArtifactName, name)
+	require.False(t, wasAlreadyStored)
+
+	require.Len(t, destination.uploads, 2)
+	require.Empty(t, destination.uploads[0].GetData())
+	require.Equal(t, filesArtifactContent, destination.uploads[1].GetData())
+	require.Equal(t, filesArtifactName, destination.uploads[1].GetName())
+}
+
+func TestCopyFilesArtifact_RenamesFilesArtifact(t *testing.T) {
+	source := newFakeApiContainerClient()
+	source.store(filesArtifactName, filesArtifactUuid, filesArtifactContent)
+	destination := newFakeApiContainerClient()
+
+	_, name, _, err := CopyFilesArtifact(context.Background(), source, destination, filesArtifactName, "other-genesis")
+	require.NoError(t, err)
+	require.Equal(t, "other-genesis", name)
+}
+
+func TestCopyFilesArtifact_SkipsTransferOfAlreadyStoredFiles(t *testing.T) {
+	source := newFakeApiContainerClient()
+	source.store(filesArtifactName, filesArtifactUuid, filesArtifactContent)
+	destination := newFakeApiContainerClient()
+	destination.store("existing-genesis", "existing-uuid", filesArtifactContent)
+
+	uuid, name, wasAlreadyStored, err := CopyFilesArtifact(context.Background(), source, destination, filesArtifactName, "")
+	require.NoError(t, err)
+	require.Equal(t, "existing-uuid", uuid)
+	require.Equal(t, filesArtifactName, name)
+	require.True(t, wasAlreadyStored)
+
+	require.Len(t, destination.uploads, 1)
+	require.Empty(t, destination.uploads[0].GetData())
+}
+
+func TestGetFilesArtifactName_AmbiguousShortenedUuid(t *testing.T) {
+	source := newFakeApiContainerClient()
+	source.store(filesArtifactName, filesArtifactUuid, filesArtifactContent)
+	source.store("other-name", filesArtifactUuid, filesArtifactContent)
+
+	name, err := getFilesArtifactName(context.Background(), source, filesArtifactUuid[:12])
+	require.NoError(t, err)
+	require.Equal(t, autogenerateFilesArtifactName, name)
+}
@@ -38,6 +38,7 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 	userServiceUuids map[service.ServiceUUID]bool,
 	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
 	shouldFollowLogs bool,
+	timeRange *service.ServiceLogsTimeRange,
 ) (
 	chan map[service.ServiceUUID][]logline.LogLine,
 	chan error,
@@ -55,7 +56,7 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred creating conjunctive log line filter with regex from filters '%+v'", conjunctiveLogLineFilters)
 	}
 
-	successfulUserServiceLogs, erroredUserServiceUuids, err := client.kurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, userServiceFilters, shouldFollowLogs)
+	successfulUserServiceLogs, erroredUserServiceUuids, err := client.kurtosisBackend.GetUserServiceLogs(ctx, enclaveUuid, userServiceFilters, shouldFollowLogs, timeRange)
 	if err != nil {
 		cancelCtxFunc()
 		return nil, nil, nil, stacktrace.Propagate(
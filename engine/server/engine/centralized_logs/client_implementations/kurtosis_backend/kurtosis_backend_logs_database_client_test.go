@@ -391,10 +391,12 @@ func executeStreamCallAndGetReceivedServiceLogLines(
 		receivedServiceLogsByUuid[serviceUuid] = []logline.LogLine{}
 	}
 
+	timeRange := service.NewUnboundedServiceLogsTimeRange()
+
 	kurtosisBackend := backend_interface.NewMockKurtosisBackend(t)
 
 	kurtosisBackend.EXPECT().
-		GetUserServiceLogs(ctxWithCancel, enclaveUuid, userServiceFilters, shouldFollowLogs).
+		GetUserServiceLogs(ctxWithCancel, enclaveUuid, userServiceFilters, shouldFollowLogs, timeRange).
 		Return(
 			successfulServiceLogs,
 			erroredUserServiceUuids,
@@ -403,7 +405,7 @@ func executeStreamCallAndGetReceivedServiceLogLines(
 
 	logsDatabaseClient := NewKurtosisBackendLogsDatabaseClient(kurtosisBackend)
 
-	userServiceLogsByUuidChan, errChan, receivedCancelCtxFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, logLinesFilters, shouldFollowLogs)
+	userServiceLogsByUuidChan, errChan, receivedCancelCtxFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, logLinesFilters, shouldFollowLogs, timeRange)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting user service logs for UUIDs '%+v' using log line filters '%v' in enclave '%v'", userServiceUuids, logLinesFilters, enclaveUuid)
 	}
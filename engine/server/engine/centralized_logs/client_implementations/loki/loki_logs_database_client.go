@@ -43,6 +43,7 @@ const (
 	organizationIdHttpHeaderKey = "X-Scope-OrgID"
 
 	startTimeQueryParamKey    = "start"
+	endTimeQueryParamKey      = "end"
 	queryLogsQueryParamKey    = "query"
 	entriesLimitQueryParamKey = "limit"
 	directionQueryParamKey    = "direction"
@@ -150,6 +151,7 @@ func (client *lokiLogsDatabaseClient) StreamUserServiceLogs(
 	userServiceUuids map[service.ServiceUUID]bool,
 	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
 	shouldFollowLogs bool,
+	timeRange *service.ServiceLogsTimeRange,
 ) (
 	chan map[service.ServiceUUID][]logline.LogLine,
 	chan error,
@@ -170,12 +172,16 @@ func (client *lokiLogsDatabaseClient) StreamUserServiceLogs(
 	}
 
 	if shouldFollowLogs {
-		serviceLogsByServiceUuidChan, errChan, cancelCtxFunc, err = client.streamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, lokiFilterLogsPipeline)
+		// The tail endpoint can't stop at a given time
+		if !timeRange.GetUntil().IsZero() {
+			return nil, nil, nil, stacktrace.NewError("Following the logs up to a given time isn't supported by the logs database; the logs can only be followed from a given time on")
+		}
+		serviceLogsByServiceUuidChan, errChan, cancelCtxFunc, err = client.streamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, lokiFilterLogsPipeline, timeRange)
 		if err != nil {
 			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred streaming service logs for UUIDs '%+v' in enclave with ID '%v'", userServiceUuids, enclaveUuid)
 		}
 	} else {
-		serviceLogsByServiceUuidChan, cancelCtxFunc, err = client.getUserServiceLogs(ctx, enclaveUuid, userServiceUuids, lokiFilterLogsPipeline)
+		serviceLogsByServiceUuidChan, cancelCtxFunc, err = client.getUserServiceLogs(ctx, enclaveUuid, userServiceUuids, lokiFilterLogsPipeline, timeRange)
 		if err != nil {
 			return nil, nil, nil, stacktrace.Propagate(err, "An error occurred streaming service logs for UUIDs '%+v' in enclave with ID '%v'", userServiceUuids, enclaveUuid)
 		}
@@ -269,6 +275,7 @@ func (client *lokiLogsDatabaseClient) getUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	lokiFilterLogsPipeline *lokiLogPipeline,
+	timeRange *service.ServiceLogsTimeRange,
 ) (
 	chan map[service.ServiceUUID][]logline.LogLine,
 	context.CancelFunc,
@@ -283,7 +290,7 @@ func (client *lokiLogsDatabaseClient) getUserServiceLogs(
 		kurtosisUuids = append(kurtosisUuids, string(userServiceUuid))
 	}
 
-	maxRetentionLogsTimeParamValue := getMaxRetentionLogsTimeParamValue()
+	startTimeParamValue := getStartTimeForGettingLogsParamValue(timeRange)
 
 	userServiceContainerTypeDockerValue := label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString()
 
@@ -295,7 +302,11 @@ func (client *lokiLogsDatabaseClient) getUserServiceLogs(
 
 	queryRangeEndpointQuery := queryRangeEndpointUrl.Query()
 
-	queryRangeEndpointQuery.Set(startTimeQueryParamKey, maxRetentionLogsTimeParamValue)
+	queryRangeEndpointQuery.Set(startTimeQueryParamKey, startTimeParamValue)
+	// Loki defaults the end of the range to now
+	if until := timeRange.GetUntil(); !until.IsZero() {
+		queryRangeEndpointQuery.Set(endTimeQueryParamKey, getTimeInNanoString(until))
+	}
 	queryRangeEndpointQuery.Set(queryLogsQueryParamKey, queryParamValue)
 	queryRangeEndpointQuery.Set(entriesLimitQueryParamKey, defaultEntriesLimit)
 	queryRangeEndpointQuery.Set(directionQueryParamKey, defaultDirection)
@@ -373,6 +384,7 @@ func (client *lokiLogsDatabaseClient) streamUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	lokiFilterLogsPipeline *lokiLogPipeline,
+	timeRange *service.ServiceLogsTimeRange,
 ) (
 	chan map[service.ServiceUUID][]logline.LogLine,
 	chan error,
@@ -390,7 +402,7 @@ func (client *lokiLogsDatabaseClient) streamUserServiceLogs(
 		}
 	}()
 
-	tailLogsEndpointURL, httpHeaderWithTenantID := client.getTailLogEndpointURLAndHeader(enclaveUuid, userServiceUuids, lokiFilterLogsPipeline)
+	tailLogsEndpointURL, httpHeaderWithTenantID := client.getTailLogEndpointURLAndHeader(enclaveUuid, userServiceUuids, lokiFilterLogsPipeline, timeRange)
 
 	//this channel will return the user service log lines by service UUID
 	logsByKurtosisUserServiceUuidChan := make(chan map[service.ServiceUUID][]logline.LogLine, logsByKurtosisUserServiceUuidChanBuffSize)
@@ -558,6 +570,7 @@ func (client *lokiLogsDatabaseClient) getTailLogEndpointURLAndHeader(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	lokiFilterLogsPipeline *lokiLogPipeline,
+	timeRange *service.ServiceLogsTimeRange,
 ) (url.URL, http.Header) {
 
 	kurtosisUuids := []string{}
@@ -565,7 +578,7 @@ func (client *lokiLogsDatabaseClient) getTailLogEndpointURLAndHeader(
 		kurtosisUuids = append(kurtosisUuids, string(userServiceUuid))
 	}
 
-	maxRetentionLogsTimeForTailingLogsParamValue := getStartTimeForStreamingLogsParamValue()
+	maxRetentionLogsTimeForTailingLogsParamValue := getStartTimeForStreamingLogsParamValue(timeRange)
 
 	userServiceContainerTypeDockerValue := label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString()
 
@@ -590,12 +603,14 @@ func (client *lokiLogsDatabaseClient) getTailLogEndpointURLAndHeader(
 	return tailLogsEndpointUrl, httpHeaderWithTenantID
 }
 
-func getMaxRetentionLogsTimeParamValue() string {
+// The logs are gotten from the start of the time range, but never from before the retention period, as there are none
+func getStartTimeForGettingLogsParamValue(timeRange *service.ServiceLogsTimeRange) string {
 	now := time.Now()
-	maxRetentionLogsTime := now.Add(-maxRetentionPeriodHours)
-	maxRetentionLogsTimeNano := maxRetentionLogsTime.UnixNano()
-	maxRetentionLogsTimeNanoStr := fmt.Sprintf("%v", maxRetentionLogsTimeNano)
-	return maxRetentionLogsTimeNanoStr
+	startTime := now.Add(-maxRetentionPeriodHours)
+	if since := timeRange.GetSince(); since.After(startTime) {
+		startTime = since
+	}
+	return getTimeInNanoString(startTime)
 }
 
 func getQueryParamValue(
@@ -674,9 +689,12 @@ func createUrl(scheme string, host string, path string) *url.URL {
 	}
 }
 
-func getStartTimeForStreamingLogsParamValue() string {
-	now := time.Now()
-	startTime := now.Add(oneHourLess)
+// Streamed logs start from the start of the time range, or an hour ago if it has none
+func getStartTimeForStreamingLogsParamValue(timeRange *service.ServiceLogsTimeRange) string {
+	startTime := timeRange.GetSince()
+	if startTime.IsZero() {
+		startTime = time.Now().Add(oneHourLess)
+	}
 	startTimeNanoStr := getTimeInNanoString(startTime)
 	return startTimeNanoStr
 }
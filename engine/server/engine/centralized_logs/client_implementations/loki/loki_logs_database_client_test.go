@@ -32,6 +32,7 @@ const (
 	expectedFirstLogLineOnEachService               = "This is the first log line."
 	expectedOrganizationIdHttpHeaderKey             = "X-Scope-Orgid"
 	expectedStartTimeQueryParamKey                  = "start"
+	expectedEndTimeQueryParamKey                    = "end"
 	expectedQueryLogsQueryParamKey                  = "query"
 	expectedEntriesLimitQueryParamKey               = "limit"
 	expectedDirectionQueryParamKey                  = "direction"
@@ -146,7 +147,7 @@ func TestStreamUserServiceLogsWithoutFilter_ValidResponse(t *testing.T) {
 
 	emptyLogLinesFilter := []logline.LogLineFilter{}
 
-	userServiceLogsByGuidChan, errChan, closeStreamFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveId, userServiceGuids, emptyLogLinesFilter, doNotFollowLogs)
+	userServiceLogsByGuidChan, errChan, closeStreamFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveId, userServiceGuids, emptyLogLinesFilter, doNotFollowLogs, service.NewUnboundedServiceLogsTimeRange())
 	defer closeStreamFunc()
 
 	require.NoError(t, err, "An error occurred getting user service logs for UUIDs '%+v' in enclave '%v'", userServiceGuids, enclaveId)
@@ -237,7 +238,7 @@ func TestStreamUserServiceLogsWithFilter_ValidResponse(t *testing.T) {
 		*logLinesFilter,
 	}
 
-	userServiceLogsByGuidChan, errChan, closeStreamFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveId, userServiceGuids, logLinesFilters, doNotFollowLogs)
+	userServiceLogsByGuidChan, errChan, closeStreamFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveId, userServiceGuids, logLinesFilters, doNotFollowLogs, service.NewUnboundedServiceLogsTimeRange())
 	defer closeStreamFunc()
 
 	require.NoError(t, err, "An error occurred getting user service logs for UUIDs '%+v' using log line filters '%v' in enclave '%v'", userServiceGuids, logLinesFilters, enclaveId)
@@ -327,6 +328,56 @@ func TestNewUserServiceLogLinesByUserServiceGuidFromLokiStreamsReturnSuccessfull
 
 }
 
+func TestStreamUserServiceLogs_QueriesTheTimeRange(t *testing.T) {
+	since := time.Now().Add(-2 * time.Hour)
+	until := time.Now().Add(-time.Hour)
+	mockHttpClient := mocks.NewMockHttpClient(t)
+	mockHttpClient.EXPECT().Do(mock.Anything).Run(func(request *http.Request) {
+		require.Equal(t, fmt.Sprintf("%v", since.UnixNano()), request.URL.Query().Get(expectedStartTimeQueryParamKey))
+		require.Equal(t, fmt.Sprintf("%v", until.UnixNano()), request.URL.Query().Get(expectedEndTimeQueryParamKey))
+	}).Return(&http.Response{
+		Status:           "",
+		StatusCode:       http.StatusOK,
+		Proto:            "",
+		ProtoMajor:       0,
+		ProtoMinor:       0,
+		Header:           nil,
+		Body:             io.NopCloser(strings.NewReader(mocks.MockedResponseBodyWithSeveralValuesStr)),
+		ContentLength:    0,
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          nil,
+		TLS:              nil,
+	}, nil)
+
+	logsDatabaseClient := NewLokiLogsDatabaseClient(fakeLogsDatabaseAddress, mockHttpClient)
+	userServiceGuids := map[service.ServiceUUID]bool{
+		testUserService1Uuid: true,
+	}
+	timeRange := service.NewServiceLogsTimeRange(since, until)
+	_, _, closeStreamFunc, err := logsDatabaseClient.StreamUserServiceLogs(context.Background(), testEnclaveUuid, userServiceGuids, []logline.LogLineFilter{}, doNotFollowLogs, timeRange)
+	require.NoError(t, err)
+	closeStreamFunc()
+}
+
+func TestStreamUserServiceLogs_StartsAtTheRetentionPeriodWhenSinceIsOlder(t *testing.T) {
+	timeRange := service.NewServiceLogsTimeRange(time.Now().Add(-10*maxRetentionPeriodHours), time.Time{})
+	startTime := getStartTimeForGettingLogsParamValue(timeRange)
+	require.Greater(t, startTime, fmt.Sprintf("%v", timeRange.GetSince().UnixNano()))
+}
+
+func TestStreamUserServiceLogs_CannotFollowLogsUntilATime(t *testing.T) {
+	logsDatabaseClient := NewLokiLogsDatabaseClient(fakeLogsDatabaseAddress, mocks.NewMockHttpClient(t))
+	userServiceGuids := map[service.ServiceUUID]bool{
+		testUserService1Uuid: true,
+	}
+	timeRange := service.NewServiceLogsTimeRange(time.Time{}, time.Now())
+	_, _, _, err := logsDatabaseClient.StreamUserServiceLogs(context.Background(), testEnclaveUuid, userServiceGuids, []logline.LogLineFilter{}, true, timeRange)
+	require.Error(t, err)
+}
+
 func TestFilterExistingServiceGuids_FilteringWorksAsExpected(t *testing.T) {
 	mockHttpClient := mocks.NewMockHttpClient(t)
 
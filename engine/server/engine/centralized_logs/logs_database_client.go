@@ -14,6 +14,8 @@ type LogsDatabaseClient interface {
 		userServiceUuids map[service.ServiceUUID]bool,
 		conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
 		shouldFollowLogs bool,
+		// Only the log lines logged within it are returned; they are filtered by the logs database, not after reading them
+		timeRange *service.ServiceLogsTimeRange,
 	) (
 		userServiceLogsByServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
 		errChan chan error,
@@ -0,0 +1,113 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// ReconcileEnclaveInfos brings the enclaves recorded in the engine state store up to date with the ones in the backend,
+// whose container labels are only a cache of the store; enclaves can change without going through the engine, e.g.
+// while it isn't running or when their containers get removed by hand
+func (manager *EnclaveManager) ReconcileEnclaveInfos(ctx context.Context) error {
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
+
+	return manager.reconcileEnclaveInfosWithoutMutex(ctx)
+}
+
+// StartReconcilingEnclaveInfos reconciles the recorded enclaves with the backend every interval, until the returned
+// function gets called
+func (manager *EnclaveManager) StartReconcilingEnclaveInfos(interval time.Duration) func() {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := manager.ReconcileEnclaveInfos(ctx); err != nil {
+					logrus.Warnf("An error occurred reconciling the enclaves recorded in the engine state store with the backend; will retry later:\n%v", err)
+				}
+			}
+		}
+	}()
+	return cancelFunc
+}
+
+func (manager *EnclaveManager) reconcileEnclaveInfosWithoutMutex(ctx context.Context) error {
+	backendEnclaveInfos, err := manager.getEnclavesFromBackendWithoutMutex(ctx, getAllEnclavesFilter())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves from the backend")
+	}
+	recordedEnclaveInfos, err := manager.engineStateStore.GetEnclaveInfos(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves recorded in the engine state store")
+	}
+
+	reconciledEnclaveInfos := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	isInSync := len(backendEnclaveInfos) == len(recordedEnclaveInfos)
+	for enclaveUuid, backendEnclaveInfo := range backendEnclaveInfos {
+		enclaveUuidStr := string(enclaveUuid)
+		reconciledEnclaveInfos[enclaveUuidStr] = backendEnclaveInfo
+		recordedEnclaveInfo, found := recordedEnclaveInfos[enclaveUuidStr]
+		if !found {
+			logrus.Debugf("Enclave '%v' exists in the backend but wasn't recorded in the engine state store; recording it", enclaveUuid)
+			isInSync = false
+			continue
+		}
+		if !proto.Equal(recordedEnclaveInfo, backendEnclaveInfo) {
+			logrus.Debugf("Enclave '%v' changed in the backend since it was recorded in the engine state store; updating it", enclaveUuid)
+			isInSync = false
+		}
+	}
+	if isInSync {
+		return nil
+	}
+	for enclaveUuidStr := range recordedEnclaveInfos {
+		if _, found := reconciledEnclaveInfos[enclaveUuidStr]; !found {
+			logrus.Debugf("Enclave '%v' was recorded in the engine state store but doesn't exist in the backend anymore; forgetting it", enclaveUuidStr)
+		}
+	}
+	if err := manager.engineStateStore.ReplaceEnclaveInfos(ctx, reconciledEnclaveInfos); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the enclaves recorded in the engine state store")
+	}
+	return nil
+}
+
+// refreshEnclaveInfoWithoutMutex is best-effort, as the periodic reconciliation catches up with what it misses
+func (manager *EnclaveManager) refreshEnclaveInfoWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID) {
+	enclaveInfos, err := manager.getEnclavesFromBackendWithoutMutex(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		logrus.Warnf("An error occurred getting enclave '%v' from the backend to update it in the engine state store:\n%v", enclaveUuid, err)
+		return
+	}
+	enclaveInfo, found := enclaveInfos[enclaveUuid]
+	if !found {
+		manager.removeDestroyedEnclaveInfos(ctx, map[enclave.EnclaveUUID]bool{enclaveUuid: true})
+		return
+	}
+	if err := manager.engineStateStore.PutEnclaveInfo(ctx, enclaveInfo); err != nil {
+		logrus.Warnf("An error occurred updating enclave '%v' in the engine state store:\n%v", enclaveUuid, err)
+	}
+}
+
+// removeDestroyedEnclaveInfos is best-effort, as the periodic reconciliation forgets the enclaves it misses
+func (manager *EnclaveManager) removeDestroyedEnclaveInfos(ctx context.Context, destroyedEnclaveUuids map[enclave.EnclaveUUID]bool) {
+	enclaveUuidStrs := map[string]bool{}
+	for enclaveUuid := range destroyedEnclaveUuids {
+		enclaveUuidStrs[string(enclaveUuid)] = true
+	}
+	if err := manager.engineStateStore.RemoveEnclaveInfos(ctx, enclaveUuidStrs); err != nil {
+		logrus.Warnf("An error occurred removing destroyed enclaves '%+v' from the engine state store:\n%v", enclaveUuidStrs, err)
+	}
+}
@@ -0,0 +1,88 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_state_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	backendOnlyEnclave  = enclave.EnclaveUUID("backend-only-enclave-uuid")
+	recordedOnlyEnclave = "recorded-only-enclave-uuid"
+)
+
+func TestReconcileEnclaveInfos_RecordsTheEnclavesOfTheBackend(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	engineStateStore := engine_state_store.NewInMemoryEngineStateStore()
+	manager := NewEnclaveManager(backend, nil, engineStateStore, 0)
+
+	require.NoError(t, engineStateStore.PutEnclaveInfo(ctx, &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                 recordedOnlyEnclave,
+		Name:                        "destroyed-by-hand",
+		ShortenedUuid:               "",
+		ContainersStatus:            kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+		ApiContainerStatus:          kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING,
+		ApiContainerInfo:            nil,
+		ApiContainerHostMachineInfo: nil,
+		CreationTime:                nil,
+		IpRange:                     "",
+	}))
+
+	backend.EXPECT().GetEnclaves(mock.Anything, mock.Anything).Return(
+		map[enclave.EnclaveUUID]*enclave.Enclave{
+			backendOnlyEnclave: enclave.NewEnclave(backendOnlyEnclave, "created-while-engine-was-down", enclave.EnclaveStatus_Stopped, nil, nil, ""),
+		},
+		nil,
+	)
+	backend.EXPECT().GetAPIContainers(mock.Anything, mock.Anything).Return(map[enclave.EnclaveUUID]*api_container.APIContainer{}, nil)
+
+	require.NoError(t, manager.ReconcileEnclaveInfos(ctx))
+
+	enclaves, err := manager.GetEnclaves(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaves, 1)
+	enclaveInfo, found := enclaves[string(backendOnlyEnclave)]
+	require.True(t, found)
+	require.Equal(t, "created-while-engine-was-down", enclaveInfo.GetName())
+	require.Equal(t, kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED, enclaveInfo.GetContainersStatus())
+	require.Equal(t, kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_NONEXISTENT, enclaveInfo.GetApiContainerStatus())
+}
+
+func TestGetEnclaves_RefreshesTheEnclavesFromTheBackend(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	engineStateStore := engine_state_store.NewInMemoryEngineStateStore()
+	manager := NewEnclaveManager(backend, nil, engineStateStore, 0)
+
+	// recorded as running, but stopped since without going through the engine
+	require.NoError(t, engineStateStore.PutEnclaveInfo(ctx, &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                 string(backendOnlyEnclave),
+		Name:                        "stopped-by-hand",
+		ShortenedUuid:               "",
+		ContainersStatus:            kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+		ApiContainerStatus:          kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING,
+		ApiContainerInfo:            nil,
+		ApiContainerHostMachineInfo: nil,
+		CreationTime:                nil,
+		IpRange:                     "",
+	}))
+
+	backend.EXPECT().GetEnclaves(mock.Anything, mock.Anything).Return(
+		map[enclave.EnclaveUUID]*enclave.Enclave{
+			backendOnlyEnclave: enclave.NewEnclave(backendOnlyEnclave, "stopped-by-hand", enclave.EnclaveStatus_Stopped, nil, nil, ""),
+		},
+		nil,
+	)
+	backend.EXPECT().GetAPIContainers(mock.Anything, mock.Anything).Return(map[enclave.EnclaveUUID]*api_container.APIContainer{}, nil)
+
+	enclaves, err := manager.GetEnclaves(ctx)
+	require.NoError(t, err)
+	require.Equal(t, kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_STOPPED, enclaves[string(backendOnlyEnclave)].GetContainersStatus())
+}
@@ -0,0 +1,181 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
+)
+
+const (
+	// Past this, the events that a subscriber doesn't read in time get dropped, so a slow subscriber can't block the
+	// enclave operations publishing them
+	enclaveLifecycleEventsChanBufferSize = 100
+
+	// Services get added through the API containers rather than the engine, so the engine has to look for them
+	addedServicesPollInterval = 2 * time.Second
+)
+
+// enclaveLifecycleEventsBroker fans out the lifecycle events of the enclaves to every subscriber
+// NOTE: Created, stopped & destroyed events are only published by the engine replica that made the change
+type enclaveLifecycleEventsBroker struct {
+	mutex *sync.Mutex
+
+	subscribers map[uint64]chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent
+
+	nextSubscriberId uint64
+
+	// Run while there's at least one subscriber, to publish the service added events
+	addedServicesWatcher func(ctx context.Context)
+
+	cancelAddedServicesWatcher context.CancelFunc
+}
+
+func newEnclaveLifecycleEventsBroker(addedServicesWatcher func(ctx context.Context)) *enclaveLifecycleEventsBroker {
+	return &enclaveLifecycleEventsBroker{
+		mutex:                      &sync.Mutex{},
+		subscribers:                map[uint64]chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent{},
+		nextSubscriberId:           0,
+		addedServicesWatcher:       addedServicesWatcher,
+		cancelAddedServicesWatcher: nil,
+	}
+}
+
+// subscribe returns the channel the events will be sent to, and the function to call to stop receiving them, which
+// closes the channel
+func (broker *enclaveLifecycleEventsBroker) subscribe() (<-chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent, func()) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	subscriberId := broker.nextSubscriberId
+	broker.nextSubscriberId++
+	eventsChan := make(chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent, enclaveLifecycleEventsChanBufferSize)
+	broker.subscribers[subscriberId] = eventsChan
+
+	if broker.cancelAddedServicesWatcher == nil && broker.addedServicesWatcher != nil {
+		watcherCtx, cancelWatcher := context.WithCancel(context.Background())
+		broker.cancelAddedServicesWatcher = cancelWatcher
+		go broker.addedServicesWatcher(watcherCtx)
+	}
+
+	unsubscribeOnce := &sync.Once{}
+	unsubscribe := func() {
+		unsubscribeOnce.Do(func() {
+			broker.mutex.Lock()
+			defer broker.mutex.Unlock()
+			delete(broker.subscribers, subscriberId)
+			close(eventsChan)
+			if len(broker.subscribers) == 0 && broker.cancelAddedServicesWatcher != nil {
+				broker.cancelAddedServicesWatcher()
+				broker.cancelAddedServicesWatcher = nil
+			}
+		})
+	}
+	return eventsChan, unsubscribe
+}
+
+func (broker *enclaveLifecycleEventsBroker) publish(
+	eventType kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	maybeAddedService *service.ServiceRegistration,
+) {
+	event := &kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent{
+		EventType:   eventType,
+		EnclaveUuid: string(enclaveUuid),
+		EnclaveName: enclaveName,
+		Timestamp:   timestamppb.Now(),
+		ServiceName: "",
+		ServiceUuid: "",
+	}
+	if maybeAddedService != nil {
+		event.ServiceName = string(maybeAddedService.GetName())
+		event.ServiceUuid = string(maybeAddedService.GetUUID())
+	}
+
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+	for subscriberId, eventsChan := range broker.subscribers {
+		select {
+		case eventsChan <- event:
+		default:
+			logrus.Warnf("Dropped enclave lifecycle event '%v' for enclave '%v' because subscriber '%v' isn't keeping up with the events", eventType.String(), enclaveUuid, subscriberId)
+		}
+	}
+}
+
+// watchForAddedServices publishes a service added event for every service that appears in a running enclave, until the
+// context gets cancelled. The services existing when it starts don't get an event.
+func (manager *EnclaveManager) watchForAddedServices(ctx context.Context) {
+	knownServiceUuidsByEnclaveUuid := map[enclave.EnclaveUUID]map[service.ServiceUUID]bool{}
+	shouldOnlyRecordServices := true
+	ticker := time.NewTicker(addedServicesPollInterval)
+	defer ticker.Stop()
+	for {
+		manager.publishAddedServices(ctx, knownServiceUuidsByEnclaveUuid, shouldOnlyRecordServices)
+		shouldOnlyRecordServices = false
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (manager *EnclaveManager) publishAddedServices(
+	ctx context.Context,
+	knownServiceUuidsByEnclaveUuid map[enclave.EnclaveUUID]map[service.ServiceUUID]bool,
+	shouldOnlyRecordServices bool,
+) {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	if err != nil {
+		logrus.Debugf("An error occurred getting the enclaves to look for added services; will retry later:\n%v", err)
+		return
+	}
+
+	for enclaveUuid := range knownServiceUuidsByEnclaveUuid {
+		if _, found := enclaves[enclaveUuid]; !found {
+			delete(knownServiceUuidsByEnclaveUuid, enclaveUuid)
+		}
+	}
+
+	allServicesFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	for enclaveUuid, enclaveObj := range enclaves {
+		if enclaveObj.GetStatus() != enclave.EnclaveStatus_Running {
+			continue
+		}
+		services, err := manager.kurtosisBackend.GetUserServices(ctx, enclaveUuid, allServicesFilters)
+		if err != nil {
+			logrus.Debugf("An error occurred getting the services of enclave '%v' to look for added ones; will retry later:\n%v", enclaveUuid, err)
+			continue
+		}
+		knownServiceUuids, found := knownServiceUuidsByEnclaveUuid[enclaveUuid]
+		if !found {
+			knownServiceUuids = map[service.ServiceUUID]bool{}
+			knownServiceUuidsByEnclaveUuid[enclaveUuid] = knownServiceUuids
+		}
+		for serviceUuid, serviceObj := range services {
+			if knownServiceUuids[serviceUuid] {
+				continue
+			}
+			knownServiceUuids[serviceUuid] = true
+			if shouldOnlyRecordServices {
+				continue
+			}
+			manager.lifecycleEventsBroker.publish(
+				kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_SERVICE_ADDED,
+				enclaveUuid,
+				enclaveObj.GetName(),
+				serviceObj.GetRegistration(),
+			)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+	testEnclaveName = "enclave-name"
+
+	watcherStateChangeTimeout = 5 * time.Second
+)
+
+func TestEnclaveLifecycleEventsBroker_PublishesToEverySubscriber(t *testing.T) {
+	broker := newEnclaveLifecycleEventsBroker(nil)
+	eventsChan1, unsubscribe1 := broker.subscribe()
+	defer unsubscribe1()
+	eventsChan2, unsubscribe2 := broker.subscribe()
+	defer unsubscribe2()
+
+	broker.publish(kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_CREATED, testEnclaveUuid, testEnclaveName, nil)
+
+	for _, eventsChan := range []<-chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent{eventsChan1, eventsChan2} {
+		event := <-eventsChan
+		require.Equal(t, kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_CREATED, event.GetEventType())
+		require.Equal(t, string(testEnclaveUuid), event.GetEnclaveUuid())
+		require.Equal(t, testEnclaveName, event.GetEnclaveName())
+		require.Empty(t, event.GetServiceName())
+	}
+}
+
+func TestEnclaveLifecycleEventsBroker_DropsEventsForSlowSubscribers(t *testing.T) {
+	broker := newEnclaveLifecycleEventsBroker(nil)
+	eventsChan, unsubscribe := broker.subscribe()
+	defer unsubscribe()
+
+	for i := 0; i < enclaveLifecycleEventsChanBufferSize+1; i++ {
+		broker.publish(kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_STOPPED, testEnclaveUuid, testEnclaveName, nil)
+	}
+	require.Len(t, eventsChan, enclaveLifecycleEventsChanBufferSize)
+}
+
+func TestEnclaveLifecycleEventsBroker_UnsubscribeClosesChanAndStopsWatcherWithLastSubscriber(t *testing.T) {
+	watcherStartedChan := make(chan struct{}, 1)
+	watcherStoppedChan := make(chan struct{}, 1)
+	broker := newEnclaveLifecycleEventsBroker(func(ctx context.Context) {
+		watcherStartedChan <- struct{}{}
+		<-ctx.Done()
+		watcherStoppedChan <- struct{}{}
+	})
+
+	eventsChan1, unsubscribe1 := broker.subscribe()
+	_, unsubscribe2 := broker.subscribe()
+	requireReceivesWithinTimeout(t, watcherStartedChan)
+
+	unsubscribe1()
+	_, isChanOpen := <-eventsChan1
+	require.False(t, isChanOpen)
+	require.Len(t, watcherStoppedChan, 0)
+	// Unsubscribing twice must be harmless
+	unsubscribe1()
+
+	unsubscribe2()
+	requireReceivesWithinTimeout(t, watcherStoppedChan)
+	require.Len(t, watcherStartedChan, 0)
+}
+
+func requireReceivesWithinTimeout(t *testing.T, signalChan chan struct{}) {
+	select {
+	case <-signalChan:
+	case <-time.After(watcherStateChangeTimeout):
+		require.Fail(t, "Timed out waiting for the added services watcher to change state")
+	}
+}
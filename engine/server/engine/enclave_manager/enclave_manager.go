@@ -2,6 +2,7 @@ package enclave_manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
@@ -9,15 +10,18 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/log_correlation"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_state_store"
 	"github.com/kurtosis-tech/kurtosis/name_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"net"
 	"sort"
 	"strings"
-	"sync"
+	"time"
 )
 
 const (
@@ -32,8 +36,14 @@ const (
 	errorDelimiter = ", "
 
 	enclaveNameNotFound = "Name Not Found"
+
+	// How long the IP range of a destroyed enclave is kept from new enclaves that ask for a cooldown
+	freedIpRangeReuseCooldown = 30 * time.Minute
 )
 
+// ErrEnclaveNameInUse is the root cause of the error CreateEnclave returns when an enclave with the name already exists
+var ErrEnclaveNameInUse = errors.New("an enclave with that name already exists")
+
 // TODO Move this to the KurtosisBackend to calculate!!
 // Completeness enforced via unit test
 var isContainerRunningDeterminer = map[types.ContainerStatus]bool{
@@ -48,29 +58,34 @@ var isContainerRunningDeterminer = map[types.ContainerStatus]bool{
 
 // Manages Kurtosis enclaves, and creates new ones in response to running tasks
 type EnclaveManager struct {
-	// We use Docker as our backing datastore, but it has tons of race conditions so we use this mutex to ensure
-	//  enclave modifications are atomic
-	mutex *sync.Mutex
-
 	kurtosisBackend                           backend_interface.KurtosisBackend
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 
-	// this is a stop gap solution, this would be stored and retrieved from the DB in the future
-	// we go with the GRPC type as it is just used by the engine server service
-	// this is an append only list
-	allExistingAndHistoricalIdentifiers []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers
+	// Holds the lock that makes enclave modifications atomic, along with the identifiers of every enclave created so far;
+	//  when it's an external store it's shared with the other replicas of the engine
+	engineStateStore engine_state_store.EngineStateStore
+
+	lifecycleEventsBroker *enclaveLifecycleEventsBroker
+
+	// Passed to the API containers; 0 lets them use their default
+	packageCacheMaxSizeBytes uint64
 }
 
 func NewEnclaveManager(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier,
+	engineStateStore engine_state_store.EngineStateStore,
+	packageCacheMaxSizeBytes uint64,
 ) *EnclaveManager {
-	return &EnclaveManager{
-		mutex:           &sync.Mutex{},
+	manager := &EnclaveManager{
 		kurtosisBackend: kurtosisBackend,
 		apiContainerKurtosisBackendConfigSupplier: apiContainerKurtosisBackendConfigSupplier,
-		allExistingAndHistoricalIdentifiers:       []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{},
+		engineStateStore:         engineStateStore,
+		lifecycleEventsBroker:    nil,
+		packageCacheMaxSizeBytes: packageCacheMaxSizeBytes,
 	}
+	manager.lifecycleEventsBroker = newEnclaveLifecycleEventsBroker(manager.watchForAddedServices)
+	return manager
 }
 
 // It's a liiiitle weird that we return an EnclaveInfo object (which is a Protobuf object), but as of 2021-10-21 this class
@@ -83,18 +98,50 @@ func (manager *EnclaveManager) CreateEnclave(
 	apiContainerLogLevel logrus.Level,
 	//If blank, will use a random one
 	enclaveName string,
+	// Empty unless the enclave gets created by a run of an enclave schedule, which labels it with the schedule name
+	scheduleName string,
 	isPartitioningEnabled bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	ipRangeReusePolicy kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy,
+	// CIDRs that the enclave's IP range must not overlap with, on top of the ones of the other enclaves
+	excludedIpRanges []string,
+	// Either a CIDR for the enclave to get exactly that subnet, or a mask like '/16'; if blank, will use the default
+	subnet string,
+	// If 0, files artifacts are kept until they get deleted or the enclave gets destroyed
+	filesArtifactsTtlSeconds uint32,
+	// Whether the services of the enclave can bind-mount directories of the host
+	allowHostDirMounts bool,
+	// Whether the enclave network also gets an IPv6 subnet, for services to get an IPv6 address too
+	isIpv6Enabled bool,
 ) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	subnetSpec, err := enclave.ParseEnclaveSubnetSpec(subnet, isIpv6Enabled)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the subnet '%v' requested for enclave '%v'", subnet, enclaveName)
+	}
+
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(setupCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
+
+	if isPartitioningEnabled {
+		backendCapabilities, err := manager.kurtosisBackend.GetBackendCapabilities(setupCtx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the capabilities of the backend")
+		}
+		if !backendCapabilities.IsNetworkPartitioningSupported() {
+			return nil, stacktrace.NewError("Cannot create enclave '%v' with subnetworking enabled because the backend doesn't support network partitioning yet", enclaveName)
+		}
+	}
 
 	uuid, err := uuid_generator.GenerateUUIDString()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating UUID for enclave with supplied name '%v'", enclaveName)
 	}
 	enclaveUuid := enclave.EnclaveUUID(uuid)
+	setupCtx = log_correlation.WithEnclaveUuid(setupCtx, uuid)
 
 	allCurrentEnclaves, err := manager.kurtosisBackend.GetEnclaves(setupCtx, getAllEnclavesFilter())
 	if err != nil {
@@ -106,16 +153,26 @@ func (manager *EnclaveManager) CreateEnclave(
 	}
 
 	if isEnclaveNameInUse(enclaveName, allCurrentEnclaves) {
-		return nil, stacktrace.NewError("Cannot create enclave '%v' because an enclave with that name already exists", enclaveName)
+		return nil, stacktrace.Propagate(ErrEnclaveNameInUse, "Cannot create enclave '%v'", enclaveName)
 	}
 
 	if err := validateEnclaveName(enclaveName); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating enclave name '%v'", enclaveName)
 	}
 
+	trackedEnclaveIpRanges, err := manager.engineStateStore.GetEnclaveIpRanges(setupCtx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the IP ranges of the enclaves from the engine state store")
+	}
+	excludedSubnets, err := getExcludedSubnets(ipRangeReusePolicy, excludedIpRanges, trackedEnclaveIpRanges, time.Now())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the IP ranges that enclave '%v' must not get", enclaveName)
+	}
+
+	logrus.WithContext(setupCtx).Debugf("Creating enclave '%v' with UUID '%v'", enclaveName, enclaveUuid)
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 	// Create Enclave with kurtosisBackend
-	newEnclave, err := manager.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, isPartitioningEnabled)
+	newEnclave, err := manager.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, scheduleName, isPartitioningEnabled, excludedSubnets, subnetSpec)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
@@ -125,13 +182,13 @@ func (manager *EnclaveManager) CreateEnclave(
 			_, destroyEnclaveErrs, err := manager.kurtosisBackend.DestroyEnclaves(teardownCtx, getEnclaveByEnclaveIdFilter(enclaveUuid))
 			manualActionRequiredStrFmt := "ACTION REQUIRED: You'll need to manually destroy the enclave '%v'!!!!!!"
 			if err != nil {
-				logrus.Errorf("Expected to be able to call the backend and destroy enclave '%v', but an error occurred:\n%v", enclaveUuid, err)
-				logrus.Errorf(manualActionRequiredStrFmt, enclaveUuid)
+				logrus.WithContext(setupCtx).Errorf("Expected to be able to call the backend and destroy enclave '%v', but an error occurred:\n%v", enclaveUuid, err)
+				logrus.WithContext(setupCtx).Errorf(manualActionRequiredStrFmt, enclaveUuid)
 				return
 			}
 			for enclaveUuid, err := range destroyEnclaveErrs {
-				logrus.Errorf("Expected to be able to cleanup the enclave '%v', but an error was thrown:\n%v", enclaveUuid, err)
-				logrus.Errorf(manualActionRequiredStrFmt, enclaveUuid)
+				logrus.WithContext(setupCtx).Errorf("Expected to be able to cleanup the enclave '%v', but an error was thrown:\n%v", enclaveUuid, err)
+				logrus.WithContext(setupCtx).Errorf(manualActionRequiredStrFmt, enclaveUuid)
 			}
 		}
 	}()
@@ -145,6 +202,8 @@ func (manager *EnclaveManager) CreateEnclave(
 		isPartitioningEnabled,
 		metricsUserID,
 		didUserAcceptSendingMetrics,
+		filesArtifactsTtlSeconds,
+		allowHostDirMounts,
 	)
 
 	if err != nil {
@@ -198,6 +257,14 @@ func (manager *EnclaveManager) CreateEnclave(
 		},
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                creationTimestamp,
+		IpRange:                     getEnclaveIpRange(newEnclave),
+		ScheduleName:                newEnclave.GetScheduleName(),
+	}
+
+	if result.IpRange != "" {
+		if err := manager.engineStateStore.AddEnclaveIpRange(setupCtx, newEnclaveUuidStr, result.IpRange); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred storing the IP range of enclave '%v' in the engine state store", newEnclaveUuidStr)
+		}
 	}
 
 	enclaveIdentifier := &kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{
@@ -205,11 +272,18 @@ func (manager *EnclaveManager) CreateEnclave(
 		Name:          enclaveName,
 		ShortenedUuid: shortenedUuid,
 	}
-	manager.allExistingAndHistoricalIdentifiers = append(manager.allExistingAndHistoricalIdentifiers, enclaveIdentifier)
+	if err := manager.engineStateStore.AddEnclaveIdentifiers(setupCtx, enclaveIdentifier); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred storing the identifiers of enclave '%v' in the engine state store", newEnclaveUuidStr)
+	}
+
+	if err := manager.engineStateStore.PutEnclaveInfo(setupCtx, result); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred recording enclave '%v' in the engine state store", newEnclaveUuidStr)
+	}
 
 	// Everything started successfully, so the responsibility of deleting the enclave is now transferred to the caller
 	shouldDestroyEnclave = false
 	shouldStopApiContainer = false
+	manager.lifecycleEventsBroker.publish(kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_CREATED, newEnclaveUuid, enclaveName, nil)
 	return result, nil
 }
 
@@ -219,8 +293,18 @@ func (manager *EnclaveManager) CreateEnclave(
 func (manager *EnclaveManager) GetEnclaves(
 	ctx context.Context,
 ) (map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
+
+	// The enclaves get listed to users, who expect to see their current status and ports, so the recorded ones get
+	// brought up to date first rather than waiting for the periodic reconciliation. The recorded enclaves are still
+	// better than nothing if the backend can't be reached
+	if err := manager.reconcileEnclaveInfosWithoutMutex(ctx); err != nil {
+		logrus.Warnf("An error occurred refreshing the enclaves from the backend; the enclaves returned might be out of date:\n%v", err)
+	}
 
 	enclaves, err := manager.getEnclavesWithoutMutex(ctx)
 	if err != nil {
@@ -238,28 +322,44 @@ func (manager *EnclaveManager) GetEnclaves(
 
 // StopEnclave
 func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifier string) error {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
 
 	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
+	ctx = log_correlation.WithEnclaveUuid(ctx, string(enclaveUuid))
+	logrus.WithContext(ctx).Debugf("Stopping enclave '%v'", enclaveIdentifier)
 
-	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
+	enclaveName := manager.getEnclaveNameWithoutMutex(ctx, enclaveUuid)
+	if err := manager.stopEnclaveWithoutMutex(ctx, enclaveUuid); err != nil {
+		return err
+	}
+	manager.refreshEnclaveInfoWithoutMutex(ctx, enclaveUuid)
+	manager.lifecycleEventsBroker.publish(kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_STOPPED, enclaveUuid, enclaveName, nil)
+	return nil
 }
 
 // DestroyEnclave
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
 func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
 
 	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
 	}
+	ctx = log_correlation.WithEnclaveUuid(ctx, string(enclaveUuid))
+	logrus.WithContext(ctx).Debugf("Destroying enclave '%v'", enclaveIdentifier)
 
 	enclaveDestroyFilter := &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
@@ -267,11 +367,16 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 		},
 		Statuses: nil,
 	}
+	manager.recordPulledImagesWithoutMutex(ctx, enclaveDestroyFilter)
+	enclaveName := manager.getEnclaveNameWithoutMutex(ctx, enclaveUuid)
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, enclaveDestroyFilter)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred destroying the enclave")
 	}
+	manager.releaseEnclaveIpRanges(ctx, successfullyDestroyedEnclaves)
+	manager.removeDestroyedEnclaveInfos(ctx, successfullyDestroyedEnclaves)
 	if _, found := successfullyDestroyedEnclaves[enclaveUuid]; found {
+		manager.lifecycleEventsBroker.publish(kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_DESTROYED, enclaveUuid, enclaveName, nil)
 		return nil
 	}
 	destructionErr, found := erroredEnclaves[enclaveUuid]
@@ -282,8 +387,11 @@ func (manager *EnclaveManager) DestroyEnclave(ctx context.Context, enclaveIdenti
 }
 
 func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) ([]*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid, error) {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
 	// TODO: Refactor with kurtosis backend
 	var resultEnclaveNameAndUuids []*kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid
 
@@ -314,16 +422,18 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 		logrus.Infof("Successfully removed the enclaves")
 		sort.Strings(successfullyRemovedEnclaveUuidStrs)
 		for _, successfullyRemovedEnclaveUuidStr := range successfullyRemovedEnclaveUuidStrs {
+			successfullyRemovedEnclaveUuid := enclave.EnclaveUUID(successfullyRemovedEnclaveUuidStr)
 			nameAndUuid := &kurtosis_engine_rpc_api_bindings.EnclaveNameAndUuid{
 				Uuid: successfullyRemovedEnclaveUuidStr,
 				Name: enclaveNameNotFound,
 			}
 			// this should always be found; but we don't want to error if it isn't
 			// we just use the default not found that we set above if we can't find the name
-			enclave, found := enclavesForUuidNameMapping[enclave.EnclaveUUID(successfullyRemovedEnclaveUuidStr)]
+			enclave, found := enclavesForUuidNameMapping[successfullyRemovedEnclaveUuid]
 			if found {
 				nameAndUuid.Name = enclave.GetName()
 			}
+			manager.lifecycleEventsBroker.publish(kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_DESTROYED, successfullyRemovedEnclaveUuid, nameAndUuid.Name, nil)
 			resultEnclaveNameAndUuids = append(resultEnclaveNameAndUuids, nameAndUuid)
 			logrus.Infof("Enclave Uuid '%v'", successfullyRemovedEnclaveUuidStr)
 		}
@@ -333,25 +443,35 @@ func (manager *EnclaveManager) Clean(ctx context.Context, shouldCleanAll bool) (
 }
 
 func (manager *EnclaveManager) GetEnclaveUuidForEnclaveIdentifier(ctx context.Context, enclaveIdentifier string) (enclave.EnclaveUUID, error) {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
 
 	return manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
 }
 
 func (manager *EnclaveManager) GetExistingAndHistoricalEnclaveIdentifiers() ([]*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers, error) {
-	manager.mutex.Lock()
-	defer manager.mutex.Unlock()
+	ctx := context.Background()
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
 
-	if len(manager.allExistingAndHistoricalIdentifiers) > 0 {
-		return manager.allExistingAndHistoricalIdentifiers, nil
+	allExistingAndHistoricalIdentifiers, err := manager.engineStateStore.GetEnclaveIdentifiers(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave identifiers from the engine state store")
+	}
+	if len(allExistingAndHistoricalIdentifiers) > 0 {
+		return allExistingAndHistoricalIdentifiers, nil
 	}
 	// either the engine got restarted or no enclaves have been created so far
 
 	var enclaveIdentifiersResult []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers
 	// TODO fix this - this is a hack while we persist enclave identifier information to disk
 	// this is a hack that will only send enclaves that are still registered; removed or destroyed enclaves will not show up
-	ctx := context.Background()
 	allCurrentEnclavesToBackFillRestart, err := manager.getEnclavesWithoutMutex(ctx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Found no registered enclaves in the in memory map; tried fetching them from backend but failed")
@@ -369,6 +489,67 @@ func (manager *EnclaveManager) GetExistingAndHistoricalEnclaveIdentifiers() ([]*
 	return enclaveIdentifiersResult, nil
 }
 
+// GetPulledImages lists the images Kurtosis pulled for enclaves that are still on the host, sorted by name
+func (manager *EnclaveManager) GetPulledImages(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.PulledImage, error) {
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
+
+	pulledImages, err := manager.getPulledImagesWithoutMutex(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pulled images")
+	}
+	return pulledImages, nil
+}
+
+// PruneImages removes the images Kurtosis pulled for enclaves that no existing enclave uses anymore, returning the
+// removed images along with the errors of the ones that couldn't be removed
+func (manager *EnclaveManager) PruneImages(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.PulledImage, map[string]string, error) {
+	unlock, err := manager.engineStateStore.LockEnclaveModifications(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred acquiring the enclave modifications lock")
+	}
+	defer unlock()
+
+	pulledImages, err := manager.getPulledImagesWithoutMutex(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the pulled images")
+	}
+
+	unusedImages := map[string]bool{}
+	for _, pulledImage := range pulledImages {
+		if len(pulledImage.GetEnclaveUuids()) > 0 {
+			continue
+		}
+		unusedImages[pulledImage.GetImage()] = true
+	}
+	if len(unusedImages) == 0 {
+		return []*kurtosis_engine_rpc_api_bindings.PulledImage{}, map[string]string{}, nil
+	}
+
+	successfullyRemovedImages, erroredImages, err := manager.kurtosisBackend.RemoveImages(ctx, unusedImages)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing unused pulled images '%+v'", unusedImages)
+	}
+	if err := manager.engineStateStore.RemovePulledImages(ctx, successfullyRemovedImages); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Removed images '%+v' but an error occurred forgetting them in the engine state store", successfullyRemovedImages)
+	}
+
+	removedImages := []*kurtosis_engine_rpc_api_bindings.PulledImage{}
+	for _, pulledImage := range pulledImages {
+		if _, found := successfullyRemovedImages[pulledImage.GetImage()]; found {
+			removedImages = append(removedImages, pulledImage)
+		}
+	}
+	removalErrorsByImage := map[string]string{}
+	for image, removalErr := range erroredImages {
+		removalErrorsByImage[image] = removalErr.Error()
+	}
+	return removedImages, removalErrorsByImage, nil
+}
+
 // ====================================================================================================
 // 									   Private helper methods
 // ====================================================================================================
@@ -430,6 +611,26 @@ func (manager *EnclaveManager) getEnclaveApiContainerInformation(
 	return resultApiContainerStatus, resultApiContainerInfo, resultApiContainerHostMachineInfo, nil
 }
 
+// SubscribeToEnclaveLifecycleEvents returns the channel the lifecycle events of all the enclaves will be sent to as they
+// happen, along with the function to call to stop receiving them
+func (manager *EnclaveManager) SubscribeToEnclaveLifecycleEvents() (<-chan *kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEvent, func()) {
+	return manager.lifecycleEventsBroker.subscribe()
+}
+
+// getEnclaveNameWithoutMutex is best-effort, as the name is only needed to give context to the lifecycle events
+func (manager *EnclaveManager) getEnclaveNameWithoutMutex(ctx context.Context, enclaveUuid enclave.EnclaveUUID) string {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		logrus.Debugf("An error occurred getting enclave '%v' to get its name:\n%v", enclaveUuid, err)
+		return enclaveNameNotFound
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return enclaveNameNotFound
+	}
+	return enclaveObj.GetName()
+}
+
 // Both StopEnclave and DestroyEnclave need to be able to stop enclaves, but both have a mutex guard. Because Go mutexes
 //
 //	aren't reentrant, DestroyEnclave can't just call StopEnclave so we use this helper function
@@ -474,11 +675,15 @@ func (manager *EnclaveManager) cleanEnclaves(ctx context.Context, shouldCleanAll
 		UUIDs:    nil,
 		Statuses: enclaveStatusFilters,
 	}
+	manager.recordPulledImagesWithoutMutex(ctx, destroyEnclaveFilters)
 	successfullyDestroyedEnclaves, erroredEnclaves, err := manager.kurtosisBackend.DestroyEnclaves(ctx, destroyEnclaveFilters)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying enclaves during cleaning")
 	}
 
+	manager.releaseEnclaveIpRanges(ctx, successfullyDestroyedEnclaves)
+	manager.removeDestroyedEnclaveInfos(ctx, successfullyDestroyedEnclaves)
+
 	successfullyDestroyedEnclaveIdStrs := []string{}
 	for enclaveId := range successfullyDestroyedEnclaves {
 		successfullyDestroyedEnclaveIdStrs = append(successfullyDestroyedEnclaveIdStrs, string(enclaveId))
@@ -492,10 +697,115 @@ func (manager *EnclaveManager) cleanEnclaves(ctx context.Context, shouldCleanAll
 	return successfullyDestroyedEnclaveIdStrs, enclaveDestructionErrors, nil
 }
 
+// recordPulledImagesWithoutMutex records the images pulled for the enclaves matching the filters before they get
+// destroyed, as the containers that tell which images were pulled go away with them
+// It's best-effort: failing to record them only means they won't get pruned, which isn't worth failing the destruction
+func (manager *EnclaveManager) recordPulledImagesWithoutMutex(ctx context.Context, filters *enclave.EnclaveFilters) {
+	if _, err := manager.recordPulledImages(ctx, filters); err != nil {
+		logrus.Warnf("An error occurred recording the images pulled for the enclaves to destroy; they won't get pruned by Kurtosis:\n%v", err)
+	}
+}
+
+func (manager *EnclaveManager) recordPulledImages(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]map[string]bool, error) {
+	pulledImagesByEnclave, err := manager.kurtosisBackend.GetEnclavesPulledImages(ctx, filters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the images pulled for the enclaves")
+	}
+	allPulledImages := map[string]bool{}
+	for _, pulledImages := range pulledImagesByEnclave {
+		for image := range pulledImages {
+			allPulledImages[image] = true
+		}
+	}
+	if err := manager.engineStateStore.AddPulledImages(ctx, allPulledImages); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred recording pulled images '%+v' in the engine state store", allPulledImages)
+	}
+	return pulledImagesByEnclave, nil
+}
+
+// getPulledImagesWithoutMutex also records the images pulled for the existing enclaves, so that images pulled for
+// enclaves that outlived an engine restart are known too
+func (manager *EnclaveManager) getPulledImagesWithoutMutex(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.PulledImage, error) {
+	pulledImagesByExistingEnclave, err := manager.recordPulledImages(ctx, getAllEnclavesFilter())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred recording the images pulled for the existing enclaves")
+	}
+	enclaveUuidsByImage := map[string][]string{}
+	for enclaveUuid, pulledImages := range pulledImagesByExistingEnclave {
+		for image := range pulledImages {
+			enclaveUuidsByImage[image] = append(enclaveUuidsByImage[image], string(enclaveUuid))
+		}
+	}
+
+	allPulledImages, err := manager.engineStateStore.GetPulledImages(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the pulled images from the engine state store")
+	}
+	imageSizesBytes, err := manager.kurtosisBackend.GetImagesSizeBytes(ctx, allPulledImages)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the size of pulled images '%+v'", allPulledImages)
+	}
+
+	// Images that got removed from the host by other means are forgotten
+	removedImages := map[string]bool{}
+	result := []*kurtosis_engine_rpc_api_bindings.PulledImage{}
+	for image := range allPulledImages {
+		sizeBytes, found := imageSizesBytes[image]
+		if !found {
+			removedImages[image] = true
+			continue
+		}
+		enclaveUuids := enclaveUuidsByImage[image]
+		sort.Strings(enclaveUuids)
+		result = append(result, &kurtosis_engine_rpc_api_bindings.PulledImage{
+			Image:        image,
+			SizeBytes:    sizeBytes,
+			EnclaveUuids: enclaveUuids,
+		})
+	}
+	if len(removedImages) > 0 {
+		if err := manager.engineStateStore.RemovePulledImages(ctx, removedImages); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred forgetting pulled images '%+v' that aren't on the host anymore", removedImages)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetImage() < result[j].GetImage()
+	})
+	return result, nil
+}
+
+// releaseEnclaveIpRanges is best-effort: failing to release a range only keeps it from enclaves asking for a cooldown
+// for longer than needed, which isn't worth failing the destruction
+func (manager *EnclaveManager) releaseEnclaveIpRanges(ctx context.Context, destroyedEnclaveUuids map[enclave.EnclaveUUID]bool) {
+	releaseTime := time.Now()
+	for enclaveUuid := range destroyedEnclaveUuids {
+		if err := manager.engineStateStore.ReleaseEnclaveIpRange(ctx, string(enclaveUuid), releaseTime); err != nil {
+			logrus.Warnf("An error occurred releasing the IP range of destroyed enclave '%v' in the engine state store:\n%v", enclaveUuid, err)
+		}
+	}
+}
+
+// getEnclavesWithoutMutex returns the enclaves recorded in the engine state store, which is much faster than going
+// through the labels of every container in the backend
 func (manager *EnclaveManager) getEnclavesWithoutMutex(
 	ctx context.Context,
 ) (map[enclave.EnclaveUUID]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
-	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getAllEnclavesFilter())
+	enclaveInfos, err := manager.engineStateStore.GetEnclaveInfos(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves recorded in the engine state store")
+	}
+	result := map[enclave.EnclaveUUID]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for enclaveUuidStr, enclaveInfo := range enclaveInfos {
+		result[enclave.EnclaveUUID(enclaveUuidStr)] = enclaveInfo
+	}
+	return result, nil
+}
+
+func (manager *EnclaveManager) getEnclavesFromBackendWithoutMutex(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (map[enclave.EnclaveUUID]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, filters)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error thrown retrieving enclaves")
 	}
@@ -512,6 +822,54 @@ func (manager *EnclaveManager) getEnclavesWithoutMutex(
 
 }
 
+// getExcludedSubnets returns the subnets a new enclave's IP range must not overlap with, given the ranges the engine
+// gave to enclaves so far; the ranges of the existing enclaves aren't needed as the backend avoids them by itself
+func getExcludedSubnets(
+	ipRangeReusePolicy kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy,
+	requestedExcludedIpRanges []string,
+	trackedEnclaveIpRanges []*engine_state_store.EnclaveIpRange,
+	now time.Time,
+) ([]*net.IPNet, error) {
+	excludedIpRanges := []string{}
+	excludedIpRanges = append(excludedIpRanges, requestedExcludedIpRanges...)
+	switch ipRangeReusePolicy {
+	case kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE:
+		// Nothing else to exclude
+	case kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_AFTER_COOLDOWN:
+		for _, enclaveIpRange := range trackedEnclaveIpRanges {
+			if enclaveIpRange.ReleaseTime != nil && now.Sub(*enclaveIpRange.ReleaseTime) < freedIpRangeReuseCooldown {
+				excludedIpRanges = append(excludedIpRanges, enclaveIpRange.Cidr)
+			}
+		}
+	case kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_NEVER:
+		for _, enclaveIpRange := range trackedEnclaveIpRanges {
+			if enclaveIpRange.ReleaseTime != nil {
+				excludedIpRanges = append(excludedIpRanges, enclaveIpRange.Cidr)
+			}
+		}
+	default:
+		return nil, stacktrace.NewError("Unrecognized enclave IP range reuse policy '%v'", ipRangeReusePolicy.String())
+	}
+
+	result := []*net.IPNet{}
+	for _, excludedIpRange := range excludedIpRanges {
+		_, excludedSubnet, err := net.ParseCIDR(excludedIpRange)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing excluded IP range '%v', which should be a CIDR like '192.168.1.0/24'", excludedIpRange)
+		}
+		result = append(result, excludedSubnet)
+	}
+	return result, nil
+}
+
+func getEnclaveIpRange(enclave *enclave.Enclave) string {
+	subnet := enclave.GetSubnet()
+	if subnet == nil {
+		return ""
+	}
+	return subnet.String()
+}
+
 func getEnclaveByEnclaveIdFilter(enclaveUuid enclave.EnclaveUUID) *enclave.EnclaveFilters {
 	return &enclave.EnclaveFilters{
 		UUIDs: map[enclave.EnclaveUUID]bool{
@@ -547,6 +905,8 @@ func (manager *EnclaveManager) launchApiContainer(
 	isPartitioningEnabled bool,
 	metricsUserID string,
 	didUserAcceptSendingMetrics bool,
+	filesArtifactsTtlSeconds uint32,
+	allowHostDirMounts bool,
 ) (
 	resultApiContainer *api_container.APIContainer,
 	resultErr error,
@@ -566,6 +926,9 @@ func (manager *EnclaveManager) launchApiContainer(
 			metricsUserID,
 			didUserAcceptSendingMetrics,
 			manager.apiContainerKurtosisBackendConfigSupplier,
+			manager.packageCacheMaxSizeBytes,
+			filesArtifactsTtlSeconds,
+			allowHostDirMounts,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with custom version '%v', but an error occurred", enclaveUuid, apiContainerImageVersionTag)
@@ -582,6 +945,9 @@ func (manager *EnclaveManager) launchApiContainer(
 		metricsUserID,
 		didUserAcceptSendingMetrics,
 		manager.apiContainerKurtosisBackendConfigSupplier,
+		manager.packageCacheMaxSizeBytes,
+		filesArtifactsTtlSeconds,
+		allowHostDirMounts,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Expected to be able to launch api container for enclave '%v' with the default version, but an error occurred", enclaveUuid)
@@ -614,6 +980,8 @@ func (manager *EnclaveManager) getEnclaveInfoForEnclave(ctx context.Context, enc
 		ApiContainerInfo:            apiContainerInfo,
 		ApiContainerHostMachineInfo: apiContainerHostMachineInfo,
 		CreationTime:                creationTimestamp,
+		IpRange:                     getEnclaveIpRange(enclave),
+		ScheduleName:                enclave.GetScheduleName(),
 	}, nil
 }
 
@@ -0,0 +1,80 @@
+package enclave_manager
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_state_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	usedImage       = "postgres:15"
+	unusedImage     = "redis:7"
+	goneImage       = "nginx:1.25"
+	existingEnclave = enclave.EnclaveUUID("existing-enclave-uuid")
+)
+
+func TestPruneImages_OnlyRemovesImagesNoExistingEnclaveUses(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	engineStateStore := engine_state_store.NewInMemoryEngineStateStore()
+	manager := NewEnclaveManager(backend, nil, engineStateStore, 0)
+
+	// Recorded when the enclaves that used them got destroyed
+	require.NoError(t, engineStateStore.AddPulledImages(ctx, map[string]bool{unusedImage: true, goneImage: true}))
+
+	backend.EXPECT().GetEnclavesPulledImages(mock.Anything, mock.Anything).Return(
+		map[enclave.EnclaveUUID]map[string]bool{existingEnclave: {usedImage: true}},
+		nil,
+	)
+	backend.EXPECT().GetImagesSizeBytes(mock.Anything, map[string]bool{usedImage: true, unusedImage: true, goneImage: true}).Return(
+		map[string]int64{usedImage: 100, unusedImage: 200},
+		nil,
+	)
+	backend.EXPECT().RemoveImages(mock.Anything, map[string]bool{unusedImage: true}).Return(
+		map[string]bool{unusedImage: true},
+		map[string]error{},
+		nil,
+	)
+
+	removedImages, removalErrors, err := manager.PruneImages(ctx)
+	require.NoError(t, err)
+	require.Empty(t, removalErrors)
+	require.Len(t, removedImages, 1)
+	require.Equal(t, unusedImage, removedImages[0].GetImage())
+	require.Equal(t, int64(200), removedImages[0].GetSizeBytes())
+
+	// The removed image and the one that was already gone from the host are forgotten
+	pulledImages, err := engineStateStore.GetPulledImages(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{usedImage: true}, pulledImages)
+}
+
+func TestGetPulledImages_ReportsTheEnclavesUsingEachImage(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	engineStateStore := engine_state_store.NewInMemoryEngineStateStore()
+	manager := NewEnclaveManager(backend, nil, engineStateStore, 0)
+
+	require.NoError(t, engineStateStore.AddPulledImages(ctx, map[string]bool{unusedImage: true}))
+
+	backend.EXPECT().GetEnclavesPulledImages(mock.Anything, mock.Anything).Return(
+		map[enclave.EnclaveUUID]map[string]bool{existingEnclave: {usedImage: true}},
+		nil,
+	)
+	backend.EXPECT().GetImagesSizeBytes(mock.Anything, mock.Anything).Return(
+		map[string]int64{usedImage: 100, unusedImage: 200},
+		nil,
+	)
+
+	pulledImages, err := manager.GetPulledImages(ctx)
+	require.NoError(t, err)
+	require.Len(t, pulledImages, 2)
+	require.Equal(t, usedImage, pulledImages[0].GetImage())
+	require.Equal(t, []string{string(existingEnclave)}, pulledImages[0].GetEnclaveUuids())
+	require.Equal(t, unusedImage, pulledImages[1].GetImage())
+	require.Empty(t, pulledImages[1].GetEnclaveUuids())
+}
@@ -1,11 +1,14 @@
 package enclave_manager
 
 import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_state_store"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestIsContainerRunningDeterminerCompleteness(t *testing.T) {
@@ -28,3 +31,35 @@ func TestGetApiContainerStatusFromContainerStatusCompleteness(t *testing.T) {
 		require.NoError(t, err, "No ApiContainerStatus provided for container status '%v'", containerStatus.String())
 	}
 }
+
+func TestGetExcludedSubnets(t *testing.T) {
+	now := time.Now()
+	recentlyReleasedTime := now.Add(-time.Minute)
+	longAgoReleasedTime := now.Add(-2 * freedIpRangeReuseCooldown)
+	trackedEnclaveIpRanges := []*engine_state_store.EnclaveIpRange{
+		{EnclaveUuid: "existing", Cidr: "32.0.0.0/20", ReleaseTime: nil},
+		{EnclaveUuid: "recently-destroyed", Cidr: "48.0.0.0/20", ReleaseTime: &recentlyReleasedTime},
+		{EnclaveUuid: "destroyed-long-ago", Cidr: "64.0.0.0/20", ReleaseTime: &longAgoReleasedTime},
+	}
+	requestedExcludedIpRanges := []string{"192.168.1.0/24"}
+
+	expectedExcludedSubnetsByPolicy := map[kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy][]string{
+		kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE:      {"192.168.1.0/24"},
+		kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_AFTER_COOLDOWN: {"192.168.1.0/24", "48.0.0.0/20"},
+		kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_NEVER:          {"192.168.1.0/24", "48.0.0.0/20", "64.0.0.0/20"},
+	}
+	for policy, expectedExcludedSubnets := range expectedExcludedSubnetsByPolicy {
+		excludedSubnets, err := getExcludedSubnets(policy, requestedExcludedIpRanges, trackedEnclaveIpRanges, now)
+		require.NoError(t, err)
+		excludedSubnetStrs := []string{}
+		for _, excludedSubnet := range excludedSubnets {
+			excludedSubnetStrs = append(excludedSubnetStrs, excludedSubnet.String())
+		}
+		require.Equal(t, expectedExcludedSubnets, excludedSubnetStrs, "Unexpected excluded subnets for policy '%v'", policy.String())
+	}
+}
+
+func TestGetExcludedSubnets_InvalidIpRange(t *testing.T) {
+	_, err := getExcludedSubnets(kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE, []string{"not-a-cidr"}, nil, time.Now())
+	require.Error(t, err)
+}
@@ -19,9 +19,9 @@ const (
 
 var (
 	creationTime             = time.Now()
-	firstEnclaveForTest      = enclave.NewEnclave(firstEnclaveUuidForTest, firstEnclaveNameForTest, runningEnclaveStatus, &creationTime)
-	secondEnclaveForTest     = enclave.NewEnclave(secondEnclaveUuidForTest, secondEnclaveNameForTest, runningEnclaveStatus, &creationTime)
-	theirEnclaveForTest      = enclave.NewEnclave(theirEnclaveUuidForTest, theirEnclaveNameForTest, runningEnclaveStatus, &creationTime)
+	firstEnclaveForTest      = enclave.NewEnclave(firstEnclaveUuidForTest, firstEnclaveNameForTest, runningEnclaveStatus, &creationTime, nil, "")
+	secondEnclaveForTest     = enclave.NewEnclave(secondEnclaveUuidForTest, secondEnclaveNameForTest, runningEnclaveStatus, &creationTime, nil, "")
+	theirEnclaveForTest      = enclave.NewEnclave(theirEnclaveUuidForTest, theirEnclaveNameForTest, runningEnclaveStatus, &creationTime, nil, "")
 	currentEnclaveIdsForTest = map[enclave.EnclaveUUID]*enclave.Enclave{
 		firstEnclaveUuidForTest:  firstEnclaveForTest,
 		secondEnclaveUuidForTest: secondEnclaveForTest,
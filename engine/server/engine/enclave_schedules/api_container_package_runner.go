@@ -0,0 +1,60 @@
+package enclave_schedules
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_container_clients"
+	"github.com/kurtosis-tech/stacktrace"
+	"strings"
+)
+
+const (
+	scheduledRunParallelism = 4
+
+	isScheduledRunDryRun = false
+
+	isScheduledRunStrict = false
+)
+
+// PackageRunner runs a package in an enclave that was just created, returning an error if the run didn't succeed
+type PackageRunner func(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, packageId string, serializedParams string) error
+
+// NewApiContainerPackageRunner returns a PackageRunner running the package through the API container of the enclave
+func NewApiContainerPackageRunner(apiContainerConnector *api_container_clients.ApiContainerConnector) PackageRunner {
+	return func(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, packageId string, serializedParams string) error {
+		apiContainerClient, closeApiContainerConnFunc, err := apiContainerConnector.Connect(enclaveInfo)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred connecting to the API container of enclave '%v'", enclaveInfo.GetName())
+		}
+		defer closeApiContainerConnFunc()
+
+		enclaveCtx := enclaves.NewEnclaveContext(
+			apiContainerClient,
+			enclaves.EnclaveUUID(enclaveInfo.GetEnclaveUuid()),
+			enclaveInfo.GetName(),
+		)
+		runResult, err := enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, packageId, serializedParams, isScheduledRunDryRun, scheduledRunParallelism, isScheduledRunStrict)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred running package '%v' in enclave '%v'", packageId, enclaveInfo.GetName())
+		}
+		return getStarlarkRunResultError(runResult)
+	}
+}
+
+func getStarlarkRunResultError(runResult *enclaves.StarlarkRunResult) error {
+	if runResult.InterpretationError != nil {
+		return stacktrace.NewError("The package failed to be interpreted:\n%v", runResult.InterpretationError.GetErrorMessage())
+	}
+	if len(runResult.ValidationErrors) > 0 {
+		validationErrorMessages := []string{}
+		for _, validationError := range runResult.ValidationErrors {
+			validationErrorMessages = append(validationErrorMessages, validationError.GetErrorMessage())
+		}
+		return stacktrace.NewError("The package failed to be validated:\n%v", strings.Join(validationErrorMessages, "\n"))
+	}
+	if runResult.ExecutionError != nil {
+		return stacktrace.NewError("The package failed to be executed:\n%v", runResult.ExecutionError.GetErrorMessage())
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package enclave_schedules
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	numCronExpressionFields = 5
+
+	cronFieldsSeparator = " "
+	cronListSeparator   = ","
+	cronRangeSeparator  = "-"
+	cronStepSeparator   = "/"
+	cronWildcard        = "*"
+
+	// Cron allows both 0 and 7 for Sunday
+	cronAlternativeSunday = 7
+
+	// A cron expression like '0 0 30 2 *' (February 30th) never matches; this bounds the search for its next time
+	maxNextTimeSearchDuration = 5 * 366 * 24 * time.Hour
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	name     string
+	minValue int
+	maxValue int
+}
+
+var (
+	minuteCronField     = cronField{name: "minute", minValue: 0, maxValue: 59}
+	hourCronField       = cronField{name: "hour", minValue: 0, maxValue: 23}
+	dayOfMonthCronField = cronField{name: "day of month", minValue: 1, maxValue: 31}
+	monthCronField      = cronField{name: "month", minValue: 1, maxValue: 12}
+	dayOfWeekCronField  = cronField{name: "day of week", minValue: 0, maxValue: cronAlternativeSunday}
+)
+
+// CronExpression is a standard 5-field cron expression (minute, hour, day of month, month, day of week), supporting
+// wildcards, lists, ranges, steps and the '@daily'-style macros
+type CronExpression struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+
+	// As in cron, when both the day of month and the day of week are restricted, a day matching either of them matches
+	isDayOfMonthRestricted bool
+	isDayOfWeekRestricted  bool
+}
+
+func ParseCronExpression(expressionStr string) (*CronExpression, error) {
+	expandedExpressionStr := strings.TrimSpace(expressionStr)
+	if macroExpansion, found := cronMacros[expandedExpressionStr]; found {
+		expandedExpressionStr = macroExpansion
+	}
+	fields := strings.Fields(expandedExpressionStr)
+	if len(fields) != numCronExpressionFields {
+		return nil, stacktrace.NewError("Cron expression '%v' must have %v fields separated by '%v' (minute, hour, day of month, month, day of week), but it has %v", expressionStr, numCronExpressionFields, cronFieldsSeparator, len(fields))
+	}
+
+	minutes, err := parseCronField(fields[0], minuteCronField)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing cron expression '%v'", expressionStr)
+	}
+	hours, err := parseCronField(fields[1], hourCronField)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing cron expression '%v'", expressionStr)
+	}
+	daysOfMonth, err := parseCronField(fields[2], dayOfMonthCronField)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing cron expression '%v'", expressionStr)
+	}
+	months, err := parseCronField(fields[3], monthCronField)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing cron expression '%v'", expressionStr)
+	}
+	daysOfWeek, err := parseCronField(fields[4], dayOfWeekCronField)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing cron expression '%v'", expressionStr)
+	}
+	if daysOfWeek[cronAlternativeSunday] {
+		daysOfWeek[int(time.Sunday)] = true
+	}
+
+	return &CronExpression{
+		minutes:                minutes,
+		hours:                  hours,
+		daysOfMonth:            daysOfMonth,
+		months:                 months,
+		daysOfWeek:             daysOfWeek,
+		isDayOfMonthRestricted: !strings.HasPrefix(fields[2], cronWildcard),
+		isDayOfWeekRestricted:  !strings.HasPrefix(fields[4], cronWildcard),
+	}, nil
+}
+
+// Matches returns whether the minute of the given time matches the expression, in the time's location
+func (expression *CronExpression) Matches(timestamp time.Time) bool {
+	return expression.minutes[timestamp.Minute()] && expression.hours[timestamp.Hour()] && expression.matchesDay(timestamp)
+}
+
+// NextTime returns the first minute strictly after the given time that matches the expression, or false if there's
+// none, e.g. for February 30th
+func (expression *CronExpression) NextTime(after time.Time) (time.Time, bool) {
+	candidate := after.Truncate(time.Minute).Add(time.Minute)
+	searchEnd := after.Add(maxNextTimeSearchDuration)
+	for candidate.Before(searchEnd) {
+		if !expression.matchesDay(candidate) {
+			candidate = time.Date(candidate.Year(), candidate.Month(), candidate.Day()+1, 0, 0, 0, 0, candidate.Location())
+			continue
+		}
+		if !expression.hours[candidate.Hour()] {
+			candidate = time.Date(candidate.Year(), candidate.Month(), candidate.Day(), candidate.Hour()+1, 0, 0, 0, candidate.Location())
+			continue
+		}
+		if !expression.minutes[candidate.Minute()] {
+			candidate = candidate.Add(time.Minute)
+			continue
+		}
+		return candidate, true
+	}
+	return time.Time{}, false
+}
+
+func (expression *CronExpression) matchesDay(timestamp time.Time) bool {
+	if !expression.months[int(timestamp.Month())] {
+		return false
+	}
+	matchesDayOfMonth := expression.daysOfMonth[timestamp.Day()]
+	matchesDayOfWeek := expression.daysOfWeek[int(timestamp.Weekday())]
+	if expression.isDayOfMonthRestricted && expression.isDayOfWeekRestricted {
+		return matchesDayOfMonth || matchesDayOfWeek
+	}
+	return matchesDayOfMonth && matchesDayOfWeek
+}
+
+// parseCronField parses a comma-separated list of '*', 'a', 'a-b', each optionally followed by a '/step'
+func parseCronField(fieldStr string, field cronField) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, itemStr := range strings.Split(fieldStr, cronListSeparator) {
+		rangeStr, stepStr, hasStep := strings.Cut(itemStr, cronStepSeparator)
+		step := 1
+		if hasStep {
+			parsedStep, err := strconv.Atoi(stepStr)
+			if err != nil || parsedStep <= 0 {
+				return nil, stacktrace.NewError("Step '%v' of the %v field must be a positive integer", stepStr, field.name)
+			}
+			step = parsedStep
+		}
+
+		var start, end int
+		if rangeStr == cronWildcard {
+			start, end = field.minValue, field.maxValue
+		} else {
+			startStr, endStr, isRange := strings.Cut(rangeStr, cronRangeSeparator)
+			var err error
+			if start, err = parseCronFieldValue(startStr, field); err != nil {
+				return nil, err
+			}
+			end = start
+			if isRange {
+				if end, err = parseCronFieldValue(endStr, field); err != nil {
+					return nil, err
+				}
+			} else if hasStep {
+				// As in cron, 'a/step' means from a to the maximum value
+				end = field.maxValue
+			}
+			if start > end {
+				return nil, stacktrace.NewError("Range '%v' of the %v field must not be decreasing", rangeStr, field.name)
+			}
+		}
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+func parseCronFieldValue(valueStr string, field cronField) (int, error) {
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		return 0, stacktrace.NewError("Value '%v' of the %v field must be an integer", valueStr, field.name)
+	}
+	if value < field.minValue || value > field.maxValue {
+		return 0, stacktrace.NewError("Value '%v' of the %v field must be between %v and %v", value, field.name, field.minValue, field.maxValue)
+	}
+	return value, nil
+}
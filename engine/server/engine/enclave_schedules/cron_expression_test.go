@@ -0,0 +1,66 @@
+package enclave_schedules
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression_InvalidExpressions(t *testing.T) {
+	for _, expressionStr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@sometimes",
+	} {
+		_, err := ParseCronExpression(expressionStr)
+		require.Error(t, err, "Expected cron expression '%v' to be invalid", expressionStr)
+	}
+}
+
+func TestCronExpression_NextTime(t *testing.T) {
+	// A Wednesday
+	now := time.Date(2026, time.January, 7, 10, 30, 45, 0, time.UTC)
+
+	testCases := map[string]time.Time{
+		"* * * * *":      time.Date(2026, time.January, 7, 10, 31, 0, 0, time.UTC),
+		"0 3 * * *":      time.Date(2026, time.January, 8, 3, 0, 0, 0, time.UTC),
+		"@daily":         time.Date(2026, time.January, 8, 0, 0, 0, 0, time.UTC),
+		"@hourly":        time.Date(2026, time.January, 7, 11, 0, 0, 0, time.UTC),
+		"*/15 * * * *":   time.Date(2026, time.January, 7, 10, 45, 0, 0, time.UTC),
+		"5,35 10 * * *":  time.Date(2026, time.January, 7, 10, 35, 0, 0, time.UTC),
+		"0 9-17/4 * * *": time.Date(2026, time.January, 7, 13, 0, 0, 0, time.UTC),
+		"0 0 * * 0":      time.Date(2026, time.January, 11, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 7":      time.Date(2026, time.January, 11, 0, 0, 0, 0, time.UTC),
+		"0 0 1 * *":      time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":     time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		"0 0 15 * 5":     time.Date(2026, time.January, 9, 0, 0, 0, 0, time.UTC),
+		"30 10 * * 3":    time.Date(2026, time.January, 14, 10, 30, 0, 0, time.UTC),
+		"0 0 1 1 *":      time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC),
+		"45 23 31 12 *":  time.Date(2026, time.December, 31, 23, 45, 0, 0, time.UTC),
+		"0 12 * * 1-5":   time.Date(2026, time.January, 7, 12, 0, 0, 0, time.UTC),
+	}
+	for expressionStr, expectedNextTime := range testCases {
+		expression, err := ParseCronExpression(expressionStr)
+		require.NoError(t, err, "Cron expression '%v'", expressionStr)
+		nextTime, found := expression.NextTime(now)
+		require.True(t, found, "Cron expression '%v'", expressionStr)
+		require.Equal(t, expectedNextTime, nextTime, "Cron expression '%v'", expressionStr)
+		require.True(t, expression.Matches(nextTime), "Cron expression '%v'", expressionStr)
+	}
+}
+
+func TestCronExpression_NextTimeOfNeverMatchingExpression(t *testing.T) {
+	expression, err := ParseCronExpression("0 0 30 2 *")
+	require.NoError(t, err)
+	_, found := expression.NextTime(time.Date(2026, time.January, 7, 10, 30, 0, 0, time.UTC))
+	require.False(t, found)
+}
@@ -0,0 +1,376 @@
+package enclave_schedules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/webhook_notifier"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_state_store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// The enclave name gets a '-<yyyymmddhhmm>' suffix, and enclave names are at most 63 characters long
+	maxScheduleNameLength = 50
+
+	scheduleEnclaveNameTimeFormat = "200601021504"
+
+	// Cron has a minute resolution
+	schedulerTickInterval = time.Minute
+
+	scheduledRunTimeout = 2 * time.Hour
+
+	scheduledRunNotificationTimeout = 30 * time.Second
+
+	scheduledEnclaveApiContainerLogLevel = logrus.InfoLevel
+
+	// Scheduled enclaves get the default API container version, the default IP range and no excluded IP ranges
+	scheduledEnclaveApiContainerVersionTag = ""
+	isScheduledEnclavePartitioningEnabled  = false
+	scheduledEnclaveSubnet                 = ""
+	// Scheduled enclaves are short-lived, so they keep their files artifacts until they get destroyed
+	scheduledEnclaveFilesArtifactsTtlSeconds = 0
+	// Scheduled enclaves run unattended, so their services never get access to the directories of the host
+	areScheduledEnclaveHostDirMountsAllowed = false
+	isScheduledEnclaveIpv6Enabled           = false
+)
+
+var scheduleNameRegex = regexp.MustCompile(fmt.Sprintf(`^[a-z0-9]([-a-z0-9]{0,%d}[a-z0-9])?$`, maxScheduleNameLength-2))
+
+type scheduleRunStatus struct {
+	runTime time.Time
+
+	// Nil if the run succeeded
+	err error
+}
+
+// EnclaveScheduler creates enclaves from packages on the cron schedules recorded in the engine state store. The
+// schedules get read from the store every minute so that engine replicas sharing the store all see the same ones;
+// the name of the enclave a run creates is derived from the schedule and the time, so that only one replica can
+// create it. The enclaves get labelled with the schedule that created them, which is how they're found afterwards
+type EnclaveScheduler struct {
+	enclaveManager   *enclave_manager.EnclaveManager
+	engineStateStore engine_state_store.EngineStateStore
+	runPackage       PackageRunner
+	notifier         *webhook_notifier.WebhookNotifier
+
+	metricsUserID               string
+	didUserAcceptSendingMetrics bool
+
+	runStatusesMutex *sync.Mutex
+
+	// Only the runs of this engine are known, as a replica doesn't know about the runs of the other ones
+	lastRunStatuses  map[string]*scheduleRunStatus
+	runningSchedules map[string]bool
+}
+
+func NewEnclaveScheduler(
+	enclaveManager *enclave_manager.EnclaveManager,
+	engineStateStore engine_state_store.EngineStateStore,
+	runPackage PackageRunner,
+	notifier *webhook_notifier.WebhookNotifier,
+	metricsUserID string,
+	didUserAcceptSendingMetrics bool,
+) *EnclaveScheduler {
+	return &EnclaveScheduler{
+		enclaveManager:              enclaveManager,
+		engineStateStore:            engineStateStore,
+		runPackage:                  runPackage,
+		notifier:                    notifier,
+		metricsUserID:               metricsUserID,
+		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
+		runStatusesMutex:            &sync.Mutex{},
+		lastRunStatuses:             map[string]*scheduleRunStatus{},
+		runningSchedules:            map[string]bool{},
+	}
+}
+
+func (scheduler *EnclaveScheduler) AddSchedule(ctx context.Context, schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule) error {
+	if !scheduleNameRegex.MatchString(schedule.GetName()) {
+		return stacktrace.NewError("Enclave schedule name '%v' is invalid; it must be at most %v lowercase alphanumeric characters or '-', starting and ending with an alphanumeric character", schedule.GetName(), maxScheduleNameLength)
+	}
+	if _, err := ParseCronExpression(schedule.GetCronExpression()); err != nil {
+		return stacktrace.Propagate(err, "The cron expression of enclave schedule '%v' is invalid", schedule.GetName())
+	}
+	if schedule.GetPackageId() == "" {
+		return stacktrace.NewError("Enclave schedule '%v' has no package to run", schedule.GetName())
+	}
+	if err := scheduler.engineStateStore.AddEnclaveSchedule(ctx, schedule); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recording enclave schedule '%v'", schedule.GetName())
+	}
+	return nil
+}
+
+func (scheduler *EnclaveScheduler) RemoveSchedule(ctx context.Context, name string, shouldDestroyEnclaves bool) error {
+	schedule, err := scheduler.getSchedule(ctx, name)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave schedule '%v'", name)
+	}
+	if err := scheduler.engineStateStore.RemoveEnclaveSchedule(ctx, schedule.GetName()); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing enclave schedule '%v'", name)
+	}
+	scheduler.runStatusesMutex.Lock()
+	delete(scheduler.lastRunStatuses, name)
+	scheduler.runStatusesMutex.Unlock()
+
+	if !shouldDestroyEnclaves {
+		return nil
+	}
+	enclaveNames, err := scheduler.getScheduleEnclaveNames(ctx, name)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves of enclave schedule '%v' to destroy them", name)
+	}
+	for _, enclaveName := range enclaveNames {
+		if err := scheduler.enclaveManager.DestroyEnclave(ctx, enclaveName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred destroying enclave '%v' of enclave schedule '%v'", enclaveName, name)
+		}
+	}
+	return nil
+}
+
+func (scheduler *EnclaveScheduler) GetSchedules(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveScheduleInfo, error) {
+	schedules, err := scheduler.engineStateStore.GetEnclaveSchedules(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave schedules")
+	}
+	enclaves, err := scheduler.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	now := time.Now().UTC()
+
+	scheduler.runStatusesMutex.Lock()
+	defer scheduler.runStatusesMutex.Unlock()
+	result := []*kurtosis_engine_rpc_api_bindings.EnclaveScheduleInfo{}
+	for _, schedule := range schedules {
+		scheduleInfo := &kurtosis_engine_rpc_api_bindings.EnclaveScheduleInfo{
+			Schedule:     schedule,
+			NextRunTime:  nil,
+			LastRunTime:  nil,
+			LastRunError: "",
+			EnclaveNames: getScheduleEnclaveNamesFromEnclaves(schedule.GetName(), enclaves),
+		}
+		if cronExpression, err := ParseCronExpression(schedule.GetCronExpression()); err == nil {
+			if nextRunTime, found := cronExpression.NextTime(now); found {
+				scheduleInfo.NextRunTime = timestamppb.New(nextRunTime)
+			}
+		}
+		if lastRunStatus, found := scheduler.lastRunStatuses[schedule.GetName()]; found {
+			scheduleInfo.LastRunTime = timestamppb.New(lastRunStatus.runTime)
+			if lastRunStatus.err != nil {
+				scheduleInfo.LastRunError = lastRunStatus.err.Error()
+			}
+		}
+		result = append(result, scheduleInfo)
+	}
+	return result, nil
+}
+
+// Start runs the due schedules every minute in the background, until the returned function gets called
+func (scheduler *EnclaveScheduler) Start() func() {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	go func() {
+		for {
+			now := time.Now().UTC()
+			nextTick := now.Truncate(schedulerTickInterval).Add(schedulerTickInterval)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(nextTick.Sub(now)):
+				scheduler.runDueSchedules(ctx, nextTick)
+			}
+		}
+	}()
+	return cancelFunc
+}
+
+func (scheduler *EnclaveScheduler) runDueSchedules(ctx context.Context, tickTime time.Time) {
+	schedules, err := scheduler.engineStateStore.GetEnclaveSchedules(ctx)
+	if err != nil {
+		logrus.Errorf("An error occurred getting the enclave schedules to run the due ones:\n%v", err)
+		return
+	}
+	for _, schedule := range schedules {
+		cronExpression, err := ParseCronExpression(schedule.GetCronExpression())
+		if err != nil {
+			logrus.Errorf("Enclave schedule '%v' has an invalid cron expression; skipping it:\n%v", schedule.GetName(), err)
+			continue
+		}
+		if !cronExpression.Matches(tickTime) {
+			continue
+		}
+		if !scheduler.markScheduleAsRunning(schedule.GetName()) {
+			logrus.Warnf("Skipping the run of enclave schedule '%v' at '%v' as its previous run hasn't finished yet", schedule.GetName(), tickTime)
+			continue
+		}
+		go scheduler.runSchedule(ctx, schedule, tickTime)
+	}
+}
+
+func (scheduler *EnclaveScheduler) runSchedule(ctx context.Context, schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule, runTime time.Time) {
+	defer scheduler.markScheduleAsNotRunning(schedule.GetName())
+	runCtx, cancelRunCtx := context.WithTimeout(ctx, scheduledRunTimeout)
+	defer cancelRunCtx()
+
+	enclaveName := getScheduleEnclaveName(schedule.GetName(), runTime)
+	logrus.Infof("Running enclave schedule '%v', creating enclave '%v' from package '%v'", schedule.GetName(), enclaveName, schedule.GetPackageId())
+	enclaveInfo, runErr := scheduler.enclaveManager.CreateEnclave(
+		runCtx,
+		scheduledEnclaveApiContainerVersionTag,
+		scheduledEnclaveApiContainerLogLevel,
+		enclaveName,
+		schedule.GetName(),
+		isScheduledEnclavePartitioningEnabled,
+		scheduler.metricsUserID,
+		scheduler.didUserAcceptSendingMetrics,
+		kurtosis_engine_rpc_api_bindings.EnclaveIpRangeReusePolicy_EnclaveIpRangeReusePolicy_IMMEDIATE,
+		nil,
+		scheduledEnclaveSubnet,
+		scheduledEnclaveFilesArtifactsTtlSeconds,
+		areScheduledEnclaveHostDirMountsAllowed,
+		isScheduledEnclaveIpv6Enabled,
+	)
+	if runErr != nil && errors.Is(stacktrace.RootCause(runErr), enclave_manager.ErrEnclaveNameInUse) {
+		// The enclave manager checks the name under the enclave modifications lock, so another replica having created
+		// the enclave for this run is noticed here rather than failing the run
+		logrus.Debugf("Enclave '%v' of enclave schedule '%v' already exists, most likely created by another engine replica; skipping the run", enclaveName, schedule.GetName())
+		return
+	}
+	if runErr != nil {
+		runErr = stacktrace.Propagate(runErr, "An error occurred creating enclave '%v'", enclaveName)
+	} else if err := scheduler.runPackage(runCtx, enclaveInfo, schedule.GetPackageId(), schedule.GetSerializedParams()); err != nil {
+		runErr = stacktrace.Propagate(err, "An error occurred running package '%v' in enclave '%v'", schedule.GetPackageId(), enclaveName)
+	}
+	scheduler.recordRunStatus(schedule.GetName(), runTime, runErr)
+	scheduler.notifyRunFinished(schedule, enclaveInfo, runErr)
+	if runErr != nil {
+		// A failed run is kept for debugging, and it counts in the retention like a successful one
+		logrus.Warnf("Run of enclave schedule '%v' failed:\n%v", schedule.GetName(), runErr)
+	} else {
+		logrus.Infof("Run of enclave schedule '%v' succeeded", schedule.GetName())
+	}
+
+	if err := scheduler.destroyEnclavesBeyondRetention(runCtx, schedule); err != nil {
+		logrus.Errorf("An error occurred destroying the old enclaves of enclave schedule '%v':\n%v", schedule.GetName(), err)
+	}
+}
+
+func (scheduler *EnclaveScheduler) destroyEnclavesBeyondRetention(ctx context.Context, schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule) error {
+	enclaveNames, err := scheduler.getScheduleEnclaveNames(ctx, schedule.GetName())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclaves of enclave schedule '%v'", schedule.GetName())
+	}
+	for _, enclaveName := range getEnclaveNamesBeyondRetention(enclaveNames, schedule.GetRetention()) {
+		logrus.Infof("Destroying enclave '%v' as enclave schedule '%v' keeps its last %v enclaves", enclaveName, schedule.GetName(), schedule.GetRetention())
+		if err := scheduler.enclaveManager.DestroyEnclave(ctx, enclaveName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred destroying enclave '%v'", enclaveName)
+		}
+	}
+	return nil
+}
+
+func (scheduler *EnclaveScheduler) notifyRunFinished(schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, runErr error) {
+	if !scheduler.notifier.IsNotifying(webhook_notifier.EventType_RunFinished) {
+		return
+	}
+	event := &webhook_notifier.Event{
+		Type:        webhook_notifier.EventType_RunFinished,
+		Timestamp:   time.Now(),
+		EnclaveUuid: enclaveInfo.GetEnclaveUuid(),
+		EnclaveName: enclaveInfo.GetName(),
+		ServiceName: "",
+		ServiceUuid: "",
+		PackageId:   schedule.GetPackageId(),
+		IsSuccess:   runErr == nil,
+		Message:     "",
+	}
+	if runErr != nil {
+		event.Message = runErr.Error()
+	}
+	// A webhook failing doesn't fail the run, so it only gets logged
+	notifyCtx, cancelNotifyCtx := context.WithTimeout(context.Background(), scheduledRunNotificationTimeout)
+	defer cancelNotifyCtx()
+	if err := scheduler.notifier.Notify(notifyCtx, event); err != nil {
+		logrus.Warnf("An error occurred notifying the webhooks of the run of enclave schedule '%v':\n%v", schedule.GetName(), err)
+	}
+}
+
+func (scheduler *EnclaveScheduler) getSchedule(ctx context.Context, name string) (*kurtosis_engine_rpc_api_bindings.EnclaveSchedule, error) {
+	schedules, err := scheduler.engineStateStore.GetEnclaveSchedules(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave schedules")
+	}
+	for _, schedule := range schedules {
+		if schedule.GetName() == name {
+			return schedule, nil
+		}
+	}
+	return nil, stacktrace.NewError("No enclave schedule named '%v' exists", name)
+}
+
+func (scheduler *EnclaveScheduler) getScheduleEnclaveNames(ctx context.Context, scheduleName string) ([]string, error) {
+	enclaves, err := scheduler.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	return getScheduleEnclaveNamesFromEnclaves(scheduleName, enclaves), nil
+}
+
+func (scheduler *EnclaveScheduler) markScheduleAsRunning(name string) bool {
+	scheduler.runStatusesMutex.Lock()
+	defer scheduler.runStatusesMutex.Unlock()
+	if scheduler.runningSchedules[name] {
+		return false
+	}
+	scheduler.runningSchedules[name] = true
+	return true
+}
+
+func (scheduler *EnclaveScheduler) markScheduleAsNotRunning(name string) {
+	scheduler.runStatusesMutex.Lock()
+	defer scheduler.runStatusesMutex.Unlock()
+	delete(scheduler.runningSchedules, name)
+}
+
+func (scheduler *EnclaveScheduler) recordRunStatus(name string, runTime time.Time, runErr error) {
+	scheduler.runStatusesMutex.Lock()
+	defer scheduler.runStatusesMutex.Unlock()
+	scheduler.lastRunStatuses[name] = &scheduleRunStatus{
+		runTime: runTime,
+		err:     runErr,
+	}
+}
+
+func getScheduleEnclaveName(scheduleName string, runTime time.Time) string {
+	return fmt.Sprintf("%v-%v", scheduleName, runTime.UTC().Format(scheduleEnclaveNameTimeFormat))
+}
+
+// getScheduleEnclaveNamesFromEnclaves returns the names of the enclaves labelled with the schedule, oldest first; the
+// time format of the names makes them sort chronologically. Enclaves that merely look like they belong to the
+// schedule, e.g. created by hand with a matching name, are left alone
+func getScheduleEnclaveNamesFromEnclaves(scheduleName string, enclaves map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo) []string {
+	enclaveNames := []string{}
+	for _, enclaveInfo := range enclaves {
+		if enclaveInfo.GetScheduleName() == scheduleName {
+			enclaveNames = append(enclaveNames, enclaveInfo.GetName())
+		}
+	}
+	sort.Strings(enclaveNames)
+	return enclaveNames
+}
+
+// getEnclaveNamesBeyondRetention returns the oldest enclave names that need to go to keep only the last ones
+func getEnclaveNamesBeyondRetention(enclaveNamesOldestFirst []string, retention uint32) []string {
+	if retention == 0 || len(enclaveNamesOldestFirst) <= int(retention) {
+		return []string{}
+	}
+	return enclaveNamesOldestFirst[:len(enclaveNamesOldestFirst)-int(retention)]
+}
@@ -0,0 +1,37 @@
+package enclave_schedules
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestGetScheduleEnclaveName(t *testing.T) {
+	runTime := time.Date(2024, time.March, 5, 7, 9, 0, 0, time.UTC)
+	require.Equal(t, "nightly-202403050709", getScheduleEnclaveName("nightly", runTime))
+}
+
+func TestGetScheduleEnclaveNamesFromEnclaves_OnlyMatchesTheScheduleEnclavesOldestFirst(t *testing.T) {
+	enclaves := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for enclaveName, scheduleName := range map[string]string{
+		"nightly-202403050709":       "nightly",
+		"nightly-202403040709":       "nightly",
+		"nightly-extra-202403040709": "nightly-extra",
+		"other-202403040709":         "other",
+		// created by hand with a name that looks like the schedule ones
+		"nightly-202403030709": "",
+	} {
+		enclaves[enclaveName] = &kurtosis_engine_rpc_api_bindings.EnclaveInfo{Name: enclaveName, ScheduleName: scheduleName}
+	}
+
+	require.Equal(t, []string{"nightly-202403040709", "nightly-202403050709"}, getScheduleEnclaveNamesFromEnclaves("nightly", enclaves))
+}
+
+func TestGetEnclaveNamesBeyondRetention(t *testing.T) {
+	enclaveNames := []string{"a-202401010000", "a-202401020000", "a-202401030000"}
+	require.Equal(t, []string{"a-202401010000"}, getEnclaveNamesBeyondRetention(enclaveNames, 2))
+	require.Empty(t, getEnclaveNamesBeyondRetention(enclaveNames, 3))
+	require.Empty(t, getEnclaveNamesBeyondRetention(enclaveNames, 5))
+	require.Empty(t, getEnclaveNamesBeyondRetention(enclaveNames, 0))
+}
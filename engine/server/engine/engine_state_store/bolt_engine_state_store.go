@@ -0,0 +1,375 @@
+package engine_state_store
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	boltDatabaseFilePerms = 0600
+
+	// How long opening the database waits for another process holding its file lock (e.g. an engine that's still
+	// shutting down) before giving up
+	boltDatabaseOpenTimeout = 10 * time.Second
+
+	sequenceKeyLen = 8
+)
+
+var (
+	enclaveIdentifiersBucketName = []byte("enclave-identifiers")
+	pulledImagesBucketName       = []byte("pulled-images")
+	enclaveIpRangesBucketName    = []byte("enclave-ip-ranges")
+	enclaveSchedulesBucketName   = []byte("enclave-schedules")
+	enclaveInfosBucketName       = []byte("enclave-infos")
+
+	allBucketNames = [][]byte{
+		enclaveIdentifiersBucketName,
+		pulledImagesBucketName,
+		enclaveIpRangesBucketName,
+		enclaveSchedulesBucketName,
+		enclaveInfosBucketName,
+	}
+
+	emptyValueForKeySet = []byte{}
+)
+
+// BoltEngineStateStore keeps the state in an embedded database file, so it survives engine restarts as long as the file
+// is on persistent storage, but can't be shared between engine replicas. This is the default when the engine has a data
+// directory and no external store is configured
+type BoltEngineStateStore struct {
+	// The database file can only be opened by one engine at a time, so an in-process mutex is enough
+	enclaveModificationsMutex *sync.Mutex
+
+	db *bolt.DB
+}
+
+// NewBoltEngineStateStore opens the database at the given filepath, creating it along with the buckets the engine needs
+// if they don't exist yet
+func NewBoltEngineStateStore(databaseFilepath string) (*BoltEngineStateStore, error) {
+	db, err := bolt.Open(databaseFilepath, boltDatabaseFilePerms, &bolt.Options{
+		Timeout:         boltDatabaseOpenTimeout,
+		NoGrowSync:      false,
+		NoFreelistSync:  false,
+		FreelistType:    "",
+		ReadOnly:        false,
+		MmapFlags:       0,
+		InitialMmapSize: 0,
+		PageSize:        0,
+		NoSync:          false,
+		OpenFile:        nil,
+		Mlock:           false,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the engine state database at '%v'", databaseFilepath)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucketName := range allBucketNames {
+			if _, err := tx.CreateBucketIfNotExists(bucketName); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating bucket '%v'", string(bucketName))
+			}
+		}
+		return nil
+	}); err != nil {
+		_ = db.Close()
+		return nil, stacktrace.Propagate(err, "An error occurred creating the buckets of the engine state database at '%v'", databaseFilepath)
+	}
+	return &BoltEngineStateStore{
+		enclaveModificationsMutex: &sync.Mutex{},
+		db:                        db,
+	}, nil
+}
+
+func (store *BoltEngineStateStore) LockEnclaveModifications(_ context.Context) (UnlockFunc, error) {
+	store.enclaveModificationsMutex.Lock()
+	return store.enclaveModificationsMutex.Unlock, nil
+}
+
+func (store *BoltEngineStateStore) AddEnclaveIdentifiers(_ context.Context, identifiers *kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers) error {
+	serializedIdentifiers, err := proto.Marshal(identifiers)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the identifiers of enclave '%v'", identifiers.GetEnclaveUuid())
+	}
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		return putWithNextSequenceKey(tx.Bucket(enclaveIdentifiersBucketName), serializedIdentifiers)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the identifiers of enclave '%v' in the engine state database", identifiers.GetEnclaveUuid())
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) GetEnclaveIdentifiers(_ context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers, error) {
+	result := []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{}
+	// Keys are big-endian sequence numbers, which the cursor iterates over in order of creation
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveIdentifiersBucketName).ForEach(func(_, serializedIdentifiers []byte) error {
+			identifiers := new(kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers)
+			if err := proto.Unmarshal(serializedIdentifiers, identifiers); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing enclave identifiers")
+			}
+			result = append(result, identifiers)
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave identifiers from the engine state database")
+	}
+	return result, nil
+}
+
+func (store *BoltEngineStateStore) AddPulledImages(_ context.Context, images map[string]bool) error {
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(pulledImagesBucketName)
+		for image := range images {
+			if err := bucket.Put([]byte(image), emptyValueForKeySet); err != nil {
+				return stacktrace.Propagate(err, "An error occurred storing pulled image '%v'", image)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing pulled images in the engine state database")
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) GetPulledImages(_ context.Context) (map[string]bool, error) {
+	result := map[string]bool{}
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(pulledImagesBucketName).ForEach(func(image, _ []byte) error {
+			result[string(image)] = true
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the pulled images from the engine state database")
+	}
+	return result, nil
+}
+
+func (store *BoltEngineStateStore) RemovePulledImages(_ context.Context, images map[string]bool) error {
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(pulledImagesBucketName)
+		for image := range images {
+			if err := bucket.Delete([]byte(image)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing pulled image '%v'", image)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing pulled images from the engine state database")
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) AddEnclaveIpRange(_ context.Context, enclaveUuid string, cidr string) error {
+	serializedEnclaveIpRange, err := json.Marshal(&EnclaveIpRange{
+		EnclaveUuid: enclaveUuid,
+		Cidr:        cidr,
+		ReleaseTime: nil,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing IP range '%v' of enclave '%v'", cidr, enclaveUuid)
+	}
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		return putWithNextSequenceKey(tx.Bucket(enclaveIpRangesBucketName), serializedEnclaveIpRange)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing IP range '%v' of enclave '%v' in the engine state database", cidr, enclaveUuid)
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) ReleaseEnclaveIpRange(_ context.Context, enclaveUuid string, releaseTime time.Time) error {
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(enclaveIpRangesBucketName)
+		releasedEnclaveIpRanges := map[string][]byte{}
+		if err := bucket.ForEach(func(key, serializedEnclaveIpRange []byte) error {
+			enclaveIpRange := new(EnclaveIpRange)
+			if err := json.Unmarshal(serializedEnclaveIpRange, enclaveIpRange); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing an enclave IP range")
+			}
+			if enclaveIpRange.EnclaveUuid != enclaveUuid || enclaveIpRange.ReleaseTime != nil {
+				return nil
+			}
+			enclaveIpRange.ReleaseTime = &releaseTime
+			serializedReleasedEnclaveIpRange, err := json.Marshal(enclaveIpRange)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred serializing the released IP range of enclave '%v'", enclaveUuid)
+			}
+			releasedEnclaveIpRanges[string(key)] = serializedReleasedEnclaveIpRange
+			return nil
+		}); err != nil {
+			return err
+		}
+		// Buckets mustn't be modified while iterating over them
+		for key, serializedEnclaveIpRange := range releasedEnclaveIpRanges {
+			if err := bucket.Put([]byte(key), serializedEnclaveIpRange); err != nil {
+				return stacktrace.Propagate(err, "An error occurred storing the released IP range of enclave '%v'", enclaveUuid)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred releasing the IP range of enclave '%v' in the engine state database", enclaveUuid)
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) GetEnclaveIpRanges(_ context.Context) ([]*EnclaveIpRange, error) {
+	result := []*EnclaveIpRange{}
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveIpRangesBucketName).ForEach(func(_, serializedEnclaveIpRange []byte) error {
+			enclaveIpRange := new(EnclaveIpRange)
+			if err := json.Unmarshal(serializedEnclaveIpRange, enclaveIpRange); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing an enclave IP range")
+			}
+			result = append(result, enclaveIpRange)
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave IP ranges from the engine state database")
+	}
+	return result, nil
+}
+
+func (store *BoltEngineStateStore) AddEnclaveSchedule(_ context.Context, schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule) error {
+	serializedSchedule, err := proto.Marshal(schedule)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing enclave schedule '%v'", schedule.GetName())
+	}
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(enclaveSchedulesBucketName)
+		if bucket.Get([]byte(schedule.GetName())) != nil {
+			return stacktrace.NewError("An enclave schedule named '%v' already exists", schedule.GetName())
+		}
+		return bucket.Put([]byte(schedule.GetName()), serializedSchedule)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing enclave schedule '%v' in the engine state database", schedule.GetName())
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) RemoveEnclaveSchedule(_ context.Context, name string) error {
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveSchedulesBucketName).Delete([]byte(name))
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing enclave schedule '%v' from the engine state database", name)
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) GetEnclaveSchedules(_ context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveSchedule, error) {
+	result := []*kurtosis_engine_rpc_api_bindings.EnclaveSchedule{}
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveSchedulesBucketName).ForEach(func(_, serializedSchedule []byte) error {
+			schedule := new(kurtosis_engine_rpc_api_bindings.EnclaveSchedule)
+			if err := proto.Unmarshal(serializedSchedule, schedule); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing an enclave schedule")
+			}
+			result = append(result, schedule)
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave schedules from the engine state database")
+	}
+	// Keys are sorted bytewise already, but sorting here keeps the order consistent with the other stores
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+	return result, nil
+}
+
+func (store *BoltEngineStateStore) PutEnclaveInfo(_ context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) error {
+	serializedEnclaveInfo, err := proto.Marshal(enclaveInfo)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the information of enclave '%v'", enclaveInfo.GetEnclaveUuid())
+	}
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveInfosBucketName).Put([]byte(enclaveInfo.GetEnclaveUuid()), serializedEnclaveInfo)
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the information of enclave '%v' in the engine state database", enclaveInfo.GetEnclaveUuid())
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) RemoveEnclaveInfos(_ context.Context, enclaveUuids map[string]bool) error {
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(enclaveInfosBucketName)
+		for enclaveUuid := range enclaveUuids {
+			if err := bucket.Delete([]byte(enclaveUuid)); err != nil {
+				return stacktrace.Propagate(err, "An error occurred removing the information of enclave '%v'", enclaveUuid)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing enclave infos from the engine state database")
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) ReplaceEnclaveInfos(_ context.Context, enclaveInfos map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo) error {
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(enclaveInfosBucketName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the enclave infos bucket")
+		}
+		bucket, err := tx.CreateBucket(enclaveInfosBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the enclave infos bucket")
+		}
+		for enclaveUuid, enclaveInfo := range enclaveInfos {
+			serializedEnclaveInfo, err := proto.Marshal(enclaveInfo)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred serializing the information of enclave '%v'", enclaveUuid)
+			}
+			if err := bucket.Put([]byte(enclaveUuid), serializedEnclaveInfo); err != nil {
+				return stacktrace.Propagate(err, "An error occurred storing the information of enclave '%v'", enclaveUuid)
+			}
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred replacing the enclave infos in the engine state database")
+	}
+	return nil
+}
+
+func (store *BoltEngineStateStore) GetEnclaveInfos(_ context.Context) (map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	result := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(enclaveInfosBucketName).ForEach(func(enclaveUuid, serializedEnclaveInfo []byte) error {
+			enclaveInfo := new(kurtosis_engine_rpc_api_bindings.EnclaveInfo)
+			if err := proto.Unmarshal(serializedEnclaveInfo, enclaveInfo); err != nil {
+				return stacktrace.Propagate(err, "An error occurred deserializing the information of enclave '%v'", string(enclaveUuid))
+			}
+			result[string(enclaveUuid)] = enclaveInfo
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave infos from the engine state database")
+	}
+	return result, nil
+}
+
+func (store *BoltEngineStateStore) Close() error {
+	if err := store.db.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the engine state database")
+	}
+	return nil
+}
+
+// putWithNextSequenceKey stores the value under the next sequence number of the bucket, encoded big-endian so that the
+// bucket iterates over its values in the order they were put
+func putWithNextSequenceKey(bucket *bolt.Bucket, value []byte) error {
+	sequence, err := bucket.NextSequence()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the next sequence number of the bucket")
+	}
+	key := make([]byte, sequenceKeyLen)
+	binary.BigEndian.PutUint64(key, sequence)
+	if err := bucket.Put(key, value); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the value under sequence number '%v'", sequence)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package engine_state_store
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	boltDatabaseFilenameForTest = "engine-state.db"
+)
+
+func TestBoltEngineStateStore_StateSurvivesReopening(t *testing.T) {
+	ctx := context.Background()
+	databaseFilepath := path.Join(t.TempDir(), boltDatabaseFilenameForTest)
+
+	store, err := NewBoltEngineStateStore(databaseFilepath)
+	require.NoError(t, err)
+	firstIdentifiers := newEnclaveIdentifiersForTest("first")
+	secondIdentifiers := newEnclaveIdentifiersForTest("second")
+	require.NoError(t, store.AddEnclaveIdentifiers(ctx, firstIdentifiers))
+	require.NoError(t, store.AddEnclaveIdentifiers(ctx, secondIdentifiers))
+	require.NoError(t, store.AddPulledImages(ctx, map[string]bool{"postgres:15": true}))
+	require.NoError(t, store.AddEnclaveSchedule(ctx, newEnclaveScheduleForTest("nightly")))
+	require.NoError(t, store.PutEnclaveInfo(ctx, newEnclaveInfoForTest("first")))
+	require.NoError(t, store.Close())
+
+	store, err = NewBoltEngineStateStore(databaseFilepath)
+	require.NoError(t, err)
+	defer store.Close()
+
+	identifiers, err := store.GetEnclaveIdentifiers(ctx)
+	require.NoError(t, err)
+	require.Len(t, identifiers, 2)
+	require.Equal(t, firstIdentifiers.GetEnclaveUuid(), identifiers[0].GetEnclaveUuid())
+	require.Equal(t, secondIdentifiers.GetEnclaveUuid(), identifiers[1].GetEnclaveUuid())
+
+	pulledImages, err := store.GetPulledImages(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"postgres:15": true}, pulledImages)
+
+	schedules, err := store.GetEnclaveSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, "nightly", schedules[0].GetName())
+	require.Error(t, store.AddEnclaveSchedule(ctx, newEnclaveScheduleForTest("nightly")))
+
+	enclaveInfos, err := store.GetEnclaveInfos(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaveInfos, 1)
+	require.Equal(t, "first", enclaveInfos["first-uuid"].GetName())
+}
+
+func TestBoltEngineStateStore_EnclaveIpRangesGetReleased(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewBoltEngineStateStore(path.Join(t.TempDir(), boltDatabaseFilenameForTest))
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.NoError(t, store.AddEnclaveIpRange(ctx, "first-uuid", "32.16.0.0/20"))
+	require.NoError(t, store.AddEnclaveIpRange(ctx, "second-uuid", "48.0.0.0/20"))
+	releaseTime := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, store.ReleaseEnclaveIpRange(ctx, "first-uuid", releaseTime))
+	require.NoError(t, store.ReleaseEnclaveIpRange(ctx, "unknown-uuid", releaseTime))
+
+	enclaveIpRanges, err := store.GetEnclaveIpRanges(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaveIpRanges, 2)
+	require.Equal(t, "32.16.0.0/20", enclaveIpRanges[0].Cidr)
+	require.NotNil(t, enclaveIpRanges[0].ReleaseTime)
+	require.True(t, releaseTime.Equal(*enclaveIpRanges[0].ReleaseTime))
+	require.Equal(t, "48.0.0.0/20", enclaveIpRanges[1].Cidr)
+	require.Nil(t, enclaveIpRanges[1].ReleaseTime)
+}
+
+func TestBoltEngineStateStore_EnclaveInfosGetReplaced(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewBoltEngineStateStore(path.Join(t.TempDir(), boltDatabaseFilenameForTest))
+	require.NoError(t, err)
+	defer store.Close()
+
+	require.NoError(t, store.PutEnclaveInfo(ctx, newEnclaveInfoForTest("first")))
+	require.NoError(t, store.PutEnclaveInfo(ctx, newEnclaveInfoForTest("second")))
+	require.NoError(t, store.RemoveEnclaveInfos(ctx, map[string]bool{"first-uuid": true, "unknown-uuid": true}))
+
+	enclaveInfos, err := store.GetEnclaveInfos(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaveInfos, 1)
+	require.Contains(t, enclaveInfos, "second-uuid")
+
+	require.NoError(t, store.ReplaceEnclaveInfos(ctx, map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		"third-uuid": newEnclaveInfoForTest("third"),
+	}))
+	enclaveInfos, err = store.GetEnclaveInfos(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaveInfos, 1)
+	require.Equal(t, "third", enclaveInfos["third-uuid"].GetName())
+}
+
+func newEnclaveInfoForTest(name string) *kurtosis_engine_rpc_api_bindings.EnclaveInfo {
+	return &kurtosis_engine_rpc_api_bindings.EnclaveInfo{
+		EnclaveUuid:                 name + "-uuid",
+		Name:                        name,
+		ShortenedUuid:               name,
+		ContainersStatus:            kurtosis_engine_rpc_api_bindings.EnclaveContainersStatus_EnclaveContainersStatus_RUNNING,
+		ApiContainerStatus:          kurtosis_engine_rpc_api_bindings.EnclaveAPIContainerStatus_EnclaveAPIContainerStatus_RUNNING,
+		ApiContainerInfo:            nil,
+		ApiContainerHostMachineInfo: nil,
+		CreationTime:                nil,
+		IpRange:                     "",
+	}
+}
@@ -0,0 +1,83 @@
+package engine_state_store
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"time"
+)
+
+// UnlockFunc releases a lock acquired from an EngineStateStore
+type UnlockFunc func()
+
+// EnclaveIpRange is the IP range an enclave got when it was created
+type EnclaveIpRange struct {
+	EnclaveUuid string
+
+	// The CIDR of the range, e.g. '32.16.0.0/20'
+	Cidr string
+
+	// Nil while the enclave exists
+	ReleaseTime *time.Time
+}
+
+// EngineStateStore holds the state of the engine that isn't stored in the Kurtosis backend itself. Engines sharing an
+// external store can run as several replicas behind a load balancer, each of them being able to serve any request
+type EngineStateStore interface {
+	// LockEnclaveModifications blocks until no other caller, in this engine or in any other engine sharing the store,
+	// holds the lock, so that enclave modifications (which aren't atomic in the backends) don't race with each other
+	LockEnclaveModifications(ctx context.Context) (UnlockFunc, error)
+
+	// AddEnclaveIdentifiers records the identifiers of a newly created enclave; they're kept even after the enclave is
+	// destroyed so that logs of historical enclaves can still be looked up by name
+	AddEnclaveIdentifiers(ctx context.Context, identifiers *kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers) error
+
+	// GetEnclaveIdentifiers returns the identifiers of every enclave recorded so far, in order of creation
+	GetEnclaveIdentifiers(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers, error)
+
+	// AddPulledImages records images that Kurtosis pulled for enclaves, so that they can still be pruned after the
+	// enclaves that used them are destroyed
+	AddPulledImages(ctx context.Context, images map[string]bool) error
+
+	// GetPulledImages returns every image recorded as pulled by Kurtosis that hasn't been removed since
+	GetPulledImages(ctx context.Context) (map[string]bool, error)
+
+	// RemovePulledImages forgets the given images, e.g. because they got removed from the host
+	RemovePulledImages(ctx context.Context, images map[string]bool) error
+
+	// AddEnclaveIpRange records the IP range given to a newly created enclave
+	AddEnclaveIpRange(ctx context.Context, enclaveUuid string, cidr string) error
+
+	// ReleaseEnclaveIpRange records that the enclave got destroyed at the given time, so its IP range is free again;
+	// releasing the range of an unknown enclave is a no-op
+	ReleaseEnclaveIpRange(ctx context.Context, enclaveUuid string, releaseTime time.Time) error
+
+	// GetEnclaveIpRanges returns the IP ranges of every enclave recorded so far, including the released ones
+	GetEnclaveIpRanges(ctx context.Context) ([]*EnclaveIpRange, error)
+
+	// AddEnclaveSchedule records a new enclave schedule; adding a schedule with the name of an existing one fails
+	AddEnclaveSchedule(ctx context.Context, schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule) error
+
+	// RemoveEnclaveSchedule forgets the enclave schedule with the given name; removing an unknown schedule is a no-op
+	RemoveEnclaveSchedule(ctx context.Context, name string) error
+
+	// GetEnclaveSchedules returns every enclave schedule recorded, sorted by name
+	GetEnclaveSchedules(ctx context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveSchedule, error)
+
+	// PutEnclaveInfo records the information of an existing enclave, overwriting what was recorded for it before. The
+	// store is the source of truth for the existing enclaves, while the labels on the containers are only a cache of it
+	// that the engine reconciles the store with
+	PutEnclaveInfo(ctx context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) error
+
+	// RemoveEnclaveInfos forgets the information of the given enclaves, e.g. because they got destroyed; removing the
+	// information of an unknown enclave is a no-op
+	RemoveEnclaveInfos(ctx context.Context, enclaveUuids map[string]bool) error
+
+	// ReplaceEnclaveInfos replaces the information of every recorded enclave with the given one, keyed by enclave UUID,
+	// as the result of reconciling the store with the backend
+	ReplaceEnclaveInfos(ctx context.Context, enclaveInfos map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo) error
+
+	// GetEnclaveInfos returns the information of every existing enclave, keyed by enclave UUID
+	GetEnclaveInfos(ctx context.Context) (map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error)
+
+	Close() error
+}
@@ -0,0 +1,208 @@
+package engine_state_store
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/protobuf/proto"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InMemoryEngineStateStore keeps the state in the engine process, so it's lost when the engine restarts and can't be
+// shared between engine replicas. This is the default when no external store is configured
+type InMemoryEngineStateStore struct {
+	// We use Docker as our backing datastore, but it has tons of race conditions so we use this mutex to ensure
+	//  enclave modifications are atomic
+	enclaveModificationsMutex *sync.Mutex
+
+	identifiersMutex *sync.RWMutex
+
+	// this is an append only list
+	allExistingAndHistoricalIdentifiers []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers
+
+	pulledImagesMutex *sync.RWMutex
+
+	pulledImages map[string]bool
+
+	enclaveIpRangesMutex *sync.RWMutex
+
+	// In order of creation
+	enclaveIpRanges []*EnclaveIpRange
+
+	enclaveSchedulesMutex *sync.RWMutex
+
+	enclaveSchedules map[string]*kurtosis_engine_rpc_api_bindings.EnclaveSchedule
+
+	enclaveInfosMutex *sync.RWMutex
+
+	enclaveInfos map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo
+}
+
+func NewInMemoryEngineStateStore() *InMemoryEngineStateStore {
+	return &InMemoryEngineStateStore{
+		enclaveModificationsMutex:           &sync.Mutex{},
+		identifiersMutex:                    &sync.RWMutex{},
+		allExistingAndHistoricalIdentifiers: []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{},
+		pulledImagesMutex:                   &sync.RWMutex{},
+		pulledImages:                        map[string]bool{},
+		enclaveIpRangesMutex:                &sync.RWMutex{},
+		enclaveIpRanges:                     []*EnclaveIpRange{},
+		enclaveSchedulesMutex:               &sync.RWMutex{},
+		enclaveSchedules:                    map[string]*kurtosis_engine_rpc_api_bindings.EnclaveSchedule{},
+		enclaveInfosMutex:                   &sync.RWMutex{},
+		enclaveInfos:                        map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{},
+	}
+}
+
+func (store *InMemoryEngineStateStore) LockEnclaveModifications(_ context.Context) (UnlockFunc, error) {
+	store.enclaveModificationsMutex.Lock()
+	return store.enclaveModificationsMutex.Unlock, nil
+}
+
+func (store *InMemoryEngineStateStore) AddEnclaveIdentifiers(_ context.Context, identifiers *kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers) error {
+	store.identifiersMutex.Lock()
+	defer store.identifiersMutex.Unlock()
+	store.allExistingAndHistoricalIdentifiers = append(store.allExistingAndHistoricalIdentifiers, identifiers)
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) GetEnclaveIdentifiers(_ context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers, error) {
+	store.identifiersMutex.RLock()
+	defer store.identifiersMutex.RUnlock()
+	result := make([]*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers, len(store.allExistingAndHistoricalIdentifiers))
+	copy(result, store.allExistingAndHistoricalIdentifiers)
+	return result, nil
+}
+
+func (store *InMemoryEngineStateStore) AddPulledImages(_ context.Context, images map[string]bool) error {
+	store.pulledImagesMutex.Lock()
+	defer store.pulledImagesMutex.Unlock()
+	for image := range images {
+		store.pulledImages[image] = true
+	}
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) GetPulledImages(_ context.Context) (map[string]bool, error) {
+	store.pulledImagesMutex.RLock()
+	defer store.pulledImagesMutex.RUnlock()
+	result := map[string]bool{}
+	for image := range store.pulledImages {
+		result[image] = true
+	}
+	return result, nil
+}
+
+func (store *InMemoryEngineStateStore) RemovePulledImages(_ context.Context, images map[string]bool) error {
+	store.pulledImagesMutex.Lock()
+	defer store.pulledImagesMutex.Unlock()
+	for image := range images {
+		delete(store.pulledImages, image)
+	}
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) AddEnclaveIpRange(_ context.Context, enclaveUuid string, cidr string) error {
+	store.enclaveIpRangesMutex.Lock()
+	defer store.enclaveIpRangesMutex.Unlock()
+	store.enclaveIpRanges = append(store.enclaveIpRanges, &EnclaveIpRange{
+		EnclaveUuid: enclaveUuid,
+		Cidr:        cidr,
+		ReleaseTime: nil,
+	})
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) ReleaseEnclaveIpRange(_ context.Context, enclaveUuid string, releaseTime time.Time) error {
+	store.enclaveIpRangesMutex.Lock()
+	defer store.enclaveIpRangesMutex.Unlock()
+	for _, enclaveIpRange := range store.enclaveIpRanges {
+		if enclaveIpRange.EnclaveUuid == enclaveUuid && enclaveIpRange.ReleaseTime == nil {
+			enclaveIpRange.ReleaseTime = &releaseTime
+		}
+	}
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) GetEnclaveIpRanges(_ context.Context) ([]*EnclaveIpRange, error) {
+	store.enclaveIpRangesMutex.RLock()
+	defer store.enclaveIpRangesMutex.RUnlock()
+	result := make([]*EnclaveIpRange, 0, len(store.enclaveIpRanges))
+	for _, enclaveIpRange := range store.enclaveIpRanges {
+		enclaveIpRangeCopy := *enclaveIpRange
+		result = append(result, &enclaveIpRangeCopy)
+	}
+	return result, nil
+}
+
+func (store *InMemoryEngineStateStore) AddEnclaveSchedule(_ context.Context, schedule *kurtosis_engine_rpc_api_bindings.EnclaveSchedule) error {
+	store.enclaveSchedulesMutex.Lock()
+	defer store.enclaveSchedulesMutex.Unlock()
+	if _, found := store.enclaveSchedules[schedule.GetName()]; found {
+		return stacktrace.NewError("An enclave schedule named '%v' already exists", schedule.GetName())
+	}
+	store.enclaveSchedules[schedule.GetName()] = proto.Clone(schedule).(*kurtosis_engine_rpc_api_bindings.EnclaveSchedule)
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) RemoveEnclaveSchedule(_ context.Context, name string) error {
+	store.enclaveSchedulesMutex.Lock()
+	defer store.enclaveSchedulesMutex.Unlock()
+	delete(store.enclaveSchedules, name)
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) GetEnclaveSchedules(_ context.Context) ([]*kurtosis_engine_rpc_api_bindings.EnclaveSchedule, error) {
+	store.enclaveSchedulesMutex.RLock()
+	defer store.enclaveSchedulesMutex.RUnlock()
+	result := make([]*kurtosis_engine_rpc_api_bindings.EnclaveSchedule, 0, len(store.enclaveSchedules))
+	for _, schedule := range store.enclaveSchedules {
+		result = append(result, proto.Clone(schedule).(*kurtosis_engine_rpc_api_bindings.EnclaveSchedule))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].GetName() < result[j].GetName()
+	})
+	return result, nil
+}
+
+func (store *InMemoryEngineStateStore) PutEnclaveInfo(_ context.Context, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo) error {
+	store.enclaveInfosMutex.Lock()
+	defer store.enclaveInfosMutex.Unlock()
+	store.enclaveInfos[enclaveInfo.GetEnclaveUuid()] = proto.Clone(enclaveInfo).(*kurtosis_engine_rpc_api_bindings.EnclaveInfo)
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) RemoveEnclaveInfos(_ context.Context, enclaveUuids map[string]bool) error {
+	store.enclaveInfosMutex.Lock()
+	defer store.enclaveInfosMutex.Unlock()
+	for enclaveUuid := range enclaveUuids {
+		delete(store.enclaveInfos, enclaveUuid)
+	}
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) ReplaceEnclaveInfos(_ context.Context, enclaveInfos map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo) error {
+	store.enclaveInfosMutex.Lock()
+	defer store.enclaveInfosMutex.Unlock()
+	store.enclaveInfos = map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for enclaveUuid, enclaveInfo := range enclaveInfos {
+		store.enclaveInfos[enclaveUuid] = proto.Clone(enclaveInfo).(*kurtosis_engine_rpc_api_bindings.EnclaveInfo)
+	}
+	return nil
+}
+
+func (store *InMemoryEngineStateStore) GetEnclaveInfos(_ context.Context) (map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	store.enclaveInfosMutex.RLock()
+	defer store.enclaveInfosMutex.RUnlock()
+	result := map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for enclaveUuid, enclaveInfo := range store.enclaveInfos {
+		result[enclaveUuid] = proto.Clone(enclaveInfo).(*kurtosis_engine_rpc_api_bindings.EnclaveInfo)
+	}
+	return result, nil
+}
+
+func (store *InMemoryEngineStateStore) Close() error {
+	return nil
+}
@@ -0,0 +1,115 @@
+package engine_state_store
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestInMemoryEngineStateStore_EnclaveIdentifiersAreKeptInOrder(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryEngineStateStore()
+
+	identifiers, err := store.GetEnclaveIdentifiers(ctx)
+	require.NoError(t, err)
+	require.Empty(t, identifiers)
+
+	firstIdentifiers := newEnclaveIdentifiersForTest("first")
+	secondIdentifiers := newEnclaveIdentifiersForTest("second")
+	require.NoError(t, store.AddEnclaveIdentifiers(ctx, firstIdentifiers))
+	require.NoError(t, store.AddEnclaveIdentifiers(ctx, secondIdentifiers))
+
+	identifiers, err = store.GetEnclaveIdentifiers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{firstIdentifiers, secondIdentifiers}, identifiers)
+}
+
+func TestInMemoryEngineStateStore_LockCanBeReacquiredAfterUnlock(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryEngineStateStore()
+
+	unlock, err := store.LockEnclaveModifications(ctx)
+	require.NoError(t, err)
+	unlock()
+
+	unlock, err = store.LockEnclaveModifications(ctx)
+	require.NoError(t, err)
+	unlock()
+}
+
+func newEnclaveIdentifiersForTest(name string) *kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers {
+	return &kurtosis_engine_rpc_api_bindings.EnclaveIdentifiers{
+		EnclaveUuid:   name + "-uuid",
+		Name:          name,
+		ShortenedUuid: name,
+	}
+}
+
+func TestInMemoryEngineStateStore_PulledImagesAreKeptUntilRemoved(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryEngineStateStore()
+
+	require.NoError(t, store.AddPulledImages(ctx, map[string]bool{"postgres:15": true, "redis:7": true}))
+	require.NoError(t, store.AddPulledImages(ctx, map[string]bool{"redis:7": true}))
+	require.NoError(t, store.RemovePulledImages(ctx, map[string]bool{"postgres:15": true}))
+
+	pulledImages, err := store.GetPulledImages(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"redis:7": true}, pulledImages)
+}
+
+func TestInMemoryEngineStateStore_EnclaveIpRangesGetReleased(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryEngineStateStore()
+
+	require.NoError(t, store.AddEnclaveIpRange(ctx, "first-uuid", "32.16.0.0/20"))
+	require.NoError(t, store.AddEnclaveIpRange(ctx, "second-uuid", "48.0.0.0/20"))
+	releaseTime := time.Now()
+	require.NoError(t, store.ReleaseEnclaveIpRange(ctx, "first-uuid", releaseTime))
+	require.NoError(t, store.ReleaseEnclaveIpRange(ctx, "unknown-uuid", releaseTime))
+
+	enclaveIpRanges, err := store.GetEnclaveIpRanges(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaveIpRanges, 2)
+	require.Equal(t, "32.16.0.0/20", enclaveIpRanges[0].Cidr)
+	require.Equal(t, &releaseTime, enclaveIpRanges[0].ReleaseTime)
+	require.Equal(t, "48.0.0.0/20", enclaveIpRanges[1].Cidr)
+	require.Nil(t, enclaveIpRanges[1].ReleaseTime)
+}
+
+func TestInMemoryEngineStateStore_EnclaveSchedulesAreSortedByNameAndUnique(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryEngineStateStore()
+
+	nightlySchedule := newEnclaveScheduleForTest("nightly")
+	hourlySchedule := newEnclaveScheduleForTest("hourly")
+	require.NoError(t, store.AddEnclaveSchedule(ctx, nightlySchedule))
+	require.NoError(t, store.AddEnclaveSchedule(ctx, hourlySchedule))
+	require.Error(t, store.AddEnclaveSchedule(ctx, newEnclaveScheduleForTest("nightly")))
+
+	schedules, err := store.GetEnclaveSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	require.Equal(t, "hourly", schedules[0].GetName())
+	require.Equal(t, "nightly", schedules[1].GetName())
+
+	require.NoError(t, store.RemoveEnclaveSchedule(ctx, "hourly"))
+	require.NoError(t, store.RemoveEnclaveSchedule(ctx, "unknown"))
+
+	schedules, err = store.GetEnclaveSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, "nightly", schedules[0].GetName())
+}
+
+func newEnclaveScheduleForTest(name string) *kurtosis_engine_rpc_api_bindings.EnclaveSchedule {
+	return &kurtosis_engine_rpc_api_bindings.EnclaveSchedule{
+		Name:             name,
+		CronExpression:   "0 3 * * *",
+		PackageId:        "github.com/kurtosis-tech/eth2-package",
+		SerializedParams: "{}",
+		Retention:        3,
+	}
+}
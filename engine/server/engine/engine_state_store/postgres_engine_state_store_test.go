@@ -0,0 +1,228 @@
+package engine_state_store
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+var errDatabaseForTest = errors.New("the database is unreachable")
+
+func TestPostgresEngineStateStore_CreatesTheTablesOnSetup(t *testing.T) {
+	_, mock := newPostgresEngineStateStoreForTest(t)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_SetupFailsIfTheDatabaseIsUnreachable(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true), sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	mock.ExpectPing().WillReturnError(errDatabaseForTest)
+
+	_, err = newPostgresEngineStateStoreFromDb(context.Background(), db)
+	require.Equal(t, errDatabaseForTest, stacktrace.RootCause(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_EnclaveIdentifiers(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	identifiers := newEnclaveIdentifiersForTest("first")
+	mock.ExpectExec(insertEnclaveIdentifiersQuery).
+		WithArgs("first-uuid", "first", "first").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectEnclaveIdentifiersQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"enclave_uuid", "name", "shortened_uuid"}).
+			AddRow("first-uuid", "first", "first").
+			AddRow("second-uuid", "second", "second"))
+
+	require.NoError(t, store.AddEnclaveIdentifiers(ctx, identifiers))
+	storedIdentifiers, err := store.GetEnclaveIdentifiers(ctx)
+	require.NoError(t, err)
+	require.Len(t, storedIdentifiers, 2)
+	require.True(t, proto.Equal(identifiers, storedIdentifiers[0]))
+	require.Equal(t, "second-uuid", storedIdentifiers[1].GetEnclaveUuid())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_PulledImages(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	mock.ExpectExec(insertPulledImageQuery).WithArgs("postgres:15").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deletePulledImageQuery).WithArgs("postgres:15").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectPulledImagesQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"image"}).AddRow("redis:7"))
+
+	require.NoError(t, store.AddPulledImages(ctx, map[string]bool{"postgres:15": true}))
+	require.NoError(t, store.RemovePulledImages(ctx, map[string]bool{"postgres:15": true}))
+	pulledImages, err := store.GetPulledImages(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"redis:7": true}, pulledImages)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_EnclaveIpRanges(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	releaseTime := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectExec(insertEnclaveIpRangeQuery).WithArgs("first-uuid", "32.16.0.0/20").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(releaseEnclaveIpRangeQuery).WithArgs("first-uuid", releaseTime).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectEnclaveIpRangesQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"enclave_uuid", "cidr", "released_at"}).
+			AddRow("first-uuid", "32.16.0.0/20", releaseTime).
+			AddRow("second-uuid", "32.16.16.0/20", nil))
+
+	require.NoError(t, store.AddEnclaveIpRange(ctx, "first-uuid", "32.16.0.0/20"))
+	require.NoError(t, store.ReleaseEnclaveIpRange(ctx, "first-uuid", releaseTime))
+	ipRanges, err := store.GetEnclaveIpRanges(ctx)
+	require.NoError(t, err)
+	require.Len(t, ipRanges, 2)
+	require.Equal(t, "first-uuid", ipRanges[0].EnclaveUuid)
+	require.NotNil(t, ipRanges[0].ReleaseTime)
+	require.True(t, releaseTime.Equal(*ipRanges[0].ReleaseTime))
+	require.Equal(t, "32.16.16.0/20", ipRanges[1].Cidr)
+	require.Nil(t, ipRanges[1].ReleaseTime)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_EnclaveSchedules(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	schedule := newEnclaveScheduleForTest("nightly")
+	mock.ExpectExec(insertEnclaveScheduleQuery).
+		WithArgs("nightly", "0 3 * * *", "github.com/kurtosis-tech/eth2-package", "{}", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectEnclaveSchedulesQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "cron_expression", "package_id", "serialized_params", "retention"}).
+			AddRow("nightly", "0 3 * * *", "github.com/kurtosis-tech/eth2-package", "{}", 3))
+	mock.ExpectExec(deleteEnclaveScheduleQuery).WithArgs("nightly").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.AddEnclaveSchedule(ctx, schedule))
+	schedules, err := store.GetEnclaveSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.True(t, proto.Equal(schedule, schedules[0]))
+	require.NoError(t, store.RemoveEnclaveSchedule(ctx, "nightly"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_EnclaveInfosAreStoredSerialized(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	enclaveInfo := newEnclaveInfoForTest("first")
+	serializedEnclaveInfo, err := proto.Marshal(enclaveInfo)
+	require.NoError(t, err)
+	mock.ExpectExec(upsertEnclaveInfoQuery).WithArgs("first-uuid", serializedEnclaveInfo).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(selectEnclaveInfosQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"enclave_uuid", "serialized_info"}).AddRow("first-uuid", serializedEnclaveInfo))
+	mock.ExpectExec(deleteEnclaveInfoQuery).WithArgs("first-uuid").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, store.PutEnclaveInfo(ctx, enclaveInfo))
+	enclaveInfos, err := store.GetEnclaveInfos(ctx)
+	require.NoError(t, err)
+	require.Len(t, enclaveInfos, 1)
+	require.True(t, proto.Equal(enclaveInfo, enclaveInfos["first-uuid"]))
+	require.NoError(t, store.RemoveEnclaveInfos(ctx, map[string]bool{"first-uuid": true}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_ReplaceEnclaveInfosCommitsInOneTransaction(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	enclaveInfo := newEnclaveInfoForTest("first")
+	serializedEnclaveInfo, err := proto.Marshal(enclaveInfo)
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec(deleteAllEnclaveInfosQuery).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(upsertEnclaveInfoQuery).WithArgs("first-uuid", serializedEnclaveInfo).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, store.ReplaceEnclaveInfos(ctx, map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{"first-uuid": enclaveInfo}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_ReplaceEnclaveInfosRollsBackOnFailure(t *testing.T) {
+	ctx := context.Background()
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(deleteAllEnclaveInfosQuery).WillReturnError(errDatabaseForTest)
+	mock.ExpectRollback()
+
+	err := store.ReplaceEnclaveInfos(ctx, map[string]*kurtosis_engine_rpc_api_bindings.EnclaveInfo{"first-uuid": newEnclaveInfoForTest("first")})
+	require.Equal(t, errDatabaseForTest, stacktrace.RootCause(err))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_LockEnclaveModificationsReleasesTheAdvisoryLock(t *testing.T) {
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	mock.ExpectExec(acquireAdvisoryLockQuery).WithArgs(enclaveModificationsAdvisoryLockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(releaseAdvisoryLockQuery).WithArgs(enclaveModificationsAdvisoryLockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	unlockFunc, err := store.LockEnclaveModifications(ctx)
+	require.NoError(t, err)
+	// The lock must get released even if the context it got acquired with is gone
+	cancelFunc()
+	unlockFunc()
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_LockEnclaveModificationsFailsIfTheLockCantBeAcquired(t *testing.T) {
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	mock.ExpectExec(acquireAdvisoryLockQuery).WithArgs(enclaveModificationsAdvisoryLockKey).WillReturnError(errDatabaseForTest)
+
+	unlockFunc, err := store.LockEnclaveModifications(context.Background())
+	require.Equal(t, errDatabaseForTest, stacktrace.RootCause(err))
+	require.Nil(t, unlockFunc)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresEngineStateStore_UnlockingSurvivesAFailedRelease(t *testing.T) {
+	store, mock := newPostgresEngineStateStoreForTest(t)
+
+	mock.ExpectExec(acquireAdvisoryLockQuery).WithArgs(enclaveModificationsAdvisoryLockKey).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(releaseAdvisoryLockQuery).WithArgs(enclaveModificationsAdvisoryLockKey).WillReturnError(errDatabaseForTest)
+
+	unlockFunc, err := store.LockEnclaveModifications(context.Background())
+	require.NoError(t, err)
+	// Postgres releases the lock once the connection gets closed, so a failed release only gets logged
+	unlockFunc()
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func newPostgresEngineStateStoreForTest(t *testing.T) (*PostgresEngineStateStore, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true), sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	mock.ExpectPing()
+	for _, createTableQuery := range []string{
+		createEnclaveIdentifiersTableQuery,
+		createPulledImagesTableQuery,
+		createEnclaveIpRangesTableQuery,
+		createEnclaveSchedulesTableQuery,
+		createEnclaveInfosTableQuery,
+	} {
+		mock.ExpectExec(createTableQuery).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	store, err := newPostgresEngineStateStoreFromDb(context.Background(), db)
+	require.NoError(t, err)
+	return store, mock
+}
@@ -12,16 +12,25 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/backend_creator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/remote_context_backend"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/log_correlation"
 	"github.com/kurtosis-tech/kurtosis/core/launcher/api_container_launcher"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/webhook_notifier"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_container_clients"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/kurtosis_backend"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_schedules"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/engine_state_store"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/request_correlation"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/webhook_notifications"
 	minimal_grpc_server "github.com/kurtosis-tech/minimal-grpc-server/golang/server"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 	"os"
 	"path"
 	"runtime"
@@ -35,12 +44,23 @@ const (
 
 	grpcServerStopGracePeriod = 5 * time.Second
 
+	// Resolves to the host machine from within the engine container, which gets started with access to it
+	dockerHostMachineHost = "host.docker.internal"
+
 	forceColors   = true
 	fullTimestamp = true
+	// Millisecond precision, so the lines of the engine and the API containers can be interleaved when tracing an operation
+	logTimestampFormat = "2006-01-02T15:04:05.000Z07:00"
 
 	logMethodAlongWithLogLine = true
 	functionPathSeparator     = "."
 	emptyFunctionName         = ""
+
+	engineStateDatabaseFilename = "engine-state.db"
+
+	// How often the enclaves recorded in the engine state store get reconciled with the ones in the backend, to catch
+	// enclaves that got modified without going through the engine (e.g. containers removed by hand)
+	enclaveInfosReconciliationInterval = 1 * time.Minute
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -60,7 +80,7 @@ func main() {
 		EnvironmentOverrideColors: false,
 		DisableTimestamp:          false,
 		FullTimestamp:             fullTimestamp,
-		TimestampFormat:           "",
+		TimestampFormat:           logTimestampFormat,
 		DisableSorting:            false,
 		SortingFunc:               nil,
 		DisableLevelTruncation:    false,
@@ -97,6 +117,7 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred parsing the log level string '%v':", serverArgs.LogLevelStr)
 	}
 	logrus.SetLevel(logLevel)
+	logrus.AddHook(log_correlation.NewCorrelationHook(logrus.Fields{}))
 
 	backendConfig := serverArgs.KurtosisLocalBackendConfig
 	if backendConfig == nil {
@@ -110,23 +131,64 @@ func runMain() error {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis backend for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
 
-	enclaveManager, err := getEnclaveManager(kurtosisBackend, serverArgs.KurtosisBackendType)
+	engineStateStore, err := getEngineStateStore(ctx, serverArgs.EngineStateStoreConfig)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the engine state store")
+	}
+	defer func() {
+		if err := engineStateStore.Close(); err != nil {
+			logrus.Warnf("We tried to close the engine state store but failed:\n%v", err)
+		}
+	}()
+
+	enclaveManager, err := getEnclaveManager(kurtosisBackend, serverArgs.KurtosisBackendType, backendConfig, engineStateStore, serverArgs.PackageCacheMaxSizeBytes)
 	if err != nil {
 		return stacktrace.Propagate(err, "Failed to create an enclave manager for backend type '%v' and config '%+v'", serverArgs.KurtosisBackendType, backendConfig)
 	}
 
+	// The recorded enclaves are the source of truth for the engine, so they're brought up to date with the backend
+	// before serving any request, as enclaves may have changed while the engine wasn't running
+	if err := enclaveManager.ReconcileEnclaveInfos(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred reconciling the enclaves recorded in the engine state store with the backend")
+	}
+	stopReconcilingEnclaveInfos := enclaveManager.StartReconcilingEnclaveInfos(enclaveInfosReconciliationInterval)
+	defer stopReconcilingEnclaveInfos()
+
+	webhookNotifier, err := webhook_notifier.NewWebhookNotifier(serverArgs.Webhooks)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the notifier of the webhooks")
+	}
+	logrus.AddHook(webhook_notifications.NewEngineErrorsHook(webhookNotifier))
+	stopForwardingEnclaveLifecycleEvents := webhook_notifications.StartForwardingEnclaveLifecycleEvents(enclaveManager, webhookNotifier)
+	defer stopForwardingEnclaveLifecycleEvents()
+
 	logsDatabaseClient := kurtosis_backend.NewKurtosisBackendLogsDatabaseClient(kurtosisBackend)
 
-	engineServerService := server.NewEngineServerService(serverArgs.ImageVersionTag, enclaveManager, serverArgs.MetricsUserID, serverArgs.DidUserAcceptSendingMetrics, logsDatabaseClient)
+	apiContainerConnector := api_container_clients.NewApiContainerConnector(getApiContainerHostMachineHost(serverArgs.KurtosisBackendType))
+
+	enclaveScheduler := enclave_schedules.NewEnclaveScheduler(
+		enclaveManager,
+		engineStateStore,
+		enclave_schedules.NewApiContainerPackageRunner(apiContainerConnector),
+		webhookNotifier,
+		serverArgs.MetricsUserID,
+		serverArgs.DidUserAcceptSendingMetrics,
+	)
+	stopEnclaveScheduler := enclaveScheduler.Start()
+	defer stopEnclaveScheduler()
+
+	engineServerService := server.NewEngineServerService(serverArgs.ImageVersionTag, enclaveManager, serverArgs.MetricsUserID, serverArgs.DidUserAcceptSendingMetrics, logsDatabaseClient, enclaveScheduler, apiContainerConnector)
 
 	engineServerServiceRegistrationFunc := func(grpcServer *grpc.Server) {
-		kurtosis_engine_rpc_api_bindings.RegisterEngineServiceServer(grpcServer, engineServerService)
+		// Equivalent to RegisterEngineServiceServer, but giving every request an ID that gets attached to its log lines
+		grpcServer.RegisterService(request_correlation.WrapServiceDesc(kurtosis_engine_rpc_api_bindings.EngineService_ServiceDesc), engineServerService)
 	}
 	engineServer := minimal_grpc_server.NewMinimalGRPCServer(
 		serverArgs.GrpcListenPortNum,
 		grpcServerStopGracePeriod,
 		[]func(*grpc.Server){
 			engineServerServiceRegistrationFunc,
+			grpcReflectionRegistrationFunc,
 		},
 	)
 
@@ -137,22 +199,61 @@ func runMain() error {
 	return nil
 }
 
-func getEnclaveManager(kurtosisBackend backend_interface.KurtosisBackend, kurtosisBackendType args.KurtosisBackendType) (*enclave_manager.EnclaveManager, error) {
+func getEnclaveManager(kurtosisBackend backend_interface.KurtosisBackend, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, engineStateStore engine_state_store.EngineStateStore, packageCacheMaxSizeBytes uint64) (*enclave_manager.EnclaveManager, error) {
 	var apiContainerKurtosisBackendConfigSupplier api_container_launcher.KurtosisBackendConfigSupplier
 	switch kurtosisBackendType {
 	case args.KurtosisBackendType_Docker:
-		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewDockerKurtosisBackendConfigSupplier()
+		dockerConfig, ok := (backendConfig).(kurtosis_backend_config.DockerBackendConfig)
+		if !ok {
+			return nil, stacktrace.NewError("Failed to cast cluster configuration interface to the appropriate type, even though Kurtosis backend type is '%v'", args.KurtosisBackendType_Docker.String())
+		}
+		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewDockerKurtosisBackendConfigSupplier(dockerConfig.ShouldFallBackToEphemeralPortOnHostPortConflict, dockerConfig.NetworkingSidecarImage)
 	case args.KurtosisBackendType_Kubernetes:
 		apiContainerKurtosisBackendConfigSupplier = api_container_launcher.NewKubernetesKurtosisBackendConfigSupplier()
 	default:
 		return nil, stacktrace.NewError("Backend type '%v' was not recognized by engine server.", kurtosisBackendType.String())
 	}
 
-	enclaveManager := enclave_manager.NewEnclaveManager(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier)
+	enclaveManager := enclave_manager.NewEnclaveManager(kurtosisBackend, apiContainerKurtosisBackendConfigSupplier, engineStateStore, packageCacheMaxSizeBytes)
 
 	return enclaveManager, nil
 }
 
+// getApiContainerHostMachineHost returns the host through which the engine reaches the API containers to run the
+// packages of the enclave schedules; on Docker the engine isn't on the networks of the enclaves, so it goes through the
+// ports the API containers publish on the host machine
+func getApiContainerHostMachineHost(kurtosisBackendType args.KurtosisBackendType) string {
+	if kurtosisBackendType == args.KurtosisBackendType_Docker {
+		return dockerHostMachineHost
+	}
+	return ""
+}
+
+func getEngineStateStore(ctx context.Context, engineStateStoreConfigMaybe *args.EngineStateStoreConfig) (engine_state_store.EngineStateStore, error) {
+	if engineStateStoreConfigMaybe == nil {
+		if _, err := os.Stat(engine.EngineDataDirpath); err != nil {
+			logrus.Infof("The engine has no data directory at '%v'; keeping the engine state in memory", engine.EngineDataDirpath)
+			return engine_state_store.NewInMemoryEngineStateStore(), nil
+		}
+		engineStateDatabaseFilepath := path.Join(engine.EngineDataDirpath, engineStateDatabaseFilename)
+		logrus.Infof("Keeping the engine state in the embedded database at '%v'", engineStateDatabaseFilepath)
+		boltEngineStateStore, err := engine_state_store.NewBoltEngineStateStore(engineStateDatabaseFilepath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the embedded engine state store")
+		}
+		return boltEngineStateStore, nil
+	}
+	if engineStateStoreConfigMaybe.PostgresConnectionUrl == "" {
+		return nil, stacktrace.NewError("An engine state store config was provided but it has no Postgres connection URL")
+	}
+	logrus.Infof("Keeping the engine state in the external Postgres store")
+	postgresEngineStateStore, err := engine_state_store.NewPostgresEngineStateStore(ctx, engineStateStoreConfigMaybe.PostgresConnectionUrl)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the Postgres engine state store")
+	}
+	return postgresEngineStateStore, nil
+}
+
 func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBackendType, backendConfig interface{}, remoteBackendConfigMaybe *remote_context_backend.KurtosisRemoteBackendConfig) (backend_interface.KurtosisBackend, error) {
 	var kurtosisBackend backend_interface.KurtosisBackend
 	var err error
@@ -206,3 +307,9 @@ func formatFilenameFunctionForLogs(filename string, functionName string) string
 	output.WriteString("]")
 	return output.String()
 }
+
+// grpcReflectionRegistrationFunc enables gRPC server reflection, so that tools like grpcurl can list and call the
+// endpoints of the server without having its protobuf definitions
+func grpcReflectionRegistrationFunc(grpcServer *grpc.Server) {
+	reflection.Register(grpcServer)
+}
@@ -0,0 +1,84 @@
+package request_correlation
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/log_correlation"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// WrapServiceDesc returns a copy of the gRPC service description whose handlers give every request an ID, store it
+// in the request context so it gets attached to the lines logged with logrus.WithContext, and send it back to the
+// client in the response headers
+func WrapServiceDesc(serviceDesc grpc.ServiceDesc) *grpc.ServiceDesc {
+	wrappedMethods := make([]grpc.MethodDesc, len(serviceDesc.Methods))
+	for idx, method := range serviceDesc.Methods {
+		wrappedMethods[idx] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler:    wrapUnaryHandler(serviceDesc.ServiceName, method.MethodName, method.Handler),
+		}
+	}
+	wrappedStreams := make([]grpc.StreamDesc, len(serviceDesc.Streams))
+	for idx, stream := range serviceDesc.Streams {
+		wrappedStreams[idx] = grpc.StreamDesc{
+			StreamName:    stream.StreamName,
+			Handler:       wrapStreamHandler(serviceDesc.ServiceName, stream.StreamName, stream.Handler),
+			ServerStreams: stream.ServerStreams,
+			ClientStreams: stream.ClientStreams,
+		}
+	}
+	return &grpc.ServiceDesc{
+		ServiceName: serviceDesc.ServiceName,
+		HandlerType: serviceDesc.HandlerType,
+		Methods:     wrappedMethods,
+		Streams:     wrappedStreams,
+		Metadata:    serviceDesc.Metadata,
+	}
+}
+
+// Same signature as grpc.MethodDesc.Handler, whose type isn't exported
+type unaryHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+func wrapUnaryHandler(serviceName string, methodName string, handler unaryHandler) unaryHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		ctxWithRequestId := startRequest(ctx, serviceName, methodName)
+		return handler(srv, ctxWithRequestId, dec, interceptor)
+	}
+}
+
+func wrapStreamHandler(serviceName string, streamName string, handler grpc.StreamHandler) grpc.StreamHandler {
+	return func(srv interface{}, stream grpc.ServerStream) error {
+		ctxWithRequestId := startRequest(stream.Context(), serviceName, streamName)
+		return handler(srv, &requestCorrelatedServerStream{
+			ServerStream: stream,
+			ctx:          ctxWithRequestId,
+		})
+	}
+}
+
+func startRequest(ctx context.Context, serviceName string, methodName string) context.Context {
+	requestId, err := log_correlation.NewRequestId()
+	if err != nil {
+		// Not worth failing the request over, it just won't be traceable
+		logrus.Warnf("An error occurred generating an ID for a request to '%v/%v':\n%v", serviceName, methodName, err)
+		return ctx
+	}
+	ctxWithRequestId := log_correlation.WithRequestId(ctx, requestId)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(log_correlation.RequestIdGrpcHeaderKey, requestId)); err != nil {
+		logrus.WithContext(ctxWithRequestId).Debugf("Couldn't send the request ID back to the client:\n%v", err)
+	}
+	logrus.WithContext(ctxWithRequestId).Debugf("Handling request to '%v/%v'", serviceName, methodName)
+	return ctxWithRequestId
+}
+
+// requestCorrelatedServerStream is a grpc.ServerStream whose context carries the request ID
+type requestCorrelatedServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (stream *requestCorrelatedServerStream) Context() context.Context {
+	return stream.ctx
+}
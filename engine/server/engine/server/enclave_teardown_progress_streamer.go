@@ -0,0 +1,97 @@
+package server
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/teardown_progress"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"sync"
+)
+
+const (
+	stoppedResourcesActionDescription   = "Stopped"
+	destroyedResourcesActionDescription = "Removed"
+)
+
+// enclaveTeardownProgressStreamer sends the progress reported by the backend while stopping or destroying an enclave
+// to the client, and collects the resources left behind to send them in a final summary
+type enclaveTeardownProgressStreamer struct {
+	// The backend reports from several goroutines, while gRPC streams don't support concurrent sends
+	mutex *sync.Mutex
+
+	send func(progress *kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress) error
+
+	// What happened to the resources counted as handled, e.g. 'Removed'
+	actionDescription string
+
+	leakedResources []*kurtosis_engine_rpc_api_bindings.LeakedEnclaveResource
+
+	// Once the client is gone, the teardown goes on but nothing gets sent anymore
+	sendErr error
+}
+
+func newEnclaveTeardownProgressStreamer(
+	send func(progress *kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress) error,
+	actionDescription string,
+) *enclaveTeardownProgressStreamer {
+	return &enclaveTeardownProgressStreamer{
+		mutex:             &sync.Mutex{},
+		send:              send,
+		actionDescription: actionDescription,
+		leakedResources:   []*kurtosis_engine_rpc_api_bindings.LeakedEnclaveResource{},
+		sendErr:           nil,
+	}
+}
+
+func (streamer *enclaveTeardownProgressStreamer) ReportProgress(resourceType teardown_progress.ResourceType, numHandled uint32, numTotal uint32) {
+	streamer.mutex.Lock()
+	defer streamer.mutex.Unlock()
+	step := &kurtosis_engine_rpc_api_bindings.EnclaveTeardownStep{
+		CurrentStepInfo:   []string{fmt.Sprintf("%v %v/%v %vs", streamer.actionDescription, numHandled, numTotal, resourceType)},
+		TotalSteps:        numTotal,
+		CurrentStepNumber: numHandled,
+	}
+	streamer.sendUnlocked(&kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress{
+		Progress: &kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress_Step{
+			Step: step,
+		},
+	})
+}
+
+func (streamer *enclaveTeardownProgressStreamer) ReportLeakedResource(resourceType teardown_progress.ResourceType, resourceName string, cause error) {
+	streamer.mutex.Lock()
+	defer streamer.mutex.Unlock()
+	streamer.leakedResources = append(streamer.leakedResources, &kurtosis_engine_rpc_api_bindings.LeakedEnclaveResource{
+		ResourceType: string(resourceType),
+		ResourceName: resourceName,
+		Error:        cause.Error(),
+	})
+}
+
+// sendSummary has to be called once the teardown is over, whether it succeeded or not
+func (streamer *enclaveTeardownProgressStreamer) sendSummary() error {
+	streamer.mutex.Lock()
+	defer streamer.mutex.Unlock()
+	streamer.sendUnlocked(&kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress{
+		Progress: &kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress_Summary{
+			Summary: &kurtosis_engine_rpc_api_bindings.EnclaveTeardownSummary{
+				LeakedResources: streamer.leakedResources,
+			},
+		},
+	})
+	if streamer.sendErr != nil {
+		return stacktrace.Propagate(streamer.sendErr, "An error occurred sending the teardown progress to the client")
+	}
+	return nil
+}
+
+func (streamer *enclaveTeardownProgressStreamer) sendUnlocked(progress *kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress) {
+	if streamer.sendErr != nil {
+		return
+	}
+	if err := streamer.send(progress); err != nil {
+		logrus.Debugf("An error occurred sending the teardown progress to the client; the teardown will go on without reporting its progress:\n%v", err)
+		streamer.sendErr = err
+	}
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"errors"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/teardown_progress"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestEnclaveTeardownProgressStreamer_SendsStepsThenSummary(t *testing.T) {
+	sentProgress := []*kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress{}
+	streamer := newEnclaveTeardownProgressStreamer(func(progress *kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress) error {
+		sentProgress = append(sentProgress, progress)
+		return nil
+	}, destroyedResourcesActionDescription)
+
+	streamer.ReportProgress(teardown_progress.ResourceType_Container, 0, 2)
+	streamer.ReportLeakedResource(teardown_progress.ResourceType_Container, "postgres--1234", errors.New("device or resource busy"))
+	streamer.ReportProgress(teardown_progress.ResourceType_Container, 1, 2)
+	require.NoError(t, streamer.sendSummary())
+
+	require.Len(t, sentProgress, 3)
+	require.Equal(t, []string{"Removed 0/2 containers"}, sentProgress[0].GetStep().GetCurrentStepInfo())
+	require.Equal(t, uint32(1), sentProgress[1].GetStep().GetCurrentStepNumber())
+	require.Equal(t, uint32(2), sentProgress[1].GetStep().GetTotalSteps())
+	leakedResources := sentProgress[2].GetSummary().GetLeakedResources()
+	require.Len(t, leakedResources, 1)
+	require.Equal(t, "container", leakedResources[0].GetResourceType())
+	require.Equal(t, "postgres--1234", leakedResources[0].GetResourceName())
+	require.Equal(t, "device or resource busy", leakedResources[0].GetError())
+}
+
+func TestEnclaveTeardownProgressStreamer_StopsSendingOnceTheClientIsGone(t *testing.T) {
+	numSendCalls := 0
+	streamer := newEnclaveTeardownProgressStreamer(func(progress *kurtosis_engine_rpc_api_bindings.EnclaveTeardownProgress) error {
+		numSendCalls++
+		return errors.New("the client went away")
+	}, stoppedResourcesActionDescription)
+
+	streamer.ReportProgress(teardown_progress.ResourceType_Container, 0, 2)
+	streamer.ReportProgress(teardown_progress.ResourceType_Container, 1, 2)
+	require.Error(t, streamer.sendSummary())
+	require.Equal(t, 1, numSendCalls)
+}
@@ -5,12 +5,24 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/teardown_progress"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/api_container_clients"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_schedules"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"time"
+)
+
+const (
+	// Past this, the oldest lines get dropped when following logs, or the reading from the logs database waits otherwise
+	maxNumBufferedLogLinesPerStream = 10000
+
+	// Only the enclave scheduler creates enclaves labelled with a schedule
+	noEnclaveScheduleName = ""
 )
 
 type EngineServerService struct {
@@ -27,6 +39,13 @@ type EngineServerService struct {
 
 	//The client for consuming container logs from the logs' database server
 	logsDatabaseClient centralized_logs.LogsDatabaseClient
+
+	logLevelSetter *runtimeLogLevelSetter
+
+	enclaveScheduler *enclave_schedules.EnclaveScheduler
+
+	// For the calls the engine makes to the API containers on behalf of the user, e.g. copying files artifacts between enclaves
+	apiContainerConnector *api_container_clients.ApiContainerConnector
 }
 
 func NewEngineServerService(
@@ -35,6 +54,8 @@ func NewEngineServerService(
 	metricsUserId string,
 	didUserAcceptSendingMetrics bool,
 	logsDatabaseClient centralized_logs.LogsDatabaseClient,
+	enclaveScheduler *enclave_schedules.EnclaveScheduler,
+	apiContainerConnector *api_container_clients.ApiContainerConnector,
 ) *EngineServerService {
 	service := &EngineServerService{
 		imageVersionTag:             imageVersionTag,
@@ -42,6 +63,9 @@ func NewEngineServerService(
 		metricsUserID:               metricsUserId,
 		didUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
 		logsDatabaseClient:          logsDatabaseClient,
+		logLevelSetter:              newRuntimeLogLevelSetter(),
+		enclaveScheduler:            enclaveScheduler,
+		apiContainerConnector:       apiContainerConnector,
 	}
 	return service
 }
@@ -64,9 +88,16 @@ func (service *EngineServerService) CreateEnclave(ctx context.Context, args *kur
 		args.ApiContainerVersionTag,
 		apiContainerLogLevel,
 		args.EnclaveName,
+		noEnclaveScheduleName,
 		args.IsPartitioningEnabled,
 		service.metricsUserID,
 		service.didUserAcceptSendingMetrics,
+		args.GetIpRangeReusePolicy(),
+		args.GetExcludedIpRanges(),
+		args.GetSubnet(),
+		args.GetFilesArtifactsTtlSeconds(),
+		args.GetAllowHostDirMounts(),
+		args.GetIsIpv6Enabled(),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.EnclaveName)
@@ -117,6 +148,36 @@ func (service *EngineServerService) DestroyEnclave(ctx context.Context, args *ku
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineServerService) StopEnclaveWithProgress(args *kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, stream kurtosis_engine_rpc_api_bindings.EngineService_StopEnclaveWithProgressServer) error {
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	progressStreamer := newEnclaveTeardownProgressStreamer(stream.Send, stoppedResourcesActionDescription)
+	ctx := teardown_progress.WithReporter(stream.Context(), progressStreamer)
+	stopErr := service.enclaveManager.StopEnclave(ctx, enclaveIdentifier)
+	if err := progressStreamer.sendSummary(); err != nil {
+		logrus.Warnf("The summary of the stop of enclave '%v' couldn't be sent to the client:\n%v", enclaveIdentifier, err)
+	}
+	if stopErr != nil {
+		return stacktrace.Propagate(stopErr, "An error occurred stopping enclave '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
+func (service *EngineServerService) DestroyEnclaveWithProgress(args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, stream kurtosis_engine_rpc_api_bindings.EngineService_DestroyEnclaveWithProgressServer) error {
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	progressStreamer := newEnclaveTeardownProgressStreamer(stream.Send, destroyedResourcesActionDescription)
+	ctx := teardown_progress.WithReporter(stream.Context(), progressStreamer)
+	destroyErr := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier)
+	if err := progressStreamer.sendSummary(); err != nil {
+		logrus.Warnf("The summary of the destruction of enclave '%v' couldn't be sent to the client:\n%v", enclaveIdentifier, err)
+	}
+	if destroyErr != nil {
+		return stacktrace.Propagate(destroyErr, "An error occurred destroying enclave with identifier '%v'", enclaveIdentifier)
+	}
+	return nil
+}
+
 func (service *EngineServerService) Clean(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CleanArgs) (*kurtosis_engine_rpc_api_bindings.CleanResponse, error) {
 	removedEnclaveUuidsAndNames, err := service.enclaveManager.Clean(ctx, args.ShouldCleanAll)
 	if err != nil {
@@ -127,6 +188,26 @@ func (service *EngineServerService) Clean(ctx context.Context, args *kurtosis_en
 	return response, nil
 }
 
+func (service *EngineServerService) GetPulledImages(ctx context.Context, _ *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetPulledImagesResponse, error) {
+	pulledImages, err := service.enclaveManager.GetPulledImages(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the images pulled for enclaves")
+	}
+	return &kurtosis_engine_rpc_api_bindings.GetPulledImagesResponse{PulledImages: pulledImages}, nil
+}
+
+func (service *EngineServerService) PruneImages(ctx context.Context, _ *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.PruneImagesResponse, error) {
+	removedImages, removalErrorsByImage, err := service.enclaveManager.PruneImages(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred pruning the images pulled for enclaves")
+	}
+	response := &kurtosis_engine_rpc_api_bindings.PruneImagesResponse{
+		RemovedImages:        removedImages,
+		RemovalErrorsByImage: removalErrorsByImage,
+	}
+	return response, nil
+}
+
 func (service *EngineServerService) GetServiceLogs(
 	args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs,
 	stream kurtosis_engine_rpc_api_bindings.EngineService_GetServiceLogsServer,
@@ -167,15 +248,21 @@ func (service *EngineServerService) GetServiceLogs(
 		return stacktrace.Propagate(err, "An error occurred creating the conjunctive log line filters from the GRPC's conjunctive log line filters '%+v'", args.GetConjunctiveFilters())
 	}
 
-	serviceLogsByServiceUuidChan, errChan, cancelCtxFunc, err = service.logsDatabaseClient.StreamUserServiceLogs(stream.Context(), enclaveUuid, requestedServiceUuids, conjunctiveLogLineFilters, shouldFollowLogs)
+	timeRange, err := newServiceLogsTimeRangeFromGRPCArgs(args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the time range of the logs from the GRPC's args")
+	}
+
+	serviceLogsByServiceUuidChan, errChan, cancelCtxFunc, err = service.logsDatabaseClient.StreamUserServiceLogs(stream.Context(), enclaveUuid, requestedServiceUuids, conjunctiveLogLineFilters, shouldFollowLogs, timeRange)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
 			"An error occurred streaming service logs for UUIDs '%+v' in enclave with ID '%v' using filters '%v+' "+
-				"and with should follow logs value as '%v'",
+				"within time range '%+v' and with should follow logs value as '%v'",
 			requestedServiceUuids,
 			enclaveUuid,
 			conjunctiveLogLineFilters,
+			timeRange,
 			shouldFollowLogs,
 		)
 	}
@@ -185,20 +272,54 @@ func (service *EngineServerService) GetServiceLogs(
 		}
 	}()
 
+	// The logs get read into a bounded buffer as fast as the logs database client provides them, and get sent from it as
+	// fast as the client receives them, so a slow client can't make the engine drop the stream
+	logsBuffer := newServiceLogsStreamBuffer(maxNumBufferedLogLinesPerStream, shouldFollowLogs)
+	defer logsBuffer.close()
+	logsBufferingDoneChan := make(chan struct{})
+	go func() {
+		defer close(logsBufferingDoneChan)
+		for {
+			select {
+			case serviceLogsByServiceUuid, isChanOpen := <-serviceLogsByServiceUuidChan:
+				//If the channel is closed means that the logs database client won't continue sending streams
+				if !isChanOpen {
+					logrus.Debug("Stopping the buffering of the logs after receiving a close signal from the service logs by service UUID channel")
+					return
+				}
+				logsBuffer.add(serviceLogsByServiceUuid)
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	sendBufferedLogs := func() error {
+		serviceLogsByServiceUuid, numDroppedLogLinesByServiceUuid := logsBuffer.take()
+		if len(serviceLogsByServiceUuid) == 0 && len(numDroppedLogLinesByServiceUuid) == 0 {
+			return nil
+		}
+		for serviceUuid, numDroppedLogLines := range numDroppedLogLinesByServiceUuid {
+			logrus.Debugf("Dropped %v log lines of service '%v' because the client couldn't keep up with them", numDroppedLogLines, serviceUuid)
+		}
+		getServiceLogsResponse := newLogsResponse(requestedServiceUuids, serviceLogsByServiceUuid, notFoundServiceUuids, numDroppedLogLinesByServiceUuid)
+		if err := stream.Send(getServiceLogsResponse); err != nil {
+			return stacktrace.Propagate(err, "An error occurred sending the stream logs for service logs response '%+v'", getServiceLogsResponse)
+		}
+		return nil
+	}
+
 	for {
 		select {
 		//stream case
-		case serviceLogsByServiceUuid, isChanOpen := <-serviceLogsByServiceUuidChan:
-			//If the channel is closed means that the logs database client won't continue sending streams
-			if !isChanOpen {
-				logrus.Debug("Exiting the stream loop after receiving a close signal from the service logs by service UUID channel")
-				return nil
-			}
-
-			getServiceLogsResponse := newLogsResponse(requestedServiceUuids, serviceLogsByServiceUuid, notFoundServiceUuids)
-			if err := stream.Send(getServiceLogsResponse); err != nil {
-				return stacktrace.Propagate(err, "An error occurred sending the stream logs for service logs response '%+v'", getServiceLogsResponse)
+		case <-logsBuffer.getHasLogLinesChan():
+			if err := sendBufferedLogs(); err != nil {
+				return err
 			}
+		//the logs database client won't send more logs, so whatever is left in the buffer is the last of them
+		case <-logsBufferingDoneChan:
+			logrus.Debug("Exiting the stream loop after the buffering of the logs is done")
+			return sendBufferedLogs()
 		//client cancel ctx case
 		case <-stream.Context().Done():
 			logrus.Debug("The user service logs stream has done")
@@ -216,11 +337,121 @@ func (service *EngineServerService) GetServiceLogs(
 
 }
 
+func (service *EngineServerService) GetEnclaveLifecycleEvents(_ *emptypb.Empty, stream kurtosis_engine_rpc_api_bindings.EngineService_GetEnclaveLifecycleEventsServer) error {
+	eventsChan, unsubscribe := service.enclaveManager.SubscribeToEnclaveLifecycleEvents()
+	defer unsubscribe()
+
+	for {
+		select {
+		case event := <-eventsChan:
+			if err := stream.Send(event); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending enclave lifecycle event '%+v'", event)
+			}
+		case <-stream.Context().Done():
+			logrus.Debug("The enclave lifecycle events stream has done")
+			return nil
+		}
+	}
+}
+
+func (service *EngineServerService) CopyFilesArtifact(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.CopyFilesArtifactArgs) (*kurtosis_engine_rpc_api_bindings.CopyFilesArtifactResponse, error) {
+	sourceEnclaveInfo, err := service.getEnclaveInfo(ctx, args.GetSourceEnclaveIdentifier())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting source enclave '%v'", args.GetSourceEnclaveIdentifier())
+	}
+	destinationEnclaveInfo, err := service.getEnclaveInfo(ctx, args.GetDestinationEnclaveIdentifier())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting destination enclave '%v'", args.GetDestinationEnclaveIdentifier())
+	}
+
+	sourceApiContainerClient, closeSourceApiContainerConnFunc, err := service.apiContainerConnector.Connect(sourceEnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of source enclave '%v'", sourceEnclaveInfo.GetName())
+	}
+	defer closeSourceApiContainerConnFunc()
+	destinationApiContainerClient, closeDestinationApiContainerConnFunc, err := service.apiContainerConnector.Connect(destinationEnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the API container of destination enclave '%v'", destinationEnclaveInfo.GetName())
+	}
+	defer closeDestinationApiContainerConnFunc()
+
+	filesArtifactUuid, filesArtifactName, wasAlreadyStored, err := api_container_clients.CopyFilesArtifact(
+		ctx,
+		sourceApiContainerClient,
+		destinationApiContainerClient,
+		args.GetFilesArtifactIdentifier(),
+		args.GetDestinationFilesArtifactName(),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred copying files artifact '%v' from enclave '%v' to enclave '%v'",
+			args.GetFilesArtifactIdentifier(),
+			sourceEnclaveInfo.GetName(),
+			destinationEnclaveInfo.GetName(),
+		)
+	}
+	return &kurtosis_engine_rpc_api_bindings.CopyFilesArtifactResponse{
+		FilesArtifactUuid: filesArtifactUuid,
+		FilesArtifactName: filesArtifactName,
+		WasAlreadyStored:  wasAlreadyStored,
+	}, nil
+}
+
+func (service *EngineServerService) SetLogLevel(_ context.Context, args *kurtosis_engine_rpc_api_bindings.SetLogLevelArgs) (*kurtosis_engine_rpc_api_bindings.SetLogLevelResponse, error) {
+	revertAfter := time.Duration(args.GetRevertAfterSeconds()) * time.Second
+	previousLogLevel, err := service.logLevelSetter.setLogLevel(args.GetLogLevel(), revertAfter)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred setting the log level of the engine to '%v'", args.GetLogLevel())
+	}
+	return &kurtosis_engine_rpc_api_bindings.SetLogLevelResponse{PreviousLogLevel: previousLogLevel.String()}, nil
+}
+
+func (service *EngineServerService) AddEnclaveSchedule(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.AddEnclaveScheduleArgs) (*emptypb.Empty, error) {
+	if err := service.enclaveScheduler.AddSchedule(ctx, args.GetSchedule()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding enclave schedule '%v'", args.GetSchedule().GetName())
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (service *EngineServerService) GetEnclaveSchedules(ctx context.Context, _ *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetEnclaveSchedulesResponse, error) {
+	schedules, err := service.enclaveScheduler.GetSchedules(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave schedules")
+	}
+	return &kurtosis_engine_rpc_api_bindings.GetEnclaveSchedulesResponse{
+		Schedules: schedules,
+	}, nil
+}
+
+func (service *EngineServerService) RemoveEnclaveSchedule(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.RemoveEnclaveScheduleArgs) (*emptypb.Empty, error) {
+	if err := service.enclaveScheduler.RemoveSchedule(ctx, args.GetName(), args.GetShouldDestroyEnclaves()); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing enclave schedule '%v'", args.GetName())
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
 //
 // ====================================================================================================
+func (service *EngineServerService) getEnclaveInfo(ctx context.Context, enclaveIdentifier string) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of enclave '%v'", enclaveIdentifier)
+	}
+	enclaveInfos, err := service.enclaveManager.GetEnclaves(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the enclaves")
+	}
+	enclaveInfo, found := enclaveInfos[string(enclaveUuid)]
+	if !found {
+		return nil, stacktrace.NewError("Enclave '%v' doesn't exist anymore", enclaveIdentifier)
+	}
+	return enclaveInfo, nil
+}
+
 func (service *EngineServerService) reportAnyMissingUuidsAndGetNotFoundUuidsList(
 	enclaveUuid enclave.EnclaveUUID,
 	requestedServiceUuids map[user_service.ServiceUUID]bool,
@@ -240,7 +471,7 @@ func (service *EngineServerService) reportAnyMissingUuidsAndGetNotFoundUuidsList
 
 	emptyServiceLogsByServiceUuid := map[user_service.ServiceUUID][]logline.LogLine{}
 
-	getServiceLogsResponse := newLogsResponse(requestedServiceUuids, emptyServiceLogsByServiceUuid, notFoundServiceUuids)
+	getServiceLogsResponse := newLogsResponse(requestedServiceUuids, emptyServiceLogsByServiceUuid, notFoundServiceUuids, map[user_service.ServiceUUID]uint32{})
 	if err := stream.Send(getServiceLogsResponse); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred sending the stream logs for service logs response '%+v'", getServiceLogsResponse)
 	}
@@ -252,6 +483,7 @@ func newLogsResponse(
 	requestedServiceUuids map[user_service.ServiceUUID]bool,
 	serviceLogsByServiceUuid map[user_service.ServiceUUID][]logline.LogLine,
 	notFoundServiceUuids map[string]bool,
+	numDroppedLogLinesByServiceUuid map[user_service.ServiceUUID]uint32,
 ) *kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse {
 	serviceLogLinesByUuid := make(map[string]*kurtosis_engine_rpc_api_bindings.LogLine, len(serviceLogsByServiceUuid))
 
@@ -279,9 +511,15 @@ func newLogsResponse(
 		serviceLogLinesByUuid[serviceUuidStr] = logLines
 	}
 
+	numDroppedLogLinesByServiceUuidStr := make(map[string]uint32, len(numDroppedLogLinesByServiceUuid))
+	for serviceUuid, numDroppedLogLines := range numDroppedLogLinesByServiceUuid {
+		numDroppedLogLinesByServiceUuidStr[string(serviceUuid)] = numDroppedLogLines
+	}
+
 	getServiceLogsResponse := &kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse{
-		ServiceLogsByServiceUuid: serviceLogLinesByUuid,
-		NotFoundServiceUuidSet:   notFoundServiceUuids,
+		ServiceLogsByServiceUuid:        serviceLogLinesByUuid,
+		NotFoundServiceUuidSet:          notFoundServiceUuids,
+		NumDroppedLogLinesByServiceUuid: numDroppedLogLinesByServiceUuidStr,
 	}
 	return getServiceLogsResponse
 }
@@ -341,3 +579,17 @@ func newConjunctiveLogLineFiltersFromGRPCLogLineFilters(
 
 	return conjunctiveLogLineFilters, nil
 }
+
+func newServiceLogsTimeRangeFromGRPCArgs(args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs) (*user_service.ServiceLogsTimeRange, error) {
+	var since, until time.Time
+	if args.Since != nil {
+		since = args.GetSince().AsTime()
+	}
+	if args.Until != nil {
+		until = args.GetUntil().AsTime()
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return nil, stacktrace.NewError("The end of the logs time range '%v' is before its start '%v'", until, since)
+	}
+	return user_service.NewServiceLogsTimeRange(since, until), nil
+}
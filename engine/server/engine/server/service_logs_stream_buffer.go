@@ -0,0 +1,119 @@
+package server
+
+import (
+	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"sync"
+)
+
+type bufferedLogLine struct {
+	serviceUuid user_service.ServiceUUID
+	logLine     logline.LogLine
+}
+
+// serviceLogsStreamBuffer sits between the logs database client and the gRPC stream, so that a client consuming the
+// logs slower than they get produced neither makes the engine hold an unbounded amount of them in memory, nor stalls
+// the reading from the logs database
+// When it's full, it either drops the oldest lines (which is what we want when following logs, as the newest lines are
+// the interesting ones) or makes the writer wait until the lines get taken (which is what we want when dumping logs,
+// as every line is expected to be there)
+type serviceLogsStreamBuffer struct {
+	mutex *sync.Mutex
+
+	// Gets signalled when lines are taken or the buffer gets closed
+	spaceAvailableCond *sync.Cond
+
+	maxNumBufferedLines int
+
+	shouldDropOldestLinesWhenFull bool
+
+	// In the order they got received, across all the services
+	bufferedLogLines []bufferedLogLine
+
+	numDroppedLogLinesByServiceUuid map[user_service.ServiceUUID]uint32
+
+	// Has a value whenever there are buffered lines to take
+	hasLogLinesChan chan struct{}
+
+	isClosed bool
+}
+
+func newServiceLogsStreamBuffer(maxNumBufferedLines int, shouldDropOldestLinesWhenFull bool) *serviceLogsStreamBuffer {
+	mutex := &sync.Mutex{}
+	return &serviceLogsStreamBuffer{
+		mutex:                           mutex,
+		spaceAvailableCond:              sync.NewCond(mutex),
+		maxNumBufferedLines:             maxNumBufferedLines,
+		shouldDropOldestLinesWhenFull:   shouldDropOldestLinesWhenFull,
+		bufferedLogLines:                []bufferedLogLine{},
+		numDroppedLogLinesByServiceUuid: map[user_service.ServiceUUID]uint32{},
+		hasLogLinesChan:                 make(chan struct{}, 1),
+		isClosed:                        false,
+	}
+}
+
+// add buffers the log lines; it only blocks if the buffer doesn't drop lines and is full
+func (buffer *serviceLogsStreamBuffer) add(serviceLogsByServiceUuid map[user_service.ServiceUUID][]logline.LogLine) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	for serviceUuid, logLines := range serviceLogsByServiceUuid {
+		for _, logLine := range logLines {
+			for len(buffer.bufferedLogLines) >= buffer.maxNumBufferedLines && !buffer.shouldDropOldestLinesWhenFull && !buffer.isClosed {
+				buffer.spaceAvailableCond.Wait()
+			}
+			if buffer.isClosed {
+				return
+			}
+			if len(buffer.bufferedLogLines) >= buffer.maxNumBufferedLines {
+				droppedLogLine := buffer.bufferedLogLines[0]
+				buffer.bufferedLogLines = buffer.bufferedLogLines[1:]
+				buffer.numDroppedLogLinesByServiceUuid[droppedLogLine.serviceUuid]++
+			}
+			buffer.bufferedLogLines = append(buffer.bufferedLogLines, bufferedLogLine{
+				serviceUuid: serviceUuid,
+				logLine:     logLine,
+			})
+			buffer.signalHasLogLines()
+		}
+	}
+}
+
+// take empties the buffer, returning the lines it had grouped by service along with how many were dropped since the
+// previous call
+func (buffer *serviceLogsStreamBuffer) take() (map[user_service.ServiceUUID][]logline.LogLine, map[user_service.ServiceUUID]uint32) {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+
+	serviceLogsByServiceUuid := map[user_service.ServiceUUID][]logline.LogLine{}
+	for _, bufferedLine := range buffer.bufferedLogLines {
+		serviceLogsByServiceUuid[bufferedLine.serviceUuid] = append(serviceLogsByServiceUuid[bufferedLine.serviceUuid], bufferedLine.logLine)
+	}
+	numDroppedLogLinesByServiceUuid := buffer.numDroppedLogLinesByServiceUuid
+
+	buffer.bufferedLogLines = []bufferedLogLine{}
+	buffer.numDroppedLogLinesByServiceUuid = map[user_service.ServiceUUID]uint32{}
+	buffer.spaceAvailableCond.Broadcast()
+	return serviceLogsByServiceUuid, numDroppedLogLinesByServiceUuid
+}
+
+// getHasLogLinesChan returns a channel that receives a value when there are lines to take
+func (buffer *serviceLogsStreamBuffer) getHasLogLinesChan() <-chan struct{} {
+	return buffer.hasLogLinesChan
+}
+
+// close unblocks any pending add, and makes the following ones no-ops
+func (buffer *serviceLogsStreamBuffer) close() {
+	buffer.mutex.Lock()
+	defer buffer.mutex.Unlock()
+	buffer.isClosed = true
+	buffer.spaceAvailableCond.Broadcast()
+}
+
+func (buffer *serviceLogsStreamBuffer) signalHasLogLines() {
+	select {
+	case buffer.hasLogLinesChan <- struct{}{}:
+	default:
+		// A signal is already pending
+	}
+}
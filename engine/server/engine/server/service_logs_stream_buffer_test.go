@@ -0,0 +1,84 @@
+package server
+
+import (
+	user_service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	testServiceUuid = user_service.ServiceUUID("test-service-uuid")
+
+	testMaxNumBufferedLines = 3
+)
+
+func TestServiceLogsStreamBuffer_DropsOldestLinesWhenFull(t *testing.T) {
+	buffer := newServiceLogsStreamBuffer(testMaxNumBufferedLines, true)
+	buffer.add(newTestServiceLogs("1", "2", "3", "4", "5"))
+
+	serviceLogs, numDroppedLogLines := buffer.take()
+	require.Equal(t, newTestServiceLogs("3", "4", "5"), serviceLogs)
+	require.Equal(t, uint32(2), numDroppedLogLines[testServiceUuid])
+
+	// The dropped lines only get reported once
+	buffer.add(newTestServiceLogs("6"))
+	serviceLogs, numDroppedLogLines = buffer.take()
+	require.Equal(t, newTestServiceLogs("6"), serviceLogs)
+	require.Empty(t, numDroppedLogLines)
+}
+
+func TestServiceLogsStreamBuffer_WaitsWhenFullIfNotDropping(t *testing.T) {
+	buffer := newServiceLogsStreamBuffer(testMaxNumBufferedLines, false)
+	addDoneChan := make(chan struct{})
+	go func() {
+		defer close(addDoneChan)
+		buffer.add(newTestServiceLogs("1", "2", "3", "4"))
+	}()
+
+	<-buffer.getHasLogLinesChan()
+	allServiceLogs := []logline.LogLine{}
+	for {
+		serviceLogs, numDroppedLogLines := buffer.take()
+		require.Empty(t, numDroppedLogLines)
+		allServiceLogs = append(allServiceLogs, serviceLogs[testServiceUuid]...)
+		if len(allServiceLogs) == 4 {
+			break
+		}
+		select {
+		case <-buffer.getHasLogLinesChan():
+		case <-time.After(time.Second):
+			t.Fatal("Expected the blocked lines to get buffered after taking the buffered ones")
+		}
+	}
+	<-addDoneChan
+	require.Equal(t, newTestServiceLogs("1", "2", "3", "4")[testServiceUuid], allServiceLogs)
+}
+
+func TestServiceLogsStreamBuffer_CloseUnblocksAdd(t *testing.T) {
+	buffer := newServiceLogsStreamBuffer(testMaxNumBufferedLines, false)
+	addDoneChan := make(chan struct{})
+	go func() {
+		defer close(addDoneChan)
+		buffer.add(newTestServiceLogs("1", "2", "3", "4"))
+	}()
+
+	<-buffer.getHasLogLinesChan()
+	buffer.close()
+	select {
+	case <-addDoneChan:
+	case <-time.After(time.Second):
+		t.Fatal("Expected closing the buffer to unblock the pending add")
+	}
+}
+
+func newTestServiceLogs(contents ...string) map[user_service.ServiceUUID][]logline.LogLine {
+	logLines := []logline.LogLine{}
+	for _, content := range contents {
+		logLines = append(logLines, *logline.NewLogLine(content))
+	}
+	return map[user_service.ServiceUUID][]logline.LogLine{
+		testServiceUuid: logLines,
+	}
+}
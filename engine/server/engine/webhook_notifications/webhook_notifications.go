@@ -0,0 +1,99 @@
+package webhook_notifications
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/engine/launcher/webhook_notifier"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	// Webhooks get called in the background, so a slow webhook can't slow down the engine
+	backgroundNotificationTimeout = 30 * time.Second
+)
+
+var webhookEventTypesByEnclaveLifecycleEventType = map[kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType]webhook_notifier.EventType{
+	kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_CREATED:       webhook_notifier.EventType_EnclaveCreated,
+	kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_STOPPED:       webhook_notifier.EventType_EnclaveStopped,
+	kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_DESTROYED:     webhook_notifier.EventType_EnclaveDestroyed,
+	kurtosis_engine_rpc_api_bindings.EnclaveLifecycleEventType_EnclaveLifecycleEventType_SERVICE_ADDED: webhook_notifier.EventType_ServiceAdded,
+}
+
+// StartForwardingEnclaveLifecycleEvents sends the enclave lifecycle events to the webhooks until the returned function
+// gets called
+func StartForwardingEnclaveLifecycleEvents(enclaveManager *enclave_manager.EnclaveManager, notifier *webhook_notifier.WebhookNotifier) func() {
+	isAnyLifecycleEventNotified := false
+	for _, webhookEventType := range webhookEventTypesByEnclaveLifecycleEventType {
+		isAnyLifecycleEventNotified = isAnyLifecycleEventNotified || notifier.IsNotifying(webhookEventType)
+	}
+	if !isAnyLifecycleEventNotified {
+		return func() {}
+	}
+
+	eventsChan, unsubscribe := enclaveManager.SubscribeToEnclaveLifecycleEvents()
+	go func() {
+		for lifecycleEvent := range eventsChan {
+			webhookEventType, found := webhookEventTypesByEnclaveLifecycleEventType[lifecycleEvent.GetEventType()]
+			if !found {
+				logrus.Warnf("Enclave lifecycle event type '%v' has no webhook event type; this is a bug in Kurtosis", lifecycleEvent.GetEventType())
+				continue
+			}
+			notifyInBackground(notifier, &webhook_notifier.Event{
+				Type:        webhookEventType,
+				Timestamp:   lifecycleEvent.GetTimestamp().AsTime(),
+				EnclaveUuid: lifecycleEvent.GetEnclaveUuid(),
+				EnclaveName: lifecycleEvent.GetEnclaveName(),
+				ServiceName: lifecycleEvent.GetServiceName(),
+				ServiceUuid: lifecycleEvent.GetServiceUuid(),
+				PackageId:   "",
+				IsSuccess:   false,
+				Message:     "",
+			})
+		}
+	}()
+	return unsubscribe
+}
+
+// EngineErrorsHook is a logrus hook that sends the errors logged by the engine to the webhooks
+type EngineErrorsHook struct {
+	notifier *webhook_notifier.WebhookNotifier
+}
+
+func NewEngineErrorsHook(notifier *webhook_notifier.WebhookNotifier) *EngineErrorsHook {
+	return &EngineErrorsHook{notifier: notifier}
+}
+
+func (hook *EngineErrorsHook) Levels() []logrus.Level {
+	if !hook.notifier.IsNotifying(webhook_notifier.EventType_EngineError) {
+		return []logrus.Level{}
+	}
+	return []logrus.Level{logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel}
+}
+
+func (hook *EngineErrorsHook) Fire(entry *logrus.Entry) error {
+	notifyInBackground(hook.notifier, &webhook_notifier.Event{
+		Type:        webhook_notifier.EventType_EngineError,
+		Timestamp:   entry.Time,
+		EnclaveUuid: "",
+		EnclaveName: "",
+		ServiceName: "",
+		ServiceUuid: "",
+		PackageId:   "",
+		IsSuccess:   false,
+		Message:     entry.Message,
+	})
+	return nil
+}
+
+// Failures only get logged as warnings, which don't fire the engine errors hook so they can't loop
+func notifyInBackground(notifier *webhook_notifier.WebhookNotifier, event *webhook_notifier.Event) {
+	go func() {
+		ctx, cancelFunc := context.WithTimeout(context.Background(), backgroundNotificationTimeout)
+		defer cancelFunc()
+		if err := notifier.Notify(ctx, event); err != nil {
+			logrus.Warnf("An error occurred notifying the webhooks of event '%v':\n%v", event.Type, err)
+		}
+	}()
+}
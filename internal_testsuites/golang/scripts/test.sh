@@ -10,7 +10,8 @@ lang_root_dirpath="$(dirname "${script_dirpath}")"
 # ==================================================================================================
 #                                             Constants
 # ==================================================================================================
-PARALLELISM=2
+DEFAULT_PARALLELISM=2
+DEFAULT_MAX_RETRIES=0
 DOCKER_TIMEOUT="3m"   # This must be Go-parseable timeout
 KUBERNETES_TIMEOUT="6m" # K8S takes longer than docker
 
@@ -28,6 +29,14 @@ show_helptext_and_exit() {
     echo ""
     echo "  cli_cluster_backend_arg   Optional argument describing the cluster backend tests are running against. Must be one of 'docker', 'minikube' (default: ${DEFAULT_TESTSUITE_CLUSTER_BACKEND})"
     echo ""
+    echo "  Environment variables:"
+    echo "    TESTSUITE_PARALLELISM   Maximum number of test packages run concurrently, each test using its own enclave (default: ${DEFAULT_PARALLELISM})"
+    echo "    TESTSUITE_RUN_PATTERN   Only run the tests whose names match this Go regex (same syntax as 'go test -run')"
+    echo "    TESTSUITE_SKIP_PATTERN  Skip the tests whose names match this Go regex (same syntax as 'go test -skip')"
+    echo "    TESTSUITE_TAGS          Comma-separated build tags selecting additional tagged tests"
+    echo "    TESTSUITE_MAX_RETRIES   Number of times failed test packages get retried; packages passing on retry are reported as flaky (default: ${DEFAULT_MAX_RETRIES})"
+    echo "    TESTSUITE_RESULTS_DIRPATH  If set, the testsuite output and a dump of the enclave of every failed test get collected in this directory"
+    echo ""
     exit 1  # Exit with an error so that if this is accidentally called by CI, the script will fail
 }
 
@@ -38,21 +47,104 @@ if [ "${testsuite_cluster_backend_arg}" != "${TESTSUITE_CLUSTER_BACKEND_DOCKER}"
     show_helptext_and_exit
 fi
 
+parallelism="${TESTSUITE_PARALLELISM:-${DEFAULT_PARALLELISM}}"
+if ! [[ "${parallelism}" =~ ^[1-9][0-9]*$ ]]; then
+    echo "Error: TESTSUITE_PARALLELISM must be a positive integer but was '${parallelism}'"
+    show_helptext_and_exit
+fi
+
+max_retries="${TESTSUITE_MAX_RETRIES:-${DEFAULT_MAX_RETRIES}}"
+if ! [[ "${max_retries}" =~ ^[0-9]+$ ]]; then
+    echo "Error: TESTSUITE_MAX_RETRIES must be a non-negative integer but was '${max_retries}'"
+    show_helptext_and_exit
+fi
+
 # ==================================================================================================
 #                                             Main Logic
 # ==================================================================================================
 
+# The tests of each package run in the package directory so they need an absolute path to collect their results in
+if [ -n "${TESTSUITE_RESULTS_DIRPATH:-}" ]; then
+    mkdir -p "${TESTSUITE_RESULTS_DIRPATH}"
+    TESTSUITE_RESULTS_DIRPATH="$(cd "${TESTSUITE_RESULTS_DIRPATH}" && pwd)"
+    export TESTSUITE_RESULTS_DIRPATH
+fi
+
 cd "${lang_root_dirpath}"
 go build ./...
 
 # The count=1 disables caching for the testsuite (which we want, because the engine server might have changed even though the test code didn't)
+go_test_args=(-p "${parallelism}" -count=1)
+build_tags="${TESTSUITE_TAGS:-}"
 if [ "${testsuite_cluster_backend_arg}" == "${TESTSUITE_CLUSTER_BACKEND_MINIKUBE}" ]; then
     # TODO This should be removed! Go Kurtosis tests should be completely agnostic to the backend they're running against
     # The only reason this exists is because, as of 2022-10-28, network partitioning doesn't work on Kubernetes so we have to know to skip
     #  those tests
     # K8S is also slower than docker, so they have different timeouts
-    CGO_ENABLED=0 go test ./... -p "${PARALLELISM}" -count=1 -timeout "${KUBERNETES_TIMEOUT}" -tags minikube
+    go_test_args+=(-timeout "${KUBERNETES_TIMEOUT}")
+    build_tags="minikube${build_tags:+,${build_tags}}"
 else
-    CGO_ENABLED=0 go test ./... -p "${PARALLELISM}" -count=1 -timeout "${DOCKER_TIMEOUT}"
+    go_test_args+=(-timeout "${DOCKER_TIMEOUT}")
+fi
+if [ -n "${build_tags}" ]; then
+    go_test_args+=(-tags "${build_tags}")
 fi
+if [ -n "${TESTSUITE_RUN_PATTERN:-}" ]; then
+    go_test_args+=(-run "${TESTSUITE_RUN_PATTERN}")
+fi
+if [ -n "${TESTSUITE_SKIP_PATTERN:-}" ]; then
+    go_test_args+=(-skip "${TESTSUITE_SKIP_PATTERN}")
+fi
+
+test_output_filepath="$(mktemp)"
+trap 'rm -f "${test_output_filepath}"' EXIT
+
+# Runs the given packages, printing the output and leaving the packages that failed in the failed_packages variable
+run_test_packages() {
+    failed_packages=()
+    if CGO_ENABLED=0 go test "${go_test_args[@]}" "${@}" 2>&1 | tee "${test_output_filepath}"; then
+        return 0
+    fi
+    if [ -n "${TESTSUITE_RESULTS_DIRPATH:-}" ]; then
+        cat "${test_output_filepath}" >> "${TESTSUITE_RESULTS_DIRPATH}/testsuite-output.log"
+    fi
+    while read -r failed_package; do
+        failed_packages+=("${failed_package}")
+    done < <(grep -E '^FAIL[[:space:]]+[^[:space:]]+' "${test_output_filepath}" | awk '{print $2}' | sort -u)
+    if [ "${#failed_packages[@]}" -eq 0 ]; then
+        echo "Error: The testsuite failed without any failed test package being reported; this usually means it didn't compile" >&2
+        exit 1
+    fi
+    return 1
+}
 
+if run_test_packages ./...; then
+    exit 0
+fi
+
+flaky_packages=()
+for (( attempt=1; attempt<=max_retries; attempt++ )); do
+    packages_to_retry=("${failed_packages[@]}")
+    echo "Retrying failed test packages (attempt ${attempt}/${max_retries}): ${packages_to_retry[*]}"
+    if run_test_packages "${packages_to_retry[@]}"; then
+        flaky_packages+=("${packages_to_retry[@]}")
+        break
+    fi
+    for package in "${packages_to_retry[@]}"; do
+        if ! [[ " ${failed_packages[*]} " == *" ${package} "* ]]; then
+            flaky_packages+=("${package}")
+        fi
+    done
+done
+
+echo ""
+if [ "${#flaky_packages[@]}" -gt 0 ]; then
+    echo "Flaky test packages (failed, then passed on retry):"
+    printf '  %s\n' "${flaky_packages[@]}"
+fi
+if [ "${#failed_packages[@]}" -eq 0 ]; then
+    exit 0
+fi
+echo "Failed test packages:"
+printf '  %s\n' "${failed_packages[@]}"
+exit 1
@@ -15,6 +15,12 @@ const (
 	testsuiteNameEnclaveIDFragment = "go-testsuite"
 
 	millisInNanos = 1000
+
+	defaultPerTestTimeout = 5 * time.Minute
+
+	// How long before the deadline of the whole test binary (set by 'go test -timeout') a test gets timed out, so that
+	// it still has time to tear down before 'go test' kills the binary without running any cleanup
+	testBinaryDeadlineTeardownMargin = 20 * time.Second
 )
 
 func CreateEnclave(t *testing.T, ctx context.Context, testName string, isPartitioningEnabled bool) (resultEnclaveCtx *enclaves.EnclaveContext, resultStopEnclaveFunc func(), resultDestroyEnclaveFunc func() error, resultErr error) {
@@ -45,5 +51,47 @@ func CreateEnclave(t *testing.T, ctx context.Context, testName string, isPartiti
 		return nil
 	}
 
+	collectResultsIfTestFails(t, enclaveName)
+	enforcePerTestTimeout(t, testName, enclaveName, kurtosisCtx)
+
 	return enclaveCtx, stopEnclaveFunc, destroyEnclaveFunc, nil
 }
+
+// Hung services or Starlark runs would otherwise block the test until 'go test' kills the whole test binary. When the
+// test exceeds its timeout, its enclave gets dumped for debugging and then destroyed, which unblocks whatever the test
+// is waiting on. The test only gets failed from its own goroutine, once that's done
+func enforcePerTestTimeout(t *testing.T, testName string, enclaveName string, kurtosisCtx *kurtosis_context.KurtosisContext) {
+	timeout := defaultPerTestTimeout
+	if testBinaryDeadline, found := t.Deadline(); found {
+		timeUntilTestBinaryDeadline := time.Until(testBinaryDeadline) - testBinaryDeadlineTeardownMargin
+		if timeUntilTestBinaryDeadline < timeout {
+			timeout = timeUntilTestBinaryDeadline
+		}
+	}
+	fullTestName := t.Name()
+	// Closed once the enclave of the timed out test got dumped and destroyed
+	timeoutHandledChan := make(chan struct{})
+	timeoutTimer := time.AfterFunc(timeout, func() {
+		defer close(timeoutHandledChan)
+		logrus.Errorf("Test '%v' timed out after %v; dumping then destroying enclave '%v' so that it can't block the test any longer", testName, timeout, enclaveName)
+		enclaveDumpDirpath, err := dumpEnclaveOfTimedOutTest(fullTestName, enclaveName)
+		if err != nil {
+			logrus.Errorf("An error occurred dumping enclave '%v' of timed out test '%v':\n%v", enclaveName, testName, err)
+		} else {
+			logrus.Infof("Dumped enclave '%v' of timed out test '%v' to '%v'", enclaveName, testName, enclaveDumpDirpath)
+		}
+		// A fresh context is used as the test one might be the one that's blocked
+		if err := kurtosisCtx.DestroyEnclave(context.Background(), enclaveName); err != nil {
+			logrus.Errorf("An error occurred destroying enclave '%v' of timed out test '%v':\n%v", enclaveName, testName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to destroy enclave '%v' manually!!!!", enclaveName)
+		}
+	})
+	t.Cleanup(func() {
+		if timeoutTimer.Stop() {
+			return
+		}
+		// Stopping the timer doesn't wait for a callback that's already running
+		<-timeoutHandledChan
+		t.Errorf("Test '%v' timed out after %v, so its enclave '%v' got dumped and destroyed", testName, timeout, enclaveName)
+	})
+}
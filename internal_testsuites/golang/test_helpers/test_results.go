@@ -0,0 +1,96 @@
+package test_helpers
+
+import (
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	// If set, the results of failed tests get collected in a subdirectory of this directory named after the test
+	testResultsDirpathEnvVar = "TESTSUITE_RESULTS_DIRPATH"
+
+	// Path to the Kurtosis CLI binary used to dump enclaves, defaulting to the one on the PATH
+	kurtosisBinpathEnvVar  = "KURTOSIS_BINPATH"
+	defaultKurtosisBinpath = "kurtosis"
+
+	enclaveDumpDirname = "enclave-dump"
+
+	testResultsDirPerms = 0755
+
+	// Subtest names contain slashes, which we don't want to turn into nested directories
+	testNameSeparator           = "/"
+	testResultsDirnameSeparator = "_"
+
+	// Used when no results directory was given, so that the enclave of a timed out test still gets dumped somewhere
+	timedOutTestResultsDirPattern = "timed-out-test-results-*"
+)
+
+// collectResultsIfTestFails registers a cleanup that, if the test failed, dumps its enclave (services' logs and
+// container specs) in the test results directory so that failures can be debugged without rerunning the test
+func collectResultsIfTestFails(t *testing.T, enclaveName string) {
+	testResultsDirpath := os.Getenv(testResultsDirpathEnvVar)
+	if testResultsDirpath == "" {
+		return
+	}
+	t.Cleanup(func() {
+		if !t.Failed() {
+			return
+		}
+		enclaveDumpDirpath := getEnclaveDumpDirpath(testResultsDirpath, t.Name())
+		if _, err := os.Stat(enclaveDumpDirpath); err == nil {
+			// The enclave of a timed out test got dumped before being destroyed
+			return
+		}
+		if err := dumpEnclave(enclaveName, enclaveDumpDirpath); err != nil {
+			logrus.Errorf("An error occurred collecting the results of failed test '%v':\n%v", t.Name(), err)
+			return
+		}
+		logrus.Infof("Collected the results of failed test '%v' in '%v'", t.Name(), filepath.Dir(enclaveDumpDirpath))
+	})
+}
+
+// dumpEnclaveOfTimedOutTest dumps the enclave of the test to the test results directory, or to a temporary directory
+// if none was given, returning where the enclave got dumped. It doesn't use the test object, as it runs outside the
+// test goroutine
+func dumpEnclaveOfTimedOutTest(testName string, enclaveName string) (string, error) {
+	testResultsDirpath := os.Getenv(testResultsDirpathEnvVar)
+	if testResultsDirpath == "" {
+		tempDirpath, err := os.MkdirTemp("", timedOutTestResultsDirPattern)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred creating a temporary directory to dump enclave '%v' to", enclaveName)
+		}
+		testResultsDirpath = tempDirpath
+	}
+	enclaveDumpDirpath := getEnclaveDumpDirpath(testResultsDirpath, testName)
+	if err := dumpEnclave(enclaveName, enclaveDumpDirpath); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred dumping the enclave of timed out test '%v'", testName)
+	}
+	return enclaveDumpDirpath, nil
+}
+
+func getEnclaveDumpDirpath(testResultsDirpath string, testName string) string {
+	testDirname := strings.ReplaceAll(testName, testNameSeparator, testResultsDirnameSeparator)
+	return filepath.Join(testResultsDirpath, testDirname, enclaveDumpDirname)
+}
+
+func dumpEnclave(enclaveName string, enclaveDumpDirpath string) error {
+	testResultsDirpathForTest := filepath.Dir(enclaveDumpDirpath)
+	if err := os.MkdirAll(testResultsDirpathForTest, testResultsDirPerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating results directory '%v'", testResultsDirpathForTest)
+	}
+
+	kurtosisBinpath := os.Getenv(kurtosisBinpathEnvVar)
+	if kurtosisBinpath == "" {
+		kurtosisBinpath = defaultKurtosisBinpath
+	}
+	dumpOutput, err := exec.Command(kurtosisBinpath, "enclave", "dump", enclaveName, enclaveDumpDirpath).CombinedOutput()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping enclave '%v' to '%v':\n%v", enclaveName, enclaveDumpDirpath, string(dumpOutput))
+	}
+	return nil
+}
@@ -53,5 +53,6 @@ func TestAddServiceWithInvalidServiceNameFailsValidation(t *testing.T) {
 
 	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error.")
 	require.NotEmpty(t, runResult.ValidationErrors, "Expected some validation errors")
-	require.Contains(t, runResult.ValidationErrors[0].ErrorMessage, fmt.Sprintf("Service name '%s' is invalid as it contains disallowed characters. Service names can only contain characters 'a-z', 'A-Z', '0-9', '-' & '_'", invalidServiceName))
+	require.Contains(t, runResult.ValidationErrors[0].ErrorMessage, fmt.Sprintf("Service name '%s' is invalid", invalidServiceName))
+	require.Contains(t, runResult.ValidationErrors[0].ErrorMessage, "contains disallowed character ';' at position 5")
 }
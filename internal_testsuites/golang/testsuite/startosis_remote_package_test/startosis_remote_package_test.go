@@ -18,6 +18,7 @@ const (
 	dataStoreService1Name = "datastore-1"
 	datastorePortId       = "grpc"
 	defaultParallelism    = 4
+	defaultIsStrict       = false
 )
 
 func TestStartosisRemotePackage(t *testing.T) {
@@ -31,7 +32,7 @@ func TestStartosisRemotePackage(t *testing.T) {
 	// ------------------------------------- TEST RUN ----------------------------------------------
 	logrus.Debugf("Executing Starlark Package: '%v'", remotePackage)
 
-	runResult, err := enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, remotePackage, executeParams, defaultDryRun, defaultParallelism)
+	runResult, err := enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, remotePackage, executeParams, defaultDryRun, defaultParallelism, defaultIsStrict)
 	require.NoError(t, err, "Unexpected error executing starlark package")
 
 	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error. This test requires you to be online for the read_file command to run")
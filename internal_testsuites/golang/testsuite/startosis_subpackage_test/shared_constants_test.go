@@ -5,4 +5,5 @@ const (
 	emptyRunParams        = "{}"
 	defaultDryRun         = false
 	defaultParallelism    = 4
+	defaultIsStrict       = false
 )
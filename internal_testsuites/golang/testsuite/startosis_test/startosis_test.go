@@ -13,6 +13,7 @@ const (
 	isPartitioningEnabled = false
 	defaultDryRun         = false
 	defaultParallelism    = 4
+	defaultIsStrict       = false
 	greetingsArg          = `{"greeting": "World!"}`
 
 	serviceName                   = "example-datastore-server-1"
@@ -106,7 +107,7 @@ func TestStartosis(t *testing.T) {
 	logrus.Infof("Executing Startosis script...")
 	logrus.Debugf("Startosis script content: \n%v", startosisScript)
 
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, startosisScript, greetingsArg, defaultDryRun, defaultParallelism)
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, startosisScript, greetingsArg, defaultDryRun, defaultParallelism, defaultIsStrict)
 	require.NoError(t, err, "Unexpected error executing startosis script")
 
 	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error. This test requires you to be online for the read_file command to run")